@@ -14,6 +14,25 @@
 
 // Command authdb-dump can dump AuthDB proto served by an Auth Service.
 //
+// It can also explain how a permission check would be decided by this AuthDB:
+//
+//	authdb-dump explain -identity user:someone@example.com \
+//	    -permission luci.dev.testing -realm project:realm -attr key=value
+//
+// And report accesses gained or lost when switching to another AuthDB or when
+// applying proposed group and realm edits (see accessdiff.ApplyEdits):
+//
+//	authdb-dump diff -new-proto-file new.pb
+//	authdb-dump diff -edits edits.textpb
+//
+// The edits file is a text protocol.AuthDB with only the changed groups and the
+// full expanded realms of changed projects. realms.cfg files are not accepted,
+// since expanding them requires permissions.cfg of the Auth Service. The
+// easiest way to get such file is to dump the current AuthDB as text (i.e. run
+// authdb-dump without arguments), delete all groups and realms that are not
+// being changed, keep `realms.permissions` and `realms.conditions` as they are
+// (bindings refer to them by index) and then edit the rest.
+//
 // This is to aid in developing Realms API and debugging issues. Not intended to
 // be used in any production setting.
 package main
@@ -29,17 +48,23 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/prototext"
 
 	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/auth/identity"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag/stringmapflag"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/hardcoded/chromeinfra"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/auth/authdb/accessdiff"
 	"go.chromium.org/luci/server/auth/service/protocol"
 )
 
@@ -48,6 +73,8 @@ var (
 		"https:// URL of a Auth Service to fetch realms from")
 	outputFile = flag.String("output-proto-file", "",
 		"If set, write the protocol.AuthDB to this file using wirepb encoding instead of dumping it as text to stdout")
+	inputFile = flag.String("input-proto-file", "",
+		"If set, read the protocol.AuthDB from this file (as written by -output-proto-file) instead of fetching it")
 )
 
 func main() {
@@ -65,19 +92,69 @@ func run(ctx context.Context) error {
 
 	flag.Parse()
 
-	opts, err := authFlags.Options()
-	if err != nil {
-		return err
+	// Parse flags of the subcommand before doing any network calls.
+	var explain *explainRequest
+	var diff *diffRequest
+	switch flag.Arg(0) {
+	case "":
+	case "explain":
+		var err error
+		if explain, err = parseExplainFlags(flag.Args()[1:]); err != nil {
+			return err
+		}
+	case "diff":
+		var err error
+		if diff, err = parseDiffFlags(flag.Args()[1:]); err != nil {
+			return err
+		}
+	default:
+		return errors.Reason("unknown subcommand %q", flag.Arg(0)).Err()
 	}
-	authenticator := auth.NewAuthenticator(ctx, auth.SilentLogin, opts)
-	client, err := authenticator.Client()
-	if err != nil {
-		return err
+
+	var authDB *protocol.AuthDB
+	if *inputFile != "" {
+		var err error
+		if authDB, err = readAuthDB(*inputFile); err != nil {
+			return err
+		}
+	} else {
+		opts, err := authFlags.Options()
+		if err != nil {
+			return err
+		}
+		authenticator := auth.NewAuthenticator(ctx, auth.SilentLogin, opts)
+		client, err := authenticator.Client()
+		if err != nil {
+			return err
+		}
+		if authDB, err = fetchAuthDB(ctx, client, *authServiceURL); err != nil {
+			return err
+		}
 	}
 
-	authDB, err := fetchAuthDB(ctx, client, *authServiceURL)
-	if err != nil {
-		return err
+	if explain != nil {
+		exp, err := authdb.ExplainPermission(ctx, authDB, explain.identity, explain.permission, explain.realm, explain.attrs)
+		if err != nil {
+			return err
+		}
+		printExplanation(os.Stdout, exp)
+		return nil
+	}
+
+	if diff != nil {
+		newDB := diff.newDB
+		if newDB == nil {
+			var err error
+			if newDB, err = accessdiff.ApplyEdits(authDB, diff.edits); err != nil {
+				return errors.Annotate(err, "failed to apply edits").Err()
+			}
+		}
+		changes, err := accessdiff.Compute(authDB, newDB)
+		if err != nil {
+			return err
+		}
+		printChanges(os.Stdout, changes)
+		return nil
 	}
 
 	if *outputFile != "" {
@@ -93,6 +170,19 @@ func run(ctx context.Context) error {
 	return nil
 }
 
+// readAuthDB reads AuthDB proto written by -output-proto-file.
+func readAuthDB(path string) (*protocol.AuthDB, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	authDB := &protocol.AuthDB{}
+	if err := proto.Unmarshal(blob, authDB); err != nil {
+		return nil, errors.Annotate(err, "failed to deserialize AuthDB proto from %s", path).Err()
+	}
+	return authDB, nil
+}
+
 func fetchAuthDB(ctx context.Context, client *http.Client, authServiceURL string) (*protocol.AuthDB, error) {
 	req, err := http.NewRequest("GET", authServiceURL+"/auth_service/api/v1/authdb/revisions/latest", nil)
 	if err != nil {
@@ -160,3 +250,157 @@ func fetchAuthDB(ctx context.Context, client *http.Client, authServiceURL string
 
 	return msg.AuthDb, nil
 }
+
+// explainRequest is parsed flags of the "explain" subcommand.
+type explainRequest struct {
+	identity   identity.Identity
+	permission string
+	realm      string
+	attrs      map[string]string
+}
+
+func parseExplainFlags(args []string) (*explainRequest, error) {
+	fs := flag.NewFlagSet("explain", flag.ContinueOnError)
+	ident := fs.String("identity", "", "Identity to check, e.g. user:someone@example.com")
+	perm := fs.String("permission", "", "Permission to check, e.g. luci.dev.testing")
+	realm := fs.String("realm", "", "Full name of the realm to check the permission in, e.g. project:realm")
+	attrs := stringmapflag.Value{}
+	fs.Var(&attrs, "attr", "A key=value attribute to evaluate conditional bindings with. May be repeated.")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		return nil, errors.Reason("unexpected positional arguments %q", fs.Args()).Err()
+	}
+
+	id, err := identity.MakeIdentity(*ident)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -identity").Err()
+	}
+	if *perm == "" {
+		return nil, errors.Reason("-permission is required").Err()
+	}
+	if *realm == "" {
+		return nil, errors.Reason("-realm is required").Err()
+	}
+	return &explainRequest{
+		identity:   id,
+		permission: *perm,
+		realm:      *realm,
+		attrs:      attrs,
+	}, nil
+}
+
+// printExplanation prints the explanation in a human-readable form.
+func printExplanation(w io.Writer, exp *authdb.PermissionExplanation) {
+	verdict := "DENIED"
+	if exp.Granted {
+		verdict = "GRANTED"
+	}
+	fmt.Fprintf(w, "%s: %q in realm %q for %s\n", verdict, exp.Permission, exp.Realm, exp.Identity)
+	for _, note := range exp.Notes {
+		fmt.Fprintf(w, "  note: %s\n", note)
+	}
+	if exp.CheckedRealm == "" {
+		return
+	}
+
+	fmt.Fprintf(w, "Bindings in realm %q:\n", exp.CheckedRealm)
+	for i, b := range exp.Bindings {
+		status := "does not apply"
+		if b.Granted {
+			status = "GRANTS"
+		}
+		fmt.Fprintf(w, "  #%d: %s\n", i+1, status)
+		for _, cond := range b.Conditions {
+			mark := "FAILED"
+			if cond.Matched {
+				mark = "ok"
+			}
+			fmt.Fprintf(w, "    condition %s: %s\n", mark, cond.Description)
+		}
+		if len(b.Identities) != 0 {
+			fmt.Fprintf(w, "    identities: %s\n", strings.Join(b.Identities, ", "))
+		}
+		if len(b.Groups) != 0 {
+			fmt.Fprintf(w, "    groups: %s\n", strings.Join(b.Groups, ", "))
+		}
+		switch {
+		case b.IdentityListed:
+			fmt.Fprintf(w, "    %s is listed directly\n", exp.Identity)
+		case b.MembershipPath != nil:
+			fmt.Fprintf(w, "    %s is a member via %s\n", exp.Identity, strings.Join(b.MembershipPath, " -> "))
+		default:
+			fmt.Fprintf(w, "    %s is not a member\n", exp.Identity)
+		}
+	}
+}
+
+// diffRequest is parsed flags of the "diff" subcommand.
+//
+// Exactly one of `newDB` or `edits` is set.
+type diffRequest struct {
+	newDB *protocol.AuthDB
+	edits *protocol.AuthDB
+}
+
+func parseDiffFlags(args []string) (*diffRequest, error) {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	newFile := fs.String("new-proto-file", "", "AuthDB to compare to, as written by -output-proto-file")
+	editsFile := fs.String("edits", "", "A text proto file with protocol.AuthDB with proposed group and expanded realm edits, see the command doc")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		return nil, errors.Reason("unexpected positional arguments %q", fs.Args()).Err()
+	}
+
+	switch {
+	case (*newFile == "") == (*editsFile == ""):
+		return nil, errors.Reason("exactly one of -new-proto-file or -edits is required").Err()
+	case *newFile != "":
+		newDB, err := readAuthDB(*newFile)
+		if err != nil {
+			return nil, err
+		}
+		return &diffRequest{newDB: newDB}, nil
+	default:
+		blob, err := os.ReadFile(*editsFile)
+		if err != nil {
+			return nil, err
+		}
+		edits := &protocol.AuthDB{}
+		if err := prototext.Unmarshal(blob, edits); err != nil {
+			return nil, errors.Annotate(err, "failed to parse %s", *editsFile).Err()
+		}
+		return &diffRequest{edits: edits}, nil
+	}
+}
+
+// printChanges prints changes grouped by principal and permission.
+func printChanges(w io.Writer, changes []accessdiff.Change) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "No access changes\n")
+		return
+	}
+	var principal, permission string
+	for _, change := range changes {
+		if change.Principal != principal {
+			fmt.Fprintf(w, "%s\n", change.Principal)
+			principal, permission = change.Principal, ""
+		}
+		if change.Permission != permission {
+			fmt.Fprintf(w, "  %s\n", change.Permission)
+			permission = change.Permission
+		}
+		sign := "-"
+		if change.Gained {
+			sign = "+"
+		}
+		if change.Condition != "" {
+			fmt.Fprintf(w, "    %s %s (if %s)\n", sign, change.Realm, change.Condition)
+		} else {
+			fmt.Fprintf(w, "    %s %s\n", sign, change.Realm)
+		}
+	}
+}
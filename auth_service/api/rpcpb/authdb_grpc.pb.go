@@ -33,7 +33,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthDB_GetSnapshot_FullMethodName = "/auth.service.AuthDB/GetSnapshot"
+	AuthDB_GetSnapshot_FullMethodName       = "/auth.service.AuthDB/GetSnapshot"
+	AuthDB_ExplainPermission_FullMethodName = "/auth.service.AuthDB/ExplainPermission"
 )
 
 // AuthDBClient is the client API for AuthDB service.
@@ -43,6 +44,12 @@ type AuthDBClient interface {
 	// GetSnapshot serves the deflated AuthDB proto
 	// message with snapshot of all groups.
 	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	// ExplainPermission explains how a permission check would be decided by
+	// some AuthDB revision.
+	//
+	// Returns the full decision trace: which realm and bindings were consulted,
+	// which conditions matched and via which groups the identity is a member.
+	ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*PermissionExplanation, error)
 }
 
 type authDBClient struct {
@@ -62,6 +69,15 @@ func (c *authDBClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest,
 	return out, nil
 }
 
+func (c *authDBClient) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*PermissionExplanation, error) {
+	out := new(PermissionExplanation)
+	err := c.cc.Invoke(ctx, AuthDB_ExplainPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthDBServer is the server API for AuthDB service.
 // All implementations must embed UnimplementedAuthDBServer
 // for forward compatibility
@@ -69,6 +85,12 @@ type AuthDBServer interface {
 	// GetSnapshot serves the deflated AuthDB proto
 	// message with snapshot of all groups.
 	GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error)
+	// ExplainPermission explains how a permission check would be decided by
+	// some AuthDB revision.
+	//
+	// Returns the full decision trace: which realm and bindings were consulted,
+	// which conditions matched and via which groups the identity is a member.
+	ExplainPermission(context.Context, *ExplainPermissionRequest) (*PermissionExplanation, error)
 	mustEmbedUnimplementedAuthDBServer()
 }
 
@@ -79,6 +101,9 @@ type UnimplementedAuthDBServer struct {
 func (UnimplementedAuthDBServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
 }
+func (UnimplementedAuthDBServer) ExplainPermission(context.Context, *ExplainPermissionRequest) (*PermissionExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
 func (UnimplementedAuthDBServer) mustEmbedUnimplementedAuthDBServer() {}
 
 // UnsafeAuthDBServer may be embedded to opt out of forward compatibility for this service.
@@ -110,6 +135,24 @@ func _AuthDB_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthDB_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthDBServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthDB_ExplainPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthDBServer).ExplainPermission(ctx, req.(*ExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthDB_ServiceDesc is the grpc.ServiceDesc for AuthDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +164,10 @@ var AuthDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnapshot",
 			Handler:    _AuthDB_GetSnapshot_Handler,
 		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _AuthDB_ExplainPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/auth_service/api/rpcpb/authdb.proto",
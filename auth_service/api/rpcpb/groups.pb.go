@@ -24,6 +24,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -94,6 +95,127 @@ func (PrincipalKind) EnumDescriptor() ([]byte, []int) {
 	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{0}
 }
 
+// State is the state of the request.
+type GroupAccessRequest_State int32
+
+const (
+	GroupAccessRequest_STATE_UNSPECIFIED GroupAccessRequest_State = 0
+	// The request waits for a review by the group owners.
+	GroupAccessRequest_PENDING GroupAccessRequest_State = 1
+	// The request was approved and the requestor was added to the group.
+	GroupAccessRequest_APPROVED GroupAccessRequest_State = 2
+	// The request was denied.
+	GroupAccessRequest_DENIED GroupAccessRequest_State = 3
+)
+
+// Enum value maps for GroupAccessRequest_State.
+var (
+	GroupAccessRequest_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "DENIED",
+	}
+	GroupAccessRequest_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"APPROVED":          2,
+		"DENIED":            3,
+	}
+)
+
+func (x GroupAccessRequest_State) Enum() *GroupAccessRequest_State {
+	p := new(GroupAccessRequest_State)
+	*p = x
+	return p
+}
+
+func (x GroupAccessRequest_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupAccessRequest_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupAccessRequest_State) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes[1]
+}
+
+func (x GroupAccessRequest_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupAccessRequest_State.Descriptor instead.
+func (GroupAccessRequest_State) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{12, 0}
+}
+
+// Analysis is a kind of analysis of the groups graph.
+type AnalyzeGroupGraphRequest_Analysis int32
+
+const (
+	AnalyzeGroupGraphRequest_ANALYSIS_UNSPECIFIED AnalyzeGroupGraphRequest_Analysis = 0
+	// Find all elementary cycles of nested groups, each listed once. Stops
+	// after 10000 cycles, setting `truncated` in the response.
+	AnalyzeGroupGraphRequest_CYCLES AnalyzeGroupGraphRequest_Analysis = 1
+	// Find members and nested groups of a group which it already includes via
+	// another nested group.
+	AnalyzeGroupGraphRequest_REDUNDANT_MEMBERSHIPS AnalyzeGroupGraphRequest_Analysis = 2
+	// Find groups which are not referenced by any realm binding, by another
+	// group as its owners, by Auth Service itself or by `referenced_groups`,
+	// either directly or via a group including them.
+	AnalyzeGroupGraphRequest_ORPHANED_GROUPS AnalyzeGroupGraphRequest_Analysis = 3
+	// Find all realm permissions that would be affected if `principal` was
+	// removed from all groups (for identities) or deleted (for groups).
+	AnalyzeGroupGraphRequest_BLAST_RADIUS AnalyzeGroupGraphRequest_Analysis = 4
+)
+
+// Enum value maps for AnalyzeGroupGraphRequest_Analysis.
+var (
+	AnalyzeGroupGraphRequest_Analysis_name = map[int32]string{
+		0: "ANALYSIS_UNSPECIFIED",
+		1: "CYCLES",
+		2: "REDUNDANT_MEMBERSHIPS",
+		3: "ORPHANED_GROUPS",
+		4: "BLAST_RADIUS",
+	}
+	AnalyzeGroupGraphRequest_Analysis_value = map[string]int32{
+		"ANALYSIS_UNSPECIFIED":  0,
+		"CYCLES":                1,
+		"REDUNDANT_MEMBERSHIPS": 2,
+		"ORPHANED_GROUPS":       3,
+		"BLAST_RADIUS":          4,
+	}
+)
+
+func (x AnalyzeGroupGraphRequest_Analysis) Enum() *AnalyzeGroupGraphRequest_Analysis {
+	p := new(AnalyzeGroupGraphRequest_Analysis)
+	*p = x
+	return p
+}
+
+func (x AnalyzeGroupGraphRequest_Analysis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyzeGroupGraphRequest_Analysis) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes[2].Descriptor()
+}
+
+func (AnalyzeGroupGraphRequest_Analysis) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes[2]
+}
+
+func (x AnalyzeGroupGraphRequest_Analysis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyzeGroupGraphRequest_Analysis.Descriptor instead.
+func (AnalyzeGroupGraphRequest_Analysis) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // ListGroupsResponse is all the groups listed in LUCI Auth Service.
 type ListGroupsResponse struct {
 	state         protoimpl.MessageState
@@ -379,6 +501,12 @@ type AuthGroup struct {
 	CreatedBy   string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // e.g: "user:test@example.com"
 	// Output only. Whether the caller can modify this group.
 	CallerCanModify bool `protobuf:"varint,9,opt,name=caller_can_modify,json=callerCanModify,proto3" json:"caller_can_modify,omitempty"`
+	// Members (also listed in `members`) whose membership is time-bounded.
+	//
+	// They are removed from the group automatically once their membership
+	// expires. When updating a group, this field is updated only if it is
+	// explicitly listed in the update mask.
+	MembershipExpirations []*MembershipExpiration `protobuf:"bytes,10,rep,name=membership_expirations,json=membershipExpirations,proto3" json:"membership_expirations,omitempty"`
 	// An opaque string that indicates the version of the group being edited.
 	// This will be sent to the client in responses, and should be sent back
 	// to the server for update and delete requests in order to protect against
@@ -485,6 +613,13 @@ func (x *AuthGroup) GetCallerCanModify() bool {
 	return false
 }
 
+func (x *AuthGroup) GetMembershipExpirations() []*MembershipExpiration {
+	if x != nil {
+		return x.MembershipExpirations
+	}
+	return nil
+}
+
 func (x *AuthGroup) GetEtag() string {
 	if x != nil {
 		return x.Etag
@@ -492,6 +627,62 @@ func (x *AuthGroup) GetEtag() string {
 	return ""
 }
 
+// MembershipExpiration defines when a group member should be removed.
+type MembershipExpiration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`                 // e.g: "user:t@example.com"
+	ExpiryTs *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiry_ts,json=expiryTs,proto3" json:"expiry_ts,omitempty"` // e.g: "1972-01-01T10:00:20.021Z"
+}
+
+func (x *MembershipExpiration) Reset() {
+	*x = MembershipExpiration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MembershipExpiration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipExpiration) ProtoMessage() {}
+
+func (x *MembershipExpiration) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipExpiration.ProtoReflect.Descriptor instead.
+func (*MembershipExpiration) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MembershipExpiration) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *MembershipExpiration) GetExpiryTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTs
+	}
+	return nil
+}
+
 // GetSubgraphRequest contains the Principal that is the basis of the search
 // for inclusion and is the root of the output subgraph.
 type GetSubgraphRequest struct {
@@ -505,7 +696,7 @@ type GetSubgraphRequest struct {
 func (x *GetSubgraphRequest) Reset() {
 	*x = GetSubgraphRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +709,7 @@ func (x *GetSubgraphRequest) String() string {
 func (*GetSubgraphRequest) ProtoMessage() {}
 
 func (x *GetSubgraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +722,7 @@ func (x *GetSubgraphRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubgraphRequest.ProtoReflect.Descriptor instead.
 func (*GetSubgraphRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetSubgraphRequest) GetPrincipal() *Principal {
@@ -556,7 +747,7 @@ type Subgraph struct {
 func (x *Subgraph) Reset() {
 	*x = Subgraph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +760,7 @@ func (x *Subgraph) String() string {
 func (*Subgraph) ProtoMessage() {}
 
 func (x *Subgraph) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +773,7 @@ func (x *Subgraph) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subgraph.ProtoReflect.Descriptor instead.
 func (*Subgraph) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Subgraph) GetNodes() []*Node {
@@ -607,7 +798,7 @@ type Principal struct {
 func (x *Principal) Reset() {
 	*x = Principal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -620,7 +811,7 @@ func (x *Principal) String() string {
 func (*Principal) ProtoMessage() {}
 
 func (x *Principal) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +824,7 @@ func (x *Principal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Principal.ProtoReflect.Descriptor instead.
 func (*Principal) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Principal) GetKind() PrincipalKind {
@@ -669,7 +860,7 @@ type Node struct {
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +873,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +886,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Node) GetPrincipal() *Principal {
@@ -712,237 +903,1347 @@ func (x *Node) GetIncludedBy() []int32 {
 	return nil
 }
 
-var File_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto protoreflect.FileDescriptor
+// RequestGroupAccessRequest asks for a time-bounded membership in a group.
+type RequestGroupAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDesc = []byte{
-	0x0a, 0x38, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
-	0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x41, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0xc0, 0x02, 0x0a,
-	0x09, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6c, 0x6f, 0x62,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2f, 0x0a, 0x11, 0x63, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x43, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x65,
-	0x74, 0x61, 0x67, 0x18, 0x63, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22,
-	0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
-	0x6c, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x08,
-	0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x28, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x22, 0x50, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
-	0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69,
-	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x09,
-	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
-	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
-	0x70, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x64, 0x42, 0x79, 0x2a, 0x52, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
-	0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50,
-	0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
-	0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x47, 0x4c, 0x4f, 0x42, 0x10, 0x03, 0x32, 0x86, 0x04, 0x0a, 0x06, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x47, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x61,
-	0x6e, 0x64, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
-	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// Name of the group to join.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// For how long the membership is requested, counting from the approval.
+	//
+	// Must not exceed 30 days.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Why the access is needed. Shown to the group owners and recorded in the
+	// changelog when the request is approved.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
 }
 
-var (
-	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescOnce sync.Once
-	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData = file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDesc
-)
+func (x *RequestGroupAccessRequest) Reset() {
+	*x = RequestGroupAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP() []byte {
-	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescOnce.Do(func() {
-		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData)
-	})
-	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData
+func (x *RequestGroupAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_goTypes = []interface{}{
-	(PrincipalKind)(0),            // 0: auth.service.PrincipalKind
-	(*ListGroupsResponse)(nil),    // 1: auth.service.ListGroupsResponse
-	(*GetGroupRequest)(nil),       // 2: auth.service.GetGroupRequest
-	(*CreateGroupRequest)(nil),    // 3: auth.service.CreateGroupRequest
-	(*UpdateGroupRequest)(nil),    // 4: auth.service.UpdateGroupRequest
-	(*DeleteGroupRequest)(nil),    // 5: auth.service.DeleteGroupRequest
-	(*AuthGroup)(nil),             // 6: auth.service.AuthGroup
-	(*GetSubgraphRequest)(nil),    // 7: auth.service.GetSubgraphRequest
-	(*Subgraph)(nil),              // 8: auth.service.Subgraph
-	(*Principal)(nil),             // 9: auth.service.Principal
-	(*Node)(nil),                  // 10: auth.service.Node
-	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+func (*RequestGroupAccessRequest) ProtoMessage() {}
+
+func (x *RequestGroupAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_depIdxs = []int32{
-	6,  // 0: auth.service.ListGroupsResponse.groups:type_name -> auth.service.AuthGroup
-	6,  // 1: auth.service.CreateGroupRequest.group:type_name -> auth.service.AuthGroup
-	6,  // 2: auth.service.UpdateGroupRequest.group:type_name -> auth.service.AuthGroup
-	11, // 3: auth.service.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 4: auth.service.AuthGroup.created_ts:type_name -> google.protobuf.Timestamp
-	9,  // 5: auth.service.GetSubgraphRequest.principal:type_name -> auth.service.Principal
-	10, // 6: auth.service.Subgraph.nodes:type_name -> auth.service.Node
-	0,  // 7: auth.service.Principal.kind:type_name -> auth.service.PrincipalKind
-	9,  // 8: auth.service.Node.principal:type_name -> auth.service.Principal
-	13, // 9: auth.service.Groups.ListGroups:input_type -> google.protobuf.Empty
-	2,  // 10: auth.service.Groups.GetGroup:input_type -> auth.service.GetGroupRequest
-	3,  // 11: auth.service.Groups.CreateGroup:input_type -> auth.service.CreateGroupRequest
-	4,  // 12: auth.service.Groups.UpdateGroup:input_type -> auth.service.UpdateGroupRequest
-	5,  // 13: auth.service.Groups.DeleteGroup:input_type -> auth.service.DeleteGroupRequest
-	7,  // 14: auth.service.Groups.GetSubgraph:input_type -> auth.service.GetSubgraphRequest
-	2,  // 15: auth.service.Groups.GetExpandedGroup:input_type -> auth.service.GetGroupRequest
-	1,  // 16: auth.service.Groups.ListGroups:output_type -> auth.service.ListGroupsResponse
-	6,  // 17: auth.service.Groups.GetGroup:output_type -> auth.service.AuthGroup
-	6,  // 18: auth.service.Groups.CreateGroup:output_type -> auth.service.AuthGroup
-	6,  // 19: auth.service.Groups.UpdateGroup:output_type -> auth.service.AuthGroup
-	13, // 20: auth.service.Groups.DeleteGroup:output_type -> google.protobuf.Empty
-	8,  // 21: auth.service.Groups.GetSubgraph:output_type -> auth.service.Subgraph
-	6,  // 22: auth.service.Groups.GetExpandedGroup:output_type -> auth.service.AuthGroup
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use RequestGroupAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestGroupAccessRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() }
-func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
-	if File_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto != nil {
-		return
+func (x *RequestGroupAccessRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateGroupRequest); i {
-			case 0:
-				return &v.state
-			case 1:
+	return ""
+}
+
+func (x *RequestGroupAccessRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *RequestGroupAccessRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// GroupAccessRequest is a request to join a group for a limited time.
+type GroupAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // e.g: 1234
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                               // e.g: "some-group"
+	Requestor     string                   `protobuf:"bytes,3,opt,name=requestor,proto3" json:"requestor,omitempty"`                                     // e.g: "user:t@example.com"
+	Justification string                   `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`                             // e.g: "Need to debug an outage"
+	Duration      *durationpb.Duration     `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`                                       // e.g: "3600s"
+	State         GroupAccessRequest_State `protobuf:"varint,6,opt,name=state,proto3,enum=auth.service.GroupAccessRequest_State" json:"state,omitempty"` // e.g: PENDING
+	CreatedTs     *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`                    // e.g: "1972-01-01T10:00:20.021Z"
+	// Fields below are populated once the request is reviewed.
+	ReviewedBy    string                 `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`           // e.g: "user:owner@example.com"
+	ReviewedTs    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=reviewed_ts,json=reviewedTs,proto3" json:"reviewed_ts,omitempty"`           // e.g: "1972-01-01T10:00:20.021Z"
+	ReviewComment string                 `protobuf:"bytes,10,opt,name=review_comment,json=reviewComment,proto3" json:"review_comment,omitempty"` // e.g: "Approved for the outage"
+	// When the granted membership expires. Populated only for approved requests.
+	MembershipExpiryTs *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=membership_expiry_ts,json=membershipExpiryTs,proto3" json:"membership_expiry_ts,omitempty"`
+}
+
+func (x *GroupAccessRequest) Reset() {
+	*x = GroupAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAccessRequest) ProtoMessage() {}
+
+func (x *GroupAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAccessRequest.ProtoReflect.Descriptor instead.
+func (*GroupAccessRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GroupAccessRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupAccessRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupAccessRequest) GetRequestor() string {
+	if x != nil {
+		return x.Requestor
+	}
+	return ""
+}
+
+func (x *GroupAccessRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *GroupAccessRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *GroupAccessRequest) GetState() GroupAccessRequest_State {
+	if x != nil {
+		return x.State
+	}
+	return GroupAccessRequest_STATE_UNSPECIFIED
+}
+
+func (x *GroupAccessRequest) GetCreatedTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return nil
+}
+
+func (x *GroupAccessRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *GroupAccessRequest) GetReviewedTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedTs
+	}
+	return nil
+}
+
+func (x *GroupAccessRequest) GetReviewComment() string {
+	if x != nil {
+		return x.ReviewComment
+	}
+	return ""
+}
+
+func (x *GroupAccessRequest) GetMembershipExpiryTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipExpiryTs
+	}
+	return nil
+}
+
+// ListGroupAccessRequestsRequest specifies the group to list requests for.
+type ListGroupAccessRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ListGroupAccessRequestsRequest) Reset() {
+	*x = ListGroupAccessRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListGroupAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListGroupAccessRequestsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListGroupAccessRequestsResponse contains pending access requests.
+type ListGroupAccessRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pending requests, oldest first.
+	Requests []*GroupAccessRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListGroupAccessRequestsResponse) Reset() {
+	*x = ListGroupAccessRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupAccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAccessRequestsResponse) ProtoMessage() {}
+
+func (x *ListGroupAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListGroupAccessRequestsResponse) GetRequests() []*GroupAccessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// ReviewGroupAccessRequestRequest approves or denies an access request.
+type ReviewGroupAccessRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the group the request is for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the request to review.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// True to approve the request, false to deny it.
+	Approve bool `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	// An optional comment recorded in the request.
+	Comment string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *ReviewGroupAccessRequestRequest) Reset() {
+	*x = ReviewGroupAccessRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewGroupAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGroupAccessRequestRequest) ProtoMessage() {}
+
+func (x *ReviewGroupAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGroupAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReviewGroupAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReviewGroupAccessRequestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReviewGroupAccessRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewGroupAccessRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewGroupAccessRequestRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// AnalyzeGroupGraphRequest specifies the analysis to run.
+type AnalyzeGroupGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The analysis to run.
+	Analysis AnalyzeGroupGraphRequest_Analysis `protobuf:"varint,1,opt,name=analysis,proto3,enum=auth.service.AnalyzeGroupGraphRequest_Analysis" json:"analysis,omitempty"`
+	// The principal to analyze. Required for BLAST_RADIUS, where it must be
+	// an identity or a group.
+	Principal *Principal `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// The maximum number of findings to return. Defaults to 100, at most 1000.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The value of next_page_token received in a previous
+	// AnalyzeGroupGraphResponse. If empty, gets the first page. Tokens are
+	// opaque and become invalid once the AuthDB revision changes. All other
+	// fields except page_size must match the request that returned the token.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Groups referenced outside of realms, e.g. in service configs, which must
+	// not be reported as orphaned. Used only by ORPHANED_GROUPS.
+	ReferencedGroups []string `protobuf:"bytes,5,rep,name=referenced_groups,json=referencedGroups,proto3" json:"referenced_groups,omitempty"`
+}
+
+func (x *AnalyzeGroupGraphRequest) Reset() {
+	*x = AnalyzeGroupGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeGroupGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeGroupGraphRequest) ProtoMessage() {}
+
+func (x *AnalyzeGroupGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeGroupGraphRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeGroupGraphRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AnalyzeGroupGraphRequest) GetAnalysis() AnalyzeGroupGraphRequest_Analysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return AnalyzeGroupGraphRequest_ANALYSIS_UNSPECIFIED
+}
+
+func (x *AnalyzeGroupGraphRequest) GetPrincipal() *Principal {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *AnalyzeGroupGraphRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AnalyzeGroupGraphRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *AnalyzeGroupGraphRequest) GetReferencedGroups() []string {
+	if x != nil {
+		return x.ReferencedGroups
+	}
+	return nil
+}
+
+// AnalyzeGroupGraphResponse contains a page of findings of the analysis.
+type AnalyzeGroupGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Findings of the analysis, in a stable order.
+	Findings []*GroupGraphFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	// The value to use as the page_token in an AnalyzeGroupGraphRequest to get
+	// the next page of findings. If empty, there are no more findings.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// True if the analysis stopped early and the findings, across all pages,
+	// are incomplete. Set on every page.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *AnalyzeGroupGraphResponse) Reset() {
+	*x = AnalyzeGroupGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeGroupGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeGroupGraphResponse) ProtoMessage() {}
+
+func (x *AnalyzeGroupGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeGroupGraphResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeGroupGraphResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AnalyzeGroupGraphResponse) GetFindings() []*GroupGraphFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *AnalyzeGroupGraphResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *AnalyzeGroupGraphResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// GroupGraphFinding is a single finding of a groups graph analysis. The kind of
+// the finding matches the requested analysis.
+type GroupGraphFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Finding:
+	//	*GroupGraphFinding_Cycle
+	//	*GroupGraphFinding_RedundantMembership
+	//	*GroupGraphFinding_OrphanedGroup
+	//	*GroupGraphFinding_AffectedPermission
+	Finding isGroupGraphFinding_Finding `protobuf_oneof:"finding"`
+}
+
+func (x *GroupGraphFinding) Reset() {
+	*x = GroupGraphFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupGraphFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGraphFinding) ProtoMessage() {}
+
+func (x *GroupGraphFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGraphFinding.ProtoReflect.Descriptor instead.
+func (*GroupGraphFinding) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *GroupGraphFinding) GetFinding() isGroupGraphFinding_Finding {
+	if m != nil {
+		return m.Finding
+	}
+	return nil
+}
+
+func (x *GroupGraphFinding) GetCycle() *GroupCycle {
+	if x, ok := x.GetFinding().(*GroupGraphFinding_Cycle); ok {
+		return x.Cycle
+	}
+	return nil
+}
+
+func (x *GroupGraphFinding) GetRedundantMembership() *RedundantMembership {
+	if x, ok := x.GetFinding().(*GroupGraphFinding_RedundantMembership); ok {
+		return x.RedundantMembership
+	}
+	return nil
+}
+
+func (x *GroupGraphFinding) GetOrphanedGroup() *OrphanedGroup {
+	if x, ok := x.GetFinding().(*GroupGraphFinding_OrphanedGroup); ok {
+		return x.OrphanedGroup
+	}
+	return nil
+}
+
+func (x *GroupGraphFinding) GetAffectedPermission() *AffectedPermission {
+	if x, ok := x.GetFinding().(*GroupGraphFinding_AffectedPermission); ok {
+		return x.AffectedPermission
+	}
+	return nil
+}
+
+type isGroupGraphFinding_Finding interface {
+	isGroupGraphFinding_Finding()
+}
+
+type GroupGraphFinding_Cycle struct {
+	Cycle *GroupCycle `protobuf:"bytes,1,opt,name=cycle,proto3,oneof"`
+}
+
+type GroupGraphFinding_RedundantMembership struct {
+	RedundantMembership *RedundantMembership `protobuf:"bytes,2,opt,name=redundant_membership,json=redundantMembership,proto3,oneof"`
+}
+
+type GroupGraphFinding_OrphanedGroup struct {
+	OrphanedGroup *OrphanedGroup `protobuf:"bytes,3,opt,name=orphaned_group,json=orphanedGroup,proto3,oneof"`
+}
+
+type GroupGraphFinding_AffectedPermission struct {
+	AffectedPermission *AffectedPermission `protobuf:"bytes,4,opt,name=affected_permission,json=affectedPermission,proto3,oneof"`
+}
+
+func (*GroupGraphFinding_Cycle) isGroupGraphFinding_Finding() {}
+
+func (*GroupGraphFinding_RedundantMembership) isGroupGraphFinding_Finding() {}
+
+func (*GroupGraphFinding_OrphanedGroup) isGroupGraphFinding_Finding() {}
+
+func (*GroupGraphFinding_AffectedPermission) isGroupGraphFinding_Finding() {}
+
+// GroupCycle is a cycle of nested groups.
+type GroupCycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The groups in the cycle, each including the next one. The last group
+	// includes the first one.
+	Groups []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GroupCycle) Reset() {
+	*x = GroupCycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCycle) ProtoMessage() {}
+
+func (x *GroupCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCycle.ProtoReflect.Descriptor instead.
+func (*GroupCycle) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GroupCycle) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// RedundantMembership is a direct membership which is already implied by
+// another nested group.
+type RedundantMembership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The group with the redundant membership.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The redundant member; either an identity or a nested group.
+	Member *Principal `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// A chain of nested groups via which `group` already includes `member`,
+	// starting from a group nested in `group`.
+	Via []string `protobuf:"bytes,3,rep,name=via,proto3" json:"via,omitempty"`
+}
+
+func (x *RedundantMembership) Reset() {
+	*x = RedundantMembership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedundantMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedundantMembership) ProtoMessage() {}
+
+func (x *RedundantMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedundantMembership.ProtoReflect.Descriptor instead.
+func (*RedundantMembership) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RedundantMembership) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *RedundantMembership) GetMember() *Principal {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *RedundantMembership) GetVia() []string {
+	if x != nil {
+		return x.Via
+	}
+	return nil
+}
+
+// OrphanedGroup is a group nothing known to Auth Service refers to.
+type OrphanedGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the group.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *OrphanedGroup) Reset() {
+	*x = OrphanedGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrphanedGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedGroup) ProtoMessage() {}
+
+func (x *OrphanedGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedGroup.ProtoReflect.Descriptor instead.
+func (*OrphanedGroup) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrphanedGroup) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// AffectedPermission is a realm permission granted to the analyzed principal.
+type AffectedPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Full realm name, e.g. "project:realm".
+	Realm string `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Name of the permission, e.g. "luci.dev.testing".
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// A chain of groups via which the principal gets the permission, ending
+	// with the group listed in the realm binding. Empty if the principal is
+	// listed in the binding directly.
+	Via []string `protobuf:"bytes,3,rep,name=via,proto3" json:"via,omitempty"`
+	// True if all bindings granting the permission have conditions.
+	Conditional bool `protobuf:"varint,4,opt,name=conditional,proto3" json:"conditional,omitempty"`
+}
+
+func (x *AffectedPermission) Reset() {
+	*x = AffectedPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AffectedPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedPermission) ProtoMessage() {}
+
+func (x *AffectedPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedPermission.ProtoReflect.Descriptor instead.
+func (*AffectedPermission) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AffectedPermission) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+func (x *AffectedPermission) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AffectedPermission) GetVia() []string {
+	if x != nil {
+		return x.Via
+	}
+	return nil
+}
+
+func (x *AffectedPermission) GetConditional() bool {
+	if x != nil {
+		return x.Conditional
+	}
+	return false
+}
+
+var File_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x41, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x9b, 0x03, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6c, 0x6f, 0x62,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2f, 0x0a, 0x11, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x43, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x59, 0x0a, 0x16, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x15, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x6b, 0x0a, 0x14, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x37,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x12, 0x28, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x09, 0x50, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x04,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x42, 0x79, 0x22, 0x9b, 0x01, 0x0a,
+	0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x04, 0x0a, 0x12, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x54, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4c,
+	0x0a, 0x14, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x73, 0x22, 0x45, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4e, 0x49, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x39, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x83, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x18, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x12, 0x18, 0x0a, 0x14, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x59,
+	0x43, 0x4c, 0x45, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x44, 0x55, 0x4e, 0x44,
+	0x41, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x53, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x44, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x53, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x41, 0x53, 0x54, 0x5f,
+	0x52, 0x41, 0x44, 0x49, 0x55, 0x53, 0x10, 0x04, 0x22, 0x9e, 0x01, 0x0a, 0x19, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x11, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x30, 0x0a, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x12, 0x56, 0x0a, 0x14, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x44, 0x0a, 0x0e, 0x6f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x0d, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x53, 0x0a, 0x13, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x12, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x24, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61,
+	0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x76, 0x69, 0x61, 0x22, 0x25, 0x0a, 0x0d, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x7e, 0x0a, 0x12,
+	0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2a, 0x52, 0x0a, 0x0d,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a,
+	0x1a, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x4c, 0x4f, 0x42, 0x10, 0x03,
+	0x32, 0xb2, 0x07, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x47, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x4a, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x64, 0x0a, 0x11, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData = file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_goTypes = []interface{}{
+	(PrincipalKind)(0),                      // 0: auth.service.PrincipalKind
+	(GroupAccessRequest_State)(0),           // 1: auth.service.GroupAccessRequest.State
+	(AnalyzeGroupGraphRequest_Analysis)(0),  // 2: auth.service.AnalyzeGroupGraphRequest.Analysis
+	(*ListGroupsResponse)(nil),              // 3: auth.service.ListGroupsResponse
+	(*GetGroupRequest)(nil),                 // 4: auth.service.GetGroupRequest
+	(*CreateGroupRequest)(nil),              // 5: auth.service.CreateGroupRequest
+	(*UpdateGroupRequest)(nil),              // 6: auth.service.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),              // 7: auth.service.DeleteGroupRequest
+	(*AuthGroup)(nil),                       // 8: auth.service.AuthGroup
+	(*MembershipExpiration)(nil),            // 9: auth.service.MembershipExpiration
+	(*GetSubgraphRequest)(nil),              // 10: auth.service.GetSubgraphRequest
+	(*Subgraph)(nil),                        // 11: auth.service.Subgraph
+	(*Principal)(nil),                       // 12: auth.service.Principal
+	(*Node)(nil),                            // 13: auth.service.Node
+	(*RequestGroupAccessRequest)(nil),       // 14: auth.service.RequestGroupAccessRequest
+	(*GroupAccessRequest)(nil),              // 15: auth.service.GroupAccessRequest
+	(*ListGroupAccessRequestsRequest)(nil),  // 16: auth.service.ListGroupAccessRequestsRequest
+	(*ListGroupAccessRequestsResponse)(nil), // 17: auth.service.ListGroupAccessRequestsResponse
+	(*ReviewGroupAccessRequestRequest)(nil), // 18: auth.service.ReviewGroupAccessRequestRequest
+	(*AnalyzeGroupGraphRequest)(nil),        // 19: auth.service.AnalyzeGroupGraphRequest
+	(*AnalyzeGroupGraphResponse)(nil),       // 20: auth.service.AnalyzeGroupGraphResponse
+	(*GroupGraphFinding)(nil),               // 21: auth.service.GroupGraphFinding
+	(*GroupCycle)(nil),                      // 22: auth.service.GroupCycle
+	(*RedundantMembership)(nil),             // 23: auth.service.RedundantMembership
+	(*OrphanedGroup)(nil),                   // 24: auth.service.OrphanedGroup
+	(*AffectedPermission)(nil),              // 25: auth.service.AffectedPermission
+	(*fieldmaskpb.FieldMask)(nil),           // 26: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),           // 27: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 28: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                   // 29: google.protobuf.Empty
+}
+var file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_depIdxs = []int32{
+	8,  // 0: auth.service.ListGroupsResponse.groups:type_name -> auth.service.AuthGroup
+	8,  // 1: auth.service.CreateGroupRequest.group:type_name -> auth.service.AuthGroup
+	8,  // 2: auth.service.UpdateGroupRequest.group:type_name -> auth.service.AuthGroup
+	26, // 3: auth.service.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	27, // 4: auth.service.AuthGroup.created_ts:type_name -> google.protobuf.Timestamp
+	9,  // 5: auth.service.AuthGroup.membership_expirations:type_name -> auth.service.MembershipExpiration
+	27, // 6: auth.service.MembershipExpiration.expiry_ts:type_name -> google.protobuf.Timestamp
+	12, // 7: auth.service.GetSubgraphRequest.principal:type_name -> auth.service.Principal
+	13, // 8: auth.service.Subgraph.nodes:type_name -> auth.service.Node
+	0,  // 9: auth.service.Principal.kind:type_name -> auth.service.PrincipalKind
+	12, // 10: auth.service.Node.principal:type_name -> auth.service.Principal
+	28, // 11: auth.service.RequestGroupAccessRequest.duration:type_name -> google.protobuf.Duration
+	28, // 12: auth.service.GroupAccessRequest.duration:type_name -> google.protobuf.Duration
+	1,  // 13: auth.service.GroupAccessRequest.state:type_name -> auth.service.GroupAccessRequest.State
+	27, // 14: auth.service.GroupAccessRequest.created_ts:type_name -> google.protobuf.Timestamp
+	27, // 15: auth.service.GroupAccessRequest.reviewed_ts:type_name -> google.protobuf.Timestamp
+	27, // 16: auth.service.GroupAccessRequest.membership_expiry_ts:type_name -> google.protobuf.Timestamp
+	15, // 17: auth.service.ListGroupAccessRequestsResponse.requests:type_name -> auth.service.GroupAccessRequest
+	2,  // 18: auth.service.AnalyzeGroupGraphRequest.analysis:type_name -> auth.service.AnalyzeGroupGraphRequest.Analysis
+	12, // 19: auth.service.AnalyzeGroupGraphRequest.principal:type_name -> auth.service.Principal
+	21, // 20: auth.service.AnalyzeGroupGraphResponse.findings:type_name -> auth.service.GroupGraphFinding
+	22, // 21: auth.service.GroupGraphFinding.cycle:type_name -> auth.service.GroupCycle
+	23, // 22: auth.service.GroupGraphFinding.redundant_membership:type_name -> auth.service.RedundantMembership
+	24, // 23: auth.service.GroupGraphFinding.orphaned_group:type_name -> auth.service.OrphanedGroup
+	25, // 24: auth.service.GroupGraphFinding.affected_permission:type_name -> auth.service.AffectedPermission
+	12, // 25: auth.service.RedundantMembership.member:type_name -> auth.service.Principal
+	29, // 26: auth.service.Groups.ListGroups:input_type -> google.protobuf.Empty
+	4,  // 27: auth.service.Groups.GetGroup:input_type -> auth.service.GetGroupRequest
+	5,  // 28: auth.service.Groups.CreateGroup:input_type -> auth.service.CreateGroupRequest
+	6,  // 29: auth.service.Groups.UpdateGroup:input_type -> auth.service.UpdateGroupRequest
+	7,  // 30: auth.service.Groups.DeleteGroup:input_type -> auth.service.DeleteGroupRequest
+	10, // 31: auth.service.Groups.GetSubgraph:input_type -> auth.service.GetSubgraphRequest
+	4,  // 32: auth.service.Groups.GetExpandedGroup:input_type -> auth.service.GetGroupRequest
+	14, // 33: auth.service.Groups.RequestGroupAccess:input_type -> auth.service.RequestGroupAccessRequest
+	16, // 34: auth.service.Groups.ListGroupAccessRequests:input_type -> auth.service.ListGroupAccessRequestsRequest
+	18, // 35: auth.service.Groups.ReviewGroupAccessRequest:input_type -> auth.service.ReviewGroupAccessRequestRequest
+	19, // 36: auth.service.Groups.AnalyzeGroupGraph:input_type -> auth.service.AnalyzeGroupGraphRequest
+	3,  // 37: auth.service.Groups.ListGroups:output_type -> auth.service.ListGroupsResponse
+	8,  // 38: auth.service.Groups.GetGroup:output_type -> auth.service.AuthGroup
+	8,  // 39: auth.service.Groups.CreateGroup:output_type -> auth.service.AuthGroup
+	8,  // 40: auth.service.Groups.UpdateGroup:output_type -> auth.service.AuthGroup
+	29, // 41: auth.service.Groups.DeleteGroup:output_type -> google.protobuf.Empty
+	11, // 42: auth.service.Groups.GetSubgraph:output_type -> auth.service.Subgraph
+	8,  // 43: auth.service.Groups.GetExpandedGroup:output_type -> auth.service.AuthGroup
+	15, // 44: auth.service.Groups.RequestGroupAccess:output_type -> auth.service.GroupAccessRequest
+	17, // 45: auth.service.Groups.ListGroupAccessRequests:output_type -> auth.service.ListGroupAccessRequestsResponse
+	15, // 46: auth.service.Groups.ReviewGroupAccessRequest:output_type -> auth.service.GroupAccessRequest
+	20, // 47: auth.service.Groups.AnalyzeGroupGraph:output_type -> auth.service.AnalyzeGroupGraphResponse
+	37, // [37:48] is the sub-list for method output_type
+	26, // [26:37] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() }
+func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
+	if File_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -975,7 +2276,7 @@ func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubgraphRequest); i {
+			switch v := v.(*MembershipExpiration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -987,7 +2288,7 @@ func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subgraph); i {
+			switch v := v.(*GetSubgraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -999,7 +2300,7 @@ func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Principal); i {
+			switch v := v.(*Subgraph); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1011,6 +2312,18 @@ func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Principal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
@@ -1022,14 +2335,164 @@ func file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGroupAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAccessRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAccessRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewGroupAccessRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeGroupGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeGroupGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupGraphFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupCycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedundantMembership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrphanedGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AffectedPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*GroupGraphFinding_Cycle)(nil),
+		(*GroupGraphFinding_RedundantMembership)(nil),
+		(*GroupGraphFinding_OrphanedGroup)(nil),
+		(*GroupGraphFinding_AffectedPermission)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_auth_service_api_rpcpb_groups_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,13 +34,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Groups_ListGroups_FullMethodName       = "/auth.service.Groups/ListGroups"
-	Groups_GetGroup_FullMethodName         = "/auth.service.Groups/GetGroup"
-	Groups_CreateGroup_FullMethodName      = "/auth.service.Groups/CreateGroup"
-	Groups_UpdateGroup_FullMethodName      = "/auth.service.Groups/UpdateGroup"
-	Groups_DeleteGroup_FullMethodName      = "/auth.service.Groups/DeleteGroup"
-	Groups_GetSubgraph_FullMethodName      = "/auth.service.Groups/GetSubgraph"
-	Groups_GetExpandedGroup_FullMethodName = "/auth.service.Groups/GetExpandedGroup"
+	Groups_ListGroups_FullMethodName               = "/auth.service.Groups/ListGroups"
+	Groups_GetGroup_FullMethodName                 = "/auth.service.Groups/GetGroup"
+	Groups_CreateGroup_FullMethodName              = "/auth.service.Groups/CreateGroup"
+	Groups_UpdateGroup_FullMethodName              = "/auth.service.Groups/UpdateGroup"
+	Groups_DeleteGroup_FullMethodName              = "/auth.service.Groups/DeleteGroup"
+	Groups_GetSubgraph_FullMethodName              = "/auth.service.Groups/GetSubgraph"
+	Groups_GetExpandedGroup_FullMethodName         = "/auth.service.Groups/GetExpandedGroup"
+	Groups_RequestGroupAccess_FullMethodName       = "/auth.service.Groups/RequestGroupAccess"
+	Groups_ListGroupAccessRequests_FullMethodName  = "/auth.service.Groups/ListGroupAccessRequests"
+	Groups_ReviewGroupAccessRequest_FullMethodName = "/auth.service.Groups/ReviewGroupAccessRequest"
+	Groups_AnalyzeGroupGraph_FullMethodName        = "/auth.service.Groups/AnalyzeGroupGraph"
 )
 
 // GroupsClient is the client API for Groups service.
@@ -69,6 +73,21 @@ type GroupsClient interface {
 	// - `globs` will include direct and indirect globs; and
 	// - `nested` will include direct and indirect subgroups.
 	GetExpandedGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*AuthGroup, error)
+	// RequestGroupAccess asks owners of a group to add the caller to it for
+	// a limited time.
+	RequestGroupAccess(ctx context.Context, in *RequestGroupAccessRequest, opts ...grpc.CallOption) (*GroupAccessRequest, error)
+	// ListGroupAccessRequests returns pending access requests for a group.
+	ListGroupAccessRequests(ctx context.Context, in *ListGroupAccessRequestsRequest, opts ...grpc.CallOption) (*ListGroupAccessRequestsResponse, error)
+	// ReviewGroupAccessRequest approves or denies a pending access request.
+	//
+	// Only owners of the group can review requests. Approving a request adds
+	// the requestor to the group until the requested duration elapses. The
+	// change is recorded in the changelog like any other group update.
+	ReviewGroupAccessRequest(ctx context.Context, in *ReviewGroupAccessRequestRequest, opts ...grpc.CallOption) (*GroupAccessRequest, error)
+	// AnalyzeGroupGraph runs an analysis over the whole groups graph, e.g. finds
+	// redundant nested memberships or the realm permissions which depend on
+	// some group. Findings are returned in pages.
+	AnalyzeGroupGraph(ctx context.Context, in *AnalyzeGroupGraphRequest, opts ...grpc.CallOption) (*AnalyzeGroupGraphResponse, error)
 }
 
 type groupsClient struct {
@@ -142,6 +161,42 @@ func (c *groupsClient) GetExpandedGroup(ctx context.Context, in *GetGroupRequest
 	return out, nil
 }
 
+func (c *groupsClient) RequestGroupAccess(ctx context.Context, in *RequestGroupAccessRequest, opts ...grpc.CallOption) (*GroupAccessRequest, error) {
+	out := new(GroupAccessRequest)
+	err := c.cc.Invoke(ctx, Groups_RequestGroupAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ListGroupAccessRequests(ctx context.Context, in *ListGroupAccessRequestsRequest, opts ...grpc.CallOption) (*ListGroupAccessRequestsResponse, error) {
+	out := new(ListGroupAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, Groups_ListGroupAccessRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ReviewGroupAccessRequest(ctx context.Context, in *ReviewGroupAccessRequestRequest, opts ...grpc.CallOption) (*GroupAccessRequest, error) {
+	out := new(GroupAccessRequest)
+	err := c.cc.Invoke(ctx, Groups_ReviewGroupAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) AnalyzeGroupGraph(ctx context.Context, in *AnalyzeGroupGraphRequest, opts ...grpc.CallOption) (*AnalyzeGroupGraphResponse, error) {
+	out := new(AnalyzeGroupGraphResponse)
+	err := c.cc.Invoke(ctx, Groups_AnalyzeGroupGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupsServer is the server API for Groups service.
 // All implementations must embed UnimplementedGroupsServer
 // for forward compatibility
@@ -168,6 +223,21 @@ type GroupsServer interface {
 	// - `globs` will include direct and indirect globs; and
 	// - `nested` will include direct and indirect subgroups.
 	GetExpandedGroup(context.Context, *GetGroupRequest) (*AuthGroup, error)
+	// RequestGroupAccess asks owners of a group to add the caller to it for
+	// a limited time.
+	RequestGroupAccess(context.Context, *RequestGroupAccessRequest) (*GroupAccessRequest, error)
+	// ListGroupAccessRequests returns pending access requests for a group.
+	ListGroupAccessRequests(context.Context, *ListGroupAccessRequestsRequest) (*ListGroupAccessRequestsResponse, error)
+	// ReviewGroupAccessRequest approves or denies a pending access request.
+	//
+	// Only owners of the group can review requests. Approving a request adds
+	// the requestor to the group until the requested duration elapses. The
+	// change is recorded in the changelog like any other group update.
+	ReviewGroupAccessRequest(context.Context, *ReviewGroupAccessRequestRequest) (*GroupAccessRequest, error)
+	// AnalyzeGroupGraph runs an analysis over the whole groups graph, e.g. finds
+	// redundant nested memberships or the realm permissions which depend on
+	// some group. Findings are returned in pages.
+	AnalyzeGroupGraph(context.Context, *AnalyzeGroupGraphRequest) (*AnalyzeGroupGraphResponse, error)
 	mustEmbedUnimplementedGroupsServer()
 }
 
@@ -196,6 +266,18 @@ func (UnimplementedGroupsServer) GetSubgraph(context.Context, *GetSubgraphReques
 func (UnimplementedGroupsServer) GetExpandedGroup(context.Context, *GetGroupRequest) (*AuthGroup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExpandedGroup not implemented")
 }
+func (UnimplementedGroupsServer) RequestGroupAccess(context.Context, *RequestGroupAccessRequest) (*GroupAccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestGroupAccess not implemented")
+}
+func (UnimplementedGroupsServer) ListGroupAccessRequests(context.Context, *ListGroupAccessRequestsRequest) (*ListGroupAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupAccessRequests not implemented")
+}
+func (UnimplementedGroupsServer) ReviewGroupAccessRequest(context.Context, *ReviewGroupAccessRequestRequest) (*GroupAccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewGroupAccessRequest not implemented")
+}
+func (UnimplementedGroupsServer) AnalyzeGroupGraph(context.Context, *AnalyzeGroupGraphRequest) (*AnalyzeGroupGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeGroupGraph not implemented")
+}
 func (UnimplementedGroupsServer) mustEmbedUnimplementedGroupsServer() {}
 
 // UnsafeGroupsServer may be embedded to opt out of forward compatibility for this service.
@@ -335,6 +417,78 @@ func _Groups_GetExpandedGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Groups_RequestGroupAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGroupAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).RequestGroupAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Groups_RequestGroupAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).RequestGroupAccess(ctx, req.(*RequestGroupAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ListGroupAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ListGroupAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Groups_ListGroupAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ListGroupAccessRequests(ctx, req.(*ListGroupAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ReviewGroupAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewGroupAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ReviewGroupAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Groups_ReviewGroupAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ReviewGroupAccessRequest(ctx, req.(*ReviewGroupAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_AnalyzeGroupGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeGroupGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).AnalyzeGroupGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Groups_AnalyzeGroupGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).AnalyzeGroupGraph(ctx, req.(*AnalyzeGroupGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Groups_ServiceDesc is the grpc.ServiceDesc for Groups service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -370,6 +524,22 @@ var Groups_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExpandedGroup",
 			Handler:    _Groups_GetExpandedGroup_Handler,
 		},
+		{
+			MethodName: "RequestGroupAccess",
+			Handler:    _Groups_RequestGroupAccess_Handler,
+		},
+		{
+			MethodName: "ListGroupAccessRequests",
+			Handler:    _Groups_ListGroupAccessRequests_Handler,
+		},
+		{
+			MethodName: "ReviewGroupAccessRequest",
+			Handler:    _Groups_ReviewGroupAccessRequest_Handler,
+		},
+		{
+			MethodName: "AnalyzeGroupGraph",
+			Handler:    _Groups_AnalyzeGroupGraph_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/auth_service/api/rpcpb/groups.proto",
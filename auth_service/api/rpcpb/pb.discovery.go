@@ -12,3835 +12,4250 @@ func init() {
 			"auth.service.Accounts", "auth.service.Allowlists", "auth.service.AuthDB", "auth.service.ChangeLogs", "auth.service.Groups", "auth.service.Replicas",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 112, 36, 201,
-			117, 24, 136, 170, 172, 110, 52, 18, 24, 76, 35, 49, 31, 152,
-			158, 175, 156, 158, 47, 96, 6, 104, 96, 48, 179, 243, 185, 75,
-			18, 3, 244, 204, 244, 16, 3, 64, 13, 96, 191, 200, 37, 182,
-			208, 157, 13, 212, 110, 119, 85, 179, 170, 122, 176, 88, 122, 101,
-			218, 20, 143, 148, 37, 209, 178, 66, 113, 146, 37, 91, 62, 201,
-			167, 211, 71, 88, 210, 157, 46, 120, 62, 135, 66, 14, 203, 58,
-			81, 114, 144, 71, 83, 214, 157, 45, 81, 17, 182, 78, 166, 248,
-			101, 57, 66, 212, 209, 119, 146, 47, 36, 94, 188, 151, 31, 85,
-			221, 192, 236, 7, 101, 43, 244, 99, 39, 118, 3, 253, 242, 227,
-			229, 203, 151, 47, 95, 190, 151, 249, 42, 147, 126, 107, 132, 30,
-			223, 10, 130, 173, 166, 152, 110, 135, 65, 28, 108, 118, 26, 211,
-			162, 213, 142, 119, 75, 8, 178, 131, 50, 179, 164, 51, 139, 253,
-			52, 83, 134, 252, 187, 111, 208, 209, 90, 208, 42, 245, 228, 223,
-			165, 152, 187, 2, 224, 138, 245, 162, 206, 222, 10, 154, 174, 191,
-			85, 10, 194, 173, 164, 153, 120, 183, 45, 162, 233, 87, 253, 96,
-			199, 151, 77, 182, 55, 255, 196, 178, 126, 194, 38, 247, 87, 238,
-			254, 172, 125, 234, 190, 172, 185, 162, 138, 151, 158, 19, 205, 230,
-			251, 161, 240, 26, 212, 123, 248, 79, 243, 52, 203, 156, 83, 125,
-			179, 121, 250, 185, 33, 106, 13, 49, 114, 170, 143, 205, 254, 202,
-			16, 199, 10, 181, 160, 201, 239, 118, 26, 13, 17, 70, 124, 138,
-			75, 84, 23, 35, 94, 119, 99, 151, 123, 126, 44, 194, 218, 182,
-			235, 111, 9, 222, 8, 194, 150, 27, 83, 62, 31, 180, 119, 67,
-			111, 107, 59, 230, 179, 51, 51, 55, 85, 5, 94, 241, 107, 37,
-			206, 231, 154, 77, 142, 121, 17, 15, 69, 36, 194, 199, 162, 94,
-			162, 124, 59, 142, 219, 209, 237, 233, 233, 186, 120, 44, 154, 65,
-			91, 132, 145, 238, 107, 45, 104, 201, 78, 214, 130, 230, 212, 166,
-			36, 98, 154, 82, 94, 21, 117, 47, 138, 67, 111, 179, 19, 123,
-			129, 207, 93, 191, 206, 59, 145, 224, 158, 207, 163, 160, 19, 214,
-			4, 166, 108, 122, 190, 27, 238, 34, 93, 209, 36, 223, 241, 226,
-			109, 30, 132, 248, 55, 232, 196, 148, 183, 130, 186, 215, 240, 106,
-			46, 96, 152, 228, 110, 40, 120, 91, 132, 45, 47, 142, 69, 157,
-			183, 195, 224, 177, 87, 23, 117, 30, 111, 187, 49, 143, 183, 161,
-			119, 205, 102, 176, 227, 249, 91, 188, 22, 248, 117, 15, 42, 69,
-			80, 137, 242, 150, 136, 111, 83, 202, 225, 223, 165, 30, 194, 34,
-			30, 52, 52, 69, 181, 160, 46, 120, 171, 19, 197, 60, 20, 177,
-			235, 249, 136, 213, 221, 12, 30, 67, 150, 226, 24, 229, 126, 16,
-			123, 53, 49, 201, 227, 109, 47, 226, 77, 47, 138, 1, 67, 186,
-			69, 191, 222, 67, 78, 221, 139, 106, 77, 215, 107, 137, 176, 244,
-			36, 34, 60, 63, 205, 11, 77, 68, 59, 12, 234, 157, 154, 72,
-			232, 160, 9, 33, 127, 33, 58, 40, 87, 189, 171, 7, 181, 78,
-			75, 248, 177, 171, 7, 105, 58, 8, 121, 16, 111, 139, 144, 183,
-			220, 88, 132, 158, 219, 140, 18, 86, 227, 0, 197, 219, 130, 242,
-			52, 245, 166, 83, 75, 194, 195, 154, 128, 216, 119, 91, 2, 8,
-			74, 203, 150, 31, 36, 121, 200, 119, 47, 142, 160, 71, 190, 68,
-			21, 132, 17, 111, 185, 187, 124, 83, 128, 164, 212, 121, 28, 112,
-			225, 215, 131, 48, 18, 32, 20, 237, 48, 104, 5, 177, 224, 146,
-			39, 113, 196, 235, 34, 244, 30, 139, 58, 111, 132, 65, 139, 74,
-			46, 68, 65, 35, 222, 1, 49, 81, 18, 196, 163, 182, 168, 129,
-			4, 241, 118, 232, 129, 96, 133, 32, 59, 190, 148, 162, 40, 66,
-			218, 41, 95, 123, 80, 89, 229, 171, 203, 247, 214, 158, 155, 171,
-			150, 121, 101, 149, 175, 84, 151, 159, 173, 44, 148, 23, 248, 221,
-			23, 248, 218, 131, 50, 159, 95, 94, 121, 161, 90, 185, 255, 96,
-			141, 63, 88, 94, 92, 40, 87, 87, 249, 220, 210, 2, 159, 95,
-			94, 90, 171, 86, 238, 174, 175, 45, 87, 87, 41, 47, 206, 173,
-			242, 202, 106, 17, 115, 230, 150, 94, 224, 229, 231, 87, 170, 229,
-			213, 85, 190, 92, 229, 149, 71, 43, 139, 149, 242, 2, 127, 110,
-			174, 90, 157, 91, 90, 171, 148, 87, 39, 121, 101, 105, 126, 113,
-			125, 161, 178, 116, 127, 146, 223, 93, 95, 227, 75, 203, 107, 148,
-			47, 86, 30, 85, 214, 202, 11, 124, 109, 121, 18, 155, 221, 91,
-			143, 47, 223, 227, 143, 202, 213, 249, 7, 115, 75, 107, 115, 119,
-			43, 139, 149, 181, 23, 176, 193, 123, 149, 181, 37, 104, 236, 222,
-			114, 149, 242, 57, 190, 50, 87, 93, 171, 204, 175, 47, 206, 85,
-			249, 202, 122, 117, 101, 121, 181, 204, 161, 103, 11, 149, 213, 249,
-			197, 185, 202, 163, 242, 66, 137, 87, 150, 248, 210, 50, 47, 63,
-			91, 94, 90, 227, 171, 15, 230, 22, 23, 187, 59, 74, 249, 242,
-			115, 75, 229, 42, 80, 159, 238, 38, 191, 91, 230, 139, 149, 185,
-			187, 139, 101, 104, 10, 251, 185, 80, 169, 150, 231, 215, 160, 67,
-			201, 175, 249, 202, 66, 121, 105, 109, 110, 113, 146, 242, 213, 149,
-			242, 124, 101, 110, 113, 146, 151, 159, 47, 63, 90, 89, 156, 171,
-			190, 48, 169, 144, 174, 150, 191, 99, 189, 188, 180, 86, 153, 91,
-			228, 11, 115, 143, 230, 238, 151, 87, 249, 248, 91, 113, 101, 165,
-			186, 60, 191, 94, 45, 63, 2, 170, 151, 239, 241, 213, 245, 187,
-			171, 107, 149, 181, 245, 181, 50, 191, 191, 188, 188, 128, 204, 94,
-			45, 87, 159, 173, 204, 151, 87, 239, 240, 197, 229, 85, 100, 216,
-			250, 106, 121, 146, 242, 133, 185, 181, 57, 108, 122, 165, 186, 124,
-			175, 178, 182, 122, 7, 126, 223, 93, 95, 173, 32, 227, 42, 75,
-			107, 229, 106, 117, 125, 101, 173, 178, 188, 52, 193, 31, 44, 63,
-			87, 126, 182, 92, 229, 243, 115, 235, 171, 229, 5, 228, 240, 242,
-			18, 244, 22, 100, 165, 188, 92, 125, 1, 208, 2, 31, 112, 4,
-			38, 249, 115, 15, 202, 107, 15, 202, 85, 96, 42, 114, 107, 14,
-			216, 176, 186, 86, 173, 204, 175, 165, 139, 45, 87, 249, 218, 114,
-			117, 141, 166, 250, 201, 151, 202, 247, 23, 43, 247, 203, 75, 243,
-			101, 200, 94, 6, 52, 207, 85, 86, 203, 19, 124, 174, 90, 89,
-			133, 2, 21, 108, 152, 63, 55, 247, 2, 95, 94, 199, 94, 195,
-			64, 173, 175, 150, 169, 252, 157, 18, 221, 73, 28, 79, 94, 185,
-			199, 231, 22, 158, 173, 0, 229, 170, 244, 202, 242, 234, 106, 69,
-			137, 11, 178, 109, 254, 129, 226, 121, 137, 210, 28, 181, 108, 70,
-			120, 223, 24, 252, 202, 49, 82, 236, 43, 211, 1, 106, 231, 6,
-			229, 79, 153, 120, 182, 111, 18, 19, 45, 249, 83, 38, 158, 235,
-			187, 140, 137, 234, 167, 76, 60, 223, 87, 196, 68, 42, 127, 202,
-			196, 11, 125, 103, 48, 241, 156, 252, 41, 19, 47, 246, 221, 193,
-			196, 243, 242, 167, 76, 28, 239, 59, 141, 137, 167, 229, 207, 95,
-			178, 169, 237, 244, 49, 50, 219, 151, 47, 252, 130, 205, 231, 248,
-			150, 240, 69, 232, 213, 56, 174, 160, 188, 37, 162, 200, 221, 18,
-			114, 9, 216, 13, 58, 188, 230, 250, 60, 20, 83, 176, 208, 196,
-			1, 119, 31, 7, 94, 157, 215, 69, 195, 243, 81, 253, 117, 218,
-			77, 88, 76, 68, 157, 118, 215, 71, 245, 187, 27, 116, 66, 62,
-			183, 82, 137, 74, 124, 142, 199, 187, 109, 175, 230, 54, 185, 120,
-			205, 109, 181, 155, 130, 123, 17, 224, 195, 245, 43, 230, 110, 132,
-			90, 44, 20, 31, 238, 136, 40, 166, 92, 105, 181, 80, 68, 237,
-			192, 135, 150, 119, 219, 168, 250, 92, 31, 240, 193, 226, 179, 29,
-			212, 75, 252, 94, 16, 114, 207, 143, 98, 215, 175, 9, 189, 26,
-			193, 250, 234, 213, 4, 191, 23, 4, 252, 35, 50, 137, 243, 176,
-			93, 227, 119, 221, 112, 188, 199, 214, 40, 161, 169, 49, 1, 107,
-			83, 39, 244, 35, 254, 132, 252, 59, 18, 205, 27, 20, 254, 17,
-			167, 207, 98, 100, 54, 119, 96, 51, 139, 197, 174, 210, 255, 107,
-			144, 222, 222, 10, 74, 181, 237, 48, 104, 121, 157, 22, 218, 40,
-			205, 78, 205, 155, 118, 59, 241, 246, 134, 162, 103, 218, 109, 123,
-			211, 97, 187, 214, 222, 156, 118, 107, 181, 160, 227, 199, 145, 50,
-			144, 134, 160, 88, 73, 21, 43, 188, 153, 45, 85, 188, 78, 115,
-			171, 162, 217, 168, 248, 141, 128, 21, 104, 206, 171, 11, 63, 246,
-			226, 221, 49, 139, 91, 227, 3, 85, 3, 179, 97, 106, 123, 237,
-			49, 27, 83, 109, 175, 61, 91, 166, 185, 57, 213, 40, 187, 69,
-			251, 239, 139, 24, 208, 176, 35, 189, 182, 151, 236, 111, 225, 72,
-			41, 77, 82, 73, 55, 121, 119, 234, 197, 203, 239, 160, 159, 15,
-			127, 101, 0, 12, 172, 225, 190, 11, 22, 253, 223, 28, 52, 176,
-			134, 251, 216, 236, 63, 113, 186, 108, 165, 217, 43, 124, 109, 91,
-			240, 197, 245, 249, 10, 159, 235, 196, 219, 65, 24, 193, 2, 178,
-			232, 213, 132, 15, 43, 86, 199, 175, 171, 229, 111, 174, 237, 214,
-			160, 164, 204, 153, 228, 207, 138, 16, 150, 27, 62, 91, 154, 225,
-			227, 80, 160, 168, 178, 138, 48, 96, 32, 186, 176, 242, 249, 65,
-			140, 66, 134, 139, 89, 195, 107, 10, 46, 94, 171, 137, 118, 12,
-			242, 89, 11, 90, 237, 166, 7, 194, 99, 150, 97, 141, 190, 68,
-			249, 11, 10, 67, 176, 137, 134, 139, 139, 118, 2, 8, 97, 170,
-			24, 119, 99, 37, 118, 104, 206, 221, 158, 158, 222, 217, 217, 41,
-			185, 72, 169, 228, 143, 44, 23, 77, 47, 86, 230, 203, 75, 171,
-			229, 169, 217, 210, 12, 165, 124, 221, 111, 138, 40, 66, 121, 247,
-			66, 81, 231, 155, 187, 220, 109, 227, 84, 218, 108, 10, 222, 116,
-			119, 96, 6, 184, 91, 161, 144, 107, 182, 231, 227, 58, 235, 249,
-			91, 147, 102, 65, 78, 25, 12, 93, 108, 210, 148, 121, 81, 87,
-			1, 52, 69, 204, 146, 122, 119, 110, 181, 178, 58, 73, 249, 115,
-			149, 181, 7, 160, 3, 211, 235, 33, 46, 37, 11, 21, 208, 219,
-			168, 236, 65, 87, 190, 191, 178, 180, 48, 201, 149, 45, 34, 94,
-			107, 135, 64, 61, 76, 62, 96, 32, 90, 179, 171, 66, 116, 53,
-			223, 80, 83, 216, 152, 11, 96, 190, 119, 64, 179, 108, 5, 143,
-			69, 136, 218, 35, 177, 25, 208, 180, 162, 188, 233, 181, 60, 105,
-			55, 69, 123, 123, 100, 20, 107, 190, 239, 176, 82, 109, 172, 239,
-			174, 86, 172, 234, 39, 233, 99, 228, 80, 223, 121, 186, 68, 237,
-			108, 31, 115, 198, 250, 78, 91, 133, 187, 92, 11, 191, 81, 14,
-			96, 32, 185, 158, 31, 41, 77, 130, 118, 209, 107, 110, 203, 243,
-			193, 154, 174, 123, 143, 189, 122, 199, 109, 114, 51, 81, 113, 222,
-			103, 97, 222, 143, 229, 242, 244, 183, 44, 234, 100, 251, 236, 62,
-			70, 78, 217, 55, 11, 191, 102, 113, 53, 159, 140, 26, 241, 124,
-			233, 21, 160, 253, 183, 9, 70, 19, 116, 163, 230, 54, 155, 34,
-			228, 155, 110, 36, 135, 163, 237, 70, 17, 232, 77, 152, 61, 48,
-			111, 165, 77, 206, 227, 224, 85, 225, 227, 28, 152, 107, 70, 129,
-			193, 153, 96, 184, 24, 241, 202, 10, 119, 235, 117, 28, 132, 113,
-			23, 122, 37, 124, 16, 33, 228, 183, 236, 225, 68, 137, 175, 71,
-			162, 209, 105, 242, 157, 109, 225, 83, 94, 23, 155, 157, 173, 45,
-			96, 122, 79, 115, 94, 20, 117, 4, 52, 55, 68, 51, 208, 41,
-			139, 145, 83, 217, 3, 26, 178, 25, 57, 53, 124, 86, 67, 132,
-			145, 83, 165, 235, 244, 6, 46, 32, 206, 217, 190, 11, 86, 225,
-			50, 215, 218, 1, 4, 238, 205, 58, 94, 74, 148, 231, 217, 92,
-			158, 150, 168, 227, 32, 15, 207, 217, 71, 138, 103, 184, 40, 109,
-			149, 120, 177, 19, 137, 240, 118, 20, 180, 68, 224, 139, 247, 169,
-			133, 2, 188, 161, 34, 146, 7, 229, 51, 80, 33, 167, 33, 139,
-			145, 115, 3, 35, 26, 34, 140, 156, 59, 116, 152, 158, 65, 204,
-			22, 35, 231, 237, 124, 241, 144, 194, 124, 229, 214, 108, 233, 202,
-			245, 155, 165, 153, 210, 21, 131, 204, 202, 64, 25, 141, 204, 130,
-			26, 3, 131, 26, 34, 140, 156, 31, 62, 104, 116, 252, 175, 94,
-			161, 167, 123, 53, 115, 236, 181, 68, 20, 187, 173, 246, 147, 60,
-			221, 59, 116, 96, 77, 151, 97, 99, 180, 63, 18, 224, 75, 68,
-			168, 174, 73, 85, 131, 236, 16, 205, 248, 174, 31, 68, 168, 176,
-			51, 85, 9, 220, 253, 184, 181, 191, 123, 60, 108, 80, 106, 23,
-			121, 246, 109, 186, 200, 134, 222, 119, 228, 38, 255, 202, 52, 237,
-			103, 153, 83, 125, 127, 219, 178, 222, 245, 147, 223, 245, 147, 223,
-			245, 147, 223, 245, 147, 223, 245, 147, 223, 245, 147, 255, 235, 248,
-			201, 198, 83, 133, 159, 218, 79, 174, 104, 27, 15, 126, 106, 63,
-			217, 56, 207, 231, 140, 243, 124, 190, 111, 90, 59, 207, 240, 83,
-			251, 201, 198, 121, 190, 96, 156, 231, 139, 137, 243, 124, 209, 56,
-			207, 227, 137, 243, 12, 63, 191, 120, 18, 205, 156, 236, 119, 89,
-			176, 244, 21, 62, 123, 146, 207, 113, 179, 244, 162, 130, 20, 145,
-			0, 155, 210, 229, 237, 192, 243, 209, 161, 128, 21, 22, 12, 72,
-			209, 22, 62, 248, 98, 210, 101, 221, 149, 233, 175, 7, 62, 106,
-			147, 102, 80, 115, 155, 20, 172, 34, 225, 215, 221, 112, 146, 11,
-			31, 180, 127, 29, 156, 96, 112, 50, 58, 178, 158, 178, 14, 80,
-			151, 54, 66, 183, 150, 172, 24, 58, 3, 22, 4, 48, 21, 16,
-			134, 21, 51, 104, 74, 165, 136, 30, 149, 68, 228, 193, 82, 218,
-			116, 99, 239, 177, 116, 223, 125, 46, 218, 65, 109, 155, 187, 49,
-			95, 95, 155, 231, 45, 175, 238, 163, 70, 15, 124, 202, 31, 186,
-			126, 7, 150, 129, 43, 147, 252, 202, 173, 27, 51, 147, 90, 81,
-			183, 195, 160, 41, 218, 177, 87, 227, 247, 67, 177, 21, 132, 158,
-			235, 27, 234, 249, 206, 182, 87, 219, 230, 226, 181, 88, 0, 77,
-			168, 160, 247, 41, 181, 233, 214, 94, 221, 113, 195, 58, 250, 252,
-			187, 194, 13, 121, 224, 11, 105, 227, 54, 121, 203, 243, 59, 177,
-			192, 245, 146, 95, 159, 49, 253, 107, 6, 254, 86, 137, 47, 10,
-			183, 157, 116, 57, 20, 188, 24, 181, 132, 27, 138, 122, 145, 71,
-			129, 92, 128, 253, 128, 55, 133, 219, 166, 170, 24, 143, 209, 137,
-			242, 34, 238, 11, 1, 124, 109, 224, 46, 65, 44, 194, 54, 172,
-			173, 114, 65, 239, 68, 104, 11, 243, 15, 204, 94, 155, 218, 14,
-			58, 33, 111, 122, 190, 112, 67, 202, 17, 251, 75, 227, 111, 110,
-			116, 192, 120, 78, 99, 201, 9, 212, 226, 219, 130, 135, 104, 229,
-			128, 143, 25, 6, 45, 62, 51, 51, 115, 101, 10, 255, 91, 155,
-			153, 185, 141, 255, 189, 8, 93, 191, 117, 235, 214, 173, 169, 43,
-			179, 83, 87, 175, 172, 205, 94, 189, 253, 212, 173, 219, 79, 221,
-			42, 221, 210, 255, 94, 44, 241, 187, 187, 20, 6, 50, 14, 189,
-			26, 120, 123, 80, 5, 187, 136, 216, 39, 249, 142, 224, 194, 143,
-			58, 161, 218, 158, 217, 17, 184, 59, 83, 11, 252, 199, 34, 140,
-			229, 248, 202, 69, 137, 127, 160, 122, 111, 158, 242, 171, 87, 175,
-			222, 74, 250, 2, 174, 169, 39, 226, 6, 90, 136, 97, 163, 6,
-			255, 67, 137, 82, 252, 90, 60, 1, 22, 155, 224, 208, 178, 191,
-			133, 222, 199, 89, 94, 150, 6, 120, 68, 169, 254, 201, 175, 220,
-			230, 243, 65, 171, 221, 137, 69, 106, 46, 96, 131, 43, 203, 171,
-			149, 231, 249, 203, 192, 153, 241, 137, 151, 75, 202, 228, 73, 10,
-			25, 227, 83, 109, 166, 36, 198, 115, 36, 226, 13, 53, 192, 227,
-			88, 125, 105, 125, 113, 113, 98, 98, 223, 114, 40, 239, 227, 51,
-			19, 119, 82, 52, 205, 190, 21, 77, 91, 34, 6, 44, 65, 163,
-			238, 238, 166, 104, 139, 226, 176, 83, 139, 177, 129, 199, 110, 147,
-			199, 143, 85, 139, 93, 197, 47, 196, 143, 39, 57, 18, 116, 231,
-			219, 237, 210, 227, 82, 252, 24, 160, 55, 235, 145, 44, 212, 137,
-			68, 141, 95, 226, 87, 102, 102, 186, 123, 120, 245, 137, 61, 124,
-			206, 243, 175, 206, 242, 151, 193, 1, 221, 141, 98, 209, 130, 236,
-			185, 232, 158, 215, 20, 107, 221, 3, 113, 175, 178, 88, 94, 171,
-			60, 42, 243, 70, 172, 200, 120, 82, 157, 11, 141, 88, 83, 186,
-			94, 89, 90, 187, 126, 141, 199, 94, 237, 213, 136, 63, 195, 199,
-			199, 199, 101, 202, 68, 35, 46, 213, 119, 30, 120, 91, 219, 11,
-			110, 140, 181, 38, 248, 211, 79, 243, 171, 179, 19, 252, 175, 113,
-			204, 91, 12, 118, 116, 150, 230, 219, 244, 52, 159, 3, 122, 235,
-			193, 78, 132, 40, 97, 178, 92, 153, 153, 73, 233, 176, 168, 100,
-			10, 72, 45, 117, 229, 250, 222, 105, 100, 176, 65, 245, 43, 215,
-			175, 93, 187, 118, 227, 234, 245, 153, 68, 109, 108, 138, 70, 16,
-			10, 190, 238, 123, 175, 105, 44, 183, 110, 204, 244, 98, 41, 125,
-			123, 131, 57, 46, 251, 207, 199, 199, 37, 83, 166, 113, 176, 224,
-			223, 4, 159, 74, 147, 243, 22, 18, 12, 120, 128, 93, 26, 207,
-			249, 20, 30, 20, 128, 137, 46, 1, 184, 246, 68, 1, 120, 232,
-			62, 118, 249, 203, 114, 32, 75, 181, 78, 24, 10, 63, 134, 34,
-			143, 188, 102, 211, 139, 82, 2, 0, 218, 148, 183, 48, 149, 63,
-			195, 159, 92, 225, 77, 196, 156, 63, 147, 164, 150, 124, 177, 115,
-			183, 227, 53, 235, 34, 28, 159, 128, 142, 173, 42, 14, 169, 38,
-			36, 99, 38, 244, 254, 43, 231, 80, 102, 73, 246, 221, 243, 99,
-			232, 185, 42, 41, 187, 174, 186, 141, 28, 152, 40, 109, 2, 230,
-			241, 46, 22, 60, 245, 22, 44, 168, 224, 54, 112, 92, 242, 131,
-			157, 84, 175, 85, 42, 247, 131, 29, 254, 12, 239, 42, 243, 166,
-			29, 77, 232, 126, 235, 30, 251, 193, 78, 105, 75, 196, 101, 144,
-			53, 153, 54, 62, 145, 234, 120, 119, 231, 85, 97, 0, 198, 247,
-			239, 232, 245, 39, 118, 84, 141, 150, 182, 50, 248, 202, 110, 188,
-			45, 221, 136, 46, 49, 75, 15, 211, 248, 68, 175, 12, 222, 23,
-			241, 124, 50, 234, 227, 19, 168, 233, 31, 174, 46, 47, 241, 71,
-			110, 187, 237, 249, 91, 148, 242, 138, 47, 83, 164, 207, 62, 137,
-			70, 64, 138, 77, 187, 109, 92, 232, 186, 204, 22, 185, 112, 40,
-			139, 129, 226, 242, 243, 142, 86, 31, 217, 20, 88, 46, 46, 24,
-			45, 147, 18, 141, 76, 133, 198, 138, 31, 1, 171, 225, 141, 169,
-			143, 180, 2, 63, 222, 126, 99, 234, 35, 117, 119, 247, 141, 181,
-			143, 192, 210, 253, 198, 237, 143, 180, 60, 255, 141, 219, 31, 137,
-			68, 237, 141, 15, 148, 62, 2, 198, 18, 76, 216, 55, 94, 122,
-			177, 72, 249, 206, 182, 8, 5, 151, 181, 1, 145, 219, 220, 113,
-			119, 35, 189, 135, 41, 234, 202, 18, 104, 128, 13, 80, 247, 182,
-			188, 56, 2, 147, 166, 41, 184, 106, 105, 146, 99, 83, 147, 148,
-			203, 198, 38, 57, 182, 54, 137, 75, 45, 54, 137, 86, 201, 235,
-			34, 12, 166, 218, 110, 189, 46, 157, 199, 120, 39, 208, 216, 132,
-			91, 219, 150, 22, 153, 182, 226, 192, 250, 83, 10, 101, 82, 217,
-			79, 176, 140, 111, 5, 188, 211, 70, 35, 65, 87, 29, 247, 74,
-			162, 164, 18, 175, 236, 111, 235, 77, 76, 82, 108, 63, 104, 75,
-			204, 178, 165, 226, 139, 69, 30, 117, 26, 13, 239, 53, 220, 206,
-			172, 185, 96, 94, 193, 40, 130, 28, 160, 29, 58, 94, 92, 95,
-			155, 47, 78, 220, 233, 74, 165, 210, 92, 148, 123, 210, 37, 62,
-			199, 229, 230, 151, 20, 134, 8, 61, 114, 239, 117, 17, 242, 104,
-			59, 232, 52, 235, 154, 149, 157, 72, 160, 45, 57, 238, 70, 166,
-			181, 58, 223, 220, 165, 64, 198, 4, 238, 63, 130, 15, 236, 75,
-			131, 102, 175, 40, 1, 35, 221, 174, 166, 218, 110, 24, 37, 205,
-			108, 10, 202, 209, 162, 3, 251, 166, 134, 123, 247, 155, 65, 188,
-			141, 109, 66, 93, 185, 101, 160, 251, 16, 237, 161, 3, 140, 222,
-			160, 209, 136, 68, 140, 198, 218, 189, 32, 212, 103, 80, 147, 188,
-			56, 59, 115, 229, 6, 172, 13, 87, 158, 90, 155, 185, 114, 251,
-			234, 204, 237, 43, 79, 149, 102, 174, 188, 88, 84, 210, 29, 113,
-			132, 205, 226, 210, 118, 163, 152, 114, 44, 137, 237, 7, 126, 98,
-			53, 63, 53, 201, 1, 91, 73, 77, 32, 247, 177, 187, 90, 11,
-			189, 118, 60, 9, 182, 110, 151, 161, 230, 114, 88, 28, 121, 176,
-			249, 138, 168, 197, 210, 198, 3, 195, 81, 10, 187, 148, 71, 20,
-			127, 208, 86, 117, 55, 172, 83, 254, 129, 56, 168, 172, 46, 175,
-			226, 36, 27, 159, 216, 199, 60, 45, 181, 130, 215, 189, 102, 211,
-			197, 217, 37, 252, 169, 245, 213, 233, 122, 80, 139, 166, 159, 19,
-			155, 211, 9, 41, 211, 85, 209, 16, 161, 240, 107, 98, 250, 126,
-			51, 216, 116, 155, 27, 203, 72, 67, 52, 13, 4, 77, 167, 26,
-			153, 160, 230, 144, 173, 162, 53, 205, 36, 206, 115, 73, 18, 127,
-			25, 236, 69, 96, 122, 73, 255, 120, 89, 119, 8, 186, 186, 41,
-			116, 111, 69, 157, 238, 219, 69, 202, 63, 240, 114, 20, 135, 13,
-			172, 154, 234, 81, 80, 139, 74, 109, 169, 217, 160, 47, 179, 211,
-			77, 111, 51, 116, 195, 93, 52, 186, 75, 219, 113, 171, 121, 22,
-			127, 233, 186, 19, 184, 227, 66, 141, 32, 235, 70, 162, 182, 168,
-			241, 139, 231, 95, 152, 58, 223, 154, 58, 95, 95, 59, 255, 224,
-			246, 249, 71, 183, 207, 175, 150, 206, 55, 94, 188, 88, 226, 139,
-			222, 171, 98, 199, 139, 4, 58, 57, 192, 160, 100, 148, 58, 145,
-			144, 216, 30, 6, 117, 23, 133, 245, 98, 196, 63, 240, 114, 101,
-			117, 89, 155, 52, 247, 164, 178, 170, 43, 112, 124, 226, 229, 151,
-			198, 169, 62, 0, 122, 37, 168, 187, 83, 146, 66, 220, 218, 107,
-			4, 225, 150, 40, 249, 34, 158, 118, 219, 30, 142, 9, 116, 11,
-			74, 73, 55, 66, 146, 59, 189, 23, 61, 118, 53, 105, 131, 242,
-			9, 224, 163, 57, 141, 146, 245, 98, 17, 242, 154, 219, 198, 249,
-			17, 52, 228, 89, 174, 43, 103, 154, 158, 101, 145, 212, 202, 134,
-			253, 37, 74, 233, 160, 220, 137, 119, 190, 203, 202, 141, 208, 191,
-			111, 169, 189, 120, 231, 147, 150, 125, 168, 240, 41, 139, 87, 19,
-			239, 86, 75, 126, 208, 64, 129, 71, 14, 71, 158, 95, 75, 91,
-			88, 116, 127, 19, 139, 63, 234, 68, 49, 72, 194, 155, 185, 68,
-			116, 63, 159, 232, 69, 238, 249, 181, 102, 39, 242, 30, 131, 147,
-			120, 64, 111, 253, 3, 125, 253, 26, 180, 0, 204, 29, 212, 32,
-			1, 144, 141, 210, 63, 176, 212, 246, 191, 243, 41, 203, 102, 133,
-			127, 109, 241, 165, 192, 159, 242, 197, 150, 244, 129, 187, 60, 105,
-			87, 123, 140, 224, 68, 238, 239, 73, 47, 169, 138, 198, 185, 124,
-			236, 54, 59, 34, 146, 219, 145, 9, 50, 220, 52, 141, 98, 175,
-			217, 228, 219, 238, 99, 193, 253, 116, 155, 136, 90, 85, 164, 210,
-			115, 147, 206, 121, 35, 8, 193, 41, 214, 59, 7, 189, 12, 83,
-			14, 227, 164, 250, 159, 238, 195, 20, 43, 131, 253, 212, 76, 177,
-			176, 219, 185, 3, 26, 36, 0, 230, 71, 204, 41, 198, 111, 28,
-			165, 79, 191, 163, 147, 234, 102, 51, 216, 105, 122, 209, 183, 113,
-			86, 93, 120, 171, 227, 146, 226, 79, 88, 244, 200, 162, 23, 197,
-			115, 166, 145, 170, 58, 238, 103, 55, 40, 77, 154, 30, 179, 56,
-			25, 31, 156, 61, 218, 125, 40, 109, 106, 85, 83, 69, 217, 5,
-			122, 176, 22, 248, 13, 111, 107, 227, 177, 39, 118, 54, 58, 97,
-			83, 157, 130, 31, 144, 201, 207, 122, 98, 103, 61, 108, 178, 139,
-			166, 92, 40, 30, 123, 145, 23, 248, 99, 4, 203, 13, 203, 228,
-			170, 74, 45, 78, 208, 209, 251, 34, 33, 177, 42, 3, 20, 24,
-			163, 142, 239, 182, 132, 58, 120, 199, 223, 197, 79, 91, 116, 192,
-			20, 220, 175, 4, 30, 1, 117, 54, 125, 17, 71, 99, 54, 39,
-			227, 3, 85, 13, 50, 78, 7, 235, 34, 66, 29, 157, 208, 146,
-			78, 98, 183, 40, 173, 133, 2, 150, 180, 141, 56, 26, 115, 184,
-			53, 62, 56, 91, 216, 115, 126, 111, 150, 214, 234, 128, 42, 189,
-			22, 177, 147, 73, 213, 205, 221, 177, 12, 226, 214, 217, 119, 119,
-			103, 127, 218, 162, 52, 25, 3, 182, 68, 135, 187, 71, 229, 137,
-			97, 2, 231, 186, 71, 228, 9, 99, 249, 144, 14, 165, 57, 200,
-			206, 116, 215, 218, 135, 187, 133, 39, 13, 245, 59, 13, 64, 248,
-			205, 17, 25, 128, 112, 231, 221, 0, 132, 119, 3, 16, 254, 146,
-			3, 16, 224, 167, 197, 200, 225, 190, 9, 186, 174, 99, 17, 184,
-			85, 168, 240, 100, 138, 60, 41, 26, 33, 74, 135, 35, 36, 202,
-			13, 123, 164, 170, 116, 135, 36, 48, 250, 105, 19, 146, 112, 194,
-			126, 84, 248, 41, 139, 119, 79, 70, 19, 69, 224, 54, 155, 242,
-			68, 47, 201, 82, 94, 101, 115, 151, 71, 113, 0, 162, 224, 249,
-			201, 100, 160, 134, 198, 186, 27, 187, 88, 64, 122, 24, 41, 4,
-			59, 176, 234, 109, 10, 213, 134, 68, 224, 54, 219, 219, 238, 166,
-			136, 49, 226, 43, 8, 235, 34, 164, 73, 224, 67, 188, 45, 188,
-			144, 87, 22, 186, 130, 14, 78, 100, 15, 165, 130, 14, 78, 28,
-			190, 148, 10, 58, 56, 241, 212, 251, 233, 26, 118, 208, 98, 228,
-			180, 253, 116, 225, 62, 79, 171, 13, 211, 187, 36, 37, 29, 136,
-			0, 108, 115, 117, 104, 153, 168, 39, 165, 76, 251, 22, 160, 205,
-			50, 13, 217, 140, 156, 30, 189, 160, 33, 194, 200, 233, 43, 183,
-			233, 243, 58, 232, 97, 202, 42, 44, 242, 253, 117, 157, 244, 47,
-			247, 112, 88, 217, 82, 93, 140, 229, 171, 74, 185, 165, 163, 34,
-			78, 209, 11, 58, 42, 226, 188, 125, 174, 112, 12, 155, 65, 67,
-			165, 217, 76, 33, 44, 153, 104, 8, 7, 10, 26, 40, 203, 200,
-			249, 193, 67, 169, 216, 136, 243, 135, 79, 167, 98, 35, 206, 23,
-			207, 210, 103, 116, 108, 196, 184, 125, 178, 48, 131, 35, 185, 94,
-			93, 4, 121, 131, 245, 18, 9, 175, 172, 164, 69, 84, 46, 135,
-			168, 183, 74, 233, 184, 137, 241, 174, 184, 137, 241, 129, 177, 84,
-			220, 196, 248, 241, 19, 84, 96, 67, 54, 35, 147, 246, 201, 194,
-			243, 114, 135, 92, 173, 169, 90, 131, 85, 86, 186, 196, 80, 54,
-			84, 11, 66, 25, 244, 87, 55, 219, 223, 41, 201, 170, 172, 208,
-			253, 248, 96, 103, 160, 29, 77, 16, 116, 111, 210, 16, 100, 19,
-			70, 38, 143, 159, 160, 75, 212, 118, 44, 230, 204, 244, 205, 90,
-			133, 187, 124, 159, 85, 71, 5, 36, 74, 117, 177, 219, 61, 134,
-			124, 83, 0, 57, 70, 134, 212, 168, 65, 215, 103, 114, 199, 233,
-			57, 234, 56, 22, 140, 218, 21, 155, 21, 143, 114, 81, 218, 186,
-			205, 139, 81, 208, 18, 83, 6, 131, 10, 58, 177, 48, 130, 229,
-			138, 162, 213, 194, 81, 186, 50, 112, 64, 67, 132, 145, 43, 249,
-			17, 186, 70, 109, 199, 102, 206, 83, 125, 119, 172, 194, 131, 148,
-			84, 99, 216, 165, 242, 228, 19, 218, 164, 124, 235, 121, 42, 221,
-			199, 39, 201, 25, 240, 230, 169, 220, 8, 82, 108, 3, 197, 215,
-			223, 138, 98, 27, 41, 190, 174, 40, 182, 145, 226, 235, 138, 98,
-			27, 41, 190, 158, 215, 248, 44, 70, 110, 216, 167, 52, 190, 15,
-			20, 175, 204, 222, 40, 205, 148, 102, 74, 87, 166, 103, 175, 21,
-			95, 210, 248, 44, 7, 138, 25, 40, 195, 200, 141, 193, 17, 13,
-			1, 10, 118, 76, 67, 132, 145, 27, 39, 78, 210, 105, 196, 110,
-			51, 114, 211, 62, 86, 44, 42, 106, 215, 182, 229, 108, 211, 51,
-			94, 234, 199, 82, 169, 100, 8, 7, 177, 184, 105, 8, 7, 234,
-			110, 14, 28, 210, 16, 97, 228, 230, 209, 49, 12, 67, 66, 224,
-			150, 125, 89, 134, 33, 221, 230, 197, 43, 183, 110, 204, 42, 87,
-			229, 10, 186, 42, 179, 51, 165, 153, 217, 43, 47, 26, 204, 36,
-			11, 21, 142, 107, 200, 98, 228, 214, 137, 11, 26, 2, 100, 19,
-			151, 232, 101, 196, 236, 48, 114, 219, 30, 43, 158, 82, 152, 49,
-			192, 41, 22, 81, 188, 79, 116, 147, 109, 59, 25, 40, 173, 9,
-			118, 44, 70, 110, 15, 140, 106, 136, 48, 114, 251, 200, 81, 99,
-			202, 255, 56, 163, 55, 223, 145, 41, 223, 137, 183, 235, 155, 251,
-			154, 241, 111, 105, 169, 63, 162, 236, 190, 136, 87, 125, 183, 29,
-			109, 7, 198, 6, 46, 208, 156, 49, 158, 101, 68, 147, 129, 217,
-			113, 58, 16, 189, 234, 181, 55, 54, 131, 250, 46, 90, 224, 185,
-			106, 14, 18, 238, 6, 245, 93, 48, 148, 115, 26, 25, 59, 69,
-			7, 145, 226, 250, 38, 152, 226, 10, 209, 0, 36, 45, 108, 86,
-			197, 99, 118, 142, 14, 235, 252, 104, 219, 157, 125, 234, 186, 50,
-			232, 135, 100, 145, 85, 76, 99, 227, 52, 175, 75, 213, 69, 163,
-			9, 150, 45, 26, 209, 67, 213, 97, 89, 110, 65, 165, 254, 5,
-			236, 232, 217, 239, 160, 89, 152, 82, 11, 119, 217, 125, 58, 152,
-			98, 8, 227, 123, 76, 218, 30, 94, 237, 137, 168, 85, 217, 239,
-			212, 160, 253, 239, 135, 165, 65, 123, 245, 77, 13, 218, 217, 119,
-			13, 218, 119, 13, 218, 255, 194, 6, 237, 4, 189, 45, 173, 216,
-			163, 125, 199, 173, 66, 137, 203, 137, 176, 175, 229, 186, 19, 132,
-			175, 202, 209, 151, 133, 82, 166, 234, 209, 220, 48, 117, 181, 165,
-			90, 176, 111, 21, 214, 120, 106, 178, 224, 26, 166, 150, 55, 61,
-			139, 117, 67, 56, 51, 169, 249, 58, 1, 209, 71, 186, 158, 178,
-			144, 182, 194, 160, 211, 238, 14, 101, 45, 100, 71, 82, 86, 101,
-			129, 157, 75, 89, 149, 133, 233, 27, 244, 61, 210, 170, 59, 213,
-			119, 193, 42, 204, 242, 189, 19, 23, 198, 92, 198, 233, 66, 207,
-			210, 180, 134, 237, 90, 202, 118, 59, 149, 43, 208, 191, 167, 183,
-			209, 72, 209, 62, 92, 248, 148, 101, 166, 147, 18, 112, 67, 110,
-			28, 240, 45, 17, 195, 96, 207, 232, 223, 144, 15, 253, 5, 115,
-			207, 135, 169, 178, 20, 196, 216, 167, 199, 18, 135, 137, 78, 115,
-			31, 187, 94, 19, 69, 90, 30, 14, 196, 120, 188, 161, 50, 183,
-			92, 185, 217, 167, 213, 48, 229, 126, 167, 181, 41, 194, 104, 162,
-			148, 14, 160, 45, 218, 253, 41, 35, 177, 152, 203, 167, 140, 196,
-			226, 232, 33, 250, 56, 9, 160, 61, 92, 240, 120, 165, 193, 227,
-			176, 147, 250, 24, 3, 13, 126, 208, 2, 210, 46, 227, 110, 45,
-			238, 184, 77, 211, 191, 73, 254, 74, 7, 56, 23, 71, 124, 245,
-			193, 220, 236, 83, 215, 41, 223, 118, 163, 237, 201, 196, 0, 148,
-			84, 201, 40, 65, 179, 212, 116, 71, 229, 102, 211, 81, 185, 253,
-			249, 116, 84, 238, 232, 33, 250, 113, 75, 90, 115, 151, 250, 174,
-			90, 133, 93, 110, 6, 197, 136, 227, 222, 64, 100, 55, 153, 55,
-			74, 164, 52, 57, 37, 218, 109, 158, 42, 234, 188, 200, 132, 2,
-			202, 239, 42, 208, 40, 244, 34, 211, 207, 148, 17, 120, 41, 151,
-			167, 51, 218, 8, 156, 180, 199, 10, 103, 121, 181, 7, 29, 10,
-			129, 23, 25, 90, 75, 105, 131, 112, 82, 141, 136, 52, 8, 39,
-			115, 163, 41, 131, 112, 242, 200, 81, 58, 133, 184, 45, 70, 74,
-			246, 137, 2, 87, 108, 69, 174, 42, 173, 176, 31, 98, 96, 100,
-			201, 88, 154, 64, 102, 105, 224, 168, 134, 8, 35, 165, 194, 113,
-			250, 126, 68, 108, 51, 50, 99, 159, 44, 188, 135, 47, 236, 55,
-			235, 244, 164, 155, 228, 129, 138, 127, 245, 26, 220, 172, 238, 104,
-			54, 135, 29, 97, 154, 5, 171, 107, 198, 244, 199, 70, 19, 121,
-			76, 67, 132, 145, 153, 227, 39, 232, 85, 108, 150, 48, 50, 107,
-			95, 46, 92, 192, 61, 118, 29, 75, 155, 234, 9, 223, 113, 35,
-			174, 150, 95, 131, 30, 76, 175, 89, 101, 122, 89, 104, 122, 205,
-			42, 211, 203, 66, 211, 107, 118, 226, 146, 177, 145, 62, 61, 245,
-			206, 182, 59, 101, 168, 114, 51, 216, 218, 127, 187, 243, 45, 237,
-			164, 239, 177, 232, 97, 112, 217, 230, 17, 207, 98, 176, 21, 105,
-			91, 233, 173, 172, 156, 35, 52, 27, 187, 225, 150, 136, 149, 117,
-			163, 32, 118, 146, 210, 182, 187, 37, 54, 240, 163, 0, 181, 45,
-			56, 0, 41, 107, 144, 0, 102, 22, 102, 71, 222, 235, 2, 109,
-			153, 76, 53, 7, 9, 171, 222, 235, 162, 248, 88, 110, 175, 166,
-			137, 81, 91, 114, 215, 104, 191, 236, 170, 222, 91, 45, 244, 108,
-			184, 225, 232, 203, 138, 85, 93, 148, 93, 160, 7, 125, 241, 90,
-			188, 145, 34, 72, 237, 173, 66, 242, 138, 38, 170, 248, 93, 3,
-			116, 40, 141, 129, 157, 166, 131, 18, 199, 70, 188, 219, 214, 59,
-			162, 84, 38, 173, 237, 182, 197, 19, 123, 223, 195, 53, 210, 203,
-			181, 60, 37, 59, 219, 1, 118, 124, 160, 10, 63, 89, 137, 58,
-			59, 219, 194, 199, 77, 206, 55, 183, 235, 176, 28, 27, 163, 253,
-			181, 160, 213, 18, 126, 60, 150, 69, 44, 26, 4, 162, 221, 118,
-			123, 67, 233, 223, 177, 126, 73, 180, 219, 110, 43, 173, 222, 187,
-			101, 155, 219, 187, 101, 123, 145, 30, 12, 154, 245, 141, 116, 169,
-			1, 185, 201, 28, 52, 235, 11, 169, 130, 71, 104, 54, 216, 241,
-			69, 24, 141, 81, 217, 127, 9, 193, 232, 3, 2, 149, 55, 40,
-			71, 63, 104, 214, 151, 101, 246, 24, 237, 111, 9, 212, 239, 99,
-			67, 114, 59, 89, 129, 236, 16, 205, 108, 53, 131, 205, 104, 236,
-			0, 166, 75, 0, 154, 241, 209, 125, 29, 27, 198, 100, 5, 165,
-			183, 165, 15, 118, 111, 75, 167, 191, 49, 203, 247, 124, 99, 86,
-			164, 7, 188, 246, 6, 58, 96, 27, 224, 129, 141, 141, 72, 14,
-			120, 109, 116, 88, 65, 244, 64, 100, 2, 28, 193, 90, 211, 19,
-			126, 188, 225, 213, 199, 152, 20, 25, 76, 158, 199, 212, 74, 157,
-			149, 232, 104, 87, 185, 72, 212, 66, 17, 143, 141, 98, 217, 145,
-			84, 217, 85, 204, 96, 207, 208, 227, 178, 188, 91, 151, 33, 231,
-			110, 51, 105, 34, 26, 59, 132, 189, 24, 195, 34, 115, 166, 132,
-			110, 45, 98, 211, 244, 16, 202, 47, 170, 0, 17, 110, 116, 194,
-			230, 70, 208, 172, 143, 29, 150, 237, 97, 222, 42, 102, 173, 135,
-			205, 229, 102, 125, 223, 10, 190, 216, 25, 59, 178, 95, 133, 37,
-			177, 3, 29, 138, 68, 173, 19, 122, 241, 238, 134, 58, 104, 128,
-			6, 142, 202, 242, 58, 107, 30, 115, 160, 129, 125, 202, 3, 254,
-			177, 253, 202, 3, 254, 203, 116, 36, 101, 234, 109, 96, 220, 193,
-			216, 49, 236, 118, 62, 149, 49, 7, 233, 108, 154, 142, 166, 11,
-			203, 137, 87, 31, 43, 96, 113, 150, 202, 146, 243, 117, 79, 133,
-			80, 180, 130, 199, 162, 62, 118, 124, 79, 133, 170, 204, 1, 39,
-			45, 57, 78, 193, 158, 158, 144, 78, 154, 57, 77, 129, 78, 118,
-			151, 130, 254, 157, 236, 41, 5, 93, 43, 210, 3, 208, 66, 100,
-			80, 157, 146, 114, 133, 137, 10, 83, 87, 25, 64, 116, 186, 187,
-			204, 146, 216, 153, 245, 40, 77, 84, 31, 251, 128, 60, 213, 72,
-			165, 156, 221, 123, 122, 177, 71, 111, 239, 119, 196, 177, 87, 159,
-			190, 83, 47, 238, 191, 229, 210, 139, 19, 239, 122, 113, 239, 122,
-			113, 127, 201, 94, 92, 69, 123, 113, 5, 171, 240, 12, 79, 100,
-			249, 109, 157, 69, 168, 79, 185, 208, 64, 74, 59, 117, 140, 222,
-			215, 78, 221, 49, 251, 81, 225, 54, 239, 158, 39, 123, 78, 31,
-			82, 120, 96, 8, 23, 204, 209, 66, 218, 117, 59, 214, 117, 32,
-			112, 172, 235, 64, 224, 216, 83, 239, 167, 31, 179, 180, 239, 118,
-			217, 42, 236, 240, 125, 231, 47, 110, 200, 235, 109, 91, 237, 107,
-			185, 201, 23, 75, 9, 29, 169, 64, 44, 138, 39, 240, 94, 51,
-			22, 90, 2, 19, 27, 68, 127, 166, 36, 13, 148, 46, 7, 240,
-			36, 125, 90, 251, 127, 220, 30, 43, 76, 247, 186, 25, 201, 199,
-			98, 73, 179, 104, 225, 182, 220, 186, 232, 242, 207, 120, 151, 127,
-			198, 149, 55, 32, 123, 206, 143, 28, 165, 183, 180, 127, 118, 214,
-			62, 84, 152, 228, 101, 92, 143, 85, 68, 56, 88, 204, 82, 139,
-			235, 232, 253, 164, 181, 46, 23, 235, 108, 215, 6, 254, 217, 129,
-			131, 41, 23, 235, 44, 27, 213, 222, 172, 205, 200, 69, 123, 12,
-			188, 89, 208, 70, 24, 195, 0, 156, 235, 49, 4, 121, 40, 106,
-			2, 191, 136, 66, 133, 176, 191, 154, 196, 47, 82, 101, 48, 144,
-			118, 121, 1, 13, 7, 52, 61, 163, 81, 2, 167, 19, 131, 9,
-			38, 161, 172, 220, 18, 104, 120, 97, 36, 75, 119, 109, 252, 95,
-			236, 218, 248, 191, 56, 48, 154, 218, 248, 191, 120, 228, 40, 93,
-			196, 126, 16, 70, 46, 217, 71, 10, 239, 197, 110, 180, 220, 215,
-			188, 86, 167, 149, 114, 205, 210, 18, 137, 122, 165, 214, 236, 212,
-			133, 102, 161, 246, 124, 77, 187, 36, 3, 232, 244, 40, 17, 244,
-			0, 245, 103, 168, 224, 132, 92, 58, 116, 152, 126, 64, 186, 168,
-			165, 190, 167, 172, 194, 242, 19, 88, 146, 204, 182, 125, 133, 82,
-			142, 105, 203, 141, 107, 219, 66, 126, 80, 247, 225, 142, 8, 119,
-			83, 142, 103, 41, 119, 138, 158, 215, 142, 231, 140, 125, 174, 48,
-			198, 231, 246, 195, 148, 120, 155, 14, 148, 51, 80, 150, 145, 153,
-			193, 163, 41, 223, 115, 102, 236, 116, 202, 247, 156, 41, 158, 149,
-			130, 128, 206, 231, 53, 251, 100, 183, 32, 168, 251, 27, 212, 229,
-			13, 41, 113, 216, 87, 10, 186, 38, 33, 198, 183, 189, 157, 225,
-			151, 27, 28, 110, 40, 184, 31, 240, 86, 16, 246, 106, 161, 196,
-			215, 189, 214, 229, 235, 94, 27, 24, 75, 249, 186, 215, 142, 159,
-			160, 55, 229, 169, 202, 205, 62, 97, 21, 38, 121, 218, 67, 225,
-			161, 192, 239, 87, 227, 0, 86, 179, 100, 118, 10, 63, 54, 220,
-			198, 227, 131, 220, 33, 250, 148, 62, 57, 185, 109, 31, 43, 140,
-			243, 123, 158, 104, 214, 35, 88, 49, 91, 176, 146, 212, 194, 32,
-			146, 122, 78, 161, 193, 239, 111, 75, 233, 163, 148, 219, 93, 71,
-			41, 183, 205, 137, 4, 240, 251, 246, 209, 49, 58, 168, 143, 82,
-			238, 216, 135, 82, 103, 36, 119, 76, 53, 232, 221, 29, 53, 95,
-			229, 25, 201, 29, 54, 170, 170, 217, 140, 60, 109, 143, 165, 206,
-			63, 158, 86, 82, 42, 207, 63, 158, 206, 141, 166, 206, 63, 158,
-			62, 114, 84, 85, 35, 140, 60, 99, 235, 51, 24, 16, 238, 103,
-			76, 107, 32, 220, 207, 12, 12, 165, 14, 55, 158, 57, 152, 87,
-			213, 28, 70, 222, 99, 159, 215, 7, 20, 89, 128, 142, 167, 14,
-			47, 222, 115, 130, 167, 14, 47, 222, 115, 246, 156, 170, 150, 97,
-			228, 189, 246, 97, 149, 149, 65, 72, 183, 150, 177, 24, 121, 239,
-			64, 94, 67, 132, 145, 247, 142, 30, 82, 213, 178, 140, 188, 207,
-			214, 71, 67, 217, 12, 64, 186, 90, 214, 98, 228, 125, 134, 147,
-			89, 194, 200, 251, 12, 39, 251, 25, 153, 51, 213, 250, 51, 0,
-			233, 106, 253, 22, 35, 115, 166, 90, 63, 97, 100, 206, 84, 203,
-			49, 114, 215, 62, 169, 178, 114, 25, 128, 116, 181, 156, 197, 200,
-			221, 1, 205, 229, 28, 97, 228, 238, 241, 19, 244, 26, 86, 27,
-			96, 164, 108, 31, 46, 92, 212, 130, 97, 150, 249, 56, 80, 34,
-			119, 63, 12, 58, 109, 41, 119, 70, 46, 6, 50, 80, 77, 227,
-			31, 176, 24, 41, 155, 1, 30, 32, 140, 148, 153, 102, 2, 101,
-			228, 158, 233, 13, 205, 0, 164, 171, 81, 139, 145, 123, 230, 188,
-			136, 18, 70, 238, 29, 209, 189, 25, 100, 228, 190, 125, 90, 101,
-			13, 58, 0, 233, 182, 7, 51, 140, 220, 55, 7, 112, 131, 22,
-			35, 247, 205, 1, 220, 32, 97, 228, 254, 137, 83, 10, 201, 16,
-			35, 15, 12, 75, 134, 28, 128, 52, 146, 161, 12, 35, 15, 12,
-			146, 33, 139, 145, 7, 236, 168, 134, 8, 35, 15, 10, 39, 20,
-			146, 3, 140, 84, 236, 83, 42, 235, 128, 3, 144, 70, 114, 32,
-			195, 72, 197, 32, 57, 96, 49, 82, 97, 154, 203, 7, 8, 35,
-			149, 227, 39, 233, 109, 68, 50, 204, 200, 162, 125, 186, 48, 245,
-			100, 46, 87, 86, 204, 177, 105, 15, 175, 135, 29, 168, 108, 160,
-			12, 35, 139, 166, 205, 97, 139, 145, 69, 211, 251, 97, 194, 200,
-			226, 137, 83, 116, 1, 219, 60, 200, 200, 178, 125, 180, 112, 227,
-			109, 181, 57, 23, 69, 222, 150, 223, 18, 126, 111, 235, 7, 51,
-			128, 70, 15, 217, 65, 139, 145, 229, 1, 221, 250, 65, 194, 200,
-			242, 161, 35, 138, 81, 121, 70, 86, 236, 19, 42, 43, 159, 1,
-			72, 87, 203, 91, 140, 172, 12, 28, 209, 16, 97, 100, 229, 216,
-			113, 165, 153, 70, 24, 89, 181, 79, 37, 154, 105, 47, 153, 210,
-			139, 236, 161, 107, 36, 3, 245, 116, 3, 35, 22, 35, 171, 70,
-			194, 71, 8, 35, 171, 199, 79, 42, 186, 24, 35, 107, 118, 81,
-			101, 177, 12, 64, 186, 26, 179, 24, 89, 27, 208, 52, 51, 194,
-			200, 218, 233, 51, 170, 218, 40, 35, 235, 246, 85, 149, 53, 234,
-			0, 164, 219, 30, 205, 48, 178, 110, 198, 96, 212, 98, 100, 157,
-			77, 105, 136, 48, 178, 62, 51, 171, 144, 28, 98, 228, 89, 251,
-			172, 202, 58, 148, 1, 72, 183, 125, 200, 98, 228, 217, 1, 45,
-			157, 135, 8, 35, 207, 242, 162, 170, 118, 152, 145, 231, 76, 181,
-			195, 25, 128, 116, 181, 195, 22, 35, 207, 153, 106, 135, 9, 35,
-			207, 153, 106, 71, 24, 121, 222, 244, 244, 72, 6, 32, 93, 237,
-			136, 197, 200, 243, 166, 167, 71, 8, 35, 207, 155, 158, 30, 101,
-			228, 5, 83, 237, 104, 6, 32, 93, 237, 168, 197, 200, 11, 166,
-			218, 81, 194, 200, 11, 167, 207, 40, 153, 30, 99, 228, 131, 246,
-			196, 147, 101, 186, 42, 220, 102, 43, 146, 97, 219, 81, 207, 232,
-			141, 57, 80, 217, 64, 25, 70, 62, 104, 248, 57, 102, 49, 242,
-			65, 166, 123, 63, 70, 24, 249, 224, 133, 113, 69, 234, 49, 70,
-			94, 178, 47, 171, 172, 99, 14, 64, 26, 201, 177, 12, 35, 47,
-			25, 36, 199, 44, 70, 94, 98, 90, 213, 31, 35, 140, 188, 52,
-			126, 73, 33, 41, 48, 242, 33, 131, 164, 224, 0, 164, 145, 20,
-			50, 140, 124, 200, 32, 41, 88, 140, 124, 200, 32, 41, 16, 70,
-			62, 52, 126, 73, 245, 254, 56, 35, 174, 125, 242, 201, 189, 95,
-			9, 131, 87, 68, 45, 150, 76, 232, 233, 253, 241, 12, 84, 214,
-			60, 62, 110, 49, 226, 14, 104, 229, 115, 156, 48, 226, 26, 229,
-			115, 130, 145, 77, 163, 193, 78, 100, 0, 210, 213, 78, 88, 140,
-			108, 154, 106, 39, 8, 35, 155, 166, 218, 73, 70, 106, 102, 42,
-			158, 204, 0, 164, 171, 157, 180, 24, 169, 153, 169, 120, 146, 48,
-			82, 59, 118, 92, 85, 59, 197, 72, 221, 84, 59, 149, 1, 72,
-			87, 59, 101, 49, 82, 55, 213, 78, 17, 70, 234, 199, 142, 155,
-			125, 235, 47, 125, 202, 161, 188, 119, 179, 89, 111, 35, 6, 225,
-			147, 174, 27, 121, 68, 71, 238, 121, 77, 177, 96, 10, 174, 138,
-			152, 221, 164, 14, 120, 247, 106, 163, 247, 220, 158, 29, 209, 238,
-			26, 120, 165, 71, 21, 107, 20, 127, 56, 67, 71, 247, 201, 125,
-			82, 100, 107, 219, 173, 189, 234, 110, 9, 181, 133, 171, 65, 118,
-			138, 82, 253, 57, 116, 109, 119, 140, 224, 22, 82, 42, 5, 119,
-			178, 58, 155, 77, 175, 182, 145, 42, 70, 57, 25, 207, 84, 243,
-			50, 99, 33, 41, 124, 145, 30, 220, 17, 238, 171, 233, 162, 131,
-			88, 116, 24, 146, 83, 5, 231, 233, 144, 58, 181, 144, 123, 206,
-			14, 246, 158, 239, 233, 125, 111, 207, 7, 85, 45, 220, 150, 158,
-			163, 3, 194, 239, 180, 36, 134, 204, 19, 248, 87, 246, 59, 173,
-			94, 44, 57, 168, 166, 80, 244, 43, 199, 122, 44, 139, 8, 46,
-			238, 65, 160, 2, 116, 122, 113, 232, 122, 108, 158, 14, 224, 215,
-			213, 106, 27, 26, 144, 156, 223, 103, 20, 69, 178, 165, 172, 81,
-			36, 245, 216, 117, 218, 47, 191, 16, 138, 112, 163, 122, 112, 246,
-			196, 190, 130, 176, 44, 203, 84, 117, 97, 86, 161, 121, 249, 21,
-			195, 70, 45, 168, 139, 13, 207, 111, 4, 184, 135, 61, 56, 123,
-			122, 111, 71, 176, 224, 124, 80, 23, 21, 191, 17, 84, 135, 163,
-			46, 152, 29, 161, 217, 104, 215, 143, 221, 215, 198, 134, 228, 38,
-			183, 132, 216, 44, 237, 23, 114, 139, 118, 108, 152, 91, 227, 195,
-			179, 99, 123, 121, 44, 243, 171, 186, 96, 241, 159, 100, 233, 193,
-			183, 35, 150, 119, 104, 166, 1, 156, 193, 112, 235, 183, 205, 55,
-			89, 167, 155, 241, 217, 111, 147, 241, 115, 116, 80, 238, 178, 75,
-			41, 34, 111, 83, 14, 169, 172, 180, 87, 12, 157, 111, 75, 12,
-			159, 167, 7, 13, 73, 27, 248, 45, 185, 146, 231, 233, 183, 162,
-			164, 84, 214, 245, 170, 120, 26, 52, 44, 186, 96, 182, 64, 105,
-			224, 139, 160, 177, 81, 23, 181, 230, 88, 238, 9, 92, 90, 134,
-			34, 123, 184, 20, 200, 212, 90, 147, 221, 74, 196, 179, 255, 9,
-			210, 245, 72, 78, 204, 61, 18, 186, 78, 135, 245, 93, 65, 170,
-			103, 3, 72, 68, 233, 45, 123, 86, 85, 213, 100, 199, 14, 132,
-			105, 144, 157, 165, 38, 97, 3, 197, 138, 162, 230, 26, 210, 137,
-			75, 110, 75, 20, 94, 167, 195, 221, 236, 97, 135, 104, 38, 138,
-			221, 48, 70, 41, 204, 84, 37, 192, 242, 148, 8, 191, 174, 174,
-			119, 130, 159, 236, 125, 73, 135, 9, 118, 248, 194, 222, 17, 237,
-			194, 220, 219, 239, 194, 13, 122, 160, 171, 3, 111, 183, 233, 226,
-			231, 28, 122, 120, 95, 220, 236, 121, 122, 168, 227, 155, 139, 18,
-			68, 125, 67, 182, 53, 246, 149, 254, 39, 8, 221, 122, 186, 180,
-			196, 82, 29, 237, 236, 77, 100, 47, 208, 65, 144, 15, 55, 196,
-			61, 76, 53, 27, 103, 223, 94, 151, 75, 11, 73, 205, 187, 228,
-			147, 150, 93, 77, 227, 98, 55, 104, 174, 33, 220, 184, 19, 138,
-			104, 108, 22, 89, 121, 124, 239, 36, 149, 5, 86, 69, 92, 53,
-			133, 89, 139, 14, 61, 22, 161, 185, 227, 9, 199, 97, 120, 246,
-			230, 219, 36, 234, 217, 84, 213, 213, 216, 141, 197, 109, 186, 190,
-			244, 108, 185, 90, 185, 87, 41, 47, 72, 50, 187, 208, 23, 126,
-			208, 162, 131, 169, 158, 224, 97, 28, 110, 63, 169, 241, 82, 16,
-			59, 78, 7, 26, 157, 102, 83, 10, 157, 92, 75, 115, 144, 0,
-			2, 7, 58, 78, 169, 17, 212, 113, 240, 91, 134, 225, 73, 65,
-			192, 99, 207, 92, 213, 192, 50, 175, 141, 7, 232, 120, 190, 137,
-			121, 18, 126, 232, 228, 156, 124, 166, 120, 141, 142, 236, 233, 10,
-			59, 72, 7, 23, 202, 243, 139, 115, 213, 185, 181, 202, 242, 82,
-			190, 143, 13, 211, 84, 239, 242, 214, 165, 129, 220, 87, 251, 243,
-			31, 253, 232, 71, 63, 106, 23, 127, 37, 75, 15, 237, 167, 4,
-			247, 213, 199, 73, 167, 73, 87, 167, 231, 104, 166, 233, 110, 138,
-			38, 30, 229, 14, 207, 94, 126, 91, 106, 182, 180, 8, 85, 170,
-			178, 38, 123, 143, 98, 77, 6, 49, 92, 122, 123, 24, 64, 57,
-			42, 54, 30, 167, 3, 240, 87, 242, 93, 158, 5, 231, 32, 1,
-			249, 94, 160, 57, 121, 195, 137, 48, 99, 162, 97, 208, 20, 117,
-			209, 112, 59, 205, 120, 3, 119, 197, 212, 81, 241, 144, 74, 124,
-			22, 210, 216, 105, 58, 40, 213, 164, 231, 215, 197, 107, 184, 132,
-			102, 170, 82, 115, 86, 32, 5, 154, 127, 37, 10, 124, 173, 107,
-			176, 9, 72, 192, 230, 111, 244, 174, 222, 39, 247, 239, 222, 30,
-			229, 120, 145, 30, 148, 38, 229, 134, 254, 62, 24, 79, 105, 115,
-			213, 97, 153, 188, 172, 82, 139, 255, 139, 77, 29, 92, 41, 14,
-			210, 193, 181, 23, 86, 202, 27, 11, 203, 235, 119, 23, 203, 121,
-			11, 134, 30, 19, 238, 45, 46, 207, 173, 229, 109, 3, 227, 69,
-			8, 121, 98, 42, 200, 155, 33, 242, 78, 186, 192, 213, 217, 124,
-			134, 229, 233, 144, 68, 80, 121, 190, 188, 112, 253, 90, 62, 219,
-			157, 114, 117, 54, 223, 207, 14, 208, 1, 76, 185, 187, 188, 188,
-			152, 207, 25, 156, 171, 107, 213, 202, 210, 253, 252, 128, 193, 121,
-			191, 186, 188, 190, 146, 167, 6, 195, 163, 242, 234, 234, 220, 253,
-			114, 126, 208, 148, 184, 251, 194, 90, 121, 53, 63, 212, 69, 214,
-			213, 217, 252, 1, 211, 68, 121, 105, 253, 81, 126, 152, 141, 208,
-			3, 178, 9, 77, 196, 193, 158, 164, 235, 215, 242, 249, 132, 16,
-			137, 101, 164, 43, 225, 250, 181, 60, 43, 206, 211, 12, 138, 33,
-			99, 116, 120, 113, 238, 110, 121, 113, 99, 25, 111, 114, 154, 91,
-			204, 91, 73, 90, 181, 188, 82, 158, 91, 43, 47, 228, 73, 58,
-			237, 59, 214, 43, 213, 242, 66, 222, 46, 214, 232, 161, 253, 86,
-			200, 125, 167, 80, 74, 22, 236, 39, 200, 2, 226, 234, 149, 133,
-			226, 191, 183, 233, 232, 62, 86, 194, 190, 141, 188, 151, 102, 164,
-			44, 75, 77, 61, 177, 175, 185, 129, 146, 189, 199, 118, 194, 122,
-			105, 123, 147, 60, 193, 222, 4, 20, 123, 4, 246, 165, 61, 171,
-			185, 52, 120, 174, 191, 29, 131, 7, 211, 222, 217, 170, 158, 217,
-			103, 85, 191, 67, 71, 246, 32, 122, 219, 171, 235, 199, 44, 58,
-			246, 36, 230, 188, 133, 74, 180, 187, 84, 226, 157, 94, 14, 158,
-			121, 242, 32, 236, 25, 235, 159, 178, 232, 145, 253, 253, 138, 125,
-			105, 120, 15, 205, 202, 211, 62, 53, 222, 123, 141, 145, 71, 152,
-			221, 59, 216, 170, 86, 218, 124, 35, 79, 114, 14, 36, 53, 123,
-			40, 253, 110, 155, 30, 222, 23, 249, 190, 132, 158, 164, 212, 243,
-			219, 157, 88, 218, 194, 82, 19, 15, 96, 10, 42, 47, 208, 178,
-			157, 216, 228, 203, 85, 146, 202, 36, 44, 112, 51, 33, 84, 70,
-			126, 159, 122, 66, 79, 247, 8, 230, 12, 205, 235, 216, 148, 56,
-			20, 110, 203, 243, 183, 228, 106, 123, 59, 211, 112, 155, 145, 168,
-			30, 148, 217, 171, 58, 23, 106, 168, 104, 145, 164, 70, 182, 171,
-			134, 204, 54, 53, 138, 63, 52, 64, 7, 83, 94, 24, 59, 67,
-			135, 94, 113, 31, 187, 27, 218, 179, 150, 156, 24, 132, 180, 21,
-			229, 93, 207, 208, 67, 88, 36, 232, 196, 34, 220, 168, 53, 221,
-			40, 66, 166, 201, 104, 36, 6, 121, 203, 144, 53, 175, 115, 216,
-			83, 116, 20, 107, 180, 58, 205, 216, 107, 55, 197, 6, 248, 250,
-			50, 240, 200, 80, 54, 2, 37, 30, 169, 2, 64, 81, 196, 22,
-			232, 73, 172, 166, 62, 48, 23, 27, 226, 195, 29, 183, 25, 109,
-			184, 126, 125, 99, 219, 141, 182, 199, 14, 1, 130, 187, 246, 152,
-			85, 61, 6, 5, 239, 171, 114, 101, 44, 54, 231, 215, 31, 184,
-			209, 54, 187, 77, 143, 32, 22, 121, 91, 200, 70, 109, 91, 212,
-			94, 221, 232, 196, 141, 155, 99, 199, 211, 237, 35, 133, 242, 30,
-			130, 121, 40, 178, 30, 55, 110, 178, 85, 58, 4, 131, 209, 242,
-			94, 23, 27, 141, 32, 196, 53, 116, 120, 31, 213, 148, 226, 96,
-			105, 89, 85, 120, 20, 212, 197, 237, 204, 234, 74, 185, 188, 80,
-			29, 212, 88, 238, 5, 33, 8, 212, 86, 96, 24, 172, 34, 172,
-			182, 2, 205, 222, 167, 232, 104, 173, 182, 161, 46, 72, 215, 145,
-			28, 17, 134, 66, 37, 204, 170, 213, 238, 203, 2, 74, 198, 35,
-			118, 139, 30, 78, 152, 149, 174, 56, 178, 167, 151, 189, 85, 159,
-			162, 163, 237, 221, 189, 21, 89, 87, 139, 237, 221, 222, 106, 231,
-			113, 151, 37, 20, 120, 231, 5, 134, 25, 153, 210, 169, 12, 86,
-			162, 249, 90, 109, 67, 248, 238, 102, 83, 108, 184, 161, 240, 221,
-			8, 67, 103, 114, 183, 157, 56, 236, 136, 234, 112, 173, 86, 198,
-			204, 57, 204, 99, 151, 232, 72, 176, 249, 74, 77, 10, 214, 70,
-			59, 20, 13, 239, 181, 177, 115, 200, 165, 131, 144, 129, 98, 181,
-			130, 201, 108, 130, 230, 107, 209, 182, 27, 182, 81, 179, 70, 109,
-			183, 38, 198, 206, 203, 162, 50, 125, 73, 39, 131, 96, 71, 59,
-			94, 35, 214, 24, 47, 74, 193, 198, 52, 133, 109, 156, 230, 219,
-			219, 237, 238, 134, 199, 101, 240, 92, 123, 187, 157, 110, 247, 44,
-			61, 0, 37, 147, 70, 39, 164, 253, 213, 222, 78, 181, 120, 141,
-			30, 129, 66, 45, 17, 187, 117, 55, 118, 83, 165, 39, 177, 244,
-			161, 246, 118, 251, 145, 202, 236, 162, 51, 236, 108, 238, 26, 249,
-			152, 146, 116, 66, 154, 150, 144, 111, 219, 253, 248, 175, 230, 108,
-			21, 111, 211, 161, 180, 220, 179, 1, 42, 37, 63, 111, 129, 17,
-			52, 191, 188, 0, 230, 203, 139, 229, 188, 13, 102, 212, 98, 101,
-			173, 188, 81, 93, 95, 90, 171, 60, 42, 231, 73, 202, 176, 127,
-			232, 228, 46, 229, 47, 63, 116, 114, 23, 242, 23, 139, 223, 36,
-			116, 184, 219, 1, 103, 79, 211, 163, 122, 135, 45, 18, 241, 198,
-			142, 23, 226, 180, 108, 185, 114, 137, 52, 226, 119, 72, 149, 90,
-			21, 241, 115, 94, 168, 238, 182, 96, 139, 244, 180, 31, 108, 232,
-			203, 68, 54, 146, 189, 205, 13, 183, 86, 19, 81, 20, 200, 229,
-			208, 96, 57, 225, 7, 171, 170, 112, 178, 78, 204, 169, 162, 61,
-			210, 79, 158, 36, 253, 199, 233, 64, 203, 109, 111, 224, 169, 46,
-			90, 233, 185, 106, 174, 229, 182, 203, 0, 179, 103, 233, 133, 164,
-			232, 70, 83, 108, 185, 181, 221, 13, 52, 201, 113, 55, 8, 163,
-			242, 154, 94, 45, 150, 113, 152, 82, 211, 21, 147, 26, 139, 88,
-			225, 97, 20, 248, 104, 137, 207, 235, 210, 93, 2, 50, 244, 87,
-			66, 64, 186, 7, 217, 201, 103, 30, 58, 185, 76, 62, 251, 208,
-			201, 101, 243, 253, 15, 157, 92, 46, 63, 240, 208, 201, 13, 228,
-			105, 241, 231, 40, 29, 74, 59, 22, 224, 167, 213, 76, 12, 239,
-			240, 236, 217, 55, 117, 67, 74, 243, 176, 230, 222, 206, 74, 43,
-			190, 42, 107, 130, 189, 3, 211, 73, 212, 213, 103, 97, 10, 98,
-			247, 105, 246, 149, 8, 113, 103, 17, 247, 126, 59, 213, 41, 220,
-			15, 87, 17, 249, 192, 195, 213, 141, 165, 229, 234, 163, 185, 197,
-			170, 170, 206, 142, 81, 167, 233, 190, 190, 219, 189, 58, 99, 18,
-			43, 209, 131, 29, 95, 122, 229, 48, 198, 80, 234, 96, 186, 212,
-			112, 146, 187, 8, 229, 223, 166, 92, 29, 163, 206, 142, 112, 95,
-			237, 94, 67, 49, 137, 141, 211, 33, 188, 121, 125, 35, 20, 117,
-			183, 22, 119, 175, 28, 131, 152, 85, 197, 28, 246, 126, 58, 0,
-			99, 228, 227, 24, 143, 32, 11, 166, 222, 156, 5, 106, 136, 117,
-			165, 106, 82, 159, 61, 160, 253, 50, 66, 41, 26, 27, 229, 100,
-			124, 120, 159, 221, 176, 125, 80, 173, 97, 21, 244, 137, 117, 117,
-			246, 28, 205, 171, 77, 215, 13, 229, 208, 202, 240, 218, 193, 217,
-			201, 55, 71, 169, 246, 108, 23, 100, 165, 234, 65, 209, 5, 119,
-			207, 139, 195, 127, 37, 230, 69, 225, 69, 58, 220, 77, 117, 122,
-			111, 154, 188, 205, 189, 105, 240, 20, 180, 239, 4, 171, 133, 4,
-			138, 211, 52, 131, 211, 129, 81, 170, 38, 68, 190, 143, 229, 168,
-			51, 191, 92, 5, 197, 156, 167, 67, 50, 117, 99, 165, 82, 158,
-			47, 231, 237, 226, 83, 52, 43, 101, 28, 148, 182, 145, 242, 124,
-			159, 2, 21, 14, 75, 231, 174, 63, 186, 91, 174, 230, 237, 226,
-			58, 61, 216, 35, 23, 236, 48, 29, 169, 150, 215, 202, 75, 224,
-			150, 110, 172, 47, 189, 127, 105, 249, 185, 165, 124, 95, 119, 178,
-			94, 1, 44, 118, 136, 230, 147, 228, 213, 229, 245, 42, 82, 243,
-			189, 54, 205, 247, 10, 9, 59, 74, 71, 215, 230, 170, 247, 203,
-			107, 27, 210, 213, 54, 168, 15, 209, 124, 58, 227, 94, 5, 119,
-			18, 78, 211, 227, 233, 212, 242, 243, 107, 229, 165, 85, 108, 124,
-			110, 233, 62, 44, 71, 61, 248, 180, 115, 79, 128, 212, 110, 124,
-			229, 197, 133, 188, 211, 155, 188, 188, 84, 94, 190, 151, 207, 244,
-			182, 142, 14, 127, 150, 21, 232, 145, 222, 212, 141, 242, 210, 90,
-			245, 133, 124, 127, 111, 195, 234, 30, 231, 124, 142, 29, 161, 172,
-			155, 162, 181, 7, 203, 11, 249, 129, 253, 52, 40, 203, 143, 22,
-			255, 145, 69, 135, 210, 206, 119, 151, 144, 91, 127, 37, 132, 60,
-			189, 117, 247, 155, 54, 29, 76, 121, 225, 248, 201, 3, 134, 243,
-			187, 77, 207, 141, 148, 126, 150, 183, 235, 204, 65, 202, 219, 213,
-			135, 111, 127, 41, 205, 126, 219, 75, 105, 255, 95, 53, 110, 202,
-			69, 180, 248, 55, 108, 154, 239, 245, 203, 123, 248, 102, 61, 137,
-			111, 233, 254, 217, 239, 164, 127, 189, 171, 12, 121, 226, 42, 243,
-			151, 34, 87, 255, 167, 69, 135, 187, 253, 253, 174, 174, 21, 223,
-			73, 215, 186, 89, 119, 230, 73, 172, 251, 75, 233, 215, 223, 37,
-			244, 64, 215, 246, 192, 219, 165, 238, 195, 116, 196, 171, 139, 86,
-			59, 136, 133, 95, 219, 221, 104, 138, 199, 162, 137, 108, 24, 222,
-			231, 0, 174, 171, 133, 82, 37, 169, 183, 8, 213, 110, 143, 86,
-			22, 202, 143, 86, 150, 215, 202, 75, 243, 47, 104, 149, 91, 205,
-			123, 61, 197, 186, 24, 126, 246, 175, 196, 92, 41, 174, 208, 124,
-			111, 111, 64, 243, 238, 211, 159, 124, 31, 27, 165, 7, 151, 150,
-			55, 86, 43, 11, 229, 141, 242, 189, 123, 229, 249, 181, 85, 185,
-			23, 109, 74, 175, 229, 237, 244, 216, 252, 8, 161, 163, 251, 80,
-			194, 230, 212, 46, 146, 220, 216, 218, 107, 81, 237, 83, 167, 4,
-			14, 224, 138, 27, 198, 106, 211, 105, 130, 230, 213, 7, 160, 158,
-			8, 213, 30, 191, 220, 90, 58, 152, 164, 203, 109, 254, 73, 202,
-			218, 65, 228, 197, 222, 99, 177, 225, 249, 250, 64, 192, 225, 214,
-			184, 83, 205, 235, 156, 138, 31, 155, 210, 250, 142, 184, 84, 233,
-			12, 126, 229, 150, 215, 57, 166, 244, 25, 58, 84, 15, 58, 224,
-			184, 203, 114, 160, 59, 173, 234, 160, 76, 51, 69, 212, 206, 74,
-			114, 18, 49, 84, 29, 148, 105, 178, 200, 69, 122, 208, 221, 218,
-			10, 1, 185, 70, 36, 247, 138, 134, 77, 50, 22, 44, 60, 164,
-			57, 205, 7, 112, 156, 128, 19, 27, 109, 185, 1, 106, 143, 15,
-			84, 115, 190, 206, 60, 67, 135, 188, 104, 35, 57, 41, 183, 185,
-			61, 158, 171, 14, 122, 145, 57, 56, 43, 126, 47, 165, 52, 17,
-			54, 246, 61, 22, 29, 150, 43, 129, 188, 25, 167, 166, 253, 137,
-			125, 54, 115, 76, 45, 105, 93, 174, 168, 10, 119, 111, 125, 210,
-			178, 126, 216, 114, 126, 216, 178, 126, 194, 58, 192, 114, 229, 231,
-			87, 22, 43, 243, 149, 181, 177, 47, 247, 35, 140, 239, 87, 0,
-			252, 149, 254, 238, 252, 175, 246, 87, 15, 52, 210, 152, 88, 35,
-			125, 188, 110, 63, 201, 247, 72, 232, 40, 171, 67, 245, 187, 103,
-			145, 132, 44, 146, 48, 200, 178, 243, 139, 203, 171, 229, 5, 36,
-			96, 128, 57, 203, 43, 229, 165, 177, 175, 244, 167, 206, 224, 127,
-			192, 162, 71, 245, 225, 155, 90, 8, 241, 142, 81, 207, 223, 82,
-			198, 229, 149, 55, 107, 182, 170, 170, 34, 27, 202, 170, 226, 221,
-			139, 123, 216, 48, 183, 180, 160, 168, 24, 100, 217, 149, 185, 249,
-			247, 151, 23, 128, 142, 195, 225, 126, 245, 217, 107, 244, 96, 39,
-			110, 220, 4, 73, 240, 234, 242, 28, 212, 121, 210, 1, 90, 66,
-			203, 122, 220, 184, 249, 172, 169, 161, 24, 33, 137, 24, 96, 206,
-			210, 242, 82, 89, 19, 128, 103, 134, 47, 0, 1, 195, 157, 174,
-			74, 236, 53, 154, 215, 251, 7, 134, 13, 153, 39, 157, 254, 37,
-			77, 171, 93, 8, 195, 0, 158, 106, 251, 16, 59, 184, 88, 94,
-			186, 191, 246, 96, 99, 165, 90, 198, 67, 156, 177, 47, 247, 87,
-			15, 182, 186, 171, 176, 191, 70, 7, 165, 49, 34, 119, 43, 164,
-			187, 185, 119, 203, 59, 213, 40, 218, 34, 88, 250, 238, 85, 108,
-			143, 232, 113, 63, 202, 216, 98, 249, 254, 220, 252, 11, 27, 119,
-			203, 171, 107, 160, 170, 150, 171, 82, 8, 41, 203, 204, 45, 46,
-			46, 63, 7, 125, 167, 175, 24, 4, 197, 15, 210, 3, 93, 146,
-			12, 134, 41, 26, 180, 64, 244, 106, 121, 105, 62, 109, 72, 15,
-			81, 35, 185, 121, 11, 32, 45, 215, 121, 27, 52, 164, 106, 218,
-			156, 36, 145, 226, 13, 154, 211, 242, 9, 230, 49, 90, 185, 61,
-			198, 121, 142, 162, 112, 230, 45, 112, 69, 164, 208, 230, 237, 226,
-			179, 244, 240, 190, 18, 198, 206, 210, 211, 250, 244, 74, 26, 222,
-			27, 229, 165, 249, 229, 5, 112, 85, 18, 156, 148, 42, 81, 147,
-			84, 106, 49, 204, 219, 197, 121, 58, 220, 45, 45, 236, 56, 61,
-			186, 190, 118, 239, 230, 198, 179, 115, 139, 149, 133, 185, 30, 167,
-			132, 82, 37, 50, 121, 27, 8, 5, 81, 202, 147, 226, 42, 61,
-			216, 51, 238, 236, 4, 29, 83, 254, 193, 126, 244, 32, 111, 186,
-			36, 65, 122, 74, 11, 101, 245, 164, 75, 222, 46, 62, 160, 52,
-			25, 87, 88, 136, 30, 174, 46, 47, 109, 220, 3, 55, 107, 45,
-			133, 106, 128, 202, 113, 204, 91, 224, 13, 236, 29, 236, 188, 125,
-			41, 11, 203, 208, 215, 250, 47, 101, 115, 95, 235, 207, 127, 29,
-			254, 126, 189, 63, 255, 31, 224, 239, 247, 44, 229, 191, 127, 233,
-			82, 54, 247, 253, 75, 249, 191, 179, 244, 48, 155, 251, 74, 127,
-			254, 171, 253, 197, 63, 183, 41, 75, 164, 203, 120, 196, 207, 211,
-			156, 113, 177, 101, 180, 222, 211, 111, 34, 148, 186, 90, 42, 169,
-			199, 229, 54, 216, 216, 28, 61, 216, 242, 124, 175, 213, 105, 109,
-			104, 119, 214, 121, 11, 119, 118, 88, 85, 80, 48, 162, 144, 95,
-			205, 24, 20, 153, 183, 68, 33, 43, 40, 184, 240, 9, 139, 142,
-			61, 137, 216, 111, 203, 211, 254, 118, 237, 229, 226, 79, 217, 116,
-			184, 59, 90, 141, 45, 208, 92, 51, 80, 145, 32, 146, 249, 227,
-			111, 17, 224, 86, 90, 84, 229, 171, 166, 102, 225, 243, 22, 205,
-			233, 100, 118, 132, 58, 109, 55, 222, 70, 116, 153, 187, 118, 222,
-			170, 34, 12, 233, 81, 219, 149, 81, 48, 42, 29, 96, 176, 51,
-			154, 194, 173, 227, 193, 136, 252, 8, 61, 210, 118, 134, 74, 159,
-			87, 201, 236, 50, 29, 137, 67, 215, 107, 118, 149, 149, 159, 193,
-			231, 117, 134, 41, 124, 155, 30, 211, 120, 235, 34, 118, 107, 219,
-			162, 158, 84, 202, 226, 1, 232, 81, 85, 96, 65, 229, 235, 186,
-			197, 47, 216, 116, 68, 31, 229, 212, 13, 179, 30, 81, 234, 250,
-			126, 16, 167, 217, 181, 215, 180, 218, 83, 175, 52, 103, 42, 85,
-			83, 8, 10, 255, 193, 162, 52, 201, 122, 34, 223, 78, 211, 65,
-			21, 139, 136, 1, 173, 114, 123, 133, 202, 164, 123, 94, 19, 207,
-			104, 55, 197, 150, 231, 171, 224, 18, 9, 232, 51, 90, 39, 9,
-			190, 170, 210, 92, 36, 90, 174, 31, 123, 53, 37, 196, 123, 143,
-			151, 223, 140, 248, 210, 170, 170, 93, 53, 120, 138, 227, 52, 167,
-			83, 141, 226, 234, 99, 253, 148, 172, 150, 65, 117, 163, 22, 169,
-			204, 173, 230, 237, 75, 63, 96, 211, 126, 61, 171, 70, 233, 193,
-			178, 252, 162, 180, 75, 121, 13, 235, 196, 149, 234, 242, 218, 242,
-			108, 254, 203, 253, 123, 18, 175, 230, 191, 210, 207, 70, 232, 144,
-			78, 156, 157, 153, 189, 154, 255, 106, 111, 210, 181, 252, 215, 112,
-			119, 67, 39, 93, 217, 88, 3, 205, 181, 188, 180, 248, 66, 222,
-			74, 103, 204, 166, 50, 108, 118, 146, 30, 213, 25, 183, 110, 221,
-			186, 117, 35, 149, 249, 247, 191, 47, 219, 155, 125, 51, 149, 253,
-			99, 123, 179, 111, 165, 178, 255, 193, 247, 101, 217, 40, 29, 212,
-			217, 143, 230, 158, 207, 127, 235, 91, 223, 250, 86, 255, 221, 239,
-			220, 255, 173, 195, 124, 207, 161, 112, 244, 192, 122, 113, 234, 45,
-			95, 59, 76, 78, 19, 186, 30, 58, 44, 244, 62, 116, 88, 21,
-			141, 166, 192, 11, 156, 31, 254, 241, 255, 76, 104, 63, 203, 92,
-			232, 251, 231, 3, 22, 253, 217, 131, 248, 81, 246, 133, 119, 95,
-			57, 124, 247, 149, 195, 119, 95, 57, 124, 247, 149, 195, 119, 95,
-			57, 124, 247, 149, 195, 111, 255, 149, 195, 217, 223, 181, 213, 5,
-			30, 183, 249, 171, 194, 143, 3, 255, 125, 137, 98, 231, 227, 239,
-			199, 36, 254, 172, 27, 214, 221, 9, 202, 249, 93, 125, 75, 114,
-			16, 122, 91, 158, 239, 54, 247, 46, 64, 117, 17, 121, 91, 62,
-			190, 176, 194, 87, 93, 255, 21, 119, 151, 223, 223, 22, 45, 119,
-			199, 141, 39, 249, 67, 209, 104, 240, 5, 225, 250, 147, 201, 227,
-			40, 145, 126, 164, 46, 253, 56, 127, 114, 241, 135, 92, 47, 55,
-			133, 190, 120, 207, 243, 149, 130, 107, 4, 29, 31, 63, 233, 151,
-			43, 50, 150, 142, 74, 48, 1, 112, 191, 32, 157, 172, 31, 253,
-			136, 67, 215, 143, 228, 29, 98, 117, 47, 20, 181, 184, 185, 43,
-			63, 171, 222, 231, 75, 34, 106, 180, 136, 235, 239, 42, 157, 152,
-			190, 184, 109, 28, 159, 110, 214, 101, 66, 105, 162, 226, 189, 114,
-			94, 171, 29, 132, 49, 94, 107, 39, 175, 190, 24, 55, 111, 72,
-			94, 234, 91, 208, 87, 95, 192, 79, 153, 120, 57, 121, 46, 242,
-			178, 121, 46, 114, 178, 239, 138, 126, 46, 18, 126, 202, 196, 169,
-			190, 27, 250, 17, 72, 248, 41, 19, 75, 201, 115, 145, 37, 243,
-			92, 228, 116, 242, 88, 229, 180, 121, 172, 242, 106, 223, 9, 250,
-			215, 169, 157, 27, 192, 159, 133, 152, 247, 126, 181, 37, 23, 158,
-			77, 249, 96, 79, 203, 123, 93, 61, 79, 24, 181, 133, 168, 243,
-			77, 81, 115, 97, 9, 15, 141, 97, 50, 133, 151, 102, 83, 238,
-			54, 183, 130, 208, 139, 183, 91, 17, 175, 7, 254, 197, 88, 94,
-			178, 88, 239, 224, 163, 75, 155, 65, 16, 71, 113, 40, 159, 111,
-			42, 81, 250, 138, 188, 24, 227, 70, 223, 45, 171, 240, 33, 28,
-			119, 109, 62, 224, 205, 46, 94, 19, 223, 12, 241, 185, 12, 140,
-			219, 51, 54, 171, 194, 92, 164, 167, 95, 236, 41, 169, 235, 23,
-			81, 0, 184, 23, 203, 23, 122, 162, 212, 253, 23, 55, 114, 199,
-			240, 171, 55, 188, 255, 226, 166, 61, 174, 175, 172, 112, 0, 74,
-			223, 80, 125, 115, 240, 84, 234, 114, 139, 155, 167, 207, 166, 46,
-			183, 184, 121, 225, 34, 157, 162, 118, 166, 143, 57, 79, 247, 189,
-			96, 21, 206, 200, 103, 147, 58, 205, 38, 143, 4, 174, 190, 248,
-			144, 53, 87, 14, 160, 106, 63, 3, 136, 158, 206, 12, 209, 219,
-			212, 201, 96, 251, 239, 177, 143, 20, 166, 248, 28, 111, 55, 221,
-			154, 216, 14, 154, 117, 17, 202, 91, 146, 125, 222, 241, 187, 80,
-			200, 139, 12, 228, 151, 132, 25, 73, 210, 123, 228, 135, 240, 25,
-			121, 239, 200, 123, 14, 29, 166, 127, 211, 70, 196, 22, 35, 11,
-			246, 209, 194, 55, 45, 46, 79, 168, 184, 252, 128, 137, 23, 53,
-			57, 197, 18, 7, 130, 35, 224, 183, 152, 194, 247, 20, 117, 214,
-			36, 223, 236, 192, 176, 227, 227, 32, 173, 78, 109, 155, 55, 189,
-			87, 213, 42, 239, 249, 181, 56, 233, 146, 70, 81, 115, 97, 152,
-			83, 43, 117, 250, 98, 106, 77, 125, 208, 224, 233, 145, 145, 173,
-			40, 55, 183, 107, 38, 203, 247, 73, 58, 237, 118, 115, 87, 214,
-			152, 157, 86, 15, 46, 233, 77, 2, 96, 16, 77, 189, 211, 9,
-			146, 226, 198, 222, 166, 215, 244, 226, 93, 195, 32, 11, 153, 144,
-			215, 144, 205, 200, 194, 40, 126, 176, 156, 193, 171, 66, 202, 246,
-			81, 149, 5, 220, 42, 155, 130, 152, 55, 122, 132, 254, 130, 133,
-			37, 9, 35, 15, 237, 35, 133, 31, 183, 120, 89, 219, 82, 104,
-			99, 34, 119, 54, 5, 222, 39, 210, 20, 32, 249, 146, 29, 137,
-			149, 161, 94, 95, 113, 241, 74, 136, 77, 47, 14, 193, 160, 195,
-			43, 114, 212, 99, 81, 126, 128, 76, 83, 31, 8, 130, 34, 149,
-			76, 137, 183, 197, 174, 188, 184, 82, 189, 90, 181, 41, 95, 11,
-			154, 194, 203, 233, 213, 44, 20, 110, 180, 75, 101, 207, 67, 47,
-			66, 219, 69, 210, 79, 44, 160, 120, 88, 67, 54, 35, 15, 71,
-			14, 171, 110, 59, 140, 188, 223, 62, 162, 178, 28, 11, 32, 93,
-			208, 177, 25, 121, 255, 200, 97, 250, 6, 22, 196, 47, 191, 11,
-			133, 54, 95, 73, 137, 165, 30, 119, 121, 47, 144, 136, 240, 233,
-			30, 61, 130, 233, 135, 104, 148, 88, 116, 117, 147, 74, 225, 8,
-			66, 224, 215, 174, 90, 54, 58, 113, 228, 213, 209, 50, 4, 116,
-			145, 233, 67, 198, 130, 246, 15, 107, 200, 102, 100, 121, 236, 152,
-			234, 67, 150, 145, 21, 187, 160, 178, 178, 22, 64, 186, 96, 214,
-			102, 100, 197, 20, 236, 103, 228, 59, 228, 183, 205, 0, 88, 0,
-			29, 211, 144, 205, 200, 119, 156, 56, 163, 10, 230, 24, 169, 154,
-			130, 160, 119, 171, 166, 96, 206, 102, 164, 106, 10, 14, 48, 178,
-			106, 10, 14, 88, 0, 233, 130, 3, 54, 35, 171, 39, 206, 208,
-			255, 85, 74, 13, 101, 228, 121, 251, 120, 225, 103, 173, 46, 6,
-			42, 237, 9, 83, 3, 88, 215, 241, 55, 97, 209, 18, 117, 51,
-			71, 64, 234, 131, 48, 70, 14, 130, 5, 43, 25, 24, 248, 205,
-			93, 202, 197, 99, 17, 154, 57, 182, 185, 203, 219, 205, 206, 150,
-			167, 165, 17, 84, 164, 120, 173, 173, 158, 232, 242, 177, 172, 186,
-			152, 10, 23, 44, 117, 47, 34, 190, 97, 164, 26, 225, 46, 247,
-			197, 142, 110, 218, 176, 158, 90, 64, 250, 1, 13, 217, 140, 60,
-			159, 47, 208, 105, 121, 65, 204, 7, 251, 190, 211, 42, 156, 229,
-			11, 106, 17, 150, 111, 0, 183, 218, 77, 17, 139, 244, 2, 155,
-			186, 244, 229, 131, 185, 227, 120, 7, 16, 94, 250, 242, 146, 125,
-			188, 56, 41, 151, 96, 176, 250, 39, 187, 158, 251, 13, 3, 121,
-			59, 174, 114, 191, 226, 80, 136, 244, 69, 48, 47, 217, 233, 75,
-			72, 245, 215, 222, 242, 34, 152, 151, 216, 17, 154, 92, 4, 243,
-			210, 177, 2, 189, 164, 239, 129, 249, 144, 125, 170, 120, 146, 227,
-			226, 92, 108, 4, 65, 113, 18, 255, 148, 54, 221, 176, 56, 201,
-			69, 92, 75, 110, 101, 73, 125, 12, 46, 239, 104, 249, 144, 105,
-			195, 194, 143, 193, 143, 209, 228, 142, 150, 15, 157, 56, 137, 151,
-			104, 224, 125, 164, 174, 125, 166, 112, 145, 47, 105, 63, 70, 45,
-			60, 184, 236, 203, 161, 50, 230, 75, 114, 241, 168, 3, 213, 210,
-			215, 144, 186, 166, 53, 32, 219, 101, 39, 52, 68, 24, 113, 79,
-			115, 250, 29, 250, 26, 210, 154, 61, 94, 88, 224, 248, 37, 142,
-			108, 15, 239, 179, 193, 175, 143, 147, 70, 21, 13, 202, 173, 51,
-			223, 26, 75, 207, 16, 157, 199, 228, 146, 82, 7, 112, 26, 40,
-			195, 72, 109, 48, 175, 33, 139, 145, 218, 72, 81, 67, 208, 250,
-			249, 139, 244, 117, 36, 197, 97, 164, 97, 95, 40, 180, 122, 73,
-			217, 17, 238, 171, 111, 143, 144, 146, 124, 224, 78, 58, 133, 83,
-			184, 53, 1, 54, 100, 203, 219, 146, 223, 157, 161, 224, 151, 248,
-			66, 160, 47, 128, 51, 52, 59, 216, 184, 129, 50, 140, 52, 12,
-			205, 160, 214, 26, 35, 92, 67, 132, 145, 198, 217, 243, 244, 58,
-			210, 156, 97, 196, 179, 39, 11, 19, 184, 175, 17, 7, 237, 41,
-			60, 222, 238, 90, 125, 210, 214, 166, 105, 47, 227, 64, 69, 3,
-			101, 25, 241, 6, 11, 26, 178, 24, 241, 142, 95, 212, 16, 97,
-			196, 187, 116, 25, 237, 11, 11, 116, 213, 43, 246, 148, 202, 202,
-			58, 0, 105, 36, 89, 200, 83, 246, 133, 133, 122, 236, 149, 211,
-			227, 26, 34, 140, 188, 114, 121, 82, 33, 233, 103, 228, 85, 187,
-			164, 178, 250, 29, 128, 52, 146, 254, 44, 35, 175, 14, 158, 209,
-			144, 197, 200, 171, 197, 9, 13, 17, 70, 94, 157, 156, 82, 72,
-			114, 140, 52, 13, 146, 156, 3, 144, 70, 146, 203, 50, 210, 28,
-			212, 87, 41, 129, 254, 107, 114, 141, 36, 71, 24, 105, 26, 36,
-			3, 140, 248, 234, 110, 10, 203, 30, 112, 0, 210, 72, 6, 178,
-			140, 248, 131, 122, 26, 130, 110, 244, 143, 234, 206, 13, 16, 70,
-			252, 51, 69, 250, 77, 121, 59, 19, 101, 36, 182, 167, 11, 95,
-			182, 164, 146, 195, 227, 197, 228, 114, 41, 253, 241, 215, 62, 215,
-			34, 131, 8, 25, 79, 35, 181, 75, 147, 186, 231, 47, 114, 27,
-			162, 185, 203, 229, 45, 144, 114, 32, 133, 31, 131, 22, 148, 205,
-			104, 3, 125, 219, 13, 91, 248, 74, 70, 199, 135, 149, 149, 242,
-			70, 199, 87, 239, 93, 122, 177, 185, 32, 48, 49, 136, 35, 62,
-			53, 133, 73, 105, 170, 146, 87, 187, 65, 80, 209, 199, 81, 59,
-			98, 45, 124, 250, 52, 8, 154, 201, 173, 79, 212, 129, 110, 27,
-			40, 203, 72, 60, 168, 53, 10, 232, 220, 184, 112, 73, 67, 132,
-			145, 120, 170, 68, 127, 82, 178, 107, 144, 145, 215, 237, 83, 133,
-			31, 146, 151, 89, 41, 59, 78, 79, 251, 148, 202, 149, 217, 82,
-			183, 139, 122, 218, 250, 40, 74, 43, 10, 116, 159, 52, 164, 138,
-			210, 223, 74, 25, 131, 148, 242, 74, 131, 191, 172, 18, 94, 198,
-			171, 196, 229, 75, 48, 106, 75, 73, 94, 162, 165, 29, 130, 174,
-			154, 146, 234, 65, 7, 232, 52, 80, 134, 145, 215, 141, 70, 27,
-			180, 24, 121, 157, 233, 59, 174, 6, 9, 35, 175, 31, 63, 169,
-			46, 138, 30, 98, 228, 13, 155, 23, 164, 237, 148, 178, 21, 123,
-			187, 167, 42, 15, 57, 80, 222, 64, 89, 70, 222, 24, 100, 26,
-			178, 24, 121, 99, 84, 207, 203, 33, 194, 200, 27, 39, 79, 211,
-			9, 188, 74, 43, 251, 55, 172, 190, 31, 183, 172, 194, 241, 174,
-			197, 75, 95, 209, 30, 239, 182, 161, 137, 65, 121, 119, 150, 243,
-			55, 172, 28, 216, 134, 242, 242, 44, 231, 111, 90, 246, 113, 124,
-			164, 206, 134, 133, 8, 64, 170, 193, 12, 128, 131, 35, 26, 180,
-			0, 100, 71, 52, 72, 0, 60, 86, 80, 152, 240, 5, 67, 251,
-			146, 202, 180, 28, 4, 53, 38, 43, 11, 224, 224, 105, 13, 98,
-			97, 126, 94, 131, 4, 192, 241, 9, 133, 201, 102, 206, 199, 45,
-			187, 164, 50, 109, 7, 65, 141, 201, 206, 2, 104, 48, 65, 179,
-			31, 183, 248, 132, 6, 9, 128, 147, 83, 10, 19, 97, 206, 39,
-			44, 251, 178, 202, 36, 14, 130, 26, 19, 201, 2, 56, 88, 208,
-			160, 5, 224, 241, 11, 26, 196, 186, 19, 151, 20, 38, 7, 31,
-			60, 156, 82, 153, 142, 4, 53, 38, 39, 11, 224, 224, 41, 13,
-			226, 115, 136, 167, 199, 53, 136, 207, 33, 94, 158, 164, 195, 128,
-			137, 244, 177, 236, 223, 178, 236, 191, 109, 17, 153, 77, 128, 171,
-			127, 203, 162, 99, 244, 4, 205, 2, 8, 67, 242, 61, 150, 115,
-			178, 56, 196, 43, 169, 55, 6, 15, 210, 126, 153, 235, 96, 246,
-			80, 146, 144, 129, 132, 3, 44, 73, 176, 32, 97, 116, 44, 73,
-			32, 144, 112, 252, 132, 105, 193, 98, 206, 247, 90, 206, 241, 226,
-			16, 47, 191, 182, 183, 5, 24, 186, 239, 77, 183, 96, 101, 32,
-			33, 213, 130, 133, 8, 70, 143, 36, 9, 4, 18, 142, 21, 232,
-			176, 106, 193, 102, 206, 167, 44, 103, 218, 20, 0, 254, 125, 42,
-			141, 19, 134, 241, 83, 214, 129, 98, 146, 128, 207, 37, 158, 189,
-			148, 36, 224, 131, 137, 83, 37, 53, 0, 25, 230, 124, 127, 34,
-			20, 25, 7, 65, 61, 0, 153, 44, 128, 131, 199, 52, 104, 1,
-			88, 208, 66, 145, 33, 0, 26, 161, 200, 50, 231, 7, 44, 123,
-			90, 101, 102, 29, 4, 53, 166, 44, 230, 26, 241, 202, 90, 0,
-			114, 45, 213, 89, 2, 160, 161, 169, 159, 57, 63, 104, 217, 90,
-			98, 250, 29, 4, 53, 166, 254, 44, 128, 134, 166, 126, 11, 192,
-			194, 25, 13, 18, 0, 207, 157, 167, 255, 200, 66, 169, 176, 88,
-			246, 239, 90, 246, 223, 179, 72, 225, 71, 45, 142, 159, 57, 130,
-			146, 208, 103, 18, 60, 118, 183, 244, 251, 1, 37, 94, 221, 39,
-			213, 220, 35, 155, 88, 208, 84, 174, 9, 120, 7, 170, 9, 9,
-			226, 161, 52, 148, 149, 197, 18, 185, 45, 179, 19, 149, 66, 172,
-			10, 181, 220, 93, 60, 1, 224, 193, 99, 17, 54, 221, 182, 122,
-			234, 210, 38, 32, 0, 127, 215, 162, 71, 149, 68, 129, 241, 235,
-			252, 200, 147, 100, 22, 237, 91, 200, 30, 74, 18, 50, 144, 96,
-			36, 10, 173, 92, 231, 71, 18, 153, 69, 67, 215, 249, 145, 68,
-			102, 193, 214, 117, 126, 244, 73, 50, 139, 214, 45, 100, 39, 45,
-			128, 204, 254, 104, 186, 5, 11, 17, 24, 153, 69, 51, 215, 249,
-			209, 68, 125, 229, 152, 243, 247, 45, 123, 82, 13, 79, 206, 65,
-			80, 143, 101, 46, 11, 224, 224, 152, 6, 45, 0, 143, 93, 212,
-			32, 1, 240, 210, 101, 250, 49, 75, 221, 59, 231, 252, 119, 150,
-			125, 190, 208, 73, 24, 42, 87, 103, 60, 10, 208, 183, 155, 238,
-			29, 48, 61, 94, 251, 141, 13, 229, 115, 124, 203, 123, 44, 124,
-			121, 214, 128, 119, 254, 226, 162, 44, 18, 33, 9, 252, 154, 126,
-			141, 212, 6, 243, 5, 168, 48, 96, 6, 64, 163, 202, 7, 44,
-			0, 153, 22, 243, 1, 2, 96, 241, 28, 29, 162, 160, 174, 178,
-			255, 208, 234, 251, 55, 150, 133, 235, 5, 168, 197, 127, 104, 229,
-			78, 210, 101, 234, 56, 4, 6, 250, 39, 45, 251, 118, 97, 78,
-			238, 101, 169, 199, 161, 227, 32, 20, 218, 180, 193, 61, 169, 122,
-			32, 34, 255, 98, 204, 67, 81, 11, 182, 124, 239, 117, 193, 183,
-			69, 40, 74, 120, 3, 175, 54, 207, 161, 105, 130, 178, 241, 147,
-			154, 78, 98, 247, 101, 1, 84, 170, 148, 160, 92, 252, 164, 117,
-			250, 170, 6, 9, 128, 215, 111, 161, 42, 37, 160, 74, 127, 202,
-			178, 255, 169, 82, 165, 4, 85, 233, 79, 89, 244, 48, 125, 63,
-			205, 2, 8, 212, 254, 140, 229, 156, 42, 220, 145, 171, 175, 153,
-			4, 234, 178, 81, 121, 119, 133, 58, 234, 81, 92, 239, 153, 41,
-			74, 198, 136, 210, 188, 63, 163, 101, 140, 40, 205, 251, 51, 90,
-			198, 136, 210, 188, 63, 99, 141, 30, 75, 18, 8, 36, 156, 56,
-			73, 95, 87, 4, 89, 204, 249, 57, 203, 41, 22, 94, 49, 187,
-			105, 187, 83, 31, 238, 184, 77, 252, 206, 197, 28, 35, 117, 211,
-			129, 82, 129, 47, 253, 133, 137, 133, 226, 114, 21, 38, 64, 121,
-			61, 192, 139, 162, 27, 97, 224, 199, 186, 54, 238, 211, 1, 186,
-			20, 253, 48, 71, 126, 46, 77, 63, 204, 145, 159, 179, 14, 140,
-			38, 9, 72, 222, 161, 147, 73, 2, 129, 4, 126, 134, 254, 184,
-			165, 58, 96, 51, 231, 23, 45, 231, 100, 225, 251, 173, 125, 123,
-			128, 143, 136, 191, 105, 55, 214, 125, 185, 219, 166, 191, 240, 43,
-			65, 149, 73, 158, 186, 142, 3, 83, 100, 71, 113, 19, 202, 116,
-			21, 123, 218, 8, 66, 179, 117, 78, 209, 204, 19, 126, 167, 21,
-			165, 250, 9, 107, 205, 47, 166, 251, 9, 107, 199, 47, 166, 251,
-			9, 195, 240, 139, 214, 161, 177, 36, 129, 64, 194, 241, 19, 244,
-			243, 186, 159, 132, 57, 255, 216, 114, 78, 23, 126, 217, 210, 111,
-			174, 76, 118, 61, 223, 174, 78, 97, 147, 247, 73, 204, 44, 220,
-			95, 142, 38, 37, 173, 174, 191, 219, 203, 146, 228, 90, 111, 133,
-			12, 119, 198, 26, 174, 7, 30, 156, 222, 36, 134, 153, 35, 95,
-			228, 160, 242, 17, 119, 55, 17, 222, 94, 124, 94, 196, 235, 162,
-			41, 228, 67, 29, 186, 135, 96, 251, 252, 227, 52, 83, 72, 6,
-			18, 14, 140, 36, 9, 22, 36, 176, 66, 146, 128, 60, 56, 121,
-			138, 254, 45, 205, 20, 135, 57, 191, 4, 76, 121, 253, 77, 121,
-			146, 208, 163, 133, 85, 190, 149, 87, 231, 46, 176, 169, 173, 222,
-			16, 225, 203, 241, 182, 8, 119, 188, 72, 188, 117, 45, 243, 162,
-			126, 210, 33, 71, 18, 147, 116, 200, 201, 64, 66, 170, 67, 96,
-			134, 253, 82, 186, 67, 96, 136, 253, 18, 116, 232, 156, 212, 22,
-			3, 204, 249, 101, 203, 57, 88, 60, 172, 252, 41, 96, 221, 134,
-			38, 144, 162, 45, 3, 165, 250, 160, 216, 129, 97, 141, 102, 0,
-			102, 121, 119, 130, 173, 18, 254, 39, 11, 117, 164, 197, 156, 95,
-			181, 236, 123, 133, 159, 176, 212, 3, 247, 202, 241, 239, 68, 34,
-			140, 110, 243, 133, 101, 190, 180, 188, 198, 215, 87, 203, 37, 254,
-			156, 188, 78, 87, 201, 76, 59, 12, 106, 120, 105, 121, 131, 7,
-			109, 161, 98, 7, 112, 142, 39, 220, 73, 221, 183, 35, 165, 255,
-			53, 81, 235, 224, 78, 165, 217, 96, 168, 53, 133, 235, 119, 218,
-			17, 223, 20, 141, 0, 176, 235, 103, 224, 213, 30, 165, 38, 168,
-			185, 107, 180, 48, 232, 134, 95, 77, 180, 48, 152, 235, 191, 106,
-			13, 30, 209, 32, 246, 232, 104, 81, 131, 4, 192, 243, 23, 52,
-			152, 3, 240, 98, 89, 50, 204, 182, 114, 35, 0, 143, 47, 224,
-			3, 151, 4, 52, 198, 103, 44, 251, 92, 97, 154, 207, 249, 187,
-			122, 59, 53, 50, 163, 171, 23, 59, 189, 171, 156, 236, 215, 73,
-			236, 32, 116, 159, 73, 40, 3, 235, 237, 51, 214, 224, 97, 13,
-			90, 0, 30, 209, 203, 5, 76, 219, 207, 88, 103, 206, 210, 247,
-			65, 211, 78, 31, 203, 254, 11, 203, 254, 223, 45, 162, 158, 215,
-			76, 95, 2, 196, 163, 24, 223, 253, 239, 85, 83, 90, 235, 35,
-			66, 124, 151, 253, 95, 88, 253, 99, 116, 30, 250, 38, 31, 102,
-			255, 172, 229, 28, 42, 92, 149, 91, 44, 233, 170, 102, 135, 8,
-			81, 164, 196, 88, 206, 83, 45, 187, 234, 189, 244, 207, 90, 206,
-			193, 36, 193, 134, 4, 54, 42, 89, 40, 159, 76, 255, 156, 229,
-			140, 154, 2, 150, 76, 24, 78, 18, 108, 72, 24, 97, 244, 83,
-			82, 230, 8, 203, 254, 43, 203, 254, 45, 235, 78, 225, 59, 223,
-			162, 171, 170, 131, 124, 109, 121, 97, 249, 54, 111, 52, 189, 182,
-			62, 107, 116, 59, 77, 220, 94, 77, 221, 55, 132, 54, 5, 222,
-			161, 140, 87, 64, 43, 195, 144, 162, 208, 182, 220, 240, 85, 57,
-			61, 147, 251, 136, 204, 176, 129, 190, 249, 87, 201, 176, 129, 175,
-			245, 175, 172, 193, 19, 26, 180, 0, 60, 57, 161, 65, 2, 224,
-			228, 148, 6, 115, 204, 249, 45, 43, 123, 91, 131, 253, 0, 142,
-			28, 87, 242, 69, 64, 190, 126, 203, 58, 121, 139, 190, 8, 230,
-			73, 134, 57, 255, 218, 178, 143, 21, 30, 113, 249, 166, 72, 132,
-			178, 46, 165, 139, 215, 58, 81, 28, 180, 18, 195, 196, 223, 51,
-			90, 94, 148, 152, 191, 105, 227, 4, 140, 66, 146, 233, 3, 228,
-			7, 164, 217, 135, 103, 91, 0, 142, 104, 208, 6, 240, 240, 24,
-			189, 74, 65, 27, 101, 191, 104, 245, 253, 115, 219, 42, 156, 239,
-			242, 192, 19, 21, 143, 247, 112, 27, 115, 14, 109, 43, 208, 80,
-			95, 180, 114, 39, 208, 156, 113, 64, 92, 127, 215, 178, 255, 63,
-			101, 206, 56, 40, 124, 191, 107, 245, 15, 210, 85, 154, 117, 228,
-			113, 158, 243, 111, 65, 248, 230, 249, 76, 215, 146, 131, 231, 37,
-			97, 136, 207, 82, 240, 229, 176, 46, 87, 164, 29, 225, 133, 50,
-			111, 219, 3, 11, 13, 223, 255, 13, 133, 27, 201, 211, 58, 144,
-			36, 71, 9, 227, 191, 213, 194, 232, 40, 97, 252, 183, 90, 24,
-			213, 251, 253, 255, 78, 11, 163, 163, 132, 241, 223, 105, 97, 116,
-			148, 48, 254, 59, 16, 198, 29, 85, 195, 102, 206, 239, 91, 206,
-			104, 97, 11, 223, 78, 123, 209, 219, 122, 209, 221, 226, 24, 90,
-			142, 199, 72, 75, 230, 53, 126, 229, 188, 196, 238, 171, 130, 95,
-			153, 225, 155, 187, 177, 136, 74, 156, 175, 71, 130, 167, 238, 246,
-			225, 94, 131, 114, 243, 132, 127, 106, 231, 7, 76, 9, 212, 98,
-			154, 18, 160, 245, 247, 211, 164, 73, 82, 70, 152, 233, 12, 97,
-			206, 191, 183, 156, 67, 166, 0, 136, 226, 191, 79, 119, 159, 216,
-			144, 192, 70, 77, 103, 28, 230, 124, 249, 191, 88, 103, 174, 206,
-			190, 253, 206, 128, 120, 124, 57, 221, 25, 199, 134, 132, 84, 103,
-			50, 204, 249, 138, 229, 28, 54, 5, 192, 241, 253, 138, 229, 228,
-			147, 4, 27, 18, 70, 15, 153, 26, 89, 230, 124, 53, 93, 3,
-			28, 220, 175, 166, 107, 100, 109, 72, 72, 213, 232, 103, 206, 215,
-			44, 135, 153, 2, 224, 200, 126, 205, 114, 14, 36, 9, 54, 36,
-			228, 71, 76, 141, 28, 115, 190, 158, 102, 49, 184, 75, 95, 79,
-			179, 56, 103, 67, 2, 27, 165, 159, 177, 85, 149, 1, 230, 252,
-			17, 72, 246, 167, 109, 190, 230, 110, 77, 213, 5, 190, 178, 1,
-			218, 69, 127, 9, 83, 162, 28, 111, 235, 150, 38, 38, 218, 56,
-			250, 155, 46, 92, 7, 193, 149, 74, 118, 7, 221, 70, 44, 66,
-			222, 27, 72, 202, 31, 4, 59, 226, 177, 8, 39, 101, 28, 199,
-			85, 138, 111, 131, 8, 19, 18, 102, 78, 164, 162, 88, 189, 163,
-			141, 79, 157, 196, 129, 116, 116, 80, 79, 226, 195, 134, 124, 7,
-			183, 92, 113, 167, 84, 30, 121, 198, 161, 112, 99, 149, 169, 220,
-			56, 55, 50, 39, 220, 50, 165, 196, 121, 197, 55, 199, 172, 147,
-			251, 163, 163, 58, 122, 67, 222, 12, 82, 231, 143, 61, 23, 75,
-			190, 220, 251, 137, 198, 203, 122, 49, 77, 137, 12, 248, 117, 127,
-			148, 22, 153, 1, 27, 18, 70, 70, 233, 148, 226, 52, 101, 206,
-			55, 44, 231, 72, 241, 36, 95, 20, 254, 86, 188, 189, 63, 175,
-			77, 125, 106, 97, 249, 68, 62, 168, 13, 9, 163, 135, 233, 89,
-			133, 112, 144, 57, 223, 132, 161, 27, 229, 75, 98, 7, 84, 236,
-			99, 243, 230, 77, 130, 102, 208, 194, 82, 9, 93, 131, 54, 36,
-			140, 36, 74, 102, 136, 57, 255, 41, 45, 152, 67, 22, 38, 36,
-			66, 51, 100, 67, 2, 75, 4, 243, 0, 115, 254, 159, 180, 90,
-			58, 96, 97, 66, 34, 152, 7, 108, 72, 200, 39, 211, 101, 152,
-			57, 255, 175, 229, 28, 53, 5, 134, 45, 76, 24, 73, 18, 108,
-			72, 56, 116, 196, 212, 56, 200, 156, 63, 73, 215, 56, 104, 97,
-			66, 82, 227, 160, 13, 9, 135, 142, 208, 139, 170, 70, 158, 57,
-			127, 106, 57, 135, 139, 71, 97, 222, 71, 93, 234, 66, 70, 132,
-			232, 154, 121, 11, 75, 38, 29, 204, 219, 144, 192, 14, 25, 84,
-			35, 204, 249, 207, 111, 11, 213, 136, 133, 37, 19, 84, 35, 54,
-			36, 32, 175, 96, 97, 177, 88, 246, 207, 44, 251, 227, 182, 94,
-			88, 64, 129, 255, 153, 213, 63, 68, 47, 97, 75, 184, 125, 243,
-			45, 203, 57, 90, 40, 60, 113, 97, 209, 141, 201, 173, 154, 111,
-			37, 10, 193, 194, 245, 226, 91, 214, 97, 205, 52, 220, 170, 249,
-			168, 109, 152, 38, 183, 94, 62, 106, 167, 106, 192, 122, 241, 81,
-			251, 240, 17, 250, 57, 75, 85, 177, 153, 243, 93, 182, 115, 180,
-			240, 75, 150, 122, 255, 81, 189, 57, 132, 247, 9, 2, 73, 184,
-			217, 129, 159, 63, 75, 155, 113, 207, 212, 134, 169, 165, 194, 40,
-			64, 29, 232, 89, 70, 185, 23, 95, 140, 184, 120, 173, 221, 244,
-			106, 94, 44, 67, 46, 182, 189, 77, 16, 248, 253, 230, 227, 203,
-			221, 31, 195, 153, 57, 102, 166, 165, 14, 1, 217, 82, 254, 152,
-			12, 36, 241, 130, 176, 148, 176, 8, 247, 194, 211, 29, 150, 253,
-			59, 124, 4, 205, 10, 7, 216, 253, 9, 91, 109, 186, 59, 184,
-			175, 240, 9, 91, 153, 74, 14, 238, 42, 124, 194, 86, 59, 53,
-			14, 178, 251, 19, 182, 218, 116, 119, 112, 71, 225, 19, 182, 218,
-			181, 194, 205, 252, 79, 218, 246, 9, 149, 9, 86, 252, 39, 19,
-			76, 224, 223, 127, 210, 30, 204, 107, 16, 11, 143, 28, 213, 32,
-			1, 176, 112, 92, 97, 178, 153, 243, 221, 9, 77, 176, 232, 125,
-			119, 130, 9, 150, 141, 239, 78, 48, 65, 179, 223, 109, 143, 104,
-			154, 96, 77, 253, 110, 160, 9, 159, 39, 113, 0, 252, 62, 219,
-			62, 86, 248, 62, 233, 59, 235, 155, 28, 97, 24, 35, 161, 15,
-			92, 124, 33, 76, 132, 72, 36, 98, 24, 139, 6, 223, 12, 208,
-			21, 246, 84, 60, 175, 174, 41, 237, 205, 164, 174, 137, 219, 242,
-			209, 170, 53, 151, 8, 76, 242, 244, 101, 5, 60, 8, 121, 114,
-			83, 97, 73, 247, 5, 44, 211, 239, 75, 186, 6, 150, 233, 247,
-			217, 131, 7, 53, 104, 1, 152, 63, 164, 65, 236, 203, 209, 49,
-			250, 143, 109, 236, 154, 195, 156, 31, 180, 109, 94, 248, 105, 27,
-			93, 59, 125, 190, 162, 183, 33, 228, 19, 35, 138, 74, 47, 234,
-			138, 13, 134, 223, 120, 14, 131, 61, 213, 57, 148, 227, 237, 117,
-			145, 220, 5, 112, 249, 197, 210, 197, 73, 112, 216, 188, 168, 119,
-			111, 165, 196, 19, 143, 121, 146, 207, 95, 190, 60, 5, 6, 3,
-			143, 106, 65, 27, 93, 196, 176, 211, 84, 134, 132, 22, 209, 134,
-			167, 130, 162, 113, 201, 148, 111, 212, 202, 135, 123, 228, 123, 63,
-			81, 172, 118, 108, 162, 100, 3, 204, 139, 146, 135, 125, 37, 211,
-			221, 176, 182, 45, 234, 56, 59, 252, 244, 70, 89, 219, 13, 241,
-			248, 44, 240, 57, 172, 202, 129, 116, 46, 130, 32, 166, 220, 220,
-			232, 52, 97, 184, 238, 72, 206, 25, 48, 3, 160, 17, 114, 176,
-			116, 126, 208, 102, 90, 250, 192, 81, 255, 65, 251, 212, 105, 250,
-			157, 200, 244, 12, 115, 126, 216, 182, 79, 23, 218, 198, 157, 150,
-			22, 252, 155, 243, 153, 111, 10, 240, 138, 213, 221, 160, 56, 217,
-			129, 173, 84, 70, 242, 164, 118, 106, 228, 62, 171, 235, 251, 34,
-			196, 231, 115, 180, 212, 25, 218, 51, 14, 18, 96, 64, 164, 199,
-			208, 14, 54, 215, 15, 219, 172, 160, 65, 2, 224, 201, 83, 244,
-			239, 72, 137, 201, 50, 231, 199, 108, 251, 92, 225, 111, 74, 137,
-			241, 59, 45, 17, 122, 53, 45, 40, 230, 88, 184, 235, 236, 55,
-			22, 175, 201, 128, 120, 60, 161, 116, 211, 71, 134, 42, 70, 14,
-			113, 109, 6, 1, 120, 250, 209, 36, 47, 198, 97, 71, 20, 65,
-			224, 139, 248, 197, 123, 81, 149, 144, 95, 27, 247, 182, 99, 92,
-			61, 104, 6, 114, 208, 109, 26, 135, 201, 40, 162, 154, 219, 86,
-			79, 71, 249, 187, 124, 199, 221, 157, 208, 141, 129, 53, 219, 131,
-			104, 222, 148, 151, 100, 201, 239, 41, 176, 36, 127, 207, 51, 252,
-			202, 236, 77, 148, 33, 85, 200, 240, 51, 235, 32, 79, 12, 152,
-			1, 208, 240, 19, 44, 210, 31, 179, 213, 214, 180, 131, 71, 46,
-			63, 102, 23, 207, 210, 239, 146, 202, 165, 159, 57, 63, 97, 219,
-			103, 10, 143, 97, 22, 161, 74, 216, 242, 244, 67, 213, 120, 161,
-			43, 190, 64, 205, 241, 58, 87, 243, 236, 86, 42, 130, 114, 183,
-			45, 46, 70, 60, 185, 39, 155, 202, 80, 13, 158, 62, 172, 247,
-			228, 217, 105, 242, 86, 176, 27, 203, 26, 166, 11, 253, 14, 146,
-			97, 192, 12, 128, 70, 63, 130, 137, 252, 19, 246, 136, 86, 203,
-			253, 4, 192, 211, 156, 254, 103, 217, 133, 28, 115, 126, 26, 186,
-			240, 31, 44, 254, 112, 117, 121, 41, 37, 185, 154, 130, 82, 234,
-			97, 39, 169, 53, 49, 34, 170, 55, 114, 180, 164, 52, 9, 238,
-			235, 132, 124, 219, 149, 69, 93, 94, 52, 247, 215, 22, 149, 243,
-			203, 3, 63, 133, 127, 82, 245, 9, 179, 46, 170, 211, 111, 42,
-			119, 30, 213, 26, 87, 74, 107, 27, 92, 67, 235, 162, 222, 169,
-			169, 79, 16, 212, 35, 96, 162, 89, 191, 24, 73, 250, 55, 119,
-			129, 205, 143, 69, 24, 163, 54, 242, 112, 39, 161, 230, 182, 68,
-			115, 222, 141, 146, 185, 148, 115, 176, 243, 6, 204, 0, 104, 198,
-			30, 60, 133, 159, 78, 244, 64, 142, 0, 120, 138, 171, 37, 106,
-			128, 57, 63, 107, 219, 231, 84, 230, 128, 131, 160, 198, 52, 144,
-			5, 112, 80, 175, 110, 96, 8, 255, 172, 61, 166, 165, 104, 128,
-			0, 88, 60, 75, 255, 199, 1, 68, 69, 153, 243, 43, 182, 125,
-			190, 240, 227, 3, 201, 86, 166, 86, 38, 174, 182, 35, 138, 122,
-			219, 177, 88, 226, 207, 201, 77, 87, 149, 99, 196, 68, 151, 0,
-			22, 129, 19, 224, 214, 94, 213, 145, 5, 53, 48, 99, 182, 220,
-			176, 222, 84, 91, 122, 178, 146, 58, 138, 151, 8, 123, 46, 4,
-			214, 239, 55, 79, 166, 134, 74, 47, 118, 205, 0, 164, 55, 48,
-			162, 29, 7, 60, 242, 182, 124, 183, 137, 143, 184, 5, 205, 186,
-			166, 173, 166, 182, 65, 112, 132, 13, 41, 136, 25, 111, 247, 74,
-			94, 169, 54, 162, 230, 169, 9, 65, 161, 152, 244, 88, 92, 224,
-			67, 49, 218, 245, 227, 109, 17, 123, 181, 162, 44, 48, 169, 190,
-			179, 233, 37, 14, 3, 193, 163, 160, 41, 212, 172, 161, 124, 92,
-			184, 181, 109, 77, 146, 233, 159, 172, 132, 175, 200, 65, 13, 104,
-			200, 52, 33, 91, 152, 40, 241, 213, 238, 148, 136, 114, 241, 154,
-			23, 197, 73, 144, 149, 14, 90, 65, 163, 80, 146, 84, 151, 49,
-			84, 250, 70, 76, 84, 94, 115, 43, 149, 253, 144, 25, 227, 65,
-			5, 155, 74, 87, 209, 109, 54, 121, 49, 20, 110, 83, 245, 20,
-			195, 244, 211, 75, 188, 116, 225, 38, 247, 12, 153, 62, 233, 146,
-			111, 99, 99, 195, 250, 67, 68, 21, 149, 56, 73, 121, 228, 193,
-			24, 248, 129, 63, 165, 183, 151, 123, 240, 234, 72, 88, 28, 35,
-			51, 106, 198, 97, 133, 233, 132, 62, 41, 237, 218, 112, 23, 73,
-			83, 117, 17, 187, 94, 19, 196, 108, 103, 91, 152, 239, 146, 80,
-			45, 236, 132, 65, 44, 82, 194, 12, 203, 132, 31, 196, 24, 81,
-			227, 69, 41, 187, 182, 209, 105, 162, 112, 132, 65, 199, 175, 79,
-			197, 161, 135, 65, 236, 169, 160, 115, 25, 170, 130, 108, 169, 5,
-			126, 228, 69, 120, 123, 8, 223, 17, 20, 149, 240, 158, 62, 245,
-			14, 46, 119, 155, 81, 48, 201, 197, 99, 1, 67, 25, 116, 182,
-			182, 149, 169, 35, 31, 176, 151, 54, 63, 202, 115, 47, 31, 214,
-			212, 220, 20, 184, 79, 236, 54, 155, 187, 42, 4, 207, 245, 99,
-			19, 170, 31, 39, 39, 146, 24, 177, 77, 121, 44, 154, 77, 238,
-			53, 204, 62, 156, 151, 14, 138, 145, 65, 232, 96, 182, 77, 242,
-			40, 0, 74, 80, 52, 212, 72, 232, 241, 164, 189, 157, 0, 193,
-			192, 237, 131, 217, 30, 185, 142, 180, 20, 70, 218, 53, 104, 52,
-			221, 173, 201, 52, 121, 224, 194, 132, 194, 173, 239, 154, 97, 164,
-			9, 18, 180, 0, 95, 238, 190, 184, 250, 101, 163, 56, 169, 131,
-			42, 203, 128, 25, 0, 141, 217, 10, 110, 250, 175, 216, 121, 173,
-			238, 40, 1, 176, 120, 142, 22, 41, 152, 90, 217, 95, 179, 251,
-			126, 195, 182, 10, 135, 186, 182, 38, 117, 111, 6, 41, 113, 192,
-			136, 249, 53, 59, 119, 2, 53, 109, 6, 28, 148, 207, 104, 103,
-			32, 131, 14, 202, 103, 116, 211, 25, 116, 80, 62, 163, 117, 118,
-			6, 29, 148, 207, 104, 7, 37, 131, 14, 202, 103, 180, 131, 146,
-			1, 79, 225, 215, 181, 206, 206, 160, 131, 242, 235, 9, 38, 43,
-			11, 160, 210, 217, 25, 116, 80, 126, 93, 235, 236, 12, 58, 40,
-			191, 14, 58, 251, 34, 181, 157, 44, 203, 126, 214, 238, 251, 3,
-			219, 42, 28, 75, 119, 194, 79, 204, 110, 213, 19, 48, 31, 62,
-			107, 231, 164, 91, 147, 133, 158, 124, 78, 247, 36, 139, 61, 249,
-			156, 110, 63, 139, 61, 249, 156, 238, 73, 22, 123, 242, 57, 221,
-			147, 44, 246, 228, 115, 186, 39, 89, 232, 201, 231, 109, 21, 128,
-			146, 197, 158, 124, 62, 193, 4, 61, 249, 188, 61, 120, 86, 131,
-			88, 248, 220, 132, 6, 9, 128, 42, 0, 37, 11, 174, 214, 23,
-			108, 91, 151, 5, 159, 226, 11, 9, 38, 48, 24, 191, 96, 15,
-			106, 34, 160, 217, 47, 216, 71, 79, 105, 144, 0, 120, 166, 72,
-			127, 1, 172, 203, 44, 233, 99, 217, 223, 182, 237, 223, 181, 73,
-			225, 199, 236, 125, 194, 70, 180, 181, 41, 119, 25, 83, 1, 30,
-			106, 219, 113, 191, 160, 17, 225, 199, 161, 215, 19, 33, 2, 76,
-			222, 55, 60, 164, 39, 58, 132, 47, 129, 170, 81, 39, 129, 114,
-			198, 154, 175, 42, 208, 108, 120, 211, 119, 63, 100, 147, 110, 140,
-			107, 41, 212, 214, 161, 36, 60, 234, 212, 182, 117, 22, 42, 44,
-			183, 221, 14, 131, 118, 232, 185, 177, 12, 135, 84, 166, 178, 60,
-			144, 145, 1, 145, 158, 31, 95, 157, 165, 188, 30, 180, 92, 79,
-			31, 39, 101, 49, 68, 224, 183, 109, 122, 28, 227, 74, 178, 50,
-			68, 224, 119, 236, 125, 35, 87, 178, 234, 204, 255, 119, 108, 117,
-			202, 152, 85, 103, 254, 191, 99, 171, 51, 255, 172, 58, 243, 255,
-			29, 91, 69, 174, 100, 213, 153, 255, 239, 216, 42, 114, 37, 43,
-			207, 252, 191, 104, 99, 228, 202, 126, 45, 128, 32, 125, 49, 221,
-			2, 120, 237, 95, 76, 183, 96, 33, 2, 21, 185, 146, 85, 167,
-			242, 95, 4, 193, 252, 89, 11, 229, 137, 48, 231, 247, 108, 123,
-			102, 255, 200, 161, 39, 138, 64, 119, 70, 175, 40, 80, 48, 232,
-			112, 110, 169, 252, 94, 113, 72, 31, 68, 62, 81, 52, 104, 79,
-			228, 80, 22, 189, 239, 223, 75, 164, 29, 188, 239, 223, 179, 213,
-			185, 80, 22, 189, 239, 223, 179, 79, 94, 214, 32, 246, 172, 52,
-			77, 155, 216, 79, 135, 57, 95, 2, 87, 234, 67, 73, 115, 9,
-			133, 79, 12, 174, 9, 133, 52, 103, 247, 141, 159, 161, 79, 10,
-			160, 201, 162, 199, 250, 165, 132, 82, 240, 88, 191, 148, 232, 10,
-			240, 88, 191, 164, 189, 148, 44, 122, 172, 95, 2, 93, 117, 133,
-			218, 78, 63, 203, 126, 213, 238, 251, 143, 118, 239, 167, 4, 146,
-			78, 125, 22, 36, 85, 151, 210, 90, 224, 49, 124, 213, 206, 73,
-			75, 183, 31, 132, 242, 107, 90, 107, 245, 163, 16, 126, 77, 83,
-			210, 143, 34, 248, 53, 77, 73, 63, 10, 224, 215, 180, 214, 234,
-			71, 241, 251, 154, 214, 90, 253, 32, 124, 95, 215, 27, 68, 253,
-			40, 108, 95, 79, 48, 129, 168, 125, 93, 187, 45, 253, 40, 104,
-			95, 215, 27, 68, 253, 40, 102, 95, 215, 27, 68, 253, 160, 181,
-			254, 208, 182, 199, 85, 38, 12, 198, 31, 38, 152, 64, 107, 253,
-			161, 61, 168, 41, 134, 102, 255, 208, 62, 113, 86, 131, 4, 192,
-			11, 23, 233, 57, 106, 59, 57, 150, 253, 35, 187, 239, 63, 217,
-			86, 225, 72, 23, 119, 244, 187, 238, 146, 33, 224, 9, 252, 145,
-			157, 59, 133, 141, 231, 128, 33, 223, 208, 12, 201, 33, 67, 190,
-			161, 27, 207, 33, 67, 190, 161, 25, 146, 67, 134, 124, 67, 51,
-			36, 135, 12, 249, 134, 102, 72, 14, 40, 251, 99, 91, 197, 121,
-			229, 144, 33, 127, 156, 96, 2, 53, 254, 199, 246, 32, 215, 32,
-			22, 62, 115, 81, 131, 4, 192, 75, 151, 21, 38, 155, 57, 223,
-			180, 85, 244, 95, 14, 25, 242, 205, 4, 19, 48, 228, 155, 182,
-			138, 254, 203, 33, 67, 190, 105, 171, 232, 191, 28, 50, 228, 155,
-			246, 185, 243, 116, 134, 218, 206, 0, 203, 254, 137, 221, 247, 223,
-			16, 171, 80, 236, 9, 222, 141, 183, 131, 186, 116, 103, 187, 153,
-			3, 206, 205, 159, 216, 185, 147, 72, 200, 0, 48, 231, 79, 53,
-			115, 6, 144, 57, 127, 170, 9, 25, 64, 230, 252, 169, 102, 206,
-			0, 50, 231, 79, 53, 115, 6, 144, 57, 127, 10, 204, 249, 33,
-			11, 81, 89, 204, 249, 51, 112, 77, 63, 105, 241, 138, 143, 31,
-			36, 250, 117, 253, 109, 162, 137, 237, 73, 62, 140, 115, 245, 135,
-			81, 189, 219, 41, 59, 238, 46, 119, 35, 202, 247, 125, 233, 197,
-			236, 176, 200, 207, 193, 212, 231, 255, 13, 176, 89, 131, 189, 65,
-			203, 146, 80, 24, 168, 63, 75, 122, 5, 146, 251, 103, 73, 175,
-			112, 67, 219, 102, 39, 52, 72, 0, 60, 205, 21, 127, 108, 230,
-			252, 185, 109, 23, 85, 38, 12, 212, 159, 39, 152, 236, 12, 128,
-			6, 19, 112, 224, 207, 109, 118, 82, 131, 4, 64, 126, 70, 97,
-			34, 204, 249, 40, 177, 207, 171, 76, 208, 101, 31, 37, 6, 19,
-			232, 178, 143, 18, 21, 36, 56, 128, 186, 236, 163, 228, 24, 215,
-			32, 214, 61, 123, 142, 150, 17, 147, 195, 156, 143, 17, 251, 70,
-			225, 6, 175, 232, 123, 235, 34, 176, 84, 165, 247, 198, 229, 51,
-			5, 17, 215, 47, 3, 232, 116, 19, 249, 164, 176, 58, 18, 143,
-			1, 51, 0, 42, 171, 112, 0, 149, 212, 199, 72, 94, 147, 0,
-			74, 234, 99, 64, 130, 2, 115, 0, 158, 191, 174, 193, 126, 0,
-			103, 158, 82, 4, 102, 152, 243, 241, 253, 8, 148, 15, 37, 236,
-			37, 80, 165, 247, 18, 152, 113, 16, 143, 1, 17, 173, 33, 16,
-			204, 206, 143, 39, 4, 102, 8, 128, 134, 192, 76, 14, 64, 67,
-			96, 166, 31, 192, 153, 167, 232, 47, 12, 83, 219, 161, 44, 251,
-			211, 164, 239, 31, 56, 214, 236, 18, 127, 230, 47, 254, 143, 114,
-			117, 203, 35, 157, 253, 194, 1, 94, 6, 231, 213, 124, 36, 145,
-			124, 60, 35, 175, 199, 128, 197, 3, 35, 211, 138, 250, 229, 12,
-			238, 198, 242, 178, 158, 244, 204, 160, 252, 21, 244, 39, 204, 93,
-			53, 81, 106, 113, 146, 46, 1, 222, 193, 17, 7, 176, 8, 105,
-			215, 181, 206, 163, 166, 183, 181, 29, 55, 119, 121, 221, 107, 52,
-			68, 40, 252, 24, 214, 41, 112, 69, 221, 93, 189, 149, 197, 183,
-			61, 95, 126, 39, 170, 112, 200, 199, 201, 125, 175, 221, 105, 162,
-			115, 104, 246, 138, 244, 128, 128, 117, 166, 35, 36, 0, 209, 254,
-			17, 18, 110, 180, 79, 60, 203, 37, 197, 154, 20, 46, 213, 203,
-			84, 89, 189, 226, 238, 10, 92, 117, 213, 46, 130, 252, 58, 25,
-			189, 72, 15, 230, 123, 20, 244, 120, 105, 104, 36, 96, 252, 104,
-			178, 189, 169, 141, 141, 22, 30, 208, 68, 177, 112, 235, 210, 1,
-			198, 40, 83, 200, 144, 143, 154, 43, 159, 206, 239, 34, 50, 217,
-			190, 174, 185, 205, 166, 168, 243, 253, 110, 208, 44, 165, 119, 251,
-			146, 72, 67, 163, 190, 212, 161, 67, 242, 130, 248, 94, 22, 240,
-			231, 132, 220, 18, 151, 174, 157, 193, 22, 7, 188, 29, 200, 81,
-			144, 91, 115, 41, 30, 97, 228, 222, 142, 224, 155, 29, 175, 89,
-			199, 16, 62, 173, 24, 39, 129, 85, 82, 58, 218, 129, 231, 199,
-			216, 40, 142, 97, 148, 124, 137, 75, 37, 223, 228, 121, 119, 20,
-			200, 176, 156, 4, 59, 40, 99, 100, 59, 12, 117, 185, 39, 214,
-			85, 201, 74, 247, 104, 171, 251, 71, 106, 219, 65, 36, 112, 203,
-			71, 222, 158, 18, 221, 166, 252, 18, 122, 249, 186, 160, 164, 12,
-			183, 7, 117, 212, 49, 250, 11, 38, 174, 5, 134, 184, 41, 192,
-			44, 111, 234, 160, 163, 32, 164, 156, 243, 32, 220, 114, 125, 239,
-			117, 117, 169, 77, 32, 191, 17, 21, 175, 181, 69, 232, 225, 177,
-			123, 83, 183, 49, 137, 140, 84, 113, 210, 138, 228, 167, 102, 102,
-			102, 102, 0, 75, 188, 29, 226, 110, 1, 222, 97, 164, 119, 242,
-			213, 177, 195, 110, 208, 145, 151, 167, 68, 157, 80, 77, 4, 72,
-			170, 155, 175, 234, 228, 48, 112, 53, 178, 50, 198, 18, 163, 72,
-			181, 234, 82, 20, 148, 222, 164, 215, 155, 234, 19, 196, 104, 91,
-			177, 31, 187, 47, 191, 74, 196, 79, 163, 12, 54, 104, 201, 243,
-			245, 151, 128, 49, 122, 35, 177, 7, 150, 169, 152, 106, 185, 158,
-			26, 213, 205, 78, 99, 106, 11, 159, 15, 158, 50, 35, 56, 21,
-			138, 45, 47, 138, 195, 221, 212, 157, 20, 216, 249, 64, 91, 168,
-			123, 34, 152, 163, 18, 95, 245, 90, 234, 67, 242, 199, 94, 93,
-			64, 215, 241, 246, 152, 87, 68, 45, 150, 166, 46, 222, 220, 0,
-			120, 150, 55, 33, 209, 123, 44, 166, 230, 213, 199, 181, 19, 216,
-			149, 174, 42, 59, 98, 51, 242, 98, 193, 199, 189, 6, 119, 31,
-			187, 94, 211, 221, 108, 138, 9, 245, 117, 88, 40, 46, 70, 220,
-			15, 0, 25, 30, 223, 1, 219, 95, 107, 55, 81, 31, 5, 59,
-			200, 118, 152, 107, 62, 230, 40, 206, 183, 74, 124, 61, 234, 224,
-			142, 13, 228, 163, 248, 96, 229, 192, 71, 94, 245, 118, 169, 132,
-			223, 186, 201, 24, 46, 12, 156, 219, 51, 78, 234, 230, 36, 64,
-			20, 248, 41, 158, 32, 89, 114, 120, 55, 119, 121, 187, 19, 199,
-			106, 247, 74, 169, 139, 168, 179, 57, 213, 21, 235, 133, 71, 24,
-			114, 70, 232, 233, 29, 201, 171, 30, 120, 208, 144, 98, 135, 119,
-			6, 69, 74, 104, 221, 86, 187, 41, 96, 114, 240, 119, 122, 67,
-			20, 32, 145, 169, 103, 3, 173, 166, 212, 49, 160, 23, 241, 184,
-			19, 130, 178, 237, 196, 106, 41, 144, 250, 3, 116, 2, 12, 135,
-			182, 253, 140, 32, 70, 34, 230, 157, 182, 146, 12, 183, 19, 7,
-			45, 55, 246, 106, 200, 97, 23, 159, 58, 215, 91, 254, 90, 70,
-			164, 209, 72, 45, 230, 252, 52, 201, 141, 202, 207, 20, 41, 88,
-			141, 63, 79, 236, 179, 133, 63, 176, 248, 170, 136, 229, 118, 226,
-			67, 247, 177, 203, 213, 11, 43, 160, 173, 66, 48, 60, 220, 40,
-			18, 81, 106, 125, 82, 251, 255, 94, 164, 119, 5, 21, 97, 84,
-			222, 241, 0, 107, 224, 221, 93, 125, 200, 52, 153, 250, 208, 77,
-			35, 134, 169, 27, 137, 186, 190, 12, 64, 238, 19, 4, 141, 24,
-			52, 156, 231, 167, 92, 123, 179, 117, 214, 85, 223, 236, 179, 249,
-			65, 216, 194, 110, 227, 17, 170, 20, 11, 115, 105, 130, 246, 252,
-			149, 197, 42, 13, 16, 138, 214, 241, 207, 107, 123, 132, 162, 117,
-			252, 243, 68, 89, 127, 20, 173, 227, 159, 39, 236, 148, 6, 9,
-			128, 103, 138, 244, 27, 54, 178, 204, 98, 206, 167, 137, 125, 185,
-			240, 251, 54, 159, 15, 252, 56, 12, 154, 123, 143, 30, 119, 66,
-			183, 221, 22, 161, 100, 37, 50, 47, 205, 58, 117, 39, 84, 169,
-			231, 195, 70, 55, 86, 69, 211, 183, 34, 232, 165, 190, 167, 194,
-			69, 64, 24, 39, 54, 245, 248, 132, 246, 20, 192, 224, 222, 17,
-			128, 32, 194, 125, 232, 228, 3, 224, 212, 42, 209, 19, 119, 219,
-			77, 73, 165, 193, 247, 121, 144, 74, 109, 227, 96, 56, 146, 58,
-			7, 118, 85, 240, 171, 188, 40, 70, 203, 136, 62, 25, 162, 93,
-			183, 209, 104, 185, 85, 7, 206, 158, 143, 119, 34, 224, 90, 43,
-			87, 13, 205, 50, 124, 56, 75, 34, 51, 227, 5, 118, 255, 167,
-			147, 241, 2, 187, 255, 211, 201, 120, 89, 56, 34, 236, 130, 6,
-			9, 128, 19, 151, 232, 255, 64, 112, 188, 108, 230, 252, 50, 177,
-			239, 20, 126, 136, 64, 207, 84, 64, 149, 234, 129, 17, 119, 180,
-			159, 212, 16, 5, 42, 250, 62, 57, 71, 224, 145, 104, 187, 248,
-			179, 4, 156, 145, 151, 123, 168, 11, 42, 106, 219, 41, 22, 43,
-			205, 50, 169, 54, 148, 113, 137, 86, 19, 119, 95, 150, 83, 181,
-			153, 206, 215, 182, 59, 50, 246, 36, 18, 230, 48, 30, 44, 14,
-			63, 136, 47, 237, 207, 54, 205, 47, 228, 148, 60, 109, 199, 207,
-			135, 246, 123, 125, 172, 196, 147, 160, 183, 61, 117, 101, 75, 58,
-			216, 141, 166, 4, 21, 191, 60, 72, 196, 239, 173, 228, 46, 45,
-			118, 244, 109, 201, 157, 250, 232, 85, 142, 27, 120, 67, 191, 156,
-			12, 50, 248, 30, 191, 172, 157, 4, 138, 46, 217, 47, 147, 252,
-			89, 13, 18, 0, 47, 140, 107, 48, 7, 224, 196, 109, 13, 246,
-			3, 120, 237, 22, 157, 64, 9, 32, 204, 249, 103, 196, 46, 23,
-			142, 171, 83, 46, 169, 24, 235, 129, 192, 51, 135, 109, 25, 70,
-			37, 107, 130, 55, 247, 207, 18, 34, 72, 6, 64, 67, 4, 120,
-			115, 255, 140, 228, 167, 52, 136, 136, 103, 102, 53, 152, 3, 240,
-			234, 2, 134, 64, 1, 136, 217, 215, 230, 233, 151, 165, 226, 112,
-			152, 243, 27, 196, 126, 79, 225, 223, 216, 234, 212, 218, 28, 243,
-			165, 36, 113, 246, 205, 69, 209, 216, 249, 20, 205, 161, 29, 185,
-			251, 253, 90, 77, 200, 62, 129, 109, 137, 71, 69, 46, 152, 221,
-			177, 104, 181, 209, 70, 106, 185, 210, 195, 80, 43, 131, 139, 135,
-			80, 235, 107, 247, 166, 110, 82, 60, 168, 231, 145, 248, 112, 7,
-			79, 8, 209, 247, 150, 1, 3, 234, 100, 144, 114, 117, 211, 111,
-			234, 18, 36, 73, 84, 61, 48, 182, 114, 137, 38, 2, 230, 250,
-			123, 62, 70, 145, 194, 229, 214, 128, 204, 40, 105, 188, 187, 237,
-			72, 159, 69, 169, 241, 217, 118, 161, 40, 23, 141, 6, 152, 36,
-			170, 115, 137, 197, 137, 109, 55, 193, 74, 81, 159, 183, 155, 49,
-			116, 36, 163, 13, 152, 1, 208, 140, 33, 184, 195, 191, 65, 242,
-			90, 91, 128, 59, 252, 27, 100, 226, 178, 6, 115, 0, 78, 62,
-			163, 193, 126, 0, 111, 60, 77, 23, 97, 4, 157, 62, 150, 253,
-			44, 177, 191, 64, 72, 225, 105, 110, 238, 35, 53, 138, 79, 157,
-			161, 237, 119, 143, 148, 246, 208, 34, 239, 117, 67, 39, 70, 118,
-			127, 150, 244, 143, 210, 5, 144, 23, 25, 217, 253, 57, 226, 12,
-			23, 175, 25, 228, 201, 197, 35, 88, 29, 16, 42, 87, 106, 18,
-			20, 139, 231, 54, 181, 85, 45, 247, 146, 169, 10, 229, 254, 28,
-			113, 6, 146, 4, 27, 18, 134, 14, 208, 251, 170, 29, 139, 57,
-			159, 39, 14, 43, 100, 229, 221, 32, 197, 105, 140, 78, 78, 46,
-			223, 92, 110, 71, 32, 8, 38, 44, 86, 233, 37, 57, 209, 117,
-			212, 56, 85, 49, 224, 159, 39, 42, 216, 146, 170, 24, 240, 207,
-			147, 252, 136, 105, 202, 102, 206, 191, 36, 206, 225, 226, 141, 116,
-			151, 234, 224, 56, 128, 132, 41, 201, 90, 244, 98, 97, 238, 110,
-			236, 25, 82, 141, 25, 136, 254, 151, 68, 133, 160, 82, 21, 211,
-			253, 47, 201, 232, 33, 220, 148, 161, 160, 45, 126, 147, 216, 119,
-			212, 184, 101, 28, 4, 181, 8, 100, 178, 0, 170, 35, 38, 138,
-			27, 14, 191, 73, 198, 206, 105, 144, 0, 120, 81, 235, 146, 76,
-			14, 64, 163, 75, 50, 253, 0, 94, 187, 69, 127, 95, 206, 226,
-			44, 115, 126, 155, 216, 197, 194, 111, 217, 137, 197, 116, 63, 232,
-			177, 151, 162, 56, 196, 11, 27, 223, 137, 189, 84, 105, 240, 64,
-			94, 244, 57, 185, 7, 169, 90, 60, 211, 247, 63, 118, 95, 116,
-			137, 246, 232, 20, 6, 140, 108, 186, 145, 72, 219, 34, 198, 224,
-			194, 163, 82, 222, 118, 227, 237, 73, 238, 53, 204, 21, 151, 37,
-			89, 53, 21, 232, 145, 174, 133, 95, 141, 160, 16, 236, 181, 21,
-			20, 26, 60, 116, 217, 31, 75, 47, 45, 93, 149, 245, 53, 21,
-			70, 91, 152, 25, 156, 117, 144, 201, 6, 204, 0, 104, 214, 251,
-			172, 5, 160, 218, 231, 163, 24, 27, 244, 219, 228, 244, 25, 250,
-			127, 56, 56, 64, 253, 204, 249, 18, 172, 247, 191, 230, 240, 85,
-			25, 200, 173, 30, 111, 212, 75, 113, 212, 189, 211, 226, 249, 114,
-			21, 111, 186, 254, 86, 199, 221, 18, 239, 229, 188, 168, 94, 115,
-			44, 154, 42, 50, 248, 16, 207, 117, 245, 55, 74, 160, 42, 253,
-			93, 152, 143, 177, 87, 3, 83, 157, 87, 87, 230, 121, 180, 27,
-			197, 184, 103, 177, 134, 7, 189, 97, 186, 37, 188, 36, 71, 80,
-			142, 22, 105, 183, 154, 143, 246, 144, 193, 199, 205, 93, 124, 117,
-			25, 156, 234, 54, 245, 117, 72, 19, 37, 202, 239, 247, 118, 106,
-			71, 168, 237, 19, 116, 138, 94, 245, 124, 220, 53, 214, 230, 135,
-			106, 41, 117, 255, 146, 62, 233, 115, 195, 230, 174, 14, 215, 198,
-			221, 158, 222, 168, 90, 186, 79, 99, 146, 27, 59, 50, 2, 64,
-			134, 80, 164, 66, 241, 61, 159, 55, 220, 199, 1, 198, 77, 201,
-			153, 174, 8, 167, 210, 53, 239, 94, 206, 210, 28, 149, 110, 232,
-			147, 89, 26, 226, 199, 108, 147, 84, 169, 164, 212, 247, 74, 24,
-			242, 86, 226, 124, 25, 239, 89, 169, 11, 229, 184, 75, 31, 60,
-			130, 245, 99, 143, 24, 200, 56, 127, 154, 142, 11, 150, 167, 246,
-			162, 37, 195, 15, 58, 201, 162, 210, 239, 160, 88, 25, 48, 3,
-			160, 89, 84, 250, 45, 0, 141, 117, 210, 79, 0, 52, 214, 73,
-			127, 14, 64, 163, 81, 250, 81, 66, 175, 221, 82, 154, 43, 199,
-			156, 63, 32, 182, 94, 113, 114, 14, 130, 186, 157, 92, 6, 64,
-			211, 78, 206, 2, 48, 127, 94, 131, 4, 192, 241, 75, 26, 68,
-			84, 151, 159, 214, 96, 63, 128, 215, 239, 168, 118, 6, 152, 243,
-			229, 68, 67, 14, 56, 8, 234, 118, 6, 50, 0, 154, 118, 6,
-			44, 0, 77, 127, 6, 8, 128, 166, 63, 3, 57, 0, 77, 127,
-			6, 250, 1, 188, 118, 139, 94, 6, 7, 115, 128, 57, 95, 33,
-			246, 112, 241, 148, 249, 158, 178, 189, 221, 222, 243, 126, 170, 252,
-			182, 138, 14, 244, 65, 233, 193, 3, 18, 19, 126, 84, 153, 6,
-			109, 5, 254, 223, 210, 89, 165, 204, 249, 143, 196, 158, 45, 124,
-			201, 226, 149, 168, 235, 186, 76, 45, 121, 239, 165, 124, 1, 7,
-			28, 100, 46, 144, 10, 75, 62, 156, 7, 106, 54, 110, 4, 97,
-			75, 133, 85, 225, 93, 98, 45, 47, 134, 242, 201, 23, 36, 102,
-			147, 150, 74, 235, 254, 177, 8, 119, 209, 64, 76, 91, 172, 184,
-			131, 229, 197, 70, 43, 235, 53, 186, 185, 203, 189, 45, 63, 8,
-			69, 253, 142, 46, 14, 245, 41, 111, 10, 55, 138, 211, 161, 101,
-			248, 145, 135, 94, 186, 177, 37, 221, 5, 105, 119, 53, 83, 190,
-			42, 117, 176, 215, 6, 204, 0, 104, 6, 10, 252, 249, 255, 72,
-			242, 5, 13, 18, 0, 79, 158, 214, 96, 14, 64, 126, 69, 131,
-			253, 0, 94, 158, 161, 127, 29, 217, 57, 200, 156, 111, 16, 251,
-			70, 225, 195, 92, 62, 49, 27, 233, 104, 33, 60, 109, 194, 247,
-			102, 141, 175, 170, 174, 202, 220, 239, 182, 82, 181, 141, 138, 187,
-			126, 160, 40, 81, 253, 96, 108, 124, 175, 129, 4, 200, 230, 47,
-			95, 54, 93, 27, 116, 144, 2, 3, 102, 0, 52, 93, 27, 180,
-			0, 84, 199, 2, 212, 30, 36, 0, 158, 213, 162, 63, 152, 3,
-			240, 194, 117, 13, 246, 3, 120, 229, 41, 250, 3, 82, 84, 134,
-			152, 243, 159, 136, 61, 81, 248, 88, 106, 95, 35, 208, 91, 95,
-			188, 166, 60, 31, 249, 140, 173, 210, 20, 242, 54, 32, 117, 75,
-			97, 44, 55, 86, 83, 85, 232, 126, 29, 234, 89, 212, 245, 247,
-			249, 24, 204, 164, 149, 147, 233, 238, 144, 131, 68, 25, 48, 3,
-			160, 89, 213, 240, 83, 23, 194, 244, 148, 27, 34, 0, 94, 24,
-			167, 21, 236, 207, 1, 230, 252, 9, 177, 199, 11, 119, 184, 121,
-			12, 23, 217, 185, 135, 166, 59, 201, 253, 145, 113, 144, 94, 201,
-			13, 25, 7, 28, 196, 101, 192, 12, 128, 134, 140, 3, 22, 128,
-			172, 168, 65, 2, 224, 249, 139, 50, 144, 153, 218, 195, 204, 249,
-			115, 34, 3, 153, 147, 237, 29, 190, 186, 227, 53, 226, 244, 98,
-			134, 83, 3, 63, 129, 219, 187, 241, 3, 134, 222, 252, 255, 207,
-			222, 191, 128, 71, 118, 85, 7, 162, 176, 206, 163, 30, 218, 82,
-			235, 113, 164, 126, 85, 183, 187, 119, 151, 219, 110, 73, 174, 46,
-			117, 183, 141, 141, 187, 109, 131, 90, 45, 183, 133, 219, 82, 71,
-			82, 99, 28, 255, 208, 125, 84, 117, 36, 29, 186, 84, 167, 56,
-			167, 170, 101, 121, 126, 134, 25, 38, 1, 2, 4, 24, 76, 50,
-			33, 64, 6, 98, 32, 188, 50, 36, 19, 39, 144, 132, 27, 30,
-			129, 27, 66, 38, 47, 184, 151, 0, 33, 55, 153, 132, 196, 4,
-			66, 120, 133, 59, 190, 56, 201, 119, 191, 245, 216, 251, 236, 83,
-			82, 219, 198, 224, 251, 125, 247, 155, 235, 143, 143, 214, 218, 181,
-			207, 126, 172, 189, 246, 218, 107, 175, 189, 30, 202, 36, 20, 173,
-			60, 226, 0, 100, 32, 32, 253, 35, 213, 35, 36, 220, 119, 154,
-			245, 32, 78, 106, 81, 28, 232, 48, 234, 100, 80, 18, 169, 69,
-			83, 22, 222, 201, 100, 178, 185, 190, 28, 53, 18, 161, 110, 151,
-			108, 167, 217, 78, 239, 18, 9, 45, 46, 201, 59, 21, 35, 244,
-			164, 86, 219, 179, 149, 45, 189, 51, 136, 39, 234, 70, 247, 162,
-			16, 56, 224, 34, 78, 52, 152, 3, 80, 227, 115, 192, 2, 80,
-			43, 147, 6, 28, 0, 15, 93, 139, 70, 237, 194, 30, 244, 220,
-			151, 187, 246, 88, 169, 149, 82, 105, 107, 173, 245, 84, 169, 19,
-			170, 110, 33, 0, 177, 13, 85, 114, 78, 15, 180, 157, 91, 111,
-			181, 55, 245, 216, 7, 93, 28, 128, 6, 115, 0, 234, 177, 15,
-			90, 0, 106, 90, 24, 116, 0, 188, 238, 136, 248, 29, 218, 99,
-			67, 158, 251, 74, 215, 190, 174, 244, 65, 11, 239, 15, 6, 190,
-			81, 115, 64, 241, 247, 117, 240, 115, 36, 217, 104, 101, 251, 65,
-			235, 33, 138, 116, 140, 91, 86, 81, 255, 148, 149, 80, 81, 190,
-			52, 141, 150, 41, 112, 106, 61, 104, 7, 241, 122, 26, 49, 87,
-			15, 66, 79, 126, 200, 197, 9, 104, 48, 7, 160, 158, 252, 144,
-			5, 160, 167, 88, 233, 144, 3, 96, 249, 176, 248, 12, 77, 126,
-			216, 115, 95, 235, 218, 213, 210, 111, 255, 16, 147, 87, 201, 175,
-			53, 22, 196, 214, 149, 122, 82, 44, 164, 42, 54, 19, 17, 66,
-			99, 226, 41, 32, 98, 216, 197, 201, 104, 48, 7, 160, 70, 196,
-			176, 5, 160, 167, 14, 255, 97, 7, 192, 27, 142, 138, 79, 16,
-			34, 60, 207, 253, 143, 174, 125, 184, 244, 107, 79, 134, 8, 181,
-			94, 209, 138, 140, 59, 203, 155, 63, 4, 17, 176, 121, 229, 211,
-			34, 3, 236, 186, 155, 37, 122, 46, 78, 66, 131, 57, 0, 53,
-			2, 60, 11, 64, 189, 133, 61, 7, 192, 67, 215, 98, 100, 3,
-			97, 143, 120, 238, 155, 220, 167, 27, 217, 64, 216, 35, 46, 126,
-			175, 193, 60, 128, 28, 217, 64, 216, 35, 22, 128, 187, 84, 215,
-			35, 14, 128, 135, 174, 21, 255, 127, 236, 122, 212, 115, 127, 206,
-			181, 79, 150, 154, 79, 43, 12, 143, 208, 207, 31, 217, 140, 8,
-			234, 200, 47, 207, 171, 119, 109, 245, 30, 98, 198, 236, 17, 246,
-			168, 139, 221, 107, 48, 15, 96, 159, 26, 234, 168, 5, 32, 199,
-			236, 17, 246, 168, 3, 224, 205, 183, 138, 87, 90, 32, 36, 230,
-			60, 247, 173, 174, 189, 183, 244, 224, 15, 237, 175, 255, 244, 103,
-			129, 2, 104, 174, 7, 70, 194, 206, 253, 2, 157, 251, 223, 234,
-			178, 115, 191, 64, 231, 254, 183, 186, 59, 247, 208, 211, 73, 175,
-			231, 254, 130, 139, 161, 132, 65, 114, 181, 0, 210, 162, 170, 213,
-			5, 218, 12, 246, 11, 219, 237, 243, 242, 239, 112, 123, 190, 237,
-			82, 40, 37, 16, 107, 222, 225, 22, 119, 137, 47, 228, 132, 235,
-			246, 217, 61, 158, 251, 136, 107, 223, 81, 250, 253, 28, 69, 119,
-			137, 59, 129, 241, 112, 150, 218, 244, 31, 87, 218, 18, 168, 101,
-			122, 30, 175, 100, 220, 161, 148, 18, 205, 56, 240, 176, 134, 126,
-			18, 57, 154, 137, 35, 157, 170, 210, 186, 90, 23, 220, 124, 85,
-			226, 83, 156, 17, 229, 56, 125, 234, 70, 219, 105, 21, 39, 158,
-			98, 10, 156, 148, 114, 182, 125, 36, 145, 141, 32, 73, 132, 12,
-			86, 86, 194, 90, 136, 14, 98, 107, 32, 76, 6, 27, 65, 172,
-			183, 8, 169, 199, 97, 41, 225, 148, 71, 33, 26, 141, 161, 235,
-			93, 177, 241, 183, 132, 130, 9, 30, 240, 49, 138, 125, 230, 169,
-			90, 234, 234, 119, 70, 145, 252, 55, 148, 159, 131, 89, 198, 85,
-			210, 226, 203, 219, 17, 219, 167, 168, 174, 65, 99, 55, 193, 2,
-			172, 251, 15, 224, 47, 47, 205, 218, 179, 6, 134, 101, 1, 92,
-			23, 56, 58, 81, 115, 83, 15, 143, 204, 192, 79, 25, 8, 77,
-			216, 202, 16, 171, 154, 75, 37, 208, 85, 42, 75, 221, 120, 3,
-			78, 131, 31, 45, 211, 3, 9, 94, 134, 149, 248, 125, 138, 66,
-			253, 162, 24, 163, 172, 39, 150, 217, 214, 53, 161, 183, 135, 164,
-			66, 65, 168, 179, 126, 6, 216, 229, 105, 109, 137, 206, 215, 17,
-			245, 84, 72, 252, 148, 172, 91, 218, 27, 248, 222, 220, 142, 195,
-			154, 78, 42, 131, 171, 31, 52, 87, 162, 184, 198, 186, 131, 246,
-			118, 145, 236, 153, 65, 244, 225, 43, 219, 35, 138, 65, 244, 225,
-			43, 219, 35, 46, 139, 247, 125, 168, 166, 124, 196, 29, 58, 162,
-			64, 7, 192, 137, 27, 20, 88, 4, 144, 245, 176, 125, 118, 79,
-			1, 192, 91, 110, 19, 95, 183, 112, 211, 88, 158, 251, 155, 174,
-			125, 103, 233, 203, 150, 60, 67, 111, 82, 36, 60, 25, 90, 14,
-			214, 56, 37, 109, 191, 89, 247, 227, 186, 44, 215, 141, 71, 139,
-			50, 42, 163, 147, 36, 138, 149, 173, 104, 205, 111, 98, 94, 22,
-			204, 108, 172, 252, 48, 73, 123, 173, 90, 82, 54, 33, 202, 77,
-			12, 185, 144, 143, 49, 80, 229, 58, 200, 186, 58, 166, 47, 203,
-			96, 188, 111, 3, 63, 9, 131, 248, 20, 6, 162, 38, 133, 7,
-			109, 38, 255, 74, 20, 42, 114, 225, 247, 27, 99, 144, 101, 141,
-			71, 203, 197, 217, 106, 48, 7, 160, 198, 163, 133, 184, 24, 154,
-			84, 160, 3, 224, 137, 27, 21, 88, 4, 240, 166, 25, 5, 22,
-			0, 124, 206, 25, 241, 143, 132, 71, 219, 115, 63, 234, 218, 199,
-			75, 127, 153, 222, 168, 21, 121, 63, 99, 151, 106, 99, 15, 253,
-			128, 55, 105, 190, 72, 139, 167, 124, 147, 54, 200, 158, 230, 111,
-			187, 56, 97, 13, 230, 0, 212, 168, 4, 178, 250, 168, 203, 151,
-			233, 62, 124, 99, 250, 168, 123, 205, 1, 5, 22, 1, 60, 120,
-			76, 129, 5, 0, 39, 38, 137, 169, 247, 122, 238, 199, 93, 123,
-			4, 207, 134, 190, 222, 30, 128, 250, 250, 169, 38, 106, 53, 76,
-			208, 102, 144, 234, 226, 143, 3, 60, 4, 60, 71, 76, 208, 102,
-			144, 234, 34, 52, 236, 241, 143, 182, 149, 5, 213, 175, 111, 207,
-			227, 218, 58, 158, 251, 5, 215, 62, 80, 250, 143, 121, 16, 162,
-			180, 55, 144, 90, 95, 218, 249, 89, 203, 1, 67, 52, 245, 91,
-			232, 3, 176, 73, 172, 136, 215, 77, 64, 185, 114, 25, 83, 174,
-			81, 186, 132, 216, 176, 132, 146, 219, 238, 14, 54, 151, 48, 164,
-			27, 230, 174, 133, 63, 239, 128, 114, 74, 179, 42, 111, 151, 199,
-			79, 137, 84, 104, 169, 155, 238, 92, 141, 40, 186, 156, 96, 24,
-			20, 213, 28, 15, 248, 30, 191, 197, 137, 48, 97, 88, 204, 225,
-			77, 46, 239, 183, 46, 210, 144, 51, 124, 61, 173, 225, 55, 36,
-			15, 75, 94, 14, 54, 121, 16, 91, 170, 232, 1, 243, 157, 240,
-			118, 121, 130, 171, 189, 148, 254, 209, 76, 53, 59, 160, 174, 217,
-			9, 57, 219, 21, 85, 4, 13, 247, 214, 162, 40, 33, 70, 106,
-			104, 72, 104, 93, 212, 240, 111, 71, 17, 64, 239, 144, 229, 78,
-			27, 133, 121, 233, 203, 38, 69, 139, 129, 181, 9, 51, 219, 80,
-			235, 140, 219, 145, 92, 3, 137, 1, 126, 187, 28, 108, 146, 7,
-			61, 27, 253, 11, 14, 170, 160, 31, 241, 166, 206, 207, 162, 116,
-			133, 126, 21, 91, 98, 160, 224, 147, 157, 50, 240, 193, 228, 38,
-			126, 34, 100, 184, 146, 58, 125, 210, 14, 220, 222, 151, 13, 221,
-			64, 230, 151, 102, 78, 170, 192, 230, 172, 88, 213, 82, 124, 87,
-			10, 27, 57, 69, 230, 14, 74, 236, 65, 170, 162, 48, 208, 66,
-			93, 203, 201, 31, 150, 27, 48, 178, 6, 224, 217, 183, 158, 81,
-			225, 146, 166, 157, 47, 71, 234, 96, 98, 203, 185, 244, 128, 114,
-			92, 220, 34, 26, 204, 1, 168, 185, 129, 99, 1, 56, 180, 87,
-			129, 184, 159, 246, 95, 35, 14, 243, 126, 255, 146, 107, 239, 40,
-			239, 198, 247, 246, 70, 216, 6, 233, 130, 223, 227, 150, 27, 129,
-			224, 13, 235, 64, 53, 189, 243, 161, 69, 19, 180, 25, 60, 200,
-			45, 254, 57, 180, 232, 97, 139, 77, 191, 25, 93, 244, 147, 139,
-			208, 178, 106, 204, 133, 26, 250, 107, 215, 202, 130, 54, 131, 127,
-			225, 224, 238, 119, 61, 247, 81, 215, 62, 95, 250, 35, 135, 181,
-			123, 244, 184, 70, 121, 82, 214, 252, 102, 189, 129, 220, 124, 133,
-			156, 143, 211, 200, 164, 250, 12, 76, 212, 49, 215, 136, 54, 130,
-			184, 230, 39, 42, 210, 34, 8, 5, 173, 196, 208, 208, 116, 59,
-			3, 235, 144, 115, 105, 222, 14, 88, 111, 229, 6, 218, 108, 108,
-			50, 45, 194, 129, 168, 2, 103, 160, 182, 40, 145, 151, 180, 207,
-			242, 37, 144, 103, 34, 56, 167, 163, 78, 147, 76, 208, 89, 197,
-			72, 218, 25, 244, 189, 83, 198, 46, 36, 50, 102, 147, 73, 232,
-			123, 32, 122, 177, 182, 131, 245, 86, 132, 201, 73, 214, 3, 31,
-			141, 4, 253, 85, 31, 8, 75, 46, 199, 209, 229, 160, 73, 230,
-			152, 137, 172, 119, 2, 161, 52, 103, 124, 121, 13, 155, 233, 40,
-			129, 17, 94, 29, 99, 130, 2, 170, 105, 241, 128, 209, 173, 191,
-			222, 8, 224, 196, 108, 146, 49, 31, 6, 111, 199, 200, 106, 245,
-			104, 163, 73, 166, 212, 66, 182, 209, 160, 26, 37, 197, 53, 191,
-			142, 6, 179, 40, 95, 160, 104, 17, 104, 210, 117, 105, 125, 53,
-			152, 3, 80, 147, 46, 208, 198, 163, 238, 208, 205, 10, 116, 0,
-			188, 245, 148, 2, 139, 0, 222, 54, 143, 118, 10, 0, 226, 207,
-			183, 207, 225, 133, 182, 15, 206, 196, 175, 61, 237, 11, 109, 31,
-			62, 189, 126, 45, 29, 89, 46, 15, 32, 95, 104, 251, 240, 233,
-			245, 107, 234, 66, 219, 135, 79, 175, 95, 131, 11, 237, 60, 118,
-			157, 247, 220, 111, 184, 63, 186, 184, 178, 125, 248, 144, 248, 141,
-			116, 48, 121, 236, 160, 79, 245, 158, 183, 0, 60, 168, 100, 165,
-			188, 3, 224, 205, 20, 82, 174, 47, 231, 185, 223, 114, 159, 153,
-			144, 114, 125, 112, 235, 252, 150, 186, 117, 246, 225, 173, 243, 91,
-			234, 214, 217, 135, 183, 206, 111, 193, 173, 19, 46, 143, 253, 94,
-			254, 187, 110, 207, 203, 243, 116, 121, 236, 183, 60, 247, 187, 110,
-			113, 84, 188, 17, 118, 121, 63, 92, 30, 191, 239, 218, 213, 210,
-			127, 112, 16, 99, 53, 60, 172, 53, 139, 85, 175, 208, 104, 226,
-			120, 195, 13, 221, 150, 37, 124, 203, 244, 83, 19, 115, 113, 149,
-			120, 19, 108, 212, 188, 230, 55, 1, 253, 218, 226, 110, 3, 246,
-			90, 85, 234, 27, 184, 162, 9, 161, 17, 179, 28, 52, 162, 13,
-			197, 72, 82, 5, 14, 110, 79, 125, 226, 112, 54, 37, 78, 216,
-			66, 239, 15, 66, 222, 143, 147, 185, 125, 122, 126, 225, 204, 11,
-			113, 247, 115, 193, 226, 210, 194, 236, 220, 217, 23, 202, 49, 35,
-			136, 197, 56, 136, 165, 25, 199, 106, 21, 244, 122, 69, 144, 0,
-			83, 198, 120, 20, 101, 69, 189, 105, 96, 205, 64, 101, 54, 146,
-			71, 143, 202, 36, 138, 227, 205, 138, 220, 8, 142, 52, 26, 18,
-			133, 159, 136, 252, 15, 235, 129, 224, 107, 46, 25, 103, 145, 229,
-			121, 7, 115, 2, 241, 211, 233, 33, 34, 178, 126, 188, 246, 124,
-			95, 209, 92, 63, 198, 50, 254, 190, 203, 238, 85, 253, 120, 237,
-			249, 190, 203, 81, 115, 250, 241, 218, 243, 125, 119, 111, 73, 129,
-			69, 0, 247, 29, 85, 96, 1, 192, 235, 41, 104, 124, 191, 219,
-			227, 229, 31, 119, 237, 87, 229, 40, 130, 83, 63, 26, 144, 60,
-			238, 22, 224, 0, 201, 3, 8, 228, 240, 47, 174, 59, 88, 26,
-			212, 122, 196, 117, 204, 28, 129, 70, 19, 253, 108, 27, 242, 47,
-			174, 107, 20, 216, 80, 176, 99, 64, 252, 172, 195, 109, 88, 158,
-			251, 147, 57, 119, 71, 233, 39, 29, 243, 184, 205, 172, 6, 91,
-			140, 19, 59, 38, 109, 244, 54, 216, 71, 226, 217, 108, 5, 66,
-			33, 191, 138, 22, 219, 217, 80, 177, 97, 19, 40, 147, 110, 158,
-			168, 10, 101, 6, 14, 215, 222, 54, 158, 45, 136, 234, 233, 40,
-			174, 107, 57, 128, 157, 161, 208, 72, 169, 42, 81, 8, 165, 219,
-			1, 8, 67, 105, 180, 19, 218, 131, 254, 166, 140, 49, 100, 133,
-			92, 15, 214, 163, 120, 19, 174, 102, 254, 170, 22, 116, 112, 76,
-			80, 201, 111, 144, 45, 125, 4, 71, 82, 187, 29, 196, 178, 21,
-			196, 120, 187, 0, 14, 141, 166, 71, 202, 137, 1, 79, 59, 95,
-			224, 160, 240, 6, 147, 249, 149, 174, 142, 141, 208, 71, 72, 155,
-			27, 250, 73, 234, 243, 80, 199, 79, 177, 219, 246, 90, 208, 20,
-			84, 93, 102, 162, 191, 144, 209, 176, 177, 116, 22, 45, 76, 49,
-			45, 176, 161, 160, 175, 31, 217, 120, 63, 217, 218, 188, 50, 199,
-			193, 211, 250, 217, 100, 230, 149, 57, 54, 153, 233, 103, 147, 153,
-			87, 230, 70, 70, 197, 231, 136, 123, 88, 158, 251, 134, 156, 189,
-			175, 244, 73, 135, 249, 45, 70, 134, 224, 21, 239, 10, 64, 183,
-			98, 170, 19, 90, 113, 184, 30, 162, 44, 202, 251, 12, 77, 239,
-			81, 240, 145, 62, 233, 115, 180, 234, 103, 11, 75, 161, 29, 184,
-			17, 2, 199, 94, 240, 249, 94, 226, 55, 117, 235, 141, 77, 129,
-			89, 75, 149, 198, 182, 237, 175, 234, 64, 80, 169, 205, 101, 64,
-			188, 163, 98, 58, 251, 250, 113, 236, 111, 162, 238, 152, 226, 50,
-			162, 120, 160, 221, 18, 26, 221, 145, 238, 150, 27, 209, 114, 53,
-			141, 24, 86, 33, 182, 164, 140, 1, 64, 142, 164, 136, 186, 109,
-			76, 40, 133, 246, 5, 108, 154, 139, 23, 119, 54, 108, 32, 164,
-			233, 120, 108, 91, 120, 93, 26, 105, 76, 134, 77, 97, 4, 26,
-			227, 36, 104, 242, 146, 154, 56, 93, 31, 182, 70, 246, 235, 14,
-			58, 86, 35, 171, 99, 82, 191, 24, 129, 199, 136, 103, 88, 46,
-			174, 170, 6, 115, 0, 178, 100, 208, 143, 84, 244, 134, 220, 144,
-			98, 63, 150, 3, 224, 222, 146, 248, 249, 28, 82, 132, 237, 185,
-			111, 203, 217, 55, 150, 94, 147, 67, 138, 120, 113, 98, 30, 40,
-			74, 149, 30, 164, 102, 226, 139, 120, 107, 163, 165, 209, 15, 14,
-			236, 190, 19, 113, 84, 26, 14, 48, 98, 114, 19, 64, 139, 206,
-			106, 140, 88, 135, 239, 110, 190, 73, 46, 135, 109, 116, 30, 88,
-			141, 253, 6, 46, 250, 74, 248, 128, 10, 79, 37, 228, 88, 216,
-			108, 223, 124, 83, 69, 118, 248, 223, 132, 255, 197, 74, 88, 192,
-			127, 141, 87, 165, 156, 50, 3, 107, 243, 68, 158, 183, 120, 145,
-			142, 15, 65, 1, 238, 152, 48, 73, 70, 52, 230, 67, 60, 68,
-			169, 134, 112, 185, 65, 14, 166, 8, 46, 173, 56, 168, 133, 104,
-			220, 72, 118, 152, 176, 58, 107, 126, 11, 78, 20, 14, 202, 77,
-			140, 72, 7, 238, 225, 136, 56, 138, 83, 174, 52, 34, 210, 78,
-			144, 143, 79, 218, 109, 85, 200, 197, 52, 111, 218, 243, 22, 47,
-			206, 93, 184, 231, 244, 204, 130, 86, 154, 240, 36, 80, 123, 151,
-			81, 119, 5, 117, 115, 240, 236, 78, 38, 244, 93, 202, 248, 173,
-			76, 222, 0, 101, 21, 131, 108, 201, 160, 32, 195, 213, 13, 143,
-			14, 90, 43, 129, 3, 153, 95, 184, 103, 234, 28, 122, 227, 103,
-			174, 137, 58, 215, 85, 91, 11, 224, 233, 10, 235, 176, 12, 17,
-			47, 182, 105, 200, 68, 199, 40, 121, 58, 248, 117, 124, 149, 37,
-			15, 149, 213, 40, 90, 173, 174, 251, 237, 181, 234, 44, 208, 65,
-			144, 18, 54, 92, 105, 222, 150, 18, 54, 8, 139, 111, 203, 241,
-			35, 77, 63, 50, 187, 183, 229, 188, 61, 10, 116, 0, 220, 183,
-			95, 129, 69, 0, 175, 57, 161, 192, 2, 128, 99, 199, 249, 92,
-			181, 188, 252, 47, 228, 236, 95, 210, 231, 42, 42, 243, 115, 133,
-			29, 24, 131, 177, 159, 34, 35, 190, 61, 231, 122, 165, 221, 252,
-			196, 101, 24, 30, 145, 123, 42, 113, 88, 10, 139, 248, 246, 28,
-			27, 68, 246, 115, 88, 196, 183, 231, 134, 134, 197, 56, 55, 101,
-			121, 238, 195, 208, 212, 94, 108, 106, 11, 205, 37, 70, 99, 22,
-			213, 77, 27, 3, 142, 255, 176, 217, 152, 237, 185, 239, 222, 182,
-			177, 212, 233, 67, 125, 11, 29, 191, 219, 108, 140, 62, 30, 26,
-			22, 111, 22, 184, 245, 29, 207, 253, 104, 206, 190, 161, 244, 106,
-			161, 204, 230, 12, 127, 182, 101, 125, 114, 53, 252, 7, 195, 198,
-			230, 115, 164, 60, 231, 63, 184, 169, 111, 100, 202, 230, 131, 69,
-			222, 163, 116, 238, 167, 1, 79, 219, 168, 41, 229, 51, 127, 67,
-			217, 59, 147, 169, 191, 161, 144, 66, 23, 75, 20, 45, 169, 183,
-			10, 113, 144, 16, 163, 178, 113, 189, 35, 73, 26, 173, 12, 185,
-			49, 123, 248, 243, 248, 128, 171, 42, 57, 33, 149, 32, 248, 64,
-			160, 71, 13, 166, 251, 76, 171, 204, 216, 107, 109, 114, 82, 74,
-			219, 195, 193, 114, 40, 62, 140, 154, 71, 138, 99, 245, 74, 193,
-			110, 43, 24, 151, 18, 221, 49, 97, 182, 93, 170, 20, 63, 14,
-			228, 74, 28, 4, 244, 30, 138, 10, 32, 29, 158, 7, 69, 112,
-			33, 3, 127, 53, 136, 65, 148, 104, 0, 86, 149, 40, 145, 141,
-			28, 165, 61, 35, 245, 5, 67, 185, 49, 106, 219, 107, 161, 15,
-			173, 204, 51, 108, 220, 9, 100, 210, 89, 93, 13, 146, 182, 17,
-			161, 95, 43, 238, 125, 204, 133, 12, 178, 122, 24, 92, 97, 193,
-			76, 240, 241, 150, 25, 79, 38, 122, 24, 6, 151, 142, 98, 126,
-			61, 50, 182, 246, 114, 20, 93, 190, 28, 4, 20, 143, 48, 186,
-			18, 196, 107, 176, 22, 237, 205, 22, 43, 25, 57, 155, 88, 198,
-			192, 56, 220, 194, 64, 148, 117, 190, 244, 201, 254, 187, 109, 228,
-			255, 107, 182, 131, 120, 133, 95, 212, 253, 102, 230, 37, 57, 170,
-			7, 167, 4, 26, 71, 176, 91, 2, 134, 199, 162, 27, 108, 28,
-			172, 251, 134, 123, 58, 136, 142, 157, 24, 150, 1, 4, 22, 32,
-			181, 56, 240, 235, 71, 19, 127, 37, 208, 201, 209, 132, 209, 89,
-			104, 142, 199, 72, 121, 72, 3, 62, 133, 166, 144, 109, 101, 36,
-			173, 58, 131, 214, 144, 25, 195, 220, 73, 114, 212, 158, 108, 212,
-			33, 146, 115, 173, 19, 147, 139, 47, 158, 57, 13, 138, 18, 149,
-			109, 16, 136, 62, 108, 178, 118, 66, 101, 189, 12, 84, 22, 28,
-			38, 203, 108, 244, 21, 92, 188, 238, 112, 76, 104, 157, 12, 196,
-			142, 52, 71, 166, 159, 156, 182, 3, 200, 51, 168, 93, 22, 50,
-			108, 52, 142, 194, 78, 65, 83, 123, 52, 185, 92, 247, 219, 232,
-			124, 28, 38, 76, 153, 28, 154, 85, 109, 241, 105, 191, 129, 10,
-			165, 217, 100, 150, 246, 112, 248, 96, 80, 31, 27, 23, 74, 220,
-			203, 110, 117, 188, 56, 82, 118, 11, 86, 40, 102, 55, 227, 154,
-			159, 108, 233, 42, 101, 39, 119, 250, 33, 134, 68, 54, 131, 219,
-			83, 139, 113, 144, 160, 241, 132, 33, 171, 251, 97, 3, 68, 168,
-			49, 242, 145, 192, 237, 44, 208, 27, 88, 143, 50, 181, 106, 195,
-			36, 182, 232, 164, 128, 254, 142, 250, 228, 113, 92, 228, 141, 26,
-			204, 1, 168, 69, 42, 199, 2, 144, 131, 133, 246, 163, 158, 240,
-			163, 185, 221, 234, 32, 114, 138, 0, 238, 157, 80, 96, 1, 192,
-			195, 227, 152, 120, 215, 237, 135, 51, 237, 147, 57, 251, 150, 210,
-			219, 45, 217, 105, 170, 149, 184, 136, 11, 199, 59, 64, 214, 162,
-			56, 14, 106, 237, 38, 176, 1, 92, 159, 68, 33, 149, 220, 39,
-			80, 113, 84, 53, 213, 95, 162, 203, 63, 23, 109, 210, 176, 77,
-			20, 134, 50, 136, 51, 36, 84, 148, 231, 163, 204, 197, 71, 24,
-			161, 226, 105, 2, 46, 141, 88, 131, 57, 0, 53, 46, 92, 11,
-			64, 142, 64, 213, 143, 138, 167, 79, 230, 202, 135, 21, 88, 4,
-			144, 93, 249, 251, 209, 185, 226, 147, 185, 99, 207, 18, 95, 37,
-			92, 228, 60, 247, 51, 57, 251, 120, 233, 139, 166, 121, 39, 112,
-			154, 103, 236, 41, 74, 61, 2, 38, 79, 239, 33, 138, 35, 165,
-			62, 37, 147, 78, 36, 93, 133, 181, 156, 139, 83, 213, 32, 206,
-			92, 35, 49, 103, 1, 56, 164, 116, 2, 57, 7, 64, 126, 134,
-			234, 71, 247, 132, 207, 228, 248, 25, 170, 31, 221, 19, 62, 147,
-			155, 152, 20, 207, 65, 28, 230, 61, 247, 15, 115, 118, 165, 116,
-			252, 7, 79, 58, 74, 237, 229, 93, 108, 65, 131, 57, 0, 245,
-			208, 242, 22, 128, 154, 214, 243, 14, 128, 187, 247, 42, 176, 8,
-			96, 233, 6, 5, 22, 0, 188, 110, 130, 194, 138, 244, 131, 208,
-			245, 167, 57, 251, 38, 10, 43, 66, 90, 128, 244, 52, 162, 149,
-			166, 243, 45, 155, 57, 186, 21, 135, 40, 162, 71, 157, 182, 114,
-			1, 194, 52, 64, 193, 114, 103, 85, 89, 41, 36, 36, 62, 166,
-			162, 69, 87, 190, 205, 36, 104, 38, 68, 224, 181, 56, 192, 72,
-			22, 126, 35, 93, 142, 130, 139, 35, 211, 96, 14, 64, 61, 231,
-			130, 5, 224, 144, 18, 37, 11, 14, 128, 7, 15, 41, 176, 8,
-			96, 249, 70, 5, 226, 36, 143, 158, 16, 159, 194, 57, 187, 182,
-			151, 255, 124, 206, 254, 82, 206, 41, 61, 98, 105, 175, 175, 72,
-			46, 204, 44, 205, 204, 45, 205, 206, 207, 93, 92, 156, 191, 176,
-			48, 61, 83, 49, 223, 70, 20, 17, 178, 55, 72, 170, 93, 95,
-			14, 155, 126, 188, 89, 37, 22, 127, 18, 83, 226, 172, 200, 231,
-			249, 205, 142, 31, 111, 202, 19, 199, 78, 220, 88, 209, 90, 52,
-			29, 222, 17, 165, 42, 216, 226, 171, 49, 48, 16, 10, 147, 200,
-			71, 235, 102, 208, 22, 202, 126, 65, 249, 89, 141, 45, 79, 158,
-			184, 249, 166, 103, 221, 122, 227, 77, 207, 190, 85, 115, 64, 12,
-			49, 253, 249, 92, 97, 23, 235, 26, 48, 99, 228, 159, 229, 220,
-			18, 75, 147, 148, 21, 242, 207, 82, 229, 131, 141, 130, 239, 159,
-			229, 246, 236, 213, 95, 88, 158, 251, 5, 243, 11, 139, 10, 210,
-			47, 64, 186, 253, 130, 249, 133, 237, 185, 95, 204, 185, 123, 117,
-			5, 104, 226, 139, 57, 78, 41, 128, 5, 88, 99, 247, 30, 84,
-			170, 246, 131, 140, 255, 229, 156, 173, 56, 109, 209, 69, 80, 45,
-			106, 49, 15, 96, 159, 218, 84, 69, 11, 192, 125, 138, 51, 21,
-			29, 0, 143, 140, 139, 95, 182, 113, 217, 28, 47, 255, 215, 57,
-			251, 27, 57, 167, 244, 86, 155, 165, 188, 76, 196, 69, 186, 190,
-			68, 43, 218, 177, 191, 155, 144, 81, 165, 132, 201, 221, 219, 81,
-			234, 184, 38, 200, 59, 82, 11, 110, 179, 43, 44, 90, 118, 154,
-			202, 158, 173, 217, 213, 200, 50, 201, 142, 141, 205, 244, 45, 163,
-			41, 140, 112, 174, 32, 255, 40, 95, 15, 28, 204, 102, 245, 7,
-			35, 15, 241, 132, 244, 241, 212, 200, 3, 142, 192, 191, 206, 21,
-			118, 243, 210, 97, 130, 184, 191, 201, 185, 251, 121, 165, 40, 131,
-			219, 223, 228, 56, 26, 60, 22, 216, 80, 80, 218, 167, 191, 176,
-			60, 247, 43, 233, 98, 83, 182, 161, 175, 164, 139, 237, 32, 121,
-			124, 5, 22, 91, 125, 97, 123, 238, 223, 230, 220, 195, 186, 2,
-			52, 241, 183, 57, 247, 96, 90, 128, 53, 202, 215, 234, 47, 28,
-			207, 253, 59, 115, 84, 48, 236, 191, 51, 71, 229, 216, 80, 96,
-			140, 202, 245, 220, 71, 83, 162, 117, 232, 97, 37, 37, 90, 7,
-			147, 113, 60, 154, 18, 173, 3, 135, 216, 87, 205, 47, 128, 153,
-			127, 213, 252, 34, 103, 67, 129, 241, 69, 222, 115, 255, 222, 156,
-			57, 240, 216, 191, 55, 103, 158, 183, 161, 192, 152, 121, 193, 115,
-			191, 102, 78, 20, 56, 212, 215, 204, 78, 11, 54, 20, 92, 115,
-			64, 127, 81, 244, 220, 175, 155, 51, 7, 242, 255, 186, 57, 243,
-			162, 13, 5, 198, 204, 123, 61, 247, 31, 114, 238, 62, 93, 161,
-			215, 194, 130, 93, 105, 129, 13, 5, 28, 81, 171, 31, 234, 127,
-			51, 103, 143, 243, 126, 234, 117, 17, 84, 155, 175, 55, 15, 32,
-			7, 6, 235, 199, 182, 190, 153, 227, 192, 96, 253, 232, 93, 242,
-			205, 220, 245, 99, 116, 27, 119, 122, 188, 252, 183, 115, 246, 63,
-			169, 219, 56, 6, 235, 251, 118, 78, 240, 244, 41, 88, 223, 119,
-			114, 238, 33, 26, 9, 135, 231, 251, 78, 142, 131, 231, 97, 65,
-			30, 10, 118, 140, 166, 5, 22, 20, 236, 220, 159, 22, 56, 80,
-			112, 80, 138, 50, 183, 105, 121, 238, 119, 115, 238, 129, 178, 39,
-			151, 130, 7, 218, 244, 110, 204, 81, 182, 245, 71, 150, 139, 149,
-			210, 126, 172, 28, 20, 112, 74, 185, 126, 14, 210, 247, 221, 220,
-			232, 222, 180, 192, 129, 130, 253, 215, 48, 146, 132, 231, 126, 47,
-			103, 171, 163, 91, 184, 8, 42, 36, 137, 60, 128, 125, 234, 48,
-			21, 22, 128, 37, 197, 206, 132, 3, 224, 209, 73, 124, 164, 235,
-			183, 251, 60, 247, 177, 220, 211, 125, 164, 235, 71, 207, 139, 199,
-			210, 174, 251, 242, 0, 242, 35, 93, 63, 122, 94, 60, 150, 219,
-			165, 36, 142, 62, 7, 64, 126, 164, 235, 183, 251, 61, 247, 241,
-			220, 143, 238, 145, 174, 31, 253, 34, 30, 79, 7, 211, 159, 7,
-			176, 79, 245, 222, 111, 1, 120, 80, 29, 176, 253, 14, 128, 252,
-			72, 215, 159, 243, 220, 127, 205, 61, 51, 143, 116, 253, 185, 30,
-			104, 156, 31, 233, 250, 241, 145, 238, 95, 115, 252, 72, 215, 143,
-			143, 116, 255, 154, 219, 185, 71, 236, 135, 113, 244, 122, 238, 191,
-			203, 219, 59, 202, 131, 218, 233, 233, 197, 168, 2, 161, 150, 122,
-			123, 224, 103, 126, 170, 239, 71, 123, 32, 19, 180, 25, 188, 149,
-			91, 250, 247, 121, 123, 160, 92, 209, 225, 94, 72, 190, 173, 240,
-			191, 23, 163, 229, 36, 2, 209, 244, 98, 61, 186, 216, 140, 218,
-			23, 59, 137, 238, 198, 130, 111, 217, 6, 181, 31, 77, 137, 76,
-			208, 102, 176, 95, 216, 238, 14, 47, 255, 19, 249, 158, 159, 230,
-			87, 197, 29, 150, 231, 254, 68, 190, 56, 138, 180, 181, 3, 246,
-			215, 43, 242, 246, 181, 79, 143, 182, 118, 224, 118, 124, 69, 222,
-			214, 96, 30, 64, 166, 173, 29, 184, 21, 95, 145, 103, 218, 218,
-			129, 27, 241, 21, 249, 67, 101, 164, 173, 29, 152, 82, 34, 255,
-			163, 163, 173, 29, 184, 103, 127, 42, 29, 140, 149, 7, 176, 79,
-			245, 110, 97, 127, 76, 91, 59, 112, 183, 254, 84, 158, 105, 107,
-			71, 206, 115, 95, 155, 127, 102, 104, 107, 7, 208, 214, 107, 243,
-			76, 91, 59, 144, 182, 94, 155, 103, 218, 218, 129, 180, 245, 218,
-			60, 63, 0, 15, 120, 249, 215, 231, 123, 126, 153, 151, 106, 192,
-			242, 220, 215, 231, 139, 35, 226, 146, 112, 221, 1, 88, 170, 135,
-			242, 182, 44, 45, 232, 204, 144, 134, 237, 189, 50, 37, 166, 87,
-			173, 117, 159, 2, 57, 235, 199, 94, 74, 28, 236, 175, 7, 218,
-			83, 40, 193, 128, 87, 138, 237, 193, 88, 6, 112, 53, 31, 82,
-			8, 28, 64, 35, 206, 135, 242, 44, 27, 15, 224, 106, 62, 148,
-			31, 218, 167, 64, 7, 192, 3, 7, 197, 223, 90, 56, 60, 203,
-			115, 223, 156, 183, 143, 151, 62, 159, 222, 232, 56, 106, 232, 51,
-			104, 91, 72, 161, 55, 158, 217, 251, 156, 202, 54, 74, 211, 6,
-			34, 123, 115, 138, 35, 56, 22, 222, 156, 226, 8, 211, 36, 231,
-			249, 58, 55, 128, 68, 246, 230, 60, 95, 231, 6, 208, 64, 243,
-			205, 121, 190, 206, 13, 160, 129, 230, 155, 243, 19, 147, 104, 19,
-			52, 208, 235, 185, 111, 201, 63, 129, 77, 208, 0, 112, 150, 183,
-			40, 86, 50, 128, 156, 197, 4, 109, 6, 63, 109, 227, 114, 216,
-			158, 251, 112, 222, 158, 47, 253, 166, 253, 63, 135, 77, 16, 25,
-			227, 44, 144, 165, 13, 18, 81, 183, 65, 78, 151, 249, 141, 97,
-			125, 35, 158, 192, 252, 102, 0, 223, 34, 30, 78, 87, 28, 164,
-			190, 135, 211, 21, 71, 37, 127, 158, 205, 111, 6, 240, 45, 226,
-			225, 252, 173, 39, 21, 88, 4, 240, 212, 28, 202, 51, 3, 148,
-			56, 250, 225, 252, 109, 247, 32, 247, 29, 128, 202, 239, 126, 218,
-			220, 119, 0, 117, 85, 239, 78, 71, 230, 228, 1, 100, 238, 59,
-			128, 18, 239, 187, 21, 247, 29, 64, 93, 213, 187, 21, 247, 29,
-			128, 73, 189, 247, 71, 200, 125, 7, 80, 89, 244, 222, 116, 48,
-			110, 30, 192, 62, 213, 59, 8, 211, 239, 85, 220, 119, 0, 149,
-			69, 239, 85, 220, 119, 32, 231, 185, 31, 120, 134, 184, 239, 0,
-			112, 223, 15, 40, 238, 59, 128, 220, 247, 3, 138, 251, 14, 32,
-			247, 253, 128, 226, 190, 131, 94, 254, 131, 249, 158, 223, 101, 238,
-			59, 104, 121, 238, 7, 243, 197, 61, 226, 127, 0, 127, 27, 68,
-			223, 13, 224, 111, 95, 237, 226, 111, 164, 198, 120, 198, 185, 28,
-			245, 243, 76, 27, 81, 27, 241, 159, 121, 97, 7, 201, 180, 95,
-			45, 236, 32, 153, 246, 43, 250, 31, 36, 211, 126, 197, 241, 6,
-			201, 180, 95, 113, 188, 65, 50, 237, 87, 28, 111, 144, 76, 251,
-			129, 227, 221, 142, 40, 181, 60, 247, 67, 79, 155, 252, 7, 145,
-			21, 127, 40, 29, 24, 156, 247, 31, 82, 228, 63, 136, 172, 248,
-			67, 138, 252, 7, 145, 21, 127, 8, 200, 255, 191, 208, 114, 218,
-			158, 251, 145, 188, 125, 99, 233, 63, 119, 43, 168, 148, 238, 156,
-			22, 34, 13, 64, 147, 93, 239, 109, 213, 86, 98, 91, 189, 213,
-			15, 170, 182, 18, 219, 232, 173, 6, 145, 11, 125, 36, 157, 44,
-			112, 161, 143, 164, 171, 0, 168, 252, 72, 158, 245, 86, 131, 200,
-			133, 62, 146, 63, 40, 21, 88, 4, 240, 208, 9, 5, 22, 0,
-			172, 28, 71, 78, 48, 136, 143, 129, 63, 66, 78, 48, 72, 42,
-			244, 116, 168, 192, 150, 62, 170, 56, 193, 32, 169, 208, 21, 39,
-			24, 36, 21, 186, 226, 4, 131, 57, 207, 253, 196, 51, 196, 9,
-			6, 129, 19, 124, 66, 113, 130, 65, 228, 4, 159, 80, 156, 96,
-			16, 57, 193, 39, 20, 39, 24, 242, 242, 159, 202, 247, 124, 137,
-			57, 193, 144, 229, 185, 159, 202, 23, 119, 33, 213, 14, 1, 35,
-			248, 116, 254, 233, 94, 199, 134, 112, 59, 125, 90, 97, 103, 8,
-			69, 230, 79, 43, 170, 29, 194, 237, 244, 105, 69, 181, 67, 184,
-			157, 62, 157, 63, 116, 173, 248, 162, 131, 125, 91, 158, 251, 185,
-			188, 125, 194, 244, 225, 72, 99, 142, 61, 131, 28, 136, 59, 121,
-			166, 217, 143, 138, 24, 81, 21, 39, 190, 106, 177, 106, 139, 34,
-			74, 235, 8, 161, 199, 117, 104, 208, 27, 79, 168, 104, 212, 105,
-			234, 66, 82, 130, 31, 73, 210, 212, 216, 11, 231, 167, 133, 148,
-			114, 37, 246, 215, 131, 141, 40, 190, 92, 149, 152, 130, 187, 21,
-			53, 162, 85, 32, 98, 204, 150, 27, 249, 113, 157, 77, 144, 18,
-			35, 173, 107, 36, 163, 78, 156, 4, 141, 43, 65, 194, 182, 249,
-			82, 110, 4, 20, 124, 69, 165, 245, 160, 141, 142, 97, 68, 48,
-			97, 15, 139, 62, 27, 152, 6, 58, 228, 199, 79, 101, 118, 120,
-			158, 159, 97, 161, 161, 211, 20, 41, 82, 211, 5, 112, 179, 207,
-			165, 116, 1, 130, 229, 231, 212, 6, 31, 66, 110, 246, 57, 197,
-			102, 135, 144, 155, 125, 46, 207, 177, 31, 134, 80, 176, 252, 92,
-			158, 99, 63, 12, 161, 96, 249, 185, 252, 13, 199, 112, 131, 15,
-			1, 171, 251, 252, 143, 112, 131, 15, 33, 47, 250, 124, 58, 84,
-			59, 15, 96, 159, 162, 89, 212, 31, 171, 13, 62, 132, 188, 232,
-			243, 106, 131, 15, 229, 60, 247, 139, 207, 208, 6, 31, 130, 13,
-			254, 69, 181, 193, 135, 112, 131, 127, 81, 109, 240, 33, 220, 224,
-			95, 84, 27, 124, 216, 203, 127, 57, 223, 243, 207, 188, 193, 135,
-			45, 207, 253, 114, 190, 184, 83, 124, 30, 118, 217, 48, 236, 240,
-			71, 97, 151, 125, 217, 244, 148, 194, 23, 230, 103, 216, 81, 10,
-			250, 120, 230, 253, 164, 56, 178, 215, 255, 108, 91, 108, 24, 89,
-			239, 163, 138, 110, 135, 81, 146, 121, 84, 109, 177, 97, 100, 189,
-			143, 170, 45, 54, 140, 172, 247, 81, 181, 197, 134, 81, 146, 121,
-			84, 109, 177, 97, 148, 100, 30, 133, 45, 246, 45, 16, 39, 134,
-			221, 30, 47, 255, 15, 121, 251, 91, 121, 39, 235, 94, 199, 102,
-			9, 245, 224, 40, 233, 212, 143, 162, 109, 200, 88, 20, 147, 177,
-			64, 216, 148, 119, 45, 45, 157, 135, 61, 217, 240, 155, 181, 96,
-			156, 22, 191, 30, 172, 183, 162, 118, 208, 132, 101, 141, 98, 217,
-			12, 194, 246, 90, 16, 63, 135, 234, 46, 251, 73, 80, 199, 88,
-			73, 93, 246, 89, 134, 207, 209, 217, 153, 37, 32, 142, 101, 138,
-			74, 231, 175, 4, 66, 45, 59, 57, 226, 158, 191, 96, 252, 158,
-			118, 167, 237, 15, 12, 227, 91, 211, 0, 234, 252, 252, 226, 146,
-			66, 38, 26, 36, 255, 67, 158, 223, 1, 134, 201, 32, 249, 27,
-			121, 214, 59, 15, 179, 253, 241, 55, 242, 172, 119, 30, 102, 251,
-			227, 111, 228, 75, 251, 196, 97, 254, 194, 242, 220, 127, 204, 187,
-			123, 202, 163, 228, 210, 19, 36, 198, 88, 132, 254, 204, 162, 106,
-			35, 105, 129, 13, 5, 187, 118, 139, 91, 184, 29, 219, 115, 191,
-			153, 119, 71, 202, 71, 76, 212, 81, 166, 1, 21, 184, 29, 67,
-			105, 210, 26, 36, 105, 211, 48, 130, 111, 230, 57, 73, 241, 48,
-			91, 205, 126, 51, 143, 249, 182, 129, 17, 88, 94, 254, 219, 121,
-			251, 59, 249, 235, 121, 213, 129, 71, 127, 59, 37, 32, 144, 56,
-			191, 157, 103, 85, 247, 48, 14, 244, 219, 249, 253, 71, 21, 232,
-			0, 200, 17, 35, 135, 145, 71, 127, 39, 159, 191, 78, 129, 5,
-			0, 135, 15, 163, 80, 49, 12, 253, 126, 239, 105, 11, 21, 195,
-			200, 145, 191, 151, 14, 12, 56, 242, 247, 148, 80, 49, 140, 211,
-			252, 158, 18, 42, 134, 145, 35, 127, 47, 207, 58, 222, 97, 128,
-			30, 251, 17, 30, 15, 195, 40, 255, 61, 150, 14, 6, 228, 191,
-			199, 212, 241, 48, 140, 242, 223, 99, 234, 120, 24, 70, 249, 239,
-			49, 117, 60, 12, 231, 60, 247, 241, 103, 232, 120, 24, 134, 227,
-			225, 113, 117, 60, 12, 227, 241, 240, 184, 58, 30, 134, 241, 120,
-			120, 28, 142, 135, 215, 56, 194, 118, 61, 47, 255, 19, 133, 158,
-			159, 43, 88, 165, 127, 182, 229, 148, 182, 85, 209, 86, 164, 192,
-			144, 124, 173, 126, 75, 209, 166, 159, 211, 52, 150, 116, 182, 189,
-			198, 166, 144, 126, 171, 21, 248, 20, 255, 77, 205, 130, 67, 190,
-			83, 242, 49, 21, 54, 78, 187, 177, 157, 60, 121, 158, 3, 231,
-			83, 140, 92, 51, 157, 82, 20, 53, 84, 42, 142, 132, 217, 42,
-			222, 45, 208, 185, 9, 6, 120, 198, 200, 155, 135, 177, 141, 146,
-			106, 38, 172, 90, 215, 16, 194, 102, 38, 211, 30, 125, 65, 193,
-			196, 101, 28, 180, 59, 113, 147, 198, 151, 54, 123, 242, 36, 55,
-			49, 54, 78, 156, 170, 21, 71, 148, 49, 178, 171, 218, 116, 212,
-			218, 92, 138, 198, 198, 199, 217, 138, 15, 195, 150, 226, 190, 188,
-			96, 198, 234, 215, 1, 253, 85, 54, 0, 138, 99, 237, 89, 158,
-			251, 19, 133, 226, 62, 241, 7, 182, 112, 93, 207, 233, 241, 242,
-			175, 46, 216, 63, 93, 112, 74, 191, 101, 147, 30, 201, 136, 58,
-			152, 9, 254, 159, 90, 205, 97, 126, 7, 142, 117, 170, 87, 145,
-			146, 208, 172, 114, 196, 67, 33, 125, 89, 143, 218, 71, 85, 252,
-			223, 186, 114, 0, 15, 147, 139, 105, 144, 83, 78, 216, 40, 195,
-			149, 21, 227, 107, 179, 201, 166, 145, 1, 64, 142, 213, 131, 102,
-			164, 111, 145, 148, 65, 23, 150, 42, 67, 3, 176, 173, 147, 110,
-			55, 201, 241, 170, 144, 51, 213, 213, 106, 229, 223, 200, 251, 203,
-			43, 81, 84, 174, 144, 89, 250, 11, 43, 242, 254, 242, 178, 31,
-			87, 151, 253, 7, 203, 21, 28, 12, 22, 173, 27, 85, 228, 75,
-			141, 17, 9, 9, 159, 87, 199, 248, 155, 241, 42, 212, 228, 205,
-			234, 225, 139, 219, 171, 11, 130, 82, 166, 123, 244, 226, 246, 154,
-			130, 91, 70, 150, 232, 241, 139, 219, 107, 10, 252, 18, 230, 113,
-			66, 172, 215, 20, 248, 37, 204, 227, 23, 183, 215, 20, 70, 175,
-			73, 11, 28, 40, 144, 135, 116, 155, 150, 231, 190, 182, 224, 94,
-			171, 43, 0, 31, 125, 173, 217, 38, 72, 187, 175, 45, 236, 24,
-			78, 11, 240, 19, 239, 64, 90, 224, 64, 193, 161, 50, 238, 101,
-			15, 70, 249, 186, 130, 77, 73, 91, 60, 28, 227, 235, 10, 204,
-			113, 60, 188, 83, 189, 174, 208, 55, 162, 64, 11, 192, 209, 61,
-			10, 116, 0, 220, 183, 159, 146, 87, 123, 48, 184, 55, 22, 236,
-			35, 165, 159, 182, 140, 28, 172, 79, 64, 77, 21, 88, 169, 141,
-			53, 191, 141, 84, 140, 102, 218, 40, 252, 69, 151, 3, 216, 240,
-			177, 128, 83, 136, 242, 167, 96, 22, 11, 63, 145, 245, 78, 76,
-			254, 3, 104, 168, 86, 149, 51, 28, 213, 130, 211, 89, 211, 230,
-			53, 188, 97, 2, 21, 72, 204, 67, 84, 189, 49, 157, 26, 32,
-			234, 141, 5, 182, 132, 246, 16, 77, 111, 44, 112, 212, 38, 15,
-			145, 244, 198, 194, 117, 215, 51, 146, 108, 207, 125, 168, 192, 239,
-			180, 30, 158, 17, 15, 165, 45, 217, 57, 0, 117, 75, 128, 134,
-			135, 10, 222, 97, 5, 58, 0, 30, 25, 227, 150, 28, 207, 125,
-			83, 129, 83, 65, 121, 200, 224, 223, 148, 182, 228, 228, 0, 100,
-			175, 39, 15, 25, 252, 155, 10, 195, 215, 42, 16, 191, 189, 254,
-			8, 183, 228, 122, 238, 207, 20, 108, 245, 163, 75, 160, 106, 201,
-			205, 1, 168, 199, 228, 90, 0, 114, 48, 30, 15, 149, 134, 63,
-			147, 146, 64, 206, 115, 127, 182, 96, 171, 169, 231, 92, 4, 85,
-			75, 57, 252, 85, 143, 41, 103, 1, 56, 172, 232, 37, 231, 0,
-			200, 73, 126, 60, 56, 45, 255, 83, 129, 243, 58, 121, 104, 21,
-			245, 159, 210, 150, 242, 57, 0, 245, 152, 242, 22, 128, 222, 33,
-			5, 58, 0, 30, 190, 78, 252, 57, 28, 27, 35, 94, 254, 225,
-			66, 207, 63, 23, 172, 210, 227, 54, 41, 164, 103, 154, 157, 245,
-			132, 61, 159, 228, 42, 153, 38, 203, 49, 144, 193, 72, 76, 33,
-			11, 104, 114, 55, 83, 158, 221, 201, 56, 37, 147, 129, 115, 4,
-			195, 108, 39, 181, 168, 197, 153, 102, 56, 14, 5, 219, 160, 4,
-			70, 214, 26, 229, 64, 130, 207, 21, 228, 140, 135, 135, 39, 156,
-			28, 107, 130, 248, 15, 213, 135, 206, 216, 46, 58, 89, 139, 226,
-			54, 63, 12, 109, 4, 71, 174, 232, 212, 32, 235, 254, 3, 24,
-			216, 24, 109, 89, 143, 38, 97, 155, 204, 3, 235, 62, 133, 146,
-			33, 233, 170, 17, 0, 11, 239, 36, 116, 17, 65, 63, 59, 124,
-			93, 10, 19, 153, 212, 130, 166, 31, 135, 17, 57, 103, 144, 243,
-			156, 26, 225, 6, 166, 53, 197, 32, 47, 73, 184, 218, 228, 252,
-			26, 93, 46, 130, 248, 68, 65, 154, 252, 138, 92, 139, 90, 1,
-			38, 71, 55, 100, 127, 58, 67, 96, 127, 25, 177, 60, 40, 216,
-			209, 137, 249, 31, 77, 130, 160, 59, 89, 12, 163, 99, 104, 196,
-			242, 220, 135, 11, 69, 146, 13, 71, 64, 226, 127, 103, 193, 126,
-			111, 129, 76, 23, 70, 80, 30, 126, 103, 161, 48, 138, 76, 111,
-			132, 228, 225, 119, 21, 216, 114, 99, 132, 229, 225, 119, 21, 216,
-			114, 99, 132, 229, 225, 119, 21, 216, 114, 99, 132, 228, 225, 119,
-			23, 220, 97, 93, 193, 162, 130, 254, 180, 192, 134, 130, 193, 33,
-			253, 133, 237, 185, 191, 100, 126, 1, 77, 252, 146, 249, 5, 213,
-			48, 190, 112, 60, 247, 61, 5, 119, 143, 174, 0, 187, 245, 61,
-			5, 150, 174, 177, 192, 134, 130, 93, 187, 105, 154, 118, 143, 151,
-			127, 95, 193, 254, 213, 130, 75, 211, 68, 86, 251, 62, 181, 59,
-			70, 144, 213, 190, 175, 192, 41, 176, 70, 112, 142, 239, 43, 236,
-			61, 162, 64, 7, 192, 137, 27, 196, 32, 129, 69, 104, 172, 242,
-			171, 5, 135, 134, 99, 247, 20, 135, 61, 247, 253, 5, 182, 239,
-			0, 120, 164, 199, 115, 63, 160, 177, 6, 5, 150, 231, 254, 114,
-			193, 61, 160, 11, 70, 123, 60, 247, 191, 20, 220, 153, 180, 192,
-			242, 220, 15, 102, 10, 108, 207, 253, 149, 130, 59, 205, 11, 101,
-			121, 249, 95, 43, 216, 31, 210, 11, 5, 88, 253, 181, 66, 97,
-			144, 81, 130, 30, 31, 143, 164, 235, 66, 142, 29, 143, 20, 216,
-			140, 103, 132, 29, 59, 30, 41, 176, 25, 207, 8, 57, 118, 252,
-			122, 129, 253, 43, 70, 216, 123, 227, 215, 11, 236, 175, 55, 194,
-			222, 27, 191, 94, 96, 127, 189, 17, 242, 222, 248, 141, 130, 59,
-			168, 43, 64, 19, 191, 81, 96, 231, 204, 17, 118, 209, 248, 141,
-			194, 142, 1, 70, 187, 229, 229, 63, 92, 176, 127, 71, 163, 29,
-			142, 129, 15, 167, 104, 135, 155, 199, 135, 213, 9, 55, 130, 253,
-			127, 24, 78, 96, 6, 29, 0, 229, 33, 70, 187, 85, 132, 198,
-			202, 191, 163, 209, 110, 1, 218, 127, 51, 69, 187, 133, 104, 255,
-			173, 20, 203, 22, 162, 253, 183, 205, 2, 64, 251, 71, 0, 169,
-			186, 192, 242, 220, 255, 165, 224, 78, 49, 150, 109, 47, 255, 177,
-			130, 253, 73, 141, 101, 152, 224, 199, 10, 133, 253, 140, 1, 180,
-			34, 252, 120, 193, 29, 227, 9, 147, 21, 225, 199, 149, 80, 48,
-			194, 86, 132, 31, 135, 131, 66, 125, 97, 121, 238, 39, 82, 156,
-			145, 21, 225, 39, 82, 156, 145, 21, 225, 39, 8, 103, 244, 133,
-			237, 185, 191, 155, 110, 7, 178, 34, 252, 221, 116, 59, 216, 92,
-			3, 183, 3, 96, 217, 246, 242, 159, 42, 216, 127, 160, 177, 12,
-			167, 211, 167, 82, 44, 195, 193, 240, 169, 2, 39, 7, 28, 193,
-			214, 62, 85, 56, 244, 108, 5, 58, 0, 158, 186, 141, 177, 108,
-			23, 161, 177, 219, 255, 64, 99, 217, 6, 44, 255, 175, 41, 150,
-			109, 196, 242, 239, 165, 196, 109, 35, 150, 63, 157, 98, 217, 70,
-			44, 255, 126, 74, 203, 54, 98, 249, 51, 41, 45, 59, 94, 254,
-			15, 11, 246, 159, 106, 44, 195, 238, 253, 195, 66, 97, 39, 99,
-			0, 141, 241, 254, 168, 224, 74, 158, 48, 25, 227, 253, 81, 129,
-			109, 187, 70, 216, 24, 239, 143, 10, 7, 14, 234, 47, 44, 207,
-			253, 227, 20, 203, 100, 140, 247, 199, 41, 150, 201, 24, 239, 143,
-			83, 44, 163, 49, 222, 159, 164, 212, 79, 198, 120, 127, 146, 82,
-			63, 25, 227, 253, 9, 81, 63, 96, 217, 241, 242, 159, 45, 216,
-			95, 208, 88, 6, 241, 225, 179, 41, 150, 225, 126, 248, 217, 2,
-			219, 66, 141, 32, 67, 250, 108, 161, 52, 174, 64, 7, 192, 202,
-			81, 198, 178, 83, 132, 198, 170, 95, 208, 88, 118, 0, 203, 159,
-			75, 177, 236, 32, 150, 255, 183, 20, 203, 14, 98, 249, 127, 79,
-			177, 236, 32, 150, 63, 15, 164, 171, 11, 44, 207, 253, 179, 20,
-			203, 174, 151, 255, 82, 193, 254, 63, 52, 150, 65, 14, 249, 82,
-			129, 45, 98, 71, 92, 23, 176, 252, 231, 186, 75, 128, 45, 44,
-			216, 159, 22, 216, 80, 112, 80, 234, 47, 44, 207, 253, 114, 202,
-			118, 93, 196, 242, 151, 83, 182, 235, 34, 150, 191, 76, 108, 151,
-			190, 176, 61, 247, 47, 10, 174, 167, 43, 64, 19, 127, 145, 162,
-			157, 168, 253, 47, 10, 67, 195, 140, 101, 215, 203, 255, 101, 193,
-			254, 138, 198, 50, 136, 86, 127, 153, 98, 217, 205, 3, 200, 54,
-			177, 35, 40, 90, 253, 101, 97, 223, 13, 10, 116, 0, 172, 78,
-			50, 150, 221, 34, 52, 118, 236, 43, 26, 203, 46, 96, 249, 175,
-			82, 44, 187, 136, 229, 255, 158, 98, 217, 69, 44, 255, 117, 138,
-			101, 23, 177, 252, 55, 5, 247, 28, 35, 53, 231, 229, 255, 174,
-			96, 127, 77, 35, 21, 68, 178, 191, 43, 20, 134, 121, 194, 152,
-			130, 250, 209, 20, 135, 148, 102, 250, 209, 2, 235, 143, 176, 192,
-			134, 2, 182, 91, 28, 161, 84, 211, 95, 45, 176, 246, 102, 132,
-			211, 73, 127, 181, 192, 217, 16, 176, 192, 134, 130, 254, 29, 250,
-			11, 219, 115, 255, 62, 221, 13, 57, 68, 234, 223, 23, 216, 210,
-			17, 11, 176, 198, 222, 18, 35, 53, 231, 229, 191, 94, 176, 191,
-			163, 145, 10, 82, 230, 215, 83, 164, 230, 242, 0, 178, 158, 101,
-			4, 165, 204, 175, 23, 118, 29, 82, 160, 3, 224, 225, 235, 24,
-			169, 185, 34, 52, 118, 253, 119, 52, 82, 115, 128, 212, 127, 72,
-			145, 154, 67, 164, 126, 35, 83, 96, 121, 238, 63, 166, 72, 205,
-			21, 71, 108, 207, 253, 166, 89, 0, 88, 254, 86, 193, 157, 79,
-			11, 44, 207, 253, 118, 193, 61, 77, 50, 75, 175, 231, 254, 83,
-			193, 166, 152, 82, 35, 189, 61, 0, 245, 13, 208, 248, 208, 130,
-			196, 4, 109, 6, 15, 194, 135, 57, 207, 253, 63, 11, 182, 87,
-			246, 80, 71, 120, 158, 227, 195, 131, 24, 75, 234, 148, 145, 92,
-			15, 212, 96, 253, 201, 8, 170, 83, 76, 208, 102, 80, 114, 99,
-			255, 3, 26, 27, 201, 54, 246, 60, 255, 138, 175, 90, 179, 160,
-			138, 254, 220, 234, 2, 109, 6, 15, 112, 107, 143, 65, 107, 195,
-			217, 214, 206, 70, 170, 45, 27, 42, 232, 143, 97, 141, 77, 80,
-			253, 122, 45, 183, 245, 253, 130, 93, 42, 239, 66, 31, 10, 173,
-			210, 110, 7, 73, 59, 108, 174, 170, 6, 29, 168, 181, 131, 57,
-			84, 14, 56, 212, 247, 211, 6, 65, 96, 250, 126, 97, 231, 94,
-			113, 146, 27, 124, 188, 96, 143, 148, 143, 146, 218, 155, 179, 134,
-			173, 134, 237, 181, 206, 50, 166, 10, 91, 238, 172, 160, 134, 102,
-			82, 167, 133, 83, 17, 210, 71, 128, 186, 30, 47, 236, 240, 184,
-			97, 216, 163, 38, 104, 51, 248, 1, 91, 216, 238, 168, 151, 127,
-			121, 177, 231, 77, 69, 171, 244, 54, 91, 78, 41, 133, 81, 93,
-			171, 8, 179, 145, 49, 117, 156, 104, 206, 45, 219, 134, 63, 148,
-			150, 81, 165, 176, 20, 89, 239, 168, 174, 124, 22, 44, 12, 47,
-			6, 237, 76, 10, 194, 102, 189, 219, 101, 61, 9, 208, 129, 139,
-			133, 125, 212, 80, 117, 84, 10, 70, 19, 54, 30, 214, 73, 217,
-			36, 151, 131, 90, 180, 78, 169, 129, 241, 54, 32, 147, 192, 143,
-			107, 107, 233, 11, 73, 173, 17, 37, 65, 210, 150, 235, 126, 187,
-			134, 145, 218, 89, 15, 90, 225, 224, 144, 164, 121, 82, 241, 203,
-			99, 138, 6, 7, 251, 0, 54, 197, 203, 139, 197, 146, 248, 21,
-			91, 184, 238, 168, 211, 227, 229, 95, 89, 180, 95, 93, 116, 74,
-			255, 25, 245, 123, 126, 139, 38, 137, 65, 224, 57, 149, 37, 183,
-			173, 226, 3, 118, 154, 225, 75, 58, 129, 194, 157, 25, 120, 59,
-			108, 83, 60, 106, 44, 64, 139, 124, 74, 212, 200, 222, 255, 58,
-			233, 34, 189, 244, 7, 117, 86, 156, 34, 197, 249, 156, 163, 90,
-			77, 68, 100, 151, 139, 253, 12, 174, 54, 111, 25, 197, 148, 143,
-			129, 60, 246, 248, 114, 20, 182, 181, 111, 24, 47, 139, 186, 58,
-			26, 233, 47, 49, 134, 164, 164, 160, 143, 210, 135, 235, 26, 189,
-			100, 65, 131, 152, 9, 60, 163, 97, 30, 69, 61, 209, 43, 139,
-			162, 140, 188, 107, 148, 244, 68, 175, 42, 50, 171, 26, 101, 61,
-			209, 171, 138, 44, 107, 141, 178, 101, 246, 171, 138, 108, 153, 61,
-			202, 122, 162, 87, 21, 217, 50, 123, 148, 245, 68, 175, 42, 242,
-			41, 57, 74, 122, 162, 159, 42, 186, 215, 233, 10, 104, 209, 105,
-			182, 137, 54, 157, 197, 29, 187, 211, 2, 252, 100, 143, 76, 11,
-			28, 40, 184, 246, 48, 238, 168, 81, 212, 102, 21, 109, 122, 181,
-			25, 37, 93, 86, 145, 217, 247, 40, 142, 240, 53, 69, 206, 225,
-			63, 74, 122, 172, 226, 225, 138, 2, 29, 0, 39, 143, 137, 151,
-			91, 216, 148, 229, 185, 175, 47, 218, 71, 74, 109, 10, 88, 26,
-			54, 195, 245, 206, 186, 145, 96, 67, 173, 201, 152, 206, 98, 63,
-			174, 140, 61, 194, 68, 110, 248, 9, 121, 141, 198, 157, 26, 198,
-			61, 213, 209, 33, 212, 178, 81, 53, 229, 229, 140, 218, 81, 77,
-			84, 106, 196, 128, 144, 215, 167, 19, 0, 116, 188, 190, 216, 231,
-			41, 16, 135, 56, 82, 86, 160, 3, 224, 117, 215, 139, 87, 208,
-			4, 108, 207, 125, 8, 38, 240, 0, 77, 0, 110, 248, 157, 245,
-			46, 98, 127, 106, 131, 215, 99, 23, 210, 95, 105, 7, 241, 118,
-			99, 143, 131, 70, 136, 118, 135, 91, 38, 129, 138, 168, 116, 18,
-			32, 101, 63, 148, 78, 2, 21, 81, 233, 36, 80, 17, 5, 147,
-			248, 146, 37, 108, 119, 167, 151, 127, 75, 177, 231, 173, 189, 86,
-			233, 197, 114, 166, 89, 243, 91, 9, 103, 191, 13, 155, 100, 101,
-			195, 65, 127, 57, 220, 134, 142, 176, 194, 225, 127, 40, 149, 27,
-			108, 116, 142, 246, 32, 228, 157, 97, 35, 232, 74, 87, 141, 211,
-			213, 220, 175, 42, 78, 92, 250, 81, 230, 27, 78, 71, 131, 186,
-			32, 24, 56, 241, 168, 157, 150, 231, 190, 165, 88, 220, 37, 94,
-			62, 44, 92, 119, 39, 144, 238, 239, 21, 237, 67, 165, 239, 14,
-			201, 41, 121, 46, 82, 62, 156, 105, 110, 102, 95, 182, 194, 128,
-			60, 163, 179, 45, 74, 63, 155, 142, 15, 230, 42, 200, 183, 52,
-			105, 69, 77, 138, 225, 226, 155, 169, 87, 210, 148, 199, 58, 224,
-			169, 129, 208, 48, 225, 44, 159, 1, 133, 203, 39, 174, 178, 210,
-			105, 0, 48, 123, 102, 38, 169, 168, 158, 235, 193, 3, 65, 156,
-			84, 186, 226, 51, 167, 201, 2, 56, 77, 94, 184, 30, 54, 252,
-			24, 218, 138, 208, 196, 9, 89, 33, 167, 219, 172, 200, 196, 223,
-			148, 27, 1, 123, 35, 209, 20, 116, 136, 200, 171, 6, 254, 5,
-			180, 114, 42, 179, 40, 210, 241, 30, 95, 42, 228, 185, 0, 99,
-			20, 71, 209, 101, 224, 146, 152, 160, 57, 181, 190, 74, 231, 141,
-			173, 63, 81, 83, 47, 226, 224, 145, 47, 122, 145, 254, 7, 254,
-			247, 162, 23, 193, 143, 62, 255, 184, 92, 195, 127, 234, 129, 148,
-			43, 82, 174, 174, 133, 66, 222, 27, 164, 169, 134, 117, 10, 39,
-			217, 224, 245, 164, 56, 154, 73, 203, 111, 74, 137, 233, 154, 100,
-			246, 63, 83, 141, 47, 229, 253, 126, 37, 28, 151, 242, 126, 121,
-			83, 69, 30, 171, 200, 19, 21, 121, 76, 190, 16, 235, 193, 142,
-			222, 88, 139, 26, 91, 39, 86, 229, 15, 151, 187, 62, 172, 200,
-			155, 224, 91, 248, 176, 225, 47, 7, 13, 57, 166, 102, 63, 78,
-			159, 212, 42, 245, 45, 159, 60, 75, 125, 130, 106, 239, 49, 66,
-			19, 215, 15, 42, 43, 91, 234, 31, 87, 245, 41, 49, 237, 74,
-			20, 113, 229, 213, 202, 218, 150, 202, 55, 234, 202, 148, 211, 117,
-			236, 248, 184, 242, 113, 7, 52, 29, 149, 83, 26, 109, 28, 235,
-			73, 103, 144, 239, 138, 70, 21, 182, 147, 160, 177, 34, 199, 194,
-			106, 80, 85, 1, 56, 49, 81, 161, 52, 137, 30, 201, 85, 101,
-			52, 14, 219, 227, 70, 172, 95, 229, 75, 205, 9, 246, 212, 169,
-			207, 129, 136, 18, 210, 207, 74, 217, 136, 86, 57, 208, 65, 208,
-			196, 51, 154, 67, 88, 113, 20, 34, 10, 0, 76, 79, 68, 124,
-			222, 107, 34, 199, 136, 41, 237, 48, 78, 83, 226, 98, 144, 162,
-			218, 101, 57, 214, 138, 146, 36, 92, 110, 232, 212, 223, 104, 181,
-			161, 2, 9, 164, 79, 75, 70, 154, 242, 113, 214, 169, 74, 237,
-			194, 71, 62, 248, 26, 93, 27, 107, 81, 18, 16, 125, 33, 214,
-			180, 27, 64, 57, 149, 229, 202, 26, 139, 104, 205, 161, 3, 234,
-			96, 90, 170, 38, 97, 171, 10, 203, 112, 143, 26, 139, 38, 226,
-			244, 157, 93, 7, 79, 134, 190, 20, 66, 41, 98, 78, 162, 66,
-			230, 16, 118, 12, 252, 113, 226, 95, 205, 108, 146, 86, 140, 214,
-			7, 208, 49, 101, 228, 214, 211, 199, 4, 106, 44, 177, 202, 245,
-			40, 65, 131, 145, 104, 249, 74, 24, 117, 18, 133, 92, 124, 163,
-			211, 115, 171, 151, 25, 175, 24, 3, 82, 231, 53, 86, 82, 153,
-			153, 197, 217, 92, 134, 68, 26, 225, 35, 72, 3, 95, 209, 250,
-			118, 35, 79, 244, 54, 179, 206, 146, 234, 145, 132, 182, 183, 10,
-			41, 65, 177, 79, 49, 57, 49, 83, 85, 216, 78, 248, 101, 144,
-			235, 18, 173, 208, 136, 152, 92, 140, 249, 4, 65, 153, 130, 97,
-			100, 104, 33, 69, 160, 122, 100, 38, 217, 17, 90, 89, 14, 86,
-			195, 102, 147, 237, 253, 183, 195, 12, 197, 102, 79, 214, 252, 152,
-			36, 232, 174, 60, 227, 180, 69, 84, 126, 100, 252, 6, 39, 249,
-			60, 18, 36, 41, 196, 184, 191, 221, 140, 205, 105, 38, 209, 186,
-			74, 31, 219, 85, 19, 90, 214, 47, 231, 235, 129, 223, 228, 120,
-			113, 109, 106, 162, 30, 160, 120, 234, 111, 179, 137, 100, 121, 53,
-			142, 58, 173, 50, 155, 8, 32, 147, 92, 142, 80, 94, 71, 14,
-			133, 94, 3, 105, 218, 120, 189, 51, 13, 124, 153, 9, 89, 83,
-			138, 134, 134, 210, 3, 19, 237, 143, 34, 237, 13, 204, 209, 170,
-			160, 81, 206, 100, 24, 198, 120, 3, 139, 154, 105, 168, 154, 232,
-			74, 16, 55, 252, 22, 34, 106, 58, 205, 81, 214, 142, 217, 131,
-			65, 63, 44, 26, 219, 136, 159, 0, 91, 113, 180, 236, 47, 83,
-			84, 7, 253, 70, 210, 198, 44, 220, 100, 2, 38, 219, 184, 159,
-			21, 150, 148, 105, 6, 185, 100, 180, 253, 38, 197, 160, 195, 216,
-			108, 218, 123, 57, 237, 165, 70, 119, 3, 160, 11, 180, 83, 136,
-			235, 70, 150, 86, 124, 170, 97, 81, 109, 39, 10, 204, 191, 167,
-			68, 181, 157, 40, 48, 255, 94, 145, 213, 206, 59, 81, 96, 254,
-			189, 226, 232, 126, 5, 58, 0, 162, 56, 239, 186, 59, 225, 142,
-			245, 233, 162, 253, 150, 94, 82, 248, 236, 196, 27, 196, 167, 139,
-			194, 19, 175, 46, 136, 60, 192, 32, 225, 252, 85, 209, 173, 148,
-			254, 175, 188, 156, 77, 197, 26, 78, 138, 239, 199, 109, 69, 174,
-			87, 147, 209, 148, 117, 140, 223, 102, 111, 30, 53, 71, 204, 135,
-			111, 68, 142, 99, 187, 56, 195, 202, 77, 98, 62, 3, 102, 106,
-			156, 192, 15, 4, 30, 20, 139, 218, 107, 40, 36, 210, 93, 44,
-			142, 162, 246, 182, 35, 80, 41, 151, 128, 35, 113, 220, 13, 190,
-			187, 49, 91, 86, 59, 177, 218, 37, 223, 160, 176, 12, 189, 224,
-			217, 143, 103, 224, 141, 120, 6, 222, 130, 7, 166, 72, 89, 244,
-			73, 226, 196, 141, 160, 170, 210, 26, 192, 114, 142, 221, 56, 46,
-			229, 228, 36, 126, 167, 130, 90, 87, 113, 114, 99, 183, 140, 107,
-			201, 97, 114, 18, 155, 212, 21, 224, 4, 30, 27, 55, 68, 139,
-			201, 73, 121, 60, 13, 107, 164, 182, 241, 54, 51, 205, 116, 78,
-			201, 29, 76, 76, 222, 132, 163, 212, 39, 112, 55, 154, 50, 31,
-			223, 46, 111, 58, 69, 94, 68, 221, 125, 80, 147, 91, 26, 63,
-			145, 109, 28, 13, 21, 187, 123, 80, 145, 184, 79, 112, 211, 219,
-			85, 194, 233, 111, 109, 254, 248, 182, 98, 31, 214, 229, 200, 222,
-			41, 111, 160, 100, 9, 72, 29, 112, 103, 79, 50, 12, 131, 20,
-			43, 169, 27, 85, 21, 147, 189, 111, 4, 202, 71, 148, 104, 169,
-			225, 39, 109, 69, 147, 91, 22, 31, 86, 94, 147, 70, 215, 49,
-			157, 21, 237, 82, 94, 63, 150, 198, 139, 80, 60, 94, 168, 93,
-			67, 82, 157, 98, 91, 24, 205, 79, 89, 89, 175, 135, 181, 168,
-			17, 53, 199, 217, 225, 121, 39, 95, 223, 255, 74, 93, 181, 119,
-			178, 153, 199, 95, 21, 119, 120, 105, 129, 5, 5, 35, 187, 211,
-			2, 7, 10, 74, 251, 210, 130, 34, 20, 236, 191, 65, 12, 137,
-			34, 23, 216, 80, 114, 205, 132, 248, 172, 205, 123, 222, 242, 220,
-			71, 97, 207, 127, 220, 86, 97, 192, 97, 81, 84, 154, 143, 246,
-			90, 28, 192, 181, 77, 174, 68, 157, 88, 139, 91, 39, 57, 223,
-			123, 35, 108, 194, 245, 0, 255, 174, 69, 141, 206, 122, 179, 34,
-			36, 156, 216, 240, 67, 42, 191, 86, 232, 148, 217, 8, 225, 84,
-			74, 146, 206, 122, 80, 167, 211, 217, 79, 140, 134, 198, 43, 248,
-			41, 181, 67, 14, 105, 80, 63, 214, 177, 49, 201, 253, 76, 157,
-			24, 132, 123, 12, 73, 201, 209, 46, 107, 155, 85, 105, 6, 96,
-			10, 155, 1, 209, 31, 53, 169, 109, 92, 161, 201, 7, 131, 56,
-			58, 74, 150, 155, 32, 128, 232, 248, 88, 155, 81, 135, 78, 139,
-			13, 206, 45, 225, 215, 235, 66, 30, 199, 24, 155, 192, 191, 88,
-			53, 80, 15, 147, 86, 195, 223, 228, 99, 200, 7, 241, 52, 54,
-			214, 206, 114, 17, 169, 233, 218, 89, 57, 40, 48, 214, 206, 66,
-			180, 27, 107, 103, 57, 80, 96, 172, 157, 85, 132, 2, 99, 237,
-			44, 88, 187, 71, 97, 237, 222, 213, 207, 107, 103, 123, 238, 207,
-			245, 186, 227, 165, 55, 245, 3, 113, 35, 173, 46, 226, 197, 19,
-			206, 183, 217, 230, 74, 148, 53, 173, 210, 249, 140, 13, 138, 85,
-			121, 207, 49, 252, 18, 72, 228, 181, 104, 157, 69, 106, 100, 150,
-			97, 106, 223, 139, 103, 55, 107, 23, 50, 109, 168, 203, 59, 126,
-			64, 247, 81, 33, 253, 118, 219, 175, 173, 145, 6, 178, 171, 62,
-			230, 94, 193, 20, 202, 124, 18, 162, 49, 225, 214, 126, 163, 38,
-			42, 57, 130, 90, 7, 163, 199, 64, 181, 132, 50, 215, 202, 102,
-			68, 52, 37, 200, 204, 167, 235, 43, 58, 141, 117, 125, 178, 219,
-			110, 179, 69, 159, 159, 152, 23, 2, 236, 20, 6, 212, 8, 124,
-			16, 43, 46, 214, 3, 26, 247, 69, 61, 32, 108, 224, 114, 16,
-			180, 224, 12, 244, 87, 99, 191, 181, 134, 195, 214, 21, 144, 220,
-			104, 0, 66, 33, 107, 108, 185, 211, 70, 241, 169, 22, 53, 155,
-			20, 179, 172, 29, 141, 147, 242, 144, 226, 141, 169, 221, 84, 165,
-			147, 81, 183, 141, 129, 228, 148, 197, 219, 242, 38, 229, 226, 234,
-			158, 76, 148, 98, 44, 61, 83, 73, 98, 72, 47, 13, 58, 25,
-			192, 60, 70, 8, 92, 75, 63, 225, 32, 126, 102, 162, 162, 83,
-			250, 199, 117, 63, 190, 12, 251, 132, 44, 13, 39, 39, 217, 250,
-			5, 221, 76, 91, 20, 11, 136, 229, 62, 146, 86, 21, 30, 42,
-			10, 135, 64, 15, 109, 206, 187, 134, 68, 211, 148, 126, 210, 14,
-			226, 48, 185, 44, 244, 12, 116, 115, 91, 57, 38, 222, 236, 48,
-			29, 47, 16, 70, 148, 70, 169, 37, 61, 65, 156, 180, 171, 66,
-			206, 5, 27, 136, 19, 164, 92, 142, 19, 173, 165, 166, 168, 211,
-			110, 117, 112, 89, 103, 232, 144, 79, 78, 138, 204, 193, 130, 233,
-			122, 180, 58, 1, 207, 222, 105, 158, 190, 73, 184, 43, 81, 132,
-			215, 227, 171, 252, 188, 236, 199, 213, 109, 154, 93, 246, 99, 58,
-			253, 182, 59, 203, 150, 253, 7, 229, 237, 242, 198, 83, 79, 216,
-			236, 131, 170, 215, 169, 38, 11, 232, 128, 137, 45, 117, 158, 160,
-			141, 117, 61, 242, 39, 107, 73, 213, 212, 3, 173, 71, 157, 229,
-			6, 92, 230, 34, 18, 15, 184, 129, 51, 188, 49, 52, 157, 172,
-			96, 98, 245, 120, 85, 153, 212, 242, 133, 74, 17, 65, 20, 203,
-			118, 236, 135, 232, 214, 172, 72, 132, 155, 162, 94, 165, 250, 94,
-			203, 58, 116, 17, 132, 5, 93, 110, 248, 205, 203, 68, 244, 106,
-			55, 112, 56, 96, 18, 4, 177, 25, 184, 88, 84, 159, 124, 120,
-			233, 214, 146, 39, 170, 219, 174, 9, 85, 187, 93, 62, 139, 86,
-			101, 66, 158, 54, 9, 91, 99, 11, 69, 183, 9, 138, 2, 140,
-			211, 150, 231, 120, 174, 138, 188, 19, 174, 162, 136, 156, 133, 141,
-			170, 156, 152, 124, 194, 150, 249, 50, 33, 39, 228, 106, 140, 105,
-			75, 249, 131, 46, 194, 162, 31, 229, 237, 242, 102, 189, 42, 236,
-			130, 34, 235, 93, 211, 79, 140, 227, 200, 118, 241, 156, 72, 143,
-			35, 59, 7, 5, 108, 49, 138, 5, 22, 20, 140, 30, 78, 11,
-			28, 40, 56, 50, 134, 47, 1, 80, 224, 120, 238, 155, 123, 221,
-			9, 93, 193, 113, 177, 32, 109, 211, 201, 65, 129, 209, 166, 99,
-			65, 193, 232, 117, 105, 1, 182, 49, 54, 174, 219, 116, 61, 247,
-			231, 123, 221, 19, 186, 130, 75, 5, 105, 155, 110, 14, 10, 140,
-			54, 93, 11, 10, 70, 143, 166, 5, 14, 20, 28, 59, 46, 254,
-			209, 18, 182, 187, 203, 203, 191, 163, 183, 231, 19, 189, 86, 233,
-			47, 45, 22, 104, 151, 89, 46, 140, 131, 6, 221, 182, 214, 194,
-			150, 92, 14, 218, 27, 65, 208, 236, 138, 93, 73, 215, 238, 118,
-			210, 173, 165, 22, 156, 105, 119, 74, 167, 209, 175, 235, 243, 213,
-			76, 232, 147, 36, 81, 45, 76, 61, 84, 49, 12, 32, 240, 105,
-			221, 139, 48, 213, 222, 169, 63, 4, 107, 149, 72, 194, 12, 154,
-			53, 186, 142, 166, 161, 34, 248, 163, 76, 202, 22, 210, 80, 239,
-			178, 60, 247, 29, 189, 197, 189, 226, 1, 225, 186, 187, 224, 250,
-			246, 206, 94, 251, 186, 210, 139, 229, 84, 83, 78, 105, 143, 38,
-			117, 4, 37, 116, 227, 71, 85, 0, 136, 164, 193, 3, 120, 102,
-			116, 33, 1, 53, 115, 234, 76, 17, 74, 29, 162, 114, 123, 53,
-			87, 205, 113, 240, 181, 116, 23, 10, 171, 239, 236, 181, 53, 152,
-			7, 144, 159, 225, 119, 161, 160, 250, 206, 222, 93, 82, 129, 14,
-			128, 215, 30, 198, 107, 233, 46, 184, 150, 190, 171, 215, 254, 56,
-			95, 75, 119, 225, 181, 244, 93, 189, 98, 84, 188, 222, 18, 121,
-			128, 97, 94, 239, 233, 117, 43, 165, 151, 153, 183, 82, 244, 195,
-			206, 158, 124, 221, 175, 11, 230, 72, 141, 247, 54, 12, 50, 65,
-			202, 116, 60, 150, 149, 174, 200, 239, 150, 158, 170, 74, 201, 95,
-			37, 53, 18, 82, 221, 46, 150, 205, 223, 163, 8, 117, 23, 203,
-			230, 239, 233, 101, 249, 110, 23, 203, 230, 239, 233, 101, 249, 110,
-			23, 203, 230, 239, 233, 101, 249, 110, 23, 203, 230, 239, 233, 101,
-			249, 110, 151, 146, 205, 223, 211, 123, 205, 132, 152, 231, 121, 91,
-			158, 251, 254, 94, 247, 112, 233, 57, 221, 243, 70, 26, 192, 196,
-			236, 116, 55, 97, 17, 107, 251, 249, 27, 227, 6, 185, 244, 253,
-			230, 184, 65, 46, 125, 191, 218, 96, 187, 88, 46, 125, 127, 239,
-			232, 193, 180, 192, 129, 130, 242, 181, 98, 131, 7, 101, 123, 238,
-			7, 123, 221, 107, 74, 171, 221, 131, 66, 73, 158, 78, 239, 149,
-			36, 192, 69, 193, 212, 3, 106, 117, 178, 116, 198, 201, 232, 113,
-			91, 166, 65, 80, 12, 67, 109, 122, 130, 54, 6, 15, 236, 226,
-			131, 230, 224, 129, 139, 125, 208, 68, 58, 32, 236, 131, 189, 35,
-			123, 210, 2, 7, 10, 246, 237, 23, 127, 174, 72, 201, 241, 220,
-			71, 122, 221, 125, 165, 207, 88, 91, 104, 137, 223, 91, 159, 202,
-			224, 57, 74, 239, 147, 14, 30, 149, 162, 1, 198, 135, 195, 86,
-			83, 139, 114, 224, 11, 45, 63, 105, 27, 215, 208, 56, 104, 4,
-			87, 224, 214, 129, 145, 69, 199, 146, 136, 3, 132, 52, 87, 219,
-			218, 166, 24, 247, 236, 237, 216, 228, 81, 146, 151, 198, 13, 12,
-			1, 79, 126, 196, 196, 16, 240, 228, 71, 76, 12, 1, 79, 126,
-			164, 119, 100, 87, 90, 128, 8, 217, 91, 18, 47, 38, 4, 185,
-			61, 94, 254, 195, 189, 238, 71, 123, 115, 165, 23, 200, 133, 244,
-			242, 176, 237, 252, 142, 36, 42, 50, 29, 59, 221, 92, 101, 63,
-			138, 46, 158, 193, 157, 163, 133, 238, 135, 123, 123, 135, 197, 157,
-			180, 5, 200, 70, 247, 183, 122, 243, 131, 165, 155, 50, 201, 188,
-			85, 47, 28, 125, 129, 32, 138, 11, 72, 76, 222, 95, 198, 134,
-			135, 69, 175, 106, 199, 194, 134, 50, 69, 54, 20, 237, 24, 16,
-			183, 233, 222, 44, 207, 253, 72, 111, 126, 160, 52, 65, 11, 149,
-			234, 166, 80, 67, 26, 27, 215, 214, 245, 78, 155, 179, 99, 166,
-			13, 90, 244, 121, 175, 89, 100, 67, 81, 255, 14, 241, 108, 221,
-			135, 237, 185, 191, 211, 155, 31, 42, 93, 15, 76, 153, 115, 77,
-			68, 89, 108, 37, 218, 157, 39, 211, 62, 12, 239, 119, 122, 243,
-			125, 102, 17, 182, 54, 64, 166, 178, 187, 232, 8, 253, 88, 47,
-			219, 108, 238, 226, 35, 244, 99, 38, 9, 184, 121, 40, 216, 177,
-			51, 45, 176, 160, 96, 215, 129, 180, 192, 129, 130, 67, 101, 241,
-			119, 215, 138, 131, 171, 232, 76, 58, 233, 183, 194, 73, 74, 136,
-			160, 83, 28, 224, 10, 122, 130, 42, 84, 253, 86, 88, 146, 92,
-			89, 217, 205, 76, 166, 222, 74, 84, 123, 226, 55, 44, 177, 3,
-			149, 63, 167, 185, 21, 239, 128, 40, 221, 57, 59, 115, 238, 204,
-			197, 211, 51, 119, 77, 61, 127, 118, 126, 225, 226, 133, 185, 197,
-			243, 51, 211, 179, 119, 206, 206, 156, 25, 234, 241, 250, 69, 113,
-			254, 252, 210, 236, 252, 220, 212, 185, 33, 11, 160, 133, 153, 31,
-			187, 48, 187, 48, 115, 102, 200, 246, 6, 69, 223, 252, 133, 165,
-			243, 23, 150, 46, 206, 207, 157, 187, 111, 200, 241, 6, 132, 152,
-			157, 211, 176, 235, 237, 16, 189, 179, 247, 220, 115, 97, 105, 234,
-			244, 185, 153, 161, 156, 231, 137, 129, 11, 115, 243, 11, 103, 102,
-			22, 102, 206, 92, 60, 55, 187, 184, 52, 148, 247, 118, 138, 225,
-			185, 249, 185, 139, 51, 247, 156, 95, 186, 239, 226, 153, 153, 59,
-			167, 46, 156, 91, 26, 42, 96, 75, 103, 102, 230, 150, 96, 24,
-			11, 67, 197, 147, 117, 49, 144, 157, 190, 119, 77, 149, 103, 174,
-			102, 91, 197, 137, 177, 135, 212, 158, 183, 22, 165, 51, 54, 112,
-			98, 111, 53, 197, 79, 53, 51, 243, 211, 246, 80, 207, 194, 142,
-			149, 76, 81, 75, 12, 212, 162, 117, 227, 147, 211, 94, 230, 27,
-			84, 148, 157, 183, 126, 124, 138, 107, 172, 70, 13, 191, 185, 90,
-			141, 226, 213, 201, 213, 160, 137, 3, 153, 164, 159, 252, 86, 152,
-			224, 162, 25, 126, 204, 167, 140, 191, 127, 209, 118, 207, 78, 157,
-			159, 125, 222, 227, 7, 69, 222, 115, 7, 122, 86, 45, 241, 17,
-			87, 88, 253, 158, 51, 208, 227, 157, 248, 85, 87, 78, 71, 173,
-			205, 56, 92, 93, 107, 99, 172, 74, 246, 41, 150, 231, 206, 77,
-			11, 33, 207, 133, 181, 160, 153, 4, 117, 82, 103, 35, 233, 78,
-			181, 64, 216, 84, 191, 84, 228, 243, 41, 13, 143, 60, 81, 61,
-			70, 185, 129, 202, 252, 83, 121, 252, 148, 64, 165, 10, 8, 58,
-			217, 4, 182, 33, 62, 158, 97, 96, 232, 176, 201, 41, 104, 41,
-			223, 139, 202, 138, 203, 109, 84, 5, 102, 193, 133, 22, 162, 101,
-			148, 148, 124, 89, 139, 90, 42, 76, 184, 170, 38, 253, 182, 32,
-			229, 234, 90, 187, 221, 58, 57, 57, 185, 177, 177, 81, 245, 113,
-			160, 136, 178, 6, 85, 75, 38, 207, 205, 78, 207, 204, 45, 206,
-			28, 61, 81, 61, 38, 132, 188, 208, 196, 24, 239, 58, 168, 246,
-			50, 7, 214, 175, 161, 173, 68, 195, 223, 64, 205, 244, 106, 204,
-			201, 248, 194, 166, 202, 143, 82, 145, 73, 180, 210, 222, 192, 187,
-			113, 61, 132, 155, 196, 114, 167, 157, 193, 146, 26, 88, 152, 100,
-			42, 96, 224, 80, 89, 158, 90, 148, 179, 139, 101, 121, 122, 106,
-			113, 118, 177, 34, 228, 189, 179, 75, 119, 205, 95, 88, 146, 247,
-			78, 45, 44, 76, 205, 45, 205, 206, 44, 202, 249, 5, 57, 61,
-			63, 119, 102, 22, 246, 195, 162, 156, 191, 83, 78, 205, 221, 39,
-			239, 158, 157, 59, 83, 145, 228, 129, 44, 131, 7, 90, 24, 57,
-			52, 138, 217, 99, 183, 110, 228, 100, 86, 221, 235, 152, 17, 202,
-			89, 85, 103, 44, 92, 141, 174, 4, 49, 222, 184, 49, 73, 68,
-			162, 77, 200, 132, 196, 60, 45, 252, 62, 177, 101, 70, 85, 33,
-			138, 194, 178, 61, 103, 168, 103, 68, 244, 10, 219, 233, 241, 28,
-			175, 103, 2, 10, 139, 158, 51, 218, 243, 2, 40, 44, 246, 209,
-			159, 84, 184, 179, 167, 140, 133, 130, 254, 164, 194, 93, 61, 55,
-			98, 33, 255, 73, 133, 187, 123, 142, 96, 161, 69, 127, 82, 225,
-			30, 254, 252, 176, 250, 211, 42, 120, 110, 169, 103, 204, 18, 95,
-			114, 132, 93, 232, 241, 156, 35, 246, 221, 165, 63, 114, 228, 20,
-			63, 208, 24, 74, 96, 61, 111, 149, 78, 129, 227, 79, 141, 169,
-			53, 175, 176, 190, 0, 69, 246, 138, 12, 218, 181, 234, 184, 208,
-			17, 178, 150, 59, 43, 153, 20, 190, 89, 141, 66, 151, 134, 90,
-			222, 63, 102, 112, 128, 44, 15, 25, 151, 183, 75, 197, 206, 94,
-			136, 55, 205, 69, 56, 84, 64, 112, 106, 63, 165, 143, 13, 238,
-			71, 223, 119, 179, 164, 51, 29, 86, 200, 181, 219, 13, 104, 144,
-			182, 195, 147, 180, 154, 178, 208, 237, 27, 93, 10, 215, 131, 164,
-			237, 175, 183, 128, 218, 194, 56, 184, 136, 113, 185, 158, 106, 235,
-			198, 152, 43, 252, 244, 241, 36, 195, 81, 28, 252, 133, 167, 132,
-			16, 194, 41, 244, 216, 158, 83, 42, 92, 75, 127, 187, 176, 208,
-			92, 158, 247, 156, 35, 125, 92, 110, 121, 206, 145, 195, 39, 232,
-			111, 199, 115, 142, 60, 235, 86, 250, 187, 232, 57, 71, 78, 62,
-			79, 244, 9, 183, 0, 242, 181, 115, 228, 212, 172, 248, 154, 45,
-			236, 92, 143, 231, 30, 235, 89, 181, 74, 127, 97, 195, 185, 204,
-			1, 134, 211, 60, 44, 102, 94, 22, 101, 18, 72, 228, 51, 182,
-			146, 125, 58, 242, 219, 234, 157, 129, 243, 218, 48, 31, 193, 11,
-			221, 75, 58, 65, 210, 166, 0, 231, 212, 134, 159, 40, 90, 195,
-			92, 25, 124, 121, 247, 65, 148, 105, 117, 218, 227, 42, 129, 248,
-			196, 132, 122, 126, 157, 152, 48, 211, 248, 235, 97, 41, 226, 172,
-			69, 42, 91, 85, 194, 70, 22, 167, 100, 216, 102, 239, 102, 114,
-			117, 77, 178, 95, 194, 37, 23, 3, 36, 147, 20, 181, 22, 109,
-			200, 169, 243, 179, 104, 249, 3, 132, 76, 110, 94, 73, 106, 146,
-			163, 44, 62, 78, 210, 200, 48, 228, 210, 196, 196, 186, 191, 57,
-			49, 33, 227, 160, 22, 132, 87, 40, 110, 28, 39, 30, 234, 126,
-			182, 20, 194, 201, 193, 234, 28, 203, 121, 226, 57, 194, 205, 129,
-			136, 231, 156, 176, 15, 149, 78, 200, 233, 168, 121, 5, 36, 74,
-			82, 58, 113, 72, 3, 196, 46, 6, 248, 51, 2, 164, 227, 137,
-			129, 110, 227, 185, 28, 202, 118, 206, 9, 123, 191, 130, 108, 207,
-			57, 113, 80, 138, 119, 88, 216, 186, 229, 57, 183, 216, 131, 165,
-			135, 44, 78, 231, 195, 122, 125, 133, 10, 245, 36, 228, 39, 90,
-			183, 82, 21, 242, 94, 76, 251, 128, 73, 34, 40, 216, 212, 118,
-			232, 245, 227, 64, 166, 47, 26, 58, 193, 217, 178, 102, 172, 156,
-			226, 68, 6, 235, 173, 53, 63, 1, 241, 116, 5, 142, 146, 56,
-			106, 197, 33, 197, 175, 163, 17, 91, 56, 70, 13, 217, 158, 115,
-			203, 142, 1, 241, 49, 26, 191, 237, 57, 183, 217, 131, 165, 255,
-			106, 201, 51, 91, 135, 172, 136, 75, 231, 153, 207, 230, 115, 75,
-			205, 168, 38, 38, 214, 59, 9, 208, 14, 198, 194, 226, 76, 244,
-			126, 146, 121, 196, 101, 234, 172, 208, 27, 137, 202, 213, 208, 142,
-			100, 61, 146, 73, 68, 42, 44, 182, 36, 104, 202, 32, 142, 129,
-			99, 118, 18, 74, 209, 114, 105, 118, 238, 249, 83, 231, 102, 207,
-			92, 156, 90, 56, 123, 225, 158, 153, 185, 165, 75, 227, 122, 122,
-			176, 4, 183, 233, 233, 225, 132, 118, 12, 136, 127, 161, 233, 57,
-			158, 115, 218, 246, 74, 223, 220, 118, 122, 6, 23, 126, 210, 25,
-			154, 41, 246, 113, 171, 37, 173, 8, 78, 247, 10, 39, 161, 169,
-			53, 58, 42, 188, 137, 48, 237, 13, 120, 214, 218, 188, 1, 173,
-			119, 199, 84, 72, 165, 32, 150, 132, 57, 245, 174, 31, 182, 233,
-			56, 196, 82, 220, 143, 24, 139, 101, 35, 197, 137, 79, 121, 120,
-			49, 247, 133, 153, 177, 241, 8, 12, 16, 110, 79, 181, 32, 197,
-			141, 99, 193, 252, 119, 40, 200, 246, 156, 211, 67, 195, 226, 23,
-			8, 55, 174, 231, 156, 181, 135, 75, 111, 216, 22, 55, 200, 31,
-			158, 38, 106, 20, 23, 98, 219, 8, 209, 101, 72, 161, 191, 130,
-			221, 102, 106, 210, 181, 22, 157, 134, 235, 90, 48, 192, 126, 5,
-			217, 158, 115, 118, 112, 72, 60, 76, 131, 207, 121, 206, 57, 123,
-			168, 244, 51, 219, 15, 126, 29, 238, 79, 120, 71, 123, 146, 177,
-			171, 29, 5, 247, 47, 144, 5, 51, 107, 214, 142, 56, 44, 131,
-			244, 5, 218, 173, 119, 226, 154, 161, 71, 227, 216, 111, 196, 46,
-			235, 164, 76, 198, 247, 42, 61, 131, 156, 5, 163, 236, 83, 144,
-			237, 57, 231, 6, 6, 197, 67, 54, 206, 32, 239, 57, 11, 246,
-			206, 210, 79, 218, 122, 6, 204, 220, 199, 212, 139, 202, 184, 145,
-			122, 185, 41, 59, 77, 101, 236, 221, 8, 241, 69, 226, 106, 51,
-			83, 1, 193, 96, 140, 188, 48, 230, 253, 79, 231, 23, 130, 102,
-			80, 236, 240, 155, 155, 210, 143, 151, 195, 54, 166, 204, 37, 19,
-			240, 138, 140, 253, 236, 67, 8, 89, 134, 163, 83, 124, 130, 246,
-			19, 116, 217, 6, 198, 175, 150, 191, 42, 167, 116, 106, 32, 144,
-			108, 84, 47, 71, 18, 254, 26, 101, 234, 216, 196, 94, 194, 235,
-			196, 56, 202, 91, 128, 149, 33, 5, 217, 158, 179, 48, 50, 42,
-			254, 61, 97, 172, 224, 57, 47, 176, 247, 148, 190, 107, 101, 49,
-			102, 36, 15, 161, 123, 109, 194, 41, 42, 233, 37, 75, 113, 122,
-			78, 209, 182, 162, 50, 84, 95, 5, 131, 156, 163, 6, 231, 200,
-			243, 98, 133, 13, 182, 198, 173, 24, 100, 2, 12, 205, 232, 142,
-			42, 164, 10, 121, 190, 104, 227, 141, 31, 27, 53, 243, 88, 249,
-			27, 200, 229, 87, 48, 156, 128, 216, 210, 74, 59, 2, 25, 136,
-			212, 82, 132, 145, 130, 5, 56, 24, 81, 144, 237, 57, 47, 216,
-			181, 91, 60, 230, 34, 126, 138, 158, 179, 98, 15, 151, 190, 230,
-			118, 227, 39, 200, 114, 36, 86, 215, 141, 249, 90, 157, 156, 13,
-			118, 40, 164, 33, 49, 169, 234, 227, 218, 44, 82, 191, 251, 113,
-			59, 40, 139, 182, 35, 118, 144, 104, 108, 42, 197, 205, 38, 109,
-			126, 85, 175, 138, 79, 120, 83, 179, 231, 143, 170, 187, 87, 27,
-			179, 146, 243, 217, 214, 149, 204, 217, 200, 55, 138, 173, 92, 162,
-			144, 176, 156, 108, 52, 59, 2, 157, 78, 74, 11, 29, 217, 232,
-			140, 70, 91, 89, 157, 55, 189, 80, 169, 102, 82, 59, 183, 118,
-			154, 58, 159, 218, 54, 148, 81, 113, 154, 240, 52, 253, 48, 90,
-			105, 7, 77, 54, 217, 215, 122, 244, 172, 248, 47, 56, 127, 158,
-			17, 46, 76, 241, 25, 178, 115, 11, 214, 151, 131, 58, 115, 66,
-			122, 10, 133, 195, 125, 154, 24, 144, 10, 204, 212, 196, 168, 205,
-			97, 42, 77, 32, 147, 237, 52, 59, 152, 64, 141, 210, 73, 193,
-			103, 23, 90, 117, 243, 179, 176, 109, 202, 138, 227, 85, 57, 75,
-			1, 158, 80, 223, 78, 149, 142, 234, 107, 139, 113, 143, 231, 52,
-			153, 151, 82, 101, 197, 37, 179, 33, 77, 150, 112, 121, 90, 209,
-			172, 26, 4, 223, 149, 193, 161, 229, 60, 69, 252, 21, 175, 190,
-			93, 108, 209, 221, 144, 32, 190, 238, 39, 151, 89, 211, 51, 216,
-			117, 15, 40, 31, 18, 189, 168, 157, 184, 199, 79, 46, 123, 163,
-			34, 215, 242, 219, 107, 201, 30, 75, 58, 99, 189, 11, 4, 156,
-			254, 73, 75, 140, 24, 26, 13, 245, 233, 233, 1, 253, 161, 82,
-			105, 156, 216, 170, 210, 208, 67, 65, 11, 185, 73, 116, 131, 160,
-			97, 193, 168, 90, 203, 143, 89, 214, 47, 218, 206, 217, 243, 167,
-			223, 103, 31, 32, 253, 68, 85, 185, 154, 85, 239, 13, 26, 141,
-			187, 225, 131, 37, 248, 246, 121, 239, 56, 37, 10, 94, 238, 64,
-			207, 63, 89, 150, 248, 111, 253, 168, 231, 56, 208, 227, 157, 248,
-			120, 191, 142, 70, 166, 66, 145, 201, 163, 105, 252, 52, 76, 129,
-			139, 86, 128, 44, 113, 211, 27, 129, 200, 40, 71, 142, 61, 91,
-			41, 71, 102, 155, 181, 170, 148, 83, 141, 134, 196, 223, 18, 29,
-			149, 173, 42, 180, 155, 89, 61, 184, 18, 52, 162, 86, 16, 39,
-			10, 39, 181, 104, 125, 82, 137, 150, 71, 89, 180, 156, 20, 66,
-			46, 4, 90, 69, 128, 150, 106, 64, 69, 9, 185, 4, 209, 174,
-			134, 18, 202, 65, 131, 227, 82, 134, 16, 81, 172, 108, 130, 133,
-			92, 143, 234, 218, 211, 172, 66, 150, 51, 58, 209, 167, 150, 8,
-			82, 22, 164, 109, 223, 107, 32, 8, 232, 116, 121, 24, 154, 236,
-			36, 171, 82, 38, 186, 6, 150, 116, 59, 55, 160, 92, 20, 7,
-			109, 149, 220, 141, 140, 178, 106, 10, 99, 24, 52, 3, 3, 85,
-			82, 8, 232, 48, 105, 147, 233, 68, 218, 35, 9, 37, 198, 112,
-			234, 97, 82, 107, 248, 225, 122, 64, 143, 235, 219, 13, 34, 108,
-			154, 184, 80, 131, 224, 104, 71, 233, 56, 68, 58, 144, 31, 106,
-			28, 42, 0, 82, 151, 27, 133, 223, 172, 79, 42, 45, 177, 92,
-			247, 219, 65, 28, 250, 13, 67, 248, 82, 138, 44, 67, 63, 164,
-			236, 255, 97, 82, 115, 172, 196, 105, 27, 17, 147, 76, 218, 106,
-			70, 233, 111, 9, 63, 196, 9, 74, 64, 11, 77, 69, 177, 190,
-			128, 40, 79, 191, 160, 89, 143, 226, 36, 224, 200, 79, 235, 81,
-			59, 224, 8, 80, 237, 68, 214, 131, 56, 188, 194, 230, 22, 108,
-			215, 166, 244, 87, 218, 170, 92, 179, 156, 86, 140, 25, 243, 99,
-			160, 157, 166, 161, 28, 66, 238, 123, 215, 236, 162, 92, 156, 191,
-			115, 233, 222, 169, 133, 25, 57, 187, 40, 207, 47, 204, 63, 127,
-			246, 204, 204, 25, 121, 250, 62, 185, 116, 215, 140, 156, 158, 63,
-			127, 223, 194, 236, 217, 187, 150, 228, 93, 243, 231, 206, 204, 44,
-			44, 202, 169, 185, 51, 114, 122, 126, 110, 105, 97, 246, 244, 133,
-			165, 249, 133, 69, 161, 181, 95, 240, 203, 212, 220, 125, 114, 230,
-			5, 231, 23, 102, 22, 81, 229, 53, 123, 207, 249, 115, 179, 51,
-			103, 12, 69, 88, 69, 206, 206, 77, 159, 187, 112, 102, 118, 238,
-			108, 69, 158, 190, 176, 36, 231, 230, 151, 132, 60, 55, 123, 207,
-			236, 210, 204, 25, 185, 52, 95, 193, 110, 183, 126, 39, 231, 239,
-			148, 247, 204, 44, 76, 223, 53, 53, 183, 52, 117, 122, 246, 220,
-			236, 210, 125, 216, 225, 157, 179, 75, 115, 208, 217, 157, 243, 11,
-			66, 78, 201, 243, 83, 11, 75, 179, 211, 23, 206, 77, 45, 200,
-			243, 23, 22, 206, 207, 47, 206, 72, 152, 217, 153, 217, 197, 233,
-			115, 83, 179, 247, 204, 156, 169, 202, 217, 57, 57, 55, 47, 103,
-			158, 63, 51, 183, 36, 23, 239, 154, 58, 119, 46, 59, 81, 33,
-			231, 239, 157, 155, 89, 96, 133, 157, 158, 166, 60, 61, 35, 207,
-			205, 78, 157, 62, 55, 3, 93, 225, 60, 207, 204, 46, 204, 76,
-			47, 193, 132, 210, 191, 166, 145, 155, 79, 157, 171, 8, 137, 170,
-			240, 169, 115, 21, 57, 243, 130, 153, 123, 206, 159, 155, 90, 184,
-			175, 194, 141, 46, 206, 252, 216, 5, 224, 249, 83, 231, 228, 153,
-			169, 123, 166, 206, 206, 44, 202, 177, 39, 195, 202, 249, 133, 249,
-			233, 11, 11, 51, 112, 63, 3, 84, 44, 94, 56, 189, 184, 52,
-			187, 116, 97, 105, 70, 158, 157, 159, 63, 131, 200, 94, 156, 89,
-			120, 254, 236, 244, 204, 226, 41, 121, 110, 126, 17, 17, 118, 97,
-			113, 166, 34, 228, 153, 169, 165, 41, 236, 250, 252, 194, 252, 157,
-			179, 75, 139, 167, 224, 239, 211, 23, 22, 103, 17, 113, 179, 115,
-			75, 51, 11, 11, 23, 80, 81, 63, 46, 239, 154, 191, 119, 230,
-			249, 51, 11, 114, 122, 234, 194, 226, 204, 25, 196, 240, 252, 28,
-			204, 22, 104, 101, 102, 126, 225, 62, 104, 22, 240, 128, 43, 80,
-			145, 247, 222, 53, 179, 116, 215, 204, 2, 32, 21, 177, 53, 5,
-			104, 88, 92, 90, 152, 157, 94, 50, 171, 205, 47, 200, 165, 249,
-			133, 37, 97, 204, 83, 206, 205, 156, 61, 55, 123, 118, 102, 110,
-			122, 6, 126, 158, 135, 102, 238, 157, 93, 156, 25, 151, 83, 11,
-			179, 139, 80, 97, 22, 59, 150, 247, 78, 221, 39, 231, 47, 224,
-			172, 97, 161, 46, 44, 206, 8, 250, 219, 32, 221, 10, 174, 167,
-			156, 189, 83, 78, 157, 121, 254, 44, 140, 156, 107, 159, 159, 95,
-			92, 156, 101, 114, 65, 180, 77, 223, 197, 56, 215, 122, 79, 217,
-			179, 135, 21, 147, 229, 158, 138, 210, 86, 194, 159, 84, 120, 109,
-			207, 164, 210, 107, 194, 159, 84, 120, 56, 213, 128, 30, 214, 26,
-			208, 235, 122, 14, 41, 189, 38, 252, 73, 133, 215, 247, 156, 194,
-			194, 235, 232, 79, 42, 60, 210, 51, 171, 180, 170, 240, 39, 21,
-			142, 245, 28, 196, 194, 131, 244, 231, 127, 173, 8, 219, 237, 241,
-			242, 223, 182, 224, 232, 43, 61, 92, 145, 151, 244, 201, 123, 169,
-			59, 204, 26, 249, 0, 108, 174, 47, 71, 13, 173, 27, 197, 99,
-			188, 34, 13, 21, 151, 58, 8, 240, 151, 147, 178, 188, 82, 245,
-			203, 221, 37, 203, 213, 122, 89, 8, 121, 87, 16, 7, 242, 210,
-			74, 87, 71, 90, 210, 69, 227, 3, 52, 222, 102, 81, 174, 34,
-			47, 249, 151, 144, 239, 94, 90, 190, 36, 12, 213, 139, 33, 238,
-			201, 149, 168, 211, 196, 207, 47, 173, 92, 162, 27, 236, 165, 250,
-			37, 221, 172, 254, 21, 185, 172, 54, 198, 128, 218, 213, 229, 75,
-			232, 200, 198, 215, 202, 228, 50, 105, 112, 180, 83, 52, 37, 123,
-			206, 56, 68, 168, 124, 234, 112, 66, 234, 167, 90, 145, 137, 181,
-			231, 203, 85, 168, 219, 10, 84, 42, 191, 152, 79, 93, 254, 185,
-			41, 59, 36, 233, 233, 42, 213, 174, 49, 52, 146, 72, 121, 210,
-			113, 252, 68, 76, 248, 160, 18, 143, 203, 177, 36, 8, 40, 90,
-			20, 58, 91, 93, 203, 95, 223, 131, 95, 135, 77, 144, 96, 94,
-			28, 212, 200, 39, 66, 200, 123, 85, 118, 51, 133, 55, 180, 236,
-			123, 160, 77, 122, 203, 150, 174, 91, 161, 139, 6, 234, 72, 52,
-			158, 64, 8, 78, 58, 203, 71, 13, 35, 150, 149, 176, 209, 14,
-			226, 52, 238, 33, 169, 6, 73, 234, 84, 118, 42, 100, 93, 169,
-			194, 178, 39, 34, 213, 127, 233, 197, 3, 49, 50, 107, 99, 207,
-			215, 56, 248, 69, 213, 106, 197, 1, 186, 9, 9, 211, 79, 200,
-			184, 27, 108, 51, 98, 63, 225, 243, 57, 81, 116, 185, 162, 188,
-			14, 165, 244, 229, 73, 121, 226, 132, 130, 150, 211, 31, 164, 172,
-			203, 147, 74, 95, 13, 255, 61, 0, 53, 21, 248, 82, 245, 199,
-			38, 84, 98, 3, 126, 46, 124, 240, 164, 124, 54, 95, 56, 88,
-			197, 163, 111, 139, 10, 27, 250, 204, 100, 5, 40, 236, 29, 198,
-			205, 3, 149, 77, 164, 216, 7, 5, 106, 152, 194, 184, 235, 50,
-			202, 249, 8, 13, 7, 115, 162, 112, 149, 123, 80, 105, 33, 241,
-			217, 95, 176, 58, 102, 252, 164, 120, 250, 83, 127, 169, 154, 29,
-			156, 123, 221, 62, 122, 202, 31, 138, 252, 246, 249, 105, 142, 133,
-			69, 180, 80, 104, 69, 73, 168, 159, 85, 4, 49, 0, 126, 0,
-			1, 82, 157, 5, 146, 211, 252, 70, 197, 16, 224, 102, 153, 35,
-			208, 149, 55, 179, 135, 56, 207, 116, 186, 167, 140, 172, 34, 134,
-			74, 118, 204, 39, 133, 170, 217, 195, 138, 81, 129, 114, 134, 44,
-			7, 65, 51, 165, 199, 241, 108, 74, 94, 95, 43, 153, 146, 203,
-			169, 127, 83, 51, 168, 1, 117, 197, 97, 195, 200, 26, 136, 67,
-			106, 71, 173, 163, 13, 144, 224, 183, 16, 34, 92, 216, 100, 205,
-			79, 40, 11, 178, 92, 152, 89, 92, 218, 118, 82, 70, 127, 106,
-			86, 245, 48, 14, 200, 180, 62, 82, 151, 102, 108, 90, 41, 46,
-			187, 154, 21, 44, 171, 102, 26, 86, 219, 136, 130, 2, 27, 248,
-			66, 171, 217, 176, 89, 15, 175, 132, 245, 142, 223, 80, 195, 21,
-			169, 142, 128, 89, 153, 86, 22, 160, 238, 106, 155, 217, 48, 111,
-			226, 16, 216, 130, 133, 92, 195, 197, 209, 148, 61, 171, 192, 135,
-			216, 221, 38, 51, 89, 166, 116, 33, 107, 141, 192, 143, 27, 155,
-			90, 128, 198, 29, 190, 74, 121, 184, 81, 76, 14, 81, 68, 53,
-			188, 14, 155, 138, 255, 84, 165, 156, 109, 10, 84, 138, 193, 24,
-			43, 166, 13, 72, 116, 149, 105, 77, 166, 170, 0, 227, 122, 44,
-			82, 133, 4, 236, 210, 169, 243, 179, 201, 182, 44, 150, 47, 235,
-			243, 10, 231, 9, 110, 23, 99, 45, 195, 173, 108, 62, 209, 84,
-			167, 28, 166, 152, 110, 85, 46, 107, 138, 69, 110, 162, 30, 99,
-			122, 68, 236, 67, 0, 152, 196, 38, 89, 53, 5, 140, 55, 51,
-			116, 205, 133, 211, 215, 32, 230, 56, 166, 214, 57, 65, 159, 138,
-			237, 248, 49, 169, 249, 41, 66, 32, 170, 15, 1, 247, 137, 236,
-			52, 219, 81, 167, 182, 6, 221, 226, 254, 213, 163, 67, 191, 151,
-			68, 157, 44, 145, 224, 88, 180, 203, 244, 53, 143, 149, 135, 80,
-			209, 103, 5, 169, 205, 147, 174, 225, 249, 141, 134, 62, 223, 137,
-			119, 94, 49, 15, 25, 60, 46, 20, 3, 217, 202, 149, 210, 233,
-			172, 144, 147, 88, 55, 238, 43, 230, 3, 148, 14, 102, 136, 62,
-			174, 245, 212, 1, 33, 120, 32, 196, 24, 50, 91, 122, 200, 196,
-			138, 79, 181, 99, 154, 111, 136, 109, 71, 69, 105, 231, 153, 89,
-			114, 27, 91, 185, 164, 188, 132, 92, 242, 82, 55, 155, 236, 58,
-			118, 183, 172, 216, 150, 150, 76, 21, 121, 114, 153, 49, 33, 182,
-			65, 5, 166, 49, 213, 248, 208, 103, 13, 70, 95, 97, 31, 154,
-			12, 54, 140, 145, 136, 171, 225, 162, 59, 32, 0, 189, 101, 26,
-			53, 185, 129, 109, 14, 229, 236, 89, 148, 61, 133, 183, 59, 132,
-			107, 39, 229, 253, 199, 95, 104, 28, 83, 228, 63, 208, 81, 250,
-			179, 167, 220, 207, 177, 109, 155, 62, 97, 52, 141, 152, 10, 183,
-			160, 54, 36, 217, 130, 197, 219, 251, 65, 190, 45, 87, 64, 204,
-			173, 149, 95, 168, 190, 106, 119, 9, 4, 203, 63, 216, 144, 158,
-			104, 238, 21, 121, 34, 51, 253, 237, 162, 202, 171, 87, 4, 21,
-			175, 24, 120, 195, 149, 32, 142, 233, 101, 33, 76, 180, 142, 221,
-			228, 119, 34, 37, 98, 124, 3, 206, 164, 166, 71, 194, 108, 170,
-			55, 133, 8, 117, 89, 250, 192, 60, 146, 164, 250, 239, 140, 176,
-			98, 96, 174, 147, 180, 5, 199, 176, 73, 79, 129, 102, 125, 27,
-			25, 39, 85, 217, 147, 16, 200, 202, 146, 148, 216, 228, 93, 65,
-			179, 22, 96, 84, 223, 238, 241, 164, 226, 64, 100, 50, 171, 138,
-			198, 136, 175, 122, 17, 120, 60, 162, 61, 145, 126, 239, 52, 52,
-			105, 93, 173, 25, 163, 198, 199, 250, 122, 36, 88, 106, 81, 205,
-			210, 132, 18, 205, 5, 235, 36, 163, 235, 13, 157, 33, 160, 140,
-			196, 19, 41, 234, 85, 121, 166, 183, 202, 57, 98, 91, 65, 199,
-			104, 50, 35, 233, 160, 131, 222, 22, 65, 199, 144, 115, 82, 249,
-			26, 159, 31, 241, 158, 87, 91, 11, 214, 125, 25, 92, 225, 88,
-			79, 198, 3, 182, 246, 239, 22, 233, 5, 40, 144, 53, 12, 247,
-			158, 74, 73, 151, 155, 248, 232, 73, 30, 161, 109, 21, 180, 28,
-			127, 90, 9, 27, 13, 102, 45, 34, 163, 71, 79, 31, 93, 82,
-			42, 8, 99, 181, 66, 85, 237, 179, 134, 57, 152, 55, 124, 202,
-			216, 164, 72, 182, 98, 90, 238, 152, 79, 104, 124, 36, 194, 253,
-			137, 198, 136, 114, 34, 122, 46, 242, 165, 78, 100, 144, 87, 97,
-			29, 24, 250, 105, 168, 87, 91, 122, 118, 66, 71, 52, 122, 102,
-			200, 138, 111, 93, 222, 231, 91, 72, 136, 35, 184, 212, 51, 46,
-			6, 217, 51, 49, 21, 185, 8, 25, 106, 191, 209, 171, 67, 194,
-			162, 139, 41, 247, 94, 14, 155, 117, 20, 197, 182, 23, 117, 182,
-			97, 84, 34, 53, 55, 33, 17, 98, 45, 34, 131, 146, 244, 246,
-			134, 2, 206, 181, 114, 58, 106, 38, 97, 93, 203, 42, 112, 124,
-			96, 242, 10, 20, 49, 233, 130, 131, 176, 202, 102, 189, 205, 41,
-			203, 98, 77, 39, 49, 222, 118, 113, 28, 122, 239, 243, 42, 159,
-			159, 90, 154, 190, 203, 76, 89, 113, 254, 194, 82, 102, 51, 39,
-			126, 59, 76, 86, 54, 169, 199, 36, 88, 247, 155, 237, 176, 150,
-			8, 57, 6, 21, 81, 151, 171, 94, 157, 240, 102, 139, 55, 170,
-			78, 163, 193, 35, 74, 248, 94, 140, 215, 230, 25, 117, 109, 142,
-			86, 76, 41, 142, 184, 25, 212, 168, 108, 217, 155, 20, 243, 57,
-			235, 7, 200, 86, 92, 228, 52, 78, 247, 26, 242, 121, 51, 29,
-			241, 208, 129, 114, 221, 175, 82, 71, 244, 246, 131, 73, 15, 200,
-			125, 175, 189, 134, 18, 93, 45, 106, 94, 9, 226, 54, 137, 108,
-			147, 232, 220, 134, 9, 27, 143, 214, 252, 245, 160, 1, 31, 177,
-			202, 157, 109, 95, 18, 38, 66, 12, 31, 193, 231, 107, 141, 87,
-			171, 75, 33, 157, 146, 144, 150, 147, 245, 61, 88, 253, 118, 62,
-			142, 208, 178, 82, 31, 61, 23, 146, 32, 166, 135, 78, 21, 56,
-			7, 254, 59, 191, 6, 247, 202, 22, 254, 63, 57, 106, 27, 39,
-			145, 106, 11, 63, 213, 13, 49, 142, 216, 245, 245, 98, 234, 147,
-			157, 253, 221, 175, 215, 209, 36, 209, 108, 21, 87, 131, 174, 178,
-			126, 183, 20, 115, 137, 71, 124, 9, 55, 55, 133, 5, 74, 100,
-			210, 169, 173, 233, 169, 65, 85, 61, 12, 165, 118, 66, 151, 91,
-			115, 48, 229, 238, 26, 56, 187, 114, 102, 12, 68, 17, 218, 227,
-			67, 145, 132, 86, 88, 17, 89, 32, 103, 87, 189, 235, 142, 161,
-			114, 87, 191, 115, 254, 122, 80, 233, 238, 38, 123, 157, 0, 150,
-			57, 223, 12, 20, 121, 38, 93, 42, 41, 116, 74, 53, 78, 161,
-			8, 170, 38, 20, 21, 9, 13, 126, 86, 49, 50, 14, 31, 210,
-			122, 27, 243, 237, 178, 155, 50, 186, 137, 97, 17, 233, 233, 30,
-			134, 244, 76, 176, 19, 12, 169, 120, 145, 254, 52, 228, 148, 172,
-			61, 227, 77, 167, 210, 95, 22, 59, 203, 170, 165, 164, 179, 124,
-			81, 245, 113, 187, 188, 245, 212, 54, 234, 133, 165, 44, 175, 162,
-			144, 132, 79, 188, 162, 198, 34, 66, 11, 243, 241, 19, 87, 55,
-			6, 97, 124, 149, 158, 130, 60, 203, 205, 150, 122, 65, 25, 43,
-			167, 83, 46, 19, 123, 198, 108, 80, 73, 48, 14, 227, 227, 199,
-			105, 86, 166, 177, 122, 131, 217, 103, 186, 162, 242, 249, 65, 172,
-			31, 217, 212, 91, 116, 86, 66, 67, 246, 185, 137, 215, 33, 78,
-			56, 68, 156, 115, 13, 57, 78, 170, 190, 192, 161, 153, 119, 16,
-			161, 15, 11, 214, 62, 94, 129, 190, 136, 149, 107, 187, 156, 140,
-			162, 22, 168, 139, 46, 191, 120, 27, 216, 106, 150, 149, 30, 119,
-			48, 36, 100, 82, 120, 218, 174, 251, 173, 22, 27, 122, 244, 9,
-			7, 61, 49, 190, 109, 21, 135, 69, 69, 184, 228, 133, 241, 93,
-			203, 222, 95, 58, 64, 49, 233, 13, 237, 40, 173, 133, 66, 205,
-			14, 145, 115, 201, 27, 234, 187, 150, 173, 193, 28, 128, 28, 159,
-			159, 60, 49, 190, 107, 121, 187, 21, 232, 0, 88, 218, 167, 31,
-			163, 127, 121, 70, 60, 123, 53, 170, 214, 214, 226, 104, 61, 236,
-			172, 147, 169, 118, 167, 22, 78, 250, 157, 246, 218, 69, 62, 248,
-			209, 182, 61, 110, 213, 90, 203, 147, 24, 95, 38, 225, 71, 234,
-			126, 168, 84, 229, 74, 165, 39, 243, 94, 40, 237, 235, 126, 245,
-			70, 227, 14, 254, 241, 73, 159, 196, 117, 251, 233, 75, 181, 50,
-			141, 165, 10, 229, 25, 225, 157, 11, 147, 246, 89, 28, 226, 2,
-			43, 118, 188, 73, 145, 167, 65, 227, 99, 121, 223, 137, 221, 85,
-			115, 212, 213, 169, 78, 123, 13, 191, 88, 224, 106, 229, 235, 196,
-			224, 217, 128, 90, 89, 32, 130, 240, 60, 225, 2, 17, 239, 177,
-			164, 53, 214, 187, 128, 127, 151, 239, 18, 30, 89, 35, 100, 106,
-			158, 16, 57, 108, 6, 171, 94, 189, 179, 211, 206, 95, 77, 217,
-			11, 84, 181, 252, 147, 150, 240, 72, 233, 241, 195, 54, 229, 157,
-			18, 125, 116, 76, 35, 226, 246, 216, 248, 101, 105, 123, 231, 9,
-			216, 5, 11, 130, 170, 195, 223, 229, 41, 225, 157, 9, 26, 65,
-			215, 48, 118, 155, 115, 167, 206, 176, 0, 144, 18, 180, 253, 85,
-			236, 164, 119, 1, 255, 46, 127, 204, 22, 189, 122, 100, 219, 161,
-			205, 219, 35, 10, 235, 1, 70, 130, 216, 99, 163, 241, 130, 2,
-			189, 81, 145, 91, 109, 68, 203, 201, 30, 135, 140, 26, 16, 240,
-			118, 137, 124, 51, 72, 218, 65, 125, 143, 139, 197, 12, 121, 82,
-			244, 41, 135, 151, 48, 106, 238, 201, 97, 23, 102, 17, 124, 25,
-			109, 52, 161, 163, 60, 254, 200, 144, 119, 171, 16, 156, 94, 232,
-			98, 59, 217, 83, 184, 10, 138, 180, 221, 245, 66, 47, 215, 94,
-			74, 188, 107, 210, 79, 151, 55, 247, 20, 177, 89, 245, 243, 233,
-			77, 111, 82, 12, 215, 252, 70, 35, 136, 47, 214, 252, 230, 69,
-			124, 153, 216, 220, 211, 43, 173, 177, 34, 224, 205, 89, 24, 164,
-			95, 167, 253, 230, 61, 248, 155, 70, 97, 205, 64, 225, 221, 194,
-			59, 27, 180, 23, 59, 203, 232, 51, 173, 86, 225, 89, 162, 183,
-			21, 135, 205, 90, 216, 242, 27, 219, 19, 196, 121, 245, 243, 66,
-			90, 179, 124, 147, 40, 170, 150, 188, 49, 145, 107, 70, 245, 64,
-			237, 3, 47, 251, 249, 92, 84, 15, 22, 168, 66, 249, 188, 232,
-			213, 173, 121, 147, 194, 5, 177, 20, 59, 29, 56, 177, 239, 42,
-			157, 222, 29, 54, 235, 11, 88, 81, 175, 186, 109, 108, 150, 23,
-			9, 23, 58, 120, 154, 211, 240, 14, 138, 62, 197, 129, 1, 241,
-			64, 56, 185, 5, 161, 138, 78, 111, 78, 44, 136, 29, 153, 161,
-			120, 7, 68, 233, 252, 194, 236, 220, 244, 236, 249, 169, 115, 23,
-			239, 158, 157, 59, 179, 213, 211, 137, 172, 120, 150, 238, 27, 178,
-			188, 94, 145, 59, 187, 48, 127, 225, 252, 144, 237, 21, 133, 123,
-			246, 220, 252, 233, 33, 231, 196, 43, 92, 145, 39, 94, 226, 221,
-			41, 68, 202, 89, 188, 93, 91, 136, 101, 6, 24, 89, 73, 102,
-			103, 178, 13, 47, 58, 45, 138, 138, 181, 120, 215, 100, 107, 119,
-			177, 156, 210, 213, 182, 187, 119, 151, 232, 51, 248, 142, 215, 213,
-			233, 86, 150, 244, 132, 45, 25, 108, 167, 187, 165, 173, 28, 233,
-			234, 45, 157, 21, 125, 6, 231, 232, 110, 105, 43, 83, 41, 93,
-			5, 133, 208, 144, 65, 252, 221, 13, 109, 221, 23, 165, 93, 217,
-			26, 250, 203, 231, 137, 161, 179, 65, 123, 230, 129, 150, 223, 172,
-			7, 245, 31, 10, 227, 167, 143, 254, 248, 13, 63, 192, 17, 249,
-			188, 207, 222, 44, 10, 94, 110, 160, 231, 151, 44, 75, 124, 50,
-			117, 243, 250, 205, 46, 55, 175, 227, 120, 186, 159, 187, 48, 61,
-			43, 161, 171, 8, 19, 51, 254, 191, 207, 219, 235, 255, 115, 247,
-			250, 127, 208, 221, 107, 39, 27, 30, 120, 61, 167, 149, 53, 2,
-			255, 233, 244, 120, 206, 104, 207, 56, 254, 105, 121, 206, 206, 158,
-			235, 240, 79, 219, 115, 118, 245, 76, 224, 159, 142, 231, 236, 238,
-			25, 23, 207, 21, 118, 190, 199, 115, 75, 61, 183, 88, 165, 155,
-			36, 241, 39, 173, 224, 209, 217, 183, 149, 165, 36, 154, 220, 194,
-			157, 57, 144, 44, 249, 161, 79, 73, 190, 199, 242, 156, 82, 113,
-			64, 188, 215, 18, 110, 30, 157, 74, 14, 216, 51, 165, 183, 88,
-			50, 101, 122, 169, 245, 113, 163, 129, 211, 161, 6, 84, 252, 157,
-			198, 38, 37, 85, 68, 233, 91, 239, 2, 53, 18, 129, 230, 128,
-			88, 129, 30, 100, 248, 227, 110, 67, 98, 124, 208, 108, 180, 214,
-			252, 229, 160, 141, 161, 51, 57, 96, 55, 134, 150, 34, 29, 79,
-			24, 11, 57, 123, 134, 108, 51, 243, 228, 190, 114, 32, 63, 164,
-			32, 219, 115, 14, 12, 95, 175, 32, 199, 115, 14, 28, 159, 22,
-			207, 199, 73, 89, 158, 115, 200, 190, 169, 52, 43, 21, 163, 208,
-			51, 218, 26, 93, 153, 98, 231, 169, 119, 63, 28, 172, 169, 167,
-			199, 16, 104, 106, 4, 22, 52, 156, 31, 80, 144, 237, 57, 135,
-			6, 15, 40, 200, 241, 156, 67, 227, 39, 196, 36, 142, 192, 246,
-			156, 195, 246, 201, 82, 89, 26, 92, 157, 109, 240, 209, 168, 59,
-			216, 160, 174, 116, 211, 48, 230, 195, 249, 97, 5, 193, 247, 222,
-			97, 5, 57, 158, 115, 120, 242, 217, 226, 89, 216, 180, 227, 57,
-			71, 236, 147, 165, 49, 105, 176, 121, 165, 225, 33, 101, 8, 63,
-			80, 100, 59, 0, 218, 58, 162, 59, 0, 242, 58, 162, 59, 0,
-			10, 59, 50, 249, 108, 113, 3, 118, 224, 122, 206, 132, 125, 103,
-			233, 128, 52, 184, 191, 172, 227, 223, 48, 246, 108, 179, 174, 229,
-			57, 19, 186, 89, 215, 246, 156, 9, 221, 172, 235, 120, 206, 196,
-			228, 25, 241, 223, 137, 212, 114, 158, 51, 105, 223, 90, 250, 156,
-			37, 141, 211, 192, 176, 116, 215, 69, 202, 170, 110, 235, 98, 49,
-			45, 145, 210, 149, 133, 8, 52, 215, 96, 1, 66, 142, 181, 130,
-			120, 205, 111, 145, 49, 60, 191, 23, 71, 177, 188, 18, 250, 18,
-			133, 210, 241, 42, 89, 219, 152, 223, 132, 137, 80, 124, 194, 87,
-			97, 169, 219, 155, 21, 53, 85, 73, 193, 237, 27, 209, 50, 153,
-			152, 100, 164, 21, 116, 185, 26, 215, 216, 200, 89, 158, 51, 169,
-			177, 145, 179, 61, 103, 82, 99, 35, 231, 120, 206, 228, 228, 45,
-			226, 181, 54, 98, 35, 239, 57, 55, 219, 183, 149, 254, 21, 177,
-			145, 57, 237, 52, 74, 12, 45, 104, 80, 151, 250, 84, 99, 3,
-			214, 4, 208, 133, 232, 192, 132, 98, 2, 152, 28, 54, 82, 145,
-			97, 53, 192, 104, 157, 151, 88, 64, 191, 68, 187, 79, 33, 140,
-			48, 67, 81, 91, 24, 77, 146, 107, 158, 194, 207, 16, 85, 79,
-			225, 35, 172, 119, 138, 24, 225, 81, 121, 137, 228, 251, 167, 240,
-			157, 30, 186, 70, 92, 222, 242, 156, 155, 243, 187, 20, 100, 123,
-			206, 205, 187, 213, 222, 206, 59, 158, 115, 243, 241, 147, 98, 30,
-			109, 180, 220, 147, 61, 207, 177, 74, 211, 114, 171, 140, 134, 86,
-			48, 89, 142, 213, 8, 147, 118, 55, 159, 90, 100, 41, 1, 217,
-			33, 92, 224, 157, 147, 197, 146, 248, 160, 197, 23, 120, 231, 14,
-			91, 150, 222, 65, 236, 80, 61, 31, 240, 96, 51, 15, 61, 24,
-			215, 204, 180, 67, 144, 13, 144, 15, 54, 2, 248, 127, 10, 176,
-			43, 210, 53, 99, 175, 48, 54, 125, 65, 45, 237, 122, 208, 246,
-			129, 85, 210, 114, 177, 237, 22, 46, 194, 90, 216, 34, 227, 5,
-			86, 111, 233, 151, 217, 70, 176, 210, 38, 247, 13, 66, 28, 233,
-			16, 156, 59, 108, 13, 229, 61, 231, 142, 190, 81, 5, 89, 158,
-			115, 199, 206, 125, 10, 114, 60, 231, 142, 3, 7, 225, 24, 113,
-			45, 207, 61, 221, 115, 6, 143, 145, 172, 40, 133, 17, 135, 13,
-			35, 175, 173, 204, 145, 241, 6, 172, 240, 116, 113, 183, 56, 44,
-			92, 76, 60, 230, 76, 219, 94, 121, 183, 12, 170, 171, 39, 101,
-			217, 175, 175, 135, 77, 56, 201, 49, 84, 122, 153, 70, 103, 217,
-			61, 57, 168, 86, 84, 144, 229, 57, 211, 189, 59, 20, 228, 120,
-			206, 244, 208, 176, 184, 83, 0, 19, 113, 207, 246, 156, 179, 74,
-			39, 229, 86, 153, 88, 123, 101, 209, 99, 11, 252, 174, 159, 140,
-			77, 174, 10, 99, 4, 158, 122, 182, 88, 18, 95, 129, 181, 181,
-			97, 144, 119, 219, 83, 165, 207, 91, 24, 242, 42, 108, 104, 3,
-			0, 218, 230, 218, 73, 42, 77, 124, 97, 224, 31, 208, 175, 204,
-			122, 249, 89, 174, 25, 108, 8, 250, 246, 164, 122, 13, 213, 106,
-			40, 109, 210, 176, 173, 105, 156, 244, 59, 237, 8, 24, 91, 141,
-			220, 142, 210, 32, 41, 228, 47, 193, 247, 210, 73, 109, 33, 167,
-			245, 37, 201, 184, 138, 59, 168, 6, 197, 174, 176, 76, 16, 54,
-			146, 192, 221, 228, 170, 69, 185, 201, 156, 187, 251, 135, 21, 228,
-			120, 206, 221, 163, 59, 21, 84, 244, 156, 187, 119, 61, 87, 12,
-			138, 34, 66, 111, 45, 2, 134, 246, 220, 33, 102, 5, 198, 182,
-			152, 239, 121, 190, 85, 186, 93, 110, 189, 77, 164, 107, 144, 190,
-			118, 112, 244, 163, 238, 179, 7, 150, 1, 78, 158, 249, 98, 73,
-			188, 68, 184, 152, 215, 203, 89, 176, 167, 74, 245, 171, 46, 2,
-			53, 104, 200, 14, 71, 18, 121, 4, 142, 225, 35, 169, 13, 1,
-			27, 35, 138, 140, 135, 206, 150, 38, 8, 37, 14, 162, 100, 129,
-			81, 130, 137, 196, 156, 5, 70, 137, 131, 40, 89, 96, 148, 56,
-			136, 146, 5, 70, 137, 163, 80, 178, 176, 231, 14, 113, 12, 199,
-			110, 121, 206, 5, 187, 82, 186, 150, 98, 205, 51, 131, 80, 43,
-			156, 49, 69, 81, 93, 91, 121, 248, 100, 159, 130, 160, 129, 253,
-			71, 20, 228, 120, 206, 133, 137, 27, 196, 105, 129, 209, 71, 238,
-			235, 241, 173, 210, 205, 114, 235, 149, 43, 75, 242, 120, 16, 107,
-			146, 55, 241, 12, 71, 241, 125, 197, 18, 156, 226, 152, 217, 203,
-			185, 223, 190, 189, 116, 64, 206, 25, 57, 133, 53, 134, 232, 56,
-			231, 97, 186, 184, 51, 239, 231, 157, 137, 73, 192, 156, 251, 121,
-			103, 186, 136, 161, 251, 135, 134, 21, 84, 244, 156, 251, 189, 219,
-			16, 67, 174, 194, 208, 253, 163, 39, 197, 111, 90, 216, 173, 229,
-			57, 151, 108, 175, 244, 126, 74, 65, 171, 35, 54, 182, 253, 213,
-			204, 24, 170, 244, 250, 219, 164, 95, 76, 199, 75, 56, 40, 210,
-			16, 218, 126, 187, 70, 151, 156, 171, 182, 84, 17, 41, 74, 212,
-			158, 192, 240, 138, 220, 150, 223, 148, 83, 167, 231, 23, 150, 102,
-			206, 176, 74, 119, 139, 71, 155, 66, 130, 149, 131, 177, 43, 36,
-			192, 90, 93, 210, 72, 128, 181, 186, 52, 52, 44, 110, 18, 24,
-			91, 173, 222, 243, 160, 85, 26, 51, 216, 59, 71, 237, 126, 2,
-			134, 9, 162, 65, 189, 56, 44, 198, 133, 139, 41, 194, 156, 192,
-			246, 202, 251, 21, 195, 236, 180, 215, 142, 178, 0, 125, 212, 175,
-			213, 130, 68, 113, 205, 28, 174, 77, 192, 195, 194, 92, 98, 78,
-			192, 195, 202, 225, 218, 4, 67, 195, 98, 2, 27, 69, 223, 165,
-			3, 229, 107, 168, 209, 251, 241, 197, 229, 100, 251, 185, 252, 58,
-			86, 173, 69, 235, 229, 23, 170, 86, 45, 23, 42, 107, 40, 231,
-			57, 43, 125, 195, 10, 130, 134, 188, 189, 10, 114, 60, 103, 101,
-			255, 53, 60, 112, 219, 115, 86, 237, 253, 106, 224, 220, 199, 68,
-			166, 15, 213, 40, 8, 146, 171, 186, 11, 16, 255, 86, 117, 23,
-			48, 214, 85, 111, 183, 130, 28, 207, 89, 45, 237, 19, 39, 177,
-			11, 199, 115, 214, 236, 107, 202, 71, 85, 23, 62, 5, 96, 60,
-			138, 216, 60, 122, 172, 92, 145, 93, 69, 199, 211, 105, 57, 46,
-			124, 172, 161, 156, 231, 172, 233, 62, 129, 15, 173, 121, 123, 20,
-			4, 221, 236, 219, 143, 59, 59, 7, 67, 13, 237, 189, 229, 107,
-			121, 61, 208, 9, 143, 54, 139, 226, 54, 112, 59, 172, 86, 171,
-			122, 118, 110, 14, 62, 81, 203, 2, 123, 47, 236, 29, 85, 144,
-			227, 57, 225, 238, 61, 120, 56, 230, 96, 226, 47, 182, 71, 159,
-			248, 112, 204, 217, 57, 172, 166, 218, 3, 106, 121, 113, 239, 160,
-			130, 28, 207, 121, 177, 55, 34, 170, 216, 94, 222, 115, 46, 219,
-			55, 148, 15, 113, 123, 199, 111, 189, 229, 196, 209, 99, 199, 143,
-			30, 59, 190, 116, 252, 216, 201, 99, 199, 78, 158, 56, 86, 61,
-			118, 226, 248, 143, 235, 150, 243, 248, 193, 62, 5, 89, 158, 115,
-			121, 255, 245, 10, 114, 60, 231, 242, 248, 4, 242, 140, 156, 93,
-			240, 156, 134, 189, 167, 124, 128, 91, 38, 250, 9, 146, 246, 182,
-			203, 91, 200, 65, 109, 53, 224, 130, 229, 57, 141, 222, 17, 5,
-			57, 158, 211, 216, 181, 91, 204, 96, 179, 69, 207, 137, 236, 217,
-			210, 179, 229, 188, 225, 167, 46, 239, 93, 11, 180, 23, 15, 105,
-			87, 241, 177, 139, 116, 175, 164, 252, 48, 174, 26, 208, 76, 14,
-			218, 201, 43, 200, 242, 156, 168, 160, 86, 179, 232, 120, 78, 180,
-			111, 191, 130, 160, 199, 107, 238, 66, 38, 5, 16, 50, 169, 232,
-			192, 89, 241, 251, 46, 14, 168, 215, 115, 54, 237, 145, 210, 111,
-			187, 114, 170, 41, 163, 150, 143, 233, 176, 232, 253, 142, 237, 64,
-			82, 79, 218, 64, 94, 97, 173, 77, 134, 135, 46, 7, 42, 123,
-			85, 26, 85, 32, 53, 79, 110, 106, 219, 29, 54, 181, 200, 122,
-			218, 163, 33, 141, 145, 36, 187, 217, 150, 203, 126, 237, 178, 80,
-			31, 177, 71, 61, 144, 28, 31, 175, 200, 21, 145, 103, 167, 167,
-			129, 105, 25, 208, 138, 163, 54, 138, 217, 171, 126, 216, 76, 218,
-			232, 237, 164, 88, 166, 233, 221, 70, 60, 48, 169, 162, 242, 67,
-			231, 116, 99, 39, 217, 176, 85, 173, 7, 87, 38, 143, 63, 235,
-			38, 152, 81, 80, 91, 107, 114, 16, 8, 101, 108, 226, 203, 242,
-			70, 224, 95, 70, 38, 92, 174, 160, 1, 76, 138, 179, 21, 217,
-			222, 136, 82, 150, 152, 232, 132, 18, 130, 222, 140, 225, 35, 20,
-			94, 54, 241, 185, 31, 216, 251, 106, 199, 143, 253, 102, 59, 208,
-			102, 24, 203, 155, 237, 224, 232, 74, 20, 31, 197, 80, 110, 116,
-			184, 215, 252, 134, 142, 147, 42, 116, 196, 211, 84, 215, 178, 22,
-			69, 117, 185, 145, 70, 154, 147, 97, 59, 163, 69, 64, 147, 192,
-			163, 25, 81, 10, 205, 202, 163, 206, 234, 90, 198, 99, 151, 223,
-			49, 227, 0, 237, 232, 35, 140, 57, 18, 114, 164, 73, 178, 29,
-			13, 155, 28, 159, 99, 92, 19, 101, 111, 14, 104, 73, 237, 130,
-			94, 203, 115, 54, 53, 119, 238, 117, 60, 103, 115, 200, 19, 111,
-			176, 132, 237, 230, 189, 220, 191, 237, 249, 119, 150, 85, 122, 121,
-			230, 254, 171, 206, 120, 173, 191, 129, 17, 235, 43, 38, 163, 150,
-			35, 145, 96, 108, 12, 166, 66, 51, 161, 28, 103, 186, 98, 239,
-			71, 174, 141, 14, 35, 92, 153, 227, 67, 36, 220, 39, 159, 72,
-			192, 9, 254, 109, 177, 36, 250, 132, 235, 230, 225, 68, 122, 153,
-			93, 194, 145, 231, 81, 98, 122, 25, 75, 76, 121, 60, 115, 94,
-			214, 191, 83, 65, 142, 231, 188, 108, 207, 94, 241, 179, 48, 171,
-			130, 151, 127, 133, 213, 243, 42, 203, 42, 189, 146, 78, 252, 174,
-			123, 61, 41, 10, 205, 251, 254, 194, 249, 105, 229, 138, 220, 140,
-			234, 65, 106, 66, 128, 54, 16, 198, 181, 156, 141, 104, 219, 81,
-			230, 115, 188, 227, 161, 205, 18, 219, 137, 199, 137, 182, 219, 162,
-			112, 2, 248, 46, 91, 176, 60, 247, 21, 86, 113, 8, 147, 167,
-			21, 236, 30, 207, 125, 165, 101, 83, 202, 208, 2, 190, 187, 190,
-			82, 189, 187, 22, 48, 232, 230, 43, 173, 190, 65, 5, 90, 0,
-			14, 237, 84, 160, 3, 224, 158, 189, 226, 199, 48, 248, 77, 254,
-			53, 86, 207, 67, 22, 92, 64, 179, 122, 0, 51, 100, 12, 190,
-			79, 71, 43, 198, 92, 178, 225, 148, 72, 193, 64, 35, 197, 116,
-			147, 175, 177, 114, 30, 140, 20, 131, 188, 184, 175, 181, 108, 76,
-			188, 73, 17, 91, 0, 220, 175, 64, 27, 192, 131, 82, 204, 113,
-			200, 22, 247, 117, 150, 61, 88, 122, 174, 156, 82, 246, 56, 134,
-			240, 145, 42, 50, 240, 78, 65, 220, 60, 137, 214, 131, 168, 25,
-			100, 24, 58, 189, 71, 83, 124, 21, 104, 80, 131, 54, 128, 59,
-			6, 224, 136, 196, 0, 43, 238, 27, 44, 187, 191, 84, 150, 83,
-			204, 3, 65, 40, 87, 173, 67, 195, 116, 28, 167, 237, 193, 0,
-			223, 96, 217, 5, 5, 98, 11, 162, 79, 156, 226, 136, 38, 238,
-			27, 45, 187, 175, 116, 20, 109, 53, 121, 176, 168, 82, 48, 71,
-			60, 177, 253, 80, 29, 11, 191, 206, 43, 208, 6, 176, 87, 136,
-			63, 5, 162, 44, 122, 249, 159, 179, 122, 222, 98, 89, 165, 79,
-			88, 198, 94, 162, 232, 19, 220, 15, 110, 44, 206, 247, 104, 58,
-			67, 105, 2, 174, 202, 41, 227, 219, 154, 223, 20, 41, 169, 42,
-			193, 187, 98, 32, 57, 138, 113, 236, 196, 89, 179, 180, 129, 1,
-			149, 27, 126, 106, 254, 32, 163, 166, 192, 0, 66, 104, 1, 149,
-			246, 130, 70, 100, 89, 179, 26, 166, 231, 162, 229, 185, 63, 103,
-			21, 73, 210, 43, 2, 149, 188, 217, 178, 247, 146, 24, 86, 149,
-			234, 61, 172, 34, 241, 45, 172, 34, 207, 158, 155, 63, 77, 228,
-			93, 68, 242, 126, 179, 101, 15, 42, 208, 2, 112, 104, 84, 129,
-			14, 128, 187, 247, 136, 123, 176, 97, 203, 115, 127, 222, 178, 189,
-			242, 115, 174, 186, 4, 149, 204, 90, 87, 152, 174, 214, 143, 117,
-			201, 8, 212, 186, 149, 195, 246, 138, 10, 196, 230, 123, 119, 40,
-			208, 1, 112, 104, 88, 252, 10, 172, 90, 175, 151, 127, 187, 213,
-			243, 75, 150, 85, 122, 135, 69, 145, 221, 231, 48, 21, 27, 133,
-			137, 97, 132, 24, 183, 125, 141, 182, 42, 167, 72, 73, 82, 253,
-			97, 163, 145, 245, 188, 138, 154, 1, 181, 214, 194, 96, 24, 252,
-			225, 41, 60, 66, 201, 149, 34, 138, 235, 9, 6, 192, 72, 55,
-			109, 119, 24, 8, 202, 84, 7, 205, 240, 162, 244, 90, 158, 251,
-			118, 171, 216, 47, 110, 17, 174, 219, 11, 139, 242, 139, 150, 93,
-			42, 141, 35, 115, 75, 219, 49, 141, 164, 150, 249, 48, 109, 82,
-			43, 128, 135, 94, 92, 161, 95, 180, 236, 62, 5, 90, 0, 246,
-			239, 84, 160, 3, 224, 158, 189, 98, 19, 123, 177, 60, 247, 221,
-			150, 125, 168, 116, 25, 71, 194, 154, 5, 173, 235, 84, 90, 55,
-			74, 191, 145, 98, 136, 81, 20, 182, 131, 117, 222, 7, 148, 51,
-			12, 49, 57, 199, 201, 246, 20, 233, 31, 73, 228, 37, 124, 78,
-			190, 164, 88, 42, 141, 196, 114, 177, 111, 13, 230, 0, 236, 27,
-			82, 32, 142, 108, 120, 191, 2, 29, 0, 15, 74, 109, 175, 242,
-			173, 23, 139, 165, 109, 31, 227, 72, 218, 193, 55, 185, 73, 245,
-			38, 167, 60, 253, 39, 211, 100, 68, 84, 129, 130, 55, 198, 129,
-			223, 88, 87, 182, 44, 187, 210, 58, 244, 14, 72, 191, 150, 255,
-			222, 18, 249, 5, 252, 211, 59, 40, 250, 252, 86, 120, 145, 37,
-			57, 124, 190, 118, 22, 132, 223, 10, 249, 69, 206, 59, 35, 250,
-			140, 167, 29, 124, 166, 238, 59, 81, 174, 110, 223, 114, 245, 188,
-			174, 186, 96, 126, 230, 77, 9, 145, 58, 198, 163, 213, 67, 223,
-			137, 67, 87, 107, 100, 90, 213, 92, 48, 62, 242, 158, 37, 242,
-			244, 59, 218, 82, 244, 157, 184, 230, 106, 159, 227, 204, 22, 184,
-			114, 249, 54, 33, 210, 65, 109, 107, 189, 81, 18, 69, 149, 20,
-			24, 223, 247, 139, 11, 26, 46, 255, 186, 37, 122, 245, 112, 188,
-			37, 81, 140, 3, 202, 167, 202, 15, 253, 55, 63, 233, 28, 170,
-			83, 109, 126, 183, 91, 224, 79, 195, 168, 121, 87, 207, 130, 110,
-			169, 116, 78, 140, 110, 87, 199, 219, 47, 122, 125, 85, 206, 3,
-			78, 11, 188, 93, 34, 79, 86, 193, 108, 114, 194, 208, 105, 87,
-			216, 81, 171, 252, 122, 75, 228, 16, 15, 219, 206, 248, 148, 40,
-			46, 135, 24, 167, 68, 45, 232, 193, 171, 205, 227, 52, 213, 91,
-			208, 31, 120, 207, 18, 110, 221, 111, 251, 123, 28, 68, 192, 85,
-			23, 17, 123, 63, 227, 183, 253, 5, 172, 94, 190, 44, 10, 220,
-			150, 39, 179, 36, 101, 73, 103, 108, 71, 150, 92, 14, 8, 161,
-			183, 168, 154, 160, 81, 2, 191, 27, 228, 228, 96, 3, 70, 73,
-			249, 86, 209, 171, 251, 247, 42, 194, 11, 154, 43, 81, 92, 11,
-			46, 134, 77, 245, 182, 205, 81, 70, 134, 248, 151, 217, 38, 43,
-			191, 79, 223, 246, 227, 39, 127, 224, 189, 120, 74, 253, 241, 188,
-			183, 213, 68, 193, 203, 237, 232, 121, 157, 109, 137, 127, 160, 119,
-			242, 29, 61, 222, 137, 247, 89, 217, 119, 242, 99, 91, 223, 201,
-			175, 18, 246, 227, 66, 194, 42, 42, 12, 174, 96, 100, 0, 77,
-			248, 237, 245, 169, 62, 171, 139, 171, 31, 236, 252, 156, 205, 177,
-			148, 79, 60, 226, 200, 123, 84, 146, 104, 226, 161, 202, 77, 75,
-			231, 206, 222, 122, 234, 224, 92, 136, 167, 176, 16, 75, 151, 25,
-			14, 160, 193, 218, 43, 227, 13, 155, 237, 201, 183, 188, 146, 166,
-			55, 20, 95, 174, 133, 171, 107, 66, 42, 127, 201, 76, 151, 43,
-			65, 27, 115, 19, 92, 9, 125, 106, 99, 58, 106, 174, 132, 171,
-			148, 250, 131, 73, 176, 182, 178, 170, 252, 149, 225, 188, 91, 9,
-			87, 5, 199, 186, 71, 81, 132, 106, 93, 164, 95, 56, 156, 180,
-			10, 57, 98, 14, 200, 68, 59, 8, 44, 228, 248, 0, 179, 156,
-			86, 233, 90, 148, 229, 39, 218, 36, 240, 53, 195, 199, 133, 197,
-			39, 240, 43, 221, 97, 62, 162, 21, 20, 114, 112, 224, 220, 77,
-			34, 212, 109, 43, 205, 26, 71, 35, 164, 156, 138, 235, 254, 101,
-			188, 7, 80, 171, 15, 82, 59, 245, 160, 22, 38, 108, 241, 77,
-			239, 233, 131, 61, 7, 249, 61, 125, 184, 231, 199, 212, 123, 58,
-			252, 249, 223, 242, 244, 72, 116, 125, 207, 25, 171, 244, 209, 60,
-			175, 21, 9, 17, 70, 22, 31, 157, 216, 140, 31, 120, 40, 117,
-			112, 215, 227, 190, 26, 24, 90, 82, 192, 36, 48, 242, 81, 35,
-			218, 84, 153, 111, 102, 241, 106, 150, 42, 239, 187, 23, 159, 83,
-			62, 66, 7, 25, 28, 160, 167, 138, 17, 81, 144, 222, 137, 206,
-			156, 30, 191, 42, 185, 100, 221, 113, 211, 235, 56, 220, 58, 226,
-			117, 191, 17, 62, 24, 212, 159, 136, 110, 53, 138, 67, 16, 2,
-			48, 201, 119, 26, 212, 132, 110, 209, 225, 234, 26, 211, 32, 8,
-			116, 71, 87, 252, 26, 187, 252, 153, 77, 37, 29, 246, 50, 7,
-			130, 76, 19, 78, 26, 148, 88, 99, 2, 5, 2, 4, 204, 93,
-			241, 99, 204, 104, 201, 229, 73, 208, 102, 186, 212, 73, 128, 128,
-			216, 112, 153, 132, 17, 187, 32, 198, 188, 28, 97, 35, 209, 97,
-			171, 174, 50, 57, 149, 49, 144, 35, 3, 52, 35, 61, 229, 8,
-			70, 16, 197, 52, 56, 33, 195, 230, 90, 0, 116, 218, 172, 209,
-			219, 66, 130, 54, 19, 181, 160, 69, 73, 80, 57, 126, 231, 19,
-			236, 88, 124, 229, 52, 238, 87, 245, 104, 163, 153, 180, 227, 192,
-			95, 79, 23, 150, 104, 161, 209, 216, 228, 64, 146, 228, 180, 233,
-			199, 193, 196, 4, 110, 133, 176, 13, 146, 230, 229, 164, 42, 84,
-			224, 42, 82, 136, 240, 158, 32, 187, 116, 186, 190, 119, 112, 248,
-			157, 118, 35, 100, 99, 137, 218, 182, 219, 112, 44, 138, 133, 12,
-			94, 210, 9, 175, 248, 13, 178, 205, 64, 19, 120, 78, 10, 163,
-			232, 127, 234, 252, 172, 12, 30, 104, 97, 94, 87, 115, 130, 200,
-			1, 26, 225, 114, 236, 199, 33, 251, 135, 204, 5, 15, 180, 229,
-			236, 153, 147, 242, 89, 198, 11, 233, 245, 197, 1, 241, 106, 71,
-			189, 144, 86, 237, 61, 165, 127, 182, 177, 81, 165, 27, 195, 240,
-			115, 181, 56, 96, 23, 101, 157, 112, 150, 84, 21, 228, 168, 162,
-			245, 69, 209, 138, 26, 151, 246, 159, 36, 5, 75, 162, 195, 93,
-			44, 251, 181, 203, 27, 126, 12, 19, 135, 109, 235, 183, 195, 229,
-			70, 32, 55, 252, 77, 126, 33, 195, 74, 138, 92, 86, 227, 104,
-			131, 173, 42, 148, 231, 56, 238, 19, 142, 72, 137, 161, 13, 3,
-			124, 16, 88, 222, 212, 107, 53, 94, 237, 218, 148, 28, 11, 9,
-			249, 40, 13, 79, 19, 36, 159, 16, 72, 99, 198, 172, 19, 90,
-			188, 122, 4, 4, 24, 7, 181, 104, 181, 25, 62, 24, 84, 153,
-			47, 192, 85, 34, 168, 249, 232, 53, 38, 148, 98, 78, 174, 135,
-			137, 230, 124, 28, 102, 83, 237, 78, 218, 34, 157, 52, 213, 151,
-			249, 130, 98, 32, 250, 120, 250, 248, 155, 131, 197, 40, 24, 207,
-			189, 213, 226, 136, 241, 220, 91, 221, 181, 91, 124, 215, 198, 101,
-			179, 60, 231, 86, 251, 250, 210, 223, 218, 153, 135, 109, 78, 208,
-			27, 100, 88, 223, 182, 134, 57, 232, 108, 83, 107, 39, 228, 253,
-			211, 105, 134, 48, 32, 191, 161, 108, 220, 187, 190, 103, 25, 40,
-			19, 254, 121, 81, 35, 218, 223, 164, 104, 30, 134, 145, 27, 174,
-			89, 68, 235, 68, 200, 54, 91, 212, 74, 67, 180, 2, 65, 53,
-			105, 71, 165, 94, 171, 181, 41, 178, 42, 177, 112, 86, 160, 166,
-			196, 77, 217, 62, 170, 102, 62, 228, 149, 78, 131, 220, 58, 235,
-			193, 114, 103, 117, 149, 253, 151, 154, 210, 175, 171, 244, 75, 152,
-			125, 168, 203, 220, 139, 166, 16, 200, 84, 204, 206, 176, 43, 76,
-			81, 154, 242, 44, 90, 3, 203, 5, 172, 107, 40, 239, 57, 183,
-			246, 237, 84, 16, 172, 200, 174, 67, 10, 114, 60, 231, 214, 195,
-			215, 137, 151, 225, 98, 217, 158, 115, 135, 125, 184, 20, 103, 214,
-			202, 8, 185, 165, 131, 255, 33, 69, 43, 153, 21, 70, 200, 136,
-			55, 170, 0, 62, 214, 121, 211, 135, 177, 153, 191, 143, 110, 128,
-			138, 155, 240, 77, 143, 134, 99, 103, 172, 10, 236, 140, 85, 129,
-			141, 86, 5, 7, 21, 228, 120, 206, 29, 229, 107, 69, 136, 3,
-			119, 60, 103, 218, 222, 95, 250, 255, 101, 6, 30, 7, 171, 97,
-			66, 193, 81, 140, 19, 117, 91, 10, 3, 4, 227, 222, 123, 114,
-			220, 58, 46, 244, 165, 161, 188, 231, 76, 247, 13, 41, 200, 242,
-			156, 233, 225, 221, 10, 130, 81, 149, 246, 137, 199, 134, 200, 242,
-			161, 213, 147, 88, 165, 175, 15, 153, 43, 73, 9, 126, 49, 164,
-			143, 218, 234, 148, 233, 70, 150, 111, 99, 6, 113, 71, 245, 182,
-			164, 131, 145, 57, 238, 168, 222, 118, 37, 136, 151, 239, 40, 87,
-			182, 186, 51, 34, 95, 226, 248, 164, 126, 188, 9, 228, 137, 217,
-			45, 203, 252, 201, 120, 70, 62, 173, 71, 77, 124, 57, 199, 175,
-			106, 126, 59, 88, 141, 226, 77, 145, 141, 200, 56, 86, 214, 253,
-			150, 199, 43, 114, 221, 111, 250, 171, 204, 201, 34, 204, 211, 172,
-			179, 128, 43, 23, 68, 147, 173, 9, 252, 158, 103, 80, 70, 6,
-			127, 47, 165, 9, 239, 142, 120, 129, 75, 67, 22, 78, 153, 21,
-			24, 175, 100, 13, 172, 146, 78, 109, 13, 153, 64, 83, 6, 112,
-			34, 114, 12, 85, 140, 140, 196, 7, 101, 173, 22, 117, 154, 109,
-			244, 13, 34, 158, 9, 68, 39, 213, 66, 102, 55, 182, 33, 120,
-			11, 12, 30, 74, 212, 136, 173, 194, 247, 122, 127, 215, 35, 118,
-			103, 102, 188, 44, 7, 141, 168, 185, 106, 228, 51, 198, 9, 84,
-			83, 18, 202, 138, 19, 237, 181, 110, 185, 15, 59, 43, 111, 223,
-			76, 25, 207, 170, 76, 212, 250, 46, 46, 135, 73, 79, 39, 228,
-			114, 39, 108, 212, 151, 59, 181, 203, 65, 187, 138, 127, 87, 201,
-			118, 131, 126, 77, 54, 252, 120, 61, 108, 174, 86, 91, 81, 212,
-			168, 194, 14, 59, 29, 81, 46, 55, 227, 183, 182, 159, 92, 174,
-			214, 64, 46, 105, 100, 164, 188, 180, 51, 118, 195, 34, 39, 201,
-			117, 58, 197, 163, 21, 18, 54, 194, 26, 102, 68, 77, 42, 20,
-			13, 150, 242, 84, 145, 158, 89, 200, 217, 51, 20, 118, 144, 23,
-			38, 149, 94, 89, 48, 98, 92, 86, 57, 63, 23, 134, 186, 237,
-			36, 164, 136, 7, 225, 40, 14, 96, 38, 93, 3, 209, 233, 196,
-			169, 213, 35, 217, 27, 155, 79, 146, 54, 186, 144, 107, 139, 151,
-			70, 20, 93, 22, 178, 233, 183, 59, 49, 41, 163, 166, 204, 70,
-			121, 51, 96, 246, 68, 116, 35, 44, 107, 197, 68, 85, 206, 42,
-			185, 47, 35, 156, 211, 251, 145, 208, 97, 36, 218, 145, 74, 162,
-			9, 130, 18, 69, 96, 33, 1, 48, 108, 146, 60, 171, 50, 44,
-			171, 155, 146, 33, 178, 114, 98, 48, 186, 205, 241, 96, 84, 100,
-			40, 67, 236, 68, 206, 53, 166, 218, 193, 7, 61, 221, 132, 186,
-			98, 42, 161, 81, 152, 118, 185, 44, 247, 86, 100, 178, 173, 216,
-			171, 55, 154, 138, 164, 200, 71, 93, 189, 206, 54, 46, 180, 207,
-			227, 64, 117, 77, 19, 187, 170, 156, 74, 209, 236, 171, 233, 229,
-			84, 71, 238, 10, 218, 157, 150, 144, 83, 211, 231, 18, 126, 54,
-			226, 185, 225, 31, 196, 169, 18, 157, 226, 13, 27, 54, 180, 30,
-			90, 133, 152, 178, 12, 147, 209, 168, 165, 86, 55, 64, 218, 94,
-			25, 177, 160, 77, 199, 59, 27, 78, 195, 145, 139, 162, 210, 114,
-			80, 139, 144, 188, 245, 149, 40, 165, 172, 86, 103, 185, 17, 214,
-			40, 152, 205, 5, 14, 161, 174, 5, 54, 234, 81, 176, 208, 128,
-			250, 94, 179, 191, 12, 23, 69, 180, 130, 128, 64, 226, 227, 182,
-			92, 125, 162, 60, 94, 17, 42, 152, 16, 153, 220, 83, 100, 218,
-			154, 159, 168, 38, 180, 236, 66, 157, 102, 197, 158, 54, 133, 77,
-			89, 81, 161, 45, 53, 21, 36, 169, 252, 209, 138, 195, 117, 10,
-			153, 148, 74, 34, 233, 148, 200, 76, 44, 90, 15, 224, 178, 80,
-			110, 197, 209, 3, 155, 101, 73, 246, 32, 34, 195, 254, 210, 8,
-			109, 151, 140, 49, 92, 130, 145, 103, 69, 107, 227, 108, 66, 129,
-			207, 24, 176, 41, 49, 137, 109, 238, 156, 89, 237, 141, 94, 104,
-			253, 104, 171, 226, 124, 103, 155, 5, 182, 139, 254, 217, 202, 196,
-			59, 221, 59, 97, 83, 94, 162, 191, 57, 254, 241, 56, 81, 250,
-			122, 212, 92, 237, 154, 7, 134, 181, 230, 8, 17, 25, 50, 83,
-			36, 100, 50, 16, 245, 72, 218, 140, 36, 48, 243, 32, 134, 91,
-			17, 242, 195, 205, 160, 61, 206, 70, 160, 58, 70, 203, 150, 142,
-			200, 175, 153, 22, 148, 169, 56, 233, 212, 106, 129, 10, 68, 90,
-			110, 70, 70, 111, 101, 193, 1, 76, 232, 25, 95, 133, 78, 245,
-			229, 134, 79, 46, 2, 112, 133, 209, 4, 220, 136, 86, 19, 195,
-			98, 178, 85, 244, 208, 212, 2, 45, 38, 95, 98, 123, 229, 3,
-			79, 40, 96, 152, 134, 147, 47, 201, 24, 78, 190, 36, 99, 56,
-			249, 146, 161, 97, 113, 6, 155, 181, 60, 39, 182, 71, 203, 183,
-			164, 155, 219, 92, 241, 45, 206, 186, 93, 236, 80, 245, 103, 229,
-			160, 153, 188, 130, 160, 209, 194, 160, 130, 28, 207, 137, 189, 17,
-			241, 219, 57, 180, 212, 204, 255, 7, 171, 231, 13, 150, 85, 250,
-			64, 78, 106, 101, 112, 106, 13, 133, 108, 11, 77, 41, 88, 115,
-			225, 95, 14, 140, 32, 138, 151, 180, 162, 87, 222, 78, 209, 31,
-			46, 201, 150, 31, 198, 137, 32, 204, 251, 93, 81, 249, 82, 89,
-			199, 60, 62, 112, 225, 82, 135, 222, 68, 46, 197, 20, 31, 29,
-			233, 154, 165, 99, 36, 219, 186, 241, 2, 163, 197, 105, 195, 18,
-			35, 14, 224, 236, 164, 87, 55, 138, 16, 232, 63, 17, 225, 85,
-			128, 167, 241, 215, 45, 63, 209, 249, 201, 50, 218, 43, 193, 247,
-			107, 140, 152, 136, 54, 38, 71, 113, 166, 117, 89, 39, 13, 56,
-			252, 4, 211, 83, 168, 232, 86, 71, 26, 193, 9, 117, 70, 244,
-			238, 233, 35, 35, 53, 83, 98, 98, 122, 6, 16, 234, 47, 7,
-			210, 12, 147, 107, 6, 227, 16, 108, 196, 66, 252, 5, 179, 249,
-			196, 152, 242, 117, 61, 104, 7, 177, 182, 52, 192, 27, 56, 212,
-			91, 56, 63, 157, 102, 20, 192, 213, 140, 195, 213, 213, 32, 86,
-			57, 225, 105, 36, 120, 186, 43, 219, 72, 255, 138, 31, 54, 208,
-			65, 200, 152, 30, 5, 209, 209, 170, 0, 35, 192, 95, 98, 4,
-			249, 16, 221, 19, 165, 193, 170, 155, 188, 138, 58, 124, 181, 227,
-			39, 68, 46, 30, 182, 81, 247, 33, 178, 138, 73, 126, 190, 179,
-			45, 207, 253, 15, 86, 113, 88, 252, 58, 92, 145, 109, 167, 199,
-			203, 191, 202, 178, 95, 99, 57, 165, 119, 218, 114, 187, 183, 10,
-			69, 3, 58, 18, 180, 49, 41, 160, 232, 49, 223, 160, 237, 203,
-			193, 38, 45, 180, 32, 146, 30, 79, 77, 60, 50, 148, 60, 166,
-			27, 169, 16, 18, 198, 177, 126, 85, 78, 243, 76, 25, 51, 232,
-			63, 165, 115, 225, 248, 28, 127, 110, 41, 229, 92, 196, 178, 48,
-			4, 206, 212, 220, 125, 48, 132, 171, 52, 205, 109, 177, 26, 237,
-			164, 188, 228, 55, 55, 199, 182, 169, 11, 220, 187, 221, 142, 73,
-			88, 208, 177, 135, 104, 56, 227, 151, 248, 85, 208, 198, 180, 163,
-			175, 178, 196, 1, 76, 173, 103, 83, 214, 209, 159, 178, 220, 189,
-			152, 39, 207, 230, 28, 159, 63, 101, 185, 34, 45, 176, 160, 160,
-			111, 52, 45, 112, 160, 96, 247, 30, 113, 140, 155, 176, 60, 247,
-			213, 150, 123, 176, 44, 101, 18, 197, 168, 74, 53, 174, 139, 32,
-			165, 54, 35, 89, 239, 180, 146, 180, 77, 203, 197, 79, 250, 211,
-			130, 28, 20, 112, 134, 78, 155, 51, 116, 190, 218, 26, 45, 165,
-			5, 14, 20, 92, 115, 0, 211, 169, 218, 197, 30, 47, 255, 211,
-			150, 253, 122, 139, 210, 169, 218, 69, 24, 229, 79, 91, 69, 129,
-			38, 36, 54, 204, 234, 117, 150, 123, 61, 253, 136, 79, 182, 175,
-			179, 220, 61, 10, 68, 203, 137, 189, 135, 20, 232, 0, 120, 248,
-			58, 241, 203, 18, 77, 169, 243, 95, 180, 122, 190, 98, 89, 165,
-			95, 144, 44, 242, 225, 125, 19, 246, 36, 230, 216, 111, 80, 168,
-			81, 92, 178, 219, 244, 85, 235, 142, 138, 188, 45, 37, 255, 59,
-			120, 237, 170, 24, 242, 28, 207, 83, 122, 120, 80, 226, 196, 246,
-			130, 105, 246, 97, 64, 191, 11, 192, 29, 142, 89, 62, 93, 43,
-			124, 140, 68, 212, 37, 28, 167, 34, 36, 198, 206, 142, 26, 172,
-			31, 101, 54, 25, 94, 9, 228, 196, 4, 127, 51, 49, 33, 36,
-			121, 105, 171, 16, 236, 117, 178, 51, 134, 81, 165, 50, 167, 145,
-			105, 96, 163, 169, 149, 145, 73, 160, 186, 86, 250, 222, 24, 14,
-			79, 54, 153, 73, 181, 76, 74, 144, 224, 225, 118, 11, 231, 81,
-			92, 65, 225, 9, 173, 229, 43, 104, 142, 225, 55, 148, 118, 154,
-			111, 222, 126, 28, 92, 5, 85, 221, 50, 252, 54, 34, 124, 85,
-			92, 13, 37, 248, 213, 196, 68, 122, 195, 154, 152, 120, 10, 232,
-			16, 25, 9, 149, 71, 215, 157, 14, 25, 155, 78, 27, 6, 38,
-			221, 253, 154, 128, 38, 211, 130, 13, 216, 64, 4, 34, 115, 252,
-			77, 147, 207, 224, 55, 140, 229, 113, 99, 105, 81, 154, 53, 238,
-			81, 157, 4, 195, 217, 41, 204, 226, 93, 3, 5, 29, 243, 218,
-			174, 103, 98, 24, 179, 134, 87, 2, 14, 250, 137, 211, 27, 67,
-			175, 22, 248, 83, 168, 211, 194, 111, 38, 181, 160, 153, 146, 217,
-			114, 212, 105, 214, 83, 205, 243, 20, 5, 43, 34, 69, 4, 30,
-			88, 134, 22, 134, 191, 185, 227, 228, 109, 248, 59, 41, 95, 130,
-			56, 224, 123, 120, 90, 161, 156, 110, 46, 237, 249, 106, 76, 93,
-			29, 174, 68, 1, 250, 194, 143, 33, 125, 225, 191, 70, 8, 119,
-			161, 134, 44, 63, 87, 95, 66, 179, 151, 37, 166, 24, 213, 45,
-			143, 102, 107, 167, 212, 44, 190, 175, 248, 87, 121, 32, 225, 91,
-			1, 165, 194, 128, 255, 66, 14, 115, 76, 146, 185, 159, 164, 145,
-			136, 143, 38, 181, 168, 21, 212, 217, 228, 42, 9, 49, 197, 15,
-			28, 249, 151, 241, 236, 105, 38, 1, 93, 128, 84, 226, 15, 137,
-			177, 109, 241, 35, 74, 60, 176, 30, 232, 27, 125, 6, 31, 132,
-			120, 44, 209, 65, 186, 224, 14, 220, 138, 66, 50, 98, 13, 30,
-			240, 201, 139, 174, 169, 230, 132, 28, 96, 37, 136, 99, 214, 154,
-			192, 33, 11, 43, 39, 182, 91, 38, 114, 160, 84, 28, 71, 233,
-			36, 161, 7, 118, 185, 91, 70, 238, 3, 52, 218, 78, 77, 54,
-			87, 26, 155, 130, 112, 151, 205, 108, 130, 185, 161, 162, 86, 16,
-			183, 195, 128, 178, 195, 97, 230, 29, 202, 5, 7, 132, 159, 168,
-			28, 65, 198, 23, 117, 191, 237, 87, 133, 188, 55, 144, 137, 207,
-			134, 95, 9, 13, 135, 231, 203, 74, 160, 164, 75, 5, 20, 197,
-			178, 220, 8, 175, 152, 17, 201, 184, 28, 19, 151, 224, 59, 75,
-			57, 243, 83, 21, 58, 193, 21, 188, 74, 79, 93, 29, 49, 182,
-			76, 196, 149, 249, 76, 207, 36, 154, 202, 170, 68, 49, 144, 98,
-			122, 173, 219, 88, 139, 16, 159, 245, 136, 147, 239, 164, 241, 113,
-			141, 164, 50, 1, 92, 69, 194, 154, 122, 147, 77, 249, 206, 106,
-			208, 6, 150, 19, 174, 54, 85, 98, 153, 244, 197, 212, 204, 122,
-			150, 94, 197, 69, 106, 131, 74, 12, 146, 162, 6, 109, 160, 98,
-			90, 171, 148, 22, 81, 21, 101, 234, 252, 81, 101, 143, 73, 27,
-			195, 154, 122, 149, 163, 158, 145, 175, 93, 9, 253, 174, 227, 74,
-			37, 101, 196, 83, 171, 162, 2, 175, 66, 83, 117, 160, 58, 89,
-			223, 108, 250, 235, 108, 162, 188, 188, 41, 80, 144, 109, 177, 29,
-			41, 145, 42, 233, 245, 51, 177, 89, 217, 183, 10, 187, 243, 155,
-			134, 57, 106, 76, 15, 10, 237, 181, 56, 8, 244, 193, 163, 78,
-			28, 184, 198, 170, 76, 53, 234, 36, 73, 71, 203, 122, 206, 147,
-			153, 29, 240, 220, 56, 138, 218, 229, 74, 166, 168, 17, 172, 250,
-			53, 184, 210, 55, 235, 153, 114, 254, 171, 172, 214, 10, 141, 119,
-			105, 10, 233, 237, 4, 15, 17, 100, 10, 43, 126, 163, 177, 236,
-			215, 46, 243, 252, 154, 102, 136, 86, 158, 41, 238, 95, 82, 26,
-			40, 100, 16, 231, 227, 247, 82, 252, 162, 138, 202, 224, 168, 211,
-			78, 45, 134, 177, 106, 37, 75, 182, 9, 235, 104, 4, 135, 212,
-			11, 175, 160, 85, 92, 147, 46, 14, 248, 126, 68, 226, 120, 154,
-			217, 175, 211, 252, 191, 169, 251, 182, 224, 182, 146, 51, 61, 116,
-			159, 6, 8, 54, 36, 94, 154, 247, 67, 81, 106, 193, 163, 33,
-			41, 19, 160, 200, 145, 52, 90, 89, 163, 49, 120, 209, 8, 186,
-			80, 28, 130, 156, 25, 239, 120, 204, 61, 36, 14, 201, 99, 129,
-			0, 140, 3, 144, 195, 89, 79, 46, 78, 121, 31, 178, 107, 207,
-			238, 184, 92, 179, 91, 107, 199, 101, 103, 119, 82, 46, 199, 181,
-			142, 83, 46, 199, 177, 243, 144, 75, 197, 229, 114, 188, 149, 23,
-			63, 184, 106, 236, 74, 106, 31, 178, 143, 41, 39, 79, 187, 229,
-			36, 213, 127, 95, 78, 31, 0, 26, 105, 125, 169, 218, 240, 137,
-			255, 65, 159, 190, 157, 191, 175, 255, 255, 125, 127, 217, 15, 131,
-			6, 48, 37, 181, 183, 72, 197, 194, 163, 209, 134, 88, 82, 194,
-			118, 91, 74, 108, 219, 6, 40, 49, 116, 96, 247, 174, 17, 211,
-			180, 249, 93, 215, 92, 127, 238, 29, 73, 187, 168, 200, 162, 212,
-			13, 201, 238, 129, 87, 13, 194, 67, 126, 12, 113, 226, 154, 218,
-			137, 67, 115, 73, 219, 58, 190, 235, 137, 94, 3, 165, 4, 127,
-			106, 238, 193, 109, 54, 181, 3, 21, 234, 249, 81, 187, 118, 116,
-			157, 181, 242, 138, 26, 74, 241, 50, 138, 73, 68, 113, 60, 83,
-			251, 242, 204, 151, 206, 220, 202, 104, 220, 82, 23, 80, 241, 246,
-			207, 4, 123, 230, 199, 0, 24, 199, 189, 74, 101, 86, 119, 85,
-			108, 51, 215, 69, 141, 172, 69, 170, 214, 224, 211, 58, 185, 220,
-			53, 77, 71, 93, 56, 71, 121, 91, 228, 78, 255, 17, 227, 84,
-			222, 60, 74, 30, 80, 191, 17, 28, 41, 90, 4, 32, 189, 172,
-			237, 201, 41, 129, 182, 91, 93, 213, 196, 160, 63, 180, 177, 71,
-			41, 157, 85, 123, 19, 190, 231, 151, 77, 232, 128, 61, 26, 83,
-			150, 154, 193, 86, 4, 59, 65, 37, 104, 6, 126, 251, 4, 203,
-			103, 236, 24, 112, 13, 191, 114, 34, 131, 87, 81, 238, 191, 14,
-			174, 243, 160, 208, 177, 123, 166, 56, 74, 199, 116, 170, 52, 71,
-			152, 243, 237, 99, 183, 235, 92, 114, 202, 194, 102, 3, 102, 28,
-			253, 170, 185, 152, 8, 213, 70, 70, 26, 100, 244, 109, 197, 142,
-			23, 170, 137, 45, 244, 78, 66, 158, 85, 184, 230, 208, 242, 43,
-			85, 0, 11, 160, 136, 86, 63, 69, 91, 180, 172, 4, 43, 91,
-			190, 173, 241, 252, 245, 13, 141, 52, 24, 232, 64, 14, 180, 195,
-			5, 6, 208, 3, 71, 96, 27, 80, 95, 36, 118, 229, 169, 108,
-			193, 145, 35, 13, 204, 27, 50, 63, 177, 71, 42, 201, 255, 76,
-			23, 88, 214, 74, 201, 199, 91, 107, 250, 213, 102, 0, 13, 133,
-			29, 86, 168, 194, 213, 239, 248, 6, 231, 252, 136, 107, 72, 176,
-			49, 52, 32, 66, 143, 201, 114, 231, 132, 199, 140, 235, 237, 151,
-			252, 84, 89, 55, 100, 171, 213, 153, 162, 5, 71, 162, 98, 247,
-			107, 237, 176, 139, 35, 135, 50, 66, 43, 99, 149, 232, 120, 233,
-			172, 33, 74, 171, 192, 166, 90, 109, 175, 213, 152, 107, 233, 205,
-			25, 144, 146, 194, 61, 21, 16, 32, 207, 232, 144, 175, 181, 198,
-			33, 172, 169, 186, 29, 179, 177, 48, 148, 198, 141, 35, 226, 208,
-			135, 109, 35, 140, 11, 181, 23, 223, 173, 181, 234, 21, 53, 187,
-			29, 4, 251, 7, 185, 78, 79, 30, 67, 102, 34, 157, 107, 246,
-			130, 14, 62, 19, 237, 11, 68, 187, 240, 242, 181, 91, 11, 99,
-			99, 69, 154, 52, 229, 221, 72, 155, 197, 188, 160, 180, 6, 76,
-			121, 112, 111, 238, 53, 26, 202, 116, 25, 30, 10, 141, 241, 14,
-			107, 173, 42, 124, 9, 8, 35, 102, 34, 165, 130, 245, 190, 225,
-			87, 100, 252, 72, 201, 248, 16, 255, 50, 162, 95, 143, 229, 94,
-			200, 131, 16, 188, 138, 17, 71, 79, 30, 112, 41, 102, 193, 172,
-			196, 201, 240, 64, 227, 149, 36, 115, 240, 76, 173, 33, 166, 205,
-			138, 239, 133, 77, 105, 157, 132, 25, 162, 86, 245, 103, 59, 11,
-			203, 43, 239, 59, 61, 221, 25, 60, 7, 240, 16, 27, 70, 212,
-			248, 133, 148, 102, 118, 14, 66, 219, 172, 17, 63, 149, 43, 198,
-			87, 117, 45, 229, 32, 70, 126, 130, 210, 167, 233, 127, 65, 10,
-			48, 77, 126, 138, 48, 115, 255, 29, 138, 65, 121, 101, 191, 122,
-			97, 215, 77, 183, 188, 104, 180, 143, 72, 246, 1, 9, 80, 181,
-			126, 200, 127, 231, 99, 51, 175, 122, 185, 55, 46, 229, 126, 235,
-			163, 185, 237, 215, 126, 119, 97, 110, 225, 210, 165, 55, 63, 105,
-			14, 62, 179, 79, 253, 78, 94, 190, 170, 179, 237, 124, 113, 251,
-			163, 249, 143, 230, 230, 197, 187, 151, 225, 93, 177, 204, 127, 82,
-			109, 115, 62, 169, 183, 53, 144, 17, 92, 141, 56, 112, 25, 244,
-			83, 141, 8, 0, 48, 54, 249, 169, 70, 4, 0, 26, 155, 252,
-			20, 13, 12, 210, 223, 86, 120, 107, 242, 223, 16, 230, 238, 61,
-			235, 74, 209, 94, 40, 42, 254, 235, 193, 110, 13, 124, 214, 45,
-			10, 25, 115, 167, 24, 191, 233, 55, 124, 221, 178, 40, 68, 32,
-			115, 35, 166, 132, 152, 97, 90, 132, 162, 135, 38, 181, 232, 8,
-			241, 236, 57, 64, 73, 56, 24, 51, 242, 87, 8, 143, 184, 207,
-			243, 66, 116, 88, 7, 245, 245, 95, 111, 54, 188, 221, 166, 237,
-			89, 247, 200, 219, 24, 83, 21, 156, 130, 252, 50, 90, 68, 66,
-			60, 53, 160, 69, 71, 136, 67, 195, 244, 37, 192, 137, 167, 254,
-			26, 37, 126, 15, 35, 247, 182, 113, 178, 145, 123, 104, 105, 223,
-			137, 248, 240, 219, 206, 235, 109, 63, 154, 69, 68, 41, 29, 65,
-			140, 252, 53, 74, 247, 211, 255, 139, 21, 122, 156, 252, 28, 225,
-			172, 251, 63, 177, 229, 38, 17, 74, 139, 168, 216, 82, 133, 210,
-			83, 38, 214, 221, 224, 79, 226, 135, 38, 136, 165, 152, 96, 165,
-			99, 133, 9, 20, 212, 97, 172, 178, 124, 212, 204, 236, 6, 102,
-			70, 229, 67, 65, 59, 209, 10, 214, 149, 112, 87, 139, 14, 40,
-			74, 44, 107, 218, 22, 3, 19, 194, 3, 66, 93, 13, 18, 81,
-			70, 111, 213, 30, 155, 81, 232, 203, 221, 90, 61, 144, 182, 255,
-			182, 90, 170, 155, 32, 179, 54, 68, 231, 80, 25, 67, 78, 94,
-			169, 67, 135, 133, 181, 138, 58, 199, 104, 149, 80, 27, 223, 160,
-			186, 79, 59, 242, 133, 175, 78, 0, 163, 246, 115, 173, 158, 0,
-			208, 39, 63, 215, 220, 160, 128, 208, 39, 63, 71, 108, 74, 139,
-			142, 16, 249, 121, 250, 95, 53, 8, 159, 252, 13, 194, 231, 221,
-			127, 143, 120, 193, 120, 104, 69, 251, 134, 102, 141, 239, 55, 188,
-			170, 52, 221, 201, 184, 131, 113, 109, 49, 198, 94, 191, 218, 108,
-			156, 180, 157, 214, 45, 142, 28, 13, 154, 157, 1, 59, 131, 68,
-			2, 221, 240, 15, 189, 160, 114, 51, 59, 11, 78, 33, 106, 206,
-			222, 87, 188, 54, 250, 252, 159, 149, 180, 25, 55, 170, 222, 161,
-			127, 19, 78, 61, 15, 84, 72, 224, 248, 69, 175, 233, 14, 49,
-			90, 255, 38, 234, 14, 148, 20, 162, 233, 14, 4, 13, 102, 103,
-			180, 232, 8, 241, 28, 167, 159, 119, 160, 59, 48, 35, 159, 198,
-			248, 188, 251, 123, 78, 100, 163, 122, 114, 93, 182, 213, 177, 83,
-			27, 35, 51, 146, 86, 198, 200, 79, 43, 166, 139, 29, 95, 58,
-			114, 66, 181, 98, 72, 242, 194, 218, 138, 21, 226, 37, 111, 72,
-			202, 162, 227, 183, 246, 93, 139, 177, 103, 24, 50, 240, 255, 143,
-			84, 28, 19, 248, 46, 70, 76, 10, 209, 124, 83, 161, 196, 159,
-			198, 230, 155, 138, 89, 240, 211, 248, 28, 167, 255, 16, 24, 24,
-			82, 191, 143, 19, 159, 197, 200, 253, 4, 55, 8, 8, 8, 7,
-			226, 31, 6, 185, 40, 2, 116, 181, 86, 205, 21, 150, 239, 61,
-			193, 212, 76, 219, 215, 100, 160, 142, 104, 54, 61, 112, 191, 143,
-			14, 209, 106, 186, 76, 34, 70, 126, 31, 167, 7, 233, 43, 138,
-			205, 129, 124, 6, 227, 89, 247, 14, 223, 146, 71, 191, 202, 9,
-			47, 183, 26, 230, 234, 38, 228, 135, 193, 190, 218, 53, 200, 193,
-			87, 150, 155, 156, 70, 173, 82, 225, 250, 252, 173, 64, 26, 202,
-			187, 252, 180, 36, 120, 32, 144, 181, 17, 147, 66, 84, 125, 4,
-			100, 16, 228, 51, 152, 61, 165, 69, 71, 136, 211, 51, 6, 114,
-			245, 23, 159, 164, 31, 249, 245, 65, 174, 128, 236, 15, 236, 103,
-			42, 208, 109, 27, 38, 198, 253, 141, 192, 187, 178, 159, 69, 116,
-			80, 249, 142, 222, 11, 170, 15, 55, 131, 221, 135, 126, 147, 77,
-			1, 118, 230, 208, 107, 156, 108, 7, 101, 141, 27, 82, 79, 138,
-			101, 118, 142, 102, 244, 207, 173, 70, 69, 17, 154, 234, 55, 182,
-			26, 21, 118, 158, 158, 50, 142, 251, 219, 59, 39, 128, 243, 233,
-			221, 200, 152, 103, 75, 39, 108, 148, 166, 154, 80, 216, 56, 225,
-			104, 230, 212, 134, 146, 178, 117, 202, 172, 250, 104, 154, 215, 40,
-			53, 178, 83, 139, 154, 168, 174, 179, 107, 162, 30, 169, 154, 128,
-			87, 90, 91, 77, 204, 179, 165, 147, 236, 187, 136, 14, 197, 138,
-			84, 164, 164, 203, 52, 21, 54, 189, 102, 43, 84, 20, 175, 31,
-			236, 128, 41, 117, 121, 43, 95, 130, 87, 54, 212, 171, 217, 59,
-			52, 37, 159, 176, 12, 237, 41, 109, 45, 47, 175, 150, 74, 3,
-			9, 54, 68, 251, 55, 55, 10, 107, 165, 245, 7, 27, 155, 219,
-			171, 27, 27, 15, 54, 6, 16, 235, 163, 116, 169, 176, 178, 189,
-			89, 92, 190, 187, 186, 57, 128, 133, 92, 216, 218, 188, 173, 126,
-			119, 178, 95, 254, 123, 68, 34, 60, 21, 35, 11, 78, 1, 228,
-			239, 145, 132, 192, 61, 237, 132, 192, 231, 104, 70, 83, 6, 136,
-			215, 211, 18, 49, 168, 31, 109, 134, 177, 4, 59, 146, 43, 184,
-			55, 74, 32, 213, 71, 145, 24, 83, 155, 196, 56, 251, 223, 17,
-			237, 23, 125, 84, 92, 127, 249, 32, 104, 250, 98, 137, 120, 84,
-			79, 133, 173, 157, 170, 223, 52, 61, 165, 196, 246, 182, 59, 143,
-			107, 59, 121, 255, 182, 39, 31, 211, 246, 212, 227, 218, 222, 211,
-			222, 246, 236, 55, 17, 157, 104, 107, 99, 193, 220, 244, 2, 20,
-			81, 237, 32, 84, 139, 141, 12, 163, 161, 190, 125, 172, 223, 82,
-			227, 37, 19, 212, 163, 206, 26, 167, 61, 202, 139, 78, 53, 93,
-			139, 191, 90, 179, 179, 239, 16, 154, 146, 64, 28, 246, 52, 237,
-			175, 1, 71, 172, 164, 239, 136, 166, 152, 211, 240, 120, 25, 158,
-			22, 203, 44, 79, 135, 98, 233, 66, 127, 183, 225, 235, 74, 15,
-			90, 105, 75, 240, 3, 123, 142, 78, 202, 244, 94, 89, 46, 250,
-			94, 37, 42, 66, 107, 254, 56, 36, 41, 152, 20, 186, 180, 144,
-			45, 26, 66, 116, 137, 45, 117, 59, 198, 123, 7, 39, 58, 91,
-			165, 167, 237, 14, 21, 159, 83, 188, 202, 187, 190, 106, 125, 175,
-			141, 83, 86, 159, 135, 172, 76, 199, 237, 108, 182, 163, 139, 251,
-			112, 188, 7, 114, 188, 248, 184, 28, 35, 13, 216, 24, 181, 242,
-			142, 30, 135, 108, 134, 14, 52, 107, 15, 125, 137, 95, 244, 27,
-			48, 99, 74, 174, 238, 62, 120, 94, 130, 199, 98, 214, 156, 166,
-			253, 161, 191, 219, 106, 4, 205, 19, 133, 109, 131, 33, 120, 106,
-			163, 79, 63, 150, 135, 117, 118, 213, 0, 106, 51, 0, 229, 60,
-			251, 190, 80, 206, 80, 35, 106, 239, 144, 116, 114, 32, 117, 135,
-			164, 233, 64, 38, 91, 167, 125, 82, 49, 54, 252, 35, 64, 162,
-			61, 110, 249, 57, 75, 51, 240, 153, 203, 59, 219, 13, 255, 8,
-			244, 193, 217, 232, 21, 143, 86, 118, 54, 252, 163, 246, 17, 230,
-			180, 143, 176, 236, 31, 33, 122, 170, 4, 54, 25, 165, 145, 156,
-			158, 210, 57, 238, 84, 106, 59, 106, 145, 161, 50, 203, 165, 74,
-			109, 135, 77, 210, 94, 176, 226, 52, 68, 141, 164, 6, 166, 229,
-			131, 98, 153, 61, 69, 251, 196, 255, 65, 117, 127, 251, 161, 15,
-			117, 150, 67, 231, 148, 122, 122, 215, 23, 213, 62, 35, 179, 240,
-			154, 173, 134, 175, 22, 189, 232, 65, 246, 69, 202, 150, 1, 166,
-			179, 86, 107, 26, 42, 3, 246, 33, 154, 110, 168, 94, 81, 104,
-			225, 78, 148, 109, 188, 243, 54, 204, 11, 217, 127, 141, 232, 232,
-			70, 180, 177, 88, 111, 133, 134, 54, 253, 87, 201, 151, 93, 162,
-			61, 170, 183, 20, 145, 254, 216, 163, 222, 77, 201, 30, 100, 23,
-			233, 160, 28, 200, 181, 178, 111, 112, 226, 178, 143, 250, 97, 24,
-			215, 202, 190, 194, 153, 102, 255, 183, 67, 199, 58, 106, 173, 150,
-			228, 23, 218, 150, 228, 249, 142, 130, 31, 241, 102, 219, 178, 204,
-			238, 208, 1, 133, 253, 217, 54, 253, 128, 159, 172, 31, 250, 213,
-			139, 70, 91, 95, 164, 20, 120, 127, 160, 117, 208, 170, 190, 197,
-			197, 39, 174, 216, 170, 120, 85, 180, 127, 163, 215, 215, 255, 118,
-			239, 47, 210, 189, 191, 110, 219, 59, 140, 194, 58, 68, 27, 31,
-			72, 192, 118, 227, 110, 113, 125, 125, 117, 101, 0, 153, 237, 70,
-			113, 117, 77, 111, 55, 48, 235, 167, 153, 91, 133, 205, 194, 61,
-			179, 191, 248, 93, 218, 107, 106, 195, 6, 233, 105, 120, 190, 189,
-			181, 118, 119, 237, 193, 203, 107, 3, 9, 241, 104, 237, 193, 230,
-			118, 97, 123, 99, 117, 253, 94, 113, 185, 48, 128, 216, 105, 218,
-			123, 235, 193, 198, 82, 113, 101, 101, 117, 109, 0, 179, 17, 58,
-			120, 191, 88, 42, 21, 215, 94, 216, 46, 21, 95, 88, 43, 108,
-			110, 109, 172, 14, 56, 226, 69, 177, 177, 137, 30, 17, 81, 184,
-			120, 180, 177, 250, 226, 214, 106, 105, 115, 32, 249, 43, 98, 166,
-			255, 212, 167, 61, 44, 153, 78, 124, 15, 35, 250, 87, 24, 48,
-			211, 233, 78, 204, 244, 194, 229, 191, 207, 152, 233, 175, 161, 8,
-			51, 189, 87, 107, 240, 117, 57, 225, 241, 27, 185, 155, 92, 105,
-			143, 188, 183, 93, 89, 226, 214, 121, 129, 235, 78, 144, 181, 86,
-			7, 176, 157, 90, 243, 192, 228, 0, 118, 123, 233, 50, 98, 115,
-			179, 75, 159, 120, 157, 181, 76, 228, 85, 79, 34, 116, 11, 212,
-			92, 70, 214, 18, 229, 26, 149, 158, 53, 80, 97, 154, 24, 215,
-			44, 219, 167, 18, 31, 83, 168, 225, 190, 8, 53, 44, 254, 125,
-			171, 71, 162, 134, 159, 74, 44, 32, 247, 150, 66, 67, 40, 248,
-			182, 170, 225, 156, 197, 71, 164, 235, 3, 72, 106, 185, 75, 231,
-			149, 160, 10, 200, 101, 117, 46, 206, 211, 197, 127, 155, 154, 255,
-			181, 254, 81, 126, 47, 42, 3, 186, 115, 206, 248, 21, 25, 159,
-			33, 93, 55, 21, 155, 77, 85, 30, 46, 88, 148, 21, 71, 191,
-			204, 247, 32, 86, 19, 95, 200, 155, 143, 160, 15, 63, 161, 138,
-			244, 215, 8, 0, 89, 28, 242, 142, 227, 151, 241, 69, 152, 57,
-			10, 60, 240, 54, 233, 164, 212, 63, 90, 208, 163, 33, 156, 191,
-			225, 213, 235, 60, 40, 223, 156, 87, 221, 36, 22, 118, 112, 58,
-			155, 205, 83, 190, 152, 239, 82, 64, 20, 69, 213, 234, 112, 47,
-			228, 47, 172, 110, 70, 62, 170, 66, 77, 229, 184, 19, 249, 66,
-			61, 252, 106, 89, 186, 174, 88, 198, 105, 105, 124, 169, 241, 23,
-			10, 171, 202, 48, 18, 183, 238, 201, 187, 15, 89, 132, 14, 126,
-			152, 167, 252, 153, 124, 164, 121, 224, 148, 218, 121, 234, 131, 168,
-			109, 170, 247, 142, 2, 79, 147, 29, 107, 27, 128, 24, 23, 70,
-			85, 57, 231, 91, 27, 247, 110, 249, 205, 221, 3, 25, 192, 109,
-			253, 65, 105, 83, 100, 208, 181, 235, 244, 37, 60, 180, 108, 91,
-			13, 166, 60, 229, 151, 163, 207, 165, 113, 117, 218, 148, 11, 73,
-			116, 104, 35, 29, 70, 56, 34, 60, 142, 157, 1, 41, 191, 18,
-			181, 46, 158, 81, 61, 210, 153, 98, 204, 14, 4, 152, 167, 139,
-			177, 209, 120, 157, 183, 31, 224, 91, 193, 124, 43, 200, 215, 79,
-			32, 169, 61, 186, 175, 27, 91, 74, 188, 189, 123, 141, 26, 0,
-			39, 230, 37, 0, 187, 17, 138, 151, 13, 240, 248, 169, 244, 4,
-			253, 162, 161, 102, 158, 198, 227, 238, 91, 72, 124, 71, 29, 123,
-			0, 166, 151, 226, 10, 4, 203, 83, 133, 193, 148, 16, 129, 242,
-			97, 126, 148, 103, 241, 168, 197, 192, 16, 20, 2, 126, 173, 251,
-			71, 181, 13, 54, 210, 200, 20, 52, 205, 157, 151, 98, 249, 146,
-			121, 218, 88, 220, 105, 229, 163, 47, 177, 184, 211, 189, 54, 22,
-			119, 122, 116, 140, 126, 1, 105, 44, 238, 69, 60, 225, 126, 22,
-			9, 133, 48, 238, 58, 181, 90, 147, 215, 33, 186, 188, 140, 64,
-			175, 186, 78, 214, 65, 145, 68, 107, 198, 190, 27, 249, 124, 254,
-			102, 222, 171, 215, 195, 122, 173, 41, 22, 114, 57, 179, 238, 181,
-			42, 178, 178, 77, 95, 186, 131, 128, 47, 254, 145, 232, 44, 69,
-			41, 8, 81, 210, 171, 112, 49, 102, 162, 138, 202, 149, 32, 2,
-			173, 38, 69, 245, 210, 22, 76, 245, 98, 239, 176, 5, 83, 189,
-			56, 54, 78, 159, 211, 48, 213, 57, 236, 186, 151, 120, 81, 223,
-			7, 67, 213, 1, 187, 247, 62, 95, 193, 192, 74, 147, 226, 253,
-			180, 5, 43, 157, 235, 29, 177, 96, 165, 115, 227, 19, 244, 61,
-			164, 113, 165, 151, 240, 144, 251, 151, 136, 63, 144, 108, 141, 98,
-			15, 172, 39, 8, 240, 132, 177, 6, 98, 80, 237, 242, 81, 243,
-			188, 216, 12, 165, 51, 27, 229, 210, 112, 220, 106, 248, 250, 62,
-			55, 110, 239, 148, 119, 171, 150, 78, 129, 37, 49, 114, 223, 174,
-			215, 183, 131, 178, 116, 67, 208, 195, 206, 110, 160, 4, 195, 71,
-			96, 160, 185, 136, 190, 25, 250, 255, 246, 253, 194, 50, 111, 122,
-			251, 17, 148, 53, 41, 154, 215, 99, 129, 87, 47, 165, 251, 44,
-			240, 234, 165, 65, 70, 191, 132, 36, 120, 245, 106, 226, 57, 228,
-			190, 141, 120, 201, 175, 54, 229, 58, 106, 173, 70, 79, 54, 21,
-			201, 217, 71, 204, 190, 115, 84, 215, 223, 180, 234, 216, 80, 84,
-			27, 215, 125, 145, 219, 43, 185, 66, 189, 238, 87, 247, 131, 170,
-			159, 43, 86, 193, 113, 83, 60, 9, 202, 252, 192, 247, 36, 120,
-			87, 99, 92, 174, 166, 93, 122, 79, 99, 92, 174, 225, 33, 247,
-			121, 94, 242, 14, 245, 120, 145, 138, 113, 236, 117, 155, 222, 69,
-			65, 29, 43, 65, 222, 6, 193, 92, 83, 189, 36, 65, 48, 215,
-			84, 47, 73, 16, 204, 181, 65, 70, 95, 208, 32, 152, 235, 120,
-			194, 189, 174, 135, 151, 49, 20, 43, 118, 145, 136, 79, 57, 42,
-			27, 186, 50, 90, 46, 35, 28, 204, 117, 131, 187, 17, 141, 187,
-			174, 134, 130, 196, 193, 92, 31, 27, 167, 47, 65, 145, 152, 57,
-			55, 176, 235, 22, 31, 57, 20, 164, 111, 154, 114, 57, 85, 93,
-			33, 57, 241, 213, 53, 159, 54, 92, 75, 109, 213, 53, 16, 99,
-			228, 134, 169, 129, 104, 217, 13, 53, 70, 16, 140, 145, 27, 227,
-			19, 244, 85, 73, 153, 94, 72, 172, 33, 247, 129, 110, 195, 116,
-			24, 17, 211, 55, 107, 93, 7, 68, 65, 242, 40, 26, 162, 70,
-			88, 44, 164, 110, 136, 125, 228, 226, 165, 75, 22, 143, 122, 33,
-			61, 73, 167, 40, 33, 176, 79, 90, 198, 247, 28, 183, 159, 203,
-			77, 61, 164, 214, 184, 108, 12, 179, 246, 114, 207, 105, 186, 69,
-			83, 66, 18, 74, 176, 74, 6, 220, 91, 49, 183, 39, 0, 107,
-			29, 195, 166, 73, 97, 57, 244, 124, 167, 57, 84, 235, 173, 16,
-			108, 245, 58, 176, 5, 248, 153, 230, 41, 237, 163, 61, 50, 91,
-			36, 242, 205, 68, 50, 102, 206, 106, 95, 63, 157, 81, 197, 34,
-			230, 188, 64, 198, 221, 9, 51, 38, 202, 53, 48, 98, 168, 149,
-			209, 202, 9, 65, 210, 161, 72, 198, 204, 121, 97, 116, 140, 94,
-			85, 57, 97, 230, 20, 201, 144, 59, 109, 182, 95, 246, 40, 63,
-			242, 42, 1, 216, 67, 252, 215, 235, 129, 164, 97, 215, 249, 136,
-			42, 20, 73, 95, 36, 139, 140, 6, 25, 45, 169, 124, 29, 230,
-			220, 37, 67, 238, 74, 123, 190, 98, 108, 68, 19, 167, 140, 87,
-			46, 29, 121, 188, 122, 93, 194, 124, 53, 101, 174, 156, 226, 125,
-			171, 80, 49, 121, 220, 181, 10, 117, 48, 115, 238, 14, 50, 224,
-			248, 4, 6, 252, 251, 120, 216, 34, 138, 191, 175, 84, 75, 18,
-			197, 223, 87, 132, 195, 146, 40, 254, 62, 27, 162, 255, 3, 73,
-			42, 248, 151, 18, 117, 36, 198, 113, 237, 208, 151, 58, 45, 153,
-			121, 249, 178, 113, 83, 133, 47, 20, 241, 97, 171, 65, 16, 0,
-			159, 175, 95, 201, 215, 79, 242, 116, 241, 79, 208, 175, 123, 71,
-			124, 223, 3, 31, 254, 46, 39, 13, 121, 208, 104, 214, 64, 145,
-			20, 191, 78, 108, 144, 91, 131, 63, 180, 136, 234, 95, 74, 15,
-			210, 243, 154, 168, 254, 21, 204, 220, 225, 78, 2, 245, 136, 88,
-			62, 41, 210, 164, 45, 98, 249, 87, 20, 46, 79, 18, 203, 191,
-			50, 48, 72, 11, 154, 57, 254, 85, 124, 214, 189, 108, 136, 17,
-			212, 173, 119, 228, 246, 239, 191, 46, 234, 18, 72, 18, 191, 78,
-			250, 99, 112, 88, 112, 94, 197, 70, 74, 50, 231, 213, 204, 160,
-			69, 44, 255, 170, 98, 236, 150, 196, 242, 175, 158, 153, 50, 206,
-			10, 206, 107, 248, 140, 251, 97, 83, 180, 190, 107, 205, 65, 76,
-			19, 21, 26, 9, 12, 144, 210, 142, 59, 221, 201, 233, 61, 61,
-			107, 170, 129, 137, 200, 207, 72, 73, 230, 188, 102, 170, 33, 154,
-			249, 154, 98, 245, 6, 199, 5, 231, 53, 119, 18, 40, 43, 29,
-			33, 108, 227, 41, 119, 202, 84, 67, 94, 228, 91, 84, 162, 161,
-			41, 195, 33, 34, 177, 145, 146, 204, 217, 54, 101, 136, 143, 180,
-			173, 88, 188, 29, 88, 30, 183, 39, 207, 168, 50, 8, 115, 118,
-			240, 132, 59, 197, 111, 183, 14, 193, 209, 207, 43, 195, 10, 102,
-			93, 141, 155, 50, 72, 82, 36, 214, 223, 142, 32, 230, 236, 168,
-			185, 221, 1, 254, 238, 157, 177, 113, 192, 84, 58, 24, 248, 215,
-			199, 220, 103, 37, 34, 48, 162, 128, 22, 3, 85, 221, 30, 231,
-			249, 253, 96, 183, 81, 11, 253, 93, 24, 10, 210, 63, 223, 175,
-			215, 118, 15, 76, 121, 73, 200, 166, 71, 75, 136, 57, 126, 154,
-			105, 201, 97, 142, 63, 50, 74, 47, 64, 121, 41, 230, 236, 227,
-			113, 119, 156, 191, 124, 80, 51, 254, 171, 157, 202, 151, 74, 138,
-			116, 186, 1, 41, 196, 156, 125, 181, 225, 116, 128, 214, 123, 127,
-			116, 140, 62, 128, 12, 123, 152, 243, 113, 60, 238, 46, 117, 107,
-			128, 225, 69, 174, 120, 98, 231, 27, 28, 250, 79, 208, 150, 30,
-			224, 42, 215, 109, 233, 65, 204, 249, 120, 90, 23, 221, 227, 48,
-			231, 227, 163, 99, 42, 98, 66, 154, 57, 21, 60, 225, 126, 0,
-			218, 18, 113, 48, 155, 42, 68, 165, 234, 188, 211, 22, 173, 184,
-			3, 44, 223, 21, 243, 93, 210, 14, 115, 42, 99, 227, 244, 54,
-			228, 221, 203, 156, 26, 30, 113, 63, 196, 11, 49, 224, 133, 138,
-			113, 160, 175, 52, 20, 159, 56, 56, 189, 1, 251, 87, 151, 161,
-			213, 11, 204, 226, 186, 204, 94, 196, 156, 154, 154, 10, 29, 32,
-			113, 174, 177, 97, 250, 16, 188, 111, 146, 45, 32, 59, 254, 152,
-			42, 179, 172, 189, 43, 204, 197, 184, 95, 230, 197, 117, 29, 203,
-			217, 15, 187, 78, 145, 214, 157, 56, 237, 58, 83, 154, 112, 14,
-			173, 244, 24, 157, 209, 225, 28, 142, 49, 115, 39, 99, 179, 81,
-			113, 61, 42, 56, 22, 203, 225, 56, 22, 203, 225, 56, 22, 203,
-			225, 120, 96, 144, 62, 173, 99, 53, 188, 129, 207, 186, 19, 177,
-			112, 22, 197, 117, 174, 236, 76, 81, 96, 4, 34, 18, 218, 97,
-			18, 222, 200, 12, 90, 97, 18, 222, 80, 51, 143, 12, 147, 240,
-			198, 153, 41, 24, 142, 68, 204, 60, 111, 62, 217, 112, 4, 235,
-			191, 243, 166, 169, 181, 168, 218, 155, 234, 179, 131, 237, 223, 121,
-			83, 13, 71, 130, 29, 70, 254, 17, 194, 99, 238, 213, 72, 157,
-			161, 238, 79, 58, 28, 165, 63, 129, 147, 132, 108, 122, 180, 136,
-			132, 152, 102, 90, 132, 66, 212, 136, 36, 152, 48, 242, 41, 132,
-			199, 221, 177, 142, 33, 105, 241, 163, 18, 136, 12, 241, 41, 237,
-			219, 70, 96, 187, 254, 41, 212, 59, 164, 69, 71, 136, 138, 20,
-			159, 128, 183, 131, 200, 243, 217, 46, 205, 208, 3, 229, 9, 218,
-			145, 148, 249, 232, 118, 36, 145, 16, 211, 186, 204, 164, 35, 196,
-			209, 49, 122, 25, 202, 4, 162, 107, 60, 225, 62, 221, 57, 28,
-			161, 108, 205, 150, 174, 71, 164, 204, 36, 149, 132, 215, 116, 179,
-			82, 192, 143, 221, 59, 172, 69, 224, 199, 30, 27, 167, 111, 35,
-			233, 153, 241, 25, 148, 248, 99, 132, 220, 127, 130, 120, 1, 124,
-			160, 249, 76, 68, 146, 108, 43, 44, 12, 160, 89, 94, 175, 180,
-			66, 77, 231, 37, 3, 34, 181, 143, 25, 218, 62, 104, 44, 139,
-			81, 62, 250, 95, 142, 159, 182, 129, 164, 157, 53, 62, 131, 210,
-			231, 129, 166, 23, 156, 53, 222, 66, 120, 212, 157, 141, 246, 234,
-			48, 125, 52, 107, 50, 152, 31, 223, 57, 105, 31, 88, 145, 243,
-			197, 91, 186, 31, 164, 243, 197, 91, 168, 119, 208, 114, 190, 120,
-			11, 13, 143, 208, 37, 21, 139, 131, 252, 17, 194, 174, 123, 217,
-			12, 216, 88, 219, 213, 169, 4, 232, 118, 218, 90, 55, 107, 10,
-			68, 73, 200, 68, 23, 136, 32, 207, 222, 17, 45, 58, 66, 28,
-			159, 160, 243, 42, 48, 7, 249, 28, 194, 35, 238, 121, 254, 242,
-			129, 116, 53, 182, 176, 53, 246, 216, 208, 185, 11, 13, 252, 92,
-			148, 187, 168, 241, 231, 80, 239, 128, 22, 29, 33, 14, 13, 211,
-			59, 42, 38, 7, 249, 188, 24, 116, 55, 34, 109, 125, 68, 246,
-			143, 83, 89, 136, 202, 33, 50, 235, 209, 34, 18, 162, 26, 122,
-			16, 151, 131, 124, 94, 12, 189, 15, 170, 192, 28, 228, 29, 49,
-			76, 166, 58, 134, 94, 84, 190, 201, 89, 12, 192, 119, 162, 38,
-			137, 1, 248, 142, 30, 128, 16, 149, 131, 188, 35, 6, 195, 6,
-			208, 241, 167, 190, 128, 18, 255, 17, 33, 119, 133, 23, 36, 43,
-			120, 195, 7, 71, 161, 29, 79, 94, 172, 171, 11, 101, 219, 227,
-			87, 19, 77, 72, 40, 186, 222, 119, 26, 146, 110, 49, 50, 190,
-			128, 210, 125, 116, 89, 8, 189, 140, 252, 51, 132, 79, 187, 125,
-			146, 82, 175, 21, 250, 215, 249, 194, 34, 205, 62, 109, 110, 109,
-			119, 124, 62, 45, 45, 198, 225, 244, 156, 197, 94, 33, 126, 151,
-			176, 227, 84, 111, 66, 228, 146, 57, 5, 77, 72, 245, 10, 157,
-			179, 69, 172, 196, 103, 84, 129, 95, 66, 184, 175, 173, 0, 73,
-			78, 36, 10, 16, 95, 73, 178, 242, 217, 5, 32, 241, 86, 230,
-			180, 202, 17, 181, 137, 88, 137, 75, 42, 70, 0, 249, 10, 194,
-			83, 238, 101, 254, 64, 40, 237, 34, 55, 182, 107, 173, 208, 205,
-			26, 63, 12, 170, 77, 8, 197, 165, 210, 128, 225, 86, 251, 221,
-			166, 96, 20, 125, 69, 127, 163, 148, 34, 187, 238, 29, 215, 34,
-			144, 93, 79, 158, 161, 31, 129, 2, 17, 35, 127, 134, 240, 121,
-			247, 110, 188, 64, 46, 123, 77, 6, 234, 10, 107, 74, 84, 14,
-			248, 17, 60, 114, 218, 190, 100, 144, 111, 70, 245, 16, 131, 235,
-			207, 162, 122, 32, 40, 170, 247, 140, 22, 29, 33, 158, 227, 112,
-			147, 145, 18, 131, 235, 93, 132, 23, 221, 155, 60, 50, 203, 119,
-			244, 65, 24, 163, 35, 130, 235, 103, 27, 66, 20, 21, 141, 109,
-			30, 237, 20, 12, 196, 119, 181, 51, 99, 10, 75, 134, 111, 150,
-			211, 34, 240, 104, 95, 90, 160, 147, 80, 19, 135, 145, 175, 34,
-			204, 221, 211, 96, 21, 50, 81, 244, 100, 90, 135, 192, 175, 70,
-			76, 9, 49, 51, 172, 69, 36, 196, 145, 73, 45, 66, 86, 103,
-			207, 209, 44, 100, 76, 24, 249, 26, 194, 57, 119, 24, 50, 182,
-			39, 171, 40, 127, 34, 19, 25, 49, 37, 196, 140, 171, 69, 36,
-			196, 201, 25, 45, 58, 66, 252, 224, 28, 45, 66, 254, 73, 70,
-			190, 142, 240, 146, 251, 33, 126, 223, 171, 215, 197, 248, 153, 86,
-			68, 97, 60, 119, 83, 151, 103, 207, 141, 146, 181, 75, 94, 157,
-			136, 100, 211, 166, 26, 73, 2, 121, 25, 49, 37, 196, 204, 83,
-			90, 68, 66, 188, 240, 156, 22, 29, 33, 126, 184, 64, 239, 66,
-			53, 82, 140, 124, 3, 225, 179, 238, 115, 112, 57, 4, 151, 52,
-			160, 160, 58, 230, 74, 164, 199, 38, 158, 136, 216, 55, 238, 107,
-			231, 189, 152, 46, 139, 149, 241, 27, 145, 14, 137, 241, 255, 13,
-			212, 59, 161, 69, 71, 136, 103, 166, 232, 55, 9, 20, 221, 195,
-			200, 247, 16, 62, 227, 254, 115, 194, 75, 218, 182, 34, 54, 144,
-			49, 47, 134, 124, 41, 230, 190, 32, 15, 180, 42, 224, 163, 250,
-			37, 167, 80, 17, 180, 125, 118, 170, 89, 236, 179, 54, 249, 172,
-			165, 128, 138, 155, 210, 102, 169, 13, 20, 203, 137, 174, 16, 0,
-			174, 197, 56, 246, 30, 250, 60, 4, 184, 91, 165, 28, 1, 33,
-			130, 234, 62, 196, 9, 108, 82, 25, 119, 114, 142, 251, 71, 126,
-			85, 209, 173, 104, 50, 76, 176, 59, 134, 77, 48, 35, 137, 165,
-			189, 107, 163, 164, 67, 62, 229, 51, 39, 126, 115, 54, 207, 11,
-			98, 27, 32, 163, 174, 72, 31, 92, 109, 205, 84, 49, 213, 84,
-			236, 152, 210, 237, 7, 91, 247, 86, 120, 216, 244, 26, 77, 77,
-			86, 86, 169, 208, 246, 34, 100, 230, 115, 38, 150, 232, 177, 7,
-			193, 136, 99, 247, 41, 112, 53, 0, 119, 2, 109, 129, 33, 121,
-			113, 143, 31, 195, 77, 71, 123, 182, 134, 104, 255, 192, 111, 248,
-			115, 241, 158, 145, 87, 167, 0, 11, 46, 55, 106, 117, 211, 190,
-			238, 253, 34, 33, 126, 17, 98, 181, 170, 47, 41, 68, 173, 140,
-			134, 245, 36, 65, 105, 122, 180, 136, 132, 152, 30, 211, 162, 35,
-			68, 119, 146, 126, 31, 129, 134, 165, 25, 249, 15, 66, 195, 254,
-			13, 226, 43, 191, 36, 199, 113, 87, 138, 227, 86, 165, 25, 28,
-			122, 77, 95, 178, 86, 69, 132, 7, 166, 233, 208, 171, 138, 245,
-			38, 162, 148, 105, 99, 172, 178, 232, 168, 21, 15, 117, 23, 114,
-			71, 217, 176, 116, 10, 90, 210, 175, 69, 36, 196, 129, 81, 45,
-			58, 66, 156, 152, 132, 96, 145, 61, 44, 245, 125, 148, 248, 75,
-			132, 220, 107, 188, 216, 17, 48, 182, 157, 35, 81, 187, 82, 152,
-			5, 126, 101, 201, 138, 24, 243, 125, 148, 30, 133, 195, 37, 68,
-			140, 249, 129, 216, 119, 92, 7, 107, 83, 161, 94, 87, 86, 166,
-			136, 16, 232, 160, 86, 41, 75, 107, 172, 188, 79, 210, 177, 182,
-			11, 38, 91, 21, 64, 38, 9, 89, 165, 173, 240, 50, 63, 208,
-			155, 18, 25, 94, 230, 7, 98, 83, 178, 0, 197, 34, 70, 126,
-			40, 138, 253, 0, 223, 176, 106, 170, 178, 84, 251, 14, 161, 32,
-			102, 31, 215, 3, 11, 217, 15, 181, 138, 244, 192, 66, 246, 67,
-			125, 2, 232, 129, 133, 236, 135, 34, 255, 87, 32, 127, 204, 200,
-			143, 68, 254, 119, 248, 166, 177, 73, 192, 97, 210, 107, 70, 125,
-			19, 89, 189, 167, 67, 190, 91, 169, 237, 62, 156, 227, 135, 239,
-			191, 171, 235, 129, 85, 236, 71, 81, 53, 68, 75, 126, 20, 85,
-			67, 172, 91, 63, 18, 213, 248, 177, 10, 208, 242, 99, 148, 248,
-			95, 8, 185, 255, 25, 113, 219, 205, 41, 50, 178, 88, 19, 18,
-			204, 34, 18, 159, 174, 135, 136, 208, 30, 75, 61, 53, 193, 93,
-			83, 232, 146, 74, 19, 86, 189, 122, 120, 80, 147, 124, 201, 47,
-			64, 132, 43, 94, 106, 214, 26, 224, 122, 95, 146, 94, 79, 230,
-			101, 47, 164, 192, 166, 189, 39, 35, 164, 43, 174, 222, 86, 85,
-			235, 180, 95, 134, 224, 159, 17, 176, 52, 148, 57, 113, 69, 253,
-			24, 5, 105, 249, 49, 74, 15, 211, 127, 160, 131, 180, 252, 68,
-			156, 182, 234, 246, 116, 223, 221, 219, 201, 216, 211, 37, 237, 149,
-			4, 153, 74, 222, 2, 202, 151, 117, 159, 152, 15, 20, 11, 140,
-			92, 53, 221, 162, 152, 255, 76, 224, 151, 36, 84, 160, 199, 10,
-			252, 242, 19, 81, 187, 40, 240, 203, 79, 196, 185, 109, 93, 7,
-			126, 121, 79, 108, 240, 151, 244, 52, 168, 151, 93, 121, 56, 58,
-			62, 168, 133, 62, 127, 232, 159, 192, 46, 210, 116, 120, 176, 47,
-			207, 2, 170, 2, 59, 149, 218, 78, 222, 142, 253, 242, 94, 60,
-			246, 203, 123, 168, 151, 89, 177, 95, 222, 19, 219, 252, 167, 161,
-			120, 204, 200, 207, 196, 204, 53, 174, 134, 25, 244, 177, 250, 72,
-			15, 253, 19, 147, 169, 208, 178, 159, 69, 153, 138, 106, 255, 12,
-			245, 142, 105, 209, 17, 162, 59, 73, 255, 48, 5, 185, 2, 44,
-			6, 143, 186, 255, 56, 37, 173, 15, 218, 149, 77, 143, 126, 237,
-			77, 39, 167, 234, 72, 161, 54, 74, 139, 87, 174, 206, 148, 110,
-			23, 174, 44, 44, 206, 216, 9, 103, 103, 21, 51, 152, 33, 30,
-			129, 164, 242, 157, 66, 169, 84, 200, 173, 223, 93, 46, 45, 228,
-			142, 22, 182, 175, 168, 197, 169, 116, 187, 144, 91, 188, 114, 85,
-			178, 63, 202, 212, 94, 101, 31, 56, 248, 55, 110, 45, 63, 123,
-			101, 225, 154, 226, 18, 145, 197, 73, 196, 20, 132, 63, 211, 224,
-			154, 171, 151, 65, 14, 103, 149, 57, 255, 118, 33, 39, 18, 150,
-			3, 88, 136, 107, 123, 64, 192, 17, 84, 235, 45, 152, 177, 129,
-			105, 163, 238, 239, 182, 42, 129, 215, 136, 91, 58, 203, 173, 232,
-			148, 171, 176, 217, 181, 61, 152, 223, 116, 87, 7, 213, 189, 134,
-			103, 222, 161, 124, 70, 86, 120, 175, 85, 85, 4, 88, 158, 94,
-			179, 184, 39, 14, 220, 97, 147, 95, 187, 187, 4, 117, 16, 229,
-			207, 42, 117, 13, 91, 135, 138, 64, 44, 54, 184, 213, 53, 55,
-			48, 172, 193, 110, 165, 166, 240, 175, 98, 219, 28, 84, 247, 161,
-			75, 23, 20, 243, 21, 159, 54, 222, 140, 211, 162, 242, 26, 110,
-			123, 162, 178, 128, 94, 91, 132, 123, 126, 99, 22, 63, 62, 62,
-			206, 203, 96, 118, 94, 61, 8, 243, 187, 181, 67, 237, 130, 178,
-			173, 180, 57, 156, 63, 90, 152, 215, 23, 13, 243, 175, 95, 185,
-			244, 91, 243, 249, 124, 94, 242, 165, 68, 190, 35, 176, 251, 107,
-			26, 37, 244, 27, 17, 21, 37, 12, 1, 241, 25, 138, 43, 178,
-			134, 145, 75, 229, 52, 228, 243, 12, 192, 68, 101, 31, 242, 102,
-			45, 183, 227, 231, 212, 228, 165, 190, 167, 23, 242, 110, 154, 5,
-			47, 95, 206, 243, 151, 252, 70, 176, 119, 34, 243, 6, 101, 157,
-			54, 176, 203, 110, 185, 73, 37, 179, 16, 66, 15, 253, 19, 213,
-			28, 216, 217, 132, 77, 191, 206, 23, 205, 248, 113, 0, 71, 102,
-			230, 4, 7, 112, 100, 233, 65, 45, 194, 128, 25, 30, 161, 95,
-			196, 50, 32, 211, 223, 162, 196, 255, 65, 200, 253, 44, 230, 235,
-			34, 119, 177, 238, 88, 171, 3, 15, 170, 205, 26, 159, 134, 224,
-			165, 229, 157, 156, 228, 112, 47, 79, 139, 180, 165, 214, 14, 111,
-			214, 234, 193, 174, 164, 59, 216, 169, 149, 79, 76, 176, 3, 61,
-			215, 137, 131, 180, 23, 250, 87, 47, 115, 191, 42, 246, 122, 101,
-			123, 210, 239, 244, 52, 205, 91, 103, 47, 26, 227, 147, 147, 131,
-			241, 149, 156, 84, 179, 92, 41, 216, 191, 235, 159, 228, 142, 22,
-			174, 243, 27, 96, 167, 231, 158, 213, 57, 55, 219, 146, 190, 228,
-			85, 100, 210, 182, 186, 108, 148, 10, 185, 210, 237, 130, 152, 8,
-			224, 251, 68, 179, 199, 205, 40, 250, 211, 223, 34, 21, 111, 23,
-			162, 63, 253, 66, 28, 44, 166, 248, 154, 127, 28, 219, 103, 68,
-			19, 100, 44, 226, 211, 47, 16, 30, 176, 34, 62, 253, 2, 13,
-			78, 88, 17, 159, 126, 33, 14, 14, 223, 34, 20, 19, 202, 82,
-			127, 128, 19, 159, 195, 200, 125, 151, 88, 102, 255, 78, 51, 86,
-			20, 249, 88, 63, 153, 14, 163, 130, 45, 103, 156, 125, 21, 102,
-			80, 93, 122, 88, 189, 30, 91, 134, 196, 180, 82, 7, 26, 7,
-			57, 243, 3, 36, 170, 14, 252, 124, 20, 98, 61, 74, 211, 63,
-			172, 137, 96, 54, 142, 145, 3, 238, 122, 141, 70, 160, 92, 101,
-			68, 7, 254, 102, 63, 145, 26, 81, 114, 142, 142, 125, 42, 190,
-			20, 84, 149, 35, 13, 180, 210, 64, 218, 31, 177, 18, 7, 97,
-			196, 33, 198, 15, 131, 176, 226, 123, 98, 155, 7, 124, 37, 146,
-			118, 44, 107, 173, 118, 89, 205, 246, 175, 144, 113, 65, 149, 175,
-			120, 77, 79, 238, 68, 102, 188, 144, 7, 225, 172, 178, 243, 183,
-			109, 66, 40, 252, 108, 245, 189, 61, 85, 206, 202, 152, 113, 102,
-			38, 85, 212, 45, 96, 252, 21, 167, 18, 253, 45, 35, 191, 75,
-			106, 57, 89, 130, 122, 82, 196, 200, 31, 224, 244, 89, 8, 26,
-			75, 129, 190, 14, 227, 179, 46, 143, 54, 150, 241, 219, 172, 216,
-			174, 146, 74, 130, 59, 172, 52, 148, 74, 130, 59, 172, 52, 148,
-			74, 130, 59, 124, 102, 10, 12, 76, 20, 226, 252, 97, 60, 226,
-			22, 158, 252, 42, 13, 104, 111, 53, 128, 94, 143, 22, 83, 58,
-			74, 65, 142, 105, 45, 66, 1, 189, 186, 50, 98, 239, 240, 135,
-			120, 104, 152, 190, 12, 165, 99, 70, 222, 198, 248, 156, 91, 52,
-			78, 173, 181, 61, 57, 43, 77, 71, 238, 105, 188, 86, 141, 92,
-			57, 21, 17, 115, 204, 113, 77, 187, 65, 213, 79, 76, 45, 196,
-			102, 227, 237, 168, 22, 162, 153, 111, 227, 94, 87, 139, 142, 16,
-			167, 206, 210, 231, 40, 38, 25, 150, 122, 7, 39, 190, 135, 145,
-			59, 111, 13, 62, 219, 3, 163, 187, 186, 169, 143, 149, 65, 140,
-			188, 131, 211, 231, 232, 34, 37, 36, 67, 18, 44, 245, 39, 24,
-			127, 5, 59, 110, 214, 248, 109, 74, 183, 112, 19, 209, 179, 238,
-			155, 56, 17, 162, 58, 25, 2, 113, 253, 112, 207, 105, 186, 73,
-			83, 66, 20, 95, 252, 79, 49, 25, 112, 87, 34, 247, 73, 219,
-			7, 5, 14, 191, 122, 196, 2, 71, 157, 60, 102, 195, 176, 215,
-			103, 25, 248, 112, 101, 177, 167, 235, 167, 61, 50, 87, 4, 217,
-			102, 162, 7, 88, 60, 232, 235, 7, 135, 143, 12, 120, 94, 144,
-			47, 138, 114, 87, 77, 185, 7, 158, 138, 206, 225, 55, 236, 96,
-			186, 82, 213, 231, 58, 107, 35, 134, 211, 195, 160, 94, 7, 117,
-			212, 229, 32, 153, 111, 84, 48, 194, 226, 65, 95, 63, 189, 167,
-			10, 198, 140, 124, 9, 147, 113, 247, 6, 95, 19, 10, 230, 53,
-			189, 138, 10, 249, 125, 0, 211, 150, 186, 66, 136, 151, 165, 248,
-			138, 26, 126, 179, 17, 196, 202, 19, 237, 248, 18, 38, 67, 209,
-			3, 200, 127, 116, 140, 222, 87, 229, 57, 140, 124, 25, 147, 97,
-			247, 57, 126, 235, 9, 203, 178, 144, 180, 157, 5, 138, 181, 248,
-			203, 152, 244, 71, 15, 176, 120, 192, 134, 224, 14, 50, 67, 16,
-			75, 253, 57, 198, 223, 196, 142, 123, 131, 131, 19, 188, 116, 188,
-			153, 131, 161, 212, 230, 59, 15, 31, 212, 114, 157, 87, 218, 99,
-			238, 32, 51, 68, 116, 231, 159, 227, 158, 1, 122, 17, 90, 131,
-			132, 186, 252, 11, 76, 70, 93, 87, 121, 121, 84, 77, 64, 147,
-			178, 108, 153, 169, 42, 56, 95, 137, 196, 131, 209, 3, 44, 30,
-			12, 143, 168, 111, 129, 68, 231, 125, 85, 228, 118, 131, 111, 54,
-			78, 20, 79, 28, 244, 133, 87, 213, 107, 20, 48, 146, 91, 4,
-			34, 129, 12, 249, 110, 92, 234, 172, 242, 144, 204, 46, 42, 79,
-			124, 251, 175, 138, 242, 94, 84, 229, 97, 70, 190, 134, 9, 115,
-			11, 70, 233, 52, 209, 213, 195, 106, 237, 88, 93, 6, 116, 112,
-			150, 105, 39, 35, 241, 131, 172, 149, 85, 168, 104, 195, 215, 48,
-			57, 29, 61, 128, 66, 6, 6, 129, 165, 84, 60, 112, 24, 249,
-			58, 38, 174, 203, 97, 238, 150, 39, 11, 189, 38, 138, 189, 46,
-			92, 180, 84, 247, 173, 60, 197, 55, 254, 58, 38, 35, 209, 3,
-			44, 30, 140, 79, 208, 89, 149, 39, 97, 228, 47, 68, 199, 77,
-			88, 121, 170, 158, 1, 95, 35, 43, 51, 130, 32, 109, 212, 43,
-			4, 139, 7, 195, 35, 244, 138, 202, 44, 201, 200, 191, 18, 26,
-			122, 129, 223, 130, 243, 98, 196, 29, 98, 6, 90, 183, 140, 147,
-			8, 222, 235, 143, 30, 96, 241, 128, 13, 129, 81, 63, 35, 84,
-			229, 91, 24, 15, 63, 249, 228, 4, 171, 201, 183, 244, 76, 154,
-			1, 253, 249, 22, 238, 237, 215, 162, 35, 68, 54, 68, 95, 132,
-			236, 17, 35, 223, 198, 248, 105, 119, 57, 90, 170, 228, 221, 213,
-			206, 137, 249, 186, 51, 161, 223, 4, 197, 87, 112, 18, 80, 120,
-			133, 38, 49, 202, 62, 107, 202, 23, 235, 201, 183, 245, 106, 150,
-			1, 125, 250, 54, 30, 60, 175, 69, 71, 136, 79, 93, 0, 203,
-			108, 70, 124, 230, 239, 96, 60, 233, 62, 203, 215, 85, 184, 215,
-			95, 102, 132, 1, 171, 199, 119, 176, 98, 245, 200, 128, 58, 125,
-			7, 159, 26, 213, 162, 35, 196, 9, 23, 214, 176, 140, 144, 190,
-			251, 68, 107, 216, 134, 118, 243, 126, 162, 53, 44, 3, 27, 254,
-			239, 70, 61, 47, 20, 240, 187, 122, 13, 203, 192, 134, 255, 187,
-			120, 234, 172, 65, 140, 191, 123, 142, 118, 7, 185, 116, 248, 168,
-			55, 234, 187, 245, 29, 141, 10, 215, 161, 56, 1, 163, 150, 87,
-			201, 220, 73, 121, 22, 147, 96, 152, 157, 214, 222, 188, 164, 116,
-			2, 209, 61, 215, 254, 163, 113, 146, 85, 9, 126, 115, 200, 245,
-			236, 167, 49, 29, 190, 23, 132, 77, 237, 255, 101, 208, 91, 119,
-			232, 128, 134, 245, 253, 93, 193, 103, 253, 234, 69, 3, 186, 234,
-			138, 144, 194, 93, 17, 82, 236, 42, 77, 235, 158, 84, 113, 63,
-			221, 188, 221, 149, 26, 155, 85, 106, 122, 77, 127, 195, 164, 101,
-			207, 209, 83, 134, 136, 97, 219, 147, 64, 117, 241, 174, 138, 232,
-			174, 251, 54, 111, 46, 251, 54, 50, 38, 125, 161, 153, 253, 151,
-			14, 61, 101, 231, 204, 70, 104, 74, 250, 1, 43, 72, 99, 210,
-			171, 215, 139, 101, 54, 65, 211, 98, 43, 103, 1, 216, 123, 132,
-			188, 213, 168, 180, 35, 29, 157, 118, 164, 227, 115, 244, 84, 195,
-			63, 218, 214, 190, 6, 79, 82, 195, 134, 127, 116, 95, 37, 239,
-			222, 137, 201, 238, 157, 40, 58, 163, 21, 30, 108, 131, 105, 192,
-			47, 3, 110, 249, 113, 157, 209, 10, 15, 74, 50, 57, 123, 158,
-			158, 134, 215, 247, 130, 42, 28, 116, 1, 214, 252, 254, 239, 67,
-			121, 183, 84, 122, 246, 97, 154, 209, 229, 55, 91, 18, 50, 222,
-			183, 120, 174, 235, 119, 92, 151, 229, 54, 91, 225, 6, 173, 155,
-			255, 1, 85, 42, 114, 128, 245, 86, 65, 202, 123, 197, 19, 88,
-			239, 47, 238, 210, 193, 142, 247, 217, 36, 29, 91, 223, 42, 221,
-			222, 46, 109, 22, 54, 183, 74, 219, 91, 107, 165, 245, 213, 229,
-			226, 173, 8, 98, 167, 16, 253, 79, 8, 177, 91, 124, 137, 166,
-			245, 176, 96, 119, 232, 41, 123, 152, 176, 209, 142, 190, 88, 21,
-			35, 218, 205, 198, 27, 217, 109, 104, 45, 229, 126, 251, 131, 127,
-			135, 137, 229, 206, 231, 71, 105, 138, 145, 190, 196, 75, 136, 254,
-			39, 25, 97, 180, 47, 193, 22, 191, 67, 226, 17, 70, 187, 160,
-			229, 40, 213, 32, 183, 39, 132, 191, 241, 25, 145, 32, 171, 126,
-			202, 206, 126, 136, 242, 147, 90, 11, 54, 134, 250, 184, 21, 133,
-			219, 147, 97, 65, 84, 56, 190, 122, 37, 240, 170, 187, 190, 230,
-			29, 51, 217, 231, 41, 255, 136, 202, 161, 182, 3, 116, 252, 158,
-			217, 81, 91, 201, 184, 215, 164, 234, 170, 230, 160, 217, 172, 171,
-			251, 43, 15, 106, 42, 251, 71, 166, 11, 231, 21, 12, 47, 183,
-			152, 191, 68, 41, 223, 170, 86, 252, 80, 186, 138, 7, 13, 121,
-			33, 163, 208, 48, 59, 21, 159, 87, 188, 99, 96, 222, 217, 111,
-			248, 242, 150, 45, 168, 194, 221, 53, 240, 129, 133, 181, 189, 230,
-			49, 176, 191, 219, 22, 192, 168, 155, 116, 205, 218, 76, 132, 112,
-			205, 204, 179, 133, 18, 47, 150, 178, 124, 169, 80, 42, 150, 230,
-			40, 127, 185, 184, 121, 251, 193, 214, 38, 127, 185, 176, 177, 81,
-			88, 219, 44, 174, 150, 248, 131, 13, 190, 252, 96, 109, 165, 184,
-			89, 124, 176, 86, 226, 15, 110, 1, 19, 253, 221, 226, 218, 202,
-			156, 38, 16, 82, 238, 166, 162, 138, 193, 33, 196, 146, 84, 134,
-			33, 187, 120, 29, 176, 201, 28, 16, 13, 121, 157, 68, 54, 182,
-			51, 215, 65, 64, 25, 251, 90, 179, 163, 69, 6, 14, 56, 144,
-			24, 81, 24, 64, 150, 88, 210, 24, 64, 245, 175, 147, 96, 206,
-			112, 226, 2, 252, 139, 152, 51, 146, 152, 133, 127, 49, 115, 198,
-			18, 187, 148, 82, 156, 74, 48, 226, 38, 206, 34, 74, 169, 147,
-			74, 32, 230, 184, 233, 1, 250, 58, 37, 41, 112, 115, 159, 34,
-			119, 221, 135, 220, 86, 126, 240, 170, 138, 193, 179, 236, 72, 130,
-			59, 190, 95, 213, 46, 240, 160, 66, 182, 21, 146, 70, 118, 80,
-			109, 96, 210, 134, 13, 99, 166, 60, 69, 147, 41, 233, 9, 63,
-			149, 30, 214, 18, 102, 206, 212, 200, 69, 45, 57, 204, 153, 186,
-			82, 20, 117, 39, 9, 70, 120, 34, 143, 12, 180, 138, 167, 207,
-			208, 155, 26, 89, 149, 37, 215, 220, 5, 112, 232, 247, 141, 193,
-			169, 20, 69, 49, 241, 154, 98, 187, 168, 42, 96, 157, 214, 21,
-			188, 41, 37, 50, 200, 90, 208, 167, 236, 7, 158, 177, 160, 79,
-			217, 171, 207, 194, 25, 6, 188, 242, 47, 144, 243, 238, 243, 252,
-			37, 8, 39, 80, 219, 227, 237, 171, 170, 222, 194, 108, 235, 217,
-			126, 123, 219, 216, 28, 235, 49, 120, 134, 68, 42, 93, 32, 212,
-			66, 42, 93, 200, 156, 177, 144, 74, 23, 206, 113, 250, 199, 72,
-			67, 149, 102, 201, 180, 251, 79, 145, 4, 211, 26, 88, 159, 180,
-			95, 78, 195, 238, 45, 30, 230, 209, 234, 1, 115, 117, 97, 136,
-			83, 131, 6, 109, 71, 104, 201, 91, 57, 25, 97, 21, 236, 158,
-			187, 126, 112, 228, 71, 125, 22, 249, 168, 239, 122, 21, 43, 142,
-			29, 38, 162, 106, 167, 172, 80, 123, 179, 167, 39, 44, 76, 212,
-			172, 155, 181, 48, 81, 179, 23, 158, 166, 219, 26, 18, 149, 35,
-			243, 238, 70, 228, 144, 21, 211, 60, 189, 197, 7, 164, 141, 94,
-			242, 231, 192, 168, 211, 40, 171, 3, 89, 252, 43, 83, 105, 234,
-			203, 219, 33, 245, 114, 100, 202, 66, 37, 229, 206, 94, 180, 80,
-			73, 185, 92, 158, 94, 163, 56, 153, 96, 100, 33, 113, 21, 185,
-			115, 210, 198, 98, 78, 2, 158, 58, 240, 53, 155, 98, 219, 167,
-			57, 148, 244, 209, 142, 82, 39, 41, 52, 101, 33, 57, 74, 51,
-			148, 36, 65, 11, 23, 9, 23, 5, 36, 165, 18, 45, 146, 73,
-			45, 97, 230, 44, 158, 61, 167, 18, 34, 230, 60, 67, 6, 212,
-			79, 8, 164, 140, 150, 48, 115, 158, 233, 235, 87, 9, 49, 115,
-			46, 147, 113, 245, 147, 120, 237, 50, 25, 210, 146, 248, 109, 116,
-			76, 37, 116, 152, 115, 133, 12, 171, 159, 68, 75, 175, 144, 126,
-			45, 97, 230, 92, 97, 67, 48, 130, 16, 35, 215, 196, 130, 164,
-			145, 78, 215, 210, 195, 116, 86, 35, 157, 174, 147, 81, 247, 12,
-			116, 65, 100, 44, 182, 6, 126, 12, 198, 116, 157, 80, 11, 198,
-			116, 93, 57, 211, 74, 24, 211, 245, 225, 17, 176, 31, 2, 140,
-			233, 38, 25, 119, 63, 193, 151, 188, 208, 215, 238, 42, 86, 150,
-			252, 160, 38, 62, 48, 104, 220, 46, 248, 215, 198, 84, 14, 122,
-			95, 193, 103, 230, 184, 159, 223, 207, 83, 158, 213, 150, 18, 177,
-			244, 230, 164, 90, 231, 202, 254, 145, 13, 37, 204, 218, 232, 167,
-			155, 166, 166, 162, 193, 55, 51, 67, 22, 250, 233, 230, 232, 24,
-			189, 162, 209, 79, 5, 226, 186, 51, 146, 233, 217, 92, 127, 233,
-			216, 165, 221, 231, 13, 9, 110, 42, 144, 94, 11, 220, 84, 160,
-			54, 184, 169, 48, 62, 65, 91, 80, 128, 195, 156, 85, 50, 239,
-			30, 196, 173, 214, 199, 90, 243, 31, 91, 164, 237, 161, 200, 103,
-			118, 78, 168, 141, 116, 181, 136, 57, 97, 4, 204, 154, 10, 138,
-			33, 176, 170, 134, 0, 28, 222, 157, 85, 53, 4, 16, 12, 129,
-			213, 92, 30, 204, 167, 226, 216, 238, 220, 38, 231, 221, 229, 95,
-			110, 98, 235, 166, 39, 36, 41, 178, 52, 18, 98, 206, 109, 53,
-			185, 33, 192, 39, 220, 62, 199, 233, 243, 80, 118, 146, 57, 119,
-			201, 188, 187, 248, 136, 206, 1, 135, 222, 216, 80, 84, 27, 100,
-			83, 84, 50, 37, 114, 208, 205, 76, 34, 230, 220, 53, 205, 76,
-			58, 204, 185, 155, 203, 195, 49, 21, 137, 217, 105, 141, 92, 106,
-			247, 30, 120, 159, 162, 244, 102, 154, 207, 52, 252, 125, 175, 81,
-			134, 157, 75, 109, 79, 77, 19, 81, 79, 167, 32, 103, 93, 133,
-			20, 98, 206, 218, 217, 15, 106, 201, 97, 206, 90, 126, 30, 252,
-			92, 17, 238, 97, 206, 139, 228, 105, 55, 171, 209, 103, 106, 76,
-			116, 20, 109, 178, 238, 73, 137, 55, 180, 94, 245, 32, 230, 188,
-			168, 194, 174, 34, 0, 43, 188, 248, 212, 5, 53, 224, 210, 204,
-			217, 34, 174, 251, 9, 117, 189, 166, 141, 33, 143, 44, 129, 23,
-			31, 241, 139, 80, 55, 42, 35, 242, 132, 225, 94, 171, 50, 39,
-			119, 144, 26, 219, 230, 85, 37, 185, 177, 178, 225, 153, 154, 166,
-			147, 162, 2, 70, 66, 204, 217, 202, 232, 122, 167, 29, 230, 108,
-			141, 79, 232, 131, 250, 255, 11, 0, 0, 255, 255, 100, 22, 1,
-			59, 47, 49, 2, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 125, 116, 92, 201,
+			117, 24, 136, 227, 125, 116, 163, 81, 13, 130, 141, 2, 63, 192,
+			230, 87, 177, 249, 5, 144, 64, 3, 4, 57, 156, 33, 57, 51,
+			18, 8, 52, 201, 230, 128, 0, 220, 0, 230, 75, 26, 97, 30,
+			186, 171, 129, 55, 236, 126, 175, 245, 222, 107, 130, 24, 101, 28,
+			217, 146, 127, 146, 99, 89, 177, 125, 116, 126, 86, 108, 71, 137,
+			149, 227, 181, 172, 141, 237, 93, 199, 218, 172, 215, 199, 177, 21,
+			175, 101, 37, 214, 42, 82, 188, 235, 200, 242, 110, 236, 117, 244,
+			57, 182, 246, 72, 182, 178, 107, 123, 143, 165, 61, 247, 214, 199,
+			123, 221, 0, 56, 156, 113, 156, 227, 63, 134, 103, 230, 160, 111,
+			189, 250, 188, 117, 235, 214, 189, 183, 110, 221, 34, 223, 29, 36,
+			135, 215, 125, 127, 189, 193, 39, 90, 129, 31, 249, 107, 237, 250,
+			4, 111, 182, 162, 173, 34, 130, 116, 175, 248, 88, 84, 31, 11,
+			189, 36, 85, 130, 239, 215, 95, 33, 67, 85, 191, 89, 236, 250,
+			126, 157, 224, 215, 69, 0, 23, 141, 231, 213, 231, 117, 191, 225,
+			120, 235, 69, 63, 88, 143, 155, 137, 182, 90, 60, 156, 184, 235,
+			249, 155, 158, 104, 178, 181, 246, 23, 134, 241, 81, 211, 186, 185,
+			120, 253, 227, 230, 177, 155, 162, 228, 162, 204, 94, 124, 134, 55,
+			26, 79, 65, 230, 101, 40, 119, 251, 95, 229, 72, 154, 218, 199,
+			122, 166, 114, 228, 51, 253, 196, 232, 167, 214, 177, 30, 58, 245,
+			201, 126, 134, 5, 170, 126, 131, 93, 111, 215, 235, 60, 8, 217,
+			56, 19, 85, 157, 13, 89, 205, 137, 28, 230, 122, 17, 15, 170,
+			27, 142, 183, 206, 89, 221, 15, 154, 78, 68, 216, 140, 223, 218,
+			10, 220, 245, 141, 136, 77, 77, 78, 62, 38, 11, 176, 178, 87,
+			45, 50, 54, 221, 104, 48, 252, 22, 178, 128, 135, 60, 184, 199,
+			107, 69, 194, 54, 162, 168, 21, 94, 157, 152, 168, 241, 123, 188,
+			225, 183, 120, 16, 170, 177, 86, 253, 166, 24, 100, 213, 111, 140,
+			175, 137, 78, 76, 16, 194, 42, 188, 230, 134, 81, 224, 174, 181,
+			35, 215, 247, 152, 227, 213, 88, 59, 228, 204, 245, 88, 232, 183,
+			131, 42, 199, 148, 53, 215, 115, 130, 45, 236, 87, 56, 198, 54,
+			221, 104, 131, 249, 1, 254, 245, 219, 17, 97, 77, 191, 230, 214,
+			221, 170, 3, 53, 140, 49, 39, 224, 172, 197, 131, 166, 27, 69,
+			188, 198, 90, 129, 127, 207, 173, 241, 26, 139, 54, 156, 136, 69,
+			27, 48, 186, 70, 195, 223, 116, 189, 117, 86, 245, 189, 154, 11,
+			133, 66, 40, 68, 88, 147, 71, 87, 9, 97, 240, 239, 92, 87,
+			199, 66, 230, 215, 85, 143, 170, 126, 141, 179, 102, 59, 140, 88,
+			192, 35, 199, 245, 176, 86, 103, 205, 191, 7, 159, 36, 198, 8,
+			243, 252, 200, 173, 242, 49, 22, 109, 184, 33, 107, 184, 97, 4,
+			53, 36, 91, 244, 106, 93, 221, 169, 185, 97, 181, 225, 184, 77,
+			30, 20, 119, 235, 132, 235, 37, 113, 161, 58, 209, 10, 252, 90,
+			187, 202, 227, 126, 144, 184, 35, 127, 163, 126, 16, 38, 71, 87,
+			243, 171, 237, 38, 247, 34, 71, 77, 210, 132, 31, 48, 63, 218,
+			224, 1, 107, 58, 17, 15, 92, 167, 17, 198, 168, 134, 137, 129,
+			58, 9, 75, 246, 94, 15, 106, 158, 187, 88, 18, 42, 246, 156,
+			38, 135, 14, 37, 105, 203, 243, 227, 111, 136, 119, 55, 10, 97,
+			68, 158, 168, 202, 15, 66, 214, 116, 182, 216, 26, 7, 74, 169,
+			177, 200, 103, 220, 171, 249, 65, 200, 129, 40, 90, 129, 223, 244,
+			35, 14, 157, 169, 181, 171, 81, 200, 106, 60, 112, 239, 241, 26,
+			171, 7, 126, 147, 8, 44, 132, 126, 61, 218, 4, 50, 145, 20,
+			196, 194, 22, 175, 2, 5, 177, 86, 224, 2, 97, 5, 64, 59,
+			158, 160, 162, 48, 196, 190, 19, 182, 124, 171, 188, 196, 150, 22,
+			110, 44, 63, 51, 93, 41, 177, 242, 18, 91, 172, 44, 60, 93,
+			158, 45, 205, 178, 235, 207, 177, 229, 91, 37, 54, 179, 176, 248,
+			92, 165, 124, 243, 214, 50, 187, 181, 48, 55, 91, 170, 44, 177,
+			233, 249, 89, 54, 179, 48, 191, 92, 41, 95, 95, 89, 94, 168,
+			44, 17, 86, 152, 94, 98, 229, 165, 2, 126, 153, 158, 127, 142,
+			149, 158, 93, 172, 148, 150, 150, 216, 66, 133, 149, 239, 44, 206,
+			149, 75, 179, 236, 153, 233, 74, 101, 122, 126, 185, 92, 90, 26,
+			99, 229, 249, 153, 185, 149, 217, 242, 252, 205, 49, 118, 125, 101,
+			153, 205, 47, 44, 19, 54, 87, 190, 83, 94, 46, 205, 178, 229,
+			133, 49, 108, 118, 123, 57, 182, 112, 131, 221, 41, 85, 102, 110,
+			77, 207, 47, 79, 95, 47, 207, 149, 151, 159, 195, 6, 111, 148,
+			151, 231, 161, 177, 27, 11, 21, 194, 166, 217, 226, 116, 101, 185,
+			60, 179, 50, 55, 93, 97, 139, 43, 149, 197, 133, 165, 18, 131,
+			145, 205, 150, 151, 102, 230, 166, 203, 119, 74, 179, 69, 86, 158,
+			103, 243, 11, 172, 244, 116, 105, 126, 153, 45, 221, 154, 158, 155,
+			235, 28, 40, 97, 11, 207, 204, 151, 42, 208, 251, 228, 48, 217,
+			245, 18, 155, 43, 79, 95, 159, 43, 177, 27, 11, 21, 28, 231,
+			108, 185, 82, 154, 89, 134, 1, 197, 191, 102, 202, 179, 165, 249,
+			229, 233, 185, 49, 194, 150, 22, 75, 51, 229, 233, 185, 49, 86,
+			122, 182, 116, 103, 113, 110, 186, 242, 220, 152, 172, 116, 169, 244,
+			61, 43, 165, 249, 229, 242, 244, 28, 155, 157, 190, 51, 125, 179,
+			180, 196, 70, 94, 11, 43, 139, 149, 133, 153, 149, 74, 233, 14,
+			244, 122, 225, 6, 91, 90, 185, 190, 180, 92, 94, 94, 89, 46,
+			177, 155, 11, 11, 179, 136, 236, 165, 82, 229, 233, 242, 76, 105,
+			233, 26, 155, 91, 0, 244, 223, 96, 43, 75, 165, 49, 194, 102,
+			167, 151, 167, 177, 233, 197, 202, 194, 141, 242, 242, 210, 53, 248,
+			125, 125, 101, 169, 140, 136, 43, 207, 47, 151, 42, 149, 149, 197,
+			229, 242, 194, 252, 40, 187, 181, 240, 76, 233, 233, 82, 133, 205,
+			76, 175, 44, 149, 102, 17, 195, 11, 243, 48, 90, 160, 149, 210,
+			66, 229, 57, 168, 22, 240, 128, 51, 48, 198, 158, 185, 85, 90,
+			190, 85, 170, 0, 82, 17, 91, 211, 128, 134, 165, 229, 74, 121,
+			102, 57, 153, 109, 161, 194, 150, 23, 42, 203, 36, 49, 78, 54,
+			95, 186, 57, 87, 190, 89, 154, 159, 41, 65, 127, 22, 160, 154,
+			103, 202, 75, 165, 81, 54, 93, 41, 47, 65, 134, 50, 54, 204,
+			158, 153, 126, 142, 45, 172, 224, 168, 97, 162, 86, 150, 74, 68,
+			252, 78, 144, 238, 24, 206, 39, 43, 223, 96, 211, 179, 79, 151,
+			161, 231, 50, 247, 226, 194, 210, 82, 89, 146, 11, 162, 109, 230,
+			150, 196, 121, 145, 144, 12, 49, 76, 106, 177, 158, 97, 248, 149,
+			161, 86, 161, 167, 68, 250, 136, 153, 201, 138, 159, 34, 241, 100,
+			207, 24, 38, 26, 226, 167, 72, 60, 213, 115, 30, 19, 229, 79,
+			145, 120, 186, 167, 128, 137, 68, 252, 20, 137, 103, 122, 78, 96,
+			226, 41, 241, 83, 36, 158, 237, 185, 134, 137, 167, 197, 79, 145,
+			56, 210, 115, 28, 19, 143, 139, 159, 191, 106, 18, 211, 238, 161,
+			214, 84, 79, 46, 255, 139, 38, 155, 102, 235, 220, 227, 129, 91,
+			101, 184, 131, 178, 38, 15, 67, 103, 29, 248, 163, 19, 177, 45,
+			191, 205, 170, 142, 199, 2, 62, 14, 27, 77, 228, 51, 231, 158,
+			239, 214, 88, 141, 215, 93, 15, 217, 112, 187, 213, 128, 205, 132,
+			215, 72, 103, 121, 100, 191, 91, 126, 59, 96, 211, 139, 229, 176,
+			200, 166, 89, 180, 213, 114, 171, 78, 131, 241, 251, 78, 179, 213,
+			224, 204, 13, 129, 27, 65, 181, 110, 196, 156, 16, 185, 88, 192,
+			223, 217, 230, 97, 68, 152, 228, 106, 1, 15, 91, 190, 7, 45,
+			111, 181, 144, 245, 57, 30, 212, 7, 155, 207, 134, 95, 43, 178,
+			27, 126, 192, 92, 47, 140, 28, 175, 202, 213, 110, 4, 251, 171,
+			91, 229, 236, 134, 239, 179, 119, 137, 36, 198, 130, 86, 149, 93,
+			119, 130, 145, 46, 89, 163, 136, 162, 198, 40, 11, 120, 212, 14,
+			188, 144, 237, 242, 253, 154, 168, 230, 21, 2, 255, 44, 187, 199,
+			160, 214, 84, 102, 207, 90, 26, 179, 93, 36, 255, 103, 150, 92,
+			93, 247, 139, 213, 141, 192, 111, 186, 237, 38, 202, 40, 141, 118,
+			213, 157, 112, 218, 209, 198, 170, 236, 207, 132, 211, 114, 39, 130,
+			86, 181, 181, 54, 225, 84, 171, 126, 219, 139, 66, 41, 32, 245,
+			67, 182, 162, 204, 150, 127, 144, 44, 85, 184, 76, 50, 75, 188,
+			81, 47, 123, 117, 159, 230, 73, 198, 173, 113, 47, 114, 163, 173,
+			97, 131, 25, 35, 125, 21, 13, 211, 1, 98, 186, 173, 97, 19,
+			83, 77, 183, 53, 85, 34, 153, 105, 217, 40, 189, 66, 122, 111,
+			242, 8, 170, 161, 7, 138, 59, 142, 55, 127, 160, 152, 236, 82,
+			81, 53, 121, 125, 252, 249, 243, 175, 99, 156, 183, 63, 217, 7,
+			2, 214, 64, 207, 25, 131, 252, 207, 54, 10, 88, 3, 61, 116,
+			234, 87, 236, 14, 89, 105, 234, 2, 91, 222, 224, 108, 110, 101,
+			166, 204, 166, 219, 209, 134, 31, 132, 69, 66, 216, 156, 91, 229,
+			30, 236, 88, 109, 175, 38, 183, 191, 233, 150, 83, 133, 156, 226,
+			203, 24, 123, 154, 7, 176, 221, 176, 169, 226, 36, 27, 1, 106,
+			41, 200, 79, 133, 209, 107, 4, 73, 23, 118, 62, 207, 143, 96,
+			235, 19, 155, 89, 221, 109, 112, 198, 239, 87, 121, 43, 2, 250,
+			172, 250, 205, 86, 195, 5, 226, 209, 219, 176, 170, 190, 72, 216,
+			115, 178, 6, 127, 13, 5, 23, 7, 229, 4, 32, 194, 68, 54,
+			230, 68, 146, 236, 80, 156, 187, 58, 49, 177, 185, 185, 89, 116,
+			176, 167, 130, 14, 68, 117, 225, 196, 92, 121, 166, 52, 191, 84,
+			26, 159, 42, 78, 18, 194, 86, 188, 6, 15, 65, 26, 124, 103,
+			219, 13, 120, 141, 173, 109, 49, 167, 133, 75, 105, 173, 193, 89,
+			195, 217, 132, 21, 224, 172, 7, 28, 132, 49, 31, 250, 186, 25,
+			184, 145, 235, 173, 143, 233, 13, 57, 33, 48, 116, 160, 73, 245,
+			204, 13, 59, 50, 160, 188, 168, 183, 212, 235, 211, 75, 229, 165,
+			49, 194, 158, 41, 47, 223, 2, 30, 152, 220, 15, 113, 43, 153,
+			45, 3, 223, 70, 102, 15, 188, 242, 169, 242, 252, 236, 24, 147,
+			178, 8, 191, 223, 10, 160, 247, 176, 248, 0, 129, 40, 205, 46,
+			113, 222, 209, 124, 93, 46, 97, 45, 46, 128, 248, 222, 6, 206,
+			178, 238, 223, 227, 1, 114, 143, 88, 102, 64, 209, 138, 176, 134,
+			219, 116, 133, 220, 20, 110, 31, 145, 102, 172, 185, 158, 253, 146,
+			181, 209, 158, 235, 138, 177, 202, 159, 86, 15, 181, 246, 245, 156,
+			38, 243, 196, 76, 247, 80, 123, 184, 231, 184, 145, 191, 206, 20,
+			241, 107, 230, 0, 2, 146, 227, 122, 161, 228, 36, 128, 99, 224,
+			75, 174, 7, 210, 116, 205, 189, 231, 214, 218, 78, 131, 233, 133,
+			138, 235, 62, 13, 235, 126, 56, 147, 35, 191, 107, 16, 59, 221,
+			99, 246, 80, 235, 152, 249, 88, 254, 183, 12, 38, 215, 147, 102,
+			35, 174, 7, 210, 183, 148, 255, 214, 64, 104, 2, 204, 84, 157,
+			70, 131, 7, 108, 205, 1, 178, 246, 61, 214, 114, 194, 16, 248,
+			38, 172, 53, 88, 199, 66, 38, 103, 145, 127, 151, 123, 184, 6,
+			166, 27, 161, 175, 235, 140, 107, 56, 27, 178, 242, 34, 115, 106,
+			53, 156, 132, 17, 39, 100, 33, 231, 30, 144, 16, 228, 145, 35,
+			28, 45, 178, 149, 144, 215, 219, 13, 182, 185, 193, 61, 194, 106,
+			124, 173, 189, 190, 238, 122, 235, 221, 205, 185, 97, 216, 230, 208,
+			92, 63, 73, 193, 160, 12, 106, 29, 75, 239, 81, 144, 73, 173,
+			99, 3, 39, 21, 100, 81, 235, 88, 241, 50, 121, 20, 55, 16,
+			251, 100, 207, 25, 35, 127, 158, 41, 238, 192, 220, 7, 15, 188,
+			24, 51, 207, 147, 153, 28, 41, 18, 219, 70, 28, 158, 50, 15,
+			20, 78, 48, 94, 92, 47, 178, 66, 59, 228, 193, 213, 208, 111,
+			114, 223, 227, 111, 149, 27, 69, 177, 234, 55, 11, 216, 61, 200,
+			159, 162, 214, 41, 51, 163, 32, 131, 90, 167, 250, 6, 21, 100,
+			81, 235, 212, 190, 253, 228, 4, 214, 108, 80, 235, 180, 153, 43,
+			236, 147, 53, 95, 184, 50, 85, 188, 112, 249, 177, 226, 100, 241,
+			130, 174, 204, 72, 65, 30, 85, 153, 1, 37, 250, 178, 10, 178,
+			168, 117, 122, 96, 175, 230, 241, 191, 121, 129, 28, 239, 230, 204,
+			145, 219, 228, 97, 228, 52, 91, 187, 105, 186, 215, 72, 223, 178,
+			202, 67, 135, 73, 111, 200, 65, 151, 8, 145, 93, 91, 21, 5,
+			210, 125, 36, 229, 57, 158, 31, 34, 195, 78, 85, 4, 112, 253,
+			7, 140, 157, 213, 227, 1, 93, 165, 82, 145, 167, 30, 82, 69,
+			214, 253, 125, 93, 106, 242, 39, 39, 72, 47, 77, 29, 235, 249,
+			135, 134, 241, 166, 158, 252, 166, 158, 252, 166, 158, 252, 166, 158,
+			252, 166, 158, 252, 166, 158, 252, 183, 163, 39, 107, 77, 21, 126,
+			42, 61, 185, 172, 100, 60, 248, 169, 244, 100, 173, 60, 159, 210,
+			202, 243, 233, 158, 9, 165, 60, 195, 79, 165, 39, 107, 229, 249,
+			140, 86, 158, 207, 198, 202, 243, 89, 173, 60, 143, 196, 202, 51,
+			252, 252, 226, 81, 20, 115, 210, 239, 53, 96, 235, 203, 255, 206,
+			81, 54, 205, 244, 214, 139, 12, 146, 135, 220, 139, 66, 230, 176,
+			150, 239, 122, 168, 80, 192, 14, 11, 2, 36, 111, 113, 15, 116,
+			49, 161, 178, 110, 137, 244, 151, 125, 15, 173, 110, 13, 191, 234,
+			52, 8, 171, 58, 13, 238, 213, 156, 96, 140, 113, 15, 172, 164,
+			53, 80, 130, 65, 201, 104, 139, 114, 82, 58, 64, 94, 90, 15,
+			156, 106, 188, 99, 168, 15, 176, 33, 128, 168, 128, 48, 236, 152,
+			126, 3, 247, 149, 34, 106, 84, 162, 34, 23, 148, 140, 134, 19,
+			185, 247, 132, 250, 238, 49, 222, 242, 171, 27, 204, 137, 216, 202,
+			242, 12, 107, 186, 53, 15, 57, 186, 239, 17, 118, 219, 241, 218,
+			176, 13, 92, 24, 99, 23, 174, 60, 58, 57, 166, 24, 117, 43,
+			240, 27, 188, 21, 185, 85, 118, 51, 224, 235, 126, 224, 58, 158,
+			238, 61, 219, 220, 112, 171, 27, 140, 223, 143, 56, 116, 22, 13,
+			153, 59, 228, 90, 115, 170, 119, 55, 157, 0, 114, 248, 108, 139,
+			59, 1, 243, 61, 14, 12, 16, 182, 252, 166, 235, 181, 35, 142,
+			251, 37, 187, 60, 169, 199, 215, 240, 189, 245, 34, 155, 227, 78,
+			43, 30, 114, 192, 89, 33, 108, 114, 39, 224, 181, 2, 11, 125,
+			177, 1, 123, 62, 107, 112, 167, 69, 100, 54, 22, 161, 18, 229,
+			134, 204, 227, 28, 240, 10, 170, 8, 138, 34, 45, 176, 65, 3,
+			134, 198, 88, 59, 132, 205, 218, 97, 111, 155, 186, 52, 190, 1,
+			102, 138, 134, 235, 113, 39, 32, 12, 107, 127, 97, 228, 193, 66,
+			7, 204, 231, 4, 230, 28, 133, 65, 0, 186, 3, 60, 13, 112,
+			67, 220, 19, 216, 228, 228, 228, 133, 113, 252, 111, 121, 114, 242,
+			42, 254, 247, 60, 12, 253, 202, 149, 43, 87, 198, 47, 76, 141,
+			95, 188, 176, 60, 117, 241, 234, 35, 87, 174, 62, 114, 165, 120,
+			69, 253, 123, 190, 200, 174, 111, 17, 152, 200, 40, 112, 171, 17,
+			116, 48, 146, 67, 196, 218, 199, 216, 38, 103, 220, 11, 219, 129,
+			52, 207, 108, 130, 108, 13, 106, 172, 119, 143, 7, 17, 212, 47,
+			136, 197, 111, 178, 183, 85, 110, 204, 16, 118, 241, 226, 197, 43,
+			241, 88, 64, 53, 117, 121, 84, 71, 197, 52, 168, 87, 39, 130,
+			122, 21, 114, 20, 163, 251, 209, 40, 72, 108, 156, 193, 206, 234,
+			173, 135, 48, 168, 147, 172, 36, 4, 240, 144, 16, 245, 147, 93,
+			184, 202, 102, 252, 102, 171, 29, 241, 196, 90, 192, 6, 23, 23,
+			150, 202, 207, 178, 23, 1, 51, 35, 163, 47, 22, 165, 200, 19,
+			103, 210, 194, 167, 52, 166, 104, 184, 24, 242, 104, 85, 78, 240,
+			8, 164, 142, 204, 175, 204, 205, 141, 142, 238, 152, 15, 69, 227,
+			145, 201, 209, 107, 137, 62, 77, 189, 86, 159, 214, 121, 4, 181,
+			248, 245, 154, 179, 149, 232, 91, 24, 5, 237, 106, 132, 107, 243,
+			158, 211, 96, 209, 61, 217, 98, 71, 246, 51, 209, 189, 49, 134,
+			29, 186, 246, 70, 135, 116, 175, 24, 221, 131, 1, 62, 104, 68,
+			34, 83, 59, 228, 85, 118, 142, 93, 152, 156, 236, 28, 225, 197,
+			93, 71, 248, 140, 235, 93, 156, 98, 47, 130, 2, 186, 21, 70,
+			188, 9, 159, 167, 195, 27, 110, 131, 47, 119, 78, 196, 141, 242,
+			92, 105, 185, 124, 167, 196, 234, 145, 236, 198, 110, 101, 206, 212,
+			35, 213, 211, 149, 242, 252, 242, 229, 75, 44, 114, 171, 119, 67,
+			246, 4, 27, 25, 25, 17, 41, 163, 245, 168, 88, 219, 188, 229,
+			174, 111, 204, 58, 17, 150, 26, 101, 143, 63, 206, 46, 78, 141,
+			178, 191, 199, 240, 219, 156, 191, 169, 62, 41, 188, 77, 76, 176,
+			105, 246, 140, 235, 213, 252, 205, 16, 171, 4, 133, 241, 194, 228,
+			100, 130, 135, 133, 69, 157, 65, 112, 169, 11, 151, 183, 47, 35,
+			93, 27, 20, 191, 112, 249, 210, 165, 75, 143, 94, 188, 60, 25,
+			179, 141, 53, 94, 247, 3, 206, 86, 60, 247, 190, 228, 117, 192,
+			204, 186, 107, 41, 190, 177, 201, 28, 17, 227, 103, 35, 35, 48,
+			130, 144, 77, 224, 100, 193, 127, 163, 108, 60, 217, 157, 215, 160,
+			96, 168, 231, 226, 84, 92, 207, 233, 68, 61, 72, 0, 163, 29,
+			4, 112, 105, 87, 2, 184, 237, 220, 115, 216, 139, 98, 34, 139,
+			213, 118, 16, 112, 47, 130, 44, 119, 220, 70, 195, 13, 19, 4,
+			0, 220, 148, 53, 49, 149, 61, 193, 118, 47, 240, 0, 50, 103,
+			79, 196, 169, 69, 143, 111, 94, 111, 187, 141, 26, 15, 70, 70,
+			97, 96, 75, 18, 67, 178, 9, 129, 152, 81, 101, 127, 101, 12,
+			242, 204, 35, 173, 143, 184, 94, 4, 35, 151, 57, 197, 208, 229,
+			176, 1, 5, 163, 163, 197, 53, 168, 121, 164, 3, 5, 143, 188,
+			6, 10, 202, 104, 6, 142, 138, 158, 191, 153, 24, 181, 76, 101,
+			158, 191, 201, 158, 96, 29, 121, 30, 56, 208, 184, 223, 175, 61,
+			98, 207, 223, 44, 174, 243, 168, 4, 180, 38, 210, 70, 70, 19,
+			3, 239, 28, 188, 204, 12, 192, 200, 206, 3, 189, 188, 235, 64,
+			229, 108, 41, 41, 131, 45, 110, 69, 27, 66, 141, 232, 32, 179,
+			228, 52, 141, 140, 118, 125, 44, 222, 228, 209, 76, 60, 235, 35,
+			163, 200, 233, 111, 47, 45, 204, 179, 59, 78, 171, 229, 122, 235,
+			132, 176, 178, 39, 82, 64, 31, 116, 34, 56, 150, 77, 246, 5,
+			78, 221, 97, 237, 118, 136, 45, 98, 227, 144, 18, 3, 193, 237,
+			231, 117, 237, 62, 162, 41, 144, 92, 28, 16, 90, 176, 77, 34,
+			141, 6, 208, 88, 225, 93, 32, 53, 188, 50, 254, 174, 166, 239,
+			69, 27, 175, 140, 191, 171, 230, 108, 189, 178, 252, 46, 216, 186,
+			95, 185, 250, 174, 166, 235, 189, 114, 245, 93, 33, 175, 190, 242,
+			182, 226, 187, 64, 88, 130, 5, 251, 202, 11, 207, 23, 8, 152,
+			191, 2, 206, 68, 105, 168, 200, 105, 108, 58, 91, 161, 178, 97,
+			130, 229, 20, 37, 129, 58, 200, 0, 53, 119, 221, 141, 66, 16,
+			105, 26, 156, 201, 150, 198, 24, 54, 53, 70, 152, 104, 108, 140,
+			97, 107, 99, 40, 151, 97, 147, 40, 149, 188, 204, 3, 127, 188,
+			229, 212, 0, 33, 176, 105, 111, 250, 170, 54, 238, 84, 55, 96,
+			92, 92, 75, 113, 32, 253, 73, 134, 50, 38, 229, 167, 170, 227,
+			177, 117, 159, 181, 91, 176, 137, 95, 81, 69, 71, 220, 34, 47,
+			202, 196, 11, 59, 203, 122, 163, 99, 4, 219, 247, 91, 0, 57,
+			13, 209, 82, 225, 249, 2, 11, 219, 245, 186, 123, 31, 164, 81,
+			60, 160, 65, 217, 12, 233, 0, 229, 208, 145, 194, 202, 242, 76,
+			97, 244, 90, 71, 42, 97, 110, 108, 147, 134, 51, 27, 52, 174,
+			92, 20, 196, 16, 162, 70, 238, 190, 204, 3, 22, 110, 248, 237,
+			70, 77, 161, 18, 172, 235, 43, 203, 51, 104, 137, 84, 173, 129,
+			69, 155, 176, 194, 243, 133, 81, 152, 0, 15, 206, 138, 61, 33,
+			208, 108, 39, 37, 64, 164, 211, 209, 84, 203, 9, 194, 184, 153,
+			53, 78, 24, 74, 116, 32, 223, 84, 209, 118, 191, 230, 71, 27,
+			40, 191, 66, 89, 113, 180, 174, 198, 16, 110, 235, 7, 8, 189,
+			126, 189, 30, 242, 8, 133, 53, 56, 51, 146, 166, 197, 49, 86,
+			152, 154, 188, 240, 232, 248, 228, 133, 241, 11, 143, 44, 79, 94,
+			184, 122, 113, 242, 234, 133, 71, 138, 147, 23, 158, 47, 72, 234,
+			14, 25, 194, 122, 115, 105, 57, 112, 58, 133, 57, 177, 125, 223,
+			139, 165, 230, 71, 198, 24, 212, 86, 148, 11, 200, 185, 231, 44,
+			85, 3, 183, 21, 141, 129, 172, 219, 33, 168, 57, 12, 54, 71,
+			230, 175, 189, 196, 65, 0, 241, 229, 225, 132, 32, 118, 33, 153,
+			34, 249, 3, 183, 170, 57, 65, 141, 176, 183, 69, 126, 121, 105,
+			97, 9, 23, 217, 200, 232, 14, 226, 105, 177, 233, 191, 236, 54,
+			26, 14, 202, 118, 220, 27, 95, 89, 154, 168, 249, 213, 112, 226,
+			25, 190, 54, 17, 119, 101, 162, 194, 235, 60, 224, 94, 149, 79,
+			220, 108, 248, 107, 78, 99, 117, 1, 251, 16, 78, 64, 135, 38,
+			18, 141, 140, 18, 125, 200, 86, 86, 156, 102, 140, 57, 186, 75,
+			236, 69, 144, 23, 1, 233, 69, 245, 227, 69, 53, 32, 24, 234,
+			26, 87, 163, 5, 19, 247, 78, 67, 36, 236, 109, 47, 134, 81,
+			80, 199, 162, 137, 17, 249, 213, 176, 216, 18, 156, 13, 198, 50,
+			53, 209, 112, 215, 2, 39, 216, 154, 128, 140, 197, 141, 168, 217,
+			56, 137, 191, 84, 217, 81, 180, 184, 16, 77, 200, 170, 17, 56,
+			120, 96, 103, 79, 63, 55, 126, 186, 57, 126, 186, 182, 124, 250,
+			214, 213, 211, 119, 174, 158, 94, 42, 158, 174, 63, 127, 182, 200,
+			230, 220, 187, 124, 211, 13, 57, 42, 57, 128, 160, 120, 150, 218,
+			33, 23, 181, 221, 246, 107, 14, 18, 235, 217, 144, 189, 237, 197,
+			242, 210, 130, 18, 105, 110, 96, 11, 56, 112, 41, 102, 189, 48,
+			66, 212, 1, 208, 75, 126, 205, 25, 135, 142, 21, 133, 105, 175,
+			238, 7, 235, 188, 232, 241, 8, 78, 0, 113, 78, 96, 88, 144,
+			11, 71, 52, 33, 186, 59, 177, 189, 122, 28, 106, 220, 6, 97,
+			163, 64, 42, 250, 52, 74, 148, 139, 120, 192, 170, 78, 11, 215,
+			135, 95, 23, 103, 185, 142, 88, 105, 106, 149, 193, 170, 76, 162,
+			31, 12, 241, 89, 97, 137, 183, 223, 107, 100, 6, 201, 63, 54,
+			164, 45, 222, 126, 191, 97, 238, 203, 127, 208, 96, 149, 88, 187,
+			85, 148, 239, 215, 145, 224, 161, 203, 44, 116, 189, 106, 82, 194,
+			34, 59, 139, 88, 236, 14, 152, 18, 215, 248, 3, 85, 34, 178,
+			147, 78, 244, 60, 115, 189, 106, 163, 29, 186, 247, 64, 73, 220,
+			163, 76, 255, 208, 191, 94, 5, 26, 212, 126, 191, 145, 217, 171,
+			64, 11, 64, 58, 68, 190, 108, 72, 243, 191, 253, 65, 195, 164,
+			249, 223, 51, 216, 188, 239, 141, 123, 124, 93, 232, 192, 138, 7,
+			227, 128, 28, 57, 58, 208, 134, 119, 228, 174, 69, 54, 47, 11,
+			106, 229, 242, 158, 211, 104, 243, 16, 105, 46, 81, 25, 90, 110,
+			195, 200, 109, 52, 216, 134, 115, 143, 51, 47, 217, 38, 86, 45,
+			11, 2, 101, 57, 145, 212, 242, 235, 126, 0, 74, 177, 178, 28,
+			116, 35, 76, 42, 140, 99, 242, 127, 178, 3, 82, 140, 20, 181,
+			63, 24, 35, 197, 192, 97, 103, 246, 40, 208, 2, 48, 55, 168,
+			79, 49, 62, 125, 144, 60, 254, 186, 78, 170, 193, 170, 13, 246,
+			221, 215, 127, 86, 157, 127, 173, 227, 146, 194, 71, 13, 114, 96,
+			206, 13, 163, 105, 221, 72, 69, 30, 247, 211, 71, 9, 113, 116,
+			234, 176, 193, 172, 145, 236, 212, 193, 206, 67, 105, 93, 170, 146,
+			200, 74, 207, 144, 189, 85, 223, 171, 187, 235, 171, 247, 92, 190,
+			185, 218, 14, 26, 242, 20, 124, 143, 72, 126, 218, 229, 155, 43,
+			65, 131, 158, 213, 249, 2, 126, 207, 5, 107, 236, 176, 133, 249,
+			6, 68, 114, 69, 166, 22, 70, 201, 208, 77, 30, 119, 177, 34,
+			28, 20, 40, 37, 54, 120, 92, 201, 131, 119, 252, 93, 248, 132,
+			65, 250, 116, 198, 157, 114, 224, 17, 80, 123, 205, 227, 17, 28,
+			245, 88, 35, 125, 21, 5, 82, 70, 178, 53, 30, 226, 118, 17,
+			247, 37, 153, 68, 175, 16, 82, 13, 56, 108, 105, 171, 81, 56,
+			108, 51, 99, 36, 59, 149, 87, 54, 9, 133, 227, 162, 222, 90,
+			43, 125, 50, 247, 114, 72, 143, 198, 69, 215, 182, 134, 83, 216,
+			33, 245, 249, 250, 214, 212, 199, 12, 66, 116, 191, 67, 58, 79,
+			6, 58, 103, 101, 87, 55, 129, 83, 157, 51, 178, 203, 92, 222,
+			38, 253, 73, 12, 210, 19, 157, 165, 118, 192, 110, 126, 183, 169,
+			126, 189, 14, 8, 159, 31, 20, 14, 8, 215, 222, 116, 64, 120,
+			211, 1, 225, 191, 178, 3, 2, 252, 52, 168, 181, 191, 103, 148,
+			172, 40, 95, 4, 102, 228, 203, 76, 83, 243, 174, 222, 8, 97,
+			210, 29, 33, 102, 110, 32, 216, 168, 34, 157, 46, 9, 148, 124,
+			66, 187, 36, 28, 49, 239, 228, 127, 214, 96, 157, 139, 81, 123,
+			17, 56, 141, 6, 142, 40, 81, 171, 212, 42, 27, 91, 44, 140,
+			124, 32, 5, 215, 139, 23, 3, 209, 125, 4, 199, 103, 204, 32,
+			52, 140, 68, 5, 155, 176, 235, 173, 113, 217, 134, 168, 192, 105,
+			180, 54, 156, 53, 14, 78, 13, 13, 230, 7, 53, 30, 144, 216,
+			241, 33, 218, 224, 110, 192, 202, 179, 29, 78, 7, 71, 210, 251,
+			20, 100, 82, 235, 200, 254, 115, 10, 178, 168, 117, 228, 145, 167,
+			200, 50, 14, 208, 160, 214, 113, 243, 241, 252, 77, 150, 100, 27,
+			122, 116, 113, 74, 210, 17, 1, 208, 230, 40, 215, 50, 94, 139,
+			39, 64, 183, 111, 64, 181, 105, 170, 32, 147, 90, 199, 135, 206,
+			40, 200, 162, 214, 241, 11, 87, 201, 179, 202, 233, 97, 220, 200,
+			207, 177, 157, 121, 29, 115, 119, 196, 176, 148, 165, 58, 16, 203,
+			150, 36, 115, 75, 122, 69, 28, 35, 103, 148, 87, 196, 105, 243,
+			84, 254, 16, 54, 131, 130, 74, 163, 145, 168, 176, 168, 189, 33,
+			108, 200, 168, 161, 52, 181, 78, 103, 247, 41, 8, 220, 25, 246,
+			31, 87, 16, 184, 51, 20, 78, 146, 39, 148, 111, 196, 136, 121,
+			52, 63, 137, 51, 185, 82, 153, 3, 122, 131, 253, 18, 73, 163,
+			188, 152, 36, 81, 177, 29, 34, 223, 210, 205, 26, 41, 40, 159,
+			244, 155, 24, 233, 27, 86, 144, 69, 173, 145, 195, 71, 8, 199,
+			134, 76, 106, 141, 153, 71, 243, 207, 98, 67, 106, 167, 85, 28,
+			172, 188, 152, 24, 147, 106, 168, 234, 7, 194, 233, 175, 166, 205,
+			223, 9, 202, 42, 47, 146, 157, 240, 96, 166, 160, 29, 213, 33,
+			24, 222, 152, 238, 144, 105, 81, 107, 236, 240, 17, 112, 8, 178,
+			13, 106, 79, 246, 76, 129, 67, 208, 14, 187, 142, 116, 72, 20,
+			236, 98, 171, 115, 14, 217, 26, 135, 238, 104, 26, 146, 190, 44,
+			48, 244, 201, 204, 97, 114, 138, 216, 182, 1, 179, 118, 193, 164,
+			133, 131, 224, 113, 114, 149, 21, 192, 141, 101, 92, 215, 32, 157,
+			78, 12, 16, 99, 173, 11, 178, 175, 6, 82, 254, 133, 190, 61,
+			10, 178, 168, 117, 33, 55, 72, 150, 137, 105, 155, 212, 126, 164,
+			231, 154, 145, 191, 21, 207, 134, 112, 187, 228, 97, 87, 223, 4,
+			125, 171, 117, 42, 212, 199, 221, 232, 12, 112, 243, 72, 102, 16,
+			123, 108, 66, 143, 47, 191, 86, 143, 77, 236, 241, 101, 217, 99,
+			19, 123, 124, 89, 246, 216, 68, 186, 186, 156, 83, 245, 25, 212,
+			122, 212, 60, 166, 234, 123, 91, 225, 194, 212, 163, 197, 73, 112,
+			185, 153, 152, 186, 84, 120, 65, 213, 103, 216, 144, 77, 67, 41,
+			106, 61, 154, 29, 84, 16, 84, 65, 15, 41, 200, 162, 214, 163,
+			71, 142, 146, 9, 236, 173, 73, 173, 199, 204, 67, 133, 130, 236,
+			237, 50, 104, 52, 49, 22, 96, 115, 245, 3, 86, 44, 22, 117,
+			199, 129, 44, 30, 211, 29, 135, 161, 63, 214, 183, 79, 65, 22,
+			181, 30, 59, 56, 140, 110, 72, 72, 35, 87, 204, 243, 133, 19,
+			178, 234, 11, 87, 30, 157, 146, 170, 202, 5, 208, 83, 174, 78,
+			77, 22, 39, 167, 46, 60, 175, 107, 182, 210, 80, 224, 176, 130,
+			12, 106, 93, 57, 114, 70, 65, 80, 217, 232, 57, 114, 30, 107,
+			182, 169, 117, 213, 28, 46, 28, 147, 53, 163, 131, 83, 196, 195,
+			104, 7, 239, 38, 211, 180, 83, 144, 91, 117, 216, 54, 168, 117,
+			181, 111, 72, 65, 22, 181, 174, 30, 56, 168, 69, 249, 15, 159,
+			37, 143, 189, 46, 81, 190, 29, 109, 212, 214, 118, 20, 227, 95,
+			83, 82, 191, 67, 40, 156, 22, 120, 78, 43, 220, 240, 213, 122,
+			1, 7, 84, 181, 164, 165, 71, 147, 134, 233, 97, 210, 23, 222,
+			117, 91, 171, 107, 126, 109, 11, 37, 240, 76, 37, 3, 9, 215,
+			253, 218, 22, 8, 202, 25, 85, 25, 61, 70, 178, 208, 153, 213,
+			218, 218, 106, 192, 239, 201, 138, 250, 32, 105, 118, 173, 194, 239,
+			209, 83, 100, 64, 125, 15, 55, 156, 169, 71, 46, 75, 129, 30,
+			53, 145, 217, 181, 37, 76, 163, 35, 36, 167, 114, 213, 120, 189,
+			1, 146, 45, 10, 244, 253, 21, 44, 61, 187, 54, 43, 83, 255,
+			6, 114, 116, 225, 159, 152, 100, 184, 116, 191, 213, 112, 92, 111,
+			81, 11, 14, 9, 108, 40, 247, 219, 109, 238, 184, 199, 8, 137,
+			37, 13, 217, 255, 68, 10, 56, 128, 5, 220, 105, 52, 165, 220,
+			47, 0, 250, 52, 33, 78, 36, 197, 39, 144, 248, 65, 9, 186,
+			220, 41, 25, 239, 214, 155, 226, 180, 46, 88, 242, 162, 96, 171,
+			146, 168, 169, 99, 222, 82, 157, 243, 150, 127, 130, 236, 237, 42,
+			74, 115, 196, 186, 203, 213, 152, 224, 39, 116, 23, 149, 89, 57,
+			18, 1, 92, 53, 31, 51, 10, 255, 214, 32, 251, 227, 206, 96,
+			239, 60, 220, 132, 95, 115, 154, 135, 73, 239, 122, 224, 120, 48,
+			111, 80, 107, 166, 162, 64, 122, 146, 236, 169, 110, 240, 234, 93,
+			94, 91, 77, 34, 169, 95, 38, 86, 32, 13, 186, 228, 249, 10,
+			77, 125, 21, 1, 208, 199, 73, 102, 13, 44, 146, 222, 122, 56,
+			156, 66, 37, 146, 117, 226, 239, 186, 248, 154, 232, 104, 69, 151,
+			40, 252, 183, 38, 161, 219, 51, 208, 235, 132, 196, 158, 78, 82,
+			55, 45, 116, 86, 59, 163, 190, 39, 43, 78, 148, 162, 227, 132,
+			198, 208, 106, 211, 137, 170, 27, 122, 224, 131, 241, 151, 59, 226,
+			3, 61, 64, 210, 235, 129, 223, 110, 133, 195, 22, 14, 79, 66,
+			244, 24, 33, 146, 198, 92, 61, 244, 68, 10, 61, 75, 246, 74,
+			104, 107, 21, 182, 10, 94, 67, 237, 47, 83, 25, 80, 201, 32,
+			96, 240, 26, 100, 108, 242, 230, 26, 15, 194, 13, 183, 181, 218,
+			114, 162, 141, 225, 52, 214, 54, 16, 39, 47, 58, 209, 70, 114,
+			154, 122, 59, 166, 169, 80, 33, 251, 118, 26, 118, 183, 102, 107,
+			108, 215, 108, 135, 73, 111, 39, 6, 20, 56, 245, 47, 12, 146,
+			6, 41, 116, 246, 58, 189, 73, 178, 9, 22, 68, 187, 230, 113,
+			59, 119, 218, 230, 195, 174, 74, 190, 72, 6, 183, 173, 26, 122,
+			230, 225, 150, 85, 254, 100, 103, 190, 56, 67, 98, 196, 175, 87,
+			73, 253, 237, 195, 177, 114, 154, 222, 166, 156, 78, 189, 169, 156,
+			190, 169, 156, 190, 78, 229, 180, 151, 92, 65, 141, 211, 58, 216,
+			211, 155, 47, 162, 24, 56, 123, 125, 71, 37, 115, 211, 15, 238,
+			138, 201, 21, 153, 138, 132, 56, 74, 145, 204, 155, 169, 252, 50,
+			75, 172, 44, 84, 5, 165, 244, 169, 54, 89, 85, 57, 110, 156,
+			68, 95, 30, 194, 42, 67, 85, 78, 42, 48, 130, 105, 21, 9,
+			249, 62, 83, 233, 114, 39, 204, 84, 254, 207, 13, 182, 109, 189,
+			1, 18, 97, 155, 13, 217, 134, 191, 201, 156, 4, 42, 24, 178,
+			125, 182, 41, 79, 134, 88, 141, 87, 209, 19, 119, 109, 139, 48,
+			144, 184, 85, 135, 212, 142, 6, 167, 49, 21, 169, 30, 2, 194,
+			235, 237, 70, 3, 11, 193, 71, 22, 5, 78, 149, 95, 149, 103,
+			112, 184, 187, 40, 215, 96, 220, 2, 216, 38, 15, 80, 59, 15,
+			219, 141, 136, 215, 198, 136, 204, 25, 115, 104, 38, 89, 21, 22,
+			187, 231, 58, 50, 131, 24, 42, 98, 74, 113, 90, 160, 56, 135,
+			9, 118, 90, 36, 228, 9, 84, 39, 173, 211, 61, 189, 249, 41,
+			182, 157, 125, 65, 110, 113, 63, 0, 116, 179, 228, 36, 4, 173,
+			106, 145, 144, 159, 82, 38, 123, 107, 212, 236, 5, 139, 189, 90,
+			238, 114, 1, 106, 220, 71, 62, 91, 231, 17, 16, 227, 164, 250,
+			13, 189, 2, 89, 40, 140, 224, 200, 163, 72, 216, 188, 31, 225,
+			4, 221, 19, 117, 104, 79, 88, 231, 158, 227, 54, 240, 100, 65,
+			28, 68, 2, 121, 115, 245, 113, 221, 17, 7, 11, 10, 209, 132,
+			121, 109, 24, 91, 8, 135, 108, 247, 148, 154, 57, 102, 166, 243,
+			46, 43, 215, 89, 20, 180, 19, 215, 185, 208, 100, 0, 188, 39,
+			192, 169, 97, 78, 53, 130, 251, 22, 170, 215, 99, 236, 37, 176,
+			167, 195, 17, 232, 210, 173, 233, 169, 71, 46, 19, 182, 225, 132,
+			27, 99, 122, 86, 101, 91, 136, 245, 88, 88, 37, 228, 189, 6,
+			106, 121, 214, 133, 158, 222, 252, 22, 211, 56, 211, 164, 191, 253,
+			126, 130, 19, 47, 193, 109, 148, 211, 169, 181, 202, 38, 221, 80,
+			123, 8, 139, 169, 173, 111, 73, 7, 96, 217, 90, 145, 144, 73,
+			165, 15, 94, 52, 123, 243, 39, 89, 165, 171, 10, 156, 35, 55,
+			212, 253, 43, 18, 50, 142, 37, 64, 57, 51, 51, 121, 38, 71,
+			141, 131, 150, 172, 162, 51, 251, 83, 152, 221, 4, 61, 170, 55,
+			255, 36, 83, 194, 173, 26, 2, 174, 70, 181, 24, 199, 152, 47,
+			221, 214, 221, 58, 211, 66, 57, 16, 24, 204, 73, 145, 144, 139,
+			88, 25, 104, 45, 230, 225, 252, 25, 60, 240, 82, 142, 237, 137,
+			86, 217, 166, 19, 50, 41, 11, 23, 9, 41, 163, 138, 106, 61,
+			209, 3, 237, 239, 182, 95, 118, 82, 241, 246, 85, 46, 104, 249,
+			186, 210, 69, 223, 98, 102, 242, 143, 176, 178, 90, 48, 145, 47,
+			22, 251, 216, 107, 93, 14, 41, 18, 242, 184, 210, 63, 167, 205,
+			76, 126, 130, 37, 218, 232, 174, 5, 182, 226, 98, 141, 223, 43,
+			194, 2, 112, 189, 245, 66, 145, 144, 39, 149, 126, 57, 99, 102,
+			242, 23, 216, 13, 224, 17, 130, 27, 128, 217, 126, 91, 71, 90,
+			129, 15, 135, 166, 87, 49, 11, 148, 95, 84, 74, 100, 201, 76,
+			229, 103, 88, 44, 65, 171, 229, 40, 13, 7, 99, 177, 115, 57,
+			200, 204, 112, 6, 172, 89, 137, 211, 208, 108, 167, 72, 200, 77,
+			165, 60, 222, 52, 123, 243, 87, 187, 87, 183, 156, 102, 121, 125,
+			82, 173, 109, 177, 143, 119, 174, 109, 178, 72, 80, 115, 124, 170,
+			167, 55, 95, 98, 59, 202, 43, 76, 136, 103, 107, 124, 119, 102,
+			235, 132, 138, 207, 22, 9, 121, 43, 177, 109, 11, 166, 235, 142,
+			217, 155, 191, 24, 19, 119, 103, 223, 212, 64, 155, 206, 93, 46,
+			119, 140, 170, 98, 200, 23, 176, 6, 131, 90, 11, 102, 58, 127,
+			138, 45, 3, 107, 112, 69, 233, 68, 219, 110, 200, 164, 108, 89,
+			36, 228, 127, 2, 126, 103, 193, 20, 45, 155, 153, 252, 63, 55,
+			228, 210, 132, 41, 218, 220, 240, 67, 222, 197, 178, 5, 63, 105,
+			108, 197, 188, 27, 246, 129, 89, 87, 92, 83, 193, 211, 181, 196,
+			180, 240, 154, 172, 74, 118, 2, 39, 155, 213, 57, 152, 53, 157,
+			234, 93, 101, 133, 146, 211, 126, 54, 36, 44, 240, 145, 119, 57,
+			141, 102, 145, 225, 21, 82, 230, 214, 153, 231, 171, 30, 57, 97,
+			71, 195, 176, 90, 45, 32, 143, 167, 77, 146, 127, 146, 221, 106,
+			55, 29, 111, 60, 224, 78, 13, 121, 107, 192, 155, 78, 112, 55,
+			76, 220, 151, 146, 196, 225, 194, 137, 59, 18, 109, 221, 105, 52,
+			192, 131, 22, 100, 191, 59, 88, 153, 77, 173, 103, 77, 146, 127,
+			43, 24, 135, 226, 177, 75, 127, 93, 169, 25, 201, 222, 224, 82,
+			134, 123, 22, 48, 73, 157, 56, 46, 18, 178, 68, 76, 219, 166,
+			214, 219, 123, 122, 243, 183, 216, 118, 109, 103, 27, 113, 128, 117,
+			169, 161, 80, 47, 27, 70, 2, 145, 157, 198, 1, 131, 149, 209,
+			6, 18, 121, 135, 73, 242, 147, 172, 212, 224, 208, 62, 184, 22,
+			39, 118, 78, 39, 138, 64, 14, 172, 41, 244, 202, 202, 138, 4,
+			109, 50, 54, 44, 230, 23, 205, 116, 254, 132, 166, 15, 216, 162,
+			182, 239, 188, 69, 66, 174, 98, 126, 147, 90, 107, 38, 201, 143,
+			179, 155, 98, 243, 21, 10, 15, 56, 85, 131, 243, 73, 213, 109,
+			57, 13, 141, 161, 184, 173, 39, 177, 45, 139, 90, 53, 147, 228,
+			47, 40, 230, 227, 242, 135, 45, 127, 19, 203, 219, 212, 170, 155,
+			233, 252, 213, 14, 90, 78, 238, 252, 178, 50, 215, 99, 47, 202,
+			100, 151, 135, 47, 178, 154, 27, 240, 106, 212, 216, 42, 18, 242,
+			27, 64, 225, 54, 152, 172, 238, 154, 36, 255, 75, 6, 155, 102,
+			213, 13, 184, 8, 228, 215, 153, 39, 136, 84, 74, 21, 177, 152,
+			177, 155, 124, 1, 124, 2, 188, 25, 252, 58, 97, 47, 138, 82,
+			47, 142, 129, 235, 70, 128, 158, 1, 154, 254, 241, 83, 162, 111,
+			157, 67, 99, 139, 126, 171, 13, 204, 164, 198, 248, 61, 238, 233,
+			213, 17, 79, 65, 205, 173, 121, 103, 35, 49, 19, 122, 30, 210,
+			212, 106, 154, 233, 252, 120, 2, 23, 110, 168, 170, 21, 75, 58,
+			220, 78, 135, 79, 19, 180, 126, 189, 179, 167, 55, 255, 20, 219,
+			73, 145, 196, 241, 121, 204, 111, 71, 85, 16, 243, 252, 186, 226,
+			161, 48, 34, 199, 99, 124, 7, 34, 43, 18, 114, 141, 216, 118,
+			10, 72, 49, 52, 51, 249, 98, 247, 218, 75, 168, 161, 138, 127,
+			37, 11, 143, 99, 97, 131, 90, 109, 51, 157, 103, 29, 147, 171,
+			179, 197, 116, 168, 77, 115, 159, 24, 127, 125, 167, 236, 34, 146,
+			76, 195, 95, 15, 223, 152, 121, 238, 135, 12, 178, 31, 20, 249,
+			25, 172, 103, 206, 95, 15, 229, 254, 251, 154, 86, 151, 3, 36,
+			29, 57, 193, 58, 143, 164, 41, 71, 66, 112, 98, 220, 114, 214,
+			249, 42, 222, 69, 149, 6, 151, 62, 72, 89, 134, 4, 176, 238,
+			1, 176, 26, 186, 47, 115, 52, 161, 165, 42, 25, 72, 88, 114,
+			95, 230, 133, 123, 228, 64, 119, 103, 228, 73, 240, 37, 210, 43,
+			134, 170, 204, 38, 249, 78, 117, 90, 108, 107, 162, 96, 69, 101,
+			133, 35, 125, 143, 223, 143, 86, 19, 29, 146, 71, 250, 144, 188,
+			168, 58, 85, 120, 111, 31, 233, 79, 214, 64, 143, 147, 172, 168,
+			99, 21, 60, 22, 165, 229, 129, 136, 164, 229, 173, 22, 223, 117,
+			244, 93, 88, 179, 186, 177, 150, 35, 214, 230, 134, 143, 3, 239,
+			171, 192, 79, 90, 36, 54, 184, 185, 13, 167, 94, 211, 156, 136,
+			249, 192, 140, 82, 245, 155, 64, 175, 195, 105, 172, 69, 129, 208,
+			105, 167, 213, 90, 149, 162, 56, 26, 89, 250, 42, 196, 105, 181,
+			164, 8, 208, 109, 79, 201, 108, 183, 167, 156, 37, 123, 253, 70,
+			109, 53, 153, 171, 15, 115, 13, 248, 141, 218, 108, 34, 227, 1,
+			146, 246, 55, 61, 30, 132, 195, 4, 191, 75, 8, 102, 31, 42,
+			144, 223, 178, 248, 173, 207, 111, 212, 22, 48, 1, 58, 47, 173,
+			66, 195, 253, 104, 36, 82, 32, 88, 225, 214, 27, 254, 90, 56,
+			188, 7, 211, 5, 0, 104, 22, 12, 108, 120, 0, 147, 37, 148,
+			244, 134, 216, 219, 233, 13, 145, 180, 165, 230, 186, 108, 169, 5,
+			178, 199, 109, 173, 162, 221, 31, 109, 90, 195, 131, 152, 33, 235,
+			182, 240, 156, 4, 72, 15, 72, 198, 7, 202, 90, 173, 54, 92,
+			238, 69, 171, 110, 109, 152, 98, 174, 61, 152, 60, 131, 169, 229,
+			26, 45, 146, 161, 142, 124, 33, 175, 6, 60, 26, 30, 194, 188,
+			131, 137, 188, 75, 248, 129, 62, 65, 14, 99, 226, 170, 83, 83,
+			66, 91, 220, 68, 56, 188, 15, 71, 49, 140, 89, 166, 117, 14,
+			213, 90, 72, 39, 200, 62, 92, 80, 24, 18, 130, 7, 224, 157,
+			178, 234, 55, 106, 195, 251, 69, 123, 248, 13, 142, 107, 120, 176,
+			18, 52, 22, 26, 181, 29, 11, 120, 124, 115, 248, 192, 78, 5,
+			230, 249, 38, 12, 40, 228, 213, 118, 224, 70, 91, 171, 210, 191,
+			5, 26, 56, 40, 242, 171, 79, 51, 248, 5, 26, 216, 33, 63,
+			212, 63, 188, 83, 126, 168, 255, 60, 25, 140, 185, 118, 8, 104,
+			224, 181, 225, 67, 56, 236, 92, 226, 195, 52, 164, 211, 9, 50,
+			148, 204, 44, 22, 94, 109, 56, 143, 217, 105, 226, 147, 88, 175,
+			219, 10, 4, 188, 233, 223, 227, 181, 225, 195, 219, 10, 84, 196,
+			23, 56, 27, 144, 189, 14, 248, 61, 68, 229, 17, 105, 27, 86,
+			78, 60, 11, 141, 238, 92, 48, 190, 163, 93, 185, 96, 104, 5,
+			178, 7, 90, 8, 117, 85, 199, 48, 83, 22, 19, 101, 77, 29,
+			121, 160, 162, 227, 157, 121, 230, 249, 230, 148, 75, 72, 204, 250,
+			232, 219, 200, 64, 39, 51, 164, 93, 38, 196, 110, 86, 137, 124,
+			59, 127, 234, 193, 153, 4, 63, 125, 189, 134, 198, 255, 63, 19,
+			222, 48, 252, 129, 222, 48, 83, 111, 122, 195, 188, 233, 13, 243,
+			95, 216, 27, 102, 20, 204, 5, 224, 2, 115, 176, 39, 111, 228,
+			159, 96, 49, 45, 235, 99, 235, 7, 185, 192, 200, 8, 2, 40,
+			32, 197, 110, 47, 7, 51, 20, 212, 100, 97, 172, 60, 100, 222,
+			201, 95, 101, 157, 235, 100, 155, 211, 75, 162, 30, 16, 228, 103,
+			181, 71, 75, 210, 15, 229, 80, 135, 31, 202, 161, 14, 63, 148,
+			67, 143, 60, 69, 222, 3, 38, 166, 30, 136, 27, 120, 222, 200,
+			111, 178, 29, 215, 47, 202, 170, 74, 187, 84, 102, 55, 39, 190,
+			40, 31, 247, 35, 225, 255, 79, 192, 170, 86, 119, 27, 17, 87,
+			20, 24, 203, 32, 234, 118, 188, 16, 80, 228, 89, 62, 244, 246,
+			88, 230, 40, 218, 62, 16, 9, 204, 28, 206, 79, 40, 5, 92,
+			219, 173, 116, 140, 130, 184, 89, 84, 216, 154, 78, 77, 142, 28,
+			252, 67, 82, 212, 98, 102, 175, 130, 12, 106, 177, 204, 144, 130,
+			44, 106, 177, 3, 7, 201, 21, 101, 212, 59, 105, 238, 203, 143,
+			177, 146, 180, 210, 64, 245, 80, 159, 228, 239, 74, 143, 136, 91,
+			211, 141, 128, 223, 200, 73, 121, 188, 45, 252, 70, 78, 246, 237,
+			85, 144, 69, 173, 147, 116, 72, 25, 54, 77, 106, 157, 53, 135,
+			193, 176, 9, 220, 8, 4, 125, 20, 249, 187, 4, 65, 22, 240,
+			42, 199, 139, 248, 120, 2, 209, 61, 23, 130, 77, 98, 32, 148,
+			26, 73, 90, 63, 161, 26, 6, 242, 100, 215, 108, 20, 89, 185,
+			46, 194, 88, 141, 129, 213, 84, 90, 140, 221, 32, 20, 185, 245,
+			64, 64, 75, 59, 171, 7, 2, 40, 57, 43, 207, 233, 123, 208,
+			151, 224, 236, 129, 131, 100, 14, 177, 101, 81, 235, 156, 121, 32,
+			255, 22, 100, 170, 77, 231, 190, 219, 108, 55, 19, 102, 192, 68,
+			219, 208, 63, 244, 239, 173, 113, 133, 66, 101, 46, 213, 237, 90,
+			41, 168, 78, 205, 146, 101, 80, 235, 92, 70, 69, 63, 177, 160,
+			169, 125, 251, 201, 219, 208, 2, 106, 23, 123, 30, 49, 242, 11,
+			219, 200, 83, 218, 95, 245, 106, 219, 145, 40, 133, 245, 79, 234,
+			53, 216, 147, 119, 182, 121, 176, 37, 201, 14, 230, 173, 152, 57,
+			70, 78, 43, 35, 231, 164, 121, 42, 63, 204, 166, 119, 170, 73,
+			246, 220, 64, 79, 165, 73, 233, 243, 97, 152, 61, 105, 106, 77,
+			102, 15, 42, 8, 188, 104, 134, 143, 43, 200, 162, 214, 100, 225,
+			164, 32, 4, 3, 112, 123, 201, 60, 218, 73, 8, 210, 214, 37,
+			99, 134, 37, 200, 97, 71, 42, 232, 88, 132, 120, 173, 226, 97,
+			166, 95, 216, 186, 225, 102, 141, 231, 179, 38, 92, 40, 220, 105,
+			88, 64, 209, 151, 36, 33, 24, 72, 209, 151, 164, 227, 145, 129,
+			20, 125, 233, 240, 17, 242, 24, 90, 74, 237, 199, 122, 184, 145,
+			31, 147, 171, 83, 80, 41, 11, 224, 54, 6, 30, 196, 56, 137,
+			234, 25, 247, 34, 141, 109, 64, 192, 99, 153, 125, 228, 17, 101,
+			36, 189, 106, 30, 202, 143, 176, 27, 46, 111, 212, 192, 202, 212,
+			108, 194, 209, 85, 53, 240, 67, 116, 134, 81, 213, 128, 198, 163,
+			186, 41, 60, 120, 98, 191, 146, 30, 244, 43, 81, 142, 48, 224,
+			193, 115, 245, 224, 48, 201, 42, 11, 234, 53, 83, 125, 130, 209,
+			93, 211, 197, 96, 116, 215, 228, 122, 53, 113, 116, 215, 232, 144,
+			44, 102, 82, 235, 113, 115, 88, 22, 131, 213, 241, 184, 164, 82,
+			19, 157, 130, 30, 151, 188, 68, 184, 221, 60, 126, 224, 160, 44,
+			102, 81, 235, 9, 83, 185, 254, 0, 113, 63, 161, 91, 3, 226,
+			126, 162, 175, 95, 65, 144, 115, 111, 78, 22, 179, 169, 245, 164,
+			121, 90, 126, 178, 211, 0, 41, 87, 28, 48, 254, 63, 121, 132,
+			41, 200, 162, 214, 147, 39, 79, 201, 98, 41, 176, 48, 239, 151,
+			159, 82, 8, 169, 214, 82, 6, 181, 222, 210, 151, 83, 144, 69,
+			173, 183, 12, 237, 147, 197, 210, 212, 122, 171, 169, 60, 146, 210,
+			41, 128, 84, 177, 180, 65, 173, 183, 106, 76, 166, 45, 106, 189,
+			85, 99, 178, 23, 108, 209, 170, 88, 111, 10, 32, 85, 172, 215,
+			160, 214, 180, 46, 214, 107, 81, 107, 90, 23, 203, 80, 235, 186,
+			121, 84, 126, 202, 164, 0, 82, 197, 50, 6, 181, 174, 75, 242,
+			50, 205, 140, 69, 173, 235, 135, 143, 144, 75, 88, 172, 15, 108,
+			207, 251, 243, 103, 21, 97, 232, 109, 62, 242, 37, 201, 161, 85,
+			76, 208, 157, 166, 139, 190, 20, 20, 83, 245, 247, 25, 212, 42,
+			233, 9, 238, 179, 168, 85, 162, 10, 9, 132, 90, 55, 244, 104,
+			72, 10, 32, 85, 140, 24, 212, 186, 161, 221, 148, 136, 69, 173,
+			27, 7, 212, 104, 178, 96, 192, 62, 46, 63, 101, 109, 128, 84,
+			219, 217, 20, 181, 110, 106, 191, 175, 172, 65, 173, 155, 218, 239,
+			43, 107, 81, 235, 230, 145, 99, 178, 146, 126, 106, 221, 210, 40,
+			233, 183, 1, 82, 149, 244, 167, 168, 117, 75, 87, 210, 111, 80,
+			235, 22, 61, 40, 43, 233, 183, 168, 117, 43, 127, 68, 86, 178,
+			135, 90, 101, 243, 152, 252, 180, 199, 6, 72, 85, 178, 39, 69,
+			173, 178, 174, 100, 143, 65, 173, 50, 85, 88, 222, 99, 81, 171,
+			124, 248, 40, 154, 182, 76, 115, 128, 90, 115, 230, 241, 252, 248,
+			238, 88, 46, 47, 106, 111, 189, 46, 92, 15, 216, 80, 88, 67,
+			41, 106, 205, 233, 54, 7, 12, 106, 205, 233, 209, 15, 88, 212,
+			154, 59, 114, 140, 204, 98, 155, 123, 193, 76, 126, 48, 255, 232,
+			67, 181, 57, 29, 134, 238, 186, 215, 228, 94, 119, 235, 123, 83,
+			80, 141, 154, 178, 189, 96, 123, 151, 113, 179, 76, 115, 175, 69,
+			173, 133, 125, 7, 36, 162, 114, 212, 90, 52, 143, 200, 79, 185,
+			20, 64, 170, 88, 206, 160, 214, 98, 223, 1, 5, 89, 212, 90,
+			60, 116, 88, 114, 166, 65, 106, 45, 153, 199, 242, 35, 187, 119,
+			83, 104, 145, 93, 253, 26, 76, 65, 57, 213, 192, 160, 65, 173,
+			37, 77, 225, 131, 22, 181, 150, 14, 31, 149, 253, 162, 96, 250,
+			47, 200, 79, 52, 5, 144, 42, 70, 13, 106, 45, 247, 169, 62,
+			83, 139, 90, 203, 199, 79, 200, 98, 67, 212, 90, 49, 47, 202,
+			79, 67, 54, 64, 170, 237, 161, 20, 181, 86, 244, 28, 12, 25,
+			212, 90, 161, 227, 10, 178, 168, 181, 50, 57, 37, 43, 217, 7,
+			166, 251, 147, 242, 211, 190, 20, 64, 170, 237, 125, 6, 181, 158,
+			238, 83, 212, 185, 15, 140, 252, 172, 32, 139, 237, 167, 214, 51,
+			186, 216, 254, 20, 64, 170, 216, 126, 131, 90, 207, 232, 98, 251,
+			45, 106, 61, 163, 139, 29, 160, 214, 179, 122, 164, 7, 82, 0,
+			169, 98, 7, 12, 106, 61, 171, 71, 122, 192, 162, 214, 179, 122,
+			164, 7, 169, 245, 156, 46, 118, 48, 5, 144, 42, 118, 208, 160,
+			214, 115, 186, 216, 65, 139, 90, 207, 29, 63, 33, 105, 122, 152,
+			90, 111, 55, 71, 119, 167, 105, 244, 175, 10, 197, 109, 193, 176,
+			107, 246, 134, 225, 228, 64, 227, 115, 56, 69, 173, 183, 107, 124,
+			14, 27, 212, 122, 59, 85, 163, 31, 182, 168, 245, 246, 51, 35,
+			178, 171, 135, 168, 245, 130, 121, 94, 126, 58, 100, 3, 164, 42,
+			57, 148, 162, 214, 11, 186, 146, 67, 6, 181, 94, 160, 138, 213,
+			31, 178, 168, 245, 194, 200, 57, 89, 73, 30, 142, 23, 84, 37,
+			121, 27, 32, 85, 73, 62, 69, 173, 119, 232, 74, 242, 6, 181,
+			222, 161, 43, 201, 91, 212, 122, 199, 200, 57, 57, 250, 195, 212,
+			114, 204, 163, 187, 143, 126, 81, 156, 249, 8, 36, 116, 141, 254,
+			112, 10, 10, 43, 28, 31, 54, 168, 229, 244, 41, 230, 115, 216,
+			162, 150, 163, 153, 207, 17, 56, 154, 80, 147, 125, 36, 5, 144,
+			42, 118, 196, 160, 214, 154, 46, 118, 196, 162, 214, 154, 46, 118,
+			148, 90, 85, 189, 20, 143, 166, 0, 82, 197, 142, 26, 212, 170,
+			234, 165, 120, 212, 162, 86, 245, 208, 97, 89, 236, 24, 28, 102,
+			168, 98, 199, 82, 0, 169, 98, 199, 12, 106, 213, 116, 177, 99,
+			112, 236, 113, 232, 176, 182, 91, 127, 233, 131, 54, 97, 221, 198,
+			102, 101, 70, 244, 131, 221, 162, 220, 221, 33, 131, 16, 112, 66,
+			89, 22, 253, 96, 137, 71, 244, 49, 98, 131, 118, 47, 13, 189,
+			167, 182, 89, 68, 59, 75, 44, 66, 93, 21, 44, 81, 248, 112,
+			138, 12, 237, 240, 117, 183, 11, 85, 45, 167, 122, 215, 89, 231,
+			210, 132, 171, 64, 112, 141, 83, 81, 120, 170, 91, 210, 109, 46,
+			145, 130, 150, 172, 246, 90, 195, 173, 174, 38, 178, 17, 102, 141,
+			164, 42, 57, 241, 97, 54, 206, 124, 150, 236, 221, 228, 206, 221,
+			100, 214, 44, 102, 29, 128, 228, 68, 198, 25, 210, 47, 79, 221,
+			133, 205, 217, 150, 78, 135, 221, 163, 239, 30, 121, 86, 150, 66,
+			179, 244, 52, 233, 227, 94, 187, 41, 106, 72, 237, 130, 191, 146,
+			215, 110, 118, 215, 146, 129, 98, 178, 138, 94, 169, 88, 163, 31,
+			95, 118, 234, 236, 182, 10, 164, 95, 120, 119, 29, 170, 28, 157,
+			33, 125, 24, 212, 71, 154, 161, 161, 23, 167, 183, 85, 130, 123,
+			82, 119, 21, 113, 57, 122, 153, 244, 250, 104, 129, 14, 209, 80,
+			157, 157, 58, 178, 67, 21, 13, 190, 32, 242, 84, 84, 102, 90,
+			38, 57, 113, 121, 118, 21, 66, 12, 172, 130, 219, 198, 112, 31,
+			86, 112, 124, 91, 5, 75, 152, 113, 198, 175, 113, 136, 66, 89,
+			25, 8, 59, 96, 176, 62, 135, 91, 94, 228, 220, 31, 238, 71,
+			10, 145, 16, 157, 34, 189, 92, 152, 104, 135, 7, 152, 49, 50,
+			48, 53, 188, 173, 230, 146, 248, 94, 81, 25, 11, 191, 146, 38,
+			123, 31, 134, 44, 175, 145, 84, 29, 48, 51, 108, 190, 30, 188,
+			137, 50, 157, 136, 79, 191, 65, 196, 79, 147, 172, 176, 178, 11,
+			42, 178, 30, 146, 14, 137, 40, 180, 157, 12, 237, 55, 68, 134,
+			207, 146, 189, 186, 75, 171, 24, 194, 72, 210, 243, 196, 107, 245,
+			164, 88, 82, 229, 42, 80, 172, 50, 160, 235, 65, 152, 206, 18,
+			226, 123, 220, 175, 175, 214, 120, 181, 49, 156, 217, 5, 75, 11,
+			144, 165, 187, 123, 125, 88, 112, 150, 87, 27, 244, 74, 76, 158,
+			189, 187, 80, 215, 29, 177, 48, 183, 81, 232, 10, 25, 80, 33,
+			42, 229, 200, 250, 176, 19, 197, 215, 28, 89, 69, 22, 195, 129,
+			84, 246, 168, 90, 16, 164, 39, 137, 78, 88, 5, 14, 135, 44,
+			169, 175, 210, 175, 18, 231, 157, 38, 207, 191, 76, 6, 58, 209,
+			3, 7, 47, 120, 186, 139, 204, 49, 85, 17, 0, 120, 110, 115,
+			175, 38, 163, 138, 90, 220, 171, 209, 183, 198, 3, 182, 112, 192,
+			103, 182, 117, 183, 179, 230, 238, 113, 231, 31, 37, 123, 58, 6,
+			240, 176, 77, 23, 62, 99, 147, 253, 59, 214, 77, 159, 37, 251,
+			218, 158, 142, 207, 197, 107, 171, 162, 139, 195, 95, 237, 221, 133,
+			232, 86, 146, 185, 69, 45, 149, 161, 142, 42, 68, 34, 125, 14,
+			206, 202, 170, 13, 39, 64, 103, 10, 185, 26, 167, 30, 110, 200,
+			197, 217, 184, 228, 117, 235, 253, 134, 89, 73, 214, 69, 31, 37,
+			153, 58, 119, 162, 118, 192, 195, 225, 41, 68, 229, 225, 109, 245,
+			222, 16, 25, 150, 120, 84, 209, 153, 105, 147, 244, 223, 227, 129,
+			14, 45, 138, 135, 171, 3, 83, 143, 61, 100, 167, 158, 78, 20,
+			93, 138, 156, 136, 95, 37, 43, 243, 79, 151, 42, 229, 27, 229,
+			210, 172, 232, 102, 71, 245, 249, 15, 25, 36, 155, 24, 9, 176,
+			67, 97, 126, 146, 243, 37, 33, 56, 210, 5, 215, 72, 65, 116,
+			48, 109, 125, 149, 12, 36, 0, 193, 193, 214, 43, 217, 8, 164,
+			227, 111, 113, 139, 64, 16, 130, 116, 42, 215, 176, 248, 214, 66,
+			87, 49, 60, 223, 204, 84, 52, 124, 219, 206, 216, 185, 84, 225,
+			18, 25, 220, 54, 20, 186, 151, 100, 103, 75, 51, 115, 211, 149,
+			105, 48, 118, 231, 122, 232, 0, 73, 140, 46, 103, 156, 235, 203,
+			124, 173, 55, 247, 238, 119, 191, 251, 221, 102, 225, 147, 105, 178,
+			111, 39, 38, 184, 35, 63, 142, 7, 109, 117, 12, 122, 154, 164,
+			26, 206, 26, 111, 224, 81, 238, 192, 212, 249, 135, 98, 179, 197,
+			57, 40, 82, 17, 37, 233, 147, 18, 53, 128, 130, 129, 169, 115,
+			15, 87, 3, 240, 87, 137, 198, 195, 164, 15, 254, 10, 188, 167,
+			5, 222, 33, 1, 241, 158, 39, 25, 228, 123, 53, 174, 231, 68,
+			193, 192, 41, 106, 188, 238, 180, 27, 209, 42, 90, 197, 228, 81,
+			113, 191, 76, 124, 26, 210, 224, 52, 25, 185, 221, 42, 196, 36,
+			188, 143, 91, 104, 170, 34, 56, 103, 25, 82, 96, 218, 95, 10,
+			125, 79, 241, 26, 108, 2, 18, 176, 249, 71, 99, 110, 33, 118,
+			239, 163, 59, 15, 175, 155, 73, 192, 117, 2, 204, 113, 81, 46,
+			101, 167, 129, 167, 180, 153, 202, 128, 72, 94, 144, 169, 133, 255,
+			193, 36, 54, 32, 3, 166, 126, 249, 185, 197, 210, 234, 236, 194,
+			202, 245, 185, 82, 206, 128, 169, 199, 132, 27, 115, 11, 211, 203,
+			57, 83, 195, 24, 127, 43, 103, 233, 2, 43, 34, 193, 78, 102,
+			184, 56, 149, 75, 209, 28, 233, 71, 248, 70, 249, 217, 210, 236,
+			229, 75, 185, 116, 103, 202, 197, 169, 92, 47, 221, 67, 250, 48,
+			229, 250, 194, 194, 92, 46, 163, 235, 132, 240, 153, 243, 55, 115,
+			125, 186, 206, 155, 149, 133, 149, 197, 28, 209, 53, 220, 41, 45,
+			45, 77, 223, 44, 229, 178, 58, 199, 245, 231, 150, 75, 75, 185,
+			254, 142, 110, 93, 156, 202, 237, 209, 77, 148, 230, 87, 238, 228,
+			6, 232, 32, 217, 131, 224, 146, 234, 196, 222, 174, 164, 203, 151,
+			114, 185, 184, 35, 162, 150, 193, 142, 132, 203, 151, 114, 180, 48,
+			67, 82, 72, 134, 148, 146, 129, 185, 233, 235, 165, 185, 213, 133,
+			69, 88, 52, 211, 115, 57, 35, 78, 171, 148, 22, 75, 211, 203,
+			165, 217, 156, 149, 76, 251, 158, 149, 114, 165, 52, 155, 51, 11,
+			85, 178, 111, 167, 29, 114, 199, 37, 148, 160, 5, 115, 23, 90,
+			192, 186, 186, 105, 161, 240, 159, 76, 50, 180, 131, 148, 176, 99,
+			35, 111, 137, 47, 22, 1, 231, 31, 221, 214, 4, 84, 132, 148,
+			221, 85, 91, 69, 148, 75, 202, 155, 214, 46, 242, 38, 84, 177,
+			141, 96, 95, 216, 182, 155, 171, 235, 86, 59, 21, 239, 106, 28,
+			211, 94, 223, 174, 158, 218, 97, 87, 191, 70, 6, 183, 85, 244,
+			208, 187, 235, 123, 12, 50, 188, 27, 114, 94, 131, 37, 154, 29,
+			44, 241, 90, 55, 6, 79, 236, 136, 2, 108, 103, 219, 92, 255,
+			172, 65, 14, 236, 172, 87, 236, 216, 135, 39, 73, 90, 156, 246,
+			201, 249, 222, 46, 140, 220, 193, 207, 93, 117, 85, 100, 169, 164,
+			248, 102, 237, 34, 190, 201, 222, 108, 235, 233, 15, 154, 100, 255,
+			142, 149, 239, 216, 209, 163, 132, 184, 94, 171, 29, 9, 89, 24,
+			16, 214, 87, 233, 195, 20, 100, 94, 192, 101, 219, 145, 254, 110,
+			225, 119, 34, 146, 48, 195, 99, 113, 71, 109, 236, 232, 177, 93,
+			70, 218, 221, 79, 58, 73, 114, 202, 55, 37, 10, 56, 28, 135,
+			174, 139, 221, 246, 106, 170, 238, 52, 66, 94, 217, 43, 62, 47,
+			169, 175, 80, 66, 122, 139, 196, 37, 210, 29, 37, 196, 103, 93,
+			162, 240, 227, 125, 36, 155, 208, 194, 232, 9, 210, 255, 146, 115,
+			207, 89, 85, 154, 181, 188, 178, 5, 105, 139, 82, 187, 158, 36,
+			251, 0, 92, 245, 219, 17, 15, 86, 171, 13, 39, 12, 1, 81,
+			210, 27, 137, 194, 183, 5, 248, 52, 163, 190, 208, 71, 200, 16,
+			164, 174, 54, 219, 141, 200, 109, 53, 248, 42, 232, 250, 194, 241,
+			72, 247, 108, 16, 114, 220, 145, 25, 160, 71, 33, 157, 37, 71,
+			33, 113, 85, 198, 53, 226, 171, 252, 157, 109, 167, 17, 174, 58,
+			94, 109, 21, 188, 227, 135, 247, 193, 208, 174, 155, 195, 70, 229,
+			16, 100, 188, 41, 243, 149, 48, 219, 180, 87, 187, 229, 132, 27,
+			244, 42, 57, 0, 31, 1, 135, 174, 183, 190, 138, 254, 177, 171,
+			237, 168, 254, 216, 240, 225, 100, 251, 216, 67, 17, 99, 107, 6,
+			178, 172, 68, 245, 199, 232, 18, 233, 135, 185, 107, 186, 47, 243,
+			213, 186, 31, 224, 30, 58, 48, 53, 250, 32, 61, 182, 184, 32,
+			11, 220, 241, 107, 252, 106, 106, 105, 177, 84, 154, 173, 100, 85,
+			45, 55, 252, 0, 60, 172, 214, 125, 141, 96, 233, 97, 181, 238,
+			43, 244, 62, 66, 134, 170, 85, 49, 102, 183, 170, 60, 57, 194,
+			225, 92, 7, 178, 170, 85, 28, 172, 91, 149, 52, 30, 210, 43,
+			100, 127, 140, 172, 100, 193, 193, 100, 193, 33, 141, 167, 68, 209,
+			71, 200, 80, 107, 107, 123, 65, 218, 209, 98, 107, 171, 187, 216,
+			105, 180, 178, 4, 28, 67, 190, 13, 31, 76, 230, 78, 124, 160,
+			69, 146, 171, 86, 87, 185, 231, 172, 53, 248, 170, 19, 112, 207,
+			9, 209, 117, 38, 115, 213, 134, 59, 8, 149, 129, 106, 181, 132,
+			31, 167, 241, 27, 61, 71, 6, 253, 181, 151, 170, 130, 176, 86,
+			91, 1, 175, 187, 247, 135, 79, 33, 150, 246, 194, 7, 36, 171,
+			69, 76, 166, 163, 36, 87, 13, 55, 156, 160, 133, 156, 53, 108,
+			57, 85, 62, 124, 90, 100, 21, 233, 243, 42, 25, 8, 59, 220,
+			116, 235, 145, 170, 241, 44, 102, 203, 98, 154, 172, 109, 132, 228,
+			90, 27, 173, 206, 134, 71, 48, 219, 64, 107, 163, 149, 108, 247,
+			36, 217, 211, 218, 72, 54, 58, 138, 217, 250, 91, 27, 137, 22,
+			47, 145, 3, 144, 169, 201, 35, 7, 226, 103, 36, 114, 143, 97,
+			238, 125, 173, 141, 214, 29, 249, 177, 163, 159, 65, 123, 109, 75,
+			211, 199, 56, 230, 205, 66, 154, 162, 144, 55, 172, 126, 252, 173,
+			41, 91, 133, 171, 164, 63, 73, 247, 180, 143, 8, 202, 207, 25,
+			32, 4, 205, 44, 204, 150, 86, 151, 202, 207, 151, 114, 38, 136,
+			81, 115, 229, 229, 210, 106, 101, 101, 30, 66, 210, 230, 172, 132,
+			96, 127, 219, 206, 156, 203, 157, 191, 109, 103, 206, 228, 206, 22,
+			190, 109, 145, 129, 78, 5, 156, 62, 78, 14, 42, 11, 27, 68,
+			207, 221, 116, 3, 92, 150, 77, 71, 108, 145, 154, 252, 246, 201,
+			92, 75, 60, 122, 198, 13, 100, 72, 53, 58, 71, 142, 123, 254,
+			170, 138, 97, 167, 93, 36, 253, 96, 213, 169, 86, 121, 24, 250,
+			193, 176, 153, 172, 229, 136, 231, 47, 201, 204, 241, 62, 49, 45,
+			179, 118, 81, 191, 181, 27, 245, 31, 38, 125, 77, 167, 181, 138,
+			167, 186, 242, 214, 108, 166, 233, 180, 196, 237, 234, 167, 201, 153,
+			56, 235, 106, 131, 175, 59, 213, 173, 85, 144, 192, 87, 209, 26,
+			132, 94, 124, 13, 183, 26, 133, 195, 89, 205, 233, 10, 113, 137,
+			57, 44, 112, 59, 244, 61, 84, 134, 102, 84, 238, 14, 253, 180,
+			255, 239, 4, 129, 116, 78, 178, 157, 75, 221, 182, 51, 169, 92,
+			250, 182, 157, 73, 231, 122, 111, 219, 153, 76, 174, 239, 182, 157,
+			233, 203, 145, 194, 207, 19, 210, 159, 84, 44, 232, 52, 73, 85,
+			181, 15, 239, 192, 212, 201, 7, 170, 33, 197, 25, 216, 115, 175,
+			166, 133, 20, 95, 17, 37, 65, 222, 129, 229, 164, 239, 24, 75,
+			136, 222, 36, 233, 151, 66, 200, 129, 138, 234, 192, 212, 169, 7,
+			215, 125, 123, 9, 43, 239, 187, 189, 180, 58, 191, 80, 185, 51,
+			61, 87, 145, 197, 233, 33, 98, 55, 156, 151, 183, 58, 119, 103,
+			76, 162, 69, 178, 183, 13, 161, 16, 221, 186, 11, 115, 12, 185,
+			246, 38, 115, 13, 196, 95, 231, 32, 255, 67, 210, 213, 33, 98,
+			131, 249, 185, 115, 15, 197, 36, 58, 66, 250, 241, 193, 159, 213,
+			128, 215, 156, 106, 212, 185, 115, 100, 241, 83, 5, 191, 208, 167,
+			72, 31, 76, 156, 7, 195, 67, 37, 109, 96, 106, 252, 193, 40,
+			144, 83, 172, 10, 85, 226, 242, 244, 22, 233, 21, 30, 74, 225,
+			240, 16, 179, 70, 6, 166, 138, 15, 83, 213, 50, 22, 1, 188,
+			86, 84, 113, 250, 12, 201, 73, 163, 235, 170, 84, 104, 133, 123,
+			109, 118, 106, 236, 193, 85, 74, 155, 237, 172, 40, 84, 217, 203,
+			59, 224, 206, 117, 177, 255, 239, 196, 186, 200, 63, 79, 6, 58,
+			123, 157, 180, 77, 91, 15, 105, 155, 222, 57, 40, 67, 97, 130,
+			164, 112, 57, 80, 66, 228, 130, 200, 245, 208, 12, 177, 103, 22,
+			42, 179, 57, 3, 56, 177, 72, 93, 93, 44, 151, 102, 74, 57,
+			179, 240, 8, 73, 11, 26, 7, 166, 173, 169, 60, 215, 35, 65,
+			89, 135, 161, 190, 174, 220, 185, 94, 170, 228, 204, 194, 10, 217,
+			219, 69, 23, 116, 63, 25, 172, 148, 150, 75, 243, 160, 150, 174,
+			174, 204, 63, 53, 191, 240, 204, 124, 174, 167, 51, 89, 237, 0,
+			6, 221, 71, 114, 113, 242, 210, 194, 74, 5, 123, 243, 1, 147,
+			228, 186, 137, 132, 30, 36, 67, 203, 211, 149, 155, 165, 229, 85,
+			212, 137, 227, 170, 247, 145, 92, 242, 3, 132, 60, 207, 25, 244,
+			56, 57, 156, 76, 45, 61, 187, 92, 154, 95, 130, 86, 42, 211,
+			243, 55, 97, 59, 234, 170, 79, 41, 247, 22, 116, 53, 249, 225,
+			70, 185, 52, 55, 155, 179, 187, 147, 23, 230, 75, 11, 55, 114,
+			169, 238, 214, 81, 225, 79, 211, 60, 57, 208, 157, 186, 90, 154,
+			95, 174, 60, 151, 235, 237, 110, 88, 62, 31, 146, 203, 208, 3,
+			132, 38, 63, 220, 41, 45, 223, 90, 152, 205, 245, 237, 196, 65,
+			105, 110, 168, 240, 207, 13, 210, 159, 84, 190, 59, 136, 220, 248,
+			187, 198, 252, 11, 159, 55, 73, 54, 161, 133, 131, 145, 202, 1,
+			167, 137, 85, 167, 225, 58, 161, 228, 207, 34, 168, 227, 52, 164,
+			60, 44, 63, 124, 248, 173, 52, 253, 134, 183, 210, 222, 191, 131,
+			91, 105, 42, 151, 46, 124, 159, 73, 114, 221, 122, 121, 23, 222,
+			140, 221, 240, 150, 28, 159, 249, 122, 198, 215, 189, 203, 88, 187,
+			238, 50, 255, 85, 232, 234, 127, 51, 200, 128, 212, 76, 20, 6,
+			146, 67, 43, 188, 158, 161, 117, 162, 238, 196, 110, 168, 251, 175,
+			50, 174, 127, 100, 145, 61, 29, 230, 129, 135, 237, 221, 59, 201,
+			160, 91, 227, 205, 150, 31, 193, 225, 244, 106, 3, 222, 25, 71,
+			52, 12, 76, 77, 60, 216, 0, 81, 44, 199, 229, 230, 160, 216,
+			213, 161, 242, 108, 233, 206, 226, 194, 114, 105, 126, 230, 57, 197,
+			205, 43, 185, 68, 245, 152, 173, 99, 173, 156, 124, 61, 8, 255,
+			91, 195, 100, 97, 145, 228, 186, 71, 3, 156, 119, 135, 241, 228,
+			122, 232, 16, 217, 59, 191, 176, 186, 84, 158, 45, 173, 150, 110,
+			220, 40, 205, 44, 47, 9, 91, 180, 206, 189, 156, 51, 147, 115,
+			243, 19, 22, 25, 218, 161, 39, 116, 90, 90, 145, 132, 97, 107,
+			252, 97, 122, 95, 4, 5, 112, 209, 9, 34, 105, 116, 26, 37,
+			57, 25, 96, 192, 229, 129, 180, 241, 91, 104, 227, 151, 65, 125,
+			234, 46, 15, 112, 189, 211, 49, 66, 91, 126, 232, 70, 238, 61,
+			190, 234, 122, 234, 64, 0, 76, 77, 118, 37, 167, 190, 148, 189,
+			72, 231, 86, 161, 137, 19, 185, 65, 110, 181, 42, 57, 245, 69,
+			231, 62, 65, 250, 107, 126, 27, 20, 119, 81, 43, 240, 78, 163,
+			146, 21, 105, 58, 139, 180, 172, 196, 39, 17, 253, 149, 172, 72,
+			19, 89, 206, 146, 189, 206, 250, 122, 0, 149, 171, 138, 132, 173,
+			104, 64, 39, 99, 198, 252, 109, 146, 81, 120, 128, 195, 9, 192,
+			196, 106, 75, 24, 64, 77, 56, 156, 240, 212, 199, 19, 164, 223,
+			13, 87, 245, 177, 240, 176, 201, 204, 145, 76, 37, 235, 134, 250,
+			224, 172, 240, 1, 66, 72, 76, 108, 244, 135, 12, 50, 32, 118,
+			2, 184, 47, 1, 65, 208, 135, 141, 221, 140, 57, 186, 148, 144,
+			46, 23, 101, 129, 235, 87, 222, 111, 24, 31, 54, 236, 15, 27,
+			198, 71, 141, 61, 52, 83, 122, 118, 113, 174, 60, 83, 94, 30,
+			254, 74, 47, 194, 248, 108, 26, 192, 95, 237, 237, 252, 254, 181,
+			222, 202, 158, 122, 178, 38, 90, 79, 30, 175, 155, 187, 233, 30,
+			113, 63, 74, 242, 80, 253, 250, 73, 236, 66, 26, 187, 144, 165,
+			233, 153, 185, 133, 165, 210, 44, 118, 160, 143, 218, 11, 139, 165,
+			249, 225, 175, 246, 38, 206, 224, 127, 204, 32, 7, 213, 225, 155,
+			220, 8, 49, 180, 61, 216, 5, 129, 156, 6, 166, 46, 60, 168,
+			217, 138, 44, 138, 104, 40, 201, 130, 215, 207, 110, 67, 195, 244,
+			252, 172, 236, 69, 150, 166, 23, 167, 103, 158, 42, 205, 66, 63,
+			246, 7, 59, 149, 167, 247, 201, 94, 48, 191, 1, 37, 184, 53,
+			60, 152, 28, 182, 119, 59, 64, 139, 251, 2, 246, 184, 167, 117,
+			9, 137, 8, 49, 23, 125, 212, 158, 95, 152, 47, 169, 14, 224,
+			137, 232, 115, 208, 129, 129, 118, 71, 33, 122, 159, 228, 148, 253,
+			64, 163, 33, 181, 219, 233, 95, 220, 180, 180, 66, 104, 4, 176,
+			68, 219, 251, 232, 222, 185, 210, 252, 205, 229, 91, 171, 139, 149,
+			18, 30, 226, 12, 127, 165, 183, 178, 183, 217, 89, 132, 254, 61,
+			146, 21, 122, 61, 154, 34, 164, 186, 121, 230, 65, 227, 69, 89,
+			4, 115, 95, 191, 136, 237, 89, 106, 222, 15, 82, 58, 87, 186,
+			57, 61, 243, 220, 234, 245, 210, 210, 50, 176, 170, 133, 138, 32,
+			66, 66, 83, 211, 115, 115, 11, 207, 192, 216, 201, 75, 186, 130,
+			194, 219, 201, 158, 14, 74, 6, 193, 20, 5, 90, 232, 244, 18,
+			60, 2, 151, 224, 130, 253, 68, 83, 110, 206, 160, 253, 68, 211,
+			117, 206, 4, 14, 41, 155, 214, 39, 73, 86, 225, 81, 146, 81,
+			244, 9, 226, 49, 74, 185, 93, 194, 121, 134, 32, 113, 230, 12,
+			80, 69, 4, 209, 230, 204, 194, 211, 100, 255, 142, 20, 70, 79,
+			146, 227, 234, 244, 106, 85, 244, 179, 52, 63, 179, 48, 91, 158,
+			191, 153, 168, 147, 16, 73, 106, 162, 151, 138, 12, 115, 102, 97,
+			134, 12, 116, 82, 11, 61, 76, 14, 174, 44, 223, 120, 108, 245,
+			233, 233, 185, 242, 236, 116, 151, 82, 66, 136, 36, 153, 156, 9,
+			218, 17, 144, 82, 206, 42, 44, 145, 189, 93, 243, 78, 143, 144,
+			97, 169, 31, 236, 212, 159, 33, 210, 77, 9, 194, 248, 53, 91,
+			146, 47, 9, 230, 204, 194, 45, 66, 226, 121, 133, 141, 8, 94,
+			188, 88, 189, 1, 106, 214, 114, 162, 170, 62, 34, 230, 49, 103,
+			128, 54, 176, 125, 178, 115, 230, 185, 52, 108, 67, 95, 239, 61,
+			151, 206, 124, 189, 55, 247, 42, 252, 125, 181, 55, 247, 39, 240,
+			247, 135, 230, 115, 63, 50, 127, 46, 157, 249, 145, 249, 220, 143,
+			206, 223, 78, 103, 190, 218, 155, 251, 90, 111, 225, 59, 38, 161,
+			49, 117, 105, 141, 248, 89, 146, 209, 42, 182, 240, 214, 123, 252,
+			1, 68, 169, 138, 37, 146, 58, 149, 215, 138, 174, 141, 78, 147,
+			189, 77, 215, 131, 43, 47, 171, 74, 157, 181, 95, 67, 157, 29,
+			144, 5, 36, 140, 85, 56, 247, 59, 170, 72, 189, 102, 21, 206,
+			253, 68, 21, 249, 247, 25, 100, 120, 183, 206, 190, 33, 77, 251,
+			141, 202, 203, 133, 159, 53, 201, 64, 167, 183, 26, 157, 37, 25,
+			120, 14, 80, 134, 198, 3, 228, 143, 188, 134, 131, 91, 113, 78,
+			230, 175, 232, 146, 249, 207, 26, 36, 163, 146, 233, 1, 98, 99,
+			0, 63, 152, 203, 212, 117, 51, 103, 84, 16, 134, 244, 176, 229,
+			120, 195, 102, 156, 14, 48, 24, 208, 27, 220, 1, 174, 182, 42,
+			47, 161, 135, 210, 209, 99, 175, 76, 159, 145, 201, 224, 52, 25,
+			5, 142, 219, 232, 200, 107, 99, 222, 156, 250, 160, 51, 95, 37,
+			135, 84, 189, 53, 46, 66, 125, 196, 133, 192, 175, 173, 175, 114,
+			80, 102, 152, 149, 223, 85, 217, 194, 231, 76, 50, 168, 142, 114,
+			106, 26, 89, 119, 8, 113, 60, 207, 143, 146, 232, 218, 46, 90,
+			109, 43, 87, 156, 214, 133, 42, 137, 10, 242, 127, 2, 113, 239,
+			53, 184, 43, 222, 142, 147, 172, 244, 69, 132, 35, 43, 105, 94,
+			33, 34, 233, 134, 219, 224, 96, 121, 89, 227, 235, 174, 39, 157,
+			75, 4, 160, 206, 104, 109, 125, 70, 75, 43, 36, 19, 242, 166,
+			227, 69, 110, 85, 18, 241, 229, 215, 213, 249, 226, 146, 44, 93,
+			209, 245, 20, 70, 72, 70, 165, 106, 198, 213, 67, 123, 137, 181,
+			84, 90, 206, 25, 96, 131, 159, 158, 43, 79, 47, 229, 204, 115,
+			63, 102, 146, 94, 73, 202, 192, 195, 75, 226, 70, 105, 7, 243,
+			26, 80, 137, 139, 149, 133, 229, 133, 169, 220, 87, 122, 183, 37,
+			94, 204, 125, 181, 151, 14, 146, 126, 149, 56, 53, 57, 117, 49,
+			247, 181, 238, 164, 75, 185, 175, 163, 117, 67, 37, 93, 88, 93,
+			6, 206, 181, 48, 63, 247, 92, 206, 72, 126, 152, 74, 124, 48,
+			233, 81, 114, 80, 125, 184, 114, 229, 202, 149, 71, 19, 31, 255,
+			241, 15, 167, 187, 63, 63, 150, 248, 252, 145, 237, 159, 175, 36,
+			62, 255, 147, 31, 78, 195, 85, 37, 245, 249, 206, 244, 179, 185,
+			239, 126, 247, 187, 223, 237, 189, 254, 189, 59, 63, 177, 157, 235,
+			58, 20, 14, 111, 25, 207, 143, 203, 76, 187, 63, 178, 29, 159,
+			38, 116, 188, 175, 157, 239, 126, 95, 187, 194, 235, 13, 142, 239,
+			134, 220, 254, 179, 127, 97, 193, 227, 218, 103, 122, 254, 117, 159,
+			65, 62, 190, 23, 47, 101, 159, 121, 243, 113, 237, 55, 31, 215,
+			126, 243, 113, 237, 55, 31, 215, 126, 243, 113, 237, 55, 31, 215,
+			126, 227, 143, 107, 79, 253, 129, 41, 3, 120, 92, 101, 119, 185,
+			23, 249, 222, 91, 99, 198, 206, 70, 158, 194, 36, 246, 180, 19,
+			212, 156, 81, 194, 216, 117, 245, 56, 135, 31, 184, 235, 174, 231,
+			52, 182, 111, 64, 53, 14, 151, 11, 241, 97, 63, 182, 228, 120,
+			47, 57, 91, 236, 230, 6, 111, 58, 155, 240, 68, 228, 109, 94,
+			175, 179, 89, 238, 120, 99, 241, 155, 124, 161, 122, 27, 89, 170,
+			194, 50, 124, 154, 10, 252, 161, 66, 203, 169, 128, 178, 174, 39,
+			25, 92, 221, 111, 123, 24, 143, 76, 236, 200, 152, 59, 44, 194,
+			2, 64, 123, 65, 50, 89, 189, 53, 23, 5, 142, 23, 138, 88,
+			101, 42, 166, 26, 176, 25, 135, 237, 112, 147, 136, 104, 46, 226,
+			120, 91, 146, 39, 38, 3, 131, 142, 96, 204, 61, 149, 7, 226,
+			244, 1, 143, 133, 96, 164, 110, 179, 229, 7, 17, 70, 56, 21,
+			161, 47, 70, 244, 211, 229, 231, 122, 102, 85, 232, 11, 248, 41,
+			18, 207, 199, 175, 148, 159, 215, 175, 148, 143, 245, 92, 80, 175,
+			148, 195, 79, 145, 56, 222, 243, 168, 122, 123, 28, 126, 138, 196,
+			98, 252, 74, 121, 81, 191, 82, 62, 17, 191, 145, 62, 161, 223,
+			72, 191, 216, 115, 132, 252, 125, 98, 102, 250, 240, 103, 62, 98,
+			221, 183, 182, 196, 238, 183, 198, 153, 242, 66, 18, 175, 98, 135,
+			45, 14, 177, 38, 120, 213, 129, 45, 60, 208, 130, 201, 248, 26,
+			144, 3, 188, 181, 177, 238, 7, 110, 180, 209, 12, 89, 205, 135,
+			80, 111, 24, 48, 184, 214, 6, 195, 30, 91, 243, 253, 40, 140,
+			2, 241, 106, 104, 145, 144, 151, 68, 96, 140, 71, 123, 174, 24,
+			249, 119, 96, 192, 3, 37, 62, 192, 61, 245, 150, 219, 192, 167,
+			234, 48, 132, 91, 171, 29, 109, 155, 155, 37, 174, 3, 181, 170,
+			135, 34, 139, 50, 172, 48, 18, 0, 115, 33, 10, 67, 16, 114,
+			25, 4, 4, 222, 175, 179, 30, 205, 28, 194, 91, 111, 24, 255,
+			226, 49, 115, 68, 6, 67, 128, 112, 3, 143, 201, 235, 128, 226,
+			97, 148, 199, 178, 199, 18, 15, 163, 60, 118, 252, 164, 130, 224,
+			29, 136, 51, 103, 201, 56, 49, 83, 61, 212, 126, 188, 231, 57,
+			3, 66, 14, 170, 72, 193, 33, 199, 221, 247, 174, 231, 111, 122,
+			76, 42, 128, 178, 253, 20, 84, 244, 120, 170, 31, 110, 18, 166,
+			176, 253, 39, 205, 3, 249, 113, 120, 53, 179, 225, 84, 249, 134,
+			223, 128, 128, 41, 128, 101, 199, 99, 109, 175, 163, 10, 241, 24,
+			156, 184, 73, 8, 101, 13, 106, 61, 41, 46, 194, 3, 100, 82,
+			235, 201, 125, 251, 201, 247, 155, 88, 177, 65, 173, 89, 243, 96,
+			254, 219, 6, 19, 39, 84, 76, 92, 96, 98, 5, 213, 157, 66,
+			145, 177, 229, 13, 30, 66, 132, 74, 62, 14, 15, 6, 234, 158,
+			142, 177, 181, 54, 76, 59, 190, 73, 215, 108, 87, 55, 88, 195,
+			189, 43, 119, 121, 215, 171, 70, 58, 163, 174, 162, 234, 192, 52,
+			39, 118, 234, 228, 123, 40, 50, 55, 96, 36, 57, 51, 162, 21,
+			169, 230, 118, 172, 100, 164, 186, 176, 221, 106, 53, 182, 96, 127,
+			143, 252, 169, 9, 249, 206, 167, 50, 18, 0, 25, 146, 196, 243,
+			240, 64, 41, 78, 228, 174, 185, 13, 55, 218, 210, 8, 130, 216,
+			3, 179, 102, 78, 65, 38, 181, 102, 135, 240, 194, 114, 10, 67,
+			133, 148, 204, 131, 242, 19, 68, 27, 40, 233, 140, 248, 109, 232,
+			0, 249, 69, 3, 49, 105, 81, 235, 182, 121, 32, 255, 211, 6,
+			147, 138, 16, 4, 144, 112, 34, 134, 216, 89, 227, 220, 131, 167,
+			242, 57, 80, 190, 64, 71, 44, 101, 200, 71, 255, 64, 2, 113,
+			130, 53, 55, 130, 247, 40, 129, 207, 17, 245, 70, 41, 196, 50,
+			94, 3, 150, 134, 87, 1, 33, 158, 184, 64, 74, 180, 193, 183,
+			68, 180, 99, 249, 88, 42, 176, 59, 183, 201, 199, 241, 77, 36,
+			185, 10, 185, 19, 110, 129, 184, 212, 108, 57, 129, 27, 98, 56,
+			68, 49, 26, 8, 130, 112, 219, 28, 144, 163, 177, 76, 106, 221,
+			30, 220, 47, 135, 109, 83, 235, 41, 243, 128, 28, 54, 132, 61,
+			120, 74, 103, 132, 192, 188, 79, 13, 238, 39, 175, 96, 70, 188,
+			249, 157, 207, 183, 216, 98, 130, 44, 213, 188, 99, 251, 16, 46,
+			22, 150, 156, 154, 193, 228, 251, 135, 146, 44, 58, 134, 73, 4,
+			113, 248, 1, 224, 107, 75, 110, 27, 237, 40, 116, 107, 24, 81,
+			3, 170, 147, 177, 39, 160, 121, 184, 107, 110, 238, 87, 144, 73,
+			173, 133, 225, 67, 114, 12, 105, 184, 93, 158, 151, 159, 210, 112,
+			187, 92, 103, 76, 155, 212, 90, 212, 25, 123, 169, 245, 61, 226,
+			110, 51, 0, 6, 64, 135, 20, 100, 82, 235, 123, 142, 156, 144,
+			25, 51, 212, 170, 232, 140, 25, 3, 32, 149, 49, 99, 82, 171,
+			162, 51, 246, 193, 189, 115, 149, 17, 34, 31, 44, 233, 140, 125,
+			38, 181, 150, 142, 156, 32, 255, 163, 160, 26, 2, 23, 176, 15,
+			231, 63, 110, 116, 32, 80, 114, 207, 170, 91, 223, 2, 212, 181,
+			189, 53, 216, 180, 120, 77, 175, 17, 160, 122, 63, 136, 16, 131,
+			32, 193, 10, 4, 250, 94, 99, 139, 64, 56, 205, 64, 175, 177,
+			181, 45, 214, 106, 180, 215, 33, 216, 57, 82, 99, 21, 130, 89,
+			222, 111, 201, 151, 97, 61, 204, 43, 3, 83, 225, 134, 37, 227,
+			34, 50, 96, 140, 178, 17, 230, 48, 143, 111, 170, 166, 53, 234,
+			33, 50, 195, 179, 230, 30, 5, 153, 212, 122, 54, 151, 39, 19,
+			34, 64, 204, 219, 123, 190, 215, 200, 159, 100, 179, 114, 19, 134,
+			128, 69, 24, 127, 139, 71, 138, 239, 170, 71, 159, 84, 208, 151,
+			183, 103, 14, 99, 12, 32, 124, 233, 232, 5, 243, 112, 97, 12,
+			115, 160, 212, 15, 161, 185, 27, 120, 112, 4, 219, 46, 134, 199,
+			141, 213, 175, 40, 224, 92, 135, 126, 73, 220, 246, 22, 143, 33,
+			169, 219, 222, 226, 49, 164, 23, 232, 129, 196, 99, 72, 47, 28,
+			202, 147, 115, 42, 14, 204, 59, 204, 99, 133, 163, 50, 4, 115,
+			221, 247, 11, 99, 172, 80, 247, 253, 226, 154, 19, 20, 198, 24,
+			143, 170, 242, 98, 182, 97, 26, 137, 203, 224, 34, 70, 139, 186,
+			12, 46, 98, 180, 188, 67, 134, 90, 16, 49, 90, 222, 113, 228,
+			40, 6, 209, 48, 128, 91, 56, 230, 137, 252, 89, 54, 175, 244,
+			24, 185, 241, 224, 182, 47, 166, 74, 139, 47, 186, 53, 211, 134,
+			98, 26, 130, 75, 225, 186, 53, 232, 182, 67, 143, 40, 8, 46,
+			133, 31, 103, 228, 123, 84, 192, 237, 170, 57, 146, 159, 101, 120,
+			19, 39, 142, 21, 45, 110, 31, 199, 141, 202, 62, 72, 181, 78,
+			223, 53, 22, 154, 33, 42, 143, 186, 43, 150, 13, 117, 106, 8,
+			110, 140, 103, 115, 10, 130, 27, 227, 131, 5, 5, 193, 141, 241,
+			211, 103, 201, 203, 216, 21, 12, 95, 123, 38, 223, 236, 238, 202,
+			38, 119, 238, 62, 92, 71, 138, 226, 93, 101, 161, 20, 142, 227,
+			217, 38, 200, 144, 77, 119, 61, 16, 66, 21, 16, 126, 145, 205,
+			250, 42, 0, 156, 238, 51, 68, 31, 174, 235, 62, 67, 12, 216,
+			186, 238, 51, 176, 181, 250, 32, 83, 144, 69, 173, 250, 201, 211,
+			228, 50, 246, 57, 69, 45, 215, 28, 203, 143, 162, 93, 35, 242,
+			91, 227, 120, 188, 221, 177, 251, 36, 165, 77, 221, 94, 202, 134,
+			130, 26, 74, 83, 203, 205, 230, 21, 100, 80, 203, 61, 124, 86,
+			65, 22, 181, 220, 115, 231, 129, 97, 216, 134, 153, 166, 214, 75,
+			230, 184, 252, 148, 182, 1, 82, 149, 164, 225, 155, 148, 47, 12,
+			228, 99, 47, 29, 31, 81, 144, 69, 173, 151, 206, 143, 201, 74,
+			122, 33, 186, 111, 81, 126, 234, 181, 1, 82, 149, 244, 166, 169,
+			117, 55, 123, 66, 65, 6, 181, 238, 22, 70, 21, 100, 81, 235,
+			238, 216, 184, 172, 36, 67, 173, 134, 174, 36, 99, 3, 164, 42,
+			201, 164, 169, 213, 200, 170, 80, 74, 192, 255, 26, 76, 85, 2,
+			1, 99, 26, 186, 146, 62, 106, 121, 50, 54, 133, 97, 246, 217,
+			0, 169, 74, 250, 210, 212, 242, 178, 106, 25, 2, 111, 244, 14,
+			170, 193, 65, 84, 24, 239, 68, 129, 124, 219, 192, 90, 8, 181,
+			34, 115, 34, 255, 21, 67, 48, 57, 60, 94, 84, 66, 92, 200,
+			212, 229, 175, 142, 215, 248, 226, 48, 215, 90, 211, 72, 88, 105,
+			18, 113, 254, 66, 167, 206, 27, 91, 44, 192, 88, 143, 98, 34,
+			193, 205, 48, 224, 178, 25, 37, 160, 111, 56, 1, 92, 124, 96,
+			65, 219, 131, 167, 0, 8, 171, 183, 61, 249, 204, 58, 196, 39,
+			147, 164, 28, 11, 196, 33, 27, 31, 199, 164, 100, 175, 220, 144,
+			121, 156, 131, 213, 9, 8, 21, 117, 28, 105, 17, 3, 123, 53,
+			139, 124, 191, 161, 194, 41, 25, 38, 177, 97, 216, 26, 74, 83,
+			43, 202, 42, 142, 2, 60, 55, 202, 159, 83, 144, 69, 173, 104,
+			188, 72, 126, 70, 160, 43, 75, 173, 151, 205, 99, 249, 31, 7,
+			116, 113, 37, 199, 201, 14, 38, 89, 174, 248, 220, 110, 201, 133,
+			151, 144, 62, 10, 152, 109, 10, 120, 31, 254, 186, 88, 16, 250,
+			86, 66, 24, 36, 132, 149, 235, 236, 69, 153, 240, 34, 115, 245,
+			3, 132, 210, 164, 132, 213, 105, 133, 160, 163, 164, 232, 53, 4,
+			234, 121, 89, 143, 15, 2, 245, 188, 172, 57, 26, 4, 234, 121,
+			89, 134, 199, 49, 48, 80, 207, 203, 135, 143, 202, 231, 11, 250,
+			169, 245, 138, 201, 242, 176, 227, 117, 200, 138, 221, 195, 147, 133,
+			33, 148, 207, 43, 186, 153, 254, 52, 181, 94, 201, 82, 217, 12,
+			132, 242, 121, 101, 72, 173, 75, 8, 229, 243, 202, 209, 227, 100,
+			20, 67, 105, 165, 191, 207, 232, 249, 105, 195, 200, 31, 238, 216,
+			188, 164, 142, 137, 79, 202, 23, 197, 3, 215, 166, 65, 237, 239,
+			51, 50, 32, 27, 66, 36, 157, 30, 106, 127, 191, 97, 30, 198,
+			183, 145, 77, 136, 72, 6, 32, 81, 96, 10, 190, 102, 7, 21,
+			104, 0, 72, 15, 40, 208, 2, 240, 80, 94, 214, 100, 80, 251,
+			189, 134, 121, 78, 126, 52, 108, 4, 85, 77, 70, 26, 192, 236,
+			113, 5, 98, 102, 118, 90, 129, 22, 128, 35, 163, 178, 38, 147,
+			218, 63, 96, 152, 69, 249, 209, 180, 17, 84, 53, 153, 105, 0,
+			117, 77, 48, 156, 31, 48, 216, 168, 2, 45, 0, 199, 198, 101,
+			77, 22, 181, 223, 103, 152, 231, 229, 71, 203, 70, 80, 213, 100,
+			165, 1, 204, 230, 21, 104, 0, 120, 248, 140, 2, 177, 236, 232,
+			57, 89, 147, 141, 239, 108, 143, 203, 143, 182, 0, 85, 77, 118,
+			26, 192, 236, 49, 5, 226, 43, 220, 199, 71, 20, 104, 1, 120,
+			126, 140, 12, 16, 219, 134, 167, 80, 211, 255, 192, 48, 255, 161,
+			97, 137, 207, 22, 188, 56, 254, 15, 12, 50, 76, 142, 144, 52,
+			128, 240, 228, 248, 15, 25, 246, 209, 66, 63, 43, 39, 158, 182,
+			222, 75, 122, 197, 87, 27, 63, 247, 199, 9, 41, 72, 216, 67,
+			227, 4, 3, 18, 134, 134, 227, 4, 11, 18, 14, 31, 209, 45,
+			24, 212, 254, 128, 97, 31, 46, 244, 179, 210, 253, 237, 45, 192,
+			212, 125, 32, 217, 2, 188, 167, 253, 129, 100, 11, 240, 162, 246,
+			7, 140, 161, 3, 113, 130, 5, 9, 135, 242, 100, 64, 182, 96,
+			82, 251, 131, 134, 61, 161, 51, 192, 36, 126, 48, 89, 39, 76,
+			227, 7, 141, 61, 133, 56, 1, 95, 233, 62, 121, 46, 78, 192,
+			119, 186, 199, 139, 114, 2, 82, 212, 254, 145, 152, 40, 82, 54,
+			130, 106, 2, 82, 105, 0, 179, 135, 20, 104, 0, 152, 87, 68,
+			145, 178, 0, 212, 68, 145, 166, 246, 143, 25, 230, 132, 252, 152,
+			182, 17, 84, 53, 165, 241, 171, 38, 175, 180, 1, 32, 83, 84,
+			157, 182, 0, 212, 125, 234, 165, 246, 135, 12, 83, 81, 76, 175,
+			141, 160, 170, 169, 55, 13, 160, 238, 83, 175, 1, 96, 254, 132,
+			2, 45, 0, 79, 157, 38, 255, 28, 184, 32, 16, 95, 250, 31,
+			25, 230, 79, 25, 86, 254, 39, 13, 86, 1, 97, 22, 228, 13,
+			117, 38, 193, 34, 103, 93, 70, 72, 12, 139, 172, 178, 67, 170,
+			142, 35, 27, 75, 208, 96, 34, 192, 128, 120, 160, 77, 41, 255,
+			31, 22, 64, 221, 90, 98, 9, 157, 166, 182, 68, 37, 42, 150,
+			153, 154, 206, 22, 158, 0, 48, 136, 134, 218, 112, 90, 242, 133,
+			117, 211, 2, 2, 248, 71, 6, 57, 40, 41, 10, 132, 95, 251,
+			39, 118, 163, 89, 148, 111, 237, 159, 136, 103, 31, 69, 92, 251,
+			39, 98, 138, 66, 41, 215, 254, 137, 152, 102, 81, 208, 181, 127,
+			34, 166, 89, 136, 121, 104, 255, 228, 110, 52, 139, 210, 173, 253,
+			147, 201, 22, 128, 102, 127, 50, 217, 2, 116, 249, 39, 99, 154,
+			69, 49, 215, 254, 201, 152, 125, 101, 168, 253, 143, 13, 115, 76,
+			78, 79, 198, 70, 80, 205, 101, 38, 13, 96, 118, 88, 129, 6,
+			128, 135, 206, 42, 208, 2, 240, 220, 121, 8, 131, 10, 85, 245,
+			81, 251, 159, 26, 230, 233, 124, 59, 70, 40, 206, 4, 42, 5,
+			58, 186, 233, 246, 9, 83, 243, 181, 211, 220, 16, 54, 205, 214,
+			93, 120, 113, 0, 42, 193, 217, 22, 155, 50, 143, 137, 196, 247,
+			170, 234, 17, 124, 19, 196, 23, 251, 159, 198, 35, 232, 75, 65,
+			167, 52, 43, 239, 51, 0, 164, 138, 204, 251, 44, 0, 11, 167,
+			72, 63, 190, 140, 146, 254, 103, 70, 207, 127, 48, 12, 220, 47,
+			44, 131, 218, 255, 204, 200, 28, 37, 11, 242, 137, 19, 251, 103,
+			12, 243, 106, 126, 26, 247, 51, 52, 61, 5, 226, 141, 100, 37,
+			218, 160, 77, 170, 230, 243, 16, 108, 38, 1, 175, 250, 235, 158,
+			251, 50, 103, 16, 58, 178, 200, 150, 184, 60, 219, 145, 253, 180,
+			144, 54, 126, 70, 245, 211, 2, 179, 148, 253, 51, 138, 149, 90,
+			72, 23, 63, 99, 28, 191, 168, 64, 139, 218, 63, 99, 92, 190,
+			130, 172, 212, 2, 86, 250, 179, 134, 249, 175, 36, 43, 181, 144,
+			149, 254, 172, 65, 246, 147, 167, 72, 218, 182, 4, 43, 253, 57,
+			195, 62, 150, 191, 134, 189, 141, 23, 129, 12, 54, 90, 195, 24,
+			16, 242, 168, 71, 98, 189, 107, 165, 72, 26, 179, 36, 231, 253,
+			57, 69, 99, 150, 228, 188, 63, 167, 104, 204, 146, 156, 247, 231,
+			140, 161, 67, 113, 130, 5, 9, 71, 142, 146, 151, 101, 135, 12,
+			106, 255, 188, 97, 23, 242, 47, 105, 107, 218, 214, 56, 220, 202,
+			4, 127, 198, 154, 62, 70, 234, 236, 7, 82, 69, 17, 10, 4,
+			242, 192, 14, 158, 161, 98, 210, 77, 128, 176, 154, 143, 129, 162,
+			235, 129, 239, 69, 170, 52, 68, 169, 192, 234, 18, 253, 135, 53,
+			242, 243, 201, 254, 195, 26, 249, 121, 99, 207, 80, 156, 128, 221,
+			219, 119, 52, 78, 176, 32, 129, 157, 32, 63, 109, 200, 1, 152,
+			212, 254, 37, 195, 62, 154, 255, 17, 99, 199, 17, 128, 160, 241,
+			224, 97, 172, 120, 194, 218, 166, 110, 248, 21, 161, 200, 24, 75,
+			132, 227, 192, 20, 49, 208, 13, 231, 94, 98, 168, 56, 82, 176,
+			49, 200, 69, 17, 18, 20, 243, 192, 171, 47, 76, 140, 19, 246,
+			154, 95, 74, 142, 211, 76, 65, 66, 98, 156, 192, 76, 126, 201,
+			216, 55, 28, 39, 88, 144, 112, 248, 8, 249, 172, 26, 167, 69,
+			237, 127, 105, 216, 199, 243, 191, 102, 168, 135, 186, 198, 24, 188,
+			214, 1, 78, 196, 210, 38, 1, 179, 36, 9, 201, 141, 143, 143,
+			213, 234, 141, 7, 142, 116, 52, 38, 250, 10, 182, 138, 46, 148,
+			232, 115, 70, 85, 25, 90, 198, 234, 142, 219, 0, 171, 129, 52,
+			18, 195, 202, 1, 5, 193, 13, 9, 131, 199, 18, 152, 19, 19,
+			111, 119, 125, 16, 84, 155, 55, 192, 3, 56, 129, 20, 144, 125,
+			254, 101, 18, 41, 86, 10, 18, 246, 12, 198, 9, 6, 36, 208,
+			124, 156, 128, 56, 56, 122, 140, 252, 3, 133, 20, 155, 218, 191,
+			10, 72, 121, 249, 129, 56, 137, 251, 163, 136, 21, 245, 79, 241,
+			108, 140, 114, 125, 44, 18, 182, 0, 135, 12, 155, 110, 200, 95,
+			187, 148, 82, 154, 18, 3, 2, 17, 236, 87, 147, 3, 178, 83,
+			144, 144, 24, 16, 24, 116, 126, 53, 57, 32, 219, 130, 132, 163,
+			199, 200, 41, 193, 45, 250, 168, 253, 107, 134, 189, 183, 176, 95,
+			234, 83, 128, 186, 85, 213, 65, 130, 178, 140, 101, 245, 244, 245,
+			64, 182, 61, 3, 170, 154, 190, 30, 163, 59, 193, 148, 9, 255,
+			189, 33, 31, 113, 178, 127, 211, 48, 111, 228, 63, 106, 160, 254,
+			15, 248, 8, 60, 167, 1, 108, 62, 8, 175, 178, 217, 5, 54,
+			191, 176, 204, 86, 150, 74, 69, 246, 12, 71, 13, 70, 210, 76,
+			43, 240, 225, 22, 37, 44, 98, 191, 197, 165, 239, 0, 174, 241,
+			24, 59, 137, 120, 59, 72, 81, 252, 62, 175, 182, 35, 88, 29,
+			218, 192, 80, 109, 112, 199, 131, 215, 126, 214, 120, 29, 130, 244,
+			186, 145, 60, 17, 146, 54, 74, 213, 33, 124, 98, 71, 48, 86,
+			224, 13, 191, 25, 115, 97, 16, 215, 127, 211, 200, 30, 80, 32,
+			142, 232, 96, 65, 129, 22, 128, 167, 207, 40, 48, 3, 224, 217,
+			146, 64, 152, 105, 100, 6, 1, 30, 153, 197, 119, 213, 225, 121,
+			42, 251, 83, 134, 121, 10, 130, 112, 123, 91, 202, 156, 26, 234,
+			217, 149, 67, 215, 86, 101, 169, 34, 233, 158, 193, 106, 254, 84,
+			220, 51, 144, 27, 63, 101, 100, 247, 43, 208, 0, 240, 128, 218,
+			46, 76, 11, 192, 19, 39, 197, 123, 92, 118, 15, 77, 255, 27,
+			195, 252, 95, 12, 75, 190, 234, 158, 12, 2, 196, 66, 136, 20,
+			180, 157, 77, 41, 174, 143, 21, 194, 113, 138, 253, 111, 140, 222,
+			97, 50, 3, 99, 131, 163, 17, 106, 255, 142, 97, 239, 203, 95,
+			20, 38, 150, 100, 81, 109, 33, 194, 42, 18, 100, 12, 19, 22,
+			47, 70, 168, 196, 192, 90, 246, 198, 9, 38, 36, 208, 33, 129,
+			66, 136, 252, 77, 237, 207, 24, 182, 228, 88, 0, 139, 4, 73,
+			115, 144, 96, 66, 194, 32, 37, 31, 52, 228, 67, 90, 233, 127,
+			111, 152, 191, 107, 92, 203, 127, 239, 107, 12, 85, 14, 144, 45,
+			47, 204, 46, 92, 101, 245, 134, 219, 146, 182, 0, 60, 123, 0,
+			158, 147, 136, 55, 132, 50, 5, 198, 80, 198, 8, 224, 82, 122,
+			36, 72, 180, 240, 66, 151, 88, 158, 113, 60, 34, 61, 109, 192,
+			111, 254, 125, 60, 109, 160, 107, 253, 123, 35, 123, 68, 129, 6,
+			128, 71, 71, 21, 104, 1, 56, 54, 174, 192, 12, 181, 127, 215,
+			72, 95, 85, 96, 47, 128, 131, 135, 37, 125, 89, 64, 95, 191,
+			107, 28, 189, 66, 158, 7, 241, 36, 69, 237, 223, 51, 204, 67,
+			249, 59, 108, 6, 131, 62, 132, 72, 235, 130, 186, 88, 181, 29,
+			70, 126, 83, 178, 15, 20, 172, 186, 103, 203, 13, 181, 136, 213,
+			33, 156, 244, 3, 86, 225, 240, 235, 247, 140, 61, 66, 236, 195,
+			179, 45, 251, 247, 140, 61, 131, 10, 52, 225, 235, 254, 97, 114,
+			17, 159, 11, 75, 127, 209, 232, 249, 215, 166, 145, 63, 221, 161,
+			129, 199, 44, 30, 227, 112, 171, 182, 132, 46, 14, 28, 234, 139,
+			70, 230, 8, 138, 51, 54, 144, 235, 31, 24, 230, 255, 43, 197,
+			25, 27, 137, 239, 15, 140, 222, 44, 89, 34, 105, 91, 28, 231,
+			217, 255, 17, 136, 111, 134, 77, 118, 108, 57, 176, 39, 242, 32,
+			192, 119, 112, 217, 2, 28, 162, 192, 231, 77, 238, 6, 226, 44,
+			101, 195, 5, 9, 205, 173, 58, 248, 130, 95, 8, 71, 91, 130,
+			145, 218, 146, 24, 255, 163, 34, 70, 91, 18, 227, 127, 84, 196,
+			104, 11, 98, 252, 67, 69, 140, 182, 36, 198, 63, 84, 196, 104,
+			75, 98, 252, 67, 99, 144, 146, 77, 89, 194, 164, 246, 31, 27,
+			246, 80, 126, 29, 159, 209, 124, 222, 93, 127, 222, 89, 103, 232,
+			90, 142, 199, 72, 243, 242, 62, 133, 220, 241, 66, 22, 57, 119,
+			57, 187, 48, 201, 214, 182, 34, 30, 22, 25, 4, 167, 103, 137,
+			216, 62, 204, 173, 19, 166, 46, 97, 36, 45, 63, 32, 74, 32,
+			23, 83, 61, 129, 133, 243, 199, 201, 174, 1, 251, 249, 99, 232,
+			154, 26, 140, 69, 237, 255, 100, 216, 251, 116, 6, 144, 111, 255,
+			83, 114, 248, 150, 9, 9, 116, 72, 15, 198, 166, 246, 87, 254,
+			139, 13, 230, 226, 212, 195, 15, 6, 200, 227, 43, 201, 193, 216,
+			38, 36, 36, 6, 147, 162, 246, 87, 13, 123, 191, 206, 144, 50,
+			48, 33, 23, 39, 152, 144, 48, 180, 79, 151, 72, 83, 251, 107,
+			201, 18, 160, 224, 126, 45, 89, 34, 109, 66, 66, 162, 68, 47,
+			181, 191, 110, 216, 84, 151, 0, 69, 246, 235, 134, 189, 39, 78,
+			48, 33, 33, 55, 168, 75, 100, 168, 253, 106, 18, 197, 25, 3,
+			19, 98, 20, 103, 76, 72, 160, 67, 228, 83, 166, 44, 210, 71,
+			237, 111, 2, 101, 127, 194, 100, 203, 206, 250, 120, 141, 227, 43,
+			27, 192, 93, 212, 77, 152, 34, 97, 55, 241, 101, 54, 148, 14,
+			81, 198, 81, 119, 186, 112, 31, 4, 85, 42, 182, 14, 58, 245,
+			136, 7, 172, 203, 133, 177, 200, 110, 249, 155, 112, 116, 52, 38,
+			252, 56, 46, 18, 124, 140, 152, 107, 151, 48, 125, 34, 21, 70,
+			32, 131, 129, 152, 13, 15, 162, 69, 190, 56, 99, 79, 60, 15,
+			7, 33, 22, 164, 131, 33, 180, 78, 24, 4, 142, 137, 228, 71,
+			169, 198, 57, 161, 62, 225, 22, 41, 69, 198, 202, 158, 62, 102,
+			29, 219, 185, 58, 162, 188, 55, 68, 100, 144, 26, 190, 108, 7,
+			57, 95, 236, 190, 162, 241, 162, 218, 76, 19, 36, 3, 122, 221,
+			55, 147, 36, 211, 103, 66, 194, 224, 16, 25, 151, 152, 38, 212,
+			254, 150, 97, 31, 40, 28, 101, 115, 220, 91, 143, 54, 118, 198,
+			181, 46, 79, 12, 204, 31, 211, 7, 49, 33, 97, 104, 63, 57,
+			41, 43, 204, 82, 251, 219, 48, 117, 67, 108, 158, 111, 2, 139,
+			149, 207, 116, 177, 169, 68, 53, 89, 3, 115, 197, 253, 202, 154,
+			144, 48, 24, 51, 153, 126, 106, 255, 231, 36, 97, 246, 27, 152,
+			16, 19, 77, 191, 9, 9, 52, 38, 204, 61, 212, 254, 191, 147,
+			108, 105, 143, 129, 9, 49, 97, 238, 49, 33, 33, 23, 47, 151,
+			1, 106, 255, 63, 134, 125, 80, 103, 24, 48, 48, 97, 48, 78,
+			48, 33, 97, 223, 1, 93, 98, 47, 181, 255, 34, 89, 98, 175,
+			129, 9, 113, 137, 189, 38, 36, 236, 59, 64, 206, 202, 18, 57,
+			106, 255, 165, 97, 239, 47, 28, 4, 38, 22, 118, 176, 11, 241,
+			130, 160, 42, 153, 51, 48, 103, 60, 192, 156, 9, 9, 116, 159,
+			174, 106, 144, 218, 127, 245, 80, 85, 13, 26, 152, 51, 174, 106,
+			208, 132, 4, 196, 149, 109, 219, 182, 65, 211, 127, 109, 152, 63,
+			96, 170, 141, 5, 24, 248, 95, 27, 189, 253, 228, 28, 182, 132,
+			230, 155, 239, 26, 246, 193, 124, 126, 215, 141, 69, 53, 38, 76,
+			53, 223, 141, 25, 130, 129, 194, 203, 119, 141, 253, 10, 105, 104,
+			170, 121, 183, 169, 145, 38, 76, 47, 239, 54, 19, 37, 96, 191,
+			120, 183, 185, 255, 0, 249, 140, 33, 139, 152, 212, 126, 175, 105,
+			31, 204, 255, 170, 122, 196, 84, 190, 57, 132, 241, 4, 161, 75,
+			104, 236, 112, 192, 183, 83, 168, 88, 219, 150, 54, 44, 45, 233,
+			70, 1, 236, 64, 173, 50, 194, 220, 232, 108, 8, 103, 199, 13,
+			183, 234, 70, 194, 229, 98, 195, 93, 3, 130, 223, 105, 61, 190,
+			216, 121, 25, 78, 175, 49, 189, 44, 149, 11, 200, 186, 212, 199,
+			132, 35, 137, 235, 7, 122, 62, 12, 148, 74, 223, 155, 28, 48,
+			236, 66, 239, 133, 1, 247, 203, 71, 64, 237, 247, 153, 210, 232,
+			110, 163, 5, 228, 125, 166, 20, 149, 108, 180, 141, 189, 207, 148,
+			150, 26, 27, 209, 253, 62, 83, 26, 221, 109, 180, 40, 188, 207,
+			148, 86, 43, 120, 15, 212, 126, 191, 105, 30, 145, 31, 65, 138,
+			127, 127, 92, 19, 232, 247, 239, 55, 179, 57, 5, 98, 230, 193,
+			131, 10, 4, 179, 180, 153, 63, 44, 107, 50, 169, 253, 131, 113,
+			159, 64, 234, 254, 193, 184, 38, 144, 186, 127, 48, 174, 9, 154,
+			253, 65, 115, 80, 245, 9, 164, 238, 31, 132, 62, 225, 243, 36,
+			240, 112, 168, 253, 195, 166, 121, 40, 255, 195, 66, 119, 86, 145,
+			28, 97, 26, 67, 174, 14, 92, 224, 100, 73, 217, 191, 66, 14,
+			46, 1, 229, 58, 91, 243, 241, 133, 43, 87, 250, 243, 170, 146,
+			66, 222, 140, 203, 42, 209, 90, 60, 231, 201, 116, 16, 129, 49,
+			150, 12, 86, 0, 206, 213, 113, 164, 66, 41, 153, 218, 40, 153,
+			254, 112, 60, 52, 144, 76, 127, 216, 204, 238, 85, 160, 1, 96,
+			110, 159, 2, 113, 44, 7, 135, 201, 191, 52, 229, 155, 166, 246,
+			135, 76, 147, 229, 63, 102, 162, 106, 39, 89, 178, 54, 67, 96,
+			151, 67, 217, 75, 55, 212, 254, 191, 74, 246, 134, 207, 98, 164,
+			234, 11, 1, 61, 36, 136, 66, 97, 5, 112, 216, 217, 226, 217,
+			49, 48, 160, 185, 97, 183, 109, 165, 200, 98, 141, 121, 140, 205,
+			156, 63, 63, 14, 210, 15, 11, 171, 62, 184, 154, 17, 22, 180,
+			27, 82, 144, 80, 36, 90, 119, 165, 83, 52, 52, 43, 159, 43,
+			23, 15, 247, 96, 235, 24, 213, 88, 244, 56, 54, 128, 129, 129,
+			65, 143, 10, 145, 238, 4, 112, 75, 5, 198, 196, 189, 56, 31,
+			135, 45, 17, 143, 207, 124, 143, 193, 174, 236, 67, 6, 124, 3,
+			152, 48, 29, 209, 105, 84, 99, 29, 212, 245, 15, 197, 88, 7,
+			101, 253, 67, 49, 145, 131, 164, 243, 33, 147, 42, 234, 3, 69,
+			253, 67, 230, 177, 227, 228, 123, 229, 243, 175, 246, 135, 77, 243,
+			120, 190, 165, 213, 105, 33, 193, 63, 24, 207, 108, 141, 131, 86,
+			44, 99, 131, 130, 216, 89, 6, 180, 18, 176, 212, 248, 141, 132,
+			165, 70, 216, 192, 29, 207, 227, 1, 40, 51, 154, 234, 116, 223,
+			225, 176, 225, 195, 113, 223, 83, 41, 0, 117, 223, 65, 230, 250,
+			176, 73, 243, 10, 180, 0, 60, 122, 140, 252, 168, 41, 95, 126,
+			181, 63, 98, 154, 167, 242, 223, 47, 40, 198, 107, 55, 33, 154,
+			152, 34, 20, 125, 44, 220, 113, 246, 27, 193, 91, 60, 1, 151,
+			39, 148, 210, 87, 64, 140, 76, 250, 200, 97, 93, 107, 190, 15,
+			154, 126, 56, 198, 10, 96, 140, 41, 0, 193, 23, 240, 198, 123,
+			65, 230, 0, 207, 127, 111, 189, 187, 29, 173, 234, 65, 51, 240,
+			5, 213, 166, 17, 88, 140, 60, 172, 58, 45, 129, 26, 48, 85,
+			109, 58, 91, 163, 170, 177, 173, 104, 91, 135, 103, 116, 126, 209,
+			45, 134, 74, 49, 202, 189, 236, 201, 39, 216, 133, 169, 199, 112,
+			225, 202, 76, 26, 159, 112, 228, 242, 145, 24, 159, 233, 20, 128,
+			26, 159, 32, 145, 126, 196, 148, 166, 105, 27, 124, 5, 236, 143,
+			152, 133, 147, 240, 140, 61, 224, 179, 151, 218, 31, 53, 205, 19,
+			249, 123, 176, 138, 144, 37, 128, 89, 60, 148, 135, 215, 53, 142,
+			103, 199, 14, 243, 33, 244, 135, 154, 97, 217, 105, 32, 7, 192,
+			251, 89, 216, 78, 84, 156, 108, 120, 208, 45, 212, 30, 73, 218,
+			130, 150, 124, 53, 24, 45, 91, 88, 66, 15, 1, 206, 122, 62,
+			26, 15, 161, 55, 5, 160, 230, 143, 32, 34, 127, 212, 28, 84,
+			108, 185, 215, 2, 240, 56, 35, 127, 37, 134, 144, 161, 246, 199,
+			96, 8, 127, 98, 176, 219, 75, 11, 243, 9, 202, 85, 61, 40,
+			38, 30, 118, 18, 92, 19, 220, 108, 182, 121, 142, 22, 37, 39,
+			65, 187, 78, 0, 47, 227, 99, 86, 135, 21, 116, 252, 218, 130,
+			84, 126, 153, 175, 253, 62, 120, 163, 54, 38, 199, 132, 159, 206,
+			202, 211, 111, 112, 1, 134, 233, 19, 12, 164, 152, 228, 54, 184,
+			135, 214, 120, 173, 93, 149, 87, 16, 228, 35, 96, 188, 81, 59,
+			27, 138, 254, 175, 109, 177, 170, 15, 33, 155, 192, 22, 5, 187,
+			46, 176, 132, 170, 211, 228, 141, 25, 39, 84, 6, 21, 27, 15,
+			86, 62, 22, 35, 46, 147, 2, 80, 207, 61, 104, 10, 31, 139,
+			249, 64, 198, 2, 240, 24, 147, 91, 84, 31, 181, 63, 110, 154,
+			167, 228, 71, 56, 224, 248, 120, 92, 83, 95, 26, 192, 172, 218,
+			221, 64, 16, 254, 184, 57, 172, 168, 8, 14, 56, 62, 14, 84,
+			244, 223, 245, 225, 20, 16, 106, 127, 210, 52, 79, 231, 127, 186,
+			47, 54, 101, 42, 102, 226, 40, 57, 162, 160, 204, 142, 133, 34,
+			123, 70, 24, 93, 229, 23, 77, 38, 42, 7, 160, 8, 148, 0,
+			120, 143, 92, 122, 22, 84, 65, 140, 89, 119, 130, 90, 67, 154,
+			244, 68, 33, 121, 20, 47, 42, 236, 10, 8, 12, 173, 39, 250,
+			34, 10, 200, 205, 174, 225, 3, 245, 130, 247, 54, 82, 34, 252,
+			4, 207, 115, 167, 129, 143, 184, 249, 141, 154, 234, 91, 85, 154,
+			65, 112, 134, 117, 87, 176, 102, 140, 238, 133, 66, 93, 92, 63,
+			144, 154, 43, 23, 4, 129, 14, 8, 141, 197, 1, 60, 20, 192,
+			27, 99, 131, 195, 157, 58, 145, 97, 76, 222, 179, 233, 238, 28,
+			220, 109, 97, 161, 223, 224, 114, 213, 16, 54, 194, 157, 234, 134,
+			234, 146, 30, 159, 24, 17, 190, 34, 7, 37, 160, 33, 221, 132,
+			104, 97, 180, 200, 150, 58, 83, 66, 194, 248, 125, 112, 235, 114,
+			189, 46, 167, 21, 20, 10, 69, 151, 106, 194, 135, 74, 69, 196,
+			68, 159, 192, 233, 197, 242, 78, 149, 169, 46, 43, 103, 83, 161,
+			42, 130, 137, 171, 0, 111, 195, 203, 145, 130, 133, 164, 99, 139,
+			199, 174, 135, 99, 219, 166, 76, 157, 116, 85, 65, 1, 132, 123,
+			94, 91, 76, 93, 68, 148, 94, 137, 99, 132, 133, 46, 152, 209,
+			60, 31, 94, 184, 23, 230, 229, 174, 122, 149, 39, 44, 82, 144,
+			38, 32, 173, 176, 194, 114, 66, 157, 148, 116, 24, 220, 121, 220,
+			84, 141, 71, 112, 98, 224, 215, 225, 120, 64, 223, 75, 66, 182,
+			176, 25, 248, 17, 79, 16, 51, 108, 19, 158, 31, 161, 71, 141,
+			27, 38, 228, 218, 122, 187, 129, 196, 17, 128, 215, 230, 120, 20,
+			184, 173, 86, 167, 211, 185, 240, 241, 67, 180, 84, 125, 47, 132,
+			39, 210, 193, 215, 109, 147, 19, 60, 155, 220, 54, 166, 238, 201,
+			101, 78, 35, 244, 199, 196, 195, 233, 224, 167, 180, 190, 33, 69,
+			29, 152, 59, 37, 243, 35, 61, 119, 227, 97, 89, 174, 77, 142,
+			118, 98, 48, 93, 75, 23, 60, 199, 139, 180, 171, 190, 148, 74,
+			96, 204, 232, 177, 77, 88, 196, 27, 13, 120, 162, 221, 233, 100,
+			236, 66, 188, 17, 78, 232, 112, 122, 4, 15, 150, 66, 79, 144,
+			52, 228, 76, 168, 249, 36, 221, 131, 0, 194, 88, 4, 108, 76,
+			117, 209, 117, 168, 168, 48, 84, 170, 65, 189, 225, 172, 143, 37,
+			187, 183, 197, 156, 6, 220, 157, 216, 210, 211, 72, 226, 74, 64,
+			182, 98, 47, 118, 6, 174, 126, 81, 51, 78, 98, 35, 203, 210,
+			96, 10, 64, 45, 182, 130, 154, 254, 73, 51, 167, 216, 29, 177,
+			0, 44, 156, 34, 5, 124, 65, 62, 253, 91, 102, 207, 167, 77,
+			35, 191, 175, 195, 52, 169, 70, 3, 150, 72, 16, 98, 126, 203,
+			204, 128, 83, 38, 188, 238, 222, 67, 237, 79, 41, 101, 32, 133,
+			10, 202, 167, 84, 211, 41, 84, 80, 62, 165, 120, 118, 10, 21,
+			148, 79, 41, 5, 37, 133, 10, 202, 167, 148, 130, 2, 239, 196,
+			219, 191, 173, 120, 118, 10, 143, 233, 127, 59, 174, 9, 142, 25,
+			126, 91, 241, 236, 20, 42, 40, 191, 173, 120, 118, 10, 21, 148,
+			223, 6, 158, 125, 150, 152, 118, 154, 166, 127, 199, 236, 249, 178,
+			105, 228, 15, 37, 7, 225, 197, 98, 183, 28, 9, 136, 15, 191,
+			99, 102, 132, 90, 147, 6, 205, 246, 51, 106, 36, 105, 28, 201,
+			103, 84, 251, 105, 28, 201, 103, 212, 72, 210, 56, 146, 207, 168,
+			145, 164, 113, 36, 159, 81, 35, 73, 195, 72, 62, 107, 74, 7,
+			148, 52, 142, 228, 179, 113, 77, 48, 146, 207, 154, 217, 147, 10,
+			196, 204, 167, 70, 85, 102, 11, 64, 233, 128, 146, 6, 101, 240,
+			115, 166, 169, 242, 130, 78, 241, 185, 184, 38, 80, 181, 62, 103,
+			202, 163, 151, 52, 170, 146, 159, 51, 15, 30, 83, 160, 5, 95,
+			79, 20, 200, 47, 130, 116, 153, 134, 19, 240, 47, 152, 230, 31,
+			152, 86, 254, 35, 230, 14, 110, 35, 74, 218, 196, 253, 61, 233,
+			57, 34, 205, 142, 59, 57, 141, 64, 72, 78, 183, 203, 67, 4,
+			144, 188, 163, 123, 72, 151, 119, 8, 152, 123, 185, 58, 9, 20,
+			43, 86, 223, 170, 64, 177, 161, 235, 214, 81, 81, 85, 41, 186,
+			142, 28, 222, 137, 112, 47, 133, 210, 202, 149, 132, 133, 112, 75,
+			67, 126, 66, 134, 229, 180, 90, 129, 223, 10, 92, 39, 18, 238,
+			144, 82, 84, 134, 53, 166, 28, 34, 93, 47, 186, 56, 5, 167,
+			227, 77, 199, 245, 228, 50, 74, 163, 139, 192, 23, 76, 114, 24,
+			61, 87, 210, 112, 68, 79, 237, 223, 55, 119, 244, 92, 73, 203,
+			51, 255, 223, 55, 229, 41, 99, 90, 158, 249, 255, 190, 41, 207,
+			252, 211, 242, 204, 255, 247, 77, 233, 185, 146, 150, 103, 254, 191,
+			111, 74, 207, 21, 72, 0, 35, 191, 105, 31, 222, 165, 5, 32,
+			164, 47, 38, 91, 0, 173, 253, 139, 201, 22, 96, 89, 124, 209,
+			148, 158, 43, 105, 121, 42, 255, 69, 32, 204, 143, 131, 60, 153,
+			6, 125, 251, 143, 76, 115, 114, 103, 207, 161, 93, 73, 160, 243,
+			67, 183, 59, 10, 1, 129, 19, 166, 93, 125, 239, 38, 135, 228,
+			65, 228, 174, 164, 65, 186, 60, 135, 210, 168, 125, 255, 81, 76,
+			237, 160, 125, 255, 145, 41, 207, 133, 210, 168, 125, 255, 145, 121,
+			244, 188, 2, 113, 100, 197, 9, 210, 192, 113, 218, 212, 254, 18,
+			168, 82, 239, 136, 155, 139, 123, 184, 171, 115, 77, 192, 97, 60,
+			197, 157, 253, 103, 200, 110, 14, 52, 105, 56, 210, 177, 191, 20,
+			247, 20, 52, 214, 47, 197, 188, 2, 52, 214, 47, 41, 45, 37,
+			141, 26, 235, 151, 128, 87, 93, 32, 166, 221, 75, 211, 95, 51,
+			123, 190, 97, 118, 95, 37, 64, 76, 42, 21, 90, 178, 46, 201,
+			181, 64, 99, 248, 154, 153, 97, 200, 33, 122, 129, 40, 191, 174,
+			184, 86, 47, 114, 173, 175, 171, 158, 244, 34, 215, 250, 186, 234,
+			73, 47, 114, 173, 175, 43, 174, 213, 139, 92, 235, 235, 138, 107,
+			245, 2, 251, 120, 85, 25, 136, 122, 145, 107, 189, 26, 215, 4,
+			164, 246, 170, 82, 91, 122, 145, 107, 189, 170, 12, 68, 189, 200,
+			127, 95, 85, 6, 162, 94, 224, 90, 127, 106, 154, 35, 242, 35,
+			112, 173, 63, 141, 107, 2, 174, 245, 167, 102, 86, 245, 24, 154,
+			253, 83, 243, 200, 73, 217, 44, 80, 232, 159, 154, 103, 206, 146,
+			83, 196, 180, 51, 52, 253, 77, 179, 231, 63, 155, 70, 254, 64,
+			7, 118, 100, 124, 109, 137, 16, 208, 4, 190, 105, 102, 192, 197,
+			218, 182, 51, 128, 144, 111, 41, 132, 100, 16, 33, 223, 82, 141,
+			103, 16, 33, 223, 82, 8, 201, 32, 66, 190, 165, 16, 146, 65,
+			132, 124, 75, 33, 36, 3, 61, 251, 51, 83, 250, 121, 101, 16,
+			33, 127, 22, 215, 4, 108, 252, 207, 204, 44, 83, 32, 102, 62,
+			113, 86, 101, 182, 0, 60, 119, 94, 214, 4, 22, 108, 83, 122,
+			255, 101, 208, 67, 244, 219, 113, 77, 128, 144, 111, 155, 210, 251,
+			47, 131, 108, 252, 219, 166, 244, 254, 203, 32, 66, 190, 109, 158,
+			58, 77, 38, 137, 105, 247, 209, 244, 95, 152, 61, 255, 63, 203,
+			200, 23, 58, 16, 210, 196, 224, 115, 192, 207, 187, 145, 3, 202,
+			205, 95, 152, 25, 120, 60, 207, 182, 251, 0, 57, 127, 169, 144,
+			211, 135, 200, 249, 75, 213, 145, 62, 68, 206, 95, 42, 228, 244,
+			33, 114, 254, 82, 33, 167, 15, 145, 243, 151, 128, 156, 31, 7,
+			86, 210, 7, 221, 252, 107, 80, 77, 223, 111, 176, 178, 135, 23,
+			18, 189, 154, 186, 155, 168, 125, 123, 66, 125, 3, 202, 9, 248,
+			206, 230, 148, 77, 103, 139, 57, 33, 17, 47, 8, 118, 51, 125,
+			109, 97, 17, 215, 193, 228, 245, 255, 58, 200, 172, 126, 66, 62,
+			147, 155, 186, 232, 40, 76, 212, 95, 199, 163, 2, 202, 253, 235,
+			120, 84, 64, 185, 127, 109, 210, 35, 10, 180, 0, 60, 206, 36,
+			126, 76, 106, 127, 199, 52, 11, 18, 63, 48, 81, 223, 137, 107,
+			2, 235, 210, 119, 226, 154, 0, 3, 223, 129, 168, 27, 18, 180,
+			0, 100, 39, 100, 77, 22, 181, 223, 109, 153, 167, 101, 77, 192,
+			203, 222, 109, 233, 154, 128, 151, 189, 219, 146, 78, 130, 125, 200,
+			203, 222, 109, 29, 98, 10, 196, 178, 39, 79, 145, 18, 34, 218,
+			166, 246, 123, 44, 243, 209, 252, 163, 172, 172, 226, 214, 133, 32,
+			169, 10, 237, 141, 137, 103, 10, 192, 210, 41, 2, 255, 171, 116,
+			137, 155, 80, 181, 9, 76, 234, 61, 113, 23, 128, 73, 189, 199,
+			146, 82, 97, 31, 58, 34, 191, 199, 202, 169, 46, 0, 147, 122,
+			143, 117, 242, 148, 2, 51, 0, 158, 190, 172, 192, 94, 0, 39,
+			31, 145, 29, 76, 81, 251, 7, 118, 234, 32, 80, 34, 15, 182,
+			119, 80, 166, 119, 119, 48, 5, 222, 219, 113, 7, 193, 118, 246,
+			3, 113, 7, 65, 236, 252, 129, 184, 131, 41, 11, 64, 221, 193,
+			84, 6, 64, 221, 193, 84, 47, 128, 147, 143, 144, 95, 28, 32,
+			166, 77, 104, 250, 99, 86, 207, 63, 177, 141, 169, 121, 246, 196,
+			223, 252, 31, 97, 50, 202, 35, 153, 250, 220, 30, 86, 2, 229,
+			85, 218, 18, 147, 151, 103, 208, 117, 17, 183, 74, 244, 76, 147,
+			58, 85, 88, 96, 78, 36, 130, 245, 36, 87, 6, 97, 47, 1,
+			101, 199, 193, 117, 194, 196, 230, 36, 52, 22, 56, 16, 7, 162,
+			95, 227, 90, 117, 173, 177, 176, 1, 161, 55, 26, 91, 172, 230,
+			194, 29, 115, 238, 69, 176, 79, 249, 129, 40, 39, 76, 89, 108,
+			195, 5, 29, 31, 180, 53, 81, 7, 200, 70, 77, 199, 115, 91,
+			109, 184, 240, 29, 198, 182, 34, 53, 33, 32, 157, 41, 15, 9,
+			168, 104, 103, 15, 9, 39, 220, 230, 33, 193, 217, 57, 137, 26,
+			77, 125, 168, 58, 194, 250, 79, 228, 85, 59, 238, 22, 216, 170,
+			184, 178, 34, 136, 219, 201, 168, 69, 186, 112, 33, 46, 244, 187,
+			180, 52, 20, 18, 208, 127, 52, 54, 111, 42, 97, 163, 9, 54,
+			91, 47, 140, 184, 83, 3, 185, 79, 122, 153, 70, 27, 188, 9,
+			25, 180, 78, 231, 117, 116, 50, 54, 95, 87, 157, 6, 156, 159,
+			238, 20, 65, 83, 42, 148, 9, 27, 6, 206, 167, 102, 95, 242,
+			208, 33, 126, 65, 124, 59, 10, 192, 117, 11, 77, 226, 48, 143,
+			9, 139, 8, 28, 22, 251, 98, 22, 160, 95, 9, 215, 45, 152,
+			126, 48, 161, 115, 182, 214, 118, 27, 53, 116, 225, 83, 140, 113,
+			12, 80, 37, 168, 163, 229, 187, 94, 132, 46, 55, 56, 135, 97,
+			124, 19, 151, 32, 50, 229, 121, 119, 232, 99, 158, 68, 237, 192,
+			140, 17, 237, 48, 213, 58, 224, 163, 118, 75, 64, 90, 233, 156,
+			109, 25, 127, 164, 186, 225, 135, 96, 221, 10, 101, 244, 148, 240,
+			42, 97, 231, 80, 203, 87, 25, 69, 207, 208, 60, 168, 188, 142,
+			65, 168, 210, 178, 12, 24, 56, 214, 27, 156, 57, 173, 86, 67,
+			57, 29, 193, 21, 102, 198, 252, 96, 221, 241, 220, 151, 101, 80,
+			27, 95, 220, 253, 230, 247, 91, 60, 112, 241, 216, 189, 161, 58,
+			51, 6, 26, 136, 196, 161, 234, 242, 35, 147, 147, 147, 147, 80,
+			75, 180, 17, 160, 181, 224, 10, 252, 83, 150, 124, 121, 236, 176,
+			229, 183, 97, 9, 113, 47, 132, 107, 186, 168, 36, 64, 146, 212,
+			197, 165, 226, 13, 149, 136, 153, 197, 170, 177, 23, 154, 117, 201,
+			30, 20, 31, 48, 234, 53, 121, 5, 49, 220, 144, 232, 199, 225,
+			99, 82, 21, 240, 177, 165, 25, 33, 180, 4, 118, 104, 113, 37,
+			49, 66, 109, 36, 114, 65, 50, 229, 227, 77, 48, 209, 168, 35,
+			203, 241, 117, 124, 62, 120, 92, 207, 224, 120, 192, 215, 221, 48,
+			10, 182, 18, 49, 41, 160, 182, 200, 87, 18, 42, 227, 221, 179,
+			90, 100, 75, 224, 194, 176, 165, 162, 214, 176, 45, 191, 29, 0,
+			0, 15, 244, 226, 102, 45, 34, 55, 64, 61, 11, 107, 144, 232,
+			222, 227, 227, 51, 242, 114, 237, 40, 82, 82, 71, 145, 77, 190,
+			22, 186, 17, 103, 35, 96, 53, 185, 231, 184, 13, 112, 66, 24,
+			149, 183, 195, 2, 136, 179, 228, 249, 80, 25, 30, 223, 1, 218,
+			239, 183, 26, 200, 143, 252, 77, 104, 27, 3, 48, 193, 169, 147,
+			175, 48, 223, 44, 178, 149, 176, 141, 22, 27, 248, 142, 228, 131,
+			133, 125, 143, 67, 69, 221, 67, 42, 178, 231, 252, 182, 244, 225,
+			66, 199, 185, 109, 243, 132, 84, 135, 182, 63, 48, 200, 196, 21,
+			64, 109, 114, 122, 193, 88, 222, 142, 34, 105, 189, 146, 236, 34,
+			108, 175, 141, 203, 229, 11, 238, 180, 72, 23, 108, 70, 172, 8,
+			181, 188, 67, 192, 16, 158, 182, 64, 109, 50, 102, 80, 40, 137,
+			214, 1, 103, 17, 88, 28, 236, 245, 70, 136, 130, 74, 68, 234,
+			73, 57, 8, 168, 164, 44, 77, 254, 81, 59, 0, 102, 219, 142,
+			228, 86, 32, 248, 71, 48, 198, 28, 182, 201, 215, 148, 236, 167,
+			9, 17, 204, 75, 237, 150, 164, 12, 167, 29, 249, 77, 39, 2,
+			223, 46, 56, 32, 15, 193, 38, 44, 17, 37, 145, 17, 74, 161,
+			17, 76, 68, 31, 179, 50, 67, 226, 154, 34, 1, 169, 241, 23,
+			44, 243, 100, 254, 203, 6, 248, 22, 11, 115, 226, 109, 231, 158,
+			195, 228, 11, 43, 192, 173, 2, 206, 240, 213, 23, 30, 38, 246,
+			39, 105, 255, 119, 67, 101, 21, 148, 29, 35, 34, 198, 3, 156,
+			180, 93, 223, 82, 254, 132, 99, 137, 123, 124, 170, 98, 88, 186,
+			33, 28, 41, 174, 181, 99, 59, 129, 95, 143, 128, 195, 185, 94,
+			66, 181, 215, 166, 179, 142, 242, 218, 206, 230, 129, 195, 12, 16,
+			22, 30, 161, 10, 178, 208, 65, 19, 148, 230, 47, 37, 86, 33,
+			128, 16, 148, 142, 127, 65, 201, 35, 4, 165, 227, 95, 176, 164,
+			244, 71, 80, 58, 254, 5, 139, 30, 83, 160, 5, 56, 58, 81,
+			32, 223, 2, 107, 11, 1, 217, 240, 19, 150, 121, 62, 255, 199,
+			38, 155, 241, 189, 40, 240, 27, 219, 143, 30, 55, 33, 246, 7,
+			15, 4, 42, 17, 121, 73, 212, 201, 152, 80, 197, 174, 139, 141,
+			112, 195, 29, 179, 38, 163, 34, 168, 173, 190, 171, 192, 89, 152,
+			139, 40, 150, 169, 71, 70, 149, 166, 0, 2, 247, 38, 135, 10,
+			224, 252, 124, 43, 113, 1, 88, 175, 145, 109, 126, 183, 137, 138,
+			139, 120, 71, 114, 135, 7, 169, 164, 25, 7, 56, 129, 58, 7,
+			118, 164, 243, 171, 8, 20, 163, 104, 68, 157, 12, 145, 100, 127,
+			53, 221, 202, 3, 103, 215, 11, 129, 81, 65, 105, 185, 107, 40,
+			148, 225, 195, 89, 2, 17, 82, 238, 39, 168, 160, 125, 34, 158,
+			47, 144, 251, 63, 17, 207, 151, 129, 51, 66, 207, 40, 208, 2,
+			112, 244, 28, 249, 111, 44, 156, 47, 240, 203, 182, 204, 107, 249,
+			31, 183, 96, 100, 220, 75, 142, 64, 147, 59, 202, 79, 114, 138,
+			124, 233, 125, 175, 102, 12, 184, 6, 111, 129, 187, 53, 103, 69,
+			192, 12, 220, 169, 106, 112, 25, 160, 162, 186, 145, 64, 177, 228,
+			44, 99, 210, 160, 12, 140, 85, 45, 220, 29, 81, 78, 164, 49,
+			157, 45, 111, 180, 209, 99, 0, 228, 41, 117, 24, 223, 104, 176,
+			115, 158, 31, 157, 219, 25, 109, 10, 95, 136, 41, 113, 218, 94,
+			131, 45, 104, 167, 215, 199, 138, 44, 118, 122, 219, 86, 86, 12,
+			86, 57, 187, 145, 4, 161, 70, 126, 7, 249, 189, 22, 221, 37,
+			201, 142, 60, 20, 221, 201, 75, 175, 98, 222, 64, 37, 251, 181,
+			120, 146, 65, 37, 251, 53, 165, 36, 16, 212, 157, 127, 205, 202,
+			157, 84, 160, 5, 95, 207, 140, 168, 175, 25, 0, 71, 175, 42,
+			176, 23, 192, 75, 87, 200, 40, 82, 128, 69, 237, 223, 176, 204,
+			82, 254, 176, 60, 229, 18, 140, 17, 206, 108, 128, 131, 108, 8,
+			55, 42, 81, 210, 178, 49, 175, 6, 83, 0, 234, 78, 128, 54,
+			247, 27, 86, 110, 92, 129, 88, 241, 228, 148, 2, 51, 0, 94,
+			156, 69, 23, 40, 98, 90, 112, 112, 249, 27, 214, 165, 25, 242,
+			21, 193, 56, 108, 106, 127, 218, 50, 159, 204, 255, 7, 83, 158,
+			90, 235, 99, 190, 4, 37, 78, 61, 152, 20, 181, 156, 15, 225,
+			223, 2, 127, 19, 214, 56, 227, 247, 171, 92, 140, 9, 100, 75,
+			152, 102, 72, 117, 162, 8, 220, 181, 97, 221, 54, 29, 40, 229,
+			171, 157, 193, 193, 67, 168, 149, 229, 27, 227, 143, 17, 60, 210,
+			103, 33, 127, 103, 27, 206, 88, 48, 19, 168, 117, 176, 105, 162,
+			24, 86, 132, 75, 58, 72, 212, 137, 32, 72, 130, 100, 106, 190,
+			86, 245, 139, 36, 38, 48, 39, 225, 95, 45, 69, 57, 65, 92,
+			240, 144, 84, 43, 10, 227, 198, 59, 219, 14, 213, 89, 148, 220,
+			184, 54, 28, 200, 202, 120, 189, 14, 82, 140, 28, 92, 44, 113,
+			98, 219, 13, 144, 82, 228, 245, 118, 61, 135, 160, 14, 127, 58,
+			158, 67, 80, 135, 63, 29, 207, 33, 168, 195, 159, 182, 114, 138,
+			91, 128, 58, 252, 105, 107, 244, 188, 2, 51, 0, 142, 61, 161,
+			192, 94, 0, 31, 125, 156, 204, 193, 12, 130, 111, 246, 239, 88,
+			230, 231, 44, 43, 255, 56, 211, 241, 72, 53, 227, 147, 103, 104,
+			59, 197, 145, 82, 26, 90, 232, 190, 172, 9, 30, 61, 187, 127,
+			199, 234, 29, 34, 179, 64, 47, 54, 90, 161, 63, 99, 217, 3,
+			133, 75, 186, 242, 56, 240, 8, 78, 60, 84, 40, 85, 169, 49,
+			144, 8, 92, 167, 161, 164, 106, 97, 75, 38, 210, 149, 251, 51,
+			150, 221, 23, 39, 192, 45, 1, 171, 127, 15, 185, 41, 219, 49,
+			168, 253, 89, 203, 166, 249, 180, 136, 13, 82, 152, 0, 47, 69,
+			22, 7, 223, 92, 104, 133, 64, 8, 218, 45, 86, 242, 37, 177,
+			193, 40, 175, 113, 34, 125, 192, 63, 107, 73, 47, 96, 34, 125,
+			192, 63, 107, 229, 6, 117, 83, 38, 181, 255, 157, 101, 239, 47,
+			60, 154, 28, 82, 13, 20, 7, 160, 48, 73, 89, 115, 48, 139,
+			42, 118, 99, 215, 148, 170, 154, 97, 253, 255, 59, 75, 186, 40,
+			19, 233, 211, 253, 239, 172, 161, 125, 104, 148, 33, 192, 45, 62,
+			111, 153, 215, 228, 188, 129, 193, 225, 243, 49, 9, 192, 205, 224,
+			207, 91, 242, 136, 137, 224, 205, 224, 207, 91, 195, 167, 20, 104,
+			193, 215, 179, 138, 151, 128, 193, 225, 243, 49, 47, 1, 131, 195,
+			231, 173, 75, 87, 200, 31, 139, 85, 156, 166, 246, 23, 44, 179,
+			144, 255, 93, 51, 150, 152, 110, 198, 98, 141, 144, 151, 194, 40,
+			192, 128, 141, 175, 71, 94, 42, 215, 153, 47, 2, 125, 142, 109,
+			171, 84, 110, 158, 201, 248, 143, 157, 129, 46, 81, 30, 29, 7,
+			133, 156, 173, 57, 33, 79, 202, 34, 170, 18, 113, 84, 202, 32,
+			114, 239, 24, 216, 112, 164, 178, 80, 195, 0, 157, 227, 73, 71,
+			143, 100, 41, 188, 53, 2, 74, 90, 231, 198, 133, 251, 150, 172,
+			6, 15, 93, 118, 174, 165, 187, 47, 29, 133, 85, 152, 10, 205,
+			45, 244, 10, 6, 223, 160, 47, 196, 211, 7, 190, 65, 95, 136,
+			247, 123, 56, 220, 251, 130, 37, 237, 124, 4, 125, 131, 190, 96,
+			29, 63, 65, 254, 87, 27, 39, 168, 151, 218, 95, 130, 253, 254,
+			183, 108, 182, 36, 66, 11, 225, 44, 184, 85, 181, 21, 135, 157,
+			150, 22, 184, 15, 226, 64, 252, 47, 199, 91, 111, 59, 235, 252,
+			45, 140, 21, 228, 107, 142, 5, 93, 132, 128, 45, 7, 118, 139,
+			56, 242, 21, 176, 74, 111, 11, 180, 241, 200, 173, 130, 168, 206,
+			42, 139, 51, 44, 220, 10, 35, 180, 89, 44, 227, 65, 111, 144,
+			108, 9, 131, 228, 64, 108, 86, 16, 232, 58, 217, 124, 184, 173,
+			27, 108, 68, 225, 199, 169, 9, 231, 84, 167, 161, 194, 33, 141,
+			130, 31, 124, 247, 160, 54, 185, 52, 159, 160, 82, 116, 23, 220,
+			254, 252, 186, 250, 172, 90, 74, 196, 95, 82, 39, 125, 78, 208,
+			216, 82, 238, 218, 33, 76, 84, 183, 87, 45, 217, 161, 49, 129,
+			141, 77, 144, 15, 64, 144, 3, 23, 138, 132, 43, 190, 235, 177,
+			186, 115, 15, 76, 7, 117, 185, 210, 101, 199, 97, 215, 114, 18,
+			30, 26, 136, 132, 36, 70, 133, 26, 186, 59, 74, 3, 188, 204,
+			54, 70, 36, 75, 210, 78, 108, 62, 67, 151, 55, 112, 143, 108,
+			212, 4, 175, 21, 138, 187, 208, 193, 193, 181, 101, 59, 25, 8,
+			63, 127, 146, 244, 11, 198, 93, 25, 148, 69, 116, 63, 104, 199,
+			210, 9, 248, 122, 125, 41, 38, 73, 240, 245, 250, 82, 188, 169,
+			244, 194, 65, 80, 44, 157, 128, 175, 215, 151, 98, 233, 164, 55,
+			3, 160, 230, 40, 189, 189, 0, 94, 186, 34, 57, 87, 134, 218,
+			95, 182, 76, 181, 227, 128, 107, 212, 151, 227, 118, 192, 53, 234,
+			203, 113, 59, 112, 32, 242, 101, 43, 119, 90, 129, 22, 128, 35,
+			231, 20, 136, 85, 157, 127, 92, 129, 189, 0, 94, 190, 38, 219,
+			233, 163, 246, 87, 98, 14, 9, 142, 83, 95, 137, 219, 129, 155,
+			225, 95, 137, 219, 129, 179, 133, 175, 196, 227, 1, 199, 169, 175,
+			196, 227, 233, 203, 0, 168, 199, 211, 215, 11, 224, 165, 43, 228,
+			60, 177, 108, 210, 71, 237, 175, 90, 230, 64, 225, 152, 190, 79,
+			9, 239, 124, 118, 191, 159, 10, 177, 77, 108, 155, 192, 181, 202,
+			175, 90, 217, 61, 162, 166, 190, 30, 163, 19, 52, 37, 248, 231,
+			66, 89, 37, 212, 254, 134, 101, 78, 229, 191, 100, 176, 114, 168,
+			204, 109, 13, 158, 160, 188, 183, 16, 54, 139, 19, 14, 187, 11,
+			58, 187, 113, 22, 225, 251, 104, 160, 150, 70, 112, 87, 67, 186,
+			85, 193, 102, 205, 155, 110, 196, 102, 117, 225, 164, 145, 150, 224,
+			94, 11, 2, 205, 22, 10, 136, 73, 137, 117, 12, 124, 103, 220,
+			72, 115, 101, 181, 71, 131, 59, 202, 186, 231, 7, 188, 118, 77,
+			101, 135, 242, 4, 46, 105, 135, 202, 107, 25, 157, 79, 160, 31,
+			122, 235, 198, 150, 212, 16, 160, 41, 25, 212, 83, 98, 23, 92,
+			62, 190, 17, 79, 20, 73, 1, 168, 39, 10, 244, 249, 111, 88,
+			185, 188, 2, 45, 0, 143, 30, 87, 96, 6, 64, 118, 65, 129,
+			189, 0, 158, 159, 36, 127, 31, 209, 153, 165, 246, 183, 192, 184,
+			255, 78, 86, 66, 165, 40, 84, 222, 66, 176, 108, 193, 175, 215,
+			145, 65, 240, 180, 238, 46, 213, 155, 174, 248, 81, 66, 170, 70,
+			171, 31, 15, 137, 176, 201, 68, 190, 94, 228, 181, 88, 51, 244,
+			3, 112, 89, 214, 67, 203, 194, 9, 94, 60, 180, 108, 10, 64,
+			61, 180, 44, 156, 224, 169, 99, 1, 2, 1, 117, 236, 111, 89,
+			39, 21, 233, 103, 51, 240, 245, 204, 101, 245, 181, 23, 192, 11,
+			143, 144, 31, 19, 164, 2, 23, 73, 44, 115, 52, 255, 158, 132,
+			93, 195, 87, 166, 47, 86, 149, 154, 143, 120, 237, 86, 114, 10,
+			17, 13, 72, 70, 41, 4, 230, 222, 104, 36, 139, 144, 157, 6,
+			212, 181, 169, 171, 251, 249, 232, 204, 164, 152, 147, 30, 110, 191,
+			141, 157, 210, 96, 10, 64, 189, 171, 225, 85, 23, 139, 170, 37,
+			215, 111, 1, 120, 102, 132, 148, 113, 60, 123, 168, 253, 23, 150,
+			57, 146, 191, 198, 244, 99, 184, 136, 206, 109, 125, 186, 166, 154,
+			13, 149, 55, 182, 220, 201, 117, 55, 246, 216, 88, 151, 6, 83,
+			0, 234, 110, 192, 253, 153, 191, 176, 104, 65, 129, 22, 128, 167,
+			207, 10, 71, 102, 98, 14, 80, 251, 59, 150, 112, 100, 142, 205,
+			59, 108, 9, 222, 9, 86, 248, 129, 205, 12, 151, 6, 94, 129,
+			219, 110, 248, 1, 65, 111, 70, 185, 132, 162, 151, 71, 192, 65,
+			6, 2, 210, 63, 91, 60, 43, 44, 56, 16, 49, 48, 8, 171,
+			112, 34, 160, 194, 168, 227, 206, 1, 134, 117, 49, 105, 202, 195,
+			59, 156, 8, 183, 154, 107, 126, 35, 36, 74, 187, 148, 126, 154,
+			81, 172, 75, 224, 178, 83, 242, 206, 88, 34, 244, 164, 54, 219,
+			75, 47, 91, 113, 206, 64, 30, 212, 140, 110, 69, 33, 112, 0,
+			142, 18, 99, 124, 14, 192, 81, 98, 140, 79, 184, 93, 244, 157,
+			216, 152, 52, 0, 71, 137, 214, 137, 147, 232, 212, 78, 204, 189,
+			212, 254, 126, 219, 28, 201, 183, 98, 42, 109, 109, 180, 30, 150,
+			58, 33, 235, 54, 2, 32, 59, 80, 165, 124, 211, 3, 176, 0,
+			42, 161, 186, 241, 77, 204, 189, 16, 234, 201, 214, 125, 223, 11,
+			161, 158, 108, 221, 119, 184, 231, 244, 253, 182, 166, 133, 189, 22,
+			128, 167, 207, 146, 127, 37, 214, 88, 142, 218, 239, 179, 205, 211,
+			249, 95, 52, 80, 127, 72, 224, 27, 58, 40, 227, 239, 43, 203,
+			24, 190, 223, 12, 140, 101, 199, 78, 235, 46, 146, 184, 143, 219,
+			102, 81, 127, 234, 148, 80, 161, 118, 205, 139, 81, 61, 196, 192,
+			169, 53, 30, 65, 200, 115, 79, 57, 47, 234, 78, 232, 193, 231,
+			224, 202, 77, 60, 248, 28, 92, 185, 137, 7, 15, 55, 179, 222,
+			103, 75, 223, 14, 98, 230, 224, 202, 141, 93, 56, 69, 254, 173,
+			24, 252, 32, 181, 63, 96, 155, 197, 252, 175, 254, 13, 6, 175,
+			30, 191, 214, 88, 32, 10, 13, 175, 3, 11, 90, 160, 238, 64,
+			132, 188, 228, 15, 44, 226, 181, 17, 49, 8, 177, 158, 98, 68,
+			12, 66, 164, 167, 24, 17, 112, 175, 236, 3, 54, 85, 155, 255,
+			32, 68, 121, 178, 207, 143, 147, 127, 45, 16, 65, 169, 253, 163,
+			182, 121, 42, 255, 47, 94, 11, 17, 106, 190, 192, 47, 169, 189,
+			182, 245, 55, 32, 2, 233, 94, 249, 134, 200, 0, 158, 10, 87,
+			101, 52, 2, 168, 141, 131, 208, 96, 10, 64, 141, 0, 106, 0,
+			168, 151, 48, 181, 0, 60, 113, 18, 35, 27, 16, 115, 136, 218,
+			31, 178, 223, 104, 100, 3, 98, 14, 193, 149, 152, 184, 233, 33,
+			136, 23, 101, 203, 200, 6, 196, 28, 130, 43, 49, 182, 140, 108,
+			64, 204, 33, 184, 18, 99, 159, 56, 73, 254, 30, 54, 189, 143,
+			218, 31, 182, 205, 171, 121, 239, 13, 133, 225, 33, 250, 248, 163,
+			243, 69, 4, 181, 229, 23, 228, 113, 72, 65, 159, 135, 36, 99,
+			246, 16, 115, 31, 92, 136, 137, 123, 190, 47, 13, 160, 140, 217,
+			67, 204, 125, 112, 33, 198, 150, 49, 123, 136, 185, 15, 46, 196,
+			216, 151, 175, 144, 247, 25, 32, 36, 166, 168, 253, 83, 182, 121,
+			40, 255, 242, 223, 248, 190, 254, 27, 31, 5, 10, 160, 112, 185,
+			255, 167, 108, 121, 185, 159, 164, 64, 0, 253, 41, 91, 94, 238,
+			39, 41, 184, 212, 248, 83, 246, 254, 97, 116, 70, 2, 201, 246,
+			35, 54, 134, 18, 6, 201, 213, 0, 72, 139, 170, 70, 23, 104,
+			74, 16, 66, 41, 101, 105, 250, 167, 237, 158, 111, 217, 34, 148,
+			18, 136, 53, 63, 109, 103, 14, 144, 47, 164, 136, 109, 103, 193,
+			232, 243, 203, 182, 249, 100, 254, 223, 164, 96, 15, 64, 165, 35,
+			113, 112, 22, 251, 244, 95, 80, 214, 18, 200, 149, 184, 42, 44,
+			143, 165, 20, 130, 148, 17, 45, 177, 225, 97, 14, 125, 36, 50,
+			222, 17, 71, 58, 54, 165, 117, 213, 78, 100, 245, 69, 134, 71,
+			113, 242, 74, 116, 124, 152, 10, 178, 183, 31, 36, 226, 196, 139,
+			152, 2, 87, 225, 88, 246, 108, 200, 26, 60, 12, 9, 216, 238,
+			220, 42, 120, 27, 140, 225, 181, 148, 58, 223, 228, 129, 94, 34,
+			96, 41, 172, 193, 74, 111, 194, 46, 15, 189, 194, 83, 99, 94,
+			235, 138, 141, 175, 125, 245, 213, 146, 226, 247, 29, 140, 98, 223,
+			113, 84, 205, 116, 246, 27, 190, 207, 222, 5, 214, 8, 38, 73,
+			72, 125, 233, 126, 22, 159, 61, 129, 216, 190, 38, 242, 38, 104,
+			236, 18, 76, 64, 211, 185, 143, 95, 94, 233, 244, 103, 141, 187,
+			85, 69, 117, 65, 70, 39, 242, 148, 47, 133, 140, 242, 21, 94,
+			75, 32, 52, 148, 94, 134, 152, 53, 57, 85, 132, 77, 119, 158,
+			222, 251, 117, 56, 63, 13, 88, 28, 252, 8, 142, 250, 92, 111,
+			29, 237, 11, 178, 229, 240, 26, 131, 211, 92, 232, 204, 150, 246,
+			158, 88, 147, 190, 174, 112, 196, 12, 161, 144, 80, 73, 233, 190,
+			103, 0, 90, 5, 187, 46, 143, 211, 180, 58, 162, 142, 10, 199,
+			18, 143, 191, 68, 155, 120, 222, 28, 5, 110, 85, 250, 132, 72,
+			243, 7, 135, 208, 160, 85, 105, 59, 208, 27, 80, 199, 125, 36,
+			193, 17, 178, 120, 202, 246, 203, 138, 65, 100, 241, 148, 237, 151,
+			109, 41, 222, 103, 209, 76, 249, 203, 118, 238, 172, 2, 45, 88,
+			7, 231, 206, 43, 48, 3, 160, 180, 195, 102, 205, 158, 94, 0,
+			31, 125, 156, 252, 9, 108, 58, 89, 48, 6, 254, 138, 109, 222,
+			200, 255, 239, 6, 155, 117, 195, 88, 121, 145, 123, 74, 226, 170,
+			92, 24, 57, 94, 205, 9, 106, 172, 16, 251, 89, 140, 140, 22,
+			208, 24, 29, 134, 224, 115, 33, 228, 171, 170, 227, 65, 160, 113,
+			241, 14, 178, 186, 135, 137, 83, 169, 107, 82, 62, 33, 234, 154,
+			24, 144, 46, 119, 60, 180, 62, 52, 65, 214, 213, 49, 125, 165,
+			12, 38, 215, 45, 119, 66, 151, 7, 215, 48, 16, 53, 90, 38,
+			228, 98, 114, 238, 249, 174, 34, 23, 121, 126, 147, 232, 100, 65,
+			50, 218, 44, 158, 126, 253, 74, 140, 71, 56, 253, 250, 149, 24,
+			143, 192, 121, 126, 197, 206, 77, 40, 208, 2, 112, 234, 162, 2,
+			51, 0, 94, 42, 41, 176, 23, 192, 183, 204, 146, 255, 75, 224,
+			209, 164, 246, 175, 219, 230, 133, 252, 31, 198, 26, 181, 36, 149,
+			191, 61, 165, 58, 177, 134, 94, 167, 38, 45, 21, 105, 242, 208,
+			154, 180, 108, 70, 41, 211, 89, 244, 207, 252, 245, 24, 149, 96,
+			53, 254, 245, 24, 149, 64, 86, 191, 110, 75, 101, 58, 139, 254,
+			153, 191, 110, 31, 61, 166, 192, 12, 124, 61, 62, 169, 192, 94,
+			0, 207, 77, 8, 166, 222, 71, 237, 79, 218, 230, 16, 238, 13,
+			89, 176, 106, 124, 210, 206, 246, 139, 156, 104, 213, 72, 130, 166,
+			4, 69, 94, 252, 56, 32, 187, 208, 103, 116, 129, 166, 4, 69,
+			94, 132, 6, 169, 252, 104, 26, 157, 160, 250, 250, 79, 211, 144,
+			25, 122, 255, 5, 219, 60, 150, 255, 209, 52, 123, 38, 113, 27,
+			72, 226, 68, 174, 252, 78, 207, 129, 88, 36, 107, 58, 45, 112,
+			90, 9, 182, 4, 43, 146, 243, 6, 166, 203, 150, 244, 113, 210,
+			87, 163, 116, 138, 96, 195, 12, 242, 60, 254, 20, 223, 90, 198,
+			144, 110, 248, 118, 45, 252, 124, 18, 210, 87, 49, 35, 123, 130,
+			93, 184, 70, 98, 161, 165, 150, 188, 206, 213, 240, 253, 187, 33,
+			134, 65, 81, 213, 201, 14, 223, 113, 90, 232, 72, 90, 194, 110,
+			73, 14, 159, 228, 242, 78, 107, 85, 116, 185, 131, 175, 199, 57,
+			156, 6, 147, 221, 98, 119, 249, 150, 236, 196, 182, 44, 186, 195,
+			210, 83, 251, 9, 54, 37, 179, 189, 34, 254, 104, 166, 218, 217,
+			161, 174, 209, 17, 86, 238, 138, 42, 2, 126, 86, 213, 13, 223,
+			15, 5, 35, 77, 88, 72, 196, 188, 168, 238, 63, 1, 157, 87,
+			163, 70, 247, 8, 20, 230, 33, 146, 189, 136, 22, 3, 115, 227,
+			118, 44, 67, 109, 51, 142, 124, 6, 81, 248, 241, 219, 93, 190,
+			5, 83, 172, 174, 121, 200, 216, 201, 137, 67, 188, 233, 197, 50,
+			90, 114, 240, 94, 133, 219, 213, 91, 113, 100, 167, 28, 124, 240,
+			113, 19, 240, 44, 112, 101, 100, 193, 248, 34, 85, 247, 30, 19,
+			83, 199, 252, 194, 114, 233, 170, 10, 108, 46, 13, 171, 18, 207,
+			219, 158, 176, 97, 211, 194, 221, 65, 137, 61, 72, 103, 112, 231,
+			202, 185, 79, 148, 90, 142, 214, 21, 85, 129, 228, 161, 202, 17,
+			205, 109, 118, 152, 112, 215, 182, 18, 202, 145, 218, 152, 164, 231,
+			92, 188, 65, 193, 97, 239, 23, 98, 110, 0, 193, 240, 190, 16,
+			115, 3, 56, 236, 253, 130, 157, 59, 164, 64, 92, 79, 71, 142,
+			146, 83, 114, 189, 127, 209, 54, 247, 20, 14, 226, 121, 59, 156,
+			89, 173, 234, 243, 184, 181, 6, 198, 244, 135, 5, 107, 81, 251,
+			139, 241, 202, 183, 140, 78, 208, 148, 224, 113, 89, 227, 31, 64,
+			141, 20, 107, 244, 28, 207, 95, 117, 194, 85, 168, 89, 85, 102,
+			83, 251, 15, 226, 210, 182, 209, 9, 154, 18, 252, 63, 192, 235,
+			33, 11, 140, 238, 171, 182, 185, 152, 255, 172, 37, 173, 123, 136,
+			16, 241, 244, 63, 219, 112, 188, 26, 188, 72, 201, 252, 186, 184,
+			124, 28, 71, 38, 213, 123, 96, 168, 182, 57, 136, 182, 17, 84,
+			29, 52, 45, 0, 57, 193, 233, 112, 43, 76, 88, 104, 146, 231,
+			81, 80, 143, 14, 57, 23, 191, 219, 1, 243, 173, 174, 129, 66,
+			184, 123, 65, 139, 176, 33, 170, 192, 25, 24, 253, 41, 100, 47,
+			234, 59, 203, 47, 130, 60, 227, 195, 62, 237, 183, 193, 21, 210,
+			171, 41, 19, 35, 16, 36, 86, 54, 165, 157, 93, 128, 220, 186,
+			31, 147, 208, 122, 32, 120, 36, 48, 56, 11, 247, 241, 113, 146,
+			38, 119, 208, 73, 208, 89, 135, 43, 236, 17, 91, 11, 252, 187,
+			220, 19, 238, 152, 33, 171, 181, 57, 81, 150, 51, 169, 188, 186,
+			94, 220, 75, 96, 132, 187, 99, 12, 122, 177, 48, 187, 160, 197,
+			3, 137, 110, 93, 122, 147, 131, 25, 26, 21, 99, 25, 188, 29,
+			46, 155, 176, 154, 191, 233, 9, 87, 106, 184, 172, 8, 14, 213,
+			40, 41, 110, 56, 53, 22, 185, 77, 60, 185, 23, 162, 133, 82,
+			190, 178, 120, 47, 229, 171, 49, 233, 194, 25, 247, 87, 99, 210,
+			5, 218, 248, 170, 157, 187, 172, 64, 11, 192, 43, 215, 20, 152,
+			1, 240, 241, 5, 244, 83, 0, 16, 63, 63, 49, 143, 10, 109,
+			22, 246, 196, 87, 223, 176, 66, 155, 197, 163, 215, 87, 227, 158,
+			193, 209, 235, 171, 74, 161, 205, 226, 209, 235, 171, 74, 161, 205,
+			98, 156, 132, 87, 65, 161, 133, 184, 178, 89, 184, 46, 241, 13,
+			251, 191, 92, 92, 217, 44, 30, 36, 126, 35, 238, 12, 196, 117,
+			254, 134, 210, 81, 179, 240, 30, 129, 253, 13, 165, 163, 102, 241,
+			32, 241, 27, 160, 163, 66, 72, 57, 48, 70, 127, 211, 254, 219,
+			9, 41, 151, 5, 173, 243, 155, 74, 235, 204, 162, 214, 249, 77,
+			165, 117, 102, 81, 235, 252, 38, 104, 157, 160, 60, 246, 211, 244,
+			159, 219, 61, 223, 159, 22, 202, 35, 24, 137, 255, 220, 206, 236,
+			35, 63, 6, 171, 188, 31, 148, 199, 191, 178, 205, 98, 254, 61,
+			22, 46, 168, 42, 110, 214, 154, 197, 170, 83, 104, 152, 175, 153,
+			243, 231, 187, 61, 75, 164, 150, 233, 196, 46, 230, 100, 151, 120,
+			19, 210, 169, 121, 195, 241, 152, 155, 240, 184, 219, 4, 22, 92,
+			100, 90, 3, 87, 52, 161, 174, 179, 2, 35, 104, 248, 155, 138,
+			145, 168, 126, 201, 56, 62, 122, 199, 145, 175, 41, 201, 7, 91,
+			96, 7, 0, 199, 203, 183, 225, 96, 158, 152, 89, 168, 204, 190,
+			128, 171, 95, 38, 44, 45, 87, 202, 243, 55, 95, 96, 35, 137,
+			32, 22, 163, 112, 188, 215, 113, 177, 90, 50, 34, 168, 8, 74,
+			177, 2, 184, 154, 132, 5, 69, 189, 113, 96, 77, 174, 94, 54,
+			98, 227, 227, 44, 244, 131, 96, 107, 140, 109, 242, 179, 96, 190,
+			6, 225, 199, 23, 247, 15, 107, 156, 72, 53, 23, 106, 195, 9,
+			119, 88, 189, 13, 122, 172, 58, 58, 61, 33, 136, 172, 223, 236,
+			177, 169, 253, 87, 182, 73, 254, 63, 246, 254, 5, 76, 142, 171,
+			188, 19, 198, 167, 171, 171, 47, 115, 52, 215, 26, 73, 150, 91,
+			182, 116, 220, 190, 104, 70, 238, 105, 73, 99, 27, 176, 100, 155,
+			29, 73, 35, 105, 204, 104, 102, 210, 51, 178, 113, 248, 195, 168,
+			166, 187, 102, 166, 172, 158, 174, 166, 171, 91, 227, 241, 46, 187,
+			108, 46, 220, 55, 176, 49, 36, 16, 66, 150, 172, 185, 67, 194,
+			102, 227, 4, 146, 240, 96, 32, 16, 248, 147, 64, 18, 248, 214,
+			1, 226, 124, 228, 66, 48, 183, 132, 91, 72, 188, 56, 201, 243,
+			61, 191, 247, 188, 231, 84, 85, 207, 200, 50, 4, 127, 207, 243,
+			61, 75, 8, 104, 170, 250, 156, 83, 231, 188, 231, 61, 239, 251,
+			158, 247, 170, 31, 179, 216, 35, 14, 175, 234, 163, 107, 207, 247,
+			109, 206, 154, 211, 71, 161, 55, 223, 183, 175, 44, 232, 199, 60,
+			26, 239, 29, 215, 141, 115, 120, 188, 65, 37, 141, 239, 131, 251,
+			201, 19, 182, 245, 178, 140, 202, 224, 212, 103, 99, 172, 39, 236,
+			92, 159, 216, 47, 178, 118, 31, 60, 61, 28, 251, 95, 108, 123,
+			176, 48, 104, 244, 136, 235, 84, 57, 130, 156, 38, 250, 216, 55,
+			228, 95, 108, 59, 246, 194, 194, 139, 254, 1, 241, 11, 105, 30,
+			3, 193, 24, 25, 187, 191, 240, 179, 233, 56, 187, 77, 236, 6,
+			123, 140, 43, 114, 76, 155, 231, 110, 3, 125, 66, 158, 205, 166,
+			39, 52, 240, 203, 228, 177, 29, 139, 92, 71, 36, 103, 3, 22,
+			33, 92, 31, 60, 73, 170, 80, 38, 224, 184, 246, 226, 220, 147,
+			191, 156, 43, 79, 4, 173, 154, 145, 3, 56, 24, 138, 156, 148,
+			202, 146, 92, 212, 213, 237, 0, 194, 16, 191, 215, 105, 97, 48,
+			213, 22, 165, 172, 64, 82, 130, 0, 214, 184, 149, 150, 187, 106,
+			4, 29, 154, 19, 26, 33, 40, 29, 38, 159, 0, 44, 169, 13,
+			23, 196, 166, 215, 162, 219, 69, 3, 94, 183, 208, 66, 234, 32,
+			6, 226, 118, 174, 160, 73, 209, 13, 38, 241, 171, 186, 58, 214,
+			125, 151, 158, 140, 187, 161, 203, 218, 125, 40, 233, 106, 212, 149,
+			34, 57, 16, 60, 128, 98, 114, 190, 219, 149, 253, 69, 57, 13,
+			199, 182, 14, 55, 131, 159, 205, 216, 249, 232, 5, 42, 36, 100,
+			118, 244, 17, 25, 199, 94, 90, 142, 253, 210, 12, 39, 79, 235,
+			99, 151, 153, 151, 102, 216, 101, 166, 143, 93, 102, 94, 154, 25,
+			217, 41, 62, 171, 168, 7, 18, 228, 103, 172, 189, 133, 143, 166,
+			153, 222, 82, 102, 8, 222, 113, 246, 102, 226, 5, 144, 178, 199,
+			236, 111, 179, 133, 100, 113, 254, 69, 195, 240, 225, 3, 78, 13,
+			5, 66, 183, 160, 207, 49, 170, 159, 45, 36, 69, 157, 64, 232,
+			97, 202, 178, 226, 242, 189, 196, 109, 24, 65, 18, 154, 146, 141,
+			150, 175, 253, 182, 41, 191, 189, 78, 4, 21, 249, 92, 122, 74,
+			182, 46, 197, 131, 125, 221, 86, 203, 221, 4, 125, 225, 188, 140,
+			36, 30, 184, 218, 193, 180, 222, 157, 233, 110, 185, 30, 44, 151,
+			163, 140, 97, 37, 69, 150, 180, 51, 0, 228, 72, 154, 3, 37,
+			49, 17, 202, 191, 128, 11, 150, 209, 197, 157, 29, 27, 84, 58,
+			13, 147, 143, 109, 11, 173, 139, 50, 141, 73, 191, 33, 98, 137,
+			198, 150, 185, 216, 203, 121, 189, 112, 117, 57, 218, 154, 217, 175,
+			59, 233, 24, 92, 130, 91, 1, 82, 126, 172, 121, 70, 182, 96,
+			150, 215, 71, 218, 130, 215, 100, 152, 229, 245, 145, 182, 224, 53,
+			25, 150, 12, 250, 40, 152, 241, 53, 153, 33, 77, 126, 16, 221,
+			249, 154, 204, 149, 5, 241, 75, 80, 70, 246, 1, 65, 222, 148,
+			177, 110, 42, 188, 50, 67, 24, 113, 111, 24, 103, 40, 90, 149,
+			206, 186, 22, 248, 205, 46, 144, 178, 66, 221, 17, 141, 193, 129,
+			195, 119, 2, 206, 74, 195, 9, 70, 226, 212, 196, 15, 99, 85,
+			141, 9, 234, 216, 215, 103, 220, 44, 151, 145, 9, 162, 209, 246,
+			86, 91, 110, 157, 136, 254, 138, 127, 159, 78, 79, 37, 228, 168,
+			223, 104, 63, 227, 230, 146, 236, 240, 191, 33, 255, 75, 141, 240,
+			67, 200, 127, 141, 161, 168, 51, 83, 33, 58, 143, 188, 144, 59,
+			23, 150, 20, 251, 32, 51, 147, 65, 76, 37, 35, 198, 214, 163,
+			104, 136, 86, 13, 145, 158, 38, 148, 117, 132, 1, 193, 134, 211,
+			242, 170, 62, 40, 62, 251, 97, 98, 119, 214, 224, 79, 203, 30,
+			138, 46, 19, 34, 147, 184, 135, 51, 226, 104, 74, 185, 82, 15,
+			148, 118, 66, 197, 248, 68, 159, 45, 11, 185, 16, 213, 77, 187,
+			115, 97, 105, 246, 220, 217, 227, 83, 21, 163, 52, 225, 69, 144,
+			246, 46, 161, 238, 242, 106, 241, 201, 115, 56, 153, 48, 119, 169,
+			216, 111, 69, 21, 13, 80, 212, 57, 200, 22, 99, 24, 164, 153,
+			189, 54, 234, 42, 58, 32, 104, 34, 115, 149, 179, 147, 51, 128,
+			89, 242, 154, 104, 106, 93, 181, 141, 0, 30, 237, 176, 73, 203,
+			16, 240, 102, 199, 29, 153, 240, 253, 144, 35, 29, 220, 26, 89,
+			101, 161, 211, 20, 148, 173, 179, 188, 238, 182, 215, 202, 211, 192,
+			3, 163, 78, 236, 35, 221, 205, 155, 34, 196, 134, 176, 248, 166,
+			12, 27, 105, 250, 200, 63, 248, 77, 25, 103, 143, 126, 68, 53,
+			134, 204, 222, 171, 244, 99, 30, 143, 87, 79, 232, 199, 28, 30,
+			71, 143, 48, 95, 77, 57, 217, 95, 201, 88, 111, 55, 124, 21,
+			135, 228, 87, 50, 185, 126, 202, 193, 216, 103, 167, 192, 87, 223,
+			156, 177, 157, 194, 21, 108, 226, 138, 128, 196, 225, 169, 138, 38,
+			171, 180, 136, 111, 206, 176, 67, 100, 31, 167, 69, 124, 115, 102,
+			104, 88, 140, 241, 80, 40, 164, 128, 161, 174, 164, 161, 98, 91,
+			195, 124, 43, 54, 24, 166, 241, 96, 124, 48, 120, 87, 62, 24,
+			31, 204, 114, 236, 183, 109, 59, 88, 20, 244, 161, 251, 2, 62,
+			111, 139, 15, 134, 179, 254, 54, 12, 246, 6, 1, 32, 64, 93,
+			244, 112, 198, 186, 177, 240, 10, 161, 221, 230, 216, 195, 3, 162,
+			223, 178, 225, 92, 117, 247, 126, 191, 190, 249, 108, 41, 103, 220,
+			251, 55, 205, 141, 76, 251, 124, 176, 200, 59, 14, 176, 232, 202,
+			35, 42, 82, 107, 29, 57, 202, 232, 196, 168, 83, 2, 32, 42,
+			87, 127, 238, 3, 148, 161, 250, 100, 36, 90, 170, 175, 17, 117,
+			7, 223, 111, 68, 237, 14, 168, 152, 8, 50, 26, 17, 53, 230,
+			8, 127, 158, 31, 168, 170, 150, 19, 34, 9, 130, 25, 130, 192,
+			89, 98, 79, 178, 228, 168, 76, 216, 171, 109, 21, 164, 20, 141,
+			71, 147, 229, 84, 124, 148, 53, 79, 41, 142, 181, 149, 130, 195,
+			86, 176, 124, 151, 194, 49, 177, 218, 196, 25, 81, 217, 248, 86,
+			90, 144, 145, 3, 173, 0, 50, 233, 121, 72, 4, 23, 210, 115,
+			87, 145, 101, 172, 37, 235, 128, 170, 22, 37, 146, 153, 163, 76,
+			100, 164, 185, 96, 232, 48, 70, 227, 123, 45, 12, 211, 138, 91,
+			76, 73, 159, 20, 118, 86, 87, 189, 80, 103, 132, 74, 40, 238,
+			93, 170, 133, 12, 89, 221, 247, 46, 178, 96, 38, 216, 111, 47,
+			49, 159, 68, 246, 48, 172, 123, 35, 104, 177, 245, 40, 118, 180,
+			151, 131, 224, 194, 5, 207, 67, 214, 32, 149, 249, 97, 13, 123,
+			209, 222, 108, 178, 146, 145, 171, 137, 37, 28, 140, 253, 45, 4,
+			68, 123, 231, 75, 87, 249, 127, 199, 235, 255, 33, 197, 253, 10,
+			91, 212, 17, 226, 18, 145, 65, 236, 242, 49, 200, 83, 117, 29,
+			150, 128, 80, 40, 190, 193, 182, 188, 117, 29, 202, 64, 62, 235,
+			82, 158, 234, 180, 32, 127, 192, 0, 5, 84, 67, 82, 158, 113,
+			20, 87, 51, 197, 209, 68, 236, 99, 108, 120, 86, 243, 137, 149,
+			60, 84, 19, 62, 6, 172, 12, 219, 252, 89, 243, 49, 140, 70,
+			196, 24, 107, 87, 122, 18, 19, 201, 166, 62, 72, 232, 92, 237,
+			180, 112, 255, 66, 34, 171, 141, 53, 178, 229, 7, 141, 241, 228,
+			128, 64, 122, 191, 193, 218, 9, 78, 147, 4, 207, 127, 78, 142,
+			162, 208, 50, 153, 125, 133, 54, 79, 35, 56, 75, 106, 64, 70,
+			165, 219, 35, 156, 83, 174, 159, 92, 182, 3, 232, 233, 85, 47,
+			8, 233, 215, 235, 227, 56, 41, 20, 220, 217, 242, 216, 92, 22,
+			180, 36, 149, 144, 111, 172, 70, 169, 89, 245, 17, 63, 225, 214,
+			73, 161, 52, 29, 78, 171, 51, 236, 223, 239, 213, 70, 199, 132,
+			12, 182, 59, 234, 116, 113, 84, 213, 45, 88, 161, 152, 60, 140,
+			48, 22, 118, 127, 42, 34, 39, 167, 92, 31, 18, 105, 34, 185,
+			189, 26, 177, 229, 133, 32, 202, 126, 76, 86, 119, 253, 58, 46,
+			105, 163, 42, 70, 130, 104, 143, 144, 157, 6, 83, 26, 204, 82,
+			127, 149, 139, 216, 82, 144, 2, 68, 89, 157, 182, 178, 143, 210,
+			149, 60, 28, 113, 30, 232, 9, 31, 142, 68, 42, 104, 245, 30,
+			206, 112, 178, 208, 62, 74, 22, 250, 112, 230, 10, 205, 136, 210,
+			176, 26, 100, 174, 60, 168, 31, 97, 53, 200, 92, 55, 70, 133,
+			119, 237, 62, 240, 180, 143, 102, 172, 103, 22, 222, 156, 146, 157,
+			134, 222, 137, 37, 218, 56, 62, 1, 178, 26, 180, 90, 94, 181,
+			221, 64, 2, 57, 218, 159, 80, 3, 21, 215, 42, 142, 193, 231,
+			88, 102, 237, 209, 154, 208, 127, 41, 151, 116, 26, 147, 132, 161,
+			4, 224, 98, 18, 42, 201, 243, 65, 226, 226, 35, 98, 169, 226,
+			213, 2, 160, 120, 250, 104, 4, 11, 40, 158, 62, 26, 193, 2,
+			138, 167, 143, 102, 56, 3, 85, 31, 37, 68, 249, 104, 166, 120,
+			157, 126, 204, 227, 87, 14, 229, 239, 163, 224, 138, 143, 102, 14,
+			223, 34, 190, 162, 96, 145, 113, 236, 79, 102, 172, 35, 133, 207,
+			199, 221, 59, 65, 105, 158, 54, 83, 148, 54, 2, 134, 63, 156,
+			33, 138, 51, 165, 62, 37, 151, 78, 66, 93, 13, 53, 232, 200,
+			62, 25, 1, 17, 249, 16, 62, 25, 1, 17, 58, 178, 79, 102,
+			216, 12, 213, 71, 58, 178, 79, 102, 216, 12, 213, 103, 101, 242,
+			248, 149, 205, 80, 125, 20, 158, 240, 201, 204, 193, 67, 226, 217,
+			4, 195, 172, 99, 127, 42, 99, 149, 10, 71, 126, 240, 162, 163,
+			234, 227, 89, 155, 70, 48, 143, 25, 60, 154, 169, 65, 99, 246,
+			169, 8, 215, 161, 49, 251, 84, 230, 138, 43, 245, 99, 30, 143,
+			133, 27, 245, 99, 14, 143, 215, 31, 84, 105, 69, 250, 44, 4,
+			82, 100, 172, 155, 85, 90, 17, 165, 5, 136, 184, 145, 218, 105,
+			197, 223, 88, 7, 160, 25, 123, 11, 243, 167, 4, 36, 58, 4,
+			8, 116, 161, 230, 45, 119, 86, 181, 151, 2, 172, 222, 48, 137,
+			27, 209, 162, 171, 222, 102, 8, 221, 28, 25, 79, 170, 45, 15,
+			242, 170, 239, 214, 163, 237, 128, 111, 247, 159, 70, 107, 134, 111,
+			247, 159, 70, 107, 134, 111, 247, 159, 102, 134, 180, 40, 9, 223,
+			238, 63, 205, 236, 191, 70, 63, 34, 90, 36, 83, 188, 73, 63,
+			210, 34, 199, 39, 196, 199, 104, 205, 168, 201, 248, 72, 198, 250,
+			66, 38, 93, 120, 40, 101, 162, 190, 2, 89, 153, 90, 156, 154,
+			69, 114, 183, 165, 133, 185, 115, 149, 19, 83, 165, 184, 109, 68,
+			35, 33, 71, 131, 68, 218, 245, 101, 191, 225, 182, 54, 203, 138,
+			196, 31, 197, 157, 5, 250, 123, 183, 209, 129, 90, 123, 226, 240,
+			196, 77, 37, 163, 69, 51, 233, 29, 241, 255, 116, 195, 93, 109,
+			129, 128, 224, 78, 101, 88, 235, 166, 215, 102, 167, 4, 200, 234,
+			196, 215, 228, 232, 242, 161, 137, 103, 220, 124, 203, 173, 55, 221,
+			252, 172, 91, 13, 5, 164, 20, 211, 143, 100, 114, 187, 89, 215,
+			64, 21, 35, 255, 44, 99, 23, 88, 154, 180, 72, 240, 253, 179,
+			72, 249, 96, 81, 208, 209, 159, 101, 246, 92, 105, 122, 164, 28,
+			251, 115, 241, 30, 144, 110, 63, 23, 239, 1, 233, 246, 115, 241,
+			30, 150, 99, 127, 62, 99, 95, 105, 122, 96, 18, 159, 207, 112,
+			73, 1, 122, 65, 45, 174, 128, 162, 20, 184, 159, 119, 236, 71,
+			51, 92, 123, 178, 143, 114, 140, 62, 26, 109, 42, 138, 183, 61,
+			154, 225, 58, 143, 125, 84, 188, 237, 209, 204, 94, 77, 153, 16,
+			170, 247, 104, 230, 192, 152, 248, 53, 184, 185, 246, 161, 250, 217,
+			95, 103, 172, 191, 203, 164, 11, 191, 108, 177, 148, 23, 211, 91,
+			177, 71, 40, 100, 4, 29, 216, 223, 141, 200, 208, 237, 64, 56,
+			38, 21, 162, 9, 92, 19, 216, 51, 227, 111, 65, 33, 63, 74,
+			180, 236, 52, 180, 63, 91, 28, 125, 89, 207, 6, 217, 177, 190,
+			25, 217, 50, 26, 90, 191, 10, 10, 232, 54, 162, 88, 15, 154,
+			204, 102, 249, 7, 67, 15, 241, 164, 248, 241, 212, 208, 3, 44,
+			240, 175, 51, 185, 43, 120, 235, 210, 64, 143, 191, 201, 216, 87,
+			241, 78, 169, 10, 110, 127, 147, 225, 108, 240, 125, 168, 225, 134,
+			250, 26, 153, 194, 94, 211, 3, 1, 20, 209, 102, 171, 106, 67,
+			95, 138, 54, 59, 77, 234, 174, 47, 129, 175, 234, 30, 150, 99,
+			255, 109, 198, 190, 206, 52, 0, 122, 252, 109, 198, 222, 31, 189,
+			160, 22, 197, 107, 77, 15, 68, 49, 196, 103, 133, 105, 127, 57,
+			62, 43, 84, 253, 248, 114, 124, 86, 182, 99, 63, 22, 33, 109,
+			154, 248, 219, 99, 17, 210, 166, 81, 116, 213, 126, 44, 66, 218,
+			52, 172, 39, 95, 137, 247, 200, 32, 167, 87, 188, 71, 6, 165,
+			53, 226, 61, 178, 142, 253, 213, 248, 202, 65, 99, 191, 26, 95,
+			57, 138, 113, 124, 53, 190, 242, 156, 99, 127, 45, 190, 80, 80,
+			168, 175, 197, 63, 154, 179, 240, 226, 234, 125, 166, 71, 222, 177,
+			191, 30, 95, 57, 208, 255, 235, 241, 149, 163, 24, 199, 215, 227,
+			43, 239, 117, 236, 111, 100, 236, 189, 166, 1, 34, 66, 190, 145,
+			177, 119, 71, 47, 44, 188, 224, 140, 90, 125, 86, 175, 99, 127,
+			51, 99, 141, 241, 121, 66, 116, 201, 55, 163, 195, 135, 180, 188,
+			223, 204, 112, 98, 176, 62, 26, 235, 155, 25, 78, 12, 214, 71,
+			209, 37, 223, 204, 220, 48, 170, 110, 227, 200, 102, 248, 237, 140,
+			245, 15, 250, 54, 78, 201, 250, 190, 157, 17, 188, 124, 228, 214,
+			115, 236, 239, 100, 236, 107, 212, 76, 240, 140, 244, 93, 25, 78,
+			158, 71, 47, 144, 192, 43, 211, 207, 16, 228, 244, 124, 223, 201,
+			236, 226, 245, 227, 5, 146, 120, 101, 246, 75, 81, 228, 49, 97,
+			87, 201, 216, 251, 138, 142, 92, 244, 238, 107, 211, 213, 72, 103,
+			217, 54, 157, 82, 54, 53, 138, 190, 3, 221, 218, 119, 51, 92,
+			82, 174, 143, 147, 244, 125, 55, 179, 147, 247, 146, 147, 244, 125,
+			55, 115, 213, 213, 12, 36, 225, 216, 223, 203, 88, 154, 117, 35,
+			178, 227, 123, 17, 144, 68, 22, 143, 156, 44, 172, 143, 34, 59,
+			190, 151, 41, 104, 114, 38, 210, 120, 28, 63, 68, 70, 186, 62,
+			107, 135, 99, 63, 158, 249, 97, 141, 116, 125, 20, 121, 241, 120,
+			244, 233, 29, 89, 60, 178, 145, 174, 207, 130, 139, 226, 227, 25,
+			54, 210, 245, 81, 228, 197, 227, 25, 54, 210, 245, 89, 125, 142,
+			253, 68, 230, 71, 103, 164, 235, 163, 184, 136, 39, 162, 201, 244,
+			101, 241, 200, 70, 186, 62, 11, 38, 175, 39, 50, 108, 164, 235,
+			163, 184, 136, 39, 50, 108, 164, 235, 131, 175, 125, 230, 233, 49,
+			210, 245, 193, 72, 247, 175, 25, 54, 210, 245, 145, 145, 238, 95,
+			51, 108, 164, 235, 35, 35, 221, 191, 102, 118, 161, 96, 111, 218,
+			238, 235, 117, 236, 23, 103, 173, 254, 226, 160, 9, 122, 186, 23,
+			44, 130, 71, 130, 63, 208, 139, 179, 108, 170, 239, 35, 127, 160,
+			248, 163, 197, 143, 183, 242, 72, 255, 57, 107, 13, 20, 75, 38,
+			221, 139, 146, 111, 75, 44, 231, 46, 5, 203, 72, 177, 220, 246,
+			150, 106, 193, 82, 35, 104, 47, 117, 66, 243, 25, 20, 117, 206,
+			178, 15, 106, 95, 111, 170, 235, 209, 226, 71, 88, 21, 251, 157,
+			236, 207, 100, 123, 254, 11, 91, 21, 17, 243, 241, 51, 217, 252,
+			78, 194, 173, 126, 156, 175, 151, 100, 173, 107, 127, 56, 220, 234,
+			167, 227, 248, 146, 44, 111, 103, 63, 29, 198, 151, 100, 25, 183,
+			250, 233, 40, 190, 36, 203, 184, 213, 79, 7, 241, 37, 217, 107,
+			138, 132, 91, 253, 32, 225, 47, 207, 254, 232, 112, 171, 159, 180,
+			225, 47, 143, 38, 131, 212, 126, 47, 207, 50, 110, 245, 19, 147,
+			121, 121, 150, 113, 171, 159, 180, 225, 47, 207, 50, 110, 245, 195,
+			11, 62, 251, 244, 224, 86, 63, 112, 235, 85, 89, 198, 173, 126,
+			194, 173, 87, 101, 25, 183, 250, 9, 183, 94, 149, 101, 3, 240,
+			128, 147, 125, 117, 182, 231, 215, 120, 171, 16, 78, 242, 234, 108,
+			126, 68, 156, 23, 182, 61, 128, 173, 122, 32, 107, 201, 66, 197,
+			84, 134, 228, 41, 233, 248, 69, 14, 21, 9, 54, 224, 95, 4,
+			149, 76, 100, 236, 37, 195, 10, 92, 62, 76, 164, 16, 180, 34,
+			194, 144, 61, 64, 108, 128, 118, 243, 1, 13, 192, 1, 114, 226,
+			124, 32, 203, 178, 241, 0, 237, 230, 3, 217, 161, 189, 250, 49,
+			141, 249, 236, 219, 47, 254, 22, 210, 239, 0, 182, 243, 13, 89,
+			235, 72, 225, 145, 232, 70, 199, 89, 67, 159, 166, 11, 29, 186,
+			227, 11, 79, 247, 125, 78, 87, 27, 85, 203, 6, 146, 189, 33,
+			130, 17, 216, 194, 27, 34, 24, 1, 201, 222, 144, 229, 235, 220,
+			0, 33, 217, 27, 178, 124, 157, 27, 176, 82, 121, 252, 202, 215,
+			185, 1, 114, 208, 124, 67, 246, 224, 33, 242, 9, 26, 232, 117,
+			236, 55, 102, 159, 196, 39, 104, 0, 148, 229, 141, 154, 148, 12,
+			16, 101, 137, 63, 90, 252, 248, 9, 72, 181, 3, 208, 248, 62,
+			152, 181, 230, 10, 191, 99, 253, 159, 225, 19, 164, 156, 113, 42,
+			68, 145, 105, 111, 245, 90, 35, 147, 71, 210, 253, 38, 230, 125,
+			35, 158, 196, 253, 102, 128, 108, 17, 15, 70, 59, 14, 169, 239,
+			193, 104, 199, 129, 247, 15, 102, 217, 253, 102, 128, 252, 72, 31,
+			204, 222, 122, 84, 63, 230, 241, 235, 177, 89, 146, 103, 240, 72,
+			63, 223, 118, 150, 168, 239, 0, 26, 191, 237, 135, 166, 190, 3,
+			164, 171, 122, 91, 52, 179, 116, 22, 195, 49, 245, 29, 160, 116,
+			148, 111, 211, 212, 119, 128, 116, 85, 111, 211, 212, 119, 0, 139,
+			122, 231, 143, 144, 250, 14, 144, 151, 210, 59, 163, 201, 160, 66,
+			254, 59, 53, 245, 29, 32, 97, 250, 157, 154, 250, 14, 144, 178,
+			232, 157, 154, 250, 34, 138, 238, 61, 79, 19, 245, 29, 0, 245,
+			125, 143, 166, 190, 3, 68, 125, 223, 163, 169, 239, 0, 81, 223,
+			247, 104, 234, 59, 232, 100, 223, 155, 237, 249, 125, 166, 190, 131,
+			41, 199, 126, 111, 54, 191, 71, 252, 19, 232, 219, 32, 200, 239,
+			67, 160, 111, 95, 233, 162, 111, 68, 66, 159, 126, 42, 167, 72,
+			245, 211, 237, 68, 29, 125, 73, 83, 188, 65, 226, 10, 15, 233,
+			141, 29, 36, 174, 240, 144, 198, 255, 65, 226, 10, 15, 105, 138,
+			55, 72, 60, 254, 33, 77, 241, 6, 173, 158, 60, 160, 198, 20,
+			111, 80, 185, 246, 131, 226, 1, 253, 7, 113, 116, 222, 247, 67,
+			163, 255, 32, 241, 251, 247, 69, 19, 3, 191, 127, 159, 70, 255,
+			65, 226, 247, 239, 211, 232, 63, 72, 164, 248, 125, 64, 255, 95,
+			87, 219, 105, 57, 246, 7, 178, 214, 77, 133, 255, 214, 173, 160,
+			210, 186, 115, 181, 17, 81, 2, 154, 228, 126, 111, 171, 182, 18,
+			219, 234, 173, 126, 80, 181, 149, 216, 70, 111, 53, 72, 84, 232,
+			3, 209, 98, 65, 133, 62, 16, 237, 2, 64, 249, 129, 44, 235,
+			173, 6, 137, 10, 125, 32, 187, 95, 234, 199, 60, 126, 189, 102,
+			66, 255, 154, 195, 99, 233, 8, 201, 97, 131, 104, 251, 240, 143,
+			144, 18, 12, 18, 89, 122, 56, 154, 42, 200, 210, 195, 154, 18,
+			12, 18, 89, 122, 88, 83, 130, 65, 34, 75, 15, 107, 74, 128,
+			152, 212, 143, 60, 77, 148, 96, 16, 148, 224, 35, 154, 18, 12,
+			18, 37, 248, 136, 166, 4, 131, 68, 9, 62, 162, 41, 193, 144,
+			147, 253, 88, 182, 231, 11, 76, 9, 16, 29, 250, 177, 108, 126,
+			55, 17, 237, 33, 16, 130, 79, 100, 127, 216, 235, 216, 16, 29,
+			167, 79, 104, 232, 12, 145, 200, 252, 9, 141, 181, 67, 116, 156,
+			62, 161, 177, 118, 136, 142, 211, 39, 178, 215, 92, 43, 62, 159,
+			166, 111, 167, 28, 251, 179, 89, 107, 34, 30, 195, 161, 147, 126,
+			60, 173, 20, 136, 63, 242, 116, 147, 31, 254, 76, 88, 22, 19,
+			95, 73, 177, 106, 75, 101, 148, 214, 169, 13, 229, 17, 147, 26,
+			244, 166, 9, 157, 141, 58, 42, 93, 168, 148, 224, 7, 66, 105,
+			212, 224, 149, 249, 19, 112, 229, 95, 105, 185, 235, 30, 60, 219,
+			203, 18, 121, 92, 221, 102, 80, 15, 86, 113, 153, 64, 175, 181,
+			192, 109, 213, 216, 5, 41, 140, 149, 117, 13, 100, 208, 105, 133,
+			94, 253, 162, 23, 178, 111, 190, 148, 27, 158, 74, 190, 162, 203,
+			122, 168, 131, 78, 41, 106, 169, 96, 15, 139, 62, 27, 112, 27,
+			168, 250, 108, 252, 212, 110, 135, 243, 108, 134, 197, 64, 199, 201,
+			233, 75, 31, 240, 33, 162, 102, 159, 141, 240, 2, 130, 229, 103,
+			245, 1, 31, 34, 106, 246, 89, 77, 102, 135, 136, 154, 125, 54,
+			203, 185, 31, 134, 72, 176, 252, 108, 150, 115, 63, 12, 145, 96,
+			249, 217, 236, 141, 135, 233, 128, 15, 65, 20, 124, 228, 71, 120,
+			192, 135, 136, 22, 61, 18, 77, 21, 222, 25, 143, 232, 3, 62,
+			68, 222, 25, 143, 232, 3, 62, 68, 180, 232, 17, 125, 192, 135,
+			50, 142, 253, 249, 167, 233, 128, 15, 225, 128, 127, 94, 31, 240,
+			33, 58, 224, 159, 215, 7, 124, 136, 14, 248, 231, 245, 1, 31,
+			118, 178, 143, 102, 123, 254, 153, 15, 56, 162, 158, 31, 205, 230,
+			119, 137, 71, 112, 202, 134, 113, 194, 31, 195, 41, 123, 52, 58,
+			101, 108, 97, 126, 90, 15, 153, 250, 198, 211, 125, 198, 216, 198,
+			252, 127, 222, 17, 27, 38, 210, 251, 152, 198, 219, 97, 146, 100,
+			30, 211, 71, 108, 152, 72, 239, 99, 250, 136, 13, 19, 233, 125,
+			76, 31, 177, 97, 146, 100, 30, 211, 71, 108, 152, 36, 153, 199,
+			112, 196, 190, 5, 113, 98, 24, 238, 186, 223, 200, 90, 223, 202,
+			166, 147, 225, 117, 236, 150, 80, 243, 198, 85, 106, 187, 113, 232,
+			247, 229, 40, 10, 245, 193, 89, 192, 111, 200, 51, 139, 139, 243,
+			80, 126, 212, 97, 130, 29, 83, 155, 95, 67, 252, 66, 27, 161,
+			180, 2, 207, 13, 207, 135, 231, 194, 179, 85, 91, 164, 217, 170,
+			1, 230, 145, 75, 133, 203, 49, 81, 38, 230, 232, 244, 212, 34,
+			144, 99, 153, 64, 15, 183, 4, 161, 183, 93, 5, 226, 206, 159,
+			139, 253, 30, 125, 78, 55, 138, 59, 223, 198, 29, 160, 230, 231,
+			22, 22, 53, 48, 201, 33, 249, 27, 89, 182, 3, 12, 195, 223,
+			216, 177, 255, 46, 203, 122, 231, 97, 246, 63, 254, 187, 44, 235,
+			157, 233, 133, 133, 23, 133, 189, 226, 58, 238, 145, 114, 236, 191,
+			207, 218, 123, 138, 59, 105, 41, 184, 217, 69, 115, 17, 166, 27,
+			72, 223, 223, 103, 185, 236, 239, 48, 59, 195, 254, 125, 118, 247,
+			21, 226, 153, 60, 142, 229, 216, 223, 204, 218, 35, 197, 3, 113,
+			208, 129, 96, 71, 137, 219, 145, 184, 138, 237, 26, 97, 52, 52,
+			72, 213, 55, 179, 92, 60, 121, 152, 189, 102, 191, 153, 165, 122,
+			219, 32, 4, 41, 39, 251, 237, 172, 245, 157, 236, 13, 188, 235,
+			160, 209, 223, 142, 16, 8, 18, 231, 183, 179, 172, 234, 30, 38,
+			26, 253, 237, 236, 85, 227, 250, 17, 73, 108, 178, 156, 49, 114,
+			152, 104, 244, 119, 178, 217, 235, 245, 99, 206, 177, 191, 147, 29,
+			190, 142, 132, 138, 97, 124, 247, 123, 63, 180, 80, 49, 76, 20,
+			249, 123, 209, 196, 64, 145, 191, 167, 133, 138, 97, 162, 200, 223,
+			211, 66, 197, 48, 81, 228, 239, 101, 89, 199, 59, 140, 167, 199,
+			127, 132, 236, 97, 152, 228, 191, 199, 163, 201, 64, 254, 123, 92,
+			179, 135, 97, 146, 255, 30, 215, 236, 97, 152, 228, 191, 199, 53,
+			123, 64, 54, 138, 39, 158, 38, 246, 48, 12, 246, 240, 132, 102,
+			15, 195, 196, 30, 158, 208, 236, 97, 152, 216, 195, 19, 96, 15,
+			175, 76, 35, 123, 167, 147, 253, 153, 92, 207, 235, 115, 169, 194,
+			63, 91, 114, 82, 15, 26, 185, 55, 131, 146, 185, 60, 147, 200,
+			215, 187, 21, 153, 211, 12, 148, 216, 89, 3, 78, 26, 2, 46,
+			116, 158, 219, 162, 169, 235, 85, 144, 86, 68, 23, 31, 211, 105,
+			227, 76, 124, 245, 209, 163, 243, 106, 96, 206, 145, 203, 229, 66,
+			16, 60, 57, 31, 4, 117, 93, 138, 35, 100, 178, 186, 177, 166,
+			131, 155, 48, 193, 168, 45, 231, 54, 10, 203, 137, 180, 106, 93,
+			83, 240, 27, 178, 182, 165, 135, 74, 38, 46, 91, 30, 82, 86,
+			43, 71, 168, 104, 216, 163, 71, 121, 136, 209, 49, 69, 169, 154,
+			173, 160, 214, 169, 110, 109, 118, 34, 104, 110, 46, 6, 163, 99,
+			99, 202, 245, 174, 1, 7, 54, 117, 46, 207, 197, 115, 245, 115,
+			250, 6, 193, 232, 190, 206, 121, 172, 145, 147, 227, 103, 114, 249,
+			189, 226, 15, 161, 218, 114, 96, 52, 122, 69, 206, 250, 47, 185,
+			116, 225, 119, 97, 176, 229, 116, 32, 76, 171, 18, 201, 255, 121,
+			137, 101, 169, 234, 59, 112, 174, 83, 179, 139, 33, 101, 251, 93,
+			5, 9, 37, 127, 109, 87, 214, 130, 246, 184, 206, 255, 91, 211,
+			1, 224, 126, 184, 100, 16, 77, 23, 108, 148, 254, 202, 74, 172,
+			119, 124, 200, 134, 136, 240, 82, 142, 214, 188, 70, 96, 110, 145,
+			170, 130, 46, 132, 204, 4, 14, 224, 88, 135, 221, 97, 146, 99,
+			101, 33, 167, 202, 171, 229, 210, 191, 151, 207, 43, 174, 4, 65,
+			177, 164, 220, 210, 159, 95, 146, 207, 43, 46, 187, 173, 242, 178,
+			123, 127, 177, 68, 147, 161, 87, 235, 177, 38, 242, 69, 177, 25,
+			9, 137, 238, 229, 81, 238, 51, 86, 70, 75, 62, 172, 14, 89,
+			220, 94, 145, 19, 14, 89, 220, 28, 101, 113, 123, 101, 206, 46,
+			18, 73, 116, 216, 226, 246, 202, 28, 91, 220, 28, 46, 136, 245,
+			202, 28, 91, 194, 28, 182, 184, 189, 50, 183, 243, 234, 232, 69,
+			26, 47, 228, 53, 102, 76, 168, 188, 115, 246, 181, 166, 1, 232,
+			232, 171, 226, 99, 66, 218, 125, 85, 174, 127, 56, 122, 65, 93,
+			156, 125, 209, 139, 52, 94, 92, 83, 164, 179, 236, 96, 150, 63,
+			151, 179, 84, 209, 22, 135, 24, 251, 207, 229, 152, 226, 56, 116,
+			167, 250, 185, 220, 142, 17, 253, 152, 66, 227, 157, 123, 116, 227,
+			52, 30, 247, 94, 37, 126, 25, 172, 219, 1, 113, 252, 249, 156,
+			117, 0, 197, 171, 163, 26, 172, 79, 130, 77, 37, 236, 212, 198,
+			154, 219, 38, 44, 134, 233, 135, 154, 182, 17, 89, 232, 223, 143,
+			208, 83, 95, 215, 79, 169, 65, 144, 115, 17, 104, 72, 136, 199,
+			142, 106, 101, 57, 197, 89, 45, 184, 156, 181, 58, 188, 172, 82,
+			224, 234, 216, 122, 45, 0, 213, 207, 71, 75, 3, 160, 126, 62,
+			199, 158, 208, 14, 177, 156, 159, 207, 113, 214, 38, 135, 128, 244,
+			243, 185, 235, 111, 96, 32, 89, 142, 253, 64, 142, 237, 180, 14,
+			241, 136, 7, 162, 145, 160, 65, 120, 32, 26, 9, 96, 120, 32,
+			231, 92, 167, 31, 161, 221, 207, 29, 24, 229, 145, 144, 139, 38,
+			199, 165, 160, 28, 34, 240, 175, 141, 70, 130, 143, 220, 107, 115,
+			28, 245, 228, 16, 129, 127, 109, 110, 248, 90, 253, 72, 125, 111,
+			56, 192, 35, 217, 142, 253, 186, 28, 151, 194, 115, 72, 105, 248,
+			186, 104, 36, 120, 152, 189, 46, 154, 19, 148, 134, 175, 203, 113,
+			50, 30, 135, 148, 134, 175, 139, 80, 32, 227, 216, 191, 144, 227,
+			34, 63, 14, 133, 34, 254, 66, 52, 18, 220, 172, 126, 33, 154,
+			83, 38, 133, 199, 97, 141, 47, 153, 52, 30, 229, 53, 60, 82,
+			214, 177, 127, 49, 199, 117, 157, 28, 138, 35, 252, 197, 104, 36,
+			36, 36, 253, 197, 104, 78, 176, 216, 255, 98, 206, 185, 70, 63,
+			166, 241, 120, 221, 245, 226, 207, 193, 54, 70, 156, 236, 131, 185,
+			158, 127, 206, 165, 10, 79, 88, 74, 33, 61, 5, 227, 1, 71,
+			62, 201, 85, 229, 154, 44, 71, 33, 131, 41, 49, 199, 39, 23,
+			100, 21, 110, 166, 35, 187, 195, 49, 92, 108, 5, 41, 151, 160,
+			220, 166, 138, 223, 92, 105, 134, 243, 80, 176, 15, 138, 23, 171,
+			90, 195, 50, 3, 41, 169, 56, 24, 143, 52, 140, 224, 28, 107,
+			168, 244, 219, 94, 83, 209, 52, 244, 49, 246, 139, 14, 215, 130,
+			86, 155, 232, 28, 114, 138, 30, 184, 104, 74, 131, 172, 187, 247,
+			81, 98, 99, 242, 101, 29, 71, 129, 8, 114, 15, 172, 185, 42,
+			149, 12, 201, 234, 72, 209, 112, 209, 147, 157, 16, 178, 228, 166,
+			138, 179, 35, 235, 18, 156, 19, 171, 94, 195, 109, 249, 129, 10,
+			206, 80, 193, 115, 122, 134, 224, 1, 84, 134, 173, 230, 193, 99,
+			151, 235, 107, 24, 105, 86, 201, 243, 20, 182, 12, 163, 133, 31,
+			150, 228, 90, 208, 244, 168, 56, 122, 76, 246, 167, 211, 183, 134,
+			243, 21, 203, 229, 65, 166, 65, 49, 49, 247, 163, 41, 16, 116,
+			138, 197, 48, 197, 134, 144, 159, 233, 193, 92, 30, 148, 210, 182,
+			71, 32, 241, 191, 37, 103, 189, 51, 167, 92, 23, 70, 72, 30,
+			126, 75, 46, 183, 147, 136, 222, 8, 196, 93, 199, 126, 107, 142,
+			61, 55, 70, 88, 30, 126, 107, 142, 61, 55, 70, 88, 30, 126,
+			107, 142, 61, 55, 240, 2, 10, 251, 156, 61, 108, 26, 224, 116,
+			191, 77, 147, 201, 17, 150, 124, 223, 150, 27, 28, 50, 61, 44,
+			199, 126, 123, 188, 7, 78, 241, 219, 227, 61, 32, 88, 190, 61,
+			222, 3, 5, 150, 115, 246, 30, 211, 0, 167, 245, 29, 57, 150,
+			174, 233, 133, 133, 23, 187, 33, 215, 219, 246, 136, 213, 227, 100,
+			223, 149, 179, 126, 35, 103, 171, 101, 18, 169, 125, 151, 62, 29,
+			35, 68, 106, 223, 149, 227, 18, 88, 35, 68, 106, 223, 149, 187,
+			242, 128, 126, 76, 59, 246, 187, 114, 7, 111, 20, 131, 234, 215,
+			60, 6, 43, 253, 70, 46, 173, 22, 96, 245, 228, 135, 29, 251,
+			221, 57, 246, 239, 24, 177, 122, 242, 35, 80, 215, 27, 168, 225,
+			69, 202, 177, 127, 45, 103, 239, 51, 47, 118, 246, 56, 246, 175,
+			231, 236, 169, 232, 69, 202, 177, 223, 155, 120, 97, 57, 246, 255,
+			200, 217, 39, 120, 163, 82, 78, 246, 55, 115, 214, 251, 204, 70,
+			1, 170, 191, 153, 203, 13, 50, 72, 40, 226, 227, 161, 104, 95,
+			84, 96, 199, 67, 57, 118, 227, 25, 225, 192, 142, 135, 114, 236,
+			198, 131, 23, 40, 66, 144, 227, 248, 138, 17, 142, 222, 248, 173,
+			28, 199, 235, 141, 112, 244, 198, 111, 229, 56, 94, 15, 47, 44,
+			199, 254, 237, 156, 61, 104, 26, 96, 163, 126, 59, 199, 193, 153,
+			35, 28, 162, 241, 219, 185, 254, 1, 6, 123, 202, 201, 190, 63,
+			103, 125, 208, 128, 29, 108, 224, 253, 17, 216, 113, 243, 120, 191,
+			230, 112, 35, 196, 6, 222, 15, 14, 204, 143, 105, 252, 42, 175,
+			97, 176, 167, 242, 24, 172, 248, 65, 3, 246, 20, 192, 254, 59,
+			17, 216, 83, 4, 246, 223, 141, 160, 156, 34, 176, 255, 94, 252,
+			5, 192, 254, 1, 0, 213, 188, 64, 146, 254, 156, 61, 201, 80,
+			182, 156, 236, 135, 114, 214, 71, 13, 148, 177, 192, 15, 229, 114,
+			87, 49, 4, 200, 139, 240, 195, 57, 123, 148, 23, 108, 17, 148,
+			63, 172, 133, 130, 17, 246, 34, 252, 112, 238, 134, 3, 166, 7,
+			20, 180, 17, 204, 148, 23, 225, 71, 34, 152, 41, 47, 194, 143,
+			40, 152, 1, 185, 201, 9, 236, 247, 163, 227, 160, 188, 8, 127,
+			63, 58, 14, 22, 183, 160, 227, 0, 228, 182, 156, 236, 199, 114,
+			214, 31, 26, 40, 131, 69, 126, 44, 130, 50, 174, 81, 31, 203,
+			113, 113, 192, 17, 26, 237, 99, 185, 107, 158, 165, 31, 211, 248,
+			245, 216, 109, 12, 101, 43, 143, 193, 110, 255, 67, 3, 101, 11,
+			80, 254, 131, 8, 202, 22, 65, 249, 227, 17, 114, 91, 4, 229,
+			79, 68, 80, 182, 8, 202, 255, 255, 8, 151, 45, 66, 238, 79,
+			70, 184, 156, 118, 178, 159, 202, 89, 127, 106, 160, 140, 211, 251,
+			169, 92, 110, 23, 67, 128, 156, 241, 62, 157, 179, 37, 47, 88,
+			57, 227, 125, 58, 199, 190, 93, 35, 236, 140, 247, 233, 220, 190,
+			253, 166, 7, 114, 173, 71, 80, 86, 206, 120, 127, 28, 65, 89,
+			57, 227, 253, 113, 4, 101, 114, 198, 251, 147, 8, 251, 211, 4,
+			151, 63, 137, 176, 63, 77, 80, 254, 19, 133, 253, 128, 114, 218,
+			201, 126, 38, 103, 125, 206, 64, 25, 226, 195, 103, 34, 40, 227,
+			126, 248, 153, 28, 251, 66, 141, 144, 248, 240, 153, 92, 97, 76,
+			63, 166, 241, 107, 105, 156, 161, 156, 206, 99, 176, 242, 231, 12,
+			148, 211, 128, 242, 103, 35, 40, 167, 9, 202, 255, 87, 4, 229,
+			52, 65, 249, 127, 69, 80, 78, 19, 148, 31, 1, 234, 154, 23,
+			240, 104, 141, 160, 108, 59, 217, 47, 228, 172, 255, 219, 64, 25,
+			114, 200, 23, 114, 236, 17, 59, 98, 219, 128, 242, 159, 155, 79,
+			226, 25, 249, 57, 114, 172, 251, 160, 6, 200, 208, 145, 219, 47,
+			77, 15, 104, 24, 35, 178, 107, 19, 148, 31, 141, 200, 174, 77,
+			80, 126, 84, 145, 93, 64, 217, 6, 12, 255, 34, 103, 59, 166,
+			1, 160, 252, 23, 17, 216, 225, 18, 139, 23, 67, 195, 12, 101,
+			219, 201, 126, 49, 103, 125, 201, 64, 25, 162, 213, 23, 35, 40,
+			195, 30, 251, 197, 28, 251, 196, 142, 144, 61, 246, 139, 185, 189,
+			55, 234, 199, 52, 126, 45, 31, 98, 40, 219, 121, 12, 118, 248,
+			75, 6, 202, 54, 160, 252, 151, 17, 148, 109, 130, 242, 95, 69,
+			80, 182, 9, 202, 127, 29, 65, 217, 38, 40, 255, 77, 206, 158,
+			97, 160, 102, 156, 236, 151, 115, 214, 215, 12, 80, 33, 146, 125,
+			57, 151, 27, 230, 5, 103, 0, 212, 199, 34, 24, 170, 50, 211,
+			143, 229, 88, 127, 68, 47, 44, 188, 96, 191, 69, 188, 128, 187,
+			101, 142, 181, 55, 35, 92, 78, 250, 43, 57, 174, 134, 64, 47,
+			224, 127, 153, 235, 235, 55, 61, 44, 199, 254, 106, 116, 26, 50,
+			132, 186, 95, 205, 177, 167, 35, 189, 160, 22, 87, 22, 24, 168,
+			25, 39, 251, 245, 156, 245, 29, 3, 84, 72, 153, 95, 143, 128,
+			154, 201, 226, 145, 245, 44, 35, 148, 240, 226, 235, 185, 221, 215,
+			232, 199, 52, 126, 189, 238, 122, 6, 106, 38, 143, 193, 110, 248,
+			142, 1, 106, 6, 64, 253, 70, 4, 212, 12, 1, 245, 239, 18,
+			47, 160, 251, 138, 128, 154, 201, 143, 64, 215, 21, 127, 1, 40,
+			127, 43, 103, 207, 69, 47, 160, 133, 202, 217, 199, 149, 204, 210,
+			235, 216, 255, 144, 179, 84, 78, 169, 17, 120, 144, 252, 67, 110,
+			199, 128, 154, 31, 121, 144, 196, 31, 45, 126, 132, 59, 202, 72,
+			198, 177, 255, 49, 103, 57, 69, 135, 116, 132, 243, 156, 31, 30,
+			1, 252, 202, 29, 101, 4, 234, 148, 127, 204, 177, 254, 100, 132,
+			212, 41, 241, 71, 139, 31, 37, 15, 246, 79, 24, 108, 36, 57,
+			24, 130, 24, 245, 104, 41, 199, 254, 167, 168, 123, 170, 235, 209,
+			226, 199, 125, 60, 218, 227, 24, 109, 56, 57, 218, 233, 64, 143,
+			101, 57, 246, 227, 81, 103, 43, 149, 124, 212, 191, 94, 203, 99,
+			125, 63, 103, 21, 138, 187, 41, 134, 194, 168, 180, 219, 94, 216,
+			70, 76, 27, 15, 136, 196, 13, 185, 126, 166, 80, 25, 80, 168,
+			239, 71, 3, 194, 145, 248, 251, 185, 93, 87, 138, 163, 60, 224,
+			19, 57, 107, 164, 56, 78, 147, 211, 85, 195, 86, 253, 246, 90,
+			103, 185, 92, 13, 214, 15, 45, 119, 86, 72, 67, 115, 200, 148,
+			133, 243, 66, 253, 29, 56, 71, 230, 250, 29, 30, 216, 78, 37,
+			31, 45, 126, 124, 143, 37, 44, 123, 167, 147, 253, 169, 124, 207,
+			107, 243, 169, 194, 155, 160, 161, 98, 133, 81, 205, 168, 8, 35,
+			229, 127, 76, 131, 27, 114, 58, 5, 175, 141, 63, 88, 30, 55,
+			197, 93, 77, 33, 69, 21, 29, 21, 69, 218, 81, 80, 2, 11,
+			195, 240, 61, 51, 234, 13, 229, 67, 222, 21, 178, 30, 122, 20,
+			192, 197, 194, 126, 203, 11, 131, 122, 71, 151, 96, 140, 63, 199,
+			12, 235, 74, 217, 132, 226, 95, 1, 92, 212, 16, 198, 143, 219,
+			128, 12, 61, 183, 85, 93, 139, 44, 36, 213, 122, 16, 122, 8,
+			188, 115, 219, 213, 53, 220, 168, 89, 15, 90, 226, 228, 144, 74,
+			243, 164, 243, 151, 183, 86, 61, 93, 10, 13, 108, 244, 167, 242,
+			249, 130, 248, 31, 80, 33, 237, 132, 10, 233, 165, 121, 235, 21,
+			249, 116, 225, 191, 1, 122, 200, 178, 69, 139, 196, 165, 98, 147,
+			75, 89, 242, 216, 58, 63, 96, 167, 225, 191, 16, 177, 149, 10,
+			118, 6, 160, 8, 109, 108, 135, 194, 188, 32, 143, 124, 10, 74,
+			228, 254, 81, 209, 69, 101, 233, 247, 106, 172, 56, 37, 140, 115,
+			185, 70, 53, 55, 46, 137, 228, 118, 185, 237, 39, 93, 183, 12,
+			90, 170, 30, 131, 138, 216, 227, 203, 145, 223, 54, 177, 97, 188,
+			45, 250, 234, 200, 161, 6, 156, 99, 19, 33, 139, 148, 244, 81,
+			186, 97, 85, 91, 178, 48, 32, 128, 200, 95, 96, 61, 209, 78,
+			210, 19, 189, 52, 47, 138, 68, 187, 118, 66, 201, 227, 216, 47,
+			203, 51, 169, 218, 201, 122, 162, 151, 229, 89, 214, 218, 201, 158,
+			217, 47, 203, 179, 103, 246, 78, 246, 204, 126, 89, 158, 61, 179,
+			119, 178, 103, 246, 203, 242, 204, 37, 241, 2, 46, 154, 121, 251,
+			122, 211, 0, 82, 239, 203, 227, 99, 66, 238, 125, 121, 190, 255,
+			138, 232, 5, 117, 217, 35, 163, 46, 240, 235, 204, 95, 123, 29,
+			157, 168, 157, 152, 229, 43, 243, 150, 178, 218, 236, 164, 203, 203,
+			43, 243, 76, 190, 119, 210, 229, 229, 149, 121, 174, 225, 191, 147,
+			24, 206, 43, 243, 215, 149, 244, 35, 180, 88, 249, 67, 135, 197,
+			79, 65, 79, 180, 19, 100, 228, 213, 121, 235, 64, 161, 77, 55,
+			103, 164, 25, 94, 239, 172, 235, 40, 11, 175, 102, 246, 100, 212,
+			84, 177, 31, 211, 206, 30, 8, 159, 117, 41, 172, 83, 165, 233,
+			65, 68, 177, 201, 14, 161, 239, 180, 170, 153, 142, 114, 38, 237,
+			168, 65, 42, 61, 99, 0, 228, 213, 209, 2, 0, 142, 87, 231,
+			119, 56, 250, 145, 166, 56, 82, 212, 143, 105, 60, 94, 127, 131,
+			120, 137, 90, 0, 212, 65, 88, 192, 125, 106, 1, 184, 225, 119,
+			214, 187, 144, 253, 169, 77, 222, 204, 93, 72, 119, 165, 237, 181,
+			182, 155, 123, 203, 171, 251, 8, 173, 220, 186, 8, 72, 217, 15,
+			68, 139, 176, 178, 120, 52, 139, 0, 156, 31, 136, 22, 1, 243,
+			196, 3, 249, 235, 111, 16, 95, 72, 9, 203, 222, 229, 100, 223,
+			152, 239, 249, 229, 222, 84, 225, 94, 57, 213, 168, 186, 205, 144,
+			171, 223, 250, 72, 85, 186, 174, 136, 158, 187, 12, 55, 156, 68,
+			134, 21, 78, 255, 3, 197, 170, 114, 86, 228, 108, 15, 168, 87,
+			93, 247, 34, 85, 53, 241, 57, 218, 43, 67, 253, 202, 98, 226,
+			252, 143, 70, 157, 48, 167, 211, 14, 242, 108, 72, 23, 132, 137,
+			43, 253, 194, 46, 56, 116, 230, 243, 187, 197, 79, 13, 11, 219,
+			222, 5, 212, 253, 120, 222, 186, 166, 240, 221, 33, 57, 41, 103,
+			2, 29, 195, 169, 117, 139, 32, 147, 77, 223, 83, 145, 209, 201,
+			17, 165, 27, 215, 36, 171, 204, 22, 80, 146, 180, 90, 94, 216,
+			12, 80, 33, 133, 146, 83, 196, 74, 175, 68, 37, 143, 53, 169,
+			136, 3, 20, 134, 101, 68, 225, 83, 84, 189, 202, 227, 208, 9,
+			161, 144, 145, 237, 64, 78, 159, 156, 10, 75, 250, 203, 53, 239,
+			62, 175, 21, 150, 186, 242, 51, 51, 44, 41, 246, 18, 12, 35,
+			244, 215, 253, 186, 219, 66, 116, 115, 64, 161, 121, 242, 84, 84,
+			110, 179, 36, 67, 119, 19, 54, 96, 58, 1, 200, 203, 90, 247,
+			162, 20, 145, 151, 76, 252, 235, 214, 77, 41, 179, 32, 48, 249,
+			30, 95, 36, 228, 140, 71, 57, 138, 131, 224, 2, 42, 238, 82,
+			129, 230, 200, 251, 42, 90, 247, 81, 113, 153, 161, 94, 192, 201,
+			35, 95, 240, 2, 243, 207, 11, 240, 207, 11, 208, 209, 229, 31,
+			151, 171, 244, 79, 205, 147, 114, 69, 202, 213, 53, 95, 192, 219,
+			196, 148, 26, 54, 37, 156, 100, 157, 247, 83, 229, 209, 12, 155,
+			110, 67, 74, 42, 215, 196, 3, 233, 255, 196, 213, 248, 82, 62,
+			207, 45, 249, 99, 82, 62, 79, 222, 92, 146, 135, 75, 114, 162,
+			36, 15, 203, 231, 163, 25, 157, 232, 141, 181, 160, 190, 117, 97,
+			101, 238, 184, 220, 213, 177, 36, 111, 70, 95, 116, 172, 187, 203,
+			94, 93, 142, 234, 213, 195, 242, 32, 229, 243, 170, 165, 218, 150,
+			46, 183, 232, 46, 164, 246, 30, 85, 96, 226, 246, 94, 105, 101,
+			75, 251, 35, 186, 61, 84, 140, 114, 116, 37, 8, 184, 241, 106,
+			105, 109, 75, 227, 155, 76, 99, 242, 14, 144, 163, 71, 198, 116,
+			140, 59, 192, 52, 46, 39, 13, 216, 56, 215, 147, 169, 32, 175,
+			211, 234, 240, 234, 253, 118, 232, 213, 87, 228, 168, 95, 246, 202,
+			58, 1, 39, 21, 42, 148, 113, 164, 39, 116, 213, 21, 141, 253,
+			246, 152, 65, 253, 208, 196, 82, 115, 129, 61, 205, 245, 57, 17,
+			17, 201, 71, 24, 172, 30, 172, 114, 162, 3, 175, 65, 178, 9,
+			167, 176, 226, 44, 68, 116, 40, 216, 68, 84, 150, 73, 36, 135,
+			239, 39, 101, 50, 18, 58, 31, 53, 37, 41, 170, 94, 144, 163,
+			205, 32, 12, 253, 229, 186, 41, 253, 13, 124, 49, 137, 4, 34,
+			211, 82, 180, 197, 225, 24, 235, 84, 37, 31, 154, 6, 199, 224,
+			27, 112, 109, 64, 171, 171, 240, 171, 229, 173, 176, 231, 5, 142,
+			65, 49, 146, 229, 138, 38, 43, 19, 6, 138, 18, 234, 128, 152,
+			34, 25, 28, 160, 85, 198, 54, 156, 213, 115, 209, 195, 135, 145,
+			157, 93, 135, 22, 208, 183, 52, 64, 215, 96, 247, 108, 132, 58,
+			101, 142, 130, 78, 12, 126, 92, 248, 215, 16, 155, 176, 9, 85,
+			51, 214, 160, 43, 114, 155, 229, 163, 120, 136, 150, 88, 229, 122,
+			16, 146, 195, 72, 176, 124, 209, 15, 58, 161, 6, 46, 54, 48,
+			90, 91, 173, 200, 112, 165, 28, 144, 166, 174, 177, 150, 202, 244,
+			200, 221, 219, 160, 75, 146, 171, 213, 144, 6, 190, 196, 250, 243,
+			21, 230, 30, 128, 249, 118, 171, 78, 162, 234, 129, 80, 29, 111,
+			157, 82, 66, 229, 62, 117, 101, 216, 89, 102, 172, 242, 219, 33,
+			208, 210, 107, 180, 185, 173, 194, 21, 53, 35, 70, 151, 216, 122,
+			60, 175, 8, 92, 76, 148, 94, 140, 3, 80, 27, 153, 149, 236,
+			136, 81, 150, 189, 85, 191, 209, 96, 127, 255, 237, 32, 3, 245,
+			59, 74, 100, 185, 144, 37, 151, 55, 187, 235, 140, 3, 11, 96,
+			31, 85, 245, 145, 9, 154, 132, 8, 119, 130, 152, 234, 138, 189,
+			238, 118, 43, 142, 47, 51, 12, 214, 117, 249, 216, 174, 150, 24,
+			217, 88, 206, 215, 61, 183, 193, 249, 226, 218, 106, 8, 88, 165,
+			189, 70, 205, 221, 230, 16, 201, 226, 106, 43, 232, 52, 139, 236,
+			34, 16, 98, 164, 229, 0, 73, 217, 40, 108, 151, 86, 166, 83,
+			123, 199, 79, 102, 12, 94, 241, 130, 172, 102, 9, 52, 80, 196,
+			48, 1, 58, 62, 48, 102, 88, 53, 40, 87, 50, 244, 91, 100,
+			178, 15, 26, 81, 170, 26, 100, 67, 169, 187, 77, 2, 212, 137,
+			168, 70, 89, 187, 197, 17, 12, 198, 176, 24, 125, 84, 155, 0,
+			155, 173, 96, 217, 5, 1, 136, 219, 72, 144, 88, 68, 178, 11,
+			152, 108, 211, 121, 214, 80, 210, 174, 25, 42, 77, 43, 146, 160,
+			151, 16, 17, 140, 84, 39, 81, 244, 114, 244, 149, 42, 236, 140,
+			24, 108, 25, 82, 90, 21, 119, 8, 227, 97, 162, 76, 53, 44,
+			170, 237, 34, 129, 249, 227, 90, 84, 219, 69, 2, 243, 199, 243,
+			172, 118, 222, 69, 2, 243, 199, 243, 59, 175, 210, 143, 105, 72,
+			44, 36, 206, 219, 246, 46, 220, 177, 62, 145, 183, 222, 216, 171,
+			20, 62, 187, 232, 6, 241, 137, 188, 112, 196, 43, 114, 34, 139,
+			223, 33, 225, 252, 101, 222, 46, 21, 254, 119, 86, 78, 71, 98,
+			13, 23, 197, 71, 129, 103, 70, 215, 75, 201, 104, 218, 59, 134,
+			48, 29, 209, 205, 122, 141, 96, 27, 83, 177, 204, 113, 236, 23,
+			23, 243, 114, 195, 117, 201, 16, 53, 46, 224, 183, 18, 180, 214,
+			73, 44, 106, 175, 145, 144, 168, 238, 98, 173, 32, 104, 111, 43,
+			37, 50, 66, 16, 69, 226, 82, 144, 124, 119, 99, 178, 172, 79,
+			98, 89, 36, 177, 150, 4, 125, 208, 71, 226, 253, 196, 204, 111,
+			34, 102, 254, 204, 146, 60, 34, 159, 47, 34, 18, 77, 13, 32,
+			0, 149, 89, 238, 89, 194, 118, 142, 222, 52, 38, 229, 161, 67,
+			212, 79, 39, 181, 46, 19, 58, 142, 62, 115, 140, 159, 169, 1,
+			134, 52, 13, 192, 129, 71, 163, 159, 169, 193, 145, 40, 173, 145,
+			62, 198, 219, 172, 52, 241, 113, 85, 220, 33, 14, 201, 155, 105,
+			150, 134, 3, 119, 131, 41, 209, 249, 118, 121, 51, 82, 246, 52,
+			182, 52, 83, 243, 223, 58, 248, 68, 114, 112, 242, 5, 238, 254,
+			130, 206, 196, 61, 193, 67, 111, 215, 136, 150, 191, 117, 248, 35,
+			219, 138, 125, 212, 150, 51, 123, 71, 180, 129, 130, 250, 20, 118,
+			224, 206, 30, 38, 8, 6, 78, 152, 193, 48, 149, 112, 104, 122,
+			69, 110, 120, 58, 70, 84, 225, 82, 221, 13, 219, 26, 39, 183,
+			108, 62, 118, 222, 160, 70, 23, 155, 78, 138, 118, 17, 173, 31,
+			141, 242, 69, 104, 26, 47, 244, 169, 81, 82, 29, 15, 160, 178,
+			249, 105, 47, 235, 117, 191, 26, 212, 131, 198, 24, 7, 60, 239,
+			226, 235, 251, 95, 234, 171, 246, 46, 118, 243, 248, 203, 124, 191,
+			19, 189, 72, 225, 197, 200, 21, 209, 139, 52, 94, 20, 246, 70,
+			47, 242, 120, 113, 213, 141, 98, 72, 228, 249, 133, 133, 55, 87,
+			31, 20, 159, 177, 248, 204, 195, 201, 18, 103, 254, 195, 150, 78,
+			3, 142, 77, 209, 101, 62, 218, 107, 240, 143, 164, 220, 203, 157,
+			150, 134, 84, 120, 148, 235, 189, 215, 253, 134, 87, 226, 191, 171,
+			65, 189, 179, 222, 40, 9, 233, 53, 106, 244, 67, 36, 191, 150,
+			20, 151, 65, 101, 82, 84, 65, 238, 172, 123, 53, 197, 197, 221,
+			48, 54, 208, 24, 138, 103, 32, 254, 2, 227, 32, 126, 140, 156,
+			48, 220, 150, 201, 141, 169, 194, 207, 52, 199, 80, 176, 135, 34,
+			74, 103, 187, 172, 110, 150, 101, 60, 1, 147, 223, 240, 20, 254,
+			169, 33, 25, 197, 72, 86, 148, 247, 123, 173, 96, 92, 121, 110,
+			142, 143, 199, 242, 99, 109, 6, 29, 197, 45, 54, 184, 182, 132,
+			91, 171, 9, 56, 224, 6, 74, 214, 96, 213, 64, 205, 15, 155,
+			117, 119, 147, 217, 144, 11, 241, 84, 167, 11, 221, 197, 106, 146,
+			199, 226, 123, 7, 47, 145, 199, 226, 123, 7, 125, 234, 99, 241,
+			189, 131, 167, 200, 99, 241, 189, 131, 123, 226, 99, 241, 189, 75,
+			33, 77, 192, 99, 249, 171, 15, 138, 183, 246, 241, 222, 89, 142,
+			253, 250, 94, 123, 172, 240, 218, 62, 100, 179, 32, 92, 93, 160,
+			139, 39, 248, 219, 116, 99, 37, 136, 93, 87, 164, 107, 60, 151,
+			227, 24, 171, 235, 158, 3, 40, 36, 145, 87, 131, 117, 22, 169,
+			137, 88, 250, 145, 127, 47, 64, 169, 181, 11, 9, 142, 205, 28,
+			66, 81, 87, 128, 10, 149, 117, 221, 118, 219, 173, 174, 41, 223,
+			129, 174, 246, 224, 6, 147, 168, 4, 234, 51, 39, 4, 182, 108,
+			243, 93, 36, 66, 13, 26, 161, 87, 237, 32, 12, 135, 154, 133,
+			37, 186, 31, 200, 70, 160, 112, 10, 87, 214, 11, 94, 163, 171,
+			151, 226, 198, 166, 189, 242, 219, 70, 170, 38, 144, 44, 55, 140,
+			144, 136, 63, 138, 9, 213, 61, 23, 10, 183, 165, 154, 167, 230,
+			189, 100, 38, 68, 248, 128, 164, 106, 16, 10, 221, 213, 150, 219,
+			92, 163, 105, 155, 6, 132, 110, 106, 2, 66, 3, 107, 20, 254,
+			24, 184, 233, 84, 131, 70, 67, 229, 44, 107, 7, 99, 0, 182,
+			228, 124, 99, 250, 52, 149, 149, 47, 156, 25, 187, 36, 76, 197,
+			123, 146, 253, 168, 222, 88, 247, 98, 130, 8, 98, 122, 28, 45,
+			49, 104, 218, 204, 57, 187, 176, 182, 185, 70, 221, 184, 49, 82,
+			83, 78, 226, 199, 41, 82, 47, 194, 103, 251, 152, 249, 113, 221,
+			109, 93, 0, 169, 83, 158, 134, 135, 14, 177, 247, 11, 104, 113,
+			179, 9, 175, 171, 142, 150, 251, 72, 242, 212, 253, 194, 146, 134,
+			33, 240, 161, 205, 117, 215, 8, 105, 26, 210, 13, 219, 94, 203,
+			15, 47, 8, 179, 2, 51, 220, 86, 138, 9, 224, 210, 105, 195,
+			162, 89, 66, 109, 175, 185, 58, 74, 171, 21, 182, 203, 66, 206,
+			122, 27, 248, 89, 29, 103, 206, 19, 109, 164, 166, 160, 211, 110,
+			118, 40, 173, 221, 148, 18, 77, 195, 163, 34, 193, 88, 168, 92,
+			143, 81, 39, 16, 239, 61, 193, 203, 143, 35, 46, 252, 244, 132,
+			188, 228, 207, 240, 224, 219, 102, 216, 101, 183, 165, 184, 223, 118,
+			188, 108, 217, 189, 95, 222, 46, 111, 58, 246, 164, 195, 222, 175,
+			191, 58, 217, 96, 1, 29, 144, 216, 210, 230, 73, 198, 88, 55,
+			51, 191, 220, 72, 186, 165, 153, 104, 45, 232, 64, 77, 184, 78,
+			192, 185, 249, 152, 224, 1, 78, 242, 193, 48, 120, 2, 218, 91,
+			13, 90, 171, 186, 8, 41, 95, 168, 52, 18, 32, 136, 162, 229,
+			250, 148, 153, 78, 163, 8, 15, 165, 230, 39, 117, 127, 35, 235,
+			180, 73, 104, 195, 134, 46, 215, 221, 198, 5, 154, 107, 168, 79,
+			3, 167, 3, 6, 186, 240, 48, 184, 88, 148, 47, 63, 189, 232,
+			104, 201, 137, 242, 182, 123, 162, 154, 221, 46, 111, 81, 187, 114,
+			80, 30, 143, 35, 182, 129, 22, 126, 148, 7, 1, 51, 94, 182,
+			156, 225, 181, 106, 244, 14, 185, 137, 70, 114, 22, 54, 202, 242,
+			224, 161, 39, 29, 153, 47, 19, 242, 160, 92, 109, 65, 55, 171,
+			59, 152, 137, 42, 124, 85, 63, 202, 219, 229, 51, 204, 174, 112,
+			8, 138, 212, 100, 75, 15, 173, 211, 143, 130, 115, 64, 191, 251,
+			250, 222, 24, 59, 130, 171, 225, 235, 123, 217, 99, 148, 90, 160,
+			96, 92, 239, 206, 235, 162, 23, 40, 25, 215, 123, 0, 105, 97,
+			20, 171, 65, 224, 124, 175, 125, 208, 52, 128, 207, 192, 27, 226,
+			99, 166, 51, 120, 17, 27, 19, 86, 185, 55, 244, 238, 188, 62,
+			122, 65, 99, 140, 142, 153, 49, 109, 199, 254, 165, 94, 123, 194,
+			52, 176, 213, 139, 104, 76, 184, 31, 254, 82, 124, 76, 88, 224,
+			126, 169, 119, 231, 120, 244, 34, 141, 23, 135, 143, 160, 166, 146,
+			101, 239, 118, 178, 191, 218, 219, 243, 145, 222, 20, 130, 62, 148,
+			84, 186, 204, 114, 97, 203, 171, 19, 239, 9, 215, 252, 166, 92,
+			246, 218, 27, 158, 215, 232, 202, 93, 73, 178, 2, 238, 254, 93,
+			90, 106, 193, 149, 118, 39, 77, 25, 253, 154, 225, 175, 44, 76,
+			131, 144, 186, 97, 24, 84, 253, 40, 66, 21, 30, 230, 68, 167,
+			205, 87, 132, 86, 11, 99, 188, 40, 30, 130, 181, 74, 74, 194,
+			244, 26, 85, 204, 56, 136, 165, 138, 224, 78, 49, 13, 178, 182,
+			162, 237, 70, 57, 187, 222, 252, 149, 226, 62, 97, 219, 187, 113,
+			125, 123, 75, 175, 117, 125, 225, 94, 57, 217, 144, 147, 38, 162,
+			73, 179, 160, 80, 221, 248, 73, 21, 0, 145, 212, 187, 143, 178,
+			53, 118, 1, 1, 242, 76, 67, 243, 20, 161, 213, 33, 220, 8,
+			135, 37, 54, 15, 190, 150, 238, 166, 107, 233, 91, 122, 249, 90,
+			186, 155, 174, 165, 111, 233, 101, 51, 252, 110, 186, 150, 190, 165,
+			119, 183, 212, 143, 105, 204, 243, 218, 235, 232, 90, 186, 27, 215,
+			210, 183, 246, 90, 31, 230, 107, 233, 110, 186, 150, 190, 181, 87,
+			236, 20, 175, 78, 137, 172, 189, 91, 93, 75, 223, 209, 107, 151,
+			10, 255, 41, 126, 43, 5, 59, 235, 226, 124, 221, 214, 133, 248,
+			76, 99, 246, 54, 202, 63, 173, 172, 19, 88, 181, 209, 21, 185,
+			221, 210, 83, 89, 43, 249, 203, 184, 100, 240, 129, 218, 205, 178,
+			249, 59, 52, 162, 238, 102, 217, 252, 29, 189, 44, 223, 237, 102,
+			217, 252, 29, 189, 44, 223, 237, 102, 217, 252, 29, 189, 44, 223,
+			237, 102, 217, 252, 29, 189, 44, 223, 237, 214, 178, 249, 59, 122,
+			175, 62, 40, 230, 120, 221, 41, 199, 126, 119, 175, 125, 93, 225,
+			217, 221, 235, 6, 254, 168, 194, 236, 234, 110, 210, 14, 158, 100,
+			253, 177, 121, 67, 46, 125, 119, 124, 222, 144, 75, 223, 173, 15,
+			216, 110, 150, 75, 223, 221, 187, 115, 127, 244, 34, 141, 23, 197,
+			107, 197, 6, 79, 202, 114, 236, 247, 246, 218, 87, 23, 86, 187,
+			39, 69, 146, 60, 16, 36, 88, 89, 129, 34, 13, 149, 91, 80,
+			247, 65, 239, 78, 18, 207, 184, 24, 61, 29, 203, 40, 9, 74,
+			204, 81, 91, 153, 160, 99, 147, 7, 21, 123, 111, 124, 242, 160,
+			98, 239, 141, 3, 29, 150, 170, 247, 246, 142, 236, 137, 94, 164,
+			241, 98, 239, 85, 226, 207, 53, 42, 33, 26, 190, 215, 222, 91,
+			248, 100, 170, 123, 250, 218, 222, 250, 84, 38, 207, 89, 122, 47,
+			59, 121, 82, 158, 227, 82, 195, 163, 70, 30, 229, 160, 11, 77,
+			55, 108, 199, 174, 161, 8, 152, 187, 136, 91, 7, 101, 22, 29,
+			13, 3, 78, 16, 130, 196, 249, 250, 10, 73, 103, 246, 118, 186,
+			39, 141, 43, 121, 73, 95, 25, 119, 51, 77, 126, 40, 14, 33,
+			208, 228, 135, 226, 16, 2, 77, 126, 168, 119, 100, 119, 244, 130,
+			0, 114, 101, 65, 220, 171, 0, 4, 47, 218, 247, 247, 218, 15,
+			247, 102, 10, 207, 149, 149, 232, 242, 176, 237, 250, 14, 132, 58,
+			51, 93, 208, 120, 178, 243, 40, 18, 39, 209, 204, 151, 60, 116,
+			223, 223, 219, 59, 44, 78, 169, 35, 0, 23, 92, 199, 254, 221,
+			222, 236, 96, 225, 230, 68, 49, 111, 253, 21, 206, 190, 160, 158,
+			32, 113, 52, 160, 138, 108, 249, 203, 176, 107, 150, 133, 24, 22,
+			189, 122, 156, 20, 13, 148, 120, 101, 225, 85, 255, 128, 184, 205,
+			124, 13, 65, 250, 189, 217, 129, 194, 65, 185, 24, 159, 61, 68,
+			14, 250, 90, 116, 109, 93, 239, 180, 65, 217, 19, 223, 192, 89,
+			249, 64, 111, 182, 55, 254, 10, 89, 12, 122, 251, 250, 197, 179,
+			204, 55, 44, 199, 254, 96, 111, 118, 168, 112, 3, 136, 50, 215,
+			154, 8, 146, 208, 10, 77, 56, 79, 98, 124, 160, 243, 7, 123,
+			179, 59, 226, 175, 104, 180, 1, 229, 42, 139, 253, 179, 29, 251,
+			67, 189, 236, 179, 185, 155, 89, 232, 135, 226, 40, 0, 55, 179,
+			15, 245, 246, 239, 138, 94, 192, 15, 180, 119, 247, 190, 232, 69,
+			26, 47, 174, 41, 138, 47, 95, 43, 246, 35, 3, 124, 221, 59,
+			228, 54, 253, 67, 68, 43, 151, 116, 182, 22, 181, 131, 142, 80,
+			13, 202, 110, 211, 47, 72, 110, 172, 253, 102, 14, 69, 209, 74,
+			170, 245, 193, 223, 78, 137, 126, 82, 254, 28, 231, 81, 156, 125,
+			162, 112, 106, 122, 106, 230, 228, 210, 241, 169, 51, 147, 119, 77,
+			207, 85, 150, 206, 205, 46, 204, 79, 157, 152, 62, 53, 61, 117,
+			114, 168, 199, 233, 19, 249, 185, 121, 228, 230, 156, 156, 25, 74,
+			225, 169, 50, 245, 19, 231, 166, 43, 83, 39, 135, 44, 103, 80,
+			236, 152, 59, 183, 56, 127, 110, 113, 105, 110, 118, 230, 158, 161,
+			180, 51, 32, 196, 244, 172, 121, 182, 157, 126, 209, 59, 125, 246,
+			236, 185, 197, 201, 227, 51, 83, 67, 25, 199, 17, 3, 231, 102,
+			231, 42, 39, 167, 42, 83, 39, 151, 102, 166, 23, 22, 135, 178,
+			206, 46, 49, 60, 59, 55, 187, 52, 117, 118, 126, 241, 158, 165,
+			147, 83, 167, 38, 207, 205, 44, 14, 229, 104, 164, 147, 83, 179,
+			139, 152, 70, 101, 40, 127, 180, 38, 6, 146, 203, 119, 174, 46,
+			243, 202, 245, 106, 203, 180, 48, 142, 144, 218, 243, 203, 121, 153,
+			30, 29, 152, 184, 82, 183, 114, 155, 126, 57, 177, 242, 227, 214,
+			80, 79, 165, 127, 37, 241, 170, 41, 6, 170, 193, 122, 172, 203,
+			113, 39, 209, 135, 180, 105, 243, 169, 159, 156, 228, 22, 171, 1,
+			34, 18, 202, 65, 107, 245, 208, 170, 215, 160, 137, 28, 82, 63,
+			185, 77, 63, 164, 77, 139, 197, 49, 31, 139, 253, 253, 223, 45,
+			251, 244, 228, 252, 244, 157, 79, 236, 71, 162, 187, 129, 158, 213,
+			148, 248, 128, 45, 82, 125, 78, 122, 160, 199, 153, 248, 13, 91,
+			34, 32, 172, 229, 175, 174, 181, 41, 87, 37, 199, 20, 203, 153,
+			153, 19, 66, 200, 25, 191, 234, 53, 66, 175, 166, 50, 12, 17,
+			234, 78, 54, 33, 108, 234, 95, 74, 242, 46, 85, 134, 71, 78,
+			148, 15, 171, 218, 64, 69, 254, 169, 56, 118, 76, 160, 170, 42,
+			89, 119, 146, 5, 108, 97, 250, 231, 196, 208, 126, 131, 75, 208,
+			34, 230, 86, 187, 33, 153, 225, 203, 130, 170, 224, 98, 132, 96,
+			25, 30, 59, 164, 202, 104, 234, 52, 225, 186, 153, 116, 219, 36,
+			20, 75, 114, 242, 58, 122, 232, 208, 198, 198, 70, 217, 165, 137,
+			18, 200, 234, 170, 89, 120, 104, 102, 250, 196, 212, 236, 194, 212,
+			248, 68, 249, 176, 16, 242, 92, 131, 114, 188, 155, 164, 218, 203,
+			156, 88, 191, 10, 154, 34, 235, 238, 6, 8, 129, 187, 218, 226,
+			98, 124, 8, 53, 82, 245, 81, 74, 50, 12, 86, 218, 27, 116,
+			55, 174, 249, 184, 206, 46, 119, 218, 9, 40, 233, 137, 249, 97,
+			162, 1, 37, 14, 149, 197, 201, 5, 57, 189, 80, 148, 199, 39,
+			23, 166, 23, 74, 66, 222, 61, 189, 120, 102, 238, 220, 162, 188,
+			123, 178, 82, 153, 156, 93, 156, 158, 90, 144, 115, 21, 121, 98,
+			110, 246, 228, 52, 206, 195, 130, 156, 59, 37, 39, 103, 239, 145,
+			207, 153, 158, 61, 89, 146, 42, 2, 89, 122, 247, 129, 66, 135,
+			50, 104, 113, 196, 46, 252, 88, 116, 69, 40, 253, 121, 237, 172,
+			101, 130, 85, 117, 92, 139, 92, 133, 77, 132, 110, 220, 77, 168,
+			35, 67, 227, 66, 38, 36, 213, 105, 97, 251, 196, 150, 21, 149,
+			133, 200, 139, 148, 229, 164, 135, 122, 70, 68, 175, 176, 210, 61,
+			78, 218, 233, 57, 136, 151, 121, 39, 189, 179, 231, 185, 120, 153,
+			223, 161, 254, 84, 47, 119, 245, 20, 233, 165, 80, 127, 170, 151,
+			187, 123, 110, 162, 151, 252, 167, 122, 121, 69, 207, 1, 122, 153,
+			82, 127, 170, 151, 123, 184, 251, 117, 250, 79, 164, 84, 40, 244,
+			140, 166, 196, 23, 210, 194, 202, 245, 56, 233, 3, 214, 115, 10,
+			159, 78, 203, 73, 54, 208, 196, 148, 192, 102, 221, 186, 156, 2,
+			231, 159, 26, 213, 123, 94, 130, 74, 163, 217, 1, 27, 67, 18,
+			120, 175, 93, 45, 143, 233, 204, 172, 228, 17, 168, 117, 230, 225,
+			86, 141, 66, 151, 134, 90, 62, 111, 52, 58, 206, 229, 36, 13,
+			25, 147, 183, 75, 77, 206, 158, 79, 55, 205, 5, 48, 21, 168,
+			64, 219, 79, 169, 115, 140, 250, 169, 254, 221, 36, 233, 100, 135,
+			21, 114, 237, 118, 29, 3, 162, 141, 188, 220, 168, 211, 179, 79,
+			62, 232, 162, 191, 238, 133, 109, 119, 189, 9, 108, 243, 91, 222,
+			18, 229, 229, 122, 170, 163, 199, 230, 92, 98, 211, 199, 101, 166,
+			163, 41, 248, 243, 143, 9, 33, 68, 58, 215, 99, 57, 233, 66,
+			238, 90, 245, 183, 141, 141, 230, 247, 89, 39, 125, 96, 7, 191,
+			79, 57, 233, 3, 215, 77, 168, 191, 211, 78, 250, 192, 45, 183,
+			170, 191, 243, 78, 250, 192, 209, 59, 197, 14, 97, 231, 32, 95,
+			167, 15, 28, 155, 22, 95, 179, 132, 5, 103, 217, 195, 61, 171,
+			169, 194, 95, 88, 224, 203, 190, 202, 56, 20, 213, 97, 209, 51,
+			194, 179, 118, 9, 164, 185, 194, 103, 34, 97, 58, 130, 234, 47,
+			118, 175, 48, 116, 196, 71, 81, 165, 23, 118, 80, 246, 161, 132,
+			243, 169, 198, 112, 67, 141, 107, 184, 253, 233, 203, 187, 11, 81,
+			166, 217, 105, 143, 233, 2, 226, 7, 15, 106, 243, 235, 193, 131,
+			241, 50, 254, 102, 90, 26, 57, 81, 251, 89, 85, 171, 66, 76,
+			56, 156, 44, 142, 193, 0, 9, 68, 134, 11, 100, 35, 208, 73,
+			147, 77, 79, 92, 114, 65, 74, 185, 180, 195, 90, 176, 33, 39,
+			231, 167, 201, 243, 7, 132, 64, 133, 121, 233, 107, 6, 235, 15,
+			161, 84, 63, 170, 110, 80, 136, 102, 147, 7, 15, 174, 187, 155,
+			7, 15, 202, 150, 87, 245, 160, 153, 69, 222, 56, 10, 211, 12,
+			183, 152, 45, 133, 72, 103, 122, 82, 78, 250, 112, 198, 17, 207,
+			22, 118, 6, 34, 94, 122, 194, 186, 166, 48, 33, 79, 4, 141,
+			139, 144, 40, 73, 193, 193, 78, 105, 228, 89, 138, 111, 132, 241,
+			4, 233, 100, 77, 164, 176, 241, 12, 6, 72, 57, 233, 9, 235,
+			42, 253, 100, 57, 233, 137, 253, 82, 252, 106, 138, 70, 79, 57,
+			233, 103, 90, 131, 133, 7, 82, 92, 206, 135, 245, 250, 26, 20,
+			218, 36, 228, 234, 80, 122, 183, 94, 22, 242, 110, 42, 251, 0,
+			35, 59, 39, 155, 218, 14, 188, 80, 75, 233, 62, 108, 51, 100,
+			151, 5, 38, 48, 92, 226, 68, 122, 235, 205, 53, 55, 132, 120,
+			186, 2, 86, 210, 10, 154, 45, 168, 5, 204, 252, 83, 52, 71,
+			243, 100, 57, 233, 103, 246, 15, 136, 15, 169, 249, 91, 78, 250,
+			54, 107, 176, 240, 63, 161, 191, 216, 50, 101, 141, 92, 101, 177,
+			53, 47, 118, 34, 3, 246, 193, 131, 168, 95, 126, 240, 32, 174,
+			26, 90, 193, 11, 11, 116, 220, 136, 203, 216, 89, 82, 54, 18,
+			93, 171, 161, 29, 200, 90, 32, 195, 64, 233, 105, 217, 147, 160,
+			33, 189, 86, 11, 20, 179, 19, 170, 18, 45, 231, 167, 103, 239,
+			154, 156, 153, 62, 185, 52, 89, 57, 125, 238, 236, 212, 236, 226,
+			249, 49, 179, 60, 43, 133, 37, 152, 39, 44, 168, 127, 64, 252,
+			139, 90, 94, 218, 73, 31, 183, 156, 194, 55, 183, 93, 94, 140,
+			10, 95, 118, 133, 241, 18, 251, 116, 212, 224, 72, 23, 122, 92,
+			218, 75, 233, 131, 57, 75, 9, 43, 194, 129, 154, 174, 94, 181,
+			113, 111, 32, 175, 229, 81, 157, 82, 201, 107, 73, 5, 57, 109,
+			215, 71, 56, 99, 163, 38, 212, 91, 160, 227, 65, 202, 197, 178,
+			17, 193, 132, 204, 9, 92, 251, 130, 33, 75, 159, 59, 128, 9,
+			122, 33, 244, 57, 17, 108, 210, 41, 172, 191, 95, 63, 89, 78,
+			250, 248, 208, 176, 248, 21, 5, 27, 219, 73, 159, 182, 134, 11,
+			175, 217, 22, 54, 126, 227, 135, 7, 141, 166, 66, 236, 27, 17,
+			247, 60, 52, 245, 17, 116, 133, 149, 184, 38, 221, 104, 209, 213,
+			116, 237, 20, 38, 216, 199, 147, 183, 45, 39, 125, 122, 112, 72,
+			60, 168, 38, 159, 113, 210, 51, 214, 80, 225, 117, 219, 79, 126,
+			29, 247, 39, 186, 163, 93, 102, 238, 250, 68, 193, 67, 5, 178,
+			96, 98, 207, 160, 120, 37, 35, 14, 66, 76, 225, 183, 14, 45,
+			71, 164, 71, 227, 220, 111, 138, 92, 210, 74, 91, 30, 217, 171,
+			204, 10, 50, 41, 204, 114, 135, 126, 178, 156, 244, 204, 192, 160,
+			120, 192, 162, 21, 100, 157, 116, 197, 218, 85, 248, 89, 203, 172,
+			128, 137, 251, 168, 182, 168, 140, 241, 36, 161, 236, 107, 200, 78,
+			67, 59, 123, 215, 253, 176, 253, 36, 43, 211, 9, 193, 48, 71,
+			222, 152, 248, 253, 143, 83, 101, 192, 94, 5, 125, 0, 86, 220,
+			216, 148, 110, 107, 217, 111, 83, 201, 92, 73, 159, 41, 201, 86,
+			172, 92, 31, 186, 211, 107, 234, 8, 235, 163, 185, 108, 131, 240,
+			235, 237, 47, 203, 73, 83, 26, 8, 146, 141, 254, 202, 129, 144,
+			123, 99, 74, 65, 43, 14, 189, 144, 247, 137, 97, 148, 77, 1,
+			42, 67, 250, 201, 114, 210, 149, 145, 157, 226, 63, 43, 136, 229,
+			156, 244, 115, 173, 61, 133, 239, 70, 123, 206, 107, 54, 158, 237,
+			42, 77, 5, 112, 1, 37, 42, 149, 37, 75, 83, 122, 46, 209,
+			182, 162, 43, 84, 95, 2, 130, 92, 163, 134, 214, 200, 235, 98,
+			133, 13, 141, 198, 163, 196, 208, 164, 36, 18, 159, 83, 13, 34,
+			133, 60, 95, 180, 229, 162, 30, 212, 120, 73, 47, 123, 210, 133,
+			56, 143, 253, 64, 58, 1, 177, 101, 20, 84, 90, 188, 175, 233,
+			85, 163, 51, 145, 75, 1, 6, 35, 250, 201, 114, 210, 207, 221,
+			125, 133, 120, 220, 38, 248, 228, 157, 244, 138, 53, 92, 248, 154,
+			221, 13, 31, 115, 82, 213, 180, 89, 93, 55, 234, 106, 57, 83,
+			167, 163, 98, 85, 178, 208, 50, 153, 219, 244, 203, 186, 249, 152,
+			113, 139, 100, 150, 107, 198, 33, 111, 137, 118, 128, 92, 9, 47,
+			236, 80, 154, 42, 165, 152, 34, 19, 96, 116, 112, 202, 100, 194,
+			155, 156, 158, 31, 215, 119, 175, 54, 36, 1, 93, 188, 147, 117,
+			85, 186, 152, 77, 172, 222, 40, 141, 114, 30, 95, 57, 207, 235,
+			8, 146, 51, 48, 229, 164, 140, 208, 193, 131, 105, 40, 71, 99,
+			37, 117, 222, 164, 111, 49, 195, 68, 126, 110, 177, 210, 249, 106,
+			236, 152, 50, 170, 165, 231, 176, 18, 235, 24, 172, 180, 189, 6,
+			187, 236, 27, 61, 122, 82, 252, 23, 92, 63, 207, 152, 140, 13,
+			71, 132, 0, 133, 228, 146, 235, 203, 94, 141, 41, 161, 50, 133,
+			130, 185, 159, 80, 4, 72, 39, 102, 2, 20, 132, 52, 153, 159,
+			185, 112, 98, 167, 129, 141, 209, 229, 164, 208, 237, 92, 179, 22,
+			239, 230, 179, 214, 71, 177, 243, 177, 178, 156, 86, 9, 158, 4,
+			150, 161, 26, 141, 155, 107, 75, 236, 30, 207, 101, 50, 207, 71,
+			202, 138, 243, 241, 129, 12, 90, 230, 83, 64, 61, 77, 170, 33,
+			248, 174, 12, 14, 45, 103, 73, 101, 112, 147, 120, 197, 237, 98,
+			139, 238, 134, 128, 179, 180, 238, 134, 23, 88, 211, 51, 216, 117,
+			15, 40, 94, 35, 122, 79, 161, 209, 89, 55, 188, 224, 236, 20,
+			25, 104, 158, 195, 61, 41, 153, 30, 237, 173, 168, 135, 227, 63,
+			155, 18, 35, 49, 141, 134, 238, 122, 124, 192, 116, 212, 42, 141,
+			137, 173, 42, 13, 221, 250, 16, 28, 147, 194, 67, 20, 6, 161,
+			148, 80, 152, 85, 115, 249, 241, 84, 234, 191, 91, 233, 211, 243,
+			199, 223, 101, 237, 83, 250, 137, 178, 14, 53, 43, 223, 237, 213,
+			235, 207, 65, 135, 69, 244, 189, 243, 87, 143, 137, 156, 147, 217,
+			215, 243, 15, 169, 148, 248, 163, 62, 210, 115, 236, 235, 113, 38,
+			62, 220, 103, 178, 145, 233, 84, 100, 114, 60, 202, 159, 70, 37,
+			112, 201, 11, 144, 37, 110, 101, 35, 16, 9, 229, 200, 225, 103,
+			105, 229, 200, 116, 163, 138, 100, 10, 245, 186, 36, 197, 73, 104,
+			178, 178, 149, 133, 9, 51, 171, 121, 23, 189, 122, 208, 68, 206,
+			51, 94, 48, 34, 206, 180, 104, 57, 206, 162, 229, 33, 33, 100,
+			197, 51, 42, 2, 92, 92, 193, 170, 33, 122, 249, 166, 82, 50,
+			222, 168, 26, 52, 144, 145, 215, 181, 35, 4, 42, 224, 42, 159,
+			96, 33, 215, 131, 154, 137, 52, 43, 145, 93, 60, 42, 244, 169,
+			89, 66, 140, 4, 25, 223, 247, 106, 208, 208, 161, 47, 164, 205,
+			88, 247, 218, 202, 92, 14, 83, 102, 114, 98, 97, 119, 112, 3,
+			201, 69, 45, 175, 173, 139, 187, 41, 167, 172, 170, 134, 24, 104,
+			104, 155, 18, 85, 146, 192, 12, 230, 131, 17, 226, 95, 108, 212,
+			186, 166, 83, 243, 195, 106, 221, 245, 215, 81, 126, 242, 18, 147,
+			240, 27, 113, 88, 232, 73, 112, 182, 163, 104, 30, 34, 154, 200,
+			191, 105, 30, 58, 1, 82, 87, 24, 133, 219, 168, 29, 210, 90,
+			98, 185, 238, 194, 4, 236, 214, 99, 194, 151, 86, 100, 197, 244,
+			67, 218, 255, 31, 144, 157, 101, 37, 78, 91, 187, 227, 7, 43,
+			9, 220, 106, 176, 222, 6, 191, 133, 108, 136, 195, 138, 26, 10,
+			16, 65, 203, 92, 64, 116, 164, 159, 215, 168, 5, 45, 148, 240,
+			166, 204, 79, 235, 112, 133, 82, 48, 105, 195, 55, 179, 229, 95,
+			100, 119, 11, 246, 107, 211, 250, 43, 227, 85, 110, 72, 78, 179,
+			229, 3, 177, 90, 192, 157, 70, 76, 57, 68, 212, 247, 204, 244,
+			130, 92, 152, 59, 181, 120, 247, 100, 101, 74, 78, 47, 200, 249,
+			202, 220, 93, 211, 39, 167, 78, 202, 227, 247, 200, 197, 51, 83,
+			242, 196, 220, 252, 61, 149, 233, 211, 103, 22, 229, 153, 185, 153,
+			147, 83, 149, 5, 57, 57, 123, 18, 154, 172, 197, 202, 244, 241,
+			115, 139, 115, 149, 5, 97, 180, 95, 248, 5, 90, 173, 169, 231,
+			206, 87, 166, 22, 72, 229, 53, 125, 118, 126, 102, 122, 234, 100,
+			76, 17, 86, 146, 211, 179, 39, 102, 206, 157, 156, 158, 61, 93,
+			146, 199, 207, 45, 202, 217, 185, 69, 33, 103, 166, 207, 78, 47,
+			78, 157, 148, 139, 115, 37, 250, 236, 214, 126, 80, 153, 157, 157,
+			170, 156, 56, 51, 57, 187, 56, 121, 124, 122, 102, 122, 241, 30,
+			154, 202, 169, 233, 197, 89, 124, 236, 212, 92, 5, 142, 72, 243,
+			147, 149, 197, 233, 19, 231, 102, 38, 43, 114, 254, 92, 101, 126,
+			110, 97, 74, 98, 101, 39, 167, 23, 78, 204, 76, 78, 159, 157,
+			58, 89, 150, 211, 179, 114, 118, 78, 78, 221, 53, 53, 187, 40,
+			23, 206, 76, 206, 204, 36, 23, 42, 228, 220, 221, 179, 83, 21,
+			86, 216, 153, 101, 202, 227, 83, 114, 102, 26, 186, 12, 121, 106,
+			174, 66, 218, 187, 147, 211, 149, 169, 19, 139, 88, 80, 244, 215,
+			9, 162, 230, 147, 51, 37, 33, 73, 21, 62, 57, 83, 146, 83,
+			207, 157, 58, 59, 63, 51, 89, 185, 167, 196, 131, 46, 76, 253,
+			196, 57, 208, 252, 201, 25, 121, 114, 242, 236, 228, 233, 169, 5,
+			57, 122, 57, 168, 204, 87, 230, 78, 156, 171, 76, 225, 126, 6,
+			80, 44, 156, 59, 190, 176, 56, 189, 120, 110, 113, 74, 158, 158,
+			155, 59, 73, 192, 94, 152, 170, 220, 53, 125, 98, 106, 225, 152,
+			156, 153, 3, 248, 79, 201, 115, 11, 83, 37, 33, 79, 78, 46,
+			78, 210, 167, 231, 43, 115, 167, 166, 23, 23, 142, 225, 239, 227,
+			231, 22, 166, 9, 112, 211, 179, 139, 83, 149, 202, 57, 82, 212,
+			143, 201, 51, 115, 119, 79, 221, 53, 85, 145, 39, 38, 207, 45,
+			76, 97, 59, 79, 202, 185, 89, 172, 22, 184, 50, 53, 87, 185,
+			7, 195, 2, 14, 180, 3, 37, 121, 247, 153, 169, 197, 51, 83,
+			21, 0, 149, 160, 53, 9, 48, 160, 52, 240, 137, 197, 120, 179,
+			185, 138, 92, 156, 171, 44, 138, 216, 58, 229, 236, 212, 233, 153,
+			233, 211, 83, 179, 39, 166, 48, 159, 57, 12, 115, 247, 244, 194,
+			212, 152, 156, 172, 76, 47, 160, 193, 52, 125, 88, 222, 61, 121,
+			143, 132, 50, 117, 238, 20, 166, 128, 53, 9, 76, 34, 129, 186,
+			37, 218, 79, 57, 125, 74, 78, 158, 188, 107, 26, 51, 231, 214,
+			243, 115, 11, 11, 211, 140, 46, 4, 182, 19, 103, 24, 230, 70,
+			239, 41, 123, 246, 176, 98, 178, 216, 83, 210, 218, 74, 252, 169,
+			94, 94, 219, 115, 72, 235, 53, 241, 167, 122, 121, 93, 164, 1,
+			189, 206, 104, 64, 175, 239, 185, 70, 235, 53, 241, 167, 122, 121,
+			67, 207, 49, 122, 121, 189, 250, 83, 189, 60, 208, 51, 173, 181,
+			170, 248, 83, 189, 28, 237, 217, 79, 47, 247, 171, 63, 255, 103,
+			73, 88, 176, 224, 125, 59, 5, 214, 87, 120, 176, 36, 207, 27,
+			206, 123, 62, 233, 11, 168, 99, 0, 54, 215, 151, 131, 186, 209,
+			141, 18, 27, 47, 201, 152, 138, 75, 51, 2, 250, 229, 168, 44,
+			174, 148, 221, 98, 247, 155, 229, 114, 173, 40, 132, 60, 3, 231,
+			157, 243, 43, 93, 31, 50, 146, 46, 57, 31, 144, 243, 54, 139,
+			114, 37, 121, 222, 61, 79, 116, 247, 252, 242, 121, 190, 164, 27,
+			5, 18, 183, 129, 223, 41, 188, 50, 26, 242, 252, 202, 121, 117,
+			131, 61, 95, 59, 111, 134, 53, 191, 146, 76, 170, 251, 80, 235,
+			242, 242, 121, 80, 171, 83, 124, 173, 12, 47, 16, 115, 139, 130,
+			162, 137, 212, 109, 38, 2, 34, 120, 6, 196, 33, 89, 98, 93,
+			246, 132, 185, 51, 192, 25, 207, 149, 171, 0, 92, 211, 99, 69,
+			43, 46, 79, 196, 117, 249, 231, 134, 236, 40, 73, 207, 52, 41,
+			119, 205, 1, 165, 238, 57, 146, 142, 243, 39, 82, 193, 7, 93,
+			120, 92, 142, 134, 30, 212, 143, 245, 96, 3, 106, 3, 121, 45,
+			247, 62, 75, 189, 253, 6, 36, 24, 216, 150, 193, 179, 4, 52,
+			87, 92, 221, 76, 195, 13, 236, 1, 70, 98, 210, 91, 54, 77,
+			91, 132, 102, 104, 29, 137, 185, 101, 64, 8, 14, 59, 203, 227,
+			6, 110, 184, 182, 213, 219, 30, 155, 65, 48, 156, 82, 13, 42,
+			169, 83, 251, 169, 40, 239, 74, 6, 22, 10, 62, 48, 219, 136,
+			38, 1, 112, 151, 147, 62, 246, 124, 141, 195, 47, 186, 85, 179,
+			229, 81, 152, 144, 208, 173, 32, 202, 198, 238, 6, 219, 204, 216,
+			13, 153, 63, 179, 246, 29, 81, 125, 28, 117, 136, 152, 191, 163,
+			114, 98, 66, 63, 45, 71, 63, 72, 89, 147, 71, 181, 190, 26,
+			255, 185, 79, 30, 149, 166, 225, 139, 244, 31, 155, 104, 116, 147,
+			136, 191, 188, 255, 168, 124, 22, 95, 56, 88, 197, 99, 110, 139,
+			26, 26, 134, 103, 178, 2, 20, 103, 135, 97, 115, 95, 9, 24,
+			81, 147, 247, 11, 50, 138, 249, 173, 174, 203, 40, 215, 35, 228,
+			32, 5, 184, 118, 41, 12, 215, 181, 7, 181, 22, 146, 92, 117,
+			4, 171, 99, 198, 142, 138, 31, 126, 233, 188, 172, 23, 145, 3,
+			174, 86, 107, 36, 21, 64, 46, 199, 237, 179, 105, 142, 133, 69,
+			114, 148, 111, 6, 161, 111, 204, 42, 130, 92, 79, 224, 192, 13,
+			3, 8, 80, 117, 26, 40, 103, 232, 13, 251, 8, 232, 97, 153,
+			34, 96, 239, 187, 206, 16, 215, 153, 54, 207, 140, 2, 100, 38,
+			143, 169, 100, 71, 161, 71, 234, 250, 194, 74, 172, 129, 170, 25,
+			178, 236, 121, 102, 75, 188, 154, 9, 87, 76, 232, 233, 233, 36,
+			26, 5, 160, 108, 120, 168, 67, 234, 182, 252, 122, 172, 106, 32,
+			77, 169, 29, 52, 199, 235, 144, 224, 183, 32, 34, 46, 108, 178,
+			138, 244, 198, 0, 133, 172, 76, 45, 44, 110, 187, 168, 216, 247,
+			244, 170, 106, 62, 10, 202, 154, 175, 152, 161, 181, 226, 178, 107,
+			88, 193, 178, 106, 98, 96, 125, 140, 232, 206, 24, 135, 23, 121,
+			205, 66, 129, 114, 209, 175, 117, 80, 115, 20, 43, 91, 245, 140,
+			252, 106, 72, 153, 190, 42, 211, 232, 219, 173, 134, 105, 19, 167,
+			192, 22, 44, 228, 198, 66, 28, 227, 178, 103, 89, 222, 109, 194,
+			109, 18, 139, 101, 76, 23, 178, 90, 247, 220, 86, 125, 211, 8,
+			208, 36, 175, 174, 170, 58, 220, 144, 62, 33, 219, 198, 93, 196,
+			245, 132, 39, 231, 167, 81, 212, 27, 185, 64, 27, 155, 52, 199,
+			82, 220, 7, 36, 184, 196, 178, 14, 233, 63, 194, 248, 245, 88,
+			68, 102, 29, 156, 82, 40, 59, 182, 37, 177, 124, 89, 159, 211,
+			48, 15, 233, 184, 196, 246, 210, 223, 74, 230, 67, 131, 117, 58,
+			96, 138, 241, 86, 215, 178, 86, 185, 200, 227, 160, 7, 59, 90,
+			13, 56, 134, 0, 82, 60, 125, 150, 85, 83, 32, 188, 137, 169,
+			27, 42, 28, 89, 131, 152, 226, 196, 181, 206, 112, 67, 140, 240,
+			95, 67, 17, 147, 6, 4, 107, 156, 33, 16, 239, 104, 67, 67,
+			217, 105, 180, 131, 14, 92, 129, 169, 32, 102, 76, 27, 5, 5,
+			187, 27, 106, 206, 18, 8, 206, 69, 187, 172, 62, 205, 115, 229,
+			41, 148, 12, 175, 80, 106, 243, 176, 107, 122, 46, 98, 97, 121,
+			126, 56, 114, 213, 224, 98, 156, 201, 96, 122, 134, 128, 108, 165,
+			74, 209, 114, 176, 107, 238, 86, 216, 151, 226, 6, 40, 147, 204,
+			16, 14, 249, 13, 78, 70, 142, 175, 120, 247, 249, 148, 67, 102,
+			203, 23, 18, 185, 226, 245, 250, 203, 17, 221, 16, 219, 206, 138,
+			88, 162, 38, 150, 126, 227, 18, 84, 82, 158, 39, 42, 121, 190,
+			155, 76, 118, 177, 221, 45, 59, 182, 101, 164, 72, 105, 8, 104,
+			49, 36, 196, 54, 160, 104, 35, 166, 215, 192, 195, 240, 26, 202,
+			190, 194, 49, 52, 9, 104, 196, 102, 34, 46, 5, 139, 238, 132,
+			0, 202, 150, 25, 107, 201, 3, 108, 195, 148, 147, 188, 40, 201,
+			133, 183, 99, 194, 213, 163, 242, 121, 71, 158, 31, 99, 83, 141,
+			90, 108, 203, 127, 128, 239, 28, 222, 118, 232, 137, 216, 208, 4,
+			41, 127, 11, 104, 125, 37, 91, 176, 120, 251, 188, 226, 74, 121,
+			185, 88, 130, 152, 91, 45, 62, 95, 247, 106, 119, 9, 4, 203,
+			63, 216, 148, 158, 108, 237, 37, 25, 159, 227, 100, 35, 202, 195,
+			25, 5, 218, 179, 134, 129, 149, 142, 196, 41, 113, 162, 90, 120,
+			71, 55, 123, 22, 39, 18, 244, 78, 68, 72, 12, 74, 192, 160,
+			228, 99, 73, 199, 175, 161, 109, 10, 208, 203, 134, 158, 97, 152,
+			7, 66, 70, 38, 198, 29, 30, 189, 20, 135, 92, 7, 182, 139,
+			101, 79, 99, 16, 33, 41, 62, 179, 85, 198, 225, 177, 184, 161,
+			81, 150, 68, 200, 38, 207, 192, 104, 70, 89, 125, 187, 231, 19,
+			137, 3, 65, 156, 88, 149, 12, 68, 92, 253, 21, 224, 114, 216,
+			38, 127, 34, 62, 61, 186, 177, 153, 86, 108, 180, 216, 172, 201,
+			88, 95, 11, 4, 75, 45, 122, 88, 181, 160, 208, 80, 65, 136,
+			26, 245, 96, 195, 28, 232, 4, 2, 37, 36, 158, 64, 99, 175,
+			174, 51, 205, 71, 53, 198, 183, 197, 182, 130, 78, 108, 200, 132,
+			164, 67, 81, 147, 91, 4, 157, 152, 156, 99, 64, 11, 145, 75,
+			1, 32, 172, 174, 121, 235, 174, 244, 46, 114, 174, 39, 214, 131,
+			1, 153, 76, 124, 183, 33, 209, 152, 102, 149, 202, 46, 70, 82,
+			18, 212, 177, 4, 57, 124, 188, 173, 147, 150, 211, 66, 87, 252,
+			122, 157, 195, 243, 68, 66, 143, 174, 105, 79, 203, 139, 176, 192,
+			111, 233, 29, 42, 155, 152, 53, 24, 50, 26, 8, 184, 139, 179,
+			232, 82, 220, 115, 39, 110, 66, 99, 150, 136, 251, 147, 154, 35,
+			201, 137, 20, 196, 207, 151, 58, 145, 0, 94, 137, 117, 96, 32,
+			253, 198, 106, 171, 204, 78, 216, 189, 73, 176, 140, 246, 90, 82,
+			124, 235, 138, 62, 223, 130, 66, 156, 193, 69, 35, 67, 184, 13,
+			79, 148, 126, 210, 168, 160, 207, 155, 178, 58, 132, 108, 115, 136,
+			203, 189, 168, 36, 77, 162, 216, 246, 162, 206, 54, 132, 74, 24,
+			249, 128, 69, 136, 181, 160, 17, 196, 24, 43, 164, 39, 8, 56,
+			215, 194, 215, 34, 244, 107, 122, 117, 196, 62, 168, 120, 5, 36,
+			87, 190, 224, 208, 51, 230, 192, 201, 12, 186, 89, 11, 139, 53,
+			157, 48, 102, 219, 165, 121, 152, 179, 207, 187, 60, 63, 185, 120,
+			226, 76, 188, 100, 5, 170, 91, 196, 15, 115, 232, 182, 253, 112,
+			101, 83, 149, 207, 8, 189, 117, 183, 209, 246, 171, 161, 144, 163,
+			104, 136, 209, 140, 213, 9, 194, 37, 77, 22, 201, 134, 25, 192,
+			33, 223, 139, 233, 218, 60, 165, 175, 205, 193, 74, 92, 138, 195,
+			169, 108, 80, 139, 82, 114, 174, 176, 235, 160, 75, 87, 28, 32,
+			123, 113, 169, 160, 113, 162, 252, 28, 243, 22, 15, 196, 131, 215,
+			225, 250, 186, 91, 86, 31, 82, 182, 31, 236, 50, 9, 221, 232,
+			132, 62, 208, 207, 94, 244, 144, 127, 10, 161, 137, 135, 160, 109,
+			149, 144, 17, 90, 227, 85, 119, 221, 171, 163, 19, 171, 220, 217,
+			247, 37, 100, 36, 116, 27, 17, 127, 173, 242, 110, 117, 41, 164,
+			35, 20, 50, 114, 178, 185, 7, 235, 223, 230, 91, 1, 188, 172,
+			35, 214, 115, 14, 134, 83, 50, 116, 234, 196, 57, 248, 207, 252,
+			26, 252, 177, 155, 244, 191, 20, 170, 22, 231, 68, 122, 44, 234,
+			106, 6, 98, 24, 113, 232, 235, 82, 20, 147, 157, 252, 221, 173,
+			213, 200, 37, 49, 62, 42, 237, 6, 89, 61, 146, 187, 129, 157,
+			61, 207, 51, 62, 79, 23, 10, 149, 22, 40, 148, 97, 167, 186,
+			102, 150, 134, 166, 102, 26, 90, 237, 132, 37, 149, 227, 147, 41,
+			118, 183, 160, 213, 21, 19, 115, 80, 24, 97, 34, 62, 52, 74,
+			24, 133, 149, 66, 11, 162, 236, 250, 235, 230, 195, 104, 220, 245,
+			221, 89, 119, 221, 43, 117, 127, 38, 121, 157, 0, 201, 156, 107,
+			120, 26, 61, 195, 46, 149, 20, 5, 165, 106, 186, 139, 51, 130,
+			166, 161, 202, 138, 68, 14, 63, 171, 148, 25, 135, 153, 180, 57,
+			198, 64, 12, 177, 21, 51, 142, 138, 228, 6, 46, 144, 90, 229,
+			44, 63, 153, 149, 208, 71, 100, 219, 11, 219, 75, 234, 79, 243,
+			75, 183, 63, 227, 205, 102, 119, 165, 92, 232, 44, 235, 145, 194,
+			206, 242, 146, 254, 198, 237, 242, 214, 99, 93, 146, 204, 139, 152,
+			162, 196, 118, 90, 165, 36, 124, 242, 29, 141, 109, 34, 70, 152,
+			107, 61, 121, 243, 216, 36, 98, 189, 34, 46, 200, 171, 68, 138,
+			4, 140, 28, 202, 209, 98, 180, 228, 34, 14, 46, 87, 131, 10,
+			189, 49, 36, 102, 103, 227, 52, 43, 211, 88, 189, 193, 228, 51,
+			218, 81, 184, 58, 27, 35, 27, 175, 179, 75, 66, 35, 242, 185,
+			9, 91, 58, 155, 114, 153, 114, 130, 115, 198, 213, 23, 176, 115,
+			50, 140, 180, 6, 83, 51, 11, 214, 62, 94, 196, 183, 212, 29,
+			201, 248, 229, 36, 20, 181, 192, 46, 117, 249, 165, 219, 192, 86,
+			183, 172, 136, 221, 129, 169, 160, 23, 240, 189, 211, 88, 119, 155,
+			77, 118, 244, 64, 32, 89, 15, 114, 148, 166, 242, 195, 162, 36,
+			108, 27, 46, 122, 246, 119, 83, 214, 85, 133, 125, 180, 188, 184,
+			118, 84, 157, 91, 13, 26, 196, 115, 169, 104, 168, 239, 166, 56,
+			248, 75, 197, 66, 125, 55, 197, 249, 249, 201, 91, 207, 254, 110,
+			202, 185, 66, 63, 162, 40, 123, 170, 176, 215, 24, 163, 63, 119,
+			189, 216, 183, 37, 144, 128, 253, 88, 47, 101, 138, 62, 42, 242,
+			218, 213, 213, 217, 35, 114, 161, 87, 69, 18, 181, 61, 41, 153,
+			26, 77, 87, 244, 35, 108, 212, 168, 218, 27, 238, 177, 100, 106,
+			52, 83, 81, 15, 199, 127, 250, 18, 54, 234, 126, 61, 162, 54,
+			81, 31, 121, 138, 38, 234, 26, 247, 251, 129, 44, 212, 95, 184,
+			22, 142, 248, 251, 122, 194, 31, 27, 168, 127, 108, 160, 254, 177,
+			129, 250, 199, 6, 234, 31, 27, 168, 127, 108, 160, 126, 154, 12,
+			212, 198, 152, 92, 52, 198, 228, 107, 35, 187, 49, 254, 212, 6,
+			234, 51, 218, 194, 140, 63, 181, 129, 218, 216, 183, 175, 55, 246,
+			237, 27, 122, 202, 218, 190, 141, 63, 181, 129, 218, 216, 183, 15,
+			24, 251, 246, 104, 100, 223, 198, 159, 191, 50, 68, 6, 106, 123,
+			165, 39, 76, 21, 254, 43, 242, 168, 106, 174, 155, 52, 26, 171,
+			50, 52, 37, 185, 226, 223, 231, 213, 198, 57, 6, 214, 4, 174,
+			35, 254, 36, 38, 178, 11, 200, 236, 184, 156, 161, 44, 61, 168,
+			191, 226, 255, 160, 126, 114, 165, 229, 86, 35, 182, 160, 127, 64,
+			133, 157, 70, 160, 228, 4, 17, 203, 128, 93, 150, 211, 38, 174,
+			20, 183, 116, 104, 23, 88, 154, 171, 186, 117, 164, 188, 107, 113,
+			66, 161, 6, 140, 99, 240, 35, 186, 224, 201, 98, 205, 221, 44,
+			10, 48, 186, 226, 122, 208, 104, 175, 21, 245, 48, 42, 62, 152,
+			20, 208, 81, 16, 141, 207, 201, 244, 64, 56, 117, 140, 127, 213,
+			211, 41, 9, 132, 108, 111, 196, 91, 107, 93, 2, 22, 104, 64,
+			133, 41, 248, 109, 157, 230, 219, 173, 129, 107, 6, 45, 24, 178,
+			219, 88, 46, 174, 158, 32, 162, 210, 141, 6, 42, 203, 10, 73,
+			12, 202, 194, 218, 10, 238, 243, 193, 14, 234, 155, 242, 198, 241,
+			35, 135, 75, 135, 15, 31, 150, 155, 42, 51, 26, 238, 47, 58,
+			194, 41, 10, 118, 146, 71, 142, 202, 19, 193, 122, 179, 211, 246,
+			162, 105, 208, 55, 18, 211, 165, 43, 76, 51, 244, 58, 53, 164,
+			200, 168, 193, 141, 24, 156, 36, 106, 192, 233, 158, 110, 151, 229,
+			114, 249, 88, 247, 111, 136, 106, 142, 255, 98, 62, 164, 37, 44,
+			253, 171, 250, 89, 191, 45, 235, 253, 166, 184, 104, 243, 52, 174,
+			190, 165, 159, 143, 117, 117, 34, 4, 224, 46, 234, 111, 221, 129,
+			158, 244, 71, 252, 21, 57, 186, 229, 67, 183, 201, 195, 242, 134,
+			27, 186, 199, 186, 67, 30, 30, 139, 110, 41, 91, 58, 221, 24,
+			191, 43, 119, 117, 29, 191, 93, 30, 57, 172, 255, 143, 27, 189,
+			72, 122, 245, 208, 219, 126, 2, 119, 108, 59, 129, 219, 158, 124,
+			2, 227, 79, 50, 129, 27, 183, 155, 64, 108, 251, 39, 162, 237,
+			143, 246, 139, 246, 63, 122, 188, 49, 218, 176, 31, 28, 11, 46,
+			185, 215, 151, 198, 17, 213, 49, 190, 229, 183, 39, 183, 92, 222,
+			24, 45, 147, 95, 241, 120, 209, 166, 235, 46, 12, 134, 168, 195,
+			22, 44, 136, 250, 36, 225, 156, 192, 185, 56, 136, 163, 14, 55,
+			62, 249, 246, 70, 13, 239, 136, 55, 188, 196, 55, 110, 220, 254,
+			27, 227, 151, 217, 193, 155, 46, 117, 128, 161, 228, 67, 68, 95,
+			25, 255, 83, 243, 234, 116, 197, 144, 243, 155, 237, 53, 184, 42,
+			170, 129, 218, 56, 152, 91, 27, 142, 214, 220, 205, 240, 246, 155,
+			74, 114, 221, 111, 116, 218, 94, 120, 251, 145, 195, 99, 201, 99,
+			38, 111, 55, 95, 27, 237, 250, 169, 124, 170, 21, 172, 47, 154,
+			161, 218, 181, 177, 72, 171, 119, 214, 109, 54, 169, 218, 131, 214,
+			217, 240, 77, 7, 194, 113, 108, 254, 116, 129, 222, 162, 209, 227,
+			154, 146, 177, 104, 9, 92, 141, 149, 55, 66, 41, 150, 23, 146,
+			53, 29, 94, 35, 210, 255, 135, 157, 149, 21, 255, 62, 89, 12,
+			139, 114, 148, 195, 16, 48, 22, 131, 126, 12, 180, 159, 156, 189,
+			81, 188, 217, 171, 69, 122, 86, 78, 94, 24, 177, 24, 190, 251,
+			68, 92, 38, 212, 161, 186, 52, 77, 97, 248, 18, 146, 149, 168,
+			46, 93, 254, 50, 55, 233, 247, 106, 164, 195, 49, 142, 101, 124,
+			243, 225, 156, 164, 215, 238, 39, 0, 5, 80, 20, 111, 10, 139,
+			218, 5, 61, 26, 13, 188, 227, 72, 108, 48, 30, 139, 244, 183,
+			209, 20, 183, 27, 173, 172, 177, 235, 8, 198, 197, 56, 93, 163,
+			10, 185, 238, 87, 91, 201, 113, 159, 234, 176, 71, 194, 34, 5,
+			11, 10, 165, 140, 72, 175, 228, 135, 196, 163, 41, 86, 70, 164,
+			239, 181, 118, 22, 62, 157, 146, 11, 36, 21, 152, 143, 178, 70,
+			60, 46, 22, 148, 229, 89, 174, 165, 64, 196, 105, 252, 166, 35,
+			183, 148, 110, 121, 230, 51, 192, 224, 240, 95, 168, 100, 229, 141,
+			93, 47, 165, 201, 176, 95, 230, 242, 219, 42, 35, 222, 50, 124,
+			203, 232, 194, 9, 189, 47, 88, 159, 186, 155, 28, 21, 242, 25,
+			135, 49, 137, 67, 235, 126, 67, 30, 196, 195, 186, 223, 56, 180,
+			214, 146, 7, 229, 196, 205, 114, 173, 117, 168, 230, 110, 202, 131,
+			242, 166, 103, 220, 82, 158, 184, 69, 226, 140, 28, 2, 115, 149,
+			7, 213, 9, 85, 156, 150, 60, 245, 161, 15, 201, 56, 233, 123,
+			173, 156, 126, 74, 57, 233, 123, 243, 131, 250, 41, 237, 164, 239,
+			117, 70, 196, 79, 167, 9, 16, 41, 39, 221, 178, 156, 194, 247,
+			44, 13, 136, 132, 112, 227, 50, 92, 146, 210, 77, 188, 188, 71,
+			12, 94, 34, 2, 152, 62, 77, 161, 172, 67, 109, 75, 7, 38,
+			104, 120, 102, 180, 86, 66, 214, 210, 197, 55, 14, 11, 121, 158,
+			247, 65, 7, 129, 0, 7, 92, 182, 89, 95, 164, 43, 94, 195,
+			91, 117, 41, 115, 223, 121, 154, 16, 55, 84, 136, 174, 201, 64,
+			72, 83, 137, 125, 144, 60, 234, 90, 94, 137, 131, 120, 144, 168,
+			145, 45, 134, 58, 142, 61, 49, 154, 46, 158, 33, 204, 242, 160,
+			185, 132, 252, 8, 244, 162, 230, 201, 121, 118, 163, 200, 173, 183,
+			222, 90, 226, 255, 42, 244, 136, 189, 136, 161, 134, 222, 175, 84,
+			6, 187, 160, 247, 11, 161, 156, 173, 124, 191, 126, 74, 59, 233,
+			214, 208, 176, 81, 109, 189, 254, 63, 138, 103, 173, 6, 229, 234,
+			90, 43, 88, 247, 59, 235, 42, 11, 65, 167, 234, 31, 114, 59,
+			237, 181, 37, 182, 105, 81, 218, 134, 86, 179, 218, 92, 62, 68,
+			169, 147, 67, 86, 122, 245, 161, 81, 153, 27, 21, 46, 151, 152,
+			163, 112, 25, 29, 90, 97, 111, 247, 239, 20, 215, 196, 63, 94,
+			54, 26, 164, 176, 191, 187, 69, 91, 179, 100, 53, 68, 113, 74,
+			56, 51, 126, 216, 62, 77, 75, 168, 176, 79, 147, 115, 72, 100,
+			213, 162, 40, 78, 100, 199, 196, 21, 229, 248, 170, 202, 147, 157,
+			246, 26, 245, 168, 112, 179, 226, 245, 98, 240, 180, 167, 70, 169,
+			40, 93, 168, 227, 8, 27, 10, 6, 210, 238, 245, 86, 232, 239,
+			226, 25, 225, 168, 64, 156, 68, 203, 9, 145, 161, 97, 168, 233,
+			165, 63, 118, 60, 253, 151, 147, 86, 69, 53, 45, 254, 108, 74,
+			56, 202, 223, 231, 223, 58, 148, 115, 76, 236, 80, 22, 42, 2,
+			28, 105, 29, 119, 76, 20, 186, 21, 141, 101, 163, 0, 174, 8,
+			213, 28, 127, 23, 39, 133, 115, 210, 171, 123, 93, 211, 184, 34,
+			190, 118, 245, 49, 122, 1, 160, 120, 109, 119, 149, 62, 210, 91,
+			161, 191, 139, 111, 76, 139, 94, 51, 179, 237, 192, 6, 93, 233,
+			186, 7, 86, 5, 157, 40, 226, 118, 244, 35, 116, 165, 171, 245,
+			96, 57, 220, 147, 86, 241, 60, 244, 224, 236, 22, 217, 134, 23,
+			182, 189, 218, 30, 155, 94, 243, 147, 35, 197, 14, 157, 235, 197,
+			15, 26, 123, 50, 244, 137, 248, 43, 244, 12, 54, 26, 248, 80,
+			150, 126, 228, 39, 231, 86, 33, 184, 178, 246, 82, 59, 220, 147,
+			187, 4, 136, 140, 188, 87, 233, 229, 214, 139, 161, 115, 117, 212,
+			117, 121, 115, 79, 158, 134, 213, 63, 31, 223, 116, 14, 137, 97,
+			132, 122, 123, 173, 165, 170, 219, 88, 34, 77, 227, 230, 158, 94,
+			153, 26, 205, 3, 110, 233, 202, 160, 250, 245, 132, 219, 56, 75,
+			191, 57, 247, 136, 221, 188, 250, 53, 191, 185, 68, 185, 13, 232,
+			192, 132, 123, 4, 225, 106, 49, 185, 231, 103, 77, 219, 41, 211,
+			180, 178, 107, 125, 155, 183, 161, 217, 157, 106, 108, 119, 46, 136,
+			157, 219, 13, 225, 20, 68, 94, 197, 178, 181, 55, 121, 175, 204,
+			179, 243, 76, 209, 75, 243, 218, 4, 176, 172, 203, 2, 43, 175,
+			26, 47, 134, 197, 231, 8, 231, 180, 215, 94, 232, 44, 83, 70,
+			81, 141, 77, 183, 136, 222, 102, 203, 111, 84, 253, 166, 91, 223,
+			30, 177, 231, 245, 207, 149, 168, 101, 241, 102, 145, 215, 35, 57,
+			163, 34, 211, 8, 106, 158, 62, 207, 78, 178, 251, 108, 80, 243,
+			42, 170, 65, 113, 94, 244, 154, 209, 156, 67, 194, 134, 101, 153,
+			62, 58, 48, 177, 247, 18, 31, 125, 142, 223, 168, 85, 168, 161,
+			193, 94, 198, 111, 252, 93, 124, 129, 176, 241, 129, 31, 114, 25,
+			206, 126, 177, 67, 27, 81, 150, 150, 55, 233, 0, 100, 42, 66,
+			191, 58, 190, 89, 124, 99, 74, 92, 201, 160, 162, 35, 52, 89,
+			133, 99, 232, 101, 143, 226, 81, 145, 215, 180, 150, 247, 232, 202,
+			45, 123, 164, 153, 44, 80, 209, 170, 152, 246, 206, 152, 232, 135,
+			169, 207, 40, 197, 247, 164, 163, 209, 147, 191, 20, 255, 192, 22,
+			206, 54, 211, 26, 16, 150, 95, 99, 203, 135, 229, 111, 11, 56,
+			231, 42, 209, 203, 134, 165, 160, 165, 190, 80, 137, 94, 56, 215,
+			117, 207, 193, 166, 22, 201, 151, 206, 45, 177, 85, 102, 46, 179,
+			202, 216, 2, 111, 19, 25, 74, 111, 66, 100, 96, 96, 226, 134,
+			228, 62, 109, 93, 79, 153, 82, 162, 84, 84, 167, 127, 11, 181,
+			216, 47, 118, 192, 189, 220, 219, 136, 147, 11, 161, 95, 29, 223,
+			116, 142, 197, 26, 180, 195, 61, 189, 151, 29, 220, 116, 94, 12,
+			157, 235, 197, 128, 122, 210, 89, 130, 247, 8, 5, 52, 245, 246,
+			132, 122, 233, 204, 136, 157, 221, 36, 134, 142, 242, 142, 203, 126,
+			204, 233, 34, 44, 155, 139, 97, 113, 74, 100, 8, 58, 72, 98,
+			181, 176, 56, 185, 56, 213, 149, 59, 107, 135, 200, 205, 79, 205,
+			66, 13, 169, 82, 103, 77, 206, 67, 241, 77, 169, 179, 132, 200,
+			158, 156, 154, 69, 138, 173, 116, 241, 86, 177, 207, 112, 234, 4,
+			236, 47, 139, 234, 197, 37, 177, 255, 146, 93, 153, 227, 223, 38,
+			242, 140, 90, 154, 70, 200, 203, 237, 121, 197, 244, 40, 254, 116,
+			74, 236, 175, 16, 8, 183, 105, 118, 153, 217, 57, 35, 116, 20,
+			112, 4, 211, 234, 53, 206, 195, 30, 145, 35, 133, 215, 69, 143,
+			48, 63, 95, 209, 143, 96, 134, 122, 243, 20, 198, 235, 199, 226,
+			139, 211, 98, 207, 100, 195, 173, 111, 222, 175, 184, 241, 233, 56,
+			17, 157, 23, 121, 23, 191, 133, 126, 200, 228, 236, 80, 114, 129,
+			151, 234, 89, 158, 228, 110, 76, 4, 244, 40, 73, 122, 102, 61,
+			101, 122, 182, 87, 244, 54, 145, 199, 63, 244, 239, 87, 107, 203,
+			84, 242, 120, 177, 224, 223, 239, 129, 89, 226, 239, 37, 202, 142,
+			205, 235, 163, 230, 139, 120, 225, 220, 40, 134, 77, 228, 117, 109,
+			137, 69, 180, 12, 241, 248, 161, 232, 7, 90, 124, 88, 108, 137,
+			188, 158, 186, 179, 71, 236, 156, 156, 157, 156, 185, 103, 97, 122,
+			161, 11, 251, 132, 200, 158, 184, 231, 196, 204, 212, 194, 80, 202,
+			185, 82, 236, 170, 76, 157, 60, 55, 123, 114, 114, 118, 113, 233,
+			236, 212, 217, 227, 83, 149, 133, 51, 211, 243, 11, 67, 150, 51,
+			34, 6, 231, 42, 243, 103, 38, 103, 167, 78, 46, 157, 174, 204,
+			157, 155, 95, 24, 74, 59, 67, 162, 239, 248, 204, 228, 194, 226,
+			82, 101, 242, 228, 244, 185, 133, 33, 187, 248, 43, 41, 113, 229,
+			54, 128, 100, 36, 59, 38, 242, 43, 184, 159, 55, 86, 53, 146,
+			237, 79, 2, 44, 234, 115, 74, 181, 171, 152, 14, 206, 13, 98,
+			176, 225, 221, 215, 94, 138, 193, 199, 34, 248, 244, 227, 245, 188,
+			129, 209, 85, 162, 183, 221, 234, 52, 144, 138, 160, 198, 184, 19,
+			189, 40, 254, 190, 37, 134, 183, 124, 197, 57, 44, 50, 213, 205,
+			106, 221, 99, 182, 180, 103, 155, 89, 157, 192, 239, 103, 122, 42,
+			170, 161, 115, 151, 216, 217, 242, 106, 29, 170, 166, 178, 20, 29,
+			122, 198, 131, 107, 146, 3, 84, 116, 203, 72, 146, 56, 211, 83,
+			25, 105, 109, 125, 237, 156, 20, 3, 65, 171, 185, 230, 54, 244,
+			254, 210, 18, 118, 116, 243, 222, 57, 110, 67, 83, 59, 211, 83,
+			233, 215, 157, 232, 133, 179, 32, 70, 84, 158, 32, 175, 182, 20,
+			25, 217, 8, 159, 182, 28, 236, 73, 110, 56, 111, 218, 157, 233,
+			169, 56, 238, 150, 183, 199, 123, 69, 142, 55, 163, 120, 157, 16,
+			17, 80, 156, 221, 137, 219, 66, 175, 185, 20, 52, 196, 200, 54,
+			43, 119, 118, 198, 101, 244, 94, 45, 133, 31, 18, 89, 5, 198,
+			203, 29, 37, 110, 230, 12, 137, 244, 69, 223, 101, 193, 23, 127,
+			22, 175, 23, 253, 9, 184, 108, 255, 165, 226, 127, 20, 206, 214,
+			53, 163, 109, 203, 115, 235, 235, 186, 45, 61, 56, 251, 132, 136,
+			193, 15, 51, 235, 173, 196, 222, 108, 157, 4, 100, 108, 99, 252,
+			117, 235, 4, 242, 124, 37, 254, 234, 96, 69, 244, 155, 213, 64,
+			116, 114, 246, 137, 194, 124, 5, 230, 186, 249, 201, 153, 37, 228,
+			137, 235, 58, 162, 125, 34, 79, 161, 166, 211, 139, 247, 12, 165,
+			156, 94, 145, 161, 3, 56, 100, 57, 121, 97, 159, 158, 153, 59,
+			62, 148, 158, 248, 159, 57, 145, 165, 53, 135, 206, 41, 33, 12,
+			177, 15, 157, 221, 91, 152, 213, 20, 46, 144, 133, 46, 36, 136,
+			122, 152, 195, 122, 92, 228, 245, 149, 206, 185, 58, 217, 186, 235,
+			170, 87, 184, 212, 53, 203, 57, 35, 118, 196, 238, 123, 78, 215,
+			71, 183, 94, 5, 159, 116, 164, 216, 117, 175, 123, 164, 173, 55,
+			193, 75, 143, 116, 90, 236, 136, 221, 216, 186, 71, 218, 122, 153,
+			43, 92, 2, 132, 206, 105, 177, 35, 38, 172, 119, 15, 180, 85,
+			142, 47, 236, 78, 182, 48, 61, 239, 20, 67, 167, 189, 246, 212,
+			125, 77, 23, 113, 15, 255, 54, 136, 47, 9, 135, 219, 196, 56,
+			177, 115, 32, 217, 124, 107, 11, 126, 211, 141, 22, 91, 91, 56,
+			23, 197, 21, 51, 254, 118, 125, 67, 167, 116, 9, 156, 74, 54,
+			227, 127, 11, 227, 79, 177, 53, 163, 227, 5, 177, 231, 82, 18,
+			134, 211, 53, 212, 101, 36, 145, 167, 176, 200, 154, 24, 222, 194,
+			197, 156, 46, 33, 120, 27, 54, 167, 134, 63, 112, 217, 118, 106,
+			73, 199, 199, 127, 242, 198, 31, 64, 205, 116, 231, 255, 126, 174,
+			248, 168, 77, 62, 71, 3, 61, 217, 137, 223, 233, 74, 254, 121,
+			132, 124, 190, 102, 206, 157, 152, 150, 192, 134, 64, 25, 5, 255,
+			63, 151, 3, 244, 199, 73, 64, 255, 95, 76, 2, 154, 19, 207,
+			22, 86, 182, 199, 73, 239, 237, 201, 21, 110, 150, 132, 162, 72,
+			162, 76, 152, 167, 227, 134, 67, 246, 134, 164, 112, 21, 216, 57,
+			80, 136, 130, 85, 158, 66, 188, 51, 37, 236, 44, 41, 251, 247,
+			91, 153, 194, 27, 83, 210, 156, 99, 157, 29, 57, 164, 16, 8,
+			172, 65, 117, 210, 117, 84, 234, 155, 18, 247, 88, 242, 218, 140,
+			240, 86, 127, 93, 144, 215, 28, 53, 40, 203, 197, 168, 115, 20,
+			155, 192, 161, 131, 8, 76, 173, 55, 215, 220, 101, 175, 141, 156,
+			129, 236, 173, 174, 74, 4, 193, 214, 132, 208, 5, 33, 167, 79,
+			150, 133, 184, 139, 166, 154, 114, 210, 69, 43, 83, 152, 150, 154,
+			166, 154, 121, 110, 173, 125, 235, 54, 226, 81, 153, 52, 255, 120,
+			20, 21, 238, 51, 101, 33, 14, 209, 184, 150, 147, 190, 222, 202,
+			20, 138, 156, 106, 136, 96, 192, 121, 207, 96, 223, 66, 104, 23,
+			13, 80, 22, 226, 22, 234, 144, 118, 210, 163, 86, 166, 48, 202,
+			73, 134, 84, 7, 246, 149, 7, 146, 153, 80, 47, 221, 237, 70,
+			234, 102, 59, 233, 27, 173, 76, 97, 159, 140, 177, 42, 89, 163,
+			191, 241, 29, 221, 248, 175, 212, 198, 100, 156, 244, 97, 43, 83,
+			248, 108, 74, 198, 24, 146, 89, 177, 43, 205, 43, 237, 170, 181,
+			21, 8, 12, 121, 248, 247, 9, 237, 207, 74, 65, 234, 44, 195,
+			200, 209, 166, 215, 90, 115, 155, 48, 199, 153, 40, 217, 160, 37,
+			47, 250, 174, 36, 125, 228, 88, 89, 229, 24, 136, 247, 65, 149,
+			57, 62, 7, 174, 46, 198, 219, 222, 44, 233, 5, 224, 80, 168,
+			222, 42, 176, 62, 33, 48, 81, 162, 73, 68, 19, 188, 202, 162,
+			53, 102, 145, 151, 49, 83, 248, 215, 148, 236, 102, 163, 102, 161,
+			177, 136, 14, 175, 38, 13, 187, 100, 123, 95, 8, 32, 208, 34,
+			87, 58, 148, 136, 205, 227, 65, 74, 210, 47, 35, 249, 221, 184,
+			60, 207, 50, 254, 121, 21, 56, 166, 193, 160, 214, 11, 3, 163,
+			89, 188, 228, 150, 199, 168, 27, 1, 224, 41, 116, 162, 118, 199,
+			48, 16, 117, 83, 10, 219, 167, 208, 207, 76, 189, 44, 132, 71,
+			208, 200, 57, 233, 99, 150, 74, 3, 223, 205, 229, 37, 98, 44,
+			164, 82, 233, 114, 134, 84, 252, 200, 245, 178, 112, 86, 164, 210,
+			182, 226, 141, 79, 165, 88, 16, 147, 67, 57, 133, 225, 8, 8,
+			195, 147, 16, 11, 244, 153, 188, 147, 190, 195, 202, 20, 78, 69,
+			7, 62, 193, 66, 163, 211, 175, 147, 117, 185, 244, 179, 222, 5,
+			36, 4, 104, 233, 9, 148, 133, 120, 155, 218, 202, 94, 39, 61,
+			101, 101, 10, 175, 183, 228, 165, 152, 184, 100, 61, 0, 82, 225,
+			33, 83, 42, 23, 112, 222, 246, 35, 166, 134, 82, 180, 100, 67,
+			135, 200, 13, 71, 169, 125, 204, 156, 202, 114, 146, 6, 167, 233,
+			234, 183, 210, 173, 213, 194, 68, 128, 20, 236, 89, 65, 108, 164,
+			78, 163, 237, 215, 227, 13, 188, 154, 177, 147, 73, 175, 238, 54,
+			67, 84, 90, 93, 132, 163, 56, 59, 0, 251, 225, 150, 130, 145,
+			234, 151, 122, 176, 170, 156, 147, 224, 246, 173, 252, 63, 249, 27,
+			68, 23, 202, 66, 124, 90, 157, 107, 225, 164, 239, 180, 50, 133,
+			15, 165, 228, 22, 249, 66, 182, 58, 56, 218, 240, 245, 85, 183,
+			127, 10, 50, 140, 149, 191, 99, 92, 167, 115, 95, 146, 42, 69,
+			154, 223, 192, 34, 205, 141, 84, 42, 4, 212, 184, 188, 230, 55,
+			67, 157, 198, 159, 46, 70, 9, 158, 163, 220, 228, 85, 128, 148,
+			12, 26, 66, 165, 12, 161, 175, 148, 37, 95, 177, 67, 182, 72,
+			70, 84, 187, 201, 137, 153, 103, 201, 181, 44, 61, 219, 147, 43,
+			156, 136, 113, 15, 45, 37, 73, 127, 11, 3, 65, 236, 123, 55,
+			219, 88, 96, 105, 75, 136, 247, 26, 243, 115, 197, 18, 133, 95,
+			85, 28, 73, 231, 28, 224, 179, 146, 136, 153, 164, 18, 97, 28,
+			38, 70, 130, 153, 172, 195, 195, 120, 195, 195, 255, 170, 90, 181,
+			34, 34, 25, 156, 96, 149, 179, 72, 80, 192, 211, 186, 215, 118,
+			193, 173, 20, 181, 224, 52, 40, 26, 110, 202, 37, 152, 108, 127,
+			177, 32, 231, 186, 183, 210, 70, 194, 217, 246, 102, 89, 128, 9,
+			35, 71, 231, 93, 138, 9, 39, 37, 125, 44, 63, 158, 246, 100,
+			43, 67, 42, 11, 113, 157, 176, 237, 20, 120, 240, 221, 86, 190,
+			120, 5, 118, 244, 168, 44, 186, 53, 148, 214, 15, 219, 45, 23,
+			30, 189, 69, 33, 166, 4, 37, 255, 124, 94, 79, 174, 112, 52,
+			206, 164, 244, 151, 24, 121, 57, 142, 176, 229, 69, 81, 17, 9,
+			230, 245, 37, 192, 215, 194, 215, 150, 172, 29, 133, 71, 144, 68,
+			178, 237, 250, 245, 174, 211, 101, 114, 126, 146, 113, 62, 30, 5,
+			169, 57, 184, 118, 2, 230, 163, 212, 240, 54, 132, 234, 123, 84,
+			250, 43, 241, 211, 164, 101, 31, 143, 179, 83, 118, 103, 122, 145,
+			110, 167, 29, 160, 100, 61, 104, 215, 166, 136, 213, 252, 24, 37,
+			220, 102, 229, 242, 33, 147, 240, 197, 216, 64, 195, 177, 18, 59,
+			13, 232, 73, 113, 102, 231, 178, 16, 103, 132, 101, 167, 157, 116,
+			181, 39, 87, 184, 61, 206, 161, 183, 64, 43, 10, 185, 107, 7,
+			219, 178, 237, 23, 10, 219, 78, 3, 94, 43, 214, 142, 66, 237,
+			146, 224, 82, 131, 196, 132, 157, 3, 161, 60, 0, 9, 227, 0,
+			91, 204, 117, 222, 72, 212, 156, 138, 167, 134, 220, 50, 132, 16,
+			135, 233, 147, 41, 39, 237, 91, 123, 11, 215, 210, 144, 218, 51,
+			93, 131, 48, 145, 186, 64, 136, 73, 97, 217, 182, 147, 94, 239,
+			201, 21, 158, 17, 151, 44, 182, 69, 14, 146, 54, 12, 114, 232,
+			117, 222, 40, 108, 219, 198, 58, 3, 43, 95, 216, 39, 103, 217,
+			243, 60, 49, 67, 234, 9, 38, 242, 59, 192, 34, 27, 57, 135,
+			219, 86, 190, 240, 238, 20, 205, 145, 37, 67, 9, 195, 91, 162,
+			107, 89, 32, 232, 20, 50, 17, 126, 137, 39, 202, 5, 51, 52,
+			193, 174, 235, 110, 187, 186, 150, 172, 213, 215, 61, 82, 73, 68,
+			179, 215, 155, 78, 229, 240, 120, 44, 183, 33, 39, 143, 207, 85,
+			144, 138, 75, 133, 224, 116, 11, 156, 101, 33, 110, 18, 150, 157,
+			113, 210, 247, 245, 228, 10, 163, 49, 194, 192, 165, 147, 47, 113,
+			70, 199, 132, 109, 103, 0, 157, 77, 43, 95, 188, 74, 159, 209,
+			78, 123, 109, 156, 37, 222, 113, 197, 32, 139, 66, 28, 164, 166,
+			41, 39, 125, 191, 37, 138, 87, 171, 166, 207, 163, 0, 182, 163,
+			237, 127, 199, 158, 70, 136, 247, 64, 92, 187, 26, 214, 114, 210,
+			255, 222, 18, 197, 171, 18, 109, 15, 38, 218, 10, 113, 148, 154,
+			166, 157, 244, 127, 176, 68, 113, 92, 55, 117, 145, 76, 217, 107,
+			141, 19, 156, 199, 15, 35, 100, 62, 249, 234, 8, 62, 115, 152,
+			62, 99, 59, 233, 23, 89, 249, 226, 181, 60, 123, 202, 21, 74,
+			141, 76, 78, 83, 48, 245, 114, 185, 92, 84, 52, 41, 99, 101,
+			156, 244, 127, 124, 50, 154, 84, 166, 113, 179, 78, 250, 63, 89,
+			123, 139, 215, 112, 171, 35, 183, 62, 115, 98, 252, 240, 145, 241,
+			195, 71, 22, 143, 28, 62, 122, 248, 240, 209, 137, 195, 229, 195,
+			19, 71, 126, 178, 40, 40, 206, 41, 99, 229, 28, 251, 197, 41,
+			43, 95, 220, 199, 29, 104, 185, 8, 18, 235, 90, 241, 41, 106,
+			157, 119, 236, 159, 78, 89, 217, 194, 179, 228, 92, 44, 81, 53,
+			82, 159, 152, 40, 9, 150, 122, 32, 18, 16, 149, 64, 236, 150,
+			94, 91, 89, 136, 151, 90, 52, 80, 175, 99, 191, 34, 101, 137,
+			194, 227, 41, 201, 234, 76, 57, 74, 121, 161, 34, 134, 100, 196,
+			196, 49, 240, 218, 48, 193, 8, 64, 201, 253, 117, 111, 156, 28,
+			148, 8, 145, 128, 244, 155, 204, 24, 169, 224, 28, 251, 230, 106,
+			100, 77, 82, 54, 149, 89, 89, 37, 36, 138, 134, 21, 156, 222,
+			63, 44, 115, 82, 41, 28, 105, 37, 192, 240, 125, 133, 175, 236,
+			154, 132, 112, 180, 51, 128, 0, 66, 75, 41, 77, 105, 144, 186,
+			95, 245, 219, 245, 205, 216, 106, 162, 232, 104, 157, 235, 227, 147,
+			54, 65, 66, 56, 246, 107, 83, 86, 190, 240, 123, 54, 178, 240,
+			7, 77, 247, 133, 29, 227, 152, 71, 196, 57, 158, 12, 216, 147,
+			23, 89, 197, 16, 63, 136, 114, 217, 195, 44, 189, 154, 223, 142,
+			18, 163, 235, 195, 22, 114, 124, 120, 44, 162, 61, 153, 44, 220,
+			77, 120, 170, 81, 243, 101, 183, 122, 65, 232, 78, 156, 20, 28,
+			232, 200, 11, 64, 15, 58, 249, 134, 175, 132, 137, 224, 102, 232,
+			71, 73, 186, 70, 117, 123, 4, 70, 195, 89, 156, 169, 72, 60,
+			254, 73, 69, 230, 133, 101, 185, 224, 121, 38, 28, 139, 21, 132,
+			174, 223, 44, 215, 188, 139, 135, 142, 220, 114, 51, 86, 228, 85,
+			215, 26, 188, 117, 58, 94, 222, 149, 197, 13, 207, 189, 64, 116,
+			172, 88, 146, 235, 158, 219, 136, 96, 182, 66, 78, 228, 134, 160,
+			132, 166, 38, 190, 80, 222, 81, 232, 68, 12, 75, 225, 12, 2,
+			31, 87, 59, 110, 11, 1, 248, 38, 146, 124, 121, 179, 237, 141,
+			175, 4, 173, 113, 252, 193, 108, 162, 234, 214, 77, 169, 71, 72,
+			31, 42, 59, 124, 164, 24, 88, 11, 130, 154, 220, 136, 138, 101,
+			33, 145, 110, 252, 2, 77, 249, 81, 198, 19, 236, 83, 64, 85,
+			20, 116, 86, 215, 56, 224, 39, 145, 39, 184, 229, 81, 154, 96,
+			168, 74, 40, 101, 46, 2, 132, 181, 224, 219, 224, 18, 3, 184,
+			190, 253, 132, 160, 50, 71, 175, 79, 245, 228, 10, 83, 242, 108,
+			210, 114, 202, 110, 197, 76, 83, 55, 144, 189, 131, 49, 154, 145,
+			63, 182, 255, 186, 92, 163, 34, 135, 89, 196, 65, 190, 1, 244,
+			97, 111, 130, 62, 116, 17, 135, 67, 212, 20, 133, 9, 83, 79,
+			141, 246, 188, 26, 165, 10, 115, 142, 253, 223, 48, 223, 159, 74,
+			92, 168, 53, 151, 52, 42, 147, 246, 90, 236, 206, 202, 219, 203,
+			5, 29, 168, 196, 0, 159, 132, 208, 115, 91, 144, 46, 129, 167,
+			236, 179, 166, 227, 4, 248, 206, 138, 188, 123, 220, 88, 229, 17,
+			67, 184, 0, 125, 147, 146, 6, 219, 118, 14, 171, 125, 115, 202,
+			218, 33, 94, 139, 249, 229, 29, 251, 237, 152, 223, 75, 21, 71,
+			53, 215, 123, 35, 121, 47, 111, 38, 52, 1, 149, 249, 19, 58,
+			53, 51, 252, 63, 34, 159, 65, 160, 101, 252, 194, 206, 73, 133,
+			218, 65, 162, 59, 9, 231, 148, 195, 129, 208, 116, 197, 111, 133,
+			38, 143, 5, 232, 8, 79, 50, 143, 73, 190, 3, 177, 166, 115,
+			170, 134, 199, 123, 82, 61, 118, 225, 68, 215, 181, 94, 23, 123,
+			64, 103, 242, 17, 14, 86, 98, 19, 72, 214, 132, 129, 248, 67,
+			194, 115, 31, 23, 167, 176, 127, 45, 101, 93, 37, 102, 185, 152,
+			132, 253, 94, 16, 234, 127, 39, 39, 117, 166, 128, 24, 71, 142,
+			148, 13, 36, 30, 42, 236, 192, 149, 37, 104, 120, 9, 28, 41,
+			19, 223, 163, 226, 14, 246, 111, 164, 172, 92, 161, 40, 39, 25,
+			5, 33, 151, 233, 254, 232, 170, 216, 39, 124, 97, 143, 209, 12,
+			210, 142, 253, 155, 224, 57, 227, 114, 50, 210, 110, 144, 62, 35,
+			254, 213, 36, 135, 46, 11, 241, 199, 216, 194, 94, 199, 254, 29,
+			108, 225, 71, 82, 49, 20, 2, 160, 27, 188, 106, 133, 79, 28,
+			70, 18, 79, 165, 104, 182, 187, 44, 39, 99, 125, 171, 112, 164,
+			54, 27, 27, 49, 134, 8, 16, 65, 139, 230, 166, 136, 154, 233,
+			72, 155, 2, 212, 68, 218, 130, 136, 0, 226, 134, 183, 22, 108,
+			208, 197, 40, 246, 21, 248, 255, 116, 5, 229, 151, 149, 44, 211,
+			139, 237, 249, 221, 148, 53, 168, 4, 148, 178, 212, 214, 172, 146,
+			36, 75, 86, 73, 194, 138, 37, 196, 89, 106, 155, 114, 236, 223,
+			195, 225, 125, 246, 37, 1, 85, 74, 192, 188, 196, 231, 123, 253,
+			112, 162, 145, 16, 191, 14, 96, 10, 199, 254, 48, 128, 249, 171,
+			41, 85, 174, 25, 158, 72, 152, 165, 27, 205, 51, 118, 207, 49,
+			171, 41, 11, 213, 92, 31, 54, 186, 185, 37, 211, 41, 194, 247,
+			149, 70, 107, 122, 173, 8, 77, 143, 1, 251, 73, 124, 146, 27,
+			65, 171, 22, 82, 86, 251, 8, 137, 187, 115, 187, 43, 237, 5,
+			134, 41, 11, 241, 76, 97, 219, 2, 176, 250, 253, 148, 181, 163,
+			48, 70, 7, 56, 234, 26, 131, 43, 178, 90, 16, 221, 197, 145,
+			45, 11, 177, 73, 29, 83, 142, 253, 113, 96, 253, 5, 26, 143,
+			111, 73, 70, 33, 167, 149, 72, 212, 207, 140, 138, 179, 79, 11,
+			245, 219, 222, 58, 193, 133, 132, 87, 239, 62, 136, 223, 46, 67,
+			171, 97, 240, 234, 64, 40, 207, 227, 155, 225, 121, 125, 186, 239,
+			18, 150, 189, 195, 177, 255, 16, 48, 190, 115, 27, 149, 147, 166,
+			140, 164, 121, 2, 46, 185, 9, 33, 40, 38, 200, 112, 138, 65,
+			22, 185, 128, 55, 59, 0, 139, 79, 65, 206, 184, 106, 251, 219,
+			196, 189, 129, 223, 40, 11, 209, 164, 182, 41, 199, 254, 76, 202,
+			42, 20, 150, 201, 79, 25, 8, 90, 15, 26, 171, 221, 183, 113,
+			206, 205, 70, 218, 141, 146, 138, 61, 3, 165, 51, 114, 151, 210,
+			46, 161, 186, 140, 80, 14, 199, 224, 105, 200, 169, 232, 213, 228,
+			77, 135, 201, 43, 188, 44, 196, 139, 113, 123, 217, 1, 186, 240,
+			8, 166, 215, 146, 119, 175, 97, 71, 60, 173, 127, 194, 214, 120,
+			136, 52, 40, 203, 133, 181, 96, 195, 92, 114, 89, 183, 169, 180,
+			81, 144, 72, 186, 180, 64, 90, 65, 4, 53, 208, 70, 148, 176,
+			202, 104, 2, 88, 247, 85, 99, 230, 217, 231, 216, 95, 80, 204,
+			115, 27, 136, 251, 97, 178, 4, 199, 189, 65, 4, 96, 86, 190,
+			117, 41, 246, 38, 132, 109, 247, 33, 204, 239, 81, 144, 174, 235,
+			36, 121, 45, 105, 78, 68, 158, 93, 122, 7, 120, 216, 178, 160,
+			130, 134, 125, 240, 128, 119, 236, 191, 72, 217, 187, 196, 20, 63,
+			167, 28, 251, 139, 41, 123, 71, 225, 22, 78, 194, 73, 237, 229,
+			134, 235, 27, 205, 31, 235, 222, 150, 55, 183, 64, 166, 44, 196,
+			34, 15, 99, 57, 246, 95, 165, 236, 190, 194, 201, 174, 97, 34,
+			80, 152, 248, 106, 254, 17, 225, 197, 136, 43, 172, 197, 82, 200,
+			69, 119, 48, 53, 106, 218, 177, 255, 38, 101, 139, 194, 149, 91,
+			70, 37, 133, 34, 192, 123, 37, 96, 129, 85, 125, 57, 101, 229,
+			138, 59, 64, 139, 142, 202, 35, 19, 55, 221, 44, 196, 53, 244,
+			83, 202, 177, 31, 3, 153, 26, 97, 193, 33, 70, 145, 240, 33,
+			52, 177, 28, 251, 43, 151, 21, 67, 112, 3, 234, 179, 210, 142,
+			253, 85, 52, 149, 220, 116, 150, 37, 186, 154, 183, 220, 161, 244,
+			71, 65, 167, 77, 9, 51, 196, 85, 212, 222, 118, 236, 175, 165,
+			172, 66, 113, 128, 219, 223, 244, 140, 195, 135, 67, 243, 107, 198,
+			177, 191, 142, 105, 243, 175, 236, 93, 70, 134, 10, 187, 207, 202,
+			58, 246, 55, 158, 162, 200, 227, 211, 199, 114, 142, 253, 77, 160,
+			249, 81, 206, 133, 162, 82, 104, 145, 12, 218, 12, 154, 157, 58,
+			223, 39, 170, 94, 124, 31, 128, 55, 218, 243, 174, 44, 138, 251,
+			249, 99, 4, 3, 82, 199, 118, 193, 65, 205, 45, 239, 216, 223,
+			122, 138, 115, 83, 128, 235, 117, 236, 111, 199, 1, 55, 169, 209,
+			66, 27, 206, 12, 224, 126, 146, 218, 11, 199, 254, 135, 148, 181,
+			183, 48, 163, 110, 75, 104, 177, 74, 18, 116, 92, 215, 25, 93,
+			169, 230, 99, 235, 171, 83, 214, 250, 8, 241, 120, 161, 33, 83,
+			193, 126, 199, 126, 92, 81, 193, 75, 104, 196, 43, 91, 212, 89,
+			17, 226, 183, 213, 37, 210, 140, 137, 47, 149, 133, 40, 10, 219,
+			238, 7, 22, 126, 31, 240, 223, 185, 149, 10, 150, 73, 162, 178,
+			7, 28, 251, 95, 83, 73, 5, 106, 247, 183, 89, 155, 106, 36,
+			212, 75, 168, 229, 149, 57, 203, 30, 192, 71, 95, 108, 89, 162,
+			112, 141, 156, 231, 150, 186, 73, 73, 6, 245, 26, 22, 194, 37,
+			253, 197, 130, 176, 236, 65, 199, 254, 25, 171, 39, 87, 56, 125,
+			73, 197, 61, 255, 179, 173, 254, 190, 209, 53, 13, 101, 35, 179,
+			7, 49, 139, 151, 88, 86, 190, 112, 96, 59, 6, 144, 68, 54,
+			5, 49, 40, 8, 6, 33, 0, 190, 220, 178, 114, 133, 125, 114,
+			250, 164, 238, 164, 155, 82, 86, 58, 224, 101, 89, 136, 59, 168,
+			181, 229, 216, 175, 178, 172, 108, 225, 176, 92, 108, 169, 108, 121,
+			60, 199, 120, 191, 146, 92, 113, 17, 216, 73, 135, 178, 177, 41,
+			125, 172, 252, 54, 234, 159, 118, 236, 87, 99, 146, 101, 8, 123,
+			166, 160, 8, 123, 37, 110, 81, 244, 243, 120, 101, 165, 144, 29,
+			114, 236, 7, 0, 183, 91, 183, 234, 241, 183, 199, 23, 163, 212,
+			199, 58, 58, 96, 127, 39, 132, 109, 15, 129, 108, 191, 14, 139,
+			184, 69, 106, 167, 63, 0, 197, 141, 101, 6, 227, 183, 113, 24,
+			178, 17, 128, 233, 248, 144, 162, 227, 191, 96, 217, 123, 196, 127,
+			77, 241, 11, 84, 131, 183, 108, 81, 120, 113, 138, 84, 249, 164,
+			201, 229, 10, 72, 110, 107, 83, 146, 55, 28, 233, 126, 217, 98,
+			64, 248, 28, 42, 181, 185, 86, 37, 128, 54, 148, 229, 66, 59,
+			104, 134, 66, 82, 105, 39, 14, 169, 82, 189, 75, 72, 235, 215,
+			6, 50, 158, 55, 142, 122, 231, 35, 112, 41, 204, 37, 62, 175,
+			166, 100, 57, 246, 47, 91, 246, 149, 133, 243, 106, 70, 124, 110,
+			137, 17, 36, 38, 17, 55, 113, 41, 27, 5, 202, 130, 213, 91,
+			158, 91, 51, 242, 80, 8, 51, 37, 114, 172, 42, 153, 45, 222,
+			191, 44, 196, 159, 104, 40, 164, 29, 251, 205, 150, 61, 82, 120,
+			152, 161, 192, 31, 80, 163, 234, 203, 120, 228, 129, 9, 1, 13,
+			22, 28, 101, 43, 89, 198, 229, 3, 133, 71, 233, 45, 125, 136,
+			85, 221, 234, 130, 172, 77, 114, 212, 32, 110, 205, 224, 210, 128,
+			176, 188, 44, 111, 202, 243, 91, 92, 63, 207, 151, 140, 17, 181,
+			219, 240, 170, 215, 157, 168, 168, 182, 94, 22, 226, 151, 244, 146,
+			108, 199, 126, 208, 178, 135, 10, 47, 143, 109, 236, 86, 219, 14,
+			9, 145, 27, 250, 162, 173, 189, 2, 161, 56, 58, 111, 132, 200,
+			243, 96, 157, 34, 169, 195, 138, 140, 45, 170, 242, 34, 223, 53,
+			124, 47, 28, 195, 20, 149, 38, 166, 166, 126, 83, 237, 198, 216,
+			144, 49, 4, 28, 124, 59, 72, 207, 110, 185, 184, 45, 194, 55,
+			128, 240, 56, 227, 239, 182, 172, 29, 133, 243, 93, 114, 50, 105,
+			225, 201, 36, 86, 38, 121, 148, 210, 236, 225, 51, 113, 87, 85,
+			29, 80, 234, 183, 163, 64, 180, 152, 29, 154, 205, 207, 140, 5,
+			207, 165, 239, 89, 142, 253, 235, 160, 41, 119, 210, 247, 214, 221,
+			251, 252, 245, 206, 122, 44, 132, 84, 59, 170, 130, 126, 168, 219,
+			118, 89, 158, 84, 41, 19, 105, 238, 71, 14, 31, 46, 33, 200,
+			111, 61, 8, 219, 132, 254, 216, 12, 40, 22, 135, 192, 253, 31,
+			2, 1, 121, 165, 69, 99, 171, 176, 57, 58, 82, 9, 127, 87,
+			93, 183, 145, 11, 80, 69, 121, 216, 183, 33, 29, 124, 104, 160,
+			54, 39, 51, 83, 9, 137, 18, 117, 121, 72, 92, 210, 97, 126,
+			43, 75, 114, 43, 230, 236, 60, 172, 198, 195, 49, 90, 246, 170,
+			48, 225, 249, 141, 139, 110, 221, 143, 241, 118, 32, 231, 201, 227,
+			68, 63, 129, 30, 44, 173, 134, 101, 57, 89, 175, 75, 70, 108,
+			54, 48, 176, 123, 144, 113, 117, 86, 112, 142, 52, 244, 76, 6,
+			117, 57, 121, 214, 78, 224, 23, 90, 107, 89, 136, 215, 65, 200,
+			30, 66, 249, 248, 247, 3, 27, 94, 146, 210, 14, 42, 177, 115,
+			22, 116, 218, 72, 172, 6, 248, 19, 238, 134, 124, 189, 246, 27,
+			113, 55, 150, 21, 127, 21, 117, 119, 232, 176, 98, 26, 34, 210,
+			84, 53, 3, 228, 252, 195, 57, 212, 30, 180, 101, 121, 46, 212,
+			252, 126, 121, 83, 118, 121, 61, 147, 108, 106, 217, 195, 142, 253,
+			1, 144, 237, 51, 151, 134, 125, 164, 12, 114, 9, 10, 9, 28,
+			97, 34, 172, 113, 155, 89, 201, 48, 80, 255, 131, 88, 108, 204,
+			110, 218, 213, 148, 242, 171, 186, 92, 239, 77, 169, 47, 203, 66,
+			188, 17, 176, 26, 198, 153, 248, 8, 16, 233, 85, 169, 24, 34,
+			193, 182, 132, 50, 203, 106, 247, 99, 232, 132, 129, 26, 219, 45,
+			192, 48, 202, 85, 143, 43, 221, 195, 197, 122, 203, 42, 226, 216,
+			133, 189, 247, 152, 18, 82, 32, 104, 212, 74, 136, 251, 105, 114,
+			150, 99, 255, 1, 56, 84, 93, 177, 89, 63, 185, 46, 56, 3,
+			53, 155, 94, 77, 170, 132, 227, 38, 107, 18, 143, 82, 146, 46,
+			98, 148, 161, 110, 130, 46, 106, 213, 163, 155, 53, 42, 205, 55,
+			16, 229, 11, 106, 2, 237, 5, 148, 251, 210, 187, 232, 181, 144,
+			52, 109, 213, 43, 11, 241, 178, 148, 176, 108, 199, 177, 255, 16,
+			219, 245, 31, 100, 180, 76, 6, 176, 244, 99, 249, 36, 121, 206,
+			49, 198, 193, 28, 210, 76, 19, 138, 84, 79, 51, 85, 17, 159,
+			162, 194, 109, 47, 140, 99, 183, 87, 139, 58, 42, 77, 152, 147,
+			239, 113, 236, 63, 178, 40, 18, 213, 182, 29, 108, 248, 167, 44,
+			123, 128, 159, 82, 142, 253, 105, 203, 190, 130, 159, 44, 199, 254,
+			99, 203, 30, 230, 167, 180, 99, 255, 137, 101, 239, 22, 183, 8,
+			203, 30, 113, 236, 255, 133, 245, 140, 201, 200, 13, 91, 45, 132,
+			56, 234, 22, 118, 92, 22, 98, 93, 216, 246, 8, 62, 247, 57,
+			224, 215, 82, 220, 167, 138, 57, 45, 117, 101, 198, 157, 96, 27,
+			68, 135, 144, 226, 80, 45, 30, 218, 96, 213, 213, 184, 3, 197,
+			9, 11, 218, 9, 113, 65, 88, 246, 78, 199, 254, 11, 204, 114,
+			73, 110, 227, 6, 174, 166, 203, 94, 44, 49, 209, 155, 25, 117,
+			24, 49, 106, 20, 185, 35, 150, 122, 73, 54, 253, 44, 97, 219,
+			59, 177, 182, 47, 2, 249, 15, 70, 107, 139, 252, 17, 35, 207,
+			134, 232, 91, 101, 33, 158, 67, 61, 83, 142, 253, 87, 96, 37,
+			183, 203, 197, 109, 154, 30, 219, 198, 81, 73, 49, 136, 174, 105,
+			252, 39, 26, 204, 114, 236, 47, 1, 196, 47, 148, 147, 160, 143,
+			126, 99, 203, 110, 64, 228, 96, 106, 116, 158, 224, 120, 126, 171,
+			84, 194, 134, 36, 112, 120, 202, 116, 97, 148, 20, 140, 151, 122,
+			68, 191, 161, 199, 224, 171, 192, 46, 199, 254, 138, 69, 87, 129,
+			132, 47, 188, 2, 55, 119, 229, 242, 169, 200, 13, 76, 170, 137,
+			132, 204, 65, 212, 21, 28, 139, 239, 31, 187, 0, 216, 175, 89,
+			151, 188, 127, 220, 35, 44, 123, 183, 99, 255, 61, 62, 122, 86,
+			110, 245, 172, 215, 218, 182, 164, 100, 97, 110, 93, 124, 69, 103,
+			150, 93, 139, 24, 121, 89, 136, 103, 8, 219, 222, 141, 207, 127,
+			11, 159, 31, 149, 167, 58, 70, 70, 137, 43, 96, 185, 92, 202,
+			81, 250, 5, 58, 216, 127, 71, 253, 82, 142, 253, 29, 244, 155,
+			72, 76, 59, 154, 130, 238, 14, 159, 91, 152, 109, 202, 176, 32,
+			250, 141, 85, 140, 240, 65, 208, 211, 221, 80, 240, 252, 35, 246,
+			242, 189, 169, 248, 102, 110, 217, 197, 118, 66, 0, 49, 140, 54,
+			241, 41, 162, 17, 34, 66, 72, 26, 164, 203, 214, 150, 16, 23,
+			203, 146, 220, 204, 53, 157, 140, 198, 135, 235, 92, 178, 31, 247,
+			224, 211, 84, 135, 90, 28, 186, 212, 221, 16, 44, 30, 7, 201,
+			125, 182, 33, 185, 32, 159, 220, 156, 72, 155, 82, 127, 37, 103,
+			171, 236, 78, 38, 100, 33, 44, 11, 19, 26, 255, 173, 123, 197,
+			226, 182, 62, 203, 202, 224, 70, 174, 203, 135, 152, 243, 154, 116,
+			132, 135, 64, 161, 131, 6, 10, 11, 169, 6, 244, 195, 33, 90,
+			173, 14, 155, 223, 29, 181, 81, 238, 211, 234, 215, 226, 87, 83,
+			34, 91, 161, 63, 17, 8, 234, 54, 253, 37, 54, 38, 114, 236,
+			164, 112, 155, 62, 123, 48, 59, 39, 197, 142, 104, 17, 8, 188,
+			85, 225, 192, 219, 143, 92, 142, 48, 180, 18, 239, 230, 76, 10,
+			17, 45, 157, 2, 168, 119, 76, 92, 115, 169, 65, 78, 232, 150,
+			149, 88, 39, 231, 22, 145, 85, 31, 161, 232, 148, 29, 19, 87,
+			95, 170, 59, 173, 172, 194, 141, 139, 183, 9, 17, 77, 106, 219,
+			64, 112, 4, 29, 35, 143, 100, 131, 35, 206, 242, 21, 243, 92,
+			252, 173, 148, 232, 53, 211, 113, 22, 17, 207, 7, 139, 108, 181,
+			205, 65, 77, 207, 184, 236, 26, 202, 147, 109, 246, 115, 174, 112,
+			87, 21, 12, 100, 70, 42, 204, 136, 157, 219, 181, 65, 164, 170,
+			171, 223, 243, 132, 163, 23, 206, 110, 145, 37, 73, 68, 71, 175,
+			243, 211, 113, 91, 88, 65, 179, 248, 234, 148, 200, 16, 28, 182,
+			93, 241, 49, 145, 215, 232, 202, 27, 186, 255, 82, 235, 56, 174,
+			67, 198, 116, 7, 231, 22, 97, 195, 47, 139, 67, 168, 46, 185,
+			137, 244, 245, 147, 110, 219, 173, 80, 243, 226, 5, 145, 227, 177,
+			28, 153, 68, 41, 4, 173, 245, 39, 209, 5, 17, 66, 250, 104,
+			234, 5, 198, 222, 56, 251, 18, 232, 4, 124, 232, 143, 227, 74,
+			241, 86, 209, 107, 190, 239, 148, 132, 227, 193, 255, 182, 234, 45,
+			249, 13, 29, 2, 192, 81, 85, 67, 252, 203, 116, 131, 41, 245,
+			241, 219, 126, 242, 232, 15, 124, 22, 143, 233, 63, 238, 124, 83,
+			21, 197, 54, 251, 123, 126, 206, 74, 137, 111, 168, 184, 130, 254,
+			30, 103, 226, 93, 169, 100, 92, 193, 225, 173, 113, 5, 151, 200,
+			77, 122, 46, 100, 34, 235, 135, 137, 76, 158, 112, 164, 128, 7,
+			98, 227, 169, 134, 33, 136, 75, 27, 184, 216, 253, 159, 34, 16,
+			202, 98, 226, 161, 180, 228, 220, 198, 124, 83, 213, 153, 221, 165,
+			62, 22, 219, 152, 121, 200, 105, 144, 96, 30, 38, 124, 48, 56,
+			203, 39, 251, 20, 197, 124, 254, 89, 69, 29, 57, 27, 50, 80,
+			35, 35, 185, 43, 215, 252, 213, 53, 33, 117, 213, 161, 196, 39,
+			87, 60, 200, 133, 53, 226, 23, 52, 198, 9, 186, 145, 40, 95,
+			15, 70, 193, 234, 202, 170, 100, 54, 198, 23, 22, 92, 164, 234,
+			30, 251, 25, 168, 86, 75, 234, 23, 69, 49, 53, 68, 220, 248,
+			132, 226, 96, 199, 149, 87, 149, 15, 192, 42, 57, 180, 153, 133,
+			61, 29, 195, 193, 186, 125, 140, 17, 180, 40, 100, 224, 98, 119,
+			46, 210, 96, 133, 108, 41, 52, 113, 254, 12, 74, 135, 41, 219,
+			59, 65, 185, 217, 162, 250, 43, 152, 33, 110, 213, 13, 185, 238,
+			94, 0, 83, 225, 81, 239, 215, 150, 252, 170, 31, 114, 222, 116,
+			21, 127, 48, 104, 50, 24, 14, 247, 252, 132, 206, 96, 136, 63,
+			255, 40, 75, 46, 161, 246, 13, 61, 39, 83, 133, 135, 179, 188,
+			87, 44, 223, 178, 176, 31, 43, 105, 164, 125, 59, 149, 56, 19,
+			59, 155, 108, 97, 81, 157, 177, 73, 88, 4, 252, 191, 154, 245,
+			96, 19, 43, 4, 96, 84, 202, 63, 237, 250, 176, 117, 243, 99,
+			215, 142, 4, 12, 228, 104, 178, 46, 191, 114, 17, 61, 121, 124,
+			236, 146, 232, 146, 44, 106, 21, 121, 132, 192, 236, 221, 90, 119,
+			235, 254, 253, 94, 237, 201, 240, 214, 128, 88, 9, 200, 34, 145,
+			121, 149, 246, 17, 72, 200, 56, 8, 61, 251, 248, 138, 91, 229,
+			194, 57, 241, 161, 194, 14, 215, 106, 3, 66, 174, 119, 234, 109,
+			191, 89, 247, 68, 28, 19, 171, 140, 160, 126, 221, 35, 132, 185,
+			232, 182, 160, 104, 208, 239, 67, 175, 205, 120, 201, 58, 78, 82,
+			190, 170, 109, 138, 170, 249, 225, 85, 77, 249, 58, 106, 15, 131,
+			75, 45, 14, 14, 124, 141, 3, 109, 93, 95, 175, 17, 152, 37,
+			7, 152, 65, 208, 82, 147, 195, 117, 99, 205, 3, 158, 146, 62,
+			113, 145, 210, 61, 153, 156, 142, 184, 123, 174, 185, 141, 90, 253,
+			201, 78, 44, 249, 215, 199, 124, 1, 106, 193, 70, 35, 68, 50,
+			247, 117, 221, 68, 227, 66, 189, 190, 41, 15, 30, 92, 215, 38,
+			192, 170, 219, 242, 14, 30, 164, 163, 224, 183, 97, 218, 189, 16,
+			194, 41, 145, 139, 37, 144, 79, 14, 159, 9, 149, 231, 151, 190,
+			223, 234, 208, 244, 59, 237, 186, 207, 78, 207, 213, 109, 143, 225,
+			104, 208, 18, 18, 170, 170, 139, 200, 86, 9, 31, 44, 36, 113,
+			224, 120, 43, 79, 227, 63, 18, 147, 123, 247, 53, 131, 176, 107,
+			129, 56, 103, 178, 238, 47, 183, 220, 22, 212, 107, 0, 246, 44,
+			46, 108, 211, 39, 143, 202, 91, 202, 81, 110, 174, 27, 242, 3,
+			226, 21, 105, 237, 28, 93, 182, 246, 20, 254, 217, 162, 108, 231,
+			218, 61, 11, 8, 217, 168, 182, 60, 46, 244, 133, 179, 236, 105,
+			207, 113, 93, 238, 193, 184, 44, 5, 43, 122, 94, 188, 225, 218,
+			118, 25, 154, 162, 145, 240, 200, 218, 112, 91, 53, 190, 163, 187,
+			109, 31, 81, 89, 27, 238, 38, 215, 229, 134, 201, 204, 160, 203,
+			106, 43, 216, 224, 56, 25, 214, 33, 169, 115, 66, 91, 112, 16,
+			140, 160, 186, 230, 85, 47, 168, 181, 235, 189, 26, 43, 39, 9,
+			147, 78, 216, 76, 116, 84, 77, 207, 32, 36, 115, 8, 194, 177,
+			216, 170, 241, 218, 67, 129, 51, 32, 32, 116, 245, 171, 13, 255,
+			126, 79, 167, 2, 133, 7, 140, 87, 117, 41, 17, 151, 208, 190,
+			97, 114, 221, 15, 13, 229, 211, 226, 51, 159, 78, 117, 68, 216,
+			249, 95, 227, 189, 246, 35, 139, 1, 250, 136, 201, 76, 213, 147,
+			193, 102, 196, 51, 137, 149, 243, 35, 250, 41, 237, 164, 203, 187,
+			175, 16, 223, 181, 116, 38, 177, 91, 173, 27, 10, 127, 107, 37,
+			124, 218, 155, 65, 24, 18, 100, 227, 164, 111, 219, 176, 40, 204,
+			103, 18, 57, 155, 93, 172, 172, 211, 240, 49, 33, 74, 101, 71,
+			131, 117, 245, 103, 25, 200, 192, 15, 189, 89, 244, 8, 41, 162,
+			143, 124, 31, 99, 65, 129, 180, 103, 129, 218, 39, 5, 236, 248,
+			136, 198, 111, 205, 93, 166, 164, 226, 90, 45, 133, 55, 213, 118,
+			135, 14, 156, 185, 145, 38, 145, 59, 220, 12, 219, 222, 186, 241,
+			97, 67, 191, 149, 78, 29, 68, 7, 68, 112, 185, 179, 186, 202,
+			85, 64, 26, 176, 251, 226, 111, 34, 25, 235, 193, 197, 174, 240,
+			56, 181, 4, 79, 70, 98, 182, 94, 29, 6, 83, 122, 171, 136,
+			102, 113, 118, 48, 219, 73, 223, 106, 153, 167, 172, 147, 190, 117,
+			199, 46, 253, 132, 29, 217, 125, 141, 126, 74, 59, 233, 91, 175,
+			187, 158, 244, 0, 176, 82, 164, 239, 176, 174, 43, 180, 18, 123,
+			21, 9, 126, 93, 214, 2, 45, 179, 98, 134, 12, 248, 88, 19,
+			224, 232, 58, 31, 122, 191, 37, 145, 92, 109, 92, 9, 31, 202,
+			89, 67, 83, 19, 227, 114, 133, 169, 90, 54, 66, 116, 204, 83,
+			214, 73, 223, 177, 99, 167, 126, 74, 57, 233, 59, 118, 237, 215,
+			79, 105, 39, 125, 71, 241, 90, 50, 247, 194, 214, 145, 62, 97,
+			93, 85, 248, 255, 37, 38, 222, 242, 86, 113, 229, 132, 46, 157,
+			49, 253, 146, 24, 6, 0, 211, 217, 187, 60, 108, 211, 182, 147,
+			62, 97, 166, 152, 206, 58, 233, 19, 59, 134, 244, 83, 202, 73,
+			159, 24, 190, 66, 63, 97, 86, 133, 189, 226, 113, 100, 34, 78,
+			57, 118, 19, 153, 136, 191, 62, 20, 223, 73, 146, 15, 84, 97,
+			92, 125, 212, 137, 49, 173, 203, 226, 109, 76, 32, 238, 40, 223,
+			22, 118, 40, 163, 228, 29, 229, 219, 46, 122, 173, 229, 59, 138,
+			165, 173, 69, 129, 136, 46, 197, 108, 92, 42, 91, 159, 28, 45,
+			114, 151, 177, 132, 124, 90, 131, 35, 16, 66, 52, 208, 11, 182,
+			171, 213, 160, 181, 41, 112, 158, 116, 217, 161, 16, 93, 245, 119,
+			139, 99, 37, 185, 238, 54, 220, 85, 166, 100, 232, 5, 49, 194,
+			175, 82, 197, 16, 109, 174, 139, 147, 53, 33, 71, 163, 21, 20,
+			97, 39, 145, 119, 251, 80, 225, 108, 169, 27, 73, 91, 35, 71,
+			195, 238, 29, 24, 43, 37, 188, 144, 70, 81, 166, 5, 10, 97,
+			120, 212, 131, 35, 66, 90, 80, 42, 45, 254, 12, 236, 177, 240,
+			141, 161, 10, 27, 138, 47, 3, 233, 164, 222, 200, 228, 193, 142,
+			9, 222, 66, 110, 24, 233, 134, 70, 69, 127, 115, 190, 107, 1,
+			23, 5, 99, 184, 192, 145, 160, 177, 202, 165, 20, 13, 21, 85,
+			178, 197, 54, 226, 68, 123, 173, 91, 238, 163, 143, 21, 183, 31,
+			166, 72, 188, 42, 44, 71, 217, 84, 195, 46, 42, 119, 20, 169,
+			77, 15, 202, 229, 142, 95, 175, 45, 119, 170, 23, 188, 118, 153,
+			254, 46, 171, 144, 17, 245, 107, 184, 225, 182, 214, 161, 143, 105,
+			6, 65, 189, 140, 19, 118, 60, 104, 135, 93, 191, 181, 225, 30,
+			93, 69, 217, 178, 122, 66, 202, 139, 62, 198, 217, 246, 117, 90,
+			202, 0, 135, 55, 88, 81, 194, 134, 95, 149, 109, 143, 74, 47,
+			192, 46, 65, 232, 199, 70, 223, 42, 66, 87, 57, 9, 182, 218,
+			152, 72, 122, 101, 193, 136, 113, 172, 44, 79, 107, 185, 165, 36,
+			59, 208, 103, 147, 180, 12, 126, 142, 149, 116, 77, 68, 223, 30,
+			120, 212, 3, 201, 27, 27, 48, 3, 125, 81, 136, 205, 4, 218,
+			160, 196, 143, 144, 13, 183, 221, 105, 41, 247, 168, 201, 248, 160,
+			124, 24, 214, 221, 22, 88, 52, 210, 152, 106, 97, 15, 105, 181,
+			181, 220, 23, 117, 8, 181, 213, 148, 106, 168, 5, 27, 92, 58,
+			184, 217, 68, 198, 77, 8, 74, 157, 176, 29, 172, 179, 0, 136,
+			186, 157, 144, 103, 105, 239, 85, 205, 9, 156, 97, 166, 68, 36,
+			178, 170, 203, 18, 223, 230, 120, 50, 29, 206, 93, 26, 19, 59,
+			137, 114, 141, 234, 113, 252, 70, 124, 8, 191, 145, 20, 26, 133,
+			129, 121, 36, 247, 194, 84, 189, 157, 216, 107, 14, 154, 54, 92,
+			48, 171, 99, 47, 36, 193, 231, 188, 229, 233, 79, 171, 133, 93,
+			82, 78, 85, 134, 223, 114, 116, 57, 229, 200, 31, 204, 1, 122,
+			247, 201, 19, 51, 33, 123, 13, 243, 218, 232, 15, 157, 87, 148,
+			179, 146, 171, 129, 99, 90, 15, 227, 237, 23, 145, 140, 56, 161,
+			209, 91, 173, 111, 128, 234, 44, 199, 55, 13, 233, 203, 193, 222,
+			57, 208, 28, 44, 151, 68, 37, 101, 48, 4, 218, 232, 43, 17,
+			15, 121, 32, 148, 205, 206, 50, 42, 149, 163, 168, 153, 60, 23,
+			42, 70, 207, 191, 106, 207, 113, 193, 66, 3, 25, 221, 226, 223,
+			75, 80, 81, 146, 32, 32, 32, 40, 241, 113, 91, 170, 126, 176,
+			56, 86, 18, 186, 36, 175, 178, 65, 178, 59, 134, 27, 234, 33,
+			140, 236, 162, 62, 154, 88, 95, 163, 141, 252, 241, 170, 100, 41,
+			217, 26, 12, 22, 132, 145, 252, 209, 108, 249, 235, 170, 240, 112,
+			36, 137, 68, 75, 162, 131, 143, 253, 198, 101, 161, 216, 108, 5,
+			247, 109, 22, 217, 191, 69, 36, 200, 95, 84, 231, 252, 124, 108,
+			14, 228, 249, 144, 20, 173, 99, 188, 9, 23, 221, 248, 132, 227,
+			18, 147, 216, 138, 72, 229, 164, 246, 70, 191, 142, 226, 6, 120,
+			186, 93, 195, 10, 137, 237, 247, 3, 62, 36, 198, 53, 32, 68,
+			126, 101, 56, 34, 224, 111, 78, 204, 58, 70, 248, 233, 174, 195,
+			217, 50, 185, 142, 208, 107, 155, 58, 139, 9, 52, 211, 40, 20,
+			251, 164, 241, 145, 111, 4, 18, 196, 220, 107, 201, 81, 164, 162,
+			13, 218, 114, 211, 107, 143, 177, 194, 219, 212, 75, 217, 242, 161,
+			18, 7, 209, 98, 67, 25, 139, 195, 78, 149, 220, 54, 73, 229,
+			94, 108, 4, 177, 175, 21, 5, 151, 1, 85, 145, 36, 172, 249,
+			145, 174, 220, 112, 85, 74, 133, 200, 126, 124, 32, 148, 245, 128,
+			228, 50, 220, 163, 144, 56, 182, 153, 119, 196, 141, 58, 226, 242,
+			133, 150, 83, 220, 247, 164, 2, 134, 146, 110, 82, 148, 49, 248,
+			133, 86, 94, 63, 165, 156, 244, 11, 123, 251, 245, 83, 218, 73,
+			191, 112, 104, 88, 156, 164, 97, 145, 156, 214, 218, 89, 124, 102,
+			116, 184, 99, 171, 221, 90, 242, 170, 139, 28, 234, 239, 169, 140,
+			183, 89, 253, 132, 65, 115, 131, 250, 9, 25, 111, 157, 17, 241,
+			123, 25, 138, 9, 205, 254, 116, 170, 231, 53, 169, 84, 225, 61,
+			25, 105, 148, 193, 38, 244, 2, 130, 131, 167, 162, 121, 88, 115,
+			225, 94, 160, 215, 124, 107, 56, 111, 20, 189, 242, 118, 149, 241,
+			247, 188, 108, 186, 126, 43, 100, 99, 135, 219, 85, 219, 62, 146,
+			117, 162, 85, 201, 234, 154, 87, 189, 32, 221, 255, 135, 186, 183,
+			11, 142, 227, 186, 242, 195, 113, 187, 123, 6, 131, 59, 252, 0,
+			155, 32, 62, 154, 4, 121, 53, 22, 5, 128, 6, 134, 36, 68,
+			210, 92, 154, 162, 53, 4, 65, 17, 34, 9, 66, 0, 40, 201,
+			43, 203, 240, 0, 211, 0, 199, 26, 206, 140, 167, 7, 164, 160,
+			181, 255, 255, 202, 110, 121, 227, 141, 35, 201, 31, 165, 210, 186,
+			214, 94, 151, 29, 175, 82, 46, 103, 203, 94, 167, 92, 142, 99,
+			231, 33, 31, 149, 45, 87, 226, 125, 243, 131, 171, 28, 215, 166,
+			54, 85, 201, 99, 202, 201, 83, 182, 54, 73, 253, 78, 159, 115,
+			187, 103, 48, 148, 176, 107, 187, 106, 131, 39, 156, 158, 238, 251,
+			121, 206, 185, 231, 158, 79, 91, 22, 43, 74, 219, 136, 181, 53,
+			112, 0, 109, 43, 114, 253, 173, 70, 137, 141, 66, 246, 156, 130,
+			65, 112, 118, 182, 225, 117, 200, 117, 246, 203, 239, 134, 120, 211,
+			136, 80, 225, 175, 17, 227, 32, 34, 68, 135, 246, 74, 243, 253,
+			122, 199, 38, 42, 159, 161, 153, 66, 85, 68, 44, 23, 63, 97,
+			122, 178, 20, 93, 82, 81, 186, 196, 191, 222, 109, 137, 138, 185,
+			31, 49, 82, 240, 38, 118, 28, 192, 20, 235, 91, 92, 172, 66,
+			210, 77, 200, 199, 194, 68, 52, 199, 81, 197, 252, 5, 181, 55,
+			80, 163, 167, 220, 42, 223, 15, 219, 169, 144, 119, 186, 129, 227,
+			189, 229, 165, 185, 78, 215, 139, 118, 171, 186, 181, 133, 170, 206,
+			58, 161, 32, 172, 92, 201, 214, 50, 42, 63, 40, 87, 107, 228,
+			109, 144, 154, 30, 200, 38, 165, 10, 72, 149, 201, 143, 82, 165,
+			50, 117, 247, 68, 227, 193, 202, 77, 94, 140, 252, 178, 61, 221,
+			199, 79, 149, 98, 73, 224, 32, 85, 90, 90, 208, 246, 185, 220,
+			173, 243, 218, 69, 4, 169, 247, 123, 168, 128, 246, 61, 92, 145,
+			29, 183, 207, 207, 126, 70, 57, 159, 85, 110, 240, 13, 199, 244,
+			178, 85, 8, 14, 216, 98, 30, 169, 73, 1, 163, 39, 203, 41,
+			220, 126, 37, 220, 137, 55, 90, 199, 40, 61, 213, 233, 212, 97,
+			49, 121, 210, 54, 50, 29, 147, 192, 20, 189, 95, 52, 115, 60,
+			83, 94, 25, 242, 179, 137, 243, 135, 80, 55, 88, 226, 152, 69,
+			51, 231, 138, 89, 22, 21, 146, 45, 45, 126, 24, 155, 253, 136,
+			166, 185, 45, 86, 163, 93, 50, 31, 43, 215, 119, 38, 123, 188,
+			11, 238, 221, 110, 183, 98, 97, 193, 86, 240, 165, 127, 162, 169,
+			143, 113, 45, 56, 199, 237, 83, 190, 247, 25, 165, 143, 147, 31,
+			160, 227, 146, 31, 224, 31, 40, 111, 76, 31, 212, 253, 49, 156,
+			241, 189, 63, 80, 158, 78, 30, 192, 199, 82, 229, 135, 146, 7,
+			46, 30, 140, 140, 234, 51, 220, 132, 66, 184, 164, 119, 162, 96,
+			76, 196, 78, 54, 169, 235, 34, 164, 212, 122, 195, 84, 182, 155,
+			81, 210, 166, 242, 232, 147, 125, 201, 131, 12, 30, 236, 63, 156,
+			60, 160, 70, 135, 130, 228, 129, 139, 7, 227, 24, 184, 231, 57,
+			185, 62, 63, 251, 186, 114, 222, 84, 110, 60, 175, 28, 230, 245,
+			186, 202, 129, 39, 198, 65, 235, 222, 27, 202, 123, 34, 254, 209,
+			233, 203, 18, 56, 42, 160, 2, 56, 246, 152, 128, 46, 192, 199,
+			79, 234, 63, 53, 20, 10, 158, 253, 153, 234, 251, 47, 74, 5,
+			127, 108, 88, 228, 163, 251, 38, 104, 18, 133, 101, 106, 181, 112,
+			67, 46, 37, 147, 151, 237, 85, 235, 202, 180, 185, 156, 160, 255,
+			21, 222, 59, 208, 23, 159, 240, 56, 59, 19, 113, 162, 183, 96,
+			42, 252, 157, 197, 80, 177, 11, 224, 14, 199, 44, 31, 200, 16,
+			167, 238, 216, 37, 28, 39, 34, 36, 80, 183, 213, 168, 177, 126,
+			148, 217, 36, 210, 188, 159, 58, 197, 223, 156, 58, 165, 217, 21,
+			81, 234, 196, 177, 19, 58, 70, 149, 200, 156, 86, 128, 161, 151,
+			69, 25, 25, 133, 210, 181, 232, 123, 91, 40, 126, 199, 49, 91,
+			137, 150, 73, 4, 9, 30, 174, 61, 227, 248, 57, 42, 8, 67,
+			120, 66, 76, 66, 52, 77, 97, 73, 229, 154, 104, 167, 249, 230,
+			141, 217, 236, 77, 134, 239, 33, 194, 23, 245, 163, 150, 132, 100,
+			148, 83, 167, 146, 27, 214, 169, 83, 123, 88, 14, 157, 90, 15,
+			59, 186, 114, 20, 53, 54, 170, 101, 155, 121, 159, 154, 78, 26,
+			198, 205, 45, 45, 17, 69, 102, 18, 61, 72, 249, 234, 248, 62,
+			139, 190, 224, 68, 154, 226, 51, 244, 13, 175, 242, 84, 234, 118,
+			64, 210, 108, 234, 30, 181, 29, 133, 58, 145, 30, 226, 187, 6,
+			58, 72, 80, 49, 74, 205, 36, 21, 149, 93, 125, 16, 206, 196,
+			6, 4, 218, 237, 73, 74, 104, 129, 127, 217, 38, 215, 110, 149,
+			235, 209, 6, 42, 254, 240, 40, 12, 5, 242, 36, 154, 231, 146,
+			217, 236, 116, 210, 72, 107, 97, 248, 155, 43, 151, 46, 211, 118,
+			198, 202, 151, 176, 21, 242, 61, 60, 121, 161, 144, 16, 151, 156,
+			96, 233, 169, 203, 225, 138, 125, 144, 224, 15, 40, 62, 160, 15,
+			68, 14, 177, 90, 21, 119, 161, 154, 41, 60, 45, 107, 80, 232,
+			188, 44, 49, 198, 72, 183, 60, 154, 221, 157, 210, 15, 226, 119,
+			147, 66, 179, 20, 37, 242, 173, 0, 95, 113, 209, 25, 184, 46,
+			69, 13, 150, 204, 137, 205, 243, 176, 103, 162, 141, 70, 51, 172,
+			176, 239, 74, 84, 133, 98, 15, 14, 168, 36, 79, 69, 200, 205,
+			21, 71, 25, 64, 108, 170, 214, 227, 214, 202, 134, 62, 194, 128,
+			64, 21, 246, 70, 223, 177, 30, 241, 194, 211, 19, 193, 69, 136,
+			137, 166, 217, 168, 198, 113, 212, 225, 171, 229, 13, 152, 21, 26,
+			117, 235, 100, 2, 231, 228, 205, 176, 213, 98, 173, 9, 14, 89,
+			236, 156, 238, 181, 77, 52, 98, 203, 113, 68, 39, 137, 30, 216,
+			51, 106, 29, 80, 109, 195, 134, 74, 208, 158, 108, 34, 147, 18,
+			173, 29, 93, 164, 44, 153, 224, 74, 216, 66, 237, 202, 118, 21,
+			190, 223, 112, 110, 14, 37, 21, 88, 25, 136, 79, 30, 157, 105,
+			33, 135, 107, 104, 22, 181, 121, 1, 21, 128, 119, 248, 138, 21,
+			51, 64, 158, 47, 43, 129, 162, 46, 21, 16, 170, 123, 213, 170,
+			15, 210, 117, 189, 249, 57, 85, 132, 33, 59, 75, 161, 227, 167,
+			34, 58, 161, 29, 124, 68, 79, 93, 29, 241, 106, 165, 23, 174,
+			192, 103, 122, 163, 5, 46, 11, 25, 181, 190, 213, 169, 39, 192,
+			151, 117, 43, 30, 70, 72, 22, 64, 59, 86, 105, 208, 157, 91,
+			39, 179, 144, 234, 250, 196, 181, 112, 21, 169, 110, 136, 77, 86,
+			126, 132, 201, 170, 109, 202, 17, 170, 103, 196, 44, 138, 121, 105,
+			53, 125, 33, 199, 130, 38, 87, 113, 43, 133, 10, 131, 164, 137,
+			194, 26, 150, 52, 91, 52, 43, 141, 251, 246, 141, 88, 231, 15,
+			117, 63, 100, 224, 114, 187, 186, 33, 86, 185, 184, 103, 226, 107,
+			48, 41, 242, 42, 116, 154, 66, 136, 86, 176, 219, 64, 133, 184,
+			169, 10, 176, 206, 84, 118, 234, 229, 251, 28, 37, 191, 190, 163,
+			233, 162, 220, 228, 232, 99, 106, 159, 245, 250, 118, 88, 216, 54,
+			78, 233, 66, 38, 245, 114, 61, 21, 196, 204, 62, 168, 237, 123,
+			173, 144, 117, 104, 150, 218, 145, 171, 161, 102, 195, 51, 228, 36,
+			73, 70, 203, 122, 206, 75, 29, 20, 240, 116, 171, 209, 104, 23,
+			166, 59, 30, 213, 194, 173, 242, 6, 174, 244, 245, 74, 199, 115,
+			254, 175, 32, 123, 133, 79, 153, 218, 88, 176, 149, 59, 27, 49,
+			133, 205, 114, 173, 6, 11, 25, 207, 47, 149, 56, 198, 206, 148,
+			232, 23, 185, 167, 26, 118, 49, 104, 212, 98, 47, 165, 47, 138,
+			164, 12, 70, 162, 54, 27, 48, 78, 157, 78, 119, 162, 109, 196,
+			78, 221, 154, 11, 211, 87, 31, 132, 20, 21, 138, 245, 102, 251,
+			17, 78, 8, 82, 220, 74, 245, 207, 122, 37, 140, 170, 45, 136,
+			254, 197, 238, 25, 109, 199, 138, 28, 157, 8, 196, 196, 186, 122,
+			30, 37, 105, 219, 6, 154, 137, 23, 176, 247, 210, 128, 77, 219,
+			223, 101, 228, 178, 221, 235, 225, 38, 172, 8, 92, 114, 153, 53,
+			36, 176, 58, 86, 163, 251, 136, 65, 0, 131, 23, 39, 14, 232,
+			16, 203, 81, 216, 129, 227, 27, 101, 172, 26, 33, 37, 5, 134,
+			153, 50, 153, 37, 52, 244, 142, 173, 70, 179, 133, 3, 155, 135,
+			101, 93, 59, 122, 114, 173, 34, 23, 88, 38, 194, 136, 141, 142,
+			27, 53, 114, 92, 78, 110, 41, 178, 175, 21, 49, 26, 111, 179,
+			2, 170, 115, 254, 147, 113, 14, 14, 250, 17, 225, 41, 109, 83,
+			174, 213, 166, 100, 169, 24, 167, 122, 175, 85, 57, 74, 31, 82,
+			141, 150, 153, 144, 215, 99, 169, 105, 34, 89, 194, 105, 77, 47,
+			112, 200, 221, 116, 154, 113, 117, 209, 105, 172, 121, 36, 182, 76,
+			254, 5, 244, 148, 58, 106, 133, 141, 205, 152, 37, 176, 162, 105,
+			23, 99, 144, 141, 182, 246, 40, 198, 89, 150, 77, 204, 102, 88,
+			9, 185, 27, 56, 79, 167, 145, 165, 97, 211, 123, 84, 215, 171,
+			181, 42, 142, 134, 46, 6, 107, 38, 211, 181, 193, 90, 97, 109,
+			7, 76, 2, 119, 204, 240, 85, 174, 246, 83, 219, 233, 24, 88,
+			186, 131, 70, 43, 178, 139, 26, 155, 35, 236, 253, 246, 61, 197,
+			117, 112, 28, 17, 54, 136, 227, 200, 167, 86, 49, 17, 177, 32,
+			19, 27, 100, 248, 33, 220, 131, 152, 177, 69, 200, 144, 80, 0,
+			255, 17, 65, 153, 59, 228, 28, 31, 101, 28, 188, 252, 83, 34,
+			162, 145, 230, 188, 35, 152, 188, 179, 125, 209, 208, 144, 96, 16,
+			109, 175, 199, 151, 214, 180, 174, 63, 169, 62, 12, 7, 44, 171,
+			198, 235, 120, 163, 202, 182, 224, 196, 145, 134, 248, 70, 220, 30,
+			84, 234, 43, 241, 127, 118, 9, 82, 214, 74, 218, 249, 102, 163,
+			13, 101, 33, 77, 148, 228, 173, 8, 187, 21, 31, 235, 146, 226,
+			236, 17, 106, 72, 178, 49, 180, 182, 97, 146, 182, 77, 174, 115,
+			43, 98, 92, 79, 137, 93, 116, 134, 104, 182, 110, 196, 179, 230,
+			59, 5, 28, 45, 176, 90, 61, 213, 218, 81, 15, 71, 14, 54,
+			66, 179, 177, 10, 11, 31, 59, 107, 160, 55, 240, 49, 78, 59,
+			106, 189, 58, 182, 69, 56, 195, 98, 66, 254, 141, 245, 135, 102,
+			146, 101, 7, 216, 25, 233, 76, 149, 121, 76, 37, 57, 170, 248,
+			149, 14, 1, 128, 197, 70, 162, 11, 150, 197, 55, 26, 219, 168,
+			248, 71, 220, 237, 94, 117, 235, 222, 204, 110, 79, 30, 155, 252,
+			21, 196, 76, 251, 209, 157, 255, 85, 124, 129, 116, 143, 234, 246,
+			221, 214, 194, 14, 90, 161, 131, 133, 117, 35, 169, 6, 65, 51,
+			37, 198, 26, 8, 40, 36, 24, 109, 148, 91, 45, 54, 93, 70,
+			247, 129, 49, 229, 251, 82, 196, 21, 14, 148, 102, 146, 79, 135,
+			216, 122, 207, 229, 84, 227, 19, 183, 155, 117, 96, 93, 137, 81,
+			86, 194, 114, 13, 147, 97, 7, 105, 97, 30, 164, 20, 75, 248,
+			30, 250, 132, 112, 188, 197, 141, 161, 115, 232, 137, 203, 109, 83,
+			11, 17, 151, 0, 126, 110, 139, 161, 77, 237, 238, 172, 104, 230,
+			58, 216, 157, 216, 217, 200, 72, 99, 227, 64, 248, 139, 20, 195,
+			21, 142, 198, 191, 76, 68, 93, 236, 211, 192, 49, 36, 44, 87,
+			88, 45, 229, 42, 223, 251, 153, 202, 237, 215, 255, 81, 113, 246,
+			55, 239, 63, 41, 199, 15, 254, 149, 234, 112, 70, 143, 215, 21,
+			214, 178, 30, 66, 55, 124, 208, 234, 91, 233, 43, 82, 242, 86,
+			193, 70, 155, 124, 236, 163, 147, 47, 149, 103, 94, 59, 51, 243,
+			91, 31, 153, 89, 123, 249, 119, 206, 78, 159, 61, 115, 230, 83,
+			159, 180, 23, 159, 169, 199, 63, 70, 197, 206, 147, 75, 206, 238,
+			15, 215, 62, 82, 252, 200, 204, 105, 124, 123, 142, 190, 197, 49,
+			255, 201, 167, 227, 83, 234, 147, 79, 115, 151, 212, 16, 169, 70,
+			92, 168, 178, 49, 157, 156, 128, 10, 193, 255, 3, 251, 5, 116,
+			1, 14, 30, 210, 191, 205, 89, 232, 188, 191, 84, 142, 9, 110,
+			165, 84, 138, 150, 216, 171, 117, 83, 11, 95, 173, 110, 52, 40,
+			202, 38, 241, 35, 224, 5, 170, 182, 186, 76, 10, 100, 113, 136,
+			236, 64, 160, 48, 250, 75, 229, 88, 48, 11, 48, 239, 11, 72,
+			93, 31, 62, 42, 160, 11, 240, 248, 9, 202, 51, 226, 58, 142,
+			239, 253, 149, 114, 142, 4, 31, 50, 165, 228, 178, 78, 232, 27,
+			190, 202, 245, 120, 45, 53, 165, 153, 16, 175, 7, 239, 187, 29,
+			138, 147, 165, 246, 242, 2, 42, 128, 251, 6, 5, 164, 148, 3,
+			135, 135, 40, 82, 220, 243, 179, 255, 77, 245, 253, 190, 163, 130,
+			27, 226, 235, 193, 50, 52, 174, 142, 53, 27, 240, 187, 235, 190,
+			222, 245, 163, 61, 68, 24, 233, 60, 133, 132, 3, 185, 131, 250,
+			255, 64, 23, 138, 84, 124, 222, 47, 149, 83, 8, 254, 187, 147,
+			114, 147, 160, 139, 80, 21, 102, 225, 50, 144, 189, 123, 185, 201,
+			159, 36, 100, 81, 13, 142, 188, 33, 251, 65, 217, 243, 165, 107,
+			71, 210, 204, 205, 26, 172, 138, 177, 153, 145, 125, 40, 244, 238,
+			196, 34, 73, 19, 194, 29, 59, 91, 165, 3, 182, 163, 105, 235,
+			9, 192, 167, 9, 50, 131, 198, 99, 21, 75, 178, 196, 196, 177,
+			199, 166, 20, 105, 134, 222, 172, 9, 113, 98, 151, 199, 154, 104,
+			130, 236, 217, 144, 220, 67, 161, 68, 111, 53, 54, 108, 249, 203,
+			106, 212, 168, 241, 61, 70, 80, 130, 5, 95, 138, 234, 232, 110,
+			151, 118, 221, 115, 250, 60, 218, 1, 11, 102, 0, 230, 15, 9,
+			136, 0, 21, 229, 143, 11, 232, 2, 52, 143, 33, 232, 24, 187,
+			167, 40, 234, 255, 177, 224, 95, 35, 246, 132, 151, 62, 37, 55,
+			32, 58, 15, 33, 52, 132, 49, 229, 117, 68, 171, 167, 86, 48,
+			14, 223, 225, 93, 168, 183, 91, 59, 93, 183, 245, 116, 40, 19,
+			231, 109, 155, 36, 59, 67, 156, 75, 231, 114, 120, 191, 92, 173,
+			93, 41, 76, 65, 12, 44, 51, 207, 222, 226, 140, 202, 114, 255,
+			47, 208, 131, 75, 151, 161, 28, 184, 66, 183, 158, 59, 192, 165,
+			93, 138, 94, 187, 28, 160, 214, 255, 149, 44, 135, 202, 0, 180,
+			203, 161, 104, 194, 254, 49, 1, 93, 128, 39, 140, 126, 11, 46,
+			139, 30, 200, 245, 211, 142, 243, 88, 240, 251, 110, 98, 163, 218,
+			59, 46, 167, 209, 113, 55, 54, 38, 102, 36, 65, 70, 251, 164,
+			19, 23, 119, 237, 52, 99, 98, 242, 58, 33, 99, 105, 241, 26,
+			221, 195, 183, 66, 200, 110, 69, 155, 212, 61, 185, 126, 139, 239,
+			90, 71, 42, 80, 241, 177, 140, 254, 31, 66, 113, 132, 231, 126,
+			218, 177, 123, 234, 100, 0, 218, 61, 133, 117, 230, 211, 142, 221,
+			83, 199, 5, 120, 194, 232, 255, 159, 50, 104, 102, 63, 235, 32,
+			250, 32, 248, 132, 177, 17, 16, 216, 155, 40, 188, 95, 157, 41,
+			183, 214, 171, 237, 22, 204, 106, 168, 38, 90, 154, 187, 181, 7,
+			214, 172, 187, 207, 100, 8, 191, 229, 118, 187, 76, 238, 247, 201,
+			37, 154, 217, 101, 70, 249, 222, 103, 157, 220, 33, 253, 34, 167,
+			230, 244, 94, 119, 156, 169, 224, 217, 84, 252, 111, 101, 187, 101,
+			85, 55, 145, 185, 95, 221, 98, 169, 33, 38, 62, 8, 154, 181,
+			29, 248, 168, 212, 140, 220, 191, 57, 72, 131, 189, 203, 177, 70,
+			25, 98, 3, 175, 203, 26, 101, 232, 244, 124, 93, 214, 40, 67,
+			108, 224, 117, 7, 229, 220, 226, 151, 93, 223, 123, 221, 153, 152,
+			180, 33, 87, 223, 254, 164, 254, 240, 175, 47, 228, 170, 89, 227,
+			52, 94, 28, 119, 117, 48, 229, 29, 130, 150, 130, 223, 72, 120,
+			87, 225, 13, 165, 15, 177, 239, 232, 173, 106, 253, 149, 213, 42,
+			156, 173, 168, 218, 21, 217, 62, 118, 214, 184, 24, 222, 0, 85,
+			202, 194, 147, 133, 10, 2, 190, 24, 88, 219, 110, 213, 184, 52,
+			158, 124, 113, 183, 85, 243, 31, 211, 251, 172, 227, 62, 170, 197,
+			185, 84, 45, 46, 111, 159, 93, 221, 65, 236, 81, 155, 58, 163,
+			74, 60, 251, 150, 25, 42, 52, 181, 159, 26, 15, 135, 60, 167,
+			222, 86, 233, 183, 49, 18, 94, 186, 244, 72, 248, 17, 143, 132,
+			162, 17, 186, 70, 98, 159, 93, 221, 41, 188, 163, 244, 225, 142,
+			46, 185, 176, 201, 156, 206, 66, 157, 182, 45, 101, 201, 222, 191,
+			43, 76, 169, 199, 87, 84, 111, 111, 59, 90, 230, 79, 11, 207,
+			234, 108, 252, 196, 207, 235, 254, 149, 187, 115, 115, 243, 43, 43,
+			131, 125, 168, 218, 181, 186, 92, 90, 92, 89, 186, 179, 188, 186,
+			54, 191, 188, 124, 103, 121, 80, 249, 7, 180, 190, 90, 186, 182,
+			182, 186, 48, 119, 115, 126, 117, 208, 1, 92, 186, 187, 122, 131,
+			127, 119, 11, 95, 115, 254, 254, 212, 35, 77, 21, 15, 109, 71,
+			84, 140, 208, 125, 116, 109, 209, 254, 238, 218, 162, 39, 116, 94,
+			178, 86, 162, 124, 95, 142, 62, 215, 242, 104, 53, 234, 120, 97,
+			61, 46, 59, 58, 144, 188, 112, 117, 39, 85, 15, 85, 167, 235,
+			161, 22, 254, 179, 210, 7, 177, 70, 11, 75, 47, 220, 171, 182,
+			67, 136, 43, 143, 90, 169, 104, 123, 189, 30, 182, 237, 74, 49,
+			216, 61, 119, 247, 189, 230, 238, 189, 251, 220, 51, 239, 49, 247,
+			236, 123, 205, 189, 191, 123, 238, 133, 239, 162, 150, 91, 231, 28,
+			75, 86, 211, 251, 174, 245, 79, 65, 13, 205, 181, 135, 242, 21,
+			211, 75, 190, 218, 76, 22, 43, 85, 197, 207, 237, 168, 226, 247,
+			171, 77, 187, 240, 182, 167, 179, 216, 152, 107, 87, 253, 39, 244,
+			193, 6, 232, 103, 45, 206, 32, 155, 176, 152, 253, 244, 120, 142,
+			158, 46, 84, 252, 162, 62, 220, 241, 94, 20, 194, 194, 194, 131,
+			62, 148, 122, 119, 133, 126, 240, 159, 210, 71, 233, 225, 26, 124,
+			205, 177, 83, 229, 90, 210, 133, 96, 254, 40, 189, 82, 178, 111,
+			72, 111, 145, 63, 107, 171, 165, 129, 24, 80, 25, 182, 155, 222,
+			119, 149, 87, 246, 231, 245, 254, 244, 130, 98, 59, 241, 169, 233,
+			249, 105, 106, 191, 150, 247, 165, 214, 60, 242, 43, 122, 52, 221,
+			204, 90, 162, 184, 71, 77, 95, 180, 120, 234, 189, 90, 76, 48,
+			96, 121, 184, 218, 236, 241, 56, 242, 39, 245, 32, 165, 240, 160,
+			248, 197, 176, 69, 28, 51, 71, 28, 243, 0, 61, 7, 59, 11,
+			91, 224, 154, 19, 250, 96, 20, 110, 108, 183, 170, 237, 29, 142,
+			109, 35, 18, 220, 183, 124, 64, 30, 199, 151, 117, 255, 130, 13,
+			168, 141, 75, 112, 30, 223, 53, 76, 62, 109, 72, 144, 136, 36,
+			162, 246, 89, 47, 151, 25, 204, 62, 235, 229, 244, 96, 190, 208,
+			212, 7, 176, 176, 215, 174, 34, 65, 85, 196, 4, 246, 110, 199,
+			207, 113, 157, 71, 211, 107, 149, 245, 181, 86, 248, 128, 240, 193,
+			93, 30, 192, 163, 107, 235, 203, 225, 131, 110, 10, 115, 187, 41,
+			172, 240, 121, 165, 247, 197, 37, 223, 25, 35, 141, 222, 39, 45,
+			174, 215, 26, 235, 212, 229, 190, 101, 141, 103, 215, 214, 175, 214,
+			26, 235, 254, 81, 61, 128, 245, 13, 91, 107, 92, 18, 115, 96,
+			57, 23, 63, 88, 168, 248, 143, 235, 3, 248, 191, 90, 223, 90,
+			123, 37, 164, 49, 187, 52, 230, 125, 252, 244, 102, 136, 97, 31,
+			139, 155, 128, 159, 110, 200, 135, 94, 242, 160, 240, 156, 246, 231,
+			40, 105, 203, 98, 35, 85, 37, 246, 131, 136, 22, 142, 87, 133,
+			163, 133, 119, 71, 217, 118, 46, 222, 178, 253, 160, 240, 207, 149,
+			30, 94, 78, 4, 139, 165, 237, 72, 82, 136, 252, 74, 237, 250,
+			103, 116, 63, 175, 150, 45, 9, 248, 136, 111, 179, 120, 239, 218,
+			186, 127, 74, 31, 194, 127, 107, 8, 59, 181, 113, 226, 241, 26,
+			29, 196, 15, 115, 141, 74, 200, 113, 166, 133, 255, 233, 234, 145,
+			93, 163, 230, 35, 249, 153, 174, 35, 249, 244, 174, 65, 63, 226,
+			203, 174, 99, 217, 127, 86, 15, 114, 236, 207, 154, 93, 7, 103,
+			111, 235, 112, 144, 63, 148, 7, 254, 115, 90, 83, 234, 105, 154,
+			29, 133, 52, 31, 152, 157, 221, 243, 192, 230, 241, 41, 230, 191,
+			60, 16, 202, 191, 189, 215, 203, 235, 189, 94, 55, 210, 18, 70,
+			105, 105, 233, 150, 45, 94, 187, 114, 115, 97, 105, 105, 254, 218,
+			160, 178, 226, 198, 194, 252, 162, 136, 27, 142, 127, 80, 231, 175,
+			151, 86, 75, 183, 172, 124, 241, 59, 122, 192, 142, 198, 63, 164,
+			247, 211, 243, 181, 187, 139, 55, 23, 239, 188, 176, 56, 216, 135,
+			71, 139, 119, 86, 215, 74, 107, 203, 243, 75, 183, 22, 230, 74,
+			131, 202, 223, 175, 7, 174, 223, 89, 190, 186, 112, 237, 218, 252,
+			226, 160, 131, 66, 186, 183, 23, 86, 86, 22, 22, 159, 89, 91,
+			89, 120, 102, 177, 180, 122, 119, 121, 126, 208, 197, 135, 16, 108,
+			146, 71, 30, 58, 199, 163, 229, 249, 231, 238, 206, 175, 172, 14,
+			102, 126, 197, 152, 233, 63, 10, 17, 51, 157, 235, 251, 145, 163,
+			244, 95, 57, 20, 51, 157, 219, 29, 51, 125, 246, 220, 223, 231,
+			152, 233, 111, 169, 36, 102, 26, 106, 228, 165, 152, 5, 154, 203,
+			51, 87, 12, 99, 79, 172, 183, 165, 68, 79, 22, 155, 140, 44,
+			66, 60, 106, 190, 128, 173, 55, 218, 247, 108, 11, 100, 183, 143,
+			93, 70, 210, 37, 236, 166, 200, 8, 33, 77, 199, 47, 193, 175,
+			133, 127, 230, 145, 147, 1, 12, 159, 37, 254, 234, 83, 54, 84,
+			88, 247, 141, 34, 64, 216, 237, 243, 221, 125, 125, 31, 229, 168,
+			225, 3, 73, 212, 48, 254, 125, 179, 63, 142, 26, 126, 188, 239,
+			172, 10, 174, 115, 52, 4, 135, 111, 243, 8, 167, 83, 89, 172,
+			101, 60, 20, 73, 29, 75, 233, 166, 86, 173, 83, 228, 50, 223,
+			139, 139, 122, 246, 95, 102, 79, 255, 90, 255, 80, 145, 207, 246,
+			65, 203, 57, 109, 253, 138, 172, 207, 144, 108, 3, 242, 225, 39,
+			203, 11, 165, 192, 29, 182, 226, 200, 199, 102, 179, 214, 120, 120,
+			73, 155, 179, 69, 187, 9, 114, 249, 129, 142, 163, 2, 61, 123,
+			149, 34, 139, 35, 179, 235, 250, 37, 119, 121, 51, 73, 41, 240,
+			140, 49, 187, 43, 15, 62, 56, 43, 212, 16, 157, 190, 92, 110,
+			54, 77, 181, 114, 229, 52, 47, 19, 14, 118, 184, 125, 192, 90,
+			105, 102, 139, 61, 58, 168, 70, 61, 22, 188, 28, 153, 103, 230,
+			87, 19, 31, 85, 232, 30, 168, 99, 106, 23, 186, 106, 196, 36,
+			145, 233, 155, 189, 90, 201, 56, 77, 247, 113, 100, 31, 47, 205,
+			179, 97, 164, 211, 186, 7, 50, 146, 46, 160, 139, 71, 44, 78,
+			81, 155, 39, 139, 246, 105, 20, 214, 43, 29, 203, 192, 167, 20,
+			172, 47, 178, 122, 15, 170, 54, 39, 17, 207, 155, 232, 130, 255,
+			167, 193, 221, 93, 190, 117, 29, 17, 253, 230, 198, 234, 234, 146,
+			89, 186, 179, 178, 138, 6, 122, 46, 157, 40, 225, 105, 102, 107,
+			76, 76, 69, 109, 206, 37, 219, 37, 113, 117, 98, 202, 165, 87,
+			166, 57, 114, 29, 97, 218, 140, 4, 29, 195, 102, 158, 174, 205,
+			249, 100, 118, 157, 13, 177, 20, 3, 156, 89, 232, 176, 3, 69,
+			240, 181, 58, 149, 172, 73, 181, 18, 94, 74, 69, 136, 208, 52,
+			78, 111, 87, 79, 111, 87, 139, 205, 29, 122, 85, 70, 10, 194,
+			189, 36, 68, 27, 117, 206, 119, 179, 213, 160, 192, 137, 211, 113,
+			0, 118, 43, 194, 199, 54, 240, 248, 241, 220, 152, 254, 138, 173,
+			202, 52, 225, 140, 6, 111, 42, 236, 163, 212, 106, 196, 97, 197,
+			137, 58, 165, 51, 98, 9, 246, 30, 31, 155, 94, 226, 155, 121,
+			50, 227, 135, 85, 168, 195, 41, 126, 173, 247, 166, 166, 13, 54,
+			176, 207, 194, 184, 109, 117, 94, 156, 102, 62, 110, 83, 115, 148,
+			33, 124, 244, 39, 216, 71, 63, 142, 197, 157, 24, 72, 199, 226,
+			78, 12, 143, 232, 47, 43, 137, 197, 61, 229, 140, 5, 111, 40,
+			115, 119, 249, 150, 117, 215, 129, 203, 135, 164, 73, 43, 203, 46,
+			200, 24, 184, 62, 148, 20, 141, 184, 92, 44, 22, 175, 20, 203,
+			205, 102, 212, 108, 180, 113, 144, 199, 156, 21, 145, 173, 52, 88,
+			78, 71, 100, 200, 23, 255, 1, 22, 139, 171, 90, 0, 57, 16,
+			199, 213, 168, 207, 84, 226, 4, 131, 18, 89, 108, 39, 2, 231,
+			255, 83, 118, 34, 136, 90, 56, 53, 32, 177, 159, 202, 245, 221,
+			83, 35, 163, 250, 41, 9, 83, 157, 118, 130, 224, 140, 89, 224,
+			219, 91, 60, 116, 168, 129, 223, 109, 23, 164, 35, 84, 120, 153,
+			182, 29, 161, 158, 207, 244, 128, 68, 199, 34, 146, 116, 122, 116,
+			76, 255, 92, 73, 92, 233, 25, 231, 112, 240, 23, 202, 220, 137,
+			51, 13, 66, 6, 22, 6, 65, 158, 48, 41, 66, 172, 214, 123,
+			108, 42, 140, 129, 81, 236, 204, 134, 180, 88, 48, 28, 111, 35,
+			5, 28, 120, 93, 183, 189, 51, 214, 173, 166, 112, 10, 31, 139,
+			70, 147, 210, 71, 175, 33, 179, 33, 220, 16, 132, 236, 210, 19,
+			68, 163, 233, 96, 160, 233, 164, 106, 20, 17, 231, 141, 219, 165,
+			57, 211, 46, 111, 217, 133, 112, 169, 210, 162, 132, 113, 187, 202,
+			119, 207, 228, 14, 112, 24, 183, 139, 169, 31, 242, 245, 87, 145,
+			139, 78, 249, 222, 133, 190, 167, 84, 240, 5, 101, 86, 96, 157,
+			160, 115, 84, 112, 122, 207, 172, 40, 230, 62, 224, 190, 211, 90,
+			198, 111, 103, 149, 228, 235, 180, 174, 251, 96, 108, 47, 206, 148,
+			154, 205, 16, 129, 146, 225, 204, 66, 157, 28, 55, 241, 164, 90,
+			49, 247, 194, 114, 28, 188, 43, 49, 46, 23, 114, 129, 190, 37,
+			49, 46, 23, 157, 195, 193, 135, 204, 74, 249, 190, 208, 11, 103,
+			5, 45, 247, 98, 239, 232, 40, 181, 115, 171, 105, 116, 137, 131,
+			96, 46, 114, 176, 187, 34, 2, 187, 200, 171, 20, 7, 193, 92,
+			60, 228, 235, 103, 36, 8, 230, 146, 51, 22, 92, 18, 242, 178,
+			134, 98, 206, 46, 194, 65, 12, 81, 186, 111, 90, 74, 217, 238,
+			116, 28, 204, 37, 198, 80, 5, 155, 160, 123, 137, 73, 33, 142,
+			131, 185, 52, 50, 138, 202, 160, 158, 2, 41, 92, 118, 130, 96,
+			225, 145, 164, 128, 227, 168, 217, 150, 52, 149, 52, 51, 174, 198,
+			200, 106, 62, 49, 92, 211, 208, 236, 8, 64, 35, 151, 237, 8,
+			48, 179, 203, 76, 35, 10, 134, 65, 247, 242, 232, 152, 126, 137,
+			2, 113, 188, 82, 223, 162, 10, 238, 8, 14, 76, 68, 54, 253,
+			45, 166, 217, 139, 32, 74, 113, 245, 13, 155, 64, 147, 14, 139,
+			24, 55, 26, 149, 208, 204, 158, 57, 195, 187, 138, 110, 75, 185,
+			163, 122, 92, 123, 30, 201, 73, 115, 206, 45, 55, 56, 72, 73,
+			222, 41, 181, 71, 133, 44, 37, 88, 51, 135, 184, 246, 92, 255,
+			126, 125, 87, 103, 241, 50, 144, 96, 222, 27, 12, 174, 119, 184,
+			61, 193, 235, 21, 89, 246, 171, 117, 137, 229, 16, 126, 39, 101,
+			124, 154, 219, 209, 61, 112, 48, 41, 106, 10, 89, 11, 172, 227,
+			0, 156, 247, 57, 217, 193, 188, 151, 79, 96, 199, 119, 231, 15,
+			28, 212, 147, 220, 173, 242, 221, 103, 188, 209, 96, 204, 210, 68,
+			165, 193, 137, 118, 233, 100, 76, 181, 132, 109, 125, 198, 59, 156,
+			192, 142, 239, 62, 51, 60, 162, 47, 112, 75, 142, 239, 46, 120,
+			135, 131, 9, 43, 126, 165, 169, 156, 147, 156, 182, 56, 237, 119,
+			37, 213, 46, 86, 109, 193, 59, 144, 192, 104, 232, 144, 175, 87,
+			184, 93, 215, 119, 111, 122, 135, 131, 107, 221, 237, 194, 25, 45,
+			97, 156, 16, 179, 37, 117, 97, 185, 217, 68, 228, 67, 219, 214,
+			157, 194, 2, 197, 57, 19, 165, 19, 48, 143, 155, 169, 78, 93,
+			199, 119, 111, 30, 242, 117, 158, 99, 24, 220, 219, 78, 140, 192,
+			20, 194, 224, 222, 102, 212, 114, 104, 61, 111, 15, 28, 20, 200,
+			245, 221, 219, 254, 97, 253, 95, 193, 117, 92, 223, 123, 190, 175,
+			169, 64, 199, 240, 155, 33, 246, 206, 21, 26, 231, 172, 155, 106,
+			36, 201, 0, 73, 251, 36, 213, 70, 170, 84, 20, 43, 172, 21,
+			155, 59, 69, 61, 251, 37, 37, 178, 236, 175, 231, 79, 155, 219,
+			136, 117, 234, 121, 211, 192, 113, 72, 204, 5, 136, 196, 249, 117,
+			58, 136, 60, 69, 252, 18, 161, 135, 197, 123, 62, 119, 136, 178,
+			244, 195, 239, 194, 125, 209, 241, 31, 145, 185, 112, 159, 56, 51,
+			184, 47, 242, 18, 186, 180, 132, 47, 114, 92, 158, 75, 44, 233,
+			197, 193, 67, 186, 36, 245, 244, 94, 114, 142, 7, 231, 108, 98,
+			4, 214, 122, 51, 115, 104, 133, 233, 42, 90, 226, 48, 210, 217,
+			153, 242, 208, 134, 133, 50, 190, 251, 82, 254, 144, 64, 202, 119,
+			95, 242, 199, 4, 114, 125, 247, 165, 99, 227, 214, 89, 193, 125,
+			217, 57, 22, 60, 109, 187, 22, 93, 235, 12, 52, 236, 82, 74,
+			26, 242, 29, 219, 113, 39, 118, 151, 128, 155, 152, 178, 195, 112,
+			60, 223, 125, 217, 14, 3, 252, 233, 101, 59, 12, 76, 243, 101,
+			127, 68, 32, 215, 119, 95, 14, 142, 82, 29, 23, 23, 204, 106,
+			205, 25, 15, 198, 237, 48, 210, 121, 45, 201, 75, 92, 120, 136,
+			75, 185, 29, 214, 108, 31, 56, 30, 215, 108, 31, 216, 164, 53,
+			127, 84, 32, 52, 123, 244, 24, 247, 225, 249, 238, 186, 51, 22,
+			140, 155, 27, 219, 247, 201, 209, 175, 92, 161, 19, 44, 165, 26,
+			183, 125, 160, 12, 223, 186, 221, 59, 79, 249, 238, 58, 243, 118,
+			23, 40, 239, 174, 143, 140, 82, 76, 165, 139, 41, 134, 206, 72,
+			240, 129, 116, 218, 127, 224, 56, 8, 149, 181, 199, 69, 115, 187,
+			10, 215, 129, 16, 214, 220, 8, 174, 64, 27, 161, 9, 155, 141,
+			141, 123, 182, 191, 12, 53, 211, 47, 144, 242, 221, 48, 231, 11,
+			228, 250, 110, 120, 100, 88, 159, 164, 254, 178, 190, 187, 229, 140,
+			6, 163, 230, 133, 123, 13, 235, 191, 186, 27, 249, 178, 25, 188,
+			39, 19, 200, 42, 223, 221, 98, 129, 211, 117, 178, 174, 239, 110,
+			13, 143, 232, 59, 212, 96, 191, 239, 126, 220, 25, 13, 174, 246,
+			154, 128, 232, 28, 169, 80, 23, 9, 42, 123, 152, 75, 127, 6,
+			45, 202, 92, 250, 149, 239, 126, 156, 243, 206, 184, 78, 191, 235,
+			187, 31, 31, 30, 225, 58, 146, 57, 223, 173, 57, 99, 193, 251,
+			104, 46, 182, 175, 100, 8, 73, 175, 210, 118, 46, 131, 79, 100,
+			90, 57, 229, 187, 53, 187, 47, 57, 215, 119, 107, 35, 163, 250,
+			6, 181, 61, 128, 114, 145, 71, 130, 15, 154, 146, 169, 247, 200,
+			240, 95, 110, 167, 139, 242, 217, 196, 225, 189, 72, 107, 32, 131,
+			166, 164, 207, 1, 229, 187, 13, 102, 133, 174, 51, 224, 250, 110,
+			195, 31, 162, 172, 180, 158, 159, 217, 238, 251, 140, 82, 193, 71,
+			185, 207, 138, 120, 87, 88, 197, 120, 88, 49, 11, 75, 72, 37,
+			3, 162, 10, 163, 158, 44, 50, 165, 19, 215, 61, 57, 37, 157,
+			186, 64, 201, 237, 220, 136, 158, 100, 135, 28, 247, 161, 227, 7,
+			71, 59, 184, 209, 194, 82, 210, 49, 79, 134, 92, 69, 220, 135,
+			60, 25, 143, 152, 210, 67, 102, 74, 30, 49, 165, 135, 131, 135,
+			244, 19, 82, 65, 243, 53, 231, 56, 151, 50, 17, 255, 170, 133,
+			37, 195, 118, 38, 219, 34, 56, 207, 107, 76, 142, 228, 123, 225,
+			190, 198, 228, 232, 145, 80, 244, 26, 115, 30, 143, 56, 207, 107,
+			199, 198, 137, 28, 225, 119, 225, 126, 106, 111, 228, 232, 57, 160,
+			178, 79, 217, 81, 99, 104, 159, 226, 109, 247, 72, 208, 249, 20,
+			147, 163, 231, 184, 84, 193, 113, 36, 184, 144, 160, 51, 141, 125,
+			175, 228, 184, 159, 218, 116, 51, 212, 76, 191, 128, 10, 96, 206,
+			23, 144, 58, 97, 138, 244, 224, 168, 240, 187, 202, 25, 13, 70,
+			118, 145, 36, 175, 124, 252, 149, 151, 161, 247, 114, 220, 8, 124,
+			170, 126, 87, 13, 28, 22, 208, 5, 56, 60, 162, 231, 169, 77,
+			120, 59, 160, 205, 15, 244, 152, 134, 16, 202, 30, 230, 145, 137,
+			219, 145, 121, 192, 49, 225, 211, 42, 39, 125, 102, 224, 53, 161,
+			134, 71, 244, 57, 234, 51, 235, 123, 255, 80, 57, 99, 193, 19,
+			187, 201, 17, 19, 177, 5, 251, 132, 34, 227, 70, 178, 25, 250,
+			76, 166, 149, 85, 0, 7, 134, 4, 116, 1, 142, 140, 234, 47,
+			168, 216, 51, 227, 117, 213, 247, 135, 74, 5, 191, 7, 223, 35,
+			248, 64, 155, 73, 57, 112, 166, 59, 16, 150, 8, 104, 202, 52,
+			107, 219, 236, 134, 44, 181, 144, 187, 105, 70, 155, 71, 26, 146,
+			162, 98, 242, 63, 230, 19, 214, 186, 8, 73, 156, 53, 94, 87,
+			185, 199, 168, 72, 22, 57, 107, 188, 169, 156, 225, 96, 42, 145,
+			213, 49, 18, 200, 3, 84, 212, 8, 225, 124, 93, 132, 197, 238,
+			21, 25, 223, 123, 83, 214, 33, 118, 190, 120, 83, 13, 88, 95,
+			12, 148, 242, 80, 67, 71, 244, 85, 46, 193, 234, 125, 30, 37,
+			165, 206, 89, 130, 237, 152, 59, 223, 74, 40, 221, 78, 215, 236,
+			166, 108, 135, 240, 114, 250, 124, 210, 33, 188, 156, 62, 175, 6,
+			142, 8, 232, 2, 28, 29, 163, 162, 43, 168, 227, 234, 125, 17,
+			62, 137, 143, 37, 5, 165, 146, 165, 73, 211, 134, 180, 14, 127,
+			155, 47, 38, 173, 99, 196, 95, 84, 3, 131, 2, 186, 0, 15,
+			15, 233, 103, 169, 117, 215, 247, 222, 2, 209, 93, 78, 176, 245,
+			17, 205, 191, 23, 202, 102, 136, 244, 222, 18, 148, 205, 16, 233,
+			189, 37, 164, 151, 193, 137, 238, 189, 165, 142, 12, 83, 158, 4,
+			212, 141, 245, 222, 6, 153, 140, 239, 34, 189, 164, 127, 219, 50,
+			8, 240, 237, 100, 74, 32, 192, 183, 133, 0, 51, 68, 128, 111,
+			131, 24, 150, 169, 120, 100, 246, 203, 170, 239, 223, 42, 21, 92,
+			67, 233, 21, 32, 40, 34, 67, 203, 237, 50, 194, 55, 192, 8,
+			89, 161, 156, 246, 248, 149, 68, 19, 113, 40, 186, 200, 157, 161,
+			120, 238, 130, 50, 190, 172, 114, 7, 244, 28, 128, 1, 223, 251,
+			99, 229, 236, 15, 14, 196, 41, 245, 182, 163, 16, 85, 160, 116,
+			225, 9, 171, 181, 93, 15, 205, 68, 108, 49, 142, 38, 166, 83,
+			217, 43, 240, 59, 248, 162, 231, 101, 7, 250, 208, 74, 126, 31,
+			77, 48, 59, 208, 167, 58, 65, 135, 193, 39, 185, 195, 175, 42,
+			231, 64, 87, 7, 113, 114, 34, 116, 0, 36, 168, 135, 15, 186,
+			58, 80, 168, 74, 153, 223, 207, 45, 170, 46, 208, 97, 240, 170,
+			20, 199, 252, 186, 114, 198, 131, 115, 230, 14, 144, 118, 150, 11,
+			172, 66, 27, 194, 8, 221, 110, 152, 251, 208, 190, 162, 234, 55,
+			191, 67, 134, 91, 241, 187, 205, 226, 120, 242, 190, 46, 123, 148,
+			37, 42, 250, 186, 26, 24, 21, 208, 245, 189, 175, 171, 163, 199,
+			244, 135, 165, 196, 230, 55, 148, 243, 88, 112, 179, 179, 67, 19,
+			175, 90, 92, 31, 28, 209, 118, 4, 178, 3, 126, 18, 30, 57,
+			145, 86, 50, 196, 95, 38, 227, 0, 113, 125, 35, 25, 7, 38,
+			254, 13, 53, 112, 76, 64, 23, 224, 9, 67, 154, 140, 44, 136,
+			235, 29, 229, 204, 6, 87, 76, 98, 150, 239, 28, 210, 90, 181,
+			194, 46, 249, 172, 145, 39, 245, 115, 58, 132, 40, 233, 26, 72,
+			253, 142, 56, 51, 102, 113, 244, 121, 239, 136, 51, 99, 150, 220,
+			127, 223, 81, 254, 140, 128, 46, 94, 62, 115, 86, 31, 165, 145,
+			184, 190, 247, 77, 229, 152, 96, 63, 89, 133, 108, 138, 254, 248,
+			93, 215, 163, 95, 45, 152, 5, 152, 31, 18, 80, 1, 60, 114,
+			84, 64, 106, 234, 248, 9, 202, 212, 158, 197, 152, 190, 165, 156,
+			153, 96, 136, 26, 78, 51, 171, 164, 125, 47, 126, 201, 130, 89,
+			128, 249, 64, 64, 148, 85, 81, 71, 39, 5, 116, 1, 190, 127,
+			90, 47, 80, 251, 25, 42, 179, 121, 53, 248, 160, 185, 93, 110,
+			54, 171, 245, 45, 51, 193, 137, 194, 204, 204, 21, 97, 184, 105,
+			222, 184, 217, 72, 84, 39, 141, 237, 122, 123, 194, 14, 35, 227,
+			81, 91, 22, 204, 2, 204, 63, 46, 160, 2, 120, 242, 41, 1,
+			93, 128, 79, 151, 40, 95, 127, 22, 174, 214, 223, 81, 206, 241,
+			224, 41, 82, 14, 129, 204, 99, 4, 53, 172, 32, 77, 240, 88,
+			238, 224, 36, 55, 110, 137, 243, 94, 7, 46, 227, 100, 252, 78,
+			130, 67, 160, 255, 239, 168, 129, 49, 1, 93, 128, 199, 198, 245,
+			119, 81, 249, 56, 139, 210, 211, 63, 82, 206, 177, 224, 159, 120,
+			208, 79, 86, 57, 107, 107, 151, 23, 67, 113, 165, 195, 125, 33,
+			190, 208, 198, 122, 124, 121, 115, 134, 163, 34, 116, 55, 119, 106,
+			164, 178, 207, 138, 159, 39, 92, 124, 83, 8, 200, 185, 41, 211,
+			89, 106, 171, 156, 229, 68, 6, 132, 112, 20, 204, 255, 126, 249,
+			149, 208, 68, 80, 146, 54, 106, 21, 219, 134, 169, 162, 106, 11,
+			242, 107, 104, 10, 215, 222, 153, 54, 33, 114, 82, 84, 55, 211,
+			201, 48, 81, 160, 177, 5, 67, 74, 37, 62, 218, 123, 78, 42,
+			118, 200, 215, 102, 114, 39, 108, 79, 21, 77, 9, 98, 0, 74,
+			216, 70, 236, 131, 43, 214, 76, 46, 59, 77, 145, 103, 59, 102,
+			229, 198, 157, 187, 183, 174, 193, 155, 181, 213, 150, 100, 101, 181,
+			154, 238, 238, 34, 110, 124, 154, 86, 14, 14, 150, 40, 31, 136,
+			119, 211, 250, 20, 82, 13, 144, 78, 32, 93, 197, 128, 243, 14,
+			61, 36, 77, 71, 119, 179, 146, 38, 223, 32, 100, 107, 186, 115,
+			101, 98, 213, 41, 133, 5, 87, 90, 141, 38, 87, 179, 209, 143,
+			88, 23, 82, 72, 166, 34, 86, 235, 162, 164, 192, 168, 44, 134,
+			245, 103, 8, 105, 250, 5, 84, 0, 115, 35, 2, 186, 0, 131,
+			163, 250, 207, 161, 38, 207, 58, 57, 223, 251, 55, 192, 176, 127,
+			161, 204, 181, 142, 92, 119, 123, 207, 113, 220, 51, 197, 49, 18,
+			253, 222, 47, 183, 17, 245, 185, 205, 41, 100, 59, 163, 99, 104,
+			85, 57, 235, 77, 170, 50, 92, 103, 198, 170, 84, 58, 234, 34,
+			99, 128, 228, 4, 100, 111, 102, 59, 237, 92, 150, 102, 114, 80,
+			64, 20, 167, 81, 131, 195, 2, 186, 0, 199, 142, 234, 235, 84,
+			85, 57, 251, 231, 170, 239, 47, 148, 10, 46, 154, 5, 120, 208,
+			222, 143, 169, 129, 162, 180, 98, 236, 75, 101, 65, 16, 87, 10,
+			123, 192, 95, 187, 202, 135, 119, 63, 213, 64, 205, 13, 211, 229,
+			146, 42, 35, 255, 24, 114, 199, 37, 178, 54, 149, 154, 77, 182,
+			50, 37, 9, 129, 238, 53, 106, 20, 126, 33, 154, 203, 141, 70,
+			147, 180, 190, 37, 219, 44, 70, 219, 79, 7, 222, 143, 133, 73,
+			244, 211, 129, 247, 99, 17, 74, 250, 233, 192, 251, 49, 132, 146,
+			179, 212, 173, 66, 61, 84, 103, 52, 120, 159, 17, 231, 141, 84,
+			147, 44, 119, 0, 65, 172, 28, 215, 143, 251, 24, 190, 233, 23,
+			144, 154, 224, 27, 64, 63, 73, 137, 255, 1, 237, 191, 72, 237,
+			59, 190, 247, 19, 76, 235, 89, 179, 106, 109, 18, 116, 153, 44,
+			183, 147, 210, 76, 137, 213, 123, 34, 50, 27, 181, 198, 198, 43,
+			211, 230, 254, 187, 75, 117, 253, 116, 138, 253, 36, 25, 6, 78,
+			177, 159, 200, 69, 164, 159, 78, 177, 159, 96, 24, 63, 197, 45,
+			33, 231, 103, 127, 170, 250, 254, 135, 82, 193, 191, 87, 38, 237,
+			230, 148, 24, 89, 82, 12, 9, 104, 202, 241, 233, 66, 34, 192,
+			158, 20, 122, 242, 17, 79, 137, 0, 132, 140, 162, 122, 185, 25,
+			221, 107, 196, 249, 146, 159, 161, 34, 235, 102, 165, 221, 104, 193,
+			3, 157, 250, 228, 2, 62, 28, 14, 11, 91, 78, 37, 220, 164,
+			76, 10, 146, 171, 119, 187, 46, 153, 156, 160, 29, 111, 85, 89,
+			15, 77, 103, 122, 220, 146, 137, 165, 43, 70, 161, 156, 242, 189,
+			159, 170, 220, 144, 254, 255, 164, 110, 245, 207, 112, 219, 106, 166,
+			217, 61, 171, 28, 19, 39, 27, 210, 247, 91, 123, 58, 216, 149,
+			4, 153, 210, 93, 136, 242, 175, 243, 154, 216, 13, 170, 166, 241,
+			188, 110, 151, 133, 51, 255, 197, 59, 146, 35, 196, 251, 153, 236,
+			72, 142, 16, 239, 103, 24, 29, 131, 46, 134, 55, 50, 170, 151,
+			104, 176, 202, 247, 126, 14, 1, 255, 170, 176, 65, 57, 118, 233,
+			154, 134, 36, 3, 81, 104, 94, 9, 119, 72, 214, 23, 249, 18,
+			187, 146, 46, 238, 5, 171, 28, 35, 68, 142, 240, 242, 231, 130,
+			247, 57, 18, 176, 126, 174, 6, 124, 1, 93, 128, 71, 134, 73,
+			21, 145, 131, 128, 245, 11, 112, 174, 81, 38, 51, 52, 202, 174,
+			105, 232, 213, 54, 10, 44, 251, 69, 210, 40, 176, 236, 23, 106,
+			96, 68, 64, 23, 96, 112, 84, 127, 46, 75, 173, 82, 88, 140,
+			51, 28, 252, 131, 44, 169, 56, 172, 43, 155, 80, 191, 120, 211,
+			197, 172, 58, 65, 168, 229, 149, 217, 243, 23, 38, 87, 110, 148,
+			206, 159, 157, 157, 76, 191, 56, 53, 197, 153, 193, 108, 226, 17,
+			122, 53, 254, 166, 180, 178, 82, 154, 89, 186, 57, 183, 114, 118,
+			230, 193, 217, 181, 243, 124, 56, 173, 220, 40, 205, 204, 158, 191,
+			16, 103, 127, 140, 223, 46, 215, 182, 224, 57, 98, 150, 175, 207,
+			125, 224, 252, 217, 139, 20, 100, 119, 202, 196, 221, 161, 169, 178,
+			161, 10, 252, 56, 162, 17, 92, 115, 225, 28, 193, 209, 20, 155,
+			243, 111, 148, 102, 206, 159, 157, 53, 149, 42, 29, 196, 80, 232,
+			18, 146, 55, 183, 137, 99, 83, 166, 141, 102, 184, 177, 93, 171,
+			150, 91, 157, 150, 206, 202, 118, 114, 203, 229, 216, 236, 198, 38,
+			241, 55, 89, 234, 106, 125, 179, 85, 182, 223, 104, 51, 25, 15,
+			120, 115, 187, 206, 9, 176, 202, 114, 102, 217, 114, 116, 23, 111,
+			94, 197, 114, 86, 235, 205, 237, 54, 173, 206, 92, 163, 30, 109,
+			223, 231, 4, 98, 29, 196, 205, 106, 110, 202, 176, 134, 129, 32,
+			165, 43, 146, 125, 80, 214, 243, 106, 125, 139, 150, 244, 44, 103,
+			190, 50, 19, 214, 155, 113, 2, 131, 151, 112, 219, 29, 110, 130,
+			86, 109, 150, 244, 252, 214, 44, 254, 240, 225, 195, 226, 22, 145,
+			122, 185, 89, 141, 160, 184, 22, 23, 148, 53, 198, 230, 232, 244,
+			131, 179, 167, 69, 209, 112, 250, 213, 243, 103, 126, 235, 116, 177,
+			72, 77, 165, 125, 71, 16, 163, 65, 166, 202, 208, 196, 131, 72,
+			82, 81, 18, 9, 96, 27, 22, 174, 153, 137, 78, 151, 202, 9,
+			106, 231, 73, 168, 47, 56, 52, 217, 180, 27, 51, 235, 225, 12,
+			51, 47, 222, 207, 114, 100, 122, 97, 22, 125, 124, 174, 8, 199,
+			44, 232, 43, 39, 44, 178, 78, 216, 176, 203, 94, 173, 197, 72,
+			150, 138, 16, 122, 37, 220, 225, 233, 144, 100, 19, 181, 195, 166,
+			153, 181, 244, 131, 187, 247, 47, 19, 158, 128, 187, 247, 47, 145,
+			2, 141, 65, 34, 152, 161, 35, 250, 43, 14, 21, 147, 207, 254,
+			181, 234, 251, 223, 74, 5, 111, 56, 102, 9, 211, 143, 238, 117,
+			248, 68, 33, 216, 186, 97, 38, 48, 141, 153, 202, 250, 12, 178,
+			41, 108, 133, 149, 9, 188, 187, 178, 189, 110, 218, 141, 102, 117,
+			3, 193, 202, 161, 89, 111, 84, 118, 152, 172, 147, 180, 188, 213,
+			200, 224, 174, 125, 225, 156, 9, 235, 144, 245, 42, 105, 166, 191,
+			219, 211, 180, 152, 186, 123, 233, 116, 186, 188, 114, 76, 140, 47,
+			206, 196, 104, 54, 179, 82, 221, 186, 137, 228, 107, 103, 47, 153,
+			203, 100, 167, 55, 229, 212, 226, 92, 233, 122, 245, 249, 114, 45,
+			126, 181, 107, 44, 203, 43, 165, 153, 149, 27, 37, 48, 2, 218,
+			31, 99, 55, 228, 74, 204, 237, 7, 148, 239, 253, 181, 202, 5,
+			164, 168, 24, 0, 183, 255, 27, 92, 44, 198, 205, 98, 248, 48,
+			57, 75, 153, 151, 197, 67, 227, 109, 24, 128, 237, 205, 251, 27,
+			229, 12, 10, 168, 240, 241, 161, 49, 1, 93, 128, 199, 198, 245,
+			247, 60, 42, 67, 159, 253, 199, 78, 223, 23, 29, 21, 188, 227,
+			165, 204, 254, 187, 205, 88, 32, 169, 88, 86, 150, 39, 19, 17,
+			115, 102, 208, 165, 124, 128, 121, 68, 28, 112, 68, 74, 143, 212,
+			170, 119, 28, 67, 200, 235, 211, 108, 85, 31, 192, 173, 12, 104,
+			143, 115, 184, 12, 47, 128, 10, 242, 138, 60, 108, 176, 233, 159,
+			206, 68, 50, 27, 179, 241, 90, 116, 239, 173, 150, 20, 158, 197,
+			2, 254, 102, 183, 136, 41, 10, 29, 77, 117, 110, 21, 194, 100,
+			217, 145, 134, 206, 96, 27, 210, 254, 136, 147, 184, 26, 37, 57,
+			196, 144, 173, 191, 22, 150, 33, 230, 81, 190, 146, 56, 237, 88,
+			33, 117, 218, 33, 207, 2, 137, 200, 28, 25, 87, 173, 27, 196,
+			162, 17, 68, 249, 0, 171, 209, 20, 219, 249, 187, 132, 16, 77,
+			63, 167, 214, 62, 205, 42, 167, 224, 55, 98, 54, 44, 39, 229,
+			212, 45, 166, 222, 192, 157, 39, 180, 123, 153, 248, 93, 234, 196,
+			244, 25, 163, 167, 86, 40, 77, 156, 59, 78, 197, 174, 53, 208,
+			243, 13, 199, 57, 30, 152, 68, 176, 236, 212, 102, 117, 72, 149,
+			154, 48, 244, 13, 135, 49, 84, 147, 240, 240, 134, 195, 24, 170,
+			73, 106, 125, 195, 57, 54, 78, 6, 38, 141, 83, 248, 115, 142,
+			115, 36, 40, 237, 93, 149, 134, 249, 113, 60, 242, 134, 165, 22,
+			219, 187, 202, 82, 139, 57, 1, 169, 3, 214, 77, 106, 146, 105,
+			63, 231, 28, 30, 210, 47, 80, 239, 142, 239, 125, 193, 113, 78,
+			4, 11, 214, 169, 181, 177, 25, 115, 165, 137, 196, 61, 13, 174,
+			80, 188, 106, 54, 17, 115, 135, 227, 154, 184, 65, 53, 69, 216,
+			208, 36, 210, 126, 33, 25, 5, 166, 249, 5, 103, 32, 16, 208,
+			5, 56, 126, 92, 63, 165, 29, 47, 239, 103, 223, 118, 250, 126,
+			228, 168, 224, 116, 138, 248, 210, 30, 24, 189, 209, 141, 37, 199,
+			60, 52, 149, 78, 238, 4, 213, 209, 207, 123, 125, 126, 246, 75,
+			142, 243, 117, 199, 13, 10, 214, 111, 51, 246, 42, 23, 217, 8,
+			57, 176, 36, 151, 37, 134, 147, 135, 223, 133, 247, 37, 167, 127,
+			63, 213, 191, 207, 195, 35, 194, 247, 254, 8, 69, 122, 175, 73,
+			215, 124, 114, 179, 250, 20, 251, 109, 211, 121, 2, 67, 249, 154,
+			77, 210, 132, 220, 101, 176, 50, 166, 178, 94, 140, 243, 44, 82,
+			171, 138, 154, 205, 39, 15, 28, 60, 56, 112, 144, 28, 62, 240,
+			134, 242, 189, 175, 160, 223, 121, 219, 47, 210, 184, 81, 117, 142,
+			176, 101, 235, 88, 240, 173, 230, 218, 213, 233, 221, 163, 1, 57,
+			189, 82, 69, 69, 207, 84, 199, 144, 31, 191, 146, 238, 88, 57,
+			120, 112, 224, 160, 190, 197, 29, 67, 87, 233, 120, 163, 193, 101,
+			179, 136, 164, 81, 229, 118, 185, 102, 200, 97, 221, 220, 35, 182,
+			21, 86, 122, 244, 197, 249, 138, 90, 97, 187, 85, 237, 232, 15,
+			187, 253, 85, 199, 59, 156, 60, 160, 246, 135, 71, 244, 109, 238,
+			207, 245, 189, 175, 57, 222, 80, 240, 148, 185, 190, 199, 190, 82,
+			145, 180, 187, 59, 196, 89, 252, 53, 199, 59, 152, 60, 112, 240,
+			192, 63, 76, 58, 200, 188, 167, 252, 236, 159, 56, 206, 119, 29,
+			55, 184, 108, 200, 9, 62, 118, 188, 153, 38, 86, 209, 229, 59,
+			79, 44, 39, 229, 58, 15, 117, 73, 27, 110, 210, 22, 93, 176,
+			156, 127, 226, 244, 15, 234, 83, 52, 27, 36, 36, 246, 254, 169,
+			227, 13, 7, 1, 123, 121, 212, 109, 65, 147, 74, 60, 51, 59,
+			84, 114, 190, 194, 203, 135, 146, 7, 14, 234, 46, 15, 29, 225,
+			189, 80, 88, 188, 111, 162, 181, 203, 102, 181, 181, 195, 121, 226,
+			104, 221, 203, 117, 57, 163, 218, 13, 123, 149, 22, 78, 132, 181,
+			177, 46, 117, 169, 254, 48, 216, 111, 166, 251, 195, 222, 127, 211,
+			25, 58, 162, 159, 227, 254, 28, 84, 116, 246, 252, 160, 100, 145,
+			78, 18, 93, 65, 237, 193, 202, 128, 148, 50, 54, 238, 82, 156,
+			140, 240, 67, 60, 170, 84, 167, 152, 195, 183, 28, 111, 127, 242,
+			128, 58, 25, 60, 68, 89, 74, 49, 10, 104, 22, 29, 47, 8,
+			140, 89, 145, 3, 199, 158, 137, 144, 117, 41, 39, 65, 125, 43,
+			213, 38, 246, 248, 79, 29, 239, 72, 242, 192, 193, 131, 209, 49,
+			61, 197, 109, 122, 190, 247, 109, 44, 220, 88, 170, 77, 94, 25,
+			242, 53, 74, 53, 6, 243, 198, 183, 211, 171, 2, 47, 176, 111,
+			99, 85, 206, 115, 99, 25, 223, 251, 51, 96, 232, 73, 115, 157,
+			238, 139, 194, 61, 4, 33, 31, 209, 48, 12, 102, 127, 150, 96,
+			162, 114, 50, 14, 30, 248, 135, 201, 168, 159, 7, 170, 124, 207,
+			113, 134, 246, 206, 156, 232, 52, 249, 158, 112, 210, 60, 225, 207,
+			247, 156, 129, 131, 2, 186, 104, 208, 63, 172, 159, 163, 230, 149,
+			239, 125, 223, 113, 158, 8, 230, 146, 163, 10, 155, 88, 135, 228,
+			41, 187, 59, 9, 187, 59, 16, 159, 195, 73, 8, 225, 57, 154,
+			196, 34, 187, 88, 210, 242, 116, 158, 124, 95, 78, 179, 60, 221,
+			69, 191, 239, 28, 122, 76, 64, 23, 191, 62, 126, 146, 44, 179,
+			121, 220, 69, 127, 224, 56, 71, 131, 15, 152, 37, 132, 91, 212,
+			219, 127, 39, 10, 115, 160, 106, 254, 129, 227, 228, 5, 84, 0,
+			247, 13, 11, 232, 2, 28, 11, 232, 12, 203, 59, 174, 239, 253,
+			112, 79, 103, 24, 47, 192, 30, 207, 176, 60, 25, 219, 126, 152,
+			172, 60, 16, 240, 135, 114, 134, 229, 201, 216, 246, 67, 103, 252,
+			184, 141, 24, 127, 231, 132, 238, 29, 228, 178, 203, 71, 189, 213,
+			220, 104, 174, 159, 102, 90, 149, 82, 156, 20, 163, 86, 228, 215,
+			130, 163, 241, 93, 44, 14, 134, 89, 223, 222, 60, 29, 167, 116,
+			34, 48, 56, 209, 253, 163, 117, 146, 229, 23, 126, 115, 145, 235,
+			133, 79, 59, 122, 8, 110, 72, 188, 152, 145, 184, 197, 35, 232,
+			138, 213, 121, 107, 127, 219, 224, 179, 131, 252, 161, 60, 232, 29,
+			33, 229, 244, 140, 144, 242, 47, 32, 216, 45, 30, 11, 215, 253,
+			12, 138, 233, 165, 148, 216, 44, 120, 92, 134, 203, 246, 93, 255,
+			41, 189, 79, 156, 210, 43, 107, 229, 54, 197, 236, 33, 44, 52,
+			94, 219, 162, 172, 109, 209, 42, 251, 150, 243, 246, 253, 82, 187,
+			240, 207, 92, 189, 47, 221, 178, 127, 68, 103, 99, 63, 96, 14,
+			105, 204, 148, 155, 205, 133, 138, 63, 166, 115, 16, 189, 83, 1,
+			236, 253, 128, 17, 135, 217, 21, 233, 232, 118, 71, 58, 62, 165,
+			247, 181, 194, 7, 107, 226, 107, 176, 151, 17, 182, 194, 7, 183,
+			249, 245, 222, 139, 152, 233, 189, 136, 88, 140, 237, 232, 222, 26,
+			153, 6, 194, 202, 104, 246, 189, 187, 194, 1, 176, 18, 191, 238,
+			127, 72, 239, 7, 184, 182, 89, 173, 87, 33, 10, 143, 246, 191,
+			231, 247, 212, 223, 117, 126, 223, 127, 90, 231, 165, 255, 246, 118,
+			68, 113, 171, 7, 102, 79, 244, 220, 71, 168, 254, 176, 151, 219,
+			209, 178, 230, 49, 32, 230, 111, 92, 19, 180, 70, 231, 45, 135,
+			148, 15, 224, 9, 157, 247, 167, 54, 244, 161, 93, 223, 251, 71,
+			245, 200, 210, 221, 149, 27, 107, 43, 171, 165, 213, 187, 43, 107,
+			119, 23, 87, 150, 230, 231, 22, 174, 39, 33, 118, 28, 209, 191,
+			199, 16, 187, 217, 231, 117, 142, 59, 137, 252, 103, 245, 190, 52,
+			153, 248, 195, 187, 214, 98, 30, 20, 29, 20, 58, 39, 217, 139,
+			180, 174, 206, 252, 246, 251, 255, 22, 140, 229, 217, 183, 134, 117,
+			214, 247, 14, 244, 61, 175, 244, 191, 139, 43, 140, 30, 232, 243,
+			103, 127, 224, 117, 86, 24, 237, 17, 45, 167, 17, 39, 69, 65,
+			110, 123, 12, 127, 51, 147, 120, 161, 192, 63, 21, 166, 62, 168,
+			205, 78, 99, 155, 210, 211, 201, 117, 43, 41, 183, 23, 151, 5,
+			225, 114, 124, 205, 90, 21, 161, 66, 146, 119, 204, 54, 95, 212,
+			230, 195, 220, 66, 99, 29, 170, 91, 42, 137, 211, 20, 13, 136,
+			188, 102, 202, 109, 205, 170, 26, 40, 176, 88, 127, 85, 166, 64,
+			189, 152, 225, 197, 239, 69, 167, 57, 12, 111, 102, 182, 120, 70,
+			107, 115, 183, 94, 131, 105, 25, 135, 56, 92, 142, 113, 44, 114,
+			52, 12, 156, 171, 106, 229, 135, 148, 121, 103, 171, 21, 66, 210,
+			39, 69, 227, 195, 22, 25, 191, 144, 29, 123, 179, 253, 144, 178,
+			191, 167, 45, 128, 201, 50, 201, 200, 186, 76, 132, 164, 102, 54,
+			133, 210, 138, 89, 88, 41, 152, 171, 165, 149, 133, 149, 105, 109,
+			94, 88, 88, 189, 113, 231, 238, 170, 121, 161, 180, 188, 92, 90,
+			92, 93, 152, 95, 49, 119, 150, 205, 220, 157, 197, 107, 11, 171,
+			11, 119, 22, 87, 204, 157, 235, 166, 180, 248, 97, 115, 115, 97,
+			241, 218, 180, 36, 16, 98, 119, 83, 211, 104, 73, 117, 118, 54,
+			12, 165, 187, 151, 130, 77, 246, 130, 104, 147, 215, 197, 145, 141,
+			221, 153, 235, 168, 160, 76, 90, 173, 185, 107, 70, 54, 28, 112,
+			176, 239, 8, 199, 0, 250, 125, 87, 37, 6, 144, 255, 69, 140,
+			224, 80, 223, 73, 60, 133, 151, 233, 145, 190, 41, 250, 215, 241,
+			221, 145, 190, 13, 173, 181, 147, 237, 243, 189, 160, 239, 184, 130,
+			127, 94, 22, 158, 117, 65, 110, 80, 191, 170, 189, 44, 110, 91,
+			238, 184, 119, 51, 120, 197, 164, 145, 159, 60, 186, 58, 194, 179,
+			248, 42, 79, 153, 27, 215, 81, 102, 135, 93, 224, 9, 133, 210,
+			86, 72, 157, 216, 65, 249, 96, 225, 251, 146, 72, 176, 177, 119,
+			30, 122, 86, 190, 59, 158, 27, 18, 200, 241, 221, 241, 35, 167,
+			4, 114, 125, 119, 252, 252, 2, 198, 14, 191, 125, 211, 87, 84,
+			54, 180, 202, 228, 142, 233, 43, 18, 89, 85, 240, 46, 6, 103,
+			201, 161, 63, 180, 6, 39, 30, 9, 170, 152, 148, 17, 80, 36,
+			3, 72, 221, 214, 57, 188, 41, 139, 6, 10, 28, 177, 130, 182,
+			11, 239, 123, 82, 32, 215, 119, 11, 23, 62, 64, 119, 24, 220,
+			13, 221, 147, 222, 99, 193, 135, 204, 243, 168, 34, 128, 158, 186,
+			79, 85, 230, 241, 197, 53, 225, 246, 107, 107, 214, 230, 200, 11,
+			97, 251, 133, 39, 226, 73, 207, 66, 104, 60, 127, 76, 32, 215,
+			119, 79, 158, 48, 250, 15, 149, 132, 42, 77, 121, 19, 193, 63,
+			82, 228, 221, 192, 155, 33, 75, 25, 77, 144, 244, 38, 73, 216,
+			105, 115, 210, 43, 96, 85, 23, 54, 113, 106, 181, 165, 109, 196,
+			2, 55, 18, 107, 229, 184, 168, 59, 236, 158, 27, 97, 149, 219,
+			233, 138, 134, 133, 231, 190, 56, 87, 198, 165, 246, 166, 188, 125,
+			2, 101, 125, 119, 106, 255, 152, 64, 202, 119, 167, 2, 89, 88,
+			184, 65, 78, 157, 124, 66, 175, 73, 72, 212, 140, 119, 58, 88,
+			78, 28, 178, 58, 48, 79, 68, 124, 152, 107, 236, 145, 63, 13,
+			21, 64, 163, 85, 225, 11, 89, 231, 46, 235, 216, 212, 103, 7,
+			134, 146, 122, 51, 222, 184, 64, 202, 119, 103, 142, 159, 18, 200,
+			245, 221, 153, 153, 162, 190, 168, 157, 76, 159, 239, 157, 237, 187,
+			160, 130, 105, 226, 197, 201, 77, 0, 74, 87, 92, 248, 218, 109,
+			136, 125, 146, 136, 88, 174, 118, 90, 187, 25, 96, 202, 217, 204,
+			176, 206, 107, 47, 67, 20, 52, 235, 25, 116, 0, 64, 249, 238,
+			172, 119, 84, 32, 199, 119, 103, 143, 159, 224, 23, 149, 239, 62,
+			233, 13, 242, 139, 240, 63, 125, 210, 203, 11, 228, 248, 238, 147,
+			7, 14, 242, 139, 142, 239, 158, 243, 70, 249, 39, 224, 222, 57,
+			239, 176, 64, 248, 109, 120, 132, 95, 116, 125, 247, 188, 55, 196,
+			47, 130, 244, 207, 123, 7, 5, 114, 124, 247, 188, 127, 152, 40,
+			72, 249, 222, 69, 28, 72, 18, 233, 116, 49, 55, 164, 167, 36,
+			210, 233, 146, 55, 28, 28, 163, 37, 72, 140, 197, 41, 194, 231,
+			101, 141, 195, 152, 46, 49, 210, 210, 77, 218, 189, 196, 206, 180,
+			113, 24, 211, 165, 161, 35, 100, 63, 196, 29, 218, 189, 226, 141,
+			6, 159, 48, 87, 161, 70, 99, 119, 149, 84, 147, 230, 94, 3,
+			110, 175, 132, 113, 27, 229, 122, 55, 202, 209, 234, 115, 248, 204,
+			180, 65, 217, 43, 109, 10, 98, 41, 193, 209, 59, 19, 163, 245,
+			76, 37, 124, 144, 14, 37, 44, 200, 216, 64, 94, 87, 236, 72,
+			49, 225, 43, 249, 195, 2, 161, 236, 227, 240, 136, 62, 47, 209,
+			79, 37, 47, 8, 38, 205, 106, 50, 58, 152, 142, 227, 52, 4,
+			143, 224, 27, 10, 250, 53, 183, 228, 13, 8, 132, 40, 35, 157,
+			14, 110, 42, 141, 142, 233, 109, 234, 192, 69, 16, 209, 233, 224,
+			94, 167, 213, 250, 161, 96, 254, 123, 118, 153, 246, 80, 52, 147,
+			235, 59, 92, 92, 166, 147, 130, 197, 216, 45, 177, 14, 138, 72,
+			96, 158, 73, 128, 46, 239, 238, 60, 147, 128, 194, 213, 201, 157,
+			159, 41, 146, 249, 84, 129, 136, 111, 120, 143, 5, 115, 127, 55,
+			198, 150, 154, 131, 237, 27, 241, 9, 55, 236, 234, 195, 25, 252,
+			6, 51, 55, 5, 215, 42, 247, 198, 9, 163, 63, 68, 125, 103,
+			16, 242, 115, 58, 152, 125, 196, 226, 144, 67, 111, 7, 41, 178,
+			128, 108, 187, 202, 100, 209, 130, 76, 51, 163, 124, 247, 166, 157,
+			102, 6, 97, 74, 51, 69, 186, 166, 42, 39, 235, 187, 139, 222,
+			153, 224, 217, 61, 119, 37, 194, 180, 153, 108, 133, 91, 229, 86,
+			133, 36, 23, 20, 36, 32, 54, 145, 172, 116, 150, 90, 150, 33,
+			32, 152, 97, 241, 248, 251, 5, 114, 125, 119, 177, 120, 154, 252,
+			92, 149, 211, 239, 187, 207, 121, 79, 4, 5, 137, 62, 107, 108,
+			246, 238, 218, 54, 221, 159, 197, 23, 130, 87, 8, 86, 120, 142,
+			203, 174, 42, 56, 208, 184, 207, 61, 126, 146, 9, 46, 231, 187,
+			119, 189, 32, 248, 4, 171, 215, 196, 24, 242, 200, 30, 204, 194,
+			35, 126, 1, 186, 105, 228, 80, 135, 23, 204, 230, 118, 13, 122,
+			199, 106, 100, 99, 219, 144, 78, 17, 114, 51, 219, 240, 236, 72,
+			17, 250, 112, 215, 110, 57, 66, 31, 238, 230, 101, 220, 8, 125,
+			184, 59, 58, 182, 158, 109, 182, 26, 237, 198, 147, 255, 119, 0,
+			52, 29, 168, 160, 236, 106, 2, 0},
 	)
 }
 
@@ -44,6 +44,9 @@ var AuthorizeRPCAccess = rpcacl.Interceptor(rpcacl.Map{
 	// Only members of auth-trusted-services can work with the AuthDB.
 	"/auth.service.AuthDB/*": model.TrustedServicesGroup,
 
+	// Explaining permission checks is a debugging aid for authorized users.
+	"/auth.service.AuthDB/ExplainPermission": authdb.AuthServiceAccessGroup,
+
 	// All methods to work with ChangeLogs require authorization.
 	"/auth.service.ChangeLogs/*": authdb.AuthServiceAccessGroup,
 
@@ -0,0 +1,420 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+
+	"go.chromium.org/luci/auth_service/api/rpcpb"
+)
+
+// MaxAccessRequestDuration is the longest membership that can be requested
+// via an access request.
+const MaxAccessRequestDuration = 30 * 24 * time.Hour
+
+// AccessRequestState is the state of an AuthGroupAccessRequest.
+type AccessRequestState string
+
+const (
+	AccessRequestPending  AccessRequestState = "PENDING"
+	AccessRequestApproved AccessRequestState = "APPROVED"
+	AccessRequestDenied   AccessRequestState = "DENIED"
+)
+
+// ErrAccessRequestReviewed is returned when reviewing an access request which
+// was already approved or denied.
+var ErrAccessRequestReviewed = stderrors.New("access request was already reviewed")
+
+// AuthGroupAccessRequest is a request from an identity to become a member of a
+// group for a limited time.
+//
+// It is not part of the AuthDB; only an approval changes the group itself.
+type AuthGroupAccessRequest struct {
+	Kind string `gae:"$kind,AuthGroupAccessRequest"`
+	ID   int64  `gae:"$id"`
+
+	// Parent is RootKey().
+	Parent *datastore.Key `gae:"$parent"`
+
+	// Group is the name of the group the access is requested to.
+	Group string `gae:"group"`
+
+	// Requestor is the identity that requested the access.
+	Requestor string `gae:"requestor"`
+
+	// Justification is the reason for the request, given by the requestor.
+	Justification string `gae:"justification,noindex"`
+
+	// DurationSecs is the requested duration of the membership.
+	DurationSecs int64 `gae:"duration_secs,noindex"`
+
+	// State is the current state of the request.
+	State AccessRequestState `gae:"state"`
+
+	// CreatedTS is the time when the request was made, in UTC.
+	CreatedTS time.Time `gae:"created_ts"`
+
+	// ReviewedBy is the identity that approved or denied the request.
+	ReviewedBy string `gae:"reviewed_by,noindex"`
+
+	// ReviewedTS is the time when the request was reviewed, in UTC.
+	ReviewedTS time.Time `gae:"reviewed_ts,noindex"`
+
+	// ReviewComment is an optional comment given by the reviewer.
+	ReviewComment string `gae:"review_comment,noindex"`
+
+	// MembershipExpiryTS is the expiry of the membership granted by the
+	// approval. Unset if the request wasn't approved, or the requestor was
+	// already a permanent member when it was.
+	MembershipExpiryTS time.Time `gae:"membership_expiry_ts,noindex"`
+}
+
+// Duration returns the requested duration of the membership.
+func (r *AuthGroupAccessRequest) Duration() time.Duration {
+	return time.Duration(r.DurationSecs) * time.Second
+}
+
+// ToProto converts the AuthGroupAccessRequest entity to the protobuffer
+// equivalent GroupAccessRequest.
+func (r *AuthGroupAccessRequest) ToProto() *rpcpb.GroupAccessRequest {
+	req := &rpcpb.GroupAccessRequest{
+		Id:            r.ID,
+		Name:          r.Group,
+		Requestor:     r.Requestor,
+		Justification: r.Justification,
+		Duration:      durationpb.New(r.Duration()),
+		State:         rpcpb.GroupAccessRequest_State(rpcpb.GroupAccessRequest_State_value[string(r.State)]),
+		CreatedTs:     timestamppb.New(r.CreatedTS),
+		ReviewedBy:    r.ReviewedBy,
+		ReviewComment: r.ReviewComment,
+	}
+	if !r.ReviewedTS.IsZero() {
+		req.ReviewedTs = timestamppb.New(r.ReviewedTS)
+	}
+	if !r.MembershipExpiryTS.IsZero() {
+		req.MembershipExpiryTs = timestamppb.New(r.MembershipExpiryTS)
+	}
+	return req
+}
+
+// CreateGroupAccessRequest creates a pending request for the caller to become a
+// member of the given group for the given duration.
+//
+// Possible errors:
+//
+//	ErrInvalidArgument if the duration or justification is invalid.
+//	datastore.ErrNoSuchEntity if the specified group does not exist.
+//	ErrPermissionDenied if the group is an external group.
+//	ErrAlreadyExists if the caller is already a permanent member, or already
+//	  has a pending request for the group.
+//	Annotated error for other errors.
+func CreateGroupAccessRequest(ctx context.Context, groupName string, duration time.Duration, justification string, dryRun bool) (*AuthGroupAccessRequest, error) {
+	switch {
+	case duration <= 0:
+		return nil, fmt.Errorf("%w: duration must be positive", ErrInvalidArgument)
+	case duration > MaxAccessRequestDuration:
+		return nil, fmt.Errorf("%w: duration must not exceed %s", ErrInvalidArgument, MaxAccessRequestDuration)
+	case duration%time.Second != 0:
+		return nil, fmt.Errorf("%w: duration must be a whole number of seconds", ErrInvalidArgument)
+	case justification == "":
+		return nil, fmt.Errorf("%w: justification is required", ErrInvalidArgument)
+	}
+	if IsExternalAuthGroupName(groupName) {
+		return nil, errors.Annotate(ErrPermissionDenied, "cannot request access to external group").Err()
+	}
+
+	requestor := auth.CurrentIdentity(ctx)
+	req := &AuthGroupAccessRequest{
+		Kind:          "AuthGroupAccessRequest",
+		Parent:        RootKey(ctx),
+		Group:         groupName,
+		Requestor:     string(requestor),
+		Justification: justification,
+		DurationSecs:  int64(duration / time.Second),
+		State:         AccessRequestPending,
+	}
+
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		group, err := GetAuthGroup(ctx, groupName)
+		if err != nil {
+			return err
+		}
+		if hasPermanentMembership(group, req.Requestor) {
+			return errors.Annotate(ErrAlreadyExists, "%s is already a member of %q", requestor, groupName).Err()
+		}
+
+		q := datastore.NewQuery("AuthGroupAccessRequest").
+			Ancestor(RootKey(ctx)).
+			Eq("group", groupName).
+			Eq("requestor", req.Requestor).
+			Eq("state", string(AccessRequestPending)).
+			KeysOnly(true)
+		count, err := datastore.Count(ctx, q)
+		if err != nil {
+			return errors.Annotate(err, "failed to query pending access requests").Err()
+		}
+		if count > 0 {
+			return errors.Annotate(ErrAlreadyExists, "%s already has a pending request for %q", requestor, groupName).Err()
+		}
+
+		req.CreatedTS = clock.Now(ctx).UTC()
+		if dryRun {
+			logging.Infof(ctx, "(dry run) creating AuthGroupAccessRequest:\n%+v", req)
+			return nil
+		}
+		return datastore.Put(ctx, req)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetPendingGroupAccessRequests returns the pending access requests for the
+// given group, oldest first.
+//
+// Returns an annotated error.
+func GetPendingGroupAccessRequests(ctx context.Context, groupName string) ([]*AuthGroupAccessRequest, error) {
+	q := datastore.NewQuery("AuthGroupAccessRequest").
+		Ancestor(RootKey(ctx)).
+		Eq("group", groupName).
+		Eq("state", string(AccessRequestPending))
+	var reqs []*AuthGroupAccessRequest
+	if err := datastore.GetAll(ctx, q, &reqs); err != nil {
+		return nil, errors.Annotate(err, "error getting pending AuthGroupAccessRequest entities").Err()
+	}
+	sort.SliceStable(reqs, func(i, j int) bool {
+		return reqs[i].CreatedTS.Before(reqs[j].CreatedTS)
+	})
+	return reqs, nil
+}
+
+// ReviewGroupAccessRequest approves or denies a pending access request.
+//
+// Approving adds the requestor to the group, with their membership expiring
+// after the requested duration. If the requestor already has a time-bounded
+// membership, it is extended rather than shortened. The group change is
+// recorded in the AuthDB changelog like any other edit.
+//
+// Possible errors:
+//
+//	datastore.ErrNoSuchEntity if the group or the request does not exist.
+//	ErrPermissionDenied if the caller is not allowed to review the request.
+//	ErrAccessRequestReviewed if the request is not pending.
+//	Annotated error for other errors.
+func ReviewGroupAccessRequest(ctx context.Context, groupName string, id int64, approve bool, comment string, dryRun bool) (*AuthGroupAccessRequest, error) {
+	req, err := getGroupAccessRequest(ctx, groupName, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer := auth.CurrentIdentity(ctx)
+	review := func(ctx context.Context, commitEntity commitAuthEntity) error {
+		// Reload the request within the transaction.
+		req, err = getGroupAccessRequest(ctx, groupName, id)
+		if err != nil {
+			return err
+		}
+		if req.State != AccessRequestPending {
+			return errors.Annotate(ErrAccessRequestReviewed, "request %d is %s", id, req.State).Err()
+		}
+		if req.Requestor == string(reviewer) {
+			return errors.Annotate(ErrPermissionDenied, "cannot review own access request").Err()
+		}
+
+		group, err := GetAuthGroup(ctx, groupName)
+		if err != nil {
+			return err
+		}
+		switch ok, err := auth.IsMember(ctx, AdminGroup, group.Owners); {
+		case err != nil:
+			return errors.Annotate(err, "permission check failed").Err()
+		case !ok:
+			return ErrPermissionDenied
+		}
+
+		now := clock.Now(ctx).UTC()
+		req.ReviewedBy = string(reviewer)
+		req.ReviewedTS = now
+		req.ReviewComment = comment
+		req.State = AccessRequestDenied
+		groupChanged := false
+		if approve {
+			req.State = AccessRequestApproved
+			if !hasPermanentMembership(group, req.Requestor) {
+				req.MembershipExpiryTS = grantMembership(group, req.Requestor, now.Add(req.Duration()))
+				groupChanged = true
+			}
+		}
+
+		if dryRun {
+			logging.Infof(ctx, "(dry run) reviewing AuthGroupAccessRequest:\n%+v", req)
+			return nil
+		}
+		if err := datastore.Put(ctx, req); err != nil {
+			return errors.Annotate(err, "failed to update access request").Err()
+		}
+		if !groupChanged {
+			return nil
+		}
+		return commitEntity(group, now, reviewer, false)
+	}
+
+	historicalComment := fmt.Sprintf("Approved access request %d from %s: %s",
+		req.ID, req.Requestor, req.Justification)
+	if approve {
+		err = runAuthDBChange(ctx, historicalComment, review)
+	} else {
+		// Denials don't touch the AuthDB, so don't produce a revision for them.
+		err = datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+			return review(ctx, nil)
+		}, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// ExpireGroupMemberships removes all members whose time-bounded membership has
+// expired.
+//
+// Each group is updated in its own AuthDB change.
+func ExpireGroupMemberships(ctx context.Context, dryRun bool) error {
+	groups, err := GetAllAuthGroups(ctx)
+	if err != nil {
+		return err
+	}
+	serviceIdentity, err := getServiceIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	var merr errors.MultiError
+	for _, group := range groups {
+		if len(expiredMembers(group, clock.Now(ctx).UTC())) == 0 {
+			continue
+		}
+		err := runAuthDBChange(ctx, "Membership expired", func(ctx context.Context, commitEntity commitAuthEntity) error {
+			// Refetch the group in the transaction, in case it was modified.
+			group, err := GetAuthGroup(ctx, group.ID)
+			if err != nil {
+				return err
+			}
+			now := clock.Now(ctx).UTC()
+			expired := expiredMembers(group, now)
+			if len(expired) == 0 {
+				return nil
+			}
+			if dryRun {
+				logging.Infof(ctx, "(dry run) removing expired members from %q: %v", group.ID, expired)
+				return nil
+			}
+			logging.Infof(ctx, "removing expired members from %q: %v", group.ID, expired)
+			group.Members = slices.DeleteFunc(group.Members, func(m string) bool {
+				return slices.Contains(expired, m)
+			})
+			group.MembershipExpirations = pruneMembershipExpirations(group.MembershipExpirations, group.Members)
+			return commitEntity(group, now, serviceIdentity, false)
+		})
+		if err != nil {
+			merr = append(merr, errors.Annotate(err, "failed to expire memberships in %q", group.ID).Err())
+		}
+	}
+	if len(merr) > 0 {
+		return merr
+	}
+	return nil
+}
+
+// getGroupAccessRequest fetches the access request with the given ID, checking
+// it is for the given group.
+func getGroupAccessRequest(ctx context.Context, groupName string, id int64) (*AuthGroupAccessRequest, error) {
+	req := &AuthGroupAccessRequest{
+		Kind:   "AuthGroupAccessRequest",
+		ID:     id,
+		Parent: RootKey(ctx),
+	}
+	switch err := datastore.Get(ctx, req); {
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, err
+	case err != nil:
+		return nil, errors.Annotate(err, "error getting AuthGroupAccessRequest").Err()
+	case req.Group != groupName:
+		return nil, datastore.ErrNoSuchEntity
+	}
+	return req, nil
+}
+
+// hasPermanentMembership returns whether the identity is a member of the group
+// without an expiration.
+func hasPermanentMembership(group *AuthGroup, id string) bool {
+	if !slices.Contains(group.Members, id) {
+		return false
+	}
+	return !slices.ContainsFunc(group.MembershipExpirations, func(exp MembershipExpiration) bool {
+		return exp.Identity == id
+	})
+}
+
+// grantMembership adds the identity to the group until the given expiry,
+// keeping any later existing expiry.
+//
+// Returns the resulting expiry.
+func grantMembership(group *AuthGroup, id string, expiry time.Time) time.Time {
+	if !slices.Contains(group.Members, id) {
+		group.Members = append(group.Members, id)
+	}
+	for i, exp := range group.MembershipExpirations {
+		if exp.Identity == id {
+			if expiry.After(exp.ExpiryTS) {
+				group.MembershipExpirations[i].ExpiryTS = expiry
+			}
+			return group.MembershipExpirations[i].ExpiryTS
+		}
+	}
+	group.MembershipExpirations = append(group.MembershipExpirations, MembershipExpiration{
+		Identity: id,
+		ExpiryTS: expiry,
+	})
+	return expiry
+}
+
+// expiredMembers returns the members of the group whose membership expired by
+// now.
+func expiredMembers(group *AuthGroup, now time.Time) []string {
+	var expired []string
+	for _, exp := range group.MembershipExpirations {
+		if !exp.ExpiryTS.After(now) {
+			expired = append(expired, exp.Identity)
+		}
+	}
+	return expired
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/auth_service/impl/info"
+	"go.chromium.org/luci/auth_service/testsupport"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGroupAccessRequests(t *testing.T) {
+	t.Parallel()
+
+	Convey("Group access requests", t, func() {
+		ctx := memory.Use(context.Background())
+		ctx = clock.Set(ctx, testclock.New(testCreatedTS))
+		ctx = info.SetImageVersion(ctx, "test-version")
+		ctx, _ = tq.TestingContext(txndefer.FilterRDS(ctx), nil)
+		ctx = testsupport.SetTestContextSigner(ctx, "test-app-id", "test-app-id@example.com")
+
+		requestor := "user:requestor@example.com"
+		requestorCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity: identity.Identity(requestor),
+		})
+		ownerCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:owner@example.com",
+			IdentityGroups: []string{"owners-foo"},
+		})
+
+		group := emptyAuthGroup(ctx, "foo")
+		group.Owners = "owners-foo"
+		group.Members = []string{"user:existing@example.com"}
+		So(datastore.Put(ctx, group, testAuthReplicationState(ctx, 10)), ShouldBeNil)
+
+		Convey("CreateGroupAccessRequest", func() {
+			Convey("validates the request", func() {
+				_, err := CreateGroupAccessRequest(requestorCtx, "foo", 0, "please", false)
+				So(err, ShouldUnwrapTo, ErrInvalidArgument)
+				_, err = CreateGroupAccessRequest(requestorCtx, "foo", MaxAccessRequestDuration+time.Second, "please", false)
+				So(err, ShouldUnwrapTo, ErrInvalidArgument)
+				_, err = CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "", false)
+				So(err, ShouldUnwrapTo, ErrInvalidArgument)
+				_, err = CreateGroupAccessRequest(requestorCtx, "mdb/foo", time.Hour, "please", false)
+				So(err, ShouldUnwrapTo, ErrPermissionDenied)
+				_, err = CreateGroupAccessRequest(requestorCtx, "unknown", time.Hour, "please", false)
+				So(err, ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("rejects permanent members", func() {
+				ctx := auth.WithState(ctx, &authtest.FakeState{
+					Identity: "user:existing@example.com",
+				})
+				_, err := CreateGroupAccessRequest(ctx, "foo", time.Hour, "please", false)
+				So(err, ShouldUnwrapTo, ErrAlreadyExists)
+			})
+
+			Convey("rejects duplicate pending requests", func() {
+				_, err := CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "please", false)
+				So(err, ShouldBeNil)
+				_, err = CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "again", false)
+				So(err, ShouldUnwrapTo, ErrAlreadyExists)
+			})
+
+			Convey("dry run", func() {
+				_, err := CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "please", true)
+				So(err, ShouldBeNil)
+				reqs, err := GetPendingGroupAccessRequests(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(reqs, ShouldBeEmpty)
+			})
+
+			Convey("lists pending requests oldest first", func() {
+				first, err := CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "please", false)
+				So(err, ShouldBeNil)
+				clock.Get(ctx).(testclock.TestClock).Add(time.Minute)
+				second, err := CreateGroupAccessRequest(auth.WithState(ctx, &authtest.FakeState{
+					Identity: "user:other@example.com",
+				}), "foo", time.Hour, "me too", false)
+				So(err, ShouldBeNil)
+
+				reqs, err := GetPendingGroupAccessRequests(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(reqs, ShouldHaveLength, 2)
+				So(reqs[0].ID, ShouldEqual, first.ID)
+				So(reqs[1].ID, ShouldEqual, second.ID)
+				So(reqs[0].State, ShouldEqual, AccessRequestPending)
+				So(reqs[0].Requestor, ShouldEqual, requestor)
+				So(reqs[0].Duration(), ShouldEqual, time.Hour)
+			})
+		})
+
+		Convey("ReviewGroupAccessRequest", func() {
+			req, err := CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "please", false)
+			So(err, ShouldBeNil)
+
+			Convey("requires ownership", func() {
+				ctx := auth.WithState(ctx, &authtest.FakeState{
+					Identity: "user:someone@example.com",
+				})
+				_, err := ReviewGroupAccessRequest(ctx, "foo", req.ID, true, "", false)
+				So(err, ShouldEqual, ErrPermissionDenied)
+			})
+
+			Convey("forbids self review", func() {
+				ctx := auth.WithState(ctx, &authtest.FakeState{
+					Identity:       identity.Identity(requestor),
+					IdentityGroups: []string{AdminGroup},
+				})
+				_, err := ReviewGroupAccessRequest(ctx, "foo", req.ID, true, "", false)
+				So(err, ShouldUnwrapTo, ErrPermissionDenied)
+			})
+
+			Convey("request must belong to the group", func() {
+				_, err := ReviewGroupAccessRequest(ownerCtx, "bar", req.ID, true, "", false)
+				So(err, ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("approval adds a time-bounded member", func() {
+				reviewed, err := ReviewGroupAccessRequest(ownerCtx, "foo", req.ID, true, "ok", false)
+				So(err, ShouldBeNil)
+				expiry := testCreatedTS.Add(time.Hour)
+				So(reviewed.State, ShouldEqual, AccessRequestApproved)
+				So(reviewed.ReviewedBy, ShouldEqual, "user:owner@example.com")
+				So(reviewed.ReviewComment, ShouldEqual, "ok")
+				So(reviewed.MembershipExpiryTS.Equal(expiry), ShouldBeTrue)
+
+				updated, err := GetAuthGroup(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(updated.Members, ShouldResemble, []string{"user:existing@example.com", requestor})
+				So(updated.MembershipExpirations, ShouldHaveLength, 1)
+				So(updated.MembershipExpirations[0].Identity, ShouldEqual, requestor)
+				So(updated.MembershipExpirations[0].ExpiryTS.Equal(expiry), ShouldBeTrue)
+				So(updated.AuthDBRev, ShouldEqual, 11)
+				So(updated.ModifiedBy, ShouldEqual, "user:owner@example.com")
+
+				entities, err := getAllDatastoreEntities(ctx, "AuthGroupHistory", HistoricalRevisionKey(ctx, 11))
+				So(err, ShouldBeNil)
+				So(entities, ShouldHaveLength, 1)
+				So(getStringProp(entities[0], "auth_db_change_comment"), ShouldEqual,
+					"Approved access request 1 from user:requestor@example.com: please")
+
+				reqs, err := GetPendingGroupAccessRequests(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(reqs, ShouldBeEmpty)
+
+				Convey("can't be reviewed twice", func() {
+					_, err := ReviewGroupAccessRequest(ownerCtx, "foo", req.ID, false, "", false)
+					So(err, ShouldUnwrapTo, ErrAccessRequestReviewed)
+				})
+
+				Convey("a later approval extends the membership", func() {
+					clock.Get(ctx).(testclock.TestClock).Add(30 * time.Minute)
+					req, err := CreateGroupAccessRequest(requestorCtx, "foo", time.Hour, "more", false)
+					So(err, ShouldBeNil)
+					_, err = ReviewGroupAccessRequest(ownerCtx, "foo", req.ID, true, "", false)
+					So(err, ShouldBeNil)
+
+					updated, err := GetAuthGroup(ctx, "foo")
+					So(err, ShouldBeNil)
+					So(updated.Members, ShouldHaveLength, 2)
+					So(updated.MembershipExpirations, ShouldHaveLength, 1)
+					So(updated.MembershipExpirations[0].ExpiryTS.Equal(expiry.Add(30*time.Minute)), ShouldBeTrue)
+				})
+
+				Convey("removing the member drops the expiration", func() {
+					update := emptyAuthGroup(ctx, "foo")
+					update.Members = []string{"user:existing@example.com"}
+					_, err := UpdateAuthGroup(ownerCtx, update, &fieldmaskpb.FieldMask{Paths: []string{"members"}}, "", false, "Go pRPC API", false)
+					So(err, ShouldBeNil)
+
+					updated, err := GetAuthGroup(ctx, "foo")
+					So(err, ShouldBeNil)
+					So(updated.MembershipExpirations, ShouldBeEmpty)
+				})
+
+				Convey("expired memberships are removed", func() {
+					So(ExpireGroupMemberships(ctx, false), ShouldBeNil)
+					updated, err := GetAuthGroup(ctx, "foo")
+					So(err, ShouldBeNil)
+					So(updated.Members, ShouldHaveLength, 2)
+
+					clock.Get(ctx).(testclock.TestClock).Add(time.Hour)
+
+					Convey("dry run", func() {
+						So(ExpireGroupMemberships(ctx, true), ShouldBeNil)
+						updated, err := GetAuthGroup(ctx, "foo")
+						So(err, ShouldBeNil)
+						So(updated.Members, ShouldHaveLength, 2)
+					})
+
+					So(ExpireGroupMemberships(ctx, false), ShouldBeNil)
+					updated, err = GetAuthGroup(ctx, "foo")
+					So(err, ShouldBeNil)
+					So(updated.Members, ShouldResemble, []string{"user:existing@example.com"})
+					So(updated.MembershipExpirations, ShouldBeEmpty)
+					So(updated.AuthDBRev, ShouldEqual, 12)
+					So(updated.ModifiedBy, ShouldEqual, "user:test-app-id@example.com")
+				})
+			})
+
+			Convey("denial leaves the group alone", func() {
+				reviewed, err := ReviewGroupAccessRequest(ownerCtx, "foo", req.ID, false, "no", false)
+				So(err, ShouldBeNil)
+				So(reviewed.State, ShouldEqual, AccessRequestDenied)
+				So(reviewed.MembershipExpiryTS.IsZero(), ShouldBeTrue)
+
+				updated, err := GetAuthGroup(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(updated.Members, ShouldResemble, []string{"user:existing@example.com"})
+				So(updated.AuthDBRev, ShouldEqual, 1)
+			})
+
+			Convey("dry run", func() {
+				_, err := ReviewGroupAccessRequest(ownerCtx, "foo", req.ID, true, "", true)
+				So(err, ShouldBeNil)
+				reqs, err := GetPendingGroupAccessRequests(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(reqs, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("UpdateAuthGroup validates membership expirations", func() {
+			update := emptyAuthGroup(ctx, "foo")
+			update.MembershipExpirations = []MembershipExpiration{
+				{Identity: "user:unknown@example.com", ExpiryTS: testCreatedTS.Add(time.Hour)},
+			}
+			mask := &fieldmaskpb.FieldMask{Paths: []string{"membership_expirations"}}
+			_, err := UpdateAuthGroup(ownerCtx, update, mask, "", false, "Go pRPC API", false)
+			So(err, ShouldUnwrapTo, ErrInvalidArgument)
+			So(err, ShouldErrLike, "is not a member")
+
+			update.MembershipExpirations[0].Identity = "user:existing@example.com"
+			update.MembershipExpirations[0].ExpiryTS = testCreatedTS
+			_, err = UpdateAuthGroup(ownerCtx, update, mask, "", false, "Go pRPC API", false)
+			So(err, ShouldErrLike, "not in the future")
+
+			update.MembershipExpirations[0].ExpiryTS = testCreatedTS.Add(time.Hour)
+			updated, err := UpdateAuthGroup(ownerCtx, update, mask, "", false, "Go pRPC API", false)
+			So(err, ShouldBeNil)
+			So(updated.MembershipExpirations, ShouldResemble, update.MembershipExpirations)
+		})
+	})
+}
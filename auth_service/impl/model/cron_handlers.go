@@ -78,6 +78,19 @@ func StaleAuthorizationCronHandler(dryRun bool) func(context.Context) error {
 	}
 }
 
+/////////////////// Handling of expired group memberships //////////////////////
+
+func ExpireGroupMembershipsCronHandler(dryRun bool) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := ExpireGroupMemberships(ctx, dryRun); err != nil {
+			err = errors.Annotate(err, "error expiring group memberships").Err()
+			logging.Errorf(ctx, err.Error())
+			return err
+		}
+		return nil
+	}
+}
+
 /////////////////////// Handling of service configs ////////////////////////////
 
 func ServiceConfigCronHandler(dryRun bool) func(context.Context) error {
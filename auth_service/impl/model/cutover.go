@@ -48,13 +48,14 @@ import (
 // Each environment variable should be either "true" or "false" (case
 // insensitive).
 const (
-	DryRunAPIChangesEnvVar    = "DRY_RUN_API_CHANGES"
-	DryRunCronConfigEnvVar    = "DRY_RUN_CRON_CONFIG"
-	DryRunCronRealmsEnvVar    = "DRY_RUN_CRON_REALMS"
-	DryRunCronStaleAuthEnvVar = "DRY_RUN_CRON_STALE_AUTH"
-	DryRunTQChangelogEnvVar   = "DRY_RUN_TQ_CHANGELOG"
-	DryRunTQReplicationEnvVar = "DRY_RUN_TQ_REPLICATION"
-	EnableGroupImportsEnvVar  = "ENABLE_GROUP_IMPORTS"
+	DryRunAPIChangesEnvVar           = "DRY_RUN_API_CHANGES"
+	DryRunCronConfigEnvVar           = "DRY_RUN_CRON_CONFIG"
+	DryRunCronMembershipExpiryEnvVar = "DRY_RUN_CRON_MEMBERSHIP_EXPIRY"
+	DryRunCronRealmsEnvVar           = "DRY_RUN_CRON_REALMS"
+	DryRunCronStaleAuthEnvVar        = "DRY_RUN_CRON_STALE_AUTH"
+	DryRunTQChangelogEnvVar          = "DRY_RUN_TQ_CHANGELOG"
+	DryRunTQReplicationEnvVar        = "DRY_RUN_TQ_REPLICATION"
+	EnableGroupImportsEnvVar         = "ENABLE_GROUP_IMPORTS"
 )
 
 // ParseDryRunEnvVar parses the dry run flag from the given environment
@@ -0,0 +1,410 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/auth_service/api/rpcpb"
+)
+
+// maxCycles is the maximum number of cycles FindCycles returns.
+//
+// The number of cycles can grow exponentially with the number of groups in a
+// strongly connected component, so the enumeration stops after that many.
+const maxCycles = 10000
+
+// FindCycles returns all elementary cycles of nested groups found in the graph.
+//
+// Each cycle is listed once, starting from its alphabetically first group.
+// Cycles are sorted by their groups. If there are more than maxCycles cycles,
+// returns only maxCycles of them and sets `truncated` to true.
+//
+// Uses Johnson's algorithm: for each group, in alphabetical order, finds all
+// cycles through it which don't visit alphabetically smaller groups.
+func (g *Graph) FindCycles() (cycles [][]string, truncated bool) {
+	// Each group has an index in the sorted list of names, successors are
+	// deduplicated, since a group may list the same nested group twice.
+	names := g.sortedGroupNames()
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	succ := make([][]int, len(names))
+	for i, name := range names {
+		added := make(map[int]bool, len(g.groups[name].includes))
+		for _, nested := range g.groups[name].includes {
+			if j := index[nested.group.ID]; !added[j] {
+				added[j] = true
+				succ[i] = append(succ[i], j)
+			}
+		}
+	}
+
+	blocked := make([]bool, len(names))
+	blockedBy := make([]map[int]bool, len(names))
+	var unblock func(v int)
+	unblock = func(v int) {
+		blocked[v] = false
+		for w := range blockedBy[v] {
+			delete(blockedBy[v], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	var stack []int
+	var circuit func(start, v int) bool
+	circuit = func(start, v int) bool {
+		found := false
+		stack = append(stack, v)
+		blocked[v] = true
+		for _, w := range succ[v] {
+			if truncated {
+				return false
+			}
+			switch {
+			case w < start:
+				// Cycles through smaller groups were already found.
+			case w == start && len(cycles) == maxCycles:
+				truncated = true
+			case w == start:
+				cycle := make([]string, len(stack))
+				for i, idx := range stack {
+					cycle[i] = names[idx]
+				}
+				cycles = append(cycles, cycle)
+				found = true
+			case !blocked[w]:
+				if circuit(start, w) {
+					found = true
+				}
+			}
+		}
+		if found {
+			unblock(v)
+		} else {
+			for _, w := range succ[v] {
+				if w >= start {
+					if blockedBy[w] == nil {
+						blockedBy[w] = map[int]bool{}
+					}
+					blockedBy[w][v] = true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		return found
+	}
+
+	for start := range names {
+		if truncated {
+			break
+		}
+		for i := start; i < len(names); i++ {
+			blocked[i] = false
+			blockedBy[i] = nil
+		}
+		circuit(start, start)
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return strings.Join(cycles[i], "\n") < strings.Join(cycles[j], "\n")
+	})
+	return cycles, truncated
+}
+
+// FindRedundantMemberships returns direct memberships (of identities and
+// nested groups) which the group already has via some other nested group.
+//
+// Results are sorted by group name; within a group, nested groups come first,
+// followed by identities, in the order they are listed in the group.
+func (g *Graph) FindRedundantMemberships() []*rpcpb.RedundantMembership {
+	var found []*rpcpb.RedundantMembership
+	for _, name := range g.sortedGroupNames() {
+		root := g.groups[name]
+		if len(root.includes) == 0 {
+			continue
+		}
+
+		// Breadth-first search through the nested groups, remembering how each
+		// group was first reached, to get the shortest chains.
+		parent := map[string]string{}
+		order := make([]string, 0, len(root.includes))
+		for _, nested := range root.includes {
+			if _, ok := parent[nested.group.ID]; !ok {
+				parent[nested.group.ID] = ""
+				order = append(order, nested.group.ID)
+			}
+		}
+		chain := func(group string) []string {
+			var via []string
+			for ; group != ""; group = parent[group] {
+				via = append(via, group)
+			}
+			for i, j := 0, len(via)-1; i < j; i, j = i+1, j-1 {
+				via[i], via[j] = via[j], via[i]
+			}
+			return via
+		}
+
+		redundantNested := map[string][]string{}
+		for i := 0; i < len(order); i++ {
+			current := order[i]
+			for _, nested := range g.groups[current].includes {
+				nestedName := nested.group.ID
+				if nestedName == name {
+					// A cycle back to the root; not a redundancy.
+					continue
+				}
+				if _, ok := redundantNested[nestedName]; !ok && isDirectlyNested(root, nestedName) {
+					if via := chain(current); via[0] != nestedName {
+						redundantNested[nestedName] = via
+					}
+				}
+				if _, ok := parent[nestedName]; !ok {
+					parent[nestedName] = current
+					order = append(order, nestedName)
+				}
+			}
+		}
+
+		for _, nested := range root.group.Nested {
+			if via, ok := redundantNested[nested]; ok {
+				found = append(found, &rpcpb.RedundantMembership{
+					Group:  name,
+					Member: &rpcpb.Principal{Kind: rpcpb.PrincipalKind_GROUP, Name: nested},
+					Via:    via,
+				})
+				delete(redundantNested, nested)
+			}
+		}
+
+		// Find the first group (in the search order) listing each direct member.
+		directMembers := stringset.NewFromSlice(root.group.Members...)
+		memberVia := map[string][]string{}
+		for _, group := range order {
+			for _, member := range g.groups[group].group.Members {
+				if _, ok := memberVia[member]; !ok && directMembers.Has(member) {
+					memberVia[member] = chain(group)
+				}
+			}
+		}
+		for _, member := range root.group.Members {
+			if via, ok := memberVia[member]; ok {
+				found = append(found, &rpcpb.RedundantMembership{
+					Group:  name,
+					Member: &rpcpb.Principal{Kind: rpcpb.PrincipalKind_IDENTITY, Name: member},
+					Via:    via,
+				})
+				delete(memberVia, member)
+			}
+		}
+	}
+	return found
+}
+
+// FindOrphanedGroups returns names of groups which are not referenced by any
+// realm binding, by the given referenced groups (e.g. those used by services
+// outside of realms) or as owners of another group, either directly or via
+// a group which includes them.
+//
+// The names are sorted alphabetically.
+func (g *Graph) FindOrphanedGroups(realms []*protocol.Realms, referencedGroups []string) []string {
+	referenced := stringset.NewFromSlice(referencedGroups...)
+	for _, projectRealms := range realms {
+		for _, realm := range projectRealms.GetRealms() {
+			for _, binding := range realm.GetBindings() {
+				for _, principal := range binding.GetPrincipals() {
+					if group, ok := strings.CutPrefix(principal, "group:"); ok {
+						referenced.Add(group)
+					}
+				}
+			}
+		}
+	}
+	for _, node := range g.groups {
+		if owners := node.group.Owners; owners != node.group.ID {
+			referenced.Add(owners)
+		}
+	}
+
+	// Everything included by a referenced group is in use too.
+	used := stringset.New(len(g.groups))
+	var markUsed func(node *groupNode)
+	markUsed = func(node *groupNode) {
+		if !used.Add(node.group.ID) {
+			return
+		}
+		for _, nested := range node.includes {
+			markUsed(nested)
+		}
+	}
+	for group := range referenced {
+		if node, ok := g.groups[group]; ok {
+			markUsed(node)
+		}
+	}
+
+	var orphaned []string
+	for _, name := range g.sortedGroupNames() {
+		if !used.Has(name) {
+			orphaned = append(orphaned, name)
+		}
+	}
+	return orphaned
+}
+
+// GetBlastRadius returns all realm permissions the principal has, i.e. those
+// that would be affected if the principal (an identity or a group) was
+// removed.
+//
+// Permissions granted to identities via globs are not included, since
+// removing an identity from groups doesn't affect them.
+//
+// The results are sorted by realm and permission name.
+func (g *Graph) GetBlastRadius(principal NodeKey, realms []*protocol.Realms) ([]*rpcpb.AffectedPermission, error) {
+	// Chains of groups via which the principal is included in each group.
+	via := map[string][]string{}
+	var queue []string
+	var listedAs string
+	switch principal.Kind {
+	case Identity:
+		listedAs = principal.Value
+		for _, group := range g.membersIndex[identity.Identity(principal.Value)] {
+			if _, ok := via[group]; !ok {
+				via[group] = []string{group}
+				queue = append(queue, group)
+			}
+		}
+	case Group:
+		if _, ok := g.groups[principal.Value]; !ok {
+			return nil, ErrNoSuchGroup
+		}
+		listedAs = "group:" + principal.Value
+		via[principal.Value] = nil
+		queue = append(queue, principal.Value)
+	default:
+		return nil, errors.New("principal kind must be an identity or a group")
+	}
+	for i := 0; i < len(queue); i++ {
+		current := queue[i]
+		for _, supergroup := range g.groups[current].included {
+			if _, ok := via[supergroup.group.ID]; !ok {
+				chain := append(append([]string(nil), via[current]...), supergroup.group.ID)
+				via[supergroup.group.ID] = chain
+				queue = append(queue, supergroup.group.ID)
+			}
+		}
+	}
+
+	type permKey struct {
+		realm      string
+		permission string
+	}
+	affected := map[permKey]*rpcpb.AffectedPermission{}
+	for _, projectRealms := range realms {
+		perms := projectRealms.GetPermissions()
+		for _, realm := range projectRealms.GetRealms() {
+			for _, binding := range realm.GetBindings() {
+				chain, ok := bindingChain(binding.GetPrincipals(), listedAs, via)
+				if !ok {
+					continue
+				}
+				conditional := len(binding.GetConditions()) > 0
+				for _, idx := range binding.GetPermissions() {
+					if int(idx) >= len(perms) {
+						continue
+					}
+					key := permKey{realm.GetName(), perms[idx].GetName()}
+					existing, ok := affected[key]
+					if !ok {
+						affected[key] = &rpcpb.AffectedPermission{
+							Realm:       key.realm,
+							Permission:  key.permission,
+							Via:         chain,
+							Conditional: conditional,
+						}
+						continue
+					}
+					if len(chain) < len(existing.Via) {
+						existing.Via = chain
+					}
+					existing.Conditional = existing.Conditional && conditional
+				}
+			}
+		}
+	}
+
+	result := make([]*rpcpb.AffectedPermission, 0, len(affected))
+	for _, perm := range affected {
+		result = append(result, perm)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Realm != result[j].Realm {
+			return result[i].Realm < result[j].Realm
+		}
+		return result[i].Permission < result[j].Permission
+	})
+	return result, nil
+}
+
+// bindingChain returns the shortest chain of groups via which the principal is
+// granted a binding with the given principals, and whether there is one.
+func bindingChain(principals []string, listedAs string, via map[string][]string) ([]string, bool) {
+	var best []string
+	found := false
+	for _, p := range principals {
+		if p == listedAs {
+			return nil, true
+		}
+		group, ok := strings.CutPrefix(p, "group:")
+		if !ok {
+			continue
+		}
+		if chain, ok := via[group]; ok && (!found || len(chain) < len(best)) {
+			best = chain
+			found = true
+		}
+	}
+	return best, found
+}
+
+// isDirectlyNested returns whether the group directly includes the named group.
+func isDirectlyNested(node *groupNode, name string) bool {
+	for _, nested := range node.includes {
+		if nested.group.ID == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedGroupNames returns names of all groups in the graph, sorted.
+func (g *Graph) sortedGroupNames() []string {
+	names := make([]string, 0, len(g.groups))
+	for name := range g.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"fmt"
+	"testing"
+
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/auth_service/api/rpcpb"
+	"go.chromium.org/luci/auth_service/impl/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestFindCycles(t *testing.T) {
+	t.Parallel()
+
+	Convey("FindCycles", t, func() {
+		// findCycles calls FindCycles expecting it to find all cycles.
+		findCycles := func(g *Graph) [][]string {
+			cycles, truncated := g.FindCycles()
+			So(truncated, ShouldBeFalse)
+			return cycles
+		}
+
+		// complete returns groups all nesting each other (and themselves).
+		complete := func(n int) []*model.AuthGroup {
+			names := make([]string, n)
+			for i := range names {
+				names[i] = fmt.Sprintf("g%d", i)
+			}
+			groups := make([]*model.AuthGroup, n)
+			for i, name := range names {
+				groups[i] = testAuthGroup(name, names...)
+			}
+			return groups
+		}
+
+		Convey("no cycles", func() {
+			g := NewGraph([]*model.AuthGroup{
+				testAuthGroup("a", "b", "c"),
+				testAuthGroup("b", "c"),
+				testAuthGroup("c"),
+			})
+			So(findCycles(g), ShouldBeEmpty)
+		})
+
+		Convey("finds each cycle once", func() {
+			g := NewGraph([]*model.AuthGroup{
+				testAuthGroup("a", "b"),
+				testAuthGroup("b", "c"),
+				testAuthGroup("c", "a"),
+				testAuthGroup("d", "c", "d"),
+			})
+			So(findCycles(g), ShouldResemble, [][]string{
+				{"a", "b", "c"},
+				{"d"},
+			})
+		})
+
+		Convey("finds cycles sharing groups", func() {
+			// The DFS tree a -> b -> c has the back edge c -> a, but a -> c -> a
+			// is a separate cycle.
+			g := NewGraph([]*model.AuthGroup{
+				testAuthGroup("a", "b", "c"),
+				testAuthGroup("b", "c"),
+				testAuthGroup("c", "a"),
+			})
+			So(findCycles(g), ShouldResemble, [][]string{
+				{"a", "b", "c"},
+				{"a", "c"},
+			})
+		})
+
+		Convey("finds cycles not through the smallest group", func() {
+			g := NewGraph([]*model.AuthGroup{
+				testAuthGroup("a", "b"),
+				testAuthGroup("b", "a", "c"),
+				testAuthGroup("c", "d"),
+				testAuthGroup("d", "b", "c"),
+			})
+			So(findCycles(g), ShouldResemble, [][]string{
+				{"a", "b"},
+				{"b", "c", "d"},
+				{"c", "d"},
+			})
+		})
+
+		Convey("enumerates all cycles under the limit", func() {
+			// 7 groups have sum of C(7, k) * (k-1)! cycles of length k.
+			So(findCycles(NewGraph(complete(7))), ShouldHaveLength, 7+21+70+210+504+840+720)
+		})
+
+		Convey("stops at the limit", func() {
+			cycles, truncated := NewGraph(complete(8)).FindCycles()
+			So(truncated, ShouldBeTrue)
+			So(cycles, ShouldHaveLength, maxCycles)
+		})
+	})
+}
+
+func TestFindRedundantMemberships(t *testing.T) {
+	t.Parallel()
+
+	Convey("FindRedundantMemberships", t, func() {
+		g := NewGraph([]*model.AuthGroup{
+			testAuthGroup("root", "user:a@example.com", "user:b@example.com", "mid", "leaf"),
+			testAuthGroup("mid", "inner"),
+			testAuthGroup("inner", "leaf", "user:c@example.com"),
+			testAuthGroup("leaf", "user:a@example.com"),
+			testAuthGroup("solo", "user:a@example.com"),
+		})
+		redundant := g.FindRedundantMemberships()
+		So(redundant, ShouldResembleProto, []*rpcpb.RedundantMembership{
+			{
+				Group:  "root",
+				Member: &rpcpb.Principal{Kind: rpcpb.PrincipalKind_GROUP, Name: "leaf"},
+				Via:    []string{"mid", "inner"},
+			},
+			{
+				Group:  "root",
+				Member: &rpcpb.Principal{Kind: rpcpb.PrincipalKind_IDENTITY, Name: "user:a@example.com"},
+				Via:    []string{"leaf"},
+			},
+		})
+	})
+}
+
+func TestFindOrphanedGroups(t *testing.T) {
+	t.Parallel()
+
+	Convey("FindOrphanedGroups", t, func() {
+		groups := []*model.AuthGroup{
+			testAuthGroup("administrators"),
+			testAuthGroup("bound", "bound-nested"),
+			testAuthGroup("bound-nested"),
+			testAuthGroup("owner"),
+			testAuthGroup("owned"),
+			testAuthGroup("unused", "unused-nested"),
+			testAuthGroup("unused-nested"),
+		}
+		for _, group := range groups {
+			group.Owners = group.ID
+		}
+		groups[4].Owners = "owner"
+
+		realms := []*protocol.Realms{
+			{
+				Realms: []*protocol.Realm{
+					{
+						Name: "p:r",
+						Bindings: []*protocol.Binding{
+							{Principals: []string{"group:bound", "user:x@example.com"}},
+						},
+					},
+				},
+			},
+		}
+
+		g := NewGraph(groups)
+		So(g.FindOrphanedGroups(realms, []string{"administrators"}), ShouldResemble, []string{
+			"owned",
+			"unused",
+			"unused-nested",
+		})
+	})
+}
+
+func TestGetBlastRadius(t *testing.T) {
+	t.Parallel()
+
+	Convey("GetBlastRadius", t, func() {
+		g := NewGraph([]*model.AuthGroup{
+			testAuthGroup("top", "mid"),
+			testAuthGroup("mid", "user:a@example.com"),
+			testAuthGroup("other", "user:a@example.com", "user:*@example.com"),
+			testAuthGroup("globs", "user:*@example.com"),
+		})
+		realms := []*protocol.Realms{
+			{
+				Permissions: []*protocol.Permission{
+					{Name: "perm.a"},
+					{Name: "perm.b"},
+					{Name: "perm.c"},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "p:r1",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{0}, Principals: []string{"group:top"}},
+							{Permissions: []uint32{0, 1}, Principals: []string{"group:mid"}, Conditions: []uint32{0}},
+							{Permissions: []uint32{2}, Principals: []string{"group:globs"}},
+						},
+					},
+					{
+						Name: "p:r2",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{2}, Principals: []string{"user:a@example.com"}, Conditions: []uint32{0}},
+						},
+					},
+				},
+			},
+		}
+
+		Convey("identity", func() {
+			perms, err := g.GetBlastRadius(NodeKey{Kind: Identity, Value: "user:a@example.com"}, realms)
+			So(err, ShouldBeNil)
+			So(perms, ShouldResembleProto, []*rpcpb.AffectedPermission{
+				{Realm: "p:r1", Permission: "perm.a", Via: []string{"mid"}},
+				{Realm: "p:r1", Permission: "perm.b", Via: []string{"mid"}, Conditional: true},
+				{Realm: "p:r2", Permission: "perm.c", Conditional: true},
+			})
+		})
+
+		Convey("group", func() {
+			perms, err := g.GetBlastRadius(NodeKey{Kind: Group, Value: "mid"}, realms)
+			So(err, ShouldBeNil)
+			So(perms, ShouldResembleProto, []*rpcpb.AffectedPermission{
+				{Realm: "p:r1", Permission: "perm.a"},
+				{Realm: "p:r1", Permission: "perm.b", Conditional: true},
+			})
+
+			perms, err = g.GetBlastRadius(NodeKey{Kind: Group, Value: "globs"}, realms)
+			So(err, ShouldBeNil)
+			So(perms, ShouldResembleProto, []*rpcpb.AffectedPermission{
+				{Realm: "p:r1", Permission: "perm.c"},
+			})
+		})
+
+		Convey("unknown group", func() {
+			_, err := g.GetBlastRadius(NodeKey{Kind: Group, Value: "unknown"}, realms)
+			So(err, ShouldEqual, ErrNoSuchGroup)
+		})
+
+		Convey("glob", func() {
+			_, err := g.GetBlastRadius(NodeKey{Kind: Glob, Value: "user:*@example.com"}, realms)
+			So(err, ShouldErrLike, "must be an identity or a group")
+		})
+	})
+}
@@ -350,6 +350,21 @@ type AuthGroup struct {
 
 	// CreatedBy is the email of the user who created this group.
 	CreatedBy string `gae:"created_by"`
+
+	// MembershipExpirations lists members with time-bounded membership.
+	//
+	// Each identity here must also be in Members; it is removed from the group
+	// by the expire-group-memberships cron once its expiry has passed.
+	MembershipExpirations []MembershipExpiration `gae:"membership_expirations,lsp,noindex"`
+}
+
+// MembershipExpiration is the expiry of a time-bounded group membership.
+type MembershipExpiration struct {
+	// Identity is the member, e.g. "user:someone@example.com".
+	Identity string `gae:"identity"`
+
+	// ExpiryTS is the time when the membership expires, in UTC.
+	ExpiryTS time.Time `gae:"expiry_ts"`
 }
 
 type AuthIPAllowlist struct {
@@ -706,6 +721,37 @@ func validateIdentities(ids []string) error {
 	return nil
 }
 
+// validateMembershipExpirations validates that every expiration refers to a
+// distinct member of the group and expires after now.
+func validateMembershipExpirations(members []string, expirations []MembershipExpiration, now time.Time) error {
+	memberSet := stringset.NewFromSlice(members...)
+	seen := stringset.New(len(expirations))
+	for _, exp := range expirations {
+		switch {
+		case !memberSet.Has(exp.Identity):
+			return fmt.Errorf("%q has an expiration but is not a member", exp.Identity)
+		case !seen.Add(exp.Identity):
+			return fmt.Errorf("%q has more than one expiration", exp.Identity)
+		case !exp.ExpiryTS.After(now):
+			return fmt.Errorf("expiration of %q is not in the future", exp.Identity)
+		}
+	}
+	return nil
+}
+
+// pruneMembershipExpirations returns the expirations which refer to one of the
+// given members.
+func pruneMembershipExpirations(expirations []MembershipExpiration, members []string) []MembershipExpiration {
+	memberSet := stringset.NewFromSlice(members...)
+	var pruned []MembershipExpiration
+	for _, exp := range expirations {
+		if memberSet.Has(exp.Identity) {
+			pruned = append(pruned, exp)
+		}
+	}
+	return pruned
+}
+
 // validateGlobs validates that all strings in a slice are parseable as valid identity globs.
 func validateGlobs(globs []string) error {
 	for _, val := range globs {
@@ -846,7 +892,7 @@ func findGroupDependencyCycle(ctx context.Context, group *AuthGroup) ([]string,
 
 // CreateAuthGroup creates a new AuthGroup and writes it to the datastore.
 // Only the following fields will be read from the input:
-// ID, Description, Owners, Members, Globs, Nested.
+// ID, Description, Owners, Members, Globs, Nested, MembershipExpirations.
 func CreateAuthGroup(ctx context.Context, group *AuthGroup, external bool, historicalComment string, dryRun bool) (*AuthGroup, error) {
 	if external {
 		if !IsExternalAuthGroupName(group.ID) {
@@ -866,6 +912,9 @@ func CreateAuthGroup(ctx context.Context, group *AuthGroup, external bool, histo
 	if err := validateGlobs(group.Globs); err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidIdentity, err)
 	}
+	if err := validateMembershipExpirations(group.Members, group.MembershipExpirations, clock.Now(ctx).UTC()); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, err)
+	}
 
 	// Construct a new group so that we don't modify the input.
 	newGroup := makeAuthGroup(ctx, group.ID)
@@ -887,6 +936,7 @@ func CreateAuthGroup(ctx context.Context, group *AuthGroup, external bool, histo
 		newGroup.Members = group.Members
 		newGroup.Globs = group.Globs
 		newGroup.Nested = group.Nested
+		newGroup.MembershipExpirations = group.MembershipExpirations
 
 		// Check that all referenced groups (owning group, nested groups) exist.
 		// It is ok for a new group to have itself as owner.
@@ -969,11 +1019,12 @@ func findReferencingGroups(ctx context.Context, groupName string) (stringset.Set
 // UpdateAuthGroup updates the given auth group.
 // The ID of the AuthGroup is used to determine which group to update.
 // The field mask determines which fields of the AuthGroup will be updated.
-// If the field mask is nil, all fields will be updated.
+// If the field mask is nil, all fields except membership_expirations will be
+// updated. Expirations of members removed by the update are dropped.
 //
 // Possible errors:
 //
-//	ErrInvalidArgument if the field mask provided is invalid.
+//	ErrInvalidArgument if the field mask or membership expirations are invalid.
 //	ErrInvalidIdentity if any identities or globs specified in the update are invalid.
 //	datastore.ErrNoSuchEntity if the specified group does not exist.
 //	ErrPermissionDenied if the caller is not allowed to update the group.
@@ -1056,6 +1107,10 @@ func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fi
 			switch field {
 			case "members":
 				authGroup.Members = groupUpdate.Members
+				authGroup.MembershipExpirations = pruneMembershipExpirations(authGroup.MembershipExpirations, authGroup.Members)
+			case "membership_expirations":
+				// Validated below, once members have been updated too.
+				authGroup.MembershipExpirations = groupUpdate.MembershipExpirations
 			case "globs":
 				authGroup.Globs = groupUpdate.Globs
 			case "nested":
@@ -1097,6 +1152,12 @@ func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fi
 			}
 		}
 
+		if slices.Contains(updateMask.GetPaths(), "membership_expirations") {
+			if err := validateMembershipExpirations(authGroup.Members, authGroup.MembershipExpirations, clock.Now(ctx).UTC()); err != nil {
+				return fmt.Errorf("%w: %s", ErrInvalidArgument, err)
+			}
+		}
+
 		if dryRun {
 			logging.Infof(ctx, "(dry run) updating AuthGroup:\n%+v", authGroup)
 			return nil
@@ -1962,12 +2023,25 @@ func (group *AuthGroup) ToProto(includeMemberships bool) *rpcpb.AuthGroup {
 		authGroup.Members = group.Members
 		authGroup.Globs = group.Globs
 		authGroup.Nested = group.Nested
+		for _, exp := range group.MembershipExpirations {
+			authGroup.MembershipExpirations = append(authGroup.MembershipExpirations, &rpcpb.MembershipExpiration{
+				Identity: exp.Identity,
+				ExpiryTs: timestamppb.New(exp.ExpiryTS),
+			})
+		}
 	}
 	return authGroup
 }
 
 // AuthGroupFromProto allocates a new AuthGroup using the values in the supplied protobuffer.
 func AuthGroupFromProto(ctx context.Context, grouppb *rpcpb.AuthGroup) *AuthGroup {
+	var expirations []MembershipExpiration
+	for _, exp := range grouppb.GetMembershipExpirations() {
+		expirations = append(expirations, MembershipExpiration{
+			Identity: exp.GetIdentity(),
+			ExpiryTS: exp.GetExpiryTs().AsTime(),
+		})
+	}
 	return &AuthGroup{
 		Kind:        "AuthGroup",
 		ID:          grouppb.GetName(),
@@ -1979,6 +2053,8 @@ func AuthGroupFromProto(ctx context.Context, grouppb *rpcpb.AuthGroup) *AuthGrou
 		Owners:      grouppb.GetOwners(),
 		CreatedTS:   grouppb.GetCreatedTs().AsTime(),
 		CreatedBy:   grouppb.GetCreatedBy(),
+
+		MembershipExpirations: expirations,
 	}
 }
 
@@ -2005,6 +2081,14 @@ func (snapshot *AuthDBSnapshot) ToProto() *rpcpb.Snapshot {
 	}
 }
 
+// ToReplicationPushRequest decodes the deflated AuthDB stored in the
+// snapshot.
+//
+// The snapshot must have been fetched with its body.
+func (snapshot *AuthDBSnapshot) ToReplicationPushRequest() (*protocol.ReplicationPushRequest, error) {
+	return processSnapshot(snapshot)
+}
+
 // entityKind is a helper function to construct the Kind field for
 // datastore entities, given the base Kind and dry run flag.
 func entityKind(kind string, dryRun bool) string {
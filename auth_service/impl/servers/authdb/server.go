@@ -23,9 +23,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"go.chromium.org/luci/auth/identity"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/auth/realms"
 	"go.chromium.org/luci/server/router"
 
 	"go.chromium.org/luci/auth_service/api/rpcpb"
@@ -68,6 +71,83 @@ func (*Server) GetSnapshot(ctx context.Context, request *rpcpb.GetSnapshotReques
 	}
 }
 
+// ExplainPermission implements the corresponding RPC method.
+func (*Server) ExplainPermission(ctx context.Context, request *rpcpb.ExplainPermissionRequest) (*rpcpb.PermissionExplanation, error) {
+	ident, err := identity.MakeIdentity(request.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad identity: %s", err)
+	}
+	if err := realms.ValidatePermissionName(request.Permission); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if err := realms.ValidateRealmName(request.Realm, realms.GlobalScope); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	if request.Revision < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Negative revision numbers are not valid")
+	} else if request.Revision == 0 {
+		if request.Revision, err = getLatestRevision(ctx, false); err != nil {
+			logging.Errorf(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	snapshot, err := model.GetAuthDBSnapshot(ctx, request.Revision, false, false)
+	switch {
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "AuthDB revision %v not found", request.Revision)
+	case err != nil:
+		errStr := "unknown error while calling GetAuthDBSnapshot"
+		logging.Errorf(ctx, "%s: %s", errStr, err)
+		return nil, status.Errorf(codes.Internal, errStr)
+	}
+
+	replicationReq, err := snapshot.ToReplicationPushRequest()
+	if err != nil {
+		logging.Errorf(ctx, "failed to decode AuthDB revision %d: %s", request.Revision, err)
+		return nil, status.Errorf(codes.Internal, "failed to decode AuthDB revision %d", request.Revision)
+	}
+
+	exp, err := authdb.ExplainPermission(ctx, replicationReq.AuthDb, ident, request.Permission, request.Realm, request.Attributes)
+	if err != nil {
+		logging.Errorf(ctx, "failed to explain permission: %s", err)
+		return nil, status.Errorf(codes.Internal, "failed to explain permission: %s", err)
+	}
+	return explanationToProto(request.Revision, exp), nil
+}
+
+// explanationToProto converts the permission explanation to its protobuffer
+// equivalent.
+func explanationToProto(rev int64, exp *authdb.PermissionExplanation) *rpcpb.PermissionExplanation {
+	out := &rpcpb.PermissionExplanation{
+		AuthDbRev:    rev,
+		Granted:      exp.Granted,
+		CheckedRealm: exp.CheckedRealm,
+		Notes:        exp.Notes,
+		Bindings:     make([]*rpcpb.BindingExplanation, len(exp.Bindings)),
+	}
+	for i, b := range exp.Bindings {
+		conds := make([]*rpcpb.ConditionExplanation, len(b.Conditions))
+		for j, c := range b.Conditions {
+			conds[j] = &rpcpb.ConditionExplanation{
+				Description: c.Description,
+				Matched:     c.Matched,
+			}
+		}
+		out.Bindings[i] = &rpcpb.BindingExplanation{
+			Conditions:        conds,
+			ConditionsMatched: b.ConditionsMatched,
+			Groups:            b.Groups,
+			Identities:        b.Identities,
+			IdentityListed:    b.IdentityListed,
+			MembershipPath:    b.MembershipPath,
+			Granted:           b.Granted,
+		}
+	}
+	return out
+}
+
 // getLatestRevision is a helper function to set the latest revision number for the GetSnapshotRequest.
 func getLatestRevision(ctx context.Context, dryRun bool) (int64, error) {
 	switch latest, err := model.GetAuthDBSnapshotLatest(ctx, dryRun); {
@@ -27,14 +27,17 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth/service/protocol"
 	"go.chromium.org/luci/server/router"
 
 	"go.chromium.org/luci/auth_service/api/rpcpb"
 	"go.chromium.org/luci/auth_service/impl/model"
+	"go.chromium.org/luci/auth_service/impl/util/zlib"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
@@ -281,3 +284,101 @@ func TestAuthDBServing(t *testing.T) {
 		})
 	})
 }
+
+func TestExplainPermission(t *testing.T) {
+	t.Parallel()
+
+	Convey("ExplainPermission works", t, func() {
+		ctx := memory.Use(context.Background())
+		server := Server{}
+
+		blob, err := proto.Marshal(&protocol.ReplicationPushRequest{
+			AuthDb: &protocol.AuthDB{
+				Groups: []*protocol.AuthGroup{
+					{Name: "outer", Nested: []string{"inner"}},
+					{Name: "inner", Members: []string{"user:someone@example.com"}},
+				},
+				Realms: &protocol.Realms{
+					ApiVersion: 1,
+					Permissions: []*protocol.Permission{
+						{Name: "luci.dev.testing"},
+					},
+					Realms: []*protocol.Realm{
+						{
+							Name: "proj:@root",
+							Bindings: []*protocol.Binding{
+								{
+									Permissions: []uint32{0},
+									Principals:  []string{"group:outer"},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		So(err, ShouldBeNil)
+		deflated, err := zlib.Compress(blob)
+		So(err, ShouldBeNil)
+		So(datastore.Put(ctx,
+			&model.AuthDBSnapshot{
+				Kind:           "AuthDBSnapshot",
+				ID:             5,
+				AuthDBDeflated: deflated,
+			},
+			&model.AuthDBSnapshotLatest{
+				Kind:      "AuthDBSnapshotLatest",
+				ID:        "latest",
+				AuthDBRev: 5,
+			}), ShouldBeNil)
+
+		Convey("Granted via nested groups", func() {
+			exp, err := server.ExplainPermission(ctx, &rpcpb.ExplainPermissionRequest{
+				Identity:   "user:someone@example.com",
+				Permission: "luci.dev.testing",
+				Realm:      "proj:some-realm",
+			})
+			So(err, ShouldBeNil)
+			So(exp, ShouldResembleProto, &rpcpb.PermissionExplanation{
+				AuthDbRev:    5,
+				Granted:      true,
+				CheckedRealm: "proj:@root",
+				Notes: []string{
+					`Realm "proj:some-realm" doesn't exist: falling back to the root realm "proj:@root"`,
+				},
+				Bindings: []*rpcpb.BindingExplanation{
+					{
+						ConditionsMatched: true,
+						Groups:            []string{"outer"},
+						MembershipPath:    []string{"outer", "inner"},
+						Granted:           true,
+					},
+				},
+			})
+		})
+
+		Convey("Bad requests", func() {
+			_, err := server.ExplainPermission(ctx, &rpcpb.ExplainPermissionRequest{
+				Identity:   "not an identity",
+				Permission: "luci.dev.testing",
+				Realm:      "proj:@root",
+			})
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+
+			_, err = server.ExplainPermission(ctx, &rpcpb.ExplainPermissionRequest{
+				Identity:   "user:someone@example.com",
+				Permission: "bad",
+				Realm:      "proj:@root",
+			})
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+
+			_, err = server.ExplainPermission(ctx, &rpcpb.ExplainPermissionRequest{
+				Identity:   "user:someone@example.com",
+				Permission: "luci.dev.testing",
+				Realm:      "proj:@root",
+				Revision:   42,
+			})
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+	})
+}
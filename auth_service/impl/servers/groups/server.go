@@ -17,21 +17,46 @@ package groups
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/auth/service/protocol"
 
 	"go.chromium.org/luci/auth_service/api/rpcpb"
 	"go.chromium.org/luci/auth_service/impl/model"
 	"go.chromium.org/luci/auth_service/impl/model/graph"
 )
 
+const (
+	// defaultAnalysisPageSize is the number of findings returned by
+	// AnalyzeGroupGraph if the page size is unspecified.
+	defaultAnalysisPageSize = 100
+
+	// maxAnalysisPageSize is the maximum number of findings returned by
+	// AnalyzeGroupGraph.
+	maxAnalysisPageSize = 1000
+)
+
+// systemGroups are groups used by Auth Service itself, which are never
+// considered orphaned.
+var systemGroups = []string{
+	model.AdminGroup,
+	model.TrustedServicesGroup,
+	authdb.AuthServiceAccessGroup,
+}
+
 // AuthGroupsProvider is the interface to get all AuthGroup entities.
 type AuthGroupsProvider interface {
 	GetAllAuthGroups(ctx context.Context) ([]*model.AuthGroup, error)
@@ -122,6 +147,8 @@ func (srv *Server) CreateGroup(ctx context.Context, request *rpcpb.CreateGroupRe
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	case errors.Is(err, model.ErrInvalidIdentity):
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInvalidArgument):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return nil, status.Errorf(codes.Internal, "failed to create group %q: %s", request.GetGroup().GetName(), err)
 	}
@@ -174,6 +201,71 @@ func (srv *Server) DeleteGroup(ctx context.Context, request *rpcpb.DeleteGroupRe
 	}
 }
 
+// RequestGroupAccess implements the corresponding RPC method.
+func (srv *Server) RequestGroupAccess(ctx context.Context, request *rpcpb.RequestGroupAccessRequest) (*rpcpb.GroupAccessRequest, error) {
+	name := request.GetName()
+	if err := request.GetDuration().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration: %s", err)
+	}
+	switch req, err := model.CreateGroupAccessRequest(ctx, name, request.GetDuration().AsDuration(), request.GetJustification(), srv.dryRun); {
+	case err == nil:
+		return req.ToProto(), nil
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "no such group %q", name)
+	case errors.Is(err, model.ErrPermissionDenied):
+		return nil, status.Errorf(codes.PermissionDenied, "cannot request access to group %q: %s", name, err)
+	case errors.Is(err, model.ErrAlreadyExists):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrInvalidArgument):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to request access to group %q: %s", name, err)
+	}
+}
+
+// ListGroupAccessRequests implements the corresponding RPC method.
+func (*Server) ListGroupAccessRequests(ctx context.Context, request *rpcpb.ListGroupAccessRequestsRequest) (*rpcpb.ListGroupAccessRequestsResponse, error) {
+	name := request.GetName()
+	switch group, err := model.GetAuthGroup(ctx, name); {
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "no such group %q", name)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to fetch group %q: %s", name, err)
+	case !canCallerModify(ctx, group):
+		return nil, status.Errorf(codes.PermissionDenied, "%s does not have permission to review access requests for group %q", auth.CurrentIdentity(ctx), name)
+	}
+
+	reqs, err := model.GetPendingGroupAccessRequests(ctx, name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch access requests for group %q: %s", name, err)
+	}
+	resp := &rpcpb.ListGroupAccessRequestsResponse{
+		Requests: make([]*rpcpb.GroupAccessRequest, len(reqs)),
+	}
+	for i, req := range reqs {
+		resp.Requests[i] = req.ToProto()
+	}
+	return resp, nil
+}
+
+// ReviewGroupAccessRequest implements the corresponding RPC method.
+func (srv *Server) ReviewGroupAccessRequest(ctx context.Context, request *rpcpb.ReviewGroupAccessRequestRequest) (*rpcpb.GroupAccessRequest, error) {
+	name := request.GetName()
+	id := request.GetId()
+	switch req, err := model.ReviewGroupAccessRequest(ctx, name, id, request.GetApprove(), request.GetComment(), srv.dryRun); {
+	case err == nil:
+		return req.ToProto(), nil
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "no access request %d for group %q", id, name)
+	case errors.Is(err, model.ErrPermissionDenied):
+		return nil, status.Errorf(codes.PermissionDenied, "%s does not have permission to review access request %d for group %q: %s", auth.CurrentIdentity(ctx), id, name, err)
+	case errors.Is(err, model.ErrAccessRequestReviewed):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to review access request %d for group %q: %s", id, name, err)
+	}
+}
+
 // GetExpandedGroup implements the corresponding RPC method.
 //
 // Possible Errors:
@@ -244,6 +336,211 @@ func (srv *Server) GetSubgraph(ctx context.Context, request *rpcpb.GetSubgraphRe
 	return subgraphProto, nil
 }
 
+// AnalyzeGroupGraph implements the corresponding RPC method.
+//
+// Findings are computed from scratch for every page. The page token holds the
+// AuthDB revision the findings were computed at, a hash of the request and
+// the offset of the next finding. A token issued at another revision or for
+// a different request is rejected, since the findings may be different.
+//
+// Possible Errors:
+//
+//	Internal error for datastore access issues.
+//	InvalidArgument error if the analysis, principal or paging is invalid.
+//	NotFound error if the analyzed group is not present in groups graph.
+func (srv *Server) AnalyzeGroupGraph(ctx context.Context, request *rpcpb.AnalyzeGroupGraphRequest) (*rpcpb.AnalyzeGroupGraphResponse, error) {
+	pageSize := int(request.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultAnalysisPageSize
+	case pageSize > maxAnalysisPageSize:
+		pageSize = maxAnalysisPageSize
+	}
+
+	// Findings are only stable within an AuthDB revision.
+	state, err := model.GetReplicationState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Failed to fetch the AuthDB revision: %s", err)
+	}
+	requestHash := analysisRequestHash(request)
+	offset := 0
+	if token := request.GetPageToken(); token != "" {
+		page, err := decodeAnalysisPageToken(token)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+		if page.Request != requestHash {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"the page token was issued for a different request")
+		}
+		if page.AuthDBRev != state.AuthDBRev {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"the groups changed since the page token was issued; "+
+					"restart from the first page")
+		}
+		offset = page.Offset
+	}
+
+	// Get all groups.
+	groups, err := srv.authGroupsProvider.GetAllAuthGroups(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Failed to fetch groups: %s", err)
+	}
+
+	// Build graph from groups.
+	groupsGraph := graph.NewGraph(groups)
+
+	var findings []*rpcpb.GroupGraphFinding
+	truncated := false
+	switch request.GetAnalysis() {
+	case rpcpb.AnalyzeGroupGraphRequest_CYCLES:
+		var cycles [][]string
+		cycles, truncated = groupsGraph.FindCycles()
+		for _, cycle := range cycles {
+			findings = append(findings, &rpcpb.GroupGraphFinding{
+				Finding: &rpcpb.GroupGraphFinding_Cycle{
+					Cycle: &rpcpb.GroupCycle{Groups: cycle},
+				},
+			})
+		}
+	case rpcpb.AnalyzeGroupGraphRequest_REDUNDANT_MEMBERSHIPS:
+		for _, redundant := range groupsGraph.FindRedundantMemberships() {
+			findings = append(findings, &rpcpb.GroupGraphFinding{
+				Finding: &rpcpb.GroupGraphFinding_RedundantMembership{
+					RedundantMembership: redundant,
+				},
+			})
+		}
+	case rpcpb.AnalyzeGroupGraphRequest_ORPHANED_GROUPS:
+		realms, err := getAllProjectRealms(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Failed to fetch realms: %s", err)
+		}
+		referenced := append(append([]string{}, systemGroups...), request.GetReferencedGroups()...)
+		for _, group := range groupsGraph.FindOrphanedGroups(realms, referenced) {
+			findings = append(findings, &rpcpb.GroupGraphFinding{
+				Finding: &rpcpb.GroupGraphFinding_OrphanedGroup{
+					OrphanedGroup: &rpcpb.OrphanedGroup{Group: group},
+				},
+			})
+		}
+	case rpcpb.AnalyzeGroupGraphRequest_BLAST_RADIUS:
+		principal := request.GetPrincipal()
+		switch principal.GetKind() {
+		case rpcpb.PrincipalKind_IDENTITY, rpcpb.PrincipalKind_GROUP:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument,
+				"blast radius requires an identity or a group principal")
+		}
+		key, err := convertPrincipal(principal)
+		if err != nil {
+			return nil, err
+		}
+		realms, err := getAllProjectRealms(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Failed to fetch realms: %s", err)
+		}
+		perms, err := groupsGraph.GetBlastRadius(key, realms)
+		if err != nil {
+			if errors.Is(err, graph.ErrNoSuchGroup) {
+				return nil, status.Errorf(codes.NotFound,
+					"The requested group \"%s\" was not found.", principal.Name)
+			}
+			return nil, status.Errorf(codes.Internal,
+				"Error computing blast radius: %s", err)
+		}
+		for _, perm := range perms {
+			findings = append(findings, &rpcpb.GroupGraphFinding{
+				Finding: &rpcpb.GroupGraphFinding_AffectedPermission{
+					AffectedPermission: perm,
+				},
+			})
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "analysis must be specified")
+	}
+
+	resp := &rpcpb.AnalyzeGroupGraphResponse{Truncated: truncated}
+	if offset < len(findings) {
+		end := min(offset+pageSize, len(findings))
+		resp.Findings = findings[offset:end]
+		if end < len(findings) {
+			resp.NextPageToken = encodeAnalysisPageToken(analysisPageToken{
+				AuthDBRev: state.AuthDBRev,
+				Request:   requestHash,
+				Offset:    end,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// analysisPageToken is a position in AnalyzeGroupGraph findings.
+type analysisPageToken struct {
+	AuthDBRev int64  `json:"r"`
+	Request   string `json:"q"` // see analysisRequestHash
+	Offset    int    `json:"o"`
+}
+
+// analysisRequestHash returns a hash of AnalyzeGroupGraphRequest fields which
+// affect findings, i.e. all fields except paging ones.
+func analysisRequestHash(request *rpcpb.AnalyzeGroupGraphRequest) string {
+	request = proto.Clone(request).(*rpcpb.AnalyzeGroupGraphRequest)
+	request.PageSize = 0
+	request.PageToken = ""
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		panic(err) // impossible
+	}
+	digest := sha256.Sum256(blob)
+	return hex.EncodeToString(digest[:16])
+}
+
+// encodeAnalysisPageToken returns an opaque page token.
+func encodeAnalysisPageToken(page analysisPageToken) string {
+	blob, err := json.Marshal(page)
+	if err != nil {
+		panic(err) // impossible
+	}
+	return base64.RawURLEncoding.EncodeToString(blob)
+}
+
+// decodeAnalysisPageToken is the reverse of encodeAnalysisPageToken.
+func decodeAnalysisPageToken(token string) (page analysisPageToken, err error) {
+	blob, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return page, err
+	}
+	if err := json.Unmarshal(blob, &page); err != nil {
+		return page, err
+	}
+	if page.Offset < 0 {
+		return page, errors.New("negative offset")
+	}
+	return page, nil
+}
+
+// getAllProjectRealms returns the realms of all projects.
+func getAllProjectRealms(ctx context.Context) ([]*protocol.Realms, error) {
+	allProjectRealms, err := model.GetAllAuthProjectRealms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	realms := make([]*protocol.Realms, len(allProjectRealms))
+	for i, projectRealms := range allProjectRealms {
+		if realms[i], err = projectRealms.RealmsToProto(); err != nil {
+			return nil, err
+		}
+	}
+	return realms, nil
+}
+
 // convertPrincipal handles the conversion of rpcpb.Principal -> graph.NodeKey
 func convertPrincipal(p *rpcpb.Principal) (graph.NodeKey, error) {
 	switch p.Kind {
@@ -20,15 +20,19 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/gae/filter/txndefer"
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/auth/service/protocol"
 	"go.chromium.org/luci/server/tq"
 
 	"go.chromium.org/luci/auth_service/api/rpcpb"
@@ -943,4 +947,297 @@ func TestGroupsServer(t *testing.T) {
 			So(err.Error(), ShouldContainSubstring, "not found")
 		})
 	})
+
+	Convey("Group access request RPC calls", t, func() {
+		srv := Server{
+			authGroupsProvider: &CachingGroupsProvider{},
+		}
+		ctx := info.SetImageVersion(memory.Use(context.Background()), "test-version")
+		ctx = clock.Set(ctx, testclock.New(modifiedTime))
+		ctx, _ = tq.TestingContext(txndefer.FilterRDS(ctx), nil)
+		requestorCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity: "user:requestor@example.com",
+		})
+		ownerCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:owner@example.com",
+			IdentityGroups: []string{"owners"},
+		})
+
+		So(datastore.Put(ctx,
+			&model.AuthGroup{
+				ID:                       "test-group",
+				Parent:                   model.RootKey(ctx),
+				Owners:                   "owners",
+				CreatedTS:                createdTime,
+				CreatedBy:                "user:test-user-1@example.com",
+				AuthVersionedEntityMixin: versionedEntity,
+			}), ShouldBeNil)
+
+		request := &rpcpb.RequestGroupAccessRequest{
+			Name:          "test-group",
+			Duration:      durationpb.New(time.Hour),
+			Justification: "debugging",
+		}
+
+		Convey("Group not found", func() {
+			request.Name = "unknown-group"
+			_, err := srv.RequestGroupAccess(requestorCtx, request)
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+
+		Convey("Invalid duration", func() {
+			request.Duration = durationpb.New(365 * 24 * time.Hour)
+			_, err := srv.RequestGroupAccess(requestorCtx, request)
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+		})
+
+		Convey("Request, list and approve", func() {
+			created, err := srv.RequestGroupAccess(requestorCtx, request)
+			So(err, ShouldBeNil)
+			So(created.State, ShouldEqual, rpcpb.GroupAccessRequest_PENDING)
+			So(created.Requestor, ShouldEqual, "user:requestor@example.com")
+
+			_, err = srv.RequestGroupAccess(requestorCtx, request)
+			So(err, ShouldHaveGRPCStatus, codes.AlreadyExists)
+
+			listRequest := &rpcpb.ListGroupAccessRequestsRequest{Name: "test-group"}
+			_, err = srv.ListGroupAccessRequests(requestorCtx, listRequest)
+			So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+
+			listed, err := srv.ListGroupAccessRequests(ownerCtx, listRequest)
+			So(err, ShouldBeNil)
+			So(listed.Requests, ShouldResembleProto, []*rpcpb.GroupAccessRequest{created})
+
+			reviewRequest := &rpcpb.ReviewGroupAccessRequestRequest{
+				Name:    "test-group",
+				Id:      created.Id,
+				Approve: true,
+			}
+			_, err = srv.ReviewGroupAccessRequest(requestorCtx, reviewRequest)
+			So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+
+			reviewed, err := srv.ReviewGroupAccessRequest(ownerCtx, reviewRequest)
+			So(err, ShouldBeNil)
+			So(reviewed.State, ShouldEqual, rpcpb.GroupAccessRequest_APPROVED)
+			So(reviewed.MembershipExpiryTs, ShouldNotBeNil)
+
+			_, err = srv.ReviewGroupAccessRequest(ownerCtx, reviewRequest)
+			So(err, ShouldHaveGRPCStatus, codes.FailedPrecondition)
+
+			group, err := srv.GetGroup(ownerCtx, &rpcpb.GetGroupRequest{Name: "test-group"})
+			So(err, ShouldBeNil)
+			So(group.Members, ShouldResemble, []string{"user:requestor@example.com"})
+			So(group.MembershipExpirations, ShouldResembleProto, []*rpcpb.MembershipExpiration{
+				{
+					Identity: "user:requestor@example.com",
+					ExpiryTs: reviewed.MembershipExpiryTs,
+				},
+			})
+		})
+
+		Convey("Unknown request", func() {
+			_, err := srv.ReviewGroupAccessRequest(ownerCtx, &rpcpb.ReviewGroupAccessRequestRequest{
+				Name: "test-group",
+				Id:   1234,
+			})
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+	})
+
+	Convey("AnalyzeGroupGraph RPC call", t, func() {
+		srv := Server{
+			authGroupsProvider: &CachingGroupsProvider{},
+		}
+		ctx := memory.Use(context.Background())
+
+		realms, err := model.ToStorableRealms(&protocol.Realms{
+			Permissions: []*protocol.Permission{{Name: "luci.dev.testing"}},
+			Realms: []*protocol.Realm{
+				{
+					Name: "p:r",
+					Bindings: []*protocol.Binding{
+						{Permissions: []uint32{0}, Principals: []string{"group:bound"}},
+					},
+				},
+			},
+		})
+		So(err, ShouldBeNil)
+		So(datastore.Put(ctx,
+			&model.AuthGroup{
+				ID:      "bound",
+				Parent:  model.RootKey(ctx),
+				Members: []string{"user:a@example.com"},
+				Nested:  []string{"nested"},
+				Owners:  "bound",
+			},
+			&model.AuthGroup{
+				ID:      "nested",
+				Parent:  model.RootKey(ctx),
+				Members: []string{"user:a@example.com"},
+				Owners:  "nested",
+			},
+			&model.AuthGroup{
+				ID:     "orphan-1",
+				Parent: model.RootKey(ctx),
+				Owners: "orphan-1",
+			},
+			&model.AuthGroup{
+				ID:     "orphan-2",
+				Parent: model.RootKey(ctx),
+				Owners: "orphan-2",
+			},
+			&model.AuthProjectRealms{
+				Kind:   "AuthProjectRealms",
+				ID:     "p",
+				Parent: model.RootKey(ctx),
+				Realms: realms,
+			}), ShouldBeNil)
+		So(putRev(ctx, 123), ShouldBeNil)
+
+		Convey("Analysis must be specified", func() {
+			_, err := srv.AnalyzeGroupGraph(ctx, &rpcpb.AnalyzeGroupGraphRequest{})
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+		})
+
+		Convey("Cycles", func() {
+			resp, err := srv.AnalyzeGroupGraph(ctx, &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_CYCLES,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldBeEmpty)
+			So(resp.Truncated, ShouldBeFalse)
+		})
+
+		Convey("Redundant memberships", func() {
+			resp, err := srv.AnalyzeGroupGraph(ctx, &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_REDUNDANT_MEMBERSHIPS,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldResembleProto, []*rpcpb.GroupGraphFinding{
+				{
+					Finding: &rpcpb.GroupGraphFinding_RedundantMembership{
+						RedundantMembership: &rpcpb.RedundantMembership{
+							Group: "bound",
+							Member: &rpcpb.Principal{
+								Kind: rpcpb.PrincipalKind_IDENTITY,
+								Name: "user:a@example.com",
+							},
+							Via: []string{"nested"},
+						},
+					},
+				},
+			})
+		})
+
+		Convey("Orphaned groups exclude referenced groups", func() {
+			resp, err := srv.AnalyzeGroupGraph(ctx, &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis:         rpcpb.AnalyzeGroupGraphRequest_ORPHANED_GROUPS,
+				ReferencedGroups: []string{"orphan-1"},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldResembleProto, []*rpcpb.GroupGraphFinding{
+				{
+					Finding: &rpcpb.GroupGraphFinding_OrphanedGroup{
+						OrphanedGroup: &rpcpb.OrphanedGroup{Group: "orphan-2"},
+					},
+				},
+			})
+		})
+
+		Convey("Orphaned groups are paged", func() {
+			request := &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_ORPHANED_GROUPS,
+				PageSize: 1,
+			}
+			resp, err := srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldHaveLength, 1)
+			So(resp.Findings[0].GetOrphanedGroup().GetGroup(), ShouldEqual, "orphan-1")
+			So(resp.NextPageToken, ShouldNotBeEmpty)
+
+			request.PageToken = resp.NextPageToken
+			resp, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldHaveLength, 1)
+			So(resp.Findings[0].GetOrphanedGroup().GetGroup(), ShouldEqual, "orphan-2")
+			So(resp.NextPageToken, ShouldBeEmpty)
+
+			request.PageToken = "bad"
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+		})
+
+		Convey("Page tokens for another request are rejected", func() {
+			request := &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_ORPHANED_GROUPS,
+				PageSize: 1,
+			}
+			resp, err := srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.NextPageToken, ShouldNotBeEmpty)
+
+			// A different page size is fine.
+			request.PageToken = resp.NextPageToken
+			request.PageSize = 10
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+
+			request.ReferencedGroups = []string{"orphan-1"}
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument, "different request")
+
+			_, err = srv.AnalyzeGroupGraph(ctx, &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis:  rpcpb.AnalyzeGroupGraphRequest_BLAST_RADIUS,
+				Principal: &rpcpb.Principal{Kind: rpcpb.PrincipalKind_GROUP, Name: "nested"},
+				PageToken: resp.NextPageToken,
+			})
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument, "different request")
+		})
+
+		Convey("Page tokens from another AuthDB revision are rejected", func() {
+			request := &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_ORPHANED_GROUPS,
+				PageSize: 1,
+			}
+			resp, err := srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.NextPageToken, ShouldNotBeEmpty)
+
+			So(putRev(ctx, 124), ShouldBeNil)
+			request.PageToken = resp.NextPageToken
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument, "restart from the first page")
+		})
+
+		Convey("Blast radius", func() {
+			request := &rpcpb.AnalyzeGroupGraphRequest{
+				Analysis: rpcpb.AnalyzeGroupGraphRequest_BLAST_RADIUS,
+				Principal: &rpcpb.Principal{
+					Kind: rpcpb.PrincipalKind_GROUP,
+					Name: "nested",
+				},
+			}
+			resp, err := srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.Findings, ShouldResembleProto, []*rpcpb.GroupGraphFinding{
+				{
+					Finding: &rpcpb.GroupGraphFinding_AffectedPermission{
+						AffectedPermission: &rpcpb.AffectedPermission{
+							Realm:      "p:r",
+							Permission: "luci.dev.testing",
+							Via:        []string{"bound"},
+						},
+					},
+				},
+			})
+
+			request.Principal.Name = "unknown"
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+
+			request.Principal.Kind = rpcpb.PrincipalKind_GLOB
+			_, err = srv.AnalyzeGroupGraph(ctx, request)
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+		})
+	})
 }
@@ -40,6 +40,7 @@ func main() {
 	dryRunCronConfig := model.ParseDryRunEnvVar(model.DryRunCronConfigEnvVar)
 	dryRunCronRealms := model.ParseDryRunEnvVar(model.DryRunCronRealmsEnvVar)
 	dryRunCronStaleAuth := model.ParseDryRunEnvVar(model.DryRunCronStaleAuthEnvVar)
+	dryRunCronMembershipExpiry := model.ParseDryRunEnvVar(model.DryRunCronMembershipExpiryEnvVar)
 
 	// The permissions maintained by the Python version should be used
 	// instead if the update-realms cron is in dry run mode.
@@ -58,6 +59,8 @@ func main() {
 				model.ServiceConfigCronHandler(dryRunCronConfig))
 			cron.RegisterHandler("update-realms",
 				model.RealmsConfigCronHandler(dryRunCronRealms))
+			cron.RegisterHandler("expire-group-memberships",
+				model.ExpireGroupMembershipsCronHandler(dryRunCronMembershipExpiry))
 
 			return nil
 		})
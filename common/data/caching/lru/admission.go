@@ -0,0 +1,185 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	"sync"
+)
+
+// AdmissionPolicy decides whether a new entry should be added to a full cache.
+//
+// Methods are called while the cache lock is held, so they must be fast and
+// must not call the cache.
+type AdmissionPolicy[K comparable] interface {
+	// Record is called on each access to the key, i.e. on each Get and on each
+	// update of the key's value.
+	Record(key K)
+
+	// Admit is called when adding the candidate key requires evicting the
+	// victim key.
+	//
+	// It returns true to evict the victim or false to reject the candidate.
+	Admit(candidate, victim K) bool
+}
+
+// TinyLFU is an AdmissionPolicy which admits a new entry only if it was used
+// more frequently than the entry that would be evicted in its favor.
+//
+// Access frequencies are approximated using a count-min sketch, with
+// a "doorkeeper" bloom filter in front of it that absorbs keys seen only once.
+// Periodically all counters are halved, so the frequencies reflect recent
+// history.
+//
+// This protects frequently used entries from being flushed out of the cache by
+// a scan through many keys that are used once. See "TinyLFU: A Highly Efficient
+// Cache Admission Policy" by Einziger, Friedman and Manes.
+//
+// A TinyLFU must be constructed using NewTinyLFU. It is goroutine-safe, but
+// should not be shared between caches.
+type TinyLFU[K comparable] struct {
+	hash func(K) uint64
+
+	m       sync.Mutex
+	sketch  [tinyLFUDepth][]uint8 // saturating counters
+	door    []uint64              // the doorkeeper bitset
+	mask    uint64                // len(sketch[i]) - 1
+	samples int                   // the number of Record calls since the last reset
+	resetAt int                   // the number of samples that triggers a reset
+}
+
+const (
+	tinyLFUDepth    = 4
+	tinyLFUMaxCount = 15
+	tinyLFUMinWidth = 64
+)
+
+// NewTinyLFU creates a TinyLFU policy for a cache of the given capacity.
+//
+// capacity is the expected number of entries in the cache. It defines the
+// memory used by the policy (a few bytes per entry) and how quickly the
+// frequencies are aged.
+//
+// hash returns a hash of a key. The quality of the hash affects the accuracy
+// of the estimated frequencies. For string keys, use e.g. maphash.String.
+func NewTinyLFU[K comparable](capacity int, hash func(K) uint64) *TinyLFU[K] {
+	width := tinyLFUMinWidth
+	for width < capacity {
+		width *= 2
+	}
+	t := &TinyLFU[K]{
+		hash:    hash,
+		door:    make([]uint64, width/8),
+		mask:    uint64(width - 1),
+		resetAt: 10 * width,
+	}
+	for i := range t.sketch {
+		t.sketch[i] = make([]uint8, width)
+	}
+	return t
+}
+
+// Record implements AdmissionPolicy.
+func (t *TinyLFU[K]) Record(key K) {
+	h := t.hash(key)
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// The first access only sets the doorkeeper bits, so keys used once never
+	// get into the sketch.
+	if !t.doorkeeperAddLocked(h) {
+		for i := range t.sketch {
+			if c := &t.sketch[i][t.slot(h, i)]; *c < tinyLFUMaxCount {
+				*c++
+			}
+		}
+	}
+
+	if t.samples++; t.samples >= t.resetAt {
+		t.resetLocked()
+	}
+}
+
+// Admit implements AdmissionPolicy.
+func (t *TinyLFU[K]) Admit(candidate, victim K) bool {
+	hc, hv := t.hash(candidate), t.hash(victim)
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.estimateLocked(hc) > t.estimateLocked(hv)
+}
+
+// Estimate returns the estimated recent access frequency of the key.
+func (t *TinyLFU[K]) Estimate(key K) int {
+	h := t.hash(key)
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.estimateLocked(h)
+}
+
+// slot returns the index of the counter for the hash in the given sketch row.
+func (t *TinyLFU[K]) slot(h uint64, row int) uint64 {
+	// Derive independent-ish row hashes via double hashing.
+	h2 := (h*0x9e3779b97f4a7c15)>>32 | 1
+	return (h + uint64(row)*h2) & t.mask
+}
+
+// doorkeeperBits returns positions of the hash bits in the doorkeeper.
+func (t *TinyLFU[K]) doorkeeperBits(h uint64) [2]uint64 {
+	bits := uint64(len(t.door)) * 64
+	return [2]uint64{h % bits, (h >> 32) % bits}
+}
+
+// doorkeeperAddLocked adds the hash to the doorkeeper.
+//
+// Returns true if it wasn't there before.
+func (t *TinyLFU[K]) doorkeeperAddLocked(h uint64) (added bool) {
+	for _, b := range t.doorkeeperBits(h) {
+		if t.door[b/64]&(1<<(b%64)) == 0 {
+			t.door[b/64] |= 1 << (b % 64)
+			added = true
+		}
+	}
+	return
+}
+
+// estimateLocked returns the estimated frequency of the hash.
+func (t *TinyLFU[K]) estimateLocked(h uint64) int {
+	est := uint8(tinyLFUMaxCount)
+	for i := range t.sketch {
+		est = min(est, t.sketch[i][t.slot(h, i)])
+	}
+	for _, b := range t.doorkeeperBits(h) {
+		if t.door[b/64]&(1<<(b%64)) == 0 {
+			return int(est)
+		}
+	}
+	return int(est) + 1
+}
+
+// resetLocked ages the frequencies by halving all counters and clearing the
+// doorkeeper.
+func (t *TinyLFU[K]) resetLocked() {
+	for i := range t.sketch {
+		for j := range t.sketch[i] {
+			t.sketch[i][j] /= 2
+		}
+	}
+	clear(t.door)
+	t.samples /= 2
+}
@@ -61,7 +61,11 @@ type Maker[V any] func() (v V, exp time.Duration, err error)
 // that limit, the entries that have have been referenced least recently will be
 // evicted.
 //
-// A Cache must be constructed using the New method.
+// Optionally entries can have a cost (e.g. their size in bytes), in which case
+// the cache also evicts entries to keep their total cost under a budget. See
+// Options.
+//
+// A Cache must be constructed using the New or NewWithOptions method.
 //
 // Cache is safe for concurrent access, using a read-write mutex to allow
 // multiple non-mutating readers (Peek) or only one mutating reader/writer (Get,
@@ -72,6 +76,18 @@ type Cache[K comparable, V any] struct {
 	// cache that may never shrink.
 	maxSize int
 
+	// maxCost, if >0, is the maximum total cost of elements in the LRU.
+	maxCost int64
+
+	// cost returns the cost of an element. Never nil.
+	cost func(K, V) int64
+
+	// admission, if not nil, decides whether new elements are admitted.
+	admission AdmissionPolicy[K]
+
+	// metrics, if not nil, are used to report the cache performance.
+	metrics *cacheMetrics
+
 	// lock is a lock protecting the Cache's members.
 	lock sync.RWMutex
 
@@ -81,6 +97,9 @@ type Cache[K comparable, V any] struct {
 	// to cache require the write lock to be held.
 	cache map[K]*cacheEntry[K, V]
 
+	// weight is the total cost of all elements in the cache.
+	weight int64
+
 	// head is the first element in a linked list of least-recently-used elements.
 	//
 	// Each time an element is used, it is moved to the beginning of the list.
@@ -99,6 +118,7 @@ type cacheEntry[K comparable, V any] struct {
 	k          K
 	v          V
 	expiry     time.Time
+	cost       int64
 	next, prev *cacheEntry[K, V]
 }
 
@@ -108,10 +128,66 @@ type cacheEntry[K comparable, V any] struct {
 // prune LRU elements when adding new ones. Use Prune to reclaim memory occupied
 // by expired elements.
 func New[K comparable, V any](maxSize int) *Cache[K, V] {
-	return &Cache[K, V]{
-		maxSize: maxSize,
-		cache:   make(map[K]*cacheEntry[K, V]),
+	return NewWithOptions(Options[K, V]{MaxSize: maxSize})
+}
+
+// Options are passed to NewWithOptions.
+type Options[K comparable, V any] struct {
+	// MaxSize is the maximum number of entries in the cache.
+	//
+	// If <= 0, the number of entries is not limited.
+	MaxSize int
+
+	// Cost returns the cost of an entry, e.g. its approximate size in bytes.
+	//
+	// It is called whenever an entry is added or its value is replaced, while
+	// the cache lock is held. It must not return negative values. If nil, each
+	// entry costs 1.
+	Cost func(key K, value V) int64
+
+	// MaxCost is the maximum total cost of all entries in the cache.
+	//
+	// When it is exceeded, least recently used entries are evicted. Entries
+	// which cost more than MaxCost are never stored. If <= 0, the total cost is
+	// not limited.
+	MaxCost int64
+
+	// Admission, if set, decides whether a new entry should be added to the
+	// cache when it doesn't fit without evicting some other entries.
+	//
+	// Without it, new entries are always admitted. See TinyLFU for a policy
+	// which protects frequently used entries from being flushed by a scan
+	// through many rarely used keys.
+	Admission AdmissionPolicy[K]
+
+	// Name, if set, enables tsmon metrics for this cache.
+	//
+	// The cache reports the number of hits, misses and evictions as well as
+	// its current weight, using Name as the value of "name" metric field. It
+	// should be unique within the process.
+	Name string
+}
+
+// NewWithOptions creates a new LRU configured by the given options.
+//
+// Without any limits, the LRU cache will have infinite capacity and will never
+// prune LRU elements when adding new ones. Use Prune to reclaim memory occupied
+// by expired elements.
+func NewWithOptions[K comparable, V any](opts Options[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{
+		maxSize:   opts.MaxSize,
+		maxCost:   opts.MaxCost,
+		cost:      opts.Cost,
+		admission: opts.Admission,
+		cache:     make(map[K]*cacheEntry[K, V]),
+	}
+	if c.cost == nil {
+		c.cost = func(K, V) int64 { return 1 }
 	}
+	if opts.Name != "" {
+		c.metrics = &cacheMetrics{name: opts.Name}
+	}
+	return c
 }
 
 // Peek fetches the element associated with the supplied key without updating
@@ -139,6 +215,15 @@ func (c *Cache[K, V]) Peek(ctx context.Context, key K) (V, bool) {
 //
 // Get uses the cache read/write lock.
 func (c *Cache[K, V]) Get(ctx context.Context, key K) (V, bool) {
+	return c.get(ctx, key, true)
+}
+
+// get implements Get.
+//
+// If `record` is false, the access is not reported to metrics and the
+// admission policy. This is used to avoid double counting lookups done by
+// GetOrCreate.
+func (c *Cache[K, V]) get(ctx context.Context, key K, record bool) (v V, ok bool) {
 	now := clock.Now(ctx)
 
 	// We need a Read/Write lock here because if the entry is present, we are
@@ -146,16 +231,23 @@ func (c *Cache[K, V]) Get(ctx context.Context, key K) (V, bool) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	if record {
+		if c.admission != nil {
+			c.admission.Record(key)
+		}
+		defer func() { c.metrics.reportLookup(ctx, ok) }()
+	}
+
 	if ent := c.cache[key]; ent != nil {
 		if !c.hasExpired(now, ent) {
 			c.moveToFrontLocked(ent)
 			return ent.v, true
 		}
 		c.deleteLocked(ent)
+		c.metrics.reportWeight(ctx, c.weight)
 	}
 
-	var zero V
-	return zero, false
+	return
 }
 
 // Put adds a new value to the cache. The value in the cache will be replaced
@@ -196,11 +288,20 @@ func (c *Cache[K, V]) Put(ctx context.Context, key K, value V, exp time.Duration
 //
 // The key will be considered most recently used regardless of whether it was
 // put.
+//
+// A new value may be rejected by the admission policy or because its cost
+// exceeds the cache budget. In that case Mutate returns the generated value and
+// false, and the cache retains no value for the key.
 func (c *Cache[K, V]) Mutate(ctx context.Context, key K, gen Generator[V]) (value V, ok bool) {
 	now := clock.Now(ctx)
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	defer func() { c.metrics.reportWeight(ctx, c.weight) }()
+
+	if c.admission != nil {
+		c.admission.Record(key)
+	}
 
 	ent := c.cache[key]
 
@@ -228,23 +329,39 @@ func (c *Cache[K, V]) Mutate(ctx context.Context, key K, gen Generator[V]) (valu
 		return zero, false
 	}
 
+	cost := c.cost(key, it.Value)
+	if c.maxCost > 0 && cost > c.maxCost {
+		// The value would never fit. Don't evict everything else trying to fit it.
+		if ent != nil {
+			c.deleteLocked(ent)
+		}
+		return it.Value, false
+	}
+
 	// Generate our entry.
 	if ent == nil {
-		// This is a new entry. Put into the map and place at the front in the
-		// linked list.
-		ent = &cacheEntry[K, V]{k: key, v: it.Value}
+		// This is a new entry. If it doesn't fit, ask the admission policy whether
+		// it is worth evicting other entries.
+		if !c.admitLocked(key, cost) {
+			return it.Value, false
+		}
+		// Put into the map and place at the front in the linked list.
+		ent = &cacheEntry[K, V]{k: key, v: it.Value, cost: cost}
 		c.cache[key] = ent
+		c.weight += cost
 		c.pushToFrontLocked(ent)
-		// Because we added a new entry, we need to perform a pruning round.
-		if c.maxSize > 0 {
-			c.pruneSizeLocked(c.maxSize)
-		}
 	} else {
 		// The element already exists. Updates its value and bump it to the front.
+		c.weight += cost - ent.cost
 		ent.v = it.Value
+		ent.cost = cost
 		c.moveToFrontLocked(ent)
 	}
 
+	// The cache may have grown, so we need to perform a pruning round. The
+	// entry itself is at the front, so it will not be pruned.
+	c.evictLocked(ctx)
+
 	// Bump the expiration time.
 	if it.Exp <= 0 {
 		ent.expiry = time.Time{}
@@ -298,8 +415,9 @@ func (c *Cache[K, V]) GetOrCreate(ctx context.Context, key K, fn Maker[V]) (v V,
 
 	// The value is currently not cached, so we will generate it.
 	c.mp.WithMutex(key, func() {
-		// Has the value been cached since we obtained the key's lock?
-		if v, ok = c.Get(ctx, key); ok {
+		// Has the value been cached since we obtained the key's lock? This lookup
+		// is not recorded, the miss was already accounted for above.
+		if v, ok = c.get(ctx, key, false); ok {
 			return
 		}
 
@@ -356,6 +474,7 @@ func (c *Cache[K, V]) Prune(ctx context.Context) {
 	defer c.lock.Unlock()
 
 	c.pruneExpiredLocked(now)
+	c.metrics.reportWeight(ctx, c.weight)
 }
 
 // Reset clears the full contents of the cache.
@@ -366,6 +485,7 @@ func (c *Cache[K, V]) Reset() {
 	defer c.lock.Unlock()
 
 	c.cache = make(map[K]*cacheEntry[K, V])
+	c.weight = 0
 	c.head = nil
 	c.tail = nil
 }
@@ -380,13 +500,55 @@ func (c *Cache[K, V]) Len() (size int) {
 	return len(c.cache)
 }
 
-// pruneSizeLocked prunes LRU elements until it has `size` items or less.
+// Weight returns the total cost of all entries in the cache.
+//
+// If the cache has no cost function, it is the same as Len.
+//
+// Weight uses the cache read lock.
+func (c *Cache[K, V]) Weight() int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.weight
+}
+
+// overLimitLocked returns true if the cache with extra `count` entries of the
+// total `cost` would exceed its limits.
+func (c *Cache[K, V]) overLimitLocked(count int, cost int64) bool {
+	return (c.maxSize > 0 && len(c.cache)+count > c.maxSize) ||
+		(c.maxCost > 0 && c.weight+cost > c.maxCost)
+}
+
+// admitLocked returns true if a new entry with the given key and cost should
+// be added to the cache.
+//
+// The entry is admitted if it fits into the cache as is, or the admission
+// policy agrees to evict all LRU entries that need to be evicted to fit it.
+func (c *Cache[K, V]) admitLocked(key K, cost int64) bool {
+	if c.admission == nil {
+		return true
+	}
+	count := 1
+	for e := c.tail; e != nil && c.overLimitLocked(count, cost); e = e.prev {
+		if !c.admission.Admit(key, e.k) {
+			return false
+		}
+		count--
+		cost -= e.cost
+	}
+	return true
+}
+
+// evictLocked prunes LRU elements until the cache is within its limits.
 //
 // Its write lock must be held by the caller.
-func (c *Cache[K, V]) pruneSizeLocked(size int) {
-	for e := c.tail; e != nil && len(c.cache) > size; e = c.tail {
+func (c *Cache[K, V]) evictLocked(ctx context.Context) {
+	evicted := 0
+	for e := c.tail; e != nil && c.overLimitLocked(0, 0); e = c.tail {
 		c.deleteLocked(e)
+		evicted++
 	}
+	c.metrics.reportEvictions(ctx, evicted)
 }
 
 // pruneExpiredLocked prunes any entries that have expired.
@@ -451,6 +613,7 @@ func (c *Cache[K, V]) moveToFrontLocked(e *cacheEntry[K, V]) {
 // deleteLocked removes the entry from the cache.
 func (c *Cache[K, V]) deleteLocked(e *cacheEntry[K, V]) {
 	delete(c.cache, e.k)
+	c.weight -= e.cost
 
 	if c.head == e {
 		c.head = e.next
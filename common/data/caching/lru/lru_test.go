@@ -16,12 +16,16 @@ package lru
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
 	"sync"
 	"testing"
 	"time"
 
 	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/common/tsmon/types"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -408,6 +412,156 @@ func TestGetOrCreate(t *testing.T) {
 	})
 }
 
+func TestCacheWithCost(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A cache with a cost budget of 10`, t, func() {
+		ctx := context.Background()
+		cache := NewWithOptions(Options[string, string]{
+			Cost:    func(k, v string) int64 { return int64(len(v)) },
+			MaxCost: 10,
+		})
+
+		cache.Put(ctx, "a", "aaa", 0)
+		cache.Put(ctx, "b", "bbb", 0)
+		cache.Put(ctx, "c", "ccc", 0)
+		So(cache.Len(), ShouldEqual, 3)
+		So(cache.Weight(), ShouldEqual, 9)
+
+		Convey(`Evicts LRU entries to fit a new one`, func() {
+			cache.Get(ctx, "a")
+			cache.Put(ctx, "d", "dddd", 0)
+			So(cache.Weight(), ShouldEqual, 10)
+			_, has := cache.Peek(ctx, "b")
+			So(has, ShouldBeFalse)
+			_, has = cache.Peek(ctx, "a")
+			So(has, ShouldBeTrue)
+		})
+
+		Convey(`Evicts LRU entries when an existing entry grows`, func() {
+			cache.Put(ctx, "c", "cccccccc", 0)
+			So(cache.Len(), ShouldEqual, 1)
+			So(cache.Weight(), ShouldEqual, 8)
+		})
+
+		Convey(`Rejects entries above the budget`, func() {
+			v, ok := cache.Mutate(ctx, "big", func(*Item[string]) *Item[string] {
+				return &Item[string]{Value: "0123456789a"}
+			})
+			So(ok, ShouldBeFalse)
+			So(v, ShouldEqual, "0123456789a")
+			So(cache.Len(), ShouldEqual, 3)
+
+			cache.Put(ctx, "a", "0123456789a", 0)
+			_, has := cache.Peek(ctx, "a")
+			So(has, ShouldBeFalse)
+			So(cache.Weight(), ShouldEqual, 6)
+		})
+
+		Convey(`Tracks the weight on removal`, func() {
+			cache.Remove("a")
+			So(cache.Weight(), ShouldEqual, 6)
+			cache.Reset()
+			So(cache.Weight(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestTinyLFU(t *testing.T) {
+	t.Parallel()
+
+	Convey(`TinyLFU`, t, func() {
+		// Use a fixed hash function to make sketch collisions deterministic.
+		hash := func(k string) uint64 {
+			h := fnv.New64a()
+			h.Write([]byte(k))
+			return h.Sum64()
+		}
+
+		Convey(`Estimates frequencies`, func() {
+			p := NewTinyLFU(100, hash)
+			So(p.Estimate("a"), ShouldEqual, 0)
+			for i := 0; i < 5; i++ {
+				p.Record("a")
+			}
+			p.Record("b")
+			So(p.Estimate("a"), ShouldEqual, 5)
+			So(p.Estimate("b"), ShouldEqual, 1)
+			So(p.Admit("a", "b"), ShouldBeTrue)
+			So(p.Admit("b", "a"), ShouldBeFalse)
+			So(p.Admit("b", "b"), ShouldBeFalse)
+		})
+
+		Convey(`Ages frequencies`, func() {
+			p := NewTinyLFU(1, hash)
+			for i := 0; i < 10; i++ {
+				p.Record("a")
+			}
+			So(p.Estimate("a"), ShouldEqual, 10)
+			for i := 0; i < p.resetAt; i++ {
+				p.Record(fmt.Sprintf("other %d", i))
+			}
+			So(p.Estimate("a"), ShouldBeLessThan, 10)
+		})
+
+		Convey(`Protects the cache from scans`, func() {
+			scan := func(cache *Cache[string, string]) (hot int) {
+				ctx := context.Background()
+				maker := func() (string, time.Duration, error) { return "v", 0, nil }
+
+				// Hot keys are used all the time, while cold keys are used once.
+				for i := 0; i < 1000; i++ {
+					if i%20 == 0 {
+						for j := 0; j < 10; j++ {
+							cache.GetOrCreate(ctx, fmt.Sprintf("hot %d", j), maker)
+						}
+					}
+					cache.GetOrCreate(ctx, fmt.Sprintf("cold %d", i), maker)
+				}
+				for i := 0; i < 10; i++ {
+					if _, ok := cache.Peek(ctx, fmt.Sprintf("hot %d", i)); ok {
+						hot++
+					}
+				}
+				return
+			}
+
+			So(scan(New[string, string](10)), ShouldEqual, 0)
+			So(scan(NewWithOptions(Options[string, string]{
+				MaxSize:   10,
+				Admission: NewTinyLFU(10, hash),
+			})), ShouldEqual, 10)
+		})
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Reports metrics`, t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		cache := NewWithOptions(Options[string, string]{
+			MaxSize: 2,
+			Name:    "test",
+		})
+
+		value := func(m types.Metric) any {
+			return tsmon.GetState(ctx).Store().Get(ctx, m, time.Time{}, []any{"test"})
+		}
+
+		cache.Put(ctx, "a", "av", 0)
+		cache.Get(ctx, "a")
+		cache.Get(ctx, "b")
+		cache.GetOrCreate(ctx, "c", func() (string, time.Duration, error) { return "cv", 0, nil })
+		cache.Put(ctx, "d", "dv", 0)
+
+		So(value(cacheHits), ShouldEqual, 1)
+		So(value(cacheMisses), ShouldEqual, 2)
+		So(value(cacheEvictions), ShouldEqual, 1)
+		So(value(cacheWeight), ShouldEqual, 2)
+	})
+}
+
 func shouldHaveValues(actual any, expected ...any) string {
 	cache := actual.(*Cache[string, string])
 
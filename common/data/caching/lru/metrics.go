@@ -0,0 +1,81 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+)
+
+var (
+	cacheHits = metric.NewCounter(
+		"luci/lru/hits",
+		"Number of lookups that found a value in the cache",
+		nil,
+		field.String("name"), // matches Options.Name
+	)
+
+	cacheMisses = metric.NewCounter(
+		"luci/lru/misses",
+		"Number of lookups that didn't find a value in the cache",
+		nil,
+		field.String("name"), // matches Options.Name
+	)
+
+	cacheEvictions = metric.NewCounter(
+		"luci/lru/evictions",
+		"Number of entries evicted to keep the cache within its limits",
+		nil,
+		field.String("name"), // matches Options.Name
+	)
+
+	cacheWeight = metric.NewInt(
+		"luci/lru/weight",
+		"Total cost of entries in the cache (their number if there's no cost function)",
+		nil,
+		field.String("name"), // matches Options.Name
+	)
+)
+
+// cacheMetrics reports metrics of a single cache.
+//
+// All methods are noop if the receiver is nil.
+type cacheMetrics struct {
+	name string
+}
+
+func (m *cacheMetrics) reportLookup(ctx context.Context, hit bool) {
+	switch {
+	case m == nil:
+	case hit:
+		cacheHits.Add(ctx, 1, m.name)
+	default:
+		cacheMisses.Add(ctx, 1, m.name)
+	}
+}
+
+func (m *cacheMetrics) reportEvictions(ctx context.Context, n int) {
+	if m != nil && n > 0 {
+		cacheEvictions.Add(ctx, int64(n), m.name)
+	}
+}
+
+func (m *cacheMetrics) reportWeight(ctx context.Context, weight int64) {
+	if m != nil {
+		cacheWeight.Set(ctx, weight, m.name)
+	}
+}
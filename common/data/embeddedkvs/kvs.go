@@ -15,7 +15,12 @@
 package embeddedkvs
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	"golang.org/x/sync/errgroup"
@@ -52,14 +57,68 @@ func (k *KVS) Close() error {
 //
 // This should be called in parallel for efficient storing.
 func (k *KVS) Set(key string, value []byte) error {
+	return k.SetWithTTL(key, value, 0)
+}
+
+// SetWithTTL sets key/value to storage, with the value expiring after the
+// given duration.
+//
+// Expired keys are invisible to all reads. If ttl is <= 0, the value never
+// expires.
+func (k *KVS) SetWithTTL(key string, value []byte, ttl time.Duration) error {
 	if err := k.db.Update(func(txn *badger.Txn) error {
-		return txn.Set([]byte(key), value)
+		return txn.SetEntry(newEntry(key, value, ttl))
 	}); err != nil {
 		return errors.Annotate(err, "failed to put %s", key).Err()
 	}
 	return nil
 }
 
+// CompareAndSet atomically sets the value of the key if its current value is
+// equal to `old`.
+//
+// A nil `old` means that the key must not exist (note that an empty non-nil
+// slice matches an existing empty value). ttl is handled as in SetWithTTL.
+//
+// Returns true if the value was set.
+func (k *KVS) CompareAndSet(key string, old, value []byte, ttl time.Duration) (swapped bool, err error) {
+	for {
+		err = k.db.Update(func(txn *badger.Txn) error {
+			swapped = false
+			item, err := txn.Get([]byte(key))
+			switch {
+			case err == badger.ErrKeyNotFound:
+				if old != nil {
+					return nil
+				}
+			case err != nil:
+				return err
+			case old == nil:
+				return nil
+			default:
+				cur, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(cur, old) {
+					return nil
+				}
+			}
+			swapped = true
+			return txn.SetEntry(newEntry(key, value, ttl))
+		})
+		// A conflict means the key was modified concurrently. Compare again with
+		// the fresh value.
+		if err != badger.ErrConflict {
+			break
+		}
+	}
+	if err != nil {
+		return false, errors.Annotate(err, "failed to compare-and-set %s", key).Err()
+	}
+	return swapped, nil
+}
+
 // GetMulti calls |fn| in parallel for cached entries.
 func (k *KVS) GetMulti(ctx context.Context, keys []string, fn func(key string, value []byte) error) error {
 	if err := k.db.View(func(txn *badger.Txn) error {
@@ -109,6 +168,68 @@ func (k *KVS) SetMulti(fn func(set func(key string, value []byte) error) error)
 	return nil
 }
 
+// ScanOptions are passed to Scan.
+type ScanOptions struct {
+	// Prefix limits the scan to keys with this prefix.
+	Prefix string
+
+	// StartAfter, if set, makes the scan start after this key.
+	//
+	// Use the cursor returned by the previous Scan call to get the next page.
+	StartAfter string
+
+	// Limit is the maximum number of pairs to visit. If <= 0, no limit.
+	Limit int
+}
+
+// Scan executes a function for each key/value pair matching the options, in
+// the lexicographical order of keys.
+//
+// Returns a cursor to pass as StartAfter to get the next page, or "" if there
+// are no more pairs.
+func (k *KVS) Scan(opts ScanOptions, fn func(key string, value []byte) error) (cursor string, err error) {
+	err = k.db.View(func(txn *badger.Txn) error {
+		itOpts := badger.DefaultIteratorOptions
+		itOpts.Prefix = []byte(opts.Prefix)
+		if opts.Limit > 0 && opts.Limit < itOpts.PrefetchSize {
+			itOpts.PrefetchSize = opts.Limit
+		}
+		it := txn.NewIterator(itOpts)
+		defer it.Close()
+
+		start := opts.Prefix
+		if opts.StartAfter > start {
+			start = opts.StartAfter
+		}
+		it.Seek([]byte(start))
+		if it.ValidForPrefix(itOpts.Prefix) && string(it.Item().Key()) == opts.StartAfter {
+			it.Next()
+		}
+
+		count := 0
+		for ; it.ValidForPrefix(itOpts.Prefix); it.Next() {
+			item := it.Item()
+			if opts.Limit > 0 && count == opts.Limit {
+				// There's more.
+				return nil
+			}
+			cursor = string(item.Key())
+			count++
+			if err := item.Value(func(val []byte) error {
+				return fn(cursor, val)
+			}); err != nil {
+				return err
+			}
+		}
+		cursor = ""
+		return nil
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to scan").Err()
+	}
+	return cursor, nil
+}
+
 // ForEach executes a function for each key/value pair in KVS.
 func (k *KVS) ForEach(fn func(key string, value []byte) error) error {
 	err := k.db.View(func(txn *badger.Txn) error {
@@ -131,3 +252,72 @@ func (k *KVS) ForEach(fn func(key string, value []byte) error) error {
 	}
 	return nil
 }
+
+// Export writes a consistent snapshot of all key/value pairs to w.
+//
+// Unexpired TTLs are preserved. The snapshot can be loaded with Import.
+func (k *KVS) Export(w io.Writer) error {
+	if _, err := k.db.Backup(w, 0); err != nil {
+		return errors.Annotate(err, "failed to export").Err()
+	}
+	return nil
+}
+
+// Import loads key/value pairs from a snapshot written by Export.
+//
+// Existing keys are overwritten, other keys are kept. It should not be called
+// concurrently with other writes.
+func (k *KVS) Import(r io.Reader) error {
+	if err := k.db.Load(r, importMaxPendingWrites); err != nil {
+		return errors.Annotate(err, "failed to import").Err()
+	}
+	return nil
+}
+
+// ExportFile writes a snapshot of all key/value pairs to a file.
+//
+// The file is replaced atomically.
+func (k *KVS) ExportFile(path string) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Annotate(err, "failed to create a temp file").Err()
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	if err := k.Export(f); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Annotate(err, "failed to close %s", f.Name()).Err()
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return errors.Annotate(err, "failed to rename %s", f.Name()).Err()
+	}
+	return nil
+}
+
+// ImportFile loads a snapshot written by ExportFile.
+func (k *KVS) ImportFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Annotate(err, "failed to open %s", path).Err()
+	}
+	defer f.Close()
+	return k.Import(f)
+}
+
+// importMaxPendingWrites is the number of writes Import may have in flight.
+const importMaxPendingWrites = 256
+
+// newEntry returns a badger entry with an optional TTL.
+func newEntry(key string, value []byte, ttl time.Duration) *badger.Entry {
+	e := badger.NewEntry([]byte(key), value)
+	if ttl > 0 {
+		e = e.WithTTL(ttl)
+	}
+	return e
+}
@@ -18,8 +18,10 @@ import (
 	"context"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -72,3 +74,207 @@ func TestCache(t *testing.T) {
 		So(k.Close(), ShouldBeNil)
 	})
 }
+
+func TestTTL(t *testing.T) {
+	t.Parallel()
+
+	Convey("ttl", t, func() {
+		k, err := New(context.Background(), filepath.Join(t.TempDir(), "db"))
+		So(err, ShouldBeNil)
+		defer k.Close()
+
+		So(k.SetWithTTL("short", []byte("v"), time.Second), ShouldBeNil)
+		So(k.SetWithTTL("long", []byte("v"), time.Hour), ShouldBeNil)
+		So(k.Set("forever", []byte("v")), ShouldBeNil)
+
+		keys := func() (keys []string) {
+			So(k.ForEach(func(key string, value []byte) error {
+				keys = append(keys, key)
+				return nil
+			}), ShouldBeNil)
+			return
+		}
+		So(keys(), ShouldResemble, []string{"forever", "long", "short"})
+
+		// Badger TTLs have a second granularity.
+		time.Sleep(2 * time.Second)
+		So(keys(), ShouldResemble, []string{"forever", "long"})
+	})
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	Convey("scan", t, func() {
+		k, err := New(context.Background(), filepath.Join(t.TempDir(), "db"))
+		So(err, ShouldBeNil)
+		defer k.Close()
+
+		So(k.SetMulti(func(set func(key string, value []byte) error) error {
+			for _, key := range []string{"a", "b/1", "b/2", "b/3", "b/4", "b/5", "c"} {
+				So(set(key, []byte(key)), ShouldBeNil)
+			}
+			return nil
+		}), ShouldBeNil)
+
+		scan := func(opts ScanOptions) (keys []string, cursor string) {
+			cursor, err := k.Scan(opts, func(key string, value []byte) error {
+				So(string(value), ShouldEqual, key)
+				keys = append(keys, key)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			return keys, cursor
+		}
+
+		Convey("all", func() {
+			keys, cursor := scan(ScanOptions{})
+			So(keys, ShouldHaveLength, 7)
+			So(cursor, ShouldEqual, "")
+		})
+
+		Convey("prefix", func() {
+			keys, cursor := scan(ScanOptions{Prefix: "b/"})
+			So(keys, ShouldResemble, []string{"b/1", "b/2", "b/3", "b/4", "b/5"})
+			So(cursor, ShouldEqual, "")
+		})
+
+		Convey("pages", func() {
+			keys, cursor := scan(ScanOptions{Prefix: "b/", Limit: 2})
+			So(keys, ShouldResemble, []string{"b/1", "b/2"})
+			So(cursor, ShouldEqual, "b/2")
+
+			keys, cursor = scan(ScanOptions{Prefix: "b/", Limit: 2, StartAfter: cursor})
+			So(keys, ShouldResemble, []string{"b/3", "b/4"})
+			So(cursor, ShouldEqual, "b/4")
+
+			keys, cursor = scan(ScanOptions{Prefix: "b/", Limit: 2, StartAfter: cursor})
+			So(keys, ShouldResemble, []string{"b/5"})
+			So(cursor, ShouldEqual, "")
+		})
+
+		Convey("exact last page", func() {
+			keys, cursor := scan(ScanOptions{Prefix: "b/", Limit: 5})
+			So(keys, ShouldHaveLength, 5)
+			So(cursor, ShouldEqual, "")
+		})
+
+		Convey("start after a missing key", func() {
+			keys, _ := scan(ScanOptions{Prefix: "b/", StartAfter: "b/25"})
+			So(keys, ShouldResemble, []string{"b/3", "b/4", "b/5"})
+
+			keys, _ = scan(ScanOptions{Prefix: "b/", StartAfter: "a"})
+			So(keys, ShouldHaveLength, 5)
+		})
+	})
+}
+
+func TestCompareAndSet(t *testing.T) {
+	t.Parallel()
+
+	Convey("compare-and-set", t, func() {
+		k, err := New(context.Background(), filepath.Join(t.TempDir(), "db"))
+		So(err, ShouldBeNil)
+		defer k.Close()
+
+		read := func(key string) (val []byte, err error) {
+			err = k.GetMulti(context.Background(), []string{key}, func(_ string, value []byte) error {
+				val = append([]byte{}, value...)
+				return nil
+			})
+			return
+		}
+		get := func(key string) []byte {
+			val, err := read(key)
+			So(err, ShouldBeNil)
+			return val
+		}
+
+		ok, err := k.CompareAndSet("key", nil, []byte("v1"), 0)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+		So(get("key"), ShouldResemble, []byte("v1"))
+
+		ok, err = k.CompareAndSet("key", nil, []byte("v2"), 0)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeFalse)
+
+		ok, err = k.CompareAndSet("key", []byte("wrong"), []byte("v2"), 0)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeFalse)
+		So(get("key"), ShouldResemble, []byte("v1"))
+
+		ok, err = k.CompareAndSet("key", []byte("v1"), []byte("v2"), 0)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+		So(get("key"), ShouldResemble, []byte("v2"))
+
+		ok, err = k.CompareAndSet("missing", []byte("v1"), []byte("v2"), 0)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeFalse)
+		So(get("missing"), ShouldBeNil)
+
+		Convey("concurrent increments", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						cur, err := read("counter")
+						if err != nil {
+							return
+						}
+						n := 0
+						if cur != nil {
+							n, _ = strconv.Atoi(string(cur))
+						}
+						if ok, err := k.CompareAndSet("counter", cur, []byte(strconv.Itoa(n+1)), 0); err != nil || ok {
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			So(get("counter"), ShouldResemble, []byte("10"))
+		})
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	Convey("snapshot", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+
+		src, err := New(ctx, filepath.Join(dir, "src"))
+		So(err, ShouldBeNil)
+		defer src.Close()
+		So(src.Set("key1", []byte("value1")), ShouldBeNil)
+		So(src.SetWithTTL("key2", []byte("value2"), time.Hour), ShouldBeNil)
+
+		snapshot := filepath.Join(dir, "snapshot")
+		So(src.ExportFile(snapshot), ShouldBeNil)
+
+		dst, err := New(ctx, filepath.Join(dir, "dst"))
+		So(err, ShouldBeNil)
+		defer dst.Close()
+		So(dst.Set("key1", []byte("old")), ShouldBeNil)
+		So(dst.Set("key3", []byte("value3")), ShouldBeNil)
+		So(dst.ImportFile(snapshot), ShouldBeNil)
+
+		got := map[string]string{}
+		So(dst.ForEach(func(key string, value []byte) error {
+			got[key] = string(value)
+			return nil
+		}), ShouldBeNil)
+		So(got, ShouldResemble, map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+			"key3": "value3",
+		})
+
+		So(dst.ImportFile(filepath.Join(dir, "missing")), ShouldNotBeNil)
+	})
+}
@@ -14,10 +14,36 @@
 
 // Package recordio implements a basic RecordIO reader and writer.
 //
+// # Format v1
+//
 // Each RecordIO frame begins with a Uvarint (
 // http://golang.org/pkg/encoding/binary/#Uvarint) containing the size of the
 // frame, followed by that many bytes of frame data.
 //
 // The frame protocol does not handle data integrity; that is left to the
 // outer protocol or medium which uses the frame.
+//
+// This format is written by NewWriter and WriteFrame.
+//
+// # Format v2
+//
+// A v2 stream starts with a header which is not a valid v1 frame header, so
+// Reader can tell the formats apart. The header is followed by a sequence of
+// blocks, the index block and the trailer.
+//
+// Each block is a block type byte, followed by Uvarints with the number of
+// entries in the block, the size of uncompressed block data and the size of
+// stored (possibly zstd-compressed) block data, followed by stored data and
+// its little-endian CRC32C (Castagnoli) checksum.
+//
+// Uncompressed data of a data block is a sequence of frames. Each frame is a
+// Uvarint with the size of the frame, the frame data and its little-endian
+// CRC32C checksum.
+//
+// The index block lists the number of the first frame and the offset of each
+// data block. The trailer contains the little-endian 64-bit offset of the index
+// block followed by a fixed magic string. It allows IndexedReader to access
+// frames by their number without reading the whole stream.
+//
+// This format is written by NewWriterV2.
 package recordio
@@ -0,0 +1,165 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recordio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// IndexedReader provides random access to frames of a complete v2 stream, i.e.
+// one written by a WriterV2 which was closed.
+//
+// It is goroutine-safe.
+type IndexedReader struct {
+	r           io.ReaderAt
+	maxSize     int64
+	index       []v2IndexEntry
+	indexOffset int64
+	frames      int64
+
+	m           sync.Mutex
+	cachedBlock int      // the number of the cached block or -1
+	cached      [][]byte // frames of the cached block
+}
+
+// NewIndexedReader reads the index of a v2 stream of the given size.
+//
+// maxSize is the maximum size of a frame, as in NewReader.
+func NewIndexedReader(r io.ReaderAt, size, maxSize int64) (*IndexedReader, error) {
+	if size < int64(len(v2Magic)+v2TrailerSize) {
+		return nil, fmt.Errorf("%w: too small for a v2 stream", ErrCorrupted)
+	}
+
+	magic := make([]byte, len(v2Magic))
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, v2Magic) {
+		return nil, fmt.Errorf("%w: not a v2 stream", ErrCorrupted)
+	}
+
+	trailer := make([]byte, v2TrailerSize)
+	if _, err := r.ReadAt(trailer, size-v2TrailerSize); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(trailer[8:], v2TailMagic) {
+		return nil, fmt.Errorf("%w: no index (was the stream closed?)", ErrCorrupted)
+	}
+	indexOffset := int64(binary.LittleEndian.Uint64(trailer))
+	indexEnd := size - v2TrailerSize
+	if indexOffset < int64(len(v2Magic)) || indexOffset >= indexEnd {
+		return nil, fmt.Errorf("%w: bad index offset", ErrCorrupted)
+	}
+
+	br := bufio.NewReader(io.NewSectionReader(r, indexOffset, indexEnd-indexOffset))
+	hdr, err := readBlockHeader(br, 0)
+	switch {
+	case err != nil:
+		return nil, noEOF(err)
+	case hdr.typ != blockIndex:
+		return nil, fmt.Errorf("%w: not an index block", ErrCorrupted)
+	case hdr.storedLen > uint64(indexEnd-indexOffset):
+		return nil, fmt.Errorf("%w: index exceeds the stream", ErrCorrupted)
+	}
+	blk, err := readBlockBody(br, hdr)
+	if err != nil {
+		return nil, err
+	}
+	index, frames, err := blk.index()
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range index {
+		if e.offset < int64(len(v2Magic)) || e.offset >= indexOffset ||
+			(i > 0 && e.offset <= index[i-1].offset) ||
+			e.firstRecord >= frames {
+			return nil, fmt.Errorf("%w: bad index entry", ErrCorrupted)
+		}
+	}
+
+	return &IndexedReader{
+		r:           r,
+		maxSize:     maxSize,
+		index:       index,
+		indexOffset: indexOffset,
+		frames:      frames,
+		cachedBlock: -1,
+	}, nil
+}
+
+// Len returns the number of frames in the stream.
+func (r *IndexedReader) Len() int64 {
+	return r.frames
+}
+
+// ReadFrame returns the contents of the frame with the given number.
+//
+// Frames are numbered from 0.
+func (r *IndexedReader) ReadFrame(n int64) ([]byte, error) {
+	if n < 0 || n >= r.frames {
+		return nil, fmt.Errorf("recordio: frame %d is out of range [0, %d)", n, r.frames)
+	}
+
+	// Find the last block starting at or before n.
+	b := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].firstRecord > n
+	}) - 1
+	if b < 0 {
+		return nil, fmt.Errorf("%w: frame %d is not indexed", ErrCorrupted, n)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.cachedBlock != b {
+		frames, err := r.readBlockFrames(b)
+		if err != nil {
+			return nil, err
+		}
+		r.cachedBlock = b
+		r.cached = frames
+	}
+
+	idx := n - r.index[b].firstRecord
+	if idx >= int64(len(r.cached)) {
+		return nil, fmt.Errorf("%w: frame %d is not in its block", ErrCorrupted, n)
+	}
+	return append([]byte(nil), r.cached[idx]...), nil
+}
+
+// readBlockFrames reads frames of the data block with the given number.
+func (r *IndexedReader) readBlockFrames(b int) ([][]byte, error) {
+	end := r.indexOffset
+	if b+1 < len(r.index) {
+		end = r.index[b+1].offset
+	}
+	start := r.index[b].offset
+
+	br := bufio.NewReader(io.NewSectionReader(r.r, start, end-start))
+	blk, err := readBlock(br, blockLimit(r.maxSize))
+	switch {
+	case err != nil:
+		return nil, noEOF(err)
+	case blk.typ == blockIndex:
+		return nil, fmt.Errorf("%w: not a data block", ErrCorrupted)
+	}
+	return blk.frames(r.maxSize)
+}
@@ -26,6 +26,9 @@ import (
 var ErrFrameTooLarge = fmt.Errorf("frame: frame size exceeds maximum")
 
 // Reader reads individual frames from a frame-formatted input Reader.
+//
+// It detects the format of the input (v1 or v2, see the package doc) when the
+// first frame is read.
 type Reader interface {
 	// ReadFrame reads the next frame, returning the frame's size and an io.Reader
 	// for that frame's data. The io.Reader is restricted such that it cannot read
@@ -49,6 +52,15 @@ type reader struct {
 	io.ByteReader
 
 	maxSize int64
+
+	detected bool     // true if the format of the input was checked
+	v2       *v2State // non-nil if the input is in the v2 format
+}
+
+// v2State is the state of a reader of the v2 format.
+type v2State struct {
+	frames [][]byte // remaining frames of the current block
+	done   bool     // true if the index block was reached
 }
 
 // NewReader creates a new Reader which reads frame data from the
@@ -69,6 +81,21 @@ func NewReader(r io.Reader, maxSize int64) Reader {
 }
 
 func (r *reader) ReadFrame() (int64, *io.LimitedReader, error) {
+	if !r.detected {
+		r.detect()
+	}
+	if r.v2 != nil {
+		frame, err := r.nextV2Frame()
+		if err != nil {
+			return 0, nil, err
+		}
+		lr := &io.LimitedReader{
+			R: bytes.NewReader(frame),
+			N: int64(len(frame)),
+		}
+		return int64(len(frame)), lr, nil
+	}
+
 	// Read the frame size.
 	count, err := binary.ReadUvarint(r)
 	if err != nil {
@@ -87,6 +114,17 @@ func (r *reader) ReadFrame() (int64, *io.LimitedReader, error) {
 }
 
 func (r *reader) ReadFrameAll() ([]byte, error) {
+	if !r.detected {
+		r.detect()
+	}
+	if r.v2 != nil {
+		frame, err := r.nextV2Frame()
+		if err != nil || len(frame) == 0 {
+			return nil, err
+		}
+		return frame, nil
+	}
+
 	count, fr, err := r.ReadFrame()
 	if err != nil {
 		return nil, err
@@ -102,6 +140,77 @@ func (r *reader) ReadFrameAll() ([]byte, error) {
 	return data, nil
 }
 
+// detect checks whether the input is in the v2 format by consuming the v2
+// stream header.
+//
+// If the input doesn't start with the header, the consumed bytes are put back
+// to be read as a v1 frame header. Since the v2 header starts with an invalid
+// v1 frame header, this never consumes more bytes than reading a v1 frame
+// header would.
+func (r *reader) detect() {
+	r.detected = true
+	for i := range v2Magic {
+		b, err := r.ByteReader.ReadByte()
+		if err != nil || b != v2Magic[i] {
+			consumed := append([]byte(nil), v2Magic[:i]...)
+			if err == nil {
+				consumed = append(consumed, b)
+			}
+			if len(consumed) > 0 {
+				r.ByteReader = &prefixByteReader{prefix: consumed, ByteReader: r.ByteReader}
+			}
+			return
+		}
+	}
+	r.v2 = &v2State{}
+}
+
+// nextV2Frame returns the next frame of a v2 input.
+func (r *reader) nextV2Frame() ([]byte, error) {
+	for len(r.v2.frames) == 0 {
+		if r.v2.done {
+			return nil, io.EOF
+		}
+		// Streams which were not closed end without the index. Accept them as
+		// long as they end at a block boundary.
+		hdr, err := readBlockHeader(r, blockLimit(r.maxSize))
+		if err != nil {
+			return nil, err
+		}
+		if hdr.typ == blockIndex {
+			// The index and the trailer are not needed for sequential reads.
+			r.v2.done = true
+			return nil, io.EOF
+		}
+		blk, err := readBlockBody(r, hdr)
+		if err != nil {
+			return nil, err
+		}
+		if r.v2.frames, err = blk.frames(r.maxSize); err != nil {
+			return nil, err
+		}
+	}
+	frame := r.v2.frames[0]
+	r.v2.frames = r.v2.frames[1:]
+	return frame, nil
+}
+
+// prefixByteReader is an io.ByteReader which returns bytes from prefix before
+// reading from the underlying io.ByteReader.
+type prefixByteReader struct {
+	prefix []byte
+	io.ByteReader
+}
+
+func (r *prefixByteReader) ReadByte() (byte, error) {
+	if len(r.prefix) > 0 {
+		b := r.prefix[0]
+		r.prefix = r.prefix[1:]
+		return b, nil
+	}
+	return r.ByteReader.ReadByte()
+}
+
 // simpleByteReader implements the io.ByteReader interface for an io.Reader.
 type simpleByteReader struct {
 	io.Reader
@@ -0,0 +1,335 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recordio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// This file implements encoding and decoding of v2 blocks.
+//
+// See doc.go for the description of the format.
+
+// ErrCorrupted is returned (possibly wrapped) when v2 data fails integrity
+// checks.
+var ErrCorrupted = errors.New("recordio: corrupted data")
+
+// Compression is a compression algorithm for v2 blocks.
+type Compression int
+
+const (
+	// CompressionNone stores blocks uncompressed.
+	CompressionNone Compression = iota
+	// CompressionZstd compresses blocks with zstd.
+	CompressionZstd
+)
+
+// MaxBlockSize is the maximum size of an uncompressed v2 block, not including
+// the size of the last frame in it.
+const MaxBlockSize = 64 << 20
+
+// Block types.
+const (
+	blockData     byte = 1
+	blockDataZstd byte = 2
+	blockIndex    byte = 3
+)
+
+// v2Magic is the header of a v2 stream.
+//
+// It starts with a byte sequence which is not a valid v1 frame header (the
+// varint overflows 64 bits), so v1 and v2 streams can be told apart.
+var v2Magic = []byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	'R', 'I', 'O', '2',
+}
+
+// v2TailMagic ends the trailer of a v2 stream.
+var v2TailMagic = []byte("RIO2INDX")
+
+// v2TrailerSize is the size of the trailer: the offset of the index block
+// followed by v2TailMagic.
+const v2TrailerSize = 8 + 8
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+// zstdCodec returns the shared zstd encoder and decoder.
+//
+// Their EncodeAll and DecodeAll methods are goroutine-safe.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder) {
+	zstdOnce.Do(func() {
+		var err error
+		if zstdEncoder, err = zstd.NewWriter(nil); err != nil {
+			panic(err)
+		}
+		if zstdDecoder, err = zstd.NewReader(nil); err != nil {
+			panic(err)
+		}
+	})
+	return zstdEncoder, zstdDecoder
+}
+
+// appendV2Frame appends a v2 frame to a block being built.
+//
+// A v2 frame is the uvarint size of the data, the data itself and the
+// little-endian CRC32C of the data.
+func appendV2Frame(block, data []byte) []byte {
+	block = binary.AppendUvarint(block, uint64(len(data)))
+	block = append(block, data...)
+	return binary.LittleEndian.AppendUint32(block, crc32.Checksum(data, crcTable))
+}
+
+// writeBlock writes a single block.
+//
+// A block is its type byte, the uvarint number of entries in it, the uvarint
+// size of uncompressed data, the uvarint size of stored data, the stored data
+// and the little-endian CRC32C of the stored data.
+//
+// If the compressed data is not smaller than raw, it is stored uncompressed.
+func writeBlock(w io.Writer, typ byte, count int, raw []byte, c Compression) (int, error) {
+	stored := raw
+	if typ == blockData && c == CompressionZstd {
+		enc, _ := zstdCodec()
+		if compressed := enc.EncodeAll(raw, nil); len(compressed) < len(raw) {
+			typ = blockDataZstd
+			stored = compressed
+		}
+	}
+
+	hdr := make([]byte, 0, 1+3*binary.MaxVarintLen64)
+	hdr = append(hdr, typ)
+	hdr = binary.AppendUvarint(hdr, uint64(count))
+	hdr = binary.AppendUvarint(hdr, uint64(len(raw)))
+	hdr = binary.AppendUvarint(hdr, uint64(len(stored)))
+
+	total := 0
+	for _, chunk := range [][]byte{
+		hdr,
+		stored,
+		binary.LittleEndian.AppendUint32(nil, crc32.Checksum(stored, crcTable)),
+	} {
+		n, err := w.Write(chunk)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// v2Block is a decoded block.
+type v2Block struct {
+	typ   byte
+	count int
+	raw   []byte // uncompressed data
+}
+
+// byteReader is a reader with ReadByte method.
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// v2BlockHeader is a header of a block.
+type v2BlockHeader struct {
+	typ       byte
+	count     uint64
+	rawLen    uint64
+	storedLen uint64
+}
+
+// blockLimit returns the maximum size of uncompressed data in a block, given
+// the maximum size of a frame.
+func blockLimit(maxSize int64) int64 {
+	const overhead = MaxBlockSize + binary.MaxVarintLen64 + 4
+	if maxSize > math.MaxInt64-overhead {
+		return math.MaxInt64
+	}
+	return maxSize + overhead
+}
+
+// readBlock reads and verifies a single block.
+//
+// limit is the maximum allowed size of uncompressed data in the block. Returns
+// io.EOF if there's no data at all.
+func readBlock(r byteReader, limit int64) (*v2Block, error) {
+	hdr, err := readBlockHeader(r, limit)
+	if err != nil {
+		return nil, err
+	}
+	return readBlockBody(r, hdr)
+}
+
+// readBlockHeader reads and validates a block header.
+//
+// limit is the maximum allowed size of uncompressed data in a data block.
+// Returns io.EOF if there's no data at all.
+func readBlockHeader(r byteReader, limit int64) (hdr v2BlockHeader, err error) {
+	if hdr.typ, err = r.ReadByte(); err != nil {
+		return
+	}
+	if hdr.typ != blockData && hdr.typ != blockDataZstd && hdr.typ != blockIndex {
+		return hdr, fmt.Errorf("%w: unknown block type %d", ErrCorrupted, hdr.typ)
+	}
+	for _, v := range []*uint64{&hdr.count, &hdr.rawLen, &hdr.storedLen} {
+		if *v, err = binary.ReadUvarint(r); err != nil {
+			return hdr, noEOF(err)
+		}
+	}
+	switch {
+	case hdr.typ != blockIndex && hdr.rawLen > uint64(limit):
+		// The size of the index is checked by IndexedReader.
+		return hdr, ErrFrameTooLarge
+	case hdr.typ == blockDataZstd && hdr.storedLen > hdr.rawLen:
+		return hdr, fmt.Errorf("%w: compressed block is larger than uncompressed", ErrCorrupted)
+	case hdr.typ != blockDataZstd && hdr.storedLen != hdr.rawLen:
+		return hdr, fmt.Errorf("%w: bad block size", ErrCorrupted)
+	case hdr.count > hdr.rawLen:
+		// Each entry takes at least one byte.
+		return hdr, fmt.Errorf("%w: bad block entry count", ErrCorrupted)
+	}
+	return hdr, nil
+}
+
+// readBlockBody reads and verifies the data of a block with the given header.
+func readBlockBody(r io.Reader, hdr v2BlockHeader) (*v2Block, error) {
+	stored := make([]byte, hdr.storedLen+4)
+	if _, err := io.ReadFull(r, stored); err != nil {
+		return nil, noEOF(err)
+	}
+	crc := binary.LittleEndian.Uint32(stored[hdr.storedLen:])
+	stored = stored[:hdr.storedLen]
+	if crc32.Checksum(stored, crcTable) != crc {
+		return nil, fmt.Errorf("%w: block checksum mismatch", ErrCorrupted)
+	}
+
+	raw := stored
+	if hdr.typ == blockDataZstd {
+		_, dec := zstdCodec()
+		var err error
+		if raw, err = dec.DecodeAll(stored, make([]byte, 0, hdr.rawLen)); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrCorrupted, err)
+		}
+		if uint64(len(raw)) != hdr.rawLen {
+			return nil, fmt.Errorf("%w: bad uncompressed block size", ErrCorrupted)
+		}
+	}
+	return &v2Block{typ: hdr.typ, count: int(hdr.count), raw: raw}, nil
+}
+
+// frames splits a data block into individual frames, verifying their
+// checksums.
+//
+// The returned frames reference the block data.
+func (b *v2Block) frames(maxSize int64) ([][]byte, error) {
+	if b.typ == blockIndex {
+		panic("frames called on an index block")
+	}
+	frames := make([][]byte, 0, b.count)
+	br := bytes.NewReader(b.raw)
+	for br.Len() > 0 {
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad frame header", ErrCorrupted)
+		}
+		if size > uint64(maxSize) {
+			return nil, ErrFrameTooLarge
+		}
+		if size+4 > uint64(br.Len()) {
+			return nil, fmt.Errorf("%w: frame exceeds block", ErrCorrupted)
+		}
+		offset := len(b.raw) - br.Len()
+		frame := b.raw[offset : offset+int(size) : offset+int(size)]
+		crc := binary.LittleEndian.Uint32(b.raw[offset+int(size):])
+		if crc32.Checksum(frame, crcTable) != crc {
+			return nil, fmt.Errorf("%w: frame checksum mismatch", ErrCorrupted)
+		}
+		frames = append(frames, frame)
+		br.Seek(int64(size)+4, io.SeekCurrent)
+	}
+	if len(frames) != b.count {
+		return nil, fmt.Errorf("%w: block has %d frames, expected %d", ErrCorrupted, len(frames), b.count)
+	}
+	return frames, nil
+}
+
+// v2IndexEntry locates a data block.
+type v2IndexEntry struct {
+	firstRecord int64 // the number of the first record in the block
+	offset      int64 // the offset of the block from the start of the stream
+}
+
+// encodeIndex encodes index entries as the contents of an index block.
+func encodeIndex(entries []v2IndexEntry, records int64) []byte {
+	buf := binary.AppendUvarint(nil, uint64(records))
+	for _, e := range entries {
+		buf = binary.AppendUvarint(buf, uint64(e.firstRecord))
+		buf = binary.AppendUvarint(buf, uint64(e.offset))
+	}
+	return buf
+}
+
+// index decodes the contents of an index block.
+//
+// Returns the index entries and the total number of records in the stream.
+func (b *v2Block) index() (entries []v2IndexEntry, records int64, err error) {
+	if b.typ != blockIndex {
+		return nil, 0, fmt.Errorf("%w: not an index block", ErrCorrupted)
+	}
+	br := bytes.NewReader(b.raw)
+	total, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: bad index", ErrCorrupted)
+	}
+	entries = make([]v2IndexEntry, b.count)
+	for i := range entries {
+		first, err1 := binary.ReadUvarint(br)
+		offset, err2 := binary.ReadUvarint(br)
+		if err1 != nil || err2 != nil {
+			return nil, 0, fmt.Errorf("%w: bad index", ErrCorrupted)
+		}
+		entries[i] = v2IndexEntry{firstRecord: int64(first), offset: int64(offset)}
+		if i > 0 && entries[i].firstRecord < entries[i-1].firstRecord {
+			return nil, 0, fmt.Errorf("%w: index is not sorted", ErrCorrupted)
+		}
+	}
+	if br.Len() != 0 {
+		return nil, 0, fmt.Errorf("%w: bad index", ErrCorrupted)
+	}
+	return entries, int64(total), nil
+}
+
+// noEOF converts io.EOF to io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
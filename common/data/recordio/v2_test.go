@@ -0,0 +1,233 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recordio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestV2(t *testing.T) {
+	t.Parallel()
+
+	Convey(`V2 format`, t, func() {
+		var frames [][]byte
+		for i := 0; i < 1000; i++ {
+			frames = append(frames, []byte(fmt.Sprintf("frame #%d %s", i, bytes.Repeat([]byte{'x'}, i%50))))
+		}
+		frames = append(frames, nil)
+
+		write := func(opts WriterV2Options, frames [][]byte) []byte {
+			buf := bytes.Buffer{}
+			w := NewWriterV2(&buf, opts)
+			for _, f := range frames {
+				_, err := w.Write(f)
+				So(err, ShouldBeNil)
+				So(w.Flush(), ShouldBeNil)
+			}
+			So(w.Close(), ShouldBeNil)
+			return buf.Bytes()
+		}
+
+		readAll := func(data []byte) ([][]byte, error) {
+			r := NewReader(bytes.NewReader(data), 1024)
+			var out [][]byte
+			for {
+				f, err := r.ReadFrameAll()
+				if err == io.EOF {
+					return out, nil
+				}
+				if err != nil {
+					return out, err
+				}
+				out = append(out, f)
+			}
+		}
+
+		expected := make([][]byte, len(frames))
+		for i, f := range frames {
+			if len(f) != 0 {
+				expected[i] = f
+			}
+		}
+
+		for _, c := range []Compression{CompressionNone, CompressionZstd} {
+			c := c
+			Convey(fmt.Sprintf(`Compression %d`, c), func() {
+				data := write(WriterV2Options{Compression: c, BlockSize: 1024}, frames)
+
+				Convey(`Round trip`, func() {
+					read, err := readAll(data)
+					So(err, ShouldBeNil)
+					So(read, ShouldResemble, expected)
+				})
+
+				Convey(`Random access`, func() {
+					r, err := NewIndexedReader(bytes.NewReader(data), int64(len(data)), 1024)
+					So(err, ShouldBeNil)
+					So(r.Len(), ShouldEqual, len(frames))
+					So(len(r.index), ShouldBeGreaterThan, 1)
+
+					for _, i := range []int64{500, 0, 999, 1000, 1, 501} {
+						f, err := r.ReadFrame(i)
+						So(err, ShouldBeNil)
+						So(f, ShouldResemble, frames[i])
+					}
+
+					_, err = r.ReadFrame(1001)
+					So(err, ShouldErrLike, "out of range")
+				})
+			})
+		}
+
+		Convey(`Compression reduces size`, func() {
+			plain := write(WriterV2Options{}, frames)
+			zstd := write(WriterV2Options{Compression: CompressionZstd}, frames)
+			So(len(zstd), ShouldBeLessThan, len(plain)/2)
+		})
+
+		Convey(`ReadFrame works`, func() {
+			data := write(WriterV2Options{}, [][]byte{[]byte("hello"), []byte("world")})
+			r := NewReader(bytes.NewReader(data), 1024)
+
+			size, lr, err := r.ReadFrame()
+			So(err, ShouldBeNil)
+			So(size, ShouldEqual, 5)
+			f, err := io.ReadAll(lr)
+			So(err, ShouldBeNil)
+			So(string(f), ShouldEqual, "hello")
+
+			f, err = r.ReadFrameAll()
+			So(err, ShouldBeNil)
+			So(string(f), ShouldEqual, "world")
+
+			_, _, err = r.ReadFrame()
+			So(err, ShouldEqual, io.EOF)
+		})
+
+		Convey(`Empty stream`, func() {
+			data := write(WriterV2Options{}, nil)
+
+			read, err := readAll(data)
+			So(err, ShouldBeNil)
+			So(read, ShouldBeEmpty)
+
+			r, err := NewIndexedReader(bytes.NewReader(data), int64(len(data)), 1024)
+			So(err, ShouldBeNil)
+			So(r.Len(), ShouldEqual, 0)
+		})
+
+		Convey(`Unclosed stream is readable up to the last block`, func() {
+			buf := bytes.Buffer{}
+			w := NewWriterV2(&buf, WriterV2Options{BlockSize: 1})
+			for _, f := range []string{"a", "b", "c"} {
+				w.Write([]byte(f))
+				So(w.Flush(), ShouldBeNil)
+			}
+
+			read, err := readAll(buf.Bytes())
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, [][]byte{[]byte("a"), []byte("b"), []byte("c")})
+
+			_, err = NewIndexedReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 1024)
+			So(err, ShouldErrLike, "no index")
+		})
+
+		Convey(`Reset`, func() {
+			buf := bytes.Buffer{}
+			w := NewWriterV2(&buf, WriterV2Options{})
+			w.Write([]byte("lost"))
+			So(w.Flush(), ShouldBeNil)
+
+			buf2 := bytes.Buffer{}
+			w.Reset(&buf2)
+			w.Write([]byte("kept"))
+			So(w.Flush(), ShouldBeNil)
+			So(w.Close(), ShouldBeNil)
+			So(buf.Len(), ShouldEqual, 0)
+
+			read, err := readAll(buf2.Bytes())
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, [][]byte{[]byte("kept")})
+		})
+
+		Convey(`Detects corruption`, func() {
+			data := write(WriterV2Options{BlockSize: 1024}, frames)
+
+			// Flip a bit in the middle of the first block.
+			data[len(v2Magic)+100] ^= 1
+
+			_, err := readAll(data)
+			So(errors.Is(err, ErrCorrupted), ShouldBeTrue)
+
+			r, err := NewIndexedReader(bytes.NewReader(data), int64(len(data)), 1024)
+			So(err, ShouldBeNil)
+			_, err = r.ReadFrame(0)
+			So(errors.Is(err, ErrCorrupted), ShouldBeTrue)
+			f, err := r.ReadFrame(999)
+			So(err, ShouldBeNil)
+			So(f, ShouldResemble, frames[999])
+		})
+
+		Convey(`Detects truncation`, func() {
+			data := write(WriterV2Options{BlockSize: 1024}, frames)
+
+			_, err := readAll(data[:len(data)/2])
+			So(err, ShouldEqual, io.ErrUnexpectedEOF)
+
+			_, err = NewIndexedReader(bytes.NewReader(data), int64(len(data)/2), 1024)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey(`Respects maxSize`, func() {
+			data := write(WriterV2Options{}, [][]byte{bytes.Repeat([]byte{'x'}, 2000)})
+			_, err := readAll(data)
+			So(err, ShouldEqual, ErrFrameTooLarge)
+		})
+
+		Convey(`Still reads v1`, func() {
+			buf := bytes.Buffer{}
+			for _, f := range frames {
+				_, err := WriteFrame(&buf, f)
+				So(err, ShouldBeNil)
+			}
+			read, err := readAll(buf.Bytes())
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, expected)
+
+			_, err = NewIndexedReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 1024)
+			So(err, ShouldErrLike, "not a v2 stream")
+		})
+
+		Convey(`Reads v1 frames with multi-byte headers`, func() {
+			big := bytes.Repeat([]byte{'x'}, 0x3fff)
+			buf := bytes.Buffer{}
+			_, err := WriteFrame(&buf, big)
+			So(err, ShouldBeNil)
+			So(buf.Bytes()[:2], ShouldResemble, []byte{0xff, 0x7f})
+
+			r := NewReader(&buf, 1<<20)
+			f, err := r.ReadFrameAll()
+			So(err, ShouldBeNil)
+			So(f, ShouldResemble, big)
+		})
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recordio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// DefaultBlockSize is the default value of WriterV2Options.BlockSize.
+const DefaultBlockSize = 64 * 1024
+
+// WriterV2Options configures a writer of the v2 format.
+type WriterV2Options struct {
+	// Compression is the compression algorithm used for blocks.
+	//
+	// Blocks which don't benefit from compression are stored uncompressed.
+	Compression Compression
+
+	// BlockSize is the size of uncompressed frame data after which the current
+	// block is written out.
+	//
+	// Defaults to DefaultBlockSize. Capped at MaxBlockSize.
+	BlockSize int
+}
+
+// WriterV2 is a Writer which writes the v2 format.
+//
+// Frames are grouped into blocks: a frame terminated by Flush is written to
+// the underlying io.Writer only when its block is complete. Close must be
+// called to write the last block and the index.
+type WriterV2 interface {
+	Writer
+
+	// Close writes the buffered block and the index of the stream.
+	//
+	// Data written after the last Flush is discarded. It doesn't close the
+	// underlying io.Writer. The Writer can't be used after Close, unless it is
+	// Reset.
+	Close() error
+}
+
+// writerV2 implements WriterV2.
+type writerV2 struct {
+	inner io.Writer
+	opts  WriterV2Options
+
+	frame bytes.Buffer // the current frame
+	block []byte       // the current block
+	count int          // the number of frames in the current block
+
+	started bool  // true if the stream header was written
+	closed  bool  // true if Close was called
+	offset  int64 // the number of bytes written to inner
+	records int64 // the number of frames in the stream so far
+
+	index []v2IndexEntry
+}
+
+var errWriterClosed = errors.New("recordio: writer is closed")
+
+// NewWriterV2 creates a new Writer which writes data as frames in the v2 format
+// to an underlying io.Writer.
+//
+// The v2 format protects frames with checksums, can compress them and allows
+// random access to them (see IndexedReader). Streams in this format can be
+// read by Reader.
+func NewWriterV2(w io.Writer, opts WriterV2Options) WriterV2 {
+	switch {
+	case opts.BlockSize <= 0:
+		opts.BlockSize = DefaultBlockSize
+	case opts.BlockSize > MaxBlockSize:
+		opts.BlockSize = MaxBlockSize
+	}
+	return &writerV2{
+		inner: w,
+		opts:  opts,
+	}
+}
+
+func (w *writerV2) Write(data []byte) (int, error) {
+	if w.closed {
+		return 0, errWriterClosed
+	}
+	return w.frame.Write(data)
+}
+
+func (w *writerV2) Flush() error {
+	if w.closed {
+		return errWriterClosed
+	}
+	w.block = appendV2Frame(w.block, w.frame.Bytes())
+	w.count++
+	w.frame.Reset()
+	if len(w.block) >= w.opts.BlockSize {
+		return w.writeDataBlock()
+	}
+	return nil
+}
+
+func (w *writerV2) Close() error {
+	if w.closed {
+		return errWriterClosed
+	}
+	w.closed = true
+	if err := w.writeDataBlock(); err != nil {
+		return err
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	indexOffset := w.offset
+	idx := encodeIndex(w.index, w.records)
+	if err := w.write(writeBlock(w.inner, blockIndex, len(w.index), idx, CompressionNone)); err != nil {
+		return err
+	}
+
+	trailer := binary.LittleEndian.AppendUint64(nil, uint64(indexOffset))
+	trailer = append(trailer, v2TailMagic...)
+	return w.write(w.inner.Write(trailer))
+}
+
+func (w *writerV2) Reset(inner io.Writer) {
+	w.inner = inner
+	w.frame.Reset()
+	w.block = w.block[:0]
+	w.count = 0
+	w.started = false
+	w.closed = false
+	w.offset = 0
+	w.records = 0
+	w.index = nil
+}
+
+// writeHeader writes the stream header if it hasn't been written yet.
+func (w *writerV2) writeHeader() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.write(w.inner.Write(v2Magic))
+}
+
+// writeDataBlock writes the current block, if it has any frames.
+func (w *writerV2) writeDataBlock() error {
+	if w.count == 0 {
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.index = append(w.index, v2IndexEntry{firstRecord: w.records, offset: w.offset})
+	if err := w.write(writeBlock(w.inner, blockData, w.count, w.block, w.opts.Compression)); err != nil {
+		return err
+	}
+	w.records += int64(w.count)
+	w.block = w.block[:0]
+	w.count = 0
+	return nil
+}
+
+// write accounts for the number of written bytes.
+func (w *writerV2) write(n int, err error) error {
+	w.offset += int64(n)
+	return err
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonlogger implements a logger that writes line-delimited JSON.
+//
+// Each log line is a JSON object with the timestamp, the level, the message,
+// logging.Fields of the context, the OpenTelemetry trace and span IDs (if the
+// context has a span) and the source location of the logging call.
+//
+// Unlike sdlogger, the format is not tied to any particular log collector and
+// is suitable for log processors like jq, Loki or Elasticsearch.
+package jsonlogger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+)
+
+// Entry is a single log line.
+type Entry struct {
+	// Time is when the entry was produced.
+	Time time.Time `json:"time"`
+	// Level is the logging level, e.g. "info".
+	Level string `json:"level"`
+	// Message is the formatted log message.
+	Message string `json:"msg"`
+	// TraceID is a hex-encoded trace ID of the current span, if any.
+	TraceID string `json:"trace_id,omitempty"`
+	// SpanID is a hex-encoded ID of the current span, if any.
+	SpanID string `json:"span_id,omitempty"`
+	// Source is the location of the logging call, if known.
+	Source *Source `json:"source,omitempty"`
+	// Fields are logging.Fields of the context.
+	Fields logging.Fields `json:"fields,omitempty"`
+}
+
+// Source is a location in the source code.
+type Source struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Function string `json:"function,omitempty"`
+}
+
+// Sink takes care of JSON-serializing log entries and synchronizing writes to
+// an io.Writer (usually stdout or stderr).
+//
+// There should be at most one Sink instance assigned to a given io.Writer,
+// shared by all loggers writing to it. Violating this requirement may cause
+// malformed log lines.
+type Sink struct {
+	// Out is where to write the log to, required.
+	Out io.Writer
+	// NoSource, if true, omits source locations from entries.
+	NoSource bool
+
+	l sync.Mutex
+}
+
+// Write writes an entry as a single line of JSON.
+//
+// Ignores errors from io.Writer.
+func (s *Sink) Write(e *Entry) {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		// Some field values are not JSON-serializable. Log them as strings.
+		cpy := *e
+		cpy.Fields = make(logging.Fields, len(e.Fields))
+		for k, v := range e.Fields {
+			cpy.Fields[k] = fmt.Sprint(v)
+		}
+		if buf, err = json.Marshal(&cpy); err != nil {
+			panic(err) // must not be possible
+		}
+	}
+	buf = append(buf, '\n')
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.Out.Write(buf)
+}
+
+// Factory is a logging.Factory producing loggers that write to the sink.
+func (s *Sink) Factory(ctx context.Context) logging.Logger {
+	return &jsonLogger{ctx: ctx, sink: s}
+}
+
+// Use installs a logger that writes to the sink as the context logger.
+func (s *Sink) Use(ctx context.Context) context.Context {
+	return logging.SetFactory(ctx, s.Factory)
+}
+
+type jsonLogger struct {
+	ctx  context.Context
+	sink *Sink
+
+	once   sync.Once      // used to initialize fields from 'ctx' on demand
+	fields logging.Fields // fields of 'ctx', ready for serialization
+}
+
+func (l *jsonLogger) Debugf(format string, args ...any) {
+	l.LogCall(logging.Debug, 1, format, args)
+}
+
+func (l *jsonLogger) Infof(format string, args ...any) {
+	l.LogCall(logging.Info, 1, format, args)
+}
+
+func (l *jsonLogger) Warningf(format string, args ...any) {
+	l.LogCall(logging.Warning, 1, format, args)
+}
+
+func (l *jsonLogger) Errorf(format string, args ...any) {
+	l.LogCall(logging.Error, 1, format, args)
+}
+
+func (l *jsonLogger) LogCall(lvl logging.Level, calldepth int, format string, args []any) {
+	if !logging.IsLogging(l.ctx, lvl) {
+		return
+	}
+
+	// Within a single context.Context fields are static, prepare them once.
+	l.once.Do(func() {
+		fields := logging.GetFields(l.ctx)
+		if len(fields) == 0 {
+			return
+		}
+		// logging.ErrorKey usually points to a value that implements 'error'
+		// interface, which is not JSON-serializable. Convert it to a string. Note
+		// that mutating the result of logging.GetFields in place is not allowed, so
+		// we'll make a copy.
+		if err, ok := fields[logging.ErrorKey].(error); ok {
+			fields = logging.NewFields(fields)
+			fields[logging.ErrorKey] = err.Error()
+		}
+		l.fields = fields
+	})
+
+	e := Entry{
+		Time:    clock.Now(l.ctx).UTC(),
+		Level:   lvl.String(),
+		Message: fmt.Sprintf(format, args...),
+		Fields:  l.fields,
+	}
+	if sc := trace.SpanContextFromContext(l.ctx); sc.IsValid() {
+		e.TraceID = sc.TraceID().String()
+		e.SpanID = sc.SpanID().String()
+	}
+	if !l.sink.NoSource {
+		if pc, file, line, ok := runtime.Caller(calldepth + 1); ok {
+			e.Source = &Source{File: file, Line: line}
+			if fn := runtime.FuncForPC(pc); fn != nil {
+				e.Source.Function = fn.Name()
+			}
+		}
+	}
+
+	l.sink.Write(&e)
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlogger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/logging"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a logger", t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		buf := bytes.Buffer{}
+		sink := &Sink{Out: &buf}
+		ctx = sink.Use(ctx)
+
+		read := func() (entries []map[string]any) {
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				var e map[string]any
+				So(json.Unmarshal([]byte(line), &e), ShouldBeNil)
+				entries = append(entries, e)
+			}
+			return
+		}
+
+		Convey("Writes entries", func() {
+			logging.Infof(ctx, "hello %d", 1)
+			logging.Debugf(ctx, "filtered out")
+			logging.Warningf(logging.SetFields(ctx, logging.Fields{
+				"key":            "value",
+				logging.ErrorKey: errors.New("boom"),
+			}), "world")
+
+			entries := read()
+			So(entries, ShouldHaveLength, 2)
+
+			So(entries[0]["time"], ShouldEqual, testclock.TestRecentTimeUTC.Format(time.RFC3339Nano))
+			So(entries[0]["level"], ShouldEqual, "info")
+			So(entries[0]["msg"], ShouldEqual, "hello 1")
+			So(entries[0]["fields"], ShouldBeNil)
+			src := entries[0]["source"].(map[string]any)
+			So(src["file"], ShouldEndWith, "logger_test.go")
+			So(src["function"], ShouldContainSubstring, "TestLogger")
+
+			So(entries[1]["level"], ShouldEqual, "warning")
+			So(entries[1]["fields"], ShouldResemble, map[string]any{
+				"key":   "value",
+				"error": "boom",
+			})
+		})
+
+		Convey("Handles unserializable fields", func() {
+			logging.Infof(logging.SetField(ctx, "chan", make(chan int)), "msg")
+			entries := read()
+			So(entries[0]["fields"].(map[string]any)["chan"], ShouldStartWith, "0x")
+		})
+
+		Convey("Writes trace IDs", func() {
+			ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{1, 2, 3},
+				SpanID:  trace.SpanID{4, 5, 6},
+			}))
+			logging.Infof(ctx, "traced")
+			entries := read()
+			So(entries[0]["trace_id"], ShouldEqual, "01020300000000000000000000000000")
+			So(entries[0]["span_id"], ShouldEqual, "0405060000000000")
+		})
+
+		Convey("Omits source if asked", func() {
+			sink.NoSource = true
+			logging.Infof(ctx, "msg")
+			So(read()[0]["source"], ShouldBeNil)
+		})
+	})
+}
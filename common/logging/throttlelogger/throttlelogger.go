@@ -0,0 +1,196 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package throttlelogger implements a logger filter that protects against log
+// storms.
+//
+// It limits the number of messages logged from a single call site (or, with
+// Dedup, the number of identical messages) per period of time. Suppressed
+// messages are counted and the count is reported via SuppressedKey field of
+// the next message that gets through.
+package throttlelogger
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+)
+
+// SuppressedKey is a logging field with the number of messages suppressed
+// since the previous message from the same call site.
+const SuppressedKey = "suppressed"
+
+// maxSites is the number of tracked call sites (or messages) after which
+// stale entries are forgotten.
+const maxSites = 10000
+
+// Options configure a Filter.
+type Options struct {
+	// Burst is the number of messages allowed per Period. Default is 10.
+	Burst int
+
+	// Period is the length of the throttling window. Default is 1 minute.
+	Period time.Duration
+
+	// Dedup, if true, throttles identical messages from a call site separately
+	// from different ones, i.e. only exact repeats are suppressed.
+	Dedup bool
+
+	// KeepErrors, if true, exempts messages at Error level from throttling.
+	KeepErrors bool
+}
+
+// Filter throttles log messages.
+//
+// It holds the throttling state shared by all loggers produced by it. Create
+// it once (e.g. in main) and install into the root context via Use.
+type Filter struct {
+	opts Options
+
+	m     sync.Mutex
+	sites map[siteKey]*siteState
+}
+
+type siteKey struct {
+	file string // the location of the logging call
+	line int
+	msg  string // the formatted message if Dedup is used
+}
+
+type siteState struct {
+	start      time.Time // when the current window started
+	logged     int       // messages logged in the current window
+	suppressed int       // messages suppressed in the current window
+}
+
+// New creates a new Filter.
+func New(opts Options) *Filter {
+	if opts.Burst <= 0 {
+		opts.Burst = 10
+	}
+	if opts.Period <= 0 {
+		opts.Period = time.Minute
+	}
+	return &Filter{
+		opts:  opts,
+		sites: map[siteKey]*siteState{},
+	}
+}
+
+// Use wraps the current logger of the context with the filter.
+func (f *Filter) Use(ctx context.Context) context.Context {
+	inner := logging.GetFactory(ctx)
+	if inner == nil {
+		return ctx
+	}
+	return logging.SetFactory(ctx, func(ctx context.Context) logging.Logger {
+		return &filteredLogger{ctx: ctx, f: f, inner: inner}
+	})
+}
+
+// admit decides whether a message from the given site should be logged.
+//
+// Returns the number of messages suppressed since the previous logged one.
+func (f *Filter) admit(now time.Time, key siteKey) (ok bool, suppressed int) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	st := f.sites[key]
+	if st == nil {
+		if len(f.sites) >= maxSites {
+			f.forgetStaleLocked(now)
+		}
+		st = &siteState{start: now}
+		f.sites[key] = st
+	}
+
+	if now.Sub(st.start) >= f.opts.Period {
+		suppressed = st.suppressed
+		*st = siteState{start: now}
+	}
+	if st.logged >= f.opts.Burst {
+		st.suppressed++
+		return false, 0
+	}
+	st.logged++
+	return true, suppressed
+}
+
+// forgetStaleLocked removes sites with expired windows.
+//
+// If all windows are fresh, forgets everything to bound the memory usage.
+func (f *Filter) forgetStaleLocked(now time.Time) {
+	for key, st := range f.sites {
+		if now.Sub(st.start) >= f.opts.Period {
+			delete(f.sites, key)
+		}
+	}
+	if len(f.sites) >= maxSites {
+		clear(f.sites)
+	}
+}
+
+type filteredLogger struct {
+	ctx   context.Context
+	f     *Filter
+	inner logging.Factory
+}
+
+func (l *filteredLogger) Debugf(format string, args ...any) {
+	l.LogCall(logging.Debug, 1, format, args)
+}
+
+func (l *filteredLogger) Infof(format string, args ...any) {
+	l.LogCall(logging.Info, 1, format, args)
+}
+
+func (l *filteredLogger) Warningf(format string, args ...any) {
+	l.LogCall(logging.Warning, 1, format, args)
+}
+
+func (l *filteredLogger) Errorf(format string, args ...any) {
+	l.LogCall(logging.Error, 1, format, args)
+}
+
+func (l *filteredLogger) LogCall(lvl logging.Level, calldepth int, format string, args []any) {
+	// Messages which would be dropped anyway must not use the quota.
+	if !logging.IsLogging(l.ctx, lvl) {
+		return
+	}
+
+	ctx := l.ctx
+	if lvl < logging.Error || !l.f.opts.KeepErrors {
+		var key siteKey
+		// Note: PCs are not used as keys, since with inlining a single call site
+		// may have many of them.
+		_, key.file, key.line, _ = runtime.Caller(calldepth + 1)
+		if l.f.opts.Dedup {
+			key.msg = fmt.Sprintf(format, args...)
+		}
+		ok, suppressed := l.f.admit(clock.Now(ctx), key)
+		if !ok {
+			return
+		}
+		if suppressed > 0 {
+			ctx = logging.SetField(ctx, SuppressedKey, suppressed)
+		}
+	}
+
+	l.inner(ctx).LogCall(lvl, calldepth+1, format, args)
+}
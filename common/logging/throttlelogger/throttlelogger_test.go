@@ -0,0 +1,127 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttlelogger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/memlogger"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a filter", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx = memlogger.Use(ctx)
+		mem := logging.Get(ctx).(*memlogger.MemLogger)
+
+		use := func(opts Options) context.Context {
+			return New(opts).Use(ctx)
+		}
+
+		messages := func() (out []string) {
+			for _, e := range mem.Messages() {
+				msg := e.Msg
+				if n, ok := e.Data[SuppressedKey]; ok {
+					msg += fmt.Sprintf(" (%d suppressed)", n)
+				}
+				out = append(out, msg)
+			}
+			mem.Reset()
+			return
+		}
+
+		Convey("Throttles per call site", func() {
+			ctx := use(Options{Burst: 2, Period: time.Minute})
+			siteA := func(i int) { logging.Infof(ctx, "site A %d", i) }
+			siteB := func(i int) { logging.Infof(ctx, "site B %d", i) }
+
+			for i := 0; i < 5; i++ {
+				siteA(i)
+				siteB(i)
+			}
+			So(messages(), ShouldResemble, []string{
+				"site A 0", "site B 0",
+				"site A 1", "site B 1",
+			})
+
+			tc.Add(time.Minute)
+			for i := 5; i < 7; i++ {
+				siteA(i)
+			}
+			So(messages(), ShouldResemble, []string{
+				"site A 5 (3 suppressed)",
+				"site A 6",
+			})
+		})
+
+		Convey("Dedups messages", func() {
+			ctx := use(Options{Burst: 1, Period: time.Minute, Dedup: true})
+			site := func(i int) { logging.Infof(ctx, "msg %d", i) }
+
+			for i := 0; i < 5; i++ {
+				site(i % 2)
+			}
+			So(messages(), ShouldResemble, []string{"msg 0", "msg 1"})
+
+			tc.Add(time.Minute)
+			site(0)
+			So(messages(), ShouldResemble, []string{"msg 0 (2 suppressed)"})
+		})
+
+		Convey("Keeps errors", func() {
+			ctx := use(Options{Burst: 1, KeepErrors: true})
+
+			for i := 0; i < 3; i++ {
+				logging.Warningf(ctx, "warning")
+				logging.Errorf(ctx, "error")
+			}
+			So(messages(), ShouldResemble, []string{"warning", "error", "error", "error"})
+		})
+
+		Convey("Ignores messages below the level", func() {
+			ctx := use(Options{Burst: 1})
+
+			for i := 0; i < 3; i++ {
+				logging.Debugf(ctx, "debug")
+			}
+			ctx = logging.SetLevel(ctx, logging.Debug)
+			for i := 0; i < 3; i++ {
+				logging.Debugf(ctx, "debug")
+			}
+			So(messages(), ShouldResemble, []string{"debug"})
+		})
+
+		Convey("Forgets stale sites", func() {
+			f := New(Options{Burst: 1, Period: time.Minute})
+			now := testclock.TestRecentTimeUTC
+			for i := 0; i < maxSites; i++ {
+				f.admit(now, siteKey{msg: fmt.Sprintf("%d", i)})
+			}
+			So(f.sites, ShouldHaveLength, maxSites)
+
+			f.admit(now.Add(time.Minute), siteKey{msg: "new"})
+			So(f.sites, ShouldHaveLength, 1)
+		})
+	})
+}
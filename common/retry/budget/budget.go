@@ -0,0 +1,177 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package budget implements retry budgets.
+//
+// A retry budget caps the number of retries across all calls that share it
+// (usually all calls made by a client), relative to the number of calls. This
+// prevents retry storms: when a backend is overloaded or down, clients stop
+// multiplying the load by retrying each call several times.
+//
+// A Budget plugs into anything that accepts a retry.Factory, e.g. pRPC and
+// lhttp clients:
+//
+//	var rpcBudget = budget.New(budget.Options{Name: "buildbucket"})
+//
+//	client := &prpc.Client{
+//	  Options: &prpc.Options{Retry: rpcBudget.Wrap(retry.Default)},
+//	  ...
+//	}
+//
+//	lhttp.NewRequest(ctx, c, rpcBudget.Wrap(transient.Only(retry.Default)), ...)
+package budget
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+)
+
+var (
+	withdrawalsMetric = metric.NewCounter(
+		"luci/retry/budget/withdrawals",
+		"Number of attempts to spend a token of a retry budget",
+		nil,
+		field.String("name"),   // matches Options.Name
+		field.String("result"), // granted | exhausted
+	)
+
+	tokensMetric = metric.NewFloat(
+		"luci/retry/budget/tokens",
+		"Number of tokens left in a retry budget",
+		nil,
+		field.String("name"), // matches Options.Name
+	)
+)
+
+// Options configure a Budget.
+type Options struct {
+	// Name identifies the budget in metrics.
+	//
+	// If empty, the budget doesn't report metrics.
+	Name string
+
+	// Ratio is the number of tokens deposited per call.
+	//
+	// It is the fraction of calls that can be retried in a steady state. For
+	// example, 0.1 means that the number of retries can be at most 10% of the
+	// number of calls (plus Burst). Default is 0.1.
+	Ratio float64
+
+	// Burst is the maximum number of tokens in the budget.
+	//
+	// The budget starts full, so this is also the number of retries allowed
+	// before any calls are made. Default is 10.
+	Burst float64
+}
+
+// Budget is a token bucket shared by all callers of some client.
+//
+// Each call deposits Options.Ratio tokens. Each retry withdraws one token. If
+// there are no tokens left, the call is not retried.
+//
+// Budget is goroutine-safe.
+type Budget struct {
+	opts Options
+
+	m      sync.Mutex
+	tokens float64
+}
+
+// New creates a full budget.
+func New(opts Options) *Budget {
+	if opts.Ratio <= 0 {
+		opts.Ratio = 0.1
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = 10
+	}
+	return &Budget{opts: opts, tokens: opts.Burst}
+}
+
+// Deposit records a new call.
+func (b *Budget) Deposit() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.tokens = min(b.tokens+b.opts.Ratio, b.opts.Burst)
+}
+
+// TryWithdraw takes a token to make a retry, if there's one.
+//
+// Returns false if the budget is exhausted.
+func (b *Budget) TryWithdraw(ctx context.Context) bool {
+	b.m.Lock()
+	granted := b.tokens >= 1
+	if granted {
+		b.tokens--
+	}
+	tokens := b.tokens
+	b.m.Unlock()
+
+	if b.opts.Name != "" {
+		result := "granted"
+		if !granted {
+			result = "exhausted"
+		}
+		withdrawalsMetric.Add(ctx, 1, b.opts.Name, result)
+		tokensMetric.Set(ctx, tokens, b.opts.Name)
+	}
+	return granted
+}
+
+// Tokens returns the number of tokens in the budget.
+func (b *Budget) Tokens() float64 {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.tokens
+}
+
+// Wrap returns a retry.Factory whose iterators are limited by the budget.
+//
+// Each produced iterator counts as a new call (i.e. deposits tokens) and
+// withdraws a token for each retry allowed by the wrapped iterator. When the
+// budget is exhausted, the iterator stops.
+//
+// Returns nil if f is nil.
+func (b *Budget) Wrap(f retry.Factory) retry.Factory {
+	if f == nil {
+		return nil
+	}
+	return func() retry.Iterator {
+		it := f()
+		if it == nil {
+			return nil
+		}
+		b.Deposit()
+		return &budgetIterator{Iterator: it, b: b}
+	}
+}
+
+// budgetIterator is an Iterator which stops when the budget is exhausted.
+type budgetIterator struct {
+	retry.Iterator // the wrapped Iterator
+	b              *Budget
+}
+
+func (i *budgetIterator) Next(ctx context.Context, err error) time.Duration {
+	delay := i.Iterator.Next(ctx, err)
+	if delay == retry.Stop || !i.b.TryWithdraw(ctx) {
+		return retry.Stop
+	}
+	return delay
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/tsmon"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Budget`, t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		b := New(Options{Name: "test", Ratio: 0.5, Burst: 2})
+
+		Convey(`Starts full`, func() {
+			So(b.Tokens(), ShouldEqual, 2)
+			So(b.TryWithdraw(ctx), ShouldBeTrue)
+			So(b.TryWithdraw(ctx), ShouldBeTrue)
+			So(b.TryWithdraw(ctx), ShouldBeFalse)
+			So(b.Tokens(), ShouldEqual, 0)
+		})
+
+		Convey(`Deposits are capped`, func() {
+			b.TryWithdraw(ctx)
+			for i := 0; i < 10; i++ {
+				b.Deposit()
+			}
+			So(b.Tokens(), ShouldEqual, 2)
+		})
+
+		Convey(`Wrap`, func() {
+			f := b.Wrap(func() retry.Iterator {
+				return &retry.Limited{Retries: 100, Delay: time.Second}
+			})
+
+			// retries runs a single call which always fails.
+			retries := func() (n int) {
+				it := f()
+				for it.Next(ctx, nil) != retry.Stop {
+					n++
+				}
+				return
+			}
+
+			// The first call spends the burst and the deposit.
+			So(retries(), ShouldEqual, 2)
+			// Subsequent calls get a retry every other call.
+			So(retries(), ShouldEqual, 0)
+			So(retries(), ShouldEqual, 1)
+			So(retries(), ShouldEqual, 0)
+			So(retries(), ShouldEqual, 1)
+
+			Convey(`Reports metrics`, func() {
+				store := tsmon.GetState(ctx).Store()
+				So(store.Get(ctx, withdrawalsMetric, time.Time{}, []any{"test", "granted"}), ShouldEqual, 4)
+				So(store.Get(ctx, withdrawalsMetric, time.Time{}, []any{"test", "exhausted"}), ShouldEqual, 5)
+				So(store.Get(ctx, tokensMetric, time.Time{}, []any{"test"}), ShouldEqual, 0)
+			})
+		})
+
+		Convey(`Wrap respects the wrapped iterator`, func() {
+			f := b.Wrap(func() retry.Iterator {
+				return &retry.Limited{Retries: 1, Delay: time.Second}
+			})
+			it := f()
+			So(it.Next(ctx, nil), ShouldEqual, time.Second)
+			So(it.Next(ctx, nil), ShouldEqual, retry.Stop)
+			So(b.Tokens(), ShouldEqual, 1)
+		})
+
+		Convey(`Wrap of nil is nil`, func() {
+			So(b.Wrap(nil), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hedge implements hedged requests.
+//
+// A hedged request is a call that is sent again (possibly to another replica)
+// if the first attempt doesn't complete quickly. The first successful
+// response wins and the rest of attempts are canceled. This cuts the tail
+// latency at the cost of some extra load, and therefore must only be used for
+// idempotent calls, e.g. reads.
+//
+// For example, with a pRPC client:
+//
+//	resp, err := hedge.Do(ctx, hedge.Options{Delay: 200 * time.Millisecond},
+//	  func(ctx context.Context) (*pb.Response, error) {
+//	    return client.GetThing(ctx, req)
+//	  })
+//
+// Errors tagged with transient.Tag (e.g. by grpcutil.WrapIfTransient) start
+// the next attempt right away.
+package hedge
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/budget"
+	"go.chromium.org/luci/common/retry/transient"
+)
+
+// Options configure Do.
+type Options struct {
+	// Delay is how long to wait for an attempt before starting another one.
+	//
+	// Usually set to some high percentile (e.g. p95) of the call latency.
+	// Required, must be positive.
+	Delay time.Duration
+
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	//
+	// Default is 2.
+	MaxAttempts int
+
+	// Budget, if set, limits the number of hedged attempts.
+	//
+	// Each call deposits into it and each attempt other than the first one
+	// withdraws a token. When the budget is exhausted, no more attempts are
+	// started. Can be shared with retries of the same client.
+	Budget *budget.Budget
+}
+
+// result is a result of a single attempt.
+type result[T any] struct {
+	val T
+	err error
+}
+
+// Do calls fn, starting more concurrent attempts if previous ones don't finish
+// within opts.Delay.
+//
+// Returns the result of the first successful attempt and cancels the context
+// of the rest. If an attempt fails with a transient error (see transient.Tag),
+// the next attempt is started right away, without waiting for the delay. If it
+// fails with a non-transient error, this error is returned right away.
+//
+// If all attempts fail, returns the error of the last one. If ctx is canceled,
+// returns its error. Do doesn't wait for canceled attempts to finish.
+//
+// Returns an error without calling fn if opts.Delay is not positive.
+func Do[T any](ctx context.Context, opts Options, fn func(ctx context.Context) (T, error)) (T, error) {
+	if opts.Delay <= 0 {
+		var zero T
+		return zero, errors.Reason("hedge: Delay must be positive, got %s", opts.Delay).Err()
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 2
+	}
+	if opts.Budget != nil {
+		opts.Budget.Deposit()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered to let abandoned attempts finish without blocking.
+	results := make(chan result[T], opts.MaxAttempts)
+	started := 0
+	pending := 0
+	start := func() bool {
+		if started == opts.MaxAttempts {
+			return false
+		}
+		if started > 0 && opts.Budget != nil && !opts.Budget.TryWithdraw(ctx) {
+			return false
+		}
+		started++
+		pending++
+		go func() {
+			val, err := fn(ctx)
+			results <- result[T]{val, err}
+		}()
+		return true
+	}
+
+	timer := clock.NewTimer(clock.Tag(ctx, "hedge"))
+	defer timer.Stop()
+
+	start()
+	timer.Reset(opts.Delay)
+
+	var zero T
+	var lastErr error
+	for {
+		select {
+		case res := <-results:
+			pending--
+			switch {
+			case res.err == nil:
+				return res.val, nil
+			case !transient.Tag.In(res.err):
+				return zero, res.err
+			}
+			lastErr = res.err
+			if !start() && pending == 0 {
+				return zero, lastErr
+			}
+			// Waiting for another attempt, give it the full delay.
+			timer.Reset(opts.Delay)
+
+		case tr := <-timer.GetC():
+			if tr.Incomplete() {
+				return zero, ctx.Err()
+			}
+			if start() {
+				timer.Reset(opts.Delay)
+			}
+
+		case <-ctx.Done():
+			// The timer may be stopped by now if no more attempts can be started,
+			// so watch the context directly.
+			return zero, ctx.Err()
+		}
+	}
+}
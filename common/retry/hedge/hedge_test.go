@@ -0,0 +1,151 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hedge
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/budget"
+	"go.chromium.org/luci/common/retry/transient"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Do`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		opts := Options{Delay: time.Second}
+
+		var calls int32
+		// attempt wraps fn, passing it the number of the attempt (starting at 0).
+		attempt := func(fn func(ctx context.Context, n int32) (string, error)) func(context.Context) (string, error) {
+			return func(ctx context.Context) (string, error) {
+				return fn(ctx, atomic.AddInt32(&calls, 1)-1)
+			}
+		}
+
+		Convey(`Fast success`, func() {
+			res, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				return "ok", nil
+			}))
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "ok")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 1)
+		})
+
+		Convey(`Delay is required`, func() {
+			opts.Delay = 0
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				return "ok", nil
+			}))
+			So(err, ShouldErrLike, "Delay must be positive")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 0)
+		})
+
+		Convey(`Hedges a slow attempt`, func() {
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				if testclock.HasTags(t, "hedge") {
+					tc.Add(d)
+				}
+			})
+			res, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				if n == 0 {
+					<-ctx.Done()
+					return "", ctx.Err()
+				}
+				return "hedged", nil
+			}))
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "hedged")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+		})
+
+		Convey(`Transient error starts the next attempt right away`, func() {
+			res, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				if n == 0 {
+					return "", transient.Tag.Apply(errors.New("flake"))
+				}
+				return "ok", nil
+			}))
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "ok")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+		})
+
+		Convey(`Fatal error is returned right away`, func() {
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				return "", errors.New("fatal")
+			}))
+			So(err, ShouldErrLike, "fatal")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 1)
+		})
+
+		Convey(`Returns the last error`, func() {
+			opts.MaxAttempts = 3
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				return "", transient.Tag.Apply(fmt.Errorf("flake #%d", n))
+			}))
+			So(err, ShouldErrLike, "flake #2")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+		})
+
+		Convey(`Respects the budget`, func() {
+			opts.MaxAttempts = 3
+			opts.Budget = budget.New(budget.Options{Burst: 1})
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				return "", transient.Tag.Apply(fmt.Errorf("flake #%d", n))
+			}))
+			So(err, ShouldErrLike, "flake #1")
+			So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+		})
+
+		Convey(`Context cancellation`, func() {
+			ctx, cancel := context.WithCancel(ctx)
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				cancel()
+				<-ctx.Done()
+				return "", ctx.Err()
+			}))
+			So(err, ShouldEqual, context.Canceled)
+		})
+
+		Convey(`Context cancellation with attempts ignoring it`, func() {
+			release := make(chan struct{})
+			defer close(release)
+
+			// Use the real clock: the hedge timer must fire and stop (since no
+			// more attempts are allowed) before the context is canceled.
+			ctx, cancel := context.WithCancel(context.Background())
+			opts := Options{Delay: time.Millisecond, MaxAttempts: 1}
+			_, err := Do(ctx, opts, attempt(func(ctx context.Context, n int32) (string, error) {
+				time.Sleep(50 * time.Millisecond)
+				cancel()
+				<-release
+				return "too late", nil
+			}))
+			So(err, ShouldEqual, context.Canceled)
+		})
+	})
+}
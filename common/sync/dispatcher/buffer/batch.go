@@ -29,6 +29,16 @@ import (
 type BatchItem[T any] struct {
 	Item T
 	Size int
+
+	// id is an opaque identifier of the item, as provided to AddNoBlockWithID.
+	id uint64
+}
+
+// ID returns the identifier of the item provided to AddNoBlockWithID.
+//
+// Returns 0 for items added with AddNoBlock.
+func (bi BatchItem[T]) ID() uint64 {
+	return bi.id
 }
 
 // Batch represents a collection of individual work items and associated
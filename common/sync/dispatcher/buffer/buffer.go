@@ -158,6 +158,15 @@ func (buf *Buffer[T]) dropOldest() (dropped *Batch[T]) {
 //   - ErrItemTooSmall - If this buffer has a BatchSizeMax configured and
 //     `itemSize` is zero, or if `itemSize` is negative.
 func (buf *Buffer[T]) AddNoBlock(now time.Time, item T, itemSize int) (dropped *Batch[T], err error) {
+	return buf.AddNoBlockWithID(now, item, itemSize, 0)
+}
+
+// AddNoBlockWithID is like AddNoBlock, but also associates an opaque
+// identifier with the item.
+//
+// The identifier is available via BatchItem.ID. The Buffer doesn't interpret
+// it in any way.
+func (buf *Buffer[T]) AddNoBlockWithID(now time.Time, item T, itemSize int, id uint64) (dropped *Batch[T], err error) {
 	if err = buf.opts.checkItemSize(itemSize); err != nil {
 		return
 	}
@@ -187,7 +196,7 @@ func (buf *Buffer[T]) AddNoBlock(now time.Time, item T, itemSize int) (dropped *
 		buf.lastBatchID++
 	}
 
-	buf.currentBatch.Data = append(buf.currentBatch.Data, BatchItem[T]{item, itemSize})
+	buf.currentBatch.Data = append(buf.currentBatch.Data, BatchItem[T]{Item: item, Size: itemSize, id: id})
 	buf.currentBatch.countedItems++
 	buf.currentBatch.countedSize += itemSize
 	buf.stats.addOneUnleased(itemSize)
@@ -351,17 +360,19 @@ func (buf *Buffer[T]) removeLease(leased *Batch[T]) (live bool) {
 //     is the case, AddNoBlock would already have returned this *Batch pointer
 //     to you.
 //
+// Returns true if the Batch was re-enqueued.
+//
 // Calling ACK/NACK on the same Batch twice will panic.
 // Calling ACK/NACK on a Batch not returned from LeaseOne will panic.
-func (buf *Buffer[T]) NACK(ctx context.Context, err error, leased *Batch[T]) {
+func (buf *Buffer[T]) NACK(ctx context.Context, err error, leased *Batch[T]) (requeued bool) {
 	if live := buf.removeLease(leased); !live {
-		return
+		return false
 	}
 
 	// TODO(iannucci): decouple retry from context (pass in 'now' instead)
 	switch toWait := leased.retry.Next(ctx, err); {
 	case toWait == retry.Stop:
-		return
+		return false
 	default:
 		leased.nextSend = clock.Now(ctx).Add(toWait)
 	}
@@ -375,6 +386,7 @@ func (buf *Buffer[T]) NACK(ctx context.Context, err error, leased *Batch[T]) {
 
 	buf.unleased.PushBatch(leased)
 	buf.stats.add(leased, categoryUnleased)
+	return true
 }
 
 func intMin(a, b int) int {
@@ -236,13 +236,26 @@ func TestBuffer(t *testing.T) {
 
 				So(must(addNoBlockZero(b, clock.Now(ctx), 1)), ShouldBeNil)
 
-				b.NACK(ctx, nil, b.LeaseOne(clock.Now(ctx)))
+				So(b.NACK(ctx, nil, b.LeaseOne(clock.Now(ctx))), ShouldBeTrue)
 				So(b.stats, ShouldResemble, Stats{UnleasedItemCount: 1})
-				b.NACK(ctx, nil, b.LeaseOne(clock.Now(ctx)))
+				So(b.NACK(ctx, nil, b.LeaseOne(clock.Now(ctx))), ShouldBeFalse)
 				// only one retry was allowed, start it's gone.
 				So(b.stats, ShouldResemble, Stats{})
 			})
 
+			Convey(`item IDs`, func() {
+				b, err := NewBuffer[any](&Options{BatchItemsMax: 2})
+				So(err, ShouldBeNil)
+
+				So(must(b.AddNoBlockWithID(clock.Now(ctx), "a", 0, 10)), ShouldBeNil)
+				So(must(b.AddNoBlock(clock.Now(ctx), "b", 0)), ShouldBeNil)
+
+				batch := b.LeaseOne(clock.Now(ctx))
+				So(batch.Data, ShouldHaveLength, 2)
+				So(batch.Data[0].ID(), ShouldEqual, 10)
+				So(batch.Data[1].ID(), ShouldEqual, 0)
+			})
+
 			Convey(`in-order delivery`, func() {
 				b, err := NewBuffer[any](&Options{
 					MaxLeases:     1,
@@ -179,6 +179,14 @@ func NewChannel[T any](ctx context.Context, opts *Options[T], send SendFn[T]) (C
 		return Channel[T]{}, errors.Annotate(err, "normalizing dispatcher.Options").Err()
 	}
 
+	var jrnl *journal
+	var replay []journalRecord
+	if optsCopy.Journal != nil {
+		if jrnl, replay, err = openJournal(optsCopy.Journal.Path, optsCopy.Journal.Sync); err != nil {
+			return Channel[T]{}, errors.Annotate(err, "opening the journal").Err()
+		}
+	}
+
 	itemCh := make(chan T)
 	drainCh := make(chan struct{})
 
@@ -191,6 +199,10 @@ func NewChannel[T any](ctx context.Context, opts *Options[T], send SendFn[T]) (C
 		resultCh: make(chan workerResult[T]),
 
 		timer: clock.NewTimer(clock.Tag(ctx, "coordinator")),
+
+		journal: jrnl,
+		replay:  replay,
+		leased:  map[*buffer.Batch[T]][]uint64{},
 	}
 
 	go cstate.run(ctx, send)
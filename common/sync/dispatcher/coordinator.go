@@ -20,6 +20,7 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/sync/dispatcher/buffer"
 )
 
@@ -41,6 +42,18 @@ type coordinatorState[T any] struct {
 
 	// true if our context is canceled
 	canceled bool
+
+	// The on-disk journal of pending items, if enabled in Options.
+	journal *journal
+
+	// Items loaded from the journal which are yet to be added to the buffer.
+	replay []journalRecord
+
+	// Journal IDs of items of the currently leased batches.
+	//
+	// Recorded when the batch is leased, since SendFn may modify Batch.Data
+	// concurrently with the coordinator.
+	leased map[*buffer.Batch[T]][]uint64
 }
 
 type workerResult[T any] struct {
@@ -99,6 +112,9 @@ func (state *coordinatorState[T]) sendBatches(ctx context.Context, now, prevLast
 			// got a batch! Send it.
 			state.dbg("  >sending batch")
 			lastSend = now
+			if state.journal != nil {
+				state.leased[batchToSend] = batchIDs(batchToSend)
+			}
 			go func() {
 				state.resultCh <- workerResult[T]{
 					batch: batchToSend,
@@ -187,9 +203,10 @@ func (state *coordinatorState[T]) getNextTimingEvent(now time.Time, nextQPSToken
 // getWorkChannel returns a channel to receive an individual work item on (from
 // our client) if our buffer is willing to accept additional work items.
 //
-// Otherwise returns nil.
+// Otherwise returns nil. Also returns nil while there are items to replay from
+// the journal.
 func (state *coordinatorState[T]) getWorkChannel() <-chan T {
-	if !state.closed && state.buf.CanAddItem() {
+	if !state.closed && len(state.replay) == 0 && state.buf.CanAddItem() {
 		state.dbg("  |waiting on new data")
 		return state.itemCh
 	}
@@ -203,9 +220,13 @@ func (state *coordinatorState[T]) getWorkChannel() <-chan T {
 func (state *coordinatorState[T]) handleResult(ctx context.Context, result workerResult[T]) {
 	state.dbg("  GOT RESULT")
 
+	leasedIDs := state.leased[result.batch]
+	delete(state.leased, result.batch)
+
 	if result.err == nil {
 		state.dbg("    ACK")
 		state.buf.ACK(result.batch)
+		state.journalDone(ctx, leasedIDs)
 		return
 	}
 
@@ -214,6 +235,11 @@ func (state *coordinatorState[T]) handleResult(ctx context.Context, result worke
 		state.dbg("    NO RETRY (dropping batch)")
 		state.opts.DropFn(result.batch, false)
 		state.buf.ACK(result.batch)
+		// SendFn likely failed because the context was canceled. Keep the items
+		// in the journal to retry them on the next run.
+		if ctx.Err() == nil {
+			state.journalDone(ctx, leasedIDs)
+		}
 		return
 	}
 
@@ -225,7 +251,121 @@ func (state *coordinatorState[T]) handleResult(ctx context.Context, result worke
 	}
 
 	state.dbg("    NACK")
-	state.buf.NACK(ctx, result.err, result.batch)
+	if requeued := state.buf.NACK(ctx, result.err, result.batch); requeued && state.journal != nil {
+		// Only the items SendFn removed from the batch are done.
+		remaining := make(map[uint64]struct{}, len(result.batch.Data))
+		for _, id := range batchIDs(result.batch) {
+			remaining[id] = struct{}{}
+		}
+		doneIDs := leasedIDs[:0]
+		for _, id := range leasedIDs {
+			if _, ok := remaining[id]; !ok {
+				doneIDs = append(doneIDs, id)
+			}
+		}
+		leasedIDs = doneIDs
+	}
+	state.journalDone(ctx, leasedIDs)
+}
+
+// addItem adds a new item to the buffer.
+//
+// id is the ID of the item in the journal, or 0 if it isn't journaled.
+func (state *coordinatorState[T]) addItem(ctx context.Context, now time.Time, itm T, id uint64) {
+	var itemSize int
+	if state.opts.ItemSizeFunc != nil {
+		itemSize = state.opts.ItemSizeFunc(itm)
+	}
+
+	dropped, err := state.buf.AddNoBlockWithID(now, itm, itemSize, id)
+	switch err {
+	case nil:
+	case buffer.ErrItemTooLarge:
+		state.dbg("    dropped item (too large)")
+	case buffer.ErrItemTooSmall:
+		state.dbg("    dropped item (too small)")
+	default:
+		// "impossible", since the only other possible error is ErrBufferFull,
+		// which we should have protected against in getWorkChannel.
+		panic(errors.Annotate(err, "unaccounted error from AddNoBlock").Err())
+	}
+	if err != nil {
+		state.opts.ErrorFn(&buffer.Batch[T]{
+			Data: []buffer.BatchItem[T]{{Item: itm, Size: itemSize}},
+		}, err)
+		state.journalDone(ctx, []uint64{id})
+		return
+	}
+	if dropped != nil {
+		state.dbg("    dropped batch")
+		state.opts.DropFn(dropped, false)
+		if ids, ok := state.leased[dropped]; ok {
+			state.journalDone(ctx, ids)
+		} else {
+			state.journalDone(ctx, batchIDs(dropped))
+		}
+	}
+}
+
+// replayOne adds the next item loaded from the journal to the buffer.
+func (state *coordinatorState[T]) replayOne(ctx context.Context, now time.Time) {
+	rec := state.replay[0]
+	state.replay = state.replay[1:]
+
+	state.dbg("  REPLAY")
+	itm, err := state.opts.Journal.Unmarshal(rec.data)
+	if err != nil {
+		state.dbg("    dropped item (bad journal record)")
+		state.opts.ErrorFn(&buffer.Batch[T]{}, errors.Annotate(err, "unmarshaling journaled item").Err())
+		state.journalDone(ctx, []uint64{rec.id})
+		return
+	}
+	state.addItem(ctx, now, itm, rec.id)
+}
+
+// journalItem persists a new item in the journal, if there's one.
+//
+// Returns the ID of the item in the journal or 0 if it wasn't persisted. Items
+// which can't be persisted are still processed, but only in memory.
+func (state *coordinatorState[T]) journalItem(ctx context.Context, itm T) uint64 {
+	if state.journal == nil {
+		return 0
+	}
+	data, err := state.opts.Journal.Marshal(itm)
+	if err != nil {
+		logging.Errorf(ctx, "dispatcher: failed to marshal an item for the journal: %s", err)
+		return 0
+	}
+	id, err := state.journal.add(data)
+	if err != nil {
+		logging.Errorf(ctx, "dispatcher: failed to journal an item: %s", err)
+		return 0
+	}
+	return id
+}
+
+// journalDone removes items from the journal, if there's one.
+func (state *coordinatorState[T]) journalDone(ctx context.Context, ids []uint64) {
+	if state.journal == nil || len(ids) == 0 {
+		return
+	}
+	if err := state.journal.done(ids); err != nil {
+		logging.Errorf(ctx, "dispatcher: failed to update the journal: %s", err)
+	}
+}
+
+// batchIDs returns journal IDs of items in the batch.
+func batchIDs[T any](b *buffer.Batch[T]) []uint64 {
+	if b == nil {
+		return nil
+	}
+	ids := make([]uint64, 0, len(b.Data))
+	for _, itm := range b.Data {
+		if id := itm.ID(); id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
 }
 
 // coordinator is the main goroutine for managing the state of the Channel.
@@ -237,6 +377,13 @@ func (state *coordinatorState[T]) run(ctx context.Context, send SendFn[T]) {
 	if state.opts.DrainedFn != nil {
 		defer state.opts.DrainedFn()
 	}
+	if state.journal != nil {
+		defer func() {
+			if err := state.journal.close(); err != nil {
+				logging.Errorf(ctx, "dispatcher: failed to close the journal: %s", err)
+			}
+		}()
+	}
 	defer state.opts.DropFn(nil, true)
 	defer close(state.resultCh)
 	defer state.timer.Stop()
@@ -263,6 +410,12 @@ loop:
 			break loop
 		}
 
+		// Replay journaled items before accepting new ones.
+		if len(state.replay) > 0 && !state.canceled && state.buf.CanAddItem() {
+			state.replayOne(ctx, now)
+			continue
+		}
+
 		// Only select on ctx.Done if we haven't observed its cancelation yet.
 		var doneCh <-chan struct{}
 		if !state.canceled {
@@ -273,6 +426,7 @@ loop:
 		case <-doneCh:
 			state.dbg("  GOT CANCEL (via context)")
 			state.canceled = true
+			state.replay = nil // stays in the journal
 			state.buf.Flush(now)
 
 		case result := <-state.resultCh:
@@ -286,46 +440,29 @@ loop:
 				continue
 			}
 
-			var itemSize int
-			if state.opts.ItemSizeFunc != nil {
-				itemSize = state.opts.ItemSizeFunc(itm)
-			}
 			state.dbg("  GOT NEW DATA")
+			// Journal the item before anything else, so that it survives a crash
+			// or, if the context is canceled, can be picked up by the next Channel
+			// using the same journal.
+			id := state.journalItem(ctx, itm)
 			if state.canceled {
 				state.dbg("    dropped item (canceled)")
+				var itemSize int
+				if state.opts.ItemSizeFunc != nil {
+					itemSize = state.opts.ItemSizeFunc(itm)
+				}
 				state.opts.DropFn(&buffer.Batch[T]{
 					Data: []buffer.BatchItem[T]{{Item: itm, Size: itemSize}},
 				}, false)
 				continue
 			}
-
-			dropped, err := state.buf.AddNoBlock(now, itm, itemSize)
-			switch err {
-			case nil:
-			case buffer.ErrItemTooLarge:
-				state.dbg("    dropped item (too large)")
-			case buffer.ErrItemTooSmall:
-				state.dbg("    dropped item (too small)")
-			default:
-				// "impossible", since the only other possible error is ErrBufferFull,
-				// which we should have protected against in getWorkChannel.
-				panic(errors.Annotate(err, "unaccounted error from AddNoBlock").Err())
-			}
-			if err != nil {
-				state.opts.ErrorFn(&buffer.Batch[T]{
-					Data: []buffer.BatchItem[T]{{Item: itm, Size: itemSize}},
-				}, err)
-				continue
-			}
-			if dropped != nil {
-				state.dbg("    dropped batch")
-				state.opts.DropFn(dropped, false)
-			}
+			state.addItem(ctx, now, itm, id)
 
 		case result := <-state.getNextTimingEvent(now, resDelay):
 			if result.Incomplete() {
 				state.dbg("  GOT CANCEL (via timer)")
 				state.canceled = true
+				state.replay = nil // stays in the journal
 				state.buf.Flush(now)
 				continue
 			}
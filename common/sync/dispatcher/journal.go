@@ -0,0 +1,329 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/common/errors"
+)
+
+// JournalOptions configures an on-disk write-ahead journal of a Channel.
+//
+// When a Channel has a journal, every item pushed into Channel.C is appended
+// to the journal before it is buffered. The item is removed from the journal
+// once it is no longer pending, i.e. when:
+//   - the Batch containing it was successfully sent by SendFn;
+//   - SendFn removed it from Batch.Data before returning an error;
+//   - the Batch containing it was dropped because ErrorFn returned false or
+//     because the Batch ran out of retries;
+//   - the Batch containing it was dropped due to Buffer.FullBehavior;
+//   - the item was rejected by the Buffer (e.g. buffer.ErrItemTooLarge).
+//
+// Items which are dropped because the Channel's context was canceled stay in
+// the journal.
+//
+// When a Channel is created with a journal which has pending items in it (e.g.
+// left there by a previous process which crashed or was interrupted), these
+// items are replayed into the Channel before any new items are accepted from
+// Channel.C. Replayed items go through the Buffer as usual: they are batched
+// according to Buffer.BatchItemsMax and Buffer.BatchSizeMax and are subject to
+// Buffer.FullBehavior.
+//
+// Delivery is at-least-once: an item whose Batch was sent, but not yet
+// acknowledged, at the time of the crash will be sent again.
+//
+// A journal file must not be used by more than one Channel at a time.
+type JournalOptions[T any] struct {
+	// [REQUIRED] Path to the journal file.
+	//
+	// It is created if it doesn't exist.
+	Path string
+
+	// [REQUIRED] Marshal serializes an item to be stored in the journal.
+	Marshal func(itm T) ([]byte, error)
+
+	// [REQUIRED] Unmarshal deserializes an item stored in the journal.
+	//
+	// Items which fail to deserialize are reported to ErrorFn and discarded.
+	Unmarshal func(data []byte) (T, error)
+
+	// [OPTIONAL] If true, the journal file is fsync'ed after every write.
+	//
+	// Without it, pending items survive crashes of the process, but not
+	// necessarily crashes of the machine.
+	Sync bool
+}
+
+func (o *JournalOptions[T]) validate() error {
+	switch {
+	case o.Path == "":
+		return errors.New("Journal.Path is required")
+	case o.Marshal == nil:
+		return errors.New("Journal.Marshal is required")
+	case o.Unmarshal == nil:
+		return errors.New("Journal.Unmarshal is required")
+	}
+	return nil
+}
+
+// Journal record types.
+//
+// Each record is a recordio frame which starts with the record type:
+//   - journalAdd is followed by a uvarint item ID and the serialized item.
+//   - journalDone is followed by a sequence of uvarint item IDs.
+const (
+	journalAdd  byte = 'A'
+	journalDone byte = 'D'
+)
+
+// journalMaxRecordSize limits the size of a single journal record when
+// reading it back.
+const journalMaxRecordSize = 1 << 30
+
+// journalCompactThreshold is the minimal number of IDs removed from the journal
+// before it is considered for compaction.
+const journalCompactThreshold = 4096
+
+// journalRecord is a pending item read from the journal.
+type journalRecord struct {
+	id   uint64
+	data []byte
+}
+
+// journal is an append-only file of journal records.
+//
+// It is not goroutine-safe; it is only used by the coordinator goroutine.
+type journal struct {
+	path string
+	sync bool
+	f    *os.File
+
+	// live is a set of IDs of pending items.
+	live map[uint64]struct{}
+	// lastID is the last ID assigned to an item.
+	lastID uint64
+	// removed is the number of IDs removed since the last compaction.
+	removed int
+
+	buf bytes.Buffer
+}
+
+// openJournal opens the journal file, creating it if necessary.
+//
+// Returns the journal and all items still pending in it, ordered by ID.
+func openJournal(path string, sync bool) (*journal, []journalRecord, error) {
+	pending, lastID, err := readJournal(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	j := &journal{
+		path:   path,
+		sync:   sync,
+		live:   make(map[uint64]struct{}, len(pending)),
+		lastID: lastID,
+	}
+	for _, rec := range pending {
+		j.live[rec.id] = struct{}{}
+	}
+	// Rewrite the journal, dropping no longer needed records and a possibly
+	// truncated tail.
+	if err := j.rewrite(pending); err != nil {
+		return nil, nil, err
+	}
+	return j, pending, nil
+}
+
+// readJournal reads all pending items from the journal file.
+//
+// A missing file is treated as an empty journal. A truncated last record
+// (e.g. due to a crash in the middle of a write) is ignored.
+func readJournal(path string) (pending []journalRecord, lastID uint64, err error) {
+	f, err := os.Open(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil, 0, nil
+	case err != nil:
+		return nil, 0, errors.Annotate(err, "opening journal").Err()
+	}
+	defer f.Close()
+
+	items := map[uint64][]byte{}
+	r := recordio.NewReader(bufio.NewReader(f), journalMaxRecordSize)
+	for {
+		frame, err := r.ReadFrameAll()
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			pending = make([]journalRecord, 0, len(items))
+			for id, data := range items {
+				pending = append(pending, journalRecord{id, data})
+			}
+			sort.Slice(pending, func(i, j int) bool { return pending[i].id < pending[j].id })
+			return pending, lastID, nil
+		case err != nil:
+			return nil, 0, errors.Annotate(err, "reading journal").Err()
+		case len(frame) == 0:
+			return nil, 0, errors.New("reading journal: empty record")
+		}
+
+		rd := bytes.NewReader(frame[1:])
+		switch frame[0] {
+		case journalAdd:
+			id, err := binary.ReadUvarint(rd)
+			if err != nil {
+				return nil, 0, errors.Annotate(err, "reading journal: bad item ID").Err()
+			}
+			items[id] = frame[len(frame)-rd.Len():]
+			if id > lastID {
+				lastID = id
+			}
+		case journalDone:
+			for rd.Len() > 0 {
+				id, err := binary.ReadUvarint(rd)
+				if err != nil {
+					return nil, 0, errors.Annotate(err, "reading journal: bad item ID").Err()
+				}
+				delete(items, id)
+			}
+		default:
+			return nil, 0, errors.Reason("reading journal: unknown record type %q", frame[0]).Err()
+		}
+	}
+}
+
+// rewrite atomically replaces the journal file with one containing only the
+// given records and opens it for appending.
+func (j *journal) rewrite(pending []journalRecord) error {
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Annotate(err, "creating journal").Err()
+	}
+	w := bufio.NewWriter(f)
+	for _, rec := range pending {
+		if _, err = recordio.WriteFrame(w, addRecord(nil, rec.id, rec.data)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, j.path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errors.Annotate(err, "writing journal").Err()
+	}
+
+	if j.f != nil {
+		j.f.Close()
+	}
+	if j.f, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600); err != nil {
+		return errors.Annotate(err, "opening journal").Err()
+	}
+	j.removed = 0
+	return nil
+}
+
+// add appends an item to the journal and returns its ID.
+func (j *journal) add(data []byte) (uint64, error) {
+	id := j.lastID + 1
+	if err := j.write(addRecord(nil, id, data)); err != nil {
+		return 0, err
+	}
+	j.lastID = id
+	j.live[id] = struct{}{}
+	return id, nil
+}
+
+// done removes items from the journal.
+//
+// IDs which are not pending (including 0) are skipped.
+func (j *journal) done(ids []uint64) error {
+	rec := []byte{journalDone}
+	count := 0
+	for _, id := range ids {
+		if _, ok := j.live[id]; ok {
+			rec = binary.AppendUvarint(rec, id)
+			delete(j.live, id)
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	j.removed += count
+
+	switch {
+	case len(j.live) == 0:
+		// Nothing is pending, just start from scratch.
+		return j.rewrite(nil)
+	case j.removed >= journalCompactThreshold && j.removed > len(j.live):
+		pending, _, err := readJournal(j.path)
+		if err != nil {
+			return err
+		}
+		// The file doesn't have the done record yet, filter it manually.
+		filtered := pending[:0]
+		for _, rec := range pending {
+			if _, ok := j.live[rec.id]; ok {
+				filtered = append(filtered, rec)
+			}
+		}
+		return j.rewrite(filtered)
+	default:
+		return j.write(rec)
+	}
+}
+
+// write appends a single record to the journal file.
+func (j *journal) write(rec []byte) error {
+	// Write the frame in a single call to minimize the chance of a torn record.
+	j.buf.Reset()
+	recordio.WriteFrame(&j.buf, rec)
+	if _, err := j.f.Write(j.buf.Bytes()); err != nil {
+		return errors.Annotate(err, "writing journal").Err()
+	}
+	if j.sync {
+		if err := j.f.Sync(); err != nil {
+			return errors.Annotate(err, "syncing journal").Err()
+		}
+	}
+	return nil
+}
+
+// close closes the journal file.
+func (j *journal) close() error {
+	return j.f.Close()
+}
+
+func addRecord(buf []byte, id uint64, data []byte) []byte {
+	buf = append(buf, journalAdd)
+	buf = binary.AppendUvarint(buf, id)
+	return append(buf, data...)
+}
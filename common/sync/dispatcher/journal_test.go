@@ -0,0 +1,210 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/sync/dispatcher/buffer"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+
+	Convey(`journal`, t, func() {
+		path := filepath.Join(t.TempDir(), "journal")
+
+		Convey(`empty`, func() {
+			j, pending, err := openJournal(path, false)
+			So(err, ShouldBeNil)
+			So(pending, ShouldBeEmpty)
+			So(j.close(), ShouldBeNil)
+		})
+
+		Convey(`add and done`, func() {
+			j, _, err := openJournal(path, true)
+			So(err, ShouldBeNil)
+			for _, itm := range []string{"a", "b", "c", "d"} {
+				_, err := j.add([]byte(itm))
+				So(err, ShouldBeNil)
+			}
+			So(j.done([]uint64{2, 4, 100}), ShouldBeNil)
+			So(j.close(), ShouldBeNil)
+
+			j, pending, err := openJournal(path, false)
+			So(err, ShouldBeNil)
+			So(pending, ShouldResemble, []journalRecord{
+				{1, []byte("a")},
+				{3, []byte("c")},
+			})
+
+			Convey(`IDs are not reused`, func() {
+				id, err := j.add([]byte("e"))
+				So(err, ShouldBeNil)
+				So(id, ShouldEqual, 5)
+				So(j.close(), ShouldBeNil)
+			})
+
+			Convey(`truncated when nothing is pending`, func() {
+				So(j.done([]uint64{1, 3}), ShouldBeNil)
+				So(j.close(), ShouldBeNil)
+
+				st, err := os.Stat(path)
+				So(err, ShouldBeNil)
+				So(st.Size(), ShouldEqual, 0)
+			})
+		})
+
+		Convey(`ignores a torn record`, func() {
+			j, _, err := openJournal(path, false)
+			So(err, ShouldBeNil)
+			_, err = j.add([]byte("a"))
+			So(err, ShouldBeNil)
+			_, err = j.f.Write([]byte{10, journalAdd, 2, 'b'})
+			So(err, ShouldBeNil)
+			So(j.close(), ShouldBeNil)
+
+			j, pending, err := openJournal(path, false)
+			So(err, ShouldBeNil)
+			So(pending, ShouldResemble, []journalRecord{{1, []byte("a")}})
+			So(j.close(), ShouldBeNil)
+		})
+
+		Convey(`compacts`, func() {
+			j, _, err := openJournal(path, false)
+			So(err, ShouldBeNil)
+			_, err = j.add([]byte("keep"))
+			So(err, ShouldBeNil)
+			for i := 0; i < journalCompactThreshold; i++ {
+				id, err := j.add([]byte("item"))
+				So(err, ShouldBeNil)
+				So(j.done([]uint64{id}), ShouldBeNil)
+			}
+			So(j.removed, ShouldBeLessThan, journalCompactThreshold)
+
+			st, err := os.Stat(path)
+			So(err, ShouldBeNil)
+			So(st.Size(), ShouldBeLessThan, 1000)
+			So(j.close(), ShouldBeNil)
+
+			pending, _, err := readJournal(path)
+			So(err, ShouldBeNil)
+			So(pending, ShouldResemble, []journalRecord{{1, []byte("keep")}})
+		})
+	})
+}
+
+func TestChannelWithJournal(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Channel with a journal`, t, func() {
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "journal")
+
+		opts := func() *Options[string] {
+			return &Options[string]{
+				DropFn: DropFnQuiet[string],
+				Buffer: buffer.Options{
+					BatchItemsMax: 2,
+				},
+				Journal: &JournalOptions[string]{
+					Path:      path,
+					Marshal:   func(s string) ([]byte, error) { return []byte(s), nil },
+					Unmarshal: func(b []byte) (string, error) { return string(b), nil },
+				},
+			}
+		}
+
+		// runChannel pushes items into a new Channel and waits for it to drain.
+		//
+		// Returns all items sent successfully.
+		runChannel := func(ctx context.Context, cancel func(), items []string, sendErr error) (sent []string) {
+			var m sync.Mutex
+			ch, err := NewChannel[string](ctx, opts(), func(b *buffer.Batch[string]) error {
+				if sendErr != nil {
+					return sendErr
+				}
+				m.Lock()
+				defer m.Unlock()
+				for _, itm := range b.Data {
+					sent = append(sent, itm.Item)
+				}
+				return nil
+			})
+			So(err, ShouldBeNil)
+			for _, itm := range items {
+				ch.C <- itm
+			}
+			if cancel != nil {
+				cancel()
+			}
+			ch.CloseAndDrain(ctx)
+			return
+		}
+
+		Convey(`bad options`, func() {
+			o := opts()
+			o.Journal.Marshal = nil
+			_, err := NewChannel[string](ctx, o, dummySendFn[string])
+			So(err, ShouldErrLike, "Journal.Marshal is required")
+		})
+
+		Convey(`successfully sent items are removed`, func() {
+			sent := runChannel(ctx, nil, []string{"a", "b", "c"}, nil)
+			So(sent, ShouldHaveLength, 3)
+
+			pending, _, err := readJournal(path)
+			So(err, ShouldBeNil)
+			So(pending, ShouldBeEmpty)
+		})
+
+		Convey(`unsent items are replayed`, func() {
+			cctx, cancel := context.WithCancel(ctx)
+			sent := runChannel(cctx, cancel, []string{"a", "b", "c"}, transient.Tag.Apply(errors.New("boom")))
+			So(sent, ShouldBeEmpty)
+
+			pending, _, err := readJournal(path)
+			So(err, ShouldBeNil)
+			So(pending, ShouldHaveLength, 3)
+
+			sent = runChannel(ctx, nil, []string{"d"}, nil)
+			sort.Strings(sent)
+			So(sent, ShouldResemble, []string{"a", "b", "c", "d"})
+
+			pending, _, err = readJournal(path)
+			So(err, ShouldBeNil)
+			So(pending, ShouldBeEmpty)
+		})
+
+		Convey(`dropped items are removed`, func() {
+			sent := runChannel(ctx, nil, []string{"a", "b", "c"}, errors.New("fatal"))
+			So(sent, ShouldBeEmpty)
+
+			pending, _, err := readJournal(path)
+			So(err, ShouldBeNil)
+			So(pending, ShouldBeEmpty)
+		})
+	})
+}
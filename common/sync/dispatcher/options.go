@@ -146,6 +146,14 @@ type Options[T any] struct {
 
 	Buffer buffer.Options
 
+	// [OPTIONAL] If set, the Channel persists pending items in an on-disk
+	// journal, so they survive restarts of the process.
+	//
+	// See JournalOptions for details.
+	//
+	// Default: pending items are kept only in memory.
+	Journal *JournalOptions[T]
+
 	// Debug output for tests.
 	testingDbg func(string, ...any)
 }
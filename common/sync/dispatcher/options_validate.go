@@ -32,6 +32,12 @@ func (o *Options[T]) normalize(ctx context.Context) error {
 		}
 	}
 
+	if o.Journal != nil {
+		if err := o.Journal.validate(); err != nil {
+			return err
+		}
+	}
+
 	if o.ErrorFn == nil {
 		o.ErrorFn = defaultErrorFnFactory[T](ctx)
 	}
@@ -15,6 +15,7 @@
 package distribution
 
 import (
+	"math"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -44,6 +45,10 @@ func TestFixedWidthBucketer(t *testing.T) {
 		So(b.Bucket(5), ShouldEqual, 1)
 		So(b.Bucket(10), ShouldEqual, 2)
 		So(b.Bucket(100), ShouldEqual, 2)
+
+		So(b.UpperBound(0), ShouldEqual, 0)
+		So(b.UpperBound(1), ShouldEqual, 10)
+		So(b.UpperBound(2), ShouldEqual, math.Inf(1))
 	})
 }
 
@@ -77,5 +82,9 @@ func TestGeometricBucketer(t *testing.T) {
 		So(b.Bucket(16), ShouldEqual, 3)
 		So(b.Bucket(63), ShouldEqual, 3)
 		So(b.Bucket(64), ShouldEqual, 4)
+
+		So(b.UpperBound(0), ShouldEqual, 1)
+		So(b.UpperBound(3), ShouldEqual, 64)
+		So(b.UpperBound(5), ShouldEqual, math.Inf(1))
 	})
 }
@@ -58,7 +58,8 @@ func (fl *Flags) Register(f *flag.FlagSet) {
 			"deployment of credentials.")
 	f.StringVar(&fl.Endpoint, "ts-mon-endpoint", fl.Endpoint,
 		"url (including file://, https://, pubsub://project/topic) to post "+
-			"monitoring metrics to. If set, overrides the value in "+
+			"monitoring metrics to, or prometheus://host:port/path to serve them "+
+			"for scraping. If set, overrides the value in "+
 			"--ts-mon-config-file")
 	f.StringVar(&fl.Credentials, "ts-mon-credentials", fl.Credentials,
 		"path to a pkcs8 json credential file. If set, overrides the value in "+
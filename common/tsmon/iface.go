@@ -186,6 +186,14 @@ func initMonitor(ctx context.Context, cfg config) (monitor.Monitor, error) {
 		}
 
 		return monitor.NewHTTPMonitor(ctx, client, endpointURL)
+	case "prometheus":
+		// E.g. "prometheus://:9090/metrics". Metrics are served for scraping
+		// instead of being pushed.
+		mon, err := monitor.ListenPrometheus(ctx, endpointURL.Host, endpointURL.Path, "")
+		if err != nil {
+			return nil, err
+		}
+		return mon, nil
 	default:
 		return nil, fmt.Errorf("unknown tsmon endpoint url: %s", cfg.Endpoint)
 	}
@@ -0,0 +1,371 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	pb "go.chromium.org/luci/common/tsmon/ts_mon_proto"
+	"go.chromium.org/luci/common/tsmon/types"
+)
+
+// PrometheusContentType is the content type of the Prometheus text exposition
+// format served by PrometheusMonitor.
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// prodxLabels are root labels added by targets for the benefit of ProdX.
+//
+// They carry no information about the target and are not exported.
+var prodxLabels = map[string]bool{
+	"proxy_environment": true,
+	"acquisition_name":  true,
+	"proxy_zone":        true,
+}
+
+// PrometheusMonitor is a Monitor that exposes metrics as a Prometheus scrape
+// endpoint.
+//
+// Each Send replaces the set of exported metrics with the given cells, so
+// scrapes observe the state of the store as of the most recent flush.
+//
+// Metrics are converted as follows:
+//   - Cumulative int and float metrics become counters.
+//   - Non-cumulative int, float and bool metrics become gauges. Bools are
+//     exported as 0 or 1.
+//   - String metrics become gauges with value 1 and a "value" label.
+//   - Distributions become histograms. Note that tsmon bucket upper bounds are
+//     exclusive, while Prometheus "le" bounds are inclusive.
+//
+// Metric names are sanitized by replacing characters not allowed by
+// Prometheus with "_", e.g. "grpc/server/count" becomes "grpc_server_count".
+// Metric fields and target root labels (e.g. "job_name" and "host_name" of
+// a target.Task) become labels. Fields that clash with target labels are
+// prefixed with "exported_".
+//
+// PrometheusMonitor implements http.Handler.
+type PrometheusMonitor struct {
+	// Namespace, if not empty, is prepended to all metric names.
+	Namespace string
+
+	m       sync.RWMutex
+	payload []byte
+	srv     *http.Server
+	addr    string
+}
+
+// NewPrometheusMonitor returns a Monitor that exposes metrics in Prometheus
+// text exposition format.
+//
+// The returned monitor should be mounted as an HTTP handler, or started via
+// ListenPrometheus.
+func NewPrometheusMonitor(namespace string) *PrometheusMonitor {
+	return &PrometheusMonitor{Namespace: namespace}
+}
+
+// ListenPrometheus returns a PrometheusMonitor serving metrics on the given
+// address and path.
+//
+// The listening socket is opened synchronously, requests are served in
+// a background goroutine until the monitor is closed.
+func ListenPrometheus(ctx context.Context, addr, path, namespace string) (*PrometheusMonitor, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to listen on %q", addr).Err()
+	}
+	if path == "" {
+		path = "/metrics"
+	}
+
+	m := NewPrometheusMonitor(namespace)
+	m.addr = l.Addr().String()
+	mux := http.NewServeMux()
+	mux.Handle(path, m)
+	m.srv = &http.Server{
+		Handler:     mux,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	go m.srv.Serve(l)
+	return m, nil
+}
+
+// Addr returns the address the monitor is listening on.
+//
+// Returns an empty string if the monitor wasn't started via ListenPrometheus.
+func (m *PrometheusMonitor) Addr() string {
+	return m.addr
+}
+
+// ChunkSize implements Monitor.
+func (m *PrometheusMonitor) ChunkSize() int {
+	return 0
+}
+
+// Send implements Monitor.
+func (m *PrometheusMonitor) Send(ctx context.Context, cells []types.Cell) error {
+	payload := m.render(cells)
+	m.m.Lock()
+	m.payload = payload
+	m.m.Unlock()
+	return nil
+}
+
+// Close implements Monitor.
+func (m *PrometheusMonitor) Close() error {
+	if m.srv != nil {
+		return m.srv.Close()
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+func (m *PrometheusMonitor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	m.m.RLock()
+	payload := m.payload
+	m.m.RUnlock()
+
+	rw.Header().Set("Content-Type", PrometheusContentType)
+	rw.Write(payload)
+}
+
+// promFamily is a group of series sharing the same metric name.
+type promFamily struct {
+	name   string
+	help   string
+	typ    string
+	series map[string][]byte // serialized labels => serialized samples
+}
+
+func (m *PrometheusMonitor) render(cells []types.Cell) []byte {
+	families := map[string]*promFamily{}
+	for _, c := range cells {
+		name := promName(c.Name)
+		if m.Namespace != "" {
+			name = promName(m.Namespace) + "_" + name
+		}
+		f := families[name]
+		if f == nil {
+			f = &promFamily{
+				name:   name,
+				help:   c.Description,
+				typ:    promType(c.ValueType),
+				series: map[string][]byte{},
+			}
+			families[name] = f
+		}
+
+		labels := promLabels(c)
+		var buf bytes.Buffer
+		writeSamples(&buf, name, labels, c)
+		f.series[labels.String()] = buf.Bytes()
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out bytes.Buffer
+	for _, name := range names {
+		f := families[name]
+		if f.help != "" {
+			fmt.Fprintf(&out, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		}
+		fmt.Fprintf(&out, "# TYPE %s %s\n", f.name, f.typ)
+
+		keys := make([]string, 0, len(f.series))
+		for k := range f.series {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			out.Write(f.series[k])
+		}
+	}
+	return out.Bytes()
+}
+
+// writeSamples writes the samples of a single cell.
+func writeSamples(buf *bytes.Buffer, name string, labels promLabelSet, c types.Cell) {
+	switch v := c.Value.(type) {
+	case int64:
+		writeSample(buf, name, labels, float64(v))
+	case float64:
+		writeSample(buf, name, labels, v)
+	case bool:
+		val := 0.0
+		if v {
+			val = 1
+		}
+		writeSample(buf, name, labels, val)
+	case string:
+		writeSample(buf, name, labels.with("value", v), 1)
+	case *distribution.Distribution:
+		b := v.Bucketer()
+		buckets := v.Buckets()
+		cumulative := int64(0)
+		for i := 0; i < b.OverflowBucket(); i++ {
+			if i < len(buckets) {
+				cumulative += buckets[i]
+			}
+			le := formatFloat(b.UpperBound(i))
+			writeSample(buf, name+"_bucket", labels.with("le", le), float64(cumulative))
+		}
+		writeSample(buf, name+"_bucket", labels.with("le", "+Inf"), float64(v.Count()))
+		writeSample(buf, name+"_sum", labels, v.Sum())
+		writeSample(buf, name+"_count", labels, float64(v.Count()))
+	default:
+		panic(fmt.Errorf("unsupported cell value type %T", c.Value))
+	}
+}
+
+func writeSample(buf *bytes.Buffer, name string, labels promLabelSet, val float64) {
+	buf.WriteString(name)
+	buf.WriteString(labels.String())
+	buf.WriteByte(' ')
+	buf.WriteString(formatFloat(val))
+	buf.WriteByte('\n')
+}
+
+func promType(vt types.ValueType) string {
+	switch vt {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		return "counter"
+	case types.NonCumulativeDistributionType, types.CumulativeDistributionType:
+		return "histogram"
+	default:
+		return "gauge"
+	}
+}
+
+// promLabel is a single name/value label pair.
+type promLabel struct {
+	name, value string
+}
+
+// promLabelSet is an ordered list of labels.
+type promLabelSet []promLabel
+
+// with returns a copy of the set with an extra label appended.
+func (ls promLabelSet) with(name, value string) promLabelSet {
+	out := make(promLabelSet, len(ls), len(ls)+1)
+	copy(out, ls)
+	return append(out, promLabel{name, value})
+}
+
+// String returns the labels serialized as `{a="b",c="d"}`.
+func (ls promLabelSet) String() string {
+	if len(ls) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, l := range ls {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(l.name)
+		sb.WriteString(`="`)
+		sb.WriteString(escapeLabelValue(l.value))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// promLabels returns target root labels followed by metric field labels.
+func promLabels(c types.Cell) promLabelSet {
+	var labels promLabelSet
+	seen := map[string]bool{}
+
+	if c.Target != nil {
+		d := &pb.MetricsCollection{}
+		c.Target.PopulateProto(d)
+		for _, l := range d.RootLabels {
+			if prodxLabels[l.GetKey()] {
+				continue
+			}
+			name := promName(l.GetKey())
+			var value string
+			switch v := l.Value.(type) {
+			case *pb.MetricsCollection_RootLabels_StringValue:
+				value = v.StringValue
+			case *pb.MetricsCollection_RootLabels_Int64Value:
+				value = strconv.FormatInt(v.Int64Value, 10)
+			case *pb.MetricsCollection_RootLabels_BoolValue:
+				value = strconv.FormatBool(v.BoolValue)
+			}
+			seen[name] = true
+			labels = append(labels, promLabel{name, value})
+		}
+	}
+
+	for i, f := range c.Fields {
+		name := promName(f.Name)
+		if seen[name] || name == "le" || name == "value" {
+			name = "exported_" + name
+		}
+		seen[name] = true
+		labels = append(labels, promLabel{name, fmt.Sprint(c.FieldVals[i])})
+	}
+	return labels
+}
+
+// promName replaces characters not allowed in Prometheus metric and label
+// names with "_".
+func promName(name string) string {
+	out := []byte(name)
+	for i, c := range out {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			out[i] = '_'
+		}
+	}
+	return string(out)
+}
+
+var (
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabelValue(v string) string { return labelValueEscaper.Replace(v) }
+func escapeHelp(v string) string       { return helpEscaper.Replace(v) }
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/target"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrometheusMonitor(t *testing.T) {
+	t.Parallel()
+
+	Convey("PrometheusMonitor", t, func() {
+		ctx := context.Background()
+		m := NewPrometheusMonitor("")
+
+		task := &target.Task{
+			ServiceName: "service",
+			JobName:     "job",
+			DataCenter:  "dc",
+			HostName:    "host",
+			TaskNum:     1,
+		}
+
+		cell := func(name string, vt types.ValueType, fields []field.Field, fieldVals []any, value any) types.Cell {
+			return types.Cell{
+				MetricInfo: types.MetricInfo{
+					Name:        name,
+					Description: "Description of " + name,
+					Fields:      fields,
+					ValueType:   vt,
+				},
+				CellData: types.CellData{
+					FieldVals: fieldVals,
+					Target:    task,
+					Value:     value,
+				},
+			}
+		}
+
+		scrape := func() string {
+			rec := httptest.NewRecorder()
+			m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+			So(rec.Code, ShouldEqual, http.StatusOK)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, PrometheusContentType)
+			return rec.Body.String()
+		}
+
+		Convey("Empty before the first Send", func() {
+			So(scrape(), ShouldEqual, "")
+		})
+
+		Convey("Counters and gauges", func() {
+			codeField := []field.Field{field.String("code")}
+			So(m.Send(ctx, []types.Cell{
+				cell("grpc/server/count", types.CumulativeIntType, codeField, []any{"OK"}, int64(10)),
+				cell("grpc/server/count", types.CumulativeIntType, codeField, []any{"NOT_FOUND"}, int64(2)),
+				cell("proc/load", types.NonCumulativeFloatType, nil, nil, 0.5),
+				cell("proc/up", types.BoolType, nil, nil, true),
+				cell("proc/version", types.StringType, nil, nil, `v"1"`),
+			}), ShouldBeNil)
+
+			So(scrape(), ShouldEqual, `# HELP grpc_server_count Description of grpc/server/count
+# TYPE grpc_server_count counter
+grpc_server_count{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",code="NOT_FOUND"} 2
+grpc_server_count{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",code="OK"} 10
+# HELP proc_load Description of proc/load
+# TYPE proc_load gauge
+proc_load{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1"} 0.5
+# HELP proc_up Description of proc/up
+# TYPE proc_up gauge
+proc_up{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1"} 1
+# HELP proc_version Description of proc/version
+# TYPE proc_version gauge
+proc_version{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",value="v\"1\""} 1
+`)
+		})
+
+		Convey("Send replaces previous metrics", func() {
+			So(m.Send(ctx, []types.Cell{
+				cell("a", types.CumulativeIntType, nil, nil, int64(1)),
+			}), ShouldBeNil)
+			So(m.Send(ctx, []types.Cell{
+				cell("b", types.CumulativeIntType, nil, nil, int64(2)),
+			}), ShouldBeNil)
+			So(scrape(), ShouldNotContainSubstring, "a{")
+			So(scrape(), ShouldContainSubstring, "b{")
+		})
+
+		Convey("Distributions", func() {
+			d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(-1)
+			d.Add(5)
+			d.Add(15)
+			d.Add(100)
+
+			m.Namespace = "luci"
+			So(m.Send(ctx, []types.Cell{
+				cell("latency", types.CumulativeDistributionType, nil, nil, d),
+			}), ShouldBeNil)
+
+			So(scrape(), ShouldEqual, `# HELP luci_latency Description of latency
+# TYPE luci_latency histogram
+luci_latency_bucket{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",le="0"} 1
+luci_latency_bucket{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",le="10"} 2
+luci_latency_bucket{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",le="20"} 3
+luci_latency_bucket{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1",le="+Inf"} 4
+luci_latency_sum{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1"} 119
+luci_latency_count{service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1"} 4
+`)
+		})
+
+		Convey("Network device targets and clashing fields", func() {
+			c := cell("dev/metric", types.NonCumulativeIntType,
+				[]field.Field{field.String("role"), field.Int("port")},
+				[]any{"field role", int64(80)},
+				int64(5))
+			c.Target = &target.NetworkDevice{
+				Metro:     "metro",
+				Role:      "role",
+				Hostname:  "hostname",
+				Hostgroup: "hostgroup",
+			}
+			So(m.Send(ctx, []types.Cell{c}), ShouldBeNil)
+
+			So(scrape(), ShouldContainSubstring,
+				`dev_metric{pop="",alertable="true",realm="ACQ_CHROME",asn="0",metro="metro",role="role",`+
+					`hostname="hostname",vendor="",hostgroup="hostgroup",exported_role="field role",port="80"} 5`)
+		})
+
+		Convey("ListenPrometheus", func() {
+			lm, err := ListenPrometheus(ctx, "127.0.0.1:0", "/metrics", "")
+			So(err, ShouldBeNil)
+			defer lm.Close()
+
+			So(lm.Send(ctx, []types.Cell{
+				cell("a", types.CumulativeIntType, nil, nil, int64(1)),
+			}), ShouldBeNil)
+
+			resp, err := http.Get("http://" + lm.Addr() + "/metrics")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			So(err, ShouldBeNil)
+			So(string(body), ShouldContainSubstring, "# TYPE a counter\n")
+		})
+	})
+}
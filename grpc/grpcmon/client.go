@@ -82,6 +82,20 @@ var (
 		sizeBucket,
 		field.String("method"))
 
+	grpcClientSentUncompressedByte = metric.NewCumulativeDistribution(
+		"grpc/client/sent_uncompressed_bytes",
+		"Size distribution of request protocol messages before compression.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
+
+	grpcClientRecvUncompressedByte = metric.NewCumulativeDistribution(
+		"grpc/client/received_uncompressed_bytes",
+		"Size distribution of response protocol messages after decompression.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
+
 	rtKey = "Holds the current rpc tag"
 )
 
@@ -99,8 +113,9 @@ func reportClientRPCMetrics(ctx context.Context, method string, err error, dur t
 // ClientRPCStatsMonitor implements stats.Handler to update tsmon metrics with
 // RPC stats.
 //
-// Can be passed to a gRPC client via WithStatsHandler(...) dial option.
-// To chain this with other stats handler, use WithMultiStatsHandler.
+// Can be passed to a gRPC client via WithStatsHandler(...) dial option or to
+// a pRPC client via its StatsHandler field. To chain this with other stats
+// handler, use WithMultiStatsHandler.
 type ClientRPCStatsMonitor struct{}
 
 // TagRPC creates a context for the RPC.
@@ -130,6 +145,7 @@ func (m *ClientRPCStatsMonitor) handleRPCInPayload(ctx context.Context, p *stats
 	n := methodNameFromTag(ctx)
 	grpcClientRecvMsg.Add(ctx, 1, n)
 	grpcClientRecvByte.Add(ctx, float64(p.WireLength), n)
+	grpcClientRecvUncompressedByte.Add(ctx, float64(p.Length), n)
 }
 
 // handleRPC updates the metrics with the information for an outgoing payload.
@@ -137,6 +153,7 @@ func (m *ClientRPCStatsMonitor) handleRPCOutPayload(ctx context.Context, p *stat
 	n := methodNameFromTag(ctx)
 	grpcClientSentMsg.Add(ctx, 1, n)
 	grpcClientSentByte.Add(ctx, float64(p.WireLength), n)
+	grpcClientSentUncompressedByte.Add(ctx, float64(p.Length), n)
 }
 
 // HandleRPC processes the RPC stats.
@@ -130,6 +130,11 @@ func TestClientRPCStatsMonitor(t *testing.T) {
 			sentBytes, recvBytes := bytes("OK")
 			So(sentBytes, ShouldBeGreaterThan, 0)
 			So(recvBytes, ShouldBeGreaterThan, 0)
+
+			sentRaw := memStore.Get(ctx, grpcClientSentUncompressedByte, time.Time{}, fields())
+			So(sentRaw.(*distribution.Distribution).Sum(), ShouldBeGreaterThan, 0)
+			recvRaw := memStore.Get(ctx, grpcClientRecvUncompressedByte, time.Time{}, fields())
+			So(recvRaw.(*distribution.Distribution).Sum(), ShouldBeGreaterThan, 0)
 		})
 	})
 }
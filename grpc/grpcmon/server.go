@@ -22,6 +22,7 @@ import (
 	gcode "google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/clock"
@@ -48,6 +49,36 @@ var (
 		field.String("method"),         // full name of the grpc method
 		field.Int("code"),              // status.Code of the result
 		field.String("canonical_code")) // String representation of the code above
+
+	grpcServerSentByte = metric.NewCumulativeDistribution(
+		"grpc/server/sent_bytes",
+		"Size distribution of response protocol messages. Size is the actual number "+
+			"of bytes sent on the wire, which may have been subject to compressions.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
+
+	grpcServerRecvByte = metric.NewCumulativeDistribution(
+		"grpc/server/received_bytes",
+		"Size distribution of request protocol messages. Size is the actual number "+
+			"of bytes received on the wire, which may have been subject to compressions.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
+
+	grpcServerSentUncompressedByte = metric.NewCumulativeDistribution(
+		"grpc/server/sent_uncompressed_bytes",
+		"Size distribution of response protocol messages before compression.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
+
+	grpcServerRecvUncompressedByte = metric.NewCumulativeDistribution(
+		"grpc/server/received_uncompressed_bytes",
+		"Size distribution of request protocol messages after decompression.",
+		&types.MetricMetadata{Units: types.Bytes},
+		sizeBucket,
+		field.String("method"))
 )
 
 // UnaryServerInterceptor is a grpc.UnaryServerInterceptor that gathers RPC
@@ -116,3 +147,48 @@ func reportServerRPCMetrics(ctx context.Context, method string, code codes.Code,
 	grpcServerCount.Add(ctx, 1, method, int(code), canon)
 	grpcServerDuration.Add(ctx, float64(dur.Nanoseconds()/1e6), method, int(code), canon)
 }
+
+// ServerRPCStatsMonitor implements stats.Handler to update tsmon metrics with
+// sizes of messages of served RPCs, before and after compression.
+//
+// It complements UnaryServerInterceptor and StreamServerInterceptor, which
+// report the RPC count and duration.
+//
+// Can be passed to a gRPC server via StatsHandler(...) server option or to
+// a pRPC server via its StatsHandler field.
+type ServerRPCStatsMonitor struct{}
+
+// TagRPC creates a context for the RPC.
+//
+// The context used for the rest lifetime of the RPC will be derived
+// from the returned context.
+func (m *ServerRPCStatsMonitor) TagRPC(ctx context.Context, tag *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, &rtKey, tag)
+}
+
+// HandleRPC processes the RPC stats.
+func (m *ServerRPCStatsMonitor) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	switch event := s.(type) {
+	case *stats.InPayload:
+		n := methodNameFromTag(ctx)
+		grpcServerRecvByte.Add(ctx, float64(event.WireLength), n)
+		grpcServerRecvUncompressedByte.Add(ctx, float64(event.Length), n)
+	case *stats.OutPayload:
+		n := methodNameFromTag(ctx)
+		grpcServerSentByte.Add(ctx, float64(event.WireLength), n)
+		grpcServerSentUncompressedByte.Add(ctx, float64(event.Length), n)
+	default:
+		// do nothing.
+	}
+}
+
+// TagConn creates a context for the connection.
+func (m *ServerRPCStatsMonitor) TagConn(ctx context.Context, t *stats.ConnTagInfo) context.Context {
+	// do nothing
+	return ctx
+}
+
+// HandleConn processes the Conn stats.
+func (m *ServerRPCStatsMonitor) HandleConn(context.Context, stats.ConnStats) {
+	// do nothing
+}
@@ -21,6 +21,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/clock"
@@ -30,6 +31,7 @@ import (
 	"go.chromium.org/luci/common/tsmon/distribution"
 	"go.chromium.org/luci/common/tsmon/store"
 	"go.chromium.org/luci/common/tsmon/target"
+	"go.chromium.org/luci/common/tsmon/types"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -80,6 +82,29 @@ func TestStreamServerInterceptor(t *testing.T) {
 	})
 }
 
+func TestServerRPCStatsMonitor(t *testing.T) {
+	Convey("Captures sizes of messages", t, func() {
+		c, memStore := testContext()
+
+		m := &ServerRPCStatsMonitor{}
+		c = m.TagRPC(c, &stats.RPCTagInfo{FullMethodName: "/service/method"})
+		m.HandleRPC(c, &stats.Begin{})
+		m.HandleRPC(c, &stats.InPayload{Length: 100, WireLength: 50})
+		m.HandleRPC(c, &stats.OutPayload{Length: 1000, WireLength: 200})
+		m.HandleRPC(c, &stats.End{})
+
+		sum := func(m types.Metric) float64 {
+			val := memStore.Get(c, m, time.Time{}, []any{"/service/method"})
+			So(val, ShouldNotBeNil)
+			return val.(*distribution.Distribution).Sum()
+		}
+		So(sum(grpcServerRecvByte), ShouldEqual, 50)
+		So(sum(grpcServerRecvUncompressedByte), ShouldEqual, 100)
+		So(sum(grpcServerSentByte), ShouldEqual, 200)
+		So(sum(grpcServerSentUncompressedByte), ShouldEqual, 1000)
+	})
+}
+
 type serverStream struct {
 	grpc.ServerStream
 	ctx context.Context
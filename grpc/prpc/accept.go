@@ -16,7 +16,6 @@ package prpc
 
 import (
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 	"unicode"
@@ -140,18 +139,3 @@ func (s acceptFormatSlice) Less(i, j int) bool {
 func (s acceptFormatSlice) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
-
-// acceptsGZipResponse returns true if the server response body may be encoded
-// with GZIP.
-func acceptsGZipResponse(header http.Header) (bool, error) {
-	accept, err := parseAccept(header.Get("Accept-Encoding"))
-	if err != nil {
-		return false, err
-	}
-	for _, a := range accept {
-		if strings.EqualFold(a.Value, "gzip") {
-			return true, nil
-		}
-	}
-	return false, nil
-}
@@ -162,29 +162,42 @@ func TestAcceptContentEncoding(t *testing.T) {
 	t.Parallel()
 	Convey("Accept-Encoding", t, func() {
 		h := http.Header{}
+		test := func(header string, expected Compression) {
+			h.Set("Accept-Encoding", header)
+			c, err := responseCompression(h)
+			So(err, ShouldBeNil)
+			So(c, ShouldEqual, expected)
+		}
+
 		Convey(`Empty`, func() {
-			ok, err := acceptsGZipResponse(h)
+			c, err := responseCompression(h)
 			So(err, ShouldBeNil)
-			So(ok, ShouldBeFalse)
+			So(c, ShouldEqual, CompressionNone)
 		})
 
 		Convey(`gzip`, func() {
-			h.Set("Accept-Encoding", "gzip")
-			ok, err := acceptsGZipResponse(h)
-			So(err, ShouldBeNil)
-			So(ok, ShouldBeTrue)
+			test("gzip", CompressionGZip)
+		})
+
+		Convey(`zstd`, func() {
+			test("zstd", CompressionZstd)
 		})
 
 		Convey(`multiple values`, func() {
-			h.Add("Accept-Encoding", "gzip, deflate, br")
-			ok, err := acceptsGZipResponse(h)
-			So(err, ShouldBeNil)
-			So(ok, ShouldBeTrue)
+			test("gzip, deflate, br", CompressionGZip)
+			test("gzip, deflate, zstd", CompressionZstd)
+			test("deflate, br", CompressionNone)
+		})
+
+		Convey(`quality factors`, func() {
+			test("zstd;q=0.5, gzip", CompressionGZip)
+			test("zstd;q=0.5, gzip;q=0.5", CompressionZstd)
+			test("zstd;q=0, gzip;q=0", CompressionNone)
 		})
 
 		Convey(`invalid input`, func() {
 			h.Add("Accept-Encoding", "gzip; q=a, deflate, br")
-			_, err := acceptsGZipResponse(h)
+			_, err := responseCompression(h)
 			So(err, ShouldErrLike, "q parameter: expected a floating-point number")
 		})
 	})
@@ -32,16 +32,19 @@ import (
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/iotools"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/retry"
 	"go.chromium.org/luci/common/retry/transient"
@@ -73,15 +76,16 @@ const (
 
 var (
 	// DefaultUserAgent is default User-Agent HTTP header for pRPC requests.
-	DefaultUserAgent = "pRPC Client 1.4"
+	DefaultUserAgent = "pRPC Client 1.6"
 
 	// ErrResponseTooBig is returned by Call when the Response's body size exceeds
 	// the Client's MaxContentLength limit.
 	ErrResponseTooBig = status.Error(codes.Unavailable, "prpc: response too big")
 
 	// ErrNoStreamingSupport is returned if a pRPC client is used to start a
-	// streaming RPC. They are not supported.
-	ErrNoStreamingSupport = status.Error(codes.Unimplemented, "prpc: no streaming support")
+	// client-streaming or a bidirectional streaming RPC. They are not supported.
+	// Only server-streaming RPCs are.
+	ErrNoStreamingSupport = status.Error(codes.Unimplemented, "prpc: no client streaming support")
 )
 
 // Client can make pRPC calls.
@@ -127,9 +131,32 @@ type Client struct {
 	// INVALID_ARGUMENT error.
 	//
 	// The response compression is configured independently on the server. The
-	// client always accepts compressed responses.
+	// client always accepts gzip and zstd compressed responses.
 	EnableRequestCompression bool
 
+	// RequestCompression is the compression to use for requests if
+	// EnableRequestCompression is true.
+	//
+	// If empty, defaults to CompressionGZip. Use CompressionZstd only with
+	// servers known to support it. Other servers would fail to parse the request
+	// with INVALID_ARGUMENT error.
+	RequestCompression Compression
+
+	// RequestCompressionThreshold is the minimum size of a request, in bytes,
+	// to compress it if EnableRequestCompression is true.
+	//
+	// If <= 0, DefaultCompressionThreshold is used.
+	RequestCompressionThreshold int
+
+	// StatsHandler, if set, receives stats about RPCs made through this client,
+	// including sizes of request and response messages before and after
+	// compression.
+	//
+	// For example, grpcmon.ClientRPCStatsMonitor can be used to report them to
+	// tsmon. Only events related to RPCs are reported. TagConn and HandleConn
+	// are never called.
+	StatsHandler stats.Handler
+
 	// PathPrefix is the prefix of the URL path, "<PathPrefix>/<service>/<method>"
 	// when making HTTP requests. If not set, defaults to "/prpc".
 	PathPrefix string
@@ -150,12 +177,10 @@ var _ grpc.ClientConnInterface = (*Client)(nil)
 //
 // It is a part of grpc.ClientConnInterface.
 func (c *Client) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
-	// 'method' looks like "/service.Name/MethodName".
-	parts := strings.Split(method, "/")
-	if len(parts) != 3 || parts[0] != "" {
-		return status.Errorf(codes.Internal, "prpc: not a valid method name %q", method)
+	serviceName, methodName, err := splitMethodName(method)
+	if err != nil {
+		return err
 	}
-	serviceName, methodName := parts[1], parts[2]
 
 	// Inputs and outputs must be proto messages.
 	in, ok := args.(proto.Message)
@@ -172,9 +197,34 @@ func (c *Client) Invoke(ctx context.Context, method string, args any, reply any,
 
 // NewStream begins a streaming RPC.
 //
+// Only server-streaming RPCs are supported. Returns ErrNoStreamingSupport for
+// client-streaming and bidirectional streaming RPCs. See ClientStream for
+// details.
+//
 // It is a part of grpc.ClientConnInterface.
 func (c *Client) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-	return nil, ErrNoStreamingSupport
+	if desc.ClientStreams || !desc.ServerStreams {
+		return nil, ErrNoStreamingSupport
+	}
+	serviceName, methodName, err := splitMethodName(method)
+	if err != nil {
+		return nil, err
+	}
+	options := c.prepareOptions(opts, serviceName, methodName)
+	options.inFormat = FormatBinary
+	if err := options.pickOutFormat(); err != nil {
+		return nil, err
+	}
+	return newClientStream(ctx, c, options), nil
+}
+
+// splitMethodName splits "/service.Name/MethodName" into its components.
+func splitMethodName(method string) (serviceName, methodName string, err error) {
+	parts := strings.Split(method, "/")
+	if len(parts) != 3 || parts[0] != "" {
+		return "", "", status.Errorf(codes.Internal, "prpc: not a valid method name %q", method)
+	}
+	return parts[1], parts[2], nil
 }
 
 // prepareOptions copies client options and applies opts.
@@ -228,15 +278,8 @@ func (c *Client) Call(ctx context.Context, serviceName, methodName string, in, o
 		return status.Errorf(codes.Internal, "prpc: failed to marshal the request: %s", err)
 	}
 
-	switch options.AcceptContentSubtype {
-	case "", mtPRPCEncodingBinary:
-		options.outFormat = FormatBinary
-	case mtPRPCEncodingJSONPB:
-		options.outFormat = FormatJSONPB
-	case mtPRPCEncodingText:
-		return status.Errorf(codes.Internal, "prpc: text encoding for pRPC calls is not implemented")
-	default:
-		return status.Errorf(codes.Internal, "prpc: unrecognized contentSubtype %q of CallAcceptContentSubtype", options.AcceptContentSubtype)
+	if err := options.pickOutFormat(); err != nil {
+		return err
 	}
 
 	resp, err := c.call(ctx, options, reqBody)
@@ -244,7 +287,15 @@ func (c *Client) Call(ctx context.Context, serviceName, methodName string, in, o
 		return err
 	}
 
-	switch options.outFormat {
+	return unmarshalResponse(resp, options.outFormat, out)
+}
+
+// unmarshalResponse unmarshals a response message received by the client.
+//
+// Returns gRPC errors.
+func unmarshalResponse(resp []byte, format Format, out proto.Message) error {
+	var err error
+	switch format {
 	case FormatBinary:
 		err = proto.Unmarshal(resp, out)
 	case FormatJSONPB:
@@ -256,12 +307,11 @@ func (c *Client) Call(ctx context.Context, serviceName, methodName string, in, o
 		// recover from in a deployment scenario than breaking all callers.
 		err = (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(resp), out)
 	default:
-		err = errors.Reason("unsupported outFormat: %s", options.outFormat).Err()
+		err = errors.Reason("unsupported outFormat: %s", format).Err()
 	}
 	if err != nil {
 		return status.Errorf(codes.Internal, "prpc: failed to unmarshal the response: %s", err)
 	}
-
 	return nil
 }
 
@@ -282,7 +332,7 @@ func (c *Client) CallWithFormats(ctx context.Context, serviceName, methodName st
 }
 
 // call implements Call and CallWithFormats.
-func (c *Client) call(ctx context.Context, options *Options, in []byte) ([]byte, error) {
+func (c *Client) call(ctx context.Context, options *Options, in []byte) (out []byte, err error) {
 	md, _ := metadata.FromOutgoingContext(ctx)
 	req, err := c.prepareRequest(options, md, in)
 	if err != nil {
@@ -294,74 +344,38 @@ func (c *Client) call(ctx context.Context, options *Options, in []byte) ([]byte,
 		"method":  options.methodName,
 	})
 
+	ctx, st := startRPCStats(ctx, c.StatsHandler, fullMethodName(options.serviceName, options.methodName), true, false)
+	defer func() { st.end(err) }()
+	reqSize := payloadSize{length: len(in), wireLength: int(req.ContentLength)}
+
 	// These are populated below based on the response.
 	buf := &bytes.Buffer{}
 	contentType := ""
 
-	// Send the request in a retry loop. Use transient.Tag to propagate the retry
-	// signal from the loop body.
-	err = retry.Retry(ctx, transient.Only(options.Retry), func() (err error) {
+	// Send the request in a retry loop.
+	err = retryRPC(ctx, options, func() (err error) {
 		// Note: `buf` is reset inside, it is safe to reuse it across attempts.
-		contentType, err = c.attemptCall(ctx, options, req, buf)
-		// Retry on regular transient errors and on per-RPC deadline. If this is
-		// a global deadline (i.e. `ctx` expired), the retry loop will just exit.
-		return grpcutil.WrapIfTransientOr(err, codes.DeadlineExceeded)
-	}, func(err error, sleepTime time.Duration) {
-		logging.Fields{
-			"sleepTime": sleepTime,
-		}.Warningf(ctx, "RPC failed transiently (retry in %s): %s", sleepTime, err)
+		contentType, err = c.attemptCall(ctx, options, req, reqSize, st, buf)
+		return err
 	})
 
 	// Parse the response content type, verify it is what we expect.
 	if err == nil {
-		switch f, formatErr := FormatFromContentType(contentType); {
-		case formatErr != nil:
-			err = status.Errorf(codes.Internal, "prpc: bad response content type %q: %s", contentType, formatErr)
-		case f != options.outFormat:
-			err = status.Errorf(codes.Internal, "prpc: output format (%q) doesn't match expected format (%q)",
-				f.MediaType(), options.outFormat.MediaType())
-		}
+		err = checkContentType(contentType, options.outFormat)
 	}
 
 	if err != nil {
-		// The context error is more interesting if it is present.
-		switch cerr := ctx.Err(); {
-		case cerr == context.DeadlineExceeded:
-			err = status.Errorf(codes.DeadlineExceeded, "prpc: overall deadline exceeded: %s", context.Cause(ctx))
-		case cerr == context.Canceled:
-			err = status.Errorf(codes.Canceled, "prpc: call canceled: %s", context.Cause(ctx))
-		case cerr != nil:
-			err = status.Error(codes.Unknown, cerr.Error())
-		}
-
-		// Unwrap the error since we wrap it in retry.Retry exclusively to attach
-		// a retry signal. call(...) **must** return standard unwrapped gRPC errors.
-		err = errors.Unwrap(err)
-
-		// Convert the error into status.Error (with Unknown code) if it wasn't
-		// a status before.
-		if status, ok := status.FromError(err); !ok {
-			err = status.Err()
-		}
+		err = finalError(ctx, err)
 
 		// Log only on unexpected codes.
-		if code := status.Code(err); code != codes.Canceled {
-			ignore := false
-			for _, expected := range options.expectedCodes {
-				if code == expected {
-					ignore = true
-					break
-				}
-			}
-			if !ignore {
-				logging.Warningf(ctx, "RPC failed permanently: %s", err)
-				if options.Debug {
-					if code == codes.InvalidArgument && strings.Contains(err.Error(), "could not decode body") {
-						logging.Warningf(ctx, "Original request size: %d", len(in))
-						logging.Warningf(ctx, "Content-type: %s", options.inFormat.MediaType())
-						b64 := base64.StdEncoding.EncodeToString(in)
-						logging.Warningf(ctx, "Original request in base64 encoding: %s", b64)
-					}
+		if code := status.Code(err); code != codes.Canceled && !options.isExpectedCode(code) {
+			logging.Warningf(ctx, "RPC failed permanently: %s", err)
+			if options.Debug {
+				if code == codes.InvalidArgument && strings.Contains(err.Error(), "could not decode body") {
+					logging.Warningf(ctx, "Original request size: %d", len(in))
+					logging.Warningf(ctx, "Content-type: %s", options.inFormat.MediaType())
+					b64 := base64.StdEncoding.EncodeToString(in)
+					logging.Warningf(ctx, "Original request in base64 encoding: %s", b64)
 				}
 			}
 		}
@@ -371,13 +385,69 @@ func (c *Client) call(ctx context.Context, options *Options, in []byte) ([]byte,
 		return nil, err
 	}
 
-	out := buf.Bytes()
+	out = buf.Bytes()
 	if options.outFormat == FormatJSONPB {
 		out = bytes.TrimPrefix(out, bytesJSONPBPrefix)
 	}
 	return out, nil
 }
 
+// retryRPC runs `attempt` in a retry loop according to the Retry option.
+//
+// Retries on regular transient errors and on per-RPC deadline. If this is
+// a global deadline (i.e. `ctx` expired), the retry loop will just exit.
+func retryRPC(ctx context.Context, options *Options, attempt func() error) error {
+	// Use transient.Tag to propagate the retry signal from the loop body.
+	return retry.Retry(ctx, transient.Only(options.Retry), func() error {
+		return grpcutil.WrapIfTransientOr(attempt(), codes.DeadlineExceeded)
+	}, func(err error, sleepTime time.Duration) {
+		logging.Fields{
+			"sleepTime": sleepTime,
+		}.Warningf(ctx, "RPC failed transiently (retry in %s): %s", sleepTime, err)
+	})
+}
+
+// finalError converts an error returned by retryRPC into a gRPC error.
+//
+// Prefers the context error if it is present.
+func finalError(ctx context.Context, err error) error {
+	// The context error is more interesting if it is present.
+	switch cerr := ctx.Err(); {
+	case cerr == context.DeadlineExceeded:
+		err = status.Errorf(codes.DeadlineExceeded, "prpc: overall deadline exceeded: %s", context.Cause(ctx))
+	case cerr == context.Canceled:
+		err = status.Errorf(codes.Canceled, "prpc: call canceled: %s", context.Cause(ctx))
+	case cerr != nil:
+		err = status.Error(codes.Unknown, cerr.Error())
+	}
+
+	// Unwrap the error since we wrap it in retry.Retry exclusively to attach
+	// a retry signal. RPC methods **must** return standard unwrapped gRPC errors.
+	err = errors.Unwrap(err)
+
+	// Convert the error into status.Error (with Unknown code) if it wasn't
+	// a status before.
+	if status, ok := status.FromError(err); !ok {
+		err = status.Err()
+	}
+	return err
+}
+
+// checkContentType checks the response content type matches the expected
+// output format.
+//
+// Returns gRPC errors.
+func checkContentType(contentType string, expected Format) error {
+	switch f, err := FormatFromContentType(contentType); {
+	case err != nil:
+		return status.Errorf(codes.Internal, "prpc: bad response content type %q: %s", contentType, err)
+	case f != expected:
+		return status.Errorf(codes.Internal, "prpc: output format (%q) doesn't match expected format (%q)",
+			f.MediaType(), expected.MediaType())
+	}
+	return nil
+}
+
 // concurrencySem returns a semaphore to use to limit concurrency or nil if
 // the concurrency is unlimited.
 func (c *Client) concurrencySem() *semaphore.Weighted {
@@ -392,9 +462,10 @@ func (c *Client) concurrencySem() *semaphore.Weighted {
 // attemptCall makes one attempt at performing an RPC.
 //
 // Writes the raw response to the provided buffer, returns its content type.
+// Reports the sent request and the received response to `st`.
 //
 // Returns gRPC errors.
-func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Request, buf *bytes.Buffer) (contentType string, err error) {
+func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Request, reqSize payloadSize, st *rpcStats, buf *bytes.Buffer) (contentType string, err error) {
 	// Wait until there's an execution slot available.
 	if sem := c.concurrencySem(); sem != nil {
 		if err := sem.Acquire(ctx, 1); err != nil {
@@ -454,6 +525,9 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 		req.Header.Del(HeaderTimeout)
 	}
 
+	// Propagate the trace context (including the client span) to the server.
+	injectTraceContext(ctx, req.Header)
+
 	client := c.C
 	if client == nil {
 		client = http.DefaultClient
@@ -476,6 +550,7 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 	if err != nil {
 		return "", status.Errorf(codeForErr(err), "prpc: sending request: %s", err)
 	}
+	st.outPayload(nil, reqSize)
 
 	if options.resHeaderMetadata != nil {
 		md, err := headersIntoMetadata(res.Header)
@@ -484,7 +559,8 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 		}
 		*options.resHeaderMetadata = md
 	}
-	if err := c.readResponseBody(ctx, buf, res); err != nil {
+	wireLen, err := c.readResponseBody(ctx, buf, res)
+	if err != nil {
 		return "", err
 	}
 	if options.resTrailerMetadata != nil {
@@ -496,34 +572,57 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 	}
 
 	// Read the RPC status (perhaps with details). This is nil on success.
-	err = c.readStatus(res, buf)
+	if err = c.readStatus(res, buf); err == nil {
+		st.inPayload(nil, payloadSize{length: buf.Len(), wireLength: wireLen})
+	}
 
 	return res.Header.Get("Content-Type"), err
 }
 
-// readResponseBody copies the response body into dest.
+// readResponseBody copies the response body into dest, decompressing it if
+// necessary.
 //
-// Returns gRPC errors. If the response body size exceeds the limits or the
-// declared size, returns ErrResponseTooBig (which is also a gRPC error).
-func (c *Client) readResponseBody(ctx context.Context, dest *bytes.Buffer, r *http.Response) error {
-	limit := c.MaxContentLength
-	if limit <= 0 {
-		limit = DefaultMaxContentLength
-	}
+// Returns the number of bytes read from the wire. Returns gRPC errors. If the
+// response body size exceeds the limits or the declared size, returns
+// ErrResponseTooBig (which is also a gRPC error).
+func (c *Client) readResponseBody(ctx context.Context, dest *bytes.Buffer, r *http.Response) (int, error) {
+	limit := c.maxContentLength()
+	encoding := r.Header.Get("Content-Encoding")
 
 	dest.Reset()
 	if l := r.ContentLength; l > 0 {
 		if l > int64(limit) {
 			logging.Errorf(ctx, "ContentLength header exceeds response body limit: %d > %d.", l, limit)
-			return ErrResponseTooBig
+			return 0, ErrResponseTooBig
+		}
+		if encoding == "" {
+			limit = int(l)
+			dest.Grow(limit)
+		}
+	}
+
+	if encoding != "" {
+		// The limit applies to the decompressed body.
+		counter := &iotools.CountingReader{Reader: r.Body}
+		body, err := readCompressed(counter, encoding, limit)
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+			logging.Errorf(ctx, "Response body limit %d exceeded: %s.", limit, err)
+			return 0, ErrResponseTooBig
 		}
-		limit = int(l)
-		dest.Grow(limit)
+		if err != nil {
+			return 0, status.Errorf(codeForErr(err), "prpc: reading response: %s", err)
+		}
+		if len(body) > limit {
+			logging.Errorf(ctx, "Response body limit %d exceeded.", limit)
+			return 0, ErrResponseTooBig
+		}
+		dest.Write(body)
+		return int(counter.Count), nil
 	}
 
 	limitedBody := io.LimitReader(r.Body, int64(limit))
 	if _, err := dest.ReadFrom(limitedBody); err != nil {
-		return status.Errorf(codeForErr(err), "prpc: reading response: %s", err)
+		return 0, status.Errorf(codeForErr(err), "prpc: reading response: %s", err)
 	}
 
 	// If there is more data in the body Reader, it means that the response
@@ -531,10 +630,10 @@ func (c *Client) readResponseBody(ctx context.Context, dest *bytes.Buffer, r *ht
 	var probeB [1]byte
 	if n, err := r.Body.Read(probeB[:]); n > 0 || err != io.EOF {
 		logging.Errorf(ctx, "Response body limit %d exceeded.", limit)
-		return ErrResponseTooBig
+		return 0, ErrResponseTooBig
 	}
 
-	return nil
+	return dest.Len(), nil
 }
 
 // codeForErr decided a gRPC status code based on an http.Client error.
@@ -589,7 +688,7 @@ func (c *Client) readStatus(r *http.Response, bodyBuf *bytes.Buffer) error {
 		Code:    int32(code),
 		Message: strings.TrimSuffix(c.readErrorMessage(bodyBuf), "\n"),
 	}
-	if sp.Details, err = c.readStatusDetails(r); err != nil {
+	if sp.Details, err = c.readStatusDetails(r.Header); err != nil {
 		return err
 	}
 	return status.FromProto(sp).Err()
@@ -614,11 +713,12 @@ func (c *Client) readErrorMessage(bodyBuf *bytes.Buffer) string {
 	return string(ret)
 }
 
-// readStatusDetails reads google.rpc.Status.details from the response headers.
+// readStatusDetails reads google.rpc.Status.details from the response headers
+// (or the trailer of a server-streaming RPC).
 //
 // Returns gRPC errors.
-func (c *Client) readStatusDetails(r *http.Response) ([]*anypb.Any, error) {
-	values := r.Header[HeaderStatusDetail]
+func (c *Client) readStatusDetails(h http.Header) ([]*anypb.Any, error) {
+	values := h[HeaderStatusDetail]
 	if len(values) == 0 {
 		return nil, nil
 	}
@@ -646,6 +746,15 @@ func (c *Client) readStatusDetails(r *http.Response) ([]*anypb.Any, error) {
 	return ret, nil
 }
 
+// requestCompressionThreshold returns the minimum size of a request to
+// compress it.
+func (c *Client) requestCompressionThreshold() int {
+	if c.RequestCompressionThreshold <= 0 {
+		return DefaultCompressionThreshold
+	}
+	return c.RequestCompressionThreshold
+}
+
 // prepareRequest creates an HTTP request for an RPC.
 //
 // Initializes GetBody, so that the request can be resent multiple times when
@@ -653,9 +762,9 @@ func (c *Client) readStatusDetails(r *http.Response) ([]*anypb.Any, error) {
 func (c *Client) prepareRequest(options *Options, md metadata.MD, requestMessage []byte) (*http.Request, error) {
 	// Convert metadata into HTTP headers in canonical form (i.e. Title-Case).
 	// Extract Host header, it is special and must be passed via
-	// http.Request.Host. Preallocate 5 more slots (for 4 headers below and for
+	// http.Request.Host. Preallocate 7 more slots (for 6 headers below and for
 	// the RPC deadline header).
-	headers := make(http.Header, len(md)+5)
+	headers := make(http.Header, len(md)+7)
 	if err := metaIntoHeaders(md, headers); err != nil {
 		return nil, status.Errorf(codes.Internal, "prpc: headers: %s", err)
 	}
@@ -667,16 +776,22 @@ func (c *Client) prepareRequest(options *Options, md metadata.MD, requestMessage
 	headers.Set("Accept", options.outFormat.MediaType())
 	headers.Set("User-Agent", options.UserAgent)
 
+	// Note that setting Accept-Encoding disables the transparent decompression
+	// of gzip responses in the http package. readResponseBody decompresses them
+	// instead.
+	headers.Set("Accept-Encoding", acceptEncoding)
+
 	body := requestMessage
-	if c.EnableRequestCompression && len(requestMessage) > gzipThreshold {
-		headers.Set("Content-Encoding", "gzip")
+	if c.EnableRequestCompression && len(requestMessage) > c.requestCompressionThreshold() {
+		compression := c.RequestCompression
+		if compression == CompressionNone {
+			compression = CompressionGZip
+		}
+		headers.Set("Content-Encoding", string(compression))
 		var err error
-		if body, err = compressBlob(requestMessage); err != nil {
+		if body, err = compressBlob(requestMessage, compression); err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		// Do not add "Accept-Encoding: gzip". The http package does this
-		// automatically, and also decompresses the response.
 	}
 
 	headers.Set("Content-Length", strconv.Itoa(len(body)))
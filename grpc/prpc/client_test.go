@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/clock"
@@ -251,7 +252,7 @@ func TestClient(t *testing.T) {
 				client, server := setUp(func(w http.ResponseWriter, r *http.Request) {
 
 					// Parse request.
-					c.So(r.Header.Get("Accept-Encoding"), ShouldEqual, "gzip")
+					c.So(r.Header.Get("Accept-Encoding"), ShouldEqual, "zstd, gzip")
 					c.So(r.Header.Get("Content-Encoding"), ShouldEqual, "gzip")
 					gz, err := gzip.NewReader(r.Body)
 					c.So(err, ShouldBeNil)
@@ -267,7 +268,7 @@ func TestClient(t *testing.T) {
 					// Write response.
 					resBytes, err := proto.Marshal(&HelloReply{Message: "compressed response"})
 					c.So(err, ShouldBeNil)
-					resBody, err := compressBlob(resBytes)
+					resBody, err := compressBlob(resBytes, CompressionGZip)
 					c.So(err, ShouldBeNil)
 
 					w.Header().Set("Content-Type", mtPRPCBinary)
@@ -286,6 +287,68 @@ func TestClient(t *testing.T) {
 				So(res.Message, ShouldEqual, "compressed response")
 			})
 
+			Convey("Works with zstd compression", func(c C) {
+				req := &HelloRequest{Name: strings.Repeat("A", 1024)}
+				reply := &HelloReply{Message: strings.Repeat("B", 1024)}
+
+				client, server := setUp(func(w http.ResponseWriter, r *http.Request) {
+					c.So(r.Header.Get("Content-Encoding"), ShouldEqual, "zstd")
+					reqBody, err := readCompressed(r.Body, "zstd", 0)
+					c.So(err, ShouldBeNil)
+
+					var actualReq HelloRequest
+					c.So(proto.Unmarshal(reqBody, &actualReq), ShouldBeNil)
+					c.So(&actualReq, ShouldResembleProto, req)
+
+					resBytes, err := proto.Marshal(reply)
+					c.So(err, ShouldBeNil)
+					resBody, err := compressBlob(resBytes, CompressionZstd)
+					c.So(err, ShouldBeNil)
+
+					w.Header().Set("Content-Type", mtPRPCBinary)
+					w.Header().Set("Content-Encoding", "zstd")
+					w.Header().Set(HeaderGRPCCode, "0")
+					w.WriteHeader(http.StatusOK)
+					_, err = w.Write(resBody)
+					c.So(err, ShouldBeNil)
+				})
+				defer server.Close()
+
+				client.EnableRequestCompression = true
+				client.RequestCompression = CompressionZstd
+
+				Convey("OK", func() {
+					err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+					So(err, ShouldBeNil)
+					So(res, ShouldResembleProto, reply)
+				})
+
+				Convey("Respects MaxContentLength", func() {
+					client.MaxContentLength = 100
+					err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+					So(err, ShouldEqual, ErrResponseTooBig)
+				})
+
+				Convey("Reports stats", func() {
+					h := &statsRecorder{}
+					client.StatsHandler = h
+
+					err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+					So(err, ShouldBeNil)
+
+					So(h.method, ShouldEqual, "/prpc.Greeter/SayHello")
+					So(h.events, ShouldHaveLength, 4)
+					So(h.events[0], ShouldHaveSameTypeAs, &stats.Begin{})
+					out := h.events[1].(*stats.OutPayload)
+					So(out.Length, ShouldEqual, proto.Size(req))
+					So(out.WireLength, ShouldBeLessThan, out.Length)
+					in := h.events[2].(*stats.InPayload)
+					So(in.Length, ShouldEqual, proto.Size(reply))
+					So(in.WireLength, ShouldBeLessThan, in.Length)
+					So(h.events[3].(*stats.End).Error, ShouldBeNil)
+				})
+			})
+
 			Convey("With a deadline <= now, does not execute.", func(c C) {
 				client, server := setUp(doPanicHandler)
 				defer server.Close()
@@ -532,3 +595,24 @@ func TestClient(t *testing.T) {
 		})
 	})
 }
+
+// statsRecorder is a stats.Handler that records RPC events.
+type statsRecorder struct {
+	method string
+	events []stats.RPCStats
+}
+
+func (r *statsRecorder) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	r.method = info.FullMethodName
+	return ctx
+}
+
+func (r *statsRecorder) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	r.events = append(r.events, s)
+}
+
+func (r *statsRecorder) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *statsRecorder) HandleConn(ctx context.Context, s stats.ConnStats) {}
@@ -0,0 +1,412 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+)
+
+// clientStream implements grpc.ClientStream for server-streaming RPCs.
+//
+// The HTTP request is sent when the response headers or the first response
+// message are requested. Establishing the stream is retried according to the
+// Retry option, just like unary calls. Once the server starts streaming
+// response messages, errors are no longer retried.
+//
+// PerRPCTimeout option is not applied to streams, since they are usually long
+// living. The deadline of the context is still propagated to the server.
+//
+// If MaxConcurrentRequests is set, an open stream occupies an execution slot
+// until it is finished or its context is canceled. Canceling the context
+// releases the slot and the connection even if RecvMsg is never called again.
+type clientStream struct {
+	c       *Client
+	ctx     context.Context
+	cancel  context.CancelFunc
+	options *Options
+
+	req  []byte // the serialized request message, set in SendMsg
+	sent bool   // true if SendMsg was called
+
+	startOnce sync.Once
+	startErr  error          // an error from starting the stream
+	res       *http.Response // the response with the stream body
+	header    metadata.MD    // response headers
+	stats     *rpcStats      // nil if there's no stats handler
+
+	m         sync.Mutex
+	body      io.Closer // the response body to close in cleanup, if any
+	release   func()    // releases the concurrency slot, if any
+	cleanedUp bool      // true if cleanup was called
+
+	trailer metadata.MD // response trailer, populated at the end
+	err     error       // the final error returned by RecvMsg
+}
+
+var _ grpc.ClientStream = (*clientStream)(nil)
+
+func newClientStream(ctx context.Context, c *Client, options *Options) *clientStream {
+	ctx = logging.SetFields(ctx, logging.Fields{
+		"host":    options.host,
+		"service": options.serviceName,
+		"method":  options.methodName,
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	ctx, st := startRPCStats(ctx, c.StatsHandler, fullMethodName(options.serviceName, options.methodName), true, true)
+	s := &clientStream{
+		c:       c,
+		ctx:     ctx,
+		cancel:  cancel,
+		options: options,
+		stats:   st,
+	}
+	// Release resources of abandoned streams as soon as they are canceled.
+	context.AfterFunc(ctx, s.cleanup)
+	return s
+}
+
+// Header returns the header metadata received from the server.
+//
+// Blocks until the stream is established.
+func (s *clientStream) Header() (metadata.MD, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+	return s.header, nil
+}
+
+// Trailer returns the trailer metadata from the server.
+//
+// It must only be called after RecvMsg returns a non-nil error.
+func (s *clientStream) Trailer() metadata.MD {
+	return s.trailer
+}
+
+// CloseSend closes the send direction of the stream.
+//
+// It is a noop, since there's exactly one request message in server-streaming
+// RPCs.
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context for this stream.
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg remembers the request message to send it when the stream starts.
+//
+// Can be called only once.
+func (s *clientStream) SendMsg(m any) error {
+	if s.sent {
+		return status.Errorf(codes.Internal, "prpc: the request message was already sent")
+	}
+	s.sent = true
+	in, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad argument type %T, not a proto", m)
+	}
+	var err error
+	if s.req, err = proto.Marshal(in); err != nil {
+		return status.Errorf(codes.Internal, "prpc: failed to marshal the request: %s", err)
+	}
+	return nil
+}
+
+// RecvMsg receives the next response message.
+//
+// Returns io.EOF when the stream completes successfully or a gRPC error if it
+// fails. Either way, the stream is finished after that and all its resources
+// are released.
+func (s *clientStream) RecvMsg(m any) error {
+	if s.err != nil {
+		return s.err
+	}
+	out, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad reply type %T, not a proto", m)
+	}
+	if err := s.start(); err != nil {
+		return s.finish(err)
+	}
+
+	flags, payload, err := readFrame(s.res.Body, s.c.maxContentLength())
+	switch {
+	case err == ErrResponseTooBig:
+		logging.Errorf(s.ctx, "Response message size limit %d exceeded.", s.c.maxContentLength())
+		return s.finish(err)
+	case err == io.EOF:
+		return s.finish(status.Errorf(codes.Internal, "prpc: the stream ended without the status"))
+	case err != nil:
+		return s.finish(status.Errorf(codeForErr(err), "prpc: reading response: %s", err))
+	}
+
+	switch flags {
+	case frameMessage:
+		if err := unmarshalResponse(payload, s.options.outFormat, out); err != nil {
+			return s.finish(err)
+		}
+		s.stats.inPayload(out, payloadSize{
+			length:     len(payload),
+			wireLength: frameHeaderLen + len(payload),
+		})
+		return nil
+	case frameTrailer:
+		return s.finish(s.readTrailer(payload))
+	default:
+		return s.finish(status.Errorf(codes.Internal, "prpc: unexpected frame flags 0x%x", flags))
+	}
+}
+
+// start sends the request and waits for the response headers.
+//
+// Does it only once, returning the same result on subsequent calls.
+func (s *clientStream) start() error {
+	s.startOnce.Do(func() {
+		if !s.sent {
+			s.startErr = status.Errorf(codes.Internal, "prpc: the request message was not sent")
+			return
+		}
+		md, _ := metadata.FromOutgoingContext(s.ctx)
+		req, err := s.c.prepareRequest(s.options, md, s.req)
+		if err != nil {
+			s.startErr = err
+			return
+		}
+		reqSize := payloadSize{length: len(s.req), wireLength: int(req.ContentLength)}
+		var release func()
+		err = retryRPC(s.ctx, s.options, func() (err error) {
+			s.res, release, err = s.c.attemptStream(s.ctx, s.options, req, reqSize, s.stats)
+			return err
+		})
+		if err != nil {
+			s.startErr = finalError(s.ctx, err)
+			return
+		}
+		s.m.Lock()
+		s.body, s.release = s.res.Body, release
+		cleanedUp := s.cleanedUp
+		s.m.Unlock()
+		if cleanedUp {
+			// The context was canceled while the stream was starting.
+			s.cleanup()
+		}
+		if s.header, err = headersIntoMetadata(s.res.Header); err != nil {
+			s.startErr = status.Errorf(codes.Internal, "prpc: decoding headers: %s", err)
+			return
+		}
+		if s.options.resHeaderMetadata != nil {
+			*s.options.resHeaderMetadata = s.header
+		}
+	})
+	return s.startErr
+}
+
+// readTrailer reads the final RPC status and the trailer metadata.
+//
+// Returns io.EOF if the RPC succeeded or a gRPC error if it failed.
+func (s *clientStream) readTrailer(payload []byte) error {
+	h, err := decodeTrailer(payload)
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: decoding trailer: %s", err)
+	}
+	if s.trailer, err = headersIntoMetadata(h); err != nil {
+		return status.Errorf(codes.Internal, "prpc: decoding trailer: %s", err)
+	}
+	if s.options.resTrailerMetadata != nil {
+		*s.options.resTrailerMetadata = s.trailer
+	}
+
+	code, err := strconv.Atoi(h.Get(HeaderGRPCCode))
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: invalid %s trailer value %q", HeaderGRPCCode, h.Get(HeaderGRPCCode))
+	}
+	if codes.Code(code) == codes.OK {
+		return io.EOF
+	}
+
+	msg, err := url.PathUnescape(h.Get(headerGRPCMessage))
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: invalid %s trailer value %q", headerGRPCMessage, h.Get(headerGRPCMessage))
+	}
+	sp := &spb.Status{
+		Code:    int32(code),
+		Message: msg,
+	}
+	if sp.Details, err = s.c.readStatusDetails(h); err != nil {
+		return err
+	}
+	return status.FromProto(sp).Err()
+}
+
+// finish finishes the stream, releasing all resources.
+//
+// Returns the given error which should be either io.EOF or a gRPC error.
+func (s *clientStream) finish(err error) error {
+	if err != io.EOF {
+		if s.ctx.Err() != nil {
+			err = finalError(s.ctx, err)
+		}
+		if code := status.Code(err); code != codes.Canceled && !s.options.isExpectedCode(code) {
+			logging.Warningf(s.ctx, "RPC failed permanently: %s", err)
+		}
+	}
+	s.err = err
+	if s.res != nil {
+		// Drain the body before closing it to enable HTTP connection reuse. This
+		// is all best effort cleanup, don't check errors. On success there should
+		// be nothing left to drain.
+		if err == io.EOF {
+			io.Copy(io.Discard, s.res.Body)
+		}
+		s.res = nil
+	}
+	s.cleanup()
+	if err == io.EOF {
+		s.stats.end(nil)
+	} else {
+		s.stats.end(err)
+	}
+	s.stats = nil
+	s.cancel()
+	return err
+}
+
+// cleanup closes the response body and releases the concurrency slot.
+//
+// Called when the stream finishes or its context is canceled, whichever
+// happens first. Can be called concurrently with RecvMsg.
+func (s *clientStream) cleanup() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.cleanedUp = true
+	if s.body != nil {
+		s.body.Close()
+		s.body = nil
+	}
+	if s.release != nil {
+		s.release()
+		s.release = nil
+	}
+}
+
+// maxContentLength returns the limit on a size of a response.
+//
+// When streaming, it applies to individual response messages.
+func (c *Client) maxContentLength() int {
+	if c.MaxContentLength <= 0 {
+		return DefaultMaxContentLength
+	}
+	return c.MaxContentLength
+}
+
+// attemptStream makes one attempt at starting a server-streaming RPC.
+//
+// On success returns the response with the body containing the stream of
+// frames and a callback that must be called to release the concurrency slot
+// when the stream is done. Reports the sent request to `st`.
+//
+// Returns gRPC errors.
+func (c *Client) attemptStream(ctx context.Context, options *Options, req *http.Request, reqSize payloadSize, st *rpcStats) (res *http.Response, release func(), err error) {
+	// Wait until there's an execution slot available.
+	releaseSlot := func() {}
+	if sem := c.concurrencySem(); sem != nil {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return nil, nil, status.FromContextError(err).Err()
+		}
+		releaseSlot = func() { sem.Release(1) }
+	}
+	defer func() {
+		if err != nil {
+			releaseSlot()
+		}
+	}()
+
+	// If we have a deadline, propagate it to the server.
+	if deadline, ok := ctx.Deadline(); ok {
+		delta := deadline.Sub(clock.Now(ctx))
+		if delta <= 0 {
+			return nil, nil, status.Errorf(codes.DeadlineExceeded, "prpc: attempt deadline exceeded: %s", context.Cause(ctx))
+		}
+		logging.Debugf(ctx, "RPC %s/%s.%s [stream, deadline %s]", options.host, options.serviceName, options.methodName, delta)
+		req.Header.Set(HeaderTimeout, EncodeTimeout(delta))
+	} else {
+		logging.Debugf(ctx, "RPC %s/%s.%s [stream]", options.host, options.serviceName, options.methodName)
+		req.Header.Del(HeaderTimeout)
+	}
+
+	// Propagate the trace context (including the client span) to the server.
+	injectTraceContext(ctx, req.Header)
+
+	client := c.C
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	// Send the request.
+	req.Body, _ = req.GetBody()
+	resp, err := client.Do(req.WithContext(ctx))
+	if c.testPostHTTP != nil {
+		err = c.testPostHTTP(ctx, err)
+	}
+	if err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, nil, status.Errorf(codeForErr(err), "prpc: sending request: %s", err)
+	}
+	st.outPayload(nil, reqSize)
+
+	if resp.Header.Get(HeaderStream) == "1" {
+		if err := checkContentType(resp.Header.Get("Content-Type"), options.outFormat); err != nil {
+			resp.Body.Close()
+			return nil, nil, err
+		}
+		return resp, releaseSlot, nil
+	}
+
+	// This is not a stream. Most likely the server responded with an error
+	// before starting the stream.
+	defer func() {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+	buf := &bytes.Buffer{}
+	if _, err := c.readResponseBody(ctx, buf, resp); err != nil {
+		return nil, nil, err
+	}
+	if err := c.readStatus(resp, buf); err != nil {
+		return nil, nil, err
+	}
+	return nil, nil, status.Errorf(codes.Internal, "prpc: the server responded to a streaming RPC with a unary response")
+}
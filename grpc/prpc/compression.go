@@ -16,21 +16,47 @@ package prpc
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
 )
 
-// gzipThreshold is the threshold to compress a blob.
-// If the blob is larger than this, then compress it.
-// The value is derived from
+// Compression is a content encoding of request or response bodies.
+type Compression string
+
+const (
+	// CompressionNone means the body is not compressed.
+	CompressionNone Compression = ""
+	// CompressionGZip means the body is compressed with gzip.
+	CompressionGZip Compression = "gzip"
+	// CompressionZstd means the body is compressed with zstd.
+	//
+	// It is faster and compresses better than gzip, but only newer Go pRPC
+	// servers and clients understand it.
+	CompressionZstd Compression = "zstd"
+)
+
+// DefaultCompressionThreshold is the default threshold to compress a blob.
+//
+// If the blob is larger than this, then compress it. The value is derived from
 // https://webmasters.stackexchange.com/questions/31750/what-is-recommended-minimum-object-size-for-gzip-performance-benefits
-const gzipThreshold = 1024
+const DefaultCompressionThreshold = 1024
+
+// acceptEncoding is the value of Accept-Encoding header sent by the client.
+//
+// Lists compressions in order of preference.
+const acceptEncoding = "zstd, gzip"
 
 var (
 	gzipWriters sync.Pool
 	gzipReaders sync.Pool
+	zstdWriters sync.Pool
+	zstdReaders sync.Map // decoded size limit => *sync.Pool with *zstd.Decoder
 )
 
 func getGZipWriter(w io.Writer) *gzip.Writer {
@@ -47,7 +73,7 @@ func returnGZipWriter(gw *gzip.Writer) {
 
 func getGZipReader(r io.Reader) (*gzip.Reader, error) {
 	if gr, _ := gzipReaders.Get().(*gzip.Reader); gr != nil {
-		if err := gr.Reset(gr); err != nil {
+		if err := gr.Reset(r); err != nil {
 			gzipReaders.Put(gr) // it is still good for reuse, even on errors
 			return nil, err
 		}
@@ -60,16 +86,174 @@ func returnGZipReader(gr *gzip.Reader) {
 	gzipReaders.Put(gr)
 }
 
-// compressBlob compresses data using gzip.
-func compressBlob(data []byte) ([]byte, error) {
-	buf := bytes.NewBuffer(nil)
-	gz := getGZipWriter(buf)
-	defer returnGZipWriter(gz)
-	if _, err := gz.Write(data); err != nil {
-		return nil, err
+func getZstdWriter(w io.Writer) *zstd.Encoder {
+	if zw, _ := zstdWriters.Get().(*zstd.Encoder); zw != nil {
+		zw.Reset(w)
+		return zw
+	}
+	// An error is possible only if options are invalid.
+	zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		panic(err)
+	}
+	return zw
+}
+
+func returnZstdWriter(zw *zstd.Encoder) {
+	zstdWriters.Put(zw)
+}
+
+// zstdMaxDecodedSize returns the maximum size of data a zstd decoder is allowed
+// to produce given the limit passed to readCompressed.
+//
+// Zstd frames declare their window size, and a decoder allocates it upfront.
+// Bounding it protects from frames that declare huge windows to exhaust
+// memory. A window never needs to be larger than the decoded data.
+func zstdMaxDecodedSize(limit int) uint64 {
+	if limit <= 0 {
+		return DefaultMaxContentLength
+	}
+	// readCompressed reads limit+1 bytes to detect overflows.
+	return uint64(limit) + 1
+}
+
+func zstdReaderPool(maxSize uint64) *sync.Pool {
+	if pool, ok := zstdReaders.Load(maxSize); ok {
+		return pool.(*sync.Pool)
+	}
+	pool, _ := zstdReaders.LoadOrStore(maxSize, &sync.Pool{})
+	return pool.(*sync.Pool)
+}
+
+func getZstdReader(r io.Reader, maxSize uint64) (*zstd.Decoder, error) {
+	if zr, _ := zstdReaderPool(maxSize).Get().(*zstd.Decoder); zr != nil {
+		if err := zr.Reset(r); err != nil {
+			returnZstdReader(zr, maxSize) // it is still good for reuse, even on errors
+			return nil, err
+		}
+		return zr, nil
 	}
-	if err := gz.Close(); err != nil {
+	maxWindow := maxSize
+	if maxWindow < zstd.MinWindowSize {
+		maxWindow = zstd.MinWindowSize
+	}
+	return zstd.NewReader(r,
+		// Use the synchronous decoder: bodies are small and decoding them in
+		// background goroutines is not worth it.
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderLowmem(true),
+		zstd.WithDecoderMaxMemory(maxSize),
+		zstd.WithDecoderMaxWindow(maxWindow),
+	)
+}
+
+func returnZstdReader(zr *zstd.Decoder, maxSize uint64) {
+	// Release the reference to the underlying reader before pooling.
+	zr.Reset(nil)
+	zstdReaderPool(maxSize).Put(zr)
+}
+
+// compressBlob compresses data using the given compression.
+func compressBlob(data []byte, c Compression) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := compressTo(buf, data, c); err != nil {
 		return nil, err
 	}
 	return buf.Bytes(), nil
 }
+
+// compressTo compresses data using the given compression, writing it to w.
+func compressTo(w io.Writer, data []byte, c Compression) error {
+	switch c {
+	case CompressionGZip:
+		gz := getGZipWriter(w)
+		defer returnGZipWriter(gz)
+		if _, err := gz.Write(data); err != nil {
+			return err
+		}
+		return gz.Close()
+	case CompressionZstd:
+		zw := getZstdWriter(w)
+		defer returnZstdWriter(zw)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		return zw.Close()
+	default:
+		return fmt.Errorf("unsupported compression %q", c)
+	}
+}
+
+// readCompressed reads and decompresses all data from r.
+//
+// Understands "gzip" and "zstd" encodings. If the encoding is empty, just reads
+// data as is. If limit is positive, reads at most limit+1 bytes of the
+// decompressed data, to allow the caller to detect that the limit is exceeded.
+//
+// Zstd data is additionally bounded by DefaultMaxContentLength if limit is not
+// positive. If a zstd frame declares it is larger than the limit, returns an
+// error wrapping zstd.ErrDecoderSizeExceeded.
+func readCompressed(r io.Reader, encoding string, limit int) (buf []byte, err error) {
+	limited := func(r io.Reader) io.Reader {
+		if limit > 0 {
+			return io.LimitReader(r, int64(limit)+1)
+		}
+		return r
+	}
+
+	switch Compression(strings.ToLower(encoding)) {
+	case CompressionNone:
+		return io.ReadAll(limited(r))
+	case CompressionGZip:
+		gr, err := getGZipReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer returnGZipReader(gr)
+		if buf, err = io.ReadAll(limited(gr)); err == nil && (limit <= 0 || len(buf) <= limit) {
+			err = gr.Close() // this just checks the checksum
+		}
+		return buf, err
+	case CompressionZstd:
+		maxSize := zstdMaxDecodedSize(limit)
+		zr, err := getZstdReader(r, maxSize)
+		if err != nil {
+			return nil, err
+		}
+		defer returnZstdReader(zr, maxSize)
+		return io.ReadAll(limited(zr))
+	default:
+		return nil, fmt.Errorf("unsupported Content-Encoding %q", encoding)
+	}
+}
+
+// responseCompression picks a compression to use for a response based on
+// the request's Accept-Encoding header.
+//
+// Prefers zstd over gzip if both are equally acceptable. Returns
+// CompressionNone if the client doesn't support any compression.
+func responseCompression(header http.Header) (Compression, error) {
+	accept, err := parseAccept(header.Get("Accept-Encoding"))
+	if err != nil {
+		return CompressionNone, err
+	}
+	best, bestQ := CompressionNone, float32(0)
+	for _, a := range accept {
+		if a.QualityFactor <= 0 {
+			continue // explicitly not acceptable
+		}
+		var c Compression
+		switch {
+		case strings.EqualFold(a.Value, string(CompressionZstd)):
+			c = CompressionZstd
+		case strings.EqualFold(a.Value, string(CompressionGZip)):
+			c = CompressionGZip
+		default:
+			continue
+		}
+		if a.QualityFactor > bestQ || (a.QualityFactor == bestQ && c == CompressionZstd) {
+			best, bestQ = c, a.QualityFactor
+		}
+	}
+	return best, nil
+}
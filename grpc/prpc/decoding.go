@@ -29,6 +29,7 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/iotools"
 	luciproto "go.chromium.org/luci/common/proto"
 
 	"go.chromium.org/luci/grpc/grpcutil"
@@ -41,40 +42,30 @@ const headerContentType = "Content-Type"
 // readMessage decodes a protobuf message from an HTTP request.
 //
 // Uses given headers to decide how to uncompress and deserialize the message.
+// Returns the size of the message before and after decompression.
 //
 // fixFieldMasksForJSON indicates whether to attempt a workaround for
 // https://github.com/golang/protobuf/issues/745 for requests with FormatJSONPB.
 // TODO(crbug/1082369): Remove this workaround once field masks can be decoded.
-func readMessage(body io.Reader, header http.Header, msg proto.Message, fixFieldMasksForJSON bool) error {
+func readMessage(body io.Reader, header http.Header, msg proto.Message, fixFieldMasksForJSON bool) (payloadSize, error) {
 	format, err := FormatFromContentType(header.Get(headerContentType))
 	if err != nil {
 		// Spec: http://www.w3.org/Protocols/rfc2616/rfc2616-sec10.html#sec10.4.16
-		return protocolErr(
+		return payloadSize{}, protocolErr(
 			codes.InvalidArgument,
 			http.StatusUnsupportedMediaType,
 			"bad Content-Type header: %s", err,
 		)
 	}
 
-	var buf []byte
-	if header.Get("Content-Encoding") == "gzip" {
-		reader, err := getGZipReader(body)
-		if err != nil {
-			return requestReadErr(err, "failed to start decompressing gzip request body")
-		}
-		buf, err = io.ReadAll(reader)
-		if err == nil {
-			err = reader.Close() // this just checks the checksum
-		}
-		returnGZipReader(reader)
-		if err != nil {
-			return requestReadErr(err, "could not read or decompress request body")
-		}
-	} else {
-		buf, err = io.ReadAll(body)
-		if err != nil {
-			return requestReadErr(err, "could not read request body")
-		}
+	counter := &iotools.CountingReader{Reader: body}
+	buf, err := readCompressed(counter, header.Get("Content-Encoding"), 0)
+	if err != nil {
+		return payloadSize{}, requestReadErr(err, "could not read or decompress request body")
+	}
+	size := payloadSize{
+		length:     len(buf),
+		wireLength: int(counter.Count),
 	}
 
 	switch format {
@@ -102,13 +93,13 @@ func readMessage(body io.Reader, header http.Header, msg proto.Message, fixField
 		panic(fmt.Errorf("impossible: invalid format %v", format))
 	}
 	if err != nil {
-		return protocolErr(
+		return size, protocolErr(
 			codes.InvalidArgument,
 			http.StatusBadRequest,
 			"could not decode body: %s", err,
 		)
 	}
-	return nil
+	return size, nil
 }
 
 // requestReadErr interprets an error from reading and unzipping of a request.
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,7 +39,7 @@ func TestDecoding(t *testing.T) {
 	Convey("readMessage", t, func() {
 		var msg HelloRequest
 		read := func(contentType string, body []byte) *protocolError {
-			err := readMessage(
+			_, err := readMessage(
 				bytes.NewBuffer(body),
 				http.Header{"Content-Type": {contentType}},
 				&msg,
@@ -133,6 +134,71 @@ func TestDecoding(t *testing.T) {
 			So(err, ShouldNotBeNil)
 			So(err.status, ShouldEqual, http.StatusUnsupportedMediaType)
 		})
+
+		Convey("compressed", func() {
+			testMsg := &HelloRequest{Name: strings.Repeat("Lucy", 1000)}
+			body, err := proto.Marshal(testMsg)
+			So(err, ShouldBeNil)
+
+			readCompressed := func(encoding string, blob []byte) (payloadSize, error) {
+				return readMessage(
+					bytes.NewBuffer(blob),
+					http.Header{
+						"Content-Type":     {mtPRPCBinary},
+						"Content-Encoding": {encoding},
+					},
+					&msg,
+					false,
+				)
+			}
+
+			for _, c := range []Compression{CompressionGZip, CompressionZstd} {
+				Convey(string(c), func() {
+					blob, err := compressBlob(body, c)
+					So(err, ShouldBeNil)
+					So(len(blob), ShouldBeLessThan, len(body))
+
+					size, err := readCompressed(string(c), blob)
+					So(err, ShouldBeNil)
+					So(&msg, ShouldResembleProto, testMsg)
+					So(size, ShouldResemble, payloadSize{length: len(body), wireLength: len(blob)})
+				})
+			}
+
+			Convey("corrupted", func() {
+				_, err := readCompressed("zstd", body)
+				So(err, ShouldNotBeNil)
+				So(err.(*protocolError).status, ShouldEqual, http.StatusBadRequest)
+			})
+
+			Convey("huge zstd window", func() {
+				blob := []byte{
+					0x28, 0xb5, 0x2f, 0xfd, // magic number
+					0x00,             // frame header: no content size, not single segment
+					0xa0,             // window descriptor: 1 GiB window
+					0x09, 0x00, 0x00, // last raw block of 1 byte
+					'x',
+				}
+				_, err := readCompressed("zstd", blob)
+				So(err, ShouldNotBeNil)
+				So(err.(*protocolError).status, ShouldEqual, http.StatusBadRequest)
+				So(err, ShouldErrLike, "window size exceeded")
+			})
+
+			Convey("zstd frame larger than the limit", func() {
+				blob, err := compressBlob(make([]byte, DefaultMaxContentLength+1), CompressionZstd)
+				So(err, ShouldBeNil)
+				_, err = readCompressed("zstd", blob)
+				So(err, ShouldNotBeNil)
+				So(err.(*protocolError).status, ShouldEqual, http.StatusBadRequest)
+			})
+
+			Convey("unsupported", func() {
+				_, err := readCompressed("br", body)
+				So(err, ShouldNotBeNil)
+				So(err.(*protocolError).status, ShouldEqual, http.StatusBadRequest)
+			})
+		})
 	})
 
 	Convey("parseHeader", t, func() {
@@ -20,7 +20,7 @@
 //
 // Unlike gRPC:
 //   - supports HTTP 1.x and AppEngine 1.x.
-//   - does not support streams.
+//   - supports only server-streaming RPCs, not client or bidirectional streams.
 //
 // # Compile service definitions
 //
@@ -33,8 +33,60 @@
 //
 //	go install go.chromium.org/luci/grpc/cmd/cproto
 //
+// # Tracing
+//
+// Client and Server open OpenTelemetry spans for RPCs, using the global tracer
+// provider. Spans are named after the method ("<service>/<method>") and record
+// the status code and sizes of sent and received messages.
+//
+// The client propagates the trace context to the server using W3C Trace
+// Context headers ("traceparent" and "tracestate"), unless the global
+// OpenTelemetry propagator is configured to use some other headers. The server
+// extracts it, unless the request context already has a span (e.g. opened by
+// an HTTP middleware).
+//
 // # Protocol
 //
+// ## v1.6
+//
+// v1.6 adds zstd (RFC-8878) as an alternative compression of request and
+// response bodies.
+//
+//   - A request MAY have a header "Content-Encoding: zstd". The server MUST
+//     decompress the request body before unmarshaling the request message.
+//   - A request MAY list "zstd" in "Accept-Encoding" header, along with "gzip".
+//     The server SHOULD pick the encoding with the highest quality factor,
+//     preferring zstd when they are equal, and set "Content-Encoding"
+//     accordingly.
+//
+// The client SHOULD NOT send zstd-compressed requests to servers that may not
+// support v1.6.
+//
+// ## v1.5
+//
+// v1.5 adds support for server-streaming RPCs.
+//
+// The request is the same as for a unary RPC. If the server starts streaming
+// the response, it MUST respond with HTTP 200, "X-Prpc-Grpc-Code: 0" and
+// "X-Prpc-Stream: 1" headers. The body of such response is a sequence of
+// frames. Each frame starts with a 5 byte header: 1 byte of flags followed by
+// the length of the frame payload as a 4 byte big-endian integer.
+//
+//   - Flags 0x00: the payload is a response message encoded according to the
+//     response Content-Type (without the JSONPB prefix).
+//   - Flags 0x80: the payload is the trailer. It is the last frame in the
+//     stream. It is encoded as a block of HTTP/1 headers (each terminated by
+//     CRLF, followed by an empty line). It contains "X-Prpc-Grpc-Code" with
+//     the final status code, "X-Prpc-Grpc-Message" with the percent-encoded
+//     error message (if any), "X-Prpc-Status-Details-Bin" (if any) and
+//     the trailer metadata.
+//
+// If the server fails before sending any response messages or headers, it
+// MAY respond with a regular error response, as in a unary RPC.
+//
+// The client MUST treat the stream that ends without the trailer frame as
+// failed. Streaming responses are never compressed.
+//
 // ## v1.4
 //
 // v1.4 hides some leaking HTTP v1 transport implementation details from gRPC
@@ -0,0 +1,184 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2etest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/stats"
+
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/testing/prpctest"
+
+	"go.chromium.org/luci/grpc/prpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// payloadStats records sizes of payloads reported to a stats.Handler.
+type payloadStats struct {
+	m    sync.Mutex
+	in   []*stats.InPayload
+	out  []*stats.OutPayload
+	done chan struct{} // receives a signal when an RPC ends
+}
+
+func newPayloadStats() *payloadStats {
+	return &payloadStats{done: make(chan struct{}, 100)}
+}
+
+func (p *payloadStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (p *payloadStats) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	switch s := s.(type) {
+	case *stats.InPayload:
+		p.in = append(p.in, s)
+	case *stats.OutPayload:
+		p.out = append(p.out, s)
+	case *stats.End:
+		p.done <- struct{}{}
+	}
+}
+
+func (p *payloadStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (p *payloadStats) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestCompression(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A client/server with compression`, t, func() {
+		ctx := gologger.StdConfig.Use(context.Background())
+
+		svc := &service{}
+		serverStats := newPayloadStats()
+
+		ts := prpctest.Server{}
+		RegisterHelloServer(&ts, svc)
+		ts.EnableResponseCompression = true
+		ts.StatsHandler = serverStats
+
+		ts.Start(ctx)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		So(err, ShouldBeNil)
+		prpcClient.EnableRequestCompression = true
+		prpcClient.RequestCompression = prpc.CompressionZstd
+
+		// Capture the encoding of responses.
+		var m sync.Mutex
+		var encodings []string
+		prpcClient.C = &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				resp, err := http.DefaultTransport.RoundTrip(r)
+				if err == nil {
+					m.Lock()
+					encodings = append(encodings, resp.Header.Get("Content-Encoding"))
+					m.Unlock()
+				}
+				return resp, err
+			}),
+		}
+		client := NewHelloClient(prpcClient)
+
+		big := strings.Repeat("abc", 1000)
+
+		Convey(`Round-trips zstd compressed messages`, func() {
+			svc.R = &HelloReply{Message: big}
+
+			resp, err := client.Greet(ctx, &HelloRequest{Name: big})
+			So(err, ShouldBeRPCOK)
+			So(resp, ShouldResembleProto, svc.R)
+			So(encodings, ShouldResemble, []string{"zstd"})
+
+			<-serverStats.done
+			So(serverStats.in, ShouldHaveLength, 1)
+			So(serverStats.in[0].Length, ShouldBeGreaterThan, len(big))
+			So(serverStats.in[0].WireLength, ShouldBeLessThan, len(big))
+			So(serverStats.out, ShouldHaveLength, 1)
+			So(serverStats.out[0].Length, ShouldBeGreaterThan, len(big))
+			So(serverStats.out[0].WireLength, ShouldBeLessThan, len(big))
+		})
+
+		Convey(`Doesn't compress small messages`, func() {
+			svc.R = &HelloReply{Message: "small"}
+
+			resp, err := client.Greet(ctx, &HelloRequest{Name: "small"})
+			So(err, ShouldBeRPCOK)
+			So(resp, ShouldResembleProto, svc.R)
+			So(encodings, ShouldResemble, []string{""})
+
+			<-serverStats.done
+			So(serverStats.out[0].WireLength, ShouldEqual, serverStats.out[0].Length)
+		})
+
+		Convey(`Respects per-service thresholds`, func() {
+			svc.R = &HelloReply{Message: big}
+
+			ts.SetResponseCompressionThreshold("e2etest.Hello", -1)
+			_, err := client.Greet(ctx, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeRPCOK)
+
+			ts.SetResponseCompressionThreshold("e2etest.Hello", 10*len(big))
+			_, err = client.Greet(ctx, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeRPCOK)
+
+			ts.SetResponseCompressionThreshold("e2etest.Hello", 0)
+			_, err = client.Greet(ctx, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeRPCOK)
+
+			So(encodings, ShouldResemble, []string{"", "", "zstd"})
+		})
+
+		Convey(`Falls back to gzip`, func() {
+			svc.R = &HelloReply{Message: big}
+			prpcClient.C = &http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					r.Header.Set("Accept-Encoding", "gzip")
+					resp, err := http.DefaultTransport.RoundTrip(r)
+					if err == nil {
+						m.Lock()
+						encodings = append(encodings, resp.Header.Get("Content-Encoding"))
+						m.Unlock()
+					}
+					return resp, err
+				}),
+			}
+
+			resp, err := client.Greet(ctx, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeRPCOK)
+			So(resp, ShouldResembleProto, svc.R)
+			So(encodings, ShouldResemble, []string{"gzip"})
+		})
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
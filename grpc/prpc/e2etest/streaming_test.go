@@ -0,0 +1,333 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2etest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/testing/prpctest"
+
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// The code below mimics what protoc-gen-go-grpc generates for a service:
+//
+//	service Streamer {
+//	  rpc GreetMany(HelloRequest) returns (stream HelloReply);
+//	}
+
+type streamerServer interface {
+	GreetMany(*HelloRequest, streamerGreetManyServer) error
+}
+
+type streamerGreetManyServer interface {
+	Send(*HelloReply) error
+	grpc.ServerStream
+}
+
+type streamerGreetManyServerImpl struct {
+	grpc.ServerStream
+}
+
+func (x *streamerGreetManyServerImpl) Send(m *HelloReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func streamerGreetManyHandler(srv any, stream grpc.ServerStream) error {
+	m := new(HelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(streamerServer).GreetMany(m, &streamerGreetManyServerImpl{stream})
+}
+
+var streamerServiceDesc = grpc.ServiceDesc{
+	ServiceName: "e2etest.Streamer",
+	HandlerType: (*streamerServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GreetMany",
+			Handler:       streamerGreetManyHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+func greetMany(ctx context.Context, cc grpc.ClientConnInterface, in *HelloRequest, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := cc.NewStream(ctx, &streamerServiceDesc.Streams[0], "/e2etest.Streamer/GreetMany", opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+type streamer struct {
+	count    int
+	err      error
+	header   metadata.MD
+	trailer  metadata.MD
+	blockErr chan error // if not nil, the handler blocks after the first message
+}
+
+func (s *streamer) GreetMany(req *HelloRequest, srv streamerGreetManyServer) error {
+	if s.header != nil {
+		if err := srv.SendHeader(s.header); err != nil {
+			return err
+		}
+	}
+	if s.trailer != nil {
+		srv.SetTrailer(s.trailer)
+	}
+	for i := 0; i < s.count; i++ {
+		if err := srv.Send(&HelloReply{Message: fmt.Sprintf("%s #%d", req.Name, i)}); err != nil {
+			return err
+		}
+		if s.blockErr != nil {
+			<-srv.Context().Done()
+			s.blockErr <- srv.Context().Err()
+			return srv.Context().Err()
+		}
+	}
+	return s.err
+}
+
+func recvAll(stream grpc.ClientStream) ([]string, error) {
+	var out []string
+	for {
+		msg := &HelloReply{}
+		if err := stream.RecvMsg(msg); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return out, err
+		}
+		out = append(out, msg.Message)
+	}
+}
+
+func TestServerStreaming(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A client/server for the Streamer service`, t, func() {
+		ctx := gologger.StdConfig.Use(context.Background())
+
+		impl := &streamer{}
+		intercepted := ""
+
+		ts := prpctest.Server{}
+		ts.RegisterService(&streamerServiceDesc, impl)
+		ts.StreamServerInterceptor = func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			intercepted = info.FullMethod
+			return handler(srv, ss)
+		}
+		ts.Start(ctx)
+		defer ts.Close()
+
+		client, err := ts.NewClient()
+		So(err, ShouldBeNil)
+
+		Convey(`Streams messages`, func() {
+			impl.count = 3
+
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeNil)
+			msgs, err := recvAll(stream)
+			So(err, ShouldBeNil)
+			So(msgs, ShouldResemble, []string{"hi #0", "hi #1", "hi #2"})
+			So(intercepted, ShouldEqual, "/e2etest.Streamer/GreetMany")
+		})
+
+		Convey(`Streams messages in JSON`, func() {
+			impl.count = 2
+
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"}, prpc.CallAcceptContentSubtype("json"))
+			So(err, ShouldBeNil)
+			msgs, err := recvAll(stream)
+			So(err, ShouldBeNil)
+			So(msgs, ShouldResemble, []string{"hi #0", "hi #1"})
+		})
+
+		Convey(`Empty stream`, func() {
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeNil)
+			msgs, err := recvAll(stream)
+			So(err, ShouldBeNil)
+			So(msgs, ShouldBeEmpty)
+		})
+
+		Convey(`Error before streaming`, func() {
+			impl.err = status.Errorf(codes.PermissionDenied, "boom")
+
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeNil)
+			_, err = recvAll(stream)
+			So(err, ShouldHaveRPCCode, codes.PermissionDenied, "boom")
+		})
+
+		Convey(`Error after streaming with details`, func() {
+			detail := &errdetails.DebugInfo{Detail: "x"}
+			st, err := status.New(codes.FailedPrecondition, "boom\nmultiline 100%").WithDetails(detail)
+			So(err, ShouldBeNil)
+
+			impl.count = 2
+			impl.err = st.Err()
+
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"})
+			So(err, ShouldBeNil)
+			msgs, err := recvAll(stream)
+			So(msgs, ShouldResemble, []string{"hi #0", "hi #1"})
+			So(err, ShouldHaveRPCCode, codes.FailedPrecondition, "boom\nmultiline 100%")
+			So(status.Convert(err).Details(), ShouldResembleProto, []any{detail})
+
+			// Subsequent calls return the same error.
+			So(stream.RecvMsg(&HelloReply{}), ShouldEqual, err)
+		})
+
+		Convey(`Internal errors are hidden`, func() {
+			impl.count = 1
+			impl.err = status.Errorf(codes.Internal, "secret")
+
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"}, prpc.ExpectedCode(codes.Internal))
+			So(err, ShouldBeNil)
+			_, err = recvAll(stream)
+			So(err, ShouldHaveRPCCode, codes.Internal, "Internal server error")
+		})
+
+		Convey(`Headers and trailers`, func() {
+			impl.count = 1
+			impl.header = metadata.Pairs("hdr", "1", "hdr-bin", "\x00\x01")
+			impl.trailer = metadata.Pairs("trl", "2", "trl-bin", "\x02\x03")
+
+			var hdr, trl metadata.MD
+			stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"}, grpc.Header(&hdr), grpc.Trailer(&trl))
+			So(err, ShouldBeNil)
+
+			streamHdr, err := stream.Header()
+			So(err, ShouldBeNil)
+			So(streamHdr, ShouldResemble, impl.header)
+			So(hdr, ShouldResemble, impl.header)
+
+			_, err = recvAll(stream)
+			So(err, ShouldBeNil)
+			So(stream.Trailer(), ShouldResemble, impl.trailer)
+			So(trl, ShouldResemble, impl.trailer)
+		})
+
+		Convey(`Client streaming is not supported`, func() {
+			_, err := client.NewStream(ctx, &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}, "/e2etest.Streamer/GreetMany")
+			So(err, ShouldEqual, prpc.ErrNoStreamingSupport)
+		})
+
+		Convey(`Unknown method`, func() {
+			stream, err := client.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/e2etest.Streamer/Unknown")
+			So(err, ShouldBeNil)
+			So(stream.SendMsg(&HelloRequest{}), ShouldBeNil)
+			So(stream.RecvMsg(&HelloReply{}), ShouldHaveRPCCode, codes.Unimplemented)
+		})
+	})
+}
+
+func TestServerStreamingCancellation(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Client cancellation propagates to the server`, t, func() {
+		ctx := gologger.StdConfig.Use(context.Background())
+
+		impl := &streamer{count: 1, blockErr: make(chan error, 1)}
+
+		// Use the request context as is to let the server notice the client
+		// disconnecting.
+		ts := prpctest.Server{}
+		ts.Base = func(context.Context) router.MiddlewareChain { return nil }
+		ts.RegisterService(&streamerServiceDesc, impl)
+		ts.Start(ctx)
+		defer ts.Close()
+
+		client, err := ts.NewClient()
+		So(err, ShouldBeNil)
+
+		ctx, cancel := context.WithCancel(ctx)
+		stream, err := greetMany(ctx, client, &HelloRequest{Name: "hi"})
+		So(err, ShouldBeNil)
+
+		msg := &HelloReply{}
+		So(stream.RecvMsg(msg), ShouldBeNil)
+		So(msg.Message, ShouldEqual, "hi #0")
+
+		cancel()
+		So(<-impl.blockErr, ShouldEqual, context.Canceled)
+		So(stream.RecvMsg(msg), ShouldHaveRPCCode, codes.Canceled)
+	})
+}
+
+func TestServerStreamingAbandoned(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Canceled streams release their concurrency slot`, t, func() {
+		ctx := gologger.StdConfig.Use(context.Background())
+
+		impl := &streamer{count: 1, blockErr: make(chan error, 2)}
+
+		// Use the request context as is to let the server notice the client
+		// disconnecting.
+		ts := prpctest.Server{}
+		ts.Base = func(context.Context) router.MiddlewareChain { return nil }
+		ts.RegisterService(&streamerServiceDesc, impl)
+		ts.Start(ctx)
+		defer ts.Close()
+
+		client, err := ts.NewClient()
+		So(err, ShouldBeNil)
+		client.MaxConcurrentRequests = 1
+
+		// Open a stream, then abandon it without draining.
+		abandonedCtx, cancel := context.WithCancel(ctx)
+		stream, err := greetMany(abandonedCtx, client, &HelloRequest{Name: "hi"})
+		So(err, ShouldBeNil)
+		So(stream.RecvMsg(&HelloReply{}), ShouldBeNil)
+		cancel()
+
+		// A new call gets the slot.
+		ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		stream, err = greetMany(ctx, client, &HelloRequest{Name: "hi"})
+		So(err, ShouldBeNil)
+		msg := &HelloReply{}
+		So(stream.RecvMsg(msg), ShouldBeNil)
+		So(msg.Message, ShouldEqual, "hi #0")
+		cancel()
+	})
+}
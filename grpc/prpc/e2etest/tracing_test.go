@@ -0,0 +1,130 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2etest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/testing/prpctest"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTracing(t *testing.T) {
+	// Note: not parallel, since it installs the global tracer provider.
+
+	Convey(`A client/server with tracing`, t, func() {
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+		ctx := gologger.StdConfig.Use(context.Background())
+
+		svc := &service{R: &HelloReply{Message: "hi"}}
+		ts := prpctest.Server{}
+		RegisterHelloServer(&ts, svc)
+		ts.Start(ctx)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		So(err, ShouldBeNil)
+		client := NewHelloClient(prpcClient)
+
+		ctx, root := otel.Tracer("test").Start(ctx, "root")
+		traceID := root.SpanContext().TraceID()
+
+		// spans waits for the client and server spans of the trace to end.
+		spans := func() (clientSpan, serverSpan sdktrace.ReadOnlySpan) {
+			deadline := time.Now().Add(30 * time.Second)
+			for time.Now().Before(deadline) {
+				for _, s := range recorder.Ended() {
+					if s.SpanContext().TraceID() != traceID {
+						continue
+					}
+					switch s.SpanKind() {
+					case trace.SpanKindClient:
+						clientSpan = s
+					case trace.SpanKindServer:
+						serverSpan = s
+					}
+				}
+				if clientSpan != nil && serverSpan != nil {
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			panic("timeout waiting for spans")
+		}
+
+		attrs := func(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+			m := map[attribute.Key]attribute.Value{}
+			for _, kv := range s.Attributes() {
+				m[kv.Key] = kv.Value
+			}
+			return m
+		}
+
+		Convey(`Propagates the trace`, func() {
+			_, err := client.Greet(ctx, &HelloRequest{Name: "hello"})
+			So(err, ShouldBeRPCOK)
+			root.End()
+
+			clientSpan, serverSpan := spans()
+			So(clientSpan.Name(), ShouldEqual, "e2etest.Hello/Greet")
+			So(clientSpan.Parent().SpanID(), ShouldEqual, root.SpanContext().SpanID())
+			So(serverSpan.Name(), ShouldEqual, "e2etest.Hello/Greet")
+			So(serverSpan.Parent().SpanID(), ShouldEqual, clientSpan.SpanContext().SpanID())
+			So(serverSpan.Parent().IsRemote(), ShouldBeTrue)
+
+			for _, s := range []sdktrace.ReadOnlySpan{clientSpan, serverSpan} {
+				a := attrs(s)
+				So(a["rpc.system"].AsString(), ShouldEqual, "prpc")
+				So(a["rpc.service"].AsString(), ShouldEqual, "e2etest.Hello")
+				So(a["rpc.method"].AsString(), ShouldEqual, "Greet")
+				So(a["rpc.grpc.status_code"].AsInt64(), ShouldEqual, 0)
+				So(s.Status().Code, ShouldEqual, otelcodes.Unset)
+				So(s.Events(), ShouldHaveLength, 2)
+			}
+		})
+
+		Convey(`Records errors`, func() {
+			svc.err = status.Errorf(codes.NotFound, "boom")
+			_, err := client.Greet(ctx, &HelloRequest{Name: "hello"})
+			So(err, ShouldHaveRPCCode, codes.NotFound)
+			root.End()
+
+			clientSpan, serverSpan := spans()
+			So(attrs(clientSpan)["rpc.grpc.status_code"].AsInt64(), ShouldEqual, int64(codes.NotFound))
+			So(clientSpan.Status().Code, ShouldEqual, otelcodes.Error)
+			// NotFound is the client's fault, not the server's.
+			So(attrs(serverSpan)["rpc.grpc.status_code"].AsInt64(), ShouldEqual, int64(codes.NotFound))
+			So(serverSpan.Status().Code, ShouldEqual, otelcodes.Unset)
+		})
+	})
+}
@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/iotools"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/grpc/grpcutil"
 )
@@ -120,9 +121,15 @@ func marshalMessage(msg proto.Message, format Format, wrap bool) ([]byte, error)
 }
 
 // writeMessage writes msg to w in the specified format.
-// ctx is used to log errors.
+//
+// If compression is not CompressionNone and the serialized message is larger
+// than the threshold, compresses the response.
+//
+// ctx is used to log errors. Returns the size of the written message before
+// and after the compression.
+//
 // panics if msg is nil.
-func writeMessage(ctx context.Context, w http.ResponseWriter, msg proto.Message, format Format, allowGZip bool) {
+func writeMessage(ctx context.Context, w http.ResponseWriter, msg proto.Message, format Format, compression Compression, threshold int) payloadSize {
 	if msg == nil {
 		panic("msg is nil")
 	}
@@ -130,7 +137,7 @@ func writeMessage(ctx context.Context, w http.ResponseWriter, msg proto.Message,
 	body, err := marshalMessage(msg, format, true)
 	if err != nil {
 		writeError(ctx, w, status.Error(codes.Internal, err.Error()), format)
-		return
+		return payloadSize{}
 	}
 
 	w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(codes.OK)))
@@ -140,25 +147,22 @@ func writeMessage(ctx context.Context, w http.ResponseWriter, msg proto.Message,
 	// is already written. There is nothing more we can do other than just log
 	// them.
 
-	if allowGZip && len(body) > gzipThreshold {
-		w.Header().Set("Content-Encoding", "gzip")
-
-		gz := getGZipWriter(w)
-		defer returnGZipWriter(gz)
-		if _, err := gz.Write(body); err != nil {
+	size := payloadSize{length: len(body)}
+	if compression != CompressionNone && len(body) > threshold {
+		w.Header().Set("Content-Encoding", string(compression))
+		cw := &iotools.CountingWriter{Writer: w}
+		if err := compressTo(cw, body, compression); err != nil {
 			logging.Warningf(ctx, "prpc: failed to write or compress the response body: %s", err)
-			return
-		}
-		if err := gz.Close(); err != nil {
-			logging.Warningf(ctx, "prpc: failed to close gzip.Writer: %s", err)
-			return
 		}
+		size.wireLength = int(cw.Count)
 	} else {
-		if _, err := w.Write(body); err != nil {
+		n, err := w.Write(body)
+		if err != nil {
 			logging.Warningf(ctx, "prpc: failed to write response body: %s", err)
-			return
 		}
+		size.wireLength = n
 	}
+	return size
 }
 
 func errorStatus(err error) (st *status.Status, httpStatus int) {
@@ -209,19 +213,39 @@ func statusDetailsToHeaderValues(details []*anypb.Any, format Format) ([]string,
 
 // writeError writes err to w and logs it.
 func writeError(ctx context.Context, w http.ResponseWriter, err error, format Format) {
-	st, httpStatus := errorStatus(err)
+	st, httpStatus, detailHeader, body := errorResponse(ctx, err, format)
+
+	w.Header()[HeaderStatusDetail] = detailHeader
+	w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(st.Code())))
+	w.Header().Set(headerContentType, "text/plain")
+	w.WriteHeader(httpStatus)
+	if _, err := io.WriteString(w, body); err != nil {
+		// This error most commonly happens if the client disconnects. The header is
+		// already written. There is nothing more we can do other than log it.
+		logging.Warningf(ctx, "prpc: failed to write response body: %s", err)
+		return
+	}
+	io.WriteString(w, "\n")
+}
+
+// errorResponse converts err into a status, logs it and prepares parts of
+// the response that should be sent to the client.
+//
+// Returns the status, the HTTP status code, values of the status details header
+// and the error message that is safe to show to the client.
+func errorResponse(ctx context.Context, err error, format Format) (st *status.Status, httpStatus int, detailHeader []string, body string) {
+	st, httpStatus = errorStatus(err)
 
 	// use st.Proto instead of st.Details to avoid unnecessary unmarshaling of
 	// google.protobuf.Any underlying messages. We need Any protos themselves.
-	detailHeader, err := statusDetailsToHeaderValues(st.Proto().Details, format)
+	detailHeader, err = statusDetailsToHeaderValues(st.Proto().Details, format)
 	if err != nil {
 		st = status.New(codes.Internal, "prpc: failed to write status details")
 		httpStatus = http.StatusInternalServerError
-	} else {
-		w.Header()[HeaderStatusDetail] = detailHeader
+		detailHeader = nil
 	}
 
-	body := st.Message()
+	body = st.Message()
 	if httpStatus < 500 {
 		logging.Warningf(ctx, "prpc: responding with %s error (HTTP %d): %s", st.Code(), httpStatus, st.Message())
 	} else {
@@ -249,15 +273,5 @@ func writeError(ctx context.Context, w http.ResponseWriter, err error, format Fo
 		logging.Errorf(ctx, "prpc: responding with %s error (HTTP %d): %s", st.Code(), httpStatus, st.Message())
 		errors.Log(ctx, err)
 	}
-
-	w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(st.Code())))
-	w.Header().Set(headerContentType, "text/plain")
-	w.WriteHeader(httpStatus)
-	if _, err := io.WriteString(w, body); err != nil {
-		// This error most commonly happens if the client disconnects. The header is
-		// already written. There is nothing more we can do other than log it.
-		logging.Warningf(ctx, "prpc: failed to write response body: %s", err)
-		return
-	}
-	io.WriteString(w, "\n")
+	return
 }
@@ -98,7 +98,7 @@ func TestEncoding(t *testing.T) {
 		test := func(f Format, body []byte, contentType string) {
 			Convey(contentType, func() {
 				rec := httptest.NewRecorder()
-				writeMessage(c, rec, msg, f, false)
+				writeMessage(c, rec, msg, f, CompressionNone, 0)
 				So(rec.Code, ShouldEqual, http.StatusOK)
 				So(rec.Header().Get(HeaderGRPCCode), ShouldEqual, "0")
 				So(rec.Header().Get(headerContentType), ShouldEqual, contentType)
@@ -114,12 +114,30 @@ func TestEncoding(t *testing.T) {
 		test(FormatText, []byte("message: \"Hi\"\n"), mtPRPCText)
 
 		Convey("compression", func() {
+			msg := &HelloReply{Message: strings.Repeat("A", 1024)}
+			for _, comp := range []Compression{CompressionGZip, CompressionZstd} {
+				Convey(string(comp), func() {
+					rec := httptest.NewRecorder()
+					size := writeMessage(c, rec, msg, FormatText, comp, DefaultCompressionThreshold)
+					So(rec.Code, ShouldEqual, http.StatusOK)
+					So(rec.Header().Get("Content-Encoding"), ShouldEqual, string(comp))
+					So(rec.Body.Len(), ShouldBeLessThan, 1024)
+					So(size.length, ShouldBeGreaterThan, 1024)
+					So(size.wireLength, ShouldEqual, rec.Body.Len())
+
+					blob, err := readCompressed(rec.Body, string(comp), 0)
+					So(err, ShouldBeNil)
+					So(len(blob), ShouldEqual, size.length)
+				})
+			}
+		})
+
+		Convey("below threshold", func() {
 			rec := httptest.NewRecorder()
 			msg := &HelloReply{Message: strings.Repeat("A", 1024)}
-			writeMessage(c, rec, msg, FormatText, true)
-			So(rec.Code, ShouldEqual, http.StatusOK)
-			So(rec.Header().Get("Content-Encoding"), ShouldEqual, "gzip")
-			So(rec.Body.Len(), ShouldBeLessThan, 1024)
+			size := writeMessage(c, rec, msg, FormatText, CompressionZstd, 2048)
+			So(rec.Header().Get("Content-Encoding"), ShouldEqual, "")
+			So(size.wireLength, ShouldEqual, size.length)
 		})
 	})
 
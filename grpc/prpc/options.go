@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/retry"
 )
@@ -108,6 +109,33 @@ func (o *Options) apply(callOptions []grpc.CallOption) {
 	}
 }
 
+// pickOutFormat populates outFormat based on AcceptContentSubtype.
+//
+// Returns gRPC errors.
+func (o *Options) pickOutFormat() error {
+	switch o.AcceptContentSubtype {
+	case "", mtPRPCEncodingBinary:
+		o.outFormat = FormatBinary
+	case mtPRPCEncodingJSONPB:
+		o.outFormat = FormatJSONPB
+	case mtPRPCEncodingText:
+		return status.Errorf(codes.Internal, "prpc: text encoding for pRPC calls is not implemented")
+	default:
+		return status.Errorf(codes.Internal, "prpc: unrecognized contentSubtype %q of CallAcceptContentSubtype", o.AcceptContentSubtype)
+	}
+	return nil
+}
+
+// isExpectedCode is true if the code was passed via ExpectedCode call option.
+func (o *Options) isExpectedCode(code codes.Code) bool {
+	for _, expected := range o.expectedCodes {
+		if code == expected {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *Options) resetResponseMetadata() {
 	if o.resHeaderMetadata != nil {
 		*o.resHeaderMetadata = nil
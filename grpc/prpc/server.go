@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/logging"
@@ -47,7 +48,7 @@ var (
 	// Custom AccessControl implementations may allow more headers.
 	//
 	// See https://developer.mozilla.org/en-US/docs/Glossary/CORS-safelisted_request_header
-	allowHeaders = strings.Join([]string{"Origin", "Content-Type", "Accept", "Authorization"}, ", ")
+	allowHeaders = strings.Join(append([]string{"Origin", "Content-Type", "Accept", "Authorization"}, traceHeaders...), ", ")
 	allowMethods = strings.Join([]string{"OPTIONS", "POST"}, ", ")
 
 	// allowPreflightCacheAgeSecs is the amount of time to enable the browser to
@@ -58,7 +59,7 @@ var (
 
 	// exposeHeaders lists the non-standard response headers that are exposed to
 	// client that make cross-origin calls.
-	exposeHeaders = strings.Join([]string{HeaderGRPCCode}, ", ")
+	exposeHeaders = strings.Join([]string{HeaderGRPCCode, HeaderStream}, ", ")
 )
 
 // AccessControlDecision describes how to handle a cross-origin request.
@@ -170,25 +171,54 @@ type Server struct {
 	// invoke handler to complete the RPC.
 	UnaryServerInterceptor grpc.UnaryServerInterceptor
 
+	// StreamServerInterceptor provides a hook to intercept the execution of
+	// a server-streaming RPC on the server. It is the responsibility of the
+	// interceptor to invoke handler to complete the RPC.
+	//
+	// Only server-streaming RPCs are supported by pRPC. Client-streaming and
+	// bidirectional streaming methods are reported as unimplemented.
+	StreamServerInterceptor grpc.StreamServerInterceptor
+
 	// EnableResponseCompression allows the server to compress responses if they
 	// are larger than a certain threshold.
 	//
 	// If false (default), responses are never compressed.
 	//
-	// If true and the client sends "Accept-Encoding: gzip" (default for all Go
-	// clients), responses larger than a certain threshold will be compressed.
+	// If true and the client sends "Accept-Encoding: zstd" or
+	// "Accept-Encoding: gzip" (default for all Go clients), responses larger than
+	// a certain threshold will be compressed. zstd is preferred if the client
+	// supports both.
 	//
 	// The request compression is configured independently on the client. The
 	// server always accepts compressed requests.
+	//
+	// Responses of server-streaming RPCs are never compressed.
 	EnableResponseCompression bool
 
-	mu        sync.RWMutex
-	services  map[string]*service
-	overrides map[string]map[string]Override
+	// ResponseCompressionThreshold is the minimum size of a response, in bytes,
+	// to compress it when EnableResponseCompression is true.
+	//
+	// If 0, DefaultCompressionThreshold is used. Can be overridden for
+	// individual services via SetResponseCompressionThreshold.
+	ResponseCompressionThreshold int
+
+	// StatsHandler, if set, receives stats about served RPCs, including sizes of
+	// request and response messages before and after compression.
+	//
+	// Only events related to RPCs are reported. TagConn and HandleConn are never
+	// called.
+	StatsHandler stats.Handler
+
+	mu         sync.RWMutex
+	services   map[string]*service
+	overrides  map[string]map[string]Override
+	thresholds map[string]int
 }
 
 type service struct {
+	name    string
 	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
 	impl    any
 }
 
@@ -201,12 +231,21 @@ type service struct {
 // Panics if a service of the same name is already registered.
 func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
 	serv := &service{
+		name:    desc.ServiceName,
 		impl:    impl,
 		methods: make(map[string]grpc.MethodDesc, len(desc.Methods)),
+		streams: make(map[string]grpc.StreamDesc, len(desc.Streams)),
 	}
 	for _, m := range desc.Methods {
 		serv.methods[m.MethodName] = m
 	}
+	for _, m := range desc.Streams {
+		// Only server-streaming RPCs are supported. Other kinds of streaming
+		// methods will be reported as unimplemented.
+		if m.ServerStreams && !m.ClientStreams {
+			serv.streams[m.StreamName] = m
+		}
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -240,6 +279,22 @@ func (s *Server) RegisterOverride(serviceName, methodName string, fn Override) {
 	s.overrides[serviceName][methodName] = fn
 }
 
+// SetResponseCompressionThreshold sets the minimum size of a response of the
+// given service, in bytes, to compress it when EnableResponseCompression is
+// true.
+//
+// Overrides ResponseCompressionThreshold. A negative threshold disables the
+// response compression for the service.
+func (s *Server) SetResponseCompressionThreshold(serviceName string, threshold int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.thresholds == nil {
+		s.thresholds = map[string]int{}
+	}
+	s.thresholds[serviceName] = threshold
+}
+
 // InstallHandlers installs HTTP handlers at /prpc/:service/:method.
 //
 // See https://godoc.org/go.chromium.org/luci/grpc/prpc#hdr-Protocol
@@ -259,7 +314,7 @@ func (s *Server) handlePOST(c *router.Context) {
 	serviceName := c.Params.ByName("service")
 	methodName := c.Params.ByName("method")
 
-	override, service, method, methodFound := s.lookup(serviceName, methodName)
+	override, service, method, methodFound, stream, streamFound := s.lookup(serviceName, methodName)
 
 	// Override takes precedence over notImplementedErr.
 	if override != nil {
@@ -274,7 +329,7 @@ func (s *Server) handlePOST(c *router.Context) {
 			if err != nil {
 				return err
 			}
-			err = readMessage(bytes.NewReader(body), c.Request.Header, msg, s.HackFixFieldMasksForJSON)
+			_, err = readMessage(bytes.NewReader(body), c.Request.Header, msg, s.HackFixFieldMasksForJSON)
 			if err != nil {
 				return err
 			}
@@ -306,6 +361,8 @@ func (s *Server) handlePOST(c *router.Context) {
 	c.Writer.Header().Set("X-Content-Type-Options", "nosniff")
 	c.Writer.Header()["Date"] = nil // omit, not part of the protocol
 
+	fullMethod := fullMethodName(serviceName, methodName)
+
 	res := response{}
 	switch {
 	case service == nil:
@@ -313,21 +370,25 @@ func (s *Server) handlePOST(c *router.Context) {
 			codes.Unimplemented,
 			"service %q is not implemented",
 			serviceName)
+	case streamFound:
+		s.callStream(c, service, fullMethod, stream, &res)
 	case !methodFound:
 		res.err = status.Errorf(
 			codes.Unimplemented,
 			"method %q in service %q is not implemented",
 			methodName, serviceName)
 	default:
-		s.call(c, service, method, &res)
+		s.call(c, service, fullMethod, method, &res)
 	}
 
-	if res.err != nil {
+	switch {
+	case res.err != nil:
 		writeError(c.Request.Context(), c.Writer, res.err, res.fmt)
-		return
+	case res.out != nil:
+		size := writeMessage(c.Request.Context(), c.Writer, res.out, res.fmt, res.compression, res.threshold)
+		res.stats.outPayload(res.out, size)
 	}
-
-	writeMessage(c.Request.Context(), c.Writer, res.out, res.fmt, s.EnableResponseCompression && res.acceptsGZip)
+	res.stats.end(res.err)
 }
 
 func (s *Server) handleOPTIONS(c *router.Context) {
@@ -357,14 +418,19 @@ func SetHeader(ctx context.Context, md metadata.MD) error {
 	return grpc.SetHeader(ctx, md)
 }
 
+// response is populated by call and callStream.
+//
+// If both `out` and `err` are nil, the response was already written.
 type response struct {
 	out         proto.Message
 	fmt         Format
-	acceptsGZip bool
+	compression Compression // the compression to use for the response
+	threshold   int         // the minimum size of the response to compress it
+	stats       *rpcStats   // nil if there's no stats handler
 	err         error
 }
 
-func (s *Server) lookup(serviceName, methodName string) (override Override, service *service, method grpc.MethodDesc, methodFound bool) {
+func (s *Server) lookup(serviceName, methodName string) (override Override, service *service, method grpc.MethodDesc, methodFound bool, stream grpc.StreamDesc, streamFound bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	if methods, ok := s.overrides[serviceName]; ok {
@@ -375,27 +441,37 @@ func (s *Server) lookup(serviceName, methodName string) (override Override, serv
 		return
 	}
 	method, methodFound = service.methods[methodName]
+	stream, streamFound = service.streams[methodName]
 	return
 }
 
-func (s *Server) call(c *router.Context, service *service, method grpc.MethodDesc, r *response) {
+// prepareCall parses request headers and prepares the context for the method
+// handler.
+//
+// On errors populates r.err and returns a nil context.
+func (s *Server) prepareCall(c *router.Context, serviceName, fullMethod string, serverStream bool, r *response) (context.Context, context.CancelFunc) {
 	var perr *protocolError
 	r.fmt, perr = responseFormat(c.Request.Header.Get(headerAccept))
 	if perr != nil {
 		r.err = perr
-		return
+		return nil, nil
 	}
 
 	methodCtx, cancelFunc, err := parseHeader(c.Request.Context(), c.Request.Header, c.Request.Host)
 	if err != nil {
 		r.err = protocolErr(codes.InvalidArgument, http.StatusBadRequest, "bad request headers: %s", err)
-		return
+		return nil, nil
 	}
-	defer cancelFunc()
 
-	if r.acceptsGZip, err = acceptsGZipResponse(c.Request.Header); err != nil {
-		r.err = protocolErr(codes.InvalidArgument, http.StatusBadRequest, "bad Accept headers: %s", err)
-		return
+	if s.EnableResponseCompression && !serverStream {
+		if r.compression, err = responseCompression(c.Request.Header); err != nil {
+			cancelFunc()
+			r.err = protocolErr(codes.InvalidArgument, http.StatusBadRequest, "bad Accept headers: %s", err)
+			return nil, nil
+		}
+		if r.threshold = s.responseCompressionThreshold(serviceName); r.threshold < 0 {
+			r.compression = CompressionNone
+		}
 	}
 
 	methodCtx = context.WithValue(methodCtx, &requestContextKey, &requestContext{header: c.Writer.Header()})
@@ -408,11 +484,45 @@ func (s *Server) call(c *router.Context, service *service, method grpc.MethodDes
 		})
 	}
 
+	methodCtx = extractTraceContext(methodCtx, c.Request.Header)
+	methodCtx, r.stats = startRPCStats(methodCtx, s.StatsHandler, fullMethod, false, serverStream)
+	return methodCtx, cancelFunc
+}
+
+// responseCompressionThreshold returns the response compression threshold
+// for the given service.
+//
+// A negative value means the compression is disabled for this service.
+func (s *Server) responseCompressionThreshold(serviceName string) int {
+	s.mu.RLock()
+	threshold, ok := s.thresholds[serviceName]
+	s.mu.RUnlock()
+	switch {
+	case ok:
+		return threshold
+	case s.ResponseCompressionThreshold != 0:
+		return s.ResponseCompressionThreshold
+	default:
+		return DefaultCompressionThreshold
+	}
+}
+
+func (s *Server) call(c *router.Context, service *service, fullMethod string, method grpc.MethodDesc, r *response) {
+	methodCtx, cancelFunc := s.prepareCall(c, service.name, fullMethod, false, r)
+	if methodCtx == nil {
+		return
+	}
+	defer cancelFunc()
+
 	out, err := method.Handler(service.impl, methodCtx, func(in any) error {
 		if in == nil {
 			return status.Errorf(codes.Internal, "input message is nil")
 		}
-		return readMessage(c.Request.Body, c.Request.Header, in.(proto.Message), s.HackFixFieldMasksForJSON)
+		size, err := readMessage(c.Request.Body, c.Request.Header, in.(proto.Message), s.HackFixFieldMasksForJSON)
+		if err == nil {
+			r.stats.inPayload(in, size)
+		}
+		return err
 	}, s.UnaryServerInterceptor)
 
 	switch {
@@ -425,6 +535,35 @@ func (s *Server) call(c *router.Context, service *service, method grpc.MethodDes
 	}
 }
 
+// callStream calls a server-streaming method.
+//
+// Response messages are written directly to the response writer. If the
+// method fails before sending any messages or headers, populates r.err to
+// let the caller write a regular error response.
+func (s *Server) callStream(c *router.Context, service *service, fullMethod string, desc grpc.StreamDesc, r *response) {
+	methodCtx, cancelFunc := s.prepareCall(c, service.name, fullMethod, true, r)
+	if methodCtx == nil {
+		return
+	}
+	defer cancelFunc()
+
+	ss := &serverStream{
+		ctx:           methodCtx,
+		rw:            c.Writer,
+		req:           c.Request,
+		format:        r.fmt,
+		fixFieldMasks: s.HackFixFieldMasksForJSON,
+		stats:         r.stats,
+	}
+	err := s.streamHandler(service, fullMethod, desc, ss)
+	if r.err = ss.finish(err); r.err == nil {
+		// The status was sent in the trailer frame. Report it right away, since
+		// the caller doesn't know about it.
+		r.stats.end(err)
+		r.stats = nil
+	}
+}
+
 func (s *Server) setAccessControlHeaders(c *router.Context, preflight bool) {
 	// Don't write out access control headers if the origin is unspecified.
 	const originHeader = "Origin"
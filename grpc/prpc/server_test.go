@@ -207,7 +207,7 @@ func TestServer(t *testing.T) {
 				So(res.Header().Get(HeaderGRPCCode), ShouldEqual, "0")
 				So(res.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "http://example.com")
 				So(res.Header().Get("Access-Control-Allow-Credentials"), ShouldEqual, "")
-				So(res.Header().Get("Access-Control-Expose-Headers"), ShouldEqual, HeaderGRPCCode)
+				So(res.Header().Get("Access-Control-Expose-Headers"), ShouldEqual, HeaderGRPCCode+", "+HeaderStream)
 			})
 
 			Convey(`When access control is enabled for "http://example.com"`, func() {
@@ -232,7 +232,7 @@ func TestServer(t *testing.T) {
 						So(res.Code, ShouldEqual, http.StatusOK)
 						So(res.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "http://example.com")
 						So(res.Header().Get("Access-Control-Allow-Credentials"), ShouldEqual, "true")
-						So(res.Header().Get("Access-Control-Allow-Headers"), ShouldEqual, "Origin, Content-Type, Accept, Authorization")
+						So(res.Header().Get("Access-Control-Allow-Headers"), ShouldEqual, "Origin, Content-Type, Accept, Authorization, traceparent, tracestate")
 						So(res.Header().Get("Access-Control-Allow-Methods"), ShouldEqual, "OPTIONS, POST")
 						So(res.Header().Get("Access-Control-Max-Age"), ShouldEqual, "600")
 					})
@@ -255,7 +255,7 @@ func TestServer(t *testing.T) {
 						So(res.Header().Get(HeaderGRPCCode), ShouldEqual, "0")
 						So(res.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "http://example.com")
 						So(res.Header().Get("Access-Control-Allow-Credentials"), ShouldEqual, "true")
-						So(res.Header().Get("Access-Control-Expose-Headers"), ShouldEqual, HeaderGRPCCode)
+						So(res.Header().Get("Access-Control-Expose-Headers"), ShouldEqual, HeaderGRPCCode+", "+HeaderStream)
 					})
 
 					Convey(`Will not supply access-* headers to "http://foo.bar"`, func() {
@@ -278,7 +278,7 @@ func TestServer(t *testing.T) {
 					So(res.Code, ShouldEqual, http.StatusOK)
 					So(res.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "http://example.com")
 					So(res.Header().Get("Access-Control-Allow-Credentials"), ShouldEqual, "true")
-					So(res.Header().Get("Access-Control-Allow-Headers"), ShouldEqual, "Booboo, bobo, Origin, Content-Type, Accept, Authorization")
+					So(res.Header().Get("Access-Control-Allow-Headers"), ShouldEqual, "Booboo, bobo, Origin, Content-Type, Accept, Authorization, traceparent, tracestate")
 					So(res.Header().Get("Access-Control-Allow-Methods"), ShouldEqual, "OPTIONS, POST")
 					So(res.Header().Get("Access-Control-Max-Age"), ShouldEqual, "600")
 				})
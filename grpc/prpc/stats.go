@@ -0,0 +1,135 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+
+	"go.chromium.org/luci/common/clock"
+)
+
+// payloadSize is a size of a request or a response message.
+type payloadSize struct {
+	length     int // the size of the serialized message before compression
+	wireLength int // the size of the message as it is sent over the wire
+}
+
+// rpcStats reports events of a single RPC to a stats.Handler and to a tracing
+// span.
+//
+// All methods are noops if the *rpcStats is nil. This happens when there's
+// no stats.Handler configured and the RPC is not traced.
+type rpcStats struct {
+	h      stats.Handler   // nil if there's no stats handler
+	ctx    context.Context // the context returned by h.TagRPC
+	span   trace.Span      // nil if the RPC is not traced
+	client bool
+	begin  time.Time
+}
+
+// startRPCStats opens a tracing span, tags the RPC and reports its beginning
+// to the handler.
+//
+// Returns the context that should be used for the rest of the RPC and the
+// object to report subsequent events through. If h is nil and the span is not
+// recording, the returned *rpcStats is nil.
+func startRPCStats(ctx context.Context, h stats.Handler, fullMethod string, client, serverStream bool) (context.Context, *rpcStats) {
+	ctx, span := startSpan(ctx, fullMethod, client)
+	if h == nil && span == nil {
+		return ctx, nil
+	}
+	s := &rpcStats{
+		h:      h,
+		ctx:    ctx,
+		span:   span,
+		client: client,
+		begin:  clock.Now(ctx),
+	}
+	if h != nil {
+		ctx = h.TagRPC(ctx, &stats.RPCTagInfo{FullMethodName: fullMethod})
+		s.ctx = ctx
+		h.HandleRPC(ctx, &stats.Begin{
+			Client:         client,
+			BeginTime:      s.begin,
+			IsServerStream: serverStream,
+		})
+	}
+	return ctx, s
+}
+
+// inPayload reports a received message.
+func (s *rpcStats) inPayload(msg any, size payloadSize) {
+	if s == nil {
+		return
+	}
+	if s.span != nil {
+		messageEvent(s.span, false, size)
+	}
+	if s.h == nil {
+		return
+	}
+	s.h.HandleRPC(s.ctx, &stats.InPayload{
+		Client:           s.client,
+		Payload:          msg,
+		Length:           size.length,
+		CompressedLength: size.wireLength,
+		WireLength:       size.wireLength,
+		RecvTime:         clock.Now(s.ctx),
+	})
+}
+
+// outPayload reports a sent message.
+func (s *rpcStats) outPayload(msg any, size payloadSize) {
+	if s == nil {
+		return
+	}
+	if s.span != nil {
+		messageEvent(s.span, true, size)
+	}
+	if s.h == nil {
+		return
+	}
+	s.h.HandleRPC(s.ctx, &stats.OutPayload{
+		Client:           s.client,
+		Payload:          msg,
+		Length:           size.length,
+		CompressedLength: size.wireLength,
+		WireLength:       size.wireLength,
+		SentTime:         clock.Now(s.ctx),
+	})
+}
+
+// end reports the end of the RPC.
+func (s *rpcStats) end(err error) {
+	if s == nil {
+		return
+	}
+	if s.span != nil {
+		endSpan(s.span, s.client, err)
+	}
+	if s.h == nil {
+		return
+	}
+	s.h.HandleRPC(s.ctx, &stats.End{
+		Client:    s.client,
+		BeginTime: s.begin,
+		EndTime:   clock.Now(s.ctx),
+		Error:     err,
+	})
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+// This file implements the framing of server-streaming responses and the
+// server side of server-streaming RPCs.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging"
+)
+
+const (
+	// HeaderStream is a name of the HTTP response header that indicates that
+	// the response body is a sequence of frames of a server-streaming RPC.
+	//
+	// Its only valid value is "1".
+	HeaderStream = "X-Prpc-Stream"
+
+	// headerGRPCMessage is a name of the header in the trailer frame that
+	// contains the percent-encoded error message.
+	headerGRPCMessage = "X-Prpc-Grpc-Message"
+
+	// frameHeaderLen is the length of the frame header: 1 byte of flags and
+	// 4 bytes of big-endian payload length.
+	frameHeaderLen = 5
+
+	// frameMessage is the frame flag for frames that carry a response message.
+	frameMessage byte = 0x00
+	// frameTrailer is the frame flag for the final frame that carries the RPC
+	// status and trailer metadata.
+	frameTrailer byte = 0x80
+)
+
+// writeFrame writes a single frame with the given flags and payload.
+func writeFrame(w io.Writer, flags byte, payload []byte) error {
+	var hdr [frameHeaderLen]byte
+	hdr[0] = flags
+	binary.BigEndian.PutUint32(hdr[1:], uint32(len(payload)))
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readFrame reads a single frame, returning its flags and payload.
+//
+// Returns io.EOF if there are no more frames. Returns ErrResponseTooBig if the
+// payload is larger than maxLen.
+func readFrame(r io.Reader, maxLen int) (flags byte, payload []byte, err error) {
+	var hdr [frameHeaderLen]byte
+	switch _, err := io.ReadFull(r, hdr[:]); {
+	case err == io.EOF:
+		return 0, nil, io.EOF
+	case err != nil:
+		return 0, nil, err
+	}
+	l := binary.BigEndian.Uint32(hdr[1:])
+	if int64(l) > int64(maxLen) {
+		return 0, nil, ErrResponseTooBig
+	}
+	payload = make([]byte, l)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return hdr[0], payload, nil
+}
+
+// encodeTrailer encodes the RPC status and trailer metadata as a payload of
+// the trailer frame.
+//
+// The payload is a MIME header block, just like HTTP/1 headers.
+func encodeTrailer(code codes.Code, msg string, details []string, md metadata.MD) ([]byte, error) {
+	h := make(http.Header, len(md)+3)
+	if err := metaIntoHeaders(md, h); err != nil {
+		return nil, err
+	}
+	h.Set(HeaderGRPCCode, strconv.Itoa(int(code)))
+	if msg != "" {
+		h.Set(headerGRPCMessage, url.PathEscape(msg))
+	}
+	if len(details) != 0 {
+		h[HeaderStatusDetail] = details
+	}
+	var buf bytes.Buffer
+	if err := h.Write(&buf); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
+
+// decodeTrailer decodes the payload of the trailer frame.
+//
+// Returns the trailer as http.Header. It contains both pRPC reserved headers
+// (e.g. the RPC status) and the trailer metadata.
+func decodeTrailer(payload []byte) (http.Header, error) {
+	h, err := textproto.NewReader(bufio.NewReader(bytes.NewReader(payload))).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	return http.Header(h), nil
+}
+
+// serverStream implements grpc.ServerStream on top of an HTTP response.
+//
+// Used to serve server-streaming RPCs. The request message is read from the
+// request body, response messages are written as frames to the response body.
+type serverStream struct {
+	ctx           context.Context
+	rw            http.ResponseWriter
+	req           *http.Request
+	format        Format
+	fixFieldMasks bool
+	stats         *rpcStats // nil if there's no stats handler
+
+	recvDone  bool        // true if the request message was already read
+	committed bool        // true if the response headers were already sent
+	trailer   metadata.MD // metadata to send in the trailer frame
+}
+
+var _ grpc.ServerStream = (*serverStream)(nil)
+
+// SetHeader sets the header metadata.
+//
+// It may be called multiple times. When called multiple times, all the
+// provided metadata will be merged. Fails if the headers were already sent.
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	if s.committed {
+		return status.Errorf(codes.Internal, "prpc: the headers were already sent")
+	}
+	if err := metaIntoHeaders(md, s.rw.Header()); err != nil {
+		return status.Errorf(codes.Internal, "prpc: %s", err)
+	}
+	return nil
+}
+
+// SendHeader sends the header metadata.
+//
+// The provided md and headers set by SetHeader will be sent. Fails if called
+// multiple times.
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// SetTrailer sets the trailer metadata which will be sent with the RPC status.
+//
+// When called more than once, all the provided metadata will be merged.
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+// Context returns the context for this stream.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a message as a frame, flushing it to the client.
+func (s *serverStream) SendMsg(m any) error {
+	if err := s.ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad message type %T, not a proto", m)
+	}
+	if err := s.commit(); err != nil {
+		return err
+	}
+	payload, err := marshalMessage(msg, s.format, false)
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: failed to marshal the response: %s", err)
+	}
+	if err := writeFrame(s.rw, frameMessage, payload); err != nil {
+		return status.Errorf(codes.Canceled, "prpc: failed to write the response: %s", err)
+	}
+	s.stats.outPayload(msg, payloadSize{
+		length:     len(payload),
+		wireLength: frameHeaderLen + len(payload),
+	})
+	return s.flush()
+}
+
+// RecvMsg reads the request message.
+//
+// Returns io.EOF if it was already read. Only server-streaming RPCs are
+// supported and they always have exactly one request message.
+func (s *serverStream) RecvMsg(m any) error {
+	if s.recvDone {
+		return io.EOF
+	}
+	s.recvDone = true
+	if m == nil {
+		return status.Errorf(codes.Internal, "input message is nil")
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad message type %T, not a proto", m)
+	}
+	size, err := readMessage(s.req.Body, s.req.Header, msg, s.fixFieldMasks)
+	if err != nil {
+		return err
+	}
+	s.stats.inPayload(msg, size)
+	return nil
+}
+
+// commit sends the response headers if they haven't been sent yet.
+func (s *serverStream) commit() error {
+	if s.committed {
+		return nil
+	}
+	s.committed = true
+	h := s.rw.Header()
+	h.Set(HeaderGRPCCode, strconv.Itoa(int(codes.OK)))
+	h.Set(HeaderStream, "1")
+	h.Set(headerContentType, s.format.MediaType())
+	s.rw.WriteHeader(http.StatusOK)
+	return s.flush()
+}
+
+// flush flushes buffered data to the client.
+func (s *serverStream) flush() error {
+	switch err := http.NewResponseController(s.rw).Flush(); {
+	case err == nil:
+		return nil
+	case err == http.ErrNotSupported:
+		// Nothing we can do. The data will be sent when the handler is done.
+		return nil
+	default:
+		return status.Errorf(codes.Canceled, "prpc: failed to flush the response: %s", err)
+	}
+}
+
+// finish completes the stream after the handler returns.
+//
+// If the response headers haven't been sent yet and the handler failed,
+// returns the error as is. It should be sent to the client as a regular pRPC
+// error response. Otherwise writes the trailer frame and returns nil.
+func (s *serverStream) finish(err error) error {
+	if !s.committed && err != nil {
+		return err
+	}
+	if cerr := s.commit(); cerr != nil {
+		logging.Warningf(s.ctx, "prpc: %s", cerr)
+		return nil
+	}
+
+	code, msg, details := codes.OK, "", []string(nil)
+	if err != nil {
+		var st *status.Status
+		st, _, details, msg = errorResponse(s.ctx, err, s.format)
+		code = st.Code()
+	}
+
+	payload, perr := encodeTrailer(code, msg, details, s.trailer)
+	if perr != nil {
+		logging.Errorf(s.ctx, "prpc: failed to encode the trailer: %s", perr)
+		payload, _ = encodeTrailer(codes.Internal, "prpc: failed to encode the trailer", nil, nil)
+	}
+
+	// Errors below most commonly happen if the client disconnects. There is
+	// nothing more we can do other than just log them.
+	if werr := writeFrame(s.rw, frameTrailer, payload); werr != nil {
+		logging.Warningf(s.ctx, "prpc: failed to write the trailer: %s", werr)
+		return nil
+	}
+	if ferr := s.flush(); ferr != nil {
+		logging.Warningf(s.ctx, "prpc: %s", ferr)
+	}
+	return nil
+}
+
+// streamHandler invokes a server-streaming method handler, perhaps through
+// the interceptor.
+func (s *Server) streamHandler(service *service, fullMethod string, desc grpc.StreamDesc, ss grpc.ServerStream) error {
+	if s.StreamServerInterceptor == nil {
+		return desc.Handler(service.impl, ss)
+	}
+	info := &grpc.StreamServerInfo{
+		FullMethod:     fullMethod,
+		IsClientStream: desc.ClientStreams,
+		IsServerStream: desc.ServerStreams,
+	}
+	return s.StreamServerInterceptor(service.impl, ss, info, desc.Handler)
+}
+
+// fullMethodName returns "/service/method" string.
+func fullMethodName(serviceName, methodName string) string {
+	return fmt.Sprintf("/%s/%s", serviceName, methodName)
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// instrumentationName identifies spans emitted by this package.
+const instrumentationName = "go.chromium.org/luci/grpc/prpc"
+
+// traceHeaders are headers used to propagate the W3C trace context.
+var traceHeaders = []string{"traceparent", "tracestate"}
+
+// propagator returns the propagator to use for trace context headers.
+//
+// This is the global OpenTelemetry propagator, if it is configured, or the W3C
+// Trace Context propagator otherwise. That way traces are not broken between
+// pRPC hops even in processes that don't configure OpenTelemetry propagation.
+func propagator() propagation.TextMapPropagator {
+	if p := otel.GetTextMapPropagator(); len(p.Fields()) != 0 {
+		return p
+	}
+	return propagation.TraceContext{}
+}
+
+// injectTraceContext puts the trace context of `ctx` into request headers.
+func injectTraceContext(ctx context.Context, h http.Header) {
+	propagator().Inject(ctx, propagation.HeaderCarrier(h))
+}
+
+// extractTraceContext returns a context with the remote trace context from
+// request headers.
+//
+// Does nothing if `ctx` already has a span, e.g. when the HTTP server has
+// already extracted the trace context and opened a span.
+func extractTraceContext(ctx context.Context, h http.Header) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	return propagator().Extract(ctx, propagation.HeaderCarrier(h))
+}
+
+// startSpan opens a span representing an RPC.
+//
+// Returns nil span if the span is not recording (e.g. there's no tracer
+// provider configured or the trace is not sampled). The returned context
+// should be used in any case, since it carries the span context to propagate.
+func startSpan(ctx context.Context, fullMethod string, client bool) (context.Context, trace.Span) {
+	kind := trace.SpanKindServer
+	if client {
+		kind = trace.SpanKindClient
+	}
+	name := strings.TrimPrefix(fullMethod, "/")
+	service, method, _ := strings.Cut(name, "/")
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("prpc"),
+			semconv.RPCService(service),
+			semconv.RPCMethod(method),
+		),
+	)
+	if !span.IsRecording() {
+		return ctx, nil
+	}
+	return ctx, span
+}
+
+// messageEvent records a sent or received message in the span.
+func messageEvent(span trace.Span, sent bool, size payloadSize) {
+	typ := semconv.MessageTypeReceived
+	if sent {
+		typ = semconv.MessageTypeSent
+	}
+	span.AddEvent("message", trace.WithAttributes(
+		typ,
+		semconv.MessageUncompressedSize(size.length),
+		semconv.MessageCompressedSize(size.wireLength),
+	))
+}
+
+// endSpan records the RPC status in the span and ends it.
+func endSpan(span trace.Span, client bool, err error) {
+	st, _ := status.FromError(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(st.Code())))
+	if isSpanError(st.Code(), client) {
+		span.SetStatus(otelcodes.Error, st.Message())
+	}
+	span.End()
+}
+
+// isSpanError returns true if an RPC with the given code should be marked as
+// failed in the trace.
+//
+// Follows OpenTelemetry semantic conventions for gRPC: all non-OK codes are
+// errors for clients, but only some of them are for servers, since others are
+// caused by the client.
+func isSpanError(code codes.Code, client bool) bool {
+	if client {
+		return code != codes.OK
+	}
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented,
+		codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
@@ -20,6 +20,8 @@ package svcmux
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -29,15 +31,24 @@ import (
 // service version.
 const VersionMetadataKey = "X-Luci-Service-Version"
 
+// versionAttributeKey is a tracing span attribute with the service version.
+const versionAttributeKey = attribute.Key("luci.service_version")
+
 // ServiceVersion extracts requested service version from metadata in ctx.
+//
+// Also records the version in the current tracing span, if any.
 func ServiceVersion(ctx context.Context, defaultVer string) string {
+	ver := defaultVer
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		values := md[VersionMetadataKey]
 		if len(values) != 0 {
-			return values[0]
+			ver = values[0]
 		}
 	}
-	return defaultVer
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		span.SetAttributes(versionAttributeKey.String(ver))
+	}
+	return ver
 }
 
 // NoImplementation creates an error for a service version that does not have an
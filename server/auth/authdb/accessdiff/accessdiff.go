@@ -0,0 +1,210 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package accessdiff calculates how changes to AuthDB affect access.
+//
+// It is intended for offline tools that help to review large group or realm
+// restructurings before they land in the Auth Service.
+package accessdiff
+
+import (
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/conds"
+	"go.chromium.org/luci/server/auth/authdb/internal/graph"
+	"go.chromium.org/luci/server/auth/service/protocol"
+)
+
+// Access is a permission granted to a principal in a realm.
+type Access struct {
+	// Principal is an identity (e.g. "user:someone@example.com") or an identity
+	// glob (e.g. "user:*@example.com") that has the permission.
+	Principal string
+	// Permission is the name of the granted permission.
+	Permission string
+	// Realm is the full name of the realm where the permission is granted.
+	Realm string
+	// Condition describes the conditions the grant is subject to.
+	//
+	// Empty if the grant is unconditional.
+	Condition string
+}
+
+// Change is an access gained or lost when switching from one AuthDB to
+// another.
+type Change struct {
+	Access
+	Gained bool // true if the access was gained, false if lost
+}
+
+// Compute returns all accesses that are different between two AuthDBs.
+//
+// Only realms explicitly defined in AuthDBs are compared, i.e. a fallback to
+// the project's root realm when checking permissions in undefined realms is
+// not taken into account.
+//
+// Changes are sorted by the principal, then by the permission, then by the
+// realm.
+func Compute(old, new *protocol.AuthDB) ([]Change, error) {
+	before, err := newPolicy(old)
+	if err != nil {
+		return nil, errors.Annotate(err, "old AuthDB").Err()
+	}
+	after, err := newPolicy(new)
+	if err != nil {
+		return nil, errors.Annotate(err, "new AuthDB").Err()
+	}
+
+	keys := make(map[grantKey]struct{}, len(after.grants))
+	for key := range before.grants {
+		keys[key] = struct{}{}
+	}
+	for key := range after.grants {
+		keys[key] = struct{}{}
+	}
+
+	var out []Change
+	emit := func(key grantKey, principals stringset.Set, gained bool) {
+		principals.Iter(func(principal string) bool {
+			out = append(out, Change{
+				Access: Access{
+					Principal:  principal,
+					Permission: key.perm,
+					Realm:      key.realm,
+					Condition:  key.cond,
+				},
+				Gained: gained,
+			})
+			return true
+		})
+	}
+	for key := range keys {
+		was := before.expand(before.grants[key])
+		now := after.expand(after.grants[key])
+		emit(key, now.Difference(was), true)
+		emit(key, was.Difference(now), false)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		l, r := out[i], out[j]
+		switch {
+		case l.Principal != r.Principal:
+			return l.Principal < r.Principal
+		case l.Permission != r.Permission:
+			return l.Permission < r.Permission
+		case l.Realm != r.Realm:
+			return l.Realm < r.Realm
+		case l.Condition != r.Condition:
+			return l.Condition < r.Condition
+		default:
+			return !l.Gained && r.Gained
+		}
+	})
+	return out, nil
+}
+
+// grantKey identifies a set of principals that have some permission.
+type grantKey struct {
+	realm string
+	perm  string
+	cond  string
+}
+
+// policy is AuthDB preprocessed for access calculations.
+type policy struct {
+	groups   *graph.Graph
+	grants   map[grantKey]stringset.Set        // principals as they are in bindings
+	expanded map[graph.NodeIndex]stringset.Set // group => all identities and globs
+}
+
+func newPolicy(db *protocol.AuthDB) (*policy, error) {
+	groups, err := graph.Build(db.GetGroups())
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to build groups graph").Err()
+	}
+	p := &policy{
+		groups:   groups,
+		grants:   map[grantKey]stringset.Set{},
+		expanded: map[graph.NodeIndex]stringset.Set{},
+	}
+
+	r := db.GetRealms()
+	if r == nil {
+		return p, nil
+	}
+	builder := conds.NewBuilder(r.Conditions)
+	for _, realm := range r.Realms {
+		for _, binding := range realm.Bindings {
+			cond, err := builder.Condition(binding.Conditions)
+			if err != nil {
+				return nil, errors.Annotate(err, "invalid binding in realm %q", realm.Name).Err()
+			}
+			desc := ""
+			if cond != nil {
+				desc = cond.Describe()
+			}
+			for _, idx := range binding.Permissions {
+				if int(idx) >= len(r.Permissions) {
+					return nil, errors.Reason("invalid binding in realm %q: permission index %d is out of bounds", realm.Name, idx).Err()
+				}
+				key := grantKey{realm: realm.Name, perm: r.Permissions[idx].Name, cond: desc}
+				if p.grants[key] == nil {
+					p.grants[key] = stringset.New(len(binding.Principals))
+				}
+				p.grants[key].AddAll(binding.Principals)
+			}
+		}
+	}
+	return p, nil
+}
+
+// expand replaces groups with identities and globs they include.
+//
+// Unknown groups are skipped.
+func (p *policy) expand(principals stringset.Set) stringset.Set {
+	out := stringset.New(principals.Len())
+	principals.Iter(func(principal string) bool {
+		name, isGroup := strings.CutPrefix(principal, "group:")
+		if !isGroup {
+			out.Add(principal)
+		} else if node := p.groups.NodeByName(name); node != nil {
+			p.groupMembers(node.Index).Iter(func(member string) bool {
+				out.Add(member)
+				return true
+			})
+		}
+		return true
+	})
+	return out
+}
+
+// groupMembers returns all identities and globs of the group and its nested
+// groups.
+func (p *policy) groupMembers(idx graph.NodeIndex) stringset.Set {
+	if members, ok := p.expanded[idx]; ok {
+		return members
+	}
+	members := stringset.New(0)
+	_ = p.groups.Visit(p.groups.Descendants(idx), func(n *graph.Node) error {
+		members.AddAll(n.Members)
+		members.AddAll(n.Globs)
+		return nil
+	})
+	p.expanded[idx] = members
+	return members
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessdiff
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	Convey("Compute works", t, func() {
+		restrict := &protocol.Condition{
+			Op: &protocol.Condition_Restrict{
+				Restrict: &protocol.Condition_AttributeRestriction{
+					Attribute: "a",
+					Values:    []string{"x"},
+				},
+			},
+		}
+
+		old := &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				{Name: "outer", Nested: []string{"inner"}},
+				{Name: "inner", Members: []string{"user:a@example.com"}},
+			},
+			Realms: &protocol.Realms{
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.p1"},
+					{Name: "luci.dev.p2"},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{
+								Permissions: []uint32{0, 1},
+								Principals:  []string{"group:outer", "user:b@example.com"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		Convey("No changes", func() {
+			changes, err := Compute(old, old)
+			So(err, ShouldBeNil)
+			So(changes, ShouldBeEmpty)
+		})
+
+		Convey("Group edits", func() {
+			new := &protocol.AuthDB{
+				Groups: []*protocol.AuthGroup{
+					{Name: "outer", Nested: []string{"inner"}, Globs: []string{"user:*@example.org"}},
+					{Name: "inner", Members: []string{"user:c@example.com"}},
+				},
+				Realms: old.Realms,
+			}
+			changes, err := Compute(old, new)
+			So(err, ShouldBeNil)
+			So(changes, ShouldResemble, []Change{
+				{Access: Access{Principal: "user:*@example.org", Permission: "luci.dev.p1", Realm: "proj:@root"}, Gained: true},
+				{Access: Access{Principal: "user:*@example.org", Permission: "luci.dev.p2", Realm: "proj:@root"}, Gained: true},
+				{Access: Access{Principal: "user:a@example.com", Permission: "luci.dev.p1", Realm: "proj:@root"}, Gained: false},
+				{Access: Access{Principal: "user:a@example.com", Permission: "luci.dev.p2", Realm: "proj:@root"}, Gained: false},
+				{Access: Access{Principal: "user:c@example.com", Permission: "luci.dev.p1", Realm: "proj:@root"}, Gained: true},
+				{Access: Access{Principal: "user:c@example.com", Permission: "luci.dev.p2", Realm: "proj:@root"}, Gained: true},
+			})
+		})
+
+		Convey("Realm edits", func() {
+			new := &protocol.AuthDB{
+				Groups: old.Groups,
+				Realms: &protocol.Realms{
+					Permissions: []*protocol.Permission{
+						{Name: "luci.dev.p1"},
+					},
+					Conditions: []*protocol.Condition{restrict},
+					Realms: []*protocol.Realm{
+						{
+							Name: "proj:@root",
+							Bindings: []*protocol.Binding{
+								{
+									Permissions: []uint32{0},
+									Principals:  []string{"group:outer"},
+								},
+								{
+									Permissions: []uint32{0},
+									Principals:  []string{"user:b@example.com"},
+									Conditions:  []uint32{0},
+								},
+							},
+						},
+					},
+				},
+			}
+			changes, err := Compute(old, new)
+			So(err, ShouldBeNil)
+			So(changes, ShouldResemble, []Change{
+				{Access: Access{Principal: "user:a@example.com", Permission: "luci.dev.p2", Realm: "proj:@root"}, Gained: false},
+				{Access: Access{Principal: "user:b@example.com", Permission: "luci.dev.p1", Realm: "proj:@root"}, Gained: false},
+				{Access: Access{Principal: "user:b@example.com", Permission: "luci.dev.p1", Realm: "proj:@root", Condition: `attribute "a" is one of ["x"]`}, Gained: true},
+				{Access: Access{Principal: "user:b@example.com", Permission: "luci.dev.p2", Realm: "proj:@root"}, Gained: false},
+			})
+		})
+
+		Convey("Bad AuthDB", func() {
+			new := &protocol.AuthDB{
+				Realms: &protocol.Realms{
+					Realms: []*protocol.Realm{
+						{
+							Name: "proj:@root",
+							Bindings: []*protocol.Binding{
+								{Permissions: []uint32{5}, Principals: []string{"user:a@example.com"}},
+							},
+						},
+					},
+				},
+			}
+			_, err := Compute(old, new)
+			So(err, ShouldErrLike, "permission index 5 is out of bounds")
+		})
+	})
+}
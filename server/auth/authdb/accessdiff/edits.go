@@ -0,0 +1,162 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessdiff
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/auth/service/protocol"
+)
+
+// ApplyEdits returns a copy of `base` with proposed edits applied.
+//
+// `edits` is a partial AuthDB:
+//   - Each group in `edits` replaces a group with the same name in `base` or
+//     is added if there's no such group. To simulate a removal of a group,
+//     list it without any members, globs and nested groups.
+//   - Realms in `edits` replace all realms of projects they belong to, i.e.
+//     they are expected to be the full expanded realms.cfg of some projects.
+//     Bindings in edited realms refer to permissions and conditions defined
+//     in `edits.Realms`. Unexpanded realms.cfg files are not supported. An
+//     expanded copy of the current realms of a project can be taken from
+//     the text dump of the current AuthDB along with its permissions and
+//     conditions lists.
+//
+// All other fields of `edits` are ignored.
+func ApplyEdits(base, edits *protocol.AuthDB) (*protocol.AuthDB, error) {
+	out := proto.Clone(base).(*protocol.AuthDB)
+
+	if len(edits.Groups) != 0 {
+		replacements := make(map[string]*protocol.AuthGroup, len(edits.Groups))
+		for _, group := range edits.Groups {
+			if _, dup := replacements[group.Name]; dup {
+				return nil, errors.Reason("group %q is edited twice", group.Name).Err()
+			}
+			replacements[group.Name] = group
+		}
+		groups := make([]*protocol.AuthGroup, 0, len(out.Groups)+len(edits.Groups))
+		for _, group := range out.Groups {
+			if replacement, ok := replacements[group.Name]; ok {
+				group = replacement
+				delete(replacements, group.Name)
+			}
+			groups = append(groups, group)
+		}
+		for _, group := range edits.Groups {
+			if _, added := replacements[group.Name]; added {
+				groups = append(groups, group)
+			}
+		}
+		out.Groups = groups
+	}
+
+	if len(edits.GetRealms().GetRealms()) != 0 {
+		merged, err := mergeRealms(out.Realms, edits.Realms)
+		if err != nil {
+			return nil, err
+		}
+		out.Realms = merged
+	}
+
+	return out, nil
+}
+
+// mergeRealms replaces realms of projects mentioned in `edits`.
+func mergeRealms(base, edits *protocol.Realms) (*protocol.Realms, error) {
+	if base == nil {
+		base = &protocol.Realms{ApiVersion: edits.ApiVersion}
+	}
+
+	projects := stringset.New(0)
+	for _, realm := range edits.Realms {
+		if err := realms.ValidateRealmName(realm.Name, realms.GlobalScope); err != nil {
+			return nil, errors.Annotate(err, "bad edited realm").Err()
+		}
+		project, _ := realms.Split(realm.Name)
+		projects.Add(project)
+	}
+
+	out := &protocol.Realms{
+		ApiVersion:  base.ApiVersion,
+		Permissions: append([]*protocol.Permission(nil), base.Permissions...),
+		Conditions:  append([]*protocol.Condition(nil), base.Conditions...),
+	}
+
+	// Map permissions used by `edits` to indexes in the merged list, appending
+	// new permissions at the end.
+	permIdx := make(map[string]uint32, len(out.Permissions))
+	for idx, perm := range out.Permissions {
+		permIdx[perm.Name] = uint32(idx)
+	}
+	permRemap := make([]uint32, len(edits.Permissions))
+	for idx, perm := range edits.Permissions {
+		if existing, ok := permIdx[perm.Name]; ok {
+			permRemap[idx] = existing
+		} else {
+			permRemap[idx] = uint32(len(out.Permissions))
+			permIdx[perm.Name] = permRemap[idx]
+			out.Permissions = append(out.Permissions, perm)
+		}
+	}
+
+	// Conditions of `edits` are simply appended.
+	condOffset := uint32(len(out.Conditions))
+	out.Conditions = append(out.Conditions, edits.Conditions...)
+
+	for _, realm := range base.Realms {
+		if project, _ := realms.Split(realm.Name); !projects.Has(project) {
+			out.Realms = append(out.Realms, realm)
+		}
+	}
+	for _, realm := range edits.Realms {
+		bindings := make([]*protocol.Binding, len(realm.Bindings))
+		for i, binding := range realm.Bindings {
+			perms := make([]uint32, len(binding.Permissions))
+			for j, idx := range binding.Permissions {
+				if int(idx) >= len(permRemap) {
+					return nil, errors.Reason("bad edited realm %q: permission index %d is out of bounds", realm.Name, idx).Err()
+				}
+				perms[j] = permRemap[idx]
+			}
+			sort.Slice(perms, func(l, r int) bool { return perms[l] < perms[r] })
+			conds := make([]uint32, len(binding.Conditions))
+			for j, idx := range binding.Conditions {
+				if int(idx) >= len(edits.Conditions) {
+					return nil, errors.Reason("bad edited realm %q: condition index %d is out of bounds", realm.Name, idx).Err()
+				}
+				conds[j] = idx + condOffset
+			}
+			bindings[i] = &protocol.Binding{
+				Permissions: perms,
+				Principals:  binding.Principals,
+				Conditions:  conds,
+			}
+		}
+		out.Realms = append(out.Realms, &protocol.Realm{
+			Name:     realm.Name,
+			Bindings: bindings,
+			Data:     realm.Data,
+		})
+	}
+	sort.Slice(out.Realms, func(l, r int) bool { return out.Realms[l].Name < out.Realms[r].Name })
+
+	return out, nil
+}
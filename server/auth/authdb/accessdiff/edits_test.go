@@ -0,0 +1,161 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessdiff
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestApplyEdits(t *testing.T) {
+	t.Parallel()
+
+	Convey("ApplyEdits works", t, func() {
+		base := &protocol.AuthDB{
+			OauthClientId: "client-id",
+			Groups: []*protocol.AuthGroup{
+				{Name: "a", Members: []string{"user:a@example.com"}},
+				{Name: "b", Members: []string{"user:b@example.com"}},
+			},
+			Realms: &protocol.Realms{
+				ApiVersion: 1,
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.p1"},
+					{Name: "luci.dev.p2"},
+				},
+				Conditions: []*protocol.Condition{
+					{},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "another:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{1}, Principals: []string{"group:a"}},
+						},
+					},
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{0}, Principals: []string{"group:a"}},
+						},
+					},
+					{
+						Name: "proj:old",
+					},
+				},
+			},
+		}
+		baseCopy := proto.Clone(base)
+
+		out, err := ApplyEdits(base, &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				{Name: "c", Members: []string{"user:c@example.com"}},
+				{Name: "b"},
+			},
+			Realms: &protocol.Realms{
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.p3"},
+					{Name: "luci.dev.p2"},
+				},
+				Conditions: []*protocol.Condition{
+					{Op: &protocol.Condition_Restrict{}},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{0, 1}, Principals: []string{"group:c"}, Conditions: []uint32{0}},
+						},
+					},
+				},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		// The base is unchanged.
+		So(base, ShouldResembleProto, baseCopy)
+
+		So(out, ShouldResembleProto, &protocol.AuthDB{
+			OauthClientId: "client-id",
+			Groups: []*protocol.AuthGroup{
+				{Name: "a", Members: []string{"user:a@example.com"}},
+				{Name: "b"},
+				{Name: "c", Members: []string{"user:c@example.com"}},
+			},
+			Realms: &protocol.Realms{
+				ApiVersion: 1,
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.p1"},
+					{Name: "luci.dev.p2"},
+					{Name: "luci.dev.p3"},
+				},
+				Conditions: []*protocol.Condition{
+					{},
+					{Op: &protocol.Condition_Restrict{}},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "another:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{1}, Principals: []string{"group:a"}},
+						},
+					},
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{1, 2}, Principals: []string{"group:c"}, Conditions: []uint32{1}},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	Convey("ApplyEdits errors", t, func() {
+		base := &protocol.AuthDB{}
+
+		_, err := ApplyEdits(base, &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{{Name: "a"}, {Name: "a"}},
+		})
+		So(err, ShouldErrLike, `group "a" is edited twice`)
+
+		_, err = ApplyEdits(base, &protocol.AuthDB{
+			Realms: &protocol.Realms{
+				Realms: []*protocol.Realm{{Name: "bad"}},
+			},
+		})
+		So(err, ShouldErrLike, "bad edited realm")
+
+		_, err = ApplyEdits(base, &protocol.AuthDB{
+			Realms: &protocol.Realms{
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{0}},
+						},
+					},
+				},
+			},
+		})
+		So(err, ShouldErrLike, "permission index 0 is out of bounds")
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/graph"
+	"go.chromium.org/luci/server/auth/authdb/internal/realmset"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/auth/service/protocol"
+)
+
+// PermissionExplanation describes how a HasPermission check was decided.
+//
+// It is produced by ExplainPermission and intended for humans debugging
+// unexpected "permission denied" errors (or unexpected grants).
+type PermissionExplanation struct {
+	Identity   identity.Identity // the identity being checked
+	Permission string            // the permission being checked
+	Realm      string            // the realm as passed to the check
+	Attrs      realms.Attrs      // attributes passed to the check
+
+	// Granted is the outcome of the check, same as HasPermission would return.
+	Granted bool
+	// CheckedRealm is the realm whose bindings were actually consulted.
+	//
+	// It is different from Realm if the check fell back to the project's root
+	// realm. Empty if no realm was consulted at all.
+	CheckedRealm string
+	// Notes are human-readable remarks about the evaluation, e.g. fallbacks.
+	Notes []string
+	// Bindings are all bindings in CheckedRealm that mention the permission.
+	Bindings []BindingExplanation
+}
+
+// BindingExplanation describes how a single realm binding was evaluated.
+type BindingExplanation struct {
+	// Conditions are elementary conditions attached to the binding.
+	Conditions []ConditionExplanation
+	// ConditionsMatched is true if all conditions evaluated to true.
+	ConditionsMatched bool
+	// Groups are names of groups listed as principals in the binding.
+	Groups []string
+	// Identities are identities listed as principals in the binding.
+	Identities []string
+	// IdentityListed is true if the identity is listed in Identities.
+	IdentityListed bool
+	// MembershipPath is a chain of nested groups via which the identity is a
+	// member of one of Groups, starting from the group listed in the binding.
+	//
+	// It is populated even if the conditions didn't match, to show which group
+	// would have granted the permission. Nil if the identity is not a member of
+	// any of Groups or if it is listed in Identities directly.
+	MembershipPath []string
+	// Granted is true if this binding grants the permission.
+	Granted bool
+}
+
+// ConditionExplanation is an outcome of evaluating an elementary condition.
+type ConditionExplanation struct {
+	Description string // a human-readable description of the condition
+	Matched     bool   // true if the condition evaluated to true
+}
+
+// ExplainPermission is like SnapshotDB.HasPermission, but instead of a boolean
+// returns the full trace of the decision made by the given AuthDB.
+//
+// Accepts any permission, even ones not registered in the current process.
+// This is useful in tools that inspect AuthDB of other services.
+//
+// It evaluates all bindings (instead of stopping at the first one that applies)
+// and builds the queryable representation of the AuthDB on every call, so it is
+// slow. Must not be used in hot paths.
+func ExplainPermission(ctx context.Context, authDB *protocol.AuthDB, id identity.Identity, perm, realm string, attrs realms.Attrs) (*PermissionExplanation, error) {
+	if err := realms.ValidatePermissionName(perm); err != nil {
+		return nil, err
+	}
+	db, err := newSnapshotDB(authDB, "", 0, false, map[string]realms.PermissionFlags{perm: 0})
+	if err != nil {
+		return nil, err
+	}
+	// SnapshotDB doesn't keep the nesting structure of groups. Rebuild it to
+	// find membership paths. Node indexes match ones in the SnapshotDB, since
+	// both are built from the same list of groups.
+	groups, err := graph.Build(authDB.Groups)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to build groups graph").Err()
+	}
+	return db.explain(ctx, groups, id, perm, realm, attrs)
+}
+
+// explain implements ExplainPermission.
+//
+// Mirrors the logic of HasPermission.
+func (db *SnapshotDB) explain(ctx context.Context, groups *graph.Graph, id identity.Identity, perm, realm string, attrs realms.Attrs) (*PermissionExplanation, error) {
+	// This may happen if the AuthDB proto has no Realms yet.
+	if db.realms == nil {
+		return nil, errors.Reason("Realms API is not available").Err()
+	}
+
+	exp := &PermissionExplanation{
+		Identity:   id,
+		Permission: perm,
+		Realm:      realm,
+		Attrs:      attrs,
+	}
+	note := func(format string, args ...any) {
+		exp.Notes = append(exp.Notes, fmt.Sprintf(format, args...))
+	}
+
+	permIdx, ok := db.realms.PermissionIndexByName(perm)
+	if !ok {
+		note("Permission %q is not present in the AuthDB: denying", perm)
+		return exp, nil
+	}
+
+	// Verify such realm is defined in the DB or fallback to its @root.
+	if !db.realms.HasRealm(realm) {
+		if err := realms.ValidateRealmName(realm, realms.GlobalScope); err != nil {
+			return nil, errors.Annotate(err, "when checking %q", perm).Err()
+		}
+		project, _ := realms.Split(realm)
+		root := realms.Join(project, realms.RootRealm)
+		if realm == root {
+			note("The root realm %q doesn't exist: denying", realm)
+			return exp, nil
+		}
+		if !db.realms.HasRealm(root) {
+			note("Realm %q doesn't exist and there's no root realm %q (no such project?): denying", realm, root)
+			return exp, nil
+		}
+		note("Realm %q doesn't exist: falling back to the root realm %q", realm, root)
+		realm = root
+	}
+	exp.CheckedRealm = realm
+
+	bindings := db.realms.Bindings(realm, permIdx)
+	if len(bindings) == 0 {
+		note("No bindings in realm %q grant %q: denying", realm, perm)
+		return exp, nil
+	}
+
+	exp.Bindings = make([]BindingExplanation, len(bindings))
+	for i, binding := range bindings {
+		exp.Bindings[i] = explainBinding(ctx, groups, id, binding, attrs)
+		exp.Granted = exp.Granted || exp.Bindings[i].Granted
+	}
+	return exp, nil
+}
+
+// explainBinding evaluates a single binding.
+func explainBinding(ctx context.Context, groups *graph.Graph, id identity.Identity, binding realmset.Binding, attrs realms.Attrs) BindingExplanation {
+	out := BindingExplanation{
+		ConditionsMatched: true,
+		Groups:            make([]string, 0, len(binding.Groups)),
+		Identities:        binding.Idents.ToSortedSlice(),
+		IdentityListed:    binding.Idents.Has(string(id)),
+	}
+
+	if binding.Condition != nil {
+		for _, res := range binding.Condition.Explain(ctx, attrs) {
+			out.Conditions = append(out.Conditions, ConditionExplanation{
+				Description: res.Description,
+				Matched:     res.Matched,
+			})
+			out.ConditionsMatched = out.ConditionsMatched && res.Matched
+		}
+	}
+
+	for _, idx := range binding.Groups {
+		out.Groups = append(out.Groups, groups.Nodes[idx].Name)
+		// Prefer the shortest path if there are many.
+		if !out.IdentityListed {
+			path := groups.MembershipPath(id, idx)
+			if path != nil && (out.MembershipPath == nil || len(path) < len(out.MembershipPath)) {
+				out.MembershipPath = path
+			}
+		}
+	}
+	sort.Strings(out.Groups)
+
+	out.Granted = out.ConditionsMatched && (out.IdentityListed || out.MembershipPath != nil)
+	return out
+}
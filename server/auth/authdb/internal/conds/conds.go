@@ -21,6 +21,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -32,6 +35,7 @@ import (
 // Condition is a predicate on attributes map.
 type Condition struct {
 	conds []elementary
+	descs []string // human-readable descriptions of `conds`
 	index int
 }
 
@@ -48,6 +52,32 @@ func (c *Condition) Eval(ctx context.Context, attrs realms.Attrs) bool {
 	return true
 }
 
+// ElementaryResult is an outcome of evaluating an elementary condition.
+type ElementaryResult struct {
+	Description string // a human-readable description of the condition
+	Matched     bool   // true if the condition evaluated to true
+}
+
+// Explain evaluates all elementary conditions over given attributes.
+//
+// Unlike Eval, it doesn't stop on the first failing condition. The condition
+// as a whole matches if all elementary conditions match.
+func (c *Condition) Explain(ctx context.Context, attrs realms.Attrs) []ElementaryResult {
+	out := make([]ElementaryResult, len(c.conds))
+	for i, cond := range c.conds {
+		out[i] = ElementaryResult{
+			Description: c.descs[i],
+			Matched:     cond(ctx, attrs),
+		}
+	}
+	return out
+}
+
+// Describe returns a human-readable description of the condition.
+func (c *Condition) Describe() string {
+	return strings.Join(c.descs, " AND ")
+}
+
 // Index can be used to sort conditions based on the order of their creation.
 //
 // Indexes are comparable only if conditions came from the same Builder.
@@ -64,6 +94,7 @@ var alwaysFalse elementary = func(context.Context, realms.Attrs) bool {
 type Builder struct {
 	vocab    []*protocol.Condition // available "vocabulary" of elementary conditions
 	elems    []elementary          // lazily constructed elementary conditions
+	descs    []string              // descriptions of constructed conditions
 	cache    map[string]*Condition // cached composite (ANDed) conditions
 	interned map[string]string     // for interning strings to save RAM
 }
@@ -77,6 +108,7 @@ func NewBuilder(vocab []*protocol.Condition) *Builder {
 	return &Builder{
 		vocab:    vocab,
 		elems:    make([]elementary, len(vocab)),
+		descs:    make([]string, len(vocab)),
 		cache:    make(map[string]*Condition),
 		interned: make(map[string]string),
 	}
@@ -120,13 +152,15 @@ func (b *Builder) Condition(indexes []uint32) (*Condition, error) {
 // A factory of Condition that builds it out of elementary conditions.
 func (b *Builder) build(indexes []uint32) (*Condition, error) {
 	elems := make([]elementary, len(indexes))
+	descs := make([]string, len(indexes))
 	for i, elemIdx := range indexes {
 		var err error
 		if elems[i], err = b.elementary(elemIdx); err != nil {
 			return nil, err
 		}
+		descs[i] = b.descs[elemIdx]
 	}
-	return &Condition{conds: elems}, nil
+	return &Condition{conds: elems, descs: descs}, nil
 }
 
 // A caching factory of elementary conditions based on their index in `vocab`.
@@ -141,14 +175,18 @@ func (b *Builder) elementary(index uint32) (elementary, error) {
 		return elem, nil
 	}
 
+	var desc string
 	switch typ := b.vocab[idx].Op.(type) {
 	case *protocol.Condition_Restrict:
 		elem = b.attributeRestriction(typ.Restrict)
+		desc = describeAttributeRestriction(typ.Restrict)
 	default:
 		elem = b.unrecognized(b.vocab[idx])
+		desc = "unrecognized condition (always false)"
 	}
 
 	b.elems[idx] = elem
+	b.descs[idx] = desc
 	return elem, nil
 }
 
@@ -189,6 +227,12 @@ func (b *Builder) attributeRestriction(cond *protocol.Condition_AttributeRestric
 	}
 }
 
+func describeAttributeRestriction(cond *protocol.Condition_AttributeRestriction) string {
+	vals := append([]string(nil), cond.Values...)
+	sort.Strings(vals)
+	return fmt.Sprintf("attribute %q is one of %q", cond.Attribute, vals)
+}
+
 func (b *Builder) unrecognized(cond *protocol.Condition) elementary {
 	return func(ctx context.Context, attrs realms.Attrs) bool {
 		logging.Warningf(ctx, "Unrecognized or empty condition in a conditional binding, evaluating it to false: %q", cond)
@@ -70,6 +70,28 @@ func TestConds(t *testing.T) {
 		So(cond.Eval(ctx, realms.Attrs{"b": "val1"}), ShouldBeFalse)
 	})
 
+	Convey("Explain", t, func() {
+		builder := NewBuilder([]*protocol.Condition{
+			restrict("a", []string{"val2", "val1"}),
+			restrict("b", []string{"val"}),
+			{},
+		})
+
+		cond, err := builder.Condition([]uint32{0, 1, 2})
+		So(err, ShouldBeNil)
+
+		So(cond.Explain(ctx, realms.Attrs{"a": "val1"}), ShouldResemble, []ElementaryResult{
+			{Description: `attribute "a" is one of ["val1" "val2"]`, Matched: true},
+			{Description: `attribute "b" is one of ["val"]`, Matched: false},
+			{Description: "unrecognized condition (always false)", Matched: false},
+		})
+
+		So(cond.Describe(), ShouldEqual,
+			`attribute "a" is one of ["val1" "val2"] AND `+
+				`attribute "b" is one of ["val"] AND `+
+				`unrecognized condition (always false)`)
+	})
+
 	Convey("Unrecognized elementary condition", t, func() {
 		builder := NewBuilder([]*protocol.Condition{
 			{},
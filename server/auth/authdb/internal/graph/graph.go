@@ -246,6 +246,61 @@ func (g *Graph) Ancestors(n NodeIndex) NodeSet {
 	return node.ancestors
 }
 
+// MembershipPath returns a chain of group names that explains why the identity
+// belongs to the group.
+//
+// The chain starts with the given group, each next group is nested in the
+// previous one, and the last group includes the identity directly (as a member
+// or through a glob). This is the shortest such chain. Returns nil if the
+// identity is not a member of the group.
+//
+// Scans members of groups on every call, so it is slow. It is intended for
+// debugging, QueryableGraph should be used to check memberships.
+func (g *Graph) MembershipPath(ident identity.Identity, group NodeIndex) []string {
+	listsDirectly := func(node *Node) bool {
+		for _, member := range node.Members {
+			if identity.Identity(member) == ident {
+				return true
+			}
+		}
+		for _, glob := range node.Globs {
+			if identity.Glob(glob).Match(ident) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Walk down the nesting graph breadth-first until reaching a group that
+	// lists the identity directly.
+	parent := map[NodeIndex]NodeIndex{group: group}
+	queue := []NodeIndex{group}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if listsDirectly(&g.Nodes[cur]) {
+			var path []string
+			for idx := cur; ; idx = parent[idx] {
+				path = append(path, g.Nodes[idx].Name)
+				if idx == group {
+					break
+				}
+			}
+			for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+				path[l], path[r] = path[r], path[l]
+			}
+			return path
+		}
+		for _, idx := range g.Nodes[cur].Nested {
+			if _, seen := parent[idx]; !seen {
+				parent[idx] = cur
+				queue = append(queue, idx)
+			}
+		}
+	}
+	return nil
+}
+
 // ToQueryable converts the graph to a form optimized for IsMember queries.
 func (g *Graph) ToQueryable() (*QueryableGraph, error) {
 	globs, err := g.buildGlobsMap()
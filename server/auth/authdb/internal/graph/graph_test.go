@@ -133,6 +133,76 @@ func TestGraph(t *testing.T) {
 		g := mkGraph(map[string][]string{"1": {"missing"}})
 		So(descendants(g, "1"), ShouldResemble, []string{"1"})
 	})
+
+	Convey("MembershipPath", t, func() {
+		g, err := Build([]*protocol.AuthGroup{
+			{
+				Name:    "root",
+				Members: []string{"user:1@example.com"},
+				Nested:  []string{"child1", "child2"},
+			},
+			{
+				Name:   "child1",
+				Nested: []string{"child2", "child3"},
+			},
+			{
+				Name:    "child2",
+				Members: []string{"user:2@example.com"},
+			},
+			{
+				Name:  "child3",
+				Globs: []string{"user:*glob@example.com"},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		root := g.NodesByName["root"]
+		child1 := g.NodesByName["child1"]
+
+		So(g.MembershipPath("user:1@example.com", root), ShouldResemble, []string{"root"})
+		So(g.MembershipPath("user:1@example.com", child1), ShouldBeNil)
+		So(g.MembershipPath("user:2@example.com", root), ShouldResemble, []string{"root", "child2"})
+		So(g.MembershipPath("user:2@example.com", child1), ShouldResemble, []string{"child1", "child2"})
+		So(g.MembershipPath("user:glob@example.com", root), ShouldResemble, []string{"root", "child1", "child3"})
+		So(g.MembershipPath("user:unknown@example.com", root), ShouldBeNil)
+	})
+
+	Convey("MembershipPath with direct and nested membership", t, func() {
+		g, err := Build([]*protocol.AuthGroup{
+			{
+				Name:    "root",
+				Members: []string{"user:1@example.com"},
+				Nested:  []string{"child"},
+			},
+			{
+				Name:    "child",
+				Members: []string{"user:1@example.com"},
+				Globs:   []string{"user:*glob@example.com"},
+				Nested:  []string{"grandchild"},
+			},
+			{
+				Name:    "grandchild",
+				Members: []string{"user:1@example.com", "user:glob@example.com"},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		root := g.NodesByName["root"]
+		child := g.NodesByName["child"]
+
+		So(g.MembershipPath("user:1@example.com", root), ShouldResemble, []string{"root"})
+		So(g.MembershipPath("user:1@example.com", child), ShouldResemble, []string{"child"})
+		So(g.MembershipPath("user:glob@example.com", root), ShouldResemble, []string{"root", "child"})
+	})
+
+	Convey("MembershipPath with cycles", t, func() {
+		g, err := Build([]*protocol.AuthGroup{
+			{Name: "1", Nested: []string{"2"}},
+			{Name: "2", Nested: []string{"1"}},
+		})
+		So(err, ShouldBeNil)
+		So(g.MembershipPath("user:1@example.com", g.NodesByName["1"]), ShouldBeNil)
+	})
 }
 
 func TestNodeSet(t *testing.T) {
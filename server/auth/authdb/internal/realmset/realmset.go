@@ -122,6 +122,12 @@ func (r *Realms) PermissionIndex(perm realms.Permission) (idx PermissionIndex, o
 	return
 }
 
+// PermissionIndexByName is like PermissionIndex, but accepts a permission name.
+func (r *Realms) PermissionIndexByName(perm string) (idx PermissionIndex, ok bool) {
+	idx, ok = r.perms[perm]
+	return
+}
+
 // HasRealm returns true if the given realm exists in the DB.
 func (r *Realms) HasRealm(realm string) bool {
 	return r.names.Has(realm)
@@ -176,6 +182,18 @@ func (r *Realms) QueryBindings(perm PermissionIndex) (map[string][]RealmBindings
 // Only registered permissions will be queriable. Bindings with all other
 // permissions will be ignored to save RAM.
 func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.Permission]realms.PermissionFlags) (*Realms, error) {
+	byName := make(map[string]realms.PermissionFlags, len(registered))
+	for perm, flags := range registered {
+		byName[perm.Name()] = flags
+	}
+	return BuildByName(r, qg, byName)
+}
+
+// BuildByName is like Build, but accepts permissions as a map from their names.
+//
+// Allows building Realms that can check permissions not registered in the
+// process. This is useful in tools that inspect AuthDB of other services.
+func BuildByName(r *protocol.Realms, qg *graph.QueryableGraph, registered map[string]realms.PermissionFlags) (*Realms, error) {
 	// Do not use realms.Realms we don't understand. Better to go offline
 	// completely than mistakenly allow access to something private by
 	// misinterpreting realm rules (e.g. if a new hypothetical DENY rule is
@@ -204,7 +222,7 @@ func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.P
 	// (they won't be checked anyway).
 	activePerms := make(map[PermissionIndex]struct{}, len(registered))
 	for perm := range registered {
-		if idx, ok := perms[perm.Name()]; ok {
+		if idx, ok := perms[perm]; ok {
 			activePerms[idx] = struct{}{}
 		}
 	}
@@ -330,7 +348,7 @@ func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.P
 	bindingsIdx := make(map[PermissionIndex]map[string][]RealmBindings, len(registered))
 	for perm, flags := range registered {
 		if flags&realms.UsedInQueryRealms != 0 {
-			if permIdx, ok := perms[perm.Name()]; ok {
+			if permIdx, ok := perms[perm]; ok {
 				bindingsIdx[permIdx] = map[string][]RealmBindings{}
 			}
 		}
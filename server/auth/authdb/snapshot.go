@@ -97,6 +97,15 @@ func SnapshotDBFromTextProto(r io.Reader) (*SnapshotDB, error) {
 // If 'validate' is false, skips some expensive validation steps, assuming they
 // were performed before, when AuthDB was initially received.
 func NewSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, validate bool) (*SnapshotDB, error) {
+	return newSnapshotDB(authDB, authServiceURL, rev, validate, nil)
+}
+
+// newSnapshotDB implements NewSnapshotDB.
+//
+// If `perms` is nil, indexes permissions registered in the current process.
+// Otherwise indexes only the given permissions (they don't need to be
+// registered).
+func newSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, validate bool, perms map[string]realms.PermissionFlags) (*SnapshotDB, error) {
 	if validate {
 		if err := validateAuthDB(authDB); err != nil {
 			return nil, err
@@ -110,7 +119,11 @@ func NewSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, va
 
 	var realmSet *realmset.Realms
 	if authDB.Realms != nil {
-		realmSet, err = realmset.Build(authDB.Realms, groups, realms.RegisteredPermissions())
+		if perms == nil {
+			realmSet, err = realmset.Build(authDB.Realms, groups, realms.RegisteredPermissions())
+		} else {
+			realmSet, err = realmset.BuildByName(authDB.Realms, groups, perms)
+		}
 		if err != nil {
 			return nil, errors.Annotate(err, "failed to prepare Realms DB").Err()
 		}
@@ -213,7 +213,7 @@ func TestSnapshotDB(t *testing.T) {
 	})
 
 	Convey("With realms", t, func() {
-		db, err := NewSnapshotDB(&protocol.AuthDB{
+		authDB := &protocol.AuthDB{
 			Groups: []*protocol.AuthGroup{
 				{
 					Name:    "direct",
@@ -280,7 +280,8 @@ func TestSnapshotDB(t *testing.T) {
 					},
 				},
 			},
-		}, "http://auth-service", 1234, false)
+		}
+		db, err := NewSnapshotDB(authDB, "http://auth-service", 1234, false)
 		So(err, ShouldBeNil)
 
 		Convey("HasPermission works", func() {
@@ -346,6 +347,72 @@ func TestSnapshotDB(t *testing.T) {
 			So(ok, ShouldBeFalse)
 		})
 
+		Convey("ExplainPermission works", func() {
+			// A hit through a group.
+			exp, err := ExplainPermission(c, authDB, "user:abc@example.com", perm1.Name(), "proj:some/realm", nil)
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.CheckedRealm, ShouldEqual, "proj:some/realm")
+			So(exp.Notes, ShouldBeEmpty)
+			So(exp.Bindings, ShouldHaveLength, 2)
+			So(exp.Bindings[0], ShouldResemble, BindingExplanation{
+				ConditionsMatched: true,
+				Groups:            []string{"direct"},
+				Identities:        []string{"user:realm@example.com"},
+				MembershipPath:    []string{"direct"},
+				Granted:           true,
+			})
+			So(exp.Bindings[1].Granted, ShouldBeFalse)
+
+			// A failed condition.
+			exp, err = ExplainPermission(c, authDB, "user:cond@example.com", perm1.Name(), "proj:some/realm", realms.Attrs{"a": "???"})
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings[1], ShouldResemble, BindingExplanation{
+				Conditions: []ConditionExplanation{
+					{Description: `attribute "a" is one of ["ok_1" "ok_2"]`, Matched: false},
+				},
+				ConditionsMatched: false,
+				Groups:            []string{},
+				Identities:        []string{"user:cond@example.com"},
+				IdentityListed:    true,
+				Granted:           false,
+			})
+
+			// Fallback to the root.
+			exp, err = ExplainPermission(c, authDB, "user:root@example.com", perm1.Name(), "proj:unknown", nil)
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.CheckedRealm, ShouldEqual, "proj:@root")
+			So(exp.Notes, ShouldResemble, []string{
+				`Realm "proj:unknown" doesn't exist: falling back to the root realm "proj:@root"`,
+			})
+
+			// Unknown root realm.
+			exp, err = ExplainPermission(c, authDB, "user:realm@example.com", perm1.Name(), "unknown:@root", nil)
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.CheckedRealm, ShouldEqual, "")
+
+			// Unknown permission.
+			exp, err = ExplainPermission(c, authDB, "user:realm@example.com", unknownPerm.Name(), "proj:some/realm", nil)
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Notes, ShouldResemble, []string{
+				`Permission "luci.dev.unknown" is not present in the AuthDB: denying`,
+			})
+
+			// Empty realm.
+			exp, err = ExplainPermission(c, authDB, "user:realm@example.com", perm1.Name(), "proj:empty", nil)
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings, ShouldBeEmpty)
+
+			// Invalid realm name.
+			_, err = ExplainPermission(c, authDB, "user:realm@example.com", perm1.Name(), "@root", nil)
+			So(err, ShouldErrLike, "bad global realm name")
+		})
+
 		Convey("QueryRealms works", func() {
 			// A direct hit.
 			r, err := db.QueryRealms(c, "user:realm@example.com", perm1, "", nil)
@@ -410,6 +477,46 @@ func TestSnapshotDB(t *testing.T) {
 		})
 	})
 
+	Convey("Standalone ExplainPermission works", t, func() {
+		authDB := &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				{Name: "outer", Nested: []string{"inner"}},
+				{Name: "inner", Members: []string{"user:abc@example.com"}},
+			},
+			Realms: &protocol.Realms{
+				ApiVersion: realmset.ExpectedAPIVersion,
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.unregistered"},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{
+								Permissions: []uint32{0},
+								Principals:  []string{"group:outer"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		exp, err := ExplainPermission(c, authDB, "user:abc@example.com", "luci.dev.unregistered", "proj:@root", nil)
+		So(err, ShouldBeNil)
+		So(exp.Granted, ShouldBeTrue)
+		So(exp.Bindings, ShouldHaveLength, 1)
+		So(exp.Bindings[0].MembershipPath, ShouldResemble, []string{"outer", "inner"})
+
+		exp, err = ExplainPermission(c, authDB, "user:another@example.com", "luci.dev.unregistered", "proj:@root", nil)
+		So(err, ShouldBeNil)
+		So(exp.Granted, ShouldBeFalse)
+		So(exp.Bindings[0].MembershipPath, ShouldBeNil)
+
+		_, err = ExplainPermission(c, authDB, "user:abc@example.com", "bad", "proj:@root", nil)
+		So(err, ShouldNotBeNil)
+	})
+
 	Convey("GetCertificates works", t, func(c C) {
 		tokenService := signingtest.NewSigner(&signing.ServiceInfo{
 			AppID:              "token-server",
@@ -0,0 +1,113 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.chromium.org/luci/auth/jwt"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+)
+
+// Claims is a verified deserialized set of claims of an ID token issued by
+// an arbitrary OpenID Connect provider.
+//
+// Unlike IDToken, it doesn't assume any Google-specific claims are present.
+// Values are as produced by the JSON decoder, i.e. numbers are float64.
+type Claims map[string]any
+
+// VerifyClaims deserializes and verifies an ID token issued by an arbitrary
+// OpenID Connect provider (e.g. a CI system or a Kubernetes cluster).
+//
+// It checks the signature, the issuer and the expiration time (as well as the
+// "not before" time if present). It checks `aud` and `sub` are present, but
+// does NOT verify them any further. It is the caller's responsibility to do so.
+//
+// This is a fast local operation.
+func VerifyClaims(ctx context.Context, token string, keys jwt.SignatureVerifier, issuer string) (Claims, error) {
+	claims := Claims{}
+	if err := jwt.VerifyAndDecode(token, &claims, keys); err != nil {
+		return nil, errors.Annotate(err, "bad ID token").Err()
+	}
+
+	now := clock.Now(ctx)
+	iss, _ := claims.Get("iss")
+	exp, hasExp := claims["exp"].(float64)
+	nbf, hasNbf := claims["nbf"].(float64)
+	sub, _ := claims.Get("sub")
+
+	switch {
+	case iss != issuer && "https://"+iss != issuer:
+		return nil, errors.Reason("bad ID token: expecting issuer %q, got %q", issuer, iss).Err()
+	case !hasExp:
+		return nil, errors.Reason("bad ID token: the expiration time is missing").Err()
+	case unixTime(exp).Add(allowedClockSkew).Before(now):
+		return nil, errors.Reason("bad ID token: expired %s ago", now.Sub(unixTime(exp))).Err()
+	case hasNbf && unixTime(nbf).Add(-allowedClockSkew).After(now):
+		return nil, errors.Reason("bad ID token: not valid for another %s", unixTime(nbf).Sub(now)).Err()
+	case len(claims.Audiences()) == 0:
+		return nil, errors.Reason("bad ID token: the audience is missing").Err()
+	case sub == "":
+		return nil, errors.Reason("bad ID token: the subject is missing").Err()
+	}
+
+	return claims, nil
+}
+
+// Get returns a value of a scalar claim as a string.
+//
+// Numbers and booleans are converted to strings. Returns false if the claim is
+// missing or it is not a scalar.
+func (c Claims) Get(name string) (string, bool) {
+	switch val := c[name].(type) {
+	case string:
+		return val, true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(val), true
+	default:
+		return "", false
+	}
+}
+
+// Audiences returns values of the `aud` claim.
+//
+// Per the OpenID Connect spec, it is either a single string or a list of
+// strings.
+func (c Claims) Audiences() []string {
+	switch aud := c["aud"].(type) {
+	case string:
+		if aud != "" {
+			return []string{aud}
+		}
+	case []any:
+		out := make([]string, 0, len(aud))
+		for _, item := range aud {
+			if str, ok := item.(string); ok && str != "" {
+				out = append(out, str)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func unixTime(sec float64) time.Time {
+	return time.Unix(int64(sec), 0)
+}
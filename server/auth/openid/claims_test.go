@@ -0,0 +1,140 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openid
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/auth/signing/signingtest"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestVerifyClaims(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, tc := testclock.UseTime(ctx, time.Unix(1442540000, 0))
+
+	const issuer = "https://issuer.example.com"
+	const signingKeyID = "signing-key"
+
+	signer := signingtest.NewSigner(nil)
+	jwks, _ := NewJSONWebKeySet(jwksForTest(signingKeyID, &signer.KeyForTest().PublicKey))
+
+	newClaims := func() map[string]any {
+		return map[string]any{
+			"iss":        issuer,
+			"sub":        "repo:owner/repo:ref:refs/heads/main",
+			"aud":        []string{"aud1", "aud2"},
+			"exp":        clock.Now(ctx).Add(time.Hour).Unix(),
+			"repository": "owner/repo",
+			"run_id":     12345,
+			"ephemeral":  true,
+		}
+	}
+	verify := func(claims map[string]any) (Claims, error) {
+		body, err := json.Marshal(claims)
+		if err != nil {
+			panic(err)
+		}
+		return VerifyClaims(ctx, jwtForTest(ctx, body, signingKeyID, signer), jwks, issuer)
+	}
+
+	Convey("Happy path", t, func() {
+		claims, err := verify(newClaims())
+		So(err, ShouldBeNil)
+		So(claims.Audiences(), ShouldResemble, []string{"aud1", "aud2"})
+
+		val, ok := claims.Get("repository")
+		So(ok, ShouldBeTrue)
+		So(val, ShouldEqual, "owner/repo")
+
+		val, ok = claims.Get("run_id")
+		So(ok, ShouldBeTrue)
+		So(val, ShouldEqual, "12345")
+
+		val, ok = claims.Get("ephemeral")
+		So(ok, ShouldBeTrue)
+		So(val, ShouldEqual, "true")
+
+		_, ok = claims.Get("aud")
+		So(ok, ShouldBeFalse)
+		_, ok = claims.Get("missing")
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Single audience", t, func() {
+		c := newClaims()
+		c["aud"] = "aud"
+		claims, err := verify(c)
+		So(err, ShouldBeNil)
+		So(claims.Audiences(), ShouldResemble, []string{"aud"})
+	})
+
+	Convey("Bad JWT", t, func() {
+		_, err := VerifyClaims(ctx, "IMANOTAJWT", jwks, issuer)
+		So(err, ShouldErrLike, "bad JWT")
+	})
+
+	Convey("Bad issuer", t, func() {
+		c := newClaims()
+		c["iss"] = "something else"
+		_, err := verify(c)
+		So(err, ShouldErrLike, "expecting issuer")
+	})
+
+	Convey("No audience", t, func() {
+		c := newClaims()
+		delete(c, "aud")
+		_, err := verify(c)
+		So(err, ShouldErrLike, "the audience is missing")
+	})
+
+	Convey("No subject", t, func() {
+		c := newClaims()
+		delete(c, "sub")
+		_, err := verify(c)
+		So(err, ShouldErrLike, "the subject is missing")
+	})
+
+	Convey("No expiration", t, func() {
+		c := newClaims()
+		delete(c, "exp")
+		_, err := verify(c)
+		So(err, ShouldErrLike, "the expiration time is missing")
+	})
+
+	Convey("Not yet valid", t, func() {
+		c := newClaims()
+		c["nbf"] = clock.Now(ctx).Add(time.Hour).Unix()
+		_, err := verify(c)
+		So(err, ShouldErrLike, "not valid for another")
+	})
+
+	Convey("Expired", t, func() {
+		c := newClaims()
+		tc.Add(2 * time.Hour)
+		_, err := verify(c)
+		So(err, ShouldErrLike, "expired")
+	})
+}
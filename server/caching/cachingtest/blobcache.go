@@ -26,7 +26,7 @@ import (
 	"go.chromium.org/luci/server/caching"
 )
 
-// BlobCache implements caching.BlobCache on top of lru.Cache for testing.
+// BlobCache implements caching.MutableBlobCache on top of lru.Cache for testing.
 //
 // Useful for mocking caching.GlobalCache in tests. See also WithGlobalCache
 // below.
@@ -61,6 +61,31 @@ func (b *BlobCache) Set(c context.Context, key string, value []byte, exp time.Du
 	return nil
 }
 
+// Add stores an item only if there's no such key in the cache yet.
+func (b *BlobCache) Add(c context.Context, key string, value []byte, exp time.Duration) (bool, error) {
+	if b.Err != nil {
+		return false, b.Err
+	}
+	added := false
+	b.LRU.Mutate(c, key, func(it *lru.Item[[]byte]) *lru.Item[[]byte] {
+		if it != nil {
+			return it
+		}
+		added = true
+		return &lru.Item[[]byte]{Value: value, Exp: exp}
+	})
+	return added, nil
+}
+
+// Delete removes an item from the cache.
+func (b *BlobCache) Delete(c context.Context, key string) error {
+	if b.Err != nil {
+		return b.Err
+	}
+	b.LRU.Remove(key)
+	return nil
+}
+
 // WithGlobalCache installs given BlobCaches as "global" in the context.
 //
 // 'caches' is a map from a namespace to BlobCache instance. If some other
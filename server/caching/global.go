@@ -51,6 +51,27 @@ type BlobCache interface {
 	Set(c context.Context, key string, value []byte, exp time.Duration) error
 }
 
+// MutableBlobCache is a BlobCache that also supports conditional writes and
+// deletions.
+//
+// It is optionally implemented by BlobCache implementations. Libraries should
+// check for it via a type assertion and gracefully degrade if it is not
+// available.
+type MutableBlobCache interface {
+	BlobCache
+
+	// Add stores an item only if there's no such key in the cache yet.
+	//
+	// Returns true if the item was stored. If 'exp' is zero, the item will have
+	// no expiration time.
+	Add(c context.Context, key string, value []byte, exp time.Duration) (bool, error)
+
+	// Delete removes an item from the cache.
+	//
+	// Deleting a missing item is not an error.
+	Delete(c context.Context, key string) error
+}
+
 // BlobCacheProvider returns a BlobCache instance targeting a namespace.
 type BlobCacheProvider func(namespace string) BlobCache
 
@@ -0,0 +1,83 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package caching
+
+import (
+	"context"
+	"sync"
+)
+
+// InvalidationBus broadcasts cache invalidation notifications to all processes
+// of the service.
+//
+// It is used to quickly propagate changes of items cached in process caches
+// across replicas, instead of waiting for them to expire. Implementations
+// deliver received notifications via DeliverInvalidation.
+type InvalidationBus interface {
+	// Publish notifies all processes (including the current one) that the item
+	// with the given key in the given global cache namespace has changed.
+	Publish(c context.Context, namespace, key string) error
+}
+
+// InvalidationHandler is called when an item in some global cache namespace
+// is invalidated.
+//
+// The context is the context passed to DeliverInvalidation, usually derived
+// from the root server context. In particular it has ProcessCacheData.
+type InvalidationHandler func(c context.Context, key string)
+
+var (
+	invalidationBusKey = "server.caching Invalidation Bus"
+
+	invalidationHandlersM sync.RWMutex
+	invalidationHandlers  = map[string][]InvalidationHandler{}
+)
+
+// WithInvalidationBus installs an invalidation bus implementation into the
+// supplied context.
+func WithInvalidationBus(c context.Context, bus InvalidationBus) context.Context {
+	return context.WithValue(c, &invalidationBusKey, bus)
+}
+
+// Invalidations returns the invalidation bus or nil if it is not available in
+// the current environment.
+func Invalidations(c context.Context) InvalidationBus {
+	bus, _ := c.Value(&invalidationBusKey).(InvalidationBus)
+	return bus
+}
+
+// RegisterInvalidationHandler registers a callback called when an item in the
+// given global cache namespace is invalidated by any process.
+//
+// Must be called during init time.
+func RegisterInvalidationHandler(namespace string, h InvalidationHandler) {
+	checkStillInitTime()
+	invalidationHandlersM.Lock()
+	defer invalidationHandlersM.Unlock()
+	invalidationHandlers[namespace] = append(invalidationHandlers[namespace], h)
+}
+
+// DeliverInvalidation calls all invalidation handlers registered for the given
+// namespace.
+//
+// Called by InvalidationBus implementations when they receive a notification.
+func DeliverInvalidation(c context.Context, namespace, key string) {
+	invalidationHandlersM.RLock()
+	handlers := invalidationHandlers[namespace]
+	invalidationHandlersM.RUnlock()
+	for _, h := range handlers {
+		h(c, key)
+	}
+}
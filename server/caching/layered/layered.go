@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.chromium.org/luci/common/clock"
@@ -34,6 +35,13 @@ import (
 // produces an item that expires sooner than the requested MinTTL.
 var ErrCantSatisfyMinTTL = errors.New("new item produced by the factory has insufficient TTL")
 
+// refreshLeaseTTL is how long a process can hold the lease on refreshing a
+// stale item before other processes are allowed to refresh it.
+const refreshLeaseTTL = time.Minute
+
+// refreshLeasePrefix is a prefix of global cache keys with refresh leases.
+const refreshLeasePrefix = "layered.refresh-lease:"
+
 // RegisterCache registers a layered cache used by a process.
 //
 // It must be called during init time to declare an intent that a package
@@ -49,9 +57,22 @@ func RegisterCache[T any](p Parameters[T]) Cache[T] {
 	if p.Unmarshal == nil {
 		panic("Unmarshal is required")
 	}
+	if p.StaleTTL < 0 {
+		panic("StaleTTL must be non-negative")
+	}
+	if p.ErrorTTL < 0 {
+		panic("ErrorTTL must be non-negative")
+	}
+	procCache := caching.RegisterLRUCache[string, *itemWithExp[T]](p.ProcessCacheCapacity)
+	caching.RegisterInvalidationHandler(p.GlobalNamespace, func(ctx context.Context, key string) {
+		if lru := procCache.LRU(ctx); lru != nil {
+			lru.Remove(key)
+		}
+	})
 	return Cache[T]{
-		procCache: caching.RegisterLRUCache[string, *itemWithExp[T]](p.ProcessCacheCapacity),
+		procCache: procCache,
 		params:    p,
+		refresh:   &refreshState{running: map[string]struct{}{}},
 	}
 }
 
@@ -86,6 +107,30 @@ type Parameters[T any] struct {
 	// the item. If AllowNoProcessCacheFallback is false, it would instead
 	// return caching.ErrNoProcessCache.
 	AllowNoProcessCacheFallback bool
+
+	// StaleTTL is how long an item can be served after its expiration while it
+	// is being refreshed in the background.
+	//
+	// If positive, GetOrCreate returns an expired item (as long as it expired
+	// less than StaleTTL ago) right away and refreshes it in a background
+	// goroutine. Only one process refreshes an item at a time if the global
+	// cache implements caching.MutableBlobCache. Items are kept in the caches
+	// for StaleTTL longer than their expiration time.
+	//
+	// Since the factory function may be called after GetOrCreate returns, it
+	// must not depend on the request being alive, in particular on the request
+	// context not being canceled.
+	//
+	// Stale items are never returned if WithMinTTL option is used.
+	StaleTTL time.Duration
+
+	// ErrorTTL is how long to cache errors returned by the factory function.
+	//
+	// If positive, an error returned by the factory function is cached in the
+	// process cache and GetOrCreate returns it without calling the factory again
+	// until ErrorTTL passes. Errors are not stored in the global cache. Context
+	// errors (context.Canceled and context.DeadlineExceeded) are never cached.
+	ErrorTTL time.Duration
 }
 
 // Cache implements a cache of serializable objects on top of process and
@@ -102,6 +147,8 @@ type Cache[T any] struct {
 	procCache caching.LRUHandle[string, *itemWithExp[T]]
 	// params are Parameters passed to Register(...) when creating the cache.
 	params Parameters[T]
+	// refresh tracks background refreshes of stale items done by this process.
+	refresh *refreshState
 }
 
 // Option is a base interface of options for GetOrCreate call.
@@ -150,6 +197,8 @@ func WithRandomizedExpiration(threshold time.Duration) Option {
 //
 // Expiration time is used with seconds precision. Zero expiration time means
 // the item doesn't expire on its own.
+//
+// See also StaleTTL and ErrorTTL parameters.
 func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T], opts ...Option) (T, error) {
 	o := options{}
 	for _, opt := range opts {
@@ -170,9 +219,18 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 
 	// Check that the item is in the local cache, its TTL is acceptable and we
 	// don't want to randomly prematurely expire it, see WithRandomizedExpiration.
+	//
+	// If the item needs a refresh, but it is allowed to use stale items, return
+	// the item right away and refresh it in the background.
 	var ignored *itemWithExp[T]
 	if item, ok := lru.Get(ctx, key); ok {
-		if item.isAcceptableTTL(now, o.minTTL) && !item.randomlyExpired(ctx, now, o.expRandThreshold) {
+		switch {
+		case item.err != nil:
+			return item.val, item.err // a cached error, see ErrorTTL
+		case item.isAcceptableTTL(now, o.minTTL) && !item.randomlyExpired(ctx, now, o.expRandThreshold):
+			return item.val, nil
+		case o.minTTL == 0 && item.isServable(now, c.params.StaleTTL):
+			c.refreshInBackground(ctx, key, fn)
 			return item.val, nil
 		}
 		ignored = item
@@ -183,56 +241,84 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 	// to fetch from the global cache or create a new one. Disable expiration
 	// randomization at this point, it has served its purpose already, since only
 	// unlucky callers will reach this code path.
+	stale := false
 	v, err := lru.Create(ctx, key, func() (*itemWithExp[T], time.Duration, error) {
 		// Now that we have the lock, recheck that the item still needs a refresh.
 		// Purposely ignore an item we decided we want to prematurely expire.
 		if item, ok := lru.Get(ctx, key); ok {
-			if item != ignored && item.isAcceptableTTL(now, o.minTTL) {
-				return item, item.expiration(now), nil
+			if item != ignored && (item.err != nil || item.isAcceptableTTL(now, o.minTTL)) {
+				return item, c.lruExpiration(item, now), nil
 			}
 		}
 
 		// Attempt to grab it from the global cache, verifying TTL is acceptable.
-		if item := c.maybeFetchItem(ctx, key); item != nil && item.isAcceptableTTL(now, o.minTTL) {
-			return item, item.expiration(now), nil
+		// Use a stale item if allowed, refreshing it in the background.
+		if item := c.maybeFetchItem(ctx, key); item != nil {
+			if item.isAcceptableTTL(now, o.minTTL) {
+				return item, c.lruExpiration(item, now), nil
+			}
+			if o.minTTL == 0 && item.isServable(now, c.params.StaleTTL) {
+				stale = true
+				return item, c.lruExpiration(item, now), nil
+			}
 		}
 
 		// Either a cache miss, problems with the cached item or its TTL is not
 		// acceptable. Need a to make a new item.
-		var item itemWithExp[T]
-		val, exp, err := fn()
-		item.val = val
-		switch {
-		case err != nil:
-			return nil, 0, err
-		case exp < 0:
-			panic("the expiration time must be non-negative")
-		case exp > 0: // note: if exp == 0 we want item.exp to be zero
-			item.exp = now.Add(exp)
-			if !item.isAcceptableTTL(now, o.minTTL) {
-				// If 'fn' is incapable of generating an item with sufficient TTL there's
-				// nothing else we can do.
-				return nil, 0, ErrCantSatisfyMinTTL
+		item, err := c.makeItem(ctx, key, fn, now, o.minTTL)
+		if err != nil {
+			if c.params.ErrorTTL > 0 && err != ErrCantSatisfyMinTTL && !isContextErr(err) {
+				item = &itemWithExp[T]{err: err, exp: now.Add(c.params.ErrorTTL)}
+				return item, c.params.ErrorTTL, nil
 			}
-		}
-
-		// Store the new item in the global cache. We may accidentally override
-		// an item here if someone else refreshed it already. But this is
-		// unavoidable given GlobalCache semantics and generally rare and harmless
-		// (given Cache guarantees or rather lack of there of).
-		if err := c.maybeStoreItem(ctx, key, &item, now); err != nil {
 			return nil, 0, err
 		}
-		return &item, item.expiration(now), nil
+		return item, c.lruExpiration(item, now), nil
 	})
 
-	if err != nil {
+	switch {
+	case err != nil:
 		var zero T
 		return zero, err
+	case v.err != nil:
+		return v.val, v.err
+	case stale:
+		c.refreshInBackground(ctx, key, fn)
 	}
 	return v.val, nil
 }
 
+// Invalidate removes the item from the process and global caches and notifies
+// other processes to remove it from their process caches.
+//
+// Other processes are notified through caching.InvalidationBus, if it is
+// available. The item is removed from the global cache only if it implements
+// caching.MutableBlobCache. Returns an error if the global cache or the
+// invalidation bus fail. The process cache is updated regardless.
+func (c *Cache[T]) Invalidate(ctx context.Context, key string) error {
+	if lru := c.procCache.LRU(ctx); lru != nil {
+		lru.Remove(key)
+	}
+
+	switch g := caching.GlobalCache(ctx, c.params.GlobalNamespace).(type) {
+	case nil:
+		// Nothing to invalidate.
+	case caching.MutableBlobCache:
+		if err := g.Delete(ctx, key); err != nil {
+			return errors.Annotate(err, "failed to remove item %q from the global cache", key).Err()
+		}
+	default:
+		logging.Warningf(ctx, "The global cache doesn't support deletions, item %q will stay there until it expires", key)
+	}
+
+	if bus := caching.Invalidations(ctx); bus != nil {
+		if err := bus.Publish(ctx, c.params.GlobalNamespace, key); err != nil {
+			return errors.Annotate(err, "failed to publish invalidation of item %q", key).Err()
+		}
+	}
+	return nil
+}
+
 // CachedLocally returns the number of items stored in the local process memory.
 func (c *Cache[T]) CachedLocally(ctx context.Context) int {
 	return c.procCache.LRU(ctx).Len()
@@ -266,10 +352,12 @@ func (o expRandThresholdOpt) apply(opts *options) { opts.expRandThreshold = time
 // itemWithExp is what is actually stored (pointer to it) in the process cache.
 //
 // It is a user-generated value plus its expiration time (or zero time if it
-// doesn't expire).
+// doesn't expire). If err is set, this is a cached error (see ErrorTTL) and
+// val is not used.
 type itemWithExp[T any] struct {
 	val T
 	exp time.Time
+	err error
 }
 
 // isAcceptableTTL returns true if item's TTL is large enough.
@@ -282,6 +370,18 @@ func (i *itemWithExp[T]) isAcceptableTTL(now time.Time, minTTL time.Duration) bo
 	return i.exp.Sub(now) > minTTL
 }
 
+// isServable returns true if serving stale items is enabled and the item either
+// hasn't expired yet or expired less than staleTTL ago.
+func (i *itemWithExp[T]) isServable(now time.Time, staleTTL time.Duration) bool {
+	if staleTTL <= 0 {
+		return false // serving stale items is disabled
+	}
+	if i.exp.IsZero() {
+		return true // never expires
+	}
+	return now.Before(i.exp.Add(staleTTL))
+}
+
 // randomlyExpired returns true if the item must be considered already expired.
 //
 // See WithRandomizedExpiration for the rationale. The context is used only to
@@ -322,6 +422,158 @@ func (i *itemWithExp[T]) expiration(now time.Time) time.Duration {
 	return d
 }
 
+// lruExpiration returns expiration time to use when storing the item in the
+// process cache.
+//
+// Value items are kept there for StaleTTL longer than their expiration time.
+func (c *Cache[T]) lruExpiration(item *itemWithExp[T], now time.Time) time.Duration {
+	switch {
+	case item.exp.IsZero():
+		return 0 // never expires
+	case item.err != nil:
+		return item.exp.Sub(now)
+	default:
+		return item.exp.Add(c.params.StaleTTL).Sub(now)
+	}
+}
+
+// makeItem calls the factory function and stores the new item in the global
+// cache.
+func (c *Cache[T]) makeItem(ctx context.Context, key string, fn lru.Maker[T], now time.Time, minTTL time.Duration) (*itemWithExp[T], error) {
+	var item itemWithExp[T]
+	val, exp, err := fn()
+	item.val = val
+	switch {
+	case err != nil:
+		return nil, err
+	case exp < 0:
+		panic("the expiration time must be non-negative")
+	case exp > 0: // note: if exp == 0 we want item.exp to be zero
+		item.exp = now.Add(exp)
+		if !item.isAcceptableTTL(now, minTTL) {
+			// If 'fn' is incapable of generating an item with sufficient TTL there's
+			// nothing else we can do.
+			return nil, ErrCantSatisfyMinTTL
+		}
+	}
+
+	// Store the new item in the global cache. We may accidentally override
+	// an item here if someone else refreshed it already. But this is
+	// unavoidable given GlobalCache semantics and generally rare and harmless
+	// (given Cache guarantees or rather lack of there of).
+	if err := c.maybeStoreItem(ctx, key, &item, now); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// refreshState tracks background refreshes done by this process.
+type refreshState struct {
+	m       sync.Mutex
+	running map[string]struct{}
+	wg      sync.WaitGroup // used in tests to wait for refreshes
+}
+
+// start returns true if there's no refresh of this key running already.
+func (r *refreshState) start(key string) bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if _, ok := r.running[key]; ok {
+		return false
+	}
+	r.running[key] = struct{}{}
+	r.wg.Add(1)
+	return true
+}
+
+// finish is called when the refresh started by `start` is done.
+func (r *refreshState) finish(key string) {
+	r.m.Lock()
+	delete(r.running, key)
+	r.m.Unlock()
+	r.wg.Done()
+}
+
+// refreshInBackground launches a goroutine that refreshes the item, unless
+// it is already being refreshed.
+func (c *Cache[T]) refreshInBackground(ctx context.Context, key string, fn lru.Maker[T]) {
+	if !c.refresh.start(key) {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer c.refresh.finish(key)
+		if err := c.refreshItem(ctx, key, fn); err != nil {
+			logging.WithError(err).Warningf(ctx, "Failed to refresh item %q in the background", key)
+		}
+	}()
+}
+
+// refreshItem replaces the item in the process cache with a fresh one.
+//
+// Takes it from the global cache if some other process has refreshed it
+// already. Otherwise calls the factory function, unless some other process is
+// doing it right now. Keeps the stale item on errors.
+func (c *Cache[T]) refreshItem(ctx context.Context, key string, fn lru.Maker[T]) error {
+	lru := c.procCache.LRU(ctx)
+	_, err := lru.Create(ctx, key, func() (*itemWithExp[T], time.Duration, error) {
+		now := clock.Now(ctx)
+
+		// The item may have been refreshed by some other goroutine already.
+		if item, ok := lru.Get(ctx, key); ok && item.err == nil && item.isAcceptableTTL(now, 0) {
+			return item, c.lruExpiration(item, now), nil
+		}
+		if item := c.maybeFetchItem(ctx, key); item != nil && item.isAcceptableTTL(now, 0) {
+			return item, c.lruExpiration(item, now), nil
+		}
+
+		if !c.acquireRefreshLease(ctx, key) {
+			return nil, 0, errors.Reason("item %q is being refreshed by another process", key).Err()
+		}
+		defer c.releaseRefreshLease(ctx, key)
+
+		item, err := c.makeItem(ctx, key, fn, now, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+		return item, c.lruExpiration(item, now), nil
+	})
+	return err
+}
+
+// acquireRefreshLease returns true if this process should refresh the item.
+//
+// Returns false if some other process is refreshing it. Returns true if the
+// global cache doesn't support leases or fails.
+func (c *Cache[T]) acquireRefreshLease(ctx context.Context, key string) bool {
+	g, _ := caching.GlobalCache(ctx, c.params.GlobalNamespace).(caching.MutableBlobCache)
+	if g == nil {
+		return true
+	}
+	added, err := g.Add(ctx, refreshLeasePrefix+key, []byte{1}, refreshLeaseTTL)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to take the refresh lease of item %q", key)
+		return true
+	}
+	return added
+}
+
+// releaseRefreshLease releases a lease taken by acquireRefreshLease.
+func (c *Cache[T]) releaseRefreshLease(ctx context.Context, key string) {
+	g, _ := caching.GlobalCache(ctx, c.params.GlobalNamespace).(caching.MutableBlobCache)
+	if g == nil {
+		return
+	}
+	if err := g.Delete(ctx, refreshLeasePrefix+key); err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to release the refresh lease of item %q", key)
+	}
+}
+
+// isContextErr returns true if the error is caused by a context expiration.
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
 // maybeFetchItem attempts to fetch the item from the global cache.
 //
 // If the global cache is not available or the cached item there is broken
@@ -365,7 +617,11 @@ func (c *Cache[T]) maybeStoreItem(ctx context.Context, key string, item *itemWit
 		return err
 	}
 
-	if err = g.Set(ctx, key, blob, item.expiration(now)); err != nil {
+	exp := item.expiration(now)
+	if exp != 0 {
+		exp += c.params.StaleTTL // keep stale items around, see StaleTTL
+	}
+	if err = g.Set(ctx, key, blob, exp); err != nil {
 		logging.WithError(err).Errorf(ctx, "Failed to store item %q in the global cache", key)
 	}
 	return nil
@@ -54,6 +54,28 @@ var testingCacheWithFallback = RegisterCache(Parameters[[]byte]{
 	AllowNoProcessCacheFallback: true,
 })
 
+var testingStaleCache = RegisterCache(Parameters[[]byte]{
+	GlobalNamespace: "stale-namespace",
+	Marshal: func(item []byte) ([]byte, error) {
+		return item, nil
+	},
+	Unmarshal: func(blob []byte) ([]byte, error) {
+		return blob, nil
+	},
+	StaleTTL: 10 * time.Minute,
+	ErrorTTL: time.Minute,
+})
+
+type testInvalidationBus struct {
+	published []string
+}
+
+func (b *testInvalidationBus) Publish(ctx context.Context, namespace, key string) error {
+	b.published = append(b.published, namespace+":"+key)
+	caching.DeliverInvalidation(ctx, namespace, key)
+	return nil
+}
+
 func TestCache(t *testing.T) {
 	t.Parallel()
 
@@ -257,6 +279,144 @@ func TestCache(t *testing.T) {
 	})
 }
 
+func TestStaleAndErrors(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fake time", t, func() {
+		ctx := context.Background()
+		ctx, tc := testclock.UseTime(ctx, time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC))
+		ctx = caching.WithEmptyProcessCache(ctx)
+
+		global := cachingtest.NewBlobCache()
+		ctx = cachingtest.WithGlobalCache(ctx, map[string]caching.BlobCache{
+			"stale-namespace": global,
+		})
+
+		calls := 0
+		getter := func(val string) func() ([]byte, time.Duration, error) {
+			return func() ([]byte, time.Duration, error) {
+				calls++
+				return []byte(val), time.Hour, nil
+			}
+		}
+		get := func(val string, opts ...Option) string {
+			item, err := testingStaleCache.GetOrCreate(ctx, "item", getter(val), opts...)
+			So(err, ShouldBeNil)
+			return string(item)
+		}
+
+		So(get("v1"), ShouldEqual, "v1")
+		So(calls, ShouldEqual, 1)
+
+		Convey("Serves stale items while refreshing them", func() {
+			tc.Add(65 * time.Minute) // expired, but still within StaleTTL
+
+			So(get("v2"), ShouldEqual, "v1")
+			testingStaleCache.refresh.wg.Wait()
+			So(calls, ShouldEqual, 2)
+			So(get("v3"), ShouldEqual, "v2")
+			So(calls, ShouldEqual, 2)
+
+			// The lease is released.
+			_, err := global.Get(ctx, refreshLeasePrefix+"item")
+			So(err, ShouldEqual, caching.ErrCacheMiss)
+		})
+
+		Convey("Stale items from the global cache", func() {
+			tc.Add(65 * time.Minute)
+			ctx = caching.WithEmptyProcessCache(ctx) // forget local items
+
+			So(get("v2"), ShouldEqual, "v1")
+			testingStaleCache.refresh.wg.Wait()
+			So(calls, ShouldEqual, 2)
+			So(get("v3"), ShouldEqual, "v2")
+		})
+
+		Convey("Doesn't refresh if another process does", func() {
+			tc.Add(65 * time.Minute)
+			added, err := global.Add(ctx, refreshLeasePrefix+"item", []byte{1}, time.Minute)
+			So(err, ShouldBeNil)
+			So(added, ShouldBeTrue)
+
+			So(get("v2"), ShouldEqual, "v1")
+			testingStaleCache.refresh.wg.Wait()
+			So(calls, ShouldEqual, 1)
+			So(get("v2"), ShouldEqual, "v1")
+			testingStaleCache.refresh.wg.Wait()
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("Blocks if the item is too old", func() {
+			tc.Add(75 * time.Minute)
+			So(get("v2"), ShouldEqual, "v2")
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey("Blocks with min TTL", func() {
+			tc.Add(65 * time.Minute)
+			So(get("v2", WithMinTTL(time.Minute)), ShouldEqual, "v2")
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey("Caches errors", func() {
+			fail := func() ([]byte, time.Duration, error) {
+				calls++
+				return nil, 0, errors.New("boom")
+			}
+
+			_, err := testingStaleCache.GetOrCreate(ctx, "broken", fail)
+			So(err, ShouldErrLike, "boom")
+			So(calls, ShouldEqual, 2)
+
+			_, err = testingStaleCache.GetOrCreate(ctx, "broken", fail)
+			So(err, ShouldErrLike, "boom")
+			So(calls, ShouldEqual, 2)
+
+			tc.Add(2 * time.Minute)
+
+			item, err := testingStaleCache.GetOrCreate(ctx, "broken", getter("fixed"))
+			So(err, ShouldBeNil)
+			So(string(item), ShouldEqual, "fixed")
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("Doesn't cache context errors", func() {
+			fail := func() ([]byte, time.Duration, error) {
+				calls++
+				return nil, 0, context.Canceled
+			}
+			_, err := testingStaleCache.GetOrCreate(ctx, "broken", fail)
+			So(err, ShouldEqual, context.Canceled)
+			_, err = testingStaleCache.GetOrCreate(ctx, "broken", fail)
+			So(err, ShouldEqual, context.Canceled)
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("Invalidate", func() {
+			bus := &testInvalidationBus{}
+			ctx = caching.WithInvalidationBus(ctx, bus)
+
+			So(testingStaleCache.Invalidate(ctx, "item"), ShouldBeNil)
+			So(bus.published, ShouldResemble, []string{"stale-namespace:item"})
+			So(testingStaleCache.CachedLocally(ctx), ShouldEqual, 0)
+			_, err := global.Get(ctx, "item")
+			So(err, ShouldEqual, caching.ErrCacheMiss)
+
+			So(get("v2"), ShouldEqual, "v2")
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey("Invalidation notifications from other processes", func() {
+			caching.DeliverInvalidation(ctx, "stale-namespace", "item")
+			So(testingStaleCache.CachedLocally(ctx), ShouldEqual, 0)
+
+			// Still in the global cache.
+			So(get("v2"), ShouldEqual, "v1")
+			So(calls, ShouldEqual, 1)
+		})
+	})
+}
+
 func TestSerialization(t *testing.T) {
 	t.Parallel()
 
@@ -275,7 +435,7 @@ func TestSerialization(t *testing.T) {
 		}
 
 		Convey("Happy path with deadline", func() {
-			originalItem := itemWithExp[[]byte]{[]byte("blah-blah"), now}
+			originalItem := itemWithExp[[]byte]{val: []byte("blah-blah"), exp: now}
 
 			blob, err := c.serializeItem(&originalItem)
 			So(err, ShouldBeNil)
@@ -287,7 +447,7 @@ func TestSerialization(t *testing.T) {
 		})
 
 		Convey("Happy path without deadline", func() {
-			originalItem := itemWithExp[[]byte]{[]byte("blah-blah"), time.Time{}}
+			originalItem := itemWithExp[[]byte]{val: []byte("blah-blah"), exp: time.Time{}}
 
 			blob, err := c.serializeItem(&originalItem)
 			So(err, ShouldBeNil)
@@ -323,7 +483,7 @@ func TestSerialization(t *testing.T) {
 				return nil, fail
 			}
 
-			blob, err := c.serializeItem(&itemWithExp[[]byte]{[]byte("blah-blah"), now})
+			blob, err := c.serializeItem(&itemWithExp[[]byte]{val: []byte("blah-blah"), exp: now})
 			So(err, ShouldBeNil)
 
 			_, err = c.deserializeItem(blob)
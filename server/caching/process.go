@@ -37,9 +37,6 @@ type registeredCache struct {
 	// Produces an empty *lru.Cache[...]. Has to return `any` since factories for
 	// different types of caches are all registered in a single registry.
 	factory func() any
-
-	// TODO(vadimsh): Add a name here and start exporting LRU cache sizes as
-	// monitoring metrics.
 }
 
 var (
@@ -93,9 +90,43 @@ func (h LRUHandle[K, V]) LRU(ctx context.Context) *lru.Cache[K, V] {
 //
 // The actual cache itself will be stored in ProcessCacheData inside a context.
 func RegisterLRUCache[K comparable, V any](capacity int) LRUHandle[K, V] {
+	return RegisterLRUCacheWithOptions(lru.Options[K, V]{MaxSize: capacity}, nil)
+}
+
+// RegisterLRUCacheWithOptions is like RegisterLRUCache, but allows to configure
+// the cache further, e.g. to limit its size in bytes or to export its metrics.
+//
+// Each ProcessCacheData gets its own cache, so an admission policy can't be
+// passed via opts.Admission (it would be shared). Instead, if `policy` is not
+// nil, it is called to create a policy for each cache. For example, to use
+// TinyLFU:
+//
+//	var cache = caching.RegisterLRUCacheWithOptions(
+//	    lru.Options[string, []byte]{
+//	      Name:    "my-cache",
+//	      Cost:    func(k string, v []byte) int64 { return int64(len(v)) },
+//	      MaxCost: 100 * 1024 * 1024,
+//	    },
+//	    func() lru.AdmissionPolicy[string] {
+//	      seed := maphash.MakeSeed()
+//	      return lru.NewTinyLFU(10000, func(k string) uint64 {
+//	        return maphash.String(seed, k)
+//	      })
+//	    },
+//	)
+func RegisterLRUCacheWithOptions[K comparable, V any](opts lru.Options[K, V], policy func() lru.AdmissionPolicy[K]) LRUHandle[K, V] {
+	if opts.Admission != nil {
+		panic("opts.Admission must not be set, pass a policy factory instead")
+	}
 	checkStillInitTime()
 	registeredCaches = append(registeredCaches, registeredCache{
-		factory: func() any { return lru.New[K, V](capacity) },
+		factory: func() any {
+			opts := opts
+			if policy != nil {
+				opts.Admission = policy()
+			}
+			return lru.NewWithOptions(opts)
+		},
 	})
 	return LRUHandle[K, V]{len(registeredCaches)}
 }
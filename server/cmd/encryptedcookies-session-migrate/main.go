@@ -0,0 +1,351 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Executable encryptedcookies-session-migrate copies sessions used by
+// encryptedcookies module from Cloud Datastore into another session store
+// (Cloud Spanner or Redis).
+//
+// It is intended to be used when migrating a server off Datastore: deploy
+// the server with the new session store, then run the tool to copy over
+// existing sessions so users don't have to log in again. Expired sessions are
+// skipped. Sessions that were already refreshed in the new store are left
+// alone, so it is safe to run the tool multiple times.
+//
+// First run in a dry run mode to see how many sessions will be copied:
+//
+//	go run main.go -cloud-project <project-id> \
+//	    -dest spanner -spanner-database projects/.../instances/.../databases/...
+//
+// Then run for real:
+//
+//	go run main.go -cloud-project <project-id> \
+//	    -dest spanner -spanner-database projects/.../instances/.../databases/... \
+//	    -migrate
+//
+// Use `-dest redis -redis-addr <host:port>` to copy sessions into Redis.
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	cloudds "cloud.google.com/go/datastore"
+	"cloud.google.com/go/spanner"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/system/signals"
+	"go.chromium.org/luci/gae/impl/cloud"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperlite"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	dssession "go.chromium.org/luci/server/encryptedcookies/session/datastore"
+	redissession "go.chromium.org/luci/server/encryptedcookies/session/redis"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	spansession "go.chromium.org/luci/server/encryptedcookies/session/spanner"
+	"go.chromium.org/luci/server/redisconn"
+	"go.chromium.org/luci/server/span"
+)
+
+var (
+	cloudProject    = flag.String("cloud-project", "", "Cloud Datastore cloud project")
+	namespace       = flag.String("namespace", "", "Session store namespace (the same as -encrypted-cookies-session-store-namespace)")
+	dest            = flag.String("dest", "", `Where to copy sessions to: "spanner" or "redis"`)
+	spannerDatabase = flag.String("spanner-database", "", "Spanner database to copy sessions to when using -dest spanner")
+	redisAddr       = flag.String("redis-addr", "", "Redis server to copy sessions to when using -dest redis")
+	redisDB         = flag.Int("redis-db", 0, "Redis database index when using -dest redis")
+	migrate         = flag.Bool("migrate", false, "If set, actually copy sessions instead of just counting them")
+	workers         = flag.Int("workers", 64, "Number of goroutines doing copies")
+)
+
+func main() {
+	flag.Parse()
+	if *cloudProject == "" {
+		fmt.Fprintf(os.Stderr, "-cloud-project is required\n")
+		os.Exit(2)
+	}
+	switch {
+	case *dest == "spanner" && *spannerDatabase == "":
+		fmt.Fprintf(os.Stderr, "-spanner-database is required when using -dest spanner\n")
+		os.Exit(2)
+	case *dest == "redis" && *redisAddr == "":
+		fmt.Fprintf(os.Stderr, "-redis-addr is required when using -dest redis\n")
+		os.Exit(2)
+	case *dest != "spanner" && *dest != "redis":
+		fmt.Fprintf(os.Stderr, "-dest should be either \"spanner\" or \"redis\"\n")
+		os.Exit(2)
+	}
+
+	ctx := gologger.StdConfig.Use(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	signals.HandleInterrupt(cancel)
+
+	if err := run(ctx); err != nil {
+		errors.Log(ctx, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	scopes := []string{
+		"https://www.googleapis.com/auth/cloud-platform",
+		"https://www.googleapis.com/auth/userinfo.email",
+	}
+
+	ts, err := auth.NewAuthenticator(ctx, auth.SilentLogin, chromeinfra.SetDefaultAuthOptions(auth.Options{
+		Scopes: scopes,
+	})).TokenSource()
+	switch {
+	case err == auth.ErrLoginRequired:
+		return errors.Reason("Need to login. Run `luci-auth login -scopes \"%s\"`", strings.Join(scopes, " ")).Err()
+	case err != nil:
+		return errors.Annotate(err, "failed to get token source").Err()
+	}
+
+	client, err := cloudds.NewClient(ctx, *cloudProject,
+		option.WithTokenSource(ts),
+		option.WithGRPCConnectionPool(4),
+	)
+	if err != nil {
+		return errors.Annotate(err, "failed to instantiate the datastore client").Err()
+	}
+	defer client.Close()
+
+	ctx = (&cloud.ConfigLite{
+		ProjectID: *cloudProject,
+		DS:        client,
+	}).Use(ctx)
+
+	ctx, store, cleanup, err := destinationStore(ctx, ts)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return reallyRun(ctx, store)
+}
+
+// destinationStore sets up the store to copy sessions to.
+func destinationStore(ctx context.Context, ts oauth2.TokenSource) (context.Context, session.Store, func(), error) {
+	switch *dest {
+	case "spanner":
+		client, err := spanner.NewClient(ctx, *spannerDatabase, option.WithTokenSource(ts))
+		if err != nil {
+			return nil, nil, nil, errors.Annotate(err, "failed to instantiate the spanner client").Err()
+		}
+		return span.UseClient(ctx, client), &spansession.Store{Namespace: *namespace}, client.Close, nil
+	case "redis":
+		pool := redisconn.NewPool(*redisAddr, *redisDB)
+		return ctx, redissession.NewStore(pool, *namespace), func() { pool.Close() }, nil
+	default:
+		panic("impossible")
+	}
+}
+
+func reallyRun(ctx context.Context, store session.Store) error {
+	sessions := make(chan *dssession.SessionEntity, 50000)
+	visitor := visitor{
+		now:        clock.Now(ctx).UTC(),
+		migrate:    *migrate,
+		nextReport: clock.Now(ctx).Add(time.Second),
+	}
+
+	// A goroutine pool to copy visited entities.
+	gr, gctx := errgroup.WithContext(ctx)
+	for i := 0; i < *workers; i++ {
+		gr.Go(func() error {
+			for s := range sessions {
+				visitor.process(gctx, store, s)
+				visitor.reportMaybe(gctx)
+			}
+			return nil
+		})
+	}
+
+	// A mapper that feeds entities to the visitor goroutine pool.
+	logging.Infof(ctx, "Visiting Session entities...")
+	mapErr := dsmapperlite.Map(info.MustNamespace(ctx, *namespace), datastore.NewQuery("encryptedcookies.Session"), 32, 1000,
+		func(ctx context.Context, _ int, s *dssession.SessionEntity) error {
+			if visitor.visit(ctx, s) {
+				sessions <- s
+			}
+			visitor.reportMaybe(ctx)
+			return nil
+		},
+	)
+	close(sessions)
+	visitor.visitedAll(ctx)
+	grErr := gr.Wait()
+
+	visitor.report(ctx, true)
+
+	if grErr != nil {
+		return errors.Annotate(grErr, "when copying SessionEntity").Err()
+	}
+	if mapErr != nil {
+		return errors.Annotate(mapErr, "when visiting SessionEntity").Err()
+	}
+	return nil
+}
+
+// errUpToDate is returned by the update callback to skip the update.
+var errUpToDate = errors.New("the session is already up-to-date")
+
+type visitor struct {
+	now     time.Time
+	migrate bool
+
+	m sync.Mutex
+
+	visited       int // total number of entities visited
+	expired       int // expired sessions that will not be copied
+	broken        int // sessions with invalid IDs or without a body
+	pendingCopy   int // sessions queued for copying
+	copied        int // total number of successfully copied sessions
+	alreadyCopied int // sessions that are already up-to-date in the destination
+	errors        int // total number of copy errors
+	reportM       sync.Mutex
+	nextReport    time.Time // when to print the next progress report
+	doneVisiting  bool      // true if done visiting, but still processing
+}
+
+// visit returns true if a session needs to be copied.
+func (v *visitor) visit(ctx context.Context, s *dssession.SessionEntity) bool {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	v.visited++
+
+	switch {
+	case s.Session == nil:
+		v.broken++
+		return false
+	case v.now.After(session.ExpireAt(ctx, s.Session)):
+		v.expired++
+		return false
+	}
+
+	v.pendingCopy++
+	return true
+}
+
+// process copies a session into the destination store.
+func (v *visitor) process(ctx context.Context, store session.Store, s *dssession.SessionEntity) {
+	var err error
+	upToDate := false
+	if v.migrate {
+		switch err = copySession(ctx, store, s); {
+		case err == errUpToDate:
+			upToDate = true
+			err = nil
+		case err != nil:
+			logging.Errorf(ctx, "%s: %s", s.ID, err)
+		}
+	}
+
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	v.pendingCopy--
+	if v.migrate {
+		switch {
+		case err != nil:
+			v.errors++
+		case upToDate:
+			v.alreadyCopied++
+		default:
+			v.copied++
+		}
+	}
+}
+
+// visitedAll is called when all sessions are visited.
+func (v *visitor) visitedAll(ctx context.Context) {
+	v.reportM.Lock()
+	v.doneVisiting = true
+	v.reportM.Unlock()
+	v.report(ctx, true)
+}
+
+// reportMaybe prints a progress report if it is time.
+func (v *visitor) reportMaybe(ctx context.Context) {
+	now := clock.Now(ctx)
+
+	v.reportM.Lock()
+	needReport := now.After(v.nextReport)
+	if needReport {
+		v.nextReport = now.Add(time.Second)
+	}
+	doneVisiting := v.doneVisiting
+	v.reportM.Unlock()
+
+	if needReport {
+		v.report(ctx, doneVisiting)
+	}
+}
+
+// report prints a progress report.
+func (v *visitor) report(ctx context.Context, doneVisiting bool) {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	logging.Infof(ctx, "-------------------------------------------")
+	if doneVisiting {
+		logging.Infof(ctx, "All visited sessions:                     %d", v.visited)
+	} else {
+		logging.Infof(ctx, "Sessions visited so far:                  %d", v.visited)
+	}
+	logging.Infof(ctx, "Expired sessions (skipped):               %d", v.expired)
+	logging.Infof(ctx, "Broken sessions (skipped):                %d", v.broken)
+	logging.Infof(ctx, "Sessions pending copy by the tool:        %d", v.pendingCopy)
+	logging.Infof(ctx, "Successfully copied sessions:             %d", v.copied)
+	logging.Infof(ctx, "Sessions already up-to-date:              %d", v.alreadyCopied)
+	logging.Infof(ctx, "Copy errors:                              %d", v.errors)
+	logging.Infof(ctx, "-------------------------------------------")
+}
+
+// copySession copies the session into the store unless the store already has
+// a more recently refreshed copy.
+//
+// Returns errUpToDate if the store already has the session.
+func copySession(ctx context.Context, store session.Store, s *dssession.SessionEntity) error {
+	id, err := base64.RawStdEncoding.DecodeString(s.ID)
+	if err != nil {
+		return errors.Annotate(err, "bad session ID").Err()
+	}
+	return store.UpdateSession(ctx, id, func(existing *sessionpb.Session) error {
+		if existing.LastRefresh != nil && !existing.LastRefresh.AsTime().Before(s.Session.LastRefresh.AsTime()) {
+			return errUpToDate
+		}
+		proto.Reset(existing)
+		proto.Merge(existing, s.Session)
+		return nil
+	})
+}
@@ -20,13 +20,92 @@ import (
 	"html"
 	"html/template"
 	"net/url"
+	"sort"
+	"strings"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 
+	"go.chromium.org/luci/server/cron/selfdriven"
 	"go.chromium.org/luci/server/portal"
 )
 
+// historyLimit is how many recent runs of each handler to show.
+const historyLimit = 10
+
+var historyTmpl = template.Must(template.New("history").Parse(`
+<h4>Self-driven mode</h4>
+<p>Handlers below are invoked according to their schedules by the leader among
+the server replicas. This replica ({{.Replica}}) is
+{{if .Leader}}<b>the leader</b>{{else}}<b>not</b> the leader{{end}}.</p>
+{{range .Handlers}}
+<h5>{{.ID}}</h5>
+<p>Schedule: <code>{{.Cron}}</code>, missed runs: {{.MissedRuns}}.</p>
+{{if .Runs}}
+<table class="table table-condensed">
+  <tr><th>Scheduled</th><th>Started</th><th>Duration</th><th>Result</th><th>Replica</th><th>Details</th></tr>
+  {{range .Runs}}
+  <tr>
+    <td>{{.Scheduled.Format "2006-01-02 15:04:05 MST"}}</td>
+    <td>{{if not .Started.IsZero}}{{.Started.Format "2006-01-02 15:04:05 MST"}}{{end}}</td>
+    <td>{{if not .Started.IsZero}}{{.Duration}}{{end}}</td>
+    <td>{{.Result}}</td>
+    <td>{{.Replica}}</td>
+    <td>{{if .Skipped}}skipped {{.Skipped}} run(s){{else}}{{.Error}}{{end}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No runs yet.</p>
+{{end}}
+{{end}}
+`))
+
+var statusTmpl = template.Must(template.New("status").Parse(`
+{{if .}}
+<table class="table table-condensed">
+  <tr><th>Handler</th><th>Last call</th><th>Duration</th><th>Result</th><th>Error</th></tr>
+  {{range .}}
+  <tr>
+    <td>{{.ID}}</td>
+    {{if .Call}}
+    <td>{{.Call.Started.Format "2006-01-02 15:04:05 MST"}}</td>
+    <td>{{.Call.Duration}}</td>
+    <td>{{.Call.Result}}</td>
+    <td>{{.Call.Error}}</td>
+    {{else}}
+    <td colspan="4">not called in this process</td>
+    {{end}}
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No cron handlers are registered.</p>
+{{end}}
+`))
+
+// renderStatus renders the results of the last calls of cron handlers.
+func renderStatus(ctx context.Context) (template.HTML, error) {
+	type handler struct {
+		ID   string
+		Call *lastCall
+	}
+	calls := Default.lastCalls()
+	var handlers []handler
+	for _, id := range Default.handlerIDs() {
+		h := handler{ID: id}
+		if call, ok := calls[id]; ok {
+			h.Call = &call
+		}
+		handlers = append(handlers, h)
+	}
+	out := strings.Builder{}
+	if err := statusTmpl.Execute(&out, handlers); err != nil {
+		return "", errors.Annotate(err, "failed to render cron handlers").Err()
+	}
+	return template.HTML(out.String()), nil
+}
+
 type portalPage struct {
 	portal.BasePage
 }
@@ -54,9 +133,52 @@ func (portalPage) Overview(ctx context.Context) (template.HTML, error) {
 			</p>
 		`)
 	}
+	if r := Default.runner.Load(); r != nil {
+		history, err := selfDrivenHistory(ctx, r)
+		if err != nil {
+			return "", err
+		}
+		text += history
+	}
 	return text, nil
 }
 
+// selfDrivenHistory renders the state of the self-driven mode.
+func selfDrivenHistory(ctx context.Context, r *SelfDrivenRunner) (template.HTML, error) {
+	history, err := r.history(ctx, historyLimit)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to fetch the run history").Err()
+	}
+
+	type handler struct {
+		ID         string
+		Cron       string
+		MissedRuns MissedRunPolicy
+		Runs       []*selfdriven.Run
+	}
+	var handlers []handler
+	for id, sched := range r.Dispatcher.schedules() {
+		handlers = append(handlers, handler{
+			ID:         id,
+			Cron:       sched.Cron,
+			MissedRuns: sched.MissedRuns,
+			Runs:       history[id],
+		})
+	}
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].ID < handlers[j].ID })
+
+	out := strings.Builder{}
+	err = historyTmpl.Execute(&out, map[string]any{
+		"Replica":  r.Replica,
+		"Leader":   r.IsLeader(),
+		"Handlers": handlers,
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to render the run history").Err()
+	}
+	return template.HTML(out.String()), nil
+}
+
 func (portalPage) Actions(ctx context.Context) ([]portal.Action, error) {
 	var actions []portal.Action
 	for _, id := range Default.handlerIDs() {
@@ -88,4 +210,9 @@ func genActionID(id string) string {
 
 func init() {
 	portal.RegisterPage("cron", portalPage{})
+	portal.RegisterStatusSection(portal.StatusSection{
+		ID:     "cron",
+		Title:  "Cron handlers",
+		Render: renderStatus,
+	})
 }
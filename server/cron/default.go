@@ -29,3 +29,8 @@ var Default Dispatcher
 func RegisterHandler(id string, h Handler) {
 	Default.RegisterHandler(id, h)
 }
+
+// RegisterSchedule is a shortcut for Default.RegisterSchedule.
+func RegisterSchedule(id string, s Schedule) {
+	Default.RegisterSchedule(id, s)
+}
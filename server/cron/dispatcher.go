@@ -22,6 +22,10 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorhill/cronexpr"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
@@ -83,8 +87,98 @@ type Dispatcher struct {
 	// This is useful when running in development mode on localhost or in tests.
 	DisableAuth bool
 
-	m sync.RWMutex
-	h map[string]Handler
+	m    sync.RWMutex
+	h    map[string]Handler
+	s    map[string]*schedule
+	last map[string]*lastCall // the last call of a handler in this process
+
+	runner atomic.Pointer[SelfDrivenRunner] // set in SelfDrivenRunner.Run
+}
+
+// MissedRunPolicy defines what to do with runs missed by the self-driven mode,
+// e.g. when no replica was running.
+type MissedRunPolicy int
+
+const (
+	// RunOnce runs the handler once to catch up with all missed runs.
+	RunOnce MissedRunPolicy = iota
+
+	// SkipMissed skips missed runs and waits for the next scheduled one.
+	SkipMissed
+
+	// RunAll runs the handler for each missed run (sequentially), but no more
+	// than Schedule.MaxCatchUp most recent ones.
+	RunAll
+)
+
+// String is used in the portal page.
+func (p MissedRunPolicy) String() string {
+	switch p {
+	case RunOnce:
+		return "run once"
+	case SkipMissed:
+		return "skip"
+	case RunAll:
+		return "run all"
+	default:
+		return fmt.Sprintf("MissedRunPolicy(%d)", int(p))
+	}
+}
+
+// Schedule defines when to run a handler in the self-driven mode.
+//
+// See SelfDrivenRunner.
+type Schedule struct {
+	// Cron is a cron expression in UTC, e.g. "*/5 * * * *" or "@hourly".
+	//
+	// See https://github.com/gorhill/cronexpr for the supported syntax.
+	Cron string
+
+	// MissedRuns defines what to do with missed runs. Default is RunOnce.
+	MissedRuns MissedRunPolicy
+
+	// MaxCatchUp is the maximum number of missed runs to execute when using
+	// RunAll policy. Default is 10.
+	MaxCatchUp int
+}
+
+// schedule is a parsed Schedule.
+type schedule struct {
+	Schedule
+	expr *cronexpr.Expression
+}
+
+// maxScheduleIterations limits how many schedule ticks are examined at once
+// when catching up after a long pause.
+const maxScheduleIterations = 100000
+
+// due returns a list of scheduled times (in order) to run the handler at, given
+// the time of the last processed run, as well as the number of skipped runs and
+// the scheduled time of the most recent skipped run.
+//
+// `grace` is how late a run can be before it is considered missed.
+func (s *schedule) due(last, now time.Time, grace time.Duration) (runs []time.Time, skipped int, lastSkipped time.Time) {
+	maxRuns := 1
+	if s.MissedRuns == RunAll {
+		maxRuns = s.MaxCatchUp
+	}
+	iter := 0
+	for t := s.expr.Next(last); !t.IsZero() && !t.After(now); t = s.expr.Next(t) {
+		if iter++; iter > maxScheduleIterations {
+			// Skip everything examined so far and continue during the next call.
+			return nil, skipped + len(runs), runs[len(runs)-1]
+		}
+		if len(runs) == maxRuns {
+			skipped++
+			lastSkipped = runs[0]
+			runs = runs[1:]
+		}
+		runs = append(runs, t)
+	}
+	if s.MissedRuns == SkipMissed && len(runs) == 1 && now.Sub(runs[0]) > grace {
+		return nil, skipped + 1, runs[0]
+	}
+	return runs, skipped, lastSkipped
 }
 
 // handlerIDRe is used to validate handler IDs.
@@ -113,6 +207,37 @@ func (d *Dispatcher) RegisterHandler(id string, h Handler) {
 	d.h[id] = h
 }
 
+// RegisterSchedule sets a schedule of a handler in the self-driven mode.
+//
+// The schedule is used only if the server runs a SelfDrivenRunner (usually
+// enabled via -cron-self-driven-store flag). Otherwise the handler is invoked
+// only when Cloud Scheduler calls it, as usual.
+//
+// The ID must match `[a-zA-Z0-9_\-.]{1,100}`. The handler itself can be
+// registered separately. Panics if the ID or the cron expression are invalid
+// or if the schedule is already registered.
+func (d *Dispatcher) RegisterSchedule(id string, s Schedule) {
+	if !handlerIDRe.MatchString(id) {
+		panic(fmt.Sprintf("bad cron handler ID %q", id))
+	}
+	expr, err := cronexpr.Parse(s.Cron)
+	if err != nil {
+		panic(fmt.Sprintf("bad cron schedule %q for handler %q: %s", s.Cron, id, err))
+	}
+	if s.MaxCatchUp <= 0 {
+		s.MaxCatchUp = 10
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.s == nil {
+		d.s = make(map[string]*schedule, 1)
+	}
+	if _, ok := d.s[id]; ok {
+		panic(fmt.Sprintf("cron schedule for handler %q is already registered", id))
+	}
+	d.s[id] = &schedule{Schedule: s, expr: expr}
+}
+
 // InstallCronRoutes installs routes that handle requests from Cloud Scheduler.
 func (d *Dispatcher) InstallCronRoutes(r *router.Router, prefix string) {
 	if prefix == "" {
@@ -158,6 +283,25 @@ func (d *Dispatcher) InstallCronRoutes(r *router.Router, prefix string) {
 	})
 }
 
+// lastCall describes the last call of a handler in this process.
+type lastCall struct {
+	Started  time.Time
+	Duration time.Duration
+	Result   string // OK | transient | fatal | panic
+	Error    string // the error message, if any
+}
+
+// lastCalls returns the last calls of handlers made in this process.
+func (d *Dispatcher) lastCalls() map[string]lastCall {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	out := make(map[string]lastCall, len(d.last))
+	for id, call := range d.last {
+		out[id] = *call
+	}
+	return out
+}
+
 // handlerIDs returns a sorted list of registered handler IDs.
 func (d *Dispatcher) handlerIDs() []string {
 	d.m.RLock()
@@ -170,24 +314,54 @@ func (d *Dispatcher) handlerIDs() []string {
 	return ids
 }
 
+// schedules returns a copy of the registered schedules.
+func (d *Dispatcher) schedules() map[string]*schedule {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	out := make(map[string]*schedule, len(d.s))
+	for id, s := range d.s {
+		out[id] = s
+	}
+	return out
+}
+
 // executeHandlerByID executes a registered cron handler.
 func (d *Dispatcher) executeHandlerByID(ctx context.Context, id string) error {
+	_, err := d.executeHandler(ctx, id)
+	return err
+}
+
+// executeHandler executes a registered cron handler, returning the result
+// reported to monitoring along with the error.
+func (d *Dispatcher) executeHandler(ctx context.Context, id string) (result string, err error) {
 	d.m.RLock()
 	h := d.h[id]
 	d.m.RUnlock()
 	if h == nil {
 		callsCounter.Add(ctx, 1, id, "no_handler")
-		return errors.Reason("no cron handler with ID %q is registered", id).Err()
+		return "no_handler", errors.Reason("no cron handler with ID %q is registered", id).Err()
 	}
 
 	start := clock.Now(ctx)
-	result := "panic"
+	result = "panic"
 	defer func() {
+		dur := clock.Since(ctx, start)
 		callsCounter.Add(ctx, 1, id, result)
-		callsDurationMS.Add(ctx, float64(clock.Since(ctx, start).Milliseconds()), id, result)
+		callsDurationMS.Add(ctx, float64(dur.Milliseconds()), id, result)
+
+		call := &lastCall{Started: start, Duration: dur, Result: result}
+		if err != nil {
+			call.Error = err.Error()
+		}
+		d.m.Lock()
+		if d.last == nil {
+			d.last = make(map[string]*lastCall, 1)
+		}
+		d.last[id] = call
+		d.m.Unlock()
 	}()
 
-	err := h(ctx)
+	err = h(ctx)
 	switch {
 	case err == nil:
 		result = "OK"
@@ -196,5 +370,5 @@ func (d *Dispatcher) executeHandlerByID(ctx context.Context, id string) error {
 	default:
 		result = "fatal"
 	}
-	return err
+	return result, err
 }
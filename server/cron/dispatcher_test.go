@@ -84,6 +84,7 @@ func TestDispatcher(t *testing.T) {
 			So(called, ShouldBeTrue)
 			So(metric(callsCounter, "ok", "OK"), ShouldEqual, 1)
 			So(metricDist(callsDurationMS, "ok", "OK"), ShouldEqual, 1)
+			So(d.lastCalls()["ok"].Result, ShouldEqual, "OK")
 		})
 
 		Convey("Fatal error", func() {
@@ -93,6 +94,10 @@ func TestDispatcher(t *testing.T) {
 			So(call("/crons/boom"), ShouldEqual, 202)
 			So(metric(callsCounter, "boom", "fatal"), ShouldEqual, 1)
 			So(metricDist(callsDurationMS, "boom", "fatal"), ShouldEqual, 1)
+
+			last := d.lastCalls()["boom"]
+			So(last.Result, ShouldEqual, "fatal")
+			So(last.Error, ShouldEqual, "boom")
 		})
 
 		Convey("Transient error", func() {
@@ -116,6 +121,7 @@ func TestDispatcher(t *testing.T) {
 			So(func() { call("/crons/panic") }, ShouldPanic)
 			So(metric(callsCounter, "panic", "panic"), ShouldEqual, 1)
 			So(metricDist(callsDurationMS, "panic", "panic"), ShouldEqual, 1)
+			So(d.lastCalls()["panic"].Result, ShouldEqual, "panic")
 		})
 	})
 }
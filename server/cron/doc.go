@@ -22,4 +22,26 @@
 // running on Appengine). By default registered handlers are exposed as
 // "/internal/cron/<handler-id>" endpoints. This URL path should be used when
 // configuring Cloud Scheduler jobs or in cron.yaml when running on Appengine.
+//
+// # Self-driven mode
+//
+// Alternatively handlers can be invoked by the server itself, without Cloud
+// Scheduler. In this mode server replicas elect a leader (using Redis, Spanner
+// or Datastore, see server/cron/selfdriven package) and the leader invokes
+// handlers according to their schedules:
+//
+//	cron.RegisterHandler("refresh-stuff", refreshStuff)
+//	cron.RegisterSchedule("refresh-stuff", cron.Schedule{
+//	  Cron:       "*/10 * * * *",
+//	  MissedRuns: cron.SkipMissed,
+//	})
+//
+// The mode is enabled via -cron-self-driven-store flag. The chosen store
+// implementation must be linked into the binary:
+//
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/redis"
+//
+// The store keeps track of runs of each handler. Runs missed while there was no
+// leader are either executed or skipped per handler's MissedRunPolicy. The
+// recent runs are shown in the cron page of the admin portal.
 package cron
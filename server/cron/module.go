@@ -23,6 +23,7 @@ import (
 	luciflag "go.chromium.org/luci/common/flag"
 	"go.chromium.org/luci/common/logging"
 
+	"go.chromium.org/luci/server/cron/selfdriven"
 	"go.chromium.org/luci/server/module"
 )
 
@@ -56,6 +57,16 @@ type ModuleOptions struct {
 	//
 	// Default is an empty list.
 	AuthorizedCallers []string
+
+	// SelfDrivenStore is a kind of a store to use for the self-driven mode.
+	//
+	// If set, server replicas elect a leader which invokes handlers according
+	// to their schedules (see RegisterSchedule), without Cloud Scheduler. The
+	// corresponding store implementation must be linked into the binary, see
+	// server/cron/selfdriven package for details.
+	//
+	// Default is empty, meaning handlers are invoked only via HTTP endpoints.
+	SelfDrivenStore string
 }
 
 // Register registers the command line flags.
@@ -69,6 +80,8 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		`URL prefix to serve registered cron handlers from, must start with '/internal/'.`)
 	f.Var(luciflag.StringSlice(&o.AuthorizedCallers), "cron-authorized-caller",
 		`Service account email to accept calls from. May be repeated.`)
+	f.StringVar(&o.SelfDrivenStore, "cron-self-driven-store", o.SelfDrivenStore,
+		`If set, invoke scheduled handlers from the elected leader replica using this store (e.g. "redis", "spanner" or "datastore").`)
 }
 
 // NewModule returns a server module that sets up a cron dispatcher.
@@ -102,7 +115,13 @@ func (*cronModule) Name() module.Name {
 
 // Dependencies is part of module.Module interface.
 func (*cronModule) Dependencies() []module.Dependency {
-	return nil
+	var deps []module.Dependency
+	selfdriven.VisitImpls(func(impl *selfdriven.Impl) {
+		if impl.Module.Valid() {
+			deps = append(deps, module.OptionalDependency(impl.Module))
+		}
+	})
+	return deps
 }
 
 // Initialize is part of module.Module interface.
@@ -121,5 +140,18 @@ func (m *cronModule) Initialize(ctx context.Context, host module.Host, opts modu
 	m.opts.Dispatcher.AuthorizedCallers = m.opts.AuthorizedCallers
 	m.opts.Dispatcher.InstallCronRoutes(host.Routes(), m.opts.ServingPrefix)
 
+	if m.opts.SelfDrivenStore != "" {
+		logging.Infof(ctx, "Cron is running in the self-driven mode using %q store", m.opts.SelfDrivenStore)
+		store, err := selfdriven.New(ctx, m.opts.SelfDrivenStore)
+		if err != nil {
+			return nil, err
+		}
+		runner := &SelfDrivenRunner{
+			Dispatcher: m.opts.Dispatcher,
+			Store:      store,
+		}
+		host.RunInBackground("luci.cron.selfdriven", runner.Run)
+	}
+
 	return ctx, nil
 }
@@ -0,0 +1,273 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/runtime/paniccatcher"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+)
+
+var (
+	leaderGauge = metric.NewBool(
+		"cron/server/self_driven/leader",
+		"True if this replica invokes cron handlers in the self-driven mode",
+		nil,
+	)
+
+	missedCounter = metric.NewCounter(
+		"cron/server/self_driven/missed",
+		"Count of skipped missed runs in the self-driven mode",
+		nil,
+		field.String("id"), // cron handler ID
+	)
+)
+
+// SelfDrivenRunner invokes cron handlers according to their schedules.
+//
+// It is an alternative to Cloud Scheduler: all server replicas run
+// a SelfDrivenRunner, elect a leader among themselves using the Store, and
+// the leader invokes handlers which have schedules (see RegisterSchedule).
+//
+// Runs are recorded in the Store. They are used to find missed runs (e.g. when
+// no replica was running) and to show the run history in the admin portal.
+// Missed runs are either executed or skipped, per handler's MissedRunPolicy.
+//
+// Handlers are invoked at least once per scheduled time: if the leader dies
+// while running a handler, the new leader will run it again. Different
+// handlers run concurrently, but runs of the same handler never overlap
+// within a leader.
+type SelfDrivenRunner struct {
+	// Dispatcher holds registered handlers and their schedules.
+	Dispatcher *Dispatcher
+
+	// Store is used for leader election and to keep track of runs.
+	Store selfdriven.Store
+
+	// Replica identifies this process. Default is selfdriven.NewReplicaID().
+	Replica string
+
+	// LeaseDuration is how long the leadership lasts without renewals.
+	//
+	// It is renewed every PollInterval. Default is 1 min.
+	LeaseDuration time.Duration
+
+	// PollInterval is how often to renew the leadership and check schedules.
+	//
+	// Default is 10 sec.
+	PollInterval time.Duration
+
+	leader atomic.Bool
+
+	m        sync.Mutex
+	running  map[string]bool      // handlers being run right now
+	baseline map[string]time.Time // when never-run handlers were first seen
+}
+
+// Run runs the loop until the context is canceled.
+func (r *SelfDrivenRunner) Run(ctx context.Context) {
+	if r.Replica == "" {
+		r.Replica = selfdriven.NewReplicaID()
+	}
+	if r.LeaseDuration == 0 {
+		r.LeaseDuration = time.Minute
+	}
+	if r.PollInterval == 0 {
+		r.PollInterval = 10 * time.Second
+	}
+	r.Dispatcher.runner.Store(r)
+
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		if r.leader.Swap(false) {
+			ctx, cancel := clock.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			defer cancel()
+			if err := r.Store.Resign(ctx, r.Replica); err != nil {
+				logging.Warningf(ctx, "Failed to resign the cron leadership: %s", err)
+			}
+		}
+	}()
+
+	for ctx.Err() == nil {
+		if r.elect(ctx) {
+			r.launchDue(ctx, &wg)
+		}
+		if res := <-clock.After(clock.Tag(ctx, "cron-poll"), r.PollInterval); res.Err != nil {
+			break
+		}
+	}
+}
+
+// IsLeader is true if this replica is the leader right now.
+func (r *SelfDrivenRunner) IsLeader() bool {
+	return r.leader.Load()
+}
+
+// elect acquires or renews the leadership.
+func (r *SelfDrivenRunner) elect(ctx context.Context) bool {
+	leader, err := r.Store.Elect(ctx, r.Replica, r.LeaseDuration)
+	if err != nil {
+		// Better to miss a run than to have two leaders.
+		logging.Warningf(ctx, "Failed to elect the cron leader: %s", err)
+		leader = false
+	}
+	if r.leader.Swap(leader) != leader {
+		if leader {
+			logging.Infof(ctx, "Replica %q became the cron leader", r.Replica)
+		} else {
+			logging.Infof(ctx, "Replica %q is no longer the cron leader", r.Replica)
+		}
+	}
+	leaderGauge.Set(ctx, leader)
+	return leader
+}
+
+// launchDue launches goroutines that process handlers that aren't running yet.
+func (r *SelfDrivenRunner) launchDue(ctx context.Context, wg *sync.WaitGroup) {
+	now := clock.Now(ctx).UTC()
+
+	scheds := r.Dispatcher.schedules()
+	ids := make([]string, 0, len(scheds))
+	for id := range scheds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.running == nil {
+		r.running = make(map[string]bool, len(ids))
+		r.baseline = make(map[string]time.Time, len(ids))
+	}
+	for _, id := range ids {
+		if r.running[id] {
+			continue
+		}
+		r.running[id] = true
+		if _, ok := r.baseline[id]; !ok {
+			r.baseline[id] = now.Truncate(time.Second)
+		}
+		wg.Add(1)
+		go func(id string, sched *schedule, baseline time.Time) {
+			defer func() {
+				r.m.Lock()
+				delete(r.running, id)
+				r.m.Unlock()
+				wg.Done()
+			}()
+			r.process(ctx, id, sched, baseline, now)
+		}(id, scheds[id], r.baseline[id])
+	}
+}
+
+// process executes all due runs of a handler and records them.
+//
+// `baseline` is used in place of the last run if the handler has never run.
+func (r *SelfDrivenRunner) process(ctx context.Context, id string, sched *schedule, baseline, now time.Time) {
+	ctx = logging.SetField(ctx, "cron", id)
+
+	last, err := selfdriven.LastRun(ctx, r.Store, id)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to fetch the last run: %s", err)
+		return
+	}
+	if last != nil {
+		baseline = last.Scheduled
+	}
+
+	runs, skipped, lastSkipped := sched.due(baseline, now, 2*r.PollInterval)
+	if skipped != 0 {
+		logging.Warningf(ctx, "Skipping %d missed run(s), the last one at %s", skipped, lastSkipped)
+		missedCounter.Add(ctx, int64(skipped), id)
+		err := r.Store.RecordRun(ctx, &selfdriven.Run{
+			ID:        id,
+			Scheduled: lastSkipped,
+			Result:    selfdriven.ResultSkipped,
+			Replica:   r.Replica,
+			Skipped:   skipped,
+		})
+		if err != nil {
+			logging.Errorf(ctx, "Failed to record skipped runs: %s", err)
+			return
+		}
+	}
+
+	for _, ts := range runs {
+		if !r.leader.Load() || ctx.Err() != nil {
+			return
+		}
+		if err := r.Store.RecordRun(ctx, r.invoke(ctx, id, ts)); err != nil {
+			logging.Errorf(ctx, "Failed to record the run: %s", err)
+			return
+		}
+	}
+}
+
+// invoke invokes the handler, converting panics into errors.
+func (r *SelfDrivenRunner) invoke(ctx context.Context, id string, scheduled time.Time) *selfdriven.Run {
+	start := clock.Now(ctx)
+	run := &selfdriven.Run{
+		ID:        id,
+		Scheduled: scheduled,
+		Started:   start.UTC(),
+		Result:    selfdriven.ResultPanic,
+		Replica:   r.Replica,
+	}
+
+	var err error
+	paniccatcher.Do(func() {
+		run.Result, err = r.Dispatcher.executeHandler(ctx, id)
+	}, func(p *paniccatcher.Panic) {
+		logging.Errorf(ctx, "Cron handler panicked: %s\n%s", p.Reason, p.Stack)
+		err = errors.Reason("panic: %s", p.Reason).Err()
+	})
+	run.Duration = clock.Since(ctx, start)
+
+	if run.Result == "no_handler" {
+		run.Result = selfdriven.ResultFatal
+	}
+	if err != nil {
+		run.Error = err.Error()
+		errors.Log(ctx, errors.Annotate(err, "%s error in cron handler %q scheduled at %s", run.Result, id, scheduled).Err())
+	}
+	return run
+}
+
+// history returns the most recent runs of all scheduled handlers.
+//
+// Used by the portal page.
+func (r *SelfDrivenRunner) history(ctx context.Context, limit int) (map[string][]*selfdriven.Run, error) {
+	out := map[string][]*selfdriven.Run{}
+	for id := range r.Dispatcher.schedules() {
+		runs, err := r.Store.History(ctx, id, limit)
+		if err != nil {
+			return nil, err
+		}
+		out[id] = runs
+	}
+	return out, nil
+}
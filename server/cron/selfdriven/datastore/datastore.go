@@ -0,0 +1,195 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datastore contains a Cloud Datastore-based store for the self-driven
+// server/cron mode.
+//
+// Importing this package registers "datastore" store kind, which uses the
+// Datastore client configured by server/gaeemulation module:
+//
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/datastore"
+//
+// Uses "cron.Leader", "cron.Handler" and "cron.Run" entity kinds.
+package datastore
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	ds "go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+	"go.chromium.org/luci/server/gaeemulation"
+)
+
+func init() {
+	selfdriven.Register(selfdriven.Impl{
+		Kind:   "datastore",
+		Module: gaeemulation.ModuleName,
+		New: func(ctx context.Context) (selfdriven.Store, error) {
+			return Store{}, nil
+		},
+	})
+}
+
+// Store is a selfdriven.Store that uses Cloud Datastore.
+//
+// Uses the Datastore client in the context.
+type Store struct{}
+
+var _ selfdriven.Store = Store{}
+
+// leaderEntity holds the current leader.
+type leaderEntity struct {
+	_kind string `gae:"$kind,cron.Leader"`
+
+	ID     string    `gae:"$id"` // always "cron"
+	Holder string    `gae:",noindex"`
+	Expiry time.Time `gae:",noindex"` // precision up to microseconds
+}
+
+// runEntity is a single run.
+//
+// Its ID is derived from the scheduled time such that more recent runs sort
+// first by key.
+type runEntity struct {
+	_kind string `gae:"$kind,cron.Run"`
+
+	ID        int64     `gae:"$id"`
+	Parent    *ds.Key   `gae:"$parent"` // cron.Handler entity (never stored)
+	Scheduled time.Time `gae:",noindex"`
+	Started   time.Time `gae:",noindex"`
+	Duration  int64     `gae:",noindex"`
+	Result    string    `gae:",noindex"`
+	Error     string    `gae:",noindex"`
+	Replica   string    `gae:",noindex"`
+	Skipped   int64     `gae:",noindex"`
+}
+
+// runID derives the run entity ID from its scheduled time.
+func runID(scheduled time.Time) int64 {
+	return math.MaxInt64 - scheduled.UnixMicro()
+}
+
+func handlerKey(ctx context.Context, id string) *ds.Key {
+	return ds.NewKey(ctx, "cron.Handler", id, 0, nil)
+}
+
+// Elect implements selfdriven.Store.
+func (Store) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	now := clock.Now(ctx)
+	leader := false
+	err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+		leader = false
+		ent := &leaderEntity{ID: "cron"}
+		switch err := ds.Get(ctx, ent); {
+		case err == ds.ErrNoSuchEntity:
+		case err != nil:
+			return err
+		case ent.Holder != replica && ent.Expiry.After(now):
+			return nil
+		}
+		leader = true
+		ent.Holder = replica
+		ent.Expiry = ds.RoundTime(now.Add(ttl))
+		return ds.Put(ctx, ent)
+	}, nil)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to elect the leader").Tag(transient.Tag).Err()
+	}
+	return leader, nil
+}
+
+// Resign implements selfdriven.Store.
+func (Store) Resign(ctx context.Context, replica string) error {
+	err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+		ent := &leaderEntity{ID: "cron"}
+		switch err := ds.Get(ctx, ent); {
+		case err == ds.ErrNoSuchEntity:
+			return nil
+		case err != nil:
+			return err
+		case ent.Holder != replica:
+			return nil
+		}
+		return ds.Delete(ctx, ent)
+	}, nil)
+	if err != nil {
+		return errors.Annotate(err, "failed to resign").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// RecordRun implements selfdriven.Store.
+func (Store) RecordRun(ctx context.Context, r *selfdriven.Run) error {
+	parent := handlerKey(ctx, r.ID)
+	err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+		// Delete old runs, given that `r` is going to be the most recent one.
+		q := ds.NewQuery("cron.Run").Ancestor(parent).KeysOnly(true).Offset(selfdriven.MaxHistory - 1)
+		var old []*ds.Key
+		if err := ds.GetAll(ctx, q, &old); err != nil {
+			return err
+		}
+		if len(old) != 0 {
+			if err := ds.Delete(ctx, old); err != nil {
+				return err
+			}
+		}
+		return ds.Put(ctx, &runEntity{
+			ID:        runID(r.Scheduled),
+			Parent:    parent,
+			Scheduled: r.Scheduled,
+			Started:   r.Started,
+			Duration:  int64(r.Duration),
+			Result:    r.Result,
+			Error:     r.Error,
+			Replica:   r.Replica,
+			Skipped:   int64(r.Skipped),
+		})
+	}, nil)
+	if err != nil {
+		return errors.Annotate(err, "failed to record run of %q", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// History implements selfdriven.Store.
+func (Store) History(ctx context.Context, id string, limit int) ([]*selfdriven.Run, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	q := ds.NewQuery("cron.Run").Ancestor(handlerKey(ctx, id)).Limit(int32(limit))
+	var ents []*runEntity
+	if err := ds.GetAll(ctx, q, &ents); err != nil {
+		return nil, errors.Annotate(err, "failed to fetch history of %q", id).Tag(transient.Tag).Err()
+	}
+	runs := make([]*selfdriven.Run, len(ents))
+	for i, ent := range ents {
+		runs[i] = &selfdriven.Run{
+			ID:        id,
+			Scheduled: ent.Scheduled,
+			Started:   ent.Started,
+			Duration:  time.Duration(ent.Duration),
+			Result:    ent.Result,
+			Error:     ent.Error,
+			Replica:   ent.Replica,
+			Skipped:   int(ent.Skipped),
+		}
+	}
+	return runs, nil
+}
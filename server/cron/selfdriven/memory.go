@@ -0,0 +1,90 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfdriven
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+)
+
+// MemoryStore is a Store that keeps everything in memory.
+//
+// Useful in tests and when running locally with a single replica.
+type MemoryStore struct {
+	m      sync.Mutex
+	leader string
+	expiry time.Time
+	runs   map[string][]*Run // most recent last
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// Elect implements Store.
+func (s *MemoryStore) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	now := clock.Now(ctx)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.leader != replica && s.leader != "" && now.Before(s.expiry) {
+		return false, nil
+	}
+	s.leader = replica
+	s.expiry = now.Add(ttl)
+	return true, nil
+}
+
+// Resign implements Store.
+func (s *MemoryStore) Resign(ctx context.Context, replica string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.leader == replica {
+		s.leader = ""
+		s.expiry = time.Time{}
+	}
+	return nil
+}
+
+// RecordRun implements Store.
+func (s *MemoryStore) RecordRun(ctx context.Context, r *Run) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.runs == nil {
+		s.runs = make(map[string][]*Run, 1)
+	}
+	cpy := *r
+	runs := append(s.runs[r.ID], &cpy)
+	if len(runs) > MaxHistory {
+		runs = append([]*Run(nil), runs[len(runs)-MaxHistory:]...)
+	}
+	s.runs[r.ID] = runs
+	return nil
+}
+
+// History implements Store.
+func (s *MemoryStore) History(ctx context.Context, id string, limit int) ([]*Run, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	runs := s.runs[id]
+	out := make([]*Run, 0, min(limit, len(runs)))
+	for i := len(runs) - 1; i >= 0 && len(out) < limit; i-- {
+		cpy := *runs[i]
+		out = append(out, &cpy)
+	}
+	return out, nil
+}
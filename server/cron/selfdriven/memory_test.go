@@ -0,0 +1,36 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfdriven_test
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+	"go.chromium.org/luci/server/cron/selfdriven/storetest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	Convey(`MemoryStore`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		storetest.TestStore(ctx, tc, &selfdriven.MemoryStore{})
+	})
+}
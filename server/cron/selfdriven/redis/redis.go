@@ -0,0 +1,168 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis contains a Redis-based store for the self-driven server/cron
+// mode.
+//
+// Importing this package registers "redis" store kind, which uses the Redis
+// connection pool configured by server/redisconn module:
+//
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/redis"
+//
+// All keys are prefixed with "cron:".
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+func init() {
+	selfdriven.Register(selfdriven.Impl{
+		Kind:   "redis",
+		Module: redisconn.ModuleName,
+		New: func(ctx context.Context) (selfdriven.Store, error) {
+			pool := redisconn.GetPool(ctx)
+			if pool == nil {
+				return nil, redisconn.ErrNotConfigured
+			}
+			return NewStore(pool, "cron"), nil
+		},
+	})
+}
+
+// Store is a selfdriven.Store that uses Redis.
+//
+// The leader is in a hash "<prefix>:leader" with its expiration time (as seen
+// by the replicas, not by Redis). Runs of each handler are JSON-encoded in
+// a list "<prefix>:runs:<id>", most recent first.
+type Store struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+var _ selfdriven.Store = (*Store)(nil)
+
+// NewStore returns a store that uses the given pool and key prefix.
+func NewStore(pool *redis.Pool, prefix string) *Store {
+	return &Store{pool: pool, prefix: prefix}
+}
+
+var (
+	// KEYS: leader.
+	// ARGV: replica, now, ttl.
+	electScript = redis.NewScript(1, `
+		local holder = redis.call('HGET', KEYS[1], 'holder')
+		local expiry = tonumber(redis.call('HGET', KEYS[1], 'expiry') or '0')
+		if holder and holder ~= ARGV[1] and expiry > tonumber(ARGV[2]) then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], 'holder', ARGV[1], 'expiry', tonumber(ARGV[2]) + tonumber(ARGV[3]))
+		redis.call('PEXPIRE', KEYS[1], ARGV[3])
+		return 1
+	`)
+
+	// KEYS: leader.
+	// ARGV: replica.
+	resignScript = redis.NewScript(1, `
+		if redis.call('HGET', KEYS[1], 'holder') == ARGV[1] then
+			redis.call('DEL', KEYS[1])
+		end
+		return 1
+	`)
+)
+
+// Elect implements selfdriven.Store.
+func (s *Store) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	leader, err := redis.Bool(s.do(ctx, electScript, s.leaderKey(), replica, clock.Now(ctx).UnixMilli(), ttl.Milliseconds()))
+	if err != nil {
+		return false, errors.Annotate(err, "failed to elect the leader").Tag(transient.Tag).Err()
+	}
+	return leader, nil
+}
+
+// Resign implements selfdriven.Store.
+func (s *Store) Resign(ctx context.Context, replica string) error {
+	if _, err := s.do(ctx, resignScript, s.leaderKey(), replica); err != nil {
+		return errors.Annotate(err, "failed to resign").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// RecordRun implements selfdriven.Store.
+func (s *Store) RecordRun(ctx context.Context, r *selfdriven.Run) error {
+	blob, err := json.Marshal(r)
+	if err != nil {
+		return errors.Annotate(err, "failed to marshal the run").Err()
+	}
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return errors.Annotate(err, "failed to get Redis connection").Tag(transient.Tag).Err()
+	}
+	defer conn.Close()
+	conn.Send("MULTI")
+	conn.Send("LPUSH", s.runsKey(r.ID), blob)
+	conn.Send("LTRIM", s.runsKey(r.ID), 0, selfdriven.MaxHistory-1)
+	if _, err := conn.Do("EXEC"); err != nil {
+		return errors.Annotate(err, "failed to record run of %q", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// History implements selfdriven.Store.
+func (s *Store) History(ctx context.Context, id string, limit int) ([]*selfdriven.Run, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get Redis connection").Tag(transient.Tag).Err()
+	}
+	defer conn.Close()
+	blobs, err := redis.ByteSlices(conn.Do("LRANGE", s.runsKey(id), 0, limit-1))
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch history of %q", id).Tag(transient.Tag).Err()
+	}
+	runs := make([]*selfdriven.Run, len(blobs))
+	for i, blob := range blobs {
+		runs[i] = &selfdriven.Run{}
+		if err := json.Unmarshal(blob, runs[i]); err != nil {
+			return nil, errors.Annotate(err, "failed to unmarshal a run of %q", id).Err()
+		}
+	}
+	return runs, nil
+}
+
+// do runs a script using a connection from the pool.
+func (s *Store) do(ctx context.Context, script *redis.Script, args ...any) (any, error) {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return script.Do(conn, args...)
+}
+
+func (s *Store) leaderKey() string        { return s.prefix + ":leader" }
+func (s *Store) runsKey(id string) string { return s.prefix + ":runs:" + id }
@@ -0,0 +1,33 @@
+-- Copyright 2024 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--------------------------------------------------------------------------------
+-- This script initializes Spanner tables required by the self-driven cron store.
+CREATE TABLE CronLeaders (
+    Name STRING(MAX) NOT NULL,
+    Holder STRING(MAX) NOT NULL,
+    Expiry TIMESTAMP NOT NULL,
+) PRIMARY KEY (Name);
+
+CREATE TABLE CronRuns (
+    HandlerID STRING(MAX) NOT NULL,
+    Scheduled TIMESTAMP NOT NULL,
+    Started TIMESTAMP,
+    Duration INT64 NOT NULL,
+    Result STRING(MAX) NOT NULL,
+    Error STRING(MAX),
+    Replica STRING(MAX) NOT NULL,
+    Skipped INT64 NOT NULL,
+) PRIMARY KEY (HandlerID, Scheduled DESC);
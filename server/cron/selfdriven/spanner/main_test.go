@@ -0,0 +1,63 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/spantest"
+)
+
+func TestMain(m *testing.M) {
+	spantest.SpannerTestMain(m, findInitScript)
+}
+
+// findInitScript returns path //server/cron/selfdriven/spanner/init_db.sql.
+func findInitScript() (string, error) {
+	ancestor, err := filepath.Abs(".")
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		scriptPath := filepath.Join(ancestor, "init_db.sql")
+		_, err := os.Stat(scriptPath)
+		if os.IsNotExist(err) {
+			parent := filepath.Dir(ancestor)
+			if parent == ancestor {
+				return "", errors.Reason("init_db.sql not found").Err()
+			}
+			ancestor = parent
+			continue
+		}
+
+		return scriptPath, err
+	}
+}
+
+// cleanupDatabase deletes all data from all tables.
+func cleanupDatabase(ctx context.Context, client *spanner.Client) error {
+	_, err := client.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete(leadersTable, spanner.AllKeys()),
+		spanner.Delete(runsTable, spanner.AllKeys()),
+	})
+	return err
+}
@@ -0,0 +1,225 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanner contains a Cloud Spanner-based store for the self-driven
+// server/cron mode.
+//
+// Importing this package registers "spanner" store kind, which uses the Spanner
+// client configured by server/span module:
+//
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/spanner"
+//
+// The database must have the following tables:
+//
+//	CREATE TABLE CronLeaders (
+//	  Name STRING(MAX) NOT NULL,
+//	  Holder STRING(MAX) NOT NULL,
+//	  Expiry TIMESTAMP NOT NULL,
+//	) PRIMARY KEY (Name);
+//
+//	CREATE TABLE CronRuns (
+//	  HandlerID STRING(MAX) NOT NULL,
+//	  Scheduled TIMESTAMP NOT NULL,
+//	  Started TIMESTAMP,
+//	  Duration INT64 NOT NULL,
+//	  Result STRING(MAX) NOT NULL,
+//	  Error STRING(MAX),
+//	  Replica STRING(MAX) NOT NULL,
+//	  Skipped INT64 NOT NULL,
+//	) PRIMARY KEY (HandlerID, Scheduled DESC);
+package spanner
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+	"go.chromium.org/luci/server/span"
+)
+
+// Names of tables that user must create in their Spanner database prior to
+// using this package.
+//
+// If you ever need to change them, change also the package doc and init_db.sql.
+const (
+	leadersTable = "CronLeaders"
+	runsTable    = "CronRuns"
+)
+
+// leaderName is the key of the row in CronLeaders table.
+const leaderName = "cron"
+
+func init() {
+	selfdriven.Register(selfdriven.Impl{
+		Kind:   "spanner",
+		Module: span.ModuleName,
+		New: func(ctx context.Context) (selfdriven.Store, error) {
+			return Store{}, nil
+		},
+	})
+}
+
+// Store is a selfdriven.Store that uses Cloud Spanner.
+//
+// Uses the Spanner client in the context (see server/span).
+type Store struct{}
+
+var _ selfdriven.Store = Store{}
+
+// Elect implements selfdriven.Store.
+func (Store) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	now := clock.Now(ctx)
+	leader := false
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		leader = false
+		row, err := span.ReadRow(ctx, leadersTable, spanner.Key{leaderName}, []string{"Holder", "Expiry"})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			var holder string
+			var expiry time.Time
+			if err := row.Columns(&holder, &expiry); err != nil {
+				return err
+			}
+			if holder != replica && expiry.After(now) {
+				return nil
+			}
+		}
+		leader = true
+		span.BufferWrite(ctx, spanner.InsertOrUpdateMap(leadersTable, map[string]any{
+			"Name":   leaderName,
+			"Holder": replica,
+			"Expiry": now.Add(ttl),
+		}))
+		return nil
+	})
+	if err != nil {
+		return false, errors.Annotate(err, "failed to elect the leader").Tag(transient.Tag).Err()
+	}
+	return leader, nil
+}
+
+// Resign implements selfdriven.Store.
+func (Store) Resign(ctx context.Context, replica string) error {
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		row, err := span.ReadRow(ctx, leadersTable, spanner.Key{leaderName}, []string{"Holder"})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+			return nil
+		case err != nil:
+			return err
+		}
+		var holder string
+		if err := row.Columns(&holder); err != nil {
+			return err
+		}
+		if holder == replica {
+			span.BufferWrite(ctx, spanner.Delete(leadersTable, spanner.Key{leaderName}))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to resign").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// RecordRun implements selfdriven.Store.
+func (Store) RecordRun(ctx context.Context, r *selfdriven.Run) error {
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		// Find the oldest run to keep, given that `r` is going to be the most
+		// recent one.
+		st := spanner.NewStatement(`
+			SELECT Scheduled
+			FROM CronRuns
+			WHERE HandlerID = @id
+			ORDER BY Scheduled DESC
+			LIMIT 1 OFFSET @offset
+		`)
+		st.Params["id"] = r.ID
+		st.Params["offset"] = selfdriven.MaxHistory - 1
+		var cutoff spanner.NullTime
+		err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+			return row.Columns(&cutoff)
+		})
+		if err != nil {
+			return err
+		}
+		if cutoff.Valid {
+			span.BufferWrite(ctx, spanner.Delete(runsTable, spanner.KeyRange{
+				Start: spanner.Key{r.ID, cutoff.Time},
+				End:   spanner.Key{r.ID},
+				Kind:  spanner.ClosedClosed,
+			}))
+		}
+		span.BufferWrite(ctx, spanner.InsertOrUpdateMap(runsTable, map[string]any{
+			"HandlerID": r.ID,
+			"Scheduled": r.Scheduled,
+			"Started":   spanner.NullTime{Time: r.Started, Valid: !r.Started.IsZero()},
+			"Duration":  int64(r.Duration),
+			"Result":    r.Result,
+			"Error":     spanner.NullString{StringVal: r.Error, Valid: r.Error != ""},
+			"Replica":   r.Replica,
+			"Skipped":   int64(r.Skipped),
+		}))
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to record run of %q", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// History implements selfdriven.Store.
+func (Store) History(ctx context.Context, id string, limit int) ([]*selfdriven.Run, error) {
+	st := spanner.NewStatement(`
+		SELECT Scheduled, Started, Duration, Result, Error, Replica, Skipped
+		FROM CronRuns
+		WHERE HandlerID = @id
+		ORDER BY Scheduled DESC
+		LIMIT @limit
+	`)
+	st.Params["id"] = id
+	st.Params["limit"] = limit
+
+	var runs []*selfdriven.Run
+	err := span.Query(span.Single(ctx), st).Do(func(row *spanner.Row) error {
+		var started spanner.NullTime
+		var errMsg spanner.NullString
+		var dur, skipped int64
+		r := &selfdriven.Run{ID: id}
+		if err := row.Columns(&r.Scheduled, &started, &dur, &r.Result, &errMsg, &r.Replica, &skipped); err != nil {
+			return err
+		}
+		r.Started = started.Time
+		r.Duration = time.Duration(dur)
+		r.Error = errMsg.StringVal
+		r.Skipped = int(skipped)
+		runs = append(runs, r)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch history of %q", id).Tag(transient.Tag).Err()
+	}
+	return runs, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfdriven defines storage for the self-driven server/cron mode.
+//
+// In the self-driven mode server replicas elect a leader among themselves and
+// the leader invokes cron handlers according to their schedules, instead of
+// relying on Cloud Scheduler calling them. It allows to use server/cron in
+// environments without Cloud Scheduler, e.g. on-prem or in Kubernetes clusters.
+//
+// This package defines the Store interface (used for leader election and to
+// keep track of runs) and a registry of its implementations. The actual
+// implementations live in subpackages and are normally imported unnamed:
+//
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/datastore"
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/redis"
+//	import _ "go.chromium.org/luci/server/cron/selfdriven/spanner"
+//
+// The store to use is then picked via -cron-self-driven-store server flag. See
+// server/cron ModuleOptions for details.
+package selfdriven
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/module"
+)
+
+// MaxHistory is how many most recent runs of each handler stores keep.
+const MaxHistory = 100
+
+// Possible values of Run.Result.
+const (
+	ResultOK        = "OK"        // the handler succeeded
+	ResultTransient = "transient" // the handler failed with a transient error
+	ResultFatal     = "fatal"     // the handler failed with a fatal error
+	ResultPanic     = "panic"     // the handler panicked
+	ResultSkipped   = "skipped"   // the run was missed and skipped per policy
+)
+
+// Run is a single run (or a series of skipped runs) of a cron handler.
+type Run struct {
+	// ID is the cron handler ID.
+	ID string
+
+	// Scheduled is the time the run was scheduled at per the handler schedule.
+	//
+	// If multiple runs were skipped, this is the time of the most recent one.
+	Scheduled time.Time
+
+	// Started is when the handler was invoked. Zero if the run was skipped.
+	Started time.Time
+
+	// Duration is how long the handler ran.
+	Duration time.Duration
+
+	// Result is one of Result... constants.
+	Result string
+
+	// Error is the error message if the handler failed.
+	Error string
+
+	// Replica identifies the replica that did the run.
+	Replica string
+
+	// Skipped is how many runs were skipped if Result is ResultSkipped.
+	Skipped int
+}
+
+// Store is used for leader election and to keep track of runs.
+//
+// All methods must be goroutine-safe. Errors that can be retried must be
+// tagged with transient.Tag.
+type Store interface {
+	// Elect tries to acquire or renew the leadership for `replica` for `ttl`.
+	//
+	// Returns true if `replica` is the leader now (and will stay the leader
+	// at least for `ttl`, unless it resigns).
+	Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error)
+
+	// Resign gives up the leadership if `replica` is the leader.
+	Resign(ctx context.Context, replica string) error
+
+	// RecordRun records a run.
+	//
+	// Runs of the same handler are recorded in order of their Scheduled time.
+	// Stores keep only MaxHistory most recent runs of each handler.
+	RecordRun(ctx context.Context, r *Run) error
+
+	// History returns up to `limit` most recent runs of a handler, most recent
+	// first.
+	History(ctx context.Context, id string, limit int) ([]*Run, error)
+}
+
+// LastRun returns the most recently recorded run of a handler or nil if it
+// has never run.
+func LastRun(ctx context.Context, s Store, id string) (*Run, error) {
+	runs, err := s.History(ctx, id, 1)
+	if err != nil || len(runs) == 0 {
+		return nil, err
+	}
+	return runs[0], nil
+}
+
+// NewReplicaID returns a random ID to identify this process in Elect.
+//
+// It includes the hostname to make it easier to find the leader.
+func NewReplicaID() string {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%s", host, hex.EncodeToString(buf[:]))
+}
+
+// Impl knows how to instantiate Store instances.
+type Impl struct {
+	// Kind identifies this particular Store implementation.
+	//
+	// This is what is passed via -cron-self-driven-store flag.
+	Kind string
+
+	// Module is name of the server module the store depends on, if any.
+	Module module.Name
+
+	// New returns a Store.
+	//
+	// Receives the server context, with all modules initialized.
+	New func(ctx context.Context) (Store, error)
+}
+
+var impls []Impl
+
+// Register registers a Store implementation.
+//
+// Must be called during init() time.
+func Register(impl Impl) {
+	if impl.Kind == "" {
+		panic("Kind must not be empty")
+	}
+	for _, existing := range impls {
+		if existing.Kind == impl.Kind {
+			panic(fmt.Sprintf("store %q is already registered", impl.Kind))
+		}
+	}
+	impls = append(impls, impl)
+}
+
+// VisitImpls calls the callback for all registered implementations.
+func VisitImpls(cb func(impl *Impl)) {
+	for i := range impls {
+		cb(&impls[i])
+	}
+}
+
+// New instantiates a store given its kind.
+func New(ctx context.Context, kind string) (Store, error) {
+	for _, impl := range impls {
+		if impl.Kind == kind {
+			return impl.New(ctx)
+		}
+	}
+	return nil, errors.Reason("no self-driven cron store %q is registered in the process", kind).Err()
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storetest contains tests shared by selfdriven.Store implementations.
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// TestStore runs a conformance test suite against a store.
+//
+// Must be called within a Convey block. The store must be empty. The context
+// must have the given test clock installed.
+func TestStore(ctx context.Context, tc testclock.TestClock, s selfdriven.Store) {
+	elect := func(replica string) bool {
+		ok, err := s.Elect(ctx, replica, time.Minute)
+		So(err, ShouldBeNil)
+		return ok
+	}
+
+	Convey(`Leader election`, func() {
+		So(elect("a"), ShouldBeTrue)
+		So(elect("b"), ShouldBeFalse)
+
+		// Renewals extend the leadership.
+		tc.Add(50 * time.Second)
+		So(elect("a"), ShouldBeTrue)
+		tc.Add(50 * time.Second)
+		So(elect("b"), ShouldBeFalse)
+
+		// The leadership expires if not renewed.
+		tc.Add(11 * time.Second)
+		So(elect("b"), ShouldBeTrue)
+		So(elect("a"), ShouldBeFalse)
+
+		// Resigning by non-leaders is noop.
+		So(s.Resign(ctx, "a"), ShouldBeNil)
+		So(elect("a"), ShouldBeFalse)
+
+		// Resigning by the leader lets others take over.
+		So(s.Resign(ctx, "b"), ShouldBeNil)
+		So(elect("a"), ShouldBeTrue)
+	})
+
+	Convey(`Run history`, func() {
+		last, err := selfdriven.LastRun(ctx, s, "h1")
+		So(err, ShouldBeNil)
+		So(last, ShouldBeNil)
+
+		base := tc.Now().Truncate(time.Second)
+		run := func(id string, i int) *selfdriven.Run {
+			return &selfdriven.Run{
+				ID:        id,
+				Scheduled: base.Add(time.Duration(i) * time.Minute),
+				Started:   base.Add(time.Duration(i)*time.Minute + time.Second),
+				Duration:  time.Duration(i) * time.Millisecond,
+				Result:    selfdriven.ResultFatal,
+				Error:     fmt.Sprintf("error %d", i),
+				Replica:   "replica",
+			}
+		}
+
+		skipped := &selfdriven.Run{
+			ID:        "h1",
+			Scheduled: base,
+			Result:    selfdriven.ResultSkipped,
+			Replica:   "replica",
+			Skipped:   5,
+		}
+		So(s.RecordRun(ctx, skipped), ShouldBeNil)
+		for i := 1; i <= selfdriven.MaxHistory+10; i++ {
+			So(s.RecordRun(ctx, run("h1", i)), ShouldBeNil)
+		}
+		So(s.RecordRun(ctx, run("h2", 1)), ShouldBeNil)
+
+		last, err = selfdriven.LastRun(ctx, s, "h1")
+		So(err, ShouldBeNil)
+		So(last, ShouldResemble, run("h1", selfdriven.MaxHistory+10))
+
+		hist, err := s.History(ctx, "h1", 3)
+		So(err, ShouldBeNil)
+		So(hist, ShouldResemble, []*selfdriven.Run{
+			run("h1", selfdriven.MaxHistory+10),
+			run("h1", selfdriven.MaxHistory+9),
+			run("h1", selfdriven.MaxHistory+8),
+		})
+
+		// Old runs are forgotten.
+		hist, err = s.History(ctx, "h1", 1000)
+		So(err, ShouldBeNil)
+		So(hist, ShouldHaveLength, selfdriven.MaxHistory)
+		So(hist[len(hist)-1], ShouldResemble, run("h1", 11))
+
+		hist, err = s.History(ctx, "h2", 1000)
+		So(err, ShouldBeNil)
+		So(hist, ShouldResemble, []*selfdriven.Run{run("h2", 1)})
+	})
+
+	Convey(`Skipped runs`, func() {
+		skipped := &selfdriven.Run{
+			ID:        "h1",
+			Scheduled: tc.Now().Truncate(time.Second),
+			Result:    selfdriven.ResultSkipped,
+			Replica:   "replica",
+			Skipped:   5,
+		}
+		So(s.RecordRun(ctx, skipped), ShouldBeNil)
+		last, err := selfdriven.LastRun(ctx, s, "h1")
+		So(err, ShouldBeNil)
+		So(last, ShouldResemble, skipped)
+	})
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon"
+
+	"go.chromium.org/luci/server/cron/selfdriven"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	Convey("due", t, func() {
+		d := &Dispatcher{}
+		base := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		at := func(min, sec int) time.Time {
+			return base.Add(time.Duration(min)*time.Minute + time.Duration(sec)*time.Second)
+		}
+		due := func(s Schedule, now time.Time) ([]time.Time, int, time.Time) {
+			d.RegisterSchedule("id", s)
+			return d.schedules()["id"].due(base, now, 20*time.Second)
+		}
+
+		Convey("Nothing due", func() {
+			runs, skipped, _ := due(Schedule{Cron: "*/5 * * * *"}, at(4, 0))
+			So(runs, ShouldBeEmpty)
+			So(skipped, ShouldEqual, 0)
+		})
+
+		Convey("RunOnce", func() {
+			runs, skipped, lastSkipped := due(Schedule{Cron: "*/5 * * * *"}, at(12, 0))
+			So(runs, ShouldResemble, []time.Time{at(10, 0)})
+			So(skipped, ShouldEqual, 1)
+			So(lastSkipped, ShouldEqual, at(5, 0))
+		})
+
+		Convey("RunAll", func() {
+			runs, skipped, _ := due(Schedule{Cron: "*/5 * * * *", MissedRuns: RunAll}, at(12, 0))
+			So(runs, ShouldResemble, []time.Time{at(5, 0), at(10, 0)})
+			So(skipped, ShouldEqual, 0)
+		})
+
+		Convey("RunAll with MaxCatchUp", func() {
+			runs, skipped, lastSkipped := due(Schedule{Cron: "* * * * *", MissedRuns: RunAll, MaxCatchUp: 2}, at(5, 30))
+			So(runs, ShouldResemble, []time.Time{at(4, 0), at(5, 0)})
+			So(skipped, ShouldEqual, 3)
+			So(lastSkipped, ShouldEqual, at(3, 0))
+		})
+
+		Convey("SkipMissed on time", func() {
+			runs, skipped, lastSkipped := due(Schedule{Cron: "*/5 * * * *", MissedRuns: SkipMissed}, at(10, 5))
+			So(runs, ShouldResemble, []time.Time{at(10, 0)})
+			So(skipped, ShouldEqual, 1)
+			So(lastSkipped, ShouldEqual, at(5, 0))
+		})
+
+		Convey("SkipMissed late", func() {
+			runs, skipped, lastSkipped := due(Schedule{Cron: "*/5 * * * *", MissedRuns: SkipMissed}, at(12, 0))
+			So(runs, ShouldBeEmpty)
+			So(skipped, ShouldEqual, 2)
+			So(lastSkipped, ShouldEqual, at(10, 0))
+		})
+
+		Convey("Bad schedule", func() {
+			So(func() { d.RegisterSchedule("id", Schedule{Cron: "huh"}) }, ShouldPanic)
+		})
+	})
+}
+
+func TestSelfDrivenRunner(t *testing.T) {
+	t.Parallel()
+
+	Convey("With runner", t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		ctx, tc := testclock.UseTime(ctx, time.Date(2024, time.January, 1, 0, 0, 30, 0, time.UTC))
+
+		d := &Dispatcher{}
+		store := &selfdriven.MemoryStore{}
+
+		var m sync.Mutex
+		var calls []string
+		handler := func(id string, err error) Handler {
+			return func(ctx context.Context) error {
+				m.Lock()
+				calls = append(calls, id)
+				m.Unlock()
+				return err
+			}
+		}
+		d.RegisterHandler("ok", handler("ok", nil))
+		d.RegisterHandler("fail", handler("fail", errors.New("boom")))
+		d.RegisterHandler("panic", func(ctx context.Context) error { panic("boom") })
+		d.RegisterSchedule("ok", Schedule{Cron: "* * * * *"})
+		d.RegisterSchedule("fail", Schedule{Cron: "*/2 * * * *"})
+		d.RegisterSchedule("panic", Schedule{Cron: "@hourly"})
+		d.RegisterSchedule("missing", Schedule{Cron: "* * * * *"})
+
+		r := &SelfDrivenRunner{
+			Dispatcher:    d,
+			Store:         store,
+			Replica:       "replica",
+			LeaseDuration: time.Minute,
+			PollInterval:  10 * time.Second,
+		}
+
+		history := func(id string) (out []string) {
+			runs, err := store.History(ctx, id, 100)
+			So(err, ShouldBeNil)
+			for _, r := range runs {
+				out = append(out, r.Scheduled.Format("15:04")+" "+r.Result)
+			}
+			return
+		}
+
+		Convey("Run loop", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			// Waits for all launched handlers to finish.
+			waitIdle := func() {
+				for {
+					r.m.Lock()
+					idle := len(r.running) == 0
+					r.m.Unlock()
+					if idle {
+						return
+					}
+					time.Sleep(time.Millisecond)
+				}
+			}
+
+			// Poll for 3 min and then stop.
+			polls := 0
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				if testclock.HasTags(t, "cron-poll") {
+					waitIdle()
+					if polls++; polls == 18 {
+						cancel()
+					} else {
+						tc.Add(d)
+					}
+				}
+			})
+			r.Run(ctx)
+
+			So(history("ok"), ShouldResemble, []string{"00:03 OK", "00:02 OK", "00:01 OK"})
+			So(history("fail"), ShouldResemble, []string{"00:02 fatal"})
+			So(history("missing"), ShouldResemble, []string{"00:03 fatal", "00:02 fatal", "00:01 fatal"})
+			So(history("panic"), ShouldBeEmpty)
+
+			runs, _ := store.History(ctx, "fail", 1)
+			So(runs[0].Error, ShouldEqual, "boom")
+			So(runs[0].Replica, ShouldEqual, "replica")
+
+			// Resigned when stopped.
+			So(r.IsLeader(), ShouldBeFalse)
+			leader, err := store.Elect(ctx, "another", time.Minute)
+			So(err, ShouldBeNil)
+			So(leader, ShouldBeTrue)
+		})
+
+		Convey("Not the leader", func() {
+			leader, err := store.Elect(ctx, "another", time.Hour)
+			So(err, ShouldBeNil)
+			So(leader, ShouldBeTrue)
+
+			So(r.elect(ctx), ShouldBeFalse)
+			tc.Add(time.Minute)
+			So(r.elect(ctx), ShouldBeFalse)
+			So(history("ok"), ShouldBeEmpty)
+		})
+
+		Convey("Catches up", func() {
+			step := func() {
+				So(r.elect(ctx), ShouldBeTrue)
+				wg := sync.WaitGroup{}
+				r.launchDue(ctx, &wg)
+				wg.Wait()
+			}
+
+			// Establishes the baseline.
+			step()
+			tc.Add(30 * time.Second)
+			step()
+			So(history("ok"), ShouldResemble, []string{"00:01 OK"})
+
+			// Simulate the downtime.
+			tc.Add(2*time.Hour + 5*time.Minute)
+			step()
+			So(history("ok"), ShouldResemble, []string{"02:06 OK", "02:05 skipped", "00:01 OK"})
+			So(history("panic"), ShouldResemble, []string{"02:00 panic", "01:00 skipped"})
+
+			runs, _ := store.History(ctx, "ok", 2)
+			So(runs[1].Skipped, ShouldEqual, 124)
+			So(missedCounter.Get(ctx, "ok"), ShouldEqual, 124)
+		})
+	})
+}
@@ -111,6 +111,15 @@
 //	  _ "go.chromium.org/luci/server/encryptedcookies/session/datastore"
 //	)
 //
+// Other available implementations are Cloud Spanner (".../session/spanner",
+// requires server/span module) and Redis (".../session/redis", requires
+// server/redisconn module). If more than one implementation is linked, pick
+// one via `-encrypted-cookies-session-store-kind` flag.
+//
+// Existing sessions can be copied from Cloud Datastore into Cloud Spanner or
+// Redis by https://go.chromium.org/luci/server/cmd/encryptedcookies-session-migrate
+// tool when migrating off Cloud Datastore.
+//
 // # Inactive sessions cleanup
 //
 // When using Cloud Datastore as a session storage, configure a time-to-live
@@ -118,6 +127,10 @@
 // field. See https://cloud.google.com/datastore/docs/ttl. This step is usually
 // done via Terraform.
 //
+// When using Cloud Spanner, the table must have a row deletion policy based on
+// `ExpireAt` column (see the spanner package doc for the schema). When using
+// Redis, sessions are stored as expiring keys and no extra setup is needed.
+//
 // A session is considered expired if it wasn't accessed for more than 14 days.
 //
 // # Exposed routes
@@ -0,0 +1,126 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storetest contains tests shared by session.Store implementations.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// TestStore runs a conformance test suite against the given store.
+//
+// Must be called from within a Convey block.
+func TestStore(ctx context.Context, store session.Store) {
+	Convey("Missing session", func() {
+		s, err := store.FetchSession(ctx, session.GenerateID())
+		So(err, ShouldBeNil)
+		So(s, ShouldBeNil)
+	})
+
+	Convey("Create and update", func() {
+		id := session.GenerateID()
+		now := clock.Now(ctx).UTC().Truncate(time.Microsecond)
+
+		err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+			So(s, ShouldResembleProto, &sessionpb.Session{})
+			s.State = sessionpb.State_STATE_OPEN
+			s.Email = "abc@example.com"
+			s.LastRefresh = timestamppb.New(now)
+			return nil
+		})
+		So(err, ShouldBeNil)
+
+		s, err := store.FetchSession(ctx, id)
+		So(err, ShouldBeNil)
+		So(s, ShouldResembleProto, &sessionpb.Session{
+			State:       sessionpb.State_STATE_OPEN,
+			Email:       "abc@example.com",
+			LastRefresh: timestamppb.New(now),
+		})
+
+		err = store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+			So(s.Email, ShouldEqual, "abc@example.com")
+			s.State = sessionpb.State_STATE_CLOSED
+			s.Closed = timestamppb.New(now)
+			return nil
+		})
+		So(err, ShouldBeNil)
+
+		s, err = store.FetchSession(ctx, id)
+		So(err, ShouldBeNil)
+		So(s.State, ShouldEqual, sessionpb.State_STATE_CLOSED)
+		So(s.Closed, ShouldResembleProto, timestamppb.New(now))
+	})
+
+	Convey("Callback errors are returned as is", func() {
+		id := session.GenerateID()
+		cbErr := errors.New("boom")
+		err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+			s.Email = "abc@example.com"
+			return cbErr
+		})
+		So(err, ShouldEqual, cbErr)
+
+		s, err := store.FetchSession(ctx, id)
+		So(err, ShouldBeNil)
+		So(s, ShouldBeNil)
+	})
+
+	Convey("Updates are transactional", func() {
+		id := session.GenerateID()
+
+		const workers = 10
+		errs := make([]error, workers)
+		wg := sync.WaitGroup{}
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+					s.Sub += "x"
+					return nil
+				})
+			}()
+		}
+		wg.Wait()
+
+		// Updates are allowed to fail due to contention, but all successful ones
+		// must be accounted for.
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			}
+		}
+		So(succeeded, ShouldBeGreaterThan, 0)
+
+		s, err := store.FetchSession(ctx, id)
+		So(err, ShouldBeNil)
+		So(s.Sub, ShouldHaveLength, succeeded)
+	})
+}
@@ -22,7 +22,6 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/gae/service/info"
@@ -39,7 +38,7 @@ import (
 //
 // It defines how long to keep inactive session in the datastore before they
 // are cleaned up by a TTL policy.
-const InactiveSessionExpiration time.Duration = 14 * 24 * time.Hour
+const InactiveSessionExpiration = session.InactiveSessionExpiration
 
 // Store uses Cloud Datastore for sessions.
 type Store struct {
@@ -102,14 +101,7 @@ func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessi
 		if cbErr = cb(mutable); cbErr != nil {
 			return cbErr
 		}
-		var lastRefresh time.Time
-		if mutable.LastRefresh != nil {
-			lastRefresh = mutable.LastRefresh.AsTime()
-		} else {
-			lastRefresh = clock.Now(ctx).UTC()
-		}
-		exp := lastRefresh.Add(InactiveSessionExpiration)
-		return datastore.Put(ctx, makeEntity(id, mutable, exp))
+		return datastore.Put(ctx, makeEntity(id, mutable, session.ExpireAt(ctx, mutable)))
 	}, nil)
 	if err == cbErr {
 		return cbErr // can also be nil on success
@@ -25,6 +25,7 @@ import (
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
 
+	"go.chromium.org/luci/server/encryptedcookies/internal/storetest"
 	"go.chromium.org/luci/server/encryptedcookies/session"
 	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
 
@@ -39,6 +40,10 @@ func TestWorks(t *testing.T) {
 		ctx, _ := testclock.UseTime(context.Background(), testTime)
 		ctx = memory.Use(ctx)
 
+		Convey("Conformance", func() {
+			storetest.TestStore(ctx, &Store{})
+		})
+
 		Convey("Works", func() {
 			store := Store{}
 			id := session.GenerateID()
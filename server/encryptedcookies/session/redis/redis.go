@@ -0,0 +1,190 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements session storage over Redis.
+//
+// Importing this package registers "redis" session store kind, which uses the
+// Redis connection pool configured by server/redisconn module:
+//
+//	import _ "go.chromium.org/luci/server/encryptedcookies/session/redis"
+//
+// Sessions are stored as serialized protos under keys
+// "encryptedcookies:session[:<namespace>]:<session ID>". Inactive sessions are
+// cleaned up by Redis itself via key expiration, see
+// session.InactiveSessionExpiration. Note that it means Redis must not be
+// configured with an eviction policy that can evict sessions prematurely.
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+// maxAttempts is how many times to retry an update on a conflict.
+const maxAttempts = 10
+
+// Store uses Redis for sessions.
+type Store struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+var _ session.Store = (*Store)(nil)
+
+func init() {
+	internal.RegisterStoreImpl(internal.StoreImpl{
+		ID: "redis",
+		Factory: func(ctx context.Context, namespace string) (session.Store, error) {
+			pool := redisconn.GetPool(ctx)
+			if pool == nil {
+				return nil, redisconn.ErrNotConfigured
+			}
+			return NewStore(pool, namespace), nil
+		},
+		Deps: []module.Dependency{
+			module.RequiredDependency(redisconn.ModuleName),
+		},
+	})
+}
+
+// NewStore returns a store that uses the given pool and namespace.
+//
+// The namespace can be empty to use the default one.
+func NewStore(pool *redis.Pool, namespace string) *Store {
+	prefix := "encryptedcookies:session:"
+	if namespace != "" {
+		prefix += namespace + ":"
+	}
+	return &Store{pool: pool, prefix: prefix}
+}
+
+// FetchSession fetches an existing session with the given ID.
+//
+// Returns (nil, nil) if there's no such session. All errors are transient.
+func (s *Store) FetchSession(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	defer conn.Close()
+	sess, err := s.read(ctx, conn, id)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	return sess, nil
+}
+
+// UpdateSession transactionally updates or creates a session.
+//
+// If fetches the session, calls the callback to mutate it, and stores the
+// result. If it is a new session, the callback receives an empty proto.
+//
+// The callback may be called multiple times in case the transaction is
+// retried. Errors from callbacks are returned as is. All other errors are
+// transient.
+func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessionpb.Session) error) error {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return transient.Tag.Apply(err)
+	}
+	defer conn.Close()
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		switch done, err := s.tryUpdate(ctx, conn, id, cb); {
+		case err != nil:
+			return err
+		case done:
+			return nil
+		}
+	}
+	return errors.Reason("too much contention when updating session %s", id).Tag(transient.Tag).Err()
+}
+
+// tryUpdate does one optimistic transaction attempt.
+//
+// Returns false if the transaction was aborted due to a conflict.
+func (s *Store) tryUpdate(ctx context.Context, conn redis.Conn, id session.ID, cb func(*sessionpb.Session) error) (done bool, err error) {
+	key := s.key(id)
+	if _, err := redis.DoContext(conn, ctx, "WATCH", key); err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+	watching := true
+	defer func() {
+		if watching {
+			redis.DoContext(conn, ctx, "UNWATCH")
+		}
+	}()
+
+	mutable, err := s.read(ctx, conn, id)
+	if err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+	if mutable == nil {
+		mutable = &sessionpb.Session{}
+	}
+	if err := cb(mutable); err != nil {
+		return false, err
+	}
+	blob, err := proto.Marshal(mutable)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to marshal the session").Tag(transient.Tag).Err()
+	}
+
+	conn.Send("MULTI")
+	conn.Send("SET", key, blob)
+	conn.Send("PEXPIREAT", key, session.ExpireAt(ctx, mutable).UnixMilli())
+	res, err := redis.DoContext(conn, ctx, "EXEC")
+	watching = false // EXEC unwatches keys
+	switch {
+	case err != nil:
+		return false, transient.Tag.Apply(err)
+	case res == nil:
+		return false, nil // the key was modified concurrently
+	}
+	return true, nil
+}
+
+// read reads a session, returning nil if it is missing.
+func (s *Store) read(ctx context.Context, conn redis.Conn, id session.ID) (*sessionpb.Session, error) {
+	blob, err := redis.Bytes(redis.DoContext(conn, ctx, "GET", s.key(id)))
+	switch {
+	case err == redis.ErrNil:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	sess := &sessionpb.Session{}
+	if err := proto.Unmarshal(blob, sess); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal the session").Err()
+	}
+	return sess, nil
+}
+
+// key is a Redis key with the session.
+func (s *Store) key(id session.ID) string {
+	return s.prefix + base64.RawURLEncoding.EncodeToString(id)
+}
+
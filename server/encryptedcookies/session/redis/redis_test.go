@@ -0,0 +1,89 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal/storetest"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Redis store`, t, func() {
+		now := testclock.TestRecentTimeUTC.Truncate(time.Millisecond)
+		ctx, _ := testclock.UseTime(context.Background(), now)
+
+		s, err := miniredis.Run()
+		So(err, ShouldBeNil)
+		defer s.Close()
+		s.SetTime(now)
+
+		pool := &redis.Pool{
+			Dial: func() (redis.Conn, error) { return redis.Dial("tcp", s.Addr()) },
+		}
+		defer pool.Close()
+
+		storetest.TestStore(ctx, NewStore(pool, ""))
+
+		Convey(`Namespaces`, func() {
+			id := session.GenerateID()
+			err := NewStore(pool, "a").UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.Email = "a@example.com"
+				return nil
+			})
+			So(err, ShouldBeNil)
+
+			sess, err := NewStore(pool, "b").FetchSession(ctx, id)
+			So(err, ShouldBeNil)
+			So(sess, ShouldBeNil)
+		})
+
+		Convey(`Expiration`, func() {
+			store := NewStore(pool, "")
+			id := session.GenerateID()
+
+			So(store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.State = sessionpb.State_STATE_OPEN
+				return nil
+			}), ShouldBeNil)
+			So(s.TTL(store.key(id)), ShouldEqual, session.InactiveSessionExpiration)
+
+			So(store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.LastRefresh = timestamppb.New(now.Add(5 * time.Hour))
+				return nil
+			}), ShouldBeNil)
+			So(s.TTL(store.key(id)), ShouldEqual, 5*time.Hour+session.InactiveSessionExpiration)
+
+			s.FastForward(5*time.Hour + session.InactiveSessionExpiration)
+			sess, err := store.FetchSession(ctx, id)
+			So(err, ShouldBeNil)
+			So(sess, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,32 @@
+-- Copyright 2024 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--------------------------------------------------------------------------------
+-- This script initializes Spanner tables required by the encryptedcookies
+-- session store.
+CREATE TABLE EncryptedCookiesSessions (
+    Namespace STRING(MAX) NOT NULL,
+    ID BYTES(MAX) NOT NULL,
+    Session BYTES(MAX) NOT NULL,
+    State INT64 NOT NULL,
+    Created TIMESTAMP,
+    LastRefresh TIMESTAMP,
+    NextRefresh TIMESTAMP,
+    Closed TIMESTAMP,
+    Sub STRING(MAX),
+    Email STRING(MAX),
+    ExpireAt TIMESTAMP NOT NULL,
+) PRIMARY KEY (Namespace, ID),
+  ROW DELETION POLICY (OLDER_THAN(ExpireAt, INTERVAL 0 DAY));
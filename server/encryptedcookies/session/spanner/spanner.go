@@ -0,0 +1,183 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanner implements session storage over Cloud Spanner.
+//
+// Importing this package registers "spanner" session store kind, which uses
+// the Spanner client configured by server/span module:
+//
+//	import _ "go.chromium.org/luci/server/encryptedcookies/session/spanner"
+//
+// The database must have the following table:
+//
+//	CREATE TABLE EncryptedCookiesSessions (
+//	  Namespace STRING(MAX) NOT NULL,
+//	  ID BYTES(MAX) NOT NULL,
+//	  Session BYTES(MAX) NOT NULL,
+//	  State INT64 NOT NULL,
+//	  Created TIMESTAMP,
+//	  LastRefresh TIMESTAMP,
+//	  NextRefresh TIMESTAMP,
+//	  Closed TIMESTAMP,
+//	  Sub STRING(MAX),
+//	  Email STRING(MAX),
+//	  ExpireAt TIMESTAMP NOT NULL,
+//	) PRIMARY KEY (Namespace, ID),
+//	  ROW DELETION POLICY (OLDER_THAN(ExpireAt, INTERVAL 0 DAY));
+//
+// Inactive sessions are cleaned up by the row deletion policy, see
+// session.InactiveSessionExpiration.
+package spanner
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/span"
+)
+
+// TableName is the name of the table with sessions.
+//
+// If you ever need to change it, change also the package doc and init_db.sql.
+const TableName = "EncryptedCookiesSessions"
+
+// Store uses Cloud Spanner for sessions.
+//
+// Uses the Spanner client in the context (see server/span).
+type Store struct {
+	Namespace string // the namespace to use or "" for default
+}
+
+var _ session.Store = (*Store)(nil)
+
+func init() {
+	internal.RegisterStoreImpl(internal.StoreImpl{
+		ID: "spanner",
+		Factory: func(ctx context.Context, namespace string) (session.Store, error) {
+			return &Store{Namespace: namespace}, nil
+		},
+		Deps: []module.Dependency{
+			module.RequiredDependency(span.ModuleName),
+		},
+	})
+}
+
+// FetchSession fetches an existing session with the given ID.
+//
+// Returns (nil, nil) if there's no such session. All errors are transient.
+func (s *Store) FetchSession(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	sess, err := s.read(span.Single(ctx), id)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	return sess, nil
+}
+
+// UpdateSession transactionally updates or creates a session.
+//
+// If fetches the session, calls the callback to mutate it, and stores the
+// result. If it is a new session, the callback receives an empty proto.
+//
+// The callback may be called multiple times in case the transaction is
+// retried. Errors from callbacks are returned as is. All other errors are
+// transient.
+func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessionpb.Session) error) error {
+	var cbErr error
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		cbErr = nil
+		mutable, err := s.read(ctx, id)
+		if err != nil {
+			return err
+		}
+		if mutable == nil {
+			mutable = &sessionpb.Session{}
+		}
+		if cbErr = cb(mutable); cbErr != nil {
+			return cbErr
+		}
+		m, err := s.mutation(id, mutable, session.ExpireAt(ctx, mutable))
+		if err != nil {
+			return err
+		}
+		span.BufferWrite(ctx, m)
+		return nil
+	})
+	switch {
+	case cbErr != nil:
+		return cbErr // the Spanner client may wrap it, return it as is
+	case err != nil:
+		return transient.Tag.Apply(err)
+	}
+	return nil
+}
+
+// read reads a session, returning nil if it is missing.
+func (s *Store) read(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	row, err := span.ReadRow(ctx, TableName, spanner.Key{s.Namespace, []byte(id)}, []string{"Session"})
+	switch {
+	case spanner.ErrCode(err) == codes.NotFound:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	var blob []byte
+	if err := row.Columns(&blob); err != nil {
+		return nil, err
+	}
+	sess := &sessionpb.Session{}
+	if err := proto.Unmarshal(blob, sess); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal the session").Err()
+	}
+	return sess, nil
+}
+
+// mutation returns a mutation that stores the session.
+func (s *Store) mutation(id session.ID, sess *sessionpb.Session, exp time.Time) (*spanner.Mutation, error) {
+	blob, err := proto.Marshal(sess)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to marshal the session").Err()
+	}
+	return spanner.InsertOrUpdateMap(TableName, map[string]any{
+		"Namespace":   s.Namespace,
+		"ID":          []byte(id),
+		"Session":     blob,
+		"State":       int64(sess.State),
+		"Created":     nullTime(sess.Created),
+		"LastRefresh": nullTime(sess.LastRefresh),
+		"NextRefresh": nullTime(sess.NextRefresh),
+		"Closed":      nullTime(sess.Closed),
+		"Sub":         spanner.NullString{StringVal: sess.Sub, Valid: sess.Sub != ""},
+		"Email":       spanner.NullString{StringVal: sess.Email, Valid: sess.Email != ""},
+		"ExpireAt":    exp,
+	}), nil
+}
+
+func nullTime(t *timestamppb.Timestamp) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: t.AsTime(), Valid: true}
+}
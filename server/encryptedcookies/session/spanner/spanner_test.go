@@ -0,0 +1,80 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/spantest"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal/storetest"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/span"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStore(t *testing.T) {
+	Convey(`Spanner store`, t, func() {
+		ctx := spantest.SpannerTestContext(t, cleanupDatabase)
+		now := clock.Now(ctx).UTC().Truncate(time.Microsecond)
+		ctx, _ = testclock.UseTime(ctx, now)
+
+		storetest.TestStore(ctx, &Store{})
+
+		Convey(`Namespaces`, func() {
+			id := session.GenerateID()
+			err := (&Store{Namespace: "a"}).UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.Email = "a@example.com"
+				return nil
+			})
+			So(err, ShouldBeNil)
+
+			s, err := (&Store{Namespace: "b"}).FetchSession(ctx, id)
+			So(err, ShouldBeNil)
+			So(s, ShouldBeNil)
+		})
+
+		Convey(`ExpireAt`, func() {
+			id := session.GenerateID()
+			expireAt := func() time.Time {
+				row, err := span.ReadRow(span.Single(ctx), TableName, spanner.Key{"", []byte(id)}, []string{"ExpireAt"})
+				So(err, ShouldBeNil)
+				var t time.Time
+				So(row.Columns(&t), ShouldBeNil)
+				return t
+			}
+
+			So((&Store{}).UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.State = sessionpb.State_STATE_OPEN
+				return nil
+			}), ShouldBeNil)
+			So(expireAt(), ShouldEqual, now.Add(session.InactiveSessionExpiration))
+
+			So((&Store{}).UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.LastRefresh = timestamppb.New(now.Add(5 * time.Hour))
+				return nil
+			}), ShouldBeNil)
+			So(expireAt(), ShouldEqual, now.Add(5*time.Hour+session.InactiveSessionExpiration))
+		})
+	})
+}
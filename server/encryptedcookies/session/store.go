@@ -20,10 +20,20 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
 
 	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
 )
 
+// InactiveSessionExpiration defines how long to keep inactive sessions in
+// a store before they are cleaned up.
+//
+// Stores that support TTL-based cleanup use it to derive the expiration time
+// of a session, see ExpireAt.
+const InactiveSessionExpiration time.Duration = 14 * 24 * time.Hour
+
 // ID identifies a session.
 type ID []byte
 
@@ -42,6 +52,20 @@ func GenerateID() ID {
 	return id
 }
 
+// ExpireAt returns when an inactive session can be cleaned up by a store.
+//
+// It is derived from LastRefresh (or the current time if LastRefresh is not
+// populated) based on InactiveSessionExpiration.
+func ExpireAt(ctx context.Context, s *sessionpb.Session) time.Time {
+	var lastRefresh time.Time
+	if s.LastRefresh != nil {
+		lastRefresh = s.LastRefresh.AsTime()
+	} else {
+		lastRefresh = clock.Now(ctx).UTC()
+	}
+	return lastRefresh.Add(InactiveSessionExpiration)
+}
+
 // Store is a persistent transactional-capable storage of user sessions.
 //
 // Session IDs are assumed to be generated by GenerateID, i.e. be high-entropy
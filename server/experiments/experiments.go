@@ -41,6 +41,40 @@
 //   - The server ignores enabled experiments it doesn't know about. It
 //     simplifies adding and removing experiments.
 //   - There's better testing support.
+//
+// # Gradual rollouts
+//
+// Instead of enabling an experiment for everyone at once, it can be rolled out
+// gradually, based on a dynamic configuration that is reloaded without
+// restarting the server. To use it, install the server module (see NewModule)
+// and pass `-experiments-rollout-config` flag pointing either to a key in the
+// server settings ("settings:<key>") or to a JSON file in the service config
+// set ("luci-config:<path>"). The configuration looks like this (see Config):
+//
+//	{
+//	  "rollouts": {
+//	    "my-experiment": {
+//	      "percent": 10,
+//	      "key": "identity",
+//	      "rules": [
+//	        {"projects": ["chromium"], "percent": 50},
+//	        {"groups": ["my-experiment-testers"], "percent": 100}
+//	      ]
+//	    }
+//	  }
+//	}
+//
+// Subjects (callers, LUCI projects or requests, depending on the key) are
+// consistently bucketed by hashing, so the experiment stays enabled or disabled
+// for a particular subject as long as the percentage doesn't change. The caller
+// identity and the request ID are taken from the context. The LUCI project must
+// be set by the server code via WithProject if it is used by the rollouts.
+//
+// Experiments enabled via `-enable-experiment` are enabled for everyone
+// regardless of their rollout configuration.
+//
+// Each evaluation of an experiment is counted in "server/experiments/evaluations"
+// metric, which allows to see how often each arm is taken.
 package experiments
 
 import (
@@ -49,6 +83,9 @@ import (
 	"sync"
 
 	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
 )
 
 // All registered experiments.
@@ -57,6 +94,15 @@ var available struct {
 	exp stringset.Set
 }
 
+var evaluationsMetric = metric.NewCounter(
+	"server/experiments/evaluations",
+	"Number of times an experiment was evaluated, by the outcome.",
+	nil,
+	field.String("experiment"), // the experiment name
+	field.String("arm"),        // "enabled" or "disabled"
+	field.String("reason"),     // "static", "default", "percent", "rule-<N>", ...
+)
+
 // A context.Context key for a set of enabled experiments.
 var ctxKey = "go.chromium.org/luci/server/experiments"
 
@@ -79,13 +125,39 @@ func (id ID) Valid() bool {
 
 // Enabled returns true if this experiment is enabled.
 //
-// In production servers an experiment is enabled by `-enable-experiment <name>`
-// CLI flag.
+// In production servers an experiment is enabled for everyone by
+// `-enable-experiment <name>` CLI flag or for some subjects by its rollout (see
+// Config and CurrentSubject).
 //
 // In tests an experiment can be enabled via Enable(ctx, id).
 func (id ID) Enabled(ctx context.Context) bool {
+	enabled, reason := id.evaluate(ctx)
+	arm := "disabled"
+	if enabled {
+		arm = "enabled"
+	}
+	evaluationsMetric.Add(ctx, 1, id.name, arm, reason)
+	return enabled
+}
+
+// evaluate decides if the experiment is enabled.
+//
+// Returns the reason of the decision for metrics.
+func (id ID) evaluate(ctx context.Context) (enabled bool, reason string) {
 	cur, _ := ctx.Value(&ctxKey).(stringset.Set)
-	return cur.Has(id.name)
+	if cur.Has(id.name) {
+		return true, "static"
+	}
+	cfg, err := CurrentConfig(ctx)
+	if err != nil {
+		logging.Warningf(ctx, "Failed to get experiments config: %s", err)
+		return false, "config-error"
+	}
+	rollout := cfg.rollout(id.name)
+	if rollout == nil {
+		return false, "default"
+	}
+	return rollout.evaluate(ctx, id.name, CurrentSubject(ctx))
 }
 
 // Register is usually called during init() to declare some experiment.
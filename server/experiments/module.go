@@ -0,0 +1,97 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/module"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/server/experiments")
+
+// ModuleOptions contain configuration of the experiments server module.
+type ModuleOptions struct {
+	// RolloutConfig defines where to read rollouts of experiments from.
+	//
+	// Either "settings:<key>" to read them from the server settings or
+	// "luci-config:<path>" to read them from a JSON file in the service config
+	// set. See Config for the format. If empty, experiments can be enabled only
+	// via `-enable-experiment` flag.
+	RolloutConfig string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.StringVar(
+		&o.RolloutConfig,
+		"experiments-rollout-config",
+		o.RolloutConfig,
+		`Where to read rollouts of experiments from: "settings:<key>" or "luci-config:<path>".`,
+	)
+}
+
+// NewModule returns a server module that installs a source of experiment
+// rollouts into the global server context.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &serverModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// serverModule implements module.Module.
+type serverModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*serverModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*serverModule) Dependencies() []module.Dependency {
+	return nil
+}
+
+// Initialize is part of module.Module interface.
+func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	if m.opts.RolloutConfig == "" {
+		return ctx, nil
+	}
+	src, err := ParseSource(m.opts.RolloutConfig)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -experiments-rollout-config").Err()
+	}
+	logging.Infof(ctx, "Reading experiment rollouts from %q", m.opts.RolloutConfig)
+	return UseSource(ctx, src), nil
+}
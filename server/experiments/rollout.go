@@ -0,0 +1,268 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/auth"
+)
+
+// Config defines gradual rollouts of experiments.
+//
+// It is usually stored as JSON in server settings or in a LUCI config file
+// and reloaded without restarting the server, see Source.
+type Config struct {
+	// Rollouts maps an experiment name to its rollout.
+	//
+	// Rollouts of unknown experiments are ignored.
+	Rollouts map[string]*Rollout `json:"rollouts,omitempty"`
+}
+
+// Rollout defines for whom an experiment is enabled.
+//
+// An experiment enabled statically (e.g. via `-enable-experiment` flag) is
+// enabled for everyone regardless of its rollout.
+type Rollout struct {
+	// Percent is the percentage of subjects the experiment is enabled for.
+	//
+	// A value in range [0, 100]. Subjects are bucketed based on a hash of the
+	// experiment name and the subject key (see Key), i.e. the experiment is
+	// consistently enabled or disabled for the same subject, and increasing the
+	// percentage only adds new subjects.
+	Percent float64 `json:"percent,omitempty"`
+
+	// Key is what part of the subject to bucket on.
+	//
+	// Default is KeyIdentity.
+	Key Key `json:"key,omitempty"`
+
+	// Rules are targeting rules that override Percent for matching subjects.
+	//
+	// The first matching rule wins.
+	Rules []*Rule `json:"rules,omitempty"`
+}
+
+// Rule overrides the rollout percentage for some subjects.
+type Rule struct {
+	// Projects is a list of LUCI projects the rule applies to.
+	//
+	// If empty, the rule applies to all projects.
+	Projects []string `json:"projects,omitempty"`
+
+	// Groups is a list of auth groups the rule applies to.
+	//
+	// The rule applies if the subject identity is in any of them. If empty, the
+	// rule applies to all identities.
+	Groups []string `json:"groups,omitempty"`
+
+	// Percent is the percentage of matching subjects the experiment is enabled
+	// for.
+	Percent float64 `json:"percent,omitempty"`
+}
+
+// Key is what part of the subject to bucket on in percentage rollouts.
+type Key string
+
+const (
+	// KeyIdentity buckets on the caller identity.
+	KeyIdentity Key = "identity"
+	// KeyProject buckets on the LUCI project.
+	KeyProject Key = "project"
+	// KeyRequest buckets on the request (trace) ID.
+	KeyRequest Key = "request"
+)
+
+// rollout returns a rollout of the given experiment or nil.
+func (cfg *Config) rollout(name string) *Rollout {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Rollouts[name]
+}
+
+// Validate returns an error if the config is malformed.
+func (cfg *Config) Validate() error {
+	var merr errors.MultiError
+	for name, r := range cfg.Rollouts {
+		if err := r.validate(); err != nil {
+			merr = append(merr, errors.Annotate(err, "experiment %q", name).Err())
+		}
+	}
+	if len(merr) != 0 {
+		return merr
+	}
+	return nil
+}
+
+func (r *Rollout) validate() error {
+	if r == nil {
+		return errors.Reason("the rollout is null").Err()
+	}
+	if err := checkPercent(r.Percent); err != nil {
+		return err
+	}
+	switch r.Key {
+	case "", KeyIdentity, KeyProject, KeyRequest:
+	default:
+		return errors.Reason("unknown key %q", r.Key).Err()
+	}
+	for i, rule := range r.Rules {
+		switch {
+		case rule == nil:
+			return errors.Reason("rule #%d is null", i).Err()
+		case len(rule.Projects) == 0 && len(rule.Groups) == 0:
+			return errors.Reason("rule #%d should have projects or groups", i).Err()
+		}
+		if err := checkPercent(rule.Percent); err != nil {
+			return errors.Annotate(err, "rule #%d", i).Err()
+		}
+	}
+	return nil
+}
+
+func checkPercent(p float64) error {
+	if p < 0 || p > 100 {
+		return errors.Reason("percent %v is not in range [0, 100]", p).Err()
+	}
+	return nil
+}
+
+// Subject is who or what an experiment is evaluated for.
+type Subject struct {
+	// Project is a LUCI project the request is related to, if any.
+	Project string
+	// Identity is the caller identity.
+	//
+	// Default is the identity from the auth state in the context.
+	Identity identity.Identity
+	// RequestID identifies the request.
+	//
+	// Default is the trace ID from the context.
+	RequestID string
+}
+
+var subjectCtxKey = "go.chromium.org/luci/server/experiments/subject"
+
+// WithSubject returns a context with the subject to evaluate rollouts for.
+//
+// Empty fields of `s` are inherited from the subject in the context.
+func WithSubject(ctx context.Context, s Subject) context.Context {
+	cur, _ := ctx.Value(&subjectCtxKey).(Subject)
+	if s.Project == "" {
+		s.Project = cur.Project
+	}
+	if s.Identity == "" {
+		s.Identity = cur.Identity
+	}
+	if s.RequestID == "" {
+		s.RequestID = cur.RequestID
+	}
+	return context.WithValue(ctx, &subjectCtxKey, s)
+}
+
+// WithProject returns a context with the LUCI project to evaluate rollouts for.
+//
+// It is a shortcut for WithSubject(ctx, Subject{Project: project}).
+func WithProject(ctx context.Context, project string) context.Context {
+	return WithSubject(ctx, Subject{Project: project})
+}
+
+// CurrentSubject returns the subject rollouts are evaluated for.
+func CurrentSubject(ctx context.Context) Subject {
+	s, _ := ctx.Value(&subjectCtxKey).(Subject)
+	if s.Identity == "" && auth.GetState(ctx) != nil {
+		s.Identity = auth.CurrentIdentity(ctx)
+	}
+	if s.RequestID == "" {
+		if tid := trace.SpanContextFromContext(ctx).TraceID(); tid.IsValid() {
+			s.RequestID = tid.String()
+		}
+	}
+	return s
+}
+
+// evaluate decides if the experiment is enabled for the subject.
+//
+// Returns the arm and the reason of the decision for metrics.
+func (r *Rollout) evaluate(ctx context.Context, name string, s Subject) (enabled bool, reason string) {
+	percent := r.Percent
+	reason = "percent"
+	for i, rule := range r.Rules {
+		if rule.matches(ctx, s) {
+			percent = rule.Percent
+			reason = fmt.Sprintf("rule-%d", i)
+			break
+		}
+	}
+	switch {
+	case percent <= 0:
+		return false, reason
+	case percent >= 100:
+		return true, reason
+	}
+
+	var key string
+	switch r.Key {
+	case "", KeyIdentity:
+		key = string(s.Identity)
+	case KeyProject:
+		key = s.Project
+	case KeyRequest:
+		key = s.RequestID
+	}
+	if key == "" {
+		// Can't bucket subjects without a key, keep them in the control group.
+		return false, "no-key"
+	}
+	return bucket(name, key) < uint64(percent*100), reason
+}
+
+// matches is true if the rule applies to the subject.
+func (rule *Rule) matches(ctx context.Context, s Subject) bool {
+	if len(rule.Projects) != 0 && !stringset.NewFromSlice(rule.Projects...).Has(s.Project) {
+		return false
+	}
+	if len(rule.Groups) != 0 {
+		state := auth.GetState(ctx)
+		if state == nil || s.Identity == "" {
+			return false
+		}
+		switch yes, err := state.DB().IsMember(ctx, s.Identity, rule.Groups); {
+		case err != nil:
+			logging.Warningf(ctx, "Failed to check group membership when evaluating experiments: %s", err)
+			return false
+		case !yes:
+			return false
+		}
+	}
+	return true
+}
+
+// bucket maps the experiment and the key to a bucket in range [0, 10000).
+func bucket(name, key string) uint64 {
+	h := sha256.Sum256([]byte(name + "\x00" + key))
+	return binary.BigEndian.Uint64(h[:8]) % 10000
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/memlogger"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+	cfgmem "go.chromium.org/luci/config/impl/memory"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/settings"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var rolloutExp = Register("rollout-exp")
+
+func TestRollouts(t *testing.T) {
+	t.Parallel()
+
+	Convey("With rollouts", t, func() {
+		ctx := context.Background()
+
+		withRollout := func(ctx context.Context, r *Rollout) context.Context {
+			return UseSource(ctx, &StaticSource{
+				Rollouts: map[string]*Rollout{"rollout-exp": r},
+			})
+		}
+
+		Convey("No config", func() {
+			So(rolloutExp.Enabled(ctx), ShouldBeFalse)
+		})
+
+		Convey("Static enablement wins", func() {
+			ctx = withRollout(ctx, &Rollout{Percent: 0})
+			ctx = Enable(ctx, rolloutExp)
+			So(rolloutExp.Enabled(ctx), ShouldBeTrue)
+		})
+
+		Convey("0% and 100%", func() {
+			So(rolloutExp.Enabled(withRollout(ctx, &Rollout{Percent: 0})), ShouldBeFalse)
+			So(rolloutExp.Enabled(withRollout(ctx, &Rollout{Percent: 100})), ShouldBeTrue)
+		})
+
+		Convey("Percentage is stable and roughly right", func() {
+			ctx := withRollout(ctx, &Rollout{Percent: 30})
+			enabled := 0
+			for i := 0; i < 10000; i++ {
+				ctx := WithSubject(ctx, Subject{Identity: identity.Identity(fmt.Sprintf("user:%d@example.com", i))})
+				on := rolloutExp.Enabled(ctx)
+				So(rolloutExp.Enabled(ctx), ShouldEqual, on)
+				if on {
+					enabled++
+				}
+			}
+			So(enabled, ShouldBeBetween, 2800, 3200)
+		})
+
+		Convey("Increasing percentage only adds subjects", func() {
+			for i := 0; i < 1000; i++ {
+				ctx := WithSubject(ctx, Subject{Identity: identity.Identity(fmt.Sprintf("user:%d@example.com", i))})
+				if rolloutExp.Enabled(withRollout(ctx, &Rollout{Percent: 10})) {
+					So(rolloutExp.Enabled(withRollout(ctx, &Rollout{Percent: 20})), ShouldBeTrue)
+				}
+			}
+		})
+
+		Convey("Keys", func() {
+			Convey("Identity from the auth state", func() {
+				ctx := withRollout(ctx, &Rollout{Percent: 50})
+				So(rolloutExp.Enabled(ctx), ShouldBeFalse) // no identity at all
+
+				results := map[bool]int{}
+				for i := 0; i < 100; i++ {
+					id := identity.Identity(fmt.Sprintf("user:%d@example.com", i))
+					on := rolloutExp.Enabled(auth.WithState(ctx, &authtest.FakeState{Identity: id}))
+					So(rolloutExp.Enabled(WithSubject(ctx, Subject{Identity: id})), ShouldEqual, on)
+					results[on]++
+				}
+				So(results[true], ShouldBeGreaterThan, 0)
+				So(results[false], ShouldBeGreaterThan, 0)
+			})
+
+			Convey("Project", func() {
+				ctx := withRollout(ctx, &Rollout{Percent: 50, Key: KeyProject})
+				So(rolloutExp.Enabled(ctx), ShouldBeFalse)
+				results := map[bool]int{}
+				for i := 0; i < 100; i++ {
+					ctx := WithProject(ctx, fmt.Sprintf("proj-%d", i))
+					// The same for all identities within the project.
+					on := rolloutExp.Enabled(WithSubject(ctx, Subject{Identity: "user:a@example.com"}))
+					So(rolloutExp.Enabled(WithSubject(ctx, Subject{Identity: "user:b@example.com"})), ShouldEqual, on)
+					results[on]++
+				}
+				So(results[true], ShouldBeGreaterThan, 0)
+				So(results[false], ShouldBeGreaterThan, 0)
+			})
+
+			Convey("Request", func() {
+				ctx := withRollout(ctx, &Rollout{Percent: 50, Key: KeyRequest})
+				results := map[bool]int{}
+				for i := 0; i < 100; i++ {
+					results[rolloutExp.Enabled(WithSubject(ctx, Subject{RequestID: fmt.Sprintf("req-%d", i)}))]++
+				}
+				So(results[true], ShouldBeGreaterThan, 0)
+				So(results[false], ShouldBeGreaterThan, 0)
+			})
+		})
+
+		Convey("Targeting rules", func() {
+			ctx := withRollout(ctx, &Rollout{
+				Percent: 0,
+				Rules: []*Rule{
+					{Projects: []string{"canary"}, Percent: 100},
+					{Groups: []string{"testers"}, Percent: 100},
+					{Projects: []string{"other"}, Groups: []string{"admins"}, Percent: 100},
+				},
+			})
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity: "user:someone@example.com",
+				FakeDB: authtest.NewFakeDB(
+					authtest.MockMembership("user:tester@example.com", "testers"),
+					authtest.MockMembership("user:admin@example.com", "admins"),
+				),
+			})
+
+			So(rolloutExp.Enabled(ctx), ShouldBeFalse)
+			So(rolloutExp.Enabled(WithProject(ctx, "canary")), ShouldBeTrue)
+			So(rolloutExp.Enabled(WithSubject(ctx, Subject{Identity: "user:tester@example.com"})), ShouldBeTrue)
+			So(rolloutExp.Enabled(WithSubject(ctx, Subject{Identity: "user:admin@example.com"})), ShouldBeFalse)
+			So(rolloutExp.Enabled(WithSubject(ctx, Subject{
+				Project:  "other",
+				Identity: "user:admin@example.com",
+			})), ShouldBeTrue)
+		})
+
+		Convey("Metrics", func() {
+			ctx, _ := tsmon.WithDummyInMemory(ctx)
+			ctx = withRollout(ctx, &Rollout{Percent: 100})
+			So(rolloutExp.Enabled(ctx), ShouldBeTrue)
+			So(rolloutExp.Enabled(ctx), ShouldBeTrue)
+			So(evaluationsMetric.Get(ctx, "rollout-exp", "enabled", "percent"), ShouldEqual, 2)
+
+			So(rolloutExp.Enabled(Enable(ctx, rolloutExp)), ShouldBeTrue)
+			So(evaluationsMetric.Get(ctx, "rollout-exp", "enabled", "static"), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey("Validate", t, func() {
+		cfg, err := ParseConfig([]byte(`{
+			"rollouts": {
+				"exp": {
+					"percent": 10,
+					"key": "project",
+					"rules": [{"projects": ["p"], "percent": 100}]
+				}
+			}
+		}`))
+		So(err, ShouldBeNil)
+		So(cfg.Rollouts["exp"].Key, ShouldEqual, KeyProject)
+
+		_, err = ParseConfig([]byte(`{"rollouts": {"exp": {"percent": 101}}}`))
+		So(err, ShouldErrLike, "not in range")
+		_, err = ParseConfig([]byte(`{"rollouts": {"exp": {"key": "zzz"}}}`))
+		So(err, ShouldErrLike, `unknown key "zzz"`)
+		_, err = ParseConfig([]byte(`{"rollouts": {"exp": {"rules": [{"percent": 1}]}}}`))
+		So(err, ShouldErrLike, "should have projects or groups")
+		_, err = ParseConfig([]byte(`not json`))
+		So(err, ShouldErrLike, "bad experiments config")
+	})
+
+	Convey("ParseSource", t, func() {
+		src, err := ParseSource("settings:experiments")
+		So(err, ShouldBeNil)
+		So(src.(*SettingsSource).Key, ShouldEqual, "experiments")
+
+		src, err = ParseSource("luci-config:experiments.json")
+		So(err, ShouldBeNil)
+		So(src.(*LUCIConfigSource).Path, ShouldEqual, "experiments.json")
+
+		_, err = ParseSource("zzz:yyy")
+		So(err, ShouldErrLike, "unknown experiments config source")
+		_, err = ParseSource("settings:")
+		So(err, ShouldErrLike, "expecting")
+	})
+
+	Convey("SettingsSource", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx = memlogger.Use(ctx)
+		log := logging.Get(ctx).(*memlogger.MemLogger)
+		ctx = settings.Use(ctx, settings.New(&settings.MemoryStorage{Expiration: time.Minute}))
+		src := &SettingsSource{Key: "experiments"}
+
+		cfg, err := src.Config(ctx)
+		So(err, ShouldBeNil)
+		So(cfg, ShouldBeNil)
+
+		So(settings.Set(ctx, "experiments", &Config{
+			Rollouts: map[string]*Rollout{"exp": {Percent: 5}},
+		}), ShouldBeNil)
+		tc.Add(2 * time.Minute)
+		cfg, err = src.Config(ctx)
+		So(err, ShouldBeNil)
+		So(cfg.Rollouts["exp"].Percent, ShouldEqual, 5)
+
+		// Invalid settings are logged once per bundle and ignored.
+		So(settings.Set(ctx, "experiments", &Config{
+			Rollouts: map[string]*Rollout{"exp": {Percent: 1000}},
+		}), ShouldBeNil)
+		tc.Add(2 * time.Minute)
+		for i := 0; i < 3; i++ {
+			cfg, err = src.Config(ctx)
+			So(err, ShouldBeNil)
+			So(cfg.Rollouts["exp"].Percent, ShouldEqual, 5)
+		}
+		So(log.Messages(), ShouldHaveLength, 1)
+		So(log, memlogger.ShouldHaveLog, logging.Error, "Invalid experiments settings")
+	})
+
+	Convey("LUCIConfigSource", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		files := cfgmem.Files{"experiments.json": `{"rollouts": {"rollout-exp": {"percent": 100}}}`}
+		ctx = cfgclient.Use(ctx, cfgmem.New(map[config.Set]cfgmem.Files{"services/app": files}))
+
+		src := &LUCIConfigSource{ConfigSet: "services/app", Path: "experiments.json"}
+		ctx = UseSource(ctx, src)
+		So(rolloutExp.Enabled(ctx), ShouldBeTrue)
+
+		// Picked up after the refresh.
+		files["experiments.json"] = `{"rollouts": {"rollout-exp": {"percent": 0}}}`
+		So(rolloutExp.Enabled(ctx), ShouldBeTrue)
+		tc.Add(2 * time.Minute)
+		So(rolloutExp.Enabled(ctx), ShouldBeFalse)
+
+		// Invalid configs are ignored.
+		files["experiments.json"] = `{"rollouts": {"rollout-exp": {"percent": 1000}}}`
+		tc.Add(2 * time.Minute)
+		So(rolloutExp.Enabled(ctx), ShouldBeFalse)
+	})
+}
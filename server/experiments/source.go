@@ -0,0 +1,213 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/data/caching/lazyslot"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+
+	"go.chromium.org/luci/server/settings"
+)
+
+// Source provides the current rollout configuration.
+type Source interface {
+	// Config returns the current rollout configuration.
+	//
+	// Called whenever an experiment is evaluated, so it must be fast, e.g. use
+	// an in-memory cache. May return nil if there's no configuration.
+	Config(ctx context.Context) (*Config, error)
+}
+
+var sourceCtxKey = "go.chromium.org/luci/server/experiments/source"
+
+// UseSource returns a context with the given source of rollouts installed.
+//
+// It is usually done by the server module, see NewModule.
+func UseSource(ctx context.Context, src Source) context.Context {
+	return context.WithValue(ctx, &sourceCtxKey, src)
+}
+
+// CurrentConfig returns the rollout configuration from the source in the
+// context or nil if there's no source.
+func CurrentConfig(ctx context.Context) (*Config, error) {
+	if src, _ := ctx.Value(&sourceCtxKey).(Source); src != nil {
+		return src.Config(ctx)
+	}
+	return nil, nil
+}
+
+// StaticSource is a Source with a fixed configuration.
+//
+// Useful in tests.
+type StaticSource Config
+
+// Config implements Source.
+func (s *StaticSource) Config(context.Context) (*Config, error) {
+	return (*Config)(s), nil
+}
+
+// SettingsSource is a Source that reads the configuration from the server
+// settings (see server/settings) stored under the given key.
+//
+// The configuration is reloaded whenever the settings are. Invalid settings are
+// logged and ignored: the previous valid configuration (if any) is used
+// instead.
+type SettingsSource struct {
+	// Key is the settings key with the configuration.
+	Key string
+
+	m     sync.RWMutex
+	seen  *Config // the last config checked by Validate
+	valid *Config // the last config that passed the validation
+}
+
+// settingsConfig is how Config is deserialized from the settings.
+//
+// Settings deserialize a value only once per fetched bundle and then return
+// its shallow copies. The embedded pointer thus identifies the bundle, which
+// allows to validate each bundle only once.
+type settingsConfig struct {
+	*Config
+}
+
+// Config implements Source.
+func (s *SettingsSource) Config(ctx context.Context) (*Config, error) {
+	var sc settingsConfig
+	switch err := settings.Get(ctx, s.Key, &sc); {
+	case err == settings.ErrNoSettings:
+		return nil, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to read experiments settings %q", s.Key).Err()
+	case sc.Config == nil:
+		return nil, nil // the settings are just "{}"
+	}
+
+	s.m.RLock()
+	seen, valid := s.seen, s.valid
+	s.m.RUnlock()
+	if seen == sc.Config {
+		return valid, nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.seen != sc.Config {
+		s.seen = sc.Config
+		if err := sc.Config.Validate(); err != nil {
+			logging.Errorf(ctx, "Invalid experiments settings %q, using the previous ones: %s", s.Key, err)
+		} else {
+			s.valid = sc.Config
+		}
+	}
+	return s.valid, nil
+}
+
+// LUCIConfigSource is a Source that reads the configuration as a JSON file
+// from LUCI Config.
+//
+// Requires the LUCI Config client to be in the context (see cfgmodule).
+type LUCIConfigSource struct {
+	// ConfigSet is the config set with the file.
+	//
+	// Default is the service config set ("services/${appid}").
+	ConfigSet config.Set
+
+	// Path is the path to the file within the config set.
+	Path string
+
+	// RefreshInterval is how often to refetch the file.
+	//
+	// Default is 1 min.
+	RefreshInterval time.Duration
+
+	slot lazyslot.Slot
+}
+
+// Config implements Source.
+//
+// If the config file is missing, returns nil. If it can't be fetched or it is
+// invalid, keeps returning the previously fetched valid config (if any).
+func (s *LUCIConfigSource) Config(ctx context.Context) (*Config, error) {
+	val, err := s.slot.Get(ctx, func(any) (any, time.Duration, error) {
+		cfg, err := s.fetch(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		exp := s.RefreshInterval
+		if exp == 0 {
+			exp = time.Minute
+		}
+		return cfg, exp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*Config), nil
+}
+
+func (s *LUCIConfigSource) fetch(ctx context.Context) (*Config, error) {
+	cs := s.ConfigSet
+	if cs == "" {
+		cs = "services/${appid}"
+	}
+	var blob []byte
+	switch err := cfgclient.Get(ctx, cs, s.Path, cfgclient.Bytes(&blob), nil); {
+	case errors.Is(err, config.ErrNoConfig):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch %s:%s", cs, s.Path).Err()
+	}
+	return ParseConfig(blob)
+}
+
+// ParseConfig parses and validates a JSON-serialized Config.
+func ParseConfig(blob []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := json.Unmarshal(blob, cfg); err != nil {
+		return nil, errors.Annotate(err, "bad experiments config").Err()
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Annotate(err, "invalid experiments config").Err()
+	}
+	return cfg, nil
+}
+
+// ParseSource parses a source specification used by the command line flag.
+//
+// Supported formats are "settings:<key>" and "luci-config:<path>" (for a file
+// in the service config set).
+func ParseSource(spec string) (Source, error) {
+	kind, arg, ok := strings.Cut(spec, ":")
+	if !ok || arg == "" {
+		return nil, errors.Reason("expecting \"settings:<key>\" or \"luci-config:<path>\", got %q", spec).Err()
+	}
+	switch kind {
+	case "settings":
+		return &SettingsSource{Key: arg}, nil
+	case "luci-config":
+		return &LUCIConfigSource{Path: arg}, nil
+	default:
+		return nil, errors.Reason("unknown experiments config source kind %q", kind).Err()
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"math"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// AdaptiveAlgorithm is an algorithm used to adjust the concurrency limit.
+type AdaptiveAlgorithm string
+
+const (
+	// Gradient adjusts the limit based on the ratio between the minimal observed
+	// latency and the current latency.
+	//
+	// When the latency starts growing (i.e. requests start queuing up somewhere
+	// inside the server), the limit decreases proportionally. When the latency
+	// is stable, the limit slowly grows.
+	Gradient AdaptiveAlgorithm = "gradient"
+
+	// AIMD (additive-increase/multiplicative-decrease) increases the limit by one
+	// when a request finishes faster than AdaptiveOptions.LatencyTarget and
+	// multiplies it by AdaptiveOptions.BackoffRatio otherwise.
+	AIMD AdaptiveAlgorithm = "aimd"
+)
+
+// AdaptiveOptions configure the adaptive concurrency limit.
+type AdaptiveOptions struct {
+	// Algorithm is the algorithm to use. Default is Gradient.
+	Algorithm AdaptiveAlgorithm
+
+	// InitialLimit is the starting value of the limit. Default is 20.
+	InitialLimit int64
+
+	// MinLimit is the lowest the limit can go. Default is 1.
+	//
+	// The highest is always Options.MaxConcurrentRequests.
+	MinLimit int64
+
+	// Tolerance is how many times the latency can grow relative to the minimal
+	// observed latency before the Gradient algorithm starts decreasing the
+	// limit. Default is 2.
+	Tolerance float64
+
+	// MinLatencyWindow is how long the minimal observed latency is remembered
+	// by the Gradient algorithm. Allows to adapt to permanent changes in the
+	// latency. Default is 1 min.
+	MinLatencyWindow time.Duration
+
+	// LatencyTarget is the latency above which the AIMD algorithm decreases the
+	// limit. Required for AIMD.
+	LatencyTarget time.Duration
+
+	// BackoffRatio is how much the AIMD algorithm decreases the limit by. Must
+	// be in range (0, 1). Default is 0.9.
+	BackoffRatio float64
+}
+
+// limitAlgorithm calculates the concurrency limit based on latency samples.
+type limitAlgorithm interface {
+	// update is called when a request finishes.
+	//
+	// `inflight` is the number of requests in flight when the request finished,
+	// including it. Returns the new limit.
+	update(now time.Time, latency time.Duration, inflight int64) float64
+}
+
+// newLimitAlgorithm validates options and creates the algorithm.
+func newLimitAlgorithm(opts AdaptiveOptions, hardMax int64) (limitAlgorithm, float64, error) {
+	if opts.MinLimit <= 0 {
+		opts.MinLimit = 1
+	}
+	if opts.InitialLimit <= 0 {
+		opts.InitialLimit = 20
+	}
+	if opts.MinLimit > hardMax {
+		return nil, 0, errors.Reason("adaptive min limit %d is larger than max concurrent requests %d", opts.MinLimit, hardMax).Err()
+	}
+	initial := float64(min(max(opts.InitialLimit, opts.MinLimit), hardMax))
+	bounds := limitBounds{min: float64(opts.MinLimit), max: float64(hardMax)}
+
+	switch opts.Algorithm {
+	case Gradient, "":
+		if opts.Tolerance == 0 {
+			opts.Tolerance = 2
+		}
+		if opts.Tolerance < 1 {
+			return nil, 0, errors.Reason("gradient tolerance must be >= 1, got %f", opts.Tolerance).Err()
+		}
+		if opts.MinLatencyWindow == 0 {
+			opts.MinLatencyWindow = time.Minute
+		}
+		return &gradientLimit{
+			limitBounds: bounds,
+			limit:       initial,
+			tolerance:   opts.Tolerance,
+			window:      opts.MinLatencyWindow,
+		}, initial, nil
+
+	case AIMD:
+		if opts.LatencyTarget <= 0 {
+			return nil, 0, errors.Reason("AIMD requires a positive latency target").Err()
+		}
+		if opts.BackoffRatio == 0 {
+			opts.BackoffRatio = 0.9
+		}
+		if opts.BackoffRatio <= 0 || opts.BackoffRatio >= 1 {
+			return nil, 0, errors.Reason("AIMD backoff ratio must be in range (0, 1), got %f", opts.BackoffRatio).Err()
+		}
+		return &aimdLimit{
+			limitBounds: bounds,
+			limit:       initial,
+			target:      opts.LatencyTarget,
+			backoff:     opts.BackoffRatio,
+		}, initial, nil
+
+	default:
+		return nil, 0, errors.Reason("unknown adaptive algorithm %q", opts.Algorithm).Err()
+	}
+}
+
+// limitBounds clamps the limit.
+type limitBounds struct {
+	min, max float64
+}
+
+func (b limitBounds) clamp(limit float64) float64 {
+	return math.Max(b.min, math.Min(b.max, limit))
+}
+
+// gradientLimit implements Gradient algorithm.
+type gradientLimit struct {
+	limitBounds
+	limit     float64
+	tolerance float64
+	window    time.Duration
+
+	minLatency  time.Duration // the minimal latency in the previous window
+	nextMin     time.Duration // the minimal latency in the current window
+	windowStart time.Time     // when the current window started
+}
+
+// smoothing is how fast the gradient limit moves towards the new estimate.
+const smoothing = 0.2
+
+func (g *gradientLimit) update(now time.Time, latency time.Duration, inflight int64) float64 {
+	if latency <= 0 {
+		latency = 1
+	}
+
+	// Track the minimal latency over a sliding-ish window.
+	if g.windowStart.IsZero() || now.Sub(g.windowStart) >= g.window {
+		if g.nextMin != 0 {
+			g.minLatency = g.nextMin
+		}
+		g.nextMin = 0
+		g.windowStart = now
+	}
+	if g.nextMin == 0 || latency < g.nextMin {
+		g.nextMin = latency
+	}
+	if g.minLatency == 0 || latency < g.minLatency {
+		g.minLatency = latency
+	}
+
+	// The gradient is 1 when the latency is within the tolerance and goes down
+	// to 0.5 as it grows.
+	gradient := math.Max(0.5, math.Min(1, g.tolerance*float64(g.minLatency)/float64(latency)))
+	// Allow some queuing, proportional to the square root of the limit.
+	estimate := g.limit*gradient + math.Sqrt(g.limit)
+
+	// Don't grow the limit if it isn't being utilized.
+	if estimate > g.limit && float64(inflight) < g.limit/2 {
+		return g.limit
+	}
+
+	g.limit = g.clamp(g.limit*(1-smoothing) + estimate*smoothing)
+	return g.limit
+}
+
+// aimdLimit implements AIMD algorithm.
+type aimdLimit struct {
+	limitBounds
+	limit   float64
+	target  time.Duration
+	backoff float64
+}
+
+func (a *aimdLimit) update(now time.Time, latency time.Duration, inflight int64) float64 {
+	switch {
+	case latency > a.target:
+		a.limit = a.clamp(a.limit * a.backoff)
+	case float64(inflight) >= a.limit/2:
+		a.limit = a.clamp(a.limit + 1)
+	}
+	return a.limit
+}
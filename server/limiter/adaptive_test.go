@@ -0,0 +1,97 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestAdaptive(t *testing.T) {
+	t.Parallel()
+
+	Convey("Validation", t, func() {
+		_, _, err := newLimitAlgorithm(AdaptiveOptions{Algorithm: "huh"}, 100)
+		So(err, ShouldErrLike, "unknown adaptive algorithm")
+
+		_, _, err = newLimitAlgorithm(AdaptiveOptions{Algorithm: AIMD}, 100)
+		So(err, ShouldErrLike, "positive latency target")
+
+		_, _, err = newLimitAlgorithm(AdaptiveOptions{MinLimit: 200}, 100)
+		So(err, ShouldErrLike, "larger than max concurrent requests")
+
+		_, initial, err := newLimitAlgorithm(AdaptiveOptions{}, 10)
+		So(err, ShouldBeNil)
+		So(initial, ShouldEqual, 10)
+	})
+
+	Convey("Gradient", t, func() {
+		algo, initial, err := newLimitAlgorithm(AdaptiveOptions{
+			InitialLimit:     20,
+			MinLimit:         5,
+			MinLatencyWindow: 10 * time.Minute,
+		}, 100)
+		So(err, ShouldBeNil)
+		So(initial, ShouldEqual, 20)
+
+		now := time.Unix(1000, 0)
+		run := func(n int, latency time.Duration, inflight int64) (limit float64) {
+			for i := 0; i < n; i++ {
+				now = now.Add(time.Second)
+				limit = algo.update(now, latency, inflight)
+			}
+			return
+		}
+
+		// Grows when the latency is stable and the limit is utilized.
+		limit := run(50, 10*time.Millisecond, 100)
+		So(limit, ShouldBeGreaterThan, 40)
+
+		// Doesn't grow when the limit is not utilized.
+		So(run(50, 10*time.Millisecond, 1), ShouldEqual, limit)
+
+		// Shrinks when the latency grows.
+		So(run(20, 100*time.Millisecond, 100), ShouldBeLessThan, limit/2)
+
+		// But not below the minimum.
+		So(run(100, 100*time.Millisecond, 100), ShouldEqual, 5)
+
+		// Eventually adapts to the new latency as the old minimum is forgotten.
+		So(run(1300, 100*time.Millisecond, 100), ShouldEqual, 100)
+	})
+
+	Convey("AIMD", t, func() {
+		algo, _, err := newLimitAlgorithm(AdaptiveOptions{
+			Algorithm:     AIMD,
+			InitialLimit:  10,
+			LatencyTarget: 50 * time.Millisecond,
+			BackoffRatio:  0.5,
+		}, 12)
+		So(err, ShouldBeNil)
+
+		now := time.Unix(1000, 0)
+		So(algo.update(now, 10*time.Millisecond, 10), ShouldEqual, 11)
+		So(algo.update(now, 10*time.Millisecond, 1), ShouldEqual, 11) // underutilized
+		So(algo.update(now, 10*time.Millisecond, 10), ShouldEqual, 12)
+		So(algo.update(now, 10*time.Millisecond, 10), ShouldEqual, 12) // capped
+		So(algo.update(now, 60*time.Millisecond, 10), ShouldEqual, 6)
+		So(algo.update(now, 60*time.Millisecond, 10), ShouldEqual, 3)
+		So(algo.update(now, 60*time.Millisecond, 10), ShouldEqual, 1.5)
+		So(algo.update(now, 60*time.Millisecond, 10), ShouldEqual, 1) // min limit
+	})
+}
@@ -14,7 +14,13 @@
 
 // Package limiter implements load shedding for servers.
 //
-// Currently only supports setting a hard limit on a number of concurrently
-// processed requests. Over-engineered to support more features in the future
-// without significantly altering its API.
+// Supports a hard limit on a number of concurrently processed requests and,
+// optionally:
+//   - An adaptive limit below the hard one, adjusted based on the observed
+//     latency of requests (see AdaptiveOptions).
+//   - A short wait queue for requests that arrive when the limit is reached.
+//     Waiting requests are admitted in order of their priority and then fairly
+//     across peers and methods (start-time fair queuing with per-flow weights).
+//   - Priority classes: critical requests bypass the adaptive limit and jump
+//     the queue, sheddable requests are rejected right away instead of waiting.
 package limiter
@@ -17,12 +17,16 @@ package limiter
 import (
 	"context"
 	"fmt"
-	"sync/atomic"
+	"sync"
+	"time"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/distribution"
 	"go.chromium.org/luci/common/tsmon/field"
 	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/common/tsmon/types"
 )
 
 // ErrLimitReached is returned by CheckRequest when some limit is reached.
@@ -45,6 +49,32 @@ var (
 		field.String("limiter"), // name of the limiter that reports the metric
 	)
 
+	// The current (perhaps adaptive) limit on a number of in-flight requests.
+	concurrencyLimitGauge = metric.NewInt(
+		"server/limiter/concurrency/limit",
+		"The current (perhaps adaptive) limit on a number of concurrently processed requests.",
+		nil,
+		field.String("limiter"), // name of the limiter that reports the metric
+	)
+
+	// Number of requests waiting for an execution slot.
+	queueLenGauge = metric.NewInt(
+		"server/limiter/queue/len",
+		"Number of requests waiting for an execution slot.",
+		nil,
+		field.String("limiter"), // name of the limiter that reports the metric
+	)
+
+	// How long requests waited for an execution slot.
+	queueWaitDist = metric.NewCumulativeDistribution(
+		"server/limiter/queue/wait",
+		"How long requests waited for an execution slot.",
+		&types.MetricMetadata{Units: types.Milliseconds},
+		distribution.DefaultBucketer,
+		field.String("limiter"), // name of the limiter that reports the metric
+		field.String("result"),  // admitted | rejected
+	)
+
 	// Counter with rejected requests.
 	rejectedCounter = metric.NewCounter(
 		"server/limiter/rejected",
@@ -61,6 +91,39 @@ type Options struct {
 	Name                  string // used for metric fields, logs and error messages
 	AdvisoryMode          bool   // if true, don't actually reject requests, just log
 	MaxConcurrentRequests int64  // a hard limit on a number of concurrent requests
+
+	// Adaptive, if set, enables the adaptive concurrency limit.
+	//
+	// The limit is adjusted based on the observed latency of requests and stays
+	// within [MinLimit, MaxConcurrentRequests] range. Requests with
+	// PriorityCritical are subject only to MaxConcurrentRequests.
+	Adaptive *AdaptiveOptions
+
+	// MaxQueueWait is how long a request can wait for an execution slot when
+	// the limit is reached.
+	//
+	// Waiting requests are admitted in order of their priority and then fairly
+	// across flows (see Weight). Requests with PrioritySheddable never wait.
+	// Default is 0, meaning requests are rejected right away.
+	MaxQueueWait time.Duration
+
+	// MaxQueueLength is the maximum number of waiting requests.
+	//
+	// Used only if MaxQueueWait is set. Default is 1000.
+	MaxQueueLength int
+
+	// Classify, if set, is called to assign a priority to a request.
+	//
+	// It overrides RequestInfo.Priority.
+	Classify func(ri *RequestInfo) Priority
+
+	// Weight, if set, returns a weight of the flow the request belongs to.
+	//
+	// A flow is a combination of PeerKey (or PeerLabel if PeerKey is empty) and
+	// CallLabel. When the limit is reached, waiting requests of a flow with
+	// weight 2 are admitted twice as often as requests of a flow with weight 1.
+	// Default weight is 1.
+	Weight func(ri *RequestInfo) float64
 }
 
 // Limiter is a stateful runtime object that decides whether to accept or reject
@@ -75,26 +138,57 @@ type Options struct {
 //
 // All methods are safe for concurrent use.
 type Limiter struct {
-	opts        Options // options passed to New, as is
-	titleForLog string  // how the limiter is named in logs and error replies
-	concurrency int64   // atomic int with number of current in-flight requests
+	opts        Options        // options passed to New, as is
+	titleForLog string         // how the limiter is named in logs and error replies
+	algo        limitAlgorithm // adjusts the limit or nil if not adaptive
+
+	m           sync.Mutex
+	concurrency int64            // number of current in-flight requests
+	limit       float64          // the current limit, <= MaxConcurrentRequests
+	queue       fairQueue        // requests waiting for an execution slot
+	rejected    map[string]int64 // reason => number of rejected requests
 }
 
+// Snapshot is a point-in-time state of a limiter.
+type Snapshot struct {
+	Name                  string           // the name of the limiter
+	AdvisoryMode          bool             // true if the limiter doesn't reject requests
+	MaxConcurrentRequests int64            // the configured hard limit
+	Limit                 int64            // the current (perhaps adaptive) limit
+	Concurrency           int64            // number of in-flight requests
+	Queued                int              // number of waiting requests
+	Rejected              map[string]int64 // reason => number of rejected requests
+}
+
+// Priority defines the order in which requests are admitted and shed.
+type Priority int
+
+const (
+	// PrioritySheddable requests are rejected right away when the limit is
+	// reached, they never wait for an execution slot.
+	PrioritySheddable Priority = -1
+
+	// PriorityDefault is the priority of most requests.
+	PriorityDefault Priority = 0
+
+	// PriorityCritical requests are admitted before other waiting requests and
+	// are not subject to the adaptive limit (only to the hard limit).
+	PriorityCritical Priority = 1
+)
+
 // RequestInfo holds information about a single inbound request.
 //
 // Used by the limiter to decide whether to accept or reject the request.
 //
-// Pretty sparse now, but in the future will contain fields like cost, a QoS
-// class and an attempt count, which will help the limiter to decide what
-// requests to drop.
-//
 // Fields `CallLabel` and `PeerLabel` are intentionally pretty generic, since
 // they will be used only as labels in internal maps and metric fields. Their
 // internal structure and meaning are not important to the limiter, but the
 // cardinality of the set of their possible values must be reasonably bounded.
 type RequestInfo struct {
-	CallLabel string // an RPC or an endpoint being called (if known)
-	PeerLabel string // who's making the request (if known), see also peer.go
+	CallLabel string   // an RPC or an endpoint being called (if known)
+	PeerLabel string   // who's making the request (if known), see also peer.go
+	PeerKey   string   // a precise peer identity for fair queuing, not used in metrics
+	Priority  Priority // the priority of the request, see also Options.Classify
 }
 
 // New returns a new limiter.
@@ -104,22 +198,67 @@ func New(opts Options) (*Limiter, error) {
 	if opts.MaxConcurrentRequests <= 0 {
 		return nil, errors.New("max concurrent requests must be positive")
 	}
-	return &Limiter{
+	if opts.MaxQueueWait < 0 {
+		return nil, errors.New("max queue wait must be non-negative")
+	}
+	if opts.MaxQueueLength <= 0 {
+		opts.MaxQueueLength = 1000
+	}
+	l := &Limiter{
 		opts:        opts,
 		titleForLog: fmt.Sprintf("%s<=%d", opts.Name, opts.MaxConcurrentRequests),
-	}, nil
+		limit:       float64(opts.MaxConcurrentRequests),
+	}
+	if opts.Adaptive != nil {
+		var err error
+		if l.algo, l.limit, err = newLimitAlgorithm(*opts.Adaptive, opts.MaxConcurrentRequests); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
 }
 
 // ReportMetrics updates all limiter's gauge metrics to match the current state.
 //
 // Must be called periodically (at least once per every metrics flush).
 func (l *Limiter) ReportMetrics(ctx context.Context) {
-	concurrencyCurGauge.Set(ctx, atomic.LoadInt64(&l.concurrency), l.opts.Name)
+	l.m.Lock()
+	cur, limit, queued := l.concurrency, l.limit, l.queue.Len()
+	l.m.Unlock()
+	concurrencyCurGauge.Set(ctx, cur, l.opts.Name)
 	concurrencyMaxGauge.Set(ctx, l.opts.MaxConcurrentRequests, l.opts.Name)
+	concurrencyLimitGauge.Set(ctx, int64(limit), l.opts.Name)
+	queueLenGauge.Set(ctx, int64(queued), l.opts.Name)
+}
+
+// Snapshot returns the current state of the limiter.
+//
+// Rejected requests are counted since the limiter was created. In advisory mode
+// they are requests that would have been rejected.
+func (l *Limiter) Snapshot() Snapshot {
+	l.m.Lock()
+	defer l.m.Unlock()
+	rejected := make(map[string]int64, len(l.rejected))
+	for reason, count := range l.rejected {
+		rejected[reason] = count
+	}
+	return Snapshot{
+		Name:                  l.opts.Name,
+		AdvisoryMode:          l.opts.AdvisoryMode,
+		MaxConcurrentRequests: l.opts.MaxConcurrentRequests,
+		Limit:                 int64(l.limit),
+		Concurrency:           l.concurrency,
+		Queued:                l.queue.Len(),
+		Rejected:              rejected,
+	}
 }
 
 // CheckRequest should be called before processing a request.
 //
+// If the limit is reached and the limiter is configured with MaxQueueWait, it
+// blocks until the request can be processed, the wait times out or the context
+// is canceled.
+//
 // If it returns an error, the request should be declined as soon as possible
 // with Unavailable/HTTP 503 status and the given error (which is an annotated
 // ErrLimitReached).
@@ -127,30 +266,143 @@ func (l *Limiter) ReportMetrics(ctx context.Context) {
 // If it succeeds, the request should be processed as usual, and the returned
 // callback called afterwards to notify the limiter the processing is done.
 func (l *Limiter) CheckRequest(ctx context.Context, ri *RequestInfo) (done func(), err error) {
-	// TODO(vadimsh): This is the simplest limiter implementation possible. It
-	// will likely learn more tricks once we understand what features we need.
-	for {
-		cur := atomic.LoadInt64(&l.concurrency)
-		if cur >= l.opts.MaxConcurrentRequests && !l.opts.AdvisoryMode {
-			return nil, l.reject(ctx, ri, "max concurrency")
-		}
-		if !atomic.CompareAndSwapInt64(&l.concurrency, cur, cur+1) {
-			continue // race, try again
+	priority := ri.Priority
+	if l.opts.Classify != nil {
+		priority = l.opts.Classify(ri)
+	}
+
+	l.m.Lock()
+	if l.admissibleLocked(priority) {
+		l.concurrency++
+		l.m.Unlock()
+		return l.doneCallback(ctx), nil
+	}
+
+	reason := "max concurrency"
+	if l.concurrency < l.opts.MaxConcurrentRequests {
+		reason = "adaptive concurrency"
+	}
+
+	switch {
+	case l.opts.AdvisoryMode:
+		// Grab the execution slot anyway and report the advisory rejection.
+		l.concurrency++
+		l.m.Unlock()
+		_ = l.reject(ctx, ri, reason) // actually ignore the error
+		return l.doneCallback(ctx), nil
+	case l.opts.MaxQueueWait == 0 || priority == PrioritySheddable:
+		l.m.Unlock()
+		return nil, l.reject(ctx, ri, reason)
+	case l.queue.Len() >= l.opts.MaxQueueLength:
+		l.m.Unlock()
+		return nil, l.reject(ctx, ri, "queue length")
+	}
+
+	w := l.queue.push(l.flow(ri), l.weight(ri), priority)
+	l.m.Unlock()
+
+	start := clock.Now(ctx)
+	waitCtx, cancel := clock.WithTimeout(ctx, l.opts.MaxQueueWait)
+	defer cancel()
+	select {
+	case <-w.ready:
+	case <-waitCtx.Done():
+	}
+
+	l.m.Lock()
+	admitted := w.admitted
+	if !admitted {
+		l.queue.remove(w)
+	}
+	l.m.Unlock()
+
+	result := "admitted"
+	if !admitted {
+		result = "rejected"
+	}
+	queueWaitDist.Add(ctx, float64(clock.Since(ctx, start).Milliseconds()), l.opts.Name, result)
+
+	if !admitted {
+		return nil, l.reject(ctx, ri, "queue wait")
+	}
+	return l.doneCallback(ctx), nil
+}
+
+// admissibleLocked returns true if a request with the given priority can be
+// processed right away.
+func (l *Limiter) admissibleLocked(priority Priority) bool {
+	switch {
+	case l.concurrency >= l.opts.MaxConcurrentRequests:
+		return false
+	case priority == PriorityCritical:
+		return true
+	default:
+		// Don't jump ahead of waiting requests.
+		return float64(l.concurrency) < l.limit && l.queue.Len() == 0
+	}
+}
+
+// doneCallback returns a callback that releases the execution slot.
+func (l *Limiter) doneCallback(ctx context.Context) func() {
+	start := clock.Now(ctx)
+	return func() {
+		now := clock.Now(ctx)
+
+		l.m.Lock()
+		defer l.m.Unlock()
+
+		if l.algo != nil {
+			l.limit = l.algo.update(now, now.Sub(start), l.concurrency)
 		}
-		// Now that we have definitely grabbed the execution slot, report the
-		// advisory rejection message. Doing it sooner may result in duplications.
-		if cur >= l.opts.MaxConcurrentRequests && l.opts.AdvisoryMode {
-			_ = l.reject(ctx, ri, "max concurrency") // actually ignore the error
+		l.concurrency--
+
+		// Admit as many waiting requests as the limit allows.
+		for {
+			w := l.queue.peek()
+			if w == nil {
+				return
+			}
+			if l.concurrency >= l.opts.MaxConcurrentRequests ||
+				(w.priority != PriorityCritical && float64(l.concurrency) >= l.limit) {
+				return
+			}
+			l.queue.pop()
+			l.concurrency++
+			w.admitted = true
+			close(w.ready)
 		}
-		return func() { atomic.AddInt64(&l.concurrency, -1) }, nil
 	}
 }
 
+// flow returns a key of the flow the request belongs to.
+func (l *Limiter) flow(ri *RequestInfo) string {
+	peer := ri.PeerKey
+	if peer == "" {
+		peer = ri.PeerLabel
+	}
+	return peer + "\x00" + ri.CallLabel
+}
+
+// weight returns a weight of the flow the request belongs to.
+func (l *Limiter) weight(ri *RequestInfo) float64 {
+	if l.opts.Weight != nil {
+		return l.opts.Weight(ri)
+	}
+	return 1
+}
+
 // reject is called when the request is rejected (either for real or in
 // advisory mode).
 //
 // It updates metrics and logs and returns an annotated ErrLimitReached error.
 func (l *Limiter) reject(ctx context.Context, ri *RequestInfo, reason string) error {
+	l.m.Lock()
+	if l.rejected == nil {
+		l.rejected = make(map[string]int64, 1)
+	}
+	l.rejected[reason]++
+	l.m.Unlock()
+
 	rejectedCounter.Add(ctx, 1, l.opts.Name, ri.CallLabel, ri.PeerLabel, reason)
 	if l.opts.AdvisoryMode {
 		logging.Warningf(ctx, "limiter %q in advisory mode: the request hit the %s limit", l.titleForLog, reason)
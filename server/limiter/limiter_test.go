@@ -18,6 +18,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"go.chromium.org/luci/common/tsmon"
 
@@ -54,6 +55,15 @@ func TestMaxConcurrencyLimit(t *testing.T) {
 			So(concurrencyMaxGauge.Get(ctx, limiterName), ShouldEqual, maxConcurrent)
 			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "max concurrency"), ShouldEqual, allConcurrent-maxConcurrent)
 
+			// The snapshot reflects the same state.
+			So(l.Snapshot(), ShouldResemble, Snapshot{
+				Name:                  limiterName,
+				MaxConcurrentRequests: maxConcurrent,
+				Limit:                 maxConcurrent,
+				Concurrency:           maxConcurrent,
+				Rejected:              map[string]int64{"max concurrency": allConcurrent - maxConcurrent},
+			})
+
 			// Unblock pending requests.
 			close(block)
 			wg.Wait()
@@ -90,6 +100,163 @@ func TestMaxConcurrencyLimit(t *testing.T) {
 	})
 }
 
+func TestQueuing(t *testing.T) {
+	t.Parallel()
+
+	Convey("With queuing", t, func() {
+		const limiterName = "test-limiter"
+
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		l, err := New(Options{
+			Name:                  limiterName,
+			MaxConcurrentRequests: 1,
+			MaxQueueWait:          time.Minute,
+			MaxQueueLength:        5,
+		})
+		So(err, ShouldBeNil)
+
+		// Occupy the only execution slot.
+		done, err := l.CheckRequest(ctx, &RequestInfo{CallLabel: "call", PeerLabel: "peer"})
+		So(err, ShouldBeNil)
+
+		queueLen := func() int {
+			l.m.Lock()
+			defer l.m.Unlock()
+			return l.queue.Len()
+		}
+
+		// enqueue starts a request in a goroutine and waits until it is queued.
+		//
+		// Once admitted, the request records its name and finishes right away.
+		admitted := make(chan string, 100)
+		rejected := make(chan error, 100)
+		enqueue := func(ctx context.Context, name string, ri *RequestInfo) {
+			before := queueLen()
+			go func() {
+				done, err := l.CheckRequest(ctx, ri)
+				if err != nil {
+					rejected <- err
+					return
+				}
+				admitted <- name
+				done()
+			}()
+			for queueLen() == before {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		collect := func(n int) (out []string) {
+			for i := 0; i < n; i++ {
+				out = append(out, <-admitted)
+			}
+			return
+		}
+
+		Convey("Fair across peers", func() {
+			enqueue(ctx, "a1", &RequestInfo{CallLabel: "call", PeerKey: "a"})
+			enqueue(ctx, "a2", &RequestInfo{CallLabel: "call", PeerKey: "a"})
+			enqueue(ctx, "a3", &RequestInfo{CallLabel: "call", PeerKey: "a"})
+			enqueue(ctx, "b1", &RequestInfo{CallLabel: "call", PeerKey: "b"})
+			enqueue(ctx, "c1", &RequestInfo{CallLabel: "call", PeerKey: "c", Priority: PriorityCritical})
+
+			l.ReportMetrics(ctx)
+			So(queueLenGauge.Get(ctx, limiterName), ShouldEqual, 5)
+
+			done()
+			So(collect(5), ShouldResemble, []string{"c1", "a1", "b1", "a2", "a3"})
+			So(queueWaitDist.Get(ctx, limiterName, "admitted").Count(), ShouldEqual, 5)
+
+			l.ReportMetrics(ctx)
+			So(concurrencyCurGauge.Get(ctx, limiterName), ShouldEqual, 0)
+			So(queueLenGauge.Get(ctx, limiterName), ShouldEqual, 0)
+		})
+
+		Convey("Sheddable requests are not queued", func() {
+			_, err := l.CheckRequest(ctx, &RequestInfo{
+				CallLabel: "call",
+				PeerLabel: "peer",
+				Priority:  PrioritySheddable,
+			})
+			So(err, ShouldErrLike, "max concurrency limit")
+			done()
+		})
+
+		Convey("Queue full", func() {
+			for i := 0; i < 5; i++ {
+				enqueue(ctx, "a", &RequestInfo{CallLabel: "call", PeerLabel: "peer"})
+			}
+			_, err := l.CheckRequest(ctx, &RequestInfo{CallLabel: "call", PeerLabel: "peer"})
+			So(err, ShouldErrLike, "queue length limit")
+			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "queue length"), ShouldEqual, 1)
+			done()
+			So(collect(5), ShouldHaveLength, 5)
+		})
+
+		Convey("Gives up waiting", func() {
+			cctx, cancel := context.WithCancel(ctx)
+			enqueue(cctx, "a", &RequestInfo{CallLabel: "call", PeerLabel: "peer"})
+			cancel()
+			So(<-rejected, ShouldErrLike, "queue wait limit")
+			So(queueLen(), ShouldEqual, 0)
+			So(queueWaitDist.Get(ctx, limiterName, "rejected").Count(), ShouldEqual, 1)
+			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "queue wait"), ShouldEqual, 1)
+			done()
+		})
+
+		Convey("Classify overrides priority", func() {
+			l.opts.Classify = func(ri *RequestInfo) Priority {
+				if ri.CallLabel == "important" {
+					return PriorityCritical
+				}
+				return PrioritySheddable
+			}
+			_, err := l.CheckRequest(ctx, &RequestInfo{CallLabel: "call", PeerLabel: "peer"})
+			So(err, ShouldErrLike, "max concurrency limit")
+			enqueue(ctx, "important", &RequestInfo{CallLabel: "important", PeerLabel: "peer"})
+			done()
+			So(collect(1), ShouldResemble, []string{"important"})
+		})
+	})
+
+	Convey("Adaptive limit", t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		l, err := New(Options{
+			Name:                  "test-limiter",
+			MaxConcurrentRequests: 3,
+			Adaptive: &AdaptiveOptions{
+				Algorithm:     AIMD,
+				InitialLimit:  1,
+				LatencyTarget: time.Hour,
+			},
+		})
+		So(err, ShouldBeNil)
+
+		done1, err := l.CheckRequest(ctx, &RequestInfo{})
+		So(err, ShouldBeNil)
+
+		// The adaptive limit is reached.
+		_, err = l.CheckRequest(ctx, &RequestInfo{})
+		So(err, ShouldErrLike, "adaptive concurrency limit")
+
+		// Critical requests are subject only to the hard limit.
+		done2, err := l.CheckRequest(ctx, &RequestInfo{Priority: PriorityCritical})
+		So(err, ShouldBeNil)
+		done3, err := l.CheckRequest(ctx, &RequestInfo{Priority: PriorityCritical})
+		So(err, ShouldBeNil)
+		_, err = l.CheckRequest(ctx, &RequestInfo{Priority: PriorityCritical})
+		So(err, ShouldErrLike, "max concurrency limit")
+
+		// Fast requests increase the limit.
+		done1()
+		done2()
+		done3()
+		l.ReportMetrics(ctx)
+		So(concurrencyLimitGauge.Get(ctx, "test-limiter"), ShouldEqual, 3)
+	})
+}
+
 func makeConcurrentRequests(ctx context.Context, l *Limiter, count int, block chan struct{}, wg *sync.WaitGroup) (accepted, rejected int) {
 	verdicts := make(chan error) // nil if accepted, non-nil if rejected
 
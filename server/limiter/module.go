@@ -34,8 +34,17 @@ const defaultMaxConcurrentRPCs = 100000 // e.g. unlimited
 // ModuleOptions contains configuration of the server module that installs
 // default limiters applied to all routes/services in the server.
 type ModuleOptions struct {
-	MaxConcurrentRPCs int64 // limit on a number of incoming concurrent RPCs (default is 100000, i.e. unlimited)
-	AdvisoryMode      bool  // if set, don't enforce MaxConcurrentRPCs, but still report violations
+	MaxConcurrentRPCs int64         // limit on a number of incoming concurrent RPCs (default is 100000, i.e. unlimited)
+	AdvisoryMode      bool          // if set, don't enforce MaxConcurrentRPCs, but still report violations
+	Adaptive          string        // if set, the adaptive limit algorithm to use ("gradient" or "aimd")
+	LatencyTarget     time.Duration // the latency target for "aimd" algorithm
+	MaxQueueWait      time.Duration // how long an RPC can wait for an execution slot (default is 0, i.e. no waiting)
+	MaxQueueLength    int           // the maximum number of waiting RPCs (default is 1000)
+
+	// Classify, if set, assigns priorities to RPCs. See Options.Classify.
+	Classify func(ri *RequestInfo) Priority
+	// Weight, if set, assigns weights to flows of RPCs. See Options.Weight.
+	Weight func(ri *RequestInfo) float64
 }
 
 // Register registers the command line flags.
@@ -55,6 +64,30 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		o.AdvisoryMode,
 		"If set, don't enforce -limiter-max-concurrent-rpcs, but still report violations",
 	)
+	f.StringVar(
+		&o.Adaptive,
+		"limiter-adaptive",
+		o.Adaptive,
+		`If set, adjust the limit on concurrent RPCs based on their latency using this algorithm ("gradient" or "aimd")`,
+	)
+	f.DurationVar(
+		&o.LatencyTarget,
+		"limiter-latency-target",
+		o.LatencyTarget,
+		`RPC latency target, required if -limiter-adaptive is "aimd"`,
+	)
+	f.DurationVar(
+		&o.MaxQueueWait,
+		"limiter-max-queue-wait",
+		o.MaxQueueWait,
+		"How long an RPC can wait for an execution slot when the limit is reached (default is 0, i.e. reject right away)",
+	)
+	f.IntVar(
+		&o.MaxQueueLength,
+		"limiter-max-queue-length",
+		o.MaxQueueLength,
+		"The maximum number of RPCs waiting for an execution slot (default is 1000)",
+	)
 }
 
 // NewModule returns a server module that installs default limiters applied to
@@ -100,15 +133,28 @@ func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts mo
 	if m.opts.MaxConcurrentRPCs == 0 {
 		m.opts.MaxConcurrentRPCs = defaultMaxConcurrentRPCs
 	}
+	var adaptive *AdaptiveOptions
+	if m.opts.Adaptive != "" {
+		adaptive = &AdaptiveOptions{
+			Algorithm:     AdaptiveAlgorithm(m.opts.Adaptive),
+			LatencyTarget: m.opts.LatencyTarget,
+		}
+	}
 	var err error
 	m.rpcLimiter, err = New(Options{
 		Name:                  "rpc",
 		AdvisoryMode:          m.opts.AdvisoryMode,
 		MaxConcurrentRequests: m.opts.MaxConcurrentRPCs,
+		Adaptive:              adaptive,
+		MaxQueueWait:          m.opts.MaxQueueWait,
+		MaxQueueLength:        m.opts.MaxQueueLength,
+		Classify:              m.opts.Classify,
+		Weight:                m.opts.Weight,
 	})
 	if err != nil {
 		return nil, err
 	}
+	registerActive(m.rpcLimiter)
 
 	// We want limiter's metrics to be reported before every flush (so the flushed
 	// values are as fresh as possible) and also once per second (to make the
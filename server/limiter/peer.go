@@ -38,3 +38,15 @@ func PeerLabelFromAuthState(ctx context.Context) string {
 	}
 	return "unknown"
 }
+
+// PeerKeyFromAuthState returns the identity of the peer from the auth.State in
+// the context or an empty string if it is unknown.
+//
+// Unlike the peer label, it has unbounded cardinality and must not be used in
+// metric fields. It is used to queue requests from different peers fairly.
+func PeerKeyFromAuthState(ctx context.Context) string {
+	if s := auth.GetState(ctx); s != nil {
+		return string(s.PeerIdentity())
+	}
+	return ""
+}
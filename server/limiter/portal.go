@@ -0,0 +1,90 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"context"
+	"html/template"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/server/portal"
+)
+
+// active is a list of limiters installed by the server module.
+//
+// They are displayed on the "Runtime status" portal page.
+var active struct {
+	sync.Mutex
+	limiters []*Limiter
+}
+
+// registerActive adds a limiter to the list of limiters displayed on the
+// portal page.
+func registerActive(l *Limiter) {
+	active.Lock()
+	defer active.Unlock()
+	active.limiters = append(active.limiters, l)
+}
+
+// activeSnapshots returns snapshots of all active limiters.
+func activeSnapshots() []Snapshot {
+	active.Lock()
+	defer active.Unlock()
+	out := make([]Snapshot, len(active.limiters))
+	for i, l := range active.limiters {
+		out[i] = l.Snapshot()
+	}
+	return out
+}
+
+var statusTmpl = template.Must(template.New("limiters").Parse(`
+{{if .}}
+<table class="table table-condensed">
+  <tr><th>Limiter</th><th>In flight</th><th>Limit</th><th>Max</th><th>Queued</th><th>Rejected</th></tr>
+  {{range .}}
+  <tr>
+    <td>{{.Name}}{{if .AdvisoryMode}} (advisory){{end}}</td>
+    <td>{{.Concurrency}}</td>
+    <td>{{.Limit}}</td>
+    <td>{{.MaxConcurrentRequests}}</td>
+    <td>{{.Queued}}</td>
+    <td>{{range $reason, $count := .Rejected}}{{$reason}}: {{$count}}<br>{{else}}none{{end}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No limiters are installed.</p>
+{{end}}
+`))
+
+func renderStatus(ctx context.Context) (template.HTML, error) {
+	snapshots := activeSnapshots()
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	out := strings.Builder{}
+	if err := statusTmpl.Execute(&out, snapshots); err != nil {
+		return "", err
+	}
+	return template.HTML(out.String()), nil
+}
+
+func init() {
+	portal.RegisterStatusSection(portal.StatusSection{
+		ID:     "limiter",
+		Title:  "Limiters",
+		Render: renderStatus,
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"container/heap"
+)
+
+// waiter is a request waiting for an execution slot.
+type waiter struct {
+	priority Priority
+	tag      float64       // the virtual start time, see fairQueue
+	seq      uint64        // breaks ties between equal tags in FIFO order
+	ready    chan struct{} // closed when the request is admitted
+	admitted bool          // true if the request is admitted
+	index    int           // index in waitersHeap or -1 if not there
+}
+
+// fairQueue orders waiting requests by priority and then by their virtual
+// start time, implementing start-time fair queuing among flows.
+//
+// Each flow (e.g. a peer calling some method) has a virtual time at which its
+// last queued request "finishes". A new request starts either at this time or
+// at the current virtual time (the start time of the last dispatched request),
+// whichever is later, and "finishes" 1/weight later. As a result, flows with
+// many queued requests don't starve flows with few, and flows with higher
+// weight get proportionally more slots.
+//
+// Not goroutine-safe.
+type fairQueue struct {
+	waiters waitersHeap
+	vtime   float64            // the start time of the last dispatched waiter
+	flows   map[string]float64 // flow => finish time of its last waiter
+	seq     uint64             // incremented for each new waiter
+}
+
+// Len is the number of waiting requests.
+func (q *fairQueue) Len() int {
+	return len(q.waiters)
+}
+
+// push adds a new waiter to the queue.
+func (q *fairQueue) push(flow string, weight float64, priority Priority) *waiter {
+	if weight <= 0 {
+		weight = 1
+	}
+	if q.flows == nil {
+		q.flows = make(map[string]float64, 1)
+	}
+	start := max(q.vtime, q.flows[flow])
+	q.flows[flow] = start + 1/weight
+	q.seq++
+	w := &waiter{
+		priority: priority,
+		tag:      start,
+		seq:      q.seq,
+		ready:    make(chan struct{}),
+	}
+	heap.Push(&q.waiters, w)
+	return w
+}
+
+// peek returns the waiter to be dispatched next or nil if the queue is empty.
+func (q *fairQueue) peek() *waiter {
+	if len(q.waiters) == 0 {
+		return nil
+	}
+	return q.waiters[0]
+}
+
+// pop removes and returns the waiter to be dispatched next.
+func (q *fairQueue) pop() *waiter {
+	w := heap.Pop(&q.waiters).(*waiter)
+	q.vtime = max(q.vtime, w.tag)
+	q.forgetIdleFlows()
+	return w
+}
+
+// remove removes a waiter which gave up waiting.
+func (q *fairQueue) remove(w *waiter) {
+	if w.index >= 0 {
+		heap.Remove(&q.waiters, w.index)
+		q.forgetIdleFlows()
+	}
+}
+
+// forgetIdleFlows forgets flows that have no waiters.
+func (q *fairQueue) forgetIdleFlows() {
+	switch {
+	case len(q.waiters) == 0:
+		// Start from scratch to keep virtual times small.
+		q.vtime = 0
+		clear(q.flows)
+	case len(q.flows) > 2*len(q.waiters)+16:
+		// Flows that finish before the current virtual time would start at the
+		// current virtual time anyway.
+		for flow, finish := range q.flows {
+			if finish <= q.vtime {
+				delete(q.flows, flow)
+			}
+		}
+	}
+}
+
+// waitersHeap implements heap.Interface.
+type waitersHeap []*waiter
+
+func (h waitersHeap) Len() int { return len(h) }
+
+func (h waitersHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	if h[i].tag != h[j].tag {
+		return h[i].tag < h[j].tag
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h waitersHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *waitersHeap) Push(x any) {
+	w := x.(*waiter)
+	w.index = len(*h)
+	*h = append(*h, w)
+}
+
+func (h *waitersHeap) Pop() any {
+	old := *h
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	w.index = -1
+	*h = old[:n-1]
+	return w
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFairQueue(t *testing.T) {
+	t.Parallel()
+
+	Convey("fairQueue", t, func() {
+		q := fairQueue{}
+		names := map[*waiter]string{}
+
+		push := func(name, flow string, weight float64, p Priority) *waiter {
+			w := q.push(flow, weight, p)
+			names[w] = name
+			return w
+		}
+		drain := func() (out []string) {
+			for q.Len() > 0 {
+				out = append(out, names[q.pop()])
+			}
+			return
+		}
+
+		Convey("Interleaves flows", func() {
+			push("a1", "a", 1, PriorityDefault)
+			push("a2", "a", 1, PriorityDefault)
+			push("a3", "a", 1, PriorityDefault)
+			push("b1", "b", 1, PriorityDefault)
+			push("b2", "b", 1, PriorityDefault)
+			So(drain(), ShouldResemble, []string{"a1", "b1", "a2", "b2", "a3"})
+		})
+
+		Convey("Respects weights", func() {
+			for _, n := range []string{"a1", "a2", "a3", "a4"} {
+				push(n, "a", 2, PriorityDefault)
+			}
+			push("b1", "b", 1, PriorityDefault)
+			push("b2", "b", 1, PriorityDefault)
+			So(drain(), ShouldResemble, []string{"a1", "b1", "a2", "a3", "b2", "a4"})
+		})
+
+		Convey("New flows start at the current virtual time", func() {
+			push("a1", "a", 1, PriorityDefault)
+			push("a2", "a", 1, PriorityDefault)
+			push("a3", "a", 1, PriorityDefault)
+			So(names[q.pop()], ShouldEqual, "a1")
+			So(names[q.pop()], ShouldEqual, "a2")
+			// "b" doesn't get a burst of credit for the time it was idle.
+			push("b1", "b", 1, PriorityDefault)
+			push("b2", "b", 1, PriorityDefault)
+			So(drain(), ShouldResemble, []string{"b1", "a3", "b2"})
+		})
+
+		Convey("Priority goes first", func() {
+			push("a1", "a", 1, PriorityDefault)
+			push("s1", "s", 1, PrioritySheddable)
+			push("c1", "c", 1, PriorityCritical)
+			So(drain(), ShouldResemble, []string{"c1", "a1", "s1"})
+		})
+
+		Convey("Remove", func() {
+			push("a1", "a", 1, PriorityDefault)
+			a2 := push("a2", "a", 1, PriorityDefault)
+			push("a3", "a", 1, PriorityDefault)
+			q.remove(a2)
+			So(a2.index, ShouldEqual, -1)
+			q.remove(a2) // noop
+			So(drain(), ShouldResemble, []string{"a1", "a3"})
+			So(q.flows, ShouldBeEmpty)
+			So(q.vtime, ShouldEqual, 0)
+		})
+	})
+}
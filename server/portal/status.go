@@ -0,0 +1,140 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portal
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/server/warmup"
+)
+
+// StatusSection is a read-only block of information about some runtime
+// subsystem of the process, displayed on the "Runtime status" portal page.
+//
+// Packages that implement such subsystems (e.g. server/tq or server/cron)
+// register their sections via RegisterStatusSection(...) call during init()
+// time. Sections should be rendered from the in-memory state of the process.
+type StatusSection struct {
+	// ID is a unique identifier of the section, sections are sorted by it.
+	ID string
+	// Title is displayed as the header of the section.
+	Title string
+	// Render returns HTML with the status of the subsystem.
+	Render func(ctx context.Context) (template.HTML, error)
+}
+
+// RegisterStatusSection adds a section to the "Runtime status" portal page.
+//
+// Should be called once when application starts (e.g. from init() of a package
+// that defines the section). Panics if a section with such ID is already
+// registered.
+func RegisterStatusSection(s StatusSection) {
+	statusSections.Lock()
+	defer statusSections.Unlock()
+	if statusSections.m == nil {
+		statusSections.m = make(map[string]StatusSection)
+	}
+	if _, ok := statusSections.m[s.ID]; ok {
+		panic(fmt.Errorf("status section %q is already registered", s.ID))
+	}
+	statusSections.m[s.ID] = s
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Internal stuff.
+
+var statusSections struct {
+	sync.RWMutex
+	m map[string]StatusSection
+}
+
+// sortedStatusSections returns all registered sections sorted by ID.
+func sortedStatusSections() []StatusSection {
+	statusSections.RLock()
+	defer statusSections.RUnlock()
+	out := make([]StatusSection, 0, len(statusSections.m))
+	for _, s := range statusSections.m {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+var warmupTmpl = template.Must(template.New("warmup").Parse(`
+{{if .}}
+<p>Warmup started at {{.Started.Format "2006-01-02 15:04:05 MST"}}.</p>
+<table class="table table-condensed">
+  <tr><th>Callback</th><th>Duration</th><th>Result</th></tr>
+  {{range .Callbacks}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{.Duration}}</td>
+    <td>{{if .Error}}{{.Error}}{{else}}OK{{end}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>The warmup hasn't run in this process.</p>
+{{end}}
+`))
+
+// renderWarmupStatus renders the report of the last warmup.
+func renderWarmupStatus(ctx context.Context) (template.HTML, error) {
+	out := strings.Builder{}
+	if err := warmupTmpl.Execute(&out, warmup.LastReport()); err != nil {
+		return "", err
+	}
+	return template.HTML(out.String()), nil
+}
+
+type statusPage struct {
+	BasePage
+}
+
+func (statusPage) Title(ctx context.Context) (string, error) {
+	return "Runtime status", nil
+}
+
+func (statusPage) Overview(ctx context.Context) (template.HTML, error) {
+	out := strings.Builder{}
+	out.WriteString(`<p>This page shows the state of runtime subsystems as seen
+		by the process that handled this request. Other replicas of the server may
+		show different data. Everything here is kept in memory and is reset when
+		the process restarts.</p>`)
+	for _, s := range sortedStatusSections() {
+		fmt.Fprintf(&out, "<h4>%s</h4>\n", template.HTMLEscapeString(s.Title))
+		html, err := s.Render(ctx)
+		if err != nil {
+			fmt.Fprintf(&out, "<p><b>Failed to render:</b> %s</p>\n", template.HTMLEscapeString(err.Error()))
+			continue
+		}
+		out.WriteString(string(html))
+	}
+	return template.HTML(out.String()), nil
+}
+
+func init() {
+	RegisterStatusSection(StatusSection{
+		ID:     "warmup",
+		Title:  "Warmup",
+		Render: renderWarmupStatus,
+	})
+	RegisterPage("status", statusPage{})
+}
@@ -16,12 +16,27 @@ package quota
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/realms"
 
 	"go.chromium.org/luci/server/quota/quotapb"
 )
 
+var (
+	permAccountsList = realms.RegisterPermission("quota.accounts.list")
+	permPoliciesRead = realms.RegisterPermission("quota.policies.read")
+)
+
+// defaultTopAccounts is how many accounts TopConsumers and TopDenied return by
+// default.
+const defaultTopAccounts = 20
+
 // Ensure quotaAdmin implements QuotaAdminServer at compile-time.
 var _ quotapb.AdminServer = &quotaAdmin{}
 
@@ -45,3 +60,135 @@ func (q *quotaAdmin) WritePolicyConfig(ctx context.Context, req *quotapb.WritePo
 	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
 	panic("implement me")
 }
+
+// TopConsumers returns accounts which consumed the most units within a window.
+func (q *quotaAdmin) TopConsumers(ctx context.Context, req *quotapb.TopAccountsRequest) (rsp *quotapb.TopAccountsResponse, err error) {
+	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
+	return topAccountsRPC(ctx, req, TopConsumers)
+}
+
+// TopDenied returns accounts with the most units denied in the dry-run mode.
+func (q *quotaAdmin) TopDenied(ctx context.Context, req *quotapb.TopAccountsRequest) (rsp *quotapb.TopAccountsResponse, err error) {
+	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
+	return topAccountsRPC(ctx, req, TopDenied)
+}
+
+// RefillHistory returns recent refills of accounts which use a policy.
+func (q *quotaAdmin) RefillHistory(ctx context.Context, req *quotapb.RefillHistoryRequest) (rsp *quotapb.RefillHistoryResponse, err error) {
+	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
+	if err := req.Validate(); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+	switch ok, err := canReadPolicy(ctx, req.Policy); {
+	case err != nil:
+		return nil, err
+	case !ok:
+		return nil, appstatus.Errorf(codes.PermissionDenied, "no %q permission", permPoliciesRead)
+	}
+
+	events, err := RefillHistory(ctx, req.Policy, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	rsp = &quotapb.RefillHistoryResponse{}
+	for _, e := range events {
+		switch ok, err := canListAccount(ctx, e.Account); {
+		case err != nil:
+			return nil, err
+		case ok:
+			rsp.Refills = append(rsp.Refills, &quotapb.RefillHistoryResponse_Refill{
+				Time:    timestamppb.New(e.Time),
+				Account: e.Account,
+				Units:   e.Units,
+				Balance: e.Balance,
+			})
+		}
+	}
+	return rsp, nil
+}
+
+// RefilledPolicies returns policies with a non-empty refill history.
+func (q *quotaAdmin) RefilledPolicies(ctx context.Context, req *quotapb.RefilledPoliciesRequest) (rsp *quotapb.RefilledPoliciesResponse, err error) {
+	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
+	policies, err := RefilledPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rsp = &quotapb.RefilledPoliciesResponse{}
+	for _, policy := range policies {
+		switch ok, err := canReadPolicy(ctx, policy); {
+		case err != nil:
+			return nil, err
+		case ok:
+			rsp.Policies = append(rsp.Policies, policy)
+		}
+	}
+	return rsp, nil
+}
+
+// topAccountsRPC implements TopConsumers and TopDenied RPCs.
+func topAccountsRPC(ctx context.Context, req *quotapb.TopAccountsRequest, top func(context.Context, time.Duration, int) ([]AccountUsage, error)) (*quotapb.TopAccountsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultTopAccounts
+	}
+
+	usage, err := top(ctx, req.Window.AsDuration(), limit)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &quotapb.TopAccountsResponse{}
+	for _, u := range usage {
+		switch ok, err := canListAccount(ctx, u.Account); {
+		case err != nil:
+			return nil, err
+		case ok:
+			rsp.Accounts = append(rsp.Accounts, &quotapb.TopAccountsResponse_AccountUsage{
+				Account: u.Account,
+				Units:   u.Units,
+			})
+		}
+	}
+	return rsp, nil
+}
+
+// canListAccount checks `quota.accounts.list` permission for the account.
+func canListAccount(ctx context.Context, id *quotapb.AccountID) (bool, error) {
+	return hasPermission(ctx, permAccountsList, id.AppId, id.Realm, realms.Attrs{
+		"app_id":        id.AppId,
+		"resource_type": id.ResourceType,
+		"namespace":     id.Namespace,
+	})
+}
+
+// canReadPolicy checks `quota.policies.read` permission for the policy.
+func canReadPolicy(ctx context.Context, id *quotapb.PolicyID) (bool, error) {
+	return hasPermission(ctx, permPoliciesRead, id.Config.AppId, id.Config.Realm, realms.Attrs{
+		"app_id": id.Config.AppId,
+	})
+}
+
+// hasPermission checks the permission in the given realm or in the
+// "@internal:<app-id>" realm, see "Access control and Administration" in the
+// package doc.
+func hasPermission(ctx context.Context, perm realms.Permission, appID, realm string, attrs realms.Attrs) (bool, error) {
+	candidates := make([]string, 0, 2)
+	if realm != "" {
+		candidates = append(candidates, realm)
+	}
+	if appID != "" {
+		candidates = append(candidates, "@internal:"+appID)
+	}
+	for _, r := range candidates {
+		switch ok, err := auth.HasPermission(ctx, perm, r, attrs); {
+		case err != nil:
+			return false, err
+		case ok:
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/redisconn"
+
+	"go.chromium.org/luci/server/quota/internal/quotakeys"
+	"go.chromium.org/luci/server/quota/quotapb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestAdminStats(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With stats`, t, func() {
+		s, err := miniredis.Run()
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		ctx := context.Background()
+		ctx, _ = testclock.UseTime(ctx, testclock.TestRecentTimeUTC.Round(time.Minute))
+		s.SetTime(clock.Now(ctx))
+		ctx = redisconn.UsePool(ctx, &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", s.Addr())
+			},
+		})
+
+		visible := &quotapb.AccountID{AppId: "app", Realm: "proj:visible", Namespace: "ns", Name: "a", ResourceType: "qps"}
+		hidden := &quotapb.AccountID{AppId: "app", Realm: "proj:hidden", Namespace: "ns", Name: "b", ResourceType: "qps"}
+		policy := &quotapb.PolicyID{
+			Config: &quotapb.PolicyConfigID{AppId: "app", Realm: "proj:policies", Version: "v1"},
+			Key:    &quotapb.PolicyKey{Namespace: "ns", Name: "limited", ResourceType: "qps"},
+		}
+
+		err = withRedisConn(ctx, func(conn redis.Conn) error {
+			now := clock.Now(ctx)
+			sendBucketIncr(conn, now, statsConsumed, quotakeys.AccountKey(visible), 5)
+			sendBucketIncr(conn, now, statsConsumed, quotakeys.AccountKey(hidden), 10)
+			sendBucketIncr(conn, now, statsDenied, quotakeys.AccountKey(visible), 3)
+			for _, id := range []*quotapb.AccountID{hidden, visible} {
+				blob, err := json.Marshal(&refillRecord{
+					Time:    now.UTC(),
+					Account: quotakeys.AccountKey(id),
+					Units:   1,
+					Balance: 2,
+				})
+				So(err, ShouldBeNil)
+				conn.Send("LPUSH", quotakeys.RefillHistoryKey(quotakeys.PolicyRef(policy)), blob)
+			}
+			_, err := conn.Do("")
+			return err
+		})
+		So(err, ShouldBeNil)
+
+		srv := &quotaAdmin{}
+		user := "user:someone@example.com"
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: identity.Identity(user),
+			FakeDB: authtest.NewFakeDB(
+				authtest.MockPermission(identity.Identity(user), "proj:visible", permAccountsList),
+				authtest.MockPermission(identity.Identity(user), "proj:policies", permPoliciesRead),
+			),
+		})
+
+		Convey(`TopConsumers`, func() {
+			rsp, err := srv.TopConsumers(ctx, &quotapb.TopAccountsRequest{Window: durationpb.New(time.Hour)})
+			So(err, ShouldBeNil)
+			So(rsp, ShouldResembleProto, &quotapb.TopAccountsResponse{
+				Accounts: []*quotapb.TopAccountsResponse_AccountUsage{
+					{Account: visible, Units: 5},
+				},
+			})
+		})
+
+		Convey(`TopDenied`, func() {
+			rsp, err := srv.TopDenied(ctx, &quotapb.TopAccountsRequest{Window: durationpb.New(time.Hour)})
+			So(err, ShouldBeNil)
+			So(rsp, ShouldResembleProto, &quotapb.TopAccountsResponse{
+				Accounts: []*quotapb.TopAccountsResponse_AccountUsage{
+					{Account: visible, Units: 3},
+				},
+			})
+		})
+
+		Convey(`Internal realm grants access to all accounts`, func() {
+			ctx := auth.WithState(ctx, &authtest.FakeState{
+				Identity: identity.Identity(user),
+				FakeDB: authtest.NewFakeDB(
+					authtest.MockPermission(identity.Identity(user), "@internal:app", permAccountsList),
+				),
+			})
+			rsp, err := srv.TopConsumers(ctx, &quotapb.TopAccountsRequest{Window: durationpb.New(time.Hour), Limit: 1})
+			So(err, ShouldBeNil)
+			So(rsp.Accounts, ShouldHaveLength, 1)
+			So(rsp.Accounts[0].Account, ShouldResembleProto, hidden)
+		})
+
+		Convey(`Bad window`, func() {
+			_, err := srv.TopConsumers(ctx, &quotapb.TopAccountsRequest{Window: durationpb.New(48 * time.Hour)})
+			So(grpcutil.Code(err), ShouldEqual, codes.InvalidArgument)
+			_, err = srv.TopConsumers(ctx, &quotapb.TopAccountsRequest{})
+			So(grpcutil.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey(`RefillHistory`, func() {
+			rsp, err := srv.RefillHistory(ctx, &quotapb.RefillHistoryRequest{Policy: policy})
+			So(err, ShouldBeNil)
+			So(rsp.Refills, ShouldHaveLength, 1)
+			So(rsp.Refills[0].Account, ShouldResembleProto, visible)
+			So(rsp.Refills[0].Time.AsTime(), ShouldEqual, clock.Now(ctx).UTC())
+			So(rsp.Refills[0].Units, ShouldEqual, 1)
+			So(rsp.Refills[0].Balance, ShouldEqual, 2)
+
+			ctx := auth.WithState(ctx, &authtest.FakeState{Identity: identity.Identity(user)})
+			_, err = srv.RefillHistory(ctx, &quotapb.RefillHistoryRequest{Policy: policy})
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey(`RefilledPolicies`, func() {
+			rsp, err := srv.RefilledPolicies(ctx, &quotapb.RefilledPoliciesRequest{})
+			So(err, ShouldBeNil)
+			So(rsp.Policies, ShouldResembleProto, []*quotapb.PolicyID{policy})
+
+			ctx := auth.WithState(ctx, &authtest.FakeState{Identity: identity.Identity(user)})
+			rsp, err = srv.RefilledPolicies(ctx, &quotapb.RefilledPoliciesRequest{})
+			So(err, ShouldBeNil)
+			So(rsp.Policies, ShouldBeEmpty)
+		})
+	})
+}
@@ -316,6 +316,27 @@
 // appropriate access checks before computing the Accounts/Policies to interact
 // with.
 //
+// # Usage accounting and dry-run mode
+//
+// If the module runs with `-quota-accounting` flag (or ApplyOps is called with
+// a context from WithAccounting), ApplyOps additionally records:
+//   - Consumption per account (the sum of negative deltas of CURRENT_BALANCE
+//     ops) in per-minute and per-hour buckets. TopConsumers returns the
+//     leaderboard of accounts over a sliding window of up to a day.
+//   - Refills of accounts noticed when ApplyOps touches them, in a short
+//     per-policy history. See RefillHistory.
+//
+// With `-quota-dry-run` flag (or WithDryRun), ops which exceed policy bounds
+// are logged, counted in the "quota/dry_run/denials" metric and recorded in
+// the TopDenied leaderboard, but then applied anyway. This is useful when
+// introducing new or stricter policies.
+//
+// Both leaderboards and the refill history are shown on the "Quota usage"
+// page of the admin portal and are available via TopConsumers, TopDenied,
+// RefillHistory and RefilledPolicies RPCs of the administration service. The
+// RPCs return only accounts the caller has `quota.accounts.list` permission
+// for and only policies with `quota.policies.read` permission.
+//
 // # Implementation notes - Refill Interval
 //
 // Initially the Quota library implemented a "units/second" refill system. This
@@ -43,6 +43,8 @@ const (
 	accountKeyPrefix      = redisKeyPrefix + QuotaFieldDelim + "a" + QuotaFieldDelim
 	policyConfigPrefix    = redisKeyPrefix + QuotaFieldDelim + "p" + QuotaFieldDelim
 	requestDedupKeyPrefix = redisKeyPrefix + QuotaFieldDelim + "r" + QuotaFieldDelim
+	statsKeyPrefix        = redisKeyPrefix + QuotaFieldDelim + "s" + QuotaFieldDelim
+	refillHistoryPrefix   = statsKeyPrefix + "r" + QuotaFieldDelim
 )
 
 // parseRedisKey will parse a quota library redis key, and populate the given
@@ -184,3 +186,58 @@ func ParseRequestDedupKey(key string) (*quotapb.RequestDedupKey, error) {
 func RequestDedupKey(id *quotapb.RequestDedupKey) string {
 	return serializeRedisKey(requestDedupKeyPrefix, id)
 }
+
+// StatsBucketKey returns the full redis key for a usage statistics bucket.
+//
+// Args:
+//   - kind identifies the statistic (e.g. "c" for consumption).
+//   - resolution identifies the size of the bucket (e.g. "m" for a minute).
+//   - bucket is the number of the bucket since the Unix epoch.
+//
+// Example (`StatsBucketKey("c", "m", 28000000)`):
+//
+//	"a~s~c~m~28000000
+func StatsBucketKey(kind, resolution string, bucket int64) string {
+	return statsKeyPrefix + kind + QuotaFieldDelim + resolution + QuotaFieldDelim + strconv.FormatInt(bucket, 10)
+}
+
+// StatsTempKey returns the full redis key for a temporary statistics value
+// with the given unique suffix.
+func StatsTempKey(suffix string) string {
+	return statsKeyPrefix + "t" + QuotaFieldDelim + suffix
+}
+
+// RefillHistoryKey returns the full redis key for the refill history of the
+// given policy.
+//
+// Example:
+//
+//	"a~s~r~"a~p~app~proj:@project~1~deadbeef~ns~name~resource
+func RefillHistoryKey(ref *quotapb.PolicyRef) string {
+	return refillHistoryPrefix + ref.Config + QuotaFieldDelim + ref.Key
+}
+
+// RefillHistoryPattern is a redis SCAN pattern matching all keys produced by
+// RefillHistoryKey.
+const RefillHistoryPattern = refillHistoryPrefix + "*"
+
+// ParseRefillHistoryKey parses a key produced by RefillHistoryKey and
+// extracts a PolicyID from it (or returns an error).
+func ParseRefillHistoryKey(key string) (*quotapb.PolicyID, error) {
+	if !strings.HasPrefix(key, refillHistoryPrefix) {
+		return nil, errors.New("incorrect prefix")
+	}
+	key = key[len(refillHistoryPrefix):]
+
+	// The config part has a fixed number of sections, the rest is the key.
+	configSections := strings.Count(policyConfigPrefix, QuotaFieldDelim) +
+		(&quotapb.PolicyConfigID{}).ProtoReflect().Descriptor().Fields().Len()
+	toks := strings.SplitN(key, QuotaFieldDelim, configSections+1)
+	if len(toks) != configSections+1 {
+		return nil, errors.Reason("incorrect number of sections: %d", len(toks)).Err()
+	}
+	return ParsePolicyRef(&quotapb.PolicyRef{
+		Config: strings.Join(toks[:configSections], QuotaFieldDelim),
+		Key:    toks[configSections],
+	})
+}
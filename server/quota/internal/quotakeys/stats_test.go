@@ -0,0 +1,60 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotakeys
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/server/quota/quotapb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStatsKeys(t *testing.T) {
+	t.Parallel()
+
+	Convey(`StatsBucketKey`, t, func() {
+		So(StatsBucketKey("c", "m", 28000000), ShouldEqual, `"a~s~c~m~28000000`)
+	})
+
+	Convey(`RefillHistoryKey`, t, func() {
+		id := &quotapb.PolicyID{
+			Config: &quotapb.PolicyConfigID{
+				AppId:         "app",
+				Realm:         "proj:@project",
+				VersionScheme: 1,
+				Version:       "deadbeef",
+			},
+			Key: &quotapb.PolicyKey{
+				Namespace:    "ns",
+				Name:         "name",
+				ResourceType: "resource",
+			},
+		}
+		key := RefillHistoryKey(PolicyRef(id))
+		So(key, ShouldEqual, `"a~s~r~"a~p~app~proj:@project~1~deadbeef~ns~name~resource`)
+
+		newID, err := ParseRefillHistoryKey(key)
+		So(err, ShouldBeNil)
+		So(newID, ShouldResembleProto, id)
+
+		_, err = ParseRefillHistoryKey(`"a~s~r~"a~p~app~proj:@project~1`)
+		So(err, ShouldErrLike, "incorrect number of sections")
+
+		_, err = ParseRefillHistoryKey(`"a~s~c~m~1`)
+		So(err, ShouldErrLike, "incorrect prefix")
+	})
+}
@@ -74,6 +74,13 @@ func withRedisConn(ctx context.Context, cb func(redis.Conn) error) (err error) {
 // Implements module.Module.
 func (m *quotaModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
 	quotapb.RegisterAdminServer(host, &quotaAdmin{})
+	if m.opts.Accounting {
+		ctx = WithAccounting(ctx)
+	}
+	if m.opts.DryRun {
+		logging.Warningf(ctx, "quota: the dry-run mode is enabled, policy bounds are not enforced")
+		ctx = WithDryRun(ctx)
+	}
 	return ctx, nil
 }
 
@@ -86,21 +93,50 @@ func (*quotaModule) Name() module.Name {
 // ModuleOptions is a set of configuration options for the quota module.
 type ModuleOptions struct {
 	// TODO(iannucci): add option to select alternate database?
+
+	// Accounting enables recording of usage statistics in all requests.
+	//
+	// See WithAccounting.
+	Accounting bool
+
+	// DryRun disables enforcement of policy bounds in all requests.
+	//
+	// See WithDryRun.
+	DryRun bool
 }
 
 // Register adds command line flags for these module options to the given
 // *flag.FlagSet. Mutates module options by initializing defaults.
 func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.BoolVar(
+		&o.Accounting,
+		"quota-accounting",
+		o.Accounting,
+		"If set, record per-account usage statistics, visible in the admin portal.",
+	)
+	f.BoolVar(
+		&o.DryRun,
+		"quota-dry-run",
+		o.DryRun,
+		"If set, ops which exceed policy bounds are only logged and recorded, but not denied.",
+	)
 }
 
 // NewModule returns a module.Module for the quota library initialized from the
 // given *ModuleOptions.
 func NewModule(opts *ModuleOptions) module.Module {
-	return &quotaModule{}
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &quotaModule{opts: opts}
 }
 
 // NewModuleFromFlags returns a module.Module for the quota library which can be
 // initialized from command line flags.
+//
+// Calling this function registers flags in flag.CommandLine.
 func NewModuleFromFlags() module.Module {
-	return NewModule(nil)
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
 }
@@ -0,0 +1,162 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"html/template"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/portal"
+	"go.chromium.org/luci/server/quota/internal/quotakeys"
+	"go.chromium.org/luci/server/quota/quotapb"
+)
+
+// portalTopN is how many accounts to show in each leaderboard.
+const portalTopN = 20
+
+// portalRefillLimit is how many refill events to show per policy.
+const portalRefillLimit = 10
+
+// portalWindows are windows of leaderboards on the portal page.
+var portalWindows = []time.Duration{5 * time.Minute, time.Hour, MaxUsageWindow}
+
+var usageTmpl = template.Must(template.New("usage").Parse(`
+{{if not .Accounting}}
+<p><b>Usage accounting is disabled in this process.</b> Statistics below are
+recorded by processes which run with <code>-quota-accounting</code> flag.</p>
+{{end}}
+{{if .DryRun}}
+<p><b>The dry-run mode is enabled in this process.</b> Ops which exceed policy
+bounds are recorded as denied, but applied anyway.</p>
+{{end}}
+{{range .Boards}}
+<h4>{{.Title}}</h4>
+{{if .Rows}}
+<table class="table table-condensed">
+  <tr><th>App</th><th>Realm</th><th>Namespace</th><th>Name</th><th>Resource</th><th>Units</th></tr>
+  {{range .Rows}}
+  <tr>
+    <td>{{.Account.AppId}}</td>
+    <td>{{.Account.Realm}}</td>
+    <td>{{.Account.Namespace}}</td>
+    <td>{{.Account.Name}}</td>
+    <td>{{.Account.ResourceType}}</td>
+    <td>{{.Units}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>Nothing recorded.</p>
+{{end}}
+{{end}}
+<h4>Refill history</h4>
+{{range .Refills}}
+<h5><code>{{.Policy}}</code></h5>
+<table class="table table-condensed">
+  <tr><th>Time</th><th>Account</th><th>Units</th><th>Balance</th></tr>
+  {{range .Events}}
+  <tr>
+    <td>{{.Time.Format "2006-01-02 15:04:05 MST"}}</td>
+    <td>{{.Account.Realm}} {{.Account.Namespace}} {{.Account.Name}}</td>
+    <td>+{{.Units}}</td>
+    <td>{{.Balance}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>Nothing recorded.</p>
+{{end}}
+`))
+
+type portalPage struct {
+	portal.BasePage
+}
+
+func (portalPage) Title(ctx context.Context) (string, error) {
+	return "Quota usage", nil
+}
+
+func (portalPage) Overview(ctx context.Context) (template.HTML, error) {
+	type board struct {
+		Title string
+		Rows  []AccountUsage
+	}
+	var boards []board
+	for _, window := range portalWindows {
+		rows, err := TopConsumers(ctx, window, portalTopN)
+		if err != nil {
+			return "", err
+		}
+		boards = append(boards, board{"Top consumers over " + shortDuration(window), rows})
+	}
+	for _, window := range portalWindows {
+		rows, err := TopDenied(ctx, window, portalTopN)
+		if err != nil {
+			return "", err
+		}
+		if len(rows) != 0 {
+			boards = append(boards, board{"Top dry-run denials over " + shortDuration(window), rows})
+		}
+	}
+
+	type refills struct {
+		Policy string
+		Events []*RefillEvent
+	}
+	var history []refills
+	policies, err := RefilledPolicies(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, policy := range policies {
+		events, err := RefillHistory(ctx, policy, portalRefillLimit)
+		if err != nil {
+			return "", err
+		}
+		history = append(history, refills{policyName(policy), events})
+	}
+
+	out := strings.Builder{}
+	err = usageTmpl.Execute(&out, map[string]any{
+		"Accounting": accountingEnabled(ctx),
+		"DryRun":     dryRunEnabled(ctx),
+		"Boards":     boards,
+		"Refills":    history,
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to render usage statistics").Err()
+	}
+	return template.HTML(out.String()), nil
+}
+
+// policyName is a human-readable name of a policy.
+func policyName(id *quotapb.PolicyID) string {
+	return id.Config.AppId + " " + id.Config.Realm + " " + id.Config.Version + " " + quotakeys.PolicyKey(id.Key)
+}
+
+// shortDuration formats durations like "5m" or "24h".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	s = strings.TrimSuffix(s, "0s")
+	return strings.TrimSuffix(s, "0m")
+}
+
+func init() {
+	portal.RegisterPage("quota", portalPage{})
+}
@@ -26,6 +26,7 @@ import (
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/proto/msgpackpb"
 
 	"go.chromium.org/luci/server/auth"
@@ -145,11 +146,45 @@ func ApplyOps(ctx context.Context, requestID string, requestTTL *durationpb.Dura
 	if err != nil {
 		return nil, err
 	}
+
+	var resp *quotapb.ApplyOpsResponse
+	err = withRedisConn(ctx, func(conn redis.Conn) (err error) {
+		var before map[string]*quotapb.Account
+		if accountingEnabled(ctx) {
+			if before, err = readAccounts(conn, inputMsg); err != nil {
+				return err
+			}
+		}
+
+		resp, err = updateAccounts(ctx, conn, keys, inputMsg)
+		if err == ErrQuotaApply && dryRunEnabled(ctx) {
+			if relaxed := ignoreBounds(inputMsg, resp); relaxed != nil {
+				if rerr := recordDenials(ctx, conn, inputMsg.Ops, resp); rerr != nil {
+					logging.Warningf(ctx, "quota: failed to record dry-run denials: %s", rerr)
+				}
+				resp, err = updateAccounts(ctx, conn, keys, relaxed)
+			}
+		}
+
+		if err == nil && before != nil {
+			if rerr := recordUsage(ctx, conn, inputMsg.Ops, before, resp); rerr != nil {
+				logging.Warningf(ctx, "quota: failed to record usage: %s", rerr)
+			}
+		}
+		return err
+	})
+	return resp, err
+}
+
+// updateAccounts runs UpdateAccountsScript.
+//
+// Returns ErrQuotaApply (along with the response) if some ops failed.
+func updateAccounts(ctx context.Context, conn redis.Conn, keys []string, inputMsg *quotapb.UpdateAccountsInput) (*quotapb.ApplyOpsResponse, error) {
 	input, err := msgpackpb.Marshal(
 		inputMsg, msgpackpb.Deterministic,
 		msgpackpb.WithStringInternTable(keys))
 	if err != nil {
-		return nil, errors.Annotate(err, "failed to marshal UpdateAccountsInput").Err()
+		return &quotapb.ApplyOpsResponse{}, errors.Annotate(err, "failed to marshal UpdateAccountsInput").Err()
 	}
 
 	fullArgs := make(redis.Args, 0, len(keys)+2)
@@ -158,23 +193,19 @@ func ApplyOps(ctx context.Context, requestID string, requestTTL *durationpb.Dura
 	fullArgs = fullArgs.Add(string(input))
 
 	resp := &quotapb.ApplyOpsResponse{}
-	err = withRedisConn(ctx, func(conn redis.Conn) error {
-		respRaw, err := redis.String(UpdateAccountsScript.DoContext(ctx, conn, fullArgs...))
-		if err != nil {
-			return errors.Annotate(err, "running UpdateAccountsScript").Err()
-		}
-		if err := msgpackpb.Unmarshal(msgpack.RawMessage(respRaw), resp); err != nil {
-			return err
-		}
-		for _, result := range resp.Results {
-			if result.Status != quotapb.OpResult_SUCCESS {
-				err = ErrQuotaApply
-				break
-			}
+	respRaw, err := redis.String(UpdateAccountsScript.DoContext(ctx, conn, fullArgs...))
+	if err != nil {
+		return resp, errors.Annotate(err, "running UpdateAccountsScript").Err()
+	}
+	if err := msgpackpb.Unmarshal(msgpack.RawMessage(respRaw), resp); err != nil {
+		return resp, err
+	}
+	for _, result := range resp.Results {
+		if result.Status != quotapb.OpResult_SUCCESS {
+			return resp, ErrQuotaApply
 		}
-		return err
-	})
-	return resp, err
+	}
+	return resp, nil
 }
 
 // GetAccounts fetches the list of requested accounts. If the account does not
@@ -12,3433 +12,3529 @@ func init() {
 			"go.chromium.org.luci.server.quota.quotapb.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 127, 120, 100, 71,
-			117, 32, 170, 251, 171, 187, 85, 210, 72, 173, 43, 217, 214, 244,
-			252, 170, 233, 153, 177, 164, 153, 86, 75, 163, 25, 219, 204, 12,
-			198, 171, 145, 228, 177, 140, 70, 154, 180, 52, 24, 155, 15, 203,
-			87, 221, 213, 234, 235, 185, 125, 111, 115, 239, 109, 105, 218, 224,
-			77, 30, 44, 100, 129, 143, 229, 153, 24, 204, 35, 16, 39, 4,
-			18, 32, 223, 11, 121, 129, 240, 194, 102, 191, 228, 37, 249, 246,
-			227, 101, 23, 146, 37, 9, 121, 132, 236, 247, 246, 125, 132, 236,
-			35, 228, 23, 144, 37, 144, 16, 150, 247, 157, 83, 63, 238, 237,
-			150, 198, 54, 4, 103, 255, 120, 204, 103, 127, 234, 83, 183, 234,
-			84, 213, 169, 83, 167, 78, 85, 157, 115, 138, 124, 234, 44, 57,
-			182, 29, 4, 219, 30, 155, 105, 133, 65, 28, 108, 181, 235, 51,
-			177, 219, 100, 81, 236, 52, 91, 101, 76, 178, 135, 121, 134, 178,
-			204, 80, 188, 68, 250, 55, 100, 30, 123, 156, 100, 35, 86, 13,
-			252, 90, 52, 174, 81, 109, 210, 168, 72, 208, 30, 35, 150, 239,
-			248, 65, 52, 174, 83, 109, 210, 170, 112, 224, 242, 27, 53, 50,
-			90, 13, 154, 229, 30, 164, 151, 135, 20, 202, 107, 144, 116, 77,
-			123, 100, 78, 100, 217, 14, 60, 199, 223, 46, 7, 225, 118, 170,
-			141, 157, 22, 139, 102, 110, 248, 193, 174, 159, 180, 183, 181, 245,
-			45, 77, 123, 191, 110, 92, 185, 118, 249, 35, 250, 209, 43, 188,
-			244, 53, 81, 164, 252, 16, 243, 188, 151, 67, 129, 13, 40, 251,
-			224, 191, 159, 33, 89, 219, 58, 218, 247, 14, 77, 35, 159, 30,
-			36, 218, 160, 109, 28, 237, 179, 231, 126, 115, 144, 98, 137, 106,
-			224, 209, 203, 237, 122, 157, 133, 17, 157, 166, 28, 215, 68, 68,
-			107, 78, 236, 80, 215, 143, 89, 88, 109, 56, 254, 54, 163, 245,
-			32, 108, 58, 49, 161, 11, 65, 171, 19, 186, 219, 141, 152, 206,
-			205, 206, 190, 68, 20, 160, 203, 126, 181, 76, 233, 188, 231, 81,
-			252, 22, 209, 144, 69, 44, 220, 97, 181, 50, 161, 141, 56, 110,
-			69, 23, 103, 102, 106, 108, 135, 121, 65, 139, 133, 145, 164, 73,
-			53, 104, 242, 158, 86, 3, 111, 122, 139, 55, 98, 134, 16, 90,
-			97, 53, 55, 138, 67, 119, 171, 29, 187, 129, 79, 29, 191, 70,
-			219, 17, 163, 174, 79, 163, 160, 29, 86, 25, 166, 108, 185, 190,
-			19, 118, 176, 93, 81, 137, 238, 186, 113, 131, 6, 33, 254, 13,
-			218, 49, 161, 205, 160, 230, 214, 221, 170, 3, 24, 74, 212, 9,
-			25, 109, 177, 176, 233, 198, 49, 171, 209, 86, 24, 236, 184, 53,
-			86, 163, 113, 195, 137, 105, 220, 128, 222, 121, 94, 176, 235, 250,
-			219, 20, 134, 212, 133, 66, 17, 20, 34, 180, 201, 226, 139, 132,
-			80, 248, 119, 186, 167, 97, 17, 13, 234, 178, 69, 213, 160, 198,
-			104, 179, 29, 197, 52, 100, 177, 227, 250, 136, 213, 217, 10, 118,
-			224, 147, 160, 24, 161, 126, 16, 187, 85, 86, 162, 113, 195, 141,
-			168, 231, 70, 49, 96, 72, 215, 232, 215, 122, 154, 83, 115, 163,
-			170, 231, 184, 77, 22, 150, 111, 213, 8, 215, 79, 211, 66, 54,
-			162, 21, 6, 181, 118, 149, 37, 237, 32, 73, 67, 254, 73, 237,
-			32, 84, 244, 174, 22, 84, 219, 77, 230, 199, 142, 28, 164, 153,
-			32, 164, 65, 220, 96, 33, 109, 58, 49, 11, 93, 199, 139, 18,
-			82, 227, 0, 197, 13, 70, 104, 186, 245, 170, 83, 171, 204, 197,
-			146, 128, 216, 119, 154, 12, 26, 148, 230, 45, 63, 72, 190, 33,
-			221, 221, 56, 130, 30, 249, 28, 85, 16, 70, 180, 233, 116, 232,
-			22, 3, 78, 169, 209, 56, 160, 204, 175, 5, 97, 196, 128, 41,
-			90, 97, 208, 12, 98, 70, 57, 77, 226, 136, 214, 88, 232, 238,
-			176, 26, 173, 135, 65, 147, 112, 42, 68, 65, 61, 222, 5, 54,
-			17, 28, 68, 163, 22, 171, 2, 7, 209, 86, 232, 2, 99, 133,
-			192, 59, 62, 231, 162, 40, 194, 182, 19, 186, 241, 192, 242, 58,
-			93, 95, 187, 127, 227, 161, 249, 202, 18, 93, 94, 167, 215, 42,
-			107, 175, 88, 94, 92, 90, 164, 151, 31, 166, 27, 15, 44, 209,
-			133, 181, 107, 15, 87, 150, 175, 60, 176, 65, 31, 88, 91, 89,
-			92, 170, 172, 211, 249, 213, 69, 186, 176, 182, 186, 81, 89, 190,
-			124, 125, 99, 173, 178, 78, 104, 113, 126, 157, 46, 175, 23, 241,
-			203, 252, 234, 195, 116, 233, 149, 215, 42, 75, 235, 235, 116, 173,
-			66, 151, 175, 94, 91, 89, 94, 90, 164, 15, 205, 87, 42, 243,
-			171, 27, 203, 75, 235, 37, 186, 188, 186, 176, 114, 125, 113, 121,
-			245, 74, 137, 94, 190, 190, 65, 87, 215, 54, 8, 93, 89, 190,
-			186, 188, 177, 180, 72, 55, 214, 74, 88, 237, 222, 114, 116, 237,
-			126, 122, 117, 169, 178, 240, 192, 252, 234, 198, 252, 229, 229, 149,
-			229, 141, 135, 177, 194, 251, 151, 55, 86, 161, 178, 251, 215, 42,
-			132, 206, 211, 107, 243, 149, 141, 229, 133, 235, 43, 243, 21, 122,
-			237, 122, 229, 218, 218, 250, 18, 133, 158, 45, 46, 175, 47, 172,
-			204, 47, 95, 93, 90, 44, 211, 229, 85, 186, 186, 70, 151, 94,
-			177, 180, 186, 65, 215, 31, 152, 95, 89, 233, 238, 40, 161, 107,
-			15, 173, 46, 85, 160, 245, 233, 110, 210, 203, 75, 116, 101, 121,
-			254, 242, 202, 18, 84, 133, 253, 92, 92, 174, 44, 45, 108, 64,
-			135, 146, 95, 11, 203, 139, 75, 171, 27, 243, 43, 37, 66, 215,
-			175, 45, 45, 44, 207, 175, 148, 232, 210, 43, 151, 174, 94, 91,
-			153, 175, 60, 92, 18, 72, 215, 151, 126, 228, 250, 210, 234, 198,
-			242, 252, 10, 93, 156, 191, 58, 127, 101, 105, 157, 78, 62, 31,
-			85, 174, 85, 214, 22, 174, 87, 150, 174, 66, 171, 215, 238, 167,
-			235, 215, 47, 175, 111, 44, 111, 92, 223, 88, 162, 87, 214, 214,
-			22, 145, 216, 235, 75, 149, 87, 44, 47, 44, 173, 95, 162, 43,
-			107, 235, 72, 176, 235, 235, 75, 37, 66, 23, 231, 55, 230, 177,
-			234, 107, 149, 181, 251, 151, 55, 214, 47, 193, 239, 203, 215, 215,
-			151, 145, 112, 203, 171, 27, 75, 149, 202, 245, 107, 27, 203, 107,
-			171, 83, 244, 129, 181, 135, 150, 94, 177, 84, 161, 11, 243, 215,
-			215, 151, 22, 145, 194, 107, 171, 208, 91, 224, 149, 165, 181, 202,
-			195, 128, 22, 232, 128, 35, 80, 162, 15, 61, 176, 180, 241, 192,
-			82, 5, 136, 138, 212, 154, 7, 50, 172, 111, 84, 150, 23, 54,
-			210, 217, 214, 42, 116, 99, 173, 178, 65, 82, 253, 164, 171, 75,
-			87, 86, 150, 175, 44, 173, 46, 44, 193, 231, 53, 64, 243, 208,
-			242, 250, 210, 20, 157, 175, 44, 175, 67, 134, 101, 172, 152, 62,
-			52, 255, 48, 93, 187, 142, 189, 134, 129, 186, 190, 190, 68, 248,
-			239, 20, 235, 150, 112, 60, 233, 242, 253, 116, 126, 241, 21, 203,
-			208, 114, 145, 251, 218, 218, 250, 250, 178, 96, 23, 36, 219, 194,
-			3, 130, 230, 101, 66, 114, 68, 211, 109, 131, 246, 141, 195, 175,
-			156, 109, 20, 251, 46, 145, 126, 162, 231, 78, 241, 159, 60, 241,
-			68, 223, 49, 76, 60, 198, 127, 242, 196, 147, 125, 203, 152, 56,
-			192, 127, 242, 196, 83, 125, 37, 76, 212, 248, 79, 158, 120, 103,
-			223, 12, 38, 138, 159, 60, 113, 162, 175, 136, 137, 132, 255, 228,
-			137, 147, 125, 199, 49, 241, 36, 255, 249, 127, 31, 33, 186, 217,
-			103, 103, 126, 92, 131, 165, 175, 240, 123, 71, 232, 60, 85, 75,
-			47, 10, 72, 22, 49, 63, 142, 168, 67, 91, 129, 235, 199, 40,
-			214, 220, 38, 44, 51, 53, 214, 98, 126, 141, 249, 40, 22, 29,
-			191, 195, 211, 159, 8, 124, 148, 38, 94, 80, 117, 60, 66, 171,
-			142, 199, 252, 154, 19, 150, 40, 243, 65, 250, 215, 168, 3, 184,
-			170, 65, 155, 151, 19, 218, 1, 202, 210, 122, 232, 84, 147, 21,
-			67, 126, 128, 5, 1, 84, 5, 132, 97, 197, 12, 60, 46, 20,
-			233, 70, 131, 9, 68, 46, 44, 165, 158, 19, 187, 59, 12, 132,
-			154, 227, 83, 214, 10, 170, 13, 234, 196, 244, 250, 198, 2, 109,
-			186, 53, 31, 37, 122, 224, 19, 250, 160, 227, 183, 97, 25, 56,
-			91, 162, 103, 47, 220, 51, 91, 146, 130, 186, 21, 6, 30, 107,
-			197, 110, 149, 94, 9, 217, 118, 16, 186, 142, 175, 90, 79, 119,
-			27, 110, 181, 65, 217, 205, 152, 65, 155, 80, 64, 239, 147, 107,
-			203, 169, 222, 216, 117, 66, 200, 17, 208, 14, 115, 66, 26, 248,
-			12, 4, 32, 44, 249, 77, 215, 111, 199, 12, 215, 75, 122, 247,
-			172, 234, 159, 23, 248, 219, 101, 186, 194, 156, 86, 210, 229, 144,
-			209, 98, 212, 100, 78, 200, 106, 69, 26, 5, 124, 1, 246, 3,
-			234, 49, 167, 69, 68, 54, 26, 59, 91, 30, 131, 158, 251, 140,
-			1, 93, 235, 65, 200, 85, 145, 22, 172, 173, 124, 65, 111, 71,
-			176, 42, 57, 244, 85, 115, 231, 167, 27, 65, 59, 164, 158, 235,
-			51, 39, 36, 20, 177, 191, 122, 242, 185, 149, 14, 24, 207, 25,
-			204, 57, 133, 82, 188, 193, 104, 136, 90, 142, 27, 225, 154, 64,
-			103, 103, 103, 207, 78, 227, 127, 27, 179, 179, 23, 241, 191, 71,
-			160, 235, 23, 46, 92, 184, 48, 125, 118, 110, 250, 220, 217, 141,
-			185, 115, 23, 239, 186, 112, 241, 174, 11, 229, 11, 242, 223, 35,
-			101, 122, 185, 67, 96, 32, 227, 208, 173, 198, 208, 192, 88, 116,
-			17, 177, 151, 232, 46, 163, 204, 143, 218, 33, 227, 169, 187, 140,
-			86, 129, 202, 129, 191, 195, 194, 152, 143, 47, 95, 148, 232, 171,
-			42, 247, 47, 16, 122, 238, 220, 185, 11, 73, 95, 118, 119, 119,
-			203, 46, 139, 235, 168, 33, 134, 245, 42, 252, 15, 57, 202, 241,
-			205, 120, 10, 52, 54, 70, 161, 102, 127, 59, 130, 78, 157, 160,
-			75, 55, 157, 102, 203, 99, 17, 33, 242, 39, 61, 123, 145, 46,
-			4, 205, 86, 59, 102, 169, 185, 128, 21, 94, 91, 91, 95, 126,
-			37, 125, 12, 40, 51, 57, 245, 88, 89, 168, 60, 73, 38, 165,
-			124, 94, 226, 95, 18, 229, 57, 98, 241, 166, 24, 224, 73, 44,
-			190, 122, 125, 101, 101, 106, 106, 223, 124, 200, 239, 147, 179, 83,
-			151, 82, 109, 154, 123, 190, 54, 109, 179, 24, 176, 4, 245, 154,
-			211, 73, 181, 45, 138, 195, 118, 53, 198, 10, 118, 28, 143, 198,
-			59, 162, 198, 174, 236, 119, 198, 59, 37, 138, 13, 186, 244, 253,
-			118, 105, 167, 28, 239, 0, 244, 92, 61, 226, 153, 218, 17, 171,
-			210, 211, 244, 236, 236, 108, 119, 15, 207, 221, 178, 135, 15, 185,
-			254, 185, 57, 250, 216, 21, 22, 175, 119, 162, 152, 53, 225, 243,
-			124, 116, 191, 235, 177, 141, 238, 129, 184, 127, 121, 101, 105, 99,
-			249, 234, 18, 173, 199, 162, 25, 183, 42, 115, 103, 61, 150, 45,
-			189, 190, 188, 186, 113, 247, 121, 26, 187, 213, 27, 17, 189, 151,
-			78, 78, 78, 242, 148, 169, 122, 92, 174, 237, 62, 224, 110, 55,
-			22, 157, 24, 75, 77, 209, 151, 190, 148, 158, 155, 155, 162, 175,
-			163, 248, 109, 37, 216, 149, 159, 36, 221, 102, 102, 232, 60, 180,
-			183, 22, 236, 70, 136, 18, 38, 203, 217, 217, 217, 148, 12, 139,
-			202, 42, 3, 151, 82, 103, 239, 222, 59, 141, 20, 54, 40, 126,
-			246, 238, 243, 231, 207, 223, 115, 238, 238, 217, 68, 108, 108, 177,
-			122, 16, 50, 122, 221, 119, 111, 74, 44, 23, 238, 153, 237, 197,
-			82, 254, 254, 6, 115, 146, 247, 159, 78, 78, 114, 162, 204, 224,
-			96, 193, 191, 41, 58, 157, 110, 206, 243, 112, 48, 224, 1, 114,
-			73, 60, 167, 82, 120, 144, 1, 166, 186, 24, 224, 252, 45, 25,
-			224, 65, 103, 199, 161, 143, 241, 129, 44, 87, 219, 97, 200, 252,
-			24, 178, 92, 117, 61, 207, 141, 82, 12, 0, 210, 148, 54, 49,
-			149, 222, 75, 111, 93, 224, 57, 216, 156, 222, 155, 164, 150, 125,
-			182, 123, 185, 237, 122, 53, 22, 78, 78, 65, 199, 214, 5, 133,
-			68, 21, 156, 48, 83, 28, 23, 252, 131, 60, 171, 188, 239, 174,
-			31, 67, 207, 69, 78, 222, 117, 209, 109, 164, 192, 84, 121, 11,
-			48, 99, 91, 18, 26, 220, 245, 60, 52, 88, 246, 163, 216, 241,
-			227, 178, 31, 236, 166, 186, 45, 82, 169, 31, 236, 210, 123, 105,
-			87, 158, 231, 236, 105, 210, 240, 231, 239, 178, 31, 236, 150, 183,
-			89, 188, 4, 204, 198, 211, 38, 167, 82, 61, 239, 238, 189, 200,
-			12, 192, 228, 45, 122, 122, 247, 45, 123, 42, 198, 75, 234, 25,
-			244, 90, 39, 110, 240, 141, 68, 23, 163, 165, 7, 106, 114, 170,
-			151, 11, 175, 176, 120, 33, 25, 247, 201, 41, 148, 245, 15, 174,
-			175, 173, 210, 171, 78, 171, 229, 250, 219, 132, 208, 101, 159, 167,
-			240, 93, 123, 9, 213, 128, 20, 157, 58, 45, 92, 234, 186, 20,
-			23, 190, 116, 8, 157, 129, 224, 2, 244, 61, 173, 63, 188, 42,
-			208, 93, 28, 80, 91, 74, 28, 13, 79, 133, 202, 138, 175, 5,
-			189, 225, 201, 233, 215, 54, 3, 63, 110, 60, 57, 253, 218, 154,
-			211, 121, 114, 227, 181, 176, 120, 63, 121, 241, 181, 77, 215, 127,
-			242, 226, 107, 35, 86, 125, 242, 85, 229, 215, 130, 186, 4, 83,
-			246, 201, 87, 63, 82, 36, 116, 183, 193, 66, 70, 121, 105, 64,
-			228, 120, 187, 78, 39, 162, 236, 38, 104, 112, 176, 217, 227, 186,
-			64, 29, 180, 128, 154, 187, 237, 198, 17, 40, 53, 30, 163, 162,
-			166, 18, 197, 170, 74, 132, 242, 202, 74, 20, 107, 43, 225, 98,
-			139, 85, 162, 94, 242, 4, 11, 131, 233, 150, 83, 171, 241, 237,
-			99, 188, 27, 72, 108, 204, 169, 54, 184, 78, 38, 245, 56, 208,
-			255, 132, 72, 41, 9, 13, 10, 22, 242, 237, 128, 182, 91, 168,
-			38, 200, 162, 147, 110, 153, 149, 69, 226, 217, 253, 181, 189, 169,
-			18, 193, 250, 131, 22, 199, 204, 107, 42, 62, 82, 164, 81, 187,
-			94, 119, 111, 130, 62, 234, 86, 29, 80, 176, 96, 20, 129, 15,
-			80, 19, 157, 44, 94, 223, 88, 40, 78, 93, 234, 74, 37, 92,
-			97, 124, 77, 219, 13, 89, 173, 76, 231, 41, 30, 175, 156, 227,
-			204, 16, 225, 158, 220, 125, 130, 133, 52, 106, 4, 109, 175, 38,
-			73, 217, 142, 24, 106, 147, 147, 78, 164, 106, 171, 209, 173, 14,
-			129, 102, 76, 193, 0, 248, 176, 11, 246, 185, 74, 179, 151, 149,
-			128, 144, 78, 87, 85, 45, 39, 140, 146, 106, 182, 24, 161, 168,
-			211, 129, 134, 83, 173, 178, 86, 76, 183, 130, 184, 129, 117, 66,
-			89, 126, 104, 32, 251, 16, 237, 105, 7, 168, 189, 65, 189, 30,
-			177, 24, 213, 181, 251, 131, 144, 50, 62, 215, 74, 180, 56, 55,
-			123, 246, 30, 88, 29, 206, 222, 181, 49, 123, 246, 226, 185, 217,
-			139, 103, 239, 42, 207, 158, 125, 164, 40, 184, 59, 162, 8, 171,
-			229, 165, 229, 68, 49, 161, 152, 19, 235, 15, 252, 68, 111, 190,
-			171, 68, 1, 91, 89, 76, 32, 103, 199, 89, 175, 134, 110, 43,
-			46, 129, 182, 219, 165, 170, 57, 20, 150, 71, 26, 108, 61, 206,
-			170, 49, 215, 242, 64, 117, 228, 204, 206, 249, 17, 217, 31, 196,
-			85, 205, 9, 107, 132, 190, 42, 14, 150, 215, 215, 214, 113, 146,
-			77, 78, 237, 163, 160, 150, 155, 193, 19, 174, 231, 57, 56, 187,
-			152, 63, 125, 125, 125, 166, 22, 84, 163, 153, 135, 216, 214, 76,
-			210, 148, 153, 10, 171, 179, 144, 249, 85, 54, 115, 197, 11, 182,
-			28, 111, 115, 13, 219, 16, 205, 64, 131, 102, 82, 149, 76, 225,
-			217, 85, 35, 168, 149, 161, 51, 92, 210, 148, 112, 158, 243, 38,
-			209, 199, 64, 99, 4, 162, 151, 229, 143, 199, 100, 135, 160, 171,
-			91, 76, 246, 150, 213, 200, 190, 93, 36, 244, 85, 143, 69, 113,
-			88, 199, 162, 169, 30, 5, 213, 168, 220, 226, 146, 13, 250, 50,
-			55, 227, 185, 91, 161, 19, 118, 80, 237, 46, 55, 226, 166, 119,
-			2, 127, 201, 178, 83, 120, 230, 66, 20, 35, 203, 74, 162, 22,
-			171, 210, 137, 83, 15, 79, 159, 106, 78, 159, 170, 109, 156, 122,
-			224, 226, 169, 171, 23, 79, 173, 151, 79, 213, 31, 153, 40, 211,
-			21, 247, 6, 219, 117, 35, 134, 219, 28, 32, 80, 50, 74, 237,
-			136, 113, 108, 15, 6, 53, 7, 153, 117, 34, 162, 175, 122, 108,
-			121, 125, 77, 42, 53, 247, 115, 97, 85, 19, 224, 228, 212, 99,
-			175, 158, 228, 39, 149, 66, 206, 61, 30, 212, 248, 72, 192, 143,
-			105, 220, 47, 56, 45, 23, 7, 68, 166, 242, 93, 4, 111, 235,
-			204, 94, 220, 216, 79, 89, 193, 169, 185, 197, 83, 115, 139, 132,
-			78, 1, 33, 131, 45, 60, 33, 116, 68, 63, 99, 22, 210, 170,
-			211, 194, 9, 18, 212, 233, 54, 243, 89, 232, 240, 169, 38, 167,
-			89, 196, 197, 178, 162, 127, 153, 16, 66, 6, 136, 97, 246, 105,
-			182, 249, 227, 90, 110, 132, 188, 79, 35, 166, 217, 167, 247, 217,
-			230, 91, 53, 125, 172, 240, 111, 52, 90, 73, 118, 184, 146, 247,
-			131, 58, 178, 60, 210, 56, 114, 253, 106, 90, 203, 34, 251, 171,
-			89, 244, 106, 59, 138, 129, 23, 158, 107, 91, 68, 246, 219, 23,
-			61, 66, 93, 191, 234, 181, 35, 119, 7, 54, 138, 7, 136, 5,
-			205, 179, 176, 125, 89, 9, 106, 0, 230, 134, 37, 104, 0, 104,
-			143, 146, 63, 227, 157, 209, 108, 243, 41, 77, 183, 11, 127, 164,
-			209, 213, 192, 159, 246, 217, 54, 223, 7, 119, 237, 166, 29, 185,
-			107, 132, 141, 228, 254, 187, 233, 85, 81, 80, 109, 48, 119, 28,
-			175, 205, 34, 126, 36, 153, 32, 195, 131, 211, 40, 118, 61, 143,
-			54, 156, 29, 70, 253, 116, 157, 136, 90, 20, 36, 124, 247, 198,
-			55, 232, 245, 32, 132, 141, 177, 60, 61, 232, 37, 152, 216, 52,
-			150, 196, 255, 100, 31, 162, 104, 22, 246, 83, 18, 69, 195, 110,
-			231, 14, 72, 208, 0, 48, 63, 178, 149, 225, 242, 149, 252, 254,
-			155, 13, 66, 123, 175, 50, 106, 44, 66, 241, 16, 132, 183, 186,
-			203, 184, 74, 70, 96, 139, 176, 168, 50, 174, 179, 216, 126, 9,
-			49, 235, 174, 199, 198, 53, 106, 76, 14, 204, 157, 236, 189, 171,
-			40, 119, 151, 192, 251, 130, 10, 150, 40, 126, 201, 36, 163, 251,
-			124, 181, 109, 98, 250, 78, 147, 225, 21, 73, 127, 5, 127, 219,
-			227, 36, 219, 114, 170, 55, 156, 109, 134, 55, 36, 253, 21, 9,
-			218, 71, 9, 145, 103, 45, 213, 206, 184, 65, 141, 201, 254, 74,
-			42, 197, 62, 67, 70, 90, 237, 45, 207, 173, 110, 166, 178, 17,
-			106, 76, 90, 149, 60, 255, 176, 152, 100, 158, 32, 195, 187, 204,
-			185, 145, 206, 58, 128, 89, 135, 32, 57, 149, 113, 129, 12, 54,
-			89, 20, 57, 219, 108, 19, 150, 175, 113, 19, 123, 79, 247, 244,
-			190, 183, 231, 3, 162, 212, 70, 167, 197, 236, 121, 210, 207, 252,
-			118, 147, 99, 176, 110, 65, 191, 37, 191, 221, 236, 197, 146, 131,
-			98, 2, 69, 54, 98, 225, 142, 91, 101, 227, 25, 68, 48, 177,
-			7, 193, 58, 255, 222, 139, 67, 150, 179, 23, 72, 63, 30, 221,
-			68, 110, 224, 143, 103, 17, 201, 169, 125, 70, 145, 121, 181, 94,
-			20, 73, 57, 251, 110, 146, 229, 202, 71, 52, 158, 163, 218, 228,
-			192, 220, 225, 125, 25, 97, 141, 231, 169, 200, 204, 246, 50, 201,
-			243, 219, 143, 77, 88, 104, 55, 93, 191, 30, 140, 247, 35, 130,
-			99, 123, 59, 130, 25, 23, 130, 26, 91, 246, 235, 65, 101, 40,
-			234, 130, 237, 219, 73, 38, 234, 248, 177, 115, 115, 124, 16, 57,
-			68, 64, 197, 79, 102, 200, 240, 11, 97, 177, 75, 196, 170, 67,
-			47, 199, 245, 239, 133, 6, 188, 76, 55, 17, 51, 223, 39, 17,
-			231, 201, 128, 207, 162, 152, 213, 56, 71, 24, 47, 144, 167, 8,
-			47, 180, 151, 165, 204, 239, 139, 165, 94, 73, 134, 85, 147, 54,
-			241, 208, 73, 240, 230, 204, 243, 181, 164, 188, 36, 203, 85, 160,
-			88, 101, 136, 117, 193, 246, 34, 33, 129, 207, 130, 250, 102, 141,
-			85, 189, 241, 220, 45, 168, 180, 6, 89, 246, 80, 41, 224, 169,
-			85, 207, 190, 144, 176, 90, 246, 22, 156, 114, 149, 79, 178, 61,
-			220, 118, 157, 12, 201, 75, 69, 209, 179, 126, 108, 68, 249, 121,
-			123, 86, 17, 197, 120, 199, 14, 132, 105, 208, 62, 65, 84, 194,
-			38, 178, 21, 65, 41, 52, 40, 19, 87, 157, 38, 43, 60, 65,
-			134, 186, 201, 99, 143, 17, 43, 138, 157, 48, 70, 46, 180, 42,
-			28, 176, 243, 196, 96, 126, 77, 220, 3, 195, 79, 251, 95, 36,
-			29, 54, 176, 195, 119, 238, 29, 209, 46, 204, 189, 253, 46, 220,
-			67, 14, 116, 117, 224, 133, 86, 93, 124, 29, 185, 109, 95, 212,
-			246, 43, 201, 88, 219, 87, 7, 170, 172, 182, 201, 171, 26, 255,
-			127, 179, 183, 224, 185, 235, 233, 220, 28, 75, 101, 180, 189, 55,
-			241, 116, 127, 238, 203, 217, 252, 143, 253, 216, 143, 253, 152, 94,
-			252, 205, 12, 25, 219, 111, 206, 236, 59, 125, 111, 39, 25, 191,
-			221, 220, 98, 33, 18, 201, 170, 8, 200, 158, 39, 150, 231, 108,
-			49, 111, 220, 164, 218, 228, 208, 220, 153, 23, 52, 43, 203, 43,
-			80, 164, 194, 75, 218, 47, 35, 166, 16, 209, 128, 225, 244, 11,
-			195, 0, 115, 169, 130, 229, 236, 67, 164, 31, 254, 114, 222, 200,
-			96, 155, 115, 144, 0, 124, 97, 23, 72, 142, 159, 156, 51, 185,
-			180, 41, 24, 24, 171, 198, 234, 78, 219, 139, 55, 81, 109, 64,
-			134, 239, 175, 12, 138, 196, 87, 64, 154, 125, 140, 12, 240, 89,
-			229, 250, 53, 118, 19, 165, 167, 85, 225, 19, 109, 25, 82, 160,
-			250, 199, 163, 192, 151, 172, 137, 85, 64, 2, 86, 127, 79, 175,
-			224, 62, 178, 127, 247, 246, 204, 165, 9, 50, 204, 181, 137, 77,
-			185, 235, 28, 31, 161, 218, 100, 174, 50, 196, 147, 215, 68, 106,
-			241, 19, 58, 49, 81, 176, 12, 147, 129, 141, 135, 175, 45, 109,
-			46, 174, 93, 191, 188, 178, 148, 215, 236, 33, 66, 48, 225, 254,
-			149, 181, 249, 141, 188, 174, 96, 60, 96, 203, 27, 170, 0, 63,
-			113, 204, 155, 233, 12, 231, 230, 242, 150, 157, 39, 131, 28, 193,
-			242, 43, 151, 22, 239, 62, 159, 207, 116, 167, 156, 155, 203, 103,
-			237, 3, 164, 31, 83, 46, 175, 173, 173, 228, 115, 10, 231, 250,
-			70, 101, 121, 245, 74, 190, 95, 225, 188, 82, 89, 187, 126, 45,
-			79, 20, 134, 171, 75, 235, 235, 243, 87, 150, 242, 3, 42, 199,
-			229, 135, 55, 150, 214, 243, 131, 93, 205, 58, 55, 151, 63, 160,
-			170, 88, 90, 189, 126, 53, 63, 100, 143, 144, 3, 188, 10, 217,
-			136, 225, 158, 164, 187, 207, 231, 243, 73, 67, 56, 150, 145, 174,
-			132, 187, 207, 231, 237, 226, 2, 177, 144, 13, 109, 155, 12, 173,
-			204, 95, 94, 90, 217, 92, 195, 27, 194, 249, 149, 188, 150, 164,
-			85, 150, 126, 228, 250, 114, 101, 105, 49, 175, 167, 211, 174, 45,
-			205, 111, 44, 45, 230, 141, 98, 149, 140, 237, 39, 80, 247, 157,
-			66, 41, 94, 208, 111, 193, 11, 136, 171, 151, 23, 138, 127, 170,
-			147, 209, 125, 22, 149, 125, 43, 185, 143, 88, 156, 151, 249, 50,
-			59, 181, 239, 234, 132, 156, 189, 103, 169, 197, 114, 105, 85, 195,
-			184, 133, 170, 1, 40, 246, 48, 236, 171, 247, 8, 127, 190, 62,
-			222, 253, 66, 214, 71, 76, 251, 222, 22, 1, 107, 159, 69, 224,
-			18, 25, 217, 131, 232, 5, 11, 227, 55, 104, 100, 252, 86, 196,
-			121, 30, 145, 168, 119, 137, 196, 75, 189, 20, 60, 126, 235, 65,
-			216, 51, 214, 63, 175, 145, 219, 247, 87, 41, 247, 109, 195, 203,
-			72, 134, 31, 33, 136, 241, 222, 187, 118, 93, 197, 207, 189, 131,
-			45, 74, 165, 87, 123, 227, 86, 122, 33, 111, 205, 158, 150, 190,
-			89, 39, 183, 237, 139, 124, 223, 134, 30, 33, 196, 245, 91, 237,
-			152, 171, 78, 92, 18, 247, 99, 10, 10, 47, 144, 178, 237, 88,
-			125, 55, 240, 59, 225, 73, 152, 225, 37, 73, 67, 77, 108, 232,
-			209, 91, 244, 116, 15, 99, 206, 146, 124, 213, 115, 153, 31, 111,
-			70, 113, 200, 156, 166, 235, 111, 227, 82, 147, 187, 104, 213, 29,
-			47, 98, 149, 97, 254, 121, 93, 126, 133, 18, 200, 64, 97, 170,
-			68, 166, 171, 4, 255, 172, 74, 20, 159, 234, 39, 3, 41, 5,
-			220, 62, 78, 6, 31, 119, 118, 156, 77, 185, 169, 226, 148, 24,
-			128, 180, 107, 98, 99, 53, 75, 198, 48, 75, 208, 142, 89, 184,
-			89, 245, 156, 40, 66, 162, 229, 48, 171, 13, 223, 214, 224, 211,
-			130, 252, 98, 223, 69, 70, 177, 68, 179, 237, 197, 110, 203, 99,
-			155, 176, 205, 139, 112, 201, 81, 45, 27, 129, 28, 87, 69, 6,
-			104, 81, 100, 47, 146, 35, 88, 76, 156, 90, 176, 77, 246, 154,
-			182, 227, 69, 155, 142, 95, 219, 108, 56, 81, 99, 124, 12, 16,
-			92, 214, 199, 181, 202, 65, 200, 120, 69, 228, 91, 194, 108, 243,
-			126, 237, 1, 39, 106, 216, 23, 201, 237, 136, 133, 159, 65, 111,
-			86, 27, 172, 122, 99, 179, 29, 215, 95, 50, 126, 40, 93, 63,
-			182, 144, 159, 110, 45, 64, 150, 235, 113, 253, 37, 246, 58, 25,
-			132, 193, 104, 186, 79, 176, 205, 122, 16, 226, 26, 58, 180, 143,
-			104, 74, 81, 176, 188, 38, 10, 92, 13, 106, 236, 162, 181, 126,
-			109, 105, 105, 177, 50, 32, 177, 220, 31, 132, 192, 80, 219, 129,
-			34, 240, 0, 103, 168, 237, 64, 146, 247, 46, 50, 90, 173, 242,
-			62, 187, 213, 77, 177, 25, 139, 198, 243, 93, 196, 170, 86, 175,
-			240, 12, 130, 199, 35, 251, 2, 185, 45, 33, 86, 186, 224, 200,
-			158, 94, 246, 22, 189, 139, 140, 182, 58, 123, 11, 218, 93, 53,
-			182, 58, 189, 197, 238, 33, 99, 173, 70, 107, 111, 185, 211, 233,
-			114, 118, 171, 209, 234, 45, 120, 10, 119, 230, 33, 195, 35, 216,
-			241, 59, 210, 217, 83, 31, 236, 50, 201, 87, 171, 155, 204, 119,
-			182, 60, 182, 233, 132, 204, 119, 162, 241, 99, 152, 217, 140, 195,
-			54, 171, 12, 85, 171, 75, 248, 113, 30, 191, 217, 167, 201, 72,
-			176, 245, 120, 149, 115, 228, 102, 43, 100, 117, 247, 230, 248, 73,
-			36, 239, 48, 124, 64, 126, 188, 134, 201, 246, 20, 201, 87, 163,
-			134, 19, 182, 80, 36, 71, 45, 167, 202, 198, 79, 241, 172, 60,
-			125, 85, 38, 195, 140, 136, 118, 221, 122, 44, 49, 78, 240, 25,
-			129, 105, 2, 219, 36, 201, 3, 37, 186, 42, 158, 196, 108, 67,
-			173, 70, 43, 93, 239, 9, 114, 0, 114, 38, 149, 78, 113, 197,
-			173, 213, 72, 213, 120, 158, 220, 14, 153, 154, 44, 118, 106, 78,
-			236, 164, 114, 151, 48, 55, 144, 253, 170, 248, 216, 213, 206, 176,
-			189, 213, 81, 140, 53, 205, 219, 9, 105, 146, 181, 94, 52, 229,
-			188, 120, 145, 12, 166, 249, 222, 238, 39, 156, 243, 243, 26, 40,
-			65, 11, 107, 139, 160, 190, 60, 178, 148, 215, 65, 141, 90, 89,
-			222, 88, 218, 172, 92, 95, 221, 88, 190, 186, 148, 55, 82, 138,
-			253, 131, 102, 238, 206, 252, 4, 104, 13, 67, 221, 59, 53, 251,
-			165, 228, 14, 121, 172, 18, 177, 120, 115, 215, 13, 113, 66, 54,
-			29, 190, 56, 42, 254, 25, 19, 185, 214, 89, 252, 144, 27, 138,
-			227, 82, 123, 133, 28, 243, 131, 77, 121, 56, 189, 153, 28, 104,
-			109, 58, 213, 42, 139, 162, 128, 47, 132, 10, 203, 97, 63, 88,
-			23, 153, 147, 21, 98, 94, 100, 237, 97, 95, 227, 86, 236, 123,
-			136, 244, 55, 157, 214, 38, 243, 227, 176, 131, 250, 121, 174, 146,
-			107, 58, 173, 37, 128, 255, 89, 182, 73, 15, 154, 57, 51, 111,
-			61, 104, 230, 172, 124, 230, 65, 51, 151, 201, 103, 31, 52, 115,
-			185, 124, 255, 131, 102, 174, 63, 79, 138, 239, 48, 201, 96, 90,
-			131, 135, 13, 81, 21, 215, 48, 13, 165, 220, 137, 231, 212, 247,
-			203, 11, 176, 184, 93, 204, 112, 117, 185, 194, 75, 130, 98, 1,
-			236, 199, 184, 122, 146, 171, 8, 200, 190, 66, 50, 143, 71, 136,
-			59, 131, 184, 247, 59, 13, 76, 225, 126, 112, 29, 145, 247, 63,
-			184, 190, 185, 186, 86, 185, 58, 191, 82, 17, 197, 237, 131, 196,
-			244, 156, 39, 58, 221, 203, 32, 38, 217, 101, 50, 220, 246, 119,
-			88, 232, 214, 93, 86, 219, 196, 92, 195, 233, 92, 67, 201, 215,
-			21, 200, 255, 2, 135, 241, 32, 49, 119, 153, 115, 163, 123, 177,
-			194, 164, 23, 113, 58, 205, 16, 11, 233, 107, 19, 34, 40, 156,
-			239, 179, 115, 196, 92, 88, 171, 192, 148, 202, 147, 65, 158, 186,
-			121, 109, 121, 105, 97, 41, 175, 23, 239, 34, 25, 78, 52, 152,
-			110, 138, 108, 249, 62, 1, 10, 28, 154, 252, 122, 253, 234, 229,
-			165, 74, 94, 223, 195, 44, 197, 136, 12, 166, 53, 249, 127, 158,
-			237, 252, 255, 161, 145, 129, 148, 102, 14, 42, 149, 227, 121, 193,
-			238, 166, 227, 185, 78, 36, 88, 137, 96, 210, 60, 164, 188, 208,
-			161, 251, 103, 154, 100, 86, 62, 83, 252, 41, 141, 228, 123, 85,
-			227, 158, 102, 106, 255, 35, 155, 89, 124, 175, 70, 134, 186, 245,
-			225, 158, 230, 29, 255, 31, 218, 188, 255, 71, 39, 7, 186, 180,
-			224, 23, 218, 186, 215, 144, 17, 183, 198, 154, 173, 32, 102, 126,
-			181, 179, 233, 177, 29, 230, 141, 23, 81, 200, 236, 61, 150, 236,
-			170, 161, 188, 156, 148, 91, 129, 98, 23, 71, 151, 23, 151, 174,
-			94, 91, 219, 88, 90, 93, 120, 120, 243, 250, 234, 203, 87, 215,
-			30, 90, 173, 228, 221, 158, 108, 47, 226, 180, 191, 70, 242, 189,
-			141, 178, 239, 32, 251, 53, 43, 223, 103, 143, 146, 225, 213, 181,
-			205, 245, 229, 197, 165, 205, 165, 251, 239, 95, 90, 216, 88, 231,
-			39, 39, 42, 247, 70, 215, 4, 47, 190, 199, 32, 163, 251, 180,
-			196, 158, 23, 123, 30, 190, 13, 155, 126, 33, 173, 47, 131, 214,
-			113, 205, 9, 99, 177, 69, 154, 34, 64, 37, 63, 6, 225, 26,
-			138, 19, 41, 190, 17, 26, 78, 210, 249, 161, 84, 137, 216, 173,
-			32, 114, 99, 119, 135, 109, 186, 190, 60, 190, 130, 141, 145, 89,
-			201, 203, 47, 203, 126, 172, 114, 203, 91, 178, 84, 110, 11, 93,
-			100, 242, 242, 139, 202, 125, 156, 12, 214, 130, 54, 104, 139, 60,
-			31, 172, 53, 90, 101, 128, 167, 169, 44, 98, 31, 144, 156, 155,
-			13, 86, 6, 120, 26, 207, 50, 65, 134, 157, 237, 237, 16, 144,
-			75, 68, 124, 103, 51, 164, 146, 49, 99, 225, 65, 146, 147, 116,
-			128, 197, 30, 40, 177, 217, 226, 219, 117, 125, 178, 191, 146, 243,
-			229, 199, 227, 100, 208, 141, 54, 147, 107, 0, 157, 234, 147, 185,
-			202, 128, 27, 169, 35, 212, 226, 207, 235, 100, 168, 251, 26, 195,
-			94, 36, 57, 47, 224, 62, 38, 226, 14, 109, 242, 121, 110, 62,
-			202, 43, 34, 127, 69, 149, 44, 252, 174, 70, 114, 50, 217, 190,
-			157, 152, 45, 39, 110, 32, 58, 235, 178, 158, 215, 42, 8, 67,
-			122, 212, 114, 124, 100, 1, 145, 14, 48, 140, 171, 199, 156, 26,
-			110, 155, 130, 102, 147, 249, 113, 36, 199, 85, 164, 47, 136, 100,
-			251, 12, 25, 137, 67, 199, 245, 186, 242, 154, 152, 55, 47, 63,
-			168, 204, 23, 201, 65, 137, 183, 198, 98, 167, 218, 96, 181, 164,
-			80, 6, 143, 71, 238, 16, 25, 22, 197, 119, 89, 182, 248, 89,
-			141, 140, 200, 141, 94, 77, 17, 235, 42, 33, 142, 239, 7, 113,
-			154, 92, 123, 89, 121, 79, 185, 242, 188, 42, 84, 73, 33, 40,
-			52, 9, 73, 190, 220, 146, 108, 199, 200, 128, 184, 163, 194, 139,
-			78, 126, 52, 64, 120, 18, 236, 8, 237, 49, 98, 109, 177, 109,
-			215, 23, 39, 207, 28, 144, 7, 56, 166, 58, 192, 185, 252, 47,
-			247, 247, 230, 202, 247, 28, 79, 68, 15, 104, 143, 76, 63, 175,
-			63, 87, 162, 221, 118, 185, 114, 21, 122, 93, 185, 42, 172, 238,
-			49, 188, 158, 126, 240, 79, 62, 163, 147, 172, 109, 77, 244, 189,
-			59, 171, 145, 143, 12, 163, 31, 215, 196, 15, 253, 184, 126, 232,
-			199, 245, 67, 63, 174, 31, 250, 113, 253, 208, 143, 235, 135, 126,
-			92, 223, 191, 31, 215, 220, 159, 232, 116, 190, 29, 55, 130, 240,
-			34, 189, 193, 252, 56, 240, 255, 69, 34, 216, 233, 228, 203, 49,
-			137, 190, 194, 9, 107, 206, 20, 161, 244, 178, 3, 51, 51, 240,
-			105, 16, 186, 219, 174, 239, 120, 123, 23, 160, 26, 139, 220, 109,
-			31, 45, 72, 233, 186, 227, 63, 238, 116, 232, 149, 6, 107, 58,
-			187, 78, 92, 162, 15, 178, 122, 157, 46, 50, 199, 47, 37, 198,
-			159, 145, 116, 195, 17, 103, 67, 41, 131, 55, 215, 99, 148, 175,
-			151, 91, 92, 10, 214, 88, 221, 245, 133, 128, 171, 7, 109, 31,
-			109, 175, 248, 138, 140, 185, 163, 50, 76, 128, 29, 199, 115, 107,
-			233, 100, 105, 212, 24, 135, 142, 31, 121, 104, 89, 90, 115, 67,
-			86, 141, 189, 14, 218, 165, 210, 125, 204, 153, 136, 146, 34, 142,
-			223, 17, 50, 209, 245, 249, 18, 10, 194, 114, 146, 149, 183, 203,
-			42, 79, 200, 213, 33, 16, 105, 212, 109, 182, 130, 48, 142, 166,
-			148, 151, 220, 148, 242, 146, 59, 211, 183, 40, 125, 223, 224, 39,
-			79, 44, 37, 190, 111, 37, 229, 251, 54, 221, 119, 86, 250, 190,
-			193, 79, 158, 88, 238, 187, 71, 58, 217, 193, 79, 158, 56, 147,
-			248, 190, 193, 79, 158, 56, 155, 120, 222, 205, 42, 207, 187, 243,
-			125, 135, 201, 143, 18, 61, 215, 143, 63, 11, 49, 237, 53, 29,
-			227, 11, 207, 22, 55, 72, 110, 186, 79, 8, 7, 172, 168, 197,
-			88, 141, 110, 177, 170, 3, 75, 120, 168, 20, 147, 233, 45, 96,
-			7, 66, 29, 111, 59, 8, 221, 184, 209, 140, 104, 45, 240, 39,
-			98, 186, 27, 132, 55, 104, 173, 141, 70, 229, 91, 65, 16, 71,
-			113, 200, 205, 211, 203, 132, 60, 142, 174, 121, 230, 75, 250, 46,
-			106, 133, 71, 113, 220, 165, 250, 64, 171, 65, 179, 229, 122, 104,
-			18, 233, 83, 126, 69, 179, 103, 108, 214, 89, 140, 75, 134, 227,
-			250, 210, 34, 153, 183, 157, 112, 6, 160, 110, 204, 45, 144, 35,
-			52, 148, 68, 51, 73, 227, 37, 185, 131, 100, 64, 24, 73, 26,
-			23, 244, 73, 50, 200, 141, 14, 77, 128, 136, 132, 50, 182, 113,
-			97, 224, 168, 132, 52, 219, 184, 112, 236, 132, 132, 12, 219, 184,
-			112, 231, 4, 153, 33, 186, 169, 217, 230, 189, 125, 143, 104, 133,
-			19, 116, 81, 176, 38, 247, 253, 107, 182, 60, 22, 179, 52, 219,
-			137, 22, 104, 154, 109, 220, 155, 59, 68, 46, 16, 211, 212, 160,
-			5, 47, 211, 15, 21, 75, 156, 49, 97, 45, 44, 117, 185, 249,
-			133, 65, 16, 167, 148, 146, 56, 100, 140, 183, 80, 195, 246, 190,
-			76, 87, 144, 101, 27, 47, 27, 24, 145, 144, 102, 27, 47, 179,
-			111, 151, 144, 97, 27, 47, 59, 88, 32, 167, 177, 74, 205, 54,
-			238, 211, 143, 22, 143, 80, 100, 217, 98, 61, 8, 138, 37, 252,
-			83, 222, 114, 194, 98, 137, 178, 184, 90, 150, 88, 53, 19, 50,
-			43, 200, 178, 141, 251, 84, 29, 208, 145, 251, 236, 131, 18, 50,
-			108, 227, 190, 195, 71, 200, 121, 172, 67, 183, 141, 203, 250, 241,
-			194, 4, 93, 149, 171, 187, 24, 14, 156, 12, 220, 148, 91, 77,
-			106, 85, 155, 110, 66, 49, 5, 89, 182, 113, 89, 213, 6, 205,
-			190, 108, 31, 150, 144, 97, 27, 151, 143, 81, 242, 35, 88, 155,
-			97, 27, 139, 250, 100, 97, 145, 162, 165, 4, 175, 15, 221, 29,
-			209, 48, 48, 169, 84, 180, 65, 40, 59, 202, 12, 144, 235, 75,
-			168, 82, 169, 166, 24, 38, 224, 84, 144, 101, 27, 139, 3, 121,
-			9, 105, 182, 177, 56, 82, 148, 16, 212, 126, 106, 130, 60, 129,
-			77, 49, 109, 227, 138, 126, 103, 161, 217, 219, 148, 93, 230, 220,
-			120, 97, 13, 41, 115, 179, 118, 174, 42, 77, 163, 194, 14, 146,
-			181, 233, 110, 135, 92, 212, 4, 190, 215, 41, 211, 197, 0, 116,
-			62, 208, 141, 84, 155, 77, 172, 92, 65, 150, 109, 92, 81, 109,
-			54, 53, 219, 184, 50, 66, 37, 100, 216, 198, 149, 19, 167, 200,
-			221, 216, 102, 203, 54, 30, 212, 75, 133, 41, 212, 246, 227, 160,
-			53, 141, 231, 50, 93, 210, 53, 45, 131, 85, 125, 150, 9, 5,
-			21, 148, 177, 141, 7, 7, 10, 18, 210, 108, 227, 193, 67, 19,
-			18, 50, 108, 227, 193, 211, 103, 112, 214, 105, 122, 198, 54, 94,
-			174, 79, 139, 79, 25, 19, 32, 137, 36, 3, 223, 196, 172, 211,
-			244, 140, 102, 27, 47, 63, 54, 41, 33, 195, 54, 94, 126, 166,
-			36, 144, 100, 109, 99, 69, 47, 139, 79, 89, 19, 32, 137, 36,
-			155, 177, 141, 149, 129, 227, 18, 210, 108, 99, 165, 56, 37, 33,
-			195, 54, 86, 74, 211, 2, 73, 206, 54, 174, 42, 36, 57, 19,
-			32, 137, 36, 151, 177, 141, 171, 3, 199, 36, 164, 217, 198, 85,
-			42, 145, 228, 12, 219, 184, 170, 144, 244, 219, 198, 154, 126, 66,
-			124, 234, 55, 1, 146, 72, 250, 51, 182, 177, 54, 32, 167, 97,
-			191, 102, 27, 107, 119, 200, 206, 245, 27, 182, 177, 118, 188, 72,
-			254, 155, 134, 88, 136, 109, 92, 215, 103, 10, 255, 85, 163, 27,
-			156, 208, 204, 171, 73, 209, 22, 41, 151, 144, 174, 53, 199, 217,
-			130, 181, 6, 88, 72, 173, 191, 169, 189, 75, 153, 208, 135, 131,
-			54, 234, 208, 145, 83, 103, 94, 135, 134, 172, 9, 187, 23, 28,
-			72, 230, 199, 110, 200, 68, 53, 114, 217, 106, 56, 97, 19, 196,
-			104, 216, 246, 99, 183, 201, 8, 173, 183, 125, 225, 229, 226, 198,
-			29, 201, 202, 201, 50, 17, 209, 233, 105, 76, 74, 183, 42, 241,
-			214, 5, 70, 197, 149, 95, 236, 19, 155, 232, 240, 20, 4, 94,
-			164, 88, 136, 152, 208, 109, 5, 101, 108, 227, 250, 128, 148, 40,
-			68, 179, 141, 235, 133, 211, 18, 50, 108, 227, 250, 116, 153, 188,
-			26, 169, 53, 96, 27, 15, 235, 71, 11, 215, 112, 197, 224, 22,
-			162, 106, 210, 167, 4, 46, 255, 220, 110, 137, 105, 39, 172, 189,
-			209, 247, 24, 179, 205, 21, 81, 241, 224, 192, 185, 162, 106, 214,
-			128, 9, 248, 21, 100, 217, 198, 195, 74, 16, 13, 104, 182, 241,
-			176, 61, 46, 33, 195, 54, 30, 62, 116, 132, 76, 16, 221, 212,
-			109, 243, 213, 125, 79, 106, 133, 67, 93, 75, 129, 208, 99, 208,
-			63, 70, 44, 1, 32, 203, 94, 157, 187, 3, 249, 71, 135, 37,
-			224, 81, 253, 16, 226, 211, 81, 168, 63, 42, 106, 214, 81, 168,
-			63, 42, 106, 214, 81, 168, 63, 42, 132, 186, 142, 66, 253, 209,
-			131, 5, 129, 68, 179, 141, 199, 244, 211, 226, 19, 72, 237, 199,
-			20, 18, 45, 99, 27, 143, 9, 78, 214, 81, 106, 63, 70, 79,
-			73, 200, 176, 141, 199, 38, 167, 4, 18, 221, 54, 28, 49, 29,
-			116, 20, 198, 142, 66, 2, 147, 214, 81, 72, 160, 58, 71, 76,
-			7, 29, 133, 177, 35, 166, 3, 2, 85, 253, 140, 248, 4, 98,
-			180, 170, 144, 24, 25, 219, 168, 10, 17, 161, 163, 24, 173, 30,
-			186, 83, 66, 80, 110, 234, 180, 64, 98, 218, 70, 77, 136, 8,
-			29, 229, 90, 77, 33, 49, 51, 182, 81, 19, 34, 66, 71, 185,
-			86, 19, 34, 66, 71, 185, 86, 59, 83, 34, 131, 128, 196, 232,
-			179, 205, 186, 126, 195, 224, 223, 12, 160, 94, 157, 140, 147, 67,
-			36, 3, 16, 144, 125, 219, 60, 82, 28, 132, 221, 168, 178, 195,
-			31, 34, 89, 254, 209, 132, 175, 131, 9, 108, 217, 198, 246, 1,
-			59, 129, 53, 219, 216, 30, 29, 79, 96, 195, 54, 182, 15, 29,
-			86, 200, 53, 219, 104, 152, 135, 138, 131, 116, 233, 230, 94, 228,
-			48, 60, 141, 20, 114, 88, 86, 27, 41, 228, 48, 68, 141, 209,
-			219, 19, 216, 176, 141, 198, 193, 2, 57, 32, 144, 235, 182, 241,
-			184, 57, 163, 62, 3, 177, 30, 79, 161, 131, 161, 122, 252, 64,
-			49, 129, 53, 219, 120, 252, 196, 233, 4, 54, 108, 227, 241, 233,
-			178, 160, 180, 101, 27, 158, 26, 115, 144, 232, 158, 162, 52, 72,
-			116, 79, 76, 71, 29, 37, 186, 87, 144, 99, 14, 18, 221, 83,
-			99, 158, 177, 13, 95, 159, 17, 159, 64, 162, 251, 10, 9, 72,
-			116, 95, 49, 14, 72, 116, 159, 74, 62, 5, 137, 238, 171, 150,
-			100, 109, 163, 165, 75, 118, 0, 137, 222, 82, 72, 64, 162, 183,
-			84, 75, 64, 162, 183, 10, 199, 37, 100, 216, 70, 235, 228, 41,
-			242, 17, 13, 7, 93, 179, 205, 182, 126, 211, 40, 252, 164, 70,
-			209, 46, 11, 196, 130, 60, 186, 162, 177, 179, 77, 185, 245, 84,
-			84, 166, 149, 125, 82, 81, 92, 194, 186, 42, 143, 29, 64, 124,
-			161, 144, 140, 40, 250, 154, 137, 35, 96, 238, 198, 175, 150, 240,
-			200, 105, 170, 13, 75, 10, 177, 200, 212, 116, 58, 120, 80, 68,
-			131, 29, 22, 122, 78, 75, 136, 25, 221, 128, 129, 110, 147, 59,
-			4, 215, 160, 50, 184, 115, 11, 150, 228, 234, 222, 142, 26, 102,
-			174, 240, 237, 40, 174, 225, 42, 223, 142, 98, 73, 174, 244, 237,
-			40, 150, 68, 181, 111, 247, 22, 44, 201, 245, 188, 221, 20, 114,
-			96, 201, 221, 20, 114, 104, 233, 174, 98, 73, 174, 237, 237, 42,
-			225, 147, 179, 141, 142, 94, 18, 227, 1, 203, 104, 71, 141, 28,
-			44, 163, 157, 129, 113, 9, 105, 182, 209, 57, 56, 33, 33, 195,
-			54, 58, 167, 207, 144, 215, 227, 200, 193, 58, 250, 58, 253, 84,
-			161, 157, 208, 143, 175, 78, 120, 64, 36, 157, 43, 247, 142, 143,
-			28, 158, 253, 134, 2, 182, 127, 219, 238, 14, 243, 249, 9, 20,
-			20, 230, 139, 18, 75, 120, 34, 240, 171, 82, 165, 209, 113, 249,
-			126, 157, 106, 124, 191, 101, 27, 175, 83, 226, 23, 150, 239, 215,
-			217, 146, 147, 97, 249, 126, 93, 241, 36, 25, 32, 32, 117, 172,
-			31, 237, 123, 147, 166, 161, 112, 7, 177, 246, 163, 185, 35, 100,
-			141, 152, 166, 161, 247, 217, 230, 255, 164, 233, 23, 11, 243, 124,
-			111, 35, 156, 33, 227, 32, 100, 114, 81, 199, 61, 74, 45, 96,
-			17, 108, 149, 66, 86, 13, 182, 125, 247, 9, 70, 27, 44, 100,
-			101, 186, 206, 152, 82, 76, 15, 16, 11, 16, 154, 136, 81, 129,
-			25, 0, 7, 142, 74, 80, 3, 240, 216, 57, 9, 26, 0, 222,
-			125, 129, 60, 2, 13, 179, 108, 243, 141, 154, 126, 176, 112, 149,
-			46, 160, 37, 90, 132, 59, 43, 84, 243, 24, 173, 182, 163, 56,
-			104, 38, 109, 242, 19, 102, 23, 74, 172, 27, 37, 44, 158, 110,
-			23, 8, 91, 195, 234, 3, 228, 7, 198, 121, 197, 22, 180, 227,
-			141, 218, 129, 17, 9, 234, 0, 222, 54, 78, 206, 17, 144, 230,
-			153, 55, 107, 125, 127, 165, 105, 133, 83, 93, 11, 101, 162, 139,
-			160, 75, 156, 26, 67, 116, 111, 131, 125, 214, 155, 181, 220, 97,
-			50, 68, 76, 211, 52, 251, 236, 204, 91, 52, 253, 195, 154, 129,
-			21, 152, 232, 253, 246, 22, 45, 59, 64, 214, 73, 198, 20, 238,
-			111, 111, 211, 204, 177, 194, 2, 157, 229, 142, 176, 146, 165, 96,
-			22, 135, 97, 16, 70, 101, 66, 215, 194, 26, 108, 227, 35, 186,
-			203, 220, 144, 127, 107, 184, 48, 56, 110, 213, 241, 96, 19, 31,
-			5, 62, 232, 42, 195, 36, 203, 145, 106, 136, 117, 56, 73, 208,
-			33, 193, 30, 37, 67, 162, 90, 205, 54, 255, 141, 102, 142, 170,
-			12, 26, 79, 24, 74, 18, 116, 72, 24, 177, 201, 174, 40, 161,
-			219, 230, 83, 154, 57, 90, 216, 166, 171, 65, 76, 31, 113, 183,
-			31, 113, 182, 165, 59, 118, 153, 42, 143, 53, 37, 160, 98, 231,
-			6, 163, 103, 103, 233, 86, 39, 102, 81, 153, 210, 235, 17, 163,
-			41, 131, 99, 234, 214, 9, 85, 190, 106, 41, 133, 199, 115, 111,
-			48, 175, 147, 234, 12, 119, 170, 75, 53, 141, 55, 101, 196, 86,
-			157, 49, 108, 243, 29, 154, 57, 166, 50, 128, 108, 125, 71, 186,
-			251, 134, 14, 9, 246, 168, 234, 140, 105, 155, 239, 252, 129, 117,
-			230, 220, 220, 11, 239, 12, 176, 199, 59, 211, 157, 1, 101, 236,
-			157, 233, 206, 88, 182, 249, 46, 205, 188, 77, 101, 176, 52, 76,
-			200, 39, 9, 58, 36, 140, 142, 169, 18, 25, 219, 124, 38, 93,
-			34, 163, 97, 66, 82, 34, 163, 67, 66, 170, 68, 214, 54, 223,
-			173, 153, 182, 202, 144, 213, 48, 225, 64, 146, 160, 67, 66, 126,
-			68, 149, 200, 217, 230, 255, 146, 38, 113, 78, 195, 132, 132, 196,
-			57, 29, 18, 236, 81, 242, 69, 77, 20, 233, 183, 205, 159, 2,
-			206, 254, 172, 70, 55, 156, 237, 233, 26, 243, 220, 166, 11, 218,
-			173, 186, 240, 44, 19, 122, 37, 12, 218, 137, 119, 127, 114, 3,
-			143, 234, 46, 200, 207, 68, 41, 118, 125, 225, 175, 93, 166, 15,
-			4, 187, 108, 135, 133, 37, 126, 140, 119, 142, 192, 134, 213, 99,
-			234, 70, 32, 146, 94, 220, 220, 53, 114, 139, 41, 87, 110, 148,
-			107, 40, 126, 183, 177, 226, 93, 220, 91, 112, 223, 93, 199, 175,
-			17, 26, 135, 204, 137, 197, 71, 33, 175, 157, 136, 182, 125, 12,
-			103, 40, 82, 82, 195, 217, 175, 97, 39, 147, 225, 236, 215, 33,
-			97, 100, 148, 76, 11, 42, 16, 219, 124, 86, 51, 111, 47, 30,
-			161, 43, 204, 223, 142, 27, 251, 211, 65, 149, 39, 26, 230, 79,
-			198, 142, 232, 144, 48, 122, 27, 57, 33, 16, 14, 216, 230, 251,
-			129, 172, 163, 116, 149, 237, 2, 81, 118, 88, 136, 43, 253, 92,
-			10, 205, 128, 134, 185, 146, 118, 13, 232, 144, 48, 146, 8, 128,
-			65, 219, 252, 217, 52, 211, 12, 106, 152, 144, 12, 232, 160, 14,
-			9, 118, 194, 52, 7, 108, 243, 3, 105, 145, 113, 64, 195, 132,
-			132, 105, 14, 232, 144, 144, 79, 88, 121, 200, 54, 63, 168, 153,
-			119, 168, 12, 67, 26, 38, 140, 36, 9, 58, 36, 140, 221, 174,
-			74, 12, 219, 230, 207, 165, 75, 12, 107, 152, 144, 148, 24, 214,
-			33, 97, 236, 118, 50, 33, 74, 228, 109, 243, 231, 53, 243, 182,
-			226, 29, 48, 39, 163, 174, 169, 204, 15, 238, 100, 201, 188, 134,
-			57, 147, 14, 230, 117, 72, 176, 199, 20, 170, 17, 219, 252, 208,
-			11, 66, 53, 162, 97, 206, 4, 213, 136, 14, 9, 72, 43, 16,
-			250, 154, 157, 249, 5, 77, 255, 37, 37, 244, 65, 184, 254, 130,
-			150, 29, 36, 167, 177, 38, 208, 159, 204, 255, 85, 51, 239, 40,
-			20, 110, 41, 244, 101, 101, 168, 46, 65, 102, 59, 73, 208, 33,
-			225, 54, 73, 52, 80, 152, 204, 95, 76, 136, 134, 58, 16, 36,
-			36, 37, 64, 150, 255, 98, 186, 132, 110, 155, 31, 77, 151, 0,
-			20, 31, 77, 151, 224, 57, 110, 187, 29, 215, 76, 19, 218, 251,
-			203, 154, 126, 136, 119, 7, 87, 246, 95, 150, 43, 187, 137, 30,
-			210, 191, 172, 13, 140, 72, 80, 3, 208, 190, 93, 130, 6, 128,
-			7, 11, 2, 147, 102, 155, 31, 211, 244, 195, 226, 163, 102, 34,
-			40, 49, 105, 22, 128, 3, 121, 9, 98, 230, 145, 59, 36, 104,
-			0, 88, 56, 36, 48, 233, 182, 249, 241, 164, 77, 32, 209, 63,
-			158, 96, 2, 153, 248, 241, 4, 19, 84, 251, 113, 109, 68, 182,
-			9, 22, 140, 143, 67, 155, 222, 171, 33, 42, 195, 54, 127, 21,
-			244, 141, 183, 105, 116, 185, 78, 149, 239, 20, 12, 77, 196, 98,
-			113, 87, 233, 51, 86, 147, 26, 93, 196, 226, 50, 133, 188, 24,
-			19, 2, 63, 227, 189, 165, 44, 201, 195, 99, 36, 101, 213, 249,
-			180, 143, 106, 190, 242, 222, 41, 209, 180, 239, 15, 40, 238, 137,
-			111, 80, 89, 246, 197, 48, 177, 121, 10, 204, 0, 56, 48, 44,
-			65, 13, 192, 252, 152, 4, 177, 47, 119, 140, 147, 255, 93, 199,
-			174, 153, 182, 249, 27, 154, 78, 11, 31, 210, 241, 176, 78, 238,
-			241, 161, 181, 204, 111, 55, 177, 201, 145, 104, 165, 27, 117, 221,
-			129, 98, 108, 131, 78, 139, 241, 158, 202, 47, 24, 32, 34, 140,
-			133, 43, 186, 67, 39, 202, 19, 37, 80, 12, 221, 136, 214, 219,
-			158, 215, 153, 126, 77, 219, 241, 208, 28, 178, 76, 233, 90, 220,
-			96, 33, 143, 120, 176, 112, 230, 204, 52, 172, 134, 52, 170, 6,
-			24, 241, 133, 134, 109, 79, 172, 146, 242, 222, 180, 238, 138, 203,
-			95, 92, 15, 120, 32, 146, 186, 27, 70, 252, 236, 136, 187, 157,
-			242, 22, 75, 237, 11, 218, 77, 146, 94, 33, 209, 157, 176, 218,
-			96, 53, 12, 230, 226, 39, 249, 80, 167, 101, 62, 6, 198, 16,
-			209, 77, 32, 49, 12, 2, 140, 105, 39, 204, 157, 167, 20, 213,
-			77, 78, 57, 5, 90, 0, 42, 38, 135, 101, 252, 55, 52, 91,
-			114, 159, 105, 0, 120, 244, 24, 249, 151, 72, 116, 203, 54, 127,
-			91, 211, 143, 21, 90, 34, 238, 135, 84, 79, 159, 155, 206, 116,
-			139, 185, 254, 182, 136, 107, 135, 228, 91, 6, 178, 18, 30, 24,
-			96, 135, 175, 128, 201, 206, 193, 241, 125, 22, 194, 226, 164, 184,
-			78, 181, 221, 50, 177, 1, 10, 196, 246, 168, 182, 131, 66, 241,
-			219, 154, 93, 144, 160, 1, 224, 145, 163, 228, 41, 206, 49, 25,
-			219, 252, 29, 77, 63, 89, 120, 61, 231, 24, 191, 221, 100, 161,
-			91, 149, 140, 162, 14, 250, 186, 78, 243, 98, 118, 51, 78, 226,
-			19, 138, 211, 95, 222, 51, 84, 134, 196, 81, 241, 86, 16, 120,
-			204, 1, 58, 20, 227, 176, 205, 138, 192, 240, 69, 52, 190, 43,
-			138, 28, 34, 24, 92, 79, 61, 194, 255, 144, 87, 3, 95, 112,
-			79, 48, 9, 147, 145, 69, 85, 167, 197, 73, 227, 248, 29, 186,
-			235, 116, 166, 100, 101, 160, 170, 245, 32, 90, 80, 249, 121, 179,
-			184, 221, 8, 230, 164, 47, 187, 151, 158, 157, 123, 9, 242, 144,
-			200, 164, 232, 153, 49, 145, 38, 10, 180, 0, 84, 244, 4, 117,
-			235, 119, 52, 251, 152, 4, 13, 0, 139, 39, 200, 191, 226, 194,
-			37, 107, 155, 159, 209, 244, 227, 133, 29, 152, 69, 40, 18, 96,
-			163, 23, 137, 227, 200, 26, 187, 201, 67, 66, 160, 3, 165, 28,
-			225, 244, 77, 81, 167, 197, 38, 34, 154, 56, 50, 19, 126, 248,
-			78, 211, 199, 175, 46, 63, 191, 3, 93, 149, 211, 221, 137, 121,
-			9, 213, 133, 172, 137, 205, 80, 160, 5, 160, 146, 143, 160, 255,
-			125, 70, 27, 145, 98, 57, 107, 0, 120, 140, 146, 127, 224, 93,
-			200, 217, 230, 31, 64, 23, 254, 66, 227, 161, 114, 18, 206, 149,
-			45, 224, 17, 128, 144, 168, 66, 106, 194, 134, 119, 207, 13, 89,
-			89, 72, 18, 2, 83, 62, 164, 13, 135, 103, 117, 104, 81, 121,
-			140, 22, 197, 206, 14, 166, 105, 130, 191, 36, 250, 132, 159, 38,
-			68, 84, 11, 66, 119, 133, 122, 7, 2, 164, 156, 150, 54, 110,
-			60, 1, 218, 100, 173, 93, 21, 166, 22, 220, 166, 4, 80, 77,
-			68, 188, 253, 91, 29, 25, 44, 6, 165, 145, 139, 49, 113, 170,
-			78, 147, 121, 11, 78, 148, 204, 165, 156, 137, 157, 87, 160, 5,
-			160, 26, 123, 80, 131, 255, 32, 145, 3, 57, 3, 192, 163, 84,
-			44, 81, 253, 182, 249, 57, 77, 63, 41, 62, 246, 155, 8, 74,
-			76, 253, 25, 0, 7, 228, 234, 6, 154, 228, 231, 180, 113, 201,
-			69, 253, 6, 128, 197, 19, 228, 99, 253, 136, 138, 216, 230, 95,
-			106, 250, 169, 194, 7, 250, 145, 132, 97, 155, 37, 194, 68, 197,
-			49, 42, 202, 211, 247, 98, 153, 62, 4, 210, 79, 125, 81, 108,
-			34, 115, 0, 137, 64, 219, 117, 170, 55, 34, 202, 231, 109, 149,
-			81, 80, 71, 195, 154, 199, 34, 113, 19, 6, 133, 196, 113, 48,
-			71, 216, 227, 130, 139, 162, 44, 105, 11, 47, 32, 23, 187, 45,
-			134, 1, 224, 240, 162, 154, 115, 119, 28, 16, 26, 185, 219, 40,
-			49, 2, 26, 120, 53, 217, 188, 170, 216, 230, 227, 32, 171, 214,
-			32, 114, 180, 244, 71, 197, 168, 155, 219, 64, 48, 138, 57, 19,
-			81, 174, 157, 99, 76, 176, 98, 212, 241, 227, 6, 139, 221, 106,
-			145, 127, 47, 9, 147, 162, 61, 237, 115, 227, 136, 70, 129, 135,
-			134, 86, 56, 115, 38, 153, 83, 109, 200, 38, 169, 46, 242, 66,
-			219, 44, 142, 176, 4, 84, 164, 170, 224, 53, 76, 149, 233, 186,
-			76, 17, 141, 138, 40, 187, 233, 70, 113, 114, 115, 38, 111, 34,
-			240, 88, 135, 55, 169, 198, 47, 198, 164, 27, 26, 202, 175, 249,
-			107, 203, 251, 33, 83, 250, 67, 88, 99, 33, 236, 28, 234, 49,
-			200, 126, 207, 163, 197, 144, 57, 158, 232, 105, 36, 35, 77, 201,
-			245, 144, 111, 87, 74, 123, 70, 77, 30, 226, 84, 97, 179, 195,
-			43, 142, 88, 211, 241, 161, 71, 220, 230, 174, 68, 68, 180, 29,
-			63, 240, 167, 67, 214, 98, 184, 39, 235, 198, 43, 67, 113, 225,
-			24, 169, 81, 83, 155, 51, 152, 81, 184, 255, 34, 61, 225, 192,
-			84, 85, 53, 22, 59, 174, 7, 156, 182, 219, 96, 202, 4, 11,
-			37, 195, 110, 24, 196, 44, 197, 207, 176, 82, 248, 65, 140, 23,
-			37, 110, 36, 237, 34, 218, 17, 171, 183, 61, 100, 142, 48, 104,
-			251, 181, 233, 56, 116, 241, 190, 62, 117, 191, 206, 47, 88, 144,
-			44, 213, 192, 143, 220, 8, 141, 160, 233, 46, 35, 40, 135, 247,
-			244, 169, 119, 112, 169, 227, 69, 65, 137, 178, 29, 6, 67, 25,
-			180, 183, 27, 66, 219, 129, 177, 147, 97, 203, 120, 176, 169, 30,
-			58, 108, 136, 233, 201, 208, 156, 203, 241, 188, 142, 184, 87, 117,
-			252, 88, 89, 37, 196, 201, 97, 91, 213, 241, 39, 96, 78, 50,
-			207, 163, 110, 93, 157, 51, 185, 233, 187, 153, 32, 196, 120, 186,
-			126, 187, 89, 162, 81, 0, 45, 65, 214, 16, 35, 33, 199, 147,
-			244, 118, 2, 24, 3, 183, 202, 115, 61, 124, 29, 73, 46, 4,
-			49, 204, 231, 136, 231, 108, 151, 210, 205, 235, 80, 199, 11, 153,
-			83, 235, 168, 97, 36, 9, 18, 84, 2, 31, 235, 246, 22, 127,
-			76, 201, 78, 98, 162, 212, 82, 160, 5, 160, 210, 92, 97, 171,
-			251, 151, 90, 94, 74, 60, 98, 0, 88, 60, 73, 138, 4, 180,
-			173, 204, 87, 181, 190, 191, 213, 180, 194, 88, 215, 209, 155, 236,
-			205, 0, 49, 76, 208, 99, 190, 170, 229, 14, 163, 176, 181, 96,
-			143, 242, 53, 185, 31, 176, 112, 143, 242, 53, 89, 181, 133, 123,
-			148, 175, 73, 177, 109, 225, 30, 229, 107, 114, 143, 98, 225, 30,
-			229, 107, 114, 143, 98, 193, 102, 225, 235, 82, 108, 91, 184, 71,
-			249, 122, 130, 73, 203, 0, 40, 196, 182, 133, 123, 148, 175, 75,
-			177, 109, 225, 30, 229, 235, 32, 182, 39, 136, 110, 102, 236, 204,
-			223, 105, 125, 255, 179, 174, 21, 14, 166, 59, 225, 39, 154, 183,
-			232, 9, 104, 16, 127, 167, 229, 248, 206, 38, 3, 61, 249, 166,
-			236, 73, 6, 123, 242, 77, 89, 127, 6, 123, 242, 77, 217, 147,
-			12, 246, 228, 155, 178, 39, 25, 236, 201, 55, 101, 79, 50, 208,
-			147, 191, 215, 244, 178, 248, 8, 61, 249, 251, 4, 19, 244, 228,
-			239, 181, 129, 19, 18, 196, 204, 39, 167, 36, 104, 0, 88, 154,
-			22, 152, 116, 219, 252, 182, 166, 203, 188, 176, 173, 248, 118, 130,
-			9, 116, 198, 111, 107, 3, 178, 17, 80, 237, 183, 181, 59, 142,
-			74, 208, 0, 240, 120, 145, 124, 20, 20, 204, 140, 209, 103, 103,
-			222, 160, 235, 111, 210, 141, 194, 79, 233, 251, 92, 125, 72, 133,
-			147, 159, 162, 165, 46, 41, 196, 177, 218, 126, 23, 31, 204, 143,
-			67, 183, 231, 150, 3, 136, 188, 239, 21, 71, 207, 13, 7, 93,
-			5, 81, 35, 204, 113, 249, 140, 173, 185, 81, 236, 250, 213, 152,
-			107, 14, 207, 25, 155, 133, 87, 233, 196, 184, 156, 66, 105, 121,
-			33, 66, 163, 118, 181, 33, 63, 161, 192, 114, 90, 173, 48, 104,
-			133, 174, 19, 243, 59, 110, 161, 45, 99, 139, 197, 45, 183, 235,
-			199, 231, 230, 8, 173, 5, 77, 199, 245, 197, 52, 202, 24, 48,
-			198, 111, 208, 201, 33, 114, 152, 100, 0, 4, 6, 249, 87, 250,
-			158, 251, 23, 216, 187, 103, 248, 149, 32, 124, 30, 76, 18, 44,
-			72, 56, 96, 39, 9, 26, 36, 140, 142, 39, 9, 6, 36, 28,
-			58, 172, 106, 208, 108, 243, 141, 58, 94, 194, 236, 87, 3, 48,
-			210, 27, 211, 53, 192, 198, 253, 141, 233, 26, 52, 68, 48, 122,
-			123, 146, 96, 64, 194, 193, 2, 191, 253, 202, 0, 79, 188, 85,
-			215, 103, 247, 191, 253, 186, 37, 11, 116, 127, 232, 101, 5, 2,
-			58, 29, 206, 45, 241, 189, 151, 29, 40, 232, 207, 14, 183, 78,
-			185, 37, 107, 144, 20, 111, 112, 6, 134, 13, 248, 91, 117, 197,
-			237, 176, 1, 127, 171, 62, 112, 88, 130, 26, 128, 71, 206, 72,
-			16, 123, 86, 158, 33, 30, 246, 211, 180, 205, 183, 235, 250, 201,
-			194, 163, 73, 117, 73, 11, 111, 121, 99, 20, 50, 174, 209, 238,
-			123, 41, 68, 246, 187, 21, 226, 149, 155, 188, 58, 5, 90, 0,
-			42, 89, 1, 155, 214, 183, 235, 98, 163, 146, 193, 77, 235, 219,
-			245, 226, 9, 114, 150, 232, 102, 214, 206, 252, 132, 222, 247, 110,
-			189, 215, 62, 140, 183, 83, 222, 117, 112, 209, 37, 164, 22, 108,
-			26, 126, 66, 207, 113, 101, 55, 11, 76, 249, 180, 46, 164, 86,
-			22, 153, 240, 105, 217, 146, 44, 178, 224, 211, 178, 37, 89, 100,
-			192, 167, 117, 33, 181, 178, 200, 126, 79, 235, 66, 106, 101, 129,
-			249, 222, 169, 139, 51, 162, 44, 50, 219, 59, 19, 76, 192, 106,
-			239, 212, 197, 206, 37, 139, 140, 246, 78, 93, 156, 17, 101, 145,
-			205, 222, 169, 139, 51, 162, 44, 72, 173, 103, 116, 125, 82, 124,
-			132, 193, 120, 38, 193, 132, 231, 230, 250, 128, 108, 49, 84, 251,
-			140, 126, 248, 132, 4, 13, 0, 239, 156, 32, 39, 137, 110, 230,
-			236, 204, 79, 234, 125, 63, 173, 107, 133, 219, 187, 168, 35, 156,
-			211, 5, 65, 96, 51, 240, 147, 122, 238, 40, 86, 158, 3, 130,
-			188, 87, 18, 36, 135, 4, 121, 175, 172, 60, 135, 4, 121, 175,
-			36, 72, 14, 9, 242, 94, 73, 144, 28, 18, 228, 189, 146, 32,
-			57, 104, 217, 251, 116, 189, 36, 62, 2, 65, 222, 151, 96, 2,
-			49, 254, 62, 125, 128, 74, 16, 51, 31, 159, 144, 160, 1, 224,
-			233, 51, 2, 147, 110, 155, 207, 234, 250, 157, 226, 35, 16, 228,
-			217, 4, 19, 16, 228, 89, 125, 224, 160, 4, 53, 0, 11, 199,
-			37, 104, 0, 120, 242, 20, 153, 37, 186, 217, 111, 103, 126, 86,
-			239, 251, 223, 116, 173, 80, 236, 177, 33, 137, 27, 65, 77, 6,
-			57, 76, 19, 7, 246, 55, 63, 171, 231, 142, 96, 67, 250, 129,
-			56, 31, 144, 196, 233, 71, 226, 124, 64, 54, 164, 31, 137, 243,
-			1, 73, 156, 126, 36, 206, 7, 36, 113, 250, 145, 56, 31, 0,
-			226, 60, 163, 33, 42, 205, 54, 63, 164, 235, 199, 11, 255, 90,
-			163, 203, 62, 218, 94, 250, 53, 105, 134, 137, 231, 42, 56, 203,
-			112, 195, 204, 34, 126, 72, 180, 239, 137, 202, 174, 211, 161, 78,
-			68, 232, 190, 225, 149, 212, 33, 75, 137, 110, 181, 99, 233, 233,
-			80, 7, 157, 53, 216, 107, 59, 195, 27, 10, 3, 245, 161, 164,
-			87, 192, 185, 31, 74, 122, 165, 97, 187, 237, 195, 18, 52, 0,
-			60, 70, 5, 125, 116, 219, 252, 176, 174, 23, 197, 71, 24, 168,
-			15, 39, 152, 116, 11, 64, 133, 9, 40, 240, 97, 221, 62, 34,
-			65, 3, 64, 122, 92, 96, 50, 108, 243, 23, 116, 253, 148, 248,
-			8, 178, 236, 23, 18, 76, 32, 203, 126, 65, 31, 24, 151, 160,
-			6, 224, 65, 42, 65, 44, 123, 226, 36, 89, 66, 76, 166, 109,
-			254, 162, 174, 223, 83, 184, 135, 46, 75, 247, 187, 8, 52, 85,
-			190, 123, 163, 60, 54, 8, 108, 86, 120, 180, 13, 153, 46, 237,
-			163, 101, 157, 38, 199, 163, 64, 11, 64, 161, 21, 246, 163, 144,
-			250, 69, 61, 47, 155, 0, 66, 234, 23, 161, 9, 2, 204, 1,
-			120, 234, 110, 9, 102, 1, 156, 189, 75, 52, 208, 178, 205, 95,
-			218, 175, 129, 60, 58, 201, 222, 6, 138, 244, 222, 6, 90, 38,
-			226, 81, 32, 162, 85, 13, 4, 181, 243, 151, 146, 6, 90, 6,
-			128, 170, 129, 86, 14, 64, 213, 64, 43, 11, 224, 236, 93, 228,
-			163, 67, 68, 55, 137, 157, 249, 67, 189, 239, 247, 12, 109, 110,
-			149, 222, 251, 79, 255, 71, 168, 240, 57, 37, 115, 255, 233, 0,
-			93, 130, 205, 171, 178, 124, 75, 44, 34, 185, 39, 16, 44, 30,
-			24, 34, 180, 40, 195, 213, 80, 39, 230, 62, 112, 233, 153, 65,
-			232, 227, 184, 159, 80, 94, 106, 81, 106, 113, 226, 91, 2, 116,
-			55, 138, 3, 88, 132, 228, 214, 181, 70, 35, 207, 221, 110, 196,
-			94, 135, 214, 220, 58, 6, 251, 141, 97, 157, 130, 173, 168, 211,
-			145, 167, 89, 180, 225, 194, 30, 191, 142, 59, 177, 154, 80, 185,
-			154, 142, 239, 182, 218, 30, 110, 14, 213, 113, 145, 28, 16, 208,
-			206, 164, 5, 0, 32, 218, 223, 2, 192, 137, 246, 88, 0, 48,
-			122, 90, 144, 38, 133, 75, 244, 50, 149, 87, 174, 184, 29, 134,
-			171, 174, 56, 69, 224, 134, 216, 50, 152, 109, 137, 191, 249, 145,
-			222, 165, 161, 146, 128, 166, 17, 201, 9, 167, 84, 54, 154, 101,
-			30, 0, 158, 57, 53, 190, 1, 70, 3, 10, 248, 64, 121, 252,
-			92, 190, 167, 243, 187, 26, 153, 156, 96, 87, 29, 207, 99, 53,
-			186, 159, 35, 112, 57, 125, 224, 135, 242, 7, 199, 83, 137, 47,
-			113, 239, 80, 13, 131, 40, 194, 163, 129, 189, 36, 160, 15, 49,
-			126, 42, 206, 183, 118, 10, 91, 28, 208, 86, 192, 71, 129, 159,
-			206, 165, 104, 132, 145, 180, 119, 25, 197, 168, 241, 212, 73, 29,
-			101, 148, 128, 84, 156, 59, 248, 107, 52, 80, 41, 142, 97, 196,
-			155, 182, 197, 152, 79, 56, 221, 248, 125, 110, 20, 96, 158, 20,
-			118, 16, 198, 72, 246, 50, 6, 163, 151, 70, 75, 242, 218, 29,
-			121, 165, 123, 180, 133, 171, 85, 181, 17, 68, 12, 143, 124, 184,
-			163, 88, 116, 145, 208, 211, 184, 203, 151, 25, 121, 203, 240, 132,
-			80, 154, 210, 224, 126, 65, 217, 109, 192, 16, 123, 12, 212, 114,
-			79, 56, 235, 209, 32, 36, 148, 210, 32, 220, 118, 124, 247, 9,
-			225, 191, 23, 132, 252, 90, 238, 102, 139, 133, 46, 94, 49, 123,
-			178, 142, 18, 18, 82, 24, 255, 136, 38, 223, 53, 59, 59, 59,
-			11, 88, 226, 70, 136, 167, 5, 248, 206, 138, 60, 204, 23, 55,
-			15, 157, 160, 205, 253, 196, 146, 135, 85, 32, 169, 166, 76, 165,
-			249, 48, 80, 49, 178, 136, 26, 91, 161, 68, 151, 104, 65, 249,
-			57, 122, 189, 37, 236, 202, 163, 134, 32, 63, 118, 159, 155, 154,
-			163, 189, 171, 194, 6, 53, 165, 159, 16, 2, 217, 25, 187, 160,
-			153, 178, 233, 166, 227, 138, 81, 221, 106, 215, 167, 183, 49, 128,
-			247, 180, 26, 193, 233, 144, 109, 187, 81, 28, 118, 82, 238, 55,
-			216, 249, 64, 106, 168, 41, 83, 52, 101, 208, 182, 238, 54, 91,
-			94, 71, 58, 232, 65, 215, 209, 81, 14, 227, 120, 163, 170, 139,
-			78, 42, 128, 135, 71, 10, 119, 119, 216, 244, 2, 109, 121, 237,
-			109, 215, 159, 194, 174, 116, 21, 217, 101, 91, 145, 27, 51, 58,
-			233, 214, 169, 179, 227, 184, 158, 179, 229, 177, 41, 97, 242, 27,
-			178, 137, 136, 250, 1, 32, 195, 27, 60, 32, 251, 205, 150, 135,
-			242, 40, 216, 69, 178, 195, 92, 243, 241, 139, 160, 124, 179, 76,
-			175, 71, 109, 60, 177, 129, 239, 200, 62, 88, 24, 195, 213, 211,
-			61, 93, 42, 163, 1, 51, 183, 81, 130, 13, 6, 219, 51, 78,
-			194, 73, 20, 16, 5, 126, 138, 38, 216, 44, 62, 188, 91, 29,
-			218, 106, 199, 50, 94, 189, 16, 23, 81, 123, 107, 186, 203, 150,
-			9, 111, 49, 248, 140, 144, 211, 59, 226, 94, 45, 52, 168, 115,
-			182, 67, 247, 200, 72, 48, 45, 127, 78, 231, 34, 124, 249, 30,
-			157, 97, 49, 112, 56, 166, 158, 8, 164, 152, 18, 55, 129, 110,
-			68, 227, 118, 8, 194, 182, 29, 139, 165, 128, 203, 15, 144, 9,
-			48, 28, 82, 247, 83, 140, 24, 177, 152, 182, 91, 130, 51, 156,
-			118, 28, 52, 157, 216, 173, 34, 133, 157, 8, 29, 175, 196, 169,
-			191, 228, 17, 174, 52, 18, 205, 54, 255, 80, 207, 141, 114, 219,
-			115, 2, 90, 227, 23, 116, 253, 68, 225, 207, 52, 186, 206, 98,
-			126, 156, 136, 79, 113, 136, 232, 68, 226, 225, 5, 140, 152, 196,
-			162, 212, 250, 36, 174, 0, 220, 72, 158, 10, 138, 134, 17, 218,
-			242, 156, 42, 174, 129, 151, 59, 242, 158, 169, 148, 50, 207, 150,
-			136, 93, 124, 114, 160, 198, 181, 63, 121, 78, 16, 212, 99, 144,
-			112, 174, 159, 218, 218, 171, 163, 179, 174, 242, 234, 156, 205, 15,
-			194, 38, 118, 27, 111, 81, 57, 91, 168, 151, 170, 228, 206, 95,
-			104, 172, 92, 1, 33, 168, 29, 127, 65, 234, 35, 4, 181, 227,
-			47, 72, 237, 143, 160, 118, 252, 5, 221, 62, 42, 65, 3, 192,
-			227, 69, 242, 53, 29, 73, 166, 217, 230, 23, 117, 253, 76, 225,
-			139, 58, 93, 8, 252, 56, 12, 188, 189, 183, 143, 187, 161, 211,
-			106, 177, 144, 147, 18, 137, 151, 38, 157, 112, 127, 45, 247, 216,
-			171, 59, 177, 200, 138, 164, 148, 39, 144, 98, 169, 239, 41, 48,
-			1, 8, 227, 68, 167, 158, 156, 146, 59, 5, 80, 184, 119, 25,
-			32, 136, 248, 179, 101, 202, 171, 35, 181, 74, 240, 117, 95, 45,
-			155, 221, 45, 89, 174, 211, 125, 162, 192, 137, 99, 28, 144, 4,
-			242, 42, 24, 150, 30, 188, 181, 196, 163, 102, 201, 35, 242, 114,
-			136, 116, 57, 222, 73, 190, 21, 119, 206, 174, 31, 185, 53, 177,
-			214, 242, 85, 67, 146, 12, 163, 213, 113, 100, 106, 188, 64, 239,
-			255, 98, 50, 94, 160, 247, 127, 49, 25, 47, 13, 71, 196, 190,
-			83, 130, 6, 128, 83, 167, 201, 207, 25, 56, 94, 186, 109, 126,
-			69, 215, 47, 21, 158, 49, 160, 103, 60, 76, 153, 236, 129, 98,
-			119, 212, 159, 196, 16, 161, 231, 185, 231, 165, 238, 17, 104, 196,
-			90, 14, 254, 44, 3, 101, 184, 135, 25, 151, 7, 160, 35, 38,
-			36, 22, 146, 165, 36, 14, 148, 113, 137, 22, 19, 119, 95, 146,
-			19, 113, 152, 78, 55, 26, 109, 254, 90, 74, 196, 212, 125, 60,
-			104, 28, 126, 16, 159, 222, 159, 108, 146, 94, 72, 41, 126, 225,
-			142, 54, 177, 251, 133, 252, 43, 211, 196, 192, 107, 79, 89, 94,
-			147, 52, 236, 34, 41, 70, 141, 131, 46, 246, 123, 62, 190, 75,
-			179, 29, 121, 65, 124, 39, 124, 138, 248, 184, 193, 110, 232, 43,
-			201, 32, 195, 222, 227, 43, 114, 147, 64, 112, 75, 246, 21, 61,
-			127, 66, 130, 6, 128, 119, 78, 74, 48, 7, 224, 212, 69, 9,
-			102, 1, 60, 127, 129, 76, 33, 7, 24, 182, 249, 87, 186, 190,
-			84, 56, 196, 21, 62, 33, 24, 107, 1, 195, 59, 135, 6, 55,
-			69, 226, 37, 97, 55, 247, 87, 73, 35, 12, 11, 64, 213, 8,
-			216, 205, 253, 149, 158, 159, 150, 32, 34, 158, 157, 147, 96, 14,
-			192, 115, 139, 104, 20, 4, 32, 126, 62, 191, 64, 254, 43, 23,
-			28, 166, 109, 126, 67, 215, 95, 86, 248, 191, 116, 113, 113, 173,
-			110, 250, 82, 156, 56, 247, 220, 172, 168, 244, 124, 130, 234, 208,
-			46, 63, 253, 190, 89, 101, 188, 79, 160, 91, 226, 85, 145, 3,
-			106, 119, 204, 154, 45, 212, 145, 154, 14, 223, 97, 136, 149, 193,
-			193, 75, 168, 235, 27, 247, 79, 191, 132, 224, 93, 61, 141, 216,
-			107, 218, 120, 67, 136, 123, 111, 241, 10, 16, 191, 25, 36, 84,
-			132, 120, 75, 249, 123, 242, 70, 213, 2, 165, 43, 151, 73, 194,
-			96, 78, 202, 126, 88, 168, 114, 156, 185, 248, 171, 47, 81, 82,
-			121, 119, 221, 145, 188, 139, 18, 227, 211, 112, 32, 43, 101, 245,
-			58, 168, 36, 162, 115, 137, 198, 137, 117, 123, 160, 165, 8, 159,
-			37, 53, 134, 38, 39, 180, 2, 45, 0, 213, 24, 194, 118, 248,
-			27, 122, 94, 74, 11, 216, 14, 127, 67, 159, 58, 35, 193, 28,
-			128, 165, 123, 37, 152, 5, 240, 158, 151, 146, 21, 24, 65, 179,
-			207, 206, 252, 189, 174, 255, 119, 221, 40, 188, 148, 170, 24, 37,
-			74, 240, 137, 59, 180, 253, 92, 102, 229, 14, 45, 114, 159, 80,
-			237, 68, 203, 229, 191, 215, 179, 163, 100, 17, 248, 133, 91, 46,
-			255, 131, 110, 14, 21, 207, 43, 228, 137, 55, 41, 22, 7, 132,
-			98, 43, 85, 82, 47, 249, 112, 173, 154, 159, 37, 19, 97, 170,
-			252, 15, 186, 217, 159, 36, 232, 144, 48, 120, 128, 92, 17, 245,
-			104, 182, 249, 143, 186, 105, 23, 50, 220, 225, 179, 56, 131, 214,
-			183, 73, 156, 145, 181, 22, 62, 241, 168, 76, 64, 133, 92, 226,
-			19, 93, 26, 107, 18, 97, 227, 252, 143, 186, 48, 88, 36, 194,
-			198, 249, 31, 245, 252, 136, 170, 74, 183, 205, 239, 232, 230, 109,
-			197, 123, 210, 93, 170, 49, 241, 60, 142, 224, 172, 21, 55, 102,
-			42, 76, 69, 207, 144, 74, 204, 208, 232, 239, 232, 194, 140, 147,
-			8, 155, 229, 239, 232, 163, 99, 120, 40, 67, 64, 90, 124, 87,
-			215, 47, 137, 113, 179, 76, 4, 37, 11, 88, 25, 0, 197, 21,
-			19, 193, 3, 135, 239, 234, 227, 39, 37, 104, 0, 56, 33, 101,
-			137, 149, 3, 80, 201, 18, 43, 11, 224, 249, 11, 228, 139, 124,
-			22, 103, 108, 243, 77, 134, 94, 44, 252, 129, 158, 104, 76, 87,
-			130, 30, 125, 137, 63, 75, 248, 189, 233, 75, 203, 117, 26, 240,
-			152, 38, 165, 61, 72, 197, 226, 153, 14, 117, 209, 29, 211, 3,
-			245, 209, 105, 180, 25, 217, 114, 34, 150, 214, 69, 148, 194, 133,
-			87, 165, 180, 229, 196, 141, 18, 117, 235, 42, 154, 71, 153, 23,
-			77, 217, 122, 164, 75, 69, 177, 19, 115, 38, 216, 171, 43, 8,
-			52, 120, 233, 178, 63, 150, 222, 182, 116, 21, 150, 190, 135, 74,
-			90, 168, 25, 156, 49, 145, 200, 10, 180, 0, 84, 235, 125, 70,
-			3, 80, 156, 243, 17, 52, 15, 122, 147, 113, 236, 56, 249, 67,
-			19, 7, 40, 107, 155, 239, 52, 244, 75, 133, 127, 111, 210, 117,
-			110, 180, 44, 34, 159, 202, 165, 56, 234, 62, 105, 113, 125, 190,
-			138, 123, 142, 191, 221, 118, 182, 217, 125, 148, 22, 69, 36, 212,
-			162, 42, 194, 237, 15, 241, 94, 87, 134, 18, 193, 151, 58, 59,
-			48, 31, 99, 183, 10, 170, 58, 173, 92, 91, 160, 17, 127, 148,
-			15, 79, 128, 58, 88, 40, 169, 9, 61, 159, 25, 161, 168, 145,
-			118, 139, 249, 104, 79, 51, 232, 164, 10, 59, 80, 227, 161, 92,
-			28, 79, 236, 216, 162, 169, 50, 161, 87, 122, 59, 181, 203, 196,
-			241, 9, 110, 138, 110, 184, 126, 141, 63, 52, 203, 213, 15, 249,
-			162, 80, 224, 167, 12, 192, 241, 166, 207, 9, 189, 142, 52, 121,
-			198, 211, 158, 222, 104, 76, 100, 159, 202, 56, 53, 118, 185, 5,
-			0, 55, 161, 72, 153, 154, 187, 62, 173, 59, 59, 1, 154, 78,
-			241, 153, 46, 26, 46, 94, 205, 233, 94, 206, 210, 20, 229, 219,
-			208, 91, 147, 52, 196, 135, 33, 75, 68, 136, 36, 101, 199, 22,
-			80, 180, 122, 43, 83, 186, 134, 206, 179, 53, 38, 54, 238, 124,
-			15, 30, 193, 250, 177, 135, 13, 184, 77, 59, 193, 237, 171, 91,
-			117, 99, 216, 68, 224, 173, 61, 107, 114, 243, 131, 118, 178, 168,
-			100, 77, 100, 43, 5, 90, 0, 170, 69, 37, 171, 1, 168, 180,
-			147, 172, 1, 160, 210, 78, 178, 57, 0, 149, 68, 201, 34, 135,
-			158, 191, 32, 36, 87, 206, 54, 223, 101, 232, 114, 197, 201, 153,
-			8, 202, 122, 114, 22, 128, 170, 158, 156, 6, 96, 254, 148, 4,
-			13, 0, 39, 79, 75, 16, 81, 157, 121, 169, 4, 179, 0, 222,
-			125, 73, 212, 211, 111, 155, 207, 24, 74, 66, 246, 155, 8, 202,
-			122, 250, 45, 0, 85, 61, 253, 26, 128, 170, 63, 253, 6, 128,
-			170, 63, 253, 57, 0, 85, 127, 250, 179, 0, 170, 254, 16, 219,
-			124, 183, 161, 203, 70, 16, 19, 65, 89, 15, 177, 0, 84, 245,
-			192, 118, 244, 221, 70, 94, 74, 98, 98, 0, 56, 49, 37, 193,
-			28, 128, 167, 101, 147, 73, 22, 192, 187, 46, 146, 191, 229, 123,
-			215, 1, 219, 252, 41, 67, 159, 43, 124, 73, 163, 203, 81, 87,
-			160, 16, 201, 136, 247, 17, 202, 159, 252, 1, 22, 12, 184, 252,
-			138, 157, 112, 155, 197, 32, 117, 227, 122, 16, 54, 133, 161, 21,
-			172, 221, 172, 233, 198, 144, 63, 113, 152, 80, 103, 182, 132, 43,
-			251, 59, 44, 236, 160, 190, 152, 86, 96, 241, 64, 203, 141, 149,
-			144, 150, 75, 182, 215, 161, 238, 182, 31, 132, 172, 118, 73, 102,
-			135, 242, 132, 122, 204, 137, 226, 180, 177, 25, 186, 75, 200, 149,
-			28, 107, 146, 93, 224, 106, 152, 151, 218, 186, 14, 152, 216, 107,
-			5, 90, 0, 42, 122, 14, 104, 0, 230, 11, 18, 52, 0, 60,
-			114, 76, 130, 57, 0, 233, 89, 9, 102, 1, 60, 51, 75, 126,
-			20, 201, 57, 104, 155, 239, 55, 244, 123, 10, 175, 161, 60, 90,
-			115, 36, 141, 135, 240, 242, 9, 67, 55, 171, 173, 171, 8, 18,
-			178, 95, 156, 22, 113, 170, 138, 135, 128, 12, 77, 202, 120, 108,
-			149, 237, 61, 250, 18, 32, 91, 56, 115, 70, 117, 109, 208, 196,
-			22, 40, 208, 2, 80, 117, 109, 80, 3, 80, 220, 18, 16, 125,
-			208, 0, 240, 132, 156, 9, 131, 57, 0, 239, 188, 91, 130, 89,
-			0, 207, 222, 69, 222, 193, 89, 229, 128, 109, 254, 188, 161, 79,
-			21, 222, 144, 58, 230, 8, 228, 73, 24, 173, 138, 141, 16, 143,
-			8, 45, 4, 135, 139, 48, 30, 224, 225, 78, 8, 54, 187, 169,
-			34, 100, 191, 14, 245, 172, 241, 101, 46, 177, 184, 109, 147, 148,
-			85, 170, 187, 7, 76, 108, 148, 2, 45, 0, 213, 34, 119, 64,
-			3, 208, 150, 51, 240, 128, 1, 224, 157, 147, 100, 25, 251, 51,
-			100, 155, 31, 49, 244, 201, 194, 37, 170, 226, 74, 35, 57, 247,
-			180, 233, 146, 172, 54, 146, 246, 217, 98, 97, 87, 205, 24, 50,
-			17, 151, 2, 45, 0, 85, 51, 134, 52, 0, 237, 162, 4, 13,
-			0, 79, 77, 112, 211, 102, 162, 15, 219, 230, 47, 25, 220, 180,
-			57, 57, 237, 161, 235, 187, 110, 61, 78, 175, 109, 56, 53, 208,
-			227, 107, 239, 57, 16, 232, 125, 11, 210, 72, 20, 141, 62, 66,
-			6, 42, 17, 176, 254, 68, 121, 130, 235, 250, 109, 191, 198, 194,
-			168, 26, 132, 76, 5, 144, 227, 246, 37, 129, 28, 52, 105, 243,
-			29, 205, 68, 157, 230, 86, 224, 69, 68, 110, 54, 133, 229, 102,
-			156, 108, 45, 34, 62, 184, 92, 253, 41, 113, 147, 44, 108, 162,
-			58, 197, 23, 118, 183, 252, 218, 129, 60, 87, 53, 170, 22, 73,
-			192, 97, 19, 105, 162, 64, 11, 64, 69, 207, 97, 13, 64, 117,
-			182, 52, 108, 0, 120, 252, 4, 154, 185, 19, 61, 111, 155, 31,
-			135, 97, 109, 37, 92, 218, 106, 180, 94, 40, 119, 66, 214, 61,
-			12, 64, 246, 225, 202, 69, 49, 78, 110, 68, 97, 135, 216, 81,
-			109, 207, 155, 216, 0, 5, 90, 0, 170, 182, 231, 53, 0, 21,
-			47, 228, 13, 0, 79, 77, 144, 95, 231, 115, 108, 196, 54, 63,
-			105, 232, 167, 10, 31, 213, 112, 59, 145, 162, 55, 30, 36, 240,
-			200, 131, 42, 236, 27, 178, 108, 80, 223, 191, 209, 170, 137, 36,
-			105, 227, 158, 81, 84, 159, 186, 21, 86, 84, 55, 211, 102, 204,
-			48, 49, 128, 25, 98, 22, 54, 147, 88, 65, 170, 17, 170, 243,
-			35, 38, 118, 64, 129, 22, 128, 170, 243, 35, 26, 128, 182, 20,
-			165, 35, 6, 128, 197, 147, 228, 63, 240, 206, 219, 182, 249, 235,
-			134, 94, 46, 252, 218, 63, 161, 243, 50, 142, 188, 162, 2, 217,
-			59, 82, 207, 75, 133, 228, 196, 45, 77, 8, 162, 40, 241, 2,
-			8, 97, 155, 216, 25, 5, 90, 0, 42, 66, 216, 26, 128, 182,
-			212, 5, 108, 3, 192, 51, 211, 228, 183, 56, 33, 70, 109, 243,
-			183, 65, 34, 124, 236, 249, 8, 33, 199, 43, 168, 211, 176, 189,
-			213, 249, 39, 48, 129, 176, 182, 252, 190, 216, 0, 171, 238, 21,
-			137, 163, 38, 118, 66, 129, 22, 128, 138, 0, 163, 26, 128, 106,
-			10, 143, 26, 0, 30, 63, 65, 94, 135, 253, 31, 179, 205, 255,
-			211, 208, 47, 22, 252, 239, 203, 235, 155, 168, 43, 137, 238, 128,
-			140, 114, 221, 45, 174, 201, 187, 102, 121, 71, 145, 118, 17, 39,
-			250, 152, 137, 213, 43, 48, 3, 224, 128, 108, 234, 152, 6, 160,
-			112, 17, 39, 250, 152, 1, 224, 221, 23, 200, 143, 107, 196, 48,
-			65, 51, 251, 180, 161, 31, 44, 60, 241, 79, 246, 17, 255, 254,
-			123, 129, 26, 164, 213, 7, 45, 17, 14, 229, 4, 29, 202, 63,
-			109, 8, 135, 114, 130, 14, 229, 159, 54, 110, 27, 231, 215, 25,
-			253, 182, 249, 187, 134, 62, 196, 11, 246, 247, 1, 52, 192, 95,
-			205, 36, 253, 80, 48, 13, 234, 2, 28, 36, 186, 57, 96, 103,
-			62, 107, 244, 253, 132, 169, 33, 26, 80, 155, 62, 107, 228, 110,
-			39, 159, 183, 136, 105, 14, 232, 125, 182, 249, 37, 67, 127, 89,
-			225, 119, 44, 16, 196, 184, 17, 72, 93, 102, 37, 118, 246, 103,
-			229, 9, 6, 228, 74, 123, 190, 214, 187, 188, 148, 228, 193, 86,
-			106, 213, 193, 28, 234, 154, 98, 26, 116, 70, 39, 118, 183, 92,
-			140, 182, 163, 142, 183, 122, 176, 19, 245, 190, 54, 94, 143, 9,
-			151, 220, 228, 130, 147, 63, 13, 155, 10, 83, 199, 253, 216, 47,
-			82, 186, 28, 79, 68, 212, 99, 81, 68, 40, 171, 215, 221, 170,
-			139, 126, 91, 13, 208, 232, 216, 46, 11, 105, 157, 57, 113, 59,
-			100, 17, 63, 178, 134, 161, 132, 165, 22, 53, 89, 254, 0, 115,
-			79, 104, 62, 101, 63, 47, 15, 116, 217, 77, 7, 131, 232, 117,
-			93, 31, 83, 149, 253, 254, 32, 160, 175, 229, 225, 65, 197, 188,
-			189, 197, 43, 17, 244, 94, 164, 182, 120, 117, 63, 197, 99, 231,
-			97, 0, 154, 206, 77, 252, 242, 100, 183, 141, 41, 75, 221, 246,
-			131, 206, 206, 47, 203, 129, 12, 178, 121, 220, 52, 251, 82, 138,
-			160, 145, 176, 252, 195, 172, 233, 161, 34, 232, 193, 212, 205, 221,
-			184, 43, 69, 203, 39, 217, 111, 216, 212, 226, 6, 85, 234, 192,
-			151, 120, 76, 53, 212, 37, 164, 69, 195, 150, 176, 63, 141, 248,
-			125, 64, 132, 59, 133, 94, 219, 127, 172, 242, 178, 178, 14, 23,
-			123, 2, 121, 125, 199, 133, 26, 183, 56, 137, 119, 241, 14, 56,
-			14, 221, 170, 138, 105, 139, 163, 207, 252, 122, 16, 86, 197, 126,
-			62, 222, 47, 144, 158, 16, 16, 3, 120, 243, 245, 37, 41, 32,
-			6, 240, 230, 235, 75, 82, 199, 30, 192, 163, 195, 47, 25, 249,
-			9, 9, 26, 0, 158, 62, 35, 193, 28, 128, 226, 108, 116, 64,
-			239, 203, 2, 120, 207, 75, 201, 95, 104, 56, 105, 52, 219, 252,
-			115, 67, 191, 191, 240, 159, 53, 186, 200, 239, 137, 184, 6, 147,
-			58, 121, 16, 167, 64, 234, 245, 233, 98, 45, 117, 145, 80, 164,
-			242, 145, 143, 212, 115, 234, 24, 22, 182, 238, 185, 213, 88, 186,
-			71, 242, 19, 101, 137, 73, 218, 105, 72, 239, 45, 148, 66, 14,
-			6, 155, 162, 77, 80, 56, 85, 240, 52, 161, 8, 137, 121, 203,
-			156, 200, 101, 225, 37, 234, 179, 93, 113, 8, 33, 30, 67, 223,
-			9, 92, 201, 46, 226, 78, 37, 213, 200, 162, 162, 163, 102, 98,
-			111, 21, 104, 1, 168, 232, 168, 33, 45, 242, 51, 18, 52, 0,
-			156, 59, 39, 193, 28, 128, 231, 151, 36, 152, 5, 240, 190, 69,
-			242, 215, 156, 142, 186, 109, 254, 141, 161, 159, 45, 252, 151, 100,
-			91, 43, 217, 251, 69, 219, 217, 166, 230, 208, 247, 184, 157, 21,
-			187, 89, 242, 130, 183, 179, 41, 182, 231, 253, 215, 77, 236, 176,
-			2, 45, 0, 21, 41, 129, 173, 254, 70, 238, 104, 7, 240, 222,
-			231, 111, 140, 35, 71, 37, 152, 3, 240, 216, 172, 4, 179, 0,
-			158, 158, 225, 66, 189, 223, 54, 191, 102, 232, 163, 184, 54, 12,
-			192, 218, 240, 53, 99, 96, 144, 231, 196, 181, 33, 13, 234, 2,
-			228, 121, 241, 227, 144, 104, 66, 191, 214, 3, 234, 2, 228, 121,
-			17, 26, 177, 197, 71, 93, 235, 6, 229, 215, 159, 206, 224, 216,
-			26, 182, 249, 175, 77, 253, 104, 225, 169, 12, 104, 50, 202, 67,
-			71, 142, 47, 159, 249, 221, 183, 249, 41, 253, 208, 105, 161, 93,
-			126, 135, 139, 34, 49, 110, 4, 210, 165, 27, 151, 116, 87, 82,
-			41, 92, 12, 83, 72, 121, 233, 203, 89, 103, 163, 211, 98, 37,
-			138, 97, 241, 225, 231, 203, 32, 125, 147, 207, 168, 123, 233, 217,
-			75, 36, 81, 90, 106, 105, 23, 43, 47, 8, 110, 68, 24, 122,
-			67, 162, 19, 13, 190, 234, 180, 208, 184, 19, 31, 203, 145, 18,
-			62, 45, 229, 229, 195, 58, 221, 114, 61, 201, 225, 120, 84, 52,
-			139, 222, 96, 29, 209, 136, 61, 89, 84, 131, 197, 198, 236, 94,
-			58, 39, 178, 61, 201, 255, 40, 161, 218, 221, 160, 158, 222, 17,
-			186, 220, 19, 213, 2, 141, 233, 26, 65, 16, 113, 65, 154, 58,
-			166, 224, 227, 34, 155, 127, 47, 170, 0, 106, 134, 108, 181, 99,
-			212, 168, 169, 67, 125, 30, 161, 4, 198, 198, 237, 154, 134, 234,
-			28, 55, 14, 104, 3, 52, 6, 248, 118, 131, 117, 184, 99, 187,
-			48, 196, 231, 4, 79, 93, 172, 205, 95, 91, 70, 237, 10, 125,
-			29, 246, 196, 224, 192, 107, 52, 105, 116, 131, 177, 85, 157, 136,
-			80, 183, 158, 248, 98, 242, 25, 184, 191, 127, 25, 186, 102, 172,
-			109, 44, 93, 148, 17, 36, 197, 97, 167, 82, 165, 123, 34, 232,
-			210, 121, 110, 130, 32, 213, 30, 228, 42, 30, 113, 143, 200, 189,
-			49, 119, 83, 21, 8, 132, 12, 149, 198, 97, 110, 179, 235, 88,
-			149, 159, 126, 139, 29, 138, 92, 152, 132, 53, 91, 178, 64, 25,
-			38, 78, 17, 5, 90, 0, 42, 105, 96, 104, 0, 230, 15, 74,
-			16, 231, 211, 225, 35, 228, 164, 152, 239, 111, 49, 245, 3, 197,
-			59, 240, 14, 220, 115, 99, 208, 46, 196, 29, 217, 150, 199, 136,
-			152, 176, 6, 100, 83, 51, 31, 48, 166, 65, 93, 128, 199, 4,
-			198, 183, 2, 70, 27, 49, 250, 142, 31, 108, 58, 209, 38, 96,
-			150, 200, 76, 200, 161, 74, 155, 90, 55, 168, 11, 112, 13, 39,
-			191, 105, 155, 111, 55, 127, 112, 1, 161, 6, 184, 115, 66, 66,
-			43, 51, 3, 224, 128, 148, 141, 232, 156, 96, 30, 147, 171, 14,
-			58, 39, 152, 34, 32, 212, 128, 101, 155, 239, 48, 95, 156, 128,
-			80, 3, 160, 191, 191, 195, 20, 250, 251, 0, 234, 239, 239, 48,
-			133, 254, 62, 128, 250, 251, 59, 204, 219, 198, 81, 13, 31, 180,
-			51, 239, 52, 251, 190, 45, 212, 240, 65, 205, 54, 223, 105, 230,
-			198, 200, 207, 234, 196, 52, 7, 65, 13, 127, 143, 169, 151, 11,
-			79, 235, 72, 49, 124, 186, 42, 97, 86, 121, 199, 134, 6, 92,
-			103, 206, 244, 222, 155, 11, 125, 221, 73, 12, 104, 201, 45, 28,
-			234, 133, 201, 102, 195, 241, 129, 252, 202, 158, 104, 23, 152, 185,
-			76, 213, 94, 70, 94, 83, 16, 69, 152, 45, 230, 5, 187, 82,
-			243, 232, 222, 143, 118, 88, 156, 76, 223, 196, 224, 33, 104, 49,
-			249, 238, 1, 180, 6, 214, 78, 70, 167, 167, 105, 20, 132, 97,
-			167, 68, 119, 217, 132, 231, 81, 148, 240, 1, 119, 124, 170, 49,
-			116, 142, 68, 67, 215, 54, 168, 232, 242, 166, 230, 56, 31, 245,
-			65, 212, 232, 222, 35, 153, 96, 16, 163, 130, 189, 199, 20, 222,
-			28, 131, 168, 209, 189, 199, 20, 113, 58, 6, 81, 163, 123, 143,
-			121, 176, 32, 193, 28, 128, 135, 166, 37, 152, 5, 240, 206, 18,
-			6, 93, 25, 52, 251, 236, 204, 79, 154, 250, 251, 77, 30, 116,
-			101, 16, 239, 171, 127, 210, 204, 194, 220, 200, 0, 8, 227, 243,
-			62, 211, 28, 46, 12, 171, 115, 138, 38, 70, 31, 197, 59, 218,
-			65, 113, 21, 253, 62, 211, 76, 37, 232, 144, 112, 96, 8, 77,
-			36, 6, 249, 85, 244, 179, 166, 184, 64, 30, 20, 55, 202, 207,
-			154, 102, 46, 73, 208, 33, 97, 96, 80, 149, 208, 109, 243, 103,
-			76, 17, 102, 103, 80, 92, 12, 255, 140, 41, 46, 134, 7, 197,
-			197, 240, 207, 152, 163, 99, 228, 11, 156, 139, 52, 219, 252, 144,
-			169, 31, 42, 252, 71, 93, 204, 59, 244, 127, 22, 195, 37, 238,
-			236, 133, 85, 16, 119, 124, 149, 194, 179, 21, 186, 77, 124, 230,
-			70, 234, 131, 104, 96, 138, 162, 132, 58, 124, 135, 164, 54, 83,
-			123, 88, 139, 143, 55, 236, 108, 202, 180, 226, 136, 149, 222, 241,
-			21, 118, 216, 123, 236, 134, 174, 180, 78, 196, 72, 132, 50, 226,
-			73, 98, 89, 196, 56, 3, 149, 210, 46, 109, 78, 24, 58, 29,
-			60, 18, 225, 209, 181, 112, 13, 80, 198, 183, 94, 111, 76, 164,
-			45, 47, 216, 42, 211, 101, 233, 105, 94, 226, 226, 89, 94, 121,
-			129, 100, 142, 121, 188, 114, 116, 38, 199, 91, 52, 97, 128, 134,
-			170, 176, 184, 190, 227, 68, 75, 69, 238, 225, 28, 131, 14, 25,
-			9, 243, 161, 67, 134, 148, 214, 131, 220, 33, 195, 204, 75, 230,
-			67, 135, 12, 96, 190, 247, 88, 56, 48, 186, 109, 254, 138, 169,
-			159, 43, 188, 213, 194, 129, 225, 47, 199, 41, 235, 28, 113, 80,
-			195, 18, 155, 196, 117, 84, 71, 56, 133, 212, 113, 150, 176, 21,
-			15, 68, 20, 4, 225, 205, 158, 94, 149, 96, 215, 173, 94, 11,
-			193, 206, 67, 185, 187, 207, 211, 45, 156, 89, 49, 219, 14, 29,
-			15, 105, 95, 119, 111, 202, 112, 40, 132, 78, 186, 126, 124, 247,
-			249, 18, 109, 139, 191, 145, 248, 139, 153, 48, 65, 252, 154, 42,
-			83, 58, 159, 10, 97, 39, 59, 162, 158, 115, 35, 60, 34, 145,
-			224, 15, 28, 176, 116, 127, 184, 181, 141, 220, 243, 32, 213, 35,
-			234, 5, 60, 98, 0, 232, 205, 46, 90, 210, 112, 163, 31, 224,
-			215, 134, 211, 2, 49, 178, 203, 227, 17, 120, 160, 110, 36, 129,
-			34, 68, 4, 6, 113, 42, 76, 235, 94, 192, 213, 110, 110, 80,
-			158, 84, 91, 38, 116, 29, 5, 90, 7, 190, 170, 183, 230, 212,
-			110, 64, 116, 2, 183, 165, 93, 251, 56, 86, 75, 55, 94, 248,
-			46, 16, 165, 36, 164, 190, 21, 185, 233, 105, 81, 198, 188, 65,
-			227, 4, 214, 112, 118, 220, 32, 76, 249, 85, 160, 224, 224, 99,
-			69, 168, 122, 18, 15, 93, 63, 187, 244, 31, 21, 45, 59, 230,
-			135, 17, 93, 50, 87, 249, 0, 7, 98, 176, 211, 183, 230, 220,
-			160, 142, 155, 213, 58, 53, 60, 243, 231, 230, 208, 219, 65, 176,
-			93, 110, 58, 113, 163, 188, 12, 124, 160, 212, 144, 65, 220, 148,
-			252, 74, 194, 216, 122, 6, 64, 113, 4, 56, 136, 50, 231, 87,
-			76, 123, 92, 130, 6, 128, 135, 14, 75, 48, 7, 224, 145, 57,
-			9, 102, 1, 156, 60, 43, 164, 170, 102, 103, 62, 97, 234, 191,
-			161, 164, 42, 76, 146, 79, 152, 217, 3, 24, 52, 107, 144, 135,
-			178, 250, 85, 211, 180, 11, 119, 136, 3, 212, 212, 45, 55, 247,
-			133, 226, 130, 142, 199, 177, 250, 213, 68, 120, 242, 56, 86, 191,
-			106, 230, 71, 200, 148, 64, 165, 217, 230, 175, 1, 170, 131, 136,
-			106, 15, 207, 69, 41, 100, 26, 207, 155, 32, 3, 193, 251, 107,
-			105, 100, 186, 109, 254, 250, 190, 200, 18, 11, 99, 89, 22, 42,
-			254, 245, 52, 50, 94, 56, 63, 66, 254, 8, 137, 0, 4, 251,
-			83, 83, 63, 83, 248, 212, 144, 180, 209, 72, 57, 79, 108, 169,
-			45, 136, 231, 60, 225, 122, 157, 251, 40, 93, 113, 158, 232, 200,
-			43, 69, 117, 163, 40, 52, 144, 105, 32, 139, 12, 213, 202, 221,
-			2, 154, 204, 241, 69, 236, 139, 93, 105, 92, 199, 237, 74, 83,
-			59, 45, 244, 231, 193, 149, 158, 215, 86, 226, 18, 196, 197, 40,
-			64, 34, 223, 68, 148, 68, 199, 65, 161, 40, 220, 73, 69, 251,
-			182, 218, 177, 84, 132, 185, 34, 199, 173, 73, 184, 92, 230, 167,
-			117, 130, 239, 187, 176, 10, 249, 90, 141, 185, 69, 124, 130, 15,
-			27, 43, 66, 63, 97, 148, 38, 126, 34, 34, 143, 223, 132, 141,
-			52, 116, 223, 65, 223, 31, 232, 109, 207, 30, 193, 9, 25, 173,
-			135, 140, 241, 211, 118, 220, 217, 168, 88, 16, 168, 17, 17, 202,
-			156, 109, 22, 194, 46, 223, 3, 170, 74, 215, 156, 238, 72, 37,
-			202, 13, 71, 233, 123, 210, 103, 70, 25, 250, 17, 181, 118, 116,
-			29, 242, 195, 70, 41, 106, 111, 111, 179, 72, 134, 31, 233, 58,
-			145, 114, 240, 141, 17, 80, 157, 92, 198, 131, 246, 56, 184, 151,
-			2, 60, 93, 237, 233, 138, 86, 131, 145, 58, 131, 80, 28, 139,
-			166, 166, 246, 86, 16, 220, 184, 193, 24, 143, 127, 21, 236, 176,
-			176, 1, 99, 17, 119, 90, 98, 247, 44, 226, 145, 119, 89, 179,
-			185, 123, 4, 136, 52, 5, 165, 14, 55, 54, 140, 83, 47, 8,
-			248, 49, 11, 235, 226, 190, 198, 241, 187, 238, 41, 130, 26, 108,
-			104, 29, 207, 147, 54, 176, 24, 138, 5, 15, 82, 105, 200, 154,
-			78, 202, 23, 178, 76, 233, 253, 237, 16, 134, 1, 244, 6, 96,
-			181, 144, 57, 181, 233, 200, 169, 51, 21, 94, 157, 164, 42, 115,
-			211, 237, 73, 61, 154, 192, 27, 124, 9, 237, 110, 98, 105, 145,
-			39, 43, 3, 108, 40, 140, 161, 239, 252, 184, 75, 185, 77, 240,
-			10, 145, 157, 171, 237, 144, 251, 147, 225, 154, 227, 241, 144, 36,
-			221, 8, 129, 233, 93, 191, 205, 8, 247, 59, 193, 152, 28, 148,
-			201, 232, 193, 130, 45, 203, 164, 203, 215, 191, 119, 183, 186, 119,
-			111, 141, 79, 122, 171, 16, 31, 82, 171, 226, 238, 67, 4, 151,
-			178, 39, 58, 221, 14, 26, 220, 42, 222, 141, 74, 216, 37, 96,
-			139, 229, 104, 153, 207, 91, 247, 9, 86, 155, 156, 146, 138, 86,
-			215, 236, 38, 88, 119, 200, 226, 118, 40, 24, 18, 131, 141, 136,
-			125, 114, 247, 84, 108, 56, 17, 197, 55, 160, 112, 10, 116, 181,
-			44, 117, 108, 239, 51, 232, 176, 19, 118, 148, 51, 66, 32, 13,
-			221, 246, 193, 137, 59, 7, 225, 76, 22, 112, 27, 63, 62, 191,
-			93, 159, 7, 156, 17, 203, 20, 62, 241, 133, 209, 169, 128, 48,
-			37, 144, 243, 204, 81, 26, 97, 171, 29, 182, 2, 110, 125, 1,
-			132, 33, 114, 102, 128, 186, 225, 247, 174, 141, 226, 192, 20, 201,
-			29, 61, 39, 189, 137, 58, 195, 86, 209, 91, 98, 241, 158, 128,
-			27, 167, 41, 46, 175, 18, 82, 214, 88, 169, 177, 145, 210, 178,
-			187, 25, 136, 90, 188, 254, 117, 154, 123, 48, 156, 22, 205, 112,
-			241, 33, 185, 174, 166, 224, 81, 227, 105, 52, 88, 62, 77, 158,
-			43, 91, 183, 108, 146, 242, 140, 71, 176, 233, 58, 64, 107, 128,
-			46, 188, 197, 152, 47, 40, 142, 199, 249, 88, 104, 110, 118, 238,
-			108, 137, 115, 152, 152, 237, 180, 26, 132, 33, 171, 198, 62, 160,
-			197, 202, 35, 201, 77, 220, 46, 25, 157, 91, 197, 235, 53, 105,
-			234, 35, 83, 0, 135, 121, 40, 107, 2, 90, 13, 157, 168, 193,
-			29, 99, 65, 141, 228, 138, 25, 108, 81, 249, 251, 67, 105, 108,
-			110, 4, 43, 149, 159, 90, 118, 104, 187, 21, 248, 201, 140, 162,
-			27, 107, 139, 107, 147, 91, 51, 115, 103, 207, 94, 152, 189, 251,
-			236, 217, 115, 83, 23, 169, 48, 200, 225, 207, 25, 169, 253, 5,
-			246, 69, 197, 79, 229, 186, 134, 97, 226, 194, 170, 64, 11, 64,
-			165, 143, 27, 26, 128, 34, 178, 225, 32, 158, 158, 252, 169, 121,
-			135, 212, 98, 140, 28, 128, 7, 79, 75, 48, 11, 224, 201, 41,
-			242, 81, 13, 151, 108, 211, 54, 191, 108, 234, 247, 20, 126, 90,
-			163, 61, 207, 55, 127, 111, 4, 21, 246, 65, 210, 246, 174, 219,
-			147, 16, 205, 101, 16, 39, 106, 210, 188, 154, 170, 122, 93, 162,
-			21, 6, 13, 119, 75, 236, 201, 130, 80, 206, 33, 199, 231, 219,
-			105, 25, 180, 153, 119, 192, 228, 45, 86, 160, 5, 160, 162, 133,
-			169, 1, 40, 98, 229, 12, 226, 233, 200, 151, 205, 226, 73, 9,
-			230, 0, 20, 78, 199, 131, 104, 6, 254, 101, 115, 246, 46, 242,
-			101, 78, 11, 203, 54, 255, 218, 212, 207, 22, 254, 56, 109, 121,
-			6, 203, 212, 139, 118, 64, 47, 175, 70, 162, 239, 239, 120, 94,
-			132, 117, 124, 65, 214, 102, 93, 12, 101, 153, 216, 85, 5, 98,
-			207, 21, 17, 45, 13, 192, 188, 60, 78, 176, 12, 0, 197, 225,
-			252, 32, 26, 82, 255, 181, 41, 14, 231, 7, 209, 144, 250, 175,
-			205, 211, 51, 228, 62, 164, 97, 198, 54, 191, 102, 234, 165, 194,
-			217, 239, 253, 205, 27, 142, 47, 99, 34, 6, 5, 90, 0, 170,
-			166, 101, 52, 0, 21, 175, 103, 12, 0, 239, 56, 40, 193, 28,
-			128, 133, 51, 18, 204, 2, 120, 234, 52, 30, 212, 13, 130, 194,
-			254, 141, 31, 224, 65, 221, 32, 26, 143, 126, 35, 105, 106, 54,
-			3, 224, 128, 164, 83, 86, 3, 80, 28, 212, 13, 162, 241, 232,
-			55, 228, 65, 221, 160, 101, 155, 223, 122, 145, 14, 234, 6, 173,
-			62, 64, 46, 14, 234, 6, 241, 160, 238, 91, 242, 160, 110, 16,
-			15, 234, 190, 101, 222, 54, 78, 14, 67, 59, 250, 109, 243, 31,
-			76, 253, 64, 113, 88, 188, 37, 83, 163, 143, 163, 222, 205, 49,
-			245, 247, 193, 103, 113, 240, 57, 136, 183, 43, 105, 80, 23, 224,
-			32, 209, 205, 3, 118, 230, 59, 102, 223, 27, 44, 126, 228, 119,
-			64, 179, 205, 239, 152, 185, 49, 164, 252, 1, 216, 251, 124, 247,
-			7, 72, 249, 3, 120, 58, 246, 93, 73, 249, 3, 120, 58, 246,
-			93, 73, 249, 3, 184, 131, 250, 174, 164, 252, 1, 60, 29, 251,
-			174, 164, 252, 1, 203, 54, 95, 111, 189, 56, 148, 63, 0, 148,
-			127, 189, 37, 40, 127, 0, 41, 255, 122, 75, 80, 254, 0, 82,
-			254, 245, 150, 56, 34, 29, 178, 51, 111, 180, 250, 222, 45, 232,
-			53, 164, 217, 230, 27, 173, 220, 40, 121, 140, 152, 230, 16, 208,
-			235, 205, 150, 78, 11, 21, 110, 167, 208, 109, 108, 35, 205, 22,
-			240, 65, 118, 218, 228, 15, 175, 37, 199, 161, 252, 241, 11, 124,
-			164, 43, 78, 60, 121, 136, 140, 47, 138, 109, 25, 66, 2, 190,
-			217, 210, 21, 104, 1, 40, 102, 217, 16, 18, 240, 205, 86, 254,
-			144, 4, 13, 0, 143, 30, 35, 127, 166, 97, 243, 52, 219, 124,
-			187, 165, 159, 45, 252, 81, 34, 39, 69, 212, 160, 23, 241, 30,
-			147, 187, 222, 189, 184, 82, 18, 175, 243, 21, 141, 52, 19, 251,
-			169, 64, 11, 64, 69, 35, 13, 169, 32, 132, 228, 16, 158, 130,
-			189, 221, 18, 66, 114, 8, 47, 131, 223, 110, 9, 33, 57, 132,
-			151, 193, 111, 183, 78, 207, 224, 253, 195, 80, 191, 109, 62, 101,
-			61, 199, 253, 195, 16, 204, 187, 167, 44, 49, 209, 134, 112, 222,
-			165, 65, 93, 128, 107, 56, 26, 186, 109, 62, 109, 253, 224, 38,
-			215, 16, 30, 146, 60, 157, 244, 27, 68, 250, 211, 214, 128, 236,
-			25, 140, 254, 211, 150, 152, 92, 67, 120, 72, 242, 180, 37, 38,
-			215, 144, 101, 155, 207, 188, 72, 147, 107, 8, 38, 215, 51, 114,
-			114, 13, 225, 228, 122, 70, 78, 174, 33, 156, 92, 207, 200, 201,
-			53, 108, 103, 222, 99, 245, 253, 156, 152, 92, 195, 154, 109, 190,
-			199, 202, 141, 147, 111, 2, 251, 14, 195, 236, 122, 22, 216, 247,
-			203, 61, 236, 203, 55, 198, 47, 58, 19, 243, 122, 94, 236, 251,
-			248, 84, 120, 47, 49, 176, 195, 56, 233, 159, 149, 3, 59, 140,
-			147, 254, 89, 201, 208, 195, 56, 233, 159, 149, 12, 61, 140, 147,
-			254, 89, 201, 208, 195, 120, 167, 240, 172, 100, 232, 97, 188, 83,
-			120, 22, 24, 122, 13, 73, 170, 217, 230, 251, 127, 128, 60, 56,
-			140, 115, 239, 253, 73, 83, 181, 12, 128, 3, 178, 49, 26, 214,
-			39, 120, 112, 24, 231, 222, 251, 37, 15, 14, 91, 182, 249, 193,
-			23, 137, 7, 135, 129, 7, 63, 40, 121, 112, 24, 121, 240, 131,
-			146, 7, 135, 145, 7, 63, 40, 121, 48, 111, 103, 62, 100, 245,
-			253, 91, 193, 131, 121, 205, 54, 63, 100, 229, 110, 39, 127, 108,
-			16, 211, 204, 3, 15, 126, 204, 210, 231, 210, 214, 32, 137, 71,
-			241, 139, 200, 128, 162, 146, 23, 155, 251, 164, 47, 78, 153, 204,
-			125, 89, 195, 195, 132, 139, 148, 199, 139, 82, 241, 63, 206, 170,
-			192, 31, 231, 230, 100, 172, 169, 36, 184, 191, 122, 228, 92, 169,
-			142, 149, 107, 11, 132, 82, 90, 15, 157, 38, 219, 13, 194, 27,
-			101, 74, 31, 98, 212, 105, 5, 94, 176, 13, 156, 132, 111, 189,
-			4, 78, 88, 19, 27, 237, 40, 245, 40, 73, 64, 131, 118, 24,
-			49, 111, 135, 69, 226, 150, 159, 210, 93, 198, 93, 171, 100, 208,
-			78, 126, 86, 133, 78, 66, 24, 142, 119, 11, 93, 146, 32, 91,
-			141, 85, 93, 113, 218, 36, 239, 246, 228, 171, 184, 128, 72, 60,
-			140, 43, 88, 55, 143, 179, 236, 99, 146, 117, 243, 56, 203, 62,
-			38, 103, 89, 30, 103, 217, 199, 228, 44, 203, 227, 44, 251, 152,
-			37, 92, 57, 242, 56, 203, 62, 102, 9, 87, 142, 60, 206, 178,
-			143, 89, 103, 102, 113, 150, 229, 97, 150, 125, 226, 7, 56, 203,
-			242, 56, 203, 62, 145, 52, 21, 102, 217, 39, 228, 44, 203, 227,
-			44, 251, 132, 156, 101, 121, 156, 101, 159, 144, 179, 44, 111, 217,
-			230, 39, 95, 164, 89, 150, 135, 89, 246, 73, 57, 203, 242, 56,
-			203, 62, 41, 103, 89, 30, 103, 217, 39, 229, 44, 27, 177, 51,
-			255, 206, 234, 251, 99, 49, 203, 70, 52, 219, 252, 119, 86, 238,
-			54, 242, 71, 48, 203, 70, 96, 150, 125, 10, 102, 217, 127, 78,
-			219, 92, 225, 145, 222, 139, 108, 114, 5, 117, 188, 248, 22, 87,
-			194, 111, 247, 255, 111, 83, 108, 4, 167, 216, 167, 36, 223, 142,
-			224, 20, 251, 148, 156, 98, 35, 56, 197, 62, 37, 167, 216, 8,
-			78, 177, 79, 201, 41, 54, 130, 83, 236, 83, 114, 138, 141, 224,
-			20, 251, 20, 76, 177, 175, 130, 114, 48, 98, 246, 217, 153, 79,
-			91, 250, 127, 178, 140, 110, 67, 61, 113, 14, 92, 99, 211, 220,
-			113, 125, 26, 15, 227, 39, 131, 144, 159, 206, 186, 62, 125, 96,
-			99, 227, 26, 204, 73, 207, 241, 171, 108, 138, 15, 126, 141, 53,
-			91, 65, 204, 124, 24, 214, 32, 164, 62, 63, 66, 187, 143, 231,
-			197, 199, 149, 209, 19, 178, 247, 180, 45, 57, 97, 189, 178, 180,
-			1, 204, 177, 197, 125, 206, 157, 58, 198, 224, 198, 97, 231, 38,
-			189, 215, 174, 167, 190, 39, 213, 169, 3, 95, 121, 139, 209, 115,
-			45, 119, 109, 109, 125, 67, 18, 19, 239, 255, 63, 109, 101, 239,
-			192, 171, 248, 17, 126, 255, 255, 25, 203, 60, 140, 183, 60, 35,
-			226, 186, 255, 51, 150, 120, 5, 101, 68, 92, 247, 127, 198, 42,
-			28, 34, 39, 69, 9, 205, 54, 127, 215, 50, 199, 139, 99, 220,
-			56, 136, 69, 169, 182, 16, 85, 76, 227, 217, 70, 147, 4, 29,
-			18, 110, 191, 131, 220, 35, 240, 232, 182, 249, 123, 150, 57, 90,
-			156, 72, 147, 142, 199, 17, 148, 97, 217, 48, 80, 6, 31, 131,
-			40, 65, 13, 45, 248, 61, 75, 60, 227, 51, 34, 172, 5, 126,
-			207, 194, 215, 162, 64, 16, 104, 118, 230, 179, 150, 254, 251, 214,
-			157, 98, 212, 65, 240, 125, 54, 97, 32, 16, 124, 159, 181, 68,
-			132, 203, 17, 108, 232, 103, 173, 195, 211, 18, 52, 0, 20, 241,
-			32, 70, 80, 181, 255, 125, 43, 115, 74, 130, 89, 0, 71, 78,
-			162, 140, 30, 129, 122, 63, 247, 3, 148, 209, 35, 168, 141, 127,
-			46, 105, 42, 104, 227, 159, 147, 50, 122, 4, 59, 254, 57, 41,
-			163, 71, 80, 27, 255, 156, 148, 209, 35, 150, 109, 126, 254, 69,
-			146, 209, 35, 32, 163, 63, 47, 101, 244, 8, 202, 232, 207, 75,
-			25, 61, 130, 50, 250, 243, 32, 163, 223, 106, 16, 221, 180, 237,
-			204, 23, 173, 190, 191, 179, 180, 194, 63, 234, 116, 94, 157, 234,
-			170, 187, 115, 144, 10, 142, 218, 225, 38, 100, 83, 247, 55, 138,
-			74, 226, 148, 145, 219, 120, 59, 173, 22, 115, 184, 139, 181, 236,
-			133, 136, 170, 198, 227, 123, 75, 207, 108, 101, 149, 118, 241, 226,
-			53, 17, 155, 142, 135, 161, 73, 71, 44, 14, 2, 79, 70, 187,
-			140, 132, 108, 195, 3, 95, 12, 231, 6, 13, 92, 76, 133, 166,
-			71, 127, 193, 168, 220, 229, 185, 220, 211, 4, 215, 239, 10, 102,
-			207, 75, 136, 71, 229, 249, 21, 6, 111, 95, 130, 246, 226, 69,
-			129, 98, 114, 138, 139, 139, 86, 24, 240, 119, 25, 122, 178, 45,
-			4, 173, 206, 70, 48, 57, 53, 37, 238, 46, 49, 50, 8, 78,
-			142, 235, 233, 112, 120, 42, 102, 158, 12, 184, 199, 67, 69, 217,
-			154, 109, 126, 209, 202, 29, 34, 255, 81, 39, 166, 105, 27, 125,
-			118, 230, 203, 150, 254, 23, 150, 81, 248, 183, 220, 146, 38, 237,
-			216, 223, 21, 95, 47, 185, 43, 196, 16, 138, 34, 156, 136, 26,
-			69, 30, 231, 117, 91, 4, 21, 32, 212, 161, 181, 32, 158, 150,
-			33, 118, 106, 210, 158, 219, 141, 54, 147, 56, 34, 226, 89, 4,
-			234, 214, 235, 169, 210, 105, 148, 126, 42, 200, 30, 157, 172, 49,
-			63, 136, 101, 164, 16, 254, 78, 13, 12, 85, 23, 15, 68, 45,
-			86, 141, 122, 173, 30, 167, 202, 132, 46, 149, 183, 203, 165, 215,
-			210, 87, 137, 7, 210, 209, 38, 230, 213, 37, 250, 170, 226, 150,
-			19, 150, 183, 156, 39, 138, 37, 108, 12, 38, 53, 83, 89, 232,
-			147, 169, 22, 17, 254, 176, 250, 164, 40, 51, 85, 134, 156, 98,
-			178, 218, 24, 129, 250, 203, 22, 225, 47, 123, 217, 60, 2, 245,
-			159, 91, 102, 17, 229, 146, 45, 98, 78, 255, 185, 37, 34, 66,
-			219, 34, 230, 244, 159, 91, 7, 70, 147, 4, 13, 18, 198, 142,
-			36, 9, 6, 36, 208, 227, 10, 167, 102, 155, 95, 177, 204, 19,
-			42, 3, 8, 179, 175, 164, 113, 106, 22, 36, 28, 24, 73, 18,
-			176, 136, 125, 52, 73, 48, 32, 225, 120, 17, 231, 178, 13, 173,
-			252, 75, 75, 231, 113, 81, 109, 108, 227, 95, 74, 137, 99, 227,
-			225, 218, 95, 90, 3, 163, 18, 212, 0, 28, 27, 151, 160, 1,
-			224, 161, 195, 252, 137, 40, 27, 26, 247, 85, 75, 159, 40, 188,
-			77, 75, 189, 116, 242, 28, 220, 84, 130, 145, 218, 109, 56, 49,
-			114, 49, 26, 167, 160, 6, 22, 220, 96, 48, 225, 67, 2, 75,
-			1, 15, 81, 138, 129, 34, 157, 72, 92, 221, 36, 55, 55, 75,
-			194, 73, 69, 60, 26, 197, 39, 175, 48, 90, 21, 111, 80, 201,
-			190, 0, 169, 190, 154, 116, 13, 8, 245, 85, 75, 216, 127, 216,
-			72, 166, 175, 90, 194, 19, 210, 70, 34, 125, 213, 58, 117, 167,
-			32, 146, 110, 155, 95, 179, 244, 41, 241, 17, 196, 242, 215, 18,
-			76, 186, 5, 160, 194, 132, 246, 226, 150, 125, 82, 130, 6, 128,
-			19, 147, 2, 147, 97, 155, 95, 183, 68, 180, 101, 27, 47, 119,
-			190, 158, 96, 50, 44, 0, 133, 165, 159, 141, 151, 59, 95, 183,
-			70, 78, 72, 16, 203, 222, 57, 33, 48, 153, 182, 249, 183, 150,
-			46, 63, 154, 28, 148, 152, 76, 11, 64, 213, 38, 83, 3, 80,
-			56, 184, 217, 120, 53, 242, 183, 9, 11, 88, 182, 249, 223, 44,
-			93, 118, 221, 50, 17, 148, 152, 44, 252, 170, 218, 100, 105, 0,
-			142, 72, 126, 177, 12, 0, 69, 28, 93, 27, 22, 168, 111, 88,
-			34, 116, 178, 141, 199, 249, 223, 72, 48, 101, 44, 0, 85, 155,
-			50, 26, 128, 246, 113, 9, 26, 0, 158, 60, 69, 190, 160, 17,
-			221, 28, 181, 51, 223, 177, 250, 254, 123, 70, 43, 60, 78, 151,
-			252, 170, 211, 138, 68, 252, 211, 23, 246, 100, 58, 15, 230, 21,
-			6, 77, 105, 130, 69, 232, 253, 174, 199, 122, 2, 22, 211, 93,
-			39, 21, 207, 165, 76, 230, 30, 251, 65, 70, 156, 237, 126, 192,
-			29, 27, 206, 165, 240, 168, 102, 155, 223, 177, 114, 183, 147, 215,
-			143, 16, 211, 28, 133, 25, 248, 225, 140, 126, 188, 240, 183, 121,
-			58, 79, 87, 2, 121, 55, 150, 68, 231, 117, 104, 203, 101, 220,
-			92, 161, 27, 35, 117, 186, 3, 178, 65, 95, 9, 191, 179, 139,
-			90, 129, 207, 237, 27, 157, 244, 117, 111, 18, 244, 86, 185, 215,
-			116, 191, 246, 206, 227, 60, 138, 215, 58, 146, 231, 67, 226, 128,
-			46, 47, 46, 225, 83, 81, 53, 241, 36, 19, 11, 163, 82, 143,
-			55, 96, 226, 31, 46, 2, 165, 185, 77, 215, 115, 66, 162, 222,
-			137, 23, 111, 125, 97, 192, 197, 18, 141, 156, 14, 108, 0, 184,
-			107, 23, 239, 130, 114, 72, 184, 165, 155, 25, 144, 85, 4, 179,
-			10, 2, 229, 93, 240, 36, 161, 43, 12, 61, 226, 130, 224, 6,
-			117, 98, 30, 162, 55, 49, 5, 78, 250, 141, 216, 159, 11, 213,
-			163, 194, 85, 225, 209, 71, 213, 31, 248, 239, 209, 71, 225, 163,
-			35, 62, 110, 85, 241, 79, 141, 81, 90, 167, 116, 187, 225, 18,
-			216, 46, 169, 96, 179, 42, 136, 15, 245, 196, 120, 114, 175, 141,
-			168, 229, 248, 148, 98, 192, 30, 218, 253, 47, 189, 202, 80, 250,
-			42, 167, 228, 78, 81, 250, 42, 122, 190, 68, 103, 75, 116, 174,
-			68, 103, 233, 171, 49, 31, 8, 214, 221, 70, 224, 237, 237, 88,
-			89, 20, 220, 234, 41, 88, 162, 231, 161, 44, 20, 244, 156, 45,
-			230, 209, 73, 217, 251, 41, 94, 164, 90, 170, 237, 41, 114, 151,
-			44, 194, 95, 153, 227, 100, 18, 249, 89, 169, 190, 39, 255, 89,
-			153, 159, 135, 38, 173, 7, 129, 200, 188, 93, 106, 236, 201, 124,
-			78, 101, 230, 81, 61, 39, 207, 78, 201, 55, 38, 128, 76, 211,
-			116, 94, 145, 77, 152, 125, 168, 24, 226, 202, 146, 87, 216, 16,
-			197, 17, 243, 234, 226, 21, 60, 97, 34, 129, 161, 234, 104, 154,
-			233, 249, 11, 98, 34, 166, 173, 27, 79, 165, 60, 203, 228, 29,
-			181, 8, 177, 134, 94, 13, 65, 93, 26, 233, 70, 60, 220, 51,
-			165, 176, 9, 230, 214, 71, 204, 175, 122, 129, 48, 252, 80, 22,
-			186, 220, 221, 140, 107, 48, 101, 218, 205, 228, 104, 198, 24, 187,
-			97, 18, 20, 21, 13, 120, 171, 55, 232, 100, 43, 136, 34, 119,
-			203, 235, 164, 159, 50, 83, 214, 61, 137, 230, 147, 10, 84, 205,
-			213, 62, 12, 84, 202, 253, 33, 133, 149, 140, 34, 215, 110, 3,
-			182, 142, 200, 95, 72, 53, 117, 17, 84, 76, 52, 252, 162, 162,
-			34, 238, 248, 149, 149, 43, 6, 38, 242, 57, 181, 202, 48, 12,
-			87, 101, 91, 20, 19, 39, 123, 49, 229, 170, 7, 117, 73, 130,
-			114, 51, 214, 72, 218, 177, 114, 234, 164, 232, 151, 126, 91, 2,
-			159, 64, 107, 133, 184, 67, 133, 138, 121, 76, 102, 213, 125, 12,
-			161, 37, 2, 210, 211, 102, 16, 225, 161, 66, 176, 181, 227, 6,
-			237, 72, 18, 87, 62, 18, 200, 251, 86, 43, 10, 186, 58, 219,
-			142, 235, 171, 200, 182, 50, 24, 114, 58, 142, 111, 122, 24, 186,
-			95, 192, 136, 170, 65, 139, 149, 184, 165, 54, 218, 165, 168, 72,
-			193, 251, 244, 186, 155, 85, 39, 34, 62, 189, 165, 157, 23, 247,
-			180, 193, 240, 180, 130, 171, 220, 56, 18, 138, 171, 200, 203, 121,
-			133, 183, 72, 176, 75, 170, 63, 140, 21, 185, 133, 90, 23, 47,
-			36, 4, 148, 123, 32, 110, 143, 7, 88, 182, 216, 182, 235, 35,
-			27, 9, 173, 171, 151, 50, 220, 19, 56, 106, 56, 33, 223, 90,
-			244, 68, 154, 150, 118, 91, 60, 66, 46, 150, 193, 78, 62, 200,
-			205, 139, 184, 153, 148, 179, 95, 143, 211, 221, 140, 130, 166, 12,
-			32, 218, 147, 19, 48, 171, 141, 93, 147, 57, 234, 89, 24, 142,
-			2, 54, 77, 204, 175, 57, 251, 76, 34, 90, 196, 231, 134, 139,
-			98, 7, 139, 66, 18, 223, 21, 117, 184, 132, 130, 158, 165, 2,
-			135, 171, 153, 217, 245, 152, 73, 18, 146, 51, 225, 104, 64, 148,
-			44, 152, 46, 127, 139, 77, 134, 4, 17, 38, 228, 128, 84, 196,
-			178, 115, 67, 220, 81, 6, 126, 98, 63, 170, 94, 66, 161, 211,
-			116, 33, 137, 82, 197, 31, 219, 65, 167, 15, 161, 247, 166, 166,
-			145, 208, 80, 91, 97, 176, 229, 108, 113, 107, 153, 26, 139, 220,
-			109, 31, 207, 193, 48, 212, 52, 30, 19, 210, 24, 231, 179, 164,
-			146, 60, 57, 224, 177, 83, 98, 199, 175, 149, 64, 41, 70, 191,
-			5, 110, 17, 29, 212, 83, 181, 84, 185, 61, 14, 229, 111, 161,
-			84, 131, 176, 150, 138, 211, 137, 174, 39, 66, 57, 30, 69, 189,
-			255, 195, 25, 93, 129, 25, 0, 133, 222, 63, 138, 122, 255, 135,
-			51, 99, 135, 37, 104, 0, 120, 140, 226, 1, 203, 40, 236, 34,
-			63, 146, 209, 191, 147, 225, 230, 207, 163, 184, 17, 250, 72, 134,
-			216, 228, 205, 89, 146, 1, 24, 52, 156, 223, 202, 152, 165, 194,
-			183, 50, 233, 71, 7, 68, 88, 116, 39, 140, 37, 187, 222, 74,
-			71, 147, 62, 223, 226, 249, 33, 162, 250, 136, 17, 209, 83, 94,
-			21, 226, 236, 52, 117, 18, 42, 94, 3, 227, 66, 77, 132, 112,
-			67, 35, 61, 135, 75, 73, 80, 18, 185, 135, 117, 24, 4, 241,
-			190, 45, 144, 81, 118, 64, 34, 9, 123, 166, 184, 235, 253, 0,
-			26, 84, 171, 109, 124, 131, 190, 139, 105, 241, 20, 5, 42, 193,
-			165, 31, 151, 192, 115, 184, 4, 222, 131, 235, 37, 73, 36, 244,
-			69, 46, 136, 61, 86, 150, 62, 244, 48, 154, 147, 231, 166, 40,
-			157, 153, 193, 114, 210, 131, 178, 140, 125, 155, 188, 103, 74, 41,
-			14, 51, 51, 136, 82, 101, 128, 5, 120, 114, 42, 165, 89, 204,
-			204, 208, 179, 137, 217, 163, 156, 197, 251, 116, 180, 171, 114, 30,
-			73, 32, 77, 200, 243, 216, 74, 181, 0, 247, 82, 169, 171, 240,
-			189, 244, 252, 37, 130, 115, 166, 183, 14, 142, 114, 15, 242, 185,
-			110, 228, 251, 61, 47, 66, 165, 219, 231, 156, 64, 189, 239, 27,
-			36, 168, 127, 236, 65, 127, 118, 95, 173, 15, 243, 10, 55, 210,
-			68, 52, 112, 207, 124, 100, 142, 228, 17, 82, 181, 192, 6, 201,
-			163, 115, 226, 80, 99, 185, 14, 74, 172, 48, 161, 225, 172, 228,
-			57, 81, 44, 89, 114, 207, 224, 195, 200, 43, 214, 232, 89, 165,
-			187, 53, 187, 68, 212, 79, 170, 240, 144, 74, 196, 19, 57, 105,
-			184, 82, 39, 165, 22, 122, 216, 200, 139, 184, 166, 91, 13, 188,
-			192, 159, 18, 118, 252, 163, 226, 16, 226, 183, 50, 226, 192, 96,
-			84, 28, 66, 252, 86, 70, 60, 75, 53, 42, 14, 33, 126, 43,
-			51, 122, 71, 146, 96, 64, 66, 225, 80, 146, 144, 131, 132, 195,
-			103, 72, 158, 228, 68, 130, 14, 41, 71, 78, 147, 223, 215, 197,
-			148, 215, 108, 243, 119, 96, 202, 255, 166, 46, 125, 78, 27, 248,
-			6, 5, 223, 174, 199, 141, 144, 49, 254, 106, 64, 59, 84, 218,
-			214, 69, 17, 240, 219, 115, 125, 216, 29, 224, 239, 106, 224, 181,
-			155, 126, 137, 80, 88, 176, 225, 67, 162, 190, 150, 82, 22, 187,
-			78, 20, 181, 155, 172, 198, 23, 103, 39, 74, 33, 154, 42, 97,
-			81, 142, 71, 61, 112, 225, 132, 202, 109, 204, 245, 121, 132, 92,
-			190, 96, 112, 218, 163, 183, 150, 112, 4, 171, 118, 202, 52, 101,
-			19, 13, 56, 57, 255, 113, 148, 234, 26, 4, 80, 62, 193, 194,
-			96, 154, 31, 238, 131, 254, 161, 108, 214, 59, 65, 155, 47, 22,
-			187, 34, 144, 129, 83, 171, 17, 122, 22, 221, 207, 64, 124, 137,
-			219, 144, 154, 27, 181, 60, 167, 227, 202, 23, 65, 219, 220, 141,
-			86, 210, 93, 51, 145, 168, 201, 216, 105, 22, 36, 164, 198, 78,
-			67, 178, 167, 198, 78, 51, 32, 33, 53, 118, 90, 14, 18, 82,
-			99, 167, 193, 216, 253, 14, 140, 221, 7, 7, 197, 216, 233, 182,
-			249, 15, 25, 115, 170, 240, 244, 160, 10, 101, 191, 142, 251, 78,
-			88, 222, 150, 253, 122, 208, 125, 240, 167, 2, 218, 166, 56, 86,
-			6, 190, 118, 48, 188, 87, 7, 54, 165, 77, 161, 81, 163, 214,
-			226, 38, 87, 64, 184, 116, 227, 91, 157, 92, 170, 38, 92, 47,
-			246, 238, 88, 64, 154, 93, 203, 7, 89, 36, 195, 167, 23, 120,
-			66, 232, 60, 198, 208, 21, 11, 33, 30, 117, 239, 173, 55, 240,
-			209, 78, 154, 85, 219, 104, 117, 10, 217, 34, 30, 186, 148, 250,
-			1, 231, 41, 194, 15, 161, 122, 74, 241, 197, 88, 229, 231, 87,
-			123, 177, 56, 111, 198, 135, 85, 213, 126, 0, 43, 133, 6, 121,
-			204, 1, 173, 98, 179, 198, 120, 187, 55, 85, 131, 16, 193, 13,
-			198, 90, 176, 4, 58, 219, 161, 211, 106, 96, 179, 85, 6, 100,
-			55, 222, 0, 34, 137, 53, 185, 213, 142, 81, 123, 170, 6, 190,
-			207, 253, 8, 226, 96, 138, 159, 116, 115, 31, 0, 57, 155, 202,
-			124, 97, 84, 184, 209, 185, 67, 158, 199, 110, 117, 120, 244, 165,
-			222, 206, 4, 9, 197, 146, 37, 149, 43, 12, 201, 158, 65, 121,
-			158, 175, 161, 215, 78, 35, 41, 34, 28, 107, 210, 81, 113, 46,
-			169, 143, 77, 39, 188, 1, 243, 132, 159, 131, 207, 204, 76, 241,
-			205, 85, 132, 239, 145, 50, 220, 5, 8, 181, 143, 43, 171, 146,
-			14, 37, 73, 67, 224, 135, 88, 68, 218, 66, 166, 241, 169, 19,
-			197, 44, 116, 163, 27, 201, 43, 199, 10, 221, 94, 137, 137, 27,
-			59, 140, 199, 10, 140, 17, 36, 14, 156, 252, 152, 32, 140, 226,
-			50, 161, 171, 108, 23, 105, 130, 156, 43, 252, 117, 19, 95, 95,
-			124, 48, 139, 63, 195, 34, 31, 141, 232, 90, 88, 48, 54, 140,
-			58, 77, 192, 181, 119, 65, 116, 63, 205, 184, 245, 32, 192, 221,
-			241, 45, 62, 111, 57, 97, 121, 31, 180, 91, 78, 200, 87, 191,
-			253, 214, 178, 45, 231, 9, 122, 47, 61, 119, 233, 57, 209, 62,
-			33, 107, 157, 247, 133, 126, 14, 148, 216, 147, 231, 57, 112, 52,
-			85, 203, 159, 15, 147, 204, 153, 122, 80, 183, 189, 229, 193, 94,
-			46, 224, 234, 129, 64, 176, 40, 38, 134, 226, 19, 254, 246, 81,
-			184, 157, 122, 29, 22, 24, 94, 50, 65, 16, 210, 56, 116, 92,
-			244, 28, 145, 44, 34, 80, 241, 90, 169, 44, 159, 126, 160, 53,
-			228, 162, 104, 203, 115, 252, 27, 156, 233, 229, 108, 16, 158, 178,
-			92, 15, 68, 52, 176, 175, 40, 63, 127, 243, 146, 169, 69, 231,
-			202, 251, 142, 9, 207, 118, 47, 189, 139, 143, 202, 105, 122, 57,
-			205, 216, 138, 90, 168, 186, 157, 230, 161, 249, 177, 219, 116, 69,
-			244, 85, 178, 119, 36, 178, 72, 38, 23, 202, 70, 153, 158, 158,
-			121, 78, 204, 98, 47, 65, 79, 211, 237, 16, 3, 85, 138, 2,
-			61, 140, 197, 63, 210, 123, 233, 221, 106, 84, 132, 149, 2, 173,
-			245, 116, 63, 74, 45, 71, 186, 137, 235, 68, 178, 28, 233, 22,
-			36, 136, 251, 12, 76, 208, 32, 97, 236, 100, 146, 96, 64, 194,
-			196, 36, 222, 103, 64, 130, 97, 155, 223, 206, 152, 167, 85, 6,
-			195, 196, 132, 4, 167, 97, 65, 66, 10, 167, 161, 65, 194, 216,
-			169, 36, 1, 113, 76, 78, 41, 156, 166, 109, 254, 99, 198, 156,
-			83, 25, 76, 158, 144, 224, 52, 45, 72, 72, 225, 52, 53, 72,
-			24, 155, 78, 18, 12, 72, 152, 61, 75, 254, 90, 35, 186, 57,
-			102, 103, 222, 144, 237, 123, 119, 86, 43, 252, 23, 45, 245, 146,
-			31, 151, 138, 30, 223, 108, 53, 220, 22, 221, 98, 241, 46, 99,
-			126, 143, 63, 25, 223, 117, 199, 81, 239, 33, 181, 124, 40, 98,
-			62, 137, 59, 175, 214, 215, 116, 244, 152, 40, 10, 170, 174, 163,
-			110, 190, 212, 251, 56, 170, 22, 146, 62, 245, 78, 174, 204, 229,
-			59, 15, 168, 97, 98, 52, 126, 96, 177, 196, 86, 88, 20, 234,
-			138, 15, 194, 15, 168, 199, 52, 219, 124, 67, 54, 119, 144, 220,
-			36, 166, 57, 6, 187, 183, 55, 101, 245, 83, 133, 199, 233, 188,
-			79, 231, 149, 209, 139, 92, 130, 34, 190, 225, 199, 147, 0, 80,
-			73, 217, 77, 92, 51, 122, 136, 128, 7, 115, 114, 77, 33, 242,
-			52, 68, 6, 146, 242, 183, 187, 31, 44, 193, 157, 228, 24, 42,
-			171, 111, 202, 234, 10, 204, 0, 56, 112, 155, 4, 53, 0, 111,
-			167, 18, 52, 0, 60, 113, 18, 119, 165, 99, 176, 43, 253, 241,
-			172, 254, 76, 150, 239, 74, 199, 112, 87, 250, 227, 89, 50, 70,
-			222, 174, 145, 12, 192, 208, 175, 183, 100, 205, 82, 225, 71, 211,
-			155, 82, 180, 212, 236, 94, 249, 122, 47, 23, 186, 222, 204, 78,
-			222, 27, 67, 43, 99, 126, 150, 142, 203, 178, 60, 42, 114, 122,
-			181, 167, 178, 60, 227, 47, 243, 83, 36, 228, 186, 49, 161, 155,
-			191, 37, 43, 24, 117, 76, 232, 230, 111, 201, 10, 253, 110, 76,
-			232, 230, 111, 201, 10, 253, 110, 76, 232, 230, 111, 201, 10, 253,
-			110, 76, 232, 230, 111, 201, 10, 253, 110, 76, 234, 230, 111, 201,
-			30, 57, 77, 214, 68, 191, 53, 219, 124, 91, 214, 60, 89, 184,
-			175, 183, 223, 200, 3, 24, 153, 155, 239, 77, 132, 138, 181, 127,
-			255, 83, 237, 6, 189, 244, 109, 233, 118, 131, 94, 250, 182, 172,
-			152, 96, 99, 66, 47, 125, 91, 118, 236, 88, 146, 96, 64, 66,
-			241, 4, 217, 21, 141, 210, 109, 243, 169, 172, 121, 164, 176, 221,
-			219, 40, 212, 228, 249, 234, 93, 143, 24, 14, 202, 86, 39, 78,
-			30, 174, 237, 230, 51, 17, 141, 28, 167, 101, 98, 5, 159, 186,
-			70, 228, 183, 241, 169, 198, 131, 184, 120, 42, 221, 120, 144, 98,
-			79, 165, 137, 14, 4, 123, 42, 43, 94, 2, 30, 19, 82, 236,
-			169, 236, 161, 195, 228, 79, 36, 43, 25, 182, 249, 174, 172, 121,
-			168, 240, 31, 180, 61, 188, 36, 140, 203, 94, 72, 227, 133, 231,
-			236, 115, 52, 30, 177, 240, 0, 61, 204, 151, 112, 234, 190, 19,
-			228, 66, 203, 137, 226, 212, 54, 52, 100, 30, 219, 193, 215, 212,
-			59, 49, 163, 147, 226, 161, 62, 30, 83, 66, 110, 33, 113, 206,
-			222, 139, 40, 167, 185, 190, 52, 149, 162, 16, 200, 228, 119, 165,
-			41, 4, 50, 249, 93, 105, 10, 129, 76, 126, 87, 86, 188, 100,
-			60, 38, 100, 242, 187, 178, 7, 11, 228, 243, 167, 200, 81, 30,
-			124, 126, 70, 6, 159, 159, 169, 181, 133, 190, 142, 41, 246, 112,
-			79, 112, 250, 226, 69, 146, 91, 20, 89, 236, 113, 146, 141, 88,
-			53, 240, 107, 209, 184, 70, 181, 73, 163, 34, 65, 123, 140, 88,
-			190, 227, 7, 209, 184, 78, 181, 73, 171, 194, 129, 203, 111, 208,
-			200, 104, 53, 104, 150, 123, 112, 94, 62, 32, 49, 226, 41, 193,
-			53, 237, 145, 179, 34, 199, 118, 224, 57, 254, 118, 57, 8, 183,
-			147, 6, 242, 104, 192, 248, 228, 158, 106, 108, 107, 235, 91, 154,
-			246, 126, 221, 184, 114, 237, 242, 71, 244, 163, 220, 126, 174, 124,
-			77, 198, 211, 127, 136, 121, 222, 203, 33, 255, 6, 20, 125, 240,
-			11, 39, 72, 198, 54, 143, 246, 69, 26, 249, 244, 32, 209, 6,
-			109, 227, 104, 159, 61, 247, 155, 131, 202, 176, 77, 90, 181, 209,
-			233, 196, 20, 15, 227, 196, 226, 97, 161, 8, 169, 202, 101, 9,
-			161, 11, 65, 171, 19, 186, 219, 141, 152, 206, 205, 206, 190, 68,
-			20, 160, 203, 126, 181, 76, 49, 176, 33, 126, 139, 148, 129, 95,
-			153, 124, 175, 175, 152, 17, 66, 43, 255, 31, 119, 239, 3, 93,
-			215, 81, 222, 139, 158, 189, 103, 246, 214, 209, 232, 255, 232, 143,
-			229, 227, 127, 99, 5, 98, 57, 150, 143, 229, 63, 9, 137, 67,
-			32, 178, 45, 199, 2, 69, 114, 143, 228, 132, 112, 233, 181, 182,
-			116, 182, 164, 29, 31, 157, 125, 114, 246, 62, 118, 196, 165, 92,
-			160, 20, 104, 225, 65, 47, 112, 129, 54, 45, 208, 150, 242, 104,
-			11, 133, 11, 180, 5, 10, 11, 40, 148, 114, 105, 122, 225, 114,
-			89, 208, 71, 185, 45, 239, 149, 219, 5, 125, 180, 47, 247, 149,
-			246, 210, 192, 163, 111, 125, 223, 252, 219, 231, 72, 78, 44, 199,
-			78, 150, 235, 181, 146, 165, 111, 159, 189, 103, 126, 243, 205, 204,
-			247, 251, 102, 230, 155, 153, 176, 28, 129, 215, 178, 216, 144, 83,
-			203, 234, 68, 230, 72, 31, 109, 131, 79, 22, 163, 106, 80, 151,
-			211, 103, 122, 192, 132, 183, 217, 224, 210, 1, 19, 107, 113, 217,
-			108, 237, 27, 147, 35, 108, 115, 72, 135, 57, 36, 211, 108, 31,
-			183, 75, 100, 80, 159, 145, 217, 234, 142, 81, 110, 210, 173, 6,
-			199, 165, 25, 88, 210, 186, 6, 170, 238, 152, 53, 23, 26, 201,
-			201, 155, 37, 173, 49, 6, 174, 35, 198, 60, 227, 40, 182, 18,
-			37, 169, 28, 98, 217, 28, 213, 29, 37, 22, 78, 57, 74, 150,
-			42, 65, 180, 22, 74, 39, 124, 51, 16, 208, 141, 173, 46, 52,
-			8, 21, 179, 99, 113, 48, 11, 228, 41, 225, 208, 97, 60, 45,
-			171, 173, 65, 181, 124, 40, 174, 171, 129, 204, 90, 144, 226, 193,
-			88, 153, 243, 72, 245, 153, 163, 76, 100, 209, 155, 66, 205, 168,
-			77, 189, 105, 38, 238, 39, 219, 182, 170, 177, 253, 45, 81, 132,
-			141, 103, 55, 202, 164, 226, 186, 185, 103, 18, 23, 209, 240, 36,
-			157, 114, 92, 79, 66, 21, 191, 180, 22, 167, 161, 138, 99, 74,
-			147, 166, 123, 78, 212, 252, 87, 18, 47, 167, 151, 160, 153, 232,
-			197, 39, 115, 107, 68, 173, 30, 65, 195, 170, 67, 219, 169, 202,
-			86, 148, 168, 219, 68, 196, 252, 153, 169, 57, 49, 55, 123, 122,
-			254, 254, 137, 210, 164, 152, 154, 19, 103, 75, 179, 247, 77, 157,
-			154, 60, 37, 78, 60, 32, 230, 207, 76, 138, 147, 179, 103, 31,
-			40, 77, 221, 115, 102, 94, 156, 153, 157, 62, 53, 89, 154, 19,
-			19, 51, 167, 196, 201, 217, 153, 249, 210, 212, 137, 115, 243, 179,
-			165, 57, 38, 70, 38, 230, 196, 212, 220, 8, 254, 50, 49, 243,
-			128, 152, 124, 209, 217, 210, 228, 220, 156, 152, 45, 137, 169, 123,
-			207, 78, 79, 77, 158, 18, 247, 79, 148, 74, 19, 51, 243, 83,
-			147, 115, 99, 98, 106, 230, 228, 244, 185, 83, 83, 51, 247, 140,
-			137, 19, 231, 230, 197, 204, 236, 60, 19, 211, 83, 247, 78, 205,
-			79, 158, 18, 243, 179, 99, 152, 237, 198, 239, 196, 236, 105, 113,
-			239, 100, 233, 228, 153, 137, 153, 249, 137, 19, 83, 211, 83, 243,
-			15, 96, 134, 167, 167, 230, 103, 32, 179, 211, 179, 37, 38, 38,
-			196, 217, 137, 210, 252, 212, 201, 115, 211, 19, 37, 113, 246, 92,
-			233, 236, 236, 220, 164, 128, 146, 157, 154, 154, 59, 57, 61, 49,
-			117, 239, 228, 169, 162, 152, 154, 17, 51, 179, 98, 242, 190, 201,
-			153, 121, 49, 119, 102, 98, 122, 186, 185, 160, 76, 204, 222, 63,
-			51, 89, 2, 244, 217, 98, 138, 19, 147, 98, 122, 106, 226, 196,
-			244, 36, 100, 133, 229, 60, 53, 85, 154, 60, 57, 15, 5, 178,
-			127, 157, 156, 58, 53, 57, 51, 63, 49, 61, 198, 196, 220, 217,
-			201, 147, 83, 19, 211, 99, 98, 242, 69, 147, 247, 158, 157, 158,
-			40, 61, 48, 166, 18, 157, 155, 252, 169, 115, 147, 51, 243, 83,
-			19, 211, 226, 212, 196, 189, 19, 247, 76, 206, 137, 209, 39, 211,
-			202, 217, 210, 236, 201, 115, 165, 201, 123, 1, 245, 236, 105, 49,
-			119, 238, 196, 220, 252, 212, 252, 185, 249, 73, 113, 207, 236, 236,
-			41, 84, 246, 220, 100, 233, 190, 169, 147, 147, 115, 119, 138, 233,
-			217, 57, 84, 216, 185, 185, 201, 49, 38, 78, 77, 204, 79, 96,
-			214, 103, 75, 179, 167, 167, 230, 231, 238, 132, 191, 79, 156, 155,
-			155, 66, 197, 77, 205, 204, 79, 150, 74, 231, 206, 206, 79, 205,
-			206, 236, 23, 103, 102, 239, 159, 188, 111, 178, 36, 78, 78, 156,
-			155, 155, 60, 133, 26, 158, 157, 129, 210, 66, 91, 153, 156, 45,
-			61, 0, 201, 130, 30, 176, 6, 198, 196, 253, 103, 38, 231, 207,
-			76, 150, 64, 169, 168, 173, 9, 80, 195, 220, 124, 105, 234, 228,
-			124, 246, 181, 217, 146, 152, 159, 45, 205, 179, 76, 57, 197, 204,
-			228, 61, 211, 83, 247, 76, 206, 156, 156, 132, 159, 103, 33, 153,
-			251, 167, 230, 38, 247, 139, 137, 210, 212, 28, 188, 48, 133, 25,
-			139, 251, 39, 30, 16, 179, 231, 176, 212, 80, 81, 231, 230, 38,
-			153, 252, 59, 211, 116, 199, 176, 62, 197, 212, 105, 49, 113, 234,
-			190, 41, 64, 174, 222, 62, 59, 59, 55, 55, 165, 154, 11, 170,
-			237, 228, 25, 165, 243, 34, 99, 121, 230, 184, 156, 136, 220, 48,
-			252, 149, 231, 100, 36, 119, 39, 107, 103, 110, 254, 217, 242, 79,
-			249, 240, 166, 220, 30, 124, 184, 71, 254, 41, 31, 62, 43, 119,
-			6, 31, 118, 200, 63, 229, 195, 103, 231, 198, 240, 161, 35, 255,
-			148, 15, 111, 206, 21, 241, 161, 250, 83, 62, 220, 151, 27, 193,
-			135, 76, 254, 41, 31, 142, 230, 246, 226, 195, 103, 201, 63, 223,
-			217, 203, 92, 154, 227, 116, 57, 151, 56, 133, 255, 208, 43, 38,
-			132, 102, 221, 150, 72, 65, 92, 232, 82, 231, 46, 29, 84, 174,
-			136, 25, 63, 68, 107, 97, 246, 104, 37, 38, 103, 214, 150, 226,
-			70, 85, 174, 46, 74, 254, 151, 75, 115, 245, 96, 201, 210, 130,
-			254, 33, 21, 232, 11, 160, 200, 100, 96, 167, 180, 124, 234, 122,
-			217, 236, 45, 174, 234, 4, 144, 165, 160, 18, 86, 203, 65, 93,
-			205, 235, 86, 229, 173, 102, 149, 232, 66, 40, 70, 202, 193, 250,
-			8, 198, 100, 142, 172, 197, 213, 116, 117, 68, 39, 35, 221, 52,
-			52, 124, 243, 209, 90, 152, 164, 193, 154, 58, 48, 82, 49, 156,
-			30, 106, 45, 133, 122, 100, 200, 240, 192, 95, 251, 182, 190, 21,
-			25, 10, 104, 84, 37, 135, 139, 250, 24, 51, 60, 220, 8, 114,
-			79, 26, 139, 41, 20, 23, 103, 224, 234, 241, 154, 8, 108, 66,
-			69, 81, 66, 143, 65, 94, 210, 81, 143, 31, 142, 128, 14, 42,
-			235, 226, 192, 193, 195, 227, 99, 227, 227, 227, 98, 61, 12, 48,
-			134, 94, 220, 100, 166, 174, 236, 44, 150, 56, 124, 92, 156, 140,
-			215, 106, 141, 52, 180, 48, 228, 252, 89, 22, 46, 242, 94, 45,
-			9, 27, 229, 24, 201, 183, 168, 72, 218, 150, 71, 206, 186, 223,
-			37, 138, 197, 226, 157, 173, 191, 129, 115, 153, 253, 197, 100, 164,
-			61, 44, 253, 171, 252, 217, 56, 137, 186, 90, 209, 61, 53, 210,
-			65, 153, 151, 150, 239, 108, 249, 8, 27, 128, 250, 68, 254, 173,
-			63, 64, 73, 103, 18, 45, 139, 209, 13, 25, 61, 87, 140, 139,
-			155, 111, 110, 77, 235, 121, 98, 124, 191, 61, 13, 117, 195, 71,
-			7, 178, 199, 156, 182, 124, 122, 240, 46, 113, 120, 92, 255, 211,
-			135, 156, 138, 176, 2, 254, 213, 102, 0, 158, 183, 41, 128, 231,
-			62, 49, 128, 131, 79, 0, 224, 192, 102, 0, 50, 213, 127, 196,
-			86, 191, 173, 47, 172, 127, 43, 30, 176, 21, 182, 245, 86, 112,
-			217, 186, 190, 124, 27, 81, 167, 133, 103, 170, 252, 174, 230, 42,
-			23, 7, 54, 40, 225, 78, 251, 145, 110, 0, 153, 74, 207, 126,
-			176, 161, 21, 216, 111, 154, 245, 220, 212, 230, 178, 42, 182, 31,
-			108, 170, 93, 91, 189, 246, 197, 231, 101, 95, 188, 76, 30, 7,
-			54, 207, 99, 211, 38, 148, 169, 193, 163, 151, 235, 192, 229, 32,
-			13, 241, 218, 61, 248, 95, 57, 172, 224, 16, 67, 156, 93, 79,
-			87, 165, 55, 5, 255, 82, 80, 250, 198, 23, 71, 203, 193, 122,
-			114, 215, 209, 49, 177, 22, 85, 27, 105, 152, 220, 117, 120, 124,
-			127, 115, 55, 19, 119, 153, 220, 70, 91, 126, 42, 158, 174, 199,
-			107, 243, 38, 169, 180, 188, 31, 109, 207, 11, 230, 102, 103, 196,
-			189, 114, 219, 58, 99, 98, 170, 42, 159, 200, 145, 142, 92, 126,
-			53, 248, 113, 33, 185, 233, 76, 68, 123, 143, 101, 61, 115, 252,
-			98, 80, 101, 106, 84, 63, 150, 89, 157, 87, 47, 226, 69, 97,
-			58, 192, 167, 177, 188, 28, 61, 44, 70, 146, 17, 49, 26, 85,
-			203, 145, 217, 175, 136, 170, 199, 59, 179, 153, 186, 133, 101, 9,
-			143, 152, 82, 91, 8, 116, 32, 129, 161, 24, 189, 88, 100, 88,
-			38, 17, 225, 195, 64, 88, 137, 58, 186, 81, 243, 82, 80, 209,
-			159, 20, 155, 67, 3, 142, 26, 182, 194, 148, 198, 155, 210, 50,
-			195, 121, 102, 202, 30, 53, 41, 10, 84, 49, 114, 52, 25, 209,
-			135, 61, 29, 109, 34, 197, 195, 153, 196, 204, 153, 44, 139, 97,
-			6, 226, 102, 169, 21, 117, 235, 58, 12, 233, 66, 58, 45, 169,
-			50, 177, 22, 45, 213, 155, 211, 189, 210, 100, 15, 39, 35, 69,
-			134, 255, 8, 205, 57, 156, 44, 231, 123, 217, 183, 28, 70, 113,
-			195, 17, 121, 208, 29, 40, 252, 23, 71, 204, 201, 240, 23, 157,
-			169, 62, 14, 62, 227, 22, 20, 197, 189, 234, 156, 35, 108, 219,
-			7, 143, 30, 190, 117, 236, 214, 231, 220, 6, 4, 55, 134, 23,
-			202, 167, 177, 56, 208, 242, 80, 174, 225, 36, 209, 197, 176, 168,
-			54, 202, 201, 133, 201, 197, 184, 129, 69, 83, 119, 34, 52, 244,
-			133, 142, 199, 153, 184, 109, 28, 64, 28, 90, 139, 170, 226, 22,
-			16, 214, 162, 234, 161, 213, 186, 184, 69, 28, 57, 38, 86, 235,
-			135, 202, 193, 186, 184, 69, 28, 189, 237, 214, 226, 145, 91, 5,
-			244, 145, 67, 64, 174, 226, 22, 217, 67, 37, 211, 50, 214, 201,
-			60, 138, 19, 120, 228, 65, 183, 77, 75, 14, 39, 15, 230, 123,
-			180, 68, 56, 121, 144, 247, 179, 159, 37, 168, 8, 135, 147, 186,
-			203, 11, 255, 232, 106, 69, 52, 57, 55, 129, 210, 75, 179, 119,
-			147, 113, 110, 178, 250, 98, 86, 97, 186, 55, 201, 171, 34, 100,
-			135, 137, 171, 161, 73, 173, 222, 228, 107, 233, 3, 249, 199, 153,
-			88, 80, 245, 176, 144, 137, 127, 10, 68, 45, 78, 228, 81, 61,
-			184, 163, 109, 69, 30, 148, 189, 128, 128, 22, 244, 65, 157, 208,
-			208, 181, 25, 72, 16, 74, 38, 195, 184, 46, 228, 105, 105, 242,
-			194, 218, 151, 134, 245, 88, 237, 19, 208, 27, 40, 155, 82, 211,
-			7, 91, 177, 166, 219, 1, 228, 141, 183, 114, 126, 173, 5, 103,
-			107, 19, 185, 227, 142, 59, 198, 212, 127, 178, 121, 100, 30, 100,
-			154, 134, 174, 47, 199, 131, 90, 208, 245, 229, 64, 157, 228, 187,
-			180, 68, 56, 169, 247, 246, 45, 250, 242, 212, 85, 246, 75, 95,
-			118, 217, 54, 117, 126, 83, 120, 72, 255, 161, 38, 181, 242, 90,
-			46, 136, 13, 211, 95, 38, 98, 69, 190, 91, 120, 146, 9, 178,
-			194, 158, 214, 223, 83, 227, 247, 225, 163, 145, 47, 230, 25, 195,
-			96, 152, 82, 163, 18, 38, 124, 156, 181, 169, 245, 131, 225, 62,
-			225, 140, 118, 28, 25, 42, 26, 116, 234, 182, 12, 124, 177, 164,
-			95, 227, 99, 204, 195, 83, 69, 113, 142, 173, 227, 200, 128, 125,
-			255, 52, 60, 198, 183, 207, 228, 74, 242, 37, 126, 136, 249, 114,
-			45, 15, 167, 222, 58, 142, 12, 218, 215, 79, 225, 115, 253, 190,
-			122, 13, 146, 199, 133, 166, 97, 210, 154, 252, 20, 60, 54, 201,
-			227, 75, 234, 237, 219, 142, 13, 211, 77, 222, 190, 237, 88, 246,
-			237, 219, 142, 1, 152, 134, 76, 220, 107, 5, 115, 174, 41, 117,
-			245, 154, 254, 224, 182, 99, 195, 254, 102, 31, 216, 12, 212, 107,
-			240, 65, 34, 115, 104, 107, 253, 96, 174, 57, 135, 196, 228, 32,
-			79, 150, 29, 206, 111, 246, 65, 38, 7, 249, 26, 63, 194, 218,
-			112, 40, 116, 244, 200, 112, 123, 107, 133, 157, 150, 63, 232, 79,
-			244, 139, 230, 155, 219, 142, 13, 179, 77, 191, 177, 217, 232, 23,
-			249, 173, 44, 159, 232, 140, 58, 240, 163, 109, 25, 104, 45, 57,
-			153, 87, 237, 103, 183, 29, 27, 238, 220, 252, 51, 155, 153, 121,
-			149, 239, 103, 116, 49, 142, 43, 195, 93, 248, 73, 191, 253, 228,
-			68, 28, 87, 244, 235, 248, 10, 106, 12, 249, 122, 184, 123, 131,
-			198, 240, 185, 213, 24, 138, 208, 70, 112, 30, 125, 184, 167, 181,
-			141, 156, 128, 199, 166, 141, 224, 75, 128, 36, 172, 54, 214, 134,
-			123, 91, 145, 76, 86, 27, 107, 6, 9, 188, 2, 101, 213, 129,
-			14, 195, 188, 181, 172, 37, 245, 139, 41, 171, 126, 149, 223, 204,
-			200, 90, 80, 27, 238, 199, 47, 120, 166, 187, 5, 53, 253, 50,
-			188, 0, 239, 5, 213, 245, 225, 129, 214, 247, 38, 170, 235, 230,
-			189, 160, 186, 14, 48, 180, 17, 24, 30, 108, 133, 161, 109, 186,
-			129, 161, 95, 229, 183, 179, 118, 99, 27, 134, 135, 240, 187, 97,
-			251, 157, 241, 179, 245, 135, 246, 229, 19, 62, 163, 224, 111, 141,
-			124, 200, 97, 204, 246, 121, 62, 192, 60, 60, 69, 18, 13, 131,
-			91, 146, 2, 239, 102, 110, 37, 197, 206, 239, 150, 220, 74, 202,
-			123, 25, 169, 164, 33, 246, 110, 183, 4, 127, 194, 27, 43, 41,
-			118, 96, 183, 228, 174, 224, 27, 43, 105, 136, 93, 212, 45, 193,
-			159, 240, 70, 84, 29, 246, 5, 129, 55, 162, 42, 31, 100, 126,
-			53, 78, 207, 71, 213, 225, 54, 124, 230, 85, 227, 116, 170, 202,
-			247, 178, 78, 185, 20, 125, 30, 227, 85, 176, 71, 229, 75, 29,
-			242, 217, 36, 60, 26, 249, 79, 14, 235, 200, 216, 157, 102, 204,
-			206, 70, 204, 78, 43, 102, 167, 21, 179, 211, 138, 217, 105, 197,
-			236, 108, 130, 217, 217, 2, 102, 80, 179, 181, 29, 205, 144, 189,
-			141, 144, 189, 86, 200, 94, 43, 100, 175, 21, 178, 215, 10, 217,
-			219, 4, 178, 183, 117, 200, 170, 167, 55, 67, 38, 27, 33, 147,
-			86, 200, 164, 21, 50, 105, 133, 76, 90, 33, 147, 77, 32, 147,
-			45, 182, 140, 115, 151, 83, 115, 215, 70, 204, 93, 173, 152, 187,
-			90, 49, 119, 181, 98, 238, 106, 197, 220, 181, 9, 230, 174, 171,
-			192, 188, 169, 158, 233, 70, 204, 180, 21, 51, 109, 197, 76, 91,
-			49, 211, 86, 204, 116, 19, 204, 116, 139, 152, 231, 46, 167, 231,
-			190, 141, 152, 251, 90, 49, 247, 181, 98, 238, 107, 197, 220, 215,
-			138, 185, 111, 19, 204, 125, 87, 129, 121, 83, 61, 243, 141, 152,
-			121, 43, 102, 222, 138, 153, 183, 98, 230, 173, 152, 249, 38, 152,
-			249, 22, 48, 127, 216, 97, 157, 89, 150, 110, 6, 221, 182, 17,
-			116, 91, 43, 232, 182, 86, 208, 109, 173, 160, 219, 90, 65, 183,
-			109, 2, 186, 237, 106, 64, 111, 170, 105, 127, 35, 104, 191, 21,
-			180, 223, 10, 218, 111, 5, 237, 183, 130, 246, 55, 1, 237, 111,
-			1, 244, 71, 28, 214, 53, 119, 121, 85, 247, 108, 68, 221, 211,
-			138, 186, 167, 21, 117, 79, 43, 234, 158, 86, 212, 61, 155, 160,
-			238, 185, 42, 212, 155, 234, 186, 119, 35, 234, 222, 86, 212, 189,
-			173, 168, 123, 91, 81, 247, 182, 162, 238, 221, 4, 117, 239, 22,
-			80, 239, 101, 237, 198, 35, 108, 6, 156, 87, 128, 71, 190, 230,
-			177, 142, 140, 35, 216, 252, 86, 187, 46, 22, 20, 35, 172, 162,
-			19, 6, 70, 48, 172, 242, 109, 172, 109, 45, 170, 158, 135, 167,
-			210, 86, 250, 107, 81, 117, 90, 253, 16, 60, 140, 63, 16, 245,
-			67, 240, 48, 252, 176, 131, 181, 87, 194, 234, 121, 233, 92, 14,
-			224, 79, 249, 74, 88, 69, 175, 18, 126, 132, 228, 228, 143, 210,
-			180, 230, 215, 162, 204, 143, 193, 195, 234, 71, 79, 253, 24, 60,
-			44, 127, 28, 102, 109, 181, 32, 77, 195, 122, 21, 71, 29, 237,
-			37, 45, 242, 33, 230, 203, 91, 128, 113, 116, 209, 94, 82, 18,
-			60, 151, 243, 85, 168, 178, 246, 146, 146, 120, 129, 229, 85, 96,
-			87, 130, 131, 133, 246, 146, 145, 65, 217, 80, 7, 230, 247, 109,
-			248, 123, 71, 53, 78, 79, 234, 87, 100, 181, 49, 65, 70, 219,
-			91, 170, 173, 3, 159, 169, 106, 27, 98, 94, 184, 22, 68, 21,
-			244, 247, 243, 224, 73, 163, 200, 119, 178, 252, 106, 156, 164, 213,
-			96, 45, 68, 191, 30, 126, 50, 79, 120, 47, 115, 163, 26, 186,
-			240, 240, 220, 141, 106, 124, 128, 209, 168, 118, 241, 24, 186, 233,
-			240, 12, 37, 245, 244, 54, 244, 199, 245, 211, 219, 56, 103, 164,
-			81, 143, 112, 200, 10, 15, 65, 224, 219, 89, 91, 163, 30, 157,
-			175, 135, 203, 232, 141, 231, 113, 88, 86, 143, 74, 225, 50, 47,
-			176, 182, 160, 92, 174, 135, 73, 130, 30, 50, 252, 164, 31, 64,
-			6, 141, 70, 84, 70, 23, 24, 51, 0, 137, 223, 205, 122, 47,
-			133, 149, 202, 121, 12, 236, 56, 95, 15, 87, 194, 135, 135, 135,
-			133, 51, 218, 157, 29, 63, 96, 20, 71, 9, 126, 59, 147, 43,
-			117, 95, 210, 113, 29, 248, 132, 239, 148, 227, 148, 165, 116, 120,
-			59, 164, 124, 156, 166, 245, 70, 88, 82, 207, 54, 180, 245, 194,
-			134, 182, 126, 162, 147, 49, 11, 97, 228, 255, 114, 25, 179, 227,
-			149, 230, 86, 221, 217, 210, 170, 187, 158, 74, 171, 206, 52, 63,
-			122, 185, 230, 231, 97, 166, 27, 155, 159, 47, 159, 111, 210, 252,
-			218, 240, 23, 219, 252, 100, 219, 202, 11, 50, 218, 217, 210, 182,
-			218, 241, 153, 106, 91, 178, 149, 176, 77, 90, 73, 199, 166, 173,
-			164, 179, 169, 149, 180, 42, 185, 251, 201, 148, 124, 129, 181, 155,
-			97, 222, 101, 28, 237, 189, 172, 83, 237, 48, 59, 31, 87, 43,
-			235, 168, 213, 124, 169, 67, 61, 155, 173, 86, 214, 85, 217, 200,
-			38, 126, 52, 205, 248, 209, 35, 207, 99, 157, 217, 25, 22, 206,
-			25, 77, 46, 68, 53, 101, 205, 240, 111, 80, 161, 62, 13, 93,
-			229, 100, 100, 112, 178, 187, 154, 70, 153, 218, 230, 68, 105, 184,
-			150, 40, 31, 16, 108, 206, 20, 200, 218, 230, 200, 31, 93, 99,
-			115, 228, 143, 67, 204, 111, 84, 163, 135, 26, 210, 176, 231, 75,
-			74, 226, 183, 48, 79, 126, 176, 97, 126, 197, 206, 33, 149, 228,
-			43, 27, 180, 237, 109, 52, 223, 223, 114, 88, 94, 15, 115, 53,
-			218, 90, 16, 213, 179, 104, 207, 130, 172, 209, 202, 31, 45, 90,
-			243, 99, 53, 62, 159, 224, 233, 63, 10, 112, 190, 26, 207, 161,
-			204, 71, 25, 189, 16, 174, 63, 49, 98, 124, 131, 143, 49, 95,
-			46, 170, 170, 233, 160, 205, 223, 85, 239, 108, 40, 158, 191, 177,
-			120, 247, 178, 188, 30, 156, 55, 213, 156, 211, 92, 115, 170, 129,
-			184, 155, 24, 86, 146, 49, 172, 35, 223, 115, 89, 87, 211, 248,
-			253, 9, 19, 61, 164, 155, 171, 156, 104, 219, 222, 26, 214, 102,
-			167, 2, 84, 75, 222, 143, 204, 78, 158, 236, 109, 32, 253, 3,
-			146, 244, 233, 147, 189, 139, 254, 192, 126, 244, 7, 188, 39, 77,
-			119, 5, 211, 5, 87, 193, 127, 210, 116, 87, 100, 186, 202, 99,
-			120, 226, 116, 163, 42, 31, 55, 10, 205, 63, 217, 235, 74, 215,
-			255, 224, 178, 238, 230, 57, 143, 39, 84, 246, 120, 179, 178, 11,
-			27, 210, 183, 105, 41, 109, 223, 146, 209, 246, 19, 189, 14, 234,
-			30, 203, 170, 251, 137, 94, 70, 125, 223, 146, 209, 247, 19, 166,
-			188, 130, 41, 91, 133, 63, 97, 202, 160, 241, 65, 230, 87, 210,
-			243, 213, 248, 18, 154, 239, 124, 201, 171, 164, 51, 241, 37, 120,
-			188, 34, 31, 75, 15, 205, 91, 193, 199, 135, 153, 47, 247, 132,
-			171, 137, 201, 39, 80, 186, 122, 241, 150, 211, 140, 101, 24, 179,
-			131, 181, 157, 155, 121, 225, 204, 236, 253, 51, 189, 57, 62, 192,
-			122, 207, 204, 207, 159, 61, 127, 102, 114, 226, 212, 100, 233, 252,
-			204, 196, 189, 147, 189, 14, 31, 100, 125, 217, 167, 247, 77, 76,
-			159, 155, 236, 117, 143, 63, 151, 229, 203, 242, 206, 225, 50, 223,
-			179, 33, 91, 101, 101, 213, 57, 87, 195, 31, 148, 168, 205, 23,
-			199, 143, 179, 54, 181, 133, 225, 201, 63, 254, 144, 252, 88, 127,
-			112, 252, 184, 109, 36, 124, 215, 134, 143, 103, 171, 97, 188, 220,
-			154, 175, 126, 255, 248, 11, 152, 87, 199, 150, 182, 241, 67, 180,
-			63, 77, 31, 94, 214, 248, 98, 18, 39, 206, 178, 66, 20, 23,
-			195, 234, 197, 120, 189, 86, 143, 31, 94, 47, 214, 86, 46, 154,
-			247, 95, 124, 100, 37, 74, 87, 27, 139, 24, 209, 105, 223, 81,
-			193, 157, 7, 87, 194, 234, 193, 13, 107, 19, 47, 248, 155, 95,
-			117, 89, 27, 247, 114, 185, 255, 238, 59, 44, 143, 129, 169, 185,
-			28, 87, 65, 69, 78, 174, 79, 133, 245, 144, 220, 148, 14, 21,
-			130, 63, 229, 67, 154, 59, 170, 67, 133, 212, 159, 36, 199, 137,
-			159, 187, 5, 255, 116, 56, 105, 203, 141, 226, 159, 46, 39, 249,
-			220, 126, 118, 156, 57, 109, 156, 118, 228, 250, 156, 194, 33, 113,
-			159, 189, 230, 2, 203, 166, 46, 126, 82, 59, 186, 237, 101, 31,
-			149, 240, 98, 88, 97, 236, 173, 14, 115, 219, 114, 156, 116, 211,
-			145, 194, 235, 204, 221, 211, 101, 81, 109, 84, 42, 234, 72, 156,
-			234, 122, 246, 238, 12, 153, 168, 92, 66, 178, 71, 215, 141, 169,
-			13, 92, 184, 149, 166, 186, 206, 154, 239, 78, 221, 184, 181, 34,
-			74, 101, 240, 78, 57, 22, 73, 163, 86, 139, 235, 105, 38, 15,
-			92, 192, 36, 109, 57, 151, 147, 142, 182, 103, 203, 191, 41, 64,
-			236, 148, 127, 123, 156, 116, 119, 245, 201, 191, 29, 78, 186, 121,
-			65, 254, 77, 56, 233, 222, 181, 151, 77, 51, 183, 205, 225, 164,
-			151, 238, 45, 76, 136, 41, 185, 185, 31, 220, 129, 164, 229, 50,
-			237, 76, 161, 244, 65, 142, 173, 197, 82, 72, 156, 12, 18, 135,
-			66, 210, 18, 137, 227, 113, 210, 171, 144, 56, 144, 37, 223, 46,
-			255, 38, 156, 244, 238, 20, 236, 118, 172, 152, 129, 220, 176, 83,
-			56, 248, 164, 21, 19, 67, 107, 215, 213, 242, 58, 168, 22, 151,
-			147, 109, 116, 164, 240, 51, 162, 164, 175, 116, 9, 171, 9, 222,
-			143, 132, 186, 11, 51, 7, 114, 217, 227, 119, 50, 39, 13, 6,
-			42, 77, 60, 245, 56, 189, 147, 101, 75, 188, 28, 68, 21, 188,
-			115, 165, 26, 235, 58, 210, 123, 48, 240, 155, 160, 174, 143, 244,
-			130, 2, 185, 46, 39, 3, 109, 55, 201, 191, 41, 192, 146, 10,
-			112, 61, 78, 182, 41, 5, 184, 14, 39, 219, 84, 85, 184, 132,
-			147, 109, 187, 246, 42, 5, 236, 200, 237, 185, 18, 5, 72, 252,
-			74, 1, 175, 2, 5, 16, 78, 118, 211, 103, 23, 46, 9, 236,
-			172, 42, 104, 117, 93, 95, 175, 165, 18, 211, 119, 211, 169, 235,
-			76, 240, 154, 194, 204, 173, 34, 69, 113, 98, 93, 159, 200, 57,
-			198, 160, 184, 25, 45, 200, 219, 13, 213, 103, 120, 152, 73, 146,
-			234, 77, 231, 170, 232, 208, 201, 118, 168, 162, 19, 10, 128, 100,
-			209, 137, 207, 201, 238, 174, 109, 242, 111, 135, 147, 221, 195, 187,
-			228, 223, 0, 90, 60, 139, 189, 211, 145, 17, 122, 55, 229, 38,
-			156, 194, 127, 112, 132, 53, 58, 34, 204, 158, 178, 133, 91, 155,
-			76, 159, 194, 77, 147, 242, 38, 199, 101, 141, 191, 245, 220, 92,
-			181, 45, 116, 76, 237, 7, 197, 91, 99, 68, 243, 230, 4, 27,
-			38, 12, 237, 69, 212, 234, 113, 45, 172, 103, 117, 86, 180, 17,
-			2, 55, 229, 57, 235, 208, 1, 2, 207, 162, 251, 244, 154, 57,
-			5, 169, 83, 75, 62, 39, 207, 234, 218, 158, 89, 93, 127, 86,
-			97, 111, 102, 117, 253, 89, 207, 146, 231, 183, 229, 242, 57, 78,
-			159, 77, 239, 246, 228, 111, 121, 120, 243, 217, 172, 155, 237, 213,
-			11, 239, 251, 242, 55, 21, 6, 196, 220, 82, 80, 9, 234, 234,
-			28, 93, 12, 228, 55, 203, 194, 62, 188, 195, 51, 203, 194, 251,
-			134, 118, 100, 150, 133, 247, 237, 29, 81, 104, 93, 78, 70, 243,
-			55, 169, 159, 92, 31, 164, 126, 45, 57, 156, 140, 14, 237, 212,
-			18, 225, 100, 212, 124, 70, 56, 217, 111, 62, 131, 74, 220, 111,
-			114, 131, 106, 220, 111, 114, 131, 138, 220, 111, 62, 163, 156, 220,
-			98, 62, 163, 62, 72, 250, 51, 234, 112, 114, 139, 249, 140, 18,
-			78, 110, 49, 159, 121, 156, 28, 48, 159, 121, 62, 72, 26, 164,
-			231, 112, 114, 192, 128, 244, 8, 39, 7, 204, 103, 62, 39, 99,
-			230, 51, 31, 37, 253, 153, 239, 112, 50, 102, 62, 243, 9, 39,
-			99, 230, 179, 54, 78, 14, 154, 207, 218, 124, 144, 244, 103, 96,
-			17, 15, 154, 207, 160, 103, 29, 52, 159, 229, 57, 41, 154, 207,
-			242, 62, 72, 250, 51, 224, 160, 162, 249, 44, 79, 56, 41, 154,
-			207, 218, 57, 57, 100, 62, 107, 247, 65, 26, 208, 146, 195, 201,
-			161, 161, 93, 90, 34, 156, 28, 50, 159, 49, 78, 198, 243, 207,
-			82, 63, 49, 31, 36, 253, 25, 115, 56, 25, 55, 159, 49, 194,
-			201, 248, 222, 155, 212, 103, 29, 156, 28, 54, 159, 117, 248, 32,
-			13, 106, 201, 225, 228, 240, 208, 110, 45, 17, 78, 14, 155, 207,
-			58, 57, 57, 98, 62, 235, 244, 65, 210, 159, 117, 58, 156, 28,
-			49, 159, 117, 18, 78, 142, 152, 207, 186, 56, 57, 106, 62, 235,
-			242, 65, 234, 211, 146, 195, 201, 209, 161, 130, 150, 8, 39, 71,
-			205, 103, 221, 156, 28, 51, 159, 117, 251, 32, 105, 77, 118, 59,
-			156, 28, 51, 154, 236, 38, 156, 28, 51, 159, 245, 112, 114, 171,
-			249, 172, 199, 7, 73, 55, 174, 30, 135, 147, 91, 77, 227, 234,
-			33, 156, 220, 186, 247, 38, 54, 130, 159, 245, 114, 114, 123, 254,
-			89, 133, 65, 12, 72, 171, 132, 15, 111, 214, 159, 122, 125, 120,
-			73, 99, 239, 117, 56, 185, 221, 96, 239, 37, 156, 220, 110, 64,
-			244, 113, 114, 135, 1, 209, 231, 131, 164, 53, 213, 231, 112, 114,
-			135, 209, 84, 31, 225, 228, 14, 243, 25, 231, 228, 184, 249, 140,
-			251, 32, 245, 106, 201, 225, 228, 248, 144, 54, 26, 156, 112, 114,
-			124, 239, 77, 236, 217, 248, 89, 63, 39, 119, 229, 111, 46, 12,
-			139, 251, 195, 74, 229, 32, 186, 177, 155, 193, 239, 247, 225, 61,
-			157, 96, 191, 195, 201, 93, 219, 52, 252, 126, 194, 201, 93, 55,
-			61, 91, 225, 24, 224, 228, 121, 249, 155, 213, 79, 3, 62, 72,
-			26, 254, 128, 195, 201, 243, 182, 237, 209, 18, 225, 228, 121, 230,
-			179, 65, 78, 158, 111, 62, 27, 244, 65, 26, 210, 146, 195, 201,
-			243, 183, 9, 45, 17, 78, 158, 127, 211, 179, 193, 187, 160, 14,
-			167, 167, 114, 161, 83, 184, 91, 216, 5, 92, 117, 14, 173, 222,
-			173, 138, 195, 150, 122, 16, 169, 243, 16, 144, 231, 210, 88, 44,
-			96, 92, 199, 130, 10, 71, 150, 70, 24, 140, 220, 169, 60, 103,
-			37, 70, 241, 2, 83, 114, 154, 238, 42, 76, 138, 147, 120, 167,
-			160, 218, 168, 17, 154, 219, 32, 205, 109, 89, 250, 34, 58, 123,
-			208, 135, 185, 101, 59, 84, 87, 228, 75, 45, 58, 104, 203, 79,
-			43, 91, 238, 96, 164, 212, 233, 46, 174, 37, 135, 147, 211, 253,
-			195, 90, 34, 156, 156, 222, 177, 147, 253, 52, 98, 113, 56, 121,
-			1, 45, 20, 206, 138, 233, 43, 1, 98, 195, 158, 54, 129, 50,
-			198, 236, 13, 136, 26, 22, 184, 82, 47, 48, 176, 192, 153, 122,
-			129, 129, 5, 74, 121, 65, 255, 160, 150, 8, 39, 47, 24, 222,
-			206, 30, 68, 88, 46, 39, 51, 116, 71, 225, 167, 197, 116, 26,
-			110, 9, 23, 16, 236, 67, 141, 64, 31, 225, 194, 54, 128, 180,
-			113, 74, 26, 35, 24, 254, 25, 131, 17, 236, 249, 140, 193, 8,
-			250, 153, 233, 31, 210, 18, 225, 100, 102, 123, 129, 125, 196, 65,
-			144, 132, 147, 57, 90, 40, 252, 166, 35, 238, 185, 18, 229, 173,
-			224, 217, 22, 245, 43, 210, 95, 81, 158, 21, 146, 57, 246, 245,
-			30, 12, 147, 199, 203, 140, 117, 168, 102, 38, 129, 233, 20, 74,
-			62, 157, 234, 107, 7, 235, 58, 130, 189, 30, 94, 12, 213, 69,
-			127, 178, 12, 224, 224, 204, 153, 210, 18, 143, 147, 57, 83, 90,
-			96, 199, 57, 83, 35, 192, 142, 115, 195, 219, 217, 103, 101, 105,
-			41, 39, 47, 162, 59, 10, 31, 129, 210, 94, 73, 157, 52, 21,
-			119, 11, 213, 178, 89, 209, 195, 13, 101, 103, 151, 45, 252, 19,
-			148, 157, 98, 25, 116, 217, 169, 199, 201, 139, 76, 217, 129, 226,
-			95, 100, 106, 26, 40, 254, 69, 219, 11, 236, 1, 44, 186, 199,
-			201, 75, 104, 161, 48, 45, 166, 170, 87, 210, 91, 117, 65, 237,
-			49, 186, 22, 44, 51, 118, 65, 102, 228, 81, 72, 91, 67, 242,
-			32, 39, 3, 9, 220, 135, 151, 152, 234, 0, 247, 225, 37, 195,
-			219, 217, 191, 69, 72, 62, 39, 11, 116, 119, 225, 167, 196, 76,
-			156, 62, 33, 170, 37, 188, 88, 97, 203, 184, 124, 10, 25, 104,
-			92, 190, 199, 201, 130, 193, 5, 254, 201, 66, 255, 118, 45, 17,
-			78, 22, 118, 238, 98, 175, 144, 205, 164, 141, 147, 50, 189, 169,
-			144, 168, 97, 25, 206, 251, 109, 132, 23, 110, 28, 111, 234, 147,
-			210, 37, 236, 108, 216, 46, 32, 11, 204, 229, 228, 234, 150, 82,
-			115, 237, 45, 148, 14, 39, 28, 53, 244, 54, 10, 16, 52, 244,
-			54, 143, 147, 114, 87, 159, 150, 28, 78, 202, 124, 183, 150, 8,
-			39, 229, 189, 35, 108, 150, 185, 212, 229, 222, 106, 238, 231, 29,
-			167, 112, 66, 100, 226, 95, 174, 208, 228, 203, 208, 188, 102, 155,
-			15, 166, 99, 53, 223, 143, 54, 223, 197, 200, 92, 186, 251, 90,
-			218, 124, 23, 109, 254, 131, 170, 160, 174, 140, 142, 237, 234, 215,
-			146, 195, 201, 131, 3, 219, 181, 68, 56, 121, 112, 231, 46, 180,
-			249, 104, 211, 170, 116, 199, 117, 178, 249, 46, 218, 252, 170, 129,
-			5, 54, 191, 106, 96, 129, 205, 175, 14, 12, 105, 137, 112, 82,
-			221, 94, 64, 155, 239, 130, 205, 175, 211, 157, 79, 135, 205, 119,
-			209, 230, 215, 13, 70, 232, 224, 117, 131, 17, 131, 135, 7, 182,
-			105, 137, 112, 82, 47, 236, 144, 54, 31, 165, 75, 116, 199, 13,
-			101, 243, 93, 180, 249, 151, 76, 105, 193, 230, 95, 50, 165, 5,
-			155, 127, 201, 212, 8, 216, 252, 75, 219, 11, 210, 230, 163, 150,
-			94, 70, 119, 222, 192, 54, 223, 69, 155, 255, 50, 83, 118, 176,
-			249, 47, 51, 101, 7, 155, 255, 50, 83, 211, 96, 243, 95, 86,
-			216, 193, 94, 140, 69, 247, 56, 125, 133, 67, 119, 92, 99, 163,
-			223, 133, 57, 121, 20, 19, 239, 212, 34, 230, 213, 213, 175, 69,
-			7, 196, 129, 33, 45, 18, 16, 183, 23, 216, 121, 196, 229, 115,
-			250, 106, 135, 238, 185, 30, 150, 95, 230, 231, 83, 204, 65, 131,
-			243, 61, 16, 13, 56, 223, 1, 113, 160, 160, 69, 2, 226, 174,
-			221, 236, 149, 178, 193, 180, 113, 250, 58, 231, 153, 50, 255, 18,
-			82, 27, 69, 12, 26, 127, 155, 7, 162, 186, 79, 195, 197, 187,
-			68, 95, 231, 168, 179, 219, 93, 188, 75, 244, 117, 206, 222, 17,
-			54, 195, 160, 254, 253, 55, 56, 185, 15, 56, 224, 245, 219, 0,
-			172, 43, 164, 0, 140, 86, 182, 12, 208, 193, 8, 37, 14, 167,
-			111, 112, 242, 156, 205, 49, 74, 137, 155, 227, 244, 141, 206, 181,
-			243, 251, 161, 0, 4, 79, 57, 121, 163, 46, 45, 193, 51, 78,
-			222, 232, 116, 113, 45, 58, 32, 246, 15, 107, 145, 128, 184, 99,
-			39, 250, 16, 196, 117, 56, 125, 179, 115, 93, 156, 127, 153, 157,
-			67, 49, 3, 141, 205, 241, 64, 52, 216, 28, 204, 190, 127, 80,
-			139, 4, 196, 225, 237, 236, 2, 98, 115, 57, 125, 155, 115, 253,
-			71, 0, 50, 111, 151, 98, 110, 26, 40, 244, 253, 183, 89, 160,
-			160, 167, 183, 57, 253, 67, 90, 36, 32, 110, 47, 176, 143, 58,
-			136, 148, 112, 250, 136, 115, 3, 13, 3, 100, 41, 8, 69, 216,
-			186, 200, 196, 3, 209, 20, 25, 90, 238, 35, 182, 110, 8, 150,
-			113, 120, 59, 251, 35, 89, 100, 202, 233, 187, 157, 27, 118, 44,
-			32, 203, 68, 101, 33, 180, 2, 168, 7, 162, 81, 0, 140, 255,
-			223, 109, 235, 156, 18, 16, 183, 23, 144, 27, 8, 180, 143, 247,
-			56, 215, 124, 64, 32, 179, 2, 110, 120, 143, 197, 229, 97, 94,
-			6, 23, 112, 195, 123, 108, 197, 0, 55, 188, 7, 42, 230, 60,
-			226, 242, 57, 125, 159, 115, 125, 70, 5, 50, 63, 224, 134, 247,
-			89, 112, 192, 13, 239, 179, 224, 128, 27, 222, 231, 244, 111, 215,
-			34, 1, 113, 231, 46, 201, 13, 4, 184, 225, 253, 207, 40, 55,
-			16, 228, 134, 247, 91, 252, 192, 13, 239, 215, 220, 64, 144, 27,
-			222, 175, 185, 129, 32, 55, 188, 95, 115, 3, 229, 254, 135, 156,
-			220, 127, 213, 220, 160, 66, 2, 175, 156, 27, 110, 59, 214, 204,
-			13, 208, 192, 62, 164, 185, 129, 2, 55, 124, 248, 26, 115, 3,
-			69, 110, 248, 176, 46, 45, 69, 110, 248, 176, 174, 45, 138, 220,
-			240, 97, 205, 13, 20, 185, 225, 195, 154, 27, 40, 222, 144, 120,
-			253, 184, 129, 202, 11, 19, 45, 54, 224, 134, 223, 183, 216, 240,
-			194, 68, 221, 204, 169, 188, 48, 81, 115, 3, 5, 110, 248, 196,
-			211, 196, 13, 232, 3, 67, 110, 26, 40, 244, 253, 79, 88, 160,
-			160, 167, 79, 104, 59, 129, 195, 3, 250, 9, 195, 13, 20, 196,
-			79, 223, 104, 220, 64, 145, 27, 62, 109, 139, 12, 220, 240, 105,
-			91, 100, 224, 134, 79, 219, 186, 33, 88, 70, 205, 13, 20, 212,
-			245, 249, 27, 155, 27, 40, 114, 195, 231, 173, 2, 128, 27, 62,
-			111, 21, 0, 93, 247, 243, 182, 206, 129, 27, 62, 175, 185, 129,
-			66, 251, 248, 226, 245, 225, 6, 138, 220, 240, 69, 139, 203, 195,
-			188, 12, 46, 224, 134, 47, 218, 138, 1, 110, 248, 162, 230, 6,
-			10, 220, 240, 232, 117, 228, 6, 138, 220, 240, 168, 5, 7, 220,
-			240, 168, 5, 7, 220, 240, 168, 230, 6, 138, 220, 240, 168, 225,
-			6, 10, 220, 240, 149, 103, 148, 27, 40, 114, 195, 87, 44, 126,
-			224, 134, 175, 104, 110, 160, 200, 13, 95, 209, 220, 64, 145, 27,
-			190, 2, 220, 112, 150, 65, 243, 240, 191, 230, 228, 254, 17, 231,
-			142, 206, 109, 121, 224, 208, 216, 100, 228, 0, 85, 249, 53, 39,
-			223, 143, 236, 224, 1, 59, 124, 221, 185, 118, 179, 71, 80, 4,
-			15, 217, 225, 235, 186, 188, 30, 178, 195, 215, 245, 56, 207, 67,
-			118, 248, 186, 51, 176, 93, 139, 4, 196, 157, 187, 144, 29, 60,
-			176, 122, 223, 116, 174, 203, 20, 146, 204, 14, 216, 225, 155, 22,
-			27, 176, 195, 55, 45, 54, 7, 179, 87, 3, 100, 15, 217, 225,
-			155, 208, 1, 47, 32, 54, 151, 211, 191, 116, 174, 255, 60, 146,
-			204, 27, 204, 221, 95, 90, 160, 208, 251, 255, 210, 2, 5, 61,
-			253, 165, 51, 176, 77, 139, 4, 196, 194, 14, 201, 14, 30, 136,
-			127, 237, 220, 64, 147, 73, 178, 20, 192, 14, 127, 109, 139, 12,
-			236, 240, 215, 182, 200, 192, 14, 127, 109, 235, 134, 96, 25, 183,
-			23, 36, 59, 120, 160, 174, 239, 58, 55, 236, 140, 146, 44, 19,
-			149, 133, 208, 10, 0, 118, 248, 174, 85, 0, 176, 195, 119, 109,
-			157, 3, 59, 124, 215, 81, 179, 74, 30, 180, 143, 239, 95, 159,
-			89, 37, 15, 217, 225, 251, 22, 151, 135, 121, 25, 92, 96, 82,
-			190, 111, 43, 6, 216, 225, 251, 122, 86, 201, 3, 118, 120, 236,
-			58, 206, 42, 121, 200, 14, 143, 89, 112, 192, 14, 143, 89, 112,
-			192, 14, 143, 233, 89, 37, 15, 217, 225, 49, 51, 171, 228, 1,
-			59, 252, 224, 25, 101, 7, 15, 217, 225, 7, 22, 63, 176, 195,
-			15, 52, 59, 120, 200, 14, 63, 208, 236, 224, 33, 59, 252, 64,
-			179, 131, 207, 253, 31, 58, 185, 95, 114, 53, 59, 108, 109, 232,
-			208, 216, 100, 236, 0, 218, 250, 161, 102, 7, 31, 216, 225, 241,
-			107, 204, 14, 62, 178, 195, 227, 186, 188, 62, 178, 195, 227, 186,
-			190, 124, 100, 135, 199, 53, 59, 248, 200, 14, 143, 107, 118, 240,
-			193, 234, 253, 228, 250, 177, 131, 143, 236, 240, 19, 139, 13, 216,
-			225, 39, 22, 155, 131, 217, 171, 134, 238, 35, 59, 252, 68, 179,
-			131, 15, 236, 240, 42, 247, 233, 97, 7, 31, 217, 225, 85, 174,
-			1, 10, 189, 255, 85, 174, 1, 10, 122, 122, 149, 171, 44, 133,
-			143, 236, 240, 42, 87, 179, 131, 15, 226, 107, 221, 27, 140, 29,
-			124, 100, 135, 215, 218, 34, 3, 59, 188, 214, 22, 25, 216, 225,
-			181, 174, 169, 27, 130, 101, 212, 236, 224, 131, 186, 222, 224, 222,
-			208, 236, 224, 35, 59, 188, 193, 42, 0, 216, 225, 13, 86, 1,
-			192, 14, 111, 176, 117, 14, 236, 240, 6, 87, 177, 131, 15, 237,
-			227, 23, 221, 235, 194, 14, 62, 178, 195, 47, 90, 92, 30, 230,
-			101, 112, 1, 59, 252, 162, 173, 24, 96, 135, 95, 116, 21, 59,
-			248, 192, 14, 111, 117, 175, 31, 59, 248, 200, 14, 111, 181, 224,
-			128, 29, 222, 106, 193, 129, 189, 123, 171, 171, 216, 193, 71, 118,
-			120, 171, 171, 217, 193, 7, 118, 120, 135, 251, 76, 178, 131, 143,
-			236, 240, 14, 139, 31, 216, 225, 29, 174, 98, 7, 31, 217, 225,
-			29, 174, 98, 7, 31, 217, 225, 29, 174, 98, 135, 54, 238, 255,
-			138, 155, 251, 20, 178, 195, 220, 150, 199, 14, 201, 38, 99, 7,
-			200, 237, 87, 92, 197, 14, 109, 192, 14, 239, 116, 175, 45, 59,
-			180, 33, 59, 188, 83, 151, 183, 13, 217, 225, 157, 186, 190, 218,
-			144, 29, 222, 233, 42, 118, 104, 67, 118, 120, 167, 171, 216, 161,
-			13, 172, 222, 175, 187, 215, 141, 29, 218, 144, 29, 126, 221, 98,
-			3, 118, 248, 117, 139, 205, 193, 236, 85, 67, 111, 67, 118, 248,
-			117, 87, 177, 67, 27, 176, 195, 123, 159, 38, 118, 104, 67, 118,
-			120, 175, 5, 10, 189, 255, 189, 22, 40, 232, 233, 189, 218, 82,
-			180, 33, 59, 188, 215, 176, 67, 27, 136, 191, 115, 163, 177, 67,
-			27, 178, 195, 239, 216, 34, 3, 59, 252, 142, 45, 50, 176, 195,
-			239, 216, 186, 33, 88, 70, 205, 14, 109, 160, 174, 15, 221, 216,
-			236, 208, 134, 236, 240, 33, 171, 0, 96, 135, 15, 89, 5, 224,
-			164, 176, 173, 115, 96, 135, 15, 105, 118, 104, 131, 246, 241, 209,
-			235, 195, 14, 109, 200, 14, 31, 181, 184, 60, 204, 203, 224, 2,
-			118, 248, 168, 173, 24, 96, 135, 143, 106, 118, 104, 3, 118, 248,
-			216, 117, 100, 135, 54, 100, 135, 143, 89, 112, 192, 14, 31, 179,
-			224, 128, 29, 62, 166, 217, 161, 13, 217, 225, 99, 134, 29, 218,
-			128, 29, 62, 249, 140, 178, 67, 27, 178, 195, 39, 45, 126, 96,
-			135, 79, 106, 118, 104, 67, 118, 248, 164, 102, 135, 54, 100, 135,
-			79, 106, 118, 200, 115, 255, 51, 110, 238, 255, 52, 236, 176, 181,
-			177, 67, 178, 201, 216, 33, 239, 112, 250, 25, 205, 14, 121, 188,
-			75, 242, 26, 179, 67, 94, 222, 138, 167, 203, 155, 151, 119, 226,
-			233, 250, 202, 203, 27, 241, 52, 59, 228, 229, 125, 120, 154, 29,
-			242, 96, 245, 190, 112, 253, 216, 33, 143, 236, 240, 5, 139, 13,
-			216, 225, 11, 22, 155, 131, 217, 171, 134, 158, 71, 118, 248, 130,
-			102, 135, 60, 176, 195, 151, 158, 38, 118, 200, 35, 59, 124, 201,
-			2, 133, 222, 255, 37, 11, 20, 244, 244, 37, 109, 41, 242, 200,
-			14, 95, 50, 236, 144, 7, 241, 203, 55, 26, 59, 228, 145, 29,
-			190, 108, 139, 12, 236, 240, 101, 91, 100, 96, 135, 47, 219, 186,
-			33, 88, 70, 205, 14, 121, 80, 215, 215, 110, 108, 118, 200, 35,
-			59, 124, 205, 42, 0, 216, 225, 107, 86, 1, 192, 14, 95, 179,
-			117, 14, 236, 240, 53, 205, 14, 121, 104, 31, 127, 126, 125, 216,
-			33, 143, 236, 240, 231, 22, 151, 135, 121, 25, 92, 192, 14, 127,
-			110, 43, 6, 216, 225, 207, 53, 59, 228, 129, 29, 190, 117, 29,
-			217, 33, 143, 236, 240, 45, 11, 14, 216, 225, 91, 22, 28, 176,
-			195, 183, 52, 59, 228, 145, 29, 190, 101, 216, 33, 15, 236, 240,
-			237, 103, 148, 29, 242, 200, 14, 223, 182, 248, 129, 29, 190, 173,
-			217, 33, 143, 236, 240, 109, 205, 14, 121, 100, 135, 111, 3, 59,
-			148, 152, 75, 219, 185, 255, 29, 55, 247, 115, 196, 41, 156, 18,
-			217, 227, 117, 174, 116, 159, 130, 252, 164, 153, 31, 218, 29, 78,
-			191, 227, 230, 7, 144, 31, 218, 129, 31, 254, 6, 106, 239, 26,
-			242, 67, 59, 242, 195, 223, 232, 18, 183, 35, 63, 252, 141, 219,
-			53, 160, 69, 7, 196, 193, 130, 22, 9, 136, 187, 118, 35, 63,
-			180, 131, 221, 251, 91, 232, 230, 215, 135, 31, 218, 145, 31, 254,
-			214, 98, 3, 126, 248, 91, 139, 205, 193, 236, 7, 183, 105, 145,
-			128, 88, 216, 129, 252, 208, 14, 252, 240, 247, 46, 221, 245, 116,
-			240, 67, 59, 242, 195, 223, 91, 160, 208, 255, 255, 222, 2, 5,
-			61, 253, 189, 59, 56, 172, 69, 2, 226, 142, 157, 146, 31, 218,
-			65, 252, 7, 208, 226, 141, 196, 15, 237, 200, 15, 255, 96, 139,
-			12, 252, 240, 15, 182, 200, 192, 15, 255, 96, 235, 134, 96, 25,
-			11, 59, 36, 63, 180, 131, 186, 126, 8, 149, 115, 227, 242, 67,
-			59, 242, 195, 15, 173, 2, 128, 31, 126, 104, 21, 0, 252, 240,
-			67, 91, 231, 192, 15, 63, 132, 58, 127, 49, 150, 223, 227, 244,
-			199, 80, 229, 215, 158, 31, 218, 145, 31, 126, 108, 113, 121, 152,
-			151, 193, 5, 252, 240, 99, 91, 49, 192, 15, 63, 134, 138, 57,
-			143, 184, 124, 78, 95, 65, 168, 184, 94, 252, 208, 142, 252, 240,
-			10, 98, 192, 1, 63, 188, 130, 24, 112, 192, 15, 175, 32, 131,
-			59, 180, 72, 64, 220, 189, 71, 242, 67, 59, 240, 195, 171, 201,
-			51, 201, 15, 237, 200, 15, 175, 182, 248, 129, 31, 94, 77, 20,
-			63, 180, 35, 63, 188, 154, 40, 126, 104, 71, 126, 120, 53, 81,
-			252, 192, 184, 255, 58, 146, 251, 45, 203, 15, 91, 27, 62, 168,
-			163, 103, 155, 249, 129, 57, 156, 190, 142, 40, 126, 96, 120, 235,
-			31, 185, 182, 252, 192, 228, 205, 125, 186, 196, 76, 222, 219, 167,
-			107, 140, 201, 91, 251, 136, 226, 7, 38, 239, 236, 35, 138, 31,
-			24, 216, 189, 55, 146, 235, 198, 15, 12, 249, 225, 141, 22, 27,
-			240, 195, 27, 45, 54, 7, 179, 87, 77, 157, 33, 63, 188, 145,
-			40, 126, 96, 192, 15, 111, 38, 79, 15, 63, 48, 228, 135, 55,
-			91, 160, 208, 255, 223, 108, 129, 98, 236, 47, 81, 182, 130, 33,
-			63, 188, 153, 104, 126, 96, 32, 190, 157, 220, 96, 252, 192, 144,
-			31, 222, 110, 139, 12, 252, 240, 118, 91, 100, 224, 135, 183, 219,
-			186, 33, 88, 70, 205, 15, 12, 212, 245, 43, 228, 134, 230, 7,
-			140, 82, 132, 66, 104, 5, 0, 63, 252, 138, 85, 0, 240, 195,
-			175, 216, 58, 7, 126, 248, 21, 162, 248, 129, 65, 251, 120, 55,
-			185, 46, 252, 192, 144, 31, 222, 109, 113, 121, 152, 151, 193, 5,
-			252, 240, 110, 91, 49, 192, 15, 239, 38, 138, 31, 24, 240, 195,
-			123, 174, 35, 63, 48, 228, 135, 247, 88, 112, 192, 15, 239, 177,
-			224, 128, 31, 222, 163, 249, 129, 33, 63, 188, 199, 240, 3, 3,
-			126, 120, 223, 51, 202, 15, 12, 249, 225, 125, 22, 63, 240, 195,
-			251, 52, 63, 48, 228, 135, 247, 105, 126, 192, 243, 54, 232, 251,
-			128, 31, 230, 153, 75, 59, 184, 255, 126, 146, 251, 51, 226, 20,
-			78, 139, 185, 171, 24, 64, 36, 155, 142, 32, 58, 28, 78, 223,
-			79, 242, 131, 200, 16, 29, 192, 16, 191, 11, 245, 119, 13, 25,
-			162, 3, 25, 226, 119, 117, 153, 59, 144, 33, 126, 151, 116, 13,
-			106, 209, 1, 113, 104, 135, 22, 9, 136, 187, 247, 32, 67, 116,
-			128, 229, 251, 48, 116, 244, 235, 195, 16, 29, 200, 16, 31, 182,
-			216, 128, 33, 62, 108, 177, 57, 152, 253, 208, 176, 22, 9, 136,
-			59, 118, 34, 67, 116, 0, 67, 252, 62, 161, 187, 159, 14, 134,
-			232, 64, 134, 248, 125, 11, 20, 44, 192, 239, 91, 160, 24, 1,
-			76, 134, 182, 107, 145, 128, 184, 115, 151, 100, 136, 14, 16, 255,
-			16, 180, 120, 35, 49, 68, 7, 50, 196, 31, 218, 34, 3, 67,
-			252, 161, 45, 50, 48, 196, 31, 218, 186, 33, 88, 198, 29, 59,
-			37, 67, 116, 128, 186, 62, 3, 149, 115, 227, 50, 68, 7, 50,
-			196, 103, 172, 2, 128, 33, 62, 99, 21, 0, 12, 241, 25, 91,
-			231, 192, 16, 159, 129, 58, 127, 49, 150, 223, 227, 244, 243, 80,
-			229, 215, 158, 33, 58, 144, 33, 62, 111, 113, 121, 152, 151, 193,
-			5, 12, 241, 121, 91, 49, 192, 16, 159, 135, 138, 57, 143, 184,
-			124, 78, 191, 72, 232, 222, 235, 197, 16, 29, 200, 16, 95, 180,
-			224, 128, 33, 190, 104, 193, 1, 67, 124, 145, 12, 237, 212, 34,
-			1, 113, 143, 144, 12, 209, 1, 12, 241, 232, 51, 202, 16, 29,
-			200, 16, 143, 90, 252, 192, 16, 143, 106, 134, 232, 64, 134, 120,
-			84, 51, 68, 7, 50, 196, 163, 154, 33, 58, 185, 255, 21, 146,
-			251, 159, 25, 134, 216, 226, 10, 196, 166, 99, 136, 78, 135, 211,
-			175, 104, 134, 232, 4, 134, 248, 234, 53, 102, 136, 78, 100, 136,
-			175, 234, 50, 119, 34, 67, 124, 85, 215, 89, 39, 50, 196, 87,
-			53, 67, 116, 34, 67, 124, 85, 51, 68, 39, 88, 190, 175, 95,
-			63, 134, 232, 68, 134, 248, 186, 197, 6, 12, 241, 117, 139, 205,
-			193, 236, 85, 99, 239, 68, 134, 248, 186, 102, 136, 78, 96, 136,
-			111, 62, 77, 12, 209, 137, 12, 241, 77, 11, 20, 44, 192, 55,
-			45, 80, 140, 2, 214, 214, 162, 19, 25, 226, 155, 134, 33, 58,
-			65, 252, 171, 27, 141, 33, 58, 145, 33, 254, 202, 22, 25, 24,
-			226, 175, 108, 145, 129, 33, 254, 202, 214, 13, 193, 50, 106, 134,
-			232, 4, 117, 125, 231, 198, 102, 136, 78, 100, 136, 239, 88, 5,
-			0, 67, 124, 199, 42, 0, 24, 226, 59, 182, 206, 129, 33, 190,
-			163, 25, 162, 19, 218, 199, 119, 175, 15, 67, 116, 34, 67, 124,
-			215, 226, 242, 48, 47, 131, 11, 24, 226, 187, 182, 98, 128, 33,
-			190, 171, 25, 162, 19, 24, 226, 251, 215, 145, 33, 58, 145, 33,
-			190, 111, 193, 1, 67, 124, 223, 130, 3, 134, 248, 190, 102, 136,
-			78, 100, 136, 239, 27, 134, 232, 4, 134, 120, 236, 25, 101, 136,
-			78, 100, 136, 199, 44, 126, 96, 136, 199, 52, 67, 116, 34, 67,
-			60, 166, 25, 162, 19, 25, 226, 49, 96, 136, 105, 230, 210, 46,
-			238, 255, 128, 228, 254, 23, 113, 10, 207, 19, 230, 224, 249, 43,
-			100, 135, 197, 56, 174, 52, 51, 67, 151, 195, 233, 15, 72, 190,
-			15, 153, 161, 11, 152, 225, 159, 96, 76, 122, 13, 153, 161, 11,
-			153, 225, 159, 116, 89, 187, 144, 25, 254, 73, 151, 181, 11, 153,
-			225, 159, 8, 223, 166, 69, 2, 98, 97, 7, 30, 17, 210, 205,
-			253, 199, 73, 238, 255, 166, 78, 97, 66, 100, 14, 208, 55, 165,
-			125, 98, 42, 196, 15, 154, 139, 219, 237, 112, 250, 56, 81, 139,
-			241, 221, 80, 220, 31, 147, 107, 187, 24, 223, 141, 197, 253, 177,
-			46, 110, 55, 22, 247, 199, 68, 45, 143, 117, 99, 113, 127, 76,
-			212, 98, 124, 55, 22, 247, 199, 208, 159, 191, 232, 32, 34, 135,
-			211, 87, 82, 186, 171, 240, 113, 71, 76, 135, 87, 210, 165, 155,
-			129, 152, 187, 30, 153, 88, 90, 13, 240, 190, 221, 122, 34, 70,
-			207, 85, 35, 188, 110, 30, 255, 87, 139, 65, 85, 251, 229, 125,
-			130, 182, 173, 111, 254, 233, 90, 176, 174, 46, 2, 86, 55, 234,
-			54, 93, 40, 137, 183, 5, 152, 251, 41, 81, 223, 69, 173, 6,
-			224, 220, 87, 82, 163, 6, 224, 220, 87, 82, 163, 6, 7, 11,
-			170, 150, 86, 187, 145, 115, 95, 73, 11, 59, 217, 159, 75, 53,
-			184, 156, 190, 134, 82, 81, 248, 207, 142, 184, 23, 15, 135, 191,
-			158, 154, 16, 65, 42, 2, 177, 22, 85, 163, 181, 198, 218, 245,
-			86, 11, 80, 214, 107, 172, 90, 192, 130, 191, 198, 170, 5, 234,
-			255, 53, 116, 96, 135, 22, 9, 136, 187, 247, 104, 181, 16, 78,
-			95, 175, 213, 130, 71, 227, 63, 13, 106, 9, 30, 126, 58, 212,
-			2, 94, 192, 235, 173, 90, 192, 11, 120, 189, 85, 11, 120, 1,
-			175, 183, 106, 33, 168, 135, 221, 123, 216, 139, 80, 43, 148, 211,
-			55, 82, 122, 83, 225, 5, 208, 101, 240, 66, 130, 171, 87, 139,
-			4, 169, 81, 81, 153, 180, 70, 5, 212, 252, 70, 139, 10, 168,
-			249, 141, 116, 96, 151, 22, 9, 136, 98, 132, 45, 35, 42, 143,
-			211, 55, 83, 58, 82, 120, 17, 180, 224, 107, 131, 170, 169, 161,
-			106, 140, 64, 211, 111, 182, 24, 61, 204, 215, 96, 4, 154, 126,
-			179, 197, 8, 52, 253, 102, 42, 246, 42, 140, 62, 167, 111, 83,
-			24, 213, 85, 31, 215, 10, 163, 108, 53, 26, 35, 176, 245, 219,
-			44, 70, 96, 235, 183, 89, 140, 192, 214, 111, 179, 24, 129, 173,
-			223, 6, 24, 127, 91, 54, 250, 54, 78, 31, 161, 116, 111, 225,
-			17, 71, 156, 149, 215, 62, 168, 236, 47, 135, 113, 45, 72, 151,
-			86, 205, 201, 179, 45, 110, 68, 61, 92, 105, 84, 130, 186, 190,
-			247, 21, 168, 125, 180, 52, 121, 68, 36, 235, 213, 52, 120, 120,
-			127, 81, 204, 175, 134, 234, 220, 229, 176, 156, 125, 75, 145, 125,
-			88, 137, 202, 33, 195, 3, 156, 203, 97, 37, 90, 139, 160, 225,
-			155, 102, 12, 180, 254, 136, 45, 40, 208, 250, 35, 182, 160, 64,
-			235, 143, 216, 102, 12, 180, 254, 8, 221, 35, 88, 130, 229, 204,
-			115, 250, 46, 40, 103, 40, 206, 226, 37, 22, 79, 86, 21, 171,
-			193, 197, 214, 202, 72, 26, 139, 234, 226, 224, 32, 101, 248, 219,
-			98, 184, 18, 85, 171, 120, 206, 237, 242, 102, 253, 46, 79, 49,
-			87, 13, 56, 239, 129, 104, 0, 231, 29, 16, 85, 44, 71, 183,
-			155, 39, 32, 238, 17, 108, 13, 1, 183, 115, 250, 27, 0, 248,
-			188, 152, 147, 87, 19, 95, 11, 192, 97, 181, 124, 25, 168, 237,
-			20, 243, 211, 80, 219, 61, 16, 13, 212, 118, 7, 68, 3, 181,
-			157, 128, 104, 160, 50, 78, 255, 119, 9, 85, 95, 37, 243, 84,
-			192, 98, 245, 203, 187, 154, 55, 183, 102, 140, 98, 126, 26, 42,
-			243, 64, 52, 80, 153, 3, 226, 192, 78, 45, 18, 16, 247, 8,
-			86, 71, 168, 29, 156, 254, 14, 165, 55, 23, 202, 96, 113, 175,
-			0, 173, 114, 160, 159, 18, 222, 14, 138, 153, 106, 188, 29, 30,
-			136, 6, 111, 135, 3, 226, 128, 208, 34, 1, 241, 166, 103, 227,
-			16, 164, 219, 237, 228, 244, 131, 192, 73, 215, 126, 8, 210, 237,
-			118, 82, 76, 92, 227, 234, 244, 64, 52, 184, 58, 29, 16, 85,
-			24, 84, 183, 219, 73, 64, 220, 181, 7, 135, 32, 221, 110, 23,
-			167, 31, 161, 215, 111, 25, 163, 219, 237, 162, 152, 131, 6, 215,
-			229, 129, 104, 192, 129, 99, 253, 17, 219, 30, 187, 8, 136, 187,
-			228, 132, 71, 119, 62, 199, 253, 63, 160, 244, 175, 168, 87, 152,
-			193, 195, 90, 229, 89, 173, 114, 140, 81, 171, 199, 23, 163, 114,
-			40, 130, 242, 197, 160, 186, 20, 150, 155, 29, 92, 101, 213, 150,
-			226, 181, 53, 48, 74, 170, 126, 213, 125, 56, 26, 91, 30, 188,
-			204, 63, 160, 108, 128, 149, 81, 23, 221, 156, 126, 130, 230, 119,
-			20, 238, 19, 147, 107, 65, 84, 217, 108, 168, 211, 92, 75, 129,
-			28, 250, 8, 188, 54, 73, 168, 123, 137, 240, 110, 112, 117, 171,
-			140, 88, 92, 23, 165, 211, 39, 197, 173, 71, 143, 28, 209, 10,
-			233, 246, 48, 27, 173, 16, 240, 181, 63, 65, 213, 126, 244, 110,
-			183, 155, 128, 56, 92, 96, 31, 144, 102, 189, 135, 211, 79, 3,
-			166, 119, 58, 226, 140, 186, 129, 233, 202, 113, 233, 59, 155, 54,
-			131, 116, 120, 252, 232, 49, 48, 227, 81, 146, 209, 156, 40, 199,
-			161, 28, 134, 169, 163, 241, 153, 136, 170, 160, 178, 64, 94, 116,
-			30, 189, 52, 44, 139, 114, 188, 22, 68, 85, 1, 9, 39, 98,
-			116, 234, 212, 76, 178, 223, 116, 145, 30, 15, 241, 234, 194, 245,
-			56, 32, 170, 1, 103, 183, 219, 67, 64, 28, 46, 176, 95, 146,
-			133, 235, 229, 244, 115, 80, 184, 95, 112, 196, 84, 237, 202, 139,
-			53, 117, 86, 140, 94, 60, 38, 226, 186, 184, 120, 219, 126, 173,
-			246, 34, 147, 167, 188, 139, 169, 179, 23, 111, 211, 15, 67, 115,
-			207, 58, 148, 73, 241, 149, 72, 26, 245, 122, 220, 144, 231, 154,
-			39, 15, 53, 130, 122, 40, 22, 235, 193, 210, 133, 48, 181, 20,
-			213, 235, 33, 54, 93, 144, 94, 7, 196, 174, 30, 45, 18, 16,
-			135, 11, 236, 133, 88, 142, 62, 78, 191, 0, 229, 184, 75, 76,
-			213, 46, 30, 219, 74, 65, 46, 30, 179, 248, 85, 218, 125, 30,
-			166, 166, 115, 238, 115, 64, 84, 227, 192, 110, 183, 143, 128, 56,
-			92, 96, 111, 150, 42, 228, 156, 126, 9, 178, 254, 89, 80, 225,
-			197, 219, 182, 148, 183, 81, 83, 81, 170, 142, 93, 35, 221, 113,
-			15, 65, 233, 18, 112, 7, 68, 83, 2, 78, 64, 28, 46, 176,
-			69, 44, 64, 63, 167, 255, 5, 10, 48, 47, 206, 213, 163, 43,
-			134, 63, 38, 130, 69, 188, 20, 61, 20, 231, 74, 83, 34, 72,
-			116, 243, 102, 186, 125, 31, 189, 227, 246, 219, 52, 160, 126, 15,
-			51, 209, 128, 250, 29, 16, 187, 122, 181, 72, 64, 28, 46, 176,
-			151, 33, 160, 1, 78, 191, 10, 128, 170, 0, 168, 20, 46, 95,
-			185, 74, 155, 145, 40, 32, 12, 145, 224, 205, 234, 224, 247, 47,
-			134, 162, 30, 86, 2, 125, 189, 186, 46, 133, 209, 221, 128, 135,
-			217, 107, 168, 3, 14, 136, 106, 213, 183, 219, 29, 32, 32, 14,
-			23, 216, 151, 101, 237, 15, 114, 250, 13, 192, 250, 25, 71, 76,
-			40, 235, 243, 164, 104, 195, 40, 93, 13, 235, 87, 108, 35, 196,
-			232, 165, 213, 104, 105, 117, 131, 101, 184, 172, 97, 96, 202, 50,
-			196, 117, 129, 182, 97, 12, 254, 138, 82, 224, 143, 203, 118, 98,
-			83, 252, 65, 15, 75, 164, 139, 63, 232, 128, 104, 138, 63, 72,
-			64, 28, 46, 40, 78, 29, 226, 244, 47, 160, 244, 211, 226, 92,
-			35, 42, 111, 161, 158, 206, 77, 157, 106, 174, 40, 134, 197, 61,
-			118, 216, 90, 233, 33, 15, 19, 215, 64, 134, 28, 16, 77, 27,
-			30, 34, 32, 14, 23, 212, 208, 106, 27, 167, 127, 73, 243, 251,
-			11, 47, 176, 44, 133, 23, 227, 100, 32, 5, 154, 139, 46, 133,
-			149, 138, 192, 75, 203, 52, 29, 25, 28, 1, 188, 134, 55, 214,
-			25, 125, 108, 243, 49, 105, 205, 14, 219, 28, 16, 251, 111, 210,
-			34, 1, 241, 230, 81, 246, 168, 139, 56, 134, 57, 253, 27, 234,
-			30, 44, 124, 202, 149, 134, 93, 206, 243, 224, 45, 21, 152, 108,
-			152, 136, 214, 171, 121, 176, 97, 110, 184, 153, 71, 94, 224, 16,
-			44, 86, 66, 38, 228, 189, 119, 98, 53, 12, 202, 77, 23, 57,
-			20, 89, 246, 122, 11, 233, 41, 68, 137, 72, 235, 141, 112, 204,
-			164, 186, 241, 179, 4, 239, 247, 40, 157, 62, 121, 112, 41, 94,
-			171, 85, 162, 160, 154, 22, 153, 152, 11, 211, 20, 76, 74, 26,
-			139, 229, 160, 146, 132, 226, 82, 84, 169, 40, 8, 34, 16, 149,
-			56, 78, 90, 82, 193, 58, 198, 89, 196, 114, 148, 4, 149, 74,
-			124, 41, 97, 226, 37, 245, 151, 84, 95, 50, 158, 25, 107, 143,
-			9, 217, 122, 85, 227, 211, 151, 83, 44, 174, 215, 130, 36, 209,
-			224, 112, 36, 4, 185, 163, 103, 97, 116, 63, 76, 81, 157, 70,
-			244, 64, 236, 208, 20, 48, 236, 128, 216, 171, 221, 170, 97, 2,
-			226, 118, 61, 104, 25, 206, 131, 184, 115, 76, 139, 109, 32, 238,
-			59, 32, 167, 86, 187, 221, 237, 156, 254, 45, 117, 71, 158, 185,
-			169, 213, 110, 119, 59, 69, 12, 70, 244, 64, 52, 165, 219, 238,
-			128, 216, 171, 157, 239, 237, 4, 196, 61, 123, 217, 221, 204, 245,
-			114, 220, 255, 123, 154, 251, 39, 234, 20, 142, 180, 182, 119, 117,
-			75, 160, 72, 226, 181, 16, 91, 250, 193, 166, 150, 14, 186, 237,
-			96, 196, 3, 191, 235, 239, 169, 215, 195, 58, 25, 245, 114, 110,
-			142, 211, 255, 135, 186, 221, 144, 151, 135, 55, 124, 128, 216, 174,
-			69, 23, 196, 206, 46, 118, 59, 190, 235, 112, 250, 255, 82, 119,
-			91, 225, 150, 166, 230, 165, 12, 88, 171, 253, 122, 206, 145, 163,
-			227, 178, 58, 61, 188, 208, 3, 62, 229, 90, 116, 65, 28, 28,
-			98, 119, 96, 186, 46, 167, 255, 72, 221, 225, 194, 129, 214, 102,
-			219, 120, 210, 132, 1, 211, 63, 82, 183, 95, 139, 152, 212, 208,
-			54, 118, 47, 115, 105, 15, 247, 255, 153, 230, 62, 234, 57, 133,
-			231, 11, 123, 9, 228, 149, 205, 204, 226, 76, 65, 243, 196, 44,
-			248, 83, 255, 76, 213, 233, 108, 61, 160, 184, 31, 209, 107, 123,
-			58, 91, 15, 78, 204, 254, 72, 59, 236, 61, 56, 49, 251, 35,
-			237, 159, 246, 96, 237, 252, 136, 170, 211, 217, 122, 112, 98, 246,
-			71, 116, 199, 78, 5, 200, 225, 244, 39, 18, 208, 83, 154, 117,
-			203, 78, 47, 245, 200, 99, 21, 44, 32, 60, 86, 65, 143, 32,
-			122, 228, 177, 10, 122, 138, 180, 71, 30, 171, 64, 11, 59, 209,
-			165, 239, 193, 99, 21, 60, 42, 10, 247, 61, 229, 9, 210, 203,
-			79, 46, 245, 200, 243, 20, 60, 131, 16, 207, 83, 240, 12, 66,
-			60, 79, 193, 83, 243, 25, 61, 242, 60, 5, 111, 247, 30, 133,
-			144, 112, 250, 26, 133, 240, 41, 206, 85, 94, 126, 106, 169, 7,
-			39, 14, 95, 99, 17, 18, 15, 68, 131, 144, 56, 32, 26, 132,
-			4, 49, 237, 222, 35, 167, 150, 122, 160, 116, 175, 247, 110, 136,
-			169, 165, 30, 156, 139, 124, 189, 45, 40, 245, 64, 52, 5, 165,
-			14, 136, 166, 160, 148, 128, 184, 71, 176, 24, 203, 233, 113, 250,
-			38, 40, 103, 112, 245, 83, 75, 114, 242, 86, 26, 111, 246, 36,
-			211, 74, 61, 56, 41, 249, 38, 11, 214, 67, 0, 166, 171, 121,
-			14, 136, 106, 108, 220, 131, 147, 146, 111, 2, 176, 17, 130, 245,
-			57, 125, 11, 128, 253, 55, 87, 63, 173, 212, 12, 246, 114, 83,
-			74, 61, 56, 47, 249, 22, 11, 211, 247, 64, 52, 48, 125, 7,
-			68, 3, 211, 39, 32, 26, 152, 109, 156, 190, 93, 194, 124, 10,
-			83, 74, 186, 97, 63, 209, 244, 76, 15, 206, 42, 190, 221, 194,
-			108, 243, 64, 52, 48, 219, 28, 16, 213, 116, 82, 15, 206, 42,
-			190, 29, 96, 190, 24, 97, 230, 57, 125, 196, 163, 187, 175, 195,
-			244, 76, 15, 78, 30, 62, 98, 113, 229, 61, 16, 13, 174, 188,
-			3, 162, 58, 29, 175, 7, 39, 15, 31, 241, 118, 238, 194, 233,
-			153, 30, 183, 157, 211, 119, 121, 215, 239, 116, 188, 30, 156, 46,
-			124, 151, 5, 215, 238, 129, 104, 192, 181, 59, 32, 170, 211, 241,
-			122, 112, 186, 240, 93, 0, 238, 37, 0, 46, 159, 227, 254, 111,
-			120, 244, 131, 158, 87, 152, 126, 74, 211, 51, 80, 189, 173, 147,
-			51, 61, 56, 57, 243, 27, 30, 27, 96, 1, 106, 130, 113, 250,
-			94, 47, 191, 173, 48, 247, 212, 167, 10, 4, 12, 82, 32, 83,
-			177, 28, 215, 215, 130, 84, 235, 130, 121, 152, 135, 214, 5, 115,
-			64, 84, 99, 254, 30, 156, 128, 124, 175, 55, 48, 164, 90, 76,
-			7, 167, 191, 13, 120, 166, 159, 194, 152, 31, 26, 178, 44, 124,
-			51, 144, 14, 15, 19, 215, 64, 58, 28, 16, 213, 224, 163, 7,
-			103, 22, 127, 219, 2, 233, 228, 244, 119, 13, 144, 171, 156, 0,
-			184, 28, 144, 78, 15, 19, 215, 64, 58, 29, 16, 13, 144, 78,
-			2, 226, 192, 144, 244, 106, 123, 112, 46, 209, 163, 207, 122, 230,
-			188, 218, 30, 57, 219, 104, 155, 51, 206, 54, 90, 192, 56, 219,
-			232, 169, 128, 129, 30, 57, 219, 232, 237, 189, 137, 77, 49, 151,
-			246, 114, 255, 15, 188, 220, 23, 60, 167, 112, 167, 48, 87, 73,
-			95, 145, 163, 22, 86, 27, 107, 77, 94, 90, 175, 195, 233, 31,
-			120, 42, 90, 160, 23, 188, 180, 143, 131, 90, 174, 161, 151, 214,
-			139, 94, 218, 199, 117, 65, 123, 209, 75, 251, 184, 238, 183, 189,
-			232, 165, 125, 220, 83, 94, 90, 47, 122, 105, 31, 247, 70, 110,
-			98, 111, 112, 16, 145, 195, 233, 167, 0, 209, 203, 197, 41, 123,
-			251, 245, 21, 224, 194, 58, 200, 88, 21, 51, 231, 34, 75, 111,
-			239, 176, 4, 149, 140, 225, 69, 143, 234, 238, 64, 96, 235, 70,
-			85, 123, 209, 248, 122, 81, 23, 5, 252, 187, 79, 217, 162, 128,
-			127, 247, 41, 93, 103, 189, 232, 223, 125, 202, 235, 223, 163, 69,
-			2, 226, 200, 77, 216, 248, 123, 193, 191, 251, 44, 148, 228, 218,
-			219, 237, 94, 244, 234, 62, 107, 113, 129, 123, 240, 89, 171, 98,
-			80, 226, 103, 181, 221, 238, 69, 175, 238, 179, 128, 235, 60, 226,
-			34, 156, 254, 49, 224, 186, 78, 118, 187, 23, 29, 186, 63, 182,
-			224, 192, 161, 251, 99, 11, 14, 28, 186, 63, 214, 118, 187, 23,
-			29, 186, 63, 6, 112, 191, 228, 48, 151, 246, 113, 255, 75, 94,
-			238, 191, 122, 78, 225, 23, 28, 145, 189, 200, 252, 202, 90, 251,
-			218, 98, 88, 6, 207, 76, 95, 179, 42, 113, 21, 153, 56, 29,
-			215, 245, 195, 131, 120, 143, 36, 22, 48, 25, 19, 151, 187, 245,
-			178, 30, 46, 53, 234, 73, 116, 49, 172, 172, 203, 129, 33, 237,
-			115, 56, 253, 146, 151, 31, 64, 45, 246, 65, 207, 249, 51, 15,
-			247, 104, 207, 93, 136, 54, 181, 250, 87, 102, 73, 164, 130, 51,
-			214, 68, 106, 177, 15, 123, 209, 159, 105, 45, 246, 97, 47, 250,
-			51, 221, 244, 250, 176, 23, 253, 153, 199, 135, 180, 72, 64, 220,
-			181, 155, 61, 15, 193, 57, 156, 126, 5, 192, 141, 219, 59, 82,
-			159, 188, 1, 38, 97, 170, 243, 134, 102, 255, 21, 155, 55, 52,
-			251, 175, 216, 188, 29, 76, 158, 23, 180, 72, 64, 220, 181, 155,
-			205, 49, 151, 114, 238, 127, 205, 203, 253, 173, 231, 20, 38, 69,
-			211, 61, 242, 87, 54, 174, 172, 171, 79, 154, 135, 150, 220, 225,
-			244, 107, 94, 126, 144, 173, 50, 74, 57, 168, 254, 27, 30, 29,
-			41, 188, 24, 6, 78, 120, 213, 252, 85, 248, 109, 153, 240, 7,
-			188, 105, 126, 147, 193, 19, 199, 58, 248, 134, 214, 3, 199, 58,
-			248, 134, 246, 216, 57, 214, 193, 55, 60, 181, 234, 205, 177, 14,
-			190, 225, 137, 189, 10, 165, 195, 233, 95, 40, 148, 234, 66, 252,
-			107, 135, 50, 59, 128, 226, 88, 91, 127, 97, 81, 66, 109, 253,
-			133, 69, 233, 32, 16, 131, 18, 106, 235, 47, 0, 229, 251, 29,
-			132, 233, 114, 250, 109, 128, 249, 171, 142, 56, 135, 215, 243, 183,
-			162, 12, 42, 21, 17, 86, 194, 181, 16, 234, 42, 170, 110, 214,
-			114, 228, 189, 254, 114, 197, 134, 225, 68, 139, 92, 177, 137, 18,
-			105, 150, 177, 134, 151, 112, 190, 44, 141, 69, 34, 111, 57, 13,
-			170, 101, 73, 79, 208, 31, 19, 49, 170, 186, 167, 156, 131, 171,
-			198, 41, 211, 179, 185, 97, 89, 207, 193, 114, 180, 123, 223, 182,
-			69, 5, 187, 247, 109, 221, 48, 57, 218, 189, 111, 107, 119, 159,
-			163, 221, 251, 54, 20, 245, 179, 178, 168, 132, 211, 255, 1, 69,
-			253, 176, 35, 54, 214, 71, 104, 113, 235, 171, 115, 179, 150, 37,
-			88, 90, 197, 26, 208, 254, 61, 42, 160, 200, 76, 27, 111, 189,
-			217, 25, 231, 8, 147, 20, 103, 10, 31, 14, 151, 26, 105, 147,
-			61, 208, 78, 166, 73, 150, 137, 70, 21, 195, 142, 19, 48, 38,
-			81, 146, 105, 2, 48, 170, 48, 229, 7, 211, 250, 63, 108, 249,
-			137, 15, 98, 215, 54, 45, 58, 32, 14, 235, 170, 38, 88, 96,
-			177, 87, 250, 64, 28, 116, 247, 61, 239, 153, 12, 154, 228, 56,
-			4, 254, 158, 197, 15, 67, 224, 239, 217, 250, 131, 33, 240, 247,
-			180, 15, 196, 113, 8, 252, 61, 111, 239, 8, 250, 64, 253, 220,
-			255, 59, 47, 247, 115, 62, 248, 64, 247, 6, 181, 45, 216, 148,
-			181, 160, 214, 108, 78, 250, 29, 78, 255, 206, 203, 247, 98, 8,
-			77, 63, 152, 147, 199, 60, 29, 230, 115, 54, 136, 234, 79, 177,
-			163, 190, 240, 190, 205, 140, 73, 63, 26, 147, 199, 116, 217, 251,
-			209, 152, 60, 166, 187, 105, 63, 26, 147, 199, 116, 55, 237, 71,
-			99, 242, 152, 167, 194, 124, 250, 161, 97, 255, 192, 211, 97, 62,
-			215, 20, 99, 214, 148, 244, 163, 41, 249, 129, 197, 8, 166, 228,
-			7, 22, 163, 131, 48, 12, 70, 48, 37, 63, 0, 140, 47, 71,
-			140, 46, 167, 63, 244, 168, 40, 212, 196, 76, 60, 87, 11, 234,
-			73, 214, 148, 40, 47, 172, 217, 126, 88, 255, 162, 81, 77, 194,
-			84, 45, 249, 66, 75, 98, 217, 245, 129, 181, 160, 182, 47, 145,
-			23, 164, 55, 17, 187, 180, 28, 69, 141, 29, 143, 43, 176, 216,
-			193, 54, 252, 80, 183, 173, 126, 180, 13, 63, 244, 248, 14, 45,
-			18, 16, 119, 239, 97, 247, 33, 118, 194, 233, 143, 160, 107, 156,
-			17, 47, 12, 215, 55, 49, 12, 201, 19, 91, 134, 11, 225, 122,
-			139, 97, 80, 217, 64, 127, 253, 145, 197, 4, 253, 245, 71, 186,
-			191, 246, 99, 127, 253, 145, 55, 188, 83, 139, 136, 98, 239, 136,
-			12, 141, 239, 135, 242, 188, 194, 167, 55, 21, 62, 226, 136, 251,
-			164, 250, 182, 128, 171, 41, 210, 93, 131, 100, 205, 40, 181, 135,
-			165, 107, 39, 99, 180, 116, 243, 137, 154, 87, 54, 172, 151, 114,
-			69, 6, 171, 31, 59, 252, 43, 124, 163, 0, 234, 131, 104, 20,
-			0, 29, 254, 21, 254, 240, 110, 45, 18, 16, 247, 142, 72, 131,
-			213, 15, 53, 248, 106, 255, 153, 52, 88, 253, 56, 13, 246, 106,
-			139, 223, 67, 72, 166, 81, 121, 14, 136, 92, 227, 247, 8, 136,
-			123, 71, 88, 204, 92, 58, 192, 253, 159, 247, 115, 111, 245, 157,
-			66, 32, 38, 170, 235, 45, 6, 107, 51, 99, 101, 182, 106, 192,
-			240, 74, 237, 103, 88, 88, 137, 227, 149, 74, 88, 172, 213, 227,
-			52, 94, 108, 44, 23, 39, 170, 235, 11, 217, 229, 11, 232, 3,
-			210, 172, 13, 56, 156, 254, 188, 159, 239, 69, 31, 112, 0, 204,
-			218, 235, 253, 171, 246, 1, 7, 208, 92, 189, 94, 151, 124, 0,
-			205, 213, 235, 117, 201, 7, 208, 92, 189, 222, 87, 62, 224, 0,
-			154, 171, 215, 251, 187, 118, 163, 239, 51, 0, 125, 237, 77, 144,
-			247, 139, 159, 112, 232, 179, 47, 17, 11, 0, 255, 124, 163, 94,
-			89, 120, 162, 113, 208, 134, 109, 29, 186, 211, 15, 160, 193, 122,
-			147, 69, 9, 6, 235, 77, 190, 50, 88, 3, 104, 176, 222, 228,
-			171, 248, 162, 1, 52, 88, 111, 2, 148, 49, 162, 116, 57, 125,
-			11, 160, 12, 158, 108, 32, 180, 9, 208, 214, 113, 17, 12, 34,
-			193, 176, 110, 50, 44, 182, 96, 161, 71, 191, 197, 130, 133, 246,
-			253, 22, 11, 22, 180, 246, 22, 127, 64, 171, 20, 108, 210, 91,
-			0, 236, 207, 48, 151, 14, 114, 255, 29, 126, 238, 247, 125, 167,
-			16, 139, 83, 141, 58, 54, 245, 43, 164, 192, 43, 106, 85, 58,
-			205, 203, 52, 173, 65, 135, 211, 119, 248, 249, 65, 108, 90, 131,
-			208, 180, 126, 249, 234, 155, 214, 32, 54, 173, 95, 214, 122, 24,
-			196, 166, 245, 203, 186, 105, 13, 98, 211, 250, 101, 221, 180, 6,
-			177, 105, 253, 178, 143, 195, 11, 200, 219, 225, 244, 87, 125, 58,
-			126, 45, 103, 44, 6, 177, 21, 253, 170, 5, 228, 248, 32, 118,
-			61, 91, 139, 152, 231, 205, 7, 180, 72, 64, 44, 30, 194, 64,
-			176, 65, 104, 69, 191, 230, 211, 131, 215, 105, 231, 219, 32, 54,
-			154, 95, 179, 216, 92, 31, 68, 131, 13, 244, 241, 107, 254, 205,
-			163, 90, 36, 32, 30, 24, 83, 216, 8, 167, 191, 233, 211, 226,
-			181, 194, 214, 178, 217, 109, 16, 233, 237, 55, 45, 54, 160, 183,
-			223, 180, 216, 128, 222, 126, 211, 191, 121, 191, 22, 17, 205, 216,
-			65, 156, 52, 29, 132, 98, 253, 22, 232, 109, 238, 218, 111, 117,
-			211, 240, 168, 204, 67, 195, 3, 242, 249, 45, 11, 15, 200, 231,
-			183, 172, 234, 128, 124, 126, 11, 84, 183, 136, 240, 60, 78, 63,
-			0, 170, 155, 191, 154, 109, 105, 87, 168, 62, 32, 151, 15, 88,
-			124, 158, 15, 162, 193, 7, 228, 242, 1, 171, 62, 32, 151, 15,
-			128, 250, 94, 140, 248, 124, 78, 255, 19, 168, 239, 218, 207, 46,
-			13, 226, 162, 202, 127, 178, 184, 124, 204, 203, 224, 242, 29, 16,
-			141, 222, 124, 2, 226, 129, 49, 156, 23, 25, 116, 219, 56, 253,
-			61, 159, 30, 190, 94, 179, 75, 131, 184, 148, 242, 123, 22, 92,
-			155, 15, 162, 1, 215, 230, 128, 120, 243, 152, 22, 9, 136, 135,
-			198, 217, 191, 103, 46, 29, 226, 254, 39, 252, 220, 127, 247, 157,
-			194, 67, 98, 62, 90, 11, 147, 52, 88, 187, 210, 129, 196, 21,
-			89, 81, 147, 232, 101, 204, 232, 144, 195, 233, 39, 252, 252, 16,
-			154, 209, 33, 48, 163, 159, 188, 122, 51, 58, 132, 102, 244, 147,
-			90, 19, 67, 104, 70, 63, 169, 205, 232, 16, 154, 209, 79, 106,
-			51, 58, 132, 102, 244, 147, 218, 140, 14, 129, 217, 248, 52, 84,
-			211, 53, 52, 163, 67, 104, 70, 63, 109, 1, 129, 25, 253, 180,
-			223, 117, 179, 22, 49, 207, 125, 99, 90, 36, 32, 30, 26, 71,
-			83, 53, 4, 102, 244, 115, 215, 208, 84, 181, 216, 130, 33, 52,
-			163, 159, 179, 216, 160, 255, 124, 206, 98, 3, 125, 124, 206, 223,
-			183, 95, 139, 4, 196, 177, 131, 216, 166, 135, 64, 250, 19, 159,
-			30, 42, 252, 212, 150, 55, 16, 95, 145, 33, 24, 66, 59, 250,
-			39, 22, 28, 216, 209, 63, 177, 224, 192, 142, 254, 137, 191, 239,
-			22, 45, 34, 156, 131, 69, 180, 163, 67, 80, 174, 63, 5, 197,
-			93, 55, 59, 58, 132, 118, 244, 79, 45, 60, 176, 163, 127, 106,
-			225, 129, 29, 253, 83, 171, 59, 176, 163, 127, 10, 186, 91, 68,
-			120, 30, 167, 95, 6, 221, 93, 63, 59, 58, 132, 118, 244, 203,
-			22, 31, 216, 209, 47, 91, 124, 96, 71, 191, 108, 213, 7, 118,
-			244, 203, 160, 190, 127, 135, 248, 124, 78, 255, 155, 79, 119, 23,
-			214, 196, 116, 58, 19, 95, 218, 20, 225, 230, 245, 186, 212, 168,
-			215, 195, 106, 42, 210, 104, 45, 44, 202, 175, 25, 6, 115, 225,
-			200, 66, 71, 116, 225, 176, 21, 94, 191, 63, 74, 87, 35, 57,
-			62, 41, 106, 224, 96, 104, 255, 155, 5, 238, 123, 32, 154, 30,
-			12, 134, 246, 191, 249, 124, 88, 139, 4, 196, 157, 187, 112, 184,
-			61, 4, 134, 246, 27, 0, 188, 38, 238, 121, 82, 224, 27, 148,
-			218, 140, 253, 158, 171, 193, 14, 118, 248, 27, 22, 123, 155, 7,
-			162, 193, 14, 118, 248, 27, 22, 59, 216, 225, 111, 0, 118, 12,
-			219, 24, 114, 243, 156, 126, 11, 204, 207, 35, 142, 78, 251, 201,
-			91, 198, 37, 249, 34, 254, 82, 86, 254, 170, 25, 32, 52, 23,
-			168, 53, 116, 60, 27, 98, 23, 84, 144, 101, 234, 182, 116, 178,
-			226, 131, 106, 153, 41, 85, 102, 99, 237, 134, 112, 141, 252, 91,
-			182, 160, 121, 31, 68, 69, 56, 67, 184, 70, 254, 45, 77, 56,
-			67, 184, 70, 254, 45, 176, 106, 63, 119, 132, 29, 93, 137, 139,
-			75, 171, 245, 120, 45, 106, 172, 21, 227, 250, 202, 161, 74, 99,
-			41, 58, 148, 132, 245, 139, 97, 253, 208, 67, 141, 56, 13, 228,
-			255, 107, 139, 135, 162, 114, 34, 105, 132, 239, 111, 249, 168, 8,
-			31, 21, 229, 71, 69, 124, 189, 168, 62, 42, 108, 83, 195, 222,
-			240, 144, 254, 67, 166, 49, 242, 62, 135, 117, 159, 141, 43, 209,
-			210, 250, 201, 184, 186, 28, 173, 76, 157, 226, 130, 249, 65, 173,
-			118, 62, 42, 15, 59, 194, 25, 109, 63, 209, 254, 248, 9, 191,
-			78, 63, 225, 56, 47, 47, 121, 65, 173, 54, 85, 230, 123, 152,
-			87, 15, 131, 202, 218, 176, 187, 225, 5, 124, 206, 139, 172, 251,
-			98, 88, 79, 162, 184, 122, 62, 89, 90, 13, 215, 194, 97, 34,
-			156, 209, 174, 19, 109, 143, 159, 160, 183, 184, 195, 78, 169, 75,
-			253, 60, 135, 191, 242, 155, 88, 155, 122, 48, 76, 91, 147, 212,
-			191, 140, 124, 220, 97, 121, 9, 117, 234, 20, 255, 55, 204, 95,
-			66, 192, 8, 178, 227, 200, 29, 197, 43, 86, 70, 177, 185, 188,
-			39, 242, 143, 159, 240, 126, 193, 113, 123, 157, 146, 74, 146, 159,
-			101, 228, 66, 184, 142, 165, 235, 56, 114, 108, 203, 41, 191, 48,
-			92, 207, 36, 10, 73, 141, 220, 202, 218, 229, 111, 165, 112, 153,
-			15, 53, 97, 111, 55, 217, 246, 218, 108, 219, 229, 103, 63, 235,
-			232, 239, 94, 24, 174, 243, 125, 172, 29, 99, 146, 107, 193, 82,
-			184, 177, 110, 236, 111, 124, 23, 163, 32, 108, 172, 30, 124, 204,
-			139, 172, 171, 30, 38, 113, 163, 190, 20, 158, 7, 143, 3, 43,
-			167, 233, 189, 78, 253, 251, 252, 122, 45, 28, 249, 59, 135, 181,
-			79, 44, 45, 197, 141, 106, 122, 109, 154, 199, 179, 89, 62, 42,
-			135, 213, 52, 74, 215, 55, 230, 109, 126, 106, 46, 239, 134, 118,
-			177, 73, 121, 189, 43, 44, 175, 255, 132, 229, 61, 113, 224, 197,
-			173, 61, 235, 242, 221, 241, 5, 159, 185, 133, 181, 113, 143, 230,
-			62, 239, 56, 236, 247, 28, 230, 116, 114, 66, 115, 252, 200, 111,
-			59, 226, 100, 92, 91, 175, 71, 43, 171, 169, 56, 50, 126, 228,
-			8, 198, 131, 77, 159, 59, 57, 37, 38, 26, 233, 106, 92, 79,
-			138, 98, 162, 82, 17, 248, 66, 34, 234, 33, 38, 94, 46, 50,
-			113, 46, 9, 205, 156, 148, 4, 37, 55, 234, 70, 137, 88, 137,
-			47, 134, 245, 106, 88, 198, 53, 232, 58, 26, 164, 137, 90, 176,
-			4, 9, 71, 75, 97, 53, 9, 199, 196, 125, 178, 179, 136, 35,
-			197, 113, 38, 205, 163, 50, 104, 203, 113, 163, 90, 214, 115, 122,
-			211, 83, 39, 39, 103, 230, 38, 197, 114, 132, 86, 186, 157, 185,
-			36, 199, 137, 159, 219, 203, 242, 204, 201, 115, 146, 207, 221, 13,
-			15, 243, 29, 242, 207, 60, 115, 92, 78, 88, 238, 8, 123, 46,
-			115, 105, 142, 211, 174, 220, 115, 156, 194, 184, 152, 194, 154, 82,
-			129, 225, 217, 94, 37, 70, 87, 234, 113, 163, 6, 229, 192, 199,
-			81, 136, 155, 107, 24, 35, 52, 231, 112, 210, 149, 31, 98, 175,
-			117, 25, 165, 24, 52, 59, 232, 110, 163, 133, 31, 58, 168, 160,
-			100, 53, 174, 167, 122, 17, 72, 46, 181, 150, 139, 140, 73, 43,
-			29, 37, 38, 242, 57, 9, 107, 65, 61, 72, 67, 181, 1, 25,
-			204, 121, 230, 35, 187, 239, 24, 247, 25, 133, 245, 139, 209, 82,
-			200, 68, 57, 172, 85, 226, 245, 181, 176, 154, 154, 36, 205, 116,
-			159, 8, 84, 222, 81, 181, 12, 169, 196, 24, 138, 120, 9, 20,
-			88, 137, 87, 162, 165, 160, 162, 211, 145, 53, 147, 45, 109, 211,
-			12, 244, 104, 37, 186, 16, 138, 133, 165, 139, 11, 64, 28, 11,
-			245, 242, 226, 194, 24, 78, 1, 45, 64, 27, 58, 184, 116, 177,
-			24, 212, 106, 73, 45, 78, 139, 75, 241, 218, 2, 104, 165, 147,
-			121, 160, 8, 143, 147, 65, 55, 175, 37, 135, 147, 193, 246, 30,
-			45, 17, 78, 6, 185, 156, 252, 201, 185, 185, 60, 167, 131, 3,
-			219, 8, 235, 101, 237, 40, 126, 48, 223, 189, 141, 147, 33, 90,
-			100, 111, 167, 168, 84, 135, 211, 155, 221, 81, 90, 120, 29, 181,
-			173, 14, 123, 158, 157, 6, 109, 170, 173, 197, 176, 18, 87, 87,
-			0, 126, 86, 215, 129, 88, 169, 196, 139, 65, 69, 125, 170, 182,
-			110, 142, 70, 197, 176, 168, 3, 27, 229, 228, 114, 173, 30, 63,
-			24, 46, 165, 80, 22, 49, 51, 59, 63, 121, 92, 38, 33, 63,
-			11, 170, 101, 217, 80, 165, 1, 81, 79, 113, 250, 248, 220, 220,
-			185, 137, 233, 233, 7, 224, 27, 25, 107, 41, 131, 38, 229, 27,
-			171, 97, 61, 100, 0, 67, 85, 8, 134, 213, 155, 95, 163, 170,
-			138, 136, 223, 80, 25, 136, 188, 188, 22, 85, 163, 36, 13, 97,
-			156, 52, 26, 22, 87, 138, 76, 62, 75, 14, 169, 74, 76, 176,
-			91, 92, 170, 71, 105, 56, 6, 73, 150, 161, 178, 106, 141, 250,
-			74, 40, 162, 84, 5, 111, 54, 35, 86, 48, 194, 132, 9, 25,
-			189, 7, 96, 76, 135, 60, 111, 12, 140, 168, 68, 23, 195, 100,
-			204, 148, 91, 183, 26, 200, 15, 154, 201, 186, 233, 42, 76, 110,
-			155, 15, 132, 6, 46, 227, 75, 84, 118, 169, 168, 134, 97, 89,
-			215, 201, 228, 195, 193, 90, 173, 18, 38, 199, 153, 16, 66, 91,
-			166, 227, 119, 43, 205, 195, 195, 187, 213, 70, 150, 202, 241, 231,
-			98, 125, 171, 124, 15, 130, 5, 124, 158, 110, 100, 142, 199, 201,
-			205, 166, 145, 57, 14, 39, 55, 183, 119, 107, 137, 112, 114, 115,
-			31, 87, 141, 204, 201, 115, 122, 115, 255, 168, 110, 100, 142, 106,
-			100, 251, 104, 145, 125, 221, 193, 70, 230, 114, 122, 200, 61, 76,
-			11, 127, 226, 24, 163, 35, 185, 222, 170, 74, 213, 81, 80, 89,
-			137, 235, 81, 186, 186, 38, 46, 5, 182, 11, 47, 5, 149, 165,
-			70, 5, 250, 240, 130, 98, 248, 5, 217, 250, 244, 98, 196, 194,
-			248, 66, 38, 41, 108, 185, 230, 77, 76, 73, 5, 231, 97, 56,
-			59, 168, 186, 145, 132, 117, 72, 226, 164, 116, 240, 212, 0, 116,
-			53, 90, 89, 13, 147, 84, 52, 59, 36, 118, 197, 24, 26, 204,
-			194, 225, 5, 211, 17, 93, 143, 147, 67, 70, 71, 174, 195, 201,
-			161, 246, 109, 90, 34, 156, 28, 42, 236, 80, 58, 2, 215, 244,
-			208, 206, 195, 90, 71, 110, 254, 131, 121, 143, 112, 50, 78, 111,
-			98, 207, 65, 21, 17, 78, 143, 185, 183, 209, 194, 126, 89, 42,
-			165, 37, 109, 220, 155, 13, 230, 196, 220, 148, 53, 6, 196, 227,
-			228, 152, 193, 64, 28, 78, 142, 181, 247, 106, 137, 112, 114, 172,
-			127, 64, 97, 32, 121, 78, 143, 13, 222, 166, 49, 16, 85, 79,
-			183, 210, 34, 251, 137, 35, 15, 37, 125, 126, 238, 180, 83, 248,
-			159, 142, 152, 142, 227, 11, 208, 139, 26, 53, 48, 118, 81, 117,
-			165, 18, 138, 26, 98, 16, 117, 57, 169, 208, 106, 198, 167, 78,
-			97, 51, 214, 15, 95, 24, 174, 235, 26, 106, 121, 43, 178, 68,
-			32, 171, 92, 165, 43, 29, 27, 189, 159, 34, 81, 123, 190, 211,
-			186, 201, 177, 60, 198, 76, 79, 49, 153, 136, 122, 184, 28, 214,
-			209, 154, 154, 83, 166, 150, 116, 146, 198, 181, 7, 106, 195, 228,
-			1, 211, 68, 37, 1, 82, 208, 137, 148, 194, 101, 69, 55, 208,
-			198, 159, 159, 7, 221, 81, 234, 0, 219, 220, 237, 158, 160, 168,
-			73, 199, 205, 249, 156, 220, 237, 246, 106, 201, 225, 228, 238, 190,
-			109, 90, 34, 156, 220, 173, 106, 218, 65, 147, 123, 247, 206, 19,
-			82, 203, 14, 154, 220, 62, 151, 147, 9, 58, 166, 18, 118, 56,
-			61, 233, 78, 234, 132, 29, 159, 147, 147, 110, 135, 150, 28, 78,
-			78, 118, 246, 104, 137, 112, 114, 82, 217, 114, 7, 187, 217, 201,
-			129, 73, 157, 176, 163, 18, 62, 69, 199, 216, 223, 185, 204, 165,
-			46, 167, 103, 115, 247, 57, 133, 111, 187, 182, 100, 98, 53, 174,
-			148, 19, 203, 111, 229, 32, 13, 228, 166, 43, 237, 29, 143, 137,
-			197, 6, 48, 153, 178, 217, 7, 195, 42, 120, 16, 101, 140, 138,
-			220, 192, 166, 170, 243, 160, 63, 35, 42, 209, 98, 61, 168, 175,
-			235, 93, 113, 149, 202, 186, 76, 43, 0, 253, 6, 181, 90, 24,
-			212, 145, 92, 165, 77, 213, 22, 114, 116, 49, 92, 10, 26, 73,
-			216, 100, 232, 227, 69, 48, 77, 198, 138, 1, 199, 150, 163, 122,
-			136, 19, 67, 107, 65, 53, 170, 97, 231, 215, 249, 179, 203, 0,
-			72, 246, 75, 63, 169, 116, 246, 36, 54, 148, 137, 179, 83, 34,
-			105, 212, 151, 131, 37, 19, 168, 215, 138, 189, 145, 132, 204, 168,
-			66, 68, 213, 36, 13, 3, 233, 68, 204, 158, 154, 29, 141, 130,
-			106, 181, 177, 180, 20, 237, 23, 7, 15, 138, 160, 92, 150, 91,
-			202, 158, 47, 91, 11, 244, 246, 179, 249, 62, 220, 156, 239, 186,
-			57, 78, 230, 220, 129, 66, 168, 155, 113, 180, 73, 255, 144, 92,
-			16, 84, 42, 58, 154, 3, 87, 67, 23, 195, 176, 42, 30, 140,
-			49, 74, 16, 199, 142, 11, 47, 95, 24, 147, 195, 198, 116, 53,
-			172, 138, 133, 145, 224, 229, 181, 151, 47, 136, 90, 61, 172, 133,
-			213, 50, 158, 15, 6, 205, 195, 69, 55, 96, 78, 245, 124, 23,
-			219, 228, 156, 114, 3, 92, 108, 147, 115, 188, 159, 221, 203, 228,
-			213, 248, 228, 156, 219, 87, 184, 91, 174, 40, 39, 217, 94, 186,
-			5, 84, 38, 99, 160, 134, 115, 38, 99, 104, 179, 231, 218, 59,
-			181, 68, 56, 57, 215, 211, 139, 39, 82, 17, 78, 31, 200, 53,
-			156, 194, 221, 153, 236, 162, 172, 31, 168, 44, 139, 252, 21, 212,
-			31, 149, 209, 161, 109, 86, 157, 234, 158, 96, 218, 30, 200, 247,
-			177, 207, 1, 167, 16, 232, 159, 139, 110, 153, 22, 62, 42, 189,
-			65, 227, 225, 235, 153, 94, 149, 168, 54, 150, 224, 43, 155, 6,
-			156, 117, 26, 211, 88, 123, 109, 149, 117, 235, 43, 174, 53, 42,
-			105, 84, 179, 86, 15, 125, 84, 224, 115, 109, 82, 84, 135, 66,
-			6, 222, 200, 186, 15, 221, 3, 239, 207, 4, 107, 33, 136, 165,
-			179, 39, 231, 36, 197, 22, 75, 103, 79, 226, 83, 212, 22, 193,
-			42, 92, 84, 154, 36, 88, 133, 139, 237, 92, 75, 132, 147, 197,
-			65, 185, 200, 72, 208, 172, 44, 110, 43, 203, 222, 79, 140, 39,
-			183, 68, 139, 216, 2, 9, 152, 149, 11, 238, 26, 45, 148, 141,
-			58, 54, 211, 68, 43, 210, 64, 246, 192, 151, 1, 31, 30, 215,
-			163, 41, 248, 33, 89, 13, 234, 97, 249, 167, 160, 183, 188, 76,
-			254, 173, 58, 107, 22, 62, 52, 132, 11, 6, 62, 52, 132, 11,
-			237, 93, 90, 34, 156, 92, 232, 237, 83, 240, 193, 120, 93, 224,
-			107, 26, 190, 246, 17, 42, 180, 200, 66, 132, 239, 114, 90, 119,
-			83, 90, 184, 31, 225, 99, 48, 101, 188, 44, 180, 171, 4, 86,
-			32, 88, 209, 21, 24, 37, 186, 90, 116, 153, 208, 113, 19, 245,
-			70, 53, 25, 19, 15, 213, 146, 49, 52, 116, 231, 147, 232, 165,
-			225, 152, 8, 211, 165, 226, 126, 141, 24, 24, 187, 110, 16, 67,
-			199, 168, 183, 15, 105, 137, 112, 82, 223, 94, 80, 136, 129, 177,
-			235, 59, 82, 141, 216, 85, 136, 19, 90, 100, 7, 240, 124, 111,
-			239, 97, 24, 200, 21, 118, 55, 15, 109, 84, 147, 86, 202, 82,
-			77, 151, 58, 156, 60, 156, 239, 99, 191, 234, 226, 21, 251, 57,
-			238, 191, 218, 113, 95, 227, 208, 194, 27, 220, 27, 107, 40, 163,
-			138, 245, 20, 71, 49, 45, 157, 229, 34, 252, 191, 94, 94, 148,
-			51, 99, 20, 87, 28, 94, 237, 184, 121, 45, 58, 32, 182, 247,
-			104, 145, 128, 200, 251, 89, 143, 20, 243, 160, 204, 129, 215, 56,
-			132, 245, 177, 118, 124, 128, 21, 69, 127, 206, 161, 69, 246, 21,
-			169, 112, 135, 251, 111, 113, 220, 255, 232, 208, 194, 167, 221, 203,
-			14, 115, 52, 25, 61, 29, 35, 156, 38, 127, 238, 73, 135, 57,
-			44, 51, 206, 185, 236, 48, 71, 117, 118, 211, 99, 204, 120, 67,
-			117, 25, 150, 105, 147, 151, 25, 35, 44, 69, 87, 48, 58, 144,
-			181, 224, 120, 156, 190, 197, 214, 145, 227, 128, 216, 222, 173, 69,
-			2, 98, 31, 87, 117, 228, 228, 65, 255, 253, 255, 209, 212, 145,
-			234, 254, 244, 173, 80, 71, 143, 57, 88, 71, 46, 247, 223, 237,
-			184, 191, 238, 208, 194, 95, 57, 205, 19, 32, 66, 219, 165, 204,
-			28, 238, 198, 202, 194, 77, 247, 35, 35, 58, 246, 38, 208, 45,
-			85, 133, 223, 196, 151, 148, 46, 50, 110, 162, 78, 23, 52, 34,
-			59, 113, 10, 109, 186, 18, 199, 23, 4, 52, 106, 212, 206, 45,
-			34, 168, 198, 213, 245, 181, 184, 145, 28, 55, 127, 201, 31, 22,
-			227, 244, 120, 177, 88, 148, 130, 170, 119, 251, 64, 233, 205, 62,
-			64, 27, 11, 146, 210, 18, 30, 244, 111, 117, 8, 22, 252, 221,
-			78, 123, 159, 22, 9, 136, 3, 131, 74, 135, 110, 30, 244, 51,
-			244, 235, 70, 135, 202, 32, 209, 95, 3, 29, 254, 137, 212, 33,
-			225, 254, 251, 29, 247, 119, 29, 90, 248, 253, 203, 176, 162, 113,
-			195, 158, 34, 45, 42, 245, 94, 123, 94, 148, 133, 39, 30, 167,
-			239, 183, 170, 33, 14, 136, 237, 92, 139, 4, 196, 193, 33, 165,
-			26, 146, 135, 98, 111, 251, 93, 163, 26, 53, 178, 161, 31, 0,
-			213, 124, 94, 170, 134, 114, 255, 99, 142, 251, 9, 135, 22, 62,
-			226, 108, 96, 200, 13, 90, 193, 46, 171, 14, 175, 192, 192, 45,
-			197, 48, 205, 141, 11, 3, 212, 26, 203, 203, 209, 82, 36, 199,
-			144, 170, 65, 233, 45, 124, 170, 223, 30, 210, 237, 236, 144, 169,
-			14, 100, 165, 141, 166, 112, 100, 3, 211, 142, 224, 227, 17, 173,
-			23, 234, 113, 250, 49, 171, 23, 24, 178, 125, 204, 105, 239, 210,
-			34, 1, 177, 183, 79, 233, 133, 230, 161, 204, 252, 19, 70, 47,
-			84, 233, 229, 227, 160, 151, 37, 84, 139, 199, 253, 207, 58, 238,
-			231, 28, 90, 152, 187, 50, 226, 13, 46, 99, 68, 30, 170, 97,
-			167, 168, 55, 112, 247, 231, 137, 70, 84, 41, 39, 26, 181, 231,
-			113, 250, 89, 139, 218, 115, 64, 108, 31, 210, 34, 1, 113, 123,
-			65, 161, 246, 242, 0, 105, 199, 231, 12, 106, 79, 161, 254, 35,
-			135, 22, 23, 125, 92, 138, 56, 202, 254, 124, 156, 221, 118, 229,
-			235, 32, 210, 91, 184, 118, 75, 33, 133, 221, 114, 141, 254, 144,
-			94, 163, 63, 164, 87, 142, 212, 82, 201, 239, 17, 230, 75, 27,
-			207, 71, 89, 155, 58, 49, 2, 39, 193, 233, 137, 238, 199, 79,
-			116, 28, 105, 31, 254, 23, 249, 175, 167, 164, 127, 230, 207, 98,
-			30, 110, 238, 197, 185, 240, 141, 239, 201, 31, 249, 89, 230, 215,
-			195, 229, 168, 82, 193, 233, 240, 142, 35, 183, 111, 121, 205, 161,
-			88, 194, 239, 75, 42, 29, 62, 204, 218, 226, 26, 58, 16, 56,
-			115, 238, 149, 180, 200, 111, 101, 249, 74, 180, 28, 166, 145, 154,
-			48, 239, 56, 178, 189, 120, 185, 24, 175, 146, 121, 181, 240, 115,
-			14, 243, 101, 30, 252, 48, 243, 26, 213, 40, 77, 176, 236, 228,
-			196, 142, 199, 79, 12, 143, 12, 153, 50, 141, 190, 242, 21, 248,
-			239, 177, 127, 113, 110, 207, 149, 228, 155, 56, 227, 15, 12, 116,
-			49, 168, 160, 38, 186, 112, 246, 253, 22, 58, 252, 138, 223, 240,
-			74, 230, 39, 190, 151, 249, 241, 242, 114, 18, 166, 106, 189, 40,
-			243, 146, 250, 97, 100, 156, 181, 205, 170, 146, 116, 51, 54, 51,
-			123, 126, 246, 236, 252, 212, 236, 204, 92, 111, 142, 15, 178, 190,
-			137, 19, 115, 179, 211, 231, 230, 39, 207, 151, 38, 231, 102, 207,
-			149, 78, 78, 246, 58, 91, 155, 206, 127, 244, 128, 156, 206, 255,
-			223, 254, 117, 76, 231, 183, 227, 197, 60, 164, 45, 55, 170, 102,
-			246, 219, 237, 204, 126, 187, 153, 217, 239, 200, 29, 97, 127, 228,
-			224, 212, 190, 199, 161, 232, 48, 0, 155, 208, 99, 140, 122, 88,
-			131, 242, 96, 136, 139, 118, 133, 229, 136, 91, 117, 65, 198, 196,
-			68, 203, 176, 15, 29, 159, 116, 189, 166, 184, 38, 115, 102, 137,
-			44, 52, 152, 160, 117, 109, 162, 19, 72, 65, 175, 19, 224, 158,
-			142, 160, 114, 41, 88, 7, 210, 14, 202, 33, 148, 82, 5, 41,
-			195, 0, 96, 49, 72, 204, 250, 238, 166, 67, 74, 24, 112, 241,
-			124, 55, 251, 25, 189, 190, 176, 205, 221, 78, 11, 177, 228, 7,
-			115, 200, 128, 182, 133, 114, 150, 41, 73, 162, 149, 170, 140, 205,
-			52, 188, 113, 9, 6, 234, 203, 81, 61, 73, 193, 62, 134, 73,
-			162, 43, 138, 101, 167, 239, 0, 250, 189, 106, 213, 249, 185, 119,
-			137, 5, 236, 207, 11, 77, 179, 250, 219, 154, 102, 245, 183, 153,
-			137, 60, 24, 11, 110, 51, 19, 121, 48, 22, 220, 54, 184, 61,
-			59, 171, 239, 19, 78, 134, 233, 17, 246, 151, 121, 61, 171, 127,
-			216, 61, 74, 11, 95, 206, 99, 89, 84, 56, 190, 88, 12, 42,
-			65, 85, 58, 3, 90, 159, 198, 192, 235, 134, 213, 4, 119, 182,
-			22, 214, 213, 216, 2, 103, 1, 160, 30, 147, 8, 143, 114, 42,
-			135, 149, 52, 144, 149, 183, 24, 138, 165, 160, 86, 211, 17, 226,
-			81, 162, 79, 73, 87, 193, 220, 233, 106, 184, 174, 131, 109, 215,
-			197, 194, 217, 217, 233, 169, 147, 15, 156, 63, 51, 81, 58, 117,
-			126, 122, 234, 222, 169, 249, 133, 49, 59, 73, 191, 4, 117, 22,
-			62, 188, 20, 134, 101, 233, 223, 70, 137, 64, 69, 153, 172, 130,
-			42, 19, 97, 189, 30, 227, 252, 237, 212, 114, 211, 120, 112, 21,
-			231, 182, 12, 70, 105, 224, 198, 52, 105, 37, 122, 113, 222, 126,
-			0, 137, 50, 177, 82, 15, 202, 13, 108, 125, 203, 216, 24, 227,
-			108, 190, 208, 8, 49, 0, 64, 140, 46, 54, 20, 140, 106, 8,
-			15, 101, 242, 162, 22, 36, 41, 46, 5, 24, 143, 255, 126, 104,
-			15, 178, 165, 224, 17, 150, 162, 26, 94, 210, 205, 93, 182, 156,
-			240, 225, 40, 65, 7, 94, 55, 161, 40, 221, 7, 160, 146, 36,
-			90, 172, 224, 46, 109, 150, 109, 96, 186, 226, 210, 88, 233, 38,
-			163, 102, 200, 246, 116, 92, 23, 161, 164, 227, 49, 145, 4, 235,
-			217, 111, 87, 131, 178, 8, 178, 85, 127, 120, 124, 92, 213, 118,
-			96, 251, 96, 186, 202, 68, 160, 75, 140, 239, 200, 217, 60, 156,
-			131, 90, 143, 27, 34, 9, 211, 230, 130, 168, 6, 97, 62, 185,
-			117, 188, 40, 166, 170, 106, 172, 1, 213, 56, 214, 60, 172, 82,
-			0, 234, 225, 26, 206, 239, 6, 41, 100, 82, 20, 103, 226, 75,
-			160, 204, 230, 151, 47, 233, 77, 140, 76, 172, 4, 106, 177, 35,
-			40, 151, 35, 121, 244, 150, 86, 188, 181, 133, 25, 88, 141, 106,
-			26, 85, 4, 180, 151, 32, 17, 139, 245, 184, 1, 102, 24, 134,
-			9, 151, 152, 184, 117, 92, 140, 234, 215, 17, 247, 254, 236, 16,
-			175, 28, 87, 67, 161, 167, 39, 155, 198, 213, 141, 68, 143, 181,
-			154, 167, 15, 193, 67, 52, 103, 108, 46, 55, 160, 41, 45, 197,
-			213, 36, 74, 210, 176, 170, 53, 36, 119, 59, 40, 112, 75, 171,
-			49, 140, 165, 165, 51, 9, 221, 2, 147, 88, 90, 141, 99, 200,
-			82, 191, 5, 118, 171, 204, 68, 92, 149, 238, 181, 88, 11, 193,
-			20, 37, 171, 81, 77, 31, 238, 4, 117, 31, 87, 130, 52, 130,
-			250, 142, 235, 242, 244, 161, 172, 251, 94, 141, 97, 60, 10, 137,
-			3, 54, 38, 86, 226, 52, 13, 171, 106, 118, 82, 71, 185, 164,
-			177, 192, 162, 102, 213, 167, 87, 30, 46, 173, 70, 149, 80, 196,
-			233, 106, 88, 151, 83, 130, 213, 125, 169, 93, 116, 112, 60, 78,
-			14, 55, 45, 14, 29, 110, 90, 28, 58, 220, 180, 56, 116, 184,
-			255, 104, 118, 113, 8, 108, 213, 17, 122, 132, 253, 5, 46, 235,
-			146, 28, 167, 207, 117, 107, 164, 240, 168, 43, 164, 211, 144, 57,
-			179, 126, 53, 190, 100, 45, 213, 6, 11, 165, 219, 193, 104, 92,
-			23, 229, 122, 16, 85, 247, 99, 103, 101, 50, 92, 71, 45, 63,
-			168, 52, 107, 245, 24, 12, 179, 88, 139, 214, 162, 37, 60, 156,
-			172, 30, 87, 199, 68, 146, 6, 245, 84, 30, 51, 43, 206, 205,
-			159, 20, 107, 81, 185, 138, 196, 125, 64, 72, 39, 98, 76, 92,
-			10, 46, 224, 81, 154, 141, 154, 128, 134, 186, 46, 22, 180, 15,
-			178, 32, 146, 112, 41, 174, 202, 57, 235, 160, 92, 22, 11, 232,
-			193, 44, 232, 141, 50, 173, 77, 127, 180, 81, 19, 105, 204, 180,
-			229, 223, 95, 212, 83, 3, 136, 48, 214, 182, 86, 70, 75, 173,
-			130, 69, 5, 187, 139, 182, 196, 118, 103, 244, 193, 83, 180, 86,
-			234, 168, 202, 96, 41, 85, 179, 180, 99, 160, 48, 0, 169, 43,
-			138, 0, 141, 60, 151, 245, 178, 255, 76, 152, 15, 34, 144, 220,
-			25, 58, 221, 86, 248, 24, 105, 97, 57, 132, 174, 11, 178, 161,
-			0, 85, 44, 64, 107, 249, 181, 142, 235, 205, 58, 134, 190, 20,
-			37, 75, 245, 48, 13, 239, 20, 167, 235, 241, 154, 152, 31, 47,
-			22, 231, 199, 15, 232, 15, 199, 68, 85, 198, 228, 226, 28, 119,
-			18, 170, 204, 209, 180, 202, 149, 3, 237, 174, 180, 168, 176, 40,
-			38, 82, 209, 148, 80, 0, 157, 14, 87, 244, 108, 18, 64, 18,
-			101, 57, 83, 158, 57, 157, 92, 90, 216, 176, 190, 22, 165, 169,
-			164, 42, 236, 182, 208, 250, 87, 130, 12, 93, 100, 107, 81, 118,
-			158, 22, 16, 137, 108, 108, 98, 49, 88, 186, 0, 47, 141, 91,
-			138, 64, 74, 130, 124, 192, 42, 216, 188, 244, 226, 131, 209, 241,
-			98, 40, 198, 197, 232, 131, 224, 12, 196, 96, 62, 83, 219, 80,
-			213, 94, 52, 38, 96, 204, 88, 15, 43, 106, 11, 24, 186, 115,
-			73, 8, 93, 176, 155, 181, 201, 170, 244, 56, 57, 67, 219, 173,
-			236, 112, 114, 134, 245, 88, 153, 112, 114, 6, 39, 202, 148, 156,
-			231, 244, 204, 192, 180, 207, 56, 99, 234, 193, 7, 243, 148, 211,
-			51, 131, 211, 30, 251, 66, 94, 53, 16, 135, 211, 101, 186, 234,
-			23, 126, 47, 223, 210, 64, 116, 91, 95, 12, 211, 75, 97, 88,
-			213, 218, 10, 47, 130, 187, 55, 38, 146, 245, 42, 248, 205, 85,
-			60, 23, 49, 141, 155, 122, 19, 19, 7, 196, 130, 236, 79, 11,
-			89, 214, 142, 18, 49, 142, 115, 98, 90, 229, 72, 204, 146, 188,
-			91, 8, 65, 213, 107, 90, 151, 251, 98, 131, 68, 68, 184, 123,
-			89, 251, 127, 192, 252, 170, 95, 73, 35, 45, 171, 2, 187, 75,
-			85, 250, 11, 246, 176, 6, 93, 3, 104, 160, 77, 245, 43, 16,
-			69, 49, 31, 139, 96, 105, 53, 10, 47, 202, 89, 207, 49, 38,
-			30, 148, 103, 60, 93, 8, 155, 41, 51, 75, 127, 178, 32, 213,
-			88, 213, 35, 100, 95, 202, 106, 72, 196, 75, 75, 141, 250, 6,
-			53, 141, 104, 29, 141, 64, 61, 159, 155, 63, 89, 20, 115, 177,
-			136, 150, 13, 231, 74, 62, 77, 99, 113, 219, 248, 152, 100, 99,
-			220, 118, 183, 22, 85, 27, 105, 120, 48, 88, 78, 195, 250, 193,
-			115, 243, 39, 15, 234, 116, 54, 209, 219, 10, 158, 81, 105, 139,
-			135, 227, 11, 131, 67, 218, 28, 40, 92, 162, 232, 205, 70, 51,
-			200, 22, 25, 215, 67, 38, 106, 245, 176, 28, 45, 165, 193, 98,
-			197, 152, 86, 89, 163, 50, 52, 1, 170, 59, 74, 116, 192, 100,
-			69, 175, 231, 226, 142, 184, 166, 85, 8, 156, 49, 77, 99, 123,
-			220, 110, 77, 123, 243, 42, 2, 160, 30, 74, 112, 122, 229, 201,
-			206, 117, 163, 19, 246, 210, 184, 26, 38, 251, 181, 115, 2, 186,
-			129, 33, 71, 99, 45, 88, 196, 76, 193, 111, 80, 235, 120, 98,
-			49, 52, 132, 39, 113, 4, 208, 39, 15, 218, 238, 156, 68, 201,
-			24, 232, 26, 188, 5, 140, 33, 169, 134, 161, 178, 27, 168, 164,
-			123, 207, 205, 205, 99, 245, 225, 153, 139, 120, 186, 207, 145, 99,
-			171, 98, 244, 246, 219, 142, 141, 143, 239, 47, 54, 187, 93, 65,
-			85, 104, 179, 4, 237, 225, 57, 135, 5, 128, 155, 153, 157, 23,
-			179, 47, 52, 110, 69, 164, 125, 27, 149, 156, 28, 170, 172, 203,
-			113, 203, 225, 35, 135, 111, 43, 222, 97, 82, 73, 198, 196, 90,
-			24, 84, 145, 124, 204, 44, 117, 61, 92, 174, 135, 201, 170, 216,
-			183, 180, 190, 84, 9, 247, 169, 18, 131, 185, 89, 138, 235, 106,
-			201, 20, 134, 96, 41, 48, 154, 97, 51, 105, 184, 203, 193, 122,
-			145, 137, 137, 22, 156, 71, 196, 212, 156, 136, 47, 136, 116, 21,
-			124, 165, 177, 44, 212, 166, 162, 39, 77, 96, 153, 56, 124, 100,
-			124, 220, 192, 169, 133, 245, 40, 46, 39, 25, 235, 4, 46, 194,
-			50, 205, 200, 14, 39, 203, 29, 253, 86, 38, 156, 44, 15, 109,
-			51, 214, 9, 28, 133, 229, 225, 85, 143, 113, 214, 161, 30, 200,
-			24, 137, 21, 127, 63, 251, 170, 163, 172, 147, 203, 233, 26, 141,
-			253, 194, 103, 29, 40, 71, 107, 3, 212, 229, 85, 109, 92, 27,
-			13, 61, 158, 12, 19, 104, 190, 81, 178, 42, 73, 21, 151, 146,
-			131, 36, 137, 151, 34, 180, 41, 198, 209, 216, 135, 94, 129, 242,
-			220, 202, 193, 250, 62, 25, 162, 211, 114, 162, 102, 4, 213, 190,
-			22, 226, 106, 136, 168, 55, 202, 17, 252, 13, 94, 33, 52, 83,
-			104, 165, 34, 168, 68, 43, 85, 120, 138, 150, 167, 169, 111, 101,
-			53, 229, 122, 156, 172, 101, 52, 229, 58, 156, 172, 117, 244, 89,
-			153, 112, 178, 134, 19, 193, 74, 206, 115, 186, 54, 20, 91, 77,
-			233, 104, 146, 170, 191, 159, 117, 168, 128, 27, 242, 144, 59, 160,
-			35, 81, 124, 144, 178, 81, 42, 15, 153, 112, 49, 72, 251, 33,
-			222, 143, 161, 9, 57, 154, 227, 52, 113, 255, 61, 145, 191, 225,
-			232, 56, 105, 235, 102, 93, 160, 123, 28, 31, 147, 148, 170, 10,
-			84, 35, 212, 148, 118, 91, 217, 229, 36, 237, 227, 236, 181, 84,
-			189, 239, 112, 242, 114, 90, 40, 252, 47, 34, 166, 154, 67, 115,
-			178, 46, 220, 18, 112, 167, 60, 5, 86, 77, 121, 224, 20, 164,
-			180, 5, 217, 233, 132, 42, 179, 7, 32, 63, 212, 8, 228, 130,
-			128, 116, 164, 51, 231, 1, 226, 188, 35, 88, 210, 229, 138, 52,
-			134, 58, 130, 59, 88, 147, 145, 6, 203, 76, 36, 105, 92, 15,
-			86, 100, 69, 170, 229, 193, 162, 173, 252, 229, 74, 176, 178, 98,
-			163, 212, 163, 68, 200, 217, 176, 204, 198, 162, 181, 160, 170, 252,
-			175, 108, 112, 194, 197, 40, 144, 166, 183, 188, 22, 85, 197, 196,
-			217, 169, 49, 236, 63, 106, 249, 92, 114, 146, 30, 136, 22, 49,
-			156, 108, 129, 73, 31, 1, 15, 251, 43, 138, 137, 236, 144, 67,
-			42, 160, 26, 170, 150, 251, 112, 13, 70, 8, 251, 176, 103, 239,
-			19, 203, 141, 234, 146, 58, 133, 56, 93, 151, 131, 69, 233, 71,
-			233, 3, 3, 229, 235, 178, 1, 199, 151, 170, 34, 172, 150, 229,
-			229, 45, 150, 3, 71, 227, 250, 152, 136, 202, 161, 12, 220, 40,
-			199, 213, 125, 208, 144, 43, 149, 248, 146, 74, 203, 104, 36, 141,
-			197, 74, 152, 138, 184, 129, 60, 7, 182, 89, 150, 169, 30, 202,
-			252, 205, 230, 239, 122, 146, 138, 90, 37, 88, 10, 153, 56, 190,
-			127, 63, 51, 45, 195, 193, 150, 48, 104, 101, 151, 147, 151, 15,
-			111, 103, 7, 117, 228, 211, 43, 29, 119, 160, 176, 71, 156, 136,
-			210, 75, 81, 18, 30, 156, 45, 65, 70, 106, 234, 174, 121, 183,
-			43, 198, 59, 193, 251, 109, 90, 116, 64, 204, 247, 104, 17, 83,
-			227, 253, 236, 85, 50, 188, 145, 114, 250, 6, 199, 29, 45, 252,
-			131, 116, 119, 77, 43, 144, 92, 130, 173, 209, 148, 115, 73, 249,
-			22, 182, 230, 85, 27, 85, 90, 173, 193, 208, 40, 1, 45, 1,
-			233, 138, 197, 16, 198, 13, 80, 145, 105, 88, 45, 138, 73, 96,
-			229, 217, 154, 166, 187, 12, 135, 130, 129, 84, 214, 19, 114, 141,
-			27, 118, 33, 118, 73, 175, 195, 154, 72, 55, 196, 32, 210, 112,
-			173, 22, 227, 16, 179, 217, 106, 200, 161, 33, 228, 129, 13, 127,
-			234, 44, 51, 231, 153, 41, 246, 108, 164, 241, 90, 144, 170, 185,
-			180, 149, 160, 190, 8, 45, 125, 41, 174, 84, 194, 165, 84, 1,
-			15, 196, 82, 88, 199, 67, 107, 181, 203, 58, 97, 247, 244, 143,
-			35, 231, 160, 211, 180, 47, 170, 46, 71, 213, 40, 13, 247, 153,
-			210, 232, 41, 94, 57, 140, 86, 19, 215, 251, 139, 202, 233, 173,
-			135, 75, 241, 218, 26, 6, 169, 64, 89, 106, 209, 210, 5, 57,
-			50, 69, 246, 193, 11, 241, 84, 70, 166, 21, 214, 3, 60, 154,
-			27, 119, 144, 128, 127, 19, 235, 54, 88, 10, 203, 210, 81, 171,
-			213, 27, 48, 84, 168, 100, 236, 51, 90, 239, 76, 212, 163, 40,
-			199, 173, 69, 197, 211, 154, 85, 155, 160, 62, 54, 130, 130, 22,
-			29, 16, 119, 220, 164, 69, 2, 226, 205, 251, 204, 210, 194, 31,
-			244, 179, 231, 92, 249, 210, 130, 94, 15, 217, 242, 218, 194, 158,
-			214, 37, 132, 84, 111, 243, 83, 107, 12, 151, 93, 124, 184, 154,
-			13, 32, 133, 171, 92, 45, 25, 121, 45, 97, 109, 74, 241, 252,
-			40, 107, 83, 67, 32, 53, 157, 191, 253, 241, 19, 67, 35, 3,
-			155, 77, 231, 151, 244, 155, 252, 36, 99, 141, 26, 160, 47, 159,
-			79, 19, 181, 79, 162, 176, 97, 21, 193, 236, 113, 196, 221, 16,
-			31, 118, 220, 188, 83, 106, 87, 223, 205, 39, 124, 134, 245, 74,
-			84, 231, 151, 86, 131, 234, 74, 8, 73, 145, 45, 36, 213, 45,
-			191, 62, 137, 31, 207, 39, 252, 167, 25, 83, 233, 213, 195, 101,
-			92, 245, 184, 154, 205, 27, 165, 112, 57, 179, 121, 163, 189, 102,
-			118, 109, 204, 49, 95, 10, 106, 213, 228, 240, 150, 147, 206, 238,
-			52, 145, 73, 109, 109, 109, 226, 111, 187, 152, 207, 41, 205, 29,
-			255, 87, 176, 52, 177, 223, 46, 77, 200, 85, 10, 151, 147, 246,
-			220, 93, 248, 39, 225, 132, 201, 181, 137, 60, 39, 157, 118, 193,
-			162, 211, 44, 88, 116, 231, 142, 176, 127, 146, 11, 22, 116, 48,
-			119, 220, 41, 124, 15, 93, 71, 107, 159, 141, 139, 145, 221, 193,
-			150, 164, 96, 134, 49, 20, 77, 173, 86, 100, 22, 62, 237, 156,
-			66, 32, 86, 34, 224, 248, 70, 18, 214, 165, 187, 41, 231, 20,
-			170, 102, 137, 24, 44, 29, 14, 216, 154, 253, 155, 72, 14, 227,
-			152, 190, 90, 100, 20, 87, 138, 199, 236, 130, 253, 126, 227, 242,
-			175, 155, 89, 30, 100, 38, 197, 52, 58, 253, 180, 30, 44, 93,
-			72, 152, 217, 71, 139, 115, 152, 106, 178, 196, 46, 114, 12, 230,
-			123, 216, 187, 136, 94, 229, 216, 229, 10, 175, 240, 139, 146, 17,
-			117, 129, 171, 141, 181, 176, 30, 45, 105, 134, 180, 73, 152, 32,
-			150, 80, 196, 229, 242, 193, 138, 138, 12, 198, 113, 175, 92, 129,
-			201, 70, 116, 234, 228, 140, 115, 44, 71, 151, 141, 36, 76, 152,
-			152, 110, 4, 183, 22, 15, 139, 81, 115, 160, 94, 84, 149, 118,
-			126, 127, 198, 65, 86, 5, 139, 170, 43, 122, 178, 19, 252, 181,
-			48, 81, 94, 158, 186, 108, 38, 110, 44, 86, 240, 120, 47, 156,
-			156, 195, 172, 245, 34, 71, 18, 44, 135, 168, 178, 149, 176, 174,
-			110, 87, 197, 9, 181, 91, 143, 138, 197, 8, 239, 55, 156, 210,
-			131, 176, 69, 24, 215, 234, 57, 119, 57, 245, 85, 151, 11, 22,
-			153, 85, 135, 116, 85, 29, 238, 30, 215, 47, 224, 109, 175, 48,
-			50, 55, 83, 71, 33, 30, 33, 140, 222, 105, 203, 108, 31, 118,
-			135, 67, 114, 106, 8, 29, 170, 116, 21, 60, 129, 24, 15, 8,
-			172, 85, 194, 32, 9, 69, 37, 76, 69, 35, 193, 203, 17, 154,
-			214, 129, 118, 185, 109, 153, 117, 160, 93, 249, 236, 238, 142, 93,
-			77, 187, 59, 118, 13, 8, 202, 122, 89, 158, 154, 233, 156, 93,
-			131, 130, 176, 143, 56, 77, 187, 59, 222, 35, 99, 30, 12, 197,
-			152, 1, 144, 88, 80, 112, 101, 152, 124, 37, 72, 82, 161, 204,
-			174, 113, 82, 86, 131, 68, 172, 69, 75, 245, 88, 206, 0, 65,
-			65, 27, 245, 0, 198, 242, 48, 6, 175, 154, 206, 96, 198, 130,
-			217, 229, 57, 121, 236, 146, 9, 224, 47, 11, 188, 195, 32, 60,
-			168, 210, 130, 94, 81, 105, 64, 27, 201, 76, 46, 251, 156, 220,
-			236, 238, 200, 238, 60, 216, 121, 115, 118, 231, 193, 254, 91, 178,
-			59, 15, 14, 52, 237, 60, 24, 114, 228, 206, 131, 21, 189, 241,
-			224, 160, 123, 136, 22, 30, 216, 188, 248, 54, 158, 97, 159, 89,
-			17, 66, 21, 72, 150, 121, 114, 21, 216, 157, 0, 62, 39, 7,
-			13, 102, 24, 250, 28, 220, 57, 150, 25, 99, 29, 60, 52, 158,
-			217, 9, 112, 240, 240, 161, 236, 78, 0, 192, 92, 164, 69, 54,
-			171, 253, 225, 35, 238, 49, 90, 152, 208, 83, 171, 102, 250, 80,
-			207, 60, 201, 133, 50, 51, 211, 146, 137, 197, 203, 198, 70, 217,
-			29, 2, 62, 39, 71, 84, 136, 185, 220, 33, 112, 164, 115, 40,
-			179, 67, 224, 200, 246, 66, 102, 135, 192, 145, 29, 199, 178, 59,
-			4, 250, 92, 78, 142, 210, 49, 133, 141, 114, 250, 28, 247, 14,
-			192, 54, 87, 13, 106, 201, 106, 156, 182, 4, 153, 110, 21, 27,
-			245, 57, 121, 142, 25, 155, 82, 135, 147, 231, 152, 177, 41, 37,
-			156, 60, 199, 52, 118, 154, 231, 244, 57, 3, 119, 104, 108, 84,
-			97, 187, 157, 142, 25, 207, 237, 143, 238, 96, 71, 174, 220, 205,
-			137, 107, 215, 48, 32, 228, 106, 124, 178, 145, 95, 160, 204, 157,
-			173, 129, 51, 162, 90, 161, 222, 41, 185, 53, 103, 196, 236, 183,
-			204, 58, 35, 42, 197, 169, 50, 63, 203, 148, 103, 2, 169, 75,
-			255, 235, 232, 150, 253, 145, 169, 83, 165, 188, 76, 101, 170, 204,
-			31, 96, 29, 250, 70, 159, 243, 105, 140, 142, 88, 247, 150, 226,
-			80, 102, 107, 197, 146, 74, 96, 62, 46, 177, 186, 249, 155, 23,
-			153, 135, 11, 203, 232, 147, 145, 19, 195, 143, 159, 24, 28, 233,
-			31, 254, 218, 255, 252, 205, 63, 120, 243, 107, 220, 209, 247, 191,
-			241, 155, 95, 252, 187, 127, 254, 255, 254, 229, 95, 156, 146, 124,
-			45, 27, 187, 2, 174, 86, 151, 137, 93, 25, 57, 201, 152, 205,
-			131, 247, 179, 158, 147, 231, 74, 165, 201, 153, 249, 243, 39, 38,
-			166, 39, 102, 78, 78, 246, 230, 120, 158, 209, 23, 79, 150, 102,
-			123, 29, 222, 193, 218, 78, 77, 158, 158, 56, 55, 61, 223, 235,
-			242, 118, 230, 225, 178, 116, 47, 25, 137, 46, 31, 65, 50, 204,
-			6, 166, 238, 153, 153, 45, 77, 158, 87, 235, 217, 39, 102, 207,
-			205, 156, 154, 235, 117, 248, 54, 214, 127, 106, 246, 252, 204, 236,
-			252, 249, 147, 19, 103, 207, 159, 45, 205, 158, 157, 157, 155, 60,
-			213, 235, 242, 29, 108, 219, 253, 83, 243, 103, 244, 7, 152, 201,
-			249, 83, 147, 211, 243, 19, 189, 116, 107, 238, 221, 87, 142, 202,
-			208, 147, 119, 255, 171, 10, 61, 201, 231, 238, 82, 158, 28, 179,
-			158, 28, 51, 158, 92, 103, 238, 8, 123, 175, 9, 61, 121, 183,
-			227, 20, 222, 225, 216, 104, 18, 187, 124, 150, 198, 106, 247, 156,
-			90, 197, 146, 126, 5, 122, 116, 214, 159, 121, 97, 24, 214, 112,
-			219, 139, 153, 206, 40, 5, 151, 102, 179, 11, 172, 233, 106, 211,
-			92, 84, 102, 221, 77, 212, 130, 68, 142, 215, 213, 210, 19, 18,
-			166, 168, 52, 2, 145, 44, 213, 163, 90, 218, 20, 94, 194, 216,
-			49, 237, 120, 13, 184, 67, 180, 112, 115, 211, 142, 65, 244, 58,
-			112, 121, 193, 30, 173, 183, 28, 215, 173, 55, 224, 115, 50, 96,
-			140, 55, 164, 56, 96, 140, 55, 120, 3, 3, 198, 120, 131, 55,
-			48, 176, 99, 40, 27, 21, 2, 6, 114, 144, 142, 201, 184, 115,
-			156, 144, 219, 227, 110, 47, 188, 73, 198, 64, 215, 244, 122, 57,
-			212, 170, 218, 33, 217, 4, 166, 197, 161, 49, 11, 55, 230, 83,
-			21, 90, 107, 142, 210, 80, 87, 34, 161, 31, 172, 29, 66, 197,
-			11, 65, 165, 30, 6, 229, 117, 92, 209, 136, 213, 212, 107, 38,
-			30, 121, 170, 57, 14, 179, 28, 135, 73, 117, 95, 106, 190, 194,
-			104, 9, 149, 250, 108, 77, 102, 176, 28, 68, 21, 181, 8, 163,
-			215, 119, 228, 228, 94, 144, 54, 18, 113, 239, 212, 220, 220, 212,
-			204, 61, 231, 39, 78, 158, 156, 61, 55, 51, 111, 66, 81, 50,
-			118, 11, 224, 67, 247, 111, 242, 59, 246, 184, 44, 227, 119, 236,
-			233, 24, 200, 248, 29, 123, 182, 13, 219, 121, 210, 189, 238, 221,
-			217, 121, 210, 189, 109, 125, 236, 207, 28, 59, 81, 122, 51, 29,
-			46, 124, 202, 201, 76, 67, 203, 22, 121, 10, 131, 102, 22, 101,
-			48, 67, 117, 69, 68, 105, 203, 82, 235, 62, 179, 132, 210, 228,
-			130, 219, 69, 204, 102, 69, 65, 13, 132, 151, 228, 244, 244, 130,
-			29, 209, 74, 103, 46, 150, 171, 143, 153, 90, 97, 155, 105, 109,
-			115, 141, 21, 89, 211, 68, 239, 205, 217, 137, 95, 151, 147, 155,
-			135, 182, 177, 159, 115, 236, 68, 239, 45, 180, 171, 112, 241, 10,
-			139, 251, 210, 176, 30, 223, 41, 223, 13, 151, 151, 195, 165, 84,
-			158, 127, 36, 111, 180, 2, 239, 26, 151, 187, 246, 225, 76, 103,
-			102, 225, 59, 141, 165, 31, 110, 194, 183, 117, 244, 75, 118, 154,
-			241, 22, 154, 111, 154, 102, 188, 165, 163, 147, 253, 60, 81, 56,
-			93, 78, 142, 209, 222, 194, 63, 187, 91, 168, 23, 213, 212, 23,
-			212, 92, 215, 130, 141, 185, 193, 52, 96, 96, 35, 253, 104, 243,
-			130, 60, 94, 164, 57, 178, 56, 83, 173, 50, 193, 49, 92, 190,
-			84, 85, 182, 32, 187, 132, 108, 220, 145, 169, 184, 162, 152, 77,
-			87, 195, 250, 165, 40, 9, 55, 233, 88, 38, 67, 214, 156, 99,
-			53, 188, 148, 9, 182, 107, 89, 1, 85, 17, 31, 152, 154, 101,
-			71, 101, 118, 116, 179, 208, 10, 87, 91, 49, 65, 31, 213, 88,
-			111, 147, 211, 187, 149, 53, 156, 122, 152, 52, 42, 169, 220, 144,
-			220, 220, 160, 226, 101, 161, 136, 174, 52, 249, 83, 231, 166, 74,
-			147, 167, 50, 117, 5, 109, 230, 24, 237, 176, 50, 212, 77, 119,
-			15, 251, 160, 174, 43, 194, 201, 243, 105, 119, 225, 93, 228, 42,
-			234, 74, 45, 3, 171, 154, 18, 231, 18, 105, 181, 211, 236, 110,
-			26, 181, 124, 107, 22, 127, 49, 213, 77, 170, 85, 165, 181, 149,
-			74, 85, 159, 92, 77, 157, 202, 79, 111, 200, 26, 5, 2, 127,
-			190, 142, 70, 0, 217, 229, 228, 249, 157, 93, 236, 126, 189, 32,
-			117, 194, 221, 85, 120, 65, 102, 207, 182, 220, 165, 141, 110, 28,
-			46, 252, 155, 75, 34, 211, 120, 76, 14, 208, 244, 168, 81, 143,
-			240, 65, 17, 214, 42, 218, 129, 215, 9, 183, 51, 51, 240, 58,
-			209, 53, 156, 25, 120, 157, 216, 177, 147, 253, 215, 78, 61, 176,
-			42, 187, 43, 94, 225, 143, 58, 85, 27, 82, 36, 127, 153, 24,
-			152, 81, 40, 120, 99, 49, 173, 7, 75, 233, 126, 123, 109, 253,
-			66, 134, 66, 22, 208, 5, 88, 49, 145, 113, 11, 181, 122, 92,
-			139, 147, 176, 188, 96, 102, 1, 244, 216, 77, 206, 19, 53, 83,
-			94, 230, 117, 53, 31, 33, 111, 188, 182, 1, 140, 173, 17, 68,
-			24, 199, 134, 19, 229, 182, 83, 232, 112, 72, 53, 85, 33, 171,
-			234, 220, 204, 169, 201, 210, 233, 233, 217, 251, 213, 222, 147, 205,
-			92, 85, 245, 189, 90, 242, 145, 45, 53, 141, 21, 136, 77, 130,
-			111, 32, 37, 97, 122, 204, 229, 202, 32, 99, 22, 54, 66, 15,
-			22, 227, 139, 218, 29, 40, 98, 59, 111, 34, 38, 189, 102, 127,
-			185, 207, 211, 184, 233, 219, 173, 23, 235, 9, 113, 201, 162, 109,
-			146, 193, 38, 142, 188, 76, 95, 79, 121, 153, 104, 18, 211, 89,
-			178, 213, 48, 123, 159, 174, 5, 38, 38, 164, 185, 146, 241, 159,
-			129, 137, 207, 104, 54, 67, 26, 21, 192, 126, 161, 236, 153, 152,
-			93, 84, 93, 170, 227, 140, 145, 188, 101, 75, 55, 135, 245, 76,
-			219, 82, 51, 119, 163, 73, 67, 238, 166, 86, 70, 81, 158, 92,
-			140, 165, 220, 95, 100, 98, 78, 181, 104, 128, 34, 207, 148, 104,
-			5, 83, 197, 112, 166, 131, 114, 250, 235, 50, 120, 152, 40, 135,
-			22, 208, 83, 192, 195, 196, 108, 45, 209, 203, 137, 214, 53, 107,
-			25, 171, 137, 184, 142, 174, 154, 14, 177, 209, 235, 114, 161, 10,
-			189, 4, 99, 182, 8, 67, 141, 160, 30, 133, 137, 138, 49, 145,
-			86, 6, 6, 34, 229, 122, 92, 83, 93, 7, 124, 15, 72, 109,
-			37, 198, 45, 207, 0, 232, 82, 176, 46, 48, 48, 79, 234, 182,
-			53, 86, 26, 176, 197, 13, 48, 186, 73, 67, 34, 182, 222, 152,
-			193, 46, 205, 152, 184, 235, 46, 49, 190, 128, 211, 94, 149, 112,
-			37, 74, 163, 181, 32, 13, 199, 228, 20, 176, 140, 18, 209, 59,
-			202, 18, 17, 168, 117, 54, 51, 88, 9, 50, 118, 222, 100, 26,
-			62, 92, 171, 68, 75, 17, 238, 131, 143, 203, 209, 242, 58, 6,
-			131, 192, 191, 140, 218, 139, 155, 37, 90, 15, 237, 161, 25, 160,
-			181, 68, 78, 88, 234, 72, 61, 21, 236, 137, 42, 26, 53, 83,
-			120, 58, 233, 168, 233, 34, 100, 233, 164, 97, 142, 241, 162, 28,
-			49, 218, 245, 194, 12, 142, 59, 229, 162, 36, 170, 226, 184, 76,
-			106, 92, 44, 199, 208, 17, 229, 6, 34, 249, 139, 56, 172, 46,
-			96, 147, 59, 197, 112, 57, 241, 82, 246, 112, 112, 249, 124, 169,
-			177, 214, 144, 13, 66, 38, 165, 80, 4, 137, 12, 198, 210, 105,
-			237, 223, 172, 240, 251, 210, 184, 177, 180, 186, 79, 182, 109, 236,
-			87, 170, 137, 143, 201, 216, 152, 84, 250, 13, 137, 176, 43, 83,
-			170, 232, 0, 6, 207, 177, 168, 174, 139, 90, 156, 134, 213, 52,
-			10, 42, 80, 13, 145, 30, 92, 70, 107, 54, 188, 18, 15, 223,
-			40, 155, 185, 90, 160, 192, 114, 190, 43, 51, 181, 86, 86, 27,
-			160, 229, 212, 90, 153, 175, 232, 185, 90, 34, 231, 106, 203, 253,
-			43, 4, 111, 212, 205, 81, 135, 123, 15, 186, 191, 234, 144, 194,
-			148, 26, 161, 234, 245, 239, 72, 94, 165, 171, 151, 53, 49, 204,
-			33, 74, 15, 74, 23, 65, 46, 142, 203, 253, 182, 56, 198, 133,
-			62, 32, 111, 192, 208, 32, 241, 92, 139, 7, 219, 186, 217, 157,
-			232, 90, 57, 48, 60, 169, 208, 254, 194, 152, 56, 37, 61, 72,
-			153, 200, 157, 208, 177, 162, 53, 121, 46, 233, 98, 184, 26, 92,
-			140, 226, 186, 154, 8, 53, 52, 47, 143, 190, 168, 152, 168, 15,
-			7, 7, 3, 149, 62, 206, 30, 165, 42, 117, 135, 147, 151, 210,
-			93, 133, 79, 82, 96, 7, 12, 160, 213, 70, 24, 231, 4, 12,
-			185, 180, 78, 151, 171, 240, 2, 236, 198, 201, 157, 98, 194, 144,
-			32, 83, 108, 109, 66, 59, 85, 131, 106, 162, 170, 214, 212, 36,
-			219, 96, 135, 110, 166, 28, 121, 72, 73, 179, 219, 119, 69, 73,
-			179, 22, 18, 6, 75, 98, 167, 19, 74, 225, 74, 80, 47, 227,
-			16, 211, 76, 163, 200, 118, 54, 166, 195, 0, 51, 27, 7, 84,
-			136, 121, 118, 108, 195, 192, 36, 41, 29, 252, 155, 241, 177, 38,
-			212, 63, 141, 49, 222, 27, 62, 210, 163, 227, 168, 42, 146, 198,
-			210, 170, 80, 115, 28, 76, 110, 157, 17, 247, 206, 150, 38, 155,
-			181, 170, 111, 66, 168, 217, 40, 227, 0, 123, 149, 244, 131, 76,
-			165, 199, 203, 218, 47, 195, 40, 70, 229, 235, 169, 133, 118, 224,
-			135, 76, 164, 114, 211, 54, 24, 12, 167, 140, 179, 49, 85, 217,
-			157, 47, 106, 242, 196, 156, 3, 42, 223, 223, 132, 97, 51, 205,
-			205, 193, 230, 52, 108, 101, 151, 147, 151, 238, 216, 201, 190, 224,
-			169, 230, 230, 114, 250, 122, 135, 238, 44, 252, 158, 103, 218, 155,
-			113, 103, 103, 107, 251, 146, 13, 27, 86, 116, 196, 133, 218, 177,
-			162, 40, 75, 79, 254, 99, 35, 97, 98, 98, 230, 148, 241, 56,
-			149, 43, 173, 191, 187, 236, 161, 151, 170, 121, 52, 79, 83, 48,
-			81, 171, 199, 229, 198, 210, 21, 248, 7, 77, 193, 132, 45, 27,
-			77, 229, 190, 22, 137, 228, 240, 248, 134, 216, 211, 195, 183, 130,
-			5, 168, 175, 201, 160, 164, 160, 42, 102, 107, 255, 174, 133, 66,
-			199, 196, 129, 195, 227, 63, 163, 138, 145, 113, 241, 155, 54, 134,
-			220, 170, 78, 131, 89, 22, 81, 106, 93, 178, 213, 64, 233, 115,
-			49, 74, 117, 143, 14, 177, 128, 50, 216, 72, 206, 189, 200, 168,
-			150, 9, 179, 73, 163, 130, 195, 161, 172, 123, 145, 45, 196, 145,
-			241, 177, 141, 165, 24, 191, 130, 82, 28, 188, 245, 201, 10, 161,
-			246, 120, 200, 253, 29, 99, 204, 150, 232, 74, 11, 180, 121, 194,
-			76, 28, 190, 245, 137, 218, 241, 102, 174, 104, 17, 156, 133, 131,
-			113, 13, 93, 0, 227, 44, 200, 134, 248, 220, 187, 196, 120, 145,
-			201, 160, 62, 104, 199, 14, 54, 228, 109, 246, 1, 182, 236, 194,
-			14, 246, 55, 190, 106, 234, 132, 211, 71, 28, 42, 10, 159, 245,
-			155, 154, 122, 180, 108, 199, 153, 213, 84, 182, 54, 185, 11, 74,
-			109, 57, 1, 11, 62, 38, 192, 39, 26, 195, 91, 233, 245, 9,
-			75, 1, 203, 196, 214, 218, 201, 35, 217, 178, 47, 233, 128, 83,
-			93, 123, 118, 77, 7, 6, 199, 82, 101, 88, 18, 102, 34, 194,
-			155, 119, 226, 72, 219, 133, 16, 227, 74, 185, 249, 97, 83, 152,
-			190, 113, 189, 130, 203, 206, 129, 233, 9, 194, 108, 83, 170, 198,
-			118, 23, 149, 69, 63, 198, 196, 101, 230, 216, 37, 145, 86, 227,
-			184, 182, 89, 111, 187, 76, 59, 141, 151, 197, 209, 141, 59, 157,
-			236, 249, 60, 25, 167, 13, 117, 23, 217, 65, 191, 76, 166, 169,
-			117, 103, 138, 176, 79, 5, 182, 201, 173, 243, 24, 2, 38, 125,
-			17, 208, 198, 237, 98, 244, 168, 56, 32, 213, 59, 166, 78, 232,
-			81, 30, 38, 180, 239, 131, 226, 214, 253, 98, 49, 92, 142, 213,
-			209, 114, 56, 195, 160, 207, 228, 40, 23, 197, 92, 180, 22, 85,
-			130, 186, 234, 129, 166, 51, 96, 140, 115, 54, 155, 195, 114, 4,
-			112, 107, 11, 48, 102, 102, 15, 84, 248, 172, 253, 226, 224, 225,
-			39, 66, 214, 10, 140, 169, 185, 15, 13, 204, 176, 229, 166, 195,
-			54, 121, 67, 204, 165, 184, 126, 33, 193, 251, 199, 227, 165, 198,
-			90, 88, 197, 83, 215, 150, 155, 2, 49, 161, 172, 166, 245, 95,
-			174, 178, 83, 140, 103, 111, 26, 156, 100, 104, 123, 132, 139, 153,
-			240, 225, 84, 29, 48, 22, 37, 226, 246, 76, 87, 36, 14, 118,
-			180, 29, 246, 129, 11, 15, 118, 239, 81, 1, 139, 148, 211, 119,
-			57, 238, 224, 149, 6, 44, 82, 15, 223, 207, 103, 194, 207, 222,
-			229, 180, 247, 102, 194, 207, 222, 229, 244, 15, 152, 69, 204, 79,
-			230, 217, 93, 91, 141, 213, 58, 175, 78, 55, 219, 242, 122, 230,
-			211, 26, 74, 246, 26, 151, 117, 102, 55, 225, 242, 7, 88, 94,
-			199, 10, 12, 59, 130, 140, 118, 28, 185, 235, 42, 143, 230, 45,
-			78, 66, 69, 150, 76, 114, 133, 95, 118, 152, 135, 207, 248, 105,
-			121, 82, 238, 214, 151, 85, 205, 153, 85, 120, 190, 46, 159, 50,
-			49, 93, 238, 85, 198, 116, 93, 93, 36, 215, 123, 168, 140, 228,
-			26, 248, 87, 176, 210, 119, 151, 141, 228, 186, 251, 201, 54, 153,
-			159, 144, 33, 91, 221, 185, 1, 167, 112, 155, 217, 97, 174, 142,
-			204, 9, 171, 75, 65, 45, 193, 209, 45, 238, 52, 15, 209, 196,
-			102, 118, 154, 71, 216, 1, 245, 34, 92, 119, 126, 64, 174, 220,
-			144, 28, 167, 61, 46, 39, 153, 221, 113, 61, 172, 71, 70, 184,
-			227, 230, 56, 210, 75, 135, 236, 6, 42, 31, 228, 174, 166, 13,
-			86, 189, 221, 125, 77, 27, 172, 122, 7, 6, 205, 231, 14, 39,
-			125, 153, 207, 29, 31, 228, 230, 29, 16, 125, 153, 184, 126, 135,
-			112, 210, 55, 48, 168, 66, 246, 115, 156, 244, 187, 187, 245, 202,
-			30, 5, 41, 187, 6, 216, 223, 209, 155, 89, 3, 236, 239, 219,
-			158, 89, 3, 236, 223, 185, 203, 152, 143, 159, 109, 219, 138, 249,
-			168, 135, 15, 53, 194, 36, 61, 143, 118, 240, 26, 30, 21, 254,
-			18, 214, 83, 146, 73, 159, 10, 203, 141, 218, 11, 195, 117, 190,
-			135, 121, 120, 14, 202, 38, 71, 65, 227, 115, 62, 202, 152, 134,
-			163, 2, 21, 154, 207, 112, 86, 63, 78, 149, 183, 24, 9, 169,
-			250, 207, 240, 191, 130, 254, 243, 164, 103, 46, 63, 168, 59, 205,
-			176, 83, 248, 183, 98, 162, 42, 178, 181, 48, 169, 78, 211, 108,
-			10, 120, 196, 19, 33, 113, 69, 4, 124, 212, 64, 93, 186, 41,
-			148, 178, 153, 40, 195, 151, 102, 91, 177, 228, 203, 11, 120, 184,
-			167, 237, 92, 219, 216, 132, 94, 225, 238, 117, 57, 45, 28, 69,
-			101, 66, 149, 136, 160, 1, 174, 177, 62, 191, 73, 175, 164, 39,
-			13, 189, 131, 93, 229, 211, 20, 252, 214, 219, 116, 8, 66, 175,
-			217, 88, 140, 221, 205, 108, 44, 206, 229, 57, 237, 237, 231, 173,
-			71, 27, 247, 209, 162, 162, 105, 71, 29, 23, 189, 7, 209, 52,
-			146, 176, 126, 48, 105, 168, 123, 87, 84, 182, 242, 152, 53, 187,
-			165, 121, 176, 105, 75, 243, 96, 123, 127, 102, 245, 119, 112, 104,
-			91, 38, 234, 108, 112, 120, 91, 235, 121, 183, 67, 153, 227, 105,
-			62, 54, 181, 149, 24, 114, 117, 82, 212, 214, 251, 223, 147, 28,
-			67, 243, 20, 98, 204, 175, 54, 2, 254, 234, 60, 138, 171, 136,
-			218, 42, 60, 53, 39, 105, 164, 204, 248, 61, 97, 170, 183, 20,
-			168, 110, 194, 103, 88, 155, 42, 142, 114, 72, 174, 42, 14, 171,
-			164, 19, 25, 249, 166, 203, 250, 155, 178, 73, 106, 113, 53, 9,
-			249, 10, 203, 235, 173, 64, 42, 163, 23, 110, 33, 163, 77, 82,
-			212, 153, 207, 165, 65, 26, 150, 76, 226, 133, 255, 195, 97, 157,
-			217, 159, 248, 12, 115, 175, 89, 144, 153, 27, 149, 249, 180, 213,
-			152, 244, 139, 142, 108, 61, 81, 163, 47, 126, 128, 245, 169, 115,
-			212, 194, 242, 121, 189, 215, 128, 8, 103, 148, 148, 122, 205, 15,
-			39, 228, 243, 145, 47, 56, 172, 103, 162, 86, 171, 172, 207, 214,
-			76, 5, 238, 106, 34, 17, 121, 103, 130, 101, 14, 62, 199, 186,
-			237, 207, 231, 211, 180, 162, 64, 95, 254, 88, 163, 19, 252, 241,
-			19, 222, 111, 59, 110, 62, 247, 248, 137, 182, 223, 118, 232, 45,
-			110, 62, 87, 234, 52, 41, 206, 167, 21, 254, 124, 70, 226, 90,
-			50, 76, 176, 30, 15, 110, 41, 12, 174, 4, 95, 142, 60, 74,
-			89, 126, 182, 86, 194, 105, 7, 190, 135, 117, 84, 195, 75, 231,
-			155, 54, 90, 148, 88, 53, 188, 164, 138, 205, 247, 179, 222, 90,
-			61, 188, 24, 197, 141, 196, 188, 229, 226, 91, 61, 250, 185, 126,
-			117, 149, 117, 235, 200, 66, 57, 225, 164, 98, 245, 38, 182, 6,
-			18, 129, 101, 91, 88, 35, 41, 117, 5, 89, 145, 207, 51, 95,
-			229, 64, 49, 135, 231, 94, 77, 14, 179, 53, 149, 184, 74, 11,
-			106, 83, 254, 117, 126, 45, 89, 145, 87, 54, 148, 218, 229, 147,
-			123, 147, 21, 126, 156, 109, 111, 213, 196, 121, 25, 191, 20, 150,
-			241, 226, 6, 82, 218, 214, 162, 146, 9, 245, 243, 200, 243, 89,
-			87, 83, 129, 56, 103, 221, 19, 211, 165, 255, 159, 187, 235, 249,
-			141, 35, 185, 206, 83, 85, 61, 173, 81, 81, 36, 135, 197, 31,
-			51, 106, 42, 82, 145, 94, 69, 164, 52, 164, 126, 69, 43, 72,
-			164, 118, 77, 81, 196, 70, 208, 46, 233, 144, 90, 217, 130, 33,
-			136, 45, 178, 73, 54, 52, 51, 61, 234, 233, 17, 87, 27, 196,
-			9, 226, 32, 48, 2, 195, 64, 12, 3, 1, 130, 69, 140, 13,
-			22, 206, 198, 118, 98, 64, 1, 22, 6, 146, 24, 201, 61, 8,
-			146, 83, 128, 92, 114, 200, 127, 224, 99, 128, 4, 65, 189, 122,
-			85, 93, 211, 20, 189, 43, 237, 250, 178, 55, 190, 230, 116, 87,
-			117, 117, 117, 245, 171, 247, 190, 247, 125, 43, 75, 183, 238, 63,
-			92, 249, 198, 237, 141, 187, 27, 213, 146, 24, 224, 71, 150, 215,
-			87, 150, 238, 174, 220, 170, 18, 49, 200, 143, 174, 175, 24, 147,
-			78, 255, 25, 81, 15, 13, 79, 30, 224, 71, 54, 222, 93, 94,
-			94, 217, 80, 103, 13, 243, 129, 149, 245, 245, 135, 239, 174, 222,
-			89, 93, 251, 250, 106, 149, 136, 42, 63, 166, 14, 152, 96, 95,
-			149, 138, 17, 62, 168, 127, 130, 89, 218, 42, 19, 19, 92, 56,
-			103, 225, 150, 182, 234, 137, 26, 31, 85, 199, 11, 240, 156, 106,
-			217, 252, 163, 144, 145, 175, 250, 211, 31, 17, 94, 205, 223, 11,
-			92, 113, 222, 225, 71, 116, 92, 203, 44, 56, 47, 131, 1, 53,
-			79, 104, 221, 92, 67, 44, 241, 161, 36, 141, 119, 99, 8, 244,
-			61, 236, 70, 217, 167, 87, 246, 172, 15, 230, 103, 108, 68, 217,
-			244, 127, 16, 94, 255, 122, 26, 103, 145, 235, 217, 155, 247, 120,
-			195, 89, 166, 190, 16, 189, 22, 181, 86, 237, 240, 193, 190, 79,
-			1, 246, 249, 234, 43, 94, 223, 185, 250, 177, 142, 115, 124, 250,
-			10, 63, 254, 130, 27, 195, 7, 81, 207, 245, 107, 244, 242, 100,
-			204, 75, 255, 200, 120, 25, 138, 71, 197, 119, 9, 31, 112, 22,
-			121, 241, 50, 219, 226, 131, 95, 181, 224, 141, 207, 247, 109, 17,
-			223, 38, 188, 98, 38, 148, 184, 254, 50, 235, 123, 255, 234, 28,
-			44, 188, 210, 185, 216, 139, 191, 32, 124, 228, 192, 176, 138, 229,
-			151, 184, 228, 97, 179, 45, 184, 245, 249, 46, 162, 59, 248, 114,
-			219, 146, 239, 93, 249, 18, 105, 193, 204, 228, 251, 250, 217, 188,
-			66, 107, 202, 84, 104, 13, 148, 150, 224, 79, 79, 176, 99, 24,
-			3, 40, 11, 54, 88, 90, 132, 63, 125, 193, 134, 74, 111, 225,
-			206, 166, 154, 239, 108, 170, 118, 103, 35, 74, 151, 212, 24, 193,
-			214, 166, 94, 154, 36, 193, 199, 68, 46, 201, 131, 19, 93, 71,
-			120, 141, 111, 159, 37, 114, 39, 202, 182, 246, 114, 210, 82, 147,
-			167, 82, 187, 24, 29, 32, 144, 247, 147, 94, 65, 216, 188, 88,
-			169, 157, 70, 225, 246, 166, 83, 167, 109, 198, 194, 178, 238, 34,
-			142, 128, 91, 1, 138, 109, 203, 55, 230, 236, 146, 234, 149, 192,
-			226, 128, 89, 64, 167, 130, 51, 114, 73, 54, 227, 174, 174, 169,
-			54, 144, 198, 208, 169, 185, 134, 206, 231, 27, 35, 79, 157, 230,
-			70, 4, 2, 11, 78, 85, 215, 15, 198, 79, 56, 219, 164, 224,
-			148, 132, 0, 10, 17, 222, 201, 82, 131, 4, 175, 203, 23, 188,
-			215, 6, 255, 148, 26, 59, 75, 220, 159, 205, 204, 230, 178, 8,
-			39, 43, 147, 90, 189, 128, 149, 132, 119, 138, 158, 213, 1, 20,
-			2, 1, 148, 83, 124, 130, 15, 113, 95, 89, 106, 7, 40, 189,
-			105, 31, 66, 28, 4, 35, 40, 18, 35, 40, 4, 35, 40, 114,
-			168, 154, 219, 76, 48, 57, 58, 6, 145, 86, 130, 20, 53, 114,
-			108, 90, 83, 27, 16, 150, 163, 152, 167, 252, 121, 254, 14, 54,
-			66, 4, 59, 237, 213, 131, 55, 250, 234, 215, 108, 130, 191, 144,
-			57, 131, 20, 65, 183, 161, 165, 224, 53, 159, 213, 126, 220, 53,
-			25, 109, 130, 97, 154, 211, 8, 69, 36, 24, 166, 57, 125, 108,
-			52, 183, 153, 96, 167, 39, 106, 252, 9, 54, 79, 5, 155, 245,
-			100, 240, 72, 46, 247, 73, 85, 24, 36, 235, 1, 228, 151, 237,
-			153, 134, 197, 196, 153, 77, 111, 240, 2, 28, 35, 108, 111, 59,
-			136, 4, 167, 139, 180, 172, 218, 60, 154, 219, 68, 176, 89, 62,
-			153, 219, 76, 176, 217, 147, 167, 32, 146, 4, 249, 253, 115, 244,
-			180, 17, 167, 240, 148, 197, 29, 25, 139, 115, 3, 53, 71, 198,
-			226, 92, 93, 58, 50, 22, 231, 190, 242, 26, 127, 160, 181, 36,
-			206, 151, 150, 73, 240, 59, 178, 176, 132, 27, 148, 110, 232, 160,
-			230, 116, 216, 45, 201, 169, 2, 53, 32, 170, 19, 165, 59, 73,
-			218, 130, 146, 175, 164, 165, 43, 210, 231, 115, 237, 132, 243, 149,
-			26, 255, 14, 53, 226, 9, 87, 104, 61, 248, 31, 34, 111, 239,
-			88, 165, 22, 157, 148, 48, 236, 227, 251, 154, 23, 108, 206, 4,
-			35, 34, 156, 185, 186, 83, 182, 42, 30, 86, 191, 219, 183, 26,
-			60, 47, 164, 180, 100, 86, 170, 3, 160, 105, 130, 210, 47, 107,
-			109, 203, 106, 94, 184, 199, 110, 15, 72, 28, 119, 122, 77, 167,
-			130, 41, 129, 220, 238, 78, 15, 234, 213, 11, 103, 228, 4, 200,
-			155, 253, 219, 139, 77, 68, 111, 181, 90, 209, 118, 28, 102, 17,
-			144, 179, 100, 189, 180, 45, 195, 174, 211, 76, 159, 164, 195, 149,
-			62, 73, 135, 43, 24, 132, 208, 146, 14, 87, 38, 106, 252, 175,
-			9, 106, 58, 120, 139, 244, 77, 47, 248, 115, 98, 73, 37, 109,
-			82, 36, 231, 80, 138, 119, 100, 222, 35, 169, 81, 9, 5, 6,
-			38, 7, 38, 30, 201, 254, 238, 171, 127, 95, 146, 123, 73, 47,
-			237, 22, 78, 122, 63, 74, 147, 195, 206, 48, 180, 1, 185, 94,
-			132, 47, 216, 34, 13, 28, 189, 136, 197, 201, 89, 71, 47, 98,
-			177, 49, 7, 161, 21, 10, 161, 149, 197, 249, 55, 117, 104, 133,
-			66, 104, 101, 156, 8, 118, 195, 155, 231, 35, 156, 155, 35, 101,
-			34, 216, 27, 94, 131, 159, 129, 129, 0, 104, 233, 68, 16, 232,
-			18, 213, 190, 89, 8, 200, 42, 219, 13, 234, 169, 95, 90, 203,
-			23, 236, 230, 192, 160, 177, 136, 96, 55, 135, 70, 140, 197, 4,
-			187, 57, 54, 206, 255, 139, 130, 138, 69, 249, 237, 210, 247, 9,
-			9, 254, 157, 74, 227, 8, 247, 203, 212, 116, 123, 173, 86, 152,
-			62, 131, 143, 129, 106, 81, 131, 56, 177, 46, 101, 173, 163, 158,
-			117, 39, 76, 51, 83, 40, 90, 152, 60, 253, 144, 178, 205, 226,
-			142, 102, 83, 131, 251, 115, 43, 53, 84, 27, 38, 215, 136, 140,
-			97, 234, 134, 91, 225, 227, 8, 213, 226, 245, 228, 154, 211, 124,
-			154, 237, 173, 4, 192, 135, 208, 131, 252, 46, 16, 176, 209, 158,
-			3, 88, 156, 165, 55, 11, 187, 114, 43, 105, 117, 66, 128, 91,
-			57, 21, 178, 51, 38, 35, 104, 99, 136, 134, 82, 253, 210, 28,
-			46, 7, 173, 238, 110, 39, 220, 122, 44, 195, 52, 13, 159, 53,
-			92, 122, 7, 46, 67, 251, 95, 173, 247, 50, 235, 8, 123, 188,
-			93, 169, 130, 42, 46, 83, 139, 193, 26, 173, 7, 235, 253, 20,
-			62, 128, 222, 76, 250, 242, 121, 5, 238, 110, 205, 109, 161, 142,
-			224, 78, 108, 110, 167, 215, 228, 249, 132, 152, 119, 53, 55, 214,
-			16, 179, 165, 53, 55, 214, 42, 163, 142, 230, 198, 218, 68, 141,
-			255, 39, 67, 77, 13, 118, 159, 254, 70, 240, 47, 172, 152, 127,
-			238, 43, 76, 233, 47, 124, 57, 164, 179, 6, 56, 23, 102, 248,
-			124, 50, 156, 17, 152, 46, 54, 136, 154, 25, 228, 232, 48, 243,
-			168, 143, 234, 178, 144, 239, 110, 240, 131, 56, 145, 109, 168, 0,
-			239, 70, 217, 236, 129, 164, 249, 118, 172, 241, 124, 102, 134, 53,
-			251, 10, 107, 184, 237, 254, 5, 36, 174, 137, 251, 42, 59, 157,
-			204, 118, 14, 244, 13, 13, 7, 72, 94, 22, 113, 160, 86, 5,
-			208, 124, 14, 99, 186, 13, 54, 91, 191, 204, 121, 105, 29, 14,
-			121, 46, 215, 191, 182, 220, 176, 159, 209, 213, 51, 217, 94, 1,
-			121, 132, 215, 252, 134, 59, 252, 214, 143, 91, 157, 187, 120, 38,
-			219, 227, 206, 155, 99, 186, 152, 107, 151, 220, 183, 211, 128, 128,
-			184, 76, 221, 209, 46, 185, 63, 121, 2, 92, 30, 229, 150, 123,
-			223, 164, 123, 218, 229, 209, 206, 220, 55, 143, 140, 241, 43, 220,
-			87, 150, 154, 175, 15, 188, 26, 84, 117, 233, 209, 49, 233, 114,
-			151, 229, 53, 39, 15, 80, 159, 108, 134, 62, 219, 3, 79, 228,
-			54, 21, 236, 193, 248, 4, 255, 42, 94, 150, 8, 182, 233, 85,
-			131, 139, 246, 178, 122, 231, 29, 89, 95, 28, 152, 98, 16, 15,
-			152, 131, 23, 156, 22, 8, 92, 98, 32, 183, 169, 96, 155, 67,
-			195, 252, 123, 20, 155, 160, 130, 237, 122, 34, 248, 63, 98, 219,
-			208, 206, 190, 211, 113, 229, 55, 169, 153, 30, 62, 2, 134, 51,
-			227, 201, 24, 25, 11, 11, 28, 86, 203, 68, 26, 25, 2, 157,
-			25, 96, 231, 51, 172, 106, 18, 28, 231, 246, 110, 1, 59, 107,
-			190, 36, 192, 3, 135, 236, 55, 32, 72, 146, 170, 21, 110, 43,
-			105, 107, 32, 145, 131, 129, 126, 20, 201, 86, 210, 142, 179, 36,
-			69, 118, 84, 227, 32, 68, 169, 236, 198, 219, 26, 37, 210, 141,
-			162, 182, 12, 51, 25, 53, 163, 167, 208, 153, 52, 204, 52, 92,
-			69, 55, 215, 140, 31, 235, 98, 163, 40, 53, 92, 118, 102, 128,
-			212, 152, 239, 162, 179, 202, 176, 38, 101, 183, 58, 2, 94, 21,
-			83, 70, 76, 191, 98, 164, 98, 124, 101, 13, 59, 50, 50, 113,
-			245, 164, 35, 35, 19, 79, 77, 243, 65, 152, 60, 68, 148, 31,
-			211, 63, 34, 102, 246, 128, 70, 206, 145, 97, 62, 15, 207, 0,
-			124, 181, 150, 87, 197, 28, 69, 190, 10, 236, 23, 221, 3, 221,
-			39, 237, 178, 181, 236, 67, 213, 240, 203, 214, 208, 48, 191, 143,
-			215, 35, 130, 117, 188, 49, 93, 103, 145, 70, 112, 157, 176, 157,
-			187, 189, 115, 189, 246, 86, 51, 236, 118, 181, 66, 1, 226, 209,
-			128, 233, 213, 46, 56, 136, 180, 200, 87, 77, 211, 20, 129, 107,
-			15, 231, 54, 21, 172, 35, 70, 249, 35, 108, 154, 10, 150, 121,
-			227, 168, 35, 160, 38, 83, 24, 55, 1, 74, 90, 96, 190, 59,
-			180, 190, 32, 7, 109, 226, 250, 198, 37, 66, 241, 109, 155, 20,
-			56, 199, 170, 185, 173, 26, 29, 29, 227, 235, 216, 7, 38, 216,
-			190, 55, 17, 44, 191, 122, 31, 176, 194, 194, 105, 83, 125, 158,
-			246, 189, 145, 220, 166, 130, 237, 143, 141, 243, 13, 108, 211, 19,
-			236, 125, 111, 50, 184, 117, 120, 155, 160, 81, 151, 234, 41, 30,
-			246, 87, 202, 155, 74, 71, 120, 225, 156, 70, 61, 192, 61, 78,
-			228, 54, 21, 236, 253, 227, 1, 143, 176, 209, 178, 96, 223, 242,
-			78, 4, 247, 14, 111, 20, 65, 55, 186, 77, 245, 141, 135, 38,
-			194, 118, 150, 127, 49, 93, 48, 61, 135, 185, 2, 91, 20, 167,
-			27, 101, 96, 238, 170, 229, 54, 21, 236, 91, 193, 36, 255, 7,
-			130, 253, 240, 133, 247, 109, 226, 157, 8, 126, 66, 14, 239, 9,
-			44, 248, 14, 186, 255, 6, 150, 90, 203, 36, 149, 0, 217, 177,
-			76, 211, 185, 190, 144, 250, 144, 229, 64, 255, 48, 203, 66, 32,
-			132, 204, 18, 25, 103, 128, 134, 197, 143, 203, 90, 199, 126, 214,
-			80, 133, 71, 239, 197, 95, 136, 48, 210, 248, 33, 68, 250, 64,
-			239, 9, 116, 191, 150, 31, 160, 234, 64, 48, 201, 207, 194, 59,
-			207, 132, 247, 199, 132, 78, 4, 39, 164, 142, 243, 230, 188, 85,
-			238, 11, 162, 245, 162, 152, 15, 63, 182, 38, 81, 230, 128, 209,
-			191, 98, 112, 169, 177, 113, 254, 251, 112, 97, 79, 120, 127, 66,
-			104, 61, 120, 34, 87, 222, 203, 210, 16, 220, 201, 232, 61, 205,
-			22, 179, 169, 3, 220, 155, 202, 13, 139, 218, 187, 192, 87, 168,
-			254, 57, 111, 11, 217, 96, 47, 171, 7, 45, 95, 24, 187, 25,
-			151, 59, 224, 140, 3, 72, 15, 120, 17, 147, 84, 98, 60, 89,
-			191, 235, 93, 219, 91, 175, 12, 61, 168, 24, 147, 40, 243, 232,
-			168, 49, 153, 50, 39, 106, 252, 187, 218, 15, 42, 11, 239, 79,
-			9, 253, 205, 224, 127, 169, 166, 54, 63, 12, 116, 101, 1, 90,
-			200, 173, 119, 104, 116, 222, 96, 184, 108, 229, 30, 254, 208, 190,
-			134, 8, 49, 147, 51, 46, 196, 12, 227, 21, 6, 180, 8, 111,
-			43, 32, 15, 249, 225, 208, 67, 168, 90, 40, 192, 15, 181, 107,
-			116, 200, 77, 88, 197, 32, 136, 23, 152, 137, 166, 133, 151, 218,
-			9, 194, 41, 213, 71, 216, 193, 28, 58, 19, 178, 31, 161, 200,
-			95, 8, 81, 252, 149, 227, 130, 254, 82, 247, 192, 143, 236, 179,
-			43, 235, 199, 113, 196, 152, 68, 153, 149, 41, 99, 50, 101, 190,
-			166, 246, 255, 212, 243, 132, 255, 3, 82, 250, 75, 66, 248, 128,
-			150, 33, 243, 126, 64, 42, 117, 254, 183, 70, 135, 204, 251, 128,
-			80, 25, 252, 144, 58, 50, 87, 93, 152, 66, 91, 70, 5, 166,
-			215, 204, 186, 13, 240, 41, 59, 106, 183, 223, 49, 238, 135, 137,
-			229, 191, 112, 55, 99, 25, 131, 212, 158, 91, 237, 10, 128, 232,
-			88, 187, 192, 58, 200, 102, 230, 184, 45, 116, 52, 28, 73, 136,
-			188, 77, 82, 136, 84, 38, 92, 110, 71, 89, 148, 182, 226, 118,
-			100, 97, 128, 102, 215, 127, 224, 35, 169, 158, 170, 150, 197, 237,
-			223, 163, 233, 245, 15, 183, 5, 182, 237, 134, 92, 93, 91, 93,
-			49, 219, 8, 199, 7, 213, 164, 207, 160, 128, 216, 13, 33, 184,
-			106, 164, 199, 60, 24, 48, 107, 250, 202, 28, 24, 117, 116, 202,
-			62, 32, 99, 147, 142, 78, 217, 7, 228, 228, 41, 254, 111, 4,
-			53, 200, 188, 15, 9, 61, 31, 252, 115, 78, 197, 83, 140, 62,
-			96, 24, 78, 221, 23, 186, 82, 218, 231, 74, 77, 225, 216, 1,
-			54, 3, 199, 159, 82, 47, 123, 14, 214, 200, 49, 14, 198, 247,
-			177, 84, 131, 59, 97, 156, 54, 159, 193, 75, 49, 19, 118, 187,
-			189, 22, 126, 10, 121, 254, 76, 221, 17, 110, 133, 219, 202, 43,
-			67, 47, 97, 55, 153, 181, 195, 65, 124, 184, 165, 73, 71, 3,
-			236, 67, 114, 226, 172, 163, 1, 246, 33, 153, 155, 135, 121, 88,
-			22, 254, 71, 164, 244, 55, 56, 15, 213, 140, 253, 136, 84, 36,
-			191, 195, 61, 175, 76, 75, 194, 255, 17, 161, 31, 19, 47, 88,
-			80, 79, 48, 222, 158, 55, 250, 187, 143, 227, 246, 182, 156, 186,
-			33, 223, 1, 194, 205, 134, 243, 47, 187, 240, 197, 187, 109, 229,
-			15, 98, 167, 202, 240, 80, 126, 68, 104, 213, 152, 68, 153, 35,
-			163, 198, 100, 202, 4, 214, 89, 48, 43, 170, 233, 218, 199, 168,
-			53, 84, 54, 225, 70, 239, 175, 136, 215, 224, 67, 208, 59, 34,
-			252, 31, 19, 250, 83, 226, 225, 37, 212, 93, 255, 152, 208, 33,
-			99, 18, 101, 14, 159, 48, 38, 83, 230, 41, 137, 13, 144, 138,
-			58, 123, 234, 167, 182, 1, 84, 109, 245, 126, 66, 64, 15, 150,
-			122, 190, 240, 127, 70, 74, 127, 135, 35, 163, 62, 72, 63, 83,
-			239, 242, 31, 170, 73, 227, 171, 55, 244, 57, 161, 227, 65, 38,
-			215, 33, 108, 132, 36, 101, 73, 171, 211, 83, 143, 120, 47, 236,
-			238, 89, 198, 254, 153, 36, 197, 80, 238, 182, 21, 186, 80, 11,
-			85, 167, 231, 138, 33, 199, 59, 28, 229, 12, 80, 100, 26, 161,
-			61, 115, 121, 89, 189, 254, 233, 172, 30, 81, 31, 228, 248, 158,
-			155, 79, 133, 15, 35, 250, 220, 224, 87, 125, 24, 209, 231, 100,
-			116, 140, 127, 149, 83, 191, 36, 252, 79, 8, 168, 34, 94, 66,
-			26, 84, 205, 68, 10, 78, 168, 81, 218, 70, 23, 119, 233, 107,
-			183, 11, 204, 28, 3, 156, 249, 234, 234, 159, 144, 202, 32, 95,
-			224, 158, 15, 241, 228, 159, 19, 122, 43, 152, 115, 3, 211, 24,
-			66, 235, 226, 253, 153, 160, 187, 195, 172, 59, 200, 203, 62, 108,
-			177, 188, 159, 19, 127, 196, 152, 84, 153, 226, 53, 99, 130, 88,
-			214, 249, 155, 252, 30, 180, 68, 132, 247, 247, 132, 46, 4, 191,
-			109, 223, 71, 220, 200, 233, 102, 194, 167, 97, 220, 84, 251, 5,
-			71, 13, 198, 142, 177, 21, 151, 206, 23, 17, 219, 9, 2, 23,
-			246, 135, 140, 73, 149, 57, 124, 210, 152, 76, 153, 179, 215, 248,
-			6, 116, 130, 10, 239, 23, 132, 222, 11, 86, 228, 129, 44, 147,
-			140, 219, 187, 234, 109, 118, 10, 44, 11, 255, 46, 8, 223, 216,
-			30, 168, 91, 251, 5, 241, 107, 198, 132, 70, 234, 23, 140, 201,
-			148, 185, 112, 215, 66, 151, 254, 123, 130, 191, 249, 217, 161, 53,
-			122, 140, 230, 108, 194, 227, 11, 135, 48, 189, 10, 52, 232, 149,
-			200, 152, 126, 73, 248, 232, 187, 112, 55, 102, 34, 221, 86, 175,
-			142, 56, 197, 7, 76, 136, 211, 224, 136, 143, 174, 27, 144, 201,
-			157, 232, 153, 88, 226, 195, 206, 15, 62, 19, 168, 100, 125, 48,
-			63, 255, 110, 214, 84, 109, 168, 87, 25, 245, 198, 181, 160, 213,
-			58, 87, 135, 54, 224, 136, 16, 220, 83, 22, 128, 43, 142, 174,
-			195, 223, 226, 166, 134, 157, 148, 33, 155, 127, 225, 37, 50, 152,
-			64, 116, 163, 145, 39, 223, 161, 188, 12, 166, 234, 130, 129, 138,
-			164, 209, 142, 185, 77, 60, 164, 57, 45, 93, 202, 76, 250, 234,
-			148, 153, 46, 81, 230, 175, 145, 73, 106, 172, 143, 73, 234, 83,
-			249, 162, 94, 46, 123, 251, 73, 85, 131, 74, 111, 124, 153, 146,
-			183, 21, 76, 200, 34, 189, 166, 78, 200, 14, 228, 9, 217, 1,
-			155, 144, 29, 44, 93, 226, 63, 164, 58, 33, 59, 81, 250, 45,
-			18, 124, 159, 202, 23, 188, 59, 198, 97, 183, 1, 106, 83, 83,
-			244, 86, 98, 37, 116, 114, 254, 139, 68, 198, 237, 167, 201, 227,
-			136, 203, 226, 162, 210, 236, 1, 51, 199, 70, 20, 29, 72, 213,
-			192, 134, 41, 79, 65, 96, 176, 188, 152, 132, 209, 202, 214, 234,
-			26, 247, 52, 204, 81, 141, 22, 22, 162, 167, 145, 201, 128, 24,
-			142, 135, 184, 107, 119, 175, 205, 94, 104, 183, 232, 72, 210, 209,
-			210, 17, 169, 183, 18, 222, 47, 5, 101, 106, 202, 240, 102, 157,
-			212, 239, 68, 101, 82, 125, 206, 116, 172, 176, 78, 143, 7, 243,
-			210, 89, 48, 140, 215, 157, 198, 97, 19, 68, 33, 10, 192, 233,
-			62, 104, 108, 189, 15, 26, 91, 63, 58, 230, 228, 124, 235, 181,
-			58, 159, 55, 60, 79, 1, 61, 31, 76, 193, 165, 226, 84, 87,
-			208, 20, 154, 212, 121, 160, 156, 248, 40, 192, 252, 140, 142, 27,
-			6, 147, 103, 29, 232, 107, 48, 55, 207, 255, 160, 108, 120, 60,
-			206, 210, 227, 193, 47, 61, 59, 233, 244, 154, 101, 191, 195, 150,
-			136, 186, 185, 155, 164, 113, 182, 215, 2, 39, 210, 210, 91, 219,
-			154, 116, 235, 145, 24, 49, 9, 93, 115, 180, 121, 97, 211, 185,
-			148, 206, 136, 88, 223, 101, 31, 118, 69, 248, 173, 197, 194, 77,
-			147, 205, 91, 182, 229, 105, 16, 22, 88, 125, 251, 190, 76, 58,
-			221, 135, 206, 178, 106, 20, 39, 244, 46, 107, 243, 34, 42, 145,
-			52, 44, 127, 121, 47, 235, 165, 81, 3, 228, 46, 180, 103, 165,
-			207, 67, 153, 185, 109, 200, 73, 154, 137, 241, 43, 240, 209, 134,
-			249, 50, 75, 195, 118, 55, 142, 218, 89, 67, 238, 71, 114, 91,
-			127, 157, 117, 57, 124, 220, 222, 189, 174, 119, 43, 214, 213, 222,
-			74, 84, 75, 49, 198, 59, 211, 232, 201, 124, 177, 251, 83, 55,
-			100, 55, 106, 238, 20, 143, 55, 184, 73, 41, 234, 36, 39, 196,
-			73, 108, 74, 95, 71, 98, 205, 41, 90, 83, 88, 35, 31, 220,
-			72, 108, 36, 123, 14, 153, 163, 222, 9, 34, 165, 167, 222, 67,
-			228, 190, 14, 170, 187, 154, 247, 11, 162, 16, 166, 142, 80, 121,
-			149, 16, 201, 0, 65, 24, 235, 161, 183, 115, 101, 10, 76, 130,
-			24, 162, 137, 181, 59, 121, 79, 247, 247, 212, 62, 190, 215, 217,
-			77, 195, 109, 19, 156, 115, 110, 51, 39, 124, 41, 171, 41, 232,
-			170, 25, 156, 181, 175, 1, 101, 130, 157, 173, 213, 249, 191, 18,
-			36, 124, 97, 23, 169, 8, 254, 137, 200, 37, 253, 64, 29, 26,
-			82, 156, 62, 206, 74, 132, 27, 65, 251, 132, 140, 30, 126, 12,
-			169, 43, 179, 13, 85, 187, 249, 45, 185, 31, 62, 43, 108, 205,
-			210, 232, 137, 251, 84, 144, 232, 33, 233, 116, 29, 129, 49, 216,
-			77, 218, 132, 198, 139, 144, 245, 234, 217, 71, 239, 117, 0, 230,
-			170, 243, 121, 46, 6, 132, 245, 169, 110, 49, 80, 221, 114, 217,
-			6, 46, 98, 176, 186, 68, 61, 193, 46, 83, 83, 34, 226, 129,
-			229, 50, 110, 94, 182, 197, 36, 30, 17, 236, 242, 200, 152, 195,
-			184, 121, 185, 86, 231, 92, 67, 71, 94, 87, 223, 55, 3, 1,
-			121, 189, 50, 152, 227, 11, 174, 226, 197, 9, 172, 74, 134, 192,
-			83, 135, 167, 175, 226, 227, 208, 136, 130, 171, 181, 58, 191, 14,
-			167, 17, 193, 174, 209, 201, 96, 78, 90, 54, 74, 53, 228, 191,
-			59, 61, 221, 144, 211, 211, 191, 135, 228, 13, 211, 105, 212, 74,
-			158, 154, 0, 240, 180, 193, 45, 168, 21, 234, 26, 178, 224, 233,
-			72, 244, 53, 100, 193, 35, 176, 66, 93, 59, 30, 96, 231, 168,
-			96, 215, 169, 129, 52, 80, 95, 89, 195, 198, 34, 130, 93, 175,
-			78, 26, 139, 9, 118, 221, 98, 38, 152, 96, 11, 84, 224, 191,
-			212, 56, 47, 96, 106, 72, 199, 127, 23, 144, 213, 129, 192, 56,
-			47, 224, 56, 67, 236, 119, 145, 142, 227, 191, 188, 178, 178, 204,
-			80, 168, 145, 93, 68, 1, 71, 2, 35, 187, 152, 87, 249, 253,
-			127, 0, 0, 0, 255, 255, 81, 33, 166, 94, 224, 39, 2, 0,
-		},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 116, 36, 199,
+			117, 24, 138, 254, 152, 15, 20, 190, 6, 13, 144, 4, 103, 73,
+			110, 237, 236, 46, 23, 216, 29, 12, 62, 118, 185, 212, 238, 138,
+			84, 176, 192, 236, 238, 80, 88, 0, 158, 25, 136, 34, 117, 36,
+			176, 49, 83, 3, 52, 217, 211, 61, 238, 238, 89, 44, 40, 49,
+			118, 164, 72, 182, 228, 163, 240, 73, 17, 69, 69, 150, 173, 68,
+			150, 99, 73, 62, 145, 28, 203, 214, 179, 227, 28, 231, 217, 57,
+			57, 138, 18, 217, 142, 28, 203, 207, 81, 114, 222, 123, 71, 118,
+			242, 36, 199, 31, 162, 100, 197, 178, 100, 69, 239, 220, 91, 31,
+			221, 51, 192, 242, 203, 162, 243, 227, 137, 34, 133, 169, 234, 170,
+			91, 183, 110, 221, 186, 117, 235, 214, 173, 91, 228, 179, 11, 228,
+			232, 142, 239, 239, 184, 108, 174, 19, 248, 145, 191, 221, 109, 205,
+			69, 78, 155, 133, 145, 221, 238, 148, 48, 203, 26, 227, 5, 74,
+			178, 64, 225, 18, 25, 172, 203, 50, 214, 20, 201, 132, 172, 225,
+			123, 205, 112, 74, 163, 218, 180, 81, 149, 73, 107, 146, 164, 60,
+			219, 243, 195, 41, 157, 106, 211, 169, 42, 79, 92, 126, 187, 70,
+			38, 26, 126, 187, 212, 7, 244, 242, 168, 2, 185, 1, 89, 27,
+			218, 163, 139, 162, 200, 142, 239, 218, 222, 78, 201, 15, 118, 18,
+			56, 238, 119, 88, 56, 247, 132, 231, 239, 121, 49, 190, 157, 237,
+			111, 105, 218, 71, 116, 227, 234, 198, 229, 79, 232, 247, 92, 229,
+			181, 55, 68, 149, 210, 195, 204, 117, 95, 11, 21, 234, 80, 247,
+			161, 127, 51, 71, 50, 86, 234, 158, 129, 247, 106, 26, 249, 252,
+			48, 209, 134, 45, 227, 158, 1, 107, 241, 55, 134, 41, 214, 104,
+			248, 46, 189, 220, 109, 181, 88, 16, 210, 89, 202, 97, 157, 10,
+			105, 211, 142, 108, 234, 120, 17, 11, 26, 187, 182, 183, 195, 104,
+			203, 15, 218, 118, 68, 232, 178, 223, 217, 15, 156, 157, 221, 136,
+			46, 206, 207, 191, 74, 84, 160, 21, 175, 81, 162, 116, 201, 117,
+			41, 126, 11, 105, 192, 66, 22, 220, 96, 205, 18, 161, 187, 81,
+			212, 9, 47, 206, 205, 53, 217, 13, 230, 250, 29, 22, 132, 146,
+			38, 13, 191, 205, 123, 218, 240, 221, 217, 109, 142, 196, 28, 33,
+			180, 202, 154, 78, 24, 5, 206, 118, 55, 114, 124, 143, 218, 94,
+			147, 118, 67, 70, 29, 143, 134, 126, 55, 104, 48, 204, 217, 118,
+			60, 59, 216, 71, 188, 194, 34, 221, 115, 162, 93, 234, 7, 248,
+			215, 239, 70, 132, 182, 253, 166, 211, 114, 26, 54, 64, 40, 82,
+			59, 96, 180, 195, 130, 182, 19, 69, 172, 73, 59, 129, 127, 195,
+			105, 178, 38, 141, 118, 237, 136, 70, 187, 208, 59, 215, 245, 247,
+			28, 111, 135, 194, 8, 59, 80, 41, 132, 74, 132, 182, 89, 116,
+			145, 16, 10, 255, 156, 238, 67, 44, 164, 126, 75, 98, 212, 240,
+			155, 140, 182, 187, 97, 68, 3, 22, 217, 142, 135, 80, 237, 109,
+			255, 6, 124, 18, 20, 35, 212, 243, 35, 167, 193, 138, 52, 218,
+			117, 66, 234, 58, 97, 4, 16, 146, 45, 122, 205, 62, 116, 154,
+			78, 216, 112, 109, 167, 205, 130, 210, 173, 144, 112, 188, 36, 45,
+			36, 18, 157, 192, 111, 118, 27, 44, 198, 131, 196, 136, 252, 141,
+			240, 32, 84, 244, 174, 233, 55, 186, 109, 230, 69, 182, 28, 164,
+			57, 63, 160, 126, 180, 203, 2, 218, 182, 35, 22, 56, 182, 27,
+			198, 164, 134, 129, 1, 152, 132, 38, 177, 87, 157, 90, 99, 14,
+			214, 4, 192, 158, 221, 102, 128, 80, 146, 183, 60, 63, 254, 134,
+			116, 119, 162, 16, 122, 228, 113, 80, 126, 16, 210, 182, 189, 79,
+			183, 25, 112, 74, 147, 70, 62, 101, 94, 211, 15, 66, 6, 76,
+			209, 9, 252, 182, 31, 49, 64, 166, 217, 109, 68, 33, 109, 178,
+			192, 185, 193, 154, 180, 21, 248, 109, 194, 169, 16, 250, 173, 104,
+			15, 216, 68, 112, 16, 13, 59, 172, 1, 28, 68, 59, 129, 3,
+			140, 21, 0, 239, 120, 156, 139, 194, 16, 113, 39, 180, 126, 173,
+			82, 163, 181, 245, 43, 245, 135, 151, 170, 101, 90, 169, 209, 141,
+			234, 250, 235, 42, 43, 229, 21, 122, 249, 17, 90, 191, 86, 166,
+			203, 235, 27, 143, 84, 43, 87, 175, 213, 233, 181, 245, 213, 149,
+			114, 181, 70, 151, 214, 86, 232, 242, 250, 90, 189, 90, 185, 188,
+			89, 95, 175, 214, 8, 45, 44, 213, 104, 165, 86, 192, 47, 75,
+			107, 143, 208, 242, 235, 55, 170, 229, 90, 141, 174, 87, 105, 229,
+			250, 198, 106, 165, 188, 66, 31, 94, 170, 86, 151, 214, 234, 149,
+			114, 173, 72, 43, 107, 203, 171, 155, 43, 149, 181, 171, 69, 122,
+			121, 179, 78, 215, 214, 235, 132, 174, 86, 174, 87, 234, 229, 21,
+			90, 95, 47, 98, 179, 7, 235, 209, 245, 43, 244, 122, 185, 186,
+			124, 109, 105, 173, 190, 116, 185, 178, 90, 169, 63, 130, 13, 94,
+			169, 212, 215, 160, 177, 43, 235, 85, 66, 151, 232, 198, 82, 181,
+			94, 89, 222, 92, 93, 170, 210, 141, 205, 234, 198, 122, 173, 76,
+			161, 103, 43, 149, 218, 242, 234, 82, 229, 122, 121, 165, 68, 43,
+			107, 116, 109, 157, 150, 95, 87, 94, 171, 211, 218, 181, 165, 213,
+			213, 222, 142, 18, 186, 254, 240, 90, 185, 10, 216, 39, 187, 73,
+			47, 151, 233, 106, 101, 233, 242, 106, 153, 94, 89, 175, 98, 63,
+			87, 42, 213, 242, 114, 29, 58, 20, 255, 90, 174, 172, 148, 215,
+			234, 75, 171, 69, 66, 107, 27, 229, 229, 202, 210, 106, 145, 150,
+			95, 95, 190, 190, 177, 186, 84, 125, 164, 40, 128, 214, 202, 63,
+			180, 89, 94, 171, 87, 150, 86, 233, 202, 210, 245, 165, 171, 229,
+			26, 157, 126, 33, 170, 108, 84, 215, 151, 55, 171, 229, 235, 128,
+			245, 250, 21, 90, 219, 188, 92, 171, 87, 234, 155, 245, 50, 189,
+			186, 190, 190, 130, 196, 174, 149, 171, 175, 171, 44, 151, 107, 151,
+			232, 234, 58, 144, 255, 10, 221, 172, 149, 139, 132, 174, 44, 213,
+			151, 176, 233, 141, 234, 250, 149, 74, 189, 118, 9, 126, 95, 222,
+			172, 85, 144, 112, 149, 181, 122, 185, 90, 221, 220, 168, 87, 214,
+			215, 102, 232, 181, 245, 135, 203, 175, 43, 87, 233, 242, 210, 102,
+			173, 188, 130, 20, 94, 95, 131, 222, 2, 175, 148, 215, 171, 143,
+			0, 88, 160, 3, 142, 64, 145, 62, 124, 173, 92, 191, 86, 174,
+			2, 81, 145, 90, 75, 64, 134, 90, 189, 90, 89, 174, 39, 139,
+			173, 87, 105, 125, 189, 90, 39, 137, 126, 210, 181, 242, 213, 213,
+			202, 213, 242, 218, 114, 25, 240, 89, 7, 48, 15, 87, 106, 229,
+			25, 186, 84, 173, 212, 160, 64, 5, 27, 166, 15, 47, 61, 66,
+			215, 55, 177, 215, 48, 80, 155, 181, 50, 225, 191, 19, 172, 91,
+			196, 241, 164, 149, 43, 116, 105, 229, 117, 21, 192, 92, 148, 222,
+			88, 175, 213, 42, 130, 93, 144, 108, 203, 215, 4, 205, 75, 132,
+			100, 137, 166, 91, 6, 29, 152, 130, 95, 89, 203, 40, 12, 92,
+			34, 131, 68, 207, 158, 228, 63, 121, 230, 241, 129, 163, 152, 121,
+			148, 255, 228, 153, 39, 6, 42, 152, 57, 196, 127, 242, 204, 147,
+			3, 69, 204, 212, 248, 79, 158, 121, 239, 192, 28, 102, 138, 159,
+			60, 243, 212, 64, 1, 51, 9, 255, 201, 51, 167, 7, 142, 97,
+			230, 9, 254, 243, 255, 186, 155, 232, 230, 128, 149, 254, 49, 13,
+			150, 190, 252, 239, 220, 77, 151, 168, 90, 122, 81, 64, 178, 144,
+			121, 81, 72, 109, 218, 241, 29, 47, 66, 177, 230, 180, 97, 153,
+			105, 178, 14, 243, 154, 204, 67, 241, 108, 123, 251, 20, 86, 94,
+			250, 164, 239, 161, 52, 113, 253, 134, 237, 18, 218, 176, 93, 230,
+			53, 237, 160, 72, 153, 7, 210, 191, 73, 109, 128, 213, 240, 187,
+			188, 158, 208, 14, 80, 150, 182, 2, 187, 17, 175, 24, 242, 67,
+			68, 40, 170, 10, 152, 134, 21, 211, 119, 185, 80, 164, 245, 93,
+			38, 0, 57, 176, 148, 186, 118, 228, 220, 96, 32, 212, 108, 143,
+			178, 142, 223, 216, 165, 118, 68, 55, 235, 203, 180, 237, 52, 61,
+			148, 232, 190, 71, 232, 67, 182, 215, 133, 37, 113, 161, 72, 23,
+			46, 220, 63, 95, 148, 130, 186, 19, 248, 46, 235, 68, 78, 131,
+			94, 13, 216, 142, 31, 56, 182, 167, 176, 167, 123, 187, 78, 99,
+			151, 178, 155, 17, 3, 100, 81, 64, 31, 82, 106, 219, 110, 60,
+			177, 103, 7, 80, 194, 167, 251, 204, 14, 168, 239, 177, 18, 33,
+			184, 228, 183, 29, 175, 27, 49, 92, 47, 233, 249, 121, 213, 63,
+			215, 247, 118, 74, 116, 149, 217, 157, 184, 203, 1, 163, 133, 176,
+			205, 236, 128, 53, 11, 52, 244, 249, 2, 236, 249, 212, 101, 118,
+			135, 136, 98, 52, 178, 183, 93, 70, 157, 144, 122, 140, 1, 93,
+			91, 126, 192, 85, 145, 14, 172, 173, 64, 161, 34, 237, 134, 176,
+			58, 218, 244, 13, 139, 231, 102, 119, 253, 110, 64, 93, 199, 99,
+			118, 64, 40, 66, 127, 227, 244, 243, 43, 29, 48, 158, 115, 88,
+			114, 6, 58, 1, 228, 14, 80, 203, 113, 66, 92, 19, 232, 252,
+			252, 252, 194, 44, 254, 91, 159, 159, 191, 136, 255, 62, 10, 93,
+			191, 112, 225, 194, 133, 217, 133, 197, 217, 179, 11, 245, 197, 179,
+			23, 239, 187, 112, 241, 190, 11, 165, 11, 242, 159, 71, 75, 244,
+			242, 62, 129, 129, 140, 2, 167, 17, 1, 130, 145, 232, 34, 66,
+			47, 210, 61, 70, 153, 23, 118, 3, 88, 150, 237, 8, 146, 13,
+			24, 11, 223, 187, 193, 130, 8, 224, 115, 102, 241, 219, 244, 13,
+			213, 43, 203, 132, 158, 61, 123, 246, 66, 220, 151, 189, 189, 189,
+			146, 195, 162, 22, 106, 136, 65, 171, 49, 23, 180, 26, 80, 162,
+			20, 221, 140, 102, 64, 99, 99, 20, 244, 2, 111, 39, 132, 78,
+			29, 167, 229, 155, 118, 187, 227, 178, 144, 16, 249, 147, 46, 92,
+			164, 203, 126, 187, 211, 141, 88, 98, 46, 96, 131, 27, 235, 181,
+			202, 235, 233, 99, 64, 153, 233, 153, 199, 74, 66, 229, 137, 11,
+			41, 229, 243, 18, 255, 162, 210, 165, 144, 69, 91, 98, 128, 167,
+			33, 119, 122, 109, 115, 117, 117, 102, 230, 208, 114, 200, 239, 211,
+			243, 51, 151, 18, 56, 45, 190, 16, 78, 59, 44, 2, 184, 126,
+			171, 105, 239, 39, 112, 11, 163, 160, 219, 136, 176, 129, 27, 182,
+			75, 163, 27, 162, 197, 158, 226, 247, 70, 55, 138, 20, 17, 186,
+			244, 114, 187, 116, 163, 20, 221, 128, 14, 62, 95, 143, 120, 161,
+			110, 200, 26, 244, 52, 93, 152, 159, 239, 237, 225, 217, 91, 246,
+			240, 97, 199, 59, 187, 72, 31, 187, 202, 162, 218, 126, 24, 177,
+			54, 124, 94, 10, 175, 56, 46, 171, 247, 14, 196, 149, 202, 106,
+			185, 94, 185, 94, 166, 173, 72, 160, 113, 171, 58, 247, 182, 34,
+			137, 233, 102, 101, 173, 126, 254, 28, 141, 156, 198, 19, 33, 125,
+			128, 78, 79, 79, 243, 156, 153, 86, 84, 106, 238, 93, 115, 118,
+			118, 87, 236, 8, 107, 205, 208, 87, 191, 154, 158, 93, 156, 161,
+			111, 161, 248, 109, 213, 223, 147, 159, 36, 221, 230, 230, 232, 18,
+			125, 216, 241, 154, 254, 94, 136, 32, 97, 134, 46, 204, 207, 39,
+			100, 88, 88, 82, 5, 184, 148, 90, 56, 127, 112, 26, 41, 104,
+			80, 125, 225, 252, 185, 115, 231, 238, 63, 123, 126, 62, 22, 27,
+			219, 172, 229, 7, 140, 110, 122, 206, 77, 33, 235, 64, 152, 245,
+			67, 41, 189, 188, 193, 156, 230, 253, 167, 211, 211, 208, 131, 144,
+			206, 225, 96, 193, 191, 51, 116, 54, 137, 206, 11, 112, 48, 192,
+			57, 187, 24, 195, 57, 153, 128, 131, 12, 48, 211, 195, 0, 231,
+			110, 201, 0, 15, 217, 55, 108, 250, 24, 31, 252, 82, 163, 27,
+			4, 204, 139, 160, 200, 117, 199, 117, 157, 48, 193, 0, 32, 77,
+			105, 27, 115, 233, 3, 244, 214, 21, 158, 135, 205, 233, 3, 113,
+			110, 201, 99, 123, 151, 187, 142, 219, 100, 193, 244, 12, 116, 172,
+			38, 40, 36, 154, 224, 132, 153, 225, 176, 224, 127, 80, 102, 13,
+			121, 125, 218, 241, 34, 232, 185, 40, 201, 187, 46, 186, 13, 36,
+			152, 153, 41, 109, 3, 100, 196, 37, 166, 193, 125, 47, 64, 131,
+			138, 23, 70, 182, 23, 149, 60, 127, 47, 209, 109, 145, 75, 61,
+			127, 143, 62, 64, 123, 202, 60, 111, 79, 99, 196, 95, 184, 203,
+			158, 191, 87, 218, 97, 81, 25, 152, 141, 231, 77, 207, 36, 122,
+			222, 219, 123, 81, 24, 18, 211, 183, 232, 233, 249, 91, 246, 84,
+			140, 151, 212, 51, 232, 198, 126, 180, 203, 55, 18, 61, 140, 150,
+			28, 168, 233, 153, 190, 143, 165, 171, 44, 90, 142, 199, 125, 122,
+			6, 101, 253, 67, 181, 245, 53, 122, 221, 238, 116, 28, 111, 135,
+			16, 90, 241, 120, 14, 236, 142, 237, 8, 54, 156, 73, 92, 162,
+			253, 14, 174, 175, 61, 138, 11, 95, 58, 132, 206, 64, 112, 1,
+			122, 73, 235, 15, 111, 170, 68, 235, 176, 164, 59, 33, 182, 73,
+			132, 217, 0, 26, 43, 188, 25, 244, 134, 167, 102, 223, 220, 246,
+			189, 104, 247, 169, 217, 55, 55, 237, 253, 167, 234, 111, 134, 197,
+			251, 169, 139, 111, 110, 59, 222, 83, 23, 223, 28, 178, 198, 83,
+			111, 40, 189, 25, 212, 37, 152, 178, 79, 189, 241, 209, 2, 161,
+			123, 187, 44, 96, 148, 215, 6, 64, 182, 187, 103, 239, 135, 148,
+			221, 4, 13, 14, 54, 123, 92, 23, 104, 129, 22, 208, 116, 118,
+			156, 40, 4, 165, 198, 101, 84, 180, 84, 164, 216, 84, 145, 80,
+			222, 88, 145, 98, 107, 69, 212, 204, 176, 73, 212, 75, 158, 100,
+			129, 63, 219, 177, 155, 64, 16, 88, 182, 247, 124, 9, 141, 217,
+			141, 93, 232, 23, 83, 122, 28, 232, 127, 66, 164, 20, 133, 6,
+			213, 176, 61, 186, 227, 211, 110, 7, 150, 241, 11, 178, 234, 180,
+			83, 98, 37, 145, 185, 112, 184, 182, 55, 83, 36, 216, 190, 223,
+			129, 148, 237, 242, 150, 10, 143, 22, 104, 216, 109, 181, 156, 155,
+			160, 143, 58, 13, 27, 20, 44, 24, 69, 96, 18, 212, 68, 167,
+			11, 155, 245, 229, 194, 204, 165, 158, 92, 2, 4, 10, 216, 15,
+			119, 157, 128, 53, 75, 116, 9, 182, 188, 145, 127, 150, 51, 67,
+			136, 123, 114, 231, 73, 22, 208, 112, 215, 239, 186, 77, 73, 74,
+			48, 174, 108, 214, 151, 233, 180, 29, 170, 214, 154, 116, 123, 159,
+			208, 194, 163, 133, 25, 24, 0, 15, 118, 193, 30, 87, 105, 14,
+			178, 18, 16, 210, 238, 105, 170, 99, 7, 97, 220, 204, 54, 35,
+			20, 117, 58, 208, 112, 26, 13, 214, 137, 232, 182, 31, 237, 162,
+			6, 11, 117, 185, 209, 64, 246, 33, 60, 128, 7, 181, 61, 234,
+			183, 90, 33, 139, 80, 93, 187, 226, 7, 148, 241, 185, 86, 164,
+			133, 197, 249, 133, 251, 103, 231, 23, 102, 23, 238, 171, 207, 47,
+			92, 60, 59, 127, 113, 225, 190, 210, 252, 194, 163, 5, 161, 150,
+			135, 20, 211, 106, 121, 233, 216, 97, 68, 40, 150, 196, 246, 125,
+			47, 214, 155, 239, 43, 82, 128, 86, 18, 19, 200, 190, 97, 215,
+			26, 129, 211, 137, 138, 160, 237, 246, 168, 106, 54, 133, 229, 145,
+			250, 219, 143, 51, 80, 65, 64, 203, 3, 213, 145, 51, 59, 231,
+			71, 100, 127, 16, 87, 77, 59, 104, 18, 250, 134, 200, 175, 212,
+			214, 107, 56, 201, 166, 103, 14, 81, 80, 75, 109, 255, 73, 199,
+			117, 109, 212, 238, 152, 55, 187, 89, 155, 107, 250, 141, 112, 238,
+			97, 182, 61, 23, 163, 50, 87, 101, 45, 22, 48, 175, 193, 230,
+			174, 186, 254, 182, 237, 110, 173, 35, 14, 225, 28, 32, 52, 151,
+			104, 100, 6, 109, 87, 187, 126, 179, 4, 210, 128, 75, 154, 34,
+			181, 21, 74, 244, 49, 208, 24, 129, 232, 37, 249, 227, 49, 217,
+			33, 232, 234, 54, 147, 189, 101, 77, 114, 104, 23, 9, 125, 195,
+			99, 97, 20, 180, 176, 106, 162, 71, 126, 35, 44, 117, 176, 61,
+			236, 203, 226, 156, 235, 108, 7, 118, 176, 143, 6, 204, 210, 110,
+			212, 118, 143, 227, 47, 89, 119, 6, 173, 118, 68, 49, 178, 108,
+			4, 44, 48, 244, 212, 201, 71, 102, 79, 182, 103, 79, 54, 235,
+			39, 175, 93, 60, 121, 253, 226, 201, 90, 233, 100, 235, 209, 83,
+			37, 186, 234, 60, 193, 246, 156, 144, 225, 54, 7, 8, 20, 143,
+			82, 55, 100, 28, 218, 67, 126, 211, 70, 102, 61, 21, 210, 55,
+			60, 86, 169, 173, 75, 165, 230, 10, 182, 128, 29, 23, 138, 214,
+			27, 167, 185, 165, 82, 200, 185, 199, 253, 38, 31, 9, 248, 49,
+			11, 88, 206, 217, 29, 7, 7, 68, 230, 98, 119, 230, 56, 174,
+			115, 7, 97, 99, 63, 101, 3, 39, 23, 87, 78, 46, 174, 16,
+			58, 3, 132, 244, 183, 209, 66, 104, 139, 126, 70, 44, 160, 13,
+			187, 131, 19, 196, 111, 209, 29, 230, 177, 192, 230, 83, 77, 78,
+			51, 152, 150, 73, 250, 151, 8, 33, 100, 136, 24, 230, 128, 102,
+			153, 63, 166, 101, 199, 201, 79, 105, 196, 52, 7, 244, 1, 203,
+			252, 9, 77, 159, 204, 255, 3, 141, 86, 227, 29, 174, 228, 125,
+			191, 133, 44, 15, 120, 211, 208, 241, 26, 73, 45, 139, 28, 174,
+			102, 209, 235, 96, 78, 220, 102, 207, 187, 45, 34, 135, 237, 139,
+			30, 165, 142, 215, 112, 187, 161, 115, 3, 54, 138, 35, 36, 5,
+			232, 165, 16, 191, 140, 76, 106, 144, 204, 142, 201, 164, 1, 73,
+			107, 130, 252, 87, 222, 25, 205, 50, 223, 163, 233, 86, 254, 247,
+			53, 186, 230, 123, 179, 30, 219, 225, 251, 96, 41, 133, 177, 67,
+			182, 232, 29, 236, 136, 15, 149, 175, 37, 186, 38, 42, 170, 13,
+			230, 13, 219, 237, 178, 16, 185, 46, 1, 12, 13, 167, 97, 228,
+			184, 46, 221, 181, 111, 48, 234, 37, 219, 68, 208, 162, 34, 240,
+			150, 29, 137, 13, 122, 203, 15, 96, 99, 44, 173, 7, 253, 4,
+			19, 155, 198, 162, 248, 143, 28, 66, 20, 45, 133, 253, 148, 68,
+			209, 176, 219, 217, 17, 153, 52, 32, 153, 27, 223, 78, 115, 249,
+			74, 126, 247, 157, 6, 161, 253, 71, 25, 77, 22, 162, 164, 242,
+			131, 91, 157, 101, 92, 39, 227, 176, 173, 88, 81, 5, 107, 44,
+			178, 94, 69, 204, 150, 227, 178, 41, 141, 26, 211, 67, 139, 39,
+			74, 125, 149, 74, 189, 53, 54, 0, 86, 21, 107, 20, 254, 200,
+			36, 19, 135, 124, 181, 44, 98, 130, 137, 22, 143, 72, 6, 171,
+			248, 27, 78, 78, 58, 118, 227, 9, 123, 135, 225, 9, 201, 96,
+			85, 38, 173, 123, 8, 145, 182, 150, 198, 254, 148, 65, 141, 233,
+			193, 106, 34, 199, 58, 67, 198, 59, 221, 109, 215, 105, 108, 37,
+			138, 17, 106, 76, 167, 170, 57, 254, 97, 37, 46, 124, 138, 140,
+			237, 49, 251, 137, 100, 209, 33, 44, 58, 10, 217, 137, 130, 203,
+			100, 184, 205, 194, 208, 222, 97, 91, 160, 9, 77, 153, 216, 123,
+			122, 160, 247, 253, 61, 31, 18, 181, 224, 92, 197, 90, 34, 131,
+			204, 235, 182, 57, 132, 212, 45, 232, 87, 246, 186, 237, 126, 40,
+			89, 168, 38, 64, 100, 224, 144, 196, 105, 176, 169, 52, 2, 56,
+			117, 0, 64, 141, 127, 239, 135, 33, 235, 89, 203, 100, 16, 77,
+			55, 96, 143, 158, 202, 32, 144, 147, 7, 128, 92, 113, 152, 219,
+			236, 7, 17, 215, 179, 206, 147, 12, 87, 62, 194, 169, 44, 213,
+			166, 135, 22, 239, 58, 4, 132, 203, 214, 121, 153, 170, 44, 108,
+			85, 72, 142, 159, 126, 108, 129, 26, 185, 229, 120, 45, 127, 106,
+			16, 1, 28, 61, 0, 160, 134, 5, 151, 253, 38, 171, 120, 45,
+			191, 58, 26, 246, 164, 173, 219, 73, 58, 220, 247, 34, 251, 230,
+			212, 48, 114, 136, 72, 21, 126, 53, 77, 198, 94, 12, 139, 93,
+			34, 169, 22, 244, 114, 74, 127, 41, 52, 224, 117, 122, 137, 152,
+			126, 153, 68, 92, 34, 67, 30, 11, 35, 214, 228, 28, 97, 188,
+			72, 158, 34, 188, 210, 65, 150, 50, 95, 22, 75, 189, 158, 140,
+			41, 148, 182, 208, 232, 36, 120, 115, 238, 133, 48, 41, 149, 101,
+			189, 42, 84, 171, 142, 42, 56, 152, 182, 86, 8, 241, 61, 230,
+			183, 182, 154, 172, 225, 78, 101, 111, 65, 165, 117, 40, 210, 143,
+			222, 32, 86, 92, 97, 13, 215, 186, 16, 179, 90, 230, 22, 156,
+			114, 157, 79, 178, 3, 220, 182, 73, 70, 229, 161, 162, 232, 217,
+			32, 34, 81, 122, 193, 158, 85, 69, 53, 236, 72, 117, 68, 66,
+			193, 164, 117, 156, 168, 140, 45, 144, 86, 40, 94, 6, 171, 195,
+			50, 115, 205, 110, 179, 252, 147, 100, 180, 151, 60, 112, 230, 27,
+			70, 118, 16, 161, 160, 75, 85, 121, 194, 202, 17, 131, 121, 77,
+			113, 14, 12, 63, 173, 191, 19, 119, 216, 192, 14, 223, 123, 0,
+			221, 94, 200, 253, 253, 206, 223, 79, 70, 122, 58, 240, 98, 155,
+			46, 188, 133, 220, 118, 40, 104, 235, 245, 100, 178, 235, 41, 131,
+			42, 107, 110, 113, 12, 167, 254, 223, 204, 45, 120, 110, 51, 89,
+			154, 67, 169, 78, 244, 128, 224, 153, 167, 7, 179, 95, 201, 228,
+			126, 244, 71, 127, 244, 71, 245, 194, 111, 164, 201, 228, 97, 115,
+			230, 208, 233, 123, 59, 73, 123, 221, 246, 54, 11, 144, 72, 169,
+			170, 72, 89, 75, 36, 229, 218, 219, 204, 157, 50, 169, 54, 61,
+			186, 120, 230, 69, 205, 202, 210, 42, 84, 169, 242, 154, 214, 131,
+			196, 20, 34, 26, 32, 156, 126, 113, 16, 96, 58, 86, 177, 158,
+			117, 132, 12, 194, 95, 206, 27, 105, 196, 57, 11, 25, 192, 23,
+			86, 158, 100, 113, 154, 52, 153, 92, 218, 84, 26, 24, 171, 201,
+			90, 118, 215, 141, 182, 80, 109, 64, 134, 31, 172, 14, 139, 204,
+			215, 65, 158, 117, 148, 12, 225, 228, 216, 130, 67, 135, 155, 40,
+			61, 83, 85, 62, 209, 42, 144, 3, 205, 63, 30, 250, 158, 100,
+			77, 128, 144, 133, 12, 108, 254, 254, 152, 185, 184, 224, 190, 251,
+			240, 238, 245, 243, 148, 117, 138, 140, 97, 137, 179, 98, 232, 109,
+			119, 106, 156, 106, 211, 217, 234, 40, 207, 94, 23, 185, 133, 207,
+			232, 196, 4, 98, 88, 99, 100, 168, 254, 200, 70, 121, 107, 101,
+			125, 243, 242, 106, 57, 167, 89, 163, 132, 96, 198, 149, 213, 245,
+			165, 122, 78, 87, 105, 52, 176, 229, 12, 85, 129, 91, 28, 115,
+			102, 178, 192, 217, 197, 92, 202, 202, 145, 97, 76, 95, 169, 188,
+			190, 188, 114, 254, 92, 46, 221, 155, 115, 118, 49, 151, 177, 70,
+			200, 32, 230, 92, 94, 95, 95, 205, 101, 21, 76, 56, 31, 91,
+			187, 154, 27, 84, 48, 175, 86, 215, 55, 55, 114, 68, 65, 184,
+			94, 174, 213, 150, 174, 150, 115, 67, 170, 196, 229, 71, 234, 229,
+			90, 110, 184, 7, 173, 179, 139, 185, 17, 213, 68, 121, 109, 243,
+			122, 110, 212, 26, 39, 35, 152, 172, 73, 36, 198, 250, 178, 206,
+			159, 203, 229, 98, 68, 56, 148, 241, 158, 140, 243, 231, 114, 86,
+			97, 153, 164, 144, 13, 45, 139, 140, 174, 46, 93, 46, 175, 110,
+			173, 227, 9, 225, 210, 106, 78, 139, 243, 170, 229, 31, 218, 172,
+			84, 203, 43, 57, 61, 153, 183, 81, 94, 170, 151, 87, 114, 70,
+			161, 65, 38, 15, 19, 168, 135, 78, 161, 4, 47, 232, 183, 224,
+			5, 132, 213, 207, 11, 133, 63, 212, 201, 196, 33, 139, 202, 161,
+			141, 188, 134, 164, 56, 47, 243, 101, 118, 230, 64, 19, 0, 8,
+			57, 187, 15, 90, 149, 215, 75, 170, 26, 198, 45, 84, 13, 0,
+			113, 128, 97, 223, 120, 64, 248, 243, 245, 241, 252, 161, 213, 251,
+			26, 199, 188, 151, 182, 8, 164, 14, 89, 4, 46, 145, 241, 3,
+			128, 94, 180, 48, 126, 155, 70, 166, 110, 69, 156, 23, 16, 137,
+			122, 143, 72, 188, 212, 79, 193, 99, 135, 146, 0, 219, 57, 48,
+			214, 63, 167, 145, 219, 15, 87, 41, 15, 197, 225, 65, 146, 230,
+			38, 4, 49, 222, 7, 215, 174, 235, 248, 185, 15, 86, 85, 212,
+			74, 174, 246, 198, 45, 86, 123, 129, 205, 1, 76, 223, 169, 147,
+			219, 14, 5, 126, 40, 162, 119, 19, 226, 120, 157, 110, 196, 85,
+			39, 32, 216, 96, 117, 16, 115, 80, 120, 129, 148, 237, 70, 234,
+			187, 129, 223, 9, 207, 194, 2, 175, 138, 17, 53, 17, 209, 123,
+			110, 209, 211, 126, 60, 173, 121, 146, 107, 184, 14, 243, 162, 173,
+			48, 10, 152, 221, 118, 188, 29, 92, 106, 178, 23, 83, 45, 219,
+			13, 89, 117, 140, 127, 174, 201, 175, 80, 3, 25, 40, 72, 212,
+			72, 247, 212, 224, 159, 85, 141, 194, 123, 6, 201, 80, 66, 1,
+			183, 142, 145, 225, 199, 237, 27, 246, 150, 220, 84, 105, 184, 169,
+			26, 130, 188, 13, 177, 177, 154, 39, 147, 144, 220, 242, 187, 17,
+			11, 182, 26, 174, 29, 134, 64, 40, 212, 239, 7, 171, 22, 124,
+			91, 135, 79, 203, 242, 139, 117, 31, 153, 128, 220, 173, 118, 215,
+			141, 156, 142, 203, 182, 96, 155, 23, 78, 145, 36, 102, 227, 80,
+			226, 186, 40, 0, 24, 133, 214, 10, 185, 27, 50, 183, 132, 213,
+			130, 109, 177, 31, 238, 218, 110, 184, 101, 123, 205, 173, 93, 59,
+			220, 157, 154, 4, 0, 151, 245, 41, 173, 122, 39, 20, 188, 42,
+			202, 149, 177, 216, 146, 215, 188, 102, 135, 187, 214, 69, 114, 59,
+			124, 4, 138, 56, 222, 206, 86, 99, 151, 53, 158, 216, 234, 70,
+			173, 87, 77, 29, 73, 182, 143, 24, 114, 19, 218, 50, 20, 217,
+			140, 90, 175, 178, 106, 100, 24, 198, 174, 237, 60, 201, 182, 90,
+			126, 128, 107, 232, 232, 226, 204, 243, 109, 97, 74, 235, 162, 194,
+			117, 191, 201, 46, 166, 106, 27, 229, 242, 74, 117, 72, 66, 185,
+			226, 7, 214, 221, 132, 236, 248, 138, 192, 67, 72, 181, 193, 29,
+			95, 146, 247, 62, 50, 209, 104, 240, 62, 59, 141, 45, 177, 25,
+			11, 167, 114, 61, 196, 106, 52, 176, 179, 78, 67, 240, 120, 104,
+			93, 32, 183, 197, 196, 74, 86, 28, 79, 86, 156, 80, 116, 74,
+			84, 189, 143, 76, 116, 246, 15, 86, 180, 122, 90, 236, 236, 247,
+			87, 187, 159, 76, 118, 118, 59, 7, 235, 157, 78, 214, 179, 58,
+			187, 157, 254, 138, 39, 113, 103, 30, 48, 52, 5, 79, 221, 145,
+			44, 158, 248, 96, 149, 72, 174, 209, 216, 98, 30, 24, 175, 182,
+			236, 128, 121, 118, 56, 117, 20, 11, 155, 81, 208, 101, 213, 209,
+			70, 163, 140, 31, 151, 240, 155, 117, 154, 140, 251, 219, 143, 55,
+			56, 71, 110, 117, 2, 214, 114, 110, 78, 157, 64, 242, 142, 193,
+			7, 228, 199, 13, 204, 182, 102, 72, 174, 17, 238, 218, 65, 7,
+			69, 114, 216, 177, 27, 108, 234, 36, 47, 202, 243, 215, 100, 54,
+			204, 136, 112, 207, 105, 69, 18, 226, 41, 44, 54, 132, 121, 2,
+			218, 52, 201, 1, 37, 122, 26, 158, 198, 98, 163, 157, 221, 78,
+			178, 221, 227, 100, 164, 179, 155, 108, 116, 6, 139, 13, 119, 118,
+			19, 45, 158, 35, 183, 67, 161, 54, 139, 108, 112, 149, 76, 148,
+			46, 98, 105, 32, 251, 117, 241, 177, 7, 207, 160, 187, 189, 175,
+			24, 107, 22, 203, 14, 65, 158, 100, 173, 87, 76, 57, 47, 92,
+			36, 195, 73, 190, 183, 6, 9, 231, 252, 156, 6, 74, 208, 242,
+			250, 74, 121, 171, 86, 121, 180, 156, 211, 65, 141, 90, 173, 212,
+			203, 91, 213, 205, 53, 56, 115, 206, 25, 9, 197, 254, 33, 51,
+			123, 111, 238, 20, 104, 13, 163, 189, 59, 53, 235, 213, 228, 14,
+			105, 86, 129, 131, 241, 61, 39, 192, 9, 217, 182, 249, 226, 168,
+			248, 103, 82, 148, 170, 177, 232, 97, 39, 16, 230, 82, 107, 149,
+			28, 245, 252, 45, 105, 156, 222, 138, 13, 90, 91, 118, 163, 193,
+			194, 208, 15, 166, 244, 36, 148, 187, 60, 191, 38, 10, 199, 43,
+			196, 146, 40, 218, 199, 190, 198, 173, 216, 247, 8, 25, 108, 219,
+			157, 45, 230, 69, 193, 62, 234, 231, 217, 106, 182, 109, 119, 202,
+			144, 254, 91, 217, 38, 61, 100, 102, 205, 92, 234, 33, 51, 155,
+			202, 165, 31, 50, 179, 233, 92, 230, 33, 51, 155, 205, 13, 62,
+			100, 102, 7, 115, 164, 240, 94, 147, 12, 39, 53, 120, 107, 137,
+			164, 26, 184, 134, 1, 69, 71, 23, 143, 63, 175, 190, 95, 90,
+			134, 197, 237, 98, 154, 171, 203, 85, 94, 19, 20, 11, 96, 63,
+			198, 213, 147, 108, 85, 164, 172, 171, 36, 253, 120, 8, 37, 112,
+			53, 26, 93, 60, 241, 252, 176, 31, 170, 33, 240, 193, 135, 106,
+			91, 107, 235, 213, 235, 75, 171, 85, 81, 221, 186, 147, 152, 174,
+			253, 228, 126, 239, 50, 136, 89, 86, 137, 140, 117, 225, 72, 193,
+			105, 57, 172, 185, 5, 89, 83, 99, 201, 82, 163, 241, 215, 85,
+			40, 255, 34, 135, 241, 78, 98, 130, 137, 175, 119, 177, 194, 172,
+			87, 112, 58, 205, 145, 20, 210, 215, 34, 68, 80, 56, 55, 96,
+			101, 137, 185, 188, 94, 93, 201, 105, 48, 135, 120, 238, 214, 70,
+			165, 188, 92, 206, 233, 133, 251, 72, 154, 19, 13, 166, 155, 34,
+			91, 110, 64, 36, 5, 12, 77, 126, 221, 188, 126, 185, 92, 205,
+			233, 7, 152, 165, 16, 146, 225, 164, 38, 255, 183, 194, 167, 133,
+			255, 67, 35, 67, 9, 205, 28, 54, 174, 54, 248, 40, 111, 217,
+			174, 99, 135, 130, 149, 8, 102, 45, 65, 206, 139, 29, 186, 191,
+			13, 228, 249, 244, 42, 252, 180, 70, 114, 253, 170, 113, 31, 154,
+			218, 255, 74, 52, 11, 31, 210, 200, 168, 88, 128, 15, 71, 239,
+			216, 255, 82, 244, 254, 31, 157, 140, 244, 104, 193, 47, 22, 187,
+			31, 38, 227, 78, 147, 181, 59, 126, 4, 230, 247, 45, 23, 238,
+			1, 76, 21, 80, 200, 204, 61, 191, 158, 93, 170, 196, 245, 86,
+			161, 218, 197, 137, 202, 74, 249, 250, 198, 122, 189, 188, 182, 252,
+			200, 214, 230, 218, 107, 215, 214, 31, 94, 171, 230, 18, 224, 177,
+			216, 43, 71, 144, 194, 6, 201, 245, 35, 101, 221, 65, 14, 67,
+			43, 55, 96, 77, 144, 177, 181, 245, 173, 90, 101, 165, 188, 85,
+			190, 114, 165, 188, 92, 175, 113, 203, 137, 42, 93, 239, 153, 224,
+			133, 15, 26, 100, 226, 16, 76, 172, 37, 177, 231, 225, 219, 176,
+			217, 23, 131, 125, 9, 180, 142, 13, 59, 136, 196, 22, 105, 134,
+			0, 149, 188, 8, 68, 111, 32, 44, 82, 6, 90, 164, 198, 226,
+			124, 156, 26, 86, 145, 88, 29, 63, 116, 224, 172, 109, 203, 241,
+			164, 249, 10, 54, 70, 102, 53, 39, 191, 84, 188, 72, 149, 150,
+			167, 100, 137, 210, 32, 252, 141, 106, 78, 126, 81, 165, 143, 145,
+			225, 166, 223, 5, 109, 145, 67, 133, 181, 70, 171, 14, 241, 60,
+			85, 68, 236, 3, 98, 187, 217, 112, 117, 136, 231, 241, 34, 167,
+			200, 152, 189, 179, 19, 0, 112, 9, 136, 239, 108, 70, 85, 54,
+			22, 204, 63, 68, 178, 146, 14, 96, 74, 3, 74, 108, 117, 248,
+			118, 93, 7, 83, 154, 39, 63, 30, 35, 195, 78, 184, 165, 108,
+			222, 83, 58, 213, 167, 179, 213, 33, 39, 84, 38, 212, 194, 207,
+			233, 100, 180, 247, 24, 195, 90, 33, 89, 240, 6, 6, 122, 139,
+			51, 180, 233, 23, 56, 249, 40, 173, 138, 242, 85, 85, 51, 255,
+			219, 26, 201, 202, 108, 235, 118, 98, 118, 236, 104, 23, 193, 165,
+			46, 235, 57, 173, 138, 105, 200, 15, 59, 182, 55, 165, 199, 249,
+			144, 6, 45, 217, 101, 118, 19, 183, 77, 126, 27, 238, 99, 240,
+			205, 246, 96, 117, 76, 228, 47, 139, 108, 56, 77, 139, 2, 219,
+			113, 123, 202, 154, 88, 54, 39, 63, 168, 194, 23, 201, 157, 18,
+			110, 147, 69, 118, 99, 151, 53, 227, 74, 112, 72, 50, 88, 189,
+			67, 20, 88, 17, 223, 101, 221, 194, 23, 52, 50, 46, 55, 122,
+			77, 69, 172, 235, 132, 216, 158, 231, 71, 73, 114, 29, 100, 229,
+			3, 245, 74, 75, 170, 82, 53, 1, 32, 223, 38, 36, 254, 114,
+			75, 178, 29, 37, 67, 226, 140, 10, 246, 179, 194, 52, 64, 120,
+			214, 21, 199, 69, 3, 206, 54, 219, 113, 60, 97, 121, 230, 9,
+			105, 192, 49, 149, 1, 231, 242, 223, 61, 252, 54, 87, 174, 207,
+			60, 17, 94, 211, 30, 157, 125, 193, 251, 92, 177, 118, 219, 115,
+			149, 43, 223, 127, 149, 171, 202, 90, 46, 195, 227, 233, 135, 254,
+			243, 111, 233, 112, 143, 235, 212, 192, 7, 50, 26, 249, 196, 24,
+			222, 227, 58, 245, 131, 123, 92, 63, 184, 199, 245, 131, 123, 92,
+			63, 184, 199, 245, 131, 123, 92, 63, 184, 199, 245, 242, 239, 113,
+			45, 254, 103, 157, 46, 117, 163, 93, 63, 184, 72, 159, 96, 94,
+			228, 123, 127, 39, 22, 236, 116, 250, 181, 152, 69, 95, 103, 7,
+			77, 27, 156, 145, 47, 219, 33, 107, 82, 223, 163, 126, 224, 236,
+			56, 158, 237, 30, 92, 128, 154, 44, 116, 118, 60, 244, 32, 165,
+			53, 219, 123, 220, 222, 167, 87, 119, 89, 219, 222, 3, 95, 228,
+			135, 88, 171, 69, 87, 152, 237, 21, 99, 231, 207, 80, 94, 195,
+			17, 182, 161, 132, 195, 155, 227, 50, 202, 215, 203, 109, 184, 54,
+			3, 137, 150, 227, 9, 1, 215, 242, 187, 94, 19, 202, 242, 21,
+			153, 194, 34, 31, 150, 96, 2, 220, 176, 93, 167, 153, 204, 150,
+			78, 141, 81, 96, 123, 161, 11, 59, 22, 218, 116, 2, 214, 136,
+			220, 125, 16, 51, 54, 61, 196, 157, 137, 40, 41, 2, 183, 192,
+			184, 76, 4, 55, 23, 240, 193, 131, 21, 109, 154, 149, 118, 74,
+			170, 76, 192, 213, 33, 16, 105, 212, 105, 119, 252, 32, 10, 103,
+			212, 45, 185, 25, 117, 75, 238, 204, 192, 138, 188, 251, 6, 63,
+			249, 229, 181, 98, 124, 247, 173, 168, 238, 190, 205, 14, 44, 200,
+			187, 111, 240, 147, 103, 150, 6, 238, 151, 151, 236, 224, 39, 207,
+			156, 139, 239, 190, 193, 79, 158, 57, 31, 223, 188, 155, 87, 55,
+			239, 206, 13, 220, 69, 126, 132, 232, 217, 65, 252, 153, 143, 104,
+			191, 235, 24, 95, 253, 182, 25, 149, 246, 112, 126, 1, 43, 236,
+			48, 240, 197, 101, 13, 27, 150, 240, 64, 41, 38, 179, 219, 192,
+			14, 132, 218, 46, 220, 44, 139, 118, 219, 33, 109, 250, 222, 169,
+			136, 238, 249, 193, 19, 180, 217, 5, 165, 157, 110, 251, 126, 20,
+			70, 1, 119, 79, 47, 17, 242, 56, 94, 205, 51, 95, 53, 112,
+			81, 203, 191, 9, 253, 157, 165, 250, 64, 27, 126, 187, 227, 184,
+			232, 18, 233, 81, 126, 68, 115, 96, 108, 106, 12, 188, 239, 60,
+			88, 159, 165, 71, 50, 31, 104, 194, 25, 128, 58, 17, 247, 64,
+			6, 182, 34, 220, 77, 210, 120, 85, 246, 78, 50, 36, 156, 36,
+			141, 11, 250, 52, 25, 70, 119, 187, 1, 211, 50, 46, 232, 68,
+			166, 210, 150, 113, 97, 232, 30, 153, 210, 44, 227, 194, 209, 227,
+			50, 101, 88, 198, 133, 123, 79, 145, 57, 162, 155, 154, 101, 62,
+			48, 240, 168, 150, 63, 78, 87, 4, 107, 242, 187, 127, 224, 147,
+			28, 73, 108, 16, 25, 129, 129, 166, 89, 198, 3, 217, 35, 228,
+			2, 49, 77, 13, 48, 120, 80, 63, 82, 40, 98, 9, 92, 11,
+			139, 61, 215, 252, 2, 223, 143, 18, 74, 73, 20, 48, 198, 49,
+			212, 16, 223, 7, 5, 190, 26, 56, 84, 26, 15, 14, 141, 203,
+			148, 102, 25, 15, 90, 183, 203, 148, 97, 25, 15, 222, 153, 39,
+			167, 177, 73, 205, 50, 94, 163, 223, 83, 184, 155, 34, 203, 22,
+			90, 190, 95, 40, 226, 159, 210, 182, 29, 20, 138, 148, 69, 141,
+			146, 132, 170, 153, 80, 88, 165, 82, 150, 241, 26, 213, 6, 116,
+			228, 53, 214, 157, 50, 101, 88, 198, 107, 238, 186, 155, 156, 195,
+			54, 116, 203, 184, 172, 31, 203, 159, 162, 107, 114, 117, 23, 195,
+			129, 147, 1, 216, 103, 63, 158, 212, 170, 53, 221, 132, 106, 42,
+			149, 178, 140, 203, 170, 53, 93, 179, 140, 203, 214, 93, 50, 101,
+			88, 198, 229, 163, 148, 252, 16, 182, 102, 88, 198, 138, 62, 157,
+			95, 161, 232, 41, 193, 219, 3, 86, 224, 142, 129, 113, 163, 2,
+			7, 161, 236, 40, 55, 64, 174, 47, 225, 21, 125, 133, 138, 97,
+			2, 76, 149, 74, 89, 198, 202, 80, 78, 166, 52, 203, 88, 25,
+			47, 200, 20, 180, 126, 242, 20, 121, 18, 81, 49, 45, 227, 170,
+			126, 111, 190, 221, 143, 10, 152, 32, 95, 28, 34, 37, 238, 214,
+			206, 85, 165, 89, 180, 0, 128, 100, 109, 59, 59, 1, 23, 53,
+			190, 231, 238, 151, 232, 138, 15, 119, 247, 225, 142, 187, 194, 217,
+			196, 198, 85, 42, 101, 25, 87, 21, 206, 166, 102, 25, 87, 199,
+			169, 76, 25, 150, 113, 245, 248, 73, 114, 30, 113, 78, 89, 198,
+			67, 122, 49, 63, 131, 218, 126, 228, 119, 102, 209, 46, 211, 35,
+			93, 147, 50, 88, 181, 151, 50, 161, 162, 74, 165, 45, 227, 161,
+			161, 188, 76, 105, 150, 241, 208, 145, 83, 50, 101, 88, 198, 67,
+			167, 207, 224, 172, 211, 244, 180, 101, 188, 86, 159, 21, 159, 210,
+			38, 164, 36, 144, 52, 124, 19, 179, 78, 211, 211, 154, 101, 188,
+			246, 232, 180, 76, 25, 150, 241, 218, 51, 69, 1, 36, 99, 25,
+			171, 122, 73, 124, 202, 152, 144, 146, 64, 50, 105, 203, 88, 29,
+			58, 38, 83, 154, 101, 172, 22, 102, 100, 202, 176, 140, 213, 226,
+			172, 0, 146, 181, 140, 235, 10, 72, 214, 132, 148, 4, 146, 77,
+			91, 198, 245, 161, 163, 50, 165, 89, 198, 117, 42, 129, 100, 13,
+			203, 184, 174, 128, 12, 90, 198, 186, 126, 92, 0, 25, 52, 33,
+			37, 129, 12, 166, 45, 99, 125, 72, 78, 195, 65, 205, 50, 214,
+			239, 144, 157, 27, 52, 44, 99, 253, 88, 129, 252, 133, 134, 80,
+			136, 101, 108, 234, 115, 249, 255, 166, 209, 58, 39, 52, 115, 155,
+			82, 180, 133, 84, 58, 231, 244, 172, 57, 246, 54, 104, 181, 192,
+			93, 106, 253, 77, 236, 93, 74, 132, 62, 226, 119, 81, 135, 14,
+			237, 22, 115, 247, 105, 192, 218, 62, 92, 30, 6, 248, 96, 231,
+			9, 152, 104, 70, 46, 91, 187, 118, 0, 135, 208, 52, 232, 122,
+			224, 193, 76, 104, 171, 235, 137, 91, 46, 78, 180, 47, 89, 57,
+			94, 38, 66, 58, 59, 139, 44, 156, 196, 42, 190, 173, 11, 140,
+			138, 43, 191, 216, 39, 130, 197, 128, 70, 190, 239, 134, 138, 133,
+			136, 9, 221, 86, 169, 180, 101, 108, 14, 73, 137, 66, 52, 203,
+			216, 204, 159, 150, 41, 195, 50, 54, 103, 75, 228, 141, 72, 173,
+			33, 203, 120, 68, 191, 39, 191, 129, 43, 6, 247, 16, 149, 232,
+			37, 5, 46, 255, 220, 237, 136, 105, 39, 188, 189, 97, 79, 80,
+			192, 98, 139, 5, 84, 60, 120, 226, 108, 65, 161, 53, 100, 2,
+			124, 149, 74, 89, 198, 35, 74, 16, 13, 105, 150, 241, 136, 53,
+			37, 83, 134, 101, 60, 114, 228, 110, 114, 138, 232, 166, 110, 153,
+			111, 28, 120, 74, 203, 31, 233, 89, 10, 132, 30, 131, 247, 99,
+			196, 18, 0, 178, 236, 141, 217, 59, 144, 127, 116, 88, 2, 222,
+			164, 31, 65, 120, 58, 10, 245, 55, 137, 150, 117, 20, 234, 111,
+			18, 45, 235, 40, 212, 223, 36, 132, 186, 142, 66, 253, 77, 119,
+			230, 5, 16, 205, 50, 30, 211, 79, 11, 32, 32, 181, 31, 83,
+			64, 180, 180, 101, 60, 38, 56, 89, 71, 169, 253, 24, 61, 41,
+			83, 134, 101, 60, 54, 61, 35, 128, 232, 150, 97, 139, 233, 160,
+			163, 48, 182, 21, 16, 152, 180, 182, 2, 2, 29, 176, 197, 116,
+			208, 81, 24, 219, 98, 58, 96, 162, 161, 159, 17, 159, 64, 140,
+			54, 20, 16, 35, 109, 25, 13, 33, 34, 116, 20, 163, 141, 35,
+			247, 202, 20, 212, 155, 57, 45, 128, 152, 150, 209, 20, 34, 66,
+			71, 185, 214, 84, 64, 204, 180, 101, 52, 133, 136, 208, 97, 253,
+			53, 154, 66, 68, 232, 40, 215, 154, 103, 138, 100, 24, 128, 24,
+			3, 150, 217, 210, 159, 48, 248, 55, 3, 168, 215, 34, 83, 228,
+			8, 73, 67, 10, 200, 190, 99, 222, 93, 24, 166, 149, 132, 31,
+			254, 40, 201, 240, 143, 38, 124, 29, 142, 211, 41, 203, 216, 25,
+			177, 226, 180, 102, 25, 59, 19, 83, 113, 218, 176, 140, 157, 35,
+			119, 41, 224, 154, 101, 236, 154, 71, 10, 195, 180, 124, 243, 32,
+			112, 24, 158, 221, 4, 112, 45, 101, 25, 187, 9, 224, 48, 68,
+			187, 19, 183, 199, 105, 195, 50, 118, 239, 204, 147, 17, 1, 92,
+			183, 140, 199, 205, 57, 245, 25, 6, 234, 241, 4, 56, 24, 170,
+			199, 71, 10, 113, 90, 179, 140, 199, 143, 159, 142, 211, 134, 101,
+			60, 62, 91, 18, 148, 78, 89, 134, 171, 198, 28, 36, 186, 171,
+			40, 157, 74, 91, 134, 43, 166, 163, 142, 18, 221, 205, 203, 49,
+			79, 25, 150, 225, 170, 49, 79, 91, 134, 167, 207, 137, 79, 32,
+			209, 61, 5, 4, 36, 186, 167, 24, 7, 36, 186, 71, 37, 159,
+			166, 13, 203, 240, 20, 38, 25, 203, 232, 232, 146, 29, 64, 162,
+			119, 20, 16, 144, 232, 29, 133, 73, 70, 179, 140, 78, 254, 152,
+			192, 36, 99, 88, 70, 231, 196, 73, 242, 9, 144, 163, 192, 84,
+			102, 87, 191, 105, 228, 127, 82, 163, 85, 52, 115, 249, 45, 101,
+			186, 162, 145, 189, 67, 185, 47, 85, 88, 162, 213, 67, 114, 81,
+			92, 194, 186, 42, 205, 14, 32, 190, 80, 72, 134, 96, 139, 82,
+			86, 97, 26, 0, 108, 181, 132, 135, 118, 91, 109, 88, 18, 128,
+			69, 161, 182, 189, 143, 134, 34, 234, 223, 96, 129, 107, 119, 132,
+			152, 209, 13, 24, 232, 46, 185, 67, 112, 13, 42, 131, 55, 110,
+			193, 146, 92, 221, 187, 161, 134, 153, 43, 124, 55, 20, 215, 104,
+			200, 146, 55, 20, 75, 106, 200, 146, 55, 20, 75, 106, 48, 101,
+			247, 110, 193, 146, 92, 207, 219, 75, 0, 7, 150, 220, 75, 0,
+			7, 76, 247, 20, 75, 106, 200, 146, 123, 74, 248, 100, 45, 99,
+			95, 47, 10, 225, 3, 203, 232, 190, 26, 57, 88, 70, 247, 135,
+			166, 100, 74, 179, 140, 253, 59, 79, 201, 148, 97, 25, 251, 167,
+			207, 144, 183, 226, 200, 233, 131, 150, 241, 22, 253, 100, 190, 27,
+			211, 15, 9, 143, 74, 177, 186, 92, 121, 112, 124, 228, 240, 28,
+			54, 20, 176, 253, 219, 113, 110, 48, 15, 129, 224, 224, 242, 69,
+			137, 197, 60, 225, 123, 13, 169, 210, 232, 184, 124, 191, 69, 33,
+			63, 152, 178, 140, 183, 40, 241, 11, 203, 247, 91, 44, 201, 201,
+			176, 124, 191, 165, 112, 130, 12, 17, 144, 58, 169, 31, 25, 120,
+			135, 166, 161, 112, 7, 177, 246, 35, 217, 187, 201, 58, 49, 77,
+			3, 174, 97, 253, 61, 77, 191, 152, 95, 194, 165, 72, 94, 134,
+			140, 252, 128, 201, 69, 29, 247, 40, 77, 159, 133, 176, 85, 10,
+			88, 195, 223, 241, 156, 39, 25, 133, 171, 174, 37, 90, 99, 194,
+			214, 39, 238, 8, 25, 192, 6, 230, 223, 211, 116, 149, 76, 67,
+			3, 67, 247, 200, 164, 6, 201, 163, 103, 101, 210, 128, 228, 249,
+			11, 228, 81, 64, 44, 101, 153, 111, 215, 244, 59, 243, 215, 233,
+			50, 122, 162, 133, 184, 179, 66, 53, 143, 209, 70, 55, 140, 252,
+			118, 140, 147, 23, 51, 187, 80, 98, 157, 48, 102, 241, 36, 94,
+			32, 108, 141, 212, 0, 0, 31, 153, 226, 13, 167, 224, 54, 218,
+			219, 181, 145, 113, 153, 212, 33, 121, 219, 20, 57, 75, 64, 154,
+			167, 223, 169, 13, 252, 169, 166, 229, 79, 246, 44, 148, 177, 46,
+			130, 87, 226, 212, 24, 146, 33, 98, 152, 176, 207, 122, 167, 150,
+			189, 139, 140, 18, 211, 52, 33, 154, 203, 187, 52, 253, 227, 154,
+			129, 13, 152, 176, 173, 51, 223, 165, 101, 134, 72, 141, 164, 77,
+			113, 253, 237, 221, 154, 57, 153, 95, 166, 243, 252, 34, 172, 24,
+			109, 216, 192, 178, 32, 240, 3, 176, 13, 172, 7, 77, 22, 192,
+			231, 61, 230, 4, 124, 115, 187, 235, 132, 145, 31, 56, 13, 219,
+			165, 1, 179, 67, 56, 121, 36, 100, 140, 100, 56, 80, 13, 161,
+			142, 197, 25, 58, 100, 88, 19, 100, 84, 52, 171, 89, 230, 63,
+			208, 204, 9, 85, 0, 174, 112, 253, 3, 205, 28, 141, 51, 116,
+			200, 24, 183, 200, 158, 168, 161, 195, 165, 46, 115, 34, 191, 67,
+			215, 252, 136, 62, 234, 236, 60, 106, 239, 200, 56, 50, 37, 170,
+			110, 172, 41, 1, 21, 217, 79, 48, 186, 48, 79, 183, 247, 35,
+			22, 150, 40, 221, 12, 25, 77, 56, 28, 83, 167, 69, 168, 60,
+			107, 19, 183, 212, 208, 86, 238, 58, 79, 48, 119, 63, 209, 25,
+			29, 111, 151, 37, 80, 227, 168, 140, 91, 170, 51, 134, 101, 190,
+			87, 51, 39, 85, 1, 144, 173, 239, 77, 118, 223, 208, 33, 195,
+			154, 80, 157, 49, 45, 243, 153, 239, 91, 103, 206, 46, 190, 248,
+			206, 0, 123, 60, 147, 164, 51, 40, 99, 207, 36, 59, 147, 178,
+			204, 247, 107, 230, 109, 170, 64, 74, 195, 140, 92, 156, 161, 67,
+			198, 196, 164, 170, 145, 182, 204, 103, 147, 53, 210, 26, 102, 196,
+			53, 210, 58, 100, 36, 106, 100, 44, 243, 3, 154, 105, 169, 2,
+			25, 13, 51, 70, 226, 12, 29, 50, 114, 227, 170, 70, 214, 50,
+			255, 81, 146, 196, 89, 13, 51, 98, 18, 103, 117, 200, 176, 38,
+			200, 151, 53, 81, 101, 208, 50, 127, 26, 56, 251, 11, 26, 173,
+			219, 59, 179, 77, 230, 58, 109, 7, 54, 149, 234, 192, 179, 68,
+			232, 213, 192, 239, 198, 183, 251, 227, 19, 120, 84, 119, 65, 126,
+			198, 74, 177, 227, 137, 251, 218, 37, 122, 205, 223, 99, 55, 88,
+			80, 228, 102, 188, 179, 132, 58, 96, 197, 80, 39, 2, 161, 188,
+			197, 205, 175, 70, 110, 51, 117, 149, 27, 229, 26, 106, 223, 59,
+			216, 48, 120, 124, 137, 243, 37, 104, 145, 80, 240, 96, 141, 196,
+			71, 33, 175, 237, 144, 118, 61, 12, 103, 40, 36, 120, 98, 56,
+			7, 53, 236, 100, 60, 156, 131, 58, 100, 140, 79, 144, 89, 65,
+			5, 98, 153, 31, 214, 204, 219, 11, 119, 211, 85, 230, 237, 68,
+			187, 135, 211, 65, 213, 39, 26, 150, 143, 199, 142, 232, 144, 49,
+			113, 27, 57, 46, 0, 14, 89, 230, 71, 128, 172, 19, 116, 141,
+			237, 129, 248, 187, 193, 2, 16, 126, 116, 49, 1, 102, 72, 195,
+			82, 49, 94, 67, 58, 100, 140, 199, 2, 96, 216, 50, 127, 38,
+			201, 52, 195, 26, 102, 196, 3, 58, 172, 67, 134, 21, 51, 205,
+			136, 101, 126, 52, 41, 50, 70, 52, 204, 136, 153, 102, 68, 135,
+			140, 92, 204, 202, 163, 150, 249, 179, 154, 121, 135, 42, 48, 170,
+			97, 198, 120, 156, 161, 67, 198, 228, 237, 170, 198, 152, 101, 254,
+			211, 100, 141, 49, 13, 51, 226, 26, 99, 58, 100, 76, 222, 78,
+			78, 137, 26, 57, 203, 252, 57, 205, 188, 173, 112, 7, 8, 152,
+			176, 103, 42, 115, 195, 157, 172, 153, 211, 176, 100, 220, 193, 156,
+			14, 25, 214, 164, 2, 53, 110, 153, 31, 123, 81, 160, 198, 53,
+			44, 25, 131, 26, 215, 33, 3, 105, 5, 66, 95, 179, 210, 63,
+			175, 233, 191, 160, 132, 62, 8, 215, 159, 215, 50, 195, 228, 52,
+			182, 4, 250, 147, 249, 207, 52, 243, 142, 124, 254, 150, 66, 95,
+			54, 134, 234, 146, 249, 207, 226, 201, 170, 161, 44, 255, 103, 218,
+			109, 146, 104, 160, 48, 153, 159, 140, 137, 166, 193, 206, 201, 252,
+			100, 178, 134, 166, 91, 230, 39, 147, 53, 116, 203, 252, 84, 178,
+			6, 128, 248, 84, 178, 6, 136, 216, 79, 65, 13, 88, 51, 77,
+			192, 247, 23, 53, 253, 8, 239, 14, 174, 236, 191, 40, 87, 118,
+			19, 244, 59, 243, 23, 181, 161, 113, 153, 212, 160, 176, 117, 187,
+			76, 26, 144, 188, 51, 47, 32, 105, 150, 249, 105, 77, 191, 75,
+			124, 212, 76, 76, 74, 72, 112, 173, 248, 211, 218, 80, 78, 38,
+			177, 240, 248, 29, 50, 105, 64, 50, 127, 68, 64, 210, 45, 243,
+			151, 98, 156, 116, 19, 147, 18, 146, 158, 134, 164, 130, 4, 253,
+			251, 37, 109, 92, 226, 164, 27, 144, 188, 51, 79, 62, 4, 10,
+			157, 9, 201, 95, 1, 125, 227, 221, 26, 173, 180, 80, 24, 161,
+			115, 44, 12, 77, 200, 34, 113, 86, 9, 145, 189, 164, 70, 23,
+			178, 168, 68, 161, 44, 198, 132, 192, 207, 32, 175, 84, 77, 130,
+			194, 63, 174, 171, 236, 211, 16, 142, 173, 69, 213, 237, 157, 34,
+			77, 222, 253, 1, 197, 61, 190, 27, 36, 20, 41, 83, 55, 76,
+			68, 79, 37, 211, 144, 28, 26, 147, 73, 13, 146, 185, 73, 153,
+			196, 190, 220, 49, 69, 254, 119, 29, 187, 102, 90, 230, 191, 210,
+			116, 154, 255, 152, 142, 198, 58, 161, 171, 128, 172, 163, 112, 113,
+			19, 59, 27, 10, 44, 157, 176, 231, 12, 20, 126, 195, 103, 222,
+			83, 249, 133, 64, 52, 134, 32, 18, 87, 209, 109, 122, 170, 116,
+			170, 8, 138, 33, 152, 130, 186, 174, 187, 63, 11, 94, 242, 224,
+			177, 211, 44, 81, 186, 30, 237, 178, 128, 71, 60, 88, 62, 115,
+			102, 22, 150, 118, 26, 54, 124, 48, 169, 19, 26, 116, 93, 177,
+			74, 202, 115, 211, 150, 35, 14, 127, 161, 89, 17, 136, 164, 229,
+			4, 33, 183, 29, 241, 107, 167, 28, 99, 169, 125, 1, 233, 73,
+			220, 43, 36, 186, 29, 128, 231, 7, 244, 137, 121, 113, 57, 6,
+			230, 117, 230, 97, 96, 12, 17, 221, 4, 50, 193, 108, 77, 168,
+			114, 133, 158, 81, 84, 55, 57, 229, 84, 50, 5, 73, 197, 228,
+			176, 140, 255, 43, 205, 146, 220, 103, 26, 144, 188, 231, 40, 249,
+			187, 72, 244, 148, 101, 254, 107, 77, 63, 154, 239, 208, 43, 201,
+			189, 216, 11, 208, 153, 110, 51, 176, 105, 137, 219, 120, 64, 190,
+			10, 144, 21, 163, 179, 249, 238, 13, 214, 236, 221, 57, 216, 158,
+			199, 2, 8, 224, 167, 184, 78, 225, 158, 50, 17, 1, 149, 68,
+			124, 20, 238, 160, 80, 252, 107, 205, 202, 203, 164, 1, 201, 187,
+			239, 33, 239, 225, 28, 147, 182, 204, 207, 105, 250, 137, 252, 91,
+			57, 199, 120, 221, 54, 248, 225, 75, 70, 81, 134, 190, 30, 107,
+			94, 196, 110, 70, 113, 124, 66, 97, 253, 229, 60, 131, 202, 144,
+			48, 21, 111, 251, 190, 203, 108, 47, 44, 210, 66, 20, 116, 89,
+			1, 24, 190, 128, 206, 119, 5, 81, 66, 4, 131, 235, 107, 71,
+			220, 63, 228, 205, 192, 23, 220, 19, 76, 195, 100, 100, 97, 195,
+			238, 112, 210, 192, 121, 215, 158, 189, 63, 35, 27, 219, 143, 14,
+			32, 188, 172, 202, 115, 180, 120, 252, 95, 84, 234, 232, 131, 15,
+			208, 133, 197, 87, 33, 15, 137, 66, 138, 158, 105, 19, 105, 162,
+			146, 41, 72, 42, 122, 130, 186, 245, 57, 205, 58, 42, 147, 6,
+			36, 11, 199, 201, 223, 231, 194, 37, 99, 153, 191, 165, 233, 199,
+			242, 55, 96, 22, 161, 72, 128, 141, 94, 40, 204, 145, 77, 118,
+			19, 230, 154, 77, 125, 240, 143, 149, 35, 44, 144, 6, 118, 0,
+			186, 159, 10, 105, 124, 145, 153, 224, 41, 64, 137, 38, 205, 175,
+			32, 127, 104, 155, 129, 174, 10, 208, 208, 183, 4, 107, 168, 46,
+			100, 76, 68, 67, 37, 83, 144, 84, 242, 17, 244, 191, 223, 210,
+			198, 165, 88, 206, 24, 144, 60, 74, 201, 183, 121, 23, 178, 150,
+			249, 31, 161, 11, 255, 93, 227, 161, 114, 98, 206, 149, 24, 148,
+			112, 215, 136, 68, 21, 82, 19, 14, 78, 14, 156, 144, 149, 132,
+			36, 33, 176, 39, 14, 232, 174, 205, 139, 218, 180, 160, 110, 140,
+			22, 196, 206, 142, 250, 202, 146, 207, 220, 102, 81, 244, 9, 63,
+			157, 10, 249, 126, 1, 142, 58, 93, 87, 238, 176, 75, 73, 105,
+			227, 68, 16, 16, 154, 53, 187, 13, 225, 106, 129, 228, 70, 98,
+			157, 10, 249, 204, 219, 222, 151, 193, 98, 80, 26, 57, 24, 19,
+			167, 97, 183, 153, 187, 108, 135, 50, 212, 133, 169, 103, 77, 236,
+			188, 74, 166, 32, 169, 198, 30, 212, 224, 255, 24, 203, 129, 172,
+			1, 201, 123, 168, 88, 162, 6, 45, 243, 139, 154, 126, 66, 124,
+			28, 52, 49, 41, 33, 13, 166, 33, 57, 36, 87, 55, 208, 36,
+			191, 168, 77, 73, 46, 26, 52, 32, 89, 56, 78, 62, 61, 136,
+			67, 64, 44, 243, 79, 52, 253, 100, 254, 163, 131, 72, 194, 160,
+			43, 93, 104, 156, 48, 142, 99, 84, 144, 214, 247, 66, 137, 62,
+			12, 17, 144, 212, 23, 197, 38, 178, 4, 144, 8, 180, 93, 27,
+			66, 193, 241, 121, 219, 0, 91, 195, 142, 29, 52, 93, 22, 138,
+			147, 48, 168, 36, 204, 193, 28, 96, 223, 21, 92, 24, 235, 4,
+			46, 188, 21, 185, 216, 109, 51, 12, 0, 23, 249, 138, 187, 225,
+			180, 19, 78, 217, 65, 98, 248, 212, 119, 155, 18, 189, 134, 216,
+			230, 227, 32, 43, 108, 16, 56, 122, 250, 163, 98, 20, 55, 1,
+			220, 6, 130, 81, 204, 153, 144, 114, 237, 220, 6, 82, 20, 192,
+			200, 190, 203, 34, 167, 81, 224, 147, 166, 40, 92, 138, 14, 224,
+			7, 103, 222, 161, 239, 162, 163, 21, 206, 156, 105, 136, 154, 37,
+			81, 82, 93, 228, 157, 218, 97, 112, 66, 30, 133, 20, 26, 82,
+			77, 240, 22, 102, 74, 180, 38, 115, 4, 82, 16, 235, 11, 206,
+			234, 28, 175, 239, 36, 2, 205, 58, 28, 165, 38, 63, 24, 147,
+			215, 208, 80, 126, 45, 109, 84, 14, 3, 38, 81, 246, 193, 14,
+			0, 59, 135, 22, 68, 224, 177, 93, 151, 22, 2, 102, 187, 162,
+			167, 161, 140, 52, 37, 215, 67, 68, 61, 44, 30, 24, 53, 105,
+			196, 105, 192, 102, 7, 92, 218, 246, 105, 200, 218, 182, 7, 61,
+			226, 62, 119, 69, 34, 162, 237, 64, 68, 153, 128, 117, 160, 92,
+			83, 173, 179, 114, 99, 196, 67, 113, 33, 19, 41, 30, 82, 155,
+			51, 80, 10, 112, 255, 69, 84, 96, 44, 24, 96, 22, 55, 213,
+			132, 176, 227, 46, 112, 218, 222, 46, 83, 46, 88, 40, 25, 246,
+			2, 63, 98, 9, 126, 134, 149, 194, 243, 35, 60, 40, 113, 66,
+			233, 23, 209, 13, 89, 171, 235, 34, 115, 4, 224, 85, 49, 27,
+			5, 78, 167, 211, 123, 190, 142, 231, 213, 37, 36, 75, 195, 247,
+			66, 39, 68, 39, 104, 186, 199, 8, 202, 225, 3, 125, 234, 31,
+			92, 106, 187, 161, 95, 164, 12, 108, 115, 112, 248, 180, 179, 43,
+			180, 29, 24, 59, 25, 182, 140, 7, 155, 234, 163, 67, 93, 76,
+			79, 134, 238, 92, 182, 235, 238, 139, 115, 85, 8, 14, 40, 189,
+			18, 132, 98, 2, 125, 110, 216, 222, 169, 136, 208, 136, 185, 46,
+			117, 90, 212, 238, 149, 237, 124, 68, 253, 0, 2, 139, 129, 230,
+			86, 132, 88, 180, 78, 196, 185, 89, 140, 132, 28, 79, 210, 223,
+			9, 96, 140, 13, 160, 198, 98, 31, 95, 135, 146, 11, 65, 12,
+			243, 57, 226, 218, 59, 197, 36, 122, 251, 212, 118, 193, 77, 100,
+			95, 13, 35, 137, 129, 128, 122, 69, 31, 235, 189, 45, 254, 152,
+			146, 157, 196, 68, 169, 165, 146, 41, 72, 42, 205, 21, 182, 186,
+			127, 162, 229, 164, 196, 35, 6, 36, 11, 39, 72, 129, 232, 102,
+			202, 74, 127, 77, 27, 248, 134, 166, 229, 39, 123, 76, 111, 178,
+			55, 96, 105, 3, 61, 230, 107, 96, 105, 3, 97, 155, 130, 61,
+			202, 115, 114, 63, 144, 194, 61, 202, 115, 178, 233, 20, 238, 81,
+			158, 147, 98, 59, 133, 123, 170, 231, 228, 30, 37, 133, 123, 148,
+			231, 228, 30, 37, 5, 155, 133, 175, 75, 177, 157, 2, 139, 179,
+			249, 245, 24, 146, 150, 134, 175, 66, 108, 167, 112, 143, 242, 117,
+			41, 182, 83, 184, 71, 249, 186, 86, 56, 142, 199, 108, 105, 43,
+			253, 63, 180, 129, 255, 77, 215, 242, 119, 38, 59, 225, 197, 154,
+			183, 232, 9, 104, 16, 255, 67, 203, 242, 157, 77, 26, 122, 242,
+			151, 178, 39, 105, 236, 201, 95, 202, 246, 211, 216, 147, 191, 148,
+			61, 73, 99, 79, 254, 82, 246, 36, 141, 61, 249, 75, 217, 147,
+			52, 244, 228, 175, 52, 189, 36, 62, 66, 79, 254, 42, 134, 4,
+			61, 249, 43, 109, 232, 184, 76, 98, 225, 19, 51, 50, 105, 64,
+			178, 56, 43, 32, 233, 150, 249, 29, 77, 151, 101, 97, 183, 245,
+			157, 24, 18, 236, 182, 190, 163, 13, 73, 36, 160, 217, 239, 104,
+			119, 220, 35, 154, 133, 237, 213, 119, 180, 99, 5, 242, 41, 80,
+			48, 211, 198, 128, 149, 126, 155, 174, 191, 67, 55, 242, 63, 173,
+			31, 114, 244, 33, 21, 78, 92, 226, 147, 167, 31, 194, 172, 118,
+			216, 193, 7, 220, 134, 115, 250, 78, 57, 128, 200, 135, 30, 113,
+			244, 157, 112, 128, 57, 83, 4, 55, 150, 11, 42, 120, 218, 58,
+			94, 35, 226, 154, 67, 159, 131, 85, 73, 130, 228, 168, 163, 132,
+			135, 240, 145, 17, 6, 50, 84, 177, 178, 104, 216, 109, 236, 202,
+			79, 40, 176, 236, 78, 39, 240, 59, 129, 99, 71, 252, 140, 91,
+			104, 203, 48, 199, 228, 41, 183, 227, 69, 103, 23, 9, 109, 250,
+			109, 219, 241, 196, 52, 74, 195, 129, 160, 249, 54, 157, 28, 33,
+			119, 145, 180, 153, 134, 67, 60, 203, 252, 251, 250, 129, 243, 23,
+			216, 187, 227, 87, 19, 63, 15, 199, 25, 41, 200, 24, 177, 226,
+			12, 13, 50, 38, 166, 226, 12, 3, 50, 142, 220, 165, 90, 0,
+			171, 184, 110, 30, 185, 69, 11, 192, 72, 111, 79, 182, 0, 27,
+			247, 183, 39, 91, 128, 105, 241, 118, 125, 226, 246, 56, 195, 128,
+			140, 59, 243, 252, 244, 43, 13, 60, 241, 19, 186, 62, 127, 248,
+			233, 215, 45, 89, 160, 247, 67, 255, 25, 24, 1, 157, 19, 134,
+			93, 126, 239, 103, 7, 218, 100, 13, 215, 14, 112, 127, 114, 75,
+			214, 32, 9, 222, 224, 12, 12, 27, 240, 159, 208, 21, 183, 27,
+			105, 72, 14, 221, 37, 147, 16, 17, 78, 191, 251, 140, 76, 98,
+			207, 74, 115, 196, 5, 102, 135, 137, 242, 180, 174, 159, 200, 191,
+			41, 110, 46, 198, 240, 150, 39, 70, 1, 131, 254, 148, 14, 63,
+			20, 34, 135, 157, 10, 241, 198, 97, 211, 250, 116, 140, 169, 153,
+			130, 164, 146, 21, 176, 105, 125, 90, 23, 27, 149, 52, 110, 90,
+			159, 214, 11, 199, 201, 2, 209, 205, 140, 149, 254, 135, 250, 192,
+			7, 244, 126, 255, 48, 164, 164, 220, 69, 11, 209, 37, 164, 22,
+			108, 26, 254, 161, 158, 165, 40, 33, 50, 192, 148, 239, 211, 133,
+			212, 202, 160, 212, 122, 159, 196, 36, 131, 44, 248, 62, 137, 73,
+			6, 165, 214, 251, 116, 33, 181, 50, 200, 126, 239, 211, 133, 212,
+			202, 128, 248, 120, 70, 23, 54, 162, 12, 202, 223, 103, 98, 72,
+			192, 106, 207, 232, 98, 231, 146, 65, 169, 245, 140, 46, 108, 68,
+			25, 100, 179, 103, 116, 97, 35, 202, 128, 21, 235, 89, 93, 159,
+			22, 31, 97, 48, 158, 141, 33, 129, 212, 122, 86, 31, 146, 24,
+			67, 179, 207, 234, 119, 29, 23, 205, 2, 135, 62, 171, 223, 123,
+			138, 156, 32, 186, 153, 181, 210, 63, 169, 15, 252, 99, 93, 203,
+			223, 222, 67, 29, 113, 57, 93, 16, 4, 54, 3, 63, 169, 103,
+			225, 196, 223, 52, 179, 64, 144, 15, 73, 130, 100, 145, 32, 31,
+			146, 141, 103, 145, 32, 31, 146, 4, 201, 34, 65, 62, 36, 9,
+			146, 69, 130, 124, 72, 18, 36, 11, 152, 253, 148, 174, 23, 197,
+			71, 32, 200, 79, 197, 144, 64, 140, 255, 148, 62, 68, 101, 18,
+			11, 31, 59, 37, 147, 6, 36, 79, 159, 17, 144, 192, 152, 172,
+			235, 247, 138, 143, 64, 144, 15, 199, 144, 128, 32, 31, 214, 135,
+			238, 148, 73, 48, 69, 235, 249, 99, 50, 105, 64, 242, 196, 73,
+			50, 79, 116, 115, 208, 74, 255, 140, 62, 240, 207, 117, 45, 95,
+			232, 33, 8, 143, 162, 33, 131, 28, 38, 137, 3, 251, 155, 159,
+			209, 179, 119, 35, 34, 131, 64, 156, 143, 74, 226, 12, 34, 113,
+			62, 42, 17, 25, 68, 226, 124, 84, 18, 103, 16, 137, 243, 81,
+			73, 156, 65, 36, 206, 71, 129, 56, 207, 130, 40, 25, 4, 234,
+			124, 76, 215, 143, 229, 127, 92, 163, 21, 136, 159, 129, 202, 181,
+			112, 195, 132, 205, 11, 159, 101, 184, 97, 102, 33, 195, 13, 254,
+			161, 22, 149, 61, 123, 159, 218, 33, 161, 135, 134, 87, 82, 70,
+			150, 34, 221, 238, 10, 173, 43, 128, 184, 166, 160, 249, 197, 250,
+			153, 88, 212, 57, 162, 48, 80, 31, 139, 123, 5, 156, 251, 177,
+			184, 87, 192, 185, 31, 211, 173, 187, 100, 210, 128, 228, 81, 42,
+			232, 163, 91, 230, 199, 117, 189, 32, 62, 194, 64, 125, 60, 134,
+			164, 167, 32, 169, 32, 1, 5, 62, 174, 91, 119, 203, 164, 1,
+			73, 122, 76, 64, 50, 44, 243, 231, 117, 253, 164, 248, 8, 178,
+			236, 231, 99, 72, 32, 203, 126, 94, 31, 154, 146, 73, 48, 84,
+			235, 119, 82, 153, 196, 186, 199, 79, 144, 50, 18, 218, 180, 204,
+			79, 234, 250, 253, 249, 251, 105, 69, 94, 191, 11, 65, 83, 229,
+			187, 55, 8, 228, 207, 236, 54, 108, 86, 120, 180, 13, 153, 47,
+			104, 19, 202, 54, 65, 72, 125, 50, 70, 1, 132, 212, 39, 117,
+			161, 21, 14, 162, 144, 250, 164, 158, 147, 40, 128, 144, 250, 164,
+			126, 252, 132, 76, 102, 33, 121, 242, 188, 76, 102, 32, 57, 127,
+			159, 64, 48, 101, 153, 191, 112, 24, 130, 192, 137, 44, 56, 136,
+			160, 200, 239, 71, 48, 101, 34, 28, 149, 68, 176, 10, 65, 80,
+			59, 127, 33, 70, 48, 101, 64, 82, 33, 152, 202, 66, 82, 33,
+			152, 202, 64, 114, 254, 62, 242, 169, 81, 162, 155, 196, 74, 255,
+			158, 62, 240, 59, 134, 182, 184, 70, 31, 248, 155, 255, 67, 168,
+			184, 115, 74, 22, 255, 195, 8, 45, 195, 230, 85, 152, 19, 147,
+			30, 145, 120, 250, 141, 139, 199, 174, 125, 67, 237, 169, 194, 2,
+			181, 35, 126, 7, 46, 57, 51, 8, 125, 28, 247, 19, 234, 150,
+			90, 152, 88, 156, 248, 142, 165, 225, 55, 209, 225, 119, 155, 169,
+			173, 107, 147, 134, 46, 220, 50, 114, 247, 105, 211, 1, 119, 122,
+			230, 69, 238, 62, 129, 77, 27, 214, 227, 214, 44, 186, 235, 192,
+			30, 31, 118, 107, 28, 6, 108, 246, 219, 182, 231, 116, 186, 224,
+			219, 30, 198, 230, 34, 57, 32, 160, 157, 73, 15, 0, 0, 116,
+			184, 7, 128, 29, 30, 240, 0, 96, 244, 180, 32, 141, 226, 62,
+			220, 58, 194, 252, 79, 148, 149, 43, 238, 62, 195, 85, 87, 88,
+			17, 184, 35, 182, 12, 102, 139, 251, 172, 222, 93, 26, 42, 9,
+			112, 250, 206, 98, 11, 167, 84, 54, 218, 96, 182, 245, 194, 136,
+			217, 77, 208, 251, 132, 3, 69, 180, 203, 218, 80, 64, 237, 233,
+			188, 30, 36, 99, 11, 118, 195, 118, 93, 8, 15, 158, 188, 28,
+			43, 246, 237, 98, 67, 153, 176, 97, 224, 120, 42, 241, 37, 206,
+			29, 26, 129, 31, 66, 192, 113, 247, 16, 18, 208, 135, 17, 99,
+			140, 54, 156, 180, 136, 68, 62, 237, 248, 124, 20, 224, 59, 73,
+			210, 8, 35, 105, 239, 49, 138, 241, 241, 169, 157, 48, 101, 20,
+			33, 126, 45, 231, 14, 254, 26, 13, 52, 138, 99, 24, 242, 104,
+			180, 219, 140, 121, 4, 137, 41, 206, 115, 67, 31, 17, 75, 64,
+			7, 97, 140, 100, 135, 161, 86, 247, 86, 213, 177, 59, 242, 74,
+			239, 104, 139, 171, 86, 141, 93, 63, 4, 3, 87, 40, 46, 138,
+			133, 23, 9, 61, 141, 187, 124, 89, 144, 99, 182, 7, 7, 192,
+			210, 149, 6, 148, 42, 165, 203, 128, 129, 99, 199, 101, 212, 238,
+			116, 92, 113, 89, 143, 250, 1, 92, 10, 243, 131, 29, 219, 115,
+			158, 20, 247, 247, 252, 0, 13, 12, 236, 102, 135, 5, 14, 30,
+			49, 187, 18, 153, 34, 18, 82, 56, 255, 8, 148, 239, 131, 247,
+			4, 0, 74, 180, 27, 160, 181, 224, 2, 252, 35, 141, 249, 226,
+			228, 97, 223, 239, 194, 20, 74, 62, 172, 2, 89, 98, 47, 46,
+			54, 222, 0, 132, 143, 44, 130, 70, 44, 148, 232, 18, 24, 148,
+			158, 167, 215, 219, 194, 175, 60, 220, 21, 228, 199, 238, 99, 86,
+			3, 232, 177, 175, 4, 33, 180, 148, 124, 66, 8, 100, 103, 228,
+			128, 102, 202, 102, 219, 96, 162, 145, 119, 65, 103, 119, 48, 128,
+			247, 172, 26, 193, 217, 128, 237, 192, 157, 186, 253, 196, 245, 27,
+			128, 6, 23, 2, 184, 190, 27, 243, 146, 36, 81, 137, 214, 224,
+			184, 126, 95, 94, 208, 163, 251, 126, 55, 128, 4, 4, 5, 199,
+			197, 154, 95, 82, 1, 56, 60, 82, 184, 115, 131, 205, 46, 211,
+			142, 219, 221, 113, 188, 25, 236, 74, 79, 149, 61, 182, 29, 58,
+			17, 163, 211, 96, 53, 185, 97, 59, 46, 156, 246, 207, 8, 151,
+			223, 0, 174, 148, 122, 62, 0, 195, 19, 60, 32, 251, 205, 142,
+			11, 209, 172, 119, 253, 61, 104, 27, 239, 154, 194, 193, 147, 47,
+			230, 5, 107, 151, 232, 102, 216, 69, 139, 13, 124, 71, 246, 193,
+			202, 24, 174, 158, 30, 232, 82, 9, 29, 152, 185, 143, 18, 108,
+			48, 216, 129, 113, 18, 151, 68, 1, 144, 239, 37, 104, 130, 104,
+			33, 12, 24, 143, 78, 55, 146, 241, 234, 133, 184, 8, 187, 219,
+			179, 98, 250, 114, 95, 38, 152, 238, 203, 124, 70, 200, 233, 29,
+			242, 235, 182, 212, 111, 1, 52, 113, 61, 50, 20, 76, 139, 33,
+			231, 97, 114, 208, 151, 122, 25, 22, 128, 240, 220, 227, 162, 19,
+			0, 164, 34, 172, 254, 81, 55, 0, 97, 11, 42, 150, 15, 83,
+			139, 203, 143, 160, 72, 109, 186, 199, 182, 165, 238, 167, 24, 17,
+			204, 75, 221, 142, 224, 12, 187, 27, 249, 109, 59, 2, 223, 37,
+			23, 84, 46, 48, 9, 11, 66, 9, 98, 132, 66, 105, 4, 19,
+			209, 239, 233, 217, 9, 238, 123, 78, 64, 107, 252, 146, 174, 31,
+			207, 255, 87, 141, 214, 192, 32, 11, 157, 133, 56, 234, 84, 68,
+			39, 18, 15, 47, 96, 196, 36, 22, 38, 214, 39, 113, 4, 224,
+			132, 210, 42, 40, 16, 35, 180, 227, 218, 13, 92, 3, 47, 227,
+			250, 2, 193, 47, 139, 9, 247, 108, 9, 24, 166, 110, 8, 167,
+			138, 219, 221, 216, 78, 224, 183, 34, 144, 112, 142, 151, 216, 218,
+			43, 211, 89, 79, 125, 101, 103, 243, 192, 215, 4, 24, 11, 79,
+			81, 57, 91, 168, 151, 170, 228, 206, 95, 104, 172, 92, 1, 33,
+			168, 29, 127, 73, 234, 35, 4, 181, 227, 47, 73, 237, 143, 160,
+			118, 252, 37, 221, 186, 71, 38, 13, 160, 209, 177, 2, 121, 14,
+			172, 45, 4, 116, 195, 47, 235, 250, 153, 252, 151, 117, 186, 236,
+			123, 81, 224, 187, 7, 79, 31, 247, 224, 154, 19, 11, 240, 249,
+			0, 78, 188, 36, 233, 196, 245, 215, 82, 159, 191, 58, 132, 19,
+			199, 162, 72, 74, 105, 129, 20, 75, 125, 95, 133, 83, 48, 22,
+			81, 172, 83, 79, 207, 136, 64, 255, 168, 112, 239, 49, 0, 0,
+			71, 232, 251, 137, 91, 29, 106, 142, 132, 98, 221, 111, 210, 131,
+			128, 75, 4, 142, 67, 14, 137, 2, 39, 204, 56, 32, 9, 228,
+			81, 48, 44, 61, 128, 23, 191, 19, 39, 121, 68, 30, 14, 145,
+			36, 88, 197, 183, 226, 204, 217, 241, 66, 16, 84, 80, 91, 172,
+			26, 146, 100, 24, 173, 142, 19, 66, 232, 253, 4, 119, 172, 95,
+			142, 199, 11, 244, 254, 47, 199, 227, 165, 225, 136, 88, 247, 202,
+			164, 1, 201, 153, 211, 228, 159, 26, 56, 94, 186, 101, 254, 177,
+			174, 95, 202, 63, 107, 64, 207, 152, 151, 236, 129, 98, 119, 212,
+			159, 196, 16, 193, 5, 97, 192, 86, 142, 24, 72, 13, 214, 1,
+			43, 7, 163, 37, 160, 12, 248, 5, 187, 112, 161, 58, 160, 120,
+			192, 17, 147, 88, 72, 150, 162, 48, 40, 131, 96, 149, 19, 247,
+			80, 146, 19, 97, 76, 167, 245, 221, 46, 30, 102, 131, 62, 37,
+			207, 227, 93, 151, 158, 246, 252, 232, 244, 225, 100, 147, 244, 66,
+			74, 241, 3, 247, 38, 136, 188, 195, 66, 254, 149, 104, 236, 224,
+			117, 160, 46, 239, 172, 116, 236, 34, 9, 70, 141, 252, 30, 246,
+			123, 33, 190, 75, 178, 29, 121, 81, 124, 39, 238, 20, 241, 113,
+			131, 45, 217, 31, 199, 131, 12, 91, 178, 63, 150, 187, 24, 130,
+			123, 231, 63, 214, 115, 199, 101, 210, 128, 175, 247, 78, 203, 175,
+			89, 72, 206, 92, 148, 201, 12, 36, 207, 93, 32, 51, 200, 1,
+			134, 101, 254, 169, 174, 151, 243, 71, 184, 194, 39, 4, 35, 156,
+			217, 128, 4, 217, 229, 94, 77, 188, 38, 236, 230, 254, 52, 70,
+			194, 72, 65, 82, 33, 1, 110, 144, 127, 170, 231, 102, 101, 18,
+			1, 207, 47, 202, 100, 22, 146, 103, 87, 208, 141, 136, 232, 6,
+			156, 93, 254, 169, 126, 110, 153, 252, 55, 46, 56, 76, 203, 252,
+			166, 174, 63, 152, 255, 63, 117, 113, 112, 173, 78, 250, 18, 156,
+			184, 248, 252, 172, 168, 244, 124, 184, 233, 30, 248, 123, 64, 108,
+			202, 110, 194, 115, 42, 32, 236, 65, 183, 132, 97, 134, 92, 59,
+			138, 88, 187, 131, 58, 82, 219, 134, 90, 190, 92, 25, 108, 60,
+			132, 218, 172, 95, 153, 125, 21, 193, 83, 125, 26, 178, 31, 238,
+			226, 9, 33, 44, 38, 226, 1, 57, 113, 50, 72, 168, 8, 241,
+			150, 184, 239, 201, 145, 106, 250, 106, 171, 95, 34, 49, 131, 217,
+			9, 255, 97, 161, 14, 115, 230, 130, 24, 110, 157, 40, 140, 27,
+			239, 109, 59, 44, 145, 158, 241, 217, 181, 161, 40, 101, 173, 22,
+			104, 49, 162, 115, 177, 198, 137, 109, 187, 160, 165, 136, 59, 75,
+			106, 12, 97, 59, 252, 205, 120, 12, 97, 59, 252, 205, 120, 12,
+			97, 59, 252, 77, 61, 39, 165, 5, 108, 135, 191, 169, 207, 156,
+			145, 201, 44, 36, 139, 15, 200, 100, 6, 146, 247, 191, 154, 172,
+			194, 8, 130, 239, 241, 95, 233, 250, 255, 212, 141, 252, 171, 169,
+			138, 81, 162, 4, 159, 56, 67, 59, 236, 202, 172, 220, 161, 133,
+			206, 147, 138, 225, 209, 115, 249, 175, 244, 204, 4, 89, 1, 126,
+			49, 209, 10, 253, 109, 221, 28, 45, 156, 83, 192, 227, 219, 164,
+			56, 240, 0, 80, 108, 165, 138, 234, 37, 31, 174, 85, 115, 91,
+			50, 17, 174, 202, 223, 214, 205, 193, 56, 67, 183, 204, 111, 235,
+			195, 35, 228, 170, 104, 71, 179, 204, 191, 214, 77, 43, 159, 230,
+			23, 62, 11, 115, 224, 233, 71, 227, 56, 35, 235, 29, 124, 226,
+			81, 185, 128, 10, 185, 196, 23, 24, 233, 21, 77, 132, 143, 243,
+			95, 235, 230, 72, 156, 161, 67, 70, 110, 92, 53, 165, 91, 230,
+			119, 117, 243, 182, 194, 253, 201, 46, 53, 153, 120, 30, 71, 112,
+			214, 42, 140, 162, 12, 83, 209, 55, 164, 18, 50, 204, 255, 239,
+			234, 102, 78, 53, 5, 166, 200, 239, 234, 19, 147, 104, 148, 33,
+			32, 45, 190, 167, 235, 151, 196, 184, 129, 193, 225, 123, 49, 11,
+			164, 210, 144, 20, 71, 76, 4, 238, 179, 152, 223, 211, 167, 78,
+			200, 164, 1, 95, 79, 73, 89, 2, 6, 135, 239, 197, 178, 4,
+			12, 14, 223, 211, 207, 93, 32, 95, 230, 179, 56, 109, 153, 239,
+			48, 244, 66, 254, 63, 234, 177, 198, 116, 53, 86, 107, 184, 190,
+			196, 159, 37, 124, 105, 250, 82, 165, 69, 253, 54, 188, 47, 220,
+			44, 30, 0, 42, 22, 207, 100, 168, 139, 222, 152, 30, 168, 143,
+			206, 194, 134, 156, 110, 219, 33, 75, 234, 34, 18, 8, 63, 42,
+			165, 29, 59, 218, 45, 130, 13, 71, 108, 22, 224, 117, 110, 74,
+			103, 147, 190, 30, 201, 90, 97, 100, 71, 156, 9, 132, 204, 22,
+			232, 131, 232, 22, 96, 240, 208, 229, 112, 40, 253, 184, 244, 84,
+			150, 119, 15, 149, 180, 80, 51, 24, 220, 131, 222, 97, 168, 225,
+			75, 167, 32, 169, 214, 123, 56, 220, 123, 135, 33, 236, 124, 4,
+			221, 131, 222, 97, 28, 61, 70, 126, 207, 196, 1, 202, 88, 230,
+			51, 134, 126, 41, 255, 111, 76, 90, 227, 78, 203, 34, 242, 41,
+			149, 145, 79, 123, 45, 45, 142, 199, 87, 113, 120, 119, 189, 107,
+			239, 176, 215, 80, 90, 16, 145, 80, 11, 170, 10, 1, 91, 14,
+			172, 22, 113, 40, 17, 16, 149, 222, 62, 236, 198, 35, 167, 209,
+			117, 237, 128, 86, 55, 150, 105, 200, 31, 229, 67, 11, 208, 62,
+			86, 138, 91, 194, 155, 207, 224, 29, 1, 123, 165, 94, 49, 31,
+			30, 64, 131, 78, 75, 250, 216, 205, 166, 3, 211, 210, 118, 197,
+			142, 13, 34, 10, 208, 171, 253, 157, 218, 99, 194, 124, 130, 187,
+			171, 39, 192, 243, 207, 111, 201, 207, 178, 37, 16, 172, 177, 3,
+			56, 158, 244, 217, 129, 187, 47, 93, 158, 67, 24, 40, 177, 135,
+			145, 91, 212, 18, 57, 164, 49, 232, 24, 60, 199, 135, 30, 0,
+			220, 133, 34, 225, 106, 238, 120, 180, 101, 223, 0, 211, 65, 75,
+			204, 116, 129, 184, 120, 53, 103, 167, 71, 22, 36, 41, 202, 183,
+			161, 183, 38, 105, 128, 15, 67, 22, 137, 16, 73, 202, 143, 205,
+			167, 232, 245, 6, 30, 146, 120, 121, 182, 201, 196, 198, 157, 239,
+			193, 193, 221, 235, 32, 27, 112, 159, 118, 48, 206, 128, 193, 194,
+			129, 32, 16, 184, 42, 195, 102, 17, 221, 15, 186, 177, 118, 2,
+			238, 94, 207, 196, 44, 153, 129, 67, 19, 67, 45, 42, 25, 56,
+			52, 49, 148, 118, 2, 238, 94, 207, 24, 74, 59, 201, 100, 33,
+			169, 36, 74, 6, 57, 244, 220, 5, 33, 185, 178, 150, 249, 126,
+			67, 151, 43, 14, 120, 71, 189, 63, 110, 39, 11, 151, 23, 226,
+			118, 224, 64, 228, 253, 70, 238, 164, 76, 26, 144, 156, 62, 45,
+			147, 8, 234, 204, 171, 101, 50, 3, 201, 243, 151, 68, 59, 131,
+			150, 249, 172, 161, 36, 36, 248, 78, 61, 27, 183, 51, 152, 130,
+			164, 106, 7, 206, 22, 158, 141, 251, 3, 190, 83, 207, 198, 253,
+			25, 204, 66, 82, 245, 103, 48, 3, 73, 213, 31, 98, 153, 31,
+			48, 116, 137, 4, 49, 49, 41, 219, 33, 41, 72, 170, 118, 96,
+			59, 250, 1, 35, 39, 37, 49, 120, 44, 124, 192, 56, 53, 35,
+			147, 89, 72, 158, 150, 40, 19, 184, 100, 97, 220, 119, 145, 124,
+			131, 239, 93, 135, 44, 243, 167, 13, 125, 49, 255, 71, 26, 173,
+			132, 210, 250, 230, 178, 4, 35, 190, 134, 80, 254, 228, 15, 44,
+			54, 232, 254, 198, 104, 100, 7, 59, 44, 130, 93, 106, 4, 215,
+			20, 132, 163, 21, 172, 221, 172, 237, 68, 116, 69, 85, 78, 218,
+			108, 9, 46, 189, 160, 223, 236, 71, 187, 241, 11, 137, 66, 144,
+			249, 1, 88, 38, 229, 14, 71, 46, 217, 224, 157, 178, 227, 249,
+			1, 107, 94, 146, 197, 161, 62, 129, 119, 142, 67, 233, 199, 140,
+			190, 40, 128, 135, 90, 201, 177, 37, 217, 5, 104, 74, 132, 51,
+			17, 68, 24, 50, 177, 215, 146, 158, 67, 41, 72, 42, 122, 14,
+			105, 144, 204, 229, 101, 210, 128, 228, 221, 71, 101, 50, 11, 73,
+			186, 32, 147, 25, 72, 158, 153, 39, 63, 130, 228, 28, 182, 204,
+			143, 24, 250, 253, 249, 31, 166, 101, 220, 35, 133, 210, 121, 8,
+			102, 49, 120, 250, 218, 161, 218, 186, 162, 108, 56, 60, 78, 139,
+			176, 170, 162, 17, 144, 161, 75, 25, 143, 173, 34, 231, 124, 51,
+			222, 40, 250, 1, 93, 62, 115, 70, 117, 109, 216, 68, 12, 84,
+			50, 5, 73, 213, 53, 184, 78, 241, 17, 67, 156, 18, 16, 125,
+			216, 128, 228, 113, 57, 19, 134, 179, 144, 188, 247, 188, 76, 102,
+			32, 185, 112, 31, 121, 47, 103, 149, 17, 203, 252, 57, 67, 159,
+			201, 191, 45, 97, 230, 240, 165, 37, 140, 54, 196, 70, 136, 71,
+			132, 22, 130, 195, 1, 243, 57, 50, 15, 223, 9, 193, 102, 55,
+			81, 133, 28, 214, 161, 190, 53, 30, 72, 1, 66, 25, 125, 155,
+			164, 172, 82, 221, 29, 49, 17, 41, 149, 76, 65, 82, 45, 114,
+			112, 23, 228, 231, 12, 75, 206, 192, 17, 3, 146, 247, 78, 147,
+			10, 246, 103, 212, 50, 63, 97, 232, 211, 249, 75, 84, 197, 149,
+			70, 114, 30, 192, 233, 146, 108, 54, 148, 254, 217, 98, 97, 87,
+			104, 140, 154, 8, 75, 37, 83, 144, 84, 104, 192, 5, 147, 79,
+			24, 86, 65, 38, 13, 72, 158, 60, 197, 93, 155, 137, 62, 102,
+			153, 191, 96, 112, 215, 230, 216, 218, 67, 107, 16, 114, 91, 210,
+			7, 214, 54, 156, 26, 120, 227, 235, 160, 29, 8, 244, 190, 101,
+			233, 36, 138, 78, 31, 1, 3, 149, 8, 88, 255, 84, 233, 20,
+			55, 232, 116, 189, 38, 11, 194, 6, 28, 16, 200, 0, 114, 184,
+			144, 128, 157, 157, 15, 154, 244, 249, 14, 231, 194, 253, 246, 182,
+			239, 134, 68, 110, 54, 133, 231, 102, 20, 111, 45, 112, 218, 73,
+			245, 7, 38, 34, 219, 231, 234, 152, 178, 226, 11, 191, 91, 126,
+			236, 64, 158, 175, 25, 213, 138, 36, 224, 152, 137, 52, 81, 201,
+			20, 36, 21, 61, 225, 250, 205, 47, 24, 202, 182, 52, 6, 103,
+			93, 198, 177, 227, 232, 230, 78, 244, 156, 101, 254, 18, 12, 107,
+			39, 230, 210, 206, 110, 231, 197, 114, 39, 20, 61, 192, 0, 228,
+			16, 174, 92, 17, 227, 4, 193, 32, 218, 157, 104, 95, 241, 66,
+			14, 238, 124, 196, 184, 231, 82, 144, 84, 184, 195, 125, 159, 95,
+			138, 121, 33, 7, 119, 62, 140, 147, 167, 200, 175, 243, 57, 54,
+			110, 153, 191, 106, 232, 39, 243, 159, 210, 112, 59, 145, 160, 55,
+			32, 40, 34, 15, 74, 67, 25, 222, 13, 0, 193, 114, 40, 210,
+			10, 69, 244, 0, 130, 93, 236, 254, 193, 81, 84, 159, 122, 21,
+			86, 128, 174, 100, 49, 238, 22, 91, 112, 8, 209, 100, 17, 4,
+			123, 83, 177, 130, 20, 18, 170, 243, 227, 38, 118, 64, 37, 83,
+			144, 84, 157, 135, 27, 74, 191, 106, 8, 87, 15, 162, 143, 27,
+			144, 44, 156, 32, 255, 142, 119, 222, 178, 204, 95, 55, 244, 82,
+			254, 215, 254, 6, 157, 151, 113, 228, 21, 21, 136, 36, 195, 75,
+			160, 130, 210, 175, 123, 8, 129, 238, 225, 77, 177, 180, 188, 16,
+			33, 44, 19, 59, 163, 146, 41, 72, 42, 66, 88, 26, 36, 45,
+			169, 11, 88, 6, 36, 207, 204, 146, 223, 228, 132, 152, 176, 204,
+			127, 13, 18, 225, 211, 47, 68, 8, 57, 94, 224, 166, 212, 221,
+			222, 255, 27, 48, 129, 240, 182, 124, 89, 108, 0, 81, 247, 101,
+			29, 69, 128, 9, 184, 237, 17, 19, 96, 2, 110, 123, 196, 4,
+			152, 128, 219, 30, 241, 20, 158, 128, 219, 30, 48, 133, 223, 130,
+			253, 159, 180, 204, 127, 107, 232, 23, 243, 222, 203, 186, 245, 77,
+			212, 145, 68, 111, 64, 70, 185, 238, 22, 196, 17, 69, 65, 157,
+			81, 36, 175, 136, 19, 125, 210, 196, 230, 85, 50, 13, 73, 113,
+			69, 156, 232, 147, 26, 36, 197, 21, 113, 162, 79, 26, 144, 60,
+			127, 129, 252, 152, 70, 12, 19, 52, 179, 207, 27, 250, 157, 249,
+			39, 255, 198, 119, 196, 95, 126, 47, 80, 83, 133, 11, 229, 159,
+			55, 196, 133, 114, 146, 2, 51, 201, 231, 13, 113, 161, 156, 224,
+			133, 242, 207, 27, 183, 77, 161, 131, 16, 25, 180, 204, 223, 54,
+			244, 81, 174, 122, 14, 14, 64, 106, 104, 132, 151, 28, 28, 208,
+			122, 147, 186, 72, 14, 19, 221, 28, 178, 210, 95, 48, 6, 254,
+			161, 169, 33, 24, 80, 155, 190, 96, 100, 111, 39, 127, 144, 34,
+			166, 57, 4, 134, 152, 63, 50, 244, 7, 243, 159, 75, 129, 32,
+			198, 141, 64, 226, 48, 43, 246, 179, 95, 144, 22, 12, 40, 149,
+			188, 249, 218, 234, 185, 165, 36, 13, 91, 137, 85, 7, 10, 198,
+			199, 20, 179, 160, 51, 218, 145, 179, 237, 96, 180, 29, 101, 222,
+			234, 131, 46, 95, 210, 46, 81, 60, 30, 19, 87, 114, 227, 3,
+			78, 80, 128, 253, 32, 17, 166, 142, 223, 99, 191, 8, 71, 165,
+			167, 66, 10, 183, 16, 8, 216, 211, 156, 6, 120, 0, 20, 241,
+			182, 72, 139, 237, 177, 128, 182, 152, 29, 117, 3, 22, 130, 245,
+			174, 9, 211, 173, 13, 75, 45, 96, 133, 39, 185, 172, 217, 23,
+			154, 79, 249, 207, 139, 213, 15, 158, 98, 198, 32, 122, 61, 199,
+			199, 84, 21, 191, 226, 251, 244, 205, 96, 33, 160, 130, 133, 228,
+			151, 254, 87, 34, 232, 3, 72, 109, 241, 234, 126, 130, 199, 206,
+			193, 0, 180, 237, 155, 248, 229, 169, 94, 31, 211, 24, 173, 6,
+			234, 236, 252, 176, 28, 14, 63, 36, 122, 104, 134, 12, 47, 37,
+			134, 43, 20, 158, 127, 88, 52, 57, 84, 132, 46, 245, 158, 168,
+			251, 45, 56, 211, 12, 208, 189, 88, 245, 27, 54, 181, 184, 231,
+			23, 45, 135, 151, 120, 76, 53, 212, 37, 164, 71, 195, 182, 240,
+			63, 133, 99, 95, 175, 219, 14, 139, 224, 191, 209, 239, 251, 15,
+			170, 61, 189, 44, 142, 184, 212, 158, 64, 30, 223, 21, 227, 152,
+			175, 248, 26, 121, 192, 192, 56, 219, 16, 126, 26, 194, 36, 193,
+			32, 6, 83, 67, 236, 231, 213, 42, 208, 115, 77, 136, 75, 132,
+			33, 60, 249, 250, 35, 41, 32, 134, 240, 228, 235, 143, 164, 142,
+			61, 132, 166, 195, 63, 50, 114, 167, 100, 210, 128, 121, 112, 250,
+			140, 76, 102, 33, 41, 108, 163, 67, 250, 64, 6, 146, 247, 191,
+			154, 252, 119, 144, 252, 67, 96, 160, 251, 170, 161, 95, 201, 255,
+			23, 141, 174, 56, 97, 188, 131, 16, 130, 61, 113, 131, 77, 62,
+			240, 65, 11, 177, 239, 195, 244, 76, 129, 202, 71, 62, 164, 255,
+			102, 195, 246, 48, 44, 108, 203, 117, 224, 80, 26, 102, 133, 244,
+			247, 240, 91, 189, 126, 26, 242, 246, 22, 176, 46, 131, 235, 0,
+			200, 45, 79, 176, 56, 120, 154, 80, 132, 196, 188, 101, 118, 232,
+			176, 224, 18, 245, 216, 158, 48, 66, 240, 201, 100, 223, 240, 29,
+			201, 46, 226, 76, 37, 129, 100, 65, 8, 218, 33, 60, 145, 250,
+			106, 76, 71, 56, 145, 250, 106, 76, 71, 176, 139, 126, 213, 200,
+			205, 201, 164, 1, 201, 197, 179, 50, 153, 133, 228, 185, 178, 76,
+			102, 32, 249, 154, 21, 242, 103, 156, 142, 186, 101, 254, 185, 161,
+			47, 228, 255, 239, 120, 91, 43, 88, 229, 149, 219, 217, 38, 230,
+			208, 75, 220, 206, 138, 221, 44, 121, 209, 219, 89, 209, 140, 220,
+			209, 14, 65, 180, 41, 243, 207, 99, 82, 130, 37, 247, 207, 99,
+			82, 2, 91, 253, 185, 220, 209, 14, 161, 43, 222, 159, 27, 119,
+			223, 35, 147, 89, 248, 122, 116, 94, 38, 51, 144, 60, 61, 199,
+			133, 250, 160, 101, 62, 103, 232, 19, 184, 54, 12, 193, 218, 240,
+			156, 49, 52, 204, 75, 226, 218, 144, 76, 234, 34, 201, 203, 226,
+			199, 81, 129, 194, 160, 214, 151, 212, 69, 146, 151, 197, 212, 184,
+			37, 62, 234, 90, 111, 82, 126, 253, 199, 105, 40, 12, 216, 255,
+			184, 169, 223, 147, 127, 79, 154, 62, 156, 184, 161, 35, 104, 34,
+			102, 126, 239, 105, 126, 172, 23, 181, 237, 14, 184, 181, 7, 251,
+			92, 20, 137, 113, 3, 115, 98, 71, 248, 29, 169, 235, 74, 42,
+			135, 59, 42, 80, 40, 243, 234, 215, 178, 253, 250, 126, 135, 21,
+			41, 134, 197, 135, 159, 15, 66, 254, 22, 22, 164, 15, 208, 133,
+			75, 36, 86, 90, 154, 201, 43, 86, 174, 239, 63, 17, 98, 232,
+			13, 9, 78, 32, 124, 221, 238, 160, 115, 39, 62, 150, 35, 37,
+			124, 82, 202, 203, 135, 117, 122, 229, 122, 92, 194, 118, 169, 64,
+			139, 62, 193, 246, 5, 18, 7, 138, 40, 132, 133, 247, 244, 3,
+			116, 81, 20, 123, 138, 255, 81, 66, 181, 23, 161, 190, 222, 17,
+			90, 233, 139, 106, 1, 190, 79, 141, 93, 223, 15, 185, 32, 77,
+			152, 41, 248, 184, 72, 244, 31, 128, 69, 73, 246, 26, 93, 22,
+			80, 163, 166, 54, 245, 120, 132, 18, 24, 27, 167, 103, 26, 42,
+			59, 110, 228, 211, 93, 31, 174, 253, 237, 98, 31, 97, 136, 229,
+			213, 11, 17, 166, 46, 113, 176, 182, 180, 81, 65, 115, 10, 222,
+			117, 112, 250, 176, 229, 199, 104, 210, 233, 6, 99, 171, 194, 105,
+			191, 211, 18, 182, 40, 117, 185, 169, 127, 141, 137, 185, 99, 109,
+			189, 94, 190, 40, 35, 72, 10, 99, 167, 160, 243, 129, 8, 186,
+			116, 137, 187, 32, 72, 181, 7, 249, 12, 238, 65, 217, 55, 137,
+			220, 27, 163, 137, 67, 2, 16, 50, 84, 58, 135, 57, 237, 30,
+			179, 234, 246, 126, 98, 135, 34, 23, 38, 225, 205, 22, 47, 80,
+			112, 0, 251, 227, 166, 146, 6, 112, 0, 251, 227, 166, 146, 6,
+			112, 0, 251, 227, 102, 238, 78, 153, 196, 249, 116, 215, 221, 228,
+			132, 152, 239, 239, 50, 245, 145, 194, 29, 120, 6, 14, 231, 72,
+			91, 234, 140, 108, 219, 197, 224, 169, 48, 97, 13, 203, 124, 151,
+			169, 102, 190, 161, 245, 38, 117, 145, 60, 42, 32, 254, 4, 64,
+			180, 16, 34, 60, 255, 190, 101, 135, 91, 0, 89, 2, 131, 139,
+			12, 113, 109, 83, 235, 77, 234, 34, 9, 1, 161, 134, 64, 206,
+			61, 109, 126, 255, 2, 66, 13, 65, 60, 37, 243, 233, 152, 86,
+			102, 26, 26, 16, 218, 254, 144, 14, 200, 60, 109, 10, 109, 127,
+			136, 95, 78, 48, 69, 64, 40, 48, 27, 190, 215, 124, 101, 2,
+			66, 13, 129, 254, 254, 94, 83, 232, 239, 67, 168, 191, 191, 215,
+			20, 250, 251, 16, 234, 239, 239, 53, 111, 131, 184, 96, 186, 57,
+			108, 165, 159, 49, 7, 190, 35, 212, 112, 48, 241, 61, 99, 102,
+			39, 201, 207, 128, 117, 105, 24, 212, 240, 15, 154, 122, 41, 255,
+			62, 29, 41, 214, 64, 177, 167, 152, 85, 158, 177, 1, 107, 46,
+			159, 57, 211, 127, 110, 46, 244, 117, 59, 118, 160, 37, 183, 184,
+			80, 47, 92, 54, 119, 109, 143, 58, 9, 127, 162, 61, 96, 230,
+			18, 85, 123, 25, 121, 76, 33, 47, 235, 133, 116, 155, 185, 254,
+			158, 212, 60, 36, 94, 161, 114, 128, 85, 211, 55, 118, 120, 240,
+			59, 76, 190, 123, 0, 216, 192, 218, 201, 232, 236, 44, 13, 253,
+			32, 216, 47, 210, 61, 118, 10, 12, 101, 32, 225, 125, 234, 192,
+			77, 164, 38, 3, 156, 208, 115, 173, 213, 5, 21, 93, 158, 212,
+			28, 227, 163, 62, 140, 26, 221, 7, 37, 19, 12, 67, 240, 98,
+			243, 131, 166, 184, 205, 49, 140, 26, 221, 7, 77, 17, 167, 99,
+			24, 61, 253, 63, 104, 98, 64, 65, 44, 156, 5, 10, 31, 153,
+			149, 201, 12, 36, 239, 45, 98, 208, 149, 97, 56, 237, 254, 73,
+			83, 255, 136, 201, 131, 174, 12, 155, 0, 235, 39, 205, 204, 48,
+			57, 74, 210, 230, 48, 28, 44, 91, 230, 79, 153, 230, 88, 126,
+			76, 217, 41, 218, 24, 125, 20, 207, 104, 135, 197, 81, 244, 79,
+			153, 102, 34, 67, 135, 140, 145, 81, 116, 145, 128, 12, 184, 17,
+			97, 138, 3, 228, 97, 113, 162, 252, 97, 211, 204, 198, 25, 112,
+			193, 2, 102, 152, 172, 161, 91, 230, 63, 49, 69, 152, 157, 97,
+			113, 48, 252, 79, 76, 113, 48, 60, 44, 14, 134, 255, 137, 57,
+			49, 73, 190, 196, 185, 8, 110, 5, 152, 250, 145, 252, 191, 215,
+			197, 188, 195, 251, 207, 98, 184, 196, 153, 189, 240, 10, 194, 237,
+			147, 18, 158, 157, 0, 194, 10, 129, 116, 23, 250, 32, 120, 58,
+			98, 65, 2, 23, 51, 96, 135, 164, 54, 83, 7, 88, 139, 143,
+			55, 236, 108, 74, 180, 106, 139, 149, 222, 246, 212, 74, 5, 123,
+			143, 189, 192, 145, 222, 137, 24, 137, 80, 70, 60, 137, 61, 139,
+			24, 95, 173, 138, 201, 43, 109, 118, 16, 216, 251, 192, 103, 34,
+			186, 22, 122, 124, 217, 210, 141, 202, 237, 143, 137, 180, 237, 250,
+			219, 37, 90, 145, 55, 205, 33, 90, 135, 187, 175, 142, 188, 64,
+			50, 35, 14, 120, 91, 159, 240, 83, 52, 110, 55, 225, 170, 176,
+			56, 190, 227, 151, 198, 19, 145, 123, 144, 37, 80, 13, 254, 88,
+			204, 124, 160, 6, 127, 76, 74, 235, 97, 188, 57, 243, 49, 51,
+			39, 153, 15, 174, 18, 125, 12, 152, 239, 131, 176, 203, 30, 134,
+			113, 250, 101, 83, 63, 155, 255, 137, 20, 14, 12, 127, 57, 78,
+			206, 35, 105, 168, 17, 155, 8, 112, 210, 170, 161, 22, 206, 149,
+			31, 101, 206, 18, 190, 226, 190, 136, 130, 32, 110, 179, 39, 87,
+			37, 39, 76, 188, 22, 130, 157, 7, 242, 158, 63, 71, 183, 113,
+			102, 69, 108, 39, 176, 93, 92, 148, 91, 206, 77, 25, 14, 133,
+			208, 105, 199, 139, 206, 159, 43, 210, 174, 248, 27, 138, 191, 88,
+			8, 62, 132, 226, 215, 76, 137, 210, 37, 33, 68, 112, 143, 34,
+			58, 162, 158, 115, 67, 35, 166, 226, 15, 80, 191, 195, 100, 127,
+			96, 67, 231, 237, 200, 61, 15, 82, 61, 164, 46, 248, 156, 131,
+			133, 48, 96, 13, 7, 230, 187, 112, 250, 1, 126, 221, 5, 231,
+			45, 225, 14, 99, 83, 23, 212, 13, 161, 23, 129, 186, 239, 195,
+			235, 121, 194, 123, 203, 166, 45, 215, 231, 106, 55, 119, 40, 143,
+			155, 45, 17, 90, 67, 129, 182, 15, 95, 213, 91, 115, 106, 55,
+			32, 58, 129, 219, 210, 158, 125, 28, 107, 38, 145, 23, 119, 23,
+			136, 82, 18, 18, 223, 10, 220, 245, 180, 32, 99, 222, 192, 152,
+			108, 179, 93, 251, 134, 227, 139, 160, 26, 241, 1, 46, 31, 117,
+			66, 213, 147, 120, 64, 179, 94, 253, 71, 69, 203, 142, 74, 164,
+			215, 83, 200, 73, 220, 1, 246, 197, 96, 39, 79, 205, 161, 253,
+			80, 184, 213, 218, 77, 180, 249, 195, 102, 157, 224, 73, 119, 169,
+			109, 71, 187, 165, 138, 23, 177, 29, 181, 79, 30, 198, 77, 201,
+			47, 199, 140, 13, 23, 185, 126, 217, 20, 38, 192, 97, 116, 70,
+			251, 101, 211, 154, 146, 73, 3, 190, 30, 185, 75, 126, 205, 66,
+			242, 238, 69, 153, 204, 64, 114, 122, 65, 72, 85, 205, 74, 127,
+			198, 212, 255, 149, 146, 170, 48, 73, 62, 99, 102, 70, 48, 104,
+			214, 48, 132, 108, 183, 204, 95, 49, 77, 43, 127, 135, 48, 160,
+			38, 78, 185, 129, 142, 82, 152, 242, 56, 86, 191, 18, 11, 79,
+			30, 199, 234, 87, 204, 220, 56, 153, 17, 160, 52, 203, 252, 53,
+			0, 117, 39, 130, 74, 12, 141, 136, 10, 147, 0, 6, 104, 252,
+			90, 18, 24, 132, 184, 250, 181, 36, 48, 221, 50, 127, 253, 80,
+			96, 177, 135, 177, 172, 11, 244, 249, 245, 36, 48, 152, 235, 191,
+			14, 192, 126, 31, 137, 0, 251, 160, 63, 52, 245, 51, 249, 207,
+			142, 74, 31, 13, 113, 126, 8, 43, 241, 182, 218, 130, 184, 246,
+			147, 142, 187, 255, 26, 74, 225, 33, 72, 121, 164, 168, 78, 20,
+			133, 6, 50, 11, 195, 43, 67, 181, 242, 107, 1, 109, 136, 137,
+			131, 51, 134, 207, 18, 32, 34, 247, 43, 21, 117, 144, 187, 224,
+			62, 15, 174, 244, 188, 53, 20, 178, 160, 216, 122, 113, 185, 83,
+			56, 161, 34, 84, 144, 80, 40, 138, 235, 164, 2, 63, 112, 93,
+			22, 138, 48, 143, 236, 137, 11, 189, 144, 203, 4, 230, 146, 112,
+			91, 232, 133, 42, 228, 107, 35, 226, 30, 241, 49, 60, 68, 86,
+			132, 126, 194, 40, 77, 220, 34, 34, 205, 111, 194, 71, 26, 186,
+			111, 227, 221, 31, 232, 109, 207, 28, 225, 209, 159, 90, 1, 168,
+			44, 190, 220, 217, 168, 88, 16, 168, 17, 129, 199, 199, 14, 68,
+			181, 9, 40, 188, 189, 41, 253, 201, 250, 34, 149, 168, 107, 56,
+			74, 223, 147, 119, 102, 148, 163, 31, 81, 107, 71, 210, 30, 143,
+			27, 165, 176, 187, 179, 195, 66, 25, 126, 164, 199, 34, 101, 227,
+			27, 35, 160, 58, 57, 140, 7, 237, 177, 35, 34, 86, 153, 30,
+			124, 122, 162, 213, 64, 191, 247, 252, 64, 152, 69, 19, 83, 123,
+			219, 247, 159, 120, 130, 49, 8, 81, 193, 175, 25, 239, 194, 88,
+			68, 251, 29, 177, 123, 22, 241, 200, 123, 188, 217, 156, 3, 2,
+			68, 186, 130, 82, 155, 59, 27, 38, 95, 16, 128, 107, 67, 45,
+			113, 94, 3, 38, 197, 88, 12, 194, 40, 95, 130, 71, 47, 92,
+			233, 3, 11, 126, 247, 220, 144, 10, 17, 215, 165, 223, 44, 58,
+			72, 82, 122, 165, 27, 128, 26, 0, 150, 85, 96, 53, 136, 0,
+			49, 11, 225, 217, 85, 120, 117, 146, 104, 76, 168, 145, 28, 159,
+			196, 163, 9, 28, 225, 75, 192, 149, 97, 36, 154, 85, 141, 1,
+			52, 20, 198, 208, 119, 110, 238, 82, 215, 38, 120, 131, 200, 206,
+			141, 110, 0, 234, 48, 68, 77, 217, 219, 197, 147, 34, 223, 155,
+			237, 5, 8, 76, 239, 120, 16, 16, 8, 239, 157, 96, 76, 14,
+			112, 51, 21, 55, 241, 57, 91, 150, 72, 207, 93, 127, 231, 5,
+			247, 214, 248, 164, 183, 10, 241, 33, 181, 42, 126, 125, 8, 84,
+			42, 28, 255, 158, 11, 26, 220, 43, 222, 9, 139, 216, 37, 224,
+			145, 74, 88, 225, 243, 214, 121, 146, 53, 167, 103, 168, 127, 200,
+			236, 6, 147, 8, 196, 3, 128, 43, 21, 156, 33, 49, 216, 136,
+			216, 39, 247, 78, 69, 176, 129, 227, 27, 80, 222, 78, 63, 102,
+			9, 179, 189, 199, 160, 195, 118, 176, 175, 46, 35, 248, 210, 209,
+			237, 16, 152, 184, 115, 224, 70, 104, 92, 116, 212, 149, 45, 7,
+			222, 203, 129, 192, 179, 112, 160, 204, 2, 144, 15, 88, 29, 9,
+			83, 4, 57, 207, 108, 165, 17, 118, 186, 65, 7, 12, 19, 126,
+			11, 39, 42, 145, 51, 3, 212, 13, 175, 127, 109, 20, 6, 83,
+			52, 101, 132, 207, 75, 111, 162, 108, 216, 42, 122, 11, 190, 128,
+			1, 162, 44, 74, 82, 92, 30, 37, 36, 188, 177, 18, 99, 35,
+			165, 101, 47, 26, 8, 90, 188, 254, 117, 154, 223, 96, 56, 45,
+			208, 128, 224, 64, 125, 168, 160, 169, 241, 180, 7, 210, 228, 52,
+			121, 190, 98, 189, 178, 73, 202, 51, 30, 193, 166, 199, 128, 182,
+			11, 186, 48, 220, 146, 19, 20, 135, 137, 190, 132, 2, 109, 113,
+			126, 113, 161, 136, 132, 148, 179, 157, 54, 252, 32, 96, 141, 200,
+			3, 145, 135, 141, 135, 146, 155, 184, 95, 50, 94, 110, 21, 175,
+			215, 36, 169, 143, 130, 24, 56, 12, 158, 62, 68, 190, 14, 236,
+			112, 151, 95, 140, 5, 53, 18, 165, 56, 108, 38, 61, 176, 66,
+			52, 133, 151, 51, 135, 6, 65, 150, 90, 142, 151, 88, 118, 104,
+			183, 227, 123, 241, 140, 162, 245, 245, 149, 245, 233, 237, 185, 197,
+			133, 133, 11, 243, 231, 23, 22, 206, 206, 92, 164, 194, 33, 7,
+			148, 60, 167, 169, 246, 23, 216, 23, 201, 169, 66, 215, 0, 235,
+			201, 31, 198, 106, 11, 88, 79, 254, 48, 214, 199, 193, 214, 241,
+			135, 166, 136, 108, 56, 140, 238, 235, 127, 104, 222, 33, 181, 24,
+			35, 11, 95, 239, 60, 45, 191, 102, 32, 121, 98, 134, 124, 10,
+			204, 210, 195, 160, 16, 125, 197, 212, 239, 207, 255, 99, 141, 246,
+			61, 223, 252, 210, 8, 42, 252, 131, 164, 239, 93, 239, 77, 66,
+			176, 116, 240, 65, 2, 169, 0, 123, 93, 245, 238, 159, 56, 61,
+			219, 117, 182, 197, 158, 12, 124, 161, 249, 28, 178, 61, 190, 157,
+			150, 65, 155, 121, 7, 192, 58, 242, 149, 152, 22, 224, 6, 254,
+			149, 152, 22, 96, 29, 249, 138, 41, 98, 229, 12, 163, 117, 228,
+			43, 102, 225, 132, 76, 102, 225, 171, 184, 116, 60, 140, 110, 224,
+			95, 49, 231, 239, 35, 95, 225, 180, 72, 89, 230, 159, 153, 250,
+			66, 254, 63, 37, 61, 207, 96, 153, 122, 197, 12, 244, 242, 104,
+			36, 124, 121, 230, 121, 17, 214, 241, 69, 121, 155, 245, 48, 20,
+			56, 82, 255, 89, 76, 196, 20, 246, 92, 17, 17, 28, 169, 255,
+			204, 20, 198, 249, 97, 12, 124, 248, 103, 166, 48, 206, 15, 235,
+			169, 44, 124, 21, 198, 249, 97, 116, 164, 254, 51, 243, 244, 28,
+			121, 13, 210, 48, 109, 153, 207, 153, 122, 49, 191, 240, 210, 223,
+			188, 225, 184, 164, 33, 32, 81, 140, 26, 56, 9, 63, 23, 143,
+			47, 56, 9, 63, 23, 243, 122, 26, 2, 18, 153, 119, 220, 41,
+			147, 89, 72, 230, 207, 200, 100, 6, 146, 39, 79, 99, 228, 246,
+			97, 29, 156, 254, 191, 143, 134, 186, 97, 116, 30, 253, 102, 140,
+			106, 38, 13, 73, 97, 168, 27, 134, 103, 106, 204, 111, 74, 67,
+			221, 48, 58, 143, 126, 83, 26, 234, 134, 83, 150, 249, 173, 87,
+			200, 80, 55, 12, 134, 186, 111, 73, 67, 221, 48, 26, 234, 190,
+			37, 13, 117, 195, 104, 168, 251, 22, 24, 234, 238, 2, 211, 220,
+			160, 101, 126, 27, 76, 161, 99, 226, 45, 153, 38, 125, 28, 245,
+			110, 14, 9, 78, 87, 190, 45, 13, 159, 195, 120, 186, 146, 76,
+			234, 34, 9, 38, 191, 17, 43, 253, 93, 115, 224, 109, 41, 110,
+			242, 3, 55, 183, 239, 130, 201, 15, 40, 63, 2, 123, 159, 239,
+			125, 31, 41, 63, 130, 214, 177, 239, 73, 202, 143, 160, 117, 236,
+			123, 146, 242, 35, 184, 131, 250, 158, 164, 252, 8, 90, 199, 190,
+			39, 41, 63, 146, 178, 204, 183, 166, 94, 25, 202, 143, 0, 229,
+			223, 154, 18, 148, 31, 65, 202, 191, 53, 37, 40, 63, 130, 148,
+			127, 107, 74, 152, 72, 71, 173, 244, 219, 83, 3, 31, 16, 244,
+			2, 127, 188, 183, 167, 178, 19, 228, 49, 98, 154, 163, 64, 175,
+			119, 166, 116, 154, 175, 114, 63, 133, 132, 74, 43, 252, 151, 133,
+			111, 152, 191, 7, 103, 25, 160, 37, 199, 230, 80, 52, 57, 193,
+			17, 167, 114, 13, 4, 69, 149, 200, 248, 162, 136, 203, 40, 18,
+			240, 157, 41, 65, 192, 81, 60, 48, 126, 103, 74, 204, 178, 81,
+			36, 224, 59, 83, 185, 35, 50, 105, 0, 62, 247, 28, 37, 255,
+			21, 228, 228, 40, 108, 3, 159, 78, 233, 11, 249, 223, 143, 229,
+			164, 136, 26, 244, 10, 137, 73, 168, 14, 45, 188, 210, 82, 18,
+			218, 144, 66, 114, 20, 173, 96, 79, 199, 52, 2, 43, 216, 211,
+			49, 141, 96, 103, 253, 116, 74, 8, 201, 81, 180, 130, 61, 157,
+			18, 66, 114, 84, 215, 178, 144, 20, 66, 114, 20, 15, 131, 159,
+			78, 157, 158, 35, 71, 97, 176, 7, 45, 243, 61, 169, 231, 57,
+			127, 24, 133, 121, 247, 158, 148, 152, 104, 163, 56, 239, 146, 73,
+			93, 36, 97, 114, 141, 194, 30, 252, 125, 169, 239, 223, 228, 26,
+			69, 35, 201, 251, 226, 126, 131, 145, 228, 125, 41, 49, 185, 70,
+			209, 72, 242, 190, 148, 152, 92, 163, 120, 114, 251, 190, 148, 152,
+			92, 163, 224, 110, 254, 10, 77, 174, 81, 152, 92, 207, 202, 201,
+			53, 138, 147, 235, 89, 57, 185, 70, 113, 114, 61, 43, 39, 215,
+			152, 149, 254, 96, 106, 224, 159, 138, 201, 5, 206, 153, 31, 76,
+			101, 167, 200, 95, 2, 251, 142, 193, 236, 250, 48, 176, 239, 87,
+			250, 216, 23, 103, 200, 43, 207, 196, 124, 38, 190, 210, 231, 241,
+			113, 75, 146, 161, 199, 112, 210, 127, 88, 14, 236, 24, 78, 250,
+			15, 75, 134, 30, 195, 73, 255, 97, 201, 208, 99, 56, 233, 63,
+			44, 25, 122, 76, 31, 200, 2, 213, 4, 67, 143, 161, 151, 200,
+			135, 129, 161, 129, 7, 199, 128, 39, 62, 242, 125, 228, 193, 49,
+			156, 123, 31, 137, 81, 133, 216, 77, 31, 145, 60, 56, 134, 22,
+			232, 143, 72, 30, 28, 195, 185, 247, 17, 201, 131, 99, 41, 203,
+			252, 217, 87, 136, 7, 199, 128, 7, 127, 86, 242, 224, 24, 242,
+			224, 207, 74, 30, 28, 67, 30, 252, 89, 201, 131, 57, 43, 253,
+			177, 212, 192, 191, 16, 60, 8, 78, 182, 31, 75, 101, 111, 39,
+			255, 201, 32, 166, 153, 3, 30, 252, 116, 74, 95, 76, 122, 131,
+			196, 55, 138, 95, 65, 6, 20, 141, 188, 210, 220, 39, 154, 9,
+			75, 100, 241, 43, 26, 26, 19, 46, 82, 30, 47, 74, 6, 46,
+			160, 11, 42, 240, 199, 217, 69, 25, 107, 42, 14, 238, 175, 30,
+			57, 87, 170, 99, 117, 99, 25, 156, 2, 90, 129, 221, 102, 112,
+			70, 94, 162, 16, 165, 197, 238, 248, 174, 191, 3, 210, 12, 106,
+			237, 250, 118, 208, 20, 27, 237, 48, 241, 40, 137, 79, 253, 110,
+			16, 50, 247, 6, 184, 194, 225, 41, 63, 165, 123, 240, 132, 99,
+			192, 84, 208, 78, 110, 171, 194, 0, 52, 24, 142, 119, 27, 175,
+			36, 65, 177, 38, 107, 56, 194, 218, 36, 207, 246, 228, 171, 184,
+			128, 145, 120, 24, 87, 176, 110, 14, 103, 217, 167, 37, 235, 230,
+			112, 150, 125, 90, 206, 178, 28, 206, 178, 79, 203, 89, 150, 195,
+			89, 246, 233, 148, 184, 202, 145, 195, 89, 246, 233, 148, 184, 202,
+			145, 195, 89, 246, 233, 212, 153, 121, 84, 96, 115, 48, 203, 62,
+			243, 125, 156, 101, 57, 156, 101, 159, 137, 81, 133, 89, 246, 25,
+			57, 203, 114, 56, 203, 62, 35, 103, 89, 14, 103, 217, 103, 228,
+			44, 203, 129, 27, 245, 43, 52, 203, 114, 48, 203, 126, 85, 206,
+			178, 28, 206, 178, 95, 149, 179, 44, 135, 179, 236, 87, 229, 44,
+			27, 183, 210, 255, 50, 53, 240, 159, 196, 44, 3, 111, 238, 127,
+			153, 202, 222, 70, 126, 31, 102, 217, 56, 204, 178, 207, 194, 44,
+			251, 47, 241, 44, 19, 38, 189, 87, 116, 146, 241, 54, 94, 233,
+			57, 38, 140, 122, 255, 255, 155, 98, 227, 56, 197, 62, 43, 249,
+			118, 28, 167, 216, 103, 229, 20, 27, 199, 41, 246, 89, 57, 197,
+			198, 113, 138, 125, 86, 78, 177, 113, 156, 98, 159, 149, 83, 108,
+			28, 167, 216, 103, 97, 138, 125, 13, 148, 131, 113, 56, 29, 255,
+			124, 74, 255, 15, 41, 163, 215, 81, 79, 216, 129, 155, 108, 150,
+			95, 92, 159, 69, 99, 252, 52, 68, 226, 7, 235, 172, 227, 209,
+			107, 245, 250, 6, 104, 95, 46, 152, 45, 102, 248, 224, 55, 89,
+			187, 227, 131, 37, 174, 72, 32, 237, 113, 19, 218, 107, 120, 89,
+			184, 68, 219, 4, 154, 31, 176, 182, 197, 22, 214, 171, 229, 58,
+			48, 199, 54, 146, 30, 236, 192, 68, 14, 59, 119, 233, 221, 216,
+			76, 124, 143, 155, 147, 133, 212, 41, 134, 223, 234, 57, 113, 218,
+			88, 175, 213, 37, 49, 241, 252, 255, 243, 169, 204, 29, 120, 120,
+			63, 14, 199, 251, 150, 249, 91, 41, 243, 46, 60, 67, 26, 23,
+			199, 253, 191, 149, 50, 239, 136, 51, 116, 200, 200, 31, 33, 39,
+			68, 13, 205, 50, 127, 59, 101, 78, 21, 38, 177, 43, 24, 237,
+			78, 225, 66, 84, 53, 144, 39, 191, 157, 18, 15, 227, 140, 11,
+			39, 128, 223, 78, 221, 126, 7, 185, 95, 192, 209, 45, 243, 119,
+			82, 230, 68, 225, 84, 146, 116, 120, 28, 163, 194, 178, 133, 78,
+			147, 137, 224, 1, 97, 12, 26, 68, 227, 239, 164, 196, 107, 81,
+			227, 194, 91, 224, 119, 82, 248, 90, 20, 8, 2, 205, 74, 127,
+			33, 165, 255, 110, 234, 94, 49, 234, 32, 248, 190, 16, 51, 16,
+			8, 190, 47, 164, 68, 132, 203, 113, 20, 124, 95, 72, 221, 53,
+			43, 147, 6, 124, 21, 241, 32, 198, 81, 181, 255, 221, 84, 250,
+			164, 76, 102, 44, 243, 119, 83, 227, 39, 80, 70, 143, 67, 187,
+			95, 252, 62, 202, 232, 113, 212, 198, 191, 24, 163, 10, 218, 248,
+			23, 165, 140, 30, 71, 109, 252, 139, 82, 70, 143, 163, 54, 254,
+			69, 41, 163, 199, 83, 150, 249, 7, 175, 144, 140, 30, 7, 25,
+			253, 7, 82, 70, 143, 163, 140, 254, 3, 41, 163, 199, 81, 70,
+			255, 1, 200, 232, 159, 48, 32, 64, 134, 149, 254, 114, 106, 224,
+			127, 164, 180, 252, 95, 235, 116, 73, 2, 141, 125, 43, 64, 156,
+			216, 2, 19, 113, 219, 3, 201, 38, 12, 144, 9, 42, 9, 43,
+			35, 88, 23, 9, 28, 28, 50, 59, 64, 212, 101, 47, 240, 60,
+			74, 198, 247, 150, 55, 179, 149, 187, 244, 197, 139, 27, 34, 54,
+			29, 92, 69, 41, 17, 17, 145, 19, 124, 33, 55, 124, 223, 149,
+			239, 135, 132, 66, 182, 161, 193, 23, 195, 185, 1, 130, 113, 89,
+			113, 95, 48, 44, 245, 220, 92, 238, 67, 193, 241, 104, 243, 64,
+			13, 241, 168, 60, 63, 194, 224, 199, 63, 49, 216, 139, 23, 5,
+			136, 233, 25, 46, 46, 58, 129, 223, 236, 54, 14, 22, 91, 246,
+			59, 251, 117, 127, 122, 102, 134, 91, 11, 209, 208, 206, 143, 37,
+			54, 147, 225, 240, 196, 109, 12, 34, 22, 153, 182, 8, 21, 5,
+			23, 125, 190, 156, 202, 30, 33, 255, 30, 252, 104, 44, 136, 50,
+			253, 149, 148, 254, 223, 83, 70, 254, 95, 112, 79, 154, 228, 197,
+			254, 158, 248, 122, 162, 139, 37, 202, 67, 40, 138, 112, 34, 106,
+			20, 97, 197, 10, 217, 142, 8, 42, 0, 7, 63, 77, 63, 154,
+			149, 33, 118, 154, 210, 159, 219, 9, 183, 20, 163, 201, 103, 17,
+			168, 211, 106, 37, 106, 39, 65, 122, 36, 230, 75, 58, 221, 100,
+			158, 31, 201, 72, 33, 252, 157, 26, 208, 244, 122, 120, 0, 28,
+			186, 194, 126, 175, 199, 153, 18, 161, 229, 210, 78, 169, 248, 102,
+			250, 6, 241, 64, 58, 250, 196, 188, 177, 72, 223, 80, 216, 182,
+			131, 210, 182, 253, 100, 161, 136, 182, 21, 204, 106, 39, 138, 208,
+			167, 18, 24, 17, 254, 176, 250, 180, 168, 51, 83, 130, 146, 98,
+			178, 90, 24, 129, 250, 43, 41, 2, 98, 7, 30, 77, 69, 89,
+			250, 213, 148, 89, 64, 185, 100, 137, 152, 211, 95, 77, 137, 136,
+			208, 150, 136, 57, 253, 213, 212, 200, 68, 156, 1, 78, 230, 169,
+			201, 187, 227, 12, 112, 51, 79, 209, 99, 10, 38, 132, 204, 73,
+			153, 199, 85, 1, 16, 102, 127, 156, 132, 9, 150, 138, 63, 134,
+			201, 168, 50, 176, 138, 117, 79, 156, 1, 113, 118, 82, 199, 10,
+			56, 151, 45, 192, 242, 79, 82, 58, 143, 139, 106, 225, 234, 250,
+			39, 82, 226, 88, 104, 92, 251, 147, 212, 208, 132, 76, 66, 52,
+			250, 212, 228, 148, 76, 66, 52, 250, 212, 145, 187, 248, 19, 81,
+			22, 200, 163, 175, 165, 244, 83, 249, 119, 107, 137, 151, 78, 158,
+			135, 155, 138, 48, 82, 123, 187, 118, 132, 92, 12, 182, 71, 44,
+			26, 249, 79, 48, 207, 121, 18, 60, 73, 29, 25, 162, 180, 9,
+			218, 148, 29, 138, 163, 155, 248, 228, 166, 44, 46, 169, 64, 192,
+			56, 222, 82, 168, 28, 255, 197, 27, 84, 178, 47, 64, 170, 175,
+			197, 93, 3, 66, 125, 45, 37, 252, 63, 44, 148, 251, 95, 75,
+			137, 155, 144, 22, 18, 233, 107, 169, 147, 247, 10, 34, 129, 207,
+			119, 74, 159, 17, 31, 65, 44, 63, 23, 67, 2, 247, 246, 231,
+			98, 72, 64, 134, 231, 82, 214, 9, 153, 4, 51, 117, 234, 212,
+			180, 128, 4, 177, 239, 83, 34, 218, 178, 133, 207, 86, 125, 61,
+			134, 4, 135, 59, 95, 79, 9, 79, 63, 11, 159, 173, 250, 122,
+			106, 252, 184, 76, 98, 221, 123, 79, 9, 72, 166, 101, 126, 35,
+			37, 162, 205, 91, 232, 56, 250, 141, 24, 18, 28, 141, 124, 35,
+			198, 9, 142, 70, 190, 145, 18, 23, 220, 44, 60, 26, 249, 70,
+			204, 2, 41, 203, 252, 139, 148, 136, 163, 107, 225, 249, 192, 95,
+			196, 144, 224, 124, 224, 47, 98, 156, 224, 124, 224, 47, 82, 227,
+			146, 95, 224, 124, 224, 47, 128, 65, 57, 36, 176, 130, 167, 68,
+			232, 100, 11, 205, 249, 223, 140, 33, 129, 57, 255, 155, 49, 78,
+			96, 206, 255, 102, 202, 58, 38, 147, 6, 36, 79, 156, 36, 95,
+			210, 136, 110, 78, 88, 233, 239, 166, 6, 254, 103, 90, 203, 63,
+			78, 203, 94, 195, 238, 132, 34, 254, 233, 139, 123, 50, 29, 230,
+			61, 63, 45, 23, 46, 88, 16, 177, 216, 101, 177, 36, 69, 13,
+			147, 238, 217, 137, 120, 46, 37, 178, 248, 216, 247, 51, 226, 108,
+			140, 13, 120, 21, 32, 226, 92, 10, 195, 109, 195, 239, 130, 61,
+			224, 173, 227, 196, 52, 39, 96, 6, 126, 60, 173, 31, 203, 127,
+			35, 71, 151, 232, 170, 47, 207, 198, 36, 235, 131, 108, 237, 56,
+			140, 187, 43, 244, 66, 164, 118, 82, 208, 113, 119, 51, 184, 94,
+			19, 4, 44, 236, 248, 16, 35, 3, 108, 197, 201, 227, 222, 56,
+			232, 173, 60, 245, 236, 123, 237, 29, 100, 190, 215, 20, 175, 117,
+			196, 207, 135, 68, 62, 173, 172, 148, 225, 208, 158, 183, 220, 100,
+			55, 89, 16, 22, 251, 110, 3, 10, 90, 226, 153, 22, 168, 168,
+			161, 211, 118, 92, 59, 32, 234, 157, 120, 241, 214, 23, 6, 92,
+			44, 210, 208, 222, 135, 125, 2, 46, 95, 112, 11, 200, 101, 241,
+			133, 132, 91, 94, 51, 179, 93, 21, 204, 202, 247, 213, 237, 130,
+			167, 8, 93, 101, 240, 158, 17, 220, 107, 128, 152, 171, 24, 162,
+			55, 118, 5, 142, 251, 125, 145, 188, 0, 168, 55, 137, 171, 10,
+			111, 122, 147, 250, 243, 38, 248, 243, 38, 168, 104, 139, 143, 219,
+			13, 252, 211, 100, 148, 182, 40, 221, 217, 117, 8, 88, 36, 84,
+			176, 89, 21, 196, 135, 186, 98, 60, 67, 108, 55, 236, 216, 30,
+			165, 24, 176, 71, 0, 146, 255, 75, 174, 50, 148, 190, 193, 46,
+			58, 51, 148, 190, 129, 158, 43, 210, 249, 34, 93, 44, 210, 121,
+			250, 70, 40, 134, 130, 117, 111, 215, 119, 15, 118, 172, 36, 42,
+			110, 247, 85, 44, 210, 115, 80, 23, 42, 186, 246, 54, 115, 233,
+			180, 236, 61, 44, 140, 148, 190, 161, 81, 108, 30, 168, 114, 159,
+			172, 130, 82, 121, 154, 147, 73, 148, 103, 197, 214, 129, 242, 11,
+			178, 60, 172, 244, 116, 186, 229, 251, 162, 240, 78, 113, 247, 64,
+			225, 179, 170, 48, 110, 86, 233, 244, 194, 140, 124, 99, 2, 200,
+			52, 75, 151, 20, 217, 132, 219, 135, 138, 33, 174, 60, 121, 133,
+			15, 81, 20, 50, 183, 37, 94, 193, 19, 46, 18, 24, 170, 142,
+			38, 153, 30, 217, 85, 198, 180, 117, 162, 25, 197, 250, 161, 58,
+			163, 22, 33, 214, 96, 193, 128, 105, 38, 156, 116, 209, 247, 10,
+			186, 1, 155, 96, 112, 87, 129, 167, 19, 26, 174, 47, 28, 63,
+			148, 135, 46, 78, 112, 161, 193, 148, 104, 47, 147, 131, 121, 24,
+			31, 174, 32, 242, 246, 35, 58, 240, 54, 158, 160, 211, 29, 63,
+			12, 157, 109, 87, 5, 127, 6, 126, 81, 222, 61, 177, 230, 19,
+			15, 113, 200, 213, 62, 128, 36, 238, 67, 10, 47, 25, 69, 174,
+			189, 93, 216, 58, 34, 127, 97, 228, 117, 117, 16, 84, 80, 240,
+			194, 130, 242, 88, 6, 64, 177, 151, 43, 8, 83, 244, 113, 104,
+			178, 155, 37, 24, 134, 235, 18, 23, 9, 62, 140, 247, 98, 242,
+			112, 9, 121, 89, 18, 148, 187, 177, 138, 120, 200, 146, 58, 9,
+			250, 137, 208, 175, 74, 216, 132, 29, 240, 96, 130, 62, 200, 152,
+			204, 170, 251, 16, 47, 66, 6, 164, 167, 109, 63, 68, 163, 130,
+			191, 125, 195, 241, 187, 161, 36, 174, 124, 36, 144, 247, 173, 89,
+			16, 116, 181, 119, 108, 199, 83, 145, 109, 101, 48, 100, 9, 185,
+			127, 24, 100, 80, 106, 126, 241, 16, 30, 94, 100, 69, 238, 169,
+			237, 183, 146, 145, 130, 15, 233, 117, 47, 171, 158, 10, 249, 244,
+			150, 126, 94, 232, 42, 3, 154, 109, 119, 91, 112, 21, 248, 203,
+			112, 197, 85, 148, 229, 188, 194, 49, 18, 236, 146, 232, 15, 131,
+			199, 255, 90, 189, 193, 247, 146, 4, 148, 123, 32, 126, 97, 22,
+			160, 108, 179, 29, 199, 195, 139, 240, 126, 43, 9, 73, 81, 198,
+			195, 219, 68, 225, 174, 29, 240, 173, 69, 95, 164, 105, 233, 183,
+			197, 35, 228, 98, 29, 100, 132, 135, 64, 152, 74, 55, 41, 251,
+			176, 30, 39, 187, 25, 250, 109, 25, 64, 180, 175, 36, 64, 86,
+			27, 187, 54, 179, 61, 225, 102, 134, 238, 145, 60, 108, 54, 243,
+			154, 246, 33, 147, 136, 22, 240, 185, 225, 130, 216, 193, 134, 0,
+			9, 223, 21, 181, 209, 21, 25, 13, 34, 137, 192, 225, 106, 102,
+			38, 232, 149, 12, 201, 169, 186, 128, 128, 226, 5, 19, 72, 39,
+			38, 140, 2, 203, 129, 138, 88, 118, 14, 68, 253, 107, 119, 124,
+			47, 246, 31, 85, 47, 161, 208, 89, 186, 28, 71, 169, 226, 143,
+			237, 128, 151, 162, 212, 123, 227, 70, 165, 134, 218, 9, 252, 109,
+			27, 4, 0, 94, 187, 6, 87, 67, 180, 131, 1, 78, 220, 76,
+			72, 35, 156, 207, 146, 74, 210, 114, 192, 99, 167, 192, 149, 219,
+			34, 120, 158, 131, 255, 161, 240, 136, 246, 91, 137, 86, 26, 160,
+			6, 3, 48, 124, 11, 165, 1, 126, 106, 113, 156, 78, 188, 122,
+			34, 54, 47, 19, 168, 247, 127, 60, 45, 84, 181, 9, 212, 251,
+			63, 158, 22, 122, 255, 4, 234, 253, 31, 79, 79, 222, 37, 147,
+			6, 104, 44, 71, 41, 26, 88, 38, 96, 23, 249, 137, 180, 254,
+			221, 52, 119, 127, 158, 192, 141, 208, 39, 210, 196, 34, 239, 204,
+			144, 180, 57, 1, 219, 24, 203, 252, 205, 180, 89, 204, 127, 43,
+			157, 124, 116, 64, 132, 69, 135, 16, 191, 130, 93, 111, 165, 163,
+			201, 59, 223, 226, 249, 33, 162, 250, 8, 203, 70, 57, 113, 171,
+			66, 216, 78, 19, 150, 80, 241, 26, 24, 46, 1, 50, 132, 27,
+			58, 233, 217, 40, 185, 80, 73, 36, 234, 149, 211, 67, 181, 68,
+			193, 16, 40, 145, 132, 63, 147, 48, 168, 9, 177, 76, 253, 70,
+			163, 139, 111, 208, 247, 48, 109, 4, 34, 17, 26, 193, 165, 31,
+			215, 242, 179, 184, 150, 223, 95, 164, 11, 244, 141, 36, 150, 208,
+			88, 0, 244, 159, 146, 80, 123, 182, 96, 52, 167, 207, 206, 80,
+			58, 55, 135, 245, 228, 13, 202, 18, 114, 227, 244, 253, 51, 34,
+			141, 5, 0, 164, 42, 0, 11, 240, 116, 252, 25, 11, 44, 196,
+			110, 143, 114, 22, 31, 210, 209, 158, 198, 121, 36, 129, 36, 33,
+			207, 33, 150, 106, 1, 238, 167, 82, 79, 229, 7, 232, 57, 112,
+			163, 245, 14, 20, 227, 248, 31, 4, 190, 216, 11, 252, 176, 231,
+			69, 68, 5, 126, 81, 212, 246, 14, 47, 132, 221, 63, 8, 126,
+			225, 80, 173, 15, 203, 138, 107, 164, 177, 104, 192, 3, 113, 206,
+			28, 241, 35, 164, 146, 225, 64, 190, 41, 6, 227, 70, 141, 74,
+			11, 148, 88, 225, 66, 195, 89, 201, 181, 193, 103, 146, 179, 228,
+			129, 193, 135, 145, 87, 172, 17, 243, 0, 141, 14, 104, 118, 177,
+			168, 159, 86, 225, 33, 149, 136, 39, 114, 210, 112, 165, 78, 0,
+			224, 55, 108, 228, 65, 92, 219, 105, 248, 174, 239, 205, 8, 63,
+			254, 9, 97, 132, 248, 205, 180, 48, 24, 76, 8, 35, 196, 111,
+			166, 197, 179, 84, 19, 194, 8, 241, 155, 233, 137, 59, 226, 12,
+			3, 50, 242, 71, 226, 140, 44, 100, 220, 117, 134, 228, 72, 86,
+			100, 232, 144, 115, 247, 105, 242, 187, 186, 152, 242, 240, 222, 107,
+			218, 44, 230, 127, 67, 151, 119, 78, 97, 80, 100, 76, 137, 104,
+			23, 76, 232, 248, 106, 64, 55, 144, 148, 10, 47, 138, 128, 223,
+			174, 227, 177, 162, 248, 221, 240, 221, 110, 219, 43, 194, 243, 101,
+			77, 252, 16, 171, 175, 197, 132, 199, 174, 29, 134, 221, 54, 107,
+			242, 197, 217, 14, 19, 128, 102, 32, 82, 3, 120, 118, 3, 28,
+			245, 192, 133, 29, 168, 107, 99, 142, 23, 249, 241, 130, 193, 135,
+			22, 76, 238, 242, 34, 88, 99, 191, 68, 19, 62, 209, 0, 147,
+			243, 31, 7, 41, 88, 12, 85, 69, 250, 36, 11, 252, 89, 110,
+			220, 159, 157, 77, 248, 172, 67, 132, 124, 208, 220, 232, 158, 8,
+			100, 96, 55, 155, 4, 206, 104, 124, 174, 106, 136, 211, 144, 166,
+			19, 118, 92, 123, 95, 172, 66, 54, 104, 167, 65, 98, 236, 192,
+			130, 241, 185, 228, 216, 129, 13, 227, 115, 201, 177, 3, 43, 198,
+			231, 146, 99, 7, 118, 140, 207, 37, 199, 14, 44, 216, 159, 75,
+			142, 157, 150, 213, 33, 231, 238, 211, 228, 103, 135, 197, 216, 129,
+			111, 87, 218, 156, 201, 191, 111, 88, 133, 178, 175, 225, 190, 19,
+			150, 183, 138, 215, 242, 19, 187, 21, 106, 171, 195, 173, 36, 199,
+			202, 192, 215, 64, 20, 84, 200, 27, 126, 91, 104, 212, 168, 181,
+			56, 241, 17, 16, 144, 146, 191, 213, 25, 237, 246, 192, 16, 11,
+			4, 87, 115, 164, 219, 181, 124, 144, 69, 50, 124, 162, 60, 44,
+			6, 75, 16, 10, 210, 17, 11, 33, 112, 203, 33, 237, 194, 29,
+			65, 223, 11, 89, 163, 139, 94, 167, 48, 160, 97, 17, 183, 7,
+			212, 243, 57, 79, 193, 142, 245, 9, 230, 245, 213, 226, 139, 177,
+			42, 207, 143, 246, 34, 97, 111, 182, 195, 152, 137, 68, 163, 128,
+			16, 56, 20, 59, 222, 206, 22, 188, 227, 9, 120, 111, 41, 132,
+			144, 31, 224, 162, 3, 108, 85, 236, 157, 192, 238, 236, 34, 218,
+			170, 0, 178, 27, 71, 128, 72, 98, 77, 195, 129, 8, 108, 116,
+			26, 190, 231, 241, 123, 4, 145, 63, 3, 196, 166, 226, 14, 128,
+			156, 77, 37, 110, 169, 85, 176, 139, 68, 133, 60, 71, 213, 15,
+			163, 47, 245, 119, 198, 143, 41, 38, 225, 72, 133, 65, 202, 102,
+			113, 45, 7, 250, 182, 238, 185, 202, 200, 142, 69, 197, 197, 154,
+			100, 84, 156, 75, 234, 99, 219, 14, 158, 0, 81, 199, 237, 224,
+			115, 115, 51, 48, 99, 224, 165, 116, 120, 143, 20, 108, 130, 93,
+			169, 246, 161, 226, 41, 235, 133, 69, 73, 67, 224, 135, 72, 68,
+			218, 66, 166, 129, 167, 77, 34, 22, 56, 225, 19, 241, 43, 199,
+			10, 220, 65, 137, 9, 196, 197, 217, 6, 157, 22, 10, 106, 180,
+			107, 203, 176, 143, 65, 24, 149, 8, 93, 99, 123, 240, 153, 79,
+			103, 113, 95, 55, 190, 235, 139, 15, 102, 65, 215, 203, 234, 209,
+			136, 158, 133, 5, 99, 195, 40, 107, 2, 174, 189, 203, 162, 251,
+			73, 198, 5, 43, 50, 161, 183, 252, 12, 246, 229, 67, 192, 110,
+			219, 1, 95, 253, 14, 91, 203, 182, 237, 39, 233, 3, 244, 236,
+			165, 231, 5, 251, 164, 108, 117, 201, 19, 250, 57, 80, 226, 64,
+			153, 231, 129, 209, 86, 152, 191, 16, 36, 89, 82, 33, 218, 244,
+			187, 224, 241, 222, 70, 226, 156, 187, 68, 4, 128, 21, 49, 49,
+			20, 159, 128, 236, 109, 248, 193, 78, 226, 117, 88, 96, 120, 201,
+			4, 112, 206, 30, 216, 142, 11, 191, 37, 139, 8, 80, 28, 63,
+			42, 235, 43, 93, 39, 66, 157, 13, 6, 116, 219, 181, 189, 39,
+			16, 215, 80, 206, 6, 128, 227, 68, 66, 15, 68, 48, 176, 175,
+			40, 189, 48, 122, 241, 212, 162, 139, 37, 210, 211, 85, 49, 38,
+			188, 216, 3, 244, 62, 62, 42, 167, 233, 229, 36, 99, 43, 106,
+			193, 71, 122, 26, 104, 38, 186, 77, 87, 69, 95, 37, 123, 135,
+			162, 136, 100, 114, 161, 108, 148, 232, 233, 185, 231, 133, 44, 246,
+			18, 244, 52, 221, 9, 224, 240, 87, 86, 80, 136, 114, 126, 229,
+			31, 233, 3, 244, 188, 26, 21, 225, 165, 64, 165, 216, 146, 160,
+			229, 149, 64, 88, 57, 192, 12, 254, 237, 228, 114, 4, 134, 240,
+			111, 167, 197, 121, 6, 102, 128, 211, 112, 122, 242, 68, 156, 97,
+			64, 198, 169, 105, 60, 207, 128, 18, 240, 236, 105, 218, 60, 173,
+			10, 128, 65, 252, 59, 73, 152, 96, 18, 255, 78, 18, 38, 24,
+			197, 191, 147, 158, 60, 25, 103, 32, 140, 233, 25, 5, 211, 180,
+			204, 191, 78, 155, 139, 170, 128, 201, 51, 98, 152, 96, 28, 255,
+			235, 36, 76, 83, 131, 140, 201, 217, 56, 195, 128, 140, 249, 5,
+			8, 224, 163, 155, 147, 86, 250, 109, 153, 129, 15, 100, 52, 240,
+			11, 224, 10, 237, 182, 208, 11, 3, 230, 226, 218, 19, 238, 58,
+			29, 186, 205, 162, 61, 198, 148, 25, 84, 220, 39, 67, 93, 1,
+			182, 254, 125, 70, 106, 249, 80, 196, 146, 138, 163, 222, 84, 235,
+			171, 80, 166, 129, 247, 237, 48, 244, 27, 142, 173, 78, 190, 212,
+			251, 56, 170, 21, 34, 173, 194, 0, 47, 62, 50, 151, 239, 60,
+			160, 134, 137, 209, 248, 129, 197, 98, 95, 97, 81, 41, 97, 64,
+			150, 47, 202, 64, 80, 185, 183, 101, 178, 119, 146, 155, 196, 52,
+			39, 97, 247, 246, 142, 140, 126, 50, 255, 56, 92, 82, 90, 82,
+			78, 47, 114, 9, 130, 7, 194, 219, 16, 242, 217, 230, 209, 160,
+			216, 77, 184, 65, 217, 79, 4, 208, 121, 60, 185, 166, 16, 105,
+			13, 17, 133, 96, 14, 38, 240, 16, 187, 210, 73, 220, 149, 190,
+			35, 35, 118, 165, 147, 184, 43, 125, 71, 102, 232, 54, 153, 132,
+			160, 225, 153, 219, 169, 76, 66, 208, 240, 204, 241, 19, 184, 43,
+			157, 132, 93, 233, 143, 101, 244, 103, 51, 124, 87, 58, 137, 187,
+			210, 31, 203, 144, 73, 242, 180, 70, 210, 230, 36, 223, 149, 190,
+			43, 99, 22, 243, 63, 146, 220, 148, 194, 160, 246, 173, 124, 253,
+			135, 11, 73, 76, 19, 239, 141, 225, 157, 112, 188, 137, 129, 215,
+			210, 149, 169, 200, 238, 215, 158, 74, 210, 198, 95, 130, 77, 134,
+			152, 80, 147, 66, 55, 127, 87, 70, 48, 234, 164, 208, 205, 223,
+			149, 17, 250, 221, 164, 208, 205, 223, 149, 17, 250, 221, 164, 208,
+			205, 223, 149, 17, 250, 221, 164, 208, 205, 223, 149, 17, 250, 221,
+			164, 212, 205, 223, 149, 185, 27, 110, 66, 240, 126, 107, 150, 249,
+			238, 140, 121, 34, 255, 154, 254, 126, 3, 255, 240, 200, 220, 124,
+			111, 18, 249, 207, 211, 255, 4, 222, 160, 151, 190, 59, 137, 55,
+			232, 165, 239, 206, 136, 9, 54, 41, 244, 210, 119, 103, 38, 143,
+			198, 25, 6, 100, 20, 142, 147, 61, 129, 20, 248, 53, 103, 204,
+			187, 243, 59, 253, 72, 161, 38, 15, 12, 226, 183, 90, 96, 71,
+			115, 60, 188, 122, 164, 204, 118, 189, 124, 38, 162, 145, 227, 180,
+			140, 189, 224, 19, 199, 136, 252, 52, 62, 129, 60, 72, 177, 247,
+			36, 145, 7, 41, 246, 158, 36, 209, 225, 64, 239, 61, 25, 241,
+			18, 240, 164, 144, 98, 239, 201, 28, 185, 139, 252, 103, 201, 74,
+			16, 180, 59, 99, 30, 201, 255, 59, 173, 31, 125, 233, 92, 246,
+			98, 144, 23, 55, 103, 159, 7, 121, 132, 2, 235, 34, 2, 150,
+			80, 227, 243, 78, 208, 223, 58, 118, 24, 37, 182, 161, 224, 83,
+			117, 3, 118, 29, 64, 53, 58, 45, 30, 234, 227, 49, 37, 228,
+			22, 18, 231, 236, 3, 8, 114, 150, 235, 75, 114, 203, 56, 41,
+			100, 242, 251, 147, 20, 2, 153, 252, 254, 36, 133, 64, 38, 191,
+			63, 35, 94, 50, 158, 20, 50, 249, 253, 153, 59, 243, 228, 15,
+			78, 146, 123, 224, 74, 190, 203, 230, 100, 240, 249, 185, 102, 87,
+			232, 235, 152, 99, 141, 245, 5, 167, 47, 92, 36, 217, 21, 81,
+			196, 154, 34, 153, 144, 53, 224, 40, 107, 74, 163, 218, 180, 81,
+			149, 73, 107, 146, 164, 60, 219, 243, 195, 41, 157, 106, 211, 169,
+			42, 79, 92, 126, 155, 70, 38, 26, 126, 187, 212, 7, 243, 242,
+			136, 132, 136, 86, 136, 13, 237, 209, 5, 81, 98, 199, 135, 168,
+			78, 37, 63, 216, 137, 17, 4, 19, 70, 56, 135, 79, 238, 41,
+			100, 59, 219, 223, 210, 180, 143, 232, 198, 213, 141, 203, 159, 208,
+			239, 185, 202, 43, 111, 136, 26, 165, 135, 153, 235, 190, 22, 202,
+			215, 161, 234, 67, 95, 58, 78, 210, 150, 121, 207, 64, 168, 145,
+			207, 15, 19, 109, 216, 50, 238, 25, 176, 22, 127, 99, 88, 57,
+			182, 73, 175, 54, 58, 27, 187, 226, 97, 156, 88, 56, 126, 11,
+			68, 72, 85, 46, 75, 8, 5, 159, 143, 0, 158, 142, 164, 139,
+			243, 243, 175, 18, 21, 224, 85, 107, 184, 54, 234, 186, 20, 191,
+			133, 202, 193, 175, 68, 94, 234, 43, 102, 132, 208, 42, 131, 247,
+			188, 3, 103, 187, 11, 68, 82, 17, 153, 29, 25, 218, 6, 115,
+			182, 29, 15, 238, 203, 2, 94, 114, 195, 132, 175, 217, 224, 209,
+			1, 161, 109, 191, 169, 174, 246, 21, 81, 127, 142, 131, 116, 168,
+			32, 153, 234, 250, 120, 124, 68, 6, 195, 43, 223, 225, 132, 29,
+			65, 155, 69, 23, 81, 15, 1, 149, 167, 23, 177, 176, 255, 12,
+			84, 188, 49, 171, 30, 52, 226, 198, 155, 134, 164, 24, 1, 75,
+			51, 250, 60, 227, 46, 214, 117, 194, 8, 32, 36, 91, 244, 154,
+			189, 207, 110, 208, 166, 19, 54, 92, 219, 105, 67, 232, 136, 91,
+			32, 225, 120, 73, 90, 200, 135, 110, 133, 207, 78, 140, 7, 137,
+			17, 249, 27, 225, 33, 221, 120, 250, 78, 91, 109, 175, 57, 7,
+			254, 69, 160, 222, 210, 182, 13, 170, 162, 237, 38, 226, 145, 202,
+			152, 163, 132, 38, 177, 87, 157, 90, 19, 151, 122, 101, 196, 96,
+			64, 40, 201, 91, 158, 240, 104, 133, 111, 161, 88, 176, 161, 71,
+			240, 140, 252, 118, 55, 242, 3, 245, 206, 36, 30, 162, 97, 36,
+			157, 166, 31, 192, 245, 102, 244, 95, 106, 131, 201, 132, 211, 36,
+			10, 123, 222, 57, 17, 246, 175, 208, 111, 69, 123, 192, 38, 242,
+			240, 73, 189, 26, 209, 9, 32, 158, 200, 94, 0, 188, 227, 193,
+			149, 80, 188, 206, 237, 123, 160, 102, 215, 175, 85, 106, 180, 182,
+			126, 165, 254, 240, 82, 181, 76, 43, 53, 186, 81, 93, 127, 93,
+			101, 165, 188, 66, 47, 63, 66, 235, 215, 202, 116, 121, 125, 227,
+			145, 106, 229, 234, 181, 58, 189, 182, 190, 186, 82, 174, 214, 232,
+			210, 218, 10, 93, 94, 95, 171, 87, 43, 151, 55, 235, 235, 213,
+			26, 161, 133, 165, 26, 173, 212, 10, 248, 101, 105, 237, 17, 90,
+			126, 253, 70, 181, 92, 171, 209, 245, 42, 173, 92, 223, 88, 173,
+			148, 87, 232, 195, 75, 213, 234, 210, 90, 189, 82, 174, 21, 105,
+			101, 109, 121, 117, 115, 165, 178, 118, 181, 72, 47, 111, 214, 233,
+			218, 122, 157, 208, 213, 202, 245, 74, 189, 188, 66, 235, 235, 69,
+			108, 246, 96, 61, 186, 126, 133, 94, 47, 87, 151, 175, 45, 173,
+			213, 151, 46, 87, 86, 43, 245, 71, 176, 193, 43, 149, 250, 26,
+			52, 118, 101, 189, 10, 6, 139, 141, 165, 106, 189, 178, 188, 185,
+			186, 84, 165, 27, 155, 213, 141, 245, 90, 153, 66, 207, 86, 42,
+			181, 229, 213, 165, 202, 245, 242, 74, 137, 86, 214, 232, 218, 58,
+			45, 191, 174, 188, 86, 167, 181, 107, 75, 171, 171, 189, 29, 37,
+			116, 253, 225, 181, 114, 21, 176, 79, 118, 147, 94, 46, 211, 213,
+			202, 210, 229, 213, 50, 189, 178, 94, 197, 126, 174, 84, 170, 229,
+			229, 58, 116, 40, 254, 181, 92, 89, 41, 175, 213, 151, 86, 139,
+			132, 214, 54, 202, 203, 149, 165, 213, 34, 45, 191, 190, 124, 125,
+			99, 117, 169, 250, 72, 81, 0, 173, 149, 127, 104, 179, 188, 86,
+			175, 44, 173, 210, 149, 165, 235, 75, 87, 203, 53, 58, 253, 66,
+			84, 217, 168, 174, 47, 111, 86, 203, 215, 1, 235, 245, 43, 180,
+			182, 121, 185, 86, 175, 212, 55, 235, 101, 122, 117, 125, 125, 5,
+			137, 93, 43, 87, 95, 87, 89, 46, 215, 46, 209, 213, 117, 32,
+			255, 21, 186, 89, 43, 23, 9, 93, 89, 170, 47, 97, 211, 27,
+			213, 245, 43, 149, 122, 237, 18, 252, 190, 188, 89, 171, 32, 225,
+			42, 107, 245, 114, 181, 186, 185, 81, 175, 172, 175, 205, 208, 107,
+			235, 15, 151, 95, 87, 174, 210, 229, 165, 205, 90, 121, 5, 41,
+			188, 190, 6, 189, 5, 94, 41, 175, 87, 31, 1, 176, 64, 7,
+			28, 129, 34, 125, 248, 90, 185, 126, 173, 92, 5, 162, 34, 181,
+			150, 128, 12, 181, 122, 181, 178, 92, 79, 22, 91, 175, 210, 250,
+			122, 181, 78, 18, 253, 164, 107, 229, 171, 171, 149, 171, 229, 181,
+			229, 50, 224, 179, 14, 96, 30, 174, 212, 202, 51, 116, 169, 90,
+			169, 65, 129, 10, 54, 76, 31, 94, 122, 132, 174, 111, 98, 175,
+			97, 160, 54, 107, 101, 194, 127, 39, 88, 183, 136, 227, 73, 43,
+			87, 232, 210, 202, 235, 42, 128, 185, 40, 189, 177, 94, 171, 85,
+			4, 187, 32, 217, 150, 175, 9, 154, 151, 8, 201, 18, 77, 183,
+			12, 58, 48, 5, 191, 178, 150, 81, 24, 184, 68, 6, 137, 158,
+			61, 201, 127, 242, 204, 227, 3, 71, 49, 243, 40, 255, 201, 51,
+			79, 12, 92, 195, 204, 33, 254, 147, 103, 158, 28, 40, 98, 166,
+			198, 127, 242, 204, 123, 7, 74, 152, 41, 126, 242, 204, 83, 3,
+			5, 204, 36, 252, 39, 207, 156, 30, 56, 134, 153, 39, 248, 207,
+			159, 206, 17, 221, 28, 176, 204, 214, 64, 168, 229, 223, 3, 222,
+			44, 114, 213, 237, 53, 24, 242, 131, 46, 17, 119, 105, 86, 168,
+			34, 106, 255, 224, 180, 19, 238, 161, 96, 111, 66, 203, 90, 195,
+			239, 122, 40, 187, 197, 250, 143, 2, 179, 21, 216, 34, 110, 110,
+			242, 67, 68, 81, 23, 192, 36, 28, 178, 132, 190, 203, 37, 159,
+			120, 94, 54, 249, 138, 171, 136, 0, 210, 176, 93, 56, 120, 12,
+			132, 93, 215, 227, 175, 154, 129, 51, 10, 45, 52, 237, 253, 2,
+			250, 100, 22, 218, 190, 23, 237, 22, 36, 24, 174, 166, 161, 85,
+			164, 238, 180, 89, 24, 217, 109, 17, 48, 82, 172, 112, 114, 171,
+			213, 96, 114, 103, 72, 104, 180, 151, 44, 45, 95, 69, 134, 14,
+			42, 82, 1, 10, 78, 132, 247, 202, 100, 112, 35, 104, 61, 236,
+			110, 71, 208, 93, 160, 8, 8, 81, 106, 199, 128, 32, 30, 153,
+			39, 182, 139, 240, 202, 230, 77, 7, 150, 3, 119, 159, 158, 153,
+			93, 152, 47, 206, 207, 207, 211, 125, 240, 150, 5, 241, 121, 92,
+			153, 174, 98, 43, 22, 93, 184, 8, 198, 158, 78, 55, 98, 49,
+			26, 216, 70, 15, 186, 168, 114, 119, 66, 214, 109, 130, 165, 2,
+			163, 194, 225, 82, 162, 10, 8, 171, 251, 3, 180, 84, 42, 93,
+			234, 255, 6, 202, 101, 242, 139, 106, 72, 106, 88, 242, 43, 255,
+			44, 115, 75, 114, 88, 81, 61, 85, 169, 89, 222, 150, 76, 95,
+			234, 171, 132, 12, 32, 170, 240, 223, 178, 2, 166, 100, 35, 78,
+			139, 78, 31, 104, 232, 213, 116, 158, 222, 123, 111, 63, 172, 7,
+			233, 252, 140, 116, 68, 58, 4, 187, 51, 210, 123, 232, 16, 52,
+			102, 31, 160, 11, 243, 242, 31, 81, 232, 41, 202, 220, 144, 29,
+			142, 192, 131, 135, 34, 240, 234, 231, 71, 96, 246, 121, 16, 56,
+			115, 24, 2, 137, 225, 95, 140, 135, 63, 30, 47, 28, 255, 56,
+			121, 38, 30, 176, 151, 206, 5, 183, 28, 235, 91, 243, 136, 136,
+			22, 158, 24, 242, 7, 122, 135, 156, 158, 137, 187, 41, 178, 4,
+			188, 120, 208, 101, 21, 65, 134, 184, 194, 1, 46, 136, 235, 244,
+			210, 185, 135, 231, 146, 36, 142, 43, 156, 121, 254, 225, 141, 11,
+			62, 152, 44, 120, 139, 54, 206, 28, 222, 198, 236, 11, 140, 224,
+			217, 91, 77, 224, 166, 29, 49, 124, 73, 17, 254, 175, 201, 92,
+			220, 98, 208, 141, 253, 104, 151, 107, 83, 0, 40, 130, 137, 121,
+			176, 224, 116, 211, 222, 15, 31, 56, 91, 164, 109, 199, 235, 70,
+			44, 124, 96, 97, 126, 166, 119, 154, 209, 7, 84, 107, 211, 125,
+			159, 74, 87, 2, 191, 93, 87, 160, 162, 230, 12, 202, 158, 135,
+			106, 235, 107, 244, 58, 191, 182, 78, 8, 132, 48, 196, 28, 208,
+			162, 109, 17, 18, 81, 225, 15, 187, 176, 222, 152, 136, 241, 59,
+			150, 65, 34, 252, 34, 68, 20, 231, 187, 250, 98, 226, 116, 94,
+			20, 100, 48, 112, 66, 123, 14, 187, 45, 120, 109, 165, 16, 22,
+			232, 180, 227, 53, 29, 117, 95, 17, 135, 23, 223, 204, 38, 226,
+			21, 150, 6, 107, 198, 87, 8, 196, 25, 114, 188, 196, 200, 195,
+			34, 181, 202, 192, 193, 38, 44, 88, 161, 8, 221, 40, 215, 37,
+			176, 25, 241, 2, 165, 94, 127, 150, 179, 50, 159, 67, 154, 239,
+			129, 165, 182, 243, 68, 245, 221, 233, 33, 20, 248, 124, 20, 206,
+			134, 5, 25, 236, 41, 134, 6, 107, 199, 66, 2, 152, 48, 13,
+			192, 241, 81, 2, 197, 195, 160, 149, 36, 119, 45, 0, 92, 128,
+			211, 7, 149, 208, 182, 211, 8, 122, 225, 190, 88, 176, 11, 33,
+			132, 89, 135, 255, 25, 112, 229, 200, 104, 101, 115, 228, 191, 128,
+			207, 56, 24, 225, 140, 199, 245, 201, 252, 111, 107, 180, 134, 90,
+			129, 106, 84, 152, 38, 146, 106, 65, 137, 94, 23, 113, 142, 80,
+			56, 205, 158, 93, 184, 175, 120, 223, 253, 231, 97, 129, 131, 255,
+			224, 208, 142, 158, 233, 203, 228, 49, 87, 33, 196, 85, 73, 92,
+			148, 227, 7, 147, 219, 126, 23, 187, 38, 222, 68, 232, 202, 7,
+			29, 47, 18, 122, 126, 30, 144, 152, 107, 59, 30, 61, 13, 137,
+			182, 227, 205, 237, 6, 244, 52, 93, 60, 71, 119, 131, 185, 166,
+			189, 79, 79, 211, 179, 231, 239, 43, 45, 222, 71, 97, 142, 204,
+			193, 226, 74, 79, 243, 25, 202, 87, 90, 136, 9, 145, 130, 219,
+			82, 41, 203, 120, 92, 207, 200, 148, 102, 25, 143, 103, 199, 100,
+			202, 176, 140, 199, 173, 9, 242, 54, 3, 9, 161, 89, 70, 160,
+			91, 249, 111, 234, 146, 16, 61, 202, 141, 45, 232, 210, 171, 221,
+			36, 148, 27, 105, 202, 1, 122, 145, 152, 96, 114, 54, 241, 167,
+			34, 64, 55, 129, 64, 67, 76, 65, 11, 122, 116, 45, 25, 144,
+			127, 158, 208, 199, 196, 56, 60, 38, 78, 196, 129, 7, 108, 218,
+			241, 67, 30, 170, 7, 111, 180, 237, 240, 64, 217, 143, 33, 66,
+			162, 32, 103, 116, 41, 6, 224, 177, 176, 86, 178, 65, 63, 192,
+			40, 171, 69, 241, 96, 45, 156, 151, 139, 123, 2, 242, 2, 101,
+			15, 52, 25, 216, 138, 168, 238, 193, 249, 62, 232, 143, 192, 94,
+			88, 188, 23, 207, 126, 22, 185, 112, 225, 66, 81, 252, 199, 217,
+			35, 145, 145, 96, 13, 57, 94, 90, 10, 70, 65, 142, 151, 6,
+			99, 146, 29, 145, 41, 195, 50, 130, 220, 248, 118, 26, 237, 68,
+			103, 201, 63, 250, 29, 157, 220, 33, 226, 55, 177, 57, 249, 131,
+			155, 156, 172, 172, 76, 231, 233, 1, 243, 151, 242, 88, 225, 101,
+			243, 47, 96, 32, 203, 31, 237, 255, 30, 201, 5, 147, 3, 40,
+			252, 219, 44, 33, 232, 12, 83, 237, 186, 44, 180, 230, 73, 70,
+			156, 31, 76, 141, 83, 109, 122, 104, 241, 246, 146, 194, 78, 188,
+			150, 129, 5, 171, 178, 152, 85, 36, 41, 140, 42, 138, 54, 182,
+			161, 197, 201, 184, 252, 21, 200, 198, 210, 215, 6, 170, 188, 144,
+			53, 71, 210, 252, 44, 15, 77, 111, 67, 139, 183, 197, 197, 87,
+			48, 95, 150, 23, 197, 0, 60, 30, 52, 77, 25, 253, 224, 43,
+			144, 173, 192, 99, 33, 81, 250, 252, 185, 41, 243, 144, 210, 231,
+			207, 37, 75, 159, 63, 7, 200, 64, 56, 215, 179, 139, 83, 169,
+			126, 100, 54, 123, 160, 139, 98, 178, 194, 249, 115, 83, 233, 195,
+			42, 196, 13, 136, 98, 80, 1, 2, 197, 158, 93, 156, 202, 244,
+			87, 168, 245, 182, 16, 170, 22, 66, 222, 66, 246, 176, 10, 137,
+			22, 120, 49, 107, 145, 100, 112, 43, 116, 118, 113, 106, 176, 127,
+			192, 174, 240, 15, 178, 138, 44, 168, 234, 156, 63, 55, 69, 14,
+			173, 19, 55, 35, 11, 90, 247, 145, 108, 40, 27, 26, 194, 74,
+			119, 196, 149, 106, 125, 45, 169, 162, 113, 181, 243, 231, 166, 134,
+			15, 175, 118, 254, 92, 95, 181, 243, 231, 172, 25, 98, 110, 251,
+			190, 59, 53, 130, 85, 38, 226, 42, 151, 125, 223, 149, 197, 177,
+			8, 82, 12, 215, 235, 169, 209, 3, 20, 195, 124, 89, 92, 20,
+			3, 30, 65, 59, 250, 212, 88, 63, 143, 92, 134, 108, 89, 156,
+			23, 2, 76, 32, 36, 198, 84, 174, 31, 147, 178, 215, 109, 203,
+			178, 88, 4, 250, 42, 29, 29, 166, 172, 254, 190, 86, 197, 23,
+			89, 69, 21, 181, 238, 37, 70, 219, 238, 76, 77, 96, 13, 43,
+			174, 113, 221, 238, 200, 194, 80, 0, 202, 217, 222, 254, 212, 100,
+			127, 185, 37, 111, 95, 149, 179, 189, 125, 64, 67, 10, 129, 169,
+			219, 250, 209, 144, 50, 93, 214, 80, 69, 173, 87, 145, 65, 37,
+			27, 166, 110, 199, 122, 83, 113, 61, 165, 103, 203, 138, 113, 225,
+			203, 105, 98, 130, 190, 85, 248, 5, 141, 144, 120, 206, 131, 145,
+			29, 66, 10, 114, 193, 160, 87, 121, 194, 26, 37, 186, 27, 225,
+			228, 215, 171, 186, 27, 89, 57, 98, 184, 17, 195, 217, 173, 87,
+			225, 39, 148, 216, 137, 112, 2, 235, 85, 125, 7, 75, 236, 68,
+			12, 167, 168, 94, 53, 118, 120, 9, 199, 155, 74, 83, 99, 90,
+			175, 234, 142, 103, 221, 70, 210, 158, 31, 109, 57, 222, 84, 6,
+			243, 82, 158, 31, 85, 60, 235, 24, 25, 230, 71, 209, 91, 232,
+			175, 130, 51, 42, 91, 29, 226, 121, 101, 200, 42, 252, 115, 141,
+			12, 37, 228, 78, 47, 206, 218, 65, 156, 181, 126, 156, 181, 126,
+			156, 181, 126, 156, 181, 126, 156, 181, 67, 112, 214, 94, 2, 206,
+			64, 230, 88, 118, 244, 162, 156, 58, 136, 114, 170, 31, 229, 84,
+			63, 202, 169, 126, 148, 83, 253, 40, 167, 14, 65, 57, 245, 210,
+			81, 62, 127, 238, 16, 148, 141, 131, 40, 27, 253, 40, 27, 253,
+			40, 27, 253, 40, 27, 253, 40, 27, 135, 160, 108, 188, 4, 148,
+			129, 51, 54, 111, 69, 230, 145, 131, 56, 143, 244, 227, 60, 210,
+			143, 243, 72, 63, 206, 35, 253, 56, 143, 28, 130, 243, 200, 203,
+			192, 249, 80, 58, 155, 7, 113, 54, 251, 113, 54, 251, 113, 54,
+			251, 113, 54, 251, 113, 54, 15, 193, 217, 124, 137, 56, 215, 110,
+			69, 231, 241, 131, 56, 143, 247, 227, 60, 222, 143, 243, 120, 63,
+			206, 227, 253, 56, 143, 31, 130, 243, 248, 203, 192, 249, 80, 58,
+			91, 7, 113, 182, 250, 113, 182, 250, 113, 182, 250, 113, 182, 250,
+			113, 182, 14, 193, 217, 122, 9, 56, 255, 162, 70, 134, 147, 171,
+			116, 47, 210, 153, 131, 72, 103, 250, 145, 206, 244, 35, 157, 233,
+			71, 58, 211, 143, 116, 230, 16, 164, 51, 47, 7, 233, 67, 41,
+			157, 62, 136, 116, 186, 31, 233, 116, 63, 210, 233, 126, 164, 211,
+			253, 72, 167, 15, 65, 58, 253, 18, 144, 254, 180, 70, 70, 106,
+			183, 38, 245, 216, 65, 172, 199, 250, 177, 30, 235, 199, 122, 172,
+			31, 235, 177, 126, 172, 199, 14, 193, 122, 236, 101, 97, 125, 40,
+			173, 115, 7, 177, 206, 245, 99, 157, 235, 199, 58, 215, 143, 117,
+			174, 31, 235, 220, 33, 88, 231, 94, 2, 214, 199, 200, 160, 210,
+			8, 123, 17, 206, 10, 132, 11, 191, 151, 34, 67, 9, 69, 176,
+			183, 212, 160, 236, 22, 168, 33, 204, 67, 37, 12, 132, 32, 243,
+			172, 59, 72, 166, 237, 120, 91, 46, 243, 132, 172, 76, 183, 29,
+			111, 85, 124, 176, 111, 226, 7, 67, 124, 176, 111, 194, 135, 35,
+			100, 208, 101, 222, 22, 234, 141, 168, 166, 153, 213, 172, 203, 60,
+			212, 42, 173, 35, 100, 16, 192, 241, 143, 38, 255, 216, 118, 18,
+			31, 237, 155, 226, 99, 74, 124, 180, 111, 242, 143, 83, 36, 211,
+			177, 35, 8, 181, 131, 187, 142, 193, 170, 76, 90, 183, 147, 52,
+			127, 163, 26, 119, 23, 131, 85, 145, 130, 124, 110, 175, 66, 245,
+			97, 176, 42, 82, 86, 158, 100, 133, 99, 87, 136, 155, 133, 193,
+			170, 74, 131, 226, 1, 99, 160, 190, 223, 129, 223, 135, 60, 63,
+			90, 150, 69, 248, 176, 17, 106, 76, 15, 246, 13, 219, 16, 230,
+			137, 97, 187, 157, 164, 32, 216, 185, 139, 250, 126, 22, 182, 126,
+			152, 180, 238, 34, 217, 93, 63, 140, 224, 72, 24, 245, 122, 248,
+			164, 114, 172, 28, 209, 157, 14, 170, 240, 144, 175, 59, 29, 107,
+			146, 152, 78, 231, 198, 57, 84, 211, 33, 15, 83, 34, 247, 252,
+			84, 46, 145, 123, 222, 178, 136, 209, 13, 28, 220, 178, 66, 81,
+			72, 88, 119, 146, 76, 55, 112, 182, 2, 214, 66, 109, 28, 242,
+			211, 221, 192, 169, 178, 150, 149, 39, 25, 251, 255, 35, 239, 221,
+			195, 227, 58, 170, 124, 209, 222, 187, 170, 183, 90, 165, 119, 233,
+			97, 185, 253, 218, 86, 32, 150, 19, 185, 45, 59, 206, 3, 67,
+			72, 228, 71, 136, 18, 199, 242, 72, 54, 175, 195, 28, 107, 171,
+			123, 75, 218, 184, 213, 187, 211, 187, 219, 182, 56, 12, 39, 100,
+			66, 96, 72, 78, 194, 13, 28, 194, 16, 32, 25, 38, 112, 120,
+			132, 199, 4, 8, 144, 9, 23, 2, 25, 200, 97, 194, 77, 134,
+			225, 35, 220, 192, 129, 220, 59, 12, 31, 112, 25, 110, 230, 146,
+			225, 100, 2, 151, 185, 223, 111, 237, 170, 93, 221, 146, 28, 75,
+			142, 157, 124, 158, 235, 63, 252, 169, 186, 123, 215, 254, 213, 170,
+			85, 235, 183, 106, 213, 170, 170, 66, 1, 17, 45, 242, 144, 241,
+			149, 254, 0, 47, 168, 213, 130, 2, 185, 192, 248, 130, 74, 242,
+			114, 209, 121, 204, 47, 22, 15, 83, 98, 199, 225, 138, 63, 227,
+			31, 239, 239, 119, 173, 193, 246, 250, 249, 3, 101, 113, 140, 227,
+			187, 43, 83, 227, 237, 199, 116, 94, 7, 125, 34, 215, 198, 243,
+			148, 124, 181, 127, 53, 106, 222, 201, 113, 102, 196, 184, 250, 108,
+			145, 174, 103, 23, 233, 250, 174, 86, 33, 12, 132, 129, 255, 211,
+			22, 194, 204, 87, 26, 181, 186, 117, 129, 86, 183, 61, 31, 173,
+			174, 83, 63, 126, 34, 245, 131, 202, 182, 46, 161, 126, 208, 215,
+			214, 37, 213, 15, 10, 219, 90, 167, 126, 177, 110, 101, 92, 54,
+			216, 186, 64, 183, 154, 233, 51, 165, 91, 177, 150, 136, 37, 180,
+			164, 101, 73, 45, 209, 42, 72, 165, 69, 66, 110, 63, 153, 144,
+			143, 136, 230, 100, 154, 119, 2, 71, 123, 163, 104, 85, 59, 204,
+			14, 35, 187, 146, 108, 69, 102, 188, 69, 125, 134, 44, 119, 53,
+			110, 216, 18, 126, 52, 175, 243, 163, 7, 94, 41, 90, 235, 35,
+			44, 82, 10, 30, 29, 9, 202, 202, 154, 209, 223, 16, 161, 62,
+			13, 93, 189, 41, 41, 195, 201, 110, 107, 152, 101, 106, 155, 19,
+			84, 253, 185, 72, 249, 128, 153, 185, 160, 52, 138, 178, 182, 57,
+			241, 151, 182, 250, 210, 59, 30, 127, 217, 39, 156, 90, 41, 184,
+			182, 22, 27, 246, 204, 184, 42, 201, 243, 68, 58, 126, 96, 81,
+			124, 197, 196, 144, 198, 227, 159, 44, 146, 118, 122, 145, 180, 7,
+			126, 100, 137, 140, 158, 230, 106, 180, 101, 47, 168, 212, 163, 61,
+			128, 178, 70, 27, 127, 105, 208, 38, 95, 150, 194, 195, 17, 14,
+			246, 208, 128, 51, 165, 112, 130, 202, 114, 80, 240, 35, 254, 252,
+			115, 35, 166, 95, 200, 33, 225, 80, 56, 49, 234, 79, 63, 199,
+			111, 213, 111, 22, 53, 207, 89, 220, 188, 107, 68, 70, 79, 206,
+			27, 122, 206, 106, 236, 57, 165, 32, 246, 18, 134, 149, 213, 25,
+			214, 129, 95, 216, 34, 73, 69, 59, 121, 165, 91, 181, 186, 66,
+			90, 45, 219, 87, 235, 44, 46, 29, 9, 52, 161, 0, 165, 201,
+			155, 105, 102, 206, 78, 246, 107, 144, 254, 249, 49, 233, 243, 147,
+			253, 22, 191, 146, 155, 105, 62, 159, 62, 105, 189, 51, 84, 47,
+			92, 5, 231, 164, 245, 206, 196, 245, 42, 143, 225, 185, 235, 13,
+			74, 114, 56, 17, 104, 230, 100, 63, 87, 178, 254, 141, 45, 218,
+			27, 99, 30, 207, 41, 236, 225, 70, 97, 103, 23, 213, 111, 234,
+			82, 210, 62, 175, 78, 218, 207, 245, 115, 136, 123, 168, 94, 220,
+			207, 245, 99, 252, 76, 158, 87, 39, 239, 231, 172, 121, 134, 106,
+			54, 2, 127, 206, 154, 33, 241, 94, 225, 20, 171, 135, 75, 225,
+			49, 242, 55, 50, 227, 233, 98, 117, 127, 120, 12, 234, 58, 19,
+			127, 156, 137, 63, 158, 161, 143, 183, 9, 7, 75, 2, 100, 194,
+			79, 210, 159, 234, 135, 231, 93, 33, 68, 29, 99, 182, 136, 166,
+			67, 251, 175, 222, 63, 246, 154, 253, 157, 41, 217, 35, 58, 113,
+			250, 221, 225, 43, 247, 142, 236, 217, 59, 126, 120, 255, 200, 53,
+			123, 59, 45, 217, 43, 186, 234, 63, 125, 245, 200, 190, 67, 123,
+			59, 237, 157, 175, 16, 153, 66, 124, 231, 112, 65, 110, 88, 244,
+			90, 101, 101, 213, 57, 87, 253, 159, 137, 81, 39, 79, 236, 220,
+			41, 154, 212, 22, 134, 147, 63, 252, 217, 248, 97, 253, 192, 206,
+			157, 70, 73, 228, 186, 69, 15, 143, 149, 252, 112, 122, 225, 123,
+			245, 239, 119, 94, 37, 210, 21, 210, 180, 197, 15, 146, 253, 105,
+			120, 240, 68, 230, 41, 174, 98, 215, 1, 145, 13, 194, 156, 95,
+			58, 26, 206, 35, 167, 99, 62, 87, 158, 57, 154, 252, 254, 245,
+			219, 103, 130, 234, 108, 109, 138, 50, 58, 205, 111, 84, 114, 231,
+			150, 25, 191, 180, 101, 209, 218, 196, 85, 63, 251, 160, 45, 154,
+			100, 58, 149, 250, 31, 142, 133, 4, 162, 86, 201, 82, 41, 169,
+			146, 138, 172, 84, 151, 74, 235, 97, 169, 81, 157, 42, 132, 63,
+			227, 15, 121, 234, 2, 157, 42, 164, 254, 100, 41, 201, 156, 212,
+			121, 248, 148, 89, 146, 53, 165, 6, 233, 79, 91, 178, 76, 106,
+			179, 216, 41, 112, 240, 117, 75, 170, 203, 202, 110, 197, 245, 180,
+			250, 154, 11, 106, 155, 186, 248, 73, 237, 232, 54, 151, 125, 20,
+			145, 176, 42, 196, 123, 44, 97, 55, 165, 36, 107, 231, 3, 217,
+			119, 36, 119, 79, 23, 220, 82, 173, 88, 84, 71, 226, 148, 230,
+			235, 239, 206, 136, 43, 141, 151, 144, 204, 41, 237, 67, 106, 3,
+			23, 86, 121, 105, 19, 162, 126, 13, 173, 45, 45, 222, 90, 129,
+			11, 243, 144, 158, 90, 8, 221, 168, 86, 46, 135, 149, 106, 221,
+			59, 104, 1, 147, 53, 165, 108, 201, 90, 154, 94, 26, 255, 205,
+			1, 177, 53, 254, 59, 45, 89, 123, 91, 87, 252, 183, 37, 89,
+			187, 204, 198, 127, 51, 201, 218, 215, 109, 20, 251, 4, 46, 5,
+			96, 157, 124, 99, 118, 196, 29, 37, 77, 115, 225, 14, 152, 61,
+			16, 241, 230, 226, 186, 70, 233, 131, 28, 23, 54, 75, 33, 177,
+			234, 144, 88, 92, 178, 78, 133, 4, 107, 88, 157, 10, 9, 86,
+			176, 58, 229, 234, 248, 111, 38, 89, 231, 90, 87, 92, 66, 29,
+			211, 147, 234, 183, 178, 91, 78, 218, 49, 33, 180, 93, 119, 203,
+			59, 208, 45, 182, 100, 171, 248, 64, 246, 79, 220, 113, 165, 243,
+			174, 95, 138, 232, 126, 36, 74, 237, 213, 59, 124, 177, 230, 103,
+			142, 223, 209, 231, 179, 209, 209, 12, 113, 157, 65, 132, 195, 86,
+			94, 46, 234, 91, 60, 237, 5, 69, 186, 115, 165, 164, 118, 123,
+			39, 107, 247, 241, 51, 72, 22, 69, 2, 124, 44, 0, 219, 150,
+			172, 167, 233, 156, 248, 111, 14, 88, 177, 0, 236, 180, 100, 171,
+			148, 0, 108, 75, 178, 85, 170, 43, 108, 38, 217, 170, 117, 27,
+			149, 0, 214, 164, 54, 44, 71, 0, 4, 67, 11, 224, 122, 8,
+			128, 73, 182, 158, 191, 52, 123, 204, 165, 193, 170, 146, 86, 227,
+			76, 1, 211, 20, 125, 55, 157, 186, 206, 196, 47, 52, 94, 126,
+			149, 115, 119, 205, 235, 19, 57, 135, 4, 182, 157, 214, 73, 33,
+			136, 234, 30, 163, 195, 76, 112, 109, 152, 122, 48, 110, 58, 179,
+			37, 91, 163, 154, 206, 56, 0, 197, 77, 103, 142, 100, 235, 219,
+			86, 197, 127, 91, 146, 173, 239, 95, 23, 255, 13, 208, 238, 75,
+			196, 135, 176, 157, 41, 37, 249, 57, 169, 17, 43, 251, 191, 88,
+			174, 49, 58, 174, 95, 127, 202, 22, 26, 99, 198, 20, 109, 154,
+			196, 242, 0, 20, 84, 225, 95, 120, 110, 174, 218, 22, 58, 164,
+			246, 131, 210, 53, 60, 110, 227, 230, 4, 147, 38, 12, 125, 113,
+			203, 21, 100, 166, 215, 203, 44, 103, 50, 4, 206, 201, 72, 209,
+			162, 19, 4, 94, 194, 55, 169, 53, 216, 20, 71, 169, 85, 151,
+			28, 201, 94, 210, 182, 90, 151, 44, 201, 94, 146, 221, 168, 75,
+			76, 178, 151, 188, 36, 62, 191, 45, 149, 73, 73, 254, 82, 126,
+			121, 58, 254, 46, 131, 20, 132, 151, 138, 118, 177, 81, 47, 188,
+			111, 202, 156, 147, 237, 113, 39, 242, 30, 142, 200, 34, 153, 184,
+			148, 200, 159, 44, 11, 59, 248, 141, 212, 37, 75, 178, 77, 125,
+			107, 116, 137, 73, 182, 105, 227, 128, 66, 107, 75, 54, 152, 57,
+			71, 125, 101, 59, 40, 117, 235, 146, 37, 217, 96, 223, 90, 93,
+			98, 146, 13, 38, 143, 49, 201, 54, 39, 143, 161, 19, 55, 39,
+			111, 131, 105, 221, 156, 188, 141, 225, 151, 201, 99, 92, 178, 243,
+			146, 199, 184, 131, 146, 126, 140, 91, 146, 157, 151, 60, 198, 153,
+			100, 231, 37, 143, 165, 37, 59, 63, 121, 44, 237, 160, 164, 65,
+			166, 45, 201, 206, 79, 64, 166, 153, 100, 231, 39, 143, 57, 146,
+			13, 37, 143, 57, 84, 210, 143, 57, 150, 100, 67, 201, 99, 14,
+			147, 108, 40, 121, 172, 73, 178, 45, 201, 99, 77, 14, 74, 250,
+			49, 88, 196, 45, 201, 99, 77, 76, 178, 45, 201, 99, 25, 201,
+			114, 201, 99, 25, 7, 37, 253, 24, 56, 40, 151, 60, 150, 97,
+			146, 229, 146, 199, 154, 37, 219, 154, 60, 214, 236, 160, 212, 163,
+			75, 150, 100, 91, 251, 214, 233, 18, 147, 108, 107, 242, 152, 144,
+			108, 56, 243, 18, 245, 149, 112, 80, 210, 143, 9, 75, 178, 225,
+			228, 49, 193, 36, 27, 222, 120, 142, 122, 172, 69, 178, 109, 201,
+			99, 45, 14, 74, 189, 186, 100, 73, 182, 173, 111, 189, 46, 49,
+			201, 182, 37, 143, 181, 74, 182, 61, 121, 172, 213, 65, 73, 63,
+			214, 106, 73, 182, 61, 121, 172, 149, 73, 182, 61, 121, 172, 77,
+			178, 11, 146, 199, 218, 28, 148, 186, 116, 201, 146, 236, 130, 190,
+			172, 46, 49, 201, 46, 72, 30, 107, 151, 108, 71, 242, 88, 187,
+			131, 146, 150, 100, 187, 37, 217, 142, 68, 146, 237, 76, 178, 29,
+			201, 99, 29, 146, 93, 152, 60, 214, 225, 160, 164, 149, 171, 195,
+			146, 236, 194, 68, 185, 58, 152, 100, 23, 110, 60, 71, 12, 208,
+			99, 157, 146, 93, 146, 121, 73, 182, 151, 18, 210, 138, 254, 241,
+			165, 198, 83, 167, 131, 31, 105, 236, 157, 150, 100, 151, 36, 216,
+			59, 153, 100, 151, 36, 32, 186, 36, 123, 89, 2, 162, 203, 65,
+			73, 75, 170, 203, 146, 236, 101, 137, 164, 186, 152, 100, 47, 75,
+			30, 147, 146, 237, 76, 30, 147, 14, 74, 157, 186, 100, 73, 182,
+			179, 79, 27, 13, 201, 36, 219, 185, 241, 28, 241, 82, 122, 172,
+			91, 178, 75, 51, 231, 102, 251, 93, 236, 231, 217, 66, 110, 236,
+			82, 240, 187, 29, 252, 78, 87, 216, 109, 73, 118, 233, 42, 13,
+			191, 155, 73, 118, 233, 57, 47, 85, 56, 122, 36, 123, 101, 230,
+			92, 133, 163, 199, 65, 73, 195, 239, 177, 36, 123, 229, 170, 13,
+			186, 196, 36, 123, 101, 242, 88, 175, 100, 151, 37, 143, 245, 58,
+			40, 245, 233, 146, 37, 217, 101, 171, 92, 93, 98, 146, 93, 118,
+			206, 75, 225, 93, 96, 15, 235, 158, 148, 111, 101, 47, 119, 205,
+			2, 174, 91, 104, 216, 173, 74, 211, 150, 138, 23, 168, 243, 16,
+			136, 231, 170, 161, 59, 73, 121, 29, 147, 42, 29, 57, 54, 194,
+			240, 28, 246, 100, 164, 24, 23, 156, 46, 48, 101, 87, 240, 117,
+			217, 189, 238, 110, 212, 160, 56, 47, 208, 156, 111, 120, 45, 185,
+			136, 206, 28, 244, 225, 39, 191, 86, 87, 228, 199, 82, 180, 176,
+			185, 147, 93, 161, 108, 185, 101, 167, 210, 146, 93, 209, 38, 117,
+			201, 146, 236, 138, 238, 126, 93, 98, 146, 93, 177, 102, 173, 248,
+			99, 194, 98, 73, 118, 21, 207, 102, 15, 184, 251, 150, 3, 196,
+			164, 61, 45, 1, 101, 72, 152, 27, 16, 53, 44, 184, 82, 87,
+			37, 176, 224, 76, 93, 149, 192, 130, 80, 174, 234, 238, 213, 37,
+			38, 217, 85, 253, 171, 197, 27, 9, 150, 45, 217, 126, 190, 38,
+			251, 199, 238, 190, 170, 191, 34, 92, 97, 197, 245, 175, 173, 121,
+			250, 8, 23, 177, 8, 164, 201, 83, 210, 24, 109, 142, 151, 105,
+			140, 240, 119, 246, 39, 24, 193, 103, 251, 187, 251, 116, 137, 73,
+			182, 127, 117, 86, 124, 14, 217, 118, 22, 60, 160, 9, 158, 205,
+			126, 196, 114, 95, 181, 28, 225, 205, 208, 217, 22, 117, 167, 36,
+			60, 135, 252, 114, 238, 232, 130, 59, 70, 95, 85, 69, 54, 37,
+			93, 102, 172, 83, 53, 235, 218, 182, 175, 138, 172, 246, 125, 85,
+			125, 237, 96, 69, 103, 176, 87, 112, 20, 95, 124, 209, 95, 220,
+			6, 56, 56, 19, 73, 107, 89, 90, 178, 137, 164, 181, 96, 199,
+			137, 164, 71, 192, 142, 19, 253, 171, 197, 131, 113, 107, 185, 100,
+			175, 229, 107, 178, 159, 67, 107, 253, 149, 54, 119, 5, 221, 178,
+			84, 211, 253, 69, 109, 23, 39, 108, 252, 115, 180, 29, 167, 52,
+			191, 54, 105, 59, 79, 75, 246, 218, 164, 237, 160, 248, 215, 38,
+			61, 13, 138, 127, 237, 234, 172, 120, 29, 53, 61, 45, 217, 27,
+			120, 54, 187, 15, 41, 182, 39, 111, 120, 210, 80, 115, 140, 174,
+			1, 171, 174, 143, 82, 214, 207, 194, 249, 176, 236, 13, 9, 164,
+			52, 222, 148, 64, 130, 251, 240, 134, 164, 59, 224, 62, 188, 161,
+			127, 181, 248, 143, 4, 201, 145, 108, 146, 175, 207, 254, 17, 210,
+			48, 159, 19, 85, 158, 46, 86, 88, 49, 46, 28, 186, 60, 153,
+			224, 114, 210, 146, 77, 38, 184, 224, 159, 76, 118, 175, 214, 37,
+			38, 217, 228, 218, 117, 226, 186, 88, 77, 154, 36, 43, 240, 115,
+			178, 145, 154, 150, 81, 164, 126, 49, 188, 122, 239, 94, 249, 198,
+			250, 164, 244, 88, 152, 137, 163, 43, 92, 31, 18, 163, 121, 37,
+			226, 201, 250, 150, 82, 117, 58, 93, 124, 156, 5, 45, 135, 105,
+			232, 56, 169, 185, 144, 64, 111, 74, 75, 86, 104, 235, 82, 208,
+			225, 35, 21, 228, 122, 93, 98, 146, 21, 54, 14, 136, 49, 97,
+			115, 91, 166, 103, 83, 127, 102, 89, 217, 93, 110, 93, 254, 203,
+			50, 77, 126, 156, 154, 215, 104, 243, 97, 58, 102, 51, 221, 100,
+			243, 109, 216, 252, 55, 242, 245, 167, 211, 230, 219, 100, 243, 223,
+			168, 26, 106, 147, 205, 127, 99, 91, 183, 46, 33, 59, 182, 103,
+			181, 46, 33, 59, 118, 237, 58, 178, 249, 56, 233, 158, 149, 248,
+			154, 51, 100, 243, 109, 220, 133, 194, 74, 9, 44, 216, 252, 82,
+			2, 11, 54, 191, 212, 211, 167, 75, 76, 178, 210, 234, 44, 217,
+			124, 27, 19, 208, 10, 95, 251, 66, 216, 124, 155, 108, 126, 37,
+			193, 8, 155, 95, 73, 48, 66, 62, 149, 158, 85, 186, 132, 68,
+			213, 236, 154, 216, 230, 83, 233, 24, 95, 115, 86, 217, 124, 27,
+			7, 99, 179, 99, 73, 107, 97, 243, 143, 37, 173, 133, 205, 63,
+			150, 244, 8, 108, 254, 177, 213, 217, 216, 230, 147, 148, 222, 204,
+			215, 158, 197, 54, 223, 198, 81, 222, 236, 205, 73, 219, 97, 243,
+			223, 156, 180, 29, 54, 255, 205, 73, 79, 195, 230, 191, 57, 187,
+			70, 188, 158, 180, 49, 45, 249, 117, 22, 95, 115, 154, 141, 62,
+			78, 207, 176, 97, 244, 81, 121, 124, 43, 157, 77, 135, 130, 95,
+			103, 181, 117, 235, 162, 133, 98, 79, 159, 46, 50, 20, 87, 103,
+			197, 97, 194, 229, 72, 126, 131, 197, 55, 156, 9, 203, 31, 191,
+			15, 231, 140, 223, 96, 192, 225, 156, 241, 27, 12, 56, 156, 51,
+			126, 131, 213, 147, 213, 69, 134, 226, 186, 245, 226, 173, 177, 194,
+			52, 73, 254, 14, 235, 197, 50, 255, 49, 36, 220, 37, 250, 14,
+			131, 191, 41, 141, 98, 91, 151, 46, 90, 40, 170, 179, 219, 109,
+			196, 159, 248, 59, 172, 141, 3, 98, 191, 192, 49, 53, 206, 205,
+			86, 234, 83, 22, 188, 126, 147, 128, 181, 76, 10, 160, 108, 101,
+			195, 0, 56, 249, 5, 7, 57, 220, 108, 101, 164, 152, 16, 156,
+			51, 156, 144, 114, 139, 117, 250, 252, 126, 180, 135, 129, 3, 248,
+			45, 186, 181, 12, 36, 192, 111, 177, 218, 164, 46, 90, 146, 223,
+			98, 117, 247, 235, 34, 67, 113, 205, 90, 242, 33, 152, 109, 73,
+			126, 155, 117, 70, 156, 255, 248, 117, 56, 201, 228, 54, 131, 13,
+			231, 152, 220, 102, 176, 225, 20, 147, 219, 172, 238, 94, 93, 100,
+			40, 246, 175, 22, 71, 8, 155, 45, 249, 123, 173, 51, 63, 3,
+			136, 223, 141, 83, 75, 222, 107, 128, 226, 204, 146, 247, 26, 160,
+			144, 211, 123, 173, 238, 62, 93, 196, 241, 36, 214, 234, 172, 248,
+			60, 84, 158, 225, 248, 146, 59, 172, 179, 104, 26, 16, 183, 2,
+			199, 144, 220, 97, 154, 140, 67, 72, 238, 48, 77, 134, 230, 222,
+			97, 250, 6, 7, 144, 220, 97, 245, 175, 22, 95, 143, 155, 204,
+			37, 191, 203, 58, 107, 231, 2, 113, 155, 120, 220, 8, 45, 0,
+			156, 98, 117, 151, 17, 0, 230, 255, 119, 153, 62, 231, 12, 197,
+			213, 89, 226, 6, 6, 253, 184, 219, 58, 237, 19, 130, 248, 85,
+			224, 134, 187, 13, 46, 112, 195, 221, 6, 87, 218, 66, 49, 233,
+			24, 92, 24, 113, 55, 58, 6, 220, 192, 112, 163, 209, 199, 172,
+			51, 51, 43, 136, 95, 15, 110, 248, 152, 1, 7, 110, 248, 152,
+			1, 7, 110, 248, 152, 213, 189, 90, 23, 25, 138, 107, 215, 197,
+			220, 192, 112, 107, 244, 61, 47, 42, 55, 48, 226, 134, 123, 12,
+			126, 112, 195, 61, 154, 27, 24, 150, 148, 248, 61, 154, 27, 24,
+			113, 195, 61, 154, 27, 184, 116, 62, 107, 165, 254, 78, 115, 195,
+			69, 59, 86, 200, 13, 23, 237, 104, 228, 6, 40, 216, 103, 53,
+			55, 112, 112, 195, 189, 167, 153, 27, 56, 113, 195, 189, 186, 181,
+			156, 184, 225, 94, 221, 91, 156, 184, 225, 94, 205, 13, 28, 27,
+			232, 248, 189, 154, 27, 56, 108, 222, 125, 103, 142, 27, 56, 38,
+			9, 252, 62, 131, 13, 220, 112, 159, 193, 6, 110, 184, 79, 171,
+			57, 199, 170, 0, 191, 79, 115, 3, 199, 205, 96, 247, 191, 64,
+			220, 192, 137, 27, 238, 55, 64, 49, 246, 239, 55, 64, 33, 167,
+			251, 181, 157, 128, 255, 140, 162, 230, 6, 142, 226, 87, 207, 54,
+			110, 224, 152, 46, 240, 175, 154, 38, 131, 27, 190, 106, 154, 12,
+			110, 248, 170, 233, 27, 112, 195, 87, 19, 110, 224, 16, 215, 67,
+			103, 55, 55, 112, 140, 118, 254, 144, 17, 0, 184, 225, 33, 35,
+			0, 12, 221, 135, 76, 159, 131, 27, 30, 210, 220, 192, 161, 31,
+			15, 159, 25, 110, 224, 52, 111, 120, 216, 224, 2, 55, 60, 108,
+			112, 129, 27, 30, 54, 29, 3, 110, 120, 88, 115, 3, 7, 55,
+			60, 114, 6, 185, 129, 211, 253, 68, 143, 24, 112, 224, 134, 71,
+			12, 56, 112, 195, 35, 154, 27, 56, 113, 195, 35, 9, 55, 112,
+			112, 195, 99, 47, 42, 55, 112, 226, 134, 199, 12, 126, 112, 195,
+			99, 154, 27, 56, 113, 195, 99, 154, 27, 56, 113, 195, 99, 224,
+			134, 3, 194, 230, 105, 233, 124, 207, 74, 253, 11, 197, 142, 14,
+			173, 120, 226, 80, 91, 98, 230, 128, 174, 252, 158, 149, 233, 166,
+			153, 67, 26, 236, 240, 125, 235, 244, 69, 143, 208, 132, 52, 177,
+			195, 247, 117, 123, 211, 196, 14, 223, 215, 243, 188, 52, 177, 195,
+			247, 173, 158, 213, 186, 200, 0, 97, 237, 58, 154, 57, 164, 97,
+			245, 158, 176, 206, 72, 8, 41, 126, 29, 216, 225, 9, 131, 13,
+			236, 240, 132, 193, 6, 118, 120, 66, 79, 144, 211, 196, 14, 79,
+			96, 0, 130, 29, 210, 96, 135, 31, 91, 103, 62, 142, 20, 191,
+			27, 230, 238, 199, 6, 40, 70, 255, 143, 13, 80, 200, 233, 199,
+			86, 207, 42, 93, 100, 40, 102, 215, 196, 236, 144, 6, 59, 252,
+			131, 117, 22, 5, 147, 226, 86, 128, 29, 254, 193, 52, 25, 236,
+			240, 15, 166, 201, 96, 135, 127, 48, 125, 3, 118, 248, 7, 244,
+			13, 177, 67, 26, 226, 250, 185, 117, 214, 70, 148, 226, 54, 129,
+			29, 126, 110, 4, 0, 118, 248, 185, 17, 0, 216, 225, 231, 166,
+			207, 193, 14, 63, 183, 84, 84, 41, 13, 253, 248, 213, 153, 137,
+			42, 165, 137, 29, 126, 101, 112, 129, 29, 126, 101, 112, 193, 164,
+			252, 202, 116, 12, 216, 225, 87, 58, 170, 148, 6, 59, 60, 117,
+			6, 163, 74, 105, 98, 135, 167, 12, 56, 176, 195, 83, 6, 28,
+			216, 225, 41, 29, 85, 74, 19, 59, 60, 149, 68, 149, 210, 96,
+			135, 167, 95, 84, 118, 72, 19, 59, 60, 109, 240, 131, 29, 158,
+			214, 236, 144, 38, 118, 120, 90, 179, 67, 154, 216, 225, 105, 205,
+			14, 142, 116, 158, 177, 82, 127, 110, 107, 118, 88, 217, 212, 161,
+			182, 196, 220, 1, 210, 122, 70, 179, 131, 3, 118, 120, 246, 52,
+			179, 131, 67, 236, 240, 172, 110, 175, 67, 236, 240, 172, 238, 47,
+			135, 216, 225, 89, 205, 14, 14, 205, 29, 158, 213, 236, 224, 192,
+			234, 253, 225, 204, 177, 131, 67, 115, 135, 63, 24, 108, 96, 135,
+			63, 24, 108, 96, 135, 63, 104, 69, 119, 136, 29, 254, 160, 217,
+			193, 1, 59, 92, 111, 191, 48, 236, 224, 208, 220, 225, 122, 59,
+			1, 138, 209, 127, 189, 157, 0, 133, 156, 174, 183, 149, 165, 112,
+			136, 29, 174, 183, 53, 59, 56, 40, 190, 221, 62, 203, 216, 193,
+			161, 185, 195, 219, 77, 147, 193, 14, 111, 55, 77, 6, 59, 188,
+			221, 78, 250, 6, 236, 240, 118, 91, 179, 131, 3, 113, 221, 108,
+			159, 213, 236, 224, 208, 220, 225, 102, 35, 0, 176, 195, 205, 70,
+			0, 96, 135, 155, 77, 159, 131, 29, 110, 182, 21, 59, 56, 208,
+			143, 119, 217, 103, 132, 29, 28, 98, 135, 119, 25, 92, 96, 135,
+			119, 25, 92, 96, 135, 119, 153, 142, 1, 59, 188, 203, 86, 236,
+			224, 128, 29, 222, 99, 159, 57, 118, 112, 136, 29, 222, 99, 192,
+			129, 29, 222, 99, 192, 193, 222, 189, 199, 86, 236, 224, 16, 59,
+			188, 199, 214, 236, 224, 128, 29, 222, 103, 191, 152, 236, 224, 16,
+			59, 188, 207, 224, 7, 59, 188, 207, 86, 236, 224, 16, 59, 188,
+			207, 86, 236, 224, 16, 59, 188, 207, 86, 236, 208, 36, 157, 15,
+			216, 169, 175, 16, 59, 76, 172, 120, 238, 16, 45, 49, 119, 192,
+			219, 62, 96, 43, 118, 104, 2, 59, 124, 200, 62, 189, 236, 208,
+			68, 236, 240, 33, 221, 222, 38, 98, 135, 15, 233, 254, 106, 34,
+			118, 248, 144, 173, 216, 161, 137, 216, 225, 67, 182, 98, 135, 38,
+			88, 189, 15, 219, 103, 140, 29, 154, 136, 29, 62, 108, 176, 129,
+			29, 62, 108, 176, 129, 29, 62, 172, 21, 189, 137, 216, 225, 195,
+			182, 98, 135, 38, 176, 195, 71, 95, 32, 118, 104, 34, 118, 248,
+			168, 1, 138, 209, 255, 81, 3, 20, 114, 250, 168, 182, 20, 77,
+			196, 14, 31, 77, 216, 161, 9, 197, 79, 158, 109, 236, 208, 68,
+			236, 240, 73, 211, 100, 176, 195, 39, 77, 147, 193, 14, 159, 52,
+			125, 3, 118, 248, 100, 194, 14, 72, 74, 231, 159, 61, 187, 217,
+			161, 137, 216, 225, 179, 70, 0, 96, 135, 207, 26, 1, 128, 29,
+			62, 107, 250, 28, 236, 240, 89, 205, 14, 77, 208, 143, 207, 159,
+			25, 118, 104, 34, 118, 248, 188, 193, 5, 118, 248, 188, 193, 5,
+			118, 248, 188, 233, 24, 176, 195, 231, 53, 59, 52, 129, 29, 190,
+			116, 6, 217, 161, 137, 216, 225, 75, 6, 28, 216, 225, 75, 6,
+			28, 216, 225, 75, 154, 29, 154, 136, 29, 190, 148, 176, 67, 19,
+			216, 225, 129, 23, 149, 29, 154, 136, 29, 30, 48, 248, 193, 14,
+			15, 104, 118, 104, 34, 118, 120, 64, 179, 67, 19, 177, 195, 3,
+			154, 29, 50, 210, 249, 154, 157, 250, 63, 18, 118, 88, 217, 220,
+			33, 90, 98, 238, 144, 177, 36, 255, 154, 102, 135, 12, 216, 225,
+			235, 167, 153, 29, 50, 196, 14, 95, 215, 237, 205, 16, 59, 124,
+			93, 247, 87, 134, 216, 225, 235, 154, 29, 50, 196, 14, 95, 215,
+			236, 144, 129, 213, 251, 230, 153, 99, 135, 12, 177, 195, 55, 13,
+			54, 176, 195, 55, 13, 54, 176, 195, 55, 181, 162, 103, 136, 29,
+			190, 169, 217, 33, 3, 118, 248, 246, 11, 196, 14, 25, 98, 135,
+			111, 27, 160, 24, 253, 223, 54, 64, 33, 167, 111, 107, 75, 145,
+			33, 118, 248, 118, 194, 14, 25, 20, 31, 61, 219, 216, 33, 67,
+			236, 240, 168, 105, 50, 216, 225, 81, 211, 100, 176, 195, 163, 166,
+			111, 192, 14, 143, 38, 236, 144, 129, 184, 190, 119, 118, 179, 67,
+			134, 216, 225, 123, 70, 0, 96, 135, 239, 25, 1, 128, 29, 190,
+			103, 250, 28, 236, 240, 61, 205, 14, 25, 232, 199, 15, 206, 12,
+			59, 100, 136, 29, 126, 96, 112, 129, 29, 126, 96, 112, 129, 29,
+			126, 96, 58, 6, 236, 240, 3, 205, 14, 25, 176, 195, 143, 206,
+			32, 59, 100, 136, 29, 126, 100, 192, 129, 29, 126, 100, 192, 129,
+			29, 126, 164, 217, 33, 67, 236, 240, 163, 132, 29, 50, 96, 135,
+			39, 95, 84, 118, 200, 16, 59, 60, 105, 240, 131, 29, 158, 212,
+			236, 144, 33, 118, 120, 82, 179, 67, 134, 216, 225, 73, 176, 195,
+			184, 176, 121, 179, 116, 126, 106, 167, 222, 198, 172, 236, 30, 183,
+			254, 120, 157, 101, 210, 131, 58, 145, 176, 145, 31, 154, 45, 201,
+			127, 106, 103, 122, 104, 246, 208, 12, 126, 248, 25, 122, 239, 52,
+			242, 67, 51, 241, 195, 207, 116, 139, 155, 137, 31, 126, 102, 183,
+			245, 232, 162, 133, 119, 246, 102, 117, 145, 161, 184, 110, 61, 205,
+			30, 154, 97, 247, 126, 137, 97, 126, 102, 248, 161, 153, 248, 225,
+			151, 6, 27, 248, 225, 151, 6, 27, 248, 225, 151, 118, 239, 42,
+			93, 100, 40, 102, 215, 80, 206, 82, 51, 248, 225, 215, 54, 95,
+			247, 66, 240, 67, 51, 241, 195, 175, 13, 80, 140, 255, 95, 27,
+			160, 144, 211, 175, 237, 222, 126, 93, 100, 40, 174, 89, 27, 199,
+			150, 154, 193, 15, 191, 129, 20, 207, 38, 126, 104, 38, 126, 248,
+			141, 105, 50, 248, 225, 55, 166, 201, 224, 135, 223, 152, 190, 1,
+			63, 252, 6, 230, 145, 248, 161, 25, 226, 122, 6, 157, 115, 246,
+			242, 67, 51, 241, 195, 51, 70, 0, 224, 135, 103, 140, 0, 192,
+			15, 207, 152, 62, 7, 63, 60, 99, 175, 89, 75, 43, 15, 205,
+			208, 143, 223, 163, 203, 79, 63, 63, 52, 19, 63, 252, 222, 224,
+			2, 63, 252, 222, 224, 2, 63, 252, 222, 116, 12, 248, 225, 247,
+			232, 24, 240, 67, 51, 248, 225, 58, 198, 221, 51, 197, 15, 205,
+			196, 15, 215, 177, 4, 28, 248, 225, 58, 150, 128, 3, 63, 92,
+			199, 122, 215, 232, 34, 146, 109, 217, 250, 13, 113, 108, 169, 25,
+			252, 112, 3, 123, 49, 249, 161, 153, 248, 225, 6, 131, 31, 252,
+			112, 3, 83, 252, 208, 76, 252, 112, 3, 83, 252, 208, 76, 252,
+			112, 3, 83, 252, 32, 164, 243, 14, 150, 250, 184, 225, 135, 149,
+			77, 31, 212, 209, 179, 141, 252, 32, 144, 63, 203, 20, 63, 8,
+			240, 195, 59, 217, 233, 229, 7, 65, 252, 240, 78, 221, 98, 65,
+			252, 240, 78, 221, 99, 130, 248, 225, 157, 76, 241, 131, 160, 249,
+			195, 59, 153, 226, 7, 1, 187, 119, 11, 59, 99, 252, 32, 136,
+			31, 110, 49, 216, 192, 15, 183, 24, 108, 224, 135, 91, 152, 82,
+			117, 65, 243, 135, 91, 152, 226, 7, 1, 126, 184, 141, 189, 48,
+			252, 32, 136, 31, 110, 51, 64, 49, 254, 111, 51, 64, 33, 167,
+			219, 152, 178, 21, 130, 230, 15, 183, 49, 205, 15, 2, 197, 219,
+			217, 89, 198, 15, 130, 248, 225, 118, 211, 100, 240, 195, 237, 166,
+			201, 224, 135, 219, 77, 223, 128, 31, 110, 103, 154, 31, 4, 196,
+			245, 1, 118, 86, 243, 131, 32, 126, 248, 128, 17, 0, 248, 225,
+			3, 70, 0, 224, 135, 15, 152, 62, 7, 63, 124, 128, 41, 126,
+			16, 208, 143, 187, 216, 25, 225, 7, 65, 252, 112, 151, 193, 5,
+			126, 184, 203, 224, 2, 63, 220, 101, 58, 6, 252, 112, 23, 83,
+			252, 32, 192, 15, 119, 159, 65, 126, 16, 196, 15, 119, 27, 112,
+			224, 135, 187, 13, 56, 240, 195, 221, 154, 31, 4, 241, 195, 221,
+			9, 63, 8, 240, 195, 199, 94, 84, 126, 16, 196, 15, 31, 51,
+			248, 193, 15, 31, 211, 252, 64, 199, 164, 240, 143, 105, 126, 16,
+			196, 15, 31, 3, 63, 28, 20, 54, 111, 145, 206, 61, 44, 245,
+			29, 102, 101, 175, 112, 39, 78, 97, 2, 17, 45, 57, 131, 104,
+			65, 22, 45, 203, 244, 18, 67, 180, 128, 33, 62, 141, 254, 59,
+			141, 12, 209, 66, 12, 241, 105, 221, 230, 22, 98, 136, 79, 179,
+			182, 94, 93, 180, 240, 206, 190, 53, 186, 200, 80, 92, 191, 129,
+			24, 162, 5, 150, 239, 94, 12, 244, 51, 195, 16, 45, 196, 16,
+			247, 26, 108, 96, 136, 123, 13, 54, 48, 196, 189, 172, 175, 95,
+			23, 145, 117, 139, 65, 136, 25, 68, 11, 24, 226, 62, 198, 215,
+			191, 16, 12, 209, 66, 12, 113, 159, 1, 10, 11, 112, 159, 1,
+			10, 57, 221, 199, 250, 86, 235, 34, 82, 112, 217, 218, 117, 49,
+			67, 180, 128, 33, 254, 26, 82, 60, 155, 24, 162, 133, 24, 226,
+			175, 77, 147, 193, 16, 127, 109, 154, 12, 134, 248, 107, 211, 55,
+			96, 136, 191, 70, 223, 16, 67, 180, 64, 92, 95, 67, 231, 156,
+			189, 12, 209, 66, 12, 241, 53, 35, 0, 48, 196, 215, 140, 0,
+			192, 16, 95, 51, 125, 14, 134, 248, 26, 250, 28, 12, 209, 2,
+			253, 120, 8, 93, 126, 250, 25, 162, 133, 24, 226, 33, 131, 11,
+			12, 241, 144, 193, 5, 134, 120, 200, 116, 12, 24, 226, 33, 116,
+			12, 24, 162, 5, 12, 241, 48, 227, 27, 207, 20, 67, 180, 16,
+			67, 60, 108, 192, 129, 33, 30, 54, 224, 192, 16, 15, 179, 190,
+			181, 186, 136, 180, 91, 182, 193, 141, 103, 16, 45, 96, 136, 71,
+			94, 84, 134, 104, 33, 134, 120, 196, 224, 7, 67, 60, 162, 25,
+			162, 133, 24, 226, 17, 205, 16, 45, 196, 16, 143, 104, 134, 104,
+			149, 206, 99, 44, 245, 207, 117, 12, 177, 194, 21, 136, 37, 231,
+			16, 173, 200, 165, 213, 12, 209, 10, 134, 248, 238, 105, 102, 136,
+			86, 98, 136, 239, 234, 54, 183, 18, 67, 124, 87, 247, 89, 43,
+			205, 33, 190, 171, 25, 162, 149, 230, 16, 223, 213, 12, 209, 10,
+			203, 247, 253, 51, 199, 16, 173, 196, 16, 223, 55, 216, 192, 16,
+			223, 55, 216, 192, 16, 223, 215, 202, 222, 74, 115, 136, 239, 107,
+			134, 104, 5, 67, 60, 241, 2, 49, 68, 43, 49, 196, 19, 6,
+			40, 44, 192, 19, 6, 40, 228, 244, 132, 182, 22, 173, 52, 135,
+			120, 34, 97, 136, 86, 20, 127, 114, 182, 49, 68, 43, 49, 196,
+			79, 76, 147, 193, 16, 63, 49, 77, 6, 67, 252, 196, 244, 13,
+			24, 226, 39, 9, 67, 180, 66, 92, 63, 61, 187, 25, 162, 149,
+			24, 226, 167, 70, 0, 96, 136, 159, 26, 1, 128, 33, 126, 106,
+			250, 28, 12, 241, 83, 205, 16, 173, 208, 143, 159, 159, 25, 134,
+			104, 37, 134, 248, 185, 193, 5, 134, 248, 185, 193, 5, 134, 248,
+			185, 233, 24, 48, 196, 207, 53, 67, 180, 130, 33, 126, 117, 6,
+			25, 162, 149, 24, 226, 87, 6, 28, 24, 226, 87, 6, 28, 24,
+			226, 87, 154, 33, 90, 137, 33, 126, 149, 48, 68, 43, 24, 226,
+			169, 23, 149, 33, 90, 137, 33, 158, 50, 248, 193, 16, 79, 105,
+			134, 104, 37, 134, 120, 74, 51, 68, 43, 49, 196, 83, 96, 8,
+			156, 147, 212, 38, 157, 167, 89, 234, 127, 50, 43, 251, 74, 55,
+			57, 120, 126, 153, 236, 128, 219, 138, 26, 153, 161, 13, 121, 180,
+			44, 211, 69, 115, 135, 54, 48, 195, 111, 49, 39, 61, 141, 204,
+			208, 70, 204, 240, 91, 221, 214, 54, 98, 134, 223, 234, 182, 182,
+			17, 51, 252, 150, 201, 85, 186, 200, 0, 33, 187, 134, 142, 8,
+			105, 151, 206, 179, 44, 245, 127, 113, 43, 59, 226, 214, 29, 160,
+			159, 180, 246, 185, 169, 144, 30, 104, 108, 110, 59, 210, 104, 153,
+			90, 140, 111, 71, 115, 127, 207, 78, 239, 98, 124, 59, 53, 247,
+			247, 186, 185, 237, 212, 220, 223, 51, 181, 60, 214, 78, 205, 253,
+			61, 83, 139, 241, 237, 68, 132, 191, 199, 120, 126, 24, 206, 75,
+			59, 44, 252, 91, 57, 95, 151, 253, 178, 229, 238, 243, 151, 51,
+			164, 27, 129, 36, 119, 61, 10, 55, 63, 235, 225, 6, 62, 191,
+			18, 185, 131, 135, 74, 1, 46, 98, 116, 233, 191, 114, 8, 81,
+			109, 142, 239, 19, 212, 245, 250, 39, 120, 20, 87, 164, 199, 23,
+			1, 199, 23, 114, 54, 94, 40, 73, 183, 5, 232, 51, 46, 113,
+			140, 124, 105, 70, 153, 181, 118, 226, 220, 183, 242, 68, 12, 224,
+			220, 183, 242, 68, 12, 224, 220, 183, 114, 181, 180, 218, 78, 156,
+			251, 86, 158, 93, 43, 126, 16, 139, 193, 150, 252, 70, 206, 221,
+			236, 127, 183, 220, 107, 130, 210, 25, 150, 4, 206, 202, 244, 112,
+			77, 104, 48, 87, 155, 59, 211, 98, 1, 101, 221, 104, 196, 2,
+			11, 126, 163, 17, 11, 250, 255, 70, 222, 179, 70, 23, 25, 138,
+			235, 55, 104, 177, 48, 201, 111, 210, 98, 241, 142, 191, 48, 98,
+			241, 142, 191, 16, 98, 129, 23, 112, 147, 17, 11, 188, 128, 155,
+			140, 88, 224, 5, 220, 100, 196, 2, 47, 224, 38, 190, 126, 131,
+			120, 45, 41, 11, 2, 196, 156, 159, 147, 189, 10, 67, 134, 46,
+			36, 56, 117, 177, 196, 32, 53, 42, 80, 243, 45, 6, 21, 168,
+			249, 22, 131, 10, 212, 124, 11, 239, 89, 167, 139, 136, 61, 115,
+			119, 64, 76, 19, 42, 132, 127, 57, 31, 200, 190, 22, 26, 124,
+			122, 80, 53, 40, 170, 198, 8, 154, 190, 205, 96, 4, 77, 223,
+			102, 48, 130, 166, 111, 51, 24, 65, 211, 183, 113, 119, 163, 194,
+			232, 72, 254, 94, 133, 209, 59, 126, 90, 49, 122, 199, 235, 49,
+			130, 173, 223, 107, 48, 130, 173, 223, 107, 48, 130, 173, 223, 107,
+			48, 130, 173, 223, 11, 140, 159, 136, 149, 190, 73, 242, 59, 56,
+			223, 152, 189, 195, 114, 15, 196, 215, 62, 168, 215, 159, 8, 227,
+			156, 87, 205, 207, 38, 39, 207, 54, 192, 197, 125, 234, 51, 53,
+			28, 147, 170, 238, 125, 5, 181, 15, 142, 239, 221, 238, 70, 243,
+			165, 170, 119, 124, 115, 206, 61, 56, 235, 171, 115, 151, 253, 66,
+			253, 175, 20, 217, 251, 197, 160, 224, 11, 186, 117, 189, 224, 23,
+			131, 185, 160, 234, 211, 205, 228, 49, 118, 208, 250, 29, 166, 161,
+			160, 245, 59, 76, 67, 65, 235, 119, 24, 53, 6, 173, 223, 193,
+			55, 184, 34, 162, 118, 102, 36, 191, 19, 237, 244, 221, 3, 116,
+			135, 202, 201, 186, 98, 214, 59, 186, 176, 51, 162, 218, 148, 186,
+			56, 216, 171, 10, 250, 110, 202, 159, 9, 74, 37, 58, 231, 118,
+			122, 169, 113, 151, 225, 244, 86, 13, 56, 147, 70, 49, 1, 140,
+			220, 181, 59, 185, 202, 229, 104, 183, 51, 12, 197, 13, 174, 152,
+			35, 192, 205, 146, 255, 37, 0, 31, 118, 39, 106, 211, 167, 11,
+			48, 238, 89, 95, 26, 106, 51, 167, 247, 105, 168, 205, 105, 20,
+			19, 168, 72, 163, 248, 75, 3, 181, 153, 161, 152, 64, 21, 146,
+			255, 183, 24, 170, 190, 74, 230, 249, 128, 165, 238, 143, 239, 106,
+			94, 218, 154, 9, 78, 239, 211, 80, 69, 26, 197, 4, 42, 86,
+			244, 254, 27, 239, 89, 171, 139, 12, 197, 13, 174, 168, 16, 212,
+			22, 201, 63, 201, 249, 185, 217, 130, 187, 223, 92, 124, 115, 114,
+			7, 250, 121, 225, 109, 65, 150, 176, 193, 219, 130, 44, 97, 131,
+			23, 241, 229, 79, 242, 30, 87, 23, 145, 37, 204, 207, 121, 41,
+			45, 115, 183, 219, 173, 146, 127, 6, 156, 116, 250, 167, 32, 237,
+			118, 43, 167, 202, 53, 174, 214, 52, 138, 9, 46, 68, 53, 62,
+			195, 85, 26, 84, 187, 221, 202, 80, 92, 183, 129, 142, 109, 106,
+			183, 219, 36, 255, 28, 63, 115, 203, 24, 237, 118, 27, 167, 55,
+			104, 112, 109, 105, 20, 19, 112, 112, 172, 63, 103, 244, 177, 141,
+			161, 184, 46, 14, 120, 180, 103, 82, 210, 249, 34, 231, 63, 225,
+			233, 236, 126, 58, 172, 53, 62, 171, 53, 158, 99, 148, 43, 225,
+			209, 160, 224, 187, 94, 225, 168, 87, 202, 251, 133, 70, 7, 87,
+			89, 181, 124, 56, 55, 23, 150, 84, 63, 10, 87, 221, 135, 163,
+			177, 225, 136, 104, 254, 69, 46, 122, 68, 129, 100, 209, 46, 249,
+			253, 60, 179, 38, 251, 106, 119, 47, 238, 65, 90, 44, 11, 127,
+			65, 47, 121, 104, 107, 80, 112, 233, 218, 36, 87, 221, 75, 68,
+			119, 131, 171, 91, 101, 112, 185, 248, 248, 21, 187, 221, 11, 47,
+			216, 190, 93, 11, 164, 29, 39, 53, 240, 140, 22, 8, 124, 237,
+			251, 185, 218, 143, 222, 110, 183, 51, 20, 251, 179, 226, 83, 177,
+			89, 239, 144, 252, 171, 192, 244, 33, 203, 189, 82, 221, 192, 180,
+			124, 92, 250, 206, 166, 165, 32, 109, 27, 190, 96, 7, 204, 120,
+			16, 213, 73, 206, 45, 132, 126, 60, 13, 83, 71, 227, 11, 55,
+			40, 85, 253, 74, 137, 102, 119, 94, 49, 120, 147, 95, 112, 11,
+			225, 156, 23, 148, 92, 84, 28, 185, 131, 163, 123, 246, 71, 155,
+			147, 33, 210, 129, 51, 25, 76, 227, 58, 112, 38, 3, 87, 19,
+			206, 118, 187, 3, 103, 50, 240, 254, 172, 248, 243, 184, 113, 157,
+			146, 127, 3, 141, 123, 167, 229, 142, 150, 151, 223, 172, 209, 3,
+			238, 224, 209, 29, 136, 156, 28, 189, 104, 179, 22, 123, 78, 196,
+			199, 220, 187, 163, 7, 142, 94, 164, 63, 244, 147, 123, 214, 209,
+			38, 197, 87, 110, 84, 171, 84, 112, 49, 56, 12, 106, 116, 109,
+			205, 171, 248, 238, 84, 197, 203, 31, 241, 171, 134, 162, 58, 211,
+			132, 77, 55, 164, 211, 66, 177, 173, 67, 23, 25, 138, 253, 89,
+			113, 53, 181, 163, 75, 242, 111, 162, 29, 151, 186, 163, 229, 163,
+			59, 86, 210, 144, 163, 59, 12, 126, 85, 119, 23, 82, 127, 205,
+			155, 187, 144, 250, 203, 213, 60, 176, 221, 238, 66, 234, 47, 68,
+			120, 91, 44, 66, 41, 249, 183, 241, 234, 63, 133, 8, 143, 94,
+			180, 162, 119, 39, 98, 202, 197, 162, 19, 167, 73, 118, 18, 57,
+			193, 166, 5, 18, 57, 193, 166, 5, 146, 161, 216, 159, 21, 83,
+			212, 128, 110, 201, 255, 55, 52, 224, 160, 123, 168, 18, 44, 27,
+			254, 144, 235, 77, 209, 165, 232, 190, 123, 104, 124, 20, 215, 132,
+			43, 245, 22, 90, 191, 47, 120, 217, 37, 23, 105, 64, 221, 105,
+			122, 137, 6, 212, 109, 161, 216, 214, 169, 139, 12, 197, 254, 172,
+			120, 51, 1, 234, 145, 252, 187, 0, 84, 2, 160, 113, 127, 122,
+			249, 34, 109, 68, 162, 128, 8, 66, 66, 55, 171, 195, 239, 159,
+			194, 85, 236, 69, 79, 95, 175, 174, 91, 145, 200, 174, 7, 1,
+			97, 3, 181, 7, 1, 97, 174, 86, 125, 219, 113, 128, 52, 255,
+			46, 122, 255, 209, 184, 247, 123, 37, 127, 28, 88, 191, 102, 185,
+			35, 202, 250, 156, 20, 173, 31, 84, 103, 253, 202, 178, 109, 132,
+			59, 120, 108, 54, 200, 207, 46, 178, 12, 39, 52, 12, 66, 89,
+			134, 176, 226, 146, 109, 24, 194, 64, 13, 170, 96, 224, 19, 14,
+			226, 164, 249, 189, 105, 106, 145, 110, 126, 175, 133, 98, 210, 252,
+			94, 134, 98, 127, 86, 113, 106, 159, 228, 63, 68, 235, 247, 185,
+			135, 106, 65, 97, 5, 253, 116, 104, 116, 79, 99, 71, 9, 106,
+			238, 142, 109, 198, 74, 247, 165, 169, 114, 13, 164, 207, 66, 49,
+			209, 225, 62, 134, 98, 127, 86, 77, 173, 86, 73, 254, 99, 158,
+			217, 156, 189, 202, 176, 20, 93, 37, 87, 7, 201, 211, 92, 132,
+			75, 203, 92, 186, 156, 78, 211, 81, 130, 195, 139, 92, 15, 158,
+			183, 127, 60, 145, 199, 42, 135, 170, 214, 236, 176, 10, 7, 55,
+			240, 238, 115, 116, 17, 7, 55, 240, 115, 7, 197, 35, 54, 225,
+			232, 151, 252, 103, 220, 222, 146, 253, 138, 29, 27, 246, 120, 201,
+			131, 110, 169, 160, 106, 253, 200, 93, 120, 53, 15, 41, 230, 162,
+			155, 121, 240, 132, 95, 242, 166, 138, 136, 246, 210, 189, 119, 238,
+			172, 239, 21, 26, 46, 114, 200, 137, 250, 235, 45, 98, 79, 33,
+			136, 220, 106, 5, 17, 97, 93, 235, 226, 199, 34, 23, 54, 99,
+			252, 138, 221, 91, 242, 56, 58, 62, 240, 74, 213, 156, 112, 39,
+			252, 106, 21, 230, 184, 26, 186, 211, 94, 49, 242, 221, 99, 65,
+			177, 168, 32, 184, 158, 91, 12, 195, 104, 65, 45, 164, 221, 148,
+			169, 134, 235, 128, 138, 197, 240, 88, 36, 220, 55, 84, 222, 80,
+			122, 195, 112, 221, 92, 123, 200, 141, 181, 87, 41, 159, 190, 156,
+			98, 106, 190, 236, 69, 145, 6, 71, 51, 33, 188, 157, 60, 139,
+			68, 246, 253, 72, 7, 230, 118, 82, 68, 58, 48, 111, 209, 20,
+			208, 143, 116, 96, 222, 169, 221, 170, 126, 164, 3, 243, 213, 122,
+			210, 210, 159, 65, 113, 237, 144, 46, 54, 161, 184, 233, 252, 120,
+			241, 173, 221, 94, 45, 249, 47, 185, 61, 240, 226, 133, 86, 219,
+			237, 213, 72, 40, 54, 173, 91, 141, 132, 98, 211, 186, 213, 72,
+			40, 230, 157, 218, 249, 94, 141, 132, 98, 190, 97, 163, 184, 92,
+			216, 233, 148, 116, 126, 205, 83, 191, 229, 86, 118, 251, 66, 125,
+			87, 183, 4, 186, 81, 56, 231, 147, 166, 111, 105, 208, 116, 208,
+			107, 139, 96, 233, 148, 37, 249, 175, 121, 186, 3, 247, 118, 164,
+			83, 136, 47, 254, 223, 220, 110, 199, 187, 80, 178, 168, 216, 172,
+			139, 54, 138, 173, 109, 226, 18, 250, 173, 37, 249, 255, 195, 237,
+			85, 217, 243, 26, 212, 75, 25, 176, 186, 17, 77, 3, 250, 226,
+			237, 23, 12, 199, 221, 137, 39, 227, 71, 165, 46, 218, 40, 246,
+			246, 137, 151, 81, 189, 182, 228, 255, 194, 237, 254, 236, 249, 11,
+			213, 182, 118, 210, 138, 17, 141, 250, 23, 110, 119, 235, 34, 85,
+			213, 183, 74, 92, 35, 108, 222, 33, 157, 127, 229, 169, 207, 167,
+			173, 236, 101, 174, 185, 4, 114, 121, 145, 89, 138, 20, 52, 6,
+			102, 225, 79, 253, 43, 87, 167, 179, 117, 64, 112, 191, 227, 167,
+			247, 116, 182, 14, 10, 204, 254, 78, 59, 236, 29, 20, 152, 253,
+			157, 246, 79, 59, 168, 119, 126, 199, 213, 233, 108, 29, 20, 152,
+			253, 29, 95, 179, 86, 1, 194, 17, 7, 49, 160, 231, 21, 117,
+			83, 65, 18, 245, 14, 132, 72, 255, 96, 0, 33, 68, 250, 7,
+			61, 131, 232, 160, 110, 253, 131, 14, 145, 118, 80, 136, 244, 15,
+			8, 145, 194, 165, 239, 192, 178, 228, 245, 105, 238, 102, 95, 253,
+			188, 3, 164, 39, 14, 46, 117, 208, 122, 228, 245, 233, 4, 33,
+			162, 149, 215, 167, 19, 132, 208, 143, 235, 211, 42, 158, 209, 65,
+			235, 145, 215, 167, 215, 111, 80, 8, 17, 187, 84, 8, 189, 227,
+			167, 17, 97, 125, 104, 169, 131, 150, 15, 111, 52, 8, 17, 56,
+			188, 209, 32, 68, 224, 240, 70, 131, 16, 129, 195, 27, 129, 144,
+			66, 75, 29, 104, 221, 77, 233, 179, 34, 180, 212, 65, 203, 132,
+			55, 153, 134, 34, 22, 121, 147, 105, 40, 98, 145, 55, 153, 134,
+			98, 153, 240, 166, 244, 6, 87, 132, 212, 206, 180, 228, 183, 162,
+			157, 222, 169, 135, 150, 168, 11, 212, 173, 82, 226, 36, 97, 165,
+			14, 90, 59, 188, 213, 128, 69, 80, 242, 214, 116, 50, 212, 16,
+			148, 188, 53, 173, 230, 198, 29, 116, 222, 242, 173, 0, 27, 16,
+			88, 71, 242, 119, 3, 236, 127, 56, 245, 176, 82, 35, 216, 19,
+			133, 148, 58, 104, 21, 241, 221, 6, 38, 226, 146, 239, 54, 48,
+			17, 151, 124, 183, 129, 233, 48, 20, 19, 152, 77, 146, 223, 30,
+			195, 124, 30, 33, 37, 173, 216, 207, 21, 158, 233, 160, 197, 194,
+			219, 13, 76, 68, 21, 111, 55, 48, 17, 85, 188, 61, 173, 194,
+			73, 29, 180, 88, 120, 59, 164, 249, 122, 146, 102, 70, 242, 59,
+			210, 124, 253, 25, 8, 207, 116, 216, 25, 78, 149, 107, 92, 8,
+			30, 222, 97, 112, 33, 120, 120, 71, 90, 157, 142, 215, 65, 193,
+			195, 59, 210, 107, 215, 81, 120, 166, 195, 110, 150, 252, 206, 244,
+			153, 59, 29, 175, 131, 194, 133, 119, 26, 112, 8, 23, 222, 105,
+			192, 33, 92, 120, 103, 90, 157, 142, 215, 129, 219, 148, 248, 157,
+			0, 247, 6, 128, 67, 120, 230, 47, 211, 252, 51, 233, 116, 118,
+			223, 243, 10, 207, 160, 123, 23, 6, 103, 58, 40, 56, 243, 151,
+			105, 209, 35, 60, 188, 204, 22, 146, 127, 52, 157, 89, 149, 157,
+			120, 254, 161, 2, 55, 40, 97, 154, 88, 245, 93, 92, 191, 230,
+			85, 181, 44, 16, 128, 252, 104, 90, 205, 189, 58, 40, 0, 249,
+			209, 180, 154, 243, 119, 216, 130, 161, 216, 211, 167, 52, 166, 69,
+			242, 79, 0, 207, 190, 231, 49, 231, 71, 156, 17, 56, 196, 2,
+			32, 136, 44, 126, 194, 0, 65, 100, 241, 19, 105, 53, 249, 232,
+			160, 200, 226, 39, 12, 144, 86, 201, 63, 157, 0, 57, 197, 0,
+			192, 137, 128, 32, 148, 248, 105, 3, 4, 161, 196, 79, 27, 32,
+			8, 37, 126, 26, 64, 200, 171, 237, 160, 88, 98, 154, 191, 228,
+			197, 243, 106, 59, 226, 104, 163, 81, 103, 138, 54, 26, 192, 20,
+			109, 76, 171, 132, 129, 14, 92, 160, 197, 63, 151, 222, 120, 142,
+			24, 21, 54, 239, 148, 206, 23, 211, 169, 111, 166, 173, 236, 203,
+			221, 228, 42, 233, 101, 57, 106, 126, 169, 54, 151, 140, 42, 120,
+			105, 8, 22, 125, 49, 173, 178, 5, 58, 225, 165, 125, 25, 98,
+			57, 141, 94, 90, 39, 121, 105, 95, 214, 13, 237, 36, 47, 237,
+			203, 122, 220, 118, 146, 151, 246, 229, 180, 242, 210, 58, 201, 75,
+			251, 114, 122, 224, 28, 113, 51, 58, 170, 19, 14, 201, 87, 128,
+			232, 45, 238, 30, 115, 251, 245, 50, 112, 81, 31, 212, 89, 21,
+			229, 21, 107, 155, 98, 238, 176, 132, 72, 134, 232, 162, 71, 117,
+			119, 160, 87, 154, 119, 107, 37, 237, 69, 211, 207, 149, 221, 238,
+			164, 37, 240, 175, 152, 166, 192, 191, 251, 138, 238, 179, 78, 242,
+			239, 190, 146, 238, 222, 160, 139, 12, 223, 14, 156, 67, 163, 176,
+			19, 254, 221, 131, 104, 201, 233, 183, 219, 157, 228, 213, 61, 104,
+			112, 193, 171, 123, 208, 136, 24, 66, 124, 80, 219, 237, 78, 242,
+			234, 30, 132, 136, 97, 183, 59, 81, 250, 27, 224, 58, 67, 118,
+			187, 147, 28, 186, 191, 49, 224, 224, 208, 253, 141, 1, 7, 135,
+			238, 111, 180, 221, 238, 36, 135, 238, 111, 0, 238, 207, 113, 51,
+			100, 151, 116, 190, 157, 78, 253, 93, 218, 202, 190, 211, 114, 213,
+			45, 185, 43, 208, 246, 185, 41, 191, 80, 240, 11, 250, 158, 82,
+			133, 43, 39, 220, 43, 194, 138, 254, 112, 11, 221, 35, 73, 13,
+			140, 134, 78, 120, 235, 101, 197, 207, 215, 42, 72, 155, 43, 206,
+			199, 19, 67, 222, 133, 80, 97, 58, 211, 67, 236, 215, 133, 145,
+			243, 157, 52, 237, 209, 158, 56, 18, 148, 79, 221, 146, 32, 108,
+			213, 96, 77, 226, 46, 238, 162, 81, 244, 29, 45, 197, 46, 26,
+			69, 223, 209, 170, 215, 69, 163, 232, 59, 105, 217, 167, 139, 12,
+			112, 214, 173, 23, 175, 36, 112, 72, 22, 5, 184, 97, 115, 71,
+			234, 137, 123, 89, 43, 96, 228, 43, 219, 218, 69, 106, 255, 152,
+			121, 55, 212, 254, 49, 243, 110, 168, 253, 99, 105, 153, 213, 69,
+			156, 235, 154, 94, 183, 94, 76, 96, 71, 144, 116, 190, 151, 78,
+			253, 50, 109, 101, 247, 186, 13, 247, 200, 47, 171, 3, 39, 43,
+			234, 145, 198, 169, 37, 162, 180, 223, 75, 103, 122, 197, 172, 224,
+			92, 66, 244, 143, 167, 249, 64, 246, 245, 152, 56, 141, 226, 154,
+			248, 83, 240, 219, 146, 169, 137, 112, 233, 166, 249, 37, 38, 79,
+			146, 250, 224, 113, 45, 7, 73, 125, 240, 184, 246, 216, 37, 245,
+			193, 227, 105, 181, 234, 45, 201, 146, 61, 158, 118, 55, 42, 148,
+			136, 202, 41, 148, 222, 241, 211, 140, 178, 126, 2, 37, 169, 183,
+			126, 104, 80, 162, 183, 126, 104, 80, 162, 183, 126, 104, 80, 98,
+			18, 250, 67, 160, 188, 7, 246, 86, 194, 74, 61, 9, 152, 31,
+			180, 220, 67, 116, 61, 255, 66, 148, 30, 130, 93, 69, 127, 206,
+			71, 95, 5, 165, 69, 168, 17, 186, 162, 7, 49, 59, 10, 34,
+			129, 193, 169, 86, 108, 130, 40, 206, 165, 163, 30, 206, 35, 100,
+			135, 81, 26, 197, 183, 156, 34, 12, 7, 91, 236, 98, 60, 70,
+			238, 160, 26, 158, 113, 12, 174, 20, 86, 133, 142, 230, 250, 5,
+			189, 134, 35, 201, 238, 61, 105, 154, 10, 187, 247, 164, 86, 76,
+			137, 91, 154, 248, 147, 218, 221, 151, 100, 247, 158, 68, 83, 31,
+			140, 155, 202, 36, 255, 71, 52, 245, 94, 203, 93, 220, 31, 190,
+			193, 173, 175, 206, 173, 183, 44, 184, 128, 22, 122, 162, 253, 123,
+			117, 23, 110, 162, 227, 137, 201, 161, 47, 162, 56, 70, 24, 85,
+			241, 191, 127, 220, 207, 215, 170, 13, 246, 64, 59, 153, 73, 181,
+			194, 173, 149, 40, 237, 24, 55, 50, 187, 129, 129, 86, 112, 177,
+			232, 155, 180, 31, 166, 245, 31, 77, 251, 153, 131, 98, 219, 42,
+			93, 180, 80, 236, 215, 93, 13, 211, 250, 143, 104, 255, 91, 227,
+			246, 115, 201, 127, 145, 126, 49, 147, 38, 37, 77, 129, 127, 97,
+			240, 99, 10, 252, 11, 211, 127, 152, 2, 255, 66, 251, 64, 146,
+			210, 113, 126, 145, 222, 56, 64, 62, 80, 183, 116, 254, 41, 157,
+			122, 155, 3, 31, 232, 26, 175, 188, 2, 155, 50, 231, 149, 27,
+			205, 9, 214, 88, 254, 41, 157, 233, 164, 20, 154, 110, 152, 147,
+			167, 160, 22, 148, 230, 115, 192, 11, 42, 207, 115, 160, 94, 253,
+			234, 165, 140, 73, 55, 25, 147, 167, 116, 219, 187, 201, 152, 60,
+			165, 135, 105, 55, 25, 147, 167, 244, 48, 237, 38, 99, 242, 84,
+			90, 165, 249, 116, 67, 177, 159, 86, 24, 189, 227, 167, 21, 99,
+			189, 41, 233, 38, 83, 242, 180, 193, 8, 83, 242, 180, 193, 8,
+			83, 242, 180, 193, 8, 83, 242, 52, 48, 190, 133, 228, 104, 75,
+			254, 12, 162, 69, 101, 119, 127, 56, 81, 246, 42, 145, 121, 119,
+			164, 228, 191, 192, 126, 24, 255, 162, 86, 194, 173, 218, 100, 64,
+			200, 100, 8, 213, 131, 52, 20, 231, 188, 242, 38, 140, 168, 234,
+			108, 50, 202, 168, 186, 216, 114, 168, 177, 209, 77, 182, 225, 25,
+			131, 29, 182, 225, 25, 173, 91, 221, 100, 27, 158, 73, 203, 53,
+			186, 136, 157, 254, 136, 35, 189, 154, 176, 35, 84, 136, 161, 113,
+			165, 123, 181, 63, 223, 40, 219, 70, 197, 90, 218, 50, 28, 241,
+			231, 23, 24, 6, 245, 26, 140, 215, 223, 25, 76, 24, 175, 191,
+			211, 227, 181, 155, 92, 161, 223, 165, 251, 215, 234, 34, 161, 216,
+			56, 16, 31, 237, 217, 141, 246, 92, 231, 240, 115, 178, 159, 179,
+			176, 6, 90, 243, 87, 132, 75, 141, 227, 56, 211, 95, 131, 20,
+			141, 40, 181, 135, 165, 123, 167, 206, 104, 105, 245, 9, 26, 87,
+			54, 140, 151, 178, 44, 131, 213, 77, 3, 254, 58, 39, 17, 0,
+			199, 209, 5, 78, 34, 0, 12, 248, 235, 156, 254, 245, 186, 136,
+			147, 4, 156, 141, 3, 241, 82, 68, 55, 122, 240, 6, 231, 197,
+			52, 88, 221, 20, 6, 187, 193, 224, 71, 24, 236, 6, 39, 81,
+			42, 132, 193, 110, 112, 148, 193, 234, 166, 48, 216, 13, 192, 31,
+			10, 155, 247, 72, 231, 207, 156, 212, 123, 28, 43, 235, 185, 35,
+			165, 249, 5, 6, 171, 190, 239, 116, 175, 37, 91, 53, 138, 243,
+			201, 126, 134, 201, 153, 48, 156, 41, 250, 185, 114, 37, 172, 134,
+			83, 181, 233, 220, 72, 105, 126, 178, 126, 249, 2, 99, 32, 54,
+			107, 88, 143, 253, 51, 39, 211, 73, 62, 96, 15, 204, 218, 77,
+			206, 41, 251, 128, 61, 100, 174, 110, 210, 45, 239, 33, 115, 117,
+			147, 110, 121, 15, 153, 171, 155, 28, 229, 3, 246, 144, 185, 186,
+			201, 89, 183, 158, 124, 159, 30, 140, 181, 91, 241, 238, 215, 63,
+			231, 212, 103, 83, 228, 78, 2, 254, 225, 90, 165, 56, 249, 92,
+			243, 160, 250, 77, 25, 218, 165, 87, 47, 134, 193, 186, 213, 160,
+			132, 193, 186, 213, 81, 6, 171, 135, 38, 104, 183, 58, 42, 191,
+			168, 135, 38, 104, 183, 2, 101, 72, 40, 109, 201, 223, 13, 148,
+			222, 201, 38, 66, 75, 0, 93, 56, 47, 194, 36, 50, 156, 22,
+			11, 44, 238, 2, 176, 24, 209, 239, 54, 96, 161, 223, 239, 54,
+			96, 33, 181, 119, 59, 202, 123, 233, 33, 239, 229, 221, 0, 251,
+			39, 194, 230, 189, 210, 121, 159, 147, 186, 207, 177, 178, 161, 187,
+			167, 86, 33, 85, 95, 38, 5, 46, 75, 171, 116, 157, 39, 80,
+			45, 172, 117, 191, 207, 201, 244, 146, 106, 245, 66, 181, 222, 127,
+			234, 170, 213, 75, 170, 245, 126, 45, 135, 94, 82, 173, 247, 107,
+			213, 234, 37, 213, 122, 191, 86, 173, 94, 82, 173, 247, 67, 14,
+			88, 198, 233, 133, 144, 62, 232, 240, 225, 211, 25, 177, 232, 37,
+			218, 251, 160, 1, 100, 57, 120, 73, 219, 75, 117, 145, 222, 121,
+			238, 249, 186, 200, 80, 204, 109, 165, 68, 176, 94, 56, 208, 127,
+			225, 240, 45, 103, 104, 231, 91, 47, 209, 218, 95, 24, 108, 216,
+			152, 243, 23, 6, 27, 228, 241, 23, 206, 185, 131, 186, 200, 80,
+			60, 127, 72, 97, 99, 146, 127, 196, 225, 185, 211, 133, 109, 193,
+			102, 183, 94, 154, 233, 127, 196, 96, 3, 189, 125, 196, 96, 3,
+			189, 125, 196, 57, 119, 179, 46, 18, 154, 161, 45, 20, 52, 237,
+			69, 179, 62, 14, 185, 77, 156, 254, 173, 110, 26, 30, 200, 231,
+			227, 6, 30, 200, 231, 227, 6, 30, 200, 231, 227, 70, 116, 240,
+			54, 63, 238, 156, 63, 68, 185, 63, 189, 24, 155, 159, 130, 232,
+			14, 158, 202, 182, 180, 101, 138, 15, 228, 242, 41, 131, 47, 237,
+			160, 152, 224, 3, 185, 124, 202, 136, 15, 228, 242, 41, 103, 104,
+			11, 69, 151, 122, 161, 6, 127, 5, 241, 157, 254, 232, 82, 47,
+			45, 170, 252, 149, 193, 229, 208, 187, 18, 92, 88, 84, 249, 43,
+			35, 55, 44, 170, 252, 21, 228, 118, 152, 228, 214, 36, 249, 23,
+			28, 190, 237, 76, 69, 151, 122, 105, 41, 229, 11, 6, 92, 147,
+			131, 23, 38, 224, 176, 148, 242, 5, 231, 220, 33, 93, 100, 40,
+			110, 29, 22, 255, 89, 216, 188, 79, 58, 247, 59, 169, 255, 225,
+			88, 217, 107, 221, 131, 193, 156, 31, 85, 189, 185, 229, 78, 36,
+			150, 101, 69, 147, 74, 79, 96, 70, 145, 169, 115, 191, 147, 233,
+			35, 51, 218, 7, 51, 250, 192, 169, 155, 209, 62, 50, 163, 15,
+			104, 73, 244, 145, 25, 125, 64, 155, 209, 62, 50, 163, 15, 104,
+			51, 218, 71, 102, 244, 1, 109, 70, 251, 96, 54, 190, 138, 110,
+			58, 141, 102, 180, 143, 204, 232, 87, 13, 32, 152, 209, 175, 58,
+			109, 231, 234, 34, 189, 115, 211, 144, 46, 50, 20, 183, 14, 147,
+			169, 234, 131, 25, 253, 198, 105, 52, 85, 11, 108, 65, 31, 153,
+			209, 111, 24, 108, 24, 63, 223, 48, 216, 32, 143, 111, 56, 155,
+			54, 235, 34, 114, 40, 49, 214, 160, 211, 125, 96, 226, 111, 57,
+			124, 107, 246, 143, 86, 188, 129, 120, 89, 134, 160, 143, 236, 232,
+			183, 12, 56, 216, 209, 111, 25, 112, 176, 163, 223, 114, 54, 157,
+			167, 139, 4, 103, 75, 142, 236, 104, 31, 218, 245, 183, 16, 220,
+			25, 179, 163, 125, 228, 196, 255, 173, 129, 7, 59, 250, 183, 6,
+			30, 236, 232, 223, 26, 217, 193, 142, 254, 45, 100, 55, 69, 178,
+			195, 129, 164, 144, 221, 153, 179, 163, 125, 228, 164, 63, 106, 240,
+			193, 142, 62, 106, 240, 193, 142, 62, 106, 196, 7, 59, 250, 168,
+			179, 37, 39, 254, 19, 225, 115, 36, 255, 123, 135, 175, 207, 206,
+			185, 251, 170, 251, 195, 99, 75, 34, 92, 186, 95, 243, 181, 74,
+			197, 47, 85, 221, 106, 48, 231, 231, 226, 167, 5, 140, 90, 60,
+			179, 208, 25, 93, 52, 109, 197, 207, 95, 19, 84, 103, 131, 120,
+			126, 162, 28, 194, 62, 50, 180, 127, 111, 128, 99, 245, 250, 239,
+			205, 8, 134, 161, 253, 123, 71, 246, 235, 34, 67, 113, 237, 58,
+			154, 110, 247, 97, 11, 236, 227, 0, 94, 118, 95, 117, 82, 224,
+			139, 132, 218, 136, 253, 85, 167, 130, 29, 118, 248, 113, 131, 29,
+			75, 218, 143, 27, 236, 176, 195, 143, 27, 236, 88, 210, 126, 220,
+			89, 187, 46, 78, 219, 232, 179, 51, 146, 255, 8, 230, 231, 14,
+			75, 215, 125, 114, 205, 0, 26, 29, 63, 40, 40, 127, 85, 177,
+			133, 88, 208, 25, 11, 83, 199, 235, 83, 236, 188, 34, 177, 76,
+			197, 180, 142, 186, 14, 201, 134, 66, 137, 178, 62, 215, 174, 143,
+			214, 200, 127, 100, 26, 154, 113, 128, 93, 17, 78, 31, 173, 145,
+			255, 72, 19, 78, 31, 173, 145, 255, 200, 217, 58, 44, 222, 182,
+			93, 92, 48, 19, 230, 242, 179, 149, 112, 46, 168, 205, 229, 194,
+			202, 204, 214, 98, 45, 31, 108, 141, 252, 202, 81, 191, 178, 245,
+			218, 90, 88, 245, 226, 255, 203, 83, 91, 131, 66, 20, 79, 241,
+			228, 230, 5, 15, 229, 240, 80, 46, 126, 40, 71, 63, 207, 169,
+			135, 178, 171, 212, 180, 215, 223, 170, 255, 136, 235, 24, 248, 152,
+			37, 218, 15, 132, 197, 32, 63, 191, 59, 44, 77, 7, 51, 163,
+			123, 164, 43, 28, 175, 92, 62, 28, 20, 250, 45, 215, 26, 108,
+			222, 213, 252, 236, 46, 167, 194, 239, 183, 172, 183, 140, 167, 189,
+			114, 121, 180, 32, 55, 136, 116, 197, 247, 138, 115, 253, 246, 162,
+			31, 208, 231, 50, 39, 218, 143, 250, 149, 40, 8, 75, 135, 163,
+			252, 172, 63, 231, 247, 51, 215, 26, 108, 219, 213, 244, 236, 46,
+			126, 158, 221, 111, 141, 183, 169, 175, 39, 232, 91, 121, 142, 104,
+			82, 31, 244, 243, 133, 85, 234, 111, 6, 190, 108, 137, 76, 12,
+			117, 116, 143, 252, 15, 194, 201, 19, 96, 2, 217, 178, 253, 101,
+			185, 101, 11, 35, 215, 216, 222, 93, 153, 103, 119, 165, 223, 105,
+			217, 157, 214, 184, 170, 82, 30, 16, 236, 136, 63, 79, 173, 107,
+			217, 190, 99, 197, 53, 95, 237, 207, 215, 85, 138, 170, 6, 46,
+			20, 205, 241, 91, 199, 253, 105, 217, 215, 128, 189, 57, 121, 109,
+			167, 121, 109, 243, 56, 254, 28, 248, 83, 75, 63, 119, 181, 63,
+			47, 55, 137, 102, 100, 8, 70, 101, 47, 239, 47, 238, 27, 243,
+			157, 92, 39, 56, 10, 139, 187, 135, 62, 150, 57, 209, 86, 241,
+			163, 176, 86, 201, 251, 135, 49, 87, 237, 103, 11, 127, 215, 170,
+			191, 63, 56, 95, 246, 7, 254, 201, 18, 205, 35, 249, 124, 88,
+			43, 85, 79, 143, 122, 188, 84, 100, 130, 130, 95, 170, 6, 213,
+			249, 197, 239, 78, 190, 106, 108, 47, 95, 70, 123, 211, 203, 108,
+			175, 243, 156, 237, 221, 117, 254, 235, 55, 47, 123, 56, 94, 245,
+			181, 243, 68, 147, 76, 243, 212, 67, 150, 37, 190, 96, 9, 171,
+			85, 50, 158, 146, 219, 63, 97, 185, 187, 195, 242, 124, 37, 152,
+			153, 173, 186, 219, 135, 183, 111, 167, 173, 134, 251, 14, 237, 30,
+			117, 71, 106, 213, 217, 176, 18, 229, 220, 145, 98, 209, 165, 31,
+			224, 162, 31, 170, 28, 203, 4, 135, 34, 229, 216, 34, 180, 79,
+			160, 220, 124, 88, 160, 211, 36, 102, 194, 163, 126, 5, 43, 207,
+			88, 131, 134, 137, 246, 221, 145, 178, 151, 71, 197, 65, 222, 47,
+			69, 254, 144, 251, 234, 120, 176, 184, 219, 115, 195, 136, 48, 120,
+			137, 65, 155, 198, 86, 17, 29, 211, 219, 55, 186, 123, 239, 254,
+			137, 189, 238, 116, 64, 86, 186, 89, 216, 44, 37, 153, 147, 218,
+			40, 50, 194, 202, 72, 150, 73, 93, 46, 154, 133, 157, 105, 137,
+			255, 204, 8, 203, 150, 76, 164, 182, 139, 87, 8, 155, 167, 36,
+			111, 75, 93, 108, 101, 135, 221, 81, 234, 41, 149, 24, 94, 63,
+			170, 220, 193, 153, 74, 88, 43, 163, 29, 244, 113, 224, 71, 88,
+			152, 17, 130, 241, 148, 37, 89, 91, 166, 79, 188, 29, 185, 222,
+			148, 52, 219, 107, 175, 226, 217, 103, 44, 18, 80, 52, 139, 156,
+			124, 181, 8, 132, 40, 131, 27, 32, 66, 26, 71, 123, 131, 40,
+			201, 124, 142, 252, 178, 87, 241, 170, 190, 218, 128, 236, 151, 26,
+			30, 138, 116, 59, 35, 36, 212, 66, 178, 65, 222, 199, 182, 128,
+			114, 49, 156, 199, 234, 84, 82, 101, 18, 238, 195, 125, 76, 244,
+			238, 160, 84, 64, 45, 33, 237, 15, 62, 6, 122, 44, 134, 51,
+			65, 222, 43, 234, 122, 226, 158, 169, 111, 109, 67, 4, 122, 176,
+			24, 28, 241, 221, 201, 252, 209, 73, 108, 10, 152, 172, 20, 166,
+			38, 135, 104, 113, 99, 18, 6, 105, 75, 254, 104, 206, 43, 151,
+			163, 114, 88, 205, 229, 195, 185, 73, 72, 165, 85, 164, 121, 10,
+			46, 57, 235, 181, 51, 186, 100, 73, 214, 219, 220, 161, 75, 76,
+			178, 94, 25, 7, 127, 82, 118, 42, 35, 121, 111, 207, 42, 38,
+			58, 69, 51, 126, 154, 249, 76, 166, 125, 149, 100, 125, 60, 39,
+			110, 231, 36, 84, 75, 242, 115, 237, 65, 158, 125, 7, 55, 90,
+			71, 35, 79, 19, 166, 223, 128, 223, 157, 242, 139, 97, 105, 6,
+			240, 235, 101, 237, 185, 51, 197, 112, 202, 43, 170, 71, 213, 214,
+			205, 193, 32, 231, 231, 116, 98, 35, 24, 216, 119, 203, 149, 240,
+			141, 126, 190, 138, 182, 184, 251, 199, 14, 238, 221, 25, 119, 87,
+			252, 24, 214, 238, 240, 35, 101, 64, 212, 167, 20, 62, 62, 52,
+			113, 104, 100, 223, 190, 215, 225, 153, 56, 215, 18, 203, 130, 190,
+			250, 5, 66, 195, 2, 48, 84, 135, 32, 3, 163, 154, 124, 27,
+			148, 84, 70, 252, 162, 206, 192, 35, 94, 1, 139, 179, 81, 213,
+			175, 248, 5, 119, 208, 207, 205, 228, 68, 252, 89, 180, 85, 117,
+			98, 68, 195, 226, 88, 37, 192, 137, 45, 21, 223, 43, 160, 179,
+			202, 181, 10, 174, 221, 170, 170, 228, 205, 70, 196, 10, 134, 31,
+			9, 247, 24, 176, 81, 203, 147, 1, 121, 56, 49, 48, 110, 49,
+			56, 234, 71, 67, 73, 187, 181, 214, 224, 125, 80, 147, 249, 100,
+			168, 136, 120, 219, 188, 231, 106, 224, 37, 10, 13, 170, 215, 85,
+			221, 146, 239, 23, 116, 159, 236, 61, 238, 205, 149, 139, 126, 180,
+			83, 184, 174, 171, 45, 211, 206, 203, 149, 228, 241, 225, 229, 106,
+			35, 75, 113, 231, 43, 200, 202, 168, 247, 110, 129, 149, 124, 165,
+			86, 50, 43, 45, 217, 185, 137, 146, 89, 150, 100, 231, 54, 183,
+			235, 18, 147, 236, 220, 46, 169, 148, 204, 202, 72, 126, 110, 247,
+			160, 86, 50, 75, 41, 217, 38, 158, 19, 223, 183, 72, 201, 108,
+			201, 183, 218, 219, 120, 246, 91, 86, 98, 116, 98, 174, 55, 162,
+			82, 125, 228, 21, 103, 194, 74, 80, 157, 157, 115, 143, 121, 102,
+			8, 231, 189, 98, 190, 86, 196, 24, 158, 84, 12, 63, 137, 150,
+			30, 76, 22, 35, 38, 135, 39, 235, 170, 34, 205, 77, 126, 73,
+			53, 169, 228, 60, 100, 128, 146, 168, 107, 145, 95, 65, 21, 187,
+			99, 7, 79, 77, 64, 103, 131, 153, 89, 63, 170, 186, 141, 14,
+			137, 89, 49, 134, 194, 76, 110, 155, 76, 6, 162, 157, 150, 108,
+			107, 34, 35, 219, 146, 108, 107, 243, 42, 93, 98, 146, 109, 205,
+			174, 81, 50, 130, 107, 186, 117, 237, 54, 45, 35, 59, 243, 153,
+			76, 154, 73, 54, 204, 207, 17, 23, 147, 136, 152, 228, 59, 236,
+			139, 120, 118, 51, 233, 147, 2, 144, 24, 247, 6, 173, 29, 28,
+			153, 24, 53, 198, 128, 165, 37, 219, 145, 96, 96, 150, 100, 59,
+			154, 59, 117, 137, 73, 182, 163, 187, 71, 97, 96, 25, 201, 119,
+			244, 94, 164, 49, 48, 213, 79, 23, 242, 156, 248, 3, 178, 100,
+			44, 201, 47, 75, 93, 97, 101, 255, 217, 114, 247, 133, 225, 17,
+			140, 162, 90, 25, 198, 46, 40, 205, 20, 113, 250, 3, 48, 184,
+			149, 56, 236, 191, 208, 140, 99, 127, 81, 169, 144, 124, 120, 181,
+			63, 175, 123, 104, 193, 175, 2, 67, 4, 113, 151, 171, 122, 99,
+			199, 70, 239, 167, 136, 212, 158, 239, 106, 37, 121, 99, 97, 72,
+			36, 35, 37, 121, 137, 91, 241, 167, 113, 192, 68, 53, 52, 167,
+			76, 229, 117, 149, 137, 107, 15, 106, 163, 234, 129, 105, 164, 24,
+			133, 110, 228, 235, 74, 198, 253, 105, 69, 55, 208, 241, 203, 50,
+			144, 29, 231, 22, 216, 230, 114, 123, 23, 39, 73, 90, 118, 202,
+			145, 236, 114, 187, 83, 151, 44, 201, 46, 239, 90, 165, 75, 76,
+			178, 203, 85, 79, 91, 100, 114, 47, 95, 187, 43, 150, 50, 138,
+			159, 201, 116, 217, 146, 141, 240, 33, 85, 177, 37, 249, 110, 123,
+			175, 174, 216, 114, 36, 219, 109, 183, 232, 146, 37, 217, 238, 214,
+			14, 93, 98, 146, 237, 86, 182, 220, 162, 97, 182, 187, 103, 175,
+			174, 216, 82, 21, 239, 225, 67, 226, 159, 108, 97, 115, 91, 242,
+			3, 169, 87, 91, 217, 39, 109, 211, 50, 119, 54, 44, 22, 34,
+			195, 111, 5, 175, 234, 185, 158, 233, 187, 209, 61, 67, 238, 84,
+			13, 251, 68, 85, 106, 241, 22, 191, 4, 15, 162, 128, 196, 182,
+			185, 122, 11, 95, 139, 204, 224, 33, 231, 216, 45, 6, 83, 21,
+			175, 50, 175, 119, 197, 21, 139, 243, 113, 93, 30, 228, 235, 149,
+			203, 190, 87, 129, 69, 118, 99, 155, 170, 45, 228, 224, 148, 159,
+			247, 106, 145, 223, 96, 232, 195, 41, 144, 66, 98, 197, 192, 177,
+			133, 160, 226, 83, 124, 125, 206, 43, 5, 101, 26, 252, 250, 253,
+			226, 4, 0, 162, 205, 177, 159, 52, 126, 96, 55, 41, 202, 200,
+			129, 81, 108, 222, 156, 246, 242, 73, 162, 222, 66, 236, 181, 200,
+			23, 137, 40, 92, 108, 160, 246, 189, 216, 137, 24, 219, 51, 54,
+			24, 120, 165, 82, 45, 159, 15, 54, 187, 91, 182, 32, 147, 22,
+			137, 252, 254, 241, 203, 98, 109, 193, 104, 63, 144, 233, 162, 205,
+			249, 182, 157, 146, 108, 194, 238, 201, 250, 74, 207, 220, 192, 200,
+			56, 209, 252, 152, 11, 144, 248, 162, 178, 57, 104, 53, 116, 202,
+			247, 75, 238, 27, 67, 228, 36, 198, 115, 199, 201, 183, 76, 18,
+			37, 192, 39, 243, 75, 238, 228, 128, 247, 150, 242, 91, 38, 221,
+			50, 146, 152, 74, 5, 186, 224, 17, 234, 97, 147, 27, 48, 161,
+			70, 190, 77, 110, 192, 132, 114, 3, 108, 196, 229, 216, 132, 236,
+			22, 215, 16, 58, 75, 178, 67, 118, 87, 246, 114, 44, 214, 214,
+			67, 195, 0, 90, 62, 170, 228, 197, 160, 134, 67, 201, 139, 49,
+			108, 14, 53, 183, 234, 18, 147, 236, 80, 71, 39, 157, 72, 197,
+			36, 127, 93, 170, 102, 101, 47, 175, 123, 93, 221, 240, 79, 44,
+			75, 252, 45, 196, 143, 237, 237, 225, 244, 2, 209, 169, 225, 9,
+			211, 246, 186, 76, 151, 248, 6, 56, 133, 97, 124, 78, 217, 5,
+			158, 253, 124, 236, 13, 38, 30, 190, 142, 244, 170, 74, 181, 177,
+			116, 15, 213, 41, 112, 189, 211, 88, 13, 181, 215, 86, 156, 55,
+			190, 226, 92, 173, 88, 13, 202, 198, 234, 145, 143, 26, 9, 99,
+			82, 212, 128, 34, 6, 94, 204, 186, 215, 190, 10, 191, 223, 239,
+			205, 249, 224, 219, 241, 3, 187, 39, 98, 138, 205, 141, 31, 216,
+			77, 159, 146, 180, 24, 117, 225, 148, 146, 36, 163, 46, 156, 106,
+			150, 186, 196, 36, 155, 234, 141, 23, 25, 25, 153, 149, 169, 85,
+			133, 120, 244, 179, 196, 147, 203, 243, 28, 105, 32, 67, 168, 241,
+			136, 61, 199, 179, 133, 68, 28, 75, 73, 98, 33, 82, 47, 30,
+			150, 111, 6, 31, 238, 212, 179, 41, 124, 17, 205, 122, 21, 191,
+			240, 71, 24, 104, 111, 142, 255, 86, 131, 181, 30, 62, 20, 225,
+			72, 2, 31, 138, 112, 164, 185, 77, 151, 152, 100, 71, 58, 187,
+			20, 124, 24, 175, 35, 114, 78, 195, 215, 62, 66, 145, 231, 132,
+			79, 240, 109, 201, 43, 118, 149, 103, 95, 67, 240, 49, 245, 2,
+			124, 237, 42, 185, 115, 94, 201, 155, 209, 29, 136, 148, 202, 198,
+			54, 145, 227, 230, 86, 106, 165, 104, 200, 189, 182, 28, 13, 145,
+			161, 59, 28, 5, 111, 242, 135, 92, 191, 154, 207, 109, 214, 136,
+			193, 216, 149, 4, 49, 198, 112, 165, 185, 79, 151, 152, 100, 149,
+			213, 89, 133, 24, 140, 93, 89, 83, 213, 136, 109, 133, 56, 226,
+			57, 113, 62, 157, 239, 157, 62, 142, 137, 92, 118, 189, 59, 186,
+			132, 74, 43, 97, 41, 213, 229, 150, 100, 199, 51, 93, 226, 131,
+			152, 200, 112, 59, 37, 157, 27, 44, 251, 70, 139, 103, 111, 182,
+			207, 174, 169, 140, 106, 214, 243, 156, 197, 44, 24, 44, 71, 241,
+			127, 165, 48, 165, 175, 255, 199, 138, 195, 13, 150, 157, 209, 69,
+			100, 67, 88, 205, 29, 186, 136, 108, 8, 75, 118, 139, 142, 248,
+			219, 12, 132, 217, 115, 163, 197, 68, 151, 104, 166, 15, 168, 163,
+			248, 219, 44, 158, 19, 143, 197, 2, 183, 164, 243, 110, 203, 254,
+			175, 22, 207, 126, 213, 62, 225, 52, 71, 181, 237, 5, 153, 225,
+			212, 155, 183, 147, 79, 115, 132, 250, 9, 89, 234, 19, 77, 115,
+			212, 96, 79, 70, 76, 50, 223, 80, 67, 38, 161, 224, 19, 207,
+			17, 242, 193, 50, 102, 7, 113, 47, 88, 72, 50, 48, 125, 100,
+			33, 201, 192, 106, 110, 215, 69, 36, 25, 88, 93, 82, 245, 145,
+			149, 129, 252, 187, 255, 107, 210, 71, 106, 248, 243, 247, 160, 143,
+			158, 178, 168, 143, 108, 233, 220, 101, 217, 31, 182, 120, 246, 39,
+			150, 233, 35, 4, 64, 92, 109, 151, 116, 95, 5, 209, 18, 157,
+			69, 155, 238, 7, 6, 116, 238, 141, 50, 108, 66, 167, 223, 132,
+			199, 148, 44, 234, 220, 68, 93, 47, 36, 18, 15, 226, 42, 116,
+			186, 24, 134, 71, 92, 40, 245, 78, 136, 227, 60, 215, 43, 133,
+			165, 249, 185, 176, 22, 237, 76, 254, 138, 191, 152, 10, 171, 59,
+			115, 185, 92, 92, 80, 253, 110, 62, 80, 114, 51, 31, 144, 141,
+			69, 73, 73, 9, 139, 193, 119, 25, 25, 194, 130, 223, 101, 53,
+			119, 233, 34, 14, 223, 183, 122, 122, 149, 12, 237, 12, 228, 211,
+			247, 225, 68, 134, 202, 32, 241, 191, 128, 12, 191, 21, 203, 144,
+			73, 231, 30, 203, 254, 180, 197, 179, 247, 157, 128, 21, 181, 220,
+			158, 47, 45, 42, 241, 158, 126, 94, 140, 27, 143, 228, 254, 123,
+			140, 104, 176, 84, 117, 143, 213, 44, 117, 145, 161, 216, 219, 167,
+			68, 195, 50, 104, 246, 170, 79, 39, 162, 81, 51, 27, 254, 41,
+			136, 230, 161, 88, 52, 92, 58, 95, 178, 236, 251, 45, 158, 253,
+			156, 17, 205, 9, 165, 66, 67, 86, 29, 94, 65, 137, 91, 138,
+			97, 26, 149, 11, 186, 21, 97, 43, 98, 62, 136, 231, 144, 74,
+			161, 244, 22, 62, 53, 110, 183, 106, 61, 219, 154, 116, 7, 177,
+			210, 98, 83, 56, 176, 136, 105, 7, 232, 227, 1, 45, 23, 100,
+			182, 126, 201, 200, 5, 107, 100, 95, 178, 154, 219, 116, 17, 23,
+			110, 90, 157, 93, 74, 46, 60, 131, 54, 203, 251, 19, 185, 112,
+			37, 151, 47, 67, 46, 121, 18, 75, 90, 58, 15, 90, 246, 55,
+			44, 158, 157, 88, 30, 241, 170, 166, 47, 130, 126, 109, 57, 2,
+			212, 74, 141, 118, 127, 238, 170, 5, 197, 130, 90, 91, 231, 148,
+			222, 246, 160, 65, 141, 149, 179, 7, 173, 230, 62, 93, 196, 62,
+			18, 107, 117, 86, 161, 78, 103, 0, 105, 205, 55, 18, 212, 105,
+			133, 250, 235, 22, 207, 77, 57, 180, 20, 113, 129, 248, 193, 176,
+			184, 104, 217, 129, 215, 173, 241, 124, 240, 244, 45, 133, 100, 215,
+			199, 249, 115, 91, 245, 26, 253, 86, 189, 114, 164, 150, 74, 190,
+			192, 132, 19, 219, 120, 57, 40, 154, 212, 137, 17, 20, 4, 231,
+			187, 218, 159, 221, 213, 178, 189, 185, 255, 223, 226, 127, 29, 227,
+			250, 107, 249, 18, 145, 166, 205, 189, 253, 246, 146, 191, 139, 191,
+			148, 7, 132, 131, 173, 186, 197, 34, 133, 195, 91, 182, 95, 178,
+			226, 53, 135, 220, 56, 61, 63, 174, 234, 145, 253, 162, 41, 44,
+			3, 124, 68, 43, 42, 233, 113, 93, 148, 23, 138, 76, 49, 152,
+			246, 177, 156, 71, 1, 243, 150, 237, 171, 115, 39, 202, 241, 26,
+			79, 126, 154, 125, 155, 37, 156, 248, 29, 114, 155, 72, 215, 74,
+			65, 53, 162, 182, 179, 93, 107, 158, 221, 213, 63, 208, 151, 180,
+			105, 240, 173, 215, 209, 191, 167, 254, 205, 186, 36, 53, 30, 255,
+			146, 34, 254, 96, 160, 163, 94, 145, 36, 209, 70, 209, 247, 243,
+			120, 255, 117, 127, 153, 30, 79, 190, 146, 27, 133, 19, 78, 79,
+			71, 126, 181, 159, 45, 252, 145, 250, 98, 96, 88, 52, 141, 169,
+			150, 180, 11, 177, 127, 236, 240, 216, 129, 131, 163, 99, 251, 39,
+			58, 83, 178, 87, 116, 141, 236, 154, 24, 219, 119, 232, 224, 222,
+			195, 227, 123, 39, 198, 14, 141, 239, 222, 219, 105, 173, 44, 156,
+			255, 200, 249, 113, 56, 255, 191, 252, 251, 8, 231, 35, 178, 111,
+			73, 214, 148, 26, 84, 145, 253, 102, 19, 217, 111, 78, 34, 251,
+			45, 169, 237, 72, 36, 70, 104, 63, 45, 83, 255, 197, 178, 48,
+			1, 27, 209, 115, 140, 138, 143, 195, 19, 253, 82, 181, 206, 21,
+			38, 113, 41, 135, 29, 102, 99, 68, 127, 161, 158, 161, 248, 110,
+			117, 190, 172, 184, 70, 47, 168, 86, 67, 213, 104, 248, 254, 243,
+			218, 68, 71, 168, 65, 175, 19, 208, 158, 14, 175, 120, 204, 155,
+			7, 105, 123, 8, 91, 4, 37, 149, 164, 140, 9, 192, 148, 23,
+			37, 235, 187, 75, 78, 41, 49, 225, 146, 153, 118, 241, 39, 122,
+			125, 97, 149, 189, 154, 103, 67, 178, 132, 73, 90, 123, 98, 11,
+			201, 219, 245, 162, 40, 152, 161, 89, 163, 87, 74, 120, 227, 216,
+			172, 95, 114, 167, 131, 74, 84, 5, 53, 224, 36, 41, 213, 81,
+			162, 62, 124, 7, 232, 215, 168, 165, 243, 87, 92, 234, 78, 210,
+			120, 54, 193, 68, 204, 5, 87, 169, 169, 9, 157, 9, 194, 86,
+			37, 129, 60, 76, 231, 87, 37, 129, 60, 204, 5, 87, 245, 174,
+			214, 129, 60, 204, 5, 29, 38, 89, 63, 223, 46, 126, 156, 209,
+			81, 253, 109, 246, 5, 60, 251, 104, 134, 218, 162, 206, 106, 117,
+			167, 188, 162, 87, 202, 147, 146, 105, 121, 38, 6, 94, 43, 86,
+			3, 220, 177, 178, 95, 81, 115, 11, 138, 2, 120, 110, 57, 140,
+			2, 58, 202, 169, 224, 23, 171, 94, 236, 181, 34, 241, 24, 1,
+			31, 149, 33, 30, 168, 188, 252, 33, 157, 204, 93, 157, 69, 142,
+			120, 236, 118, 205, 187, 147, 7, 198, 246, 141, 238, 126, 221, 225,
+			43, 71, 198, 247, 28, 222, 55, 122, 205, 232, 193, 201, 33, 19,
+			164, 207, 163, 207, 252, 227, 121, 223, 167, 3, 183, 8, 15, 9,
+			42, 121, 149, 87, 18, 174, 95, 169, 132, 20, 191, 29, 157, 110,
+			152, 15, 206, 122, 81, 61, 198, 216, 192, 13, 105, 210, 82, 141,
+			168, 127, 0, 149, 10, 119, 166, 226, 21, 106, 164, 125, 248, 125,
+			210, 138, 248, 189, 80, 66, 23, 38, 208, 29, 68, 120, 11, 79,
+			184, 37, 156, 213, 174, 170, 119, 203, 94, 84, 117, 131, 122, 143,
+			255, 53, 208, 135, 88, 83, 208, 8, 207, 45, 249, 199, 148, 84,
+			149, 230, 248, 199, 131, 136, 142, 255, 209, 42, 20, 84, 55, 161,
+			21, 81, 20, 96, 63, 211, 116, 88, 17, 245, 10, 166, 59, 14,
+			59, 3, 72, 54, 117, 98, 198, 107, 177, 17, 209, 143, 61, 137,
+			33, 55, 242, 230, 235, 159, 157, 245, 16, 150, 173, 235, 250, 109,
+			195, 195, 202, 140, 232, 65, 65, 114, 17, 46, 226, 98, 212, 226,
+			105, 119, 219, 240, 112, 28, 205, 163, 24, 212, 124, 88, 67, 134,
+			88, 99, 67, 148, 66, 36, 143, 92, 56, 156, 67, 210, 32, 128,
+			33, 97, 5, 38, 169, 58, 235, 47, 106, 66, 5, 71, 7, 150,
+			104, 47, 203, 182, 225, 225, 156, 123, 101, 120, 12, 194, 108, 252,
+			241, 49, 189, 137, 81, 184, 216, 211, 68, 139, 29, 94, 161, 16,
+			64, 21, 105, 149, 137, 250, 201, 216, 194, 58, 88, 181, 82, 53,
+			40, 186, 65, 149, 194, 254, 83, 149, 176, 134, 85, 85, 204, 233,
+			142, 9, 247, 194, 97, 119, 80, 255, 156, 112, 27, 103, 47, 136,
+			220, 2, 50, 56, 116, 120, 178, 97, 94, 93, 139, 244, 92, 171,
+			49, 124, 8, 15, 49, 57, 99, 115, 186, 6, 85, 66, 150, 95,
+			16, 85, 253, 146, 150, 80, 188, 219, 65, 129, 203, 207, 134, 152,
+			75, 199, 206, 36, 134, 5, 85, 145, 159, 197, 97, 79, 137, 137,
+			114, 97, 183, 10, 194, 13, 75, 177, 123, 237, 206, 249, 48, 69,
+			209, 108, 80, 214, 135, 59, 97, 136, 133, 69, 175, 26, 160, 191,
+			195, 74, 124, 250, 80, 189, 251, 94, 10, 49, 31, 69, 229, 192,
+			38, 220, 153, 176, 90, 245, 75, 42, 58, 169, 24, 27, 170, 72,
+			77, 173, 23, 159, 94, 121, 56, 54, 27, 20, 125, 55, 196, 193,
+			105, 113, 72, 176, 180, 169, 106, 22, 29, 16, 248, 217, 150, 216,
+			42, 4, 126, 182, 53, 44, 14, 109, 107, 88, 28, 218, 214, 125,
+			129, 182, 85, 150, 178, 85, 219, 249, 118, 241, 67, 76, 206, 83,
+			44, 37, 249, 43, 236, 50, 203, 62, 98, 187, 177, 211, 144, 164,
+			77, 70, 238, 108, 120, 76, 235, 68, 180, 216, 66, 105, 61, 24,
+			12, 43, 110, 1, 41, 57, 155, 105, 176, 10, 26, 173, 122, 249,
+			65, 213, 89, 174, 132, 121, 216, 162, 185, 96, 46, 200, 195, 72,
+			228, 43, 97, 105, 200, 141, 170, 94, 133, 198, 161, 87, 117, 15,
+			29, 220, 237, 206, 5, 133, 18, 17, 247, 249, 110, 236, 68, 12,
+			185, 199, 60, 44, 133, 8, 183, 86, 118, 161, 168, 243, 238, 164,
+			246, 65, 38, 221, 200, 207, 135, 165, 56, 102, 141, 248, 240, 36,
+			121, 48, 147, 42, 149, 115, 145, 234, 15, 214, 202, 110, 53, 20,
+			218, 242, 111, 86, 25, 69, 170, 21, 161, 182, 181, 113, 182, 212,
+			44, 44, 106, 41, 138, 185, 165, 110, 56, 35, 22, 145, 175, 146,
+			181, 82, 71, 85, 122, 249, 170, 138, 210, 14, 65, 96, 0, 169,
+			59, 138, 129, 70, 94, 33, 58, 197, 127, 103, 194, 65, 17, 36,
+			119, 37, 223, 215, 148, 253, 18, 91, 192, 114, 4, 93, 55, 100,
+			81, 3, 74, 212, 128, 133, 237, 215, 50, 174, 52, 202, 24, 99,
+			41, 136, 242, 21, 191, 234, 191, 220, 189, 2, 11, 151, 7, 135,
+			115, 185, 131, 195, 231, 235, 7, 17, 31, 66, 182, 84, 188, 179,
+			33, 194, 70, 45, 188, 156, 76, 43, 154, 237, 85, 180, 187, 98,
+			16, 144, 245, 200, 185, 35, 85, 183, 161, 34, 15, 131, 174, 58,
+			219, 80, 5, 72, 2, 27, 182, 1, 207, 156, 78, 30, 91, 88,
+			191, 50, 23, 84, 33, 175, 106, 72, 106, 77, 54, 109, 198, 171,
+			163, 139, 250, 94, 196, 47, 196, 66, 16, 81, 172, 108, 238, 148,
+			151, 63, 130, 31, 13, 27, 138, 192, 27, 71, 241, 30, 88, 5,
+			243, 46, 189, 248, 144, 200, 120, 202, 119, 135, 221, 193, 55, 194,
+			25, 8, 97, 62, 171, 70, 81, 213, 94, 52, 156, 78, 83, 13,
+			42, 72, 12, 78, 150, 176, 188, 200, 199, 16, 108, 23, 77, 113,
+			87, 166, 37, 187, 146, 55, 155, 178, 37, 217, 149, 162, 195, 148,
+			153, 100, 87, 82, 160, 76, 149, 51, 146, 95, 217, 179, 207, 17,
+			82, 8, 245, 193, 103, 144, 133, 118, 101, 239, 190, 180, 248, 102,
+			70, 41, 136, 37, 249, 52, 159, 117, 178, 95, 200, 44, 80, 16,
+			173, 235, 83, 126, 245, 24, 214, 44, 148, 180, 252, 163, 112, 247,
+			134, 112, 152, 17, 102, 33, 37, 58, 23, 177, 26, 54, 140, 38,
+			225, 158, 239, 78, 198, 227, 105, 178, 158, 181, 131, 200, 29, 166,
+			152, 152, 22, 57, 17, 115, 236, 96, 44, 32, 4, 213, 175, 85,
+			74, 57, 44, 96, 13, 43, 160, 221, 203, 218, 255, 3, 243, 171,
+			113, 21, 175, 241, 208, 123, 104, 138, 14, 186, 133, 191, 160, 186,
+			33, 48, 61, 64, 20, 150, 116, 191, 2, 145, 115, 15, 134, 174,
+			151, 159, 13, 124, 218, 171, 22, 68, 67, 194, 165, 158, 154, 243,
+			142, 212, 25, 233, 133, 244, 23, 55, 164, 20, 170, 126, 196, 235,
+			85, 143, 198, 18, 114, 195, 124, 190, 86, 89, 36, 166, 1, 45,
+			163, 1, 168, 252, 161, 131, 187, 115, 238, 68, 136, 32, 150, 230,
+			92, 8, 74, 0, 241, 69, 195, 36, 146, 82, 188, 55, 112, 46,
+			40, 213, 170, 254, 22, 111, 186, 234, 87, 182, 28, 58, 184, 123,
+			139, 174, 103, 9, 185, 129, 66, 133, 86, 107, 101, 116, 12, 142,
+			152, 11, 208, 184, 72, 173, 142, 233, 135, 85, 15, 207, 133, 72,
+			175, 40, 87, 252, 66, 144, 175, 122, 83, 52, 111, 32, 221, 136,
+			123, 52, 78, 77, 56, 116, 112, 55, 100, 173, 18, 38, 139, 122,
+			61, 151, 182, 240, 54, 172, 66, 80, 196, 180, 26, 154, 227, 118,
+			203, 218, 155, 87, 25, 0, 21, 63, 22, 146, 94, 121, 50, 177,
+			110, 140, 48, 247, 77, 97, 201, 143, 54, 107, 231, 4, 178, 193,
+			148, 163, 54, 231, 77, 209, 75, 225, 55, 168, 117, 60, 196, 183,
+			53, 225, 197, 56, 60, 140, 255, 45, 186, 121, 83, 94, 20, 68,
+			67, 144, 53, 188, 5, 202, 33, 193, 10, 164, 111, 66, 239, 215,
+			28, 154, 56, 8, 163, 15, 123, 92, 8, 232, 116, 159, 237, 59,
+			102, 221, 193, 75, 46, 218, 49, 60, 188, 57, 215, 232, 118, 121,
+			37, 87, 155, 37, 232, 195, 197, 219, 92, 128, 67, 36, 119, 236,
+			234, 196, 173, 8, 180, 111, 163, 170, 131, 100, 10, 30, 198, 120,
+			53, 116, 183, 109, 223, 118, 81, 238, 101, 73, 45, 209, 144, 59,
+			231, 123, 8, 167, 168, 249, 28, 126, 92, 241, 167, 43, 126, 52,
+			235, 110, 202, 207, 231, 139, 254, 38, 213, 98, 152, 155, 124, 88,
+			81, 75, 166, 152, 130, 85, 225, 96, 105, 157, 80, 196, 85, 240,
+			230, 115, 194, 29, 89, 128, 115, 187, 59, 58, 225, 134, 71, 220,
+			234, 44, 124, 165, 161, 122, 168, 13, 77, 143, 212, 188, 42, 6,
+			43, 220, 109, 219, 135, 135, 19, 56, 101, 191, 18, 132, 133, 168,
+			206, 58, 193, 69, 152, 230, 117, 101, 75, 178, 233, 150, 110, 83,
+			102, 146, 77, 247, 173, 74, 172, 19, 86, 136, 166, 251, 103, 211,
+			66, 138, 22, 245, 65, 156, 35, 49, 227, 108, 22, 223, 181, 148,
+			117, 178, 37, 159, 227, 161, 147, 125, 208, 66, 59, 22, 42, 160,
+			110, 175, 210, 113, 77, 6, 122, 62, 137, 173, 25, 83, 197, 32,
+			154, 141, 73, 149, 50, 124, 189, 40, 10, 243, 1, 217, 20, 165,
+			23, 145, 187, 137, 188, 2, 229, 185, 21, 188, 249, 77, 232, 220,
+			130, 158, 78, 235, 218, 2, 116, 59, 50, 196, 224, 56, 87, 106,
+			133, 0, 127, 195, 43, 132, 154, 66, 75, 93, 175, 24, 204, 148,
+			240, 41, 89, 158, 134, 177, 85, 47, 41, 172, 73, 205, 213, 73,
+			10, 171, 82, 115, 45, 93, 166, 204, 36, 155, 163, 64, 176, 42,
+			103, 36, 159, 235, 11, 141, 164, 116, 54, 73, 201, 217, 44, 90,
+			84, 194, 13, 187, 214, 238, 209, 153, 40, 142, 100, 215, 38, 206,
+			26, 106, 191, 54, 73, 23, 179, 153, 100, 215, 202, 110, 209, 138,
+			199, 144, 174, 23, 217, 255, 153, 197, 223, 81, 250, 93, 212, 132,
+			152, 190, 131, 18, 214, 184, 171, 92, 117, 160, 202, 59, 171, 242,
+			118, 83, 182, 37, 171, 118, 73, 241, 118, 174, 126, 111, 73, 246,
+			22, 158, 205, 254, 79, 230, 142, 54, 166, 230, 212, 187, 112, 121,
+			112, 103, 228, 122, 201, 44, 59, 14, 65, 198, 182, 160, 62, 156,
+			80, 18, 230, 0, 228, 107, 107, 30, 165, 125, 42, 71, 218, 112,
+			212, 20, 197, 29, 97, 73, 167, 139, 80, 132, 33, 109, 144, 92,
+			111, 142, 60, 25, 108, 166, 140, 170, 97, 197, 155, 137, 213, 66,
+			45, 15, 230, 146, 126, 113, 167, 139, 222, 204, 140, 182, 61, 132,
+			52, 142, 134, 213, 109, 44, 154, 243, 74, 202, 255, 170, 79, 78,
+			56, 26, 120, 80, 25, 119, 4, 217, 99, 238, 200, 129, 209, 33,
+			12, 187, 146, 90, 62, 167, 222, 207, 233, 137, 104, 142, 210, 201,
+			38, 5, 236, 209, 92, 16, 33, 79, 41, 231, 142, 212, 79, 57,
+			98, 1, 192, 30, 65, 215, 252, 227, 101, 204, 16, 54, 209, 200,
+			222, 228, 78, 215, 74, 121, 252, 204, 43, 66, 8, 52, 89, 140,
+			253, 40, 181, 176, 167, 126, 30, 43, 48, 182, 243, 250, 165, 66,
+			124, 121, 139, 225, 192, 193, 176, 50, 132, 149, 21, 52, 100, 8,
+			115, 159, 77, 85, 225, 122, 197, 98, 120, 76, 249, 100, 137, 68,
+			16, 137, 241, 171, 110, 88, 163, 105, 30, 108, 115, 220, 38, 4,
+			183, 241, 126, 229, 170, 197, 241, 143, 114, 209, 67, 254, 228, 206,
+			205, 155, 149, 66, 171, 249, 193, 91, 120, 175, 41, 219, 146, 189,
+			165, 127, 181, 216, 162, 51, 159, 222, 106, 217, 61, 217, 13, 238,
+			174, 160, 122, 44, 136, 252, 45, 99, 227, 120, 145, 10, 221, 169,
+			125, 90, 106, 213, 132, 242, 157, 248, 91, 45, 187, 73, 23, 113,
+			95, 144, 149, 233, 208, 69, 220, 23, 132, 213, 193, 235, 227, 244,
+			70, 46, 249, 205, 150, 61, 152, 253, 77, 236, 238, 38, 90, 64,
+			131, 52, 214, 198, 164, 157, 121, 229, 91, 152, 158, 87, 58, 170,
+			164, 90, 198, 212, 40, 130, 148, 64, 186, 238, 148, 143, 121, 3,
+			58, 178, 234, 151, 114, 238, 94, 176, 242, 88, 89, 211, 157, 170,
+			85, 27, 72, 181, 56, 136, 183, 134, 53, 179, 16, 155, 215, 235,
+			176, 218, 168, 196, 24, 220, 170, 63, 87, 14, 41, 185, 165, 209,
+			106, 196, 83, 67, 188, 131, 20, 127, 244, 128, 72, 206, 51, 83,
+			236, 89, 171, 134, 115, 94, 85, 197, 210, 102, 188, 202, 20, 52,
+			61, 31, 22, 139, 72, 176, 33, 111, 1, 115, 31, 191, 130, 36,
+			171, 196, 101, 29, 81, 105, 116, 228, 198, 128, 115, 200, 105, 218,
+			20, 148, 166, 131, 82, 80, 245, 55, 37, 173, 209, 33, 222, 120,
+			26, 173, 2, 215, 155, 115, 202, 233, 173, 248, 56, 222, 206, 47,
+			33, 16, 87, 13, 221, 114, 144, 63, 18, 207, 76, 137, 125, 232,
+			66, 60, 245, 162, 68, 11, 43, 30, 102, 152, 232, 136, 146, 11,
+			255, 38, 212, 58, 56, 238, 23, 98, 71, 173, 92, 169, 97, 170,
+			80, 172, 179, 207, 100, 189, 131, 186, 124, 161, 66, 184, 176, 169,
+			24, 80, 90, 99, 176, 177, 232, 102, 203, 206, 234, 162, 133, 226,
+			154, 115, 116, 145, 161, 120, 238, 166, 100, 105, 225, 139, 221, 226,
+			226, 101, 7, 129, 183, 170, 17, 189, 242, 181, 133, 13, 11, 151,
+			16, 170, 122, 155, 159, 90, 99, 56, 225, 226, 195, 169, 108, 0,
+			201, 158, 226, 106, 201, 192, 219, 153, 104, 82, 130, 151, 23, 136,
+			38, 53, 5, 82, 225, 252, 213, 207, 238, 234, 27, 232, 89, 42,
+			156, 63, 174, 127, 41, 119, 11, 81, 43, 3, 125, 225, 112, 53,
+			82, 251, 36, 178, 185, 19, 238, 113, 164, 221, 16, 247, 90, 118,
+			198, 26, 111, 86, 207, 29, 140, 228, 126, 209, 73, 110, 226, 252,
+			225, 252, 172, 87, 154, 241, 81, 21, 91, 65, 85, 237, 241, 211,
+			187, 233, 225, 131, 145, 252, 99, 33, 84, 125, 21, 127, 186, 159,
+			159, 226, 230, 141, 113, 127, 186, 110, 243, 70, 115, 89, 127, 40,
+			39, 132, 19, 23, 212, 170, 201, 182, 21, 87, 93, 87, 175, 170,
+			106, 101, 107, 19, 191, 108, 19, 142, 228, 60, 181, 243, 223, 193,
+			210, 196, 102, 179, 52, 17, 175, 82, 216, 88, 144, 184, 148, 254,
+			100, 216, 107, 112, 185, 90, 176, 104, 53, 11, 22, 173, 201, 130,
+			69, 123, 106, 187, 248, 109, 188, 96, 193, 123, 83, 59, 173, 236,
+			47, 200, 117, 84, 58, 93, 239, 98, 212, 239, 96, 139, 170, 200,
+			54, 14, 167, 205, 50, 134, 30, 232, 13, 49, 5, 207, 157, 9,
+			192, 241, 88, 218, 143, 3, 212, 136, 24, 192, 120, 170, 159, 19,
+			223, 210, 132, 173, 209, 191, 65, 94, 164, 151, 159, 21, 250, 106,
+			145, 65, 144, 233, 220, 144, 89, 176, 223, 156, 184, 252, 42, 193,
+			209, 203, 99, 54, 87, 157, 85, 76, 163, 235, 175, 226, 26, 143,
+			72, 36, 251, 104, 225, 119, 170, 161, 87, 183, 200, 209, 155, 233,
+			16, 119, 50, 189, 202, 177, 206, 118, 211, 217, 119, 197, 140, 168,
+			27, 92, 170, 205, 249, 149, 32, 175, 25, 210, 84, 145, 36, 177,
+			248, 110, 88, 40, 108, 41, 170, 204, 96, 154, 106, 199, 167, 106,
+			233, 9, 67, 189, 252, 18, 231, 56, 158, 93, 214, 34, 63, 18,
+			238, 190, 154, 119, 97, 110, 155, 59, 152, 28, 168, 23, 148, 98,
+			59, 191, 185, 206, 65, 86, 13, 11, 74, 51, 58, 216, 9, 127,
+			13, 119, 180, 144, 151, 23, 197, 55, 187, 132, 181, 41, 28, 83,
+			49, 56, 71, 193, 57, 82, 31, 189, 200, 17, 121, 211, 144, 111,
+			213, 159, 65, 136, 30, 3, 62, 14, 168, 93, 120, 129, 59, 21,
+			208, 253, 134, 163, 122, 18, 134, 179, 166, 146, 152, 123, 28, 250,
+			34, 46, 109, 88, 117, 168, 206, 170, 195, 221, 195, 202, 145, 28,
+			66, 23, 152, 153, 39, 161, 35, 176, 241, 116, 236, 157, 230, 243,
+			245, 162, 139, 135, 195, 86, 184, 183, 202, 161, 170, 206, 194, 19,
+			64, 66, 238, 144, 91, 46, 250, 88, 232, 40, 250, 85, 183, 22,
+			209, 229, 8, 73, 108, 21, 235, 64, 235, 236, 38, 93, 178, 36,
+			91, 151, 169, 223, 221, 177, 174, 97, 119, 199, 186, 30, 151, 139,
+			78, 145, 81, 235, 64, 92, 242, 117, 189, 46, 19, 159, 179, 244,
+			58, 16, 237, 238, 184, 59, 206, 121, 72, 40, 38, 153, 0, 185,
+			147, 10, 238, 36, 197, 203, 139, 88, 202, 80, 102, 55, 113, 82,
+			16, 90, 153, 11, 242, 149, 48, 142, 0, 161, 161, 181, 138, 135,
+			140, 66, 204, 193, 75, 201, 96, 72, 230, 130, 245, 203, 115, 16,
+			189, 48, 9, 252, 5, 76, 75, 195, 146, 191, 69, 213, 133, 81,
+			81, 172, 65, 71, 234, 130, 203, 14, 118, 30, 172, 209, 37, 75,
+			178, 115, 215, 158, 171, 75, 216, 121, 176, 249, 188, 250, 157, 7,
+			231, 55, 236, 60, 232, 179, 226, 157, 7, 51, 122, 227, 193, 22,
+			123, 43, 207, 190, 110, 233, 230, 155, 124, 134, 77, 201, 138, 16,
+			137, 32, 102, 153, 147, 139, 32, 193, 108, 59, 146, 109, 73, 48,
+			99, 142, 181, 101, 237, 80, 221, 28, 107, 203, 214, 97, 133, 217,
+			206, 72, 190, 101, 219, 86, 141, 217, 86, 152, 115, 60, 39, 198,
+			180, 63, 188, 221, 222, 193, 179, 35, 58, 180, 170, 124, 118, 179,
+			96, 74, 158, 158, 26, 105, 250, 28, 60, 63, 74, 150, 173, 148,
+			105, 75, 176, 49, 71, 178, 237, 42, 197, 156, 28, 102, 182, 189,
+			181, 79, 151, 152, 100, 219, 87, 107, 215, 8, 59, 4, 182, 175,
+			217, 161, 177, 49, 149, 98, 126, 1, 31, 82, 216, 184, 228, 23,
+			219, 47, 3, 182, 137, 146, 87, 142, 102, 195, 234, 130, 36, 211,
+			149, 98, 227, 142, 100, 23, 39, 115, 83, 228, 73, 94, 156, 204,
+			77, 57, 147, 236, 226, 68, 217, 121, 70, 242, 139, 123, 94, 166,
+			177, 113, 133, 237, 18, 62, 148, 120, 110, 95, 127, 153, 216, 190,
+			108, 138, 220, 26, 150, 79, 99, 66, 200, 169, 248, 100, 3, 239,
+			228, 194, 30, 43, 195, 25, 81, 90, 168, 119, 74, 174, 204, 25,
+			81, 50, 109, 216, 158, 218, 172, 106, 28, 45, 200, 3, 66, 121,
+			38, 168, 61, 246, 191, 46, 88, 177, 63, 50, 186, 103, 60, 19,
+			215, 50, 90, 144, 175, 19, 45, 250, 70, 159, 195, 213, 144, 242,
+			47, 218, 87, 148, 135, 50, 86, 206, 141, 171, 10, 14, 134, 227,
+			66, 87, 118, 48, 148, 57, 145, 166, 133, 101, 242, 201, 216, 174,
+			254, 103, 119, 245, 14, 116, 247, 127, 239, 159, 63, 242, 197, 219,
+			110, 180, 7, 239, 185, 229, 137, 135, 255, 233, 95, 255, 223, 127,
+			251, 55, 107, 60, 254, 89, 125, 238, 10, 92, 173, 182, 36, 119,
+			101, 96, 183, 16, 230, 29, 136, 130, 239, 62, 52, 62, 190, 119,
+			255, 193, 195, 187, 70, 246, 141, 236, 223, 189, 183, 51, 37, 51,
+			130, 191, 126, 239, 248, 88, 167, 37, 91, 68, 211, 158, 189, 87,
+			140, 28, 218, 119, 176, 211, 150, 205, 34, 77, 203, 210, 157, 108,
+			32, 56, 113, 6, 73, 191, 232, 25, 125, 213, 254, 177, 241, 189,
+			135, 213, 122, 246, 174, 177, 67, 251, 247, 76, 116, 90, 114, 149,
+			232, 222, 51, 118, 120, 255, 216, 193, 195, 187, 71, 14, 28, 62,
+			48, 62, 118, 96, 108, 98, 239, 158, 78, 91, 174, 17, 171, 94,
+			51, 122, 240, 74, 253, 0, 189, 228, 240, 158, 189, 251, 14, 142,
+			116, 242, 149, 185, 119, 143, 93, 16, 167, 158, 220, 245, 239, 42,
+			245, 36, 147, 186, 84, 121, 114, 194, 120, 114, 202, 189, 179, 225,
+			211, 109, 23, 31, 77, 82, 79, 238, 178, 172, 236, 251, 44, 147,
+			77, 98, 150, 207, 64, 228, 229, 114, 114, 162, 138, 242, 43, 200,
+			163, 51, 254, 204, 213, 190, 95, 6, 48, 19, 206, 24, 247, 142,
+			141, 213, 47, 176, 86, 117, 58, 92, 242, 137, 94, 119, 195, 138,
+			127, 60, 95, 87, 75, 79, 68, 152, 110, 177, 230, 185, 56, 53,
+			171, 92, 109, 72, 47, 17, 98, 135, 118, 188, 122, 236, 62, 158,
+			61, 183, 97, 199, 32, 192, 22, 104, 121, 193, 28, 173, 55, 29,
+			38, 11, 120, 180, 241, 168, 39, 49, 222, 168, 177, 39, 49, 222,
+			240, 6, 122, 18, 227, 157, 202, 72, 222, 179, 166, 79, 27, 72,
+			189, 241, 168, 151, 15, 197, 121, 231, 240, 6, 216, 6, 123, 117,
+			246, 214, 56, 7, 90, 241, 93, 220, 171, 106, 135, 100, 3, 152,
+			5, 14, 77, 178, 112, 147, 60, 170, 82, 107, 85, 62, 45, 130,
+			105, 213, 36, 236, 170, 29, 66, 197, 11, 94, 17, 59, 39, 145,
+			73, 90, 85, 17, 146, 134, 124, 100, 85, 175, 22, 10, 110, 222,
+			42, 109, 170, 38, 79, 81, 182, 132, 170, 125, 172, 28, 191, 0,
+			71, 109, 171, 28, 4, 189, 190, 19, 7, 247, 188, 106, 45, 114,
+			175, 25, 157, 152, 24, 221, 255, 170, 195, 35, 187, 119, 143, 29,
+			218, 127, 48, 73, 69, 169, 179, 91, 232, 99, 12, 255, 68, 212,
+			216, 138, 181, 193, 78, 74, 144, 86, 139, 142, 161, 90, 76, 178,
+			13, 171, 250, 77, 156, 116, 163, 125, 121, 125, 156, 116, 99, 83,
+			151, 248, 142, 165, 2, 159, 41, 201, 206, 229, 253, 217, 175, 88,
+			117, 97, 104, 16, 225, 188, 187, 7, 70, 11, 155, 24, 144, 204,
+			80, 154, 65, 140, 189, 113, 169, 117, 83, 178, 132, 82, 47, 246,
+			186, 69, 204, 70, 65, 161, 7, 252, 99, 113, 120, 122, 210, 204,
+			104, 99, 103, 14, 43, 139, 213, 134, 94, 17, 75, 73, 109, 105,
+			137, 233, 248, 180, 82, 186, 115, 235, 3, 191, 182, 100, 231, 246,
+			173, 18, 111, 211, 237, 181, 36, 59, 143, 183, 101, 143, 46, 179,
+			185, 111, 242, 43, 225, 203, 227, 223, 250, 211, 211, 8, 214, 224,
+			60, 236, 56, 238, 19, 185, 243, 97, 141, 150, 187, 54, 81, 164,
+			179, 110, 225, 27, 107, 70, 161, 185, 11, 50, 159, 100, 191, 104,
+			92, 72, 67, 56, 143, 103, 18, 156, 152, 253, 157, 215, 210, 42,
+			254, 140, 41, 156, 182, 100, 59, 120, 103, 246, 95, 237, 101, 2,
+			173, 83, 245, 73, 21, 235, 82, 71, 190, 38, 142, 33, 38, 54,
+			212, 35, 230, 7, 180, 185, 107, 65, 102, 113, 93, 183, 198, 21,
+			98, 217, 73, 232, 46, 155, 140, 135, 68, 172, 220, 65, 210, 113,
+			57, 119, 12, 193, 49, 132, 68, 151, 24, 88, 201, 11, 69, 227,
+			27, 145, 203, 161, 34, 56, 139, 87, 64, 85, 198, 7, 213, 102,
+			216, 81, 153, 29, 173, 22, 90, 224, 106, 43, 38, 228, 81, 10,
+			245, 54, 57, 189, 91, 89, 195, 193, 218, 91, 17, 251, 71, 22,
+			41, 20, 54, 244, 199, 68, 55, 190, 247, 143, 14, 141, 142, 239,
+			221, 83, 215, 87, 176, 69, 59, 120, 139, 41, 163, 111, 218, 59,
+			196, 103, 116, 95, 49, 201, 46, 227, 237, 217, 59, 217, 41, 244,
+			149, 90, 6, 86, 61, 229, 30, 138, 98, 171, 173, 151, 156, 205,
+			33, 52, 117, 107, 255, 164, 1, 75, 116, 171, 170, 107, 37, 157,
+			170, 30, 57, 149, 62, 141, 31, 61, 43, 123, 20, 243, 138, 203,
+			116, 54, 2, 202, 182, 100, 151, 181, 182, 137, 215, 232, 5, 169,
+			93, 246, 186, 236, 85, 117, 123, 182, 105, 206, 56, 73, 110, 28,
+			45, 252, 107, 211, 76, 123, 57, 104, 126, 170, 103, 141, 122, 134,
+			15, 213, 54, 86, 81, 77, 174, 28, 212, 220, 170, 75, 150, 100,
+			187, 218, 250, 117, 137, 73, 182, 107, 205, 90, 241, 119, 173, 122,
+			98, 85, 176, 103, 210, 217, 175, 183, 42, 29, 82, 36, 111, 150,
+			143, 146, 252, 12, 228, 192, 32, 171, 40, 170, 77, 33, 192, 82,
+			221, 108, 174, 173, 159, 172, 163, 16, 100, 250, 8, 90, 28, 129,
+			38, 29, 115, 39, 203, 149, 176, 28, 70, 126, 97, 82, 227, 76,
+			230, 110, 113, 156, 168, 145, 242, 234, 126, 174, 226, 17, 180, 169,
+			168, 46, 129, 81, 119, 156, 174, 141, 242, 216, 40, 80, 158, 116,
+			87, 146, 14, 169, 66, 21, 113, 87, 29, 218, 191, 103, 239, 248,
+			21, 251, 198, 94, 163, 246, 158, 44, 229, 170, 170, 231, 213, 146,
+			79, 172, 169, 200, 77, 169, 104, 121, 47, 120, 59, 106, 114, 147,
+			17, 115, 162, 54, 128, 225, 151, 120, 216, 245, 166, 194, 163, 218,
+			29, 200, 145, 158, 55, 16, 147, 94, 179, 63, 209, 227, 213, 176,
+			225, 217, 149, 55, 235, 57, 113, 161, 54, 119, 169, 23, 44, 225,
+			200, 19, 96, 87, 135, 188, 146, 108, 18, 175, 180, 68, 55, 140,
+			189, 90, 247, 130, 112, 71, 98, 106, 169, 134, 245, 225, 193, 133,
+			102, 72, 163, 66, 111, 92, 29, 143, 76, 122, 93, 80, 194, 202,
+			16, 13, 116, 95, 36, 191, 82, 251, 118, 98, 221, 82, 145, 187,
+			193, 168, 22, 239, 166, 86, 70, 145, 78, 157, 138, 165, 143, 133,
+			154, 9, 165, 209, 128, 66, 58, 189, 8, 76, 137, 210, 153, 182,
+			144, 20, 78, 132, 7, 7, 164, 24, 64, 207, 3, 143, 112, 199,
+			202, 42, 45, 183, 110, 92, 185, 11, 230, 106, 216, 218, 5, 87,
+			77, 167, 216, 40, 167, 18, 93, 74, 129, 56, 24, 179, 41, 76,
+			53, 188, 10, 130, 13, 113, 142, 9, 153, 127, 10, 52, 23, 42,
+			97, 89, 13, 29, 248, 30, 168, 109, 38, 132, 134, 16, 160, 99,
+			222, 60, 114, 251, 244, 241, 139, 58, 140, 168, 219, 4, 108, 88,
+			250, 12, 162, 168, 230, 111, 110, 244, 198, 18, 236, 177, 25, 115,
+			47, 189, 212, 29, 158, 164, 152, 104, 209, 159, 9, 170, 193, 156,
+			135, 51, 67, 166, 77, 150, 136, 222, 81, 134, 5, 239, 120, 157,
+			45, 153, 172, 120, 117, 118, 62, 121, 169, 127, 28, 75, 194, 1,
+			34, 41, 115, 97, 33, 152, 158, 167, 100, 16, 232, 106, 157, 216,
+			149, 170, 54, 86, 90, 241, 147, 152, 27, 73, 45, 130, 156, 60,
+			157, 210, 163, 94, 19, 139, 104, 48, 9, 225, 233, 170, 205, 22,
+			81, 172, 120, 199, 78, 26, 164, 227, 134, 83, 52, 159, 87, 135,
+			230, 105, 130, 82, 56, 94, 78, 91, 253, 93, 18, 5, 53, 212,
+			117, 135, 221, 233, 16, 3, 49, 222, 122, 23, 127, 227, 110, 83,
+			23, 176, 197, 251, 84, 105, 33, 214, 132, 206, 147, 207, 243, 181,
+			57, 160, 15, 244, 224, 84, 40, 188, 40, 78, 198, 210, 117, 109,
+			94, 170, 241, 155, 170, 97, 45, 63, 187, 41, 30, 104, 208, 41,
+			173, 226, 56, 192, 37, 82, 23, 175, 194, 212, 171, 80, 231, 225,
+			106, 164, 154, 14, 48, 16, 23, 18, 142, 203, 97, 21, 57, 120,
+			30, 238, 90, 40, 7, 122, 114, 25, 204, 153, 244, 74, 44, 70,
+			179, 66, 18, 171, 5, 5, 22, 50, 109, 186, 196, 36, 43, 168,
+			13, 208, 136, 165, 73, 94, 144, 51, 58, 86, 203, 226, 88, 109,
+			161, 123, 134, 209, 141, 186, 41, 110, 201, 244, 27, 237, 15, 90,
+			44, 59, 170, 102, 168, 122, 253, 27, 108, 95, 183, 172, 9, 161,
+			79, 5, 213, 45, 177, 139, 64, 253, 16, 13, 209, 60, 204, 3,
+			79, 99, 12, 196, 55, 96, 104, 144, 28, 126, 241, 27, 155, 218,
+			197, 203, 201, 181, 194, 201, 22, 172, 200, 187, 179, 67, 184, 10,
+			9, 30, 100, 220, 153, 47, 199, 192, 66, 216, 158, 246, 174, 249,
+			179, 222, 209, 32, 172, 168, 64, 104, 66, 243, 241, 209, 23, 197,
+			36, 235, 195, 162, 201, 64, 177, 75, 138, 71, 184, 170, 221, 146,
+			236, 77, 124, 93, 246, 1, 14, 134, 139, 124, 125, 79, 238, 88,
+			153, 134, 162, 33, 23, 109, 116, 148, 242, 232, 244, 2, 26, 198,
+			209, 203, 221, 145, 132, 4, 133, 98, 235, 36, 181, 83, 41, 84,
+			53, 124, 142, 218, 200, 222, 225, 27, 177, 128, 114, 168, 115, 141,
+			189, 93, 126, 213, 117, 86, 55, 177, 36, 38, 156, 48, 238, 207,
+			120, 149, 2, 77, 49, 147, 48, 74, 172, 103, 67, 58, 13, 176,
+			110, 227, 128, 74, 49, 55, 142, 25, 185, 17, 161, 150, 193, 127,
+			24, 30, 106, 64, 253, 199, 148, 227, 189, 232, 33, 61, 59, 70,
+			20, 163, 134, 37, 33, 244, 127, 213, 167, 217, 211, 76, 232, 94,
+			51, 54, 190, 183, 81, 170, 42, 45, 74, 229, 179, 32, 50, 239,
+			122, 52, 170, 98, 63, 40, 233, 244, 112, 90, 251, 101, 104, 160,
+			246, 245, 212, 66, 187, 87, 170, 11, 181, 132, 13, 169, 201, 176,
+			90, 208, 162, 186, 156, 42, 213, 12, 218, 249, 162, 130, 39, 201,
+			57, 160, 100, 229, 150, 98, 216, 58, 117, 131, 238, 190, 137, 247,
+			155, 178, 45, 217, 155, 214, 172, 21, 223, 76, 43, 117, 179, 37,
+			191, 201, 226, 107, 179, 95, 72, 39, 250, 150, 184, 179, 99, 184,
+			224, 97, 225, 134, 21, 157, 113, 161, 118, 172, 40, 202, 82, 6,
+			145, 196, 61, 36, 220, 145, 253, 123, 18, 143, 83, 185, 210, 250,
+			185, 19, 30, 122, 169, 212, 195, 232, 59, 58, 28, 217, 137, 97,
+			161, 150, 95, 134, 127, 208, 144, 76, 184, 96, 163, 41, 214, 31,
+			60, 101, 116, 183, 13, 47, 202, 61, 221, 118, 33, 44, 64, 101,
+			14, 242, 165, 125, 35, 99, 229, 255, 180, 128, 66, 135, 220, 243,
+			183, 13, 255, 137, 106, 70, 157, 139, 159, 104, 181, 170, 6, 75,
+			58, 148, 17, 105, 92, 50, 108, 32, 161, 54, 77, 5, 85, 61,
+			162, 125, 106, 32, 85, 166, 98, 47, 113, 86, 203, 72, 178, 73,
+			3, 89, 69, 193, 116, 189, 175, 83, 223, 136, 237, 195, 67, 139,
+			91, 49, 188, 140, 86, 108, 185, 240, 100, 141, 80, 123, 60, 168,
+			218, 205, 67, 194, 180, 104, 185, 13, 90, 186, 98, 225, 110, 187,
+			240, 185, 244, 120, 41, 87, 52, 7, 103, 97, 75, 88, 166, 89,
+			64, 226, 44, 208, 208, 114, 95, 113, 169, 139, 155, 154, 59, 148,
+			98, 99, 203, 214, 77, 22, 87, 249, 144, 80, 108, 210, 236, 236,
+			26, 241, 51, 71, 169, 58, 238, 201, 180, 184, 155, 125, 208, 105,
+			80, 245, 64, 217, 27, 204, 51, 75, 213, 88, 219, 32, 104, 165,
+			210, 177, 5, 31, 114, 225, 19, 13, 185, 65, 50, 9, 140, 92,
+			79, 212, 229, 214, 154, 224, 17, 60, 1, 236, 255, 240, 170, 13,
+			42, 168, 212, 188, 72, 135, 230, 68, 67, 234, 54, 188, 98, 213,
+			19, 73, 70, 184, 30, 49, 245, 182, 139, 32, 134, 197, 196, 18,
+			44, 216, 203, 85, 80, 185, 66, 100, 169, 189, 69, 243, 106, 37,
+			254, 156, 14, 60, 106, 48, 80, 165, 82, 104, 118, 81, 25, 244,
+			67, 194, 61, 65, 140, 29, 62, 172, 231, 150, 194, 176, 188, 212,
+			104, 59, 129, 158, 134, 211, 238, 5, 139, 20, 245, 66, 115, 62,
+			79, 157, 211, 134, 183, 39, 55, 209, 140, 149, 85, 53, 13, 218,
+			93, 215, 132, 77, 106, 247, 86, 124, 164, 12, 100, 161, 124, 17,
+			72, 227, 18, 119, 240, 2, 247, 252, 216, 98, 13, 169, 19, 122,
+			148, 135, 9, 253, 222, 226, 94, 184, 217, 157, 242, 167, 67, 117,
+			180, 28, 122, 62, 210, 103, 114, 20, 114, 238, 68, 48, 23, 20,
+			189, 138, 26, 129, 201, 96, 160, 28, 231, 250, 215, 108, 139, 103,
+			181, 23, 46, 0, 166, 142, 70, 79, 58, 169, 238, 137, 45, 219,
+			158, 11, 217, 66, 96, 66, 197, 62, 52, 176, 132, 45, 151, 156,
+			182, 197, 215, 90, 29, 11, 43, 71, 176, 198, 239, 22, 194, 124,
+			13, 185, 179, 190, 209, 112, 69, 92, 72, 171, 78, 180, 255, 68,
+			157, 93, 165, 124, 246, 58, 239, 180, 129, 182, 7, 164, 187, 223,
+			63, 94, 197, 30, 11, 28, 32, 21, 185, 151, 212, 13, 69, 156,
+			54, 112, 135, 197, 215, 152, 15, 108, 124, 176, 126, 131, 74, 88,
+			196, 253, 177, 150, 221, 187, 220, 132, 69, 236, 217, 191, 83, 239,
+			126, 79, 209, 158, 253, 59, 173, 230, 78, 93, 196, 5, 179, 86,
+			119, 79, 178, 136, 249, 64, 70, 92, 186, 236, 133, 32, 149, 171,
+			117, 88, 157, 110, 182, 226, 245, 204, 23, 52, 149, 236, 70, 91,
+			180, 214, 111, 194, 149, 175, 19, 25, 157, 252, 223, 111, 185, 108,
+			176, 101, 251, 165, 43, 94, 152, 140, 171, 202, 237, 69, 71, 170,
+			37, 202, 192, 143, 178, 239, 183, 68, 154, 62, 147, 87, 196, 39,
+			229, 174, 124, 89, 53, 57, 179, 138, 206, 215, 149, 163, 73, 78,
+			151, 125, 138, 57, 93, 167, 150, 201, 117, 55, 143, 51, 185, 122,
+			254, 29, 172, 244, 93, 170, 87, 250, 154, 82, 151, 159, 108, 147,
+			249, 46, 90, 232, 227, 237, 169, 30, 43, 123, 145, 59, 210, 120,
+			100, 142, 95, 202, 123, 229, 8, 243, 67, 196, 18, 224, 148, 160,
+			77, 117, 59, 205, 3, 63, 170, 91, 132, 107, 207, 96, 29, 71,
+			109, 54, 236, 192, 249, 180, 102, 119, 92, 135, 64, 238, 176, 222,
+			28, 199, 58, 121, 95, 146, 120, 143, 181, 183, 78, 222, 102, 202,
+			150, 100, 157, 237, 38, 49, 31, 235, 111, 157, 61, 189, 201, 227,
+			150, 100, 93, 117, 143, 99, 61, 169, 107, 193, 14, 136, 174, 186,
+			188, 126, 172, 41, 117, 245, 244, 170, 148, 253, 148, 100, 221, 246,
+			122, 53, 119, 76, 113, 148, 244, 44, 19, 56, 186, 91, 146, 189,
+			224, 150, 100, 221, 93, 171, 117, 137, 73, 214, 189, 118, 93, 98,
+			62, 254, 180, 105, 37, 230, 3, 71, 43, 250, 81, 245, 48, 217,
+			193, 211, 151, 14, 49, 240, 6, 209, 129, 203, 39, 252, 168, 186,
+			199, 47, 212, 202, 56, 145, 122, 131, 72, 211, 57, 40, 75, 28,
+			5, 77, 159, 203, 65, 33, 52, 28, 149, 168, 208, 240, 171, 102,
+			245, 229, 104, 97, 101, 227, 231, 151, 106, 252, 244, 255, 255, 225,
+			204, 229, 55, 234, 65, 211, 111, 101, 255, 35, 210, 28, 235, 123,
+			97, 175, 58, 77, 51, 217, 83, 161, 207, 73, 128, 71, 23, 111,
+			82, 244, 176, 19, 19, 183, 117, 42, 97, 35, 206, 87, 168, 153,
+			109, 197, 164, 39, 56, 69, 176, 97, 112, 173, 18, 35, 122, 133,
+			187, 211, 150, 60, 123, 1, 9, 19, 93, 226, 122, 56, 191, 73,
+			157, 173, 164, 179, 131, 40, 146, 166, 60, 112, 245, 158, 36, 160,
+			130, 228, 183, 206, 36, 31, 8, 227, 179, 51, 217, 88, 76, 195,
+			45, 217, 88, 140, 229, 238, 206, 110, 89, 191, 220, 141, 243, 217,
+			186, 120, 78, 209, 180, 165, 142, 139, 222, 64, 104, 144, 161, 185,
+			5, 7, 185, 194, 69, 210, 205, 139, 79, 140, 86, 43, 188, 13,
+			135, 42, 91, 116, 168, 114, 183, 58, 84, 25, 35, 181, 183, 111,
+			149, 122, 51, 178, 206, 122, 251, 147, 67, 149, 245, 89, 118, 125,
+			117, 199, 211, 124, 238, 245, 43, 201, 33, 87, 39, 69, 173, 124,
+			252, 157, 228, 24, 154, 231, 145, 99, 126, 170, 25, 240, 167, 230,
+			81, 156, 66, 214, 86, 246, 249, 57, 73, 3, 5, 33, 95, 229,
+			87, 85, 228, 34, 82, 195, 68, 238, 23, 77, 170, 57, 202, 33,
+			57, 165, 60, 172, 113, 93, 201, 192, 19, 182, 232, 110, 120, 77,
+			84, 14, 75, 145, 47, 103, 68, 70, 253, 36, 82, 47, 186, 122,
+			5, 47, 90, 162, 70, 253, 242, 9, 68, 128, 198, 147, 202, 179,
+			255, 187, 37, 90, 235, 191, 146, 251, 133, 125, 218, 146, 204, 236,
+			160, 32, 247, 25, 137, 197, 126, 209, 246, 149, 87, 154, 200, 75,
+			158, 47, 186, 212, 57, 106, 126, 225, 176, 114, 220, 41, 191, 140,
+			141, 119, 38, 95, 236, 138, 63, 31, 248, 95, 45, 209, 129, 61,
+			83, 243, 99, 229, 164, 3, 215, 53, 144, 8, 81, 77, 29, 115,
+			200, 171, 69, 187, 249, 250, 112, 181, 90, 236, 183, 79, 114, 172,
+			209, 46, 241, 236, 174, 166, 79, 88, 60, 147, 58, 47, 53, 222,
+			154, 212, 116, 176, 90, 148, 151, 9, 22, 150, 163, 126, 70, 138,
+			178, 101, 5, 205, 30, 43, 143, 227, 201, 129, 71, 184, 200, 140,
+			149, 199, 41, 220, 32, 55, 136, 150, 146, 127, 44, 105, 180, 69,
+			141, 22, 37, 255, 152, 106, 174, 220, 44, 58, 203, 21, 255, 104,
+			16, 214, 162, 228, 87, 54, 253, 170, 67, 127, 174, 127, 58, 43,
+			218, 149, 68, 15, 35, 36, 88, 139, 84, 142, 222, 200, 202, 64,
+			18, 48, 221, 73, 208, 172, 90, 52, 222, 166, 42, 142, 139, 242,
+			160, 112, 212, 27, 56, 189, 225, 21, 167, 242, 134, 177, 178, 170,
+			92, 213, 37, 215, 9, 17, 255, 117, 120, 46, 154, 161, 196, 190,
+			230, 241, 230, 248, 147, 107, 162, 25, 185, 83, 172, 94, 40, 137,
+			195, 113, 222, 146, 95, 160, 11, 27, 216, 248, 170, 5, 34, 25,
+			81, 95, 15, 92, 38, 218, 26, 26, 36, 165, 104, 31, 217, 55,
+			190, 119, 100, 207, 235, 14, 239, 125, 237, 232, 196, 65, 228, 246,
+			181, 136, 166, 221, 227, 123, 71, 14, 238, 221, 211, 105, 201, 54,
+			209, 60, 190, 87, 23, 237, 129, 219, 45, 116, 154, 122, 184, 69,
+			52, 77, 28, 218, 189, 123, 239, 4, 158, 234, 16, 45, 123, 199,
+			199, 15, 31, 218, 127, 245, 254, 177, 215, 236, 239, 180, 100, 167,
+			104, 197, 7, 58, 200, 215, 105, 203, 46, 209, 134, 79, 146, 213,
+			217, 78, 38, 251, 132, 172, 123, 74, 77, 101, 59, 185, 92, 37,
+			186, 241, 249, 130, 180, 156, 206, 180, 254, 98, 193, 74, 124, 167,
+			51, 112, 183, 37, 58, 205, 120, 80, 150, 230, 26, 209, 20, 199,
+			179, 180, 161, 185, 224, 20, 122, 104, 92, 215, 33, 71, 68, 123,
+			88, 9, 102, 130, 18, 66, 96, 135, 113, 250, 214, 73, 119, 244,
+			140, 183, 153, 39, 38, 252, 234, 192, 227, 150, 232, 127, 13, 182,
+			63, 214, 123, 244, 122, 252, 78, 212, 153, 167, 211, 114, 79, 11,
+			108, 212, 180, 104, 107, 160, 0, 133, 249, 226, 83, 172, 191, 174,
+			246, 86, 181, 175, 136, 62, 31, 184, 80, 172, 94, 162, 97, 170,
+			35, 250, 205, 189, 53, 24, 219, 205, 230, 178, 154, 255, 36, 228,
+			193, 176, 60, 146, 207, 135, 181, 82, 53, 26, 247, 175, 253, 255,
+			184, 187, 190, 24, 57, 142, 180, 62, 213, 93, 59, 187, 91, 155,
+			37, 78, 37, 23, 111, 218, 112, 233, 172, 116, 242, 218, 204, 76,
+			98, 251, 46, 81, 188, 222, 220, 57, 182, 73, 34, 18, 47, 108,
+			236, 8, 11, 208, 110, 123, 167, 119, 183, 229, 157, 238, 113, 247,
+			76, 54, 22, 66, 156, 56, 4, 209, 201, 23, 116, 167, 123, 226,
+			208, 157, 224, 0, 157, 130, 116, 220, 3, 32, 64, 32, 224, 17,
+			9, 161, 59, 36, 164, 19, 18, 15, 128, 16, 60, 129, 56, 16,
+			18, 167, 128, 208, 239, 171, 175, 170, 255, 204, 174, 237, 93, 7,
+			33, 238, 109, 103, 187, 187, 186, 186, 234, 171, 175, 170, 190, 250,
+			253, 126, 31, 156, 149, 190, 164, 218, 123, 73, 218, 207, 246, 22,
+			196, 253, 92, 212, 177, 239, 190, 60, 255, 190, 80, 51, 98, 81,
+			206, 124, 250, 171, 83, 167, 91, 107, 252, 168, 14, 171, 146, 114,
+			83, 228, 203, 130, 169, 133, 127, 154, 94, 106, 173, 153, 11, 139,
+			255, 46, 212, 227, 181, 183, 127, 40, 51, 212, 62, 37, 90, 63,
+			114, 29, 169, 129, 43, 51, 212, 200, 77, 80, 116, 165, 62, 5,
+			139, 135, 158, 130, 245, 19, 86, 129, 14, 77, 224, 179, 200, 220,
+			226, 123, 66, 61, 97, 206, 101, 94, 77, 192, 47, 185, 99, 13,
+			240, 186, 219, 230, 139, 35, 67, 165, 43, 6, 194, 133, 233, 167,
+			235, 29, 129, 125, 77, 32, 23, 250, 101, 63, 252, 142, 167, 62,
+			210, 168, 16, 247, 68, 132, 17, 140, 11, 182, 35, 94, 57, 68,
+			149, 246, 45, 210, 10, 0, 218, 114, 131, 223, 43, 5, 251, 122,
+			74, 98, 145, 184, 32, 238, 59, 172, 233, 190, 106, 119, 121, 31,
+			106, 119, 249, 149, 238, 2, 204, 155, 29, 189, 1, 134, 59, 122,
+			225, 226, 83, 234, 184, 249, 154, 184, 111, 101, 225, 184, 43, 23,
+			111, 169, 133, 201, 75, 220, 168, 171, 19, 161, 167, 135, 192, 196,
+			39, 113, 113, 246, 115, 51, 106, 138, 152, 223, 250, 174, 80, 115,
+			149, 21, 154, 94, 57, 234, 202, 142, 190, 34, 120, 233, 168, 143,
+			243, 151, 126, 70, 168, 25, 59, 43, 232, 243, 135, 40, 172, 156,
+			74, 76, 69, 150, 143, 244, 44, 215, 226, 203, 66, 61, 54, 225,
+			27, 245, 165, 67, 20, 185, 143, 103, 53, 245, 186, 252, 112, 133,
+			112, 5, 63, 39, 212, 35, 215, 178, 225, 165, 44, 45, 192, 132,
+			43, 244, 202, 81, 221, 221, 225, 187, 109, 31, 111, 169, 63, 43,
+			212, 236, 181, 108, 120, 57, 78, 147, 184, 255, 127, 93, 153, 47,
+			8, 53, 95, 243, 36, 250, 147, 71, 247, 65, 166, 74, 159, 58,
+			122, 1, 92, 169, 47, 9, 117, 172, 57, 190, 245, 203, 135, 46,
+			182, 234, 28, 76, 213, 46, 61, 84, 25, 166, 118, 135, 139, 81,
+			125, 240, 98, 25, 155, 106, 255, 255, 138, 77, 153, 216, 147, 110,
+			77, 171, 223, 54, 212, 12, 127, 161, 53, 29, 124, 13, 196, 140,
+			73, 103, 134, 138, 68, 46, 248, 50, 202, 194, 173, 120, 180, 185,
+			83, 170, 74, 243, 237, 116, 44, 99, 34, 184, 225, 141, 108, 108,
+			242, 77, 58, 116, 80, 83, 74, 3, 32, 136, 141, 138, 144, 134,
+			173, 168, 147, 69, 103, 160, 151, 178, 186, 233, 113, 223, 158, 117,
+			21, 189, 146, 156, 225, 7, 158, 10, 78, 134, 23, 195, 221, 4,
+			170, 92, 91, 37, 206, 220, 222, 236, 42, 220, 83, 234, 34, 229,
+			203, 241, 63, 218, 154, 14, 158, 175, 127, 168, 233, 127, 75, 32,
+			201, 237, 239, 81, 86, 189, 109, 233, 20, 146, 235, 75, 41, 192,
+			128, 121, 218, 155, 165, 16, 178, 64, 136, 89, 251, 161, 156, 87,
+			111, 240, 79, 161, 253, 143, 201, 185, 224, 165, 26, 85, 151, 235,
+			51, 1, 18, 160, 211, 208, 2, 84, 7, 138, 135, 91, 156, 113,
+			79, 169, 219, 92, 156, 167, 253, 83, 114, 54, 184, 137, 52, 82,
+			149, 44, 59, 22, 132, 63, 1, 90, 117, 111, 50, 136, 190, 100,
+			228, 78, 102, 85, 3, 73, 134, 83, 94, 62, 39, 92, 167, 102,
+			157, 227, 36, 58, 254, 15, 122, 74, 253, 4, 165, 167, 241, 159,
+			109, 77, 7, 63, 26, 54, 230, 21, 139, 251, 143, 152, 224, 91,
+			6, 242, 29, 204, 196, 66, 44, 135, 113, 142, 180, 52, 232, 191,
+			81, 54, 48, 26, 23, 61, 165, 222, 245, 108, 10, 150, 79, 120,
+			51, 193, 119, 5, 14, 139, 109, 190, 167, 78, 45, 177, 22, 157,
+			104, 246, 227, 174, 13, 105, 198, 220, 71, 52, 238, 75, 109, 13,
+			58, 78, 127, 237, 114, 71, 149, 116, 108, 39, 137, 135, 151, 2,
+			194, 100, 19, 72, 173, 166, 46, 55, 66, 227, 187, 138, 49, 73,
+			193, 110, 141, 119, 43, 60, 200, 140, 16, 34, 91, 99, 116, 78,
+			243, 137, 82, 70, 125, 163, 30, 164, 216, 96, 12, 232, 96, 16,
+			247, 147, 104, 4, 178, 68, 30, 143, 198, 57, 52, 70, 43, 175,
+			233, 41, 245, 53, 208, 126, 9, 92, 127, 193, 11, 130, 95, 18,
+			78, 111, 214, 157, 151, 90, 56, 12, 89, 79, 249, 26, 216, 107,
+			17, 151, 92, 156, 164, 168, 128, 214, 59, 213, 102, 226, 58, 225,
+			242, 217, 112, 39, 27, 231, 69, 227, 33, 64, 44, 15, 122, 194,
+			42, 138, 244, 148, 58, 73, 53, 245, 128, 222, 86, 65, 64, 6,
+			94, 239, 112, 156, 26, 3, 241, 254, 55, 72, 110, 228, 107, 255,
+			245, 214, 116, 240, 45, 47, 180, 123, 210, 18, 199, 128, 87, 21,
+			227, 193, 0, 114, 41, 24, 238, 120, 14, 93, 228, 168, 251, 171,
+			67, 52, 212, 16, 154, 78, 204, 213, 110, 180, 124, 29, 213, 185,
+			209, 12, 46, 108, 80, 203, 149, 76, 105, 0, 60, 141, 218, 141,
+			61, 238, 103, 209, 62, 84, 123, 16, 221, 2, 4, 139, 234, 68,
+			6, 208, 133, 22, 106, 72, 121, 149, 80, 43, 170, 65, 249, 21,
+			140, 153, 74, 187, 104, 54, 59, 0, 233, 0, 124, 51, 27, 12,
+			65, 250, 143, 138, 10, 73, 125, 201, 30, 202, 187, 48, 190, 205,
+			106, 112, 182, 203, 227, 103, 80, 108, 15, 161, 76, 24, 229, 121,
+			116, 167, 83, 85, 88, 81, 97, 228, 174, 154, 148, 75, 192, 179,
+			174, 115, 58, 29, 127, 213, 155, 14, 214, 42, 116, 11, 99, 54,
+			214, 240, 185, 106, 22, 243, 201, 158, 140, 37, 101, 240, 31, 14,
+			132, 116, 183, 198, 187, 170, 28, 187, 61, 165, 254, 218, 231, 4,
+			53, 254, 13, 111, 58, 248, 115, 191, 9, 230, 176, 56, 54, 242,
+			95, 117, 22, 217, 1, 85, 176, 40, 212, 104, 196, 45, 61, 226,
+			190, 101, 236, 5, 191, 59, 92, 98, 193, 27, 107, 17, 236, 179,
+			12, 229, 173, 1, 30, 233, 168, 166, 63, 5, 238, 9, 114, 10,
+			69, 60, 58, 53, 129, 64, 233, 39, 6, 28, 107, 109, 101, 183,
+			198, 82, 83, 174, 250, 207, 177, 10, 84, 178, 85, 165, 195, 85,
+			96, 34, 37, 106, 62, 178, 130, 58, 37, 199, 168, 52, 74, 126,
+			158, 160, 177, 182, 14, 150, 203, 130, 147, 27, 55, 135, 86, 6,
+			17, 79, 132, 120, 70, 133, 107, 63, 114, 169, 227, 28, 251, 213,
+			147, 163, 157, 178, 143, 224, 243, 108, 153, 63, 86, 109, 126, 55,
+			231, 94, 237, 158, 57, 57, 218, 81, 21, 181, 0, 91, 69, 248,
+			121, 156, 168, 249, 63, 238, 181, 213, 39, 84, 91, 250, 56, 155,
+			209, 254, 79, 74, 77, 76, 71, 234, 15, 7, 58, 169, 42, 31,
+			243, 27, 123, 74, 125, 138, 31, 19, 218, 223, 144, 115, 193, 25,
+			247, 152, 137, 58, 197, 110, 237, 1, 200, 185, 197, 192, 150, 128,
+			157, 158, 82, 239, 121, 92, 132, 167, 253, 109, 57, 31, 252, 55,
+			83, 239, 86, 135, 188, 120, 169, 188, 24, 19, 36, 12, 14, 74,
+			134, 78, 26, 218, 162, 6, 203, 198, 221, 195, 184, 203, 99, 43,
+			10, 181, 20, 21, 21, 165, 192, 144, 214, 26, 233, 118, 3, 15,
+			110, 93, 32, 206, 153, 50, 86, 116, 186, 73, 176, 107, 184, 12,
+			8, 30, 144, 130, 113, 5, 215, 127, 51, 14, 7, 89, 154, 64,
+			225, 194, 40, 254, 218, 233, 42, 206, 195, 34, 233, 199, 100, 119,
+			5, 192, 85, 209, 40, 140, 119, 227, 183, 169, 25, 115, 156, 123,
+			115, 71, 145, 82, 197, 45, 204, 9, 209, 46, 100, 157, 140, 62,
+			227, 28, 39, 85, 242, 19, 239, 81, 238, 35, 100, 103, 242, 218,
+			170, 71, 45, 69, 83, 243, 64, 206, 241, 233, 88, 57, 100, 246,
+			154, 83, 202, 13, 190, 95, 104, 127, 40, 31, 53, 12, 158, 60,
+			38, 130, 97, 148, 150, 171, 140, 238, 56, 221, 220, 133, 228, 53,
+			229, 190, 96, 164, 35, 105, 8, 59, 127, 204, 24, 30, 126, 83,
+			79, 169, 155, 92, 52, 132, 227, 228, 49, 206, 64, 129, 46, 139,
+			146, 221, 184, 239, 116, 69, 156, 102, 98, 9, 30, 182, 222, 103,
+			2, 238, 203, 131, 89, 133, 76, 226, 80, 107, 252, 14, 95, 251,
+			123, 242, 177, 224, 210, 209, 223, 193, 220, 155, 158, 82, 111, 114,
+			153, 18, 216, 212, 39, 131, 203, 7, 151, 73, 217, 9, 115, 99,
+			8, 165, 114, 40, 113, 109, 45, 199, 149, 220, 80, 79, 169, 152,
+			11, 157, 130, 106, 222, 241, 224, 173, 131, 11, 101, 56, 149, 41,
+			51, 205, 210, 46, 21, 17, 165, 78, 202, 172, 78, 147, 80, 212,
+			87, 180, 130, 235, 41, 245, 135, 130, 223, 211, 214, 242, 51, 66,
+			30, 15, 126, 83, 28, 252, 38, 58, 23, 174, 240, 50, 86, 152,
+			36, 15, 56, 50, 129, 173, 156, 70, 184, 125, 181, 34, 112, 101,
+			73, 209, 136, 70, 35, 80, 197, 169, 1, 0, 191, 206, 114, 235,
+			201, 86, 135, 206, 135, 114, 254, 36, 120, 26, 181, 63, 165, 199,
+			32, 191, 122, 74, 157, 134, 41, 67, 34, 227, 231, 133, 167, 130,
+			239, 15, 77, 28, 158, 253, 90, 195, 188, 126, 134, 238, 149, 90,
+			126, 86, 120, 51, 193, 237, 240, 202, 59, 163, 60, 162, 101, 3,
+			160, 95, 120, 100, 195, 156, 41, 108, 96, 186, 141, 211, 109, 146,
+			134, 28, 197, 239, 140, 122, 142, 51, 72, 107, 105, 243, 149, 229,
+			120, 133, 6, 220, 22, 45, 110, 82, 48, 51, 224, 187, 81, 26,
+			135, 240, 13, 13, 8, 171, 162, 187, 102, 226, 155, 210, 242, 243,
+			194, 155, 14, 254, 203, 51, 194, 240, 7, 65, 214, 28, 188, 141,
+			149, 9, 15, 60, 227, 176, 8, 56, 59, 47, 218, 27, 157, 169,
+			50, 64, 47, 92, 170, 2, 244, 120, 71, 98, 33, 159, 155, 177,
+			197, 109, 170, 131, 129, 155, 184, 208, 4, 111, 154, 185, 240, 128,
+			143, 112, 249, 150, 208, 40, 174, 179, 77, 218, 170, 52, 99, 58,
+			1, 220, 57, 7, 228, 243, 120, 171, 106, 20, 214, 249, 2, 59,
+			9, 82, 143, 155, 44, 170, 183, 223, 171, 93, 12, 167, 36, 42,
+			38, 110, 34, 192, 2, 210, 182, 201, 47, 138, 214, 180, 250, 186,
+			205, 196, 38, 127, 25, 134, 244, 21, 166, 4, 211, 254, 180, 160,
+			158, 221, 180, 121, 112, 112, 238, 209, 161, 133, 192, 16, 44, 193,
+			161, 157, 140, 236, 169, 70, 115, 25, 95, 194, 28, 175, 241, 126,
+			1, 139, 50, 172, 195, 153, 61, 67, 79, 59, 211, 115, 84, 79,
+			171, 18, 197, 216, 227, 44, 199, 34, 5, 196, 128, 126, 60, 194,
+			230, 54, 141, 29, 16, 146, 23, 214, 147, 206, 26, 61, 3, 234,
+			74, 222, 88, 34, 27, 63, 193, 43, 52, 103, 246, 157, 240, 234,
+			234, 213, 43, 118, 69, 231, 134, 78, 193, 178, 215, 148, 3, 18,
+			188, 33, 128, 36, 190, 41, 56, 147, 154, 252, 21, 225, 157, 8,
+			254, 180, 20, 20, 106, 238, 126, 120, 195, 187, 23, 57, 69, 69,
+			51, 203, 230, 150, 254, 54, 161, 201, 80, 153, 65, 49, 142, 74,
+			200, 73, 137, 212, 176, 179, 157, 19, 76, 220, 138, 146, 124, 247,
+			14, 25, 231, 82, 84, 20, 227, 1, 187, 109, 85, 246, 75, 181,
+			149, 6, 81, 31, 243, 176, 81, 181, 143, 182, 179, 83, 108, 15,
+			83, 90, 254, 26, 236, 225, 53, 37, 229, 20, 0, 44, 191, 33,
+			188, 99, 193, 50, 218, 49, 233, 247, 248, 132, 102, 253, 86, 146,
+			246, 195, 103, 86, 194, 55, 72, 248, 179, 83, 185, 228, 188, 66,
+			178, 157, 98, 14, 39, 32, 11, 138, 18, 90, 190, 47, 188, 239,
+			35, 163, 107, 107, 249, 91, 120, 201, 207, 162, 13, 219, 120, 11,
+			84, 233, 130, 81, 184, 70, 187, 56, 86, 30, 203, 6, 195, 49,
+			140, 120, 39, 42, 118, 156, 12, 63, 72, 168, 38, 134, 208, 103,
+			244, 56, 238, 77, 210, 225, 184, 154, 225, 24, 92, 114, 147, 163,
+			128, 51, 71, 51, 94, 167, 91, 114, 229, 205, 173, 167, 148, 250,
+			7, 132, 105, 166, 181, 252, 3, 209, 154, 14, 190, 45, 194, 201,
+			112, 225, 1, 81, 26, 30, 155, 149, 106, 192, 19, 2, 48, 4,
+			246, 20, 242, 30, 140, 7, 216, 63, 163, 3, 211, 132, 41, 214,
+			224, 133, 135, 230, 48, 234, 193, 162, 57, 136, 190, 220, 59, 154,
+			83, 145, 218, 130, 36, 64, 76, 51, 149, 187, 139, 79, 56, 148,
+			250, 18, 26, 123, 26, 141, 253, 199, 194, 11, 130, 187, 198, 96,
+			77, 85, 96, 133, 209, 246, 118, 30, 111, 219, 24, 192, 152, 182,
+			111, 32, 62, 118, 192, 78, 163, 47, 59, 251, 241, 202, 254, 150,
+			90, 37, 7, 57, 9, 49, 52, 162, 11, 70, 44, 194, 29, 66,
+			73, 21, 134, 107, 10, 71, 46, 154, 116, 219, 50, 68, 162, 148,
+			10, 129, 180, 37, 85, 29, 63, 122, 74, 253, 190, 169, 157, 208,
+			242, 207, 48, 53, 188, 47, 106, 121, 90, 202, 72, 136, 109, 23,
+			60, 158, 147, 181, 160, 54, 182, 195, 92, 68, 34, 206, 17, 171,
+			59, 9, 54, 67, 76, 252, 67, 222, 185, 135, 141, 13, 144, 195,
+			130, 119, 194, 130, 65, 247, 46, 66, 101, 147, 69, 132, 91, 241,
+			94, 156, 187, 55, 247, 28, 77, 12, 59, 126, 80, 22, 174, 41,
+			79, 206, 104, 249, 77, 24, 208, 171, 117, 251, 57, 56, 248, 85,
+			141, 173, 47, 157, 162, 201, 197, 133, 183, 151, 78, 241, 176, 153,
+			241, 91, 90, 126, 75, 120, 96, 145, 183, 229, 12, 197, 195, 228,
+			95, 10, 57, 175, 94, 231, 223, 66, 203, 191, 18, 114, 54, 184,
+			208, 16, 167, 39, 43, 172, 37, 41, 230, 250, 91, 43, 28, 185,
+			206, 239, 41, 117, 78, 73, 57, 131, 178, 191, 13, 231, 255, 177,
+			240, 226, 61, 76, 219, 228, 245, 233, 41, 245, 13, 196, 33, 102,
+			181, 252, 91, 124, 247, 87, 189, 176, 17, 149, 190, 215, 208, 201,
+			227, 205, 216, 233, 169, 23, 181, 126, 165, 197, 160, 226, 60, 28,
+			12, 151, 190, 215, 64, 177, 7, 78, 247, 15, 123, 82, 239, 90,
+			18, 8, 157, 152, 244, 194, 181, 125, 106, 0, 115, 49, 166, 133,
+			209, 11, 82, 88, 178, 5, 21, 14, 147, 200, 217, 190, 95, 61,
+			232, 72, 77, 224, 176, 162, 221, 65, 193, 125, 58, 139, 118, 254,
+			59, 225, 97, 217, 140, 95, 66, 203, 127, 132, 201, 95, 57, 192,
+			226, 109, 35, 57, 131, 47, 7, 36, 18, 215, 52, 132, 103, 213,
+			43, 202, 147, 74, 203, 127, 70, 167, 44, 55, 251, 228, 96, 115,
+			172, 221, 232, 236, 79, 193, 254, 254, 5, 246, 183, 162, 218, 82,
+			25, 251, 251, 87, 33, 127, 32, 232, 154, 165, 27, 43, 176, 195,
+			231, 99, 86, 73, 179, 81, 178, 201, 1, 152, 212, 77, 129, 68,
+			95, 51, 143, 11, 45, 255, 13, 230, 123, 157, 139, 243, 180, 252,
+			15, 152, 239, 149, 125, 205, 215, 176, 97, 138, 4, 187, 141, 218,
+			186, 206, 108, 159, 237, 68, 205, 61, 208, 83, 234, 37, 46, 214,
+			215, 242, 63, 81, 108, 143, 138, 229, 21, 79, 227, 246, 74, 164,
+			38, 183, 25, 10, 48, 14, 20, 190, 241, 3, 51, 14, 172, 121,
+			212, 135, 1, 91, 175, 29, 7, 127, 132, 9, 100, 78, 203, 159,
+			243, 90, 211, 193, 55, 68, 56, 121, 4, 178, 255, 80, 128, 103,
+			47, 181, 1, 153, 83, 131, 101, 9, 37, 88, 116, 1, 229, 29,
+			211, 121, 112, 115, 171, 48, 70, 247, 4, 121, 88, 140, 150, 82,
+			128, 241, 129, 198, 69, 4, 97, 127, 107, 224, 61, 165, 222, 80,
+			158, 124, 68, 203, 95, 64, 245, 47, 238, 83, 251, 251, 25, 77,
+			121, 175, 179, 155, 71, 208, 134, 239, 122, 30, 122, 196, 107, 183,
+			180, 188, 139, 194, 207, 178, 20, 184, 81, 227, 38, 73, 229, 8,
+			255, 72, 138, 17, 111, 182, 145, 161, 188, 182, 18, 82, 203, 74,
+			182, 201, 234, 222, 243, 188, 169, 160, 91, 61, 51, 224, 111, 48,
+			213, 42, 143, 50, 236, 229, 158, 82, 111, 209, 195, 66, 203, 207,
+			227, 225, 87, 157, 65, 114, 248, 197, 60, 25, 189, 29, 37, 187,
+			136, 125, 84, 242, 154, 185, 254, 182, 97, 243, 202, 98, 144, 246,
+			187, 40, 215, 211, 242, 139, 40, 247, 74, 56, 113, 138, 26, 38,
+			233, 118, 92, 240, 124, 68, 59, 205, 176, 113, 185, 145, 149, 205,
+			152, 17, 74, 5, 169, 13, 165, 126, 93, 212, 166, 8, 247, 177,
+			117, 71, 89, 46, 51, 26, 78, 26, 95, 128, 36, 23, 213, 197,
+			6, 225, 73, 208, 143, 121, 140, 213, 167, 245, 110, 55, 239, 216,
+			68, 53, 206, 12, 157, 228, 104, 24, 167, 104, 154, 126, 184, 84,
+			196, 177, 10, 55, 186, 212, 59, 93, 174, 68, 146, 110, 111, 144,
+			62, 60, 250, 253, 203, 130, 90, 27, 100, 32, 212, 255, 23, 169,
+			254, 230, 184, 118, 159, 202, 215, 6, 20, 87, 153, 62, 200, 68,
+			29, 92, 206, 117, 101, 23, 79, 236, 205, 251, 249, 157, 110, 62,
+			78, 195, 65, 214, 143, 169, 82, 174, 78, 124, 133, 43, 180, 207,
+			98, 235, 26, 213, 111, 74, 203, 95, 69, 253, 126, 168, 238, 242,
+			92, 21, 239, 55, 53, 53, 103, 166, 53, 42, 181, 173, 229, 175,
+			163, 212, 203, 19, 99, 194, 21, 124, 224, 72, 159, 24, 232, 14,
+			53, 253, 247, 79, 170, 79, 62, 240, 161, 233, 179, 198, 168, 109,
+			223, 20, 31, 62, 122, 250, 40, 168, 228, 163, 192, 159, 23, 191,
+			35, 212, 227, 215, 233, 107, 236, 96, 126, 13, 11, 124, 64, 66,
+			217, 127, 174, 91, 10, 211, 236, 154, 197, 183, 130, 83, 113, 81,
+			61, 90, 185, 225, 129, 240, 172, 107, 243, 229, 243, 0, 177, 62,
+			173, 230, 176, 225, 88, 47, 54, 119, 226, 65, 76, 56, 209, 249,
+			53, 133, 127, 189, 73, 255, 209, 90, 73, 252, 34, 220, 205, 236,
+			26, 253, 173, 95, 54, 200, 215, 41, 2, 206, 60, 119, 152, 227,
+			114, 104, 236, 25, 240, 235, 187, 158, 154, 34, 201, 61, 124, 38,
+			119, 34, 246, 247, 246, 51, 249, 95, 70, 78, 187, 170, 214, 237,
+			29, 93, 173, 123, 141, 149, 48, 81, 232, 255, 162, 136, 229, 19,
+			53, 17, 203, 251, 74, 85, 30, 14, 43, 240, 187, 199, 12, 159,
+			101, 229, 123, 128, 207, 178, 84, 42, 63, 94, 168, 42, 123, 27,
+			17, 200, 185, 146, 229, 50, 231, 88, 46, 243, 173, 179, 234, 43,
+			88, 137, 183, 180, 124, 178, 245, 113, 17, 124, 193, 11, 247, 25,
+			59, 118, 250, 118, 7, 115, 150, 206, 252, 74, 230, 178, 247, 149,
+			210, 91, 89, 152, 164, 111, 103, 56, 183, 107, 58, 149, 221, 113,
+			132, 169, 228, 205, 56, 118, 179, 169, 93, 223, 96, 61, 82, 57,
+			168, 229, 67, 194, 242, 31, 24, 142, 28, 222, 41, 80, 198, 91,
+			134, 106, 129, 149, 50, 107, 224, 84, 246, 105, 44, 47, 149, 20,
+			46, 252, 10, 53, 73, 27, 35, 102, 125, 176, 129, 57, 56, 120,
+			37, 83, 245, 44, 148, 81, 191, 118, 10, 89, 225, 230, 60, 57,
+			115, 2, 75, 10, 115, 36, 179, 224, 61, 21, 244, 236, 138, 12,
+			30, 197, 134, 187, 242, 36, 218, 165, 180, 93, 252, 105, 150, 179,
+			85, 99, 229, 44, 212, 88, 57, 11, 179, 86, 25, 17, 172, 156,
+			133, 227, 11, 170, 199, 164, 27, 63, 240, 158, 13, 158, 161, 162,
+			18, 68, 190, 147, 173, 230, 43, 205, 57, 51, 51, 107, 218, 218,
+			15, 188, 192, 254, 18, 218, 15, 78, 156, 182, 191, 124, 237, 7,
+			221, 158, 250, 244, 148, 149, 16, 59, 237, 61, 21, 124, 71, 58,
+			163, 51, 62, 203, 173, 133, 236, 140, 21, 237, 110, 103, 121, 50,
+			218, 25, 80, 124, 204, 101, 214, 112, 114, 56, 46, 110, 98, 243,
+			88, 209, 89, 86, 184, 241, 220, 70, 165, 40, 178, 229, 50, 194,
+			130, 146, 220, 226, 136, 119, 152, 22, 2, 112, 201, 49, 227, 97,
+			96, 171, 87, 95, 191, 17, 102, 195, 98, 189, 226, 86, 109, 178,
+			43, 52, 71, 17, 110, 156, 225, 36, 104, 29, 59, 62, 182, 198,
+			163, 113, 30, 119, 40, 211, 86, 136, 231, 248, 211, 56, 195, 45,
+			182, 5, 101, 94, 166, 123, 80, 179, 172, 232, 246, 40, 143, 210,
+			2, 57, 201, 59, 225, 94, 28, 246, 205, 218, 203, 40, 241, 36,
+			233, 246, 121, 18, 238, 42, 215, 230, 155, 25, 222, 148, 164, 198,
+			186, 242, 248, 118, 175, 89, 253, 103, 86, 194, 34, 222, 221, 106,
+			254, 191, 99, 215, 213, 28, 135, 160, 64, 191, 91, 48, 155, 3,
+			51, 251, 72, 56, 136, 70, 155, 59, 113, 209, 60, 48, 3, 239,
+			189, 212, 145, 54, 33, 88, 86, 19, 167, 218, 85, 214, 78, 20,
+			218, 229, 127, 175, 39, 125, 138, 202, 91, 9, 3, 172, 221, 40,
+			178, 79, 185, 232, 92, 80, 46, 45, 147, 98, 25, 135, 199, 171,
+			145, 52, 92, 253, 225, 114, 105, 15, 177, 57, 12, 253, 237, 60,
+			226, 164, 179, 113, 88, 249, 76, 103, 172, 222, 20, 76, 208, 14,
+			3, 216, 250, 105, 55, 12, 32, 242, 125, 250, 248, 130, 250, 11,
+			193, 90, 115, 254, 25, 79, 7, 127, 2, 28, 20, 138, 170, 42,
+			160, 223, 188, 211, 244, 68, 217, 86, 189, 135, 216, 107, 132, 102,
+			69, 107, 227, 191, 8, 133, 111, 134, 123, 209, 157, 70, 60, 53,
+			143, 111, 87, 123, 133, 53, 166, 178, 97, 97, 213, 12, 225, 217,
+			17, 198, 117, 199, 191, 147, 150, 99, 16, 60, 241, 59, 67, 98,
+			216, 96, 188, 80, 127, 185, 79, 247, 107, 9, 63, 125, 74, 248,
+			89, 21, 58, 58, 115, 236, 49, 230, 177, 74, 237, 159, 243, 44,
+			59, 85, 210, 47, 91, 8, 196, 190, 207, 57, 30, 43, 240, 83,
+			231, 30, 179, 237, 7, 177, 239, 115, 199, 23, 40, 94, 42, 180,
+			124, 190, 181, 34, 200, 139, 129, 136, 247, 252, 204, 124, 137, 39,
+			122, 129, 11, 23, 228, 149, 172, 118, 184, 17, 38, 122, 129, 187,
+			67, 16, 45, 246, 133, 227, 11, 234, 60, 61, 38, 180, 255, 162,
+			119, 34, 232, 242, 50, 118, 61, 161, 128, 251, 79, 45, 46, 118,
+			194, 197, 197, 159, 102, 221, 168, 197, 60, 30, 224, 20, 209, 220,
+			179, 168, 184, 36, 209, 198, 195, 115, 246, 23, 138, 98, 1, 94,
+			65, 30, 234, 197, 167, 2, 174, 156, 167, 253, 243, 94, 200, 151,
+			32, 8, 127, 222, 123, 212, 254, 18, 218, 63, 127, 236, 132, 253,
+			229, 107, 255, 252, 71, 159, 230, 199, 124, 237, 47, 123, 154, 47,
+			161, 157, 151, 89, 80, 74, 16, 85, 122, 153, 5, 165, 4, 181,
+			243, 50, 183, 179, 240, 164, 246, 47, 120, 31, 225, 75, 114, 10,
+			191, 108, 83, 160, 101, 47, 112, 238, 104, 65, 216, 153, 11, 143,
+			63, 113, 179, 61, 204, 179, 81, 118, 238, 127, 6, 0, 240, 220,
+			144, 55, 91, 48, 2, 0},
 	)
 }
 
@@ -618,6 +618,325 @@ func (x *WritePolicyConfigResponse) GetVersion() string {
 	return ""
 }
 
+// TopAccountsRequest is a request to fetch accounts with the most units
+// consumed or denied within a window.
+//
+// You must have the `quota.accounts.list` permission in the realm of an
+// account to see it in the response.
+type TopAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The window to aggregate the usage over, at most 24 hours.
+	//
+	// It is rounded up to a minute or, for windows longer than an hour, to an
+	// hour.
+	Window *durationpb.Duration `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// The maximum number of accounts to return.
+	//
+	// Accounts the caller can't see are omitted after applying the limit, so the
+	// response may have fewer accounts. Default is 20.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *TopAccountsRequest) Reset() {
+	*x = TopAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopAccountsRequest) ProtoMessage() {}
+
+func (x *TopAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopAccountsRequest.ProtoReflect.Descriptor instead.
+func (*TopAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TopAccountsRequest) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TopAccountsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TopAccountsResponse is the response to TopConsumers() and TopDenied().
+type TopAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Accounts with the most units first.
+	Accounts []*TopAccountsResponse_AccountUsage `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *TopAccountsResponse) Reset() {
+	*x = TopAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopAccountsResponse) ProtoMessage() {}
+
+func (x *TopAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopAccountsResponse.ProtoReflect.Descriptor instead.
+func (*TopAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TopAccountsResponse) GetAccounts() []*TopAccountsResponse_AccountUsage {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// RefillHistoryRequest is a request to fetch recent refills of accounts which
+// use a policy.
+//
+// You must have the `quota.policies.read` permission in the realm of the
+// policy config. Refills of accounts are returned only if you also have the
+// `quota.accounts.list` permission in their realms.
+type RefillHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PolicyID `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The maximum number of refills to return, at most 100 (the default).
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RefillHistoryRequest) Reset() {
+	*x = RefillHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefillHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillHistoryRequest) ProtoMessage() {}
+
+func (x *RefillHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RefillHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefillHistoryRequest) GetPolicy() *PolicyID {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *RefillHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RefillHistoryResponse is the response to RefillHistory().
+type RefillHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Refills with the most recent first.
+	Refills []*RefillHistoryResponse_Refill `protobuf:"bytes,1,rep,name=refills,proto3" json:"refills,omitempty"`
+}
+
+func (x *RefillHistoryResponse) Reset() {
+	*x = RefillHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefillHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillHistoryResponse) ProtoMessage() {}
+
+func (x *RefillHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RefillHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RefillHistoryResponse) GetRefills() []*RefillHistoryResponse_Refill {
+	if x != nil {
+		return x.Refills
+	}
+	return nil
+}
+
+// RefilledPoliciesRequest is a request to list policies with a non-empty
+// refill history.
+//
+// Only policies for which you have the `quota.policies.read` permission are
+// returned.
+type RefilledPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefilledPoliciesRequest) Reset() {
+	*x = RefilledPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefilledPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefilledPoliciesRequest) ProtoMessage() {}
+
+func (x *RefilledPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefilledPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*RefilledPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{11}
+}
+
+// RefilledPoliciesResponse is the response to RefilledPolicies().
+type RefilledPoliciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*PolicyID `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *RefilledPoliciesResponse) Reset() {
+	*x = RefilledPoliciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefilledPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefilledPoliciesResponse) ProtoMessage() {}
+
+func (x *RefilledPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefilledPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*RefilledPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefilledPoliciesResponse) GetPolicies() []*PolicyID {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
 type GetAccountsResponse_AccountState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -634,7 +953,7 @@ type GetAccountsResponse_AccountState struct {
 func (x *GetAccountsResponse_AccountState) Reset() {
 	*x = GetAccountsResponse_AccountState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -647,7 +966,7 @@ func (x *GetAccountsResponse_AccountState) String() string {
 func (*GetAccountsResponse_AccountState) ProtoMessage() {}
 
 func (x *GetAccountsResponse_AccountState) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,6 +1003,136 @@ func (x *GetAccountsResponse_AccountState) GetProjectedBalance() int64 {
 	return 0
 }
 
+type TopAccountsResponse_AccountUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountID `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The number of units used by the account within the window.
+	Units int64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+}
+
+func (x *TopAccountsResponse_AccountUsage) Reset() {
+	*x = TopAccountsResponse_AccountUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopAccountsResponse_AccountUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopAccountsResponse_AccountUsage) ProtoMessage() {}
+
+func (x *TopAccountsResponse_AccountUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopAccountsResponse_AccountUsage.ProtoReflect.Descriptor instead.
+func (*TopAccountsResponse_AccountUsage) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *TopAccountsResponse_AccountUsage) GetAccount() *AccountID {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TopAccountsResponse_AccountUsage) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+type RefillHistoryResponse_Refill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the refill was noticed by an ApplyOps.
+	Time    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Account *AccountID             `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The number of units added since the previous update of the account.
+	Units int64 `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	// The balance of the account after the refill.
+	Balance int64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *RefillHistoryResponse_Refill) Reset() {
+	*x = RefillHistoryResponse_Refill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefillHistoryResponse_Refill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillHistoryResponse_Refill) ProtoMessage() {}
+
+func (x *RefillHistoryResponse_Refill) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillHistoryResponse_Refill.ProtoReflect.Descriptor instead.
+func (*RefillHistoryResponse_Refill) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *RefillHistoryResponse_Refill) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RefillHistoryResponse_Refill) GetAccount() *AccountID {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *RefillHistoryResponse_Refill) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *RefillHistoryResponse_Refill) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
 var File_go_chromium_org_luci_server_quota_quotapb_service_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDesc = []byte{
@@ -817,38 +1266,136 @@ var file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDesc = []byt
 	0x66, 0x69, 0x67, 0x22, 0x35, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xbd, 0x03, 0x0a, 0x05, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x12, 0x54, 0x6f,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0xfa, 0x42, 0x0d,
+	0xaa, 0x01, 0x0a, 0x08, 0x01, 0x22, 0x04, 0x08, 0x80, 0xa3, 0x05, 0x2a, 0x00, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x13, 0x54, 0x6f, 0x70, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x4b, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x6f,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x74, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x8e,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa,
+	0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xb5, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x07, 0x72, 0x65, 0x66,
+	0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x6f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x66,
+	0x69, 0x6c, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x1a, 0xb8, 0x01, 0x0a,
+	0x06, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x6b, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x49, 0x44, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x32,
+	0x8d, 0x08, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4f, 0x70, 0x73, 0x12, 0x3a, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3b, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x4f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9e,
+	0x01, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
 	0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
-	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x73,
-	0x12, 0x3a, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8d, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73,
+	0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f,
 	0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71,
-	0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x4f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x67,
+	0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3e, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x70, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8a, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x12, 0x3d, 0x2e,
+	0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67,
 	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
 	0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61,
-	0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x43, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x70, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a,
+	0x0d, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3f,
+	0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6c,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x40, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
 	0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75,
-	0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
-	0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62,
-	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
-	0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2f,
-	0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x69,
+	0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x9b, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x42, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x67, 0x6f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x75, 0x63,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f,
+	0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -864,7 +1411,7 @@ func file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDescGZIP()
 }
 
 var file_go_chromium_org_luci_server_quota_quotapb_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_go_chromium_org_luci_server_quota_quotapb_service_proto_goTypes = []interface{}{
 	(OpResult_AccountStatus)(0),              // 0: go.chromium.org.luci.server.quota.quotapb.OpResult.AccountStatus
 	(OpResult_OpStatus)(0),                   // 1: go.chromium.org.luci.server.quota.quotapb.OpResult.OpStatus
@@ -875,39 +1422,64 @@ var file_go_chromium_org_luci_server_quota_quotapb_service_proto_goTypes = []int
 	(*ApplyOpsResponse)(nil),                 // 6: go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse
 	(*WritePolicyConfigRequest)(nil),         // 7: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest
 	(*WritePolicyConfigResponse)(nil),        // 8: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigResponse
-	(*GetAccountsResponse_AccountState)(nil), // 9: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState
-	(*AccountID)(nil),                        // 10: go.chromium.org.luci.server.quota.quotapb.AccountID
-	(*durationpb.Duration)(nil),              // 11: google.protobuf.Duration
-	(*Op)(nil),                               // 12: go.chromium.org.luci.server.quota.quotapb.Op
-	(*timestamppb.Timestamp)(nil),            // 13: google.protobuf.Timestamp
-	(*PolicyConfigID)(nil),                   // 14: go.chromium.org.luci.server.quota.quotapb.PolicyConfigID
-	(*PolicyConfig)(nil),                     // 15: go.chromium.org.luci.server.quota.quotapb.PolicyConfig
-	(*Account)(nil),                          // 16: go.chromium.org.luci.server.quota.quotapb.Account
+	(*TopAccountsRequest)(nil),               // 9: go.chromium.org.luci.server.quota.quotapb.TopAccountsRequest
+	(*TopAccountsResponse)(nil),              // 10: go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse
+	(*RefillHistoryRequest)(nil),             // 11: go.chromium.org.luci.server.quota.quotapb.RefillHistoryRequest
+	(*RefillHistoryResponse)(nil),            // 12: go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse
+	(*RefilledPoliciesRequest)(nil),          // 13: go.chromium.org.luci.server.quota.quotapb.RefilledPoliciesRequest
+	(*RefilledPoliciesResponse)(nil),         // 14: go.chromium.org.luci.server.quota.quotapb.RefilledPoliciesResponse
+	(*GetAccountsResponse_AccountState)(nil), // 15: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState
+	(*TopAccountsResponse_AccountUsage)(nil), // 16: go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse.AccountUsage
+	(*RefillHistoryResponse_Refill)(nil),     // 17: go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse.Refill
+	(*AccountID)(nil),                        // 18: go.chromium.org.luci.server.quota.quotapb.AccountID
+	(*durationpb.Duration)(nil),              // 19: google.protobuf.Duration
+	(*Op)(nil),                               // 20: go.chromium.org.luci.server.quota.quotapb.Op
+	(*timestamppb.Timestamp)(nil),            // 21: google.protobuf.Timestamp
+	(*PolicyConfigID)(nil),                   // 22: go.chromium.org.luci.server.quota.quotapb.PolicyConfigID
+	(*PolicyConfig)(nil),                     // 23: go.chromium.org.luci.server.quota.quotapb.PolicyConfig
+	(*PolicyID)(nil),                         // 24: go.chromium.org.luci.server.quota.quotapb.PolicyID
+	(*Account)(nil),                          // 25: go.chromium.org.luci.server.quota.quotapb.Account
 }
 var file_go_chromium_org_luci_server_quota_quotapb_service_proto_depIdxs = []int32{
-	10, // 0: go.chromium.org.luci.server.quota.quotapb.GetAccountsRequest.account:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
-	9,  // 1: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.accounts:type_name -> go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState
-	11, // 2: go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest.request_id_ttl:type_name -> google.protobuf.Duration
-	12, // 3: go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest.ops:type_name -> go.chromium.org.luci.server.quota.quotapb.Op
+	18, // 0: go.chromium.org.luci.server.quota.quotapb.GetAccountsRequest.account:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
+	15, // 1: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.accounts:type_name -> go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState
+	19, // 2: go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest.request_id_ttl:type_name -> google.protobuf.Duration
+	20, // 3: go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest.ops:type_name -> go.chromium.org.luci.server.quota.quotapb.Op
 	0,  // 4: go.chromium.org.luci.server.quota.quotapb.OpResult.account_status:type_name -> go.chromium.org.luci.server.quota.quotapb.OpResult.AccountStatus
 	1,  // 5: go.chromium.org.luci.server.quota.quotapb.OpResult.status:type_name -> go.chromium.org.luci.server.quota.quotapb.OpResult.OpStatus
 	5,  // 6: go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse.results:type_name -> go.chromium.org.luci.server.quota.quotapb.OpResult
-	13, // 7: go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse.originally_set:type_name -> google.protobuf.Timestamp
-	14, // 8: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest.id:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyConfigID
-	15, // 9: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest.policy_config:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyConfig
-	10, // 10: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState.id:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
-	16, // 11: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState.account:type_name -> go.chromium.org.luci.server.quota.quotapb.Account
-	2,  // 12: go.chromium.org.luci.server.quota.quotapb.Admin.GetAccounts:input_type -> go.chromium.org.luci.server.quota.quotapb.GetAccountsRequest
-	4,  // 13: go.chromium.org.luci.server.quota.quotapb.Admin.ApplyOps:input_type -> go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest
-	7,  // 14: go.chromium.org.luci.server.quota.quotapb.Admin.WritePolicyConfig:input_type -> go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest
-	3,  // 15: go.chromium.org.luci.server.quota.quotapb.Admin.GetAccounts:output_type -> go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse
-	6,  // 16: go.chromium.org.luci.server.quota.quotapb.Admin.ApplyOps:output_type -> go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse
-	8,  // 17: go.chromium.org.luci.server.quota.quotapb.Admin.WritePolicyConfig:output_type -> go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigResponse
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	21, // 7: go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse.originally_set:type_name -> google.protobuf.Timestamp
+	22, // 8: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest.id:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyConfigID
+	23, // 9: go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest.policy_config:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyConfig
+	19, // 10: go.chromium.org.luci.server.quota.quotapb.TopAccountsRequest.window:type_name -> google.protobuf.Duration
+	16, // 11: go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse.accounts:type_name -> go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse.AccountUsage
+	24, // 12: go.chromium.org.luci.server.quota.quotapb.RefillHistoryRequest.policy:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyID
+	17, // 13: go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse.refills:type_name -> go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse.Refill
+	24, // 14: go.chromium.org.luci.server.quota.quotapb.RefilledPoliciesResponse.policies:type_name -> go.chromium.org.luci.server.quota.quotapb.PolicyID
+	18, // 15: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState.id:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
+	25, // 16: go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse.AccountState.account:type_name -> go.chromium.org.luci.server.quota.quotapb.Account
+	18, // 17: go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse.AccountUsage.account:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
+	21, // 18: go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse.Refill.time:type_name -> google.protobuf.Timestamp
+	18, // 19: go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse.Refill.account:type_name -> go.chromium.org.luci.server.quota.quotapb.AccountID
+	2,  // 20: go.chromium.org.luci.server.quota.quotapb.Admin.GetAccounts:input_type -> go.chromium.org.luci.server.quota.quotapb.GetAccountsRequest
+	4,  // 21: go.chromium.org.luci.server.quota.quotapb.Admin.ApplyOps:input_type -> go.chromium.org.luci.server.quota.quotapb.ApplyOpsRequest
+	7,  // 22: go.chromium.org.luci.server.quota.quotapb.Admin.WritePolicyConfig:input_type -> go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigRequest
+	9,  // 23: go.chromium.org.luci.server.quota.quotapb.Admin.TopConsumers:input_type -> go.chromium.org.luci.server.quota.quotapb.TopAccountsRequest
+	9,  // 24: go.chromium.org.luci.server.quota.quotapb.Admin.TopDenied:input_type -> go.chromium.org.luci.server.quota.quotapb.TopAccountsRequest
+	11, // 25: go.chromium.org.luci.server.quota.quotapb.Admin.RefillHistory:input_type -> go.chromium.org.luci.server.quota.quotapb.RefillHistoryRequest
+	13, // 26: go.chromium.org.luci.server.quota.quotapb.Admin.RefilledPolicies:input_type -> go.chromium.org.luci.server.quota.quotapb.RefilledPoliciesRequest
+	3,  // 27: go.chromium.org.luci.server.quota.quotapb.Admin.GetAccounts:output_type -> go.chromium.org.luci.server.quota.quotapb.GetAccountsResponse
+	6,  // 28: go.chromium.org.luci.server.quota.quotapb.Admin.ApplyOps:output_type -> go.chromium.org.luci.server.quota.quotapb.ApplyOpsResponse
+	8,  // 29: go.chromium.org.luci.server.quota.quotapb.Admin.WritePolicyConfig:output_type -> go.chromium.org.luci.server.quota.quotapb.WritePolicyConfigResponse
+	10, // 30: go.chromium.org.luci.server.quota.quotapb.Admin.TopConsumers:output_type -> go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse
+	10, // 31: go.chromium.org.luci.server.quota.quotapb.Admin.TopDenied:output_type -> go.chromium.org.luci.server.quota.quotapb.TopAccountsResponse
+	12, // 32: go.chromium.org.luci.server.quota.quotapb.Admin.RefillHistory:output_type -> go.chromium.org.luci.server.quota.quotapb.RefillHistoryResponse
+	14, // 33: go.chromium.org.luci.server.quota.quotapb.Admin.RefilledPolicies:output_type -> go.chromium.org.luci.server.quota.quotapb.RefilledPoliciesResponse
+	27, // [27:34] is the sub-list for method output_type
+	20, // [20:27] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_server_quota_quotapb_service_proto_init() }
@@ -1005,6 +1577,78 @@ func file_go_chromium_org_luci_server_quota_quotapb_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefillHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefillHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefilledPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefilledPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAccountsResponse_AccountState); i {
 			case 0:
 				return &v.state
@@ -1016,6 +1660,30 @@ func file_go_chromium_org_luci_server_quota_quotapb_service_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopAccountsResponse_AccountUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_quota_quotapb_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefillHistoryResponse_Refill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1023,7 +1691,7 @@ func file_go_chromium_org_luci_server_quota_quotapb_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_server_quota_quotapb_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1056,6 +1724,19 @@ type AdminClient interface {
 	ApplyOps(ctx context.Context, in *ApplyOpsRequest, opts ...grpc.CallOption) (*ApplyOpsResponse, error)
 	// WritePolicyConfig ingests the given PolicyConfig into the database.
 	WritePolicyConfig(ctx context.Context, in *WritePolicyConfigRequest, opts ...grpc.CallOption) (*WritePolicyConfigResponse, error)
+	// TopConsumers returns accounts which consumed the most units within
+	// a window.
+	//
+	// Usage is recorded only by processes with accounting enabled (see
+	// `-quota-accounting` flag).
+	TopConsumers(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error)
+	// TopDenied returns accounts with the most units which would have been
+	// denied in the dry-run mode (see `-quota-dry-run` flag) within a window.
+	TopDenied(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error)
+	// RefillHistory returns recent refills of accounts which use a policy.
+	RefillHistory(ctx context.Context, in *RefillHistoryRequest, opts ...grpc.CallOption) (*RefillHistoryResponse, error)
+	// RefilledPolicies returns policies with a non-empty refill history.
+	RefilledPolicies(ctx context.Context, in *RefilledPoliciesRequest, opts ...grpc.CallOption) (*RefilledPoliciesResponse, error)
 }
 type adminPRPCClient struct {
 	client *prpc.Client
@@ -1092,6 +1773,42 @@ func (c *adminPRPCClient) WritePolicyConfig(ctx context.Context, in *WritePolicy
 	return out, nil
 }
 
+func (c *adminPRPCClient) TopConsumers(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error) {
+	out := new(TopAccountsResponse)
+	err := c.client.Call(ctx, "go.chromium.org.luci.server.quota.quotapb.Admin", "TopConsumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminPRPCClient) TopDenied(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error) {
+	out := new(TopAccountsResponse)
+	err := c.client.Call(ctx, "go.chromium.org.luci.server.quota.quotapb.Admin", "TopDenied", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminPRPCClient) RefillHistory(ctx context.Context, in *RefillHistoryRequest, opts ...grpc.CallOption) (*RefillHistoryResponse, error) {
+	out := new(RefillHistoryResponse)
+	err := c.client.Call(ctx, "go.chromium.org.luci.server.quota.quotapb.Admin", "RefillHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminPRPCClient) RefilledPolicies(ctx context.Context, in *RefilledPoliciesRequest, opts ...grpc.CallOption) (*RefilledPoliciesResponse, error) {
+	out := new(RefilledPoliciesResponse)
+	err := c.client.Call(ctx, "go.chromium.org.luci.server.quota.quotapb.Admin", "RefilledPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type adminClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -1127,6 +1844,42 @@ func (c *adminClient) WritePolicyConfig(ctx context.Context, in *WritePolicyConf
 	return out, nil
 }
 
+func (c *adminClient) TopConsumers(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error) {
+	out := new(TopAccountsResponse)
+	err := c.cc.Invoke(ctx, "/go.chromium.org.luci.server.quota.quotapb.Admin/TopConsumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) TopDenied(ctx context.Context, in *TopAccountsRequest, opts ...grpc.CallOption) (*TopAccountsResponse, error) {
+	out := new(TopAccountsResponse)
+	err := c.cc.Invoke(ctx, "/go.chromium.org.luci.server.quota.quotapb.Admin/TopDenied", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RefillHistory(ctx context.Context, in *RefillHistoryRequest, opts ...grpc.CallOption) (*RefillHistoryResponse, error) {
+	out := new(RefillHistoryResponse)
+	err := c.cc.Invoke(ctx, "/go.chromium.org.luci.server.quota.quotapb.Admin/RefillHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RefilledPolicies(ctx context.Context, in *RefilledPoliciesRequest, opts ...grpc.CallOption) (*RefilledPoliciesResponse, error) {
+	out := new(RefilledPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/go.chromium.org.luci.server.quota.quotapb.Admin/RefilledPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	// GetAccounts returns the indicated Accounts.
@@ -1135,6 +1888,19 @@ type AdminServer interface {
 	ApplyOps(context.Context, *ApplyOpsRequest) (*ApplyOpsResponse, error)
 	// WritePolicyConfig ingests the given PolicyConfig into the database.
 	WritePolicyConfig(context.Context, *WritePolicyConfigRequest) (*WritePolicyConfigResponse, error)
+	// TopConsumers returns accounts which consumed the most units within
+	// a window.
+	//
+	// Usage is recorded only by processes with accounting enabled (see
+	// `-quota-accounting` flag).
+	TopConsumers(context.Context, *TopAccountsRequest) (*TopAccountsResponse, error)
+	// TopDenied returns accounts with the most units which would have been
+	// denied in the dry-run mode (see `-quota-dry-run` flag) within a window.
+	TopDenied(context.Context, *TopAccountsRequest) (*TopAccountsResponse, error)
+	// RefillHistory returns recent refills of accounts which use a policy.
+	RefillHistory(context.Context, *RefillHistoryRequest) (*RefillHistoryResponse, error)
+	// RefilledPolicies returns policies with a non-empty refill history.
+	RefilledPolicies(context.Context, *RefilledPoliciesRequest) (*RefilledPoliciesResponse, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -1150,6 +1916,18 @@ func (*UnimplementedAdminServer) ApplyOps(context.Context, *ApplyOpsRequest) (*A
 func (*UnimplementedAdminServer) WritePolicyConfig(context.Context, *WritePolicyConfigRequest) (*WritePolicyConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WritePolicyConfig not implemented")
 }
+func (*UnimplementedAdminServer) TopConsumers(context.Context, *TopAccountsRequest) (*TopAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopConsumers not implemented")
+}
+func (*UnimplementedAdminServer) TopDenied(context.Context, *TopAccountsRequest) (*TopAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopDenied not implemented")
+}
+func (*UnimplementedAdminServer) RefillHistory(context.Context, *RefillHistoryRequest) (*RefillHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefillHistory not implemented")
+}
+func (*UnimplementedAdminServer) RefilledPolicies(context.Context, *RefilledPoliciesRequest) (*RefilledPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefilledPolicies not implemented")
+}
 
 func RegisterAdminServer(s prpc.Registrar, srv AdminServer) {
 	s.RegisterService(&_Admin_serviceDesc, srv)
@@ -1209,6 +1987,78 @@ func _Admin_WritePolicyConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_TopConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).TopConsumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go.chromium.org.luci.server.quota.quotapb.Admin/TopConsumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).TopConsumers(ctx, req.(*TopAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_TopDenied_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).TopDenied(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go.chromium.org.luci.server.quota.quotapb.Admin/TopDenied",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).TopDenied(ctx, req.(*TopAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RefillHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefillHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefillHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go.chromium.org.luci.server.quota.quotapb.Admin/RefillHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefillHistory(ctx, req.(*RefillHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RefilledPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefilledPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefilledPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go.chromium.org.luci.server.quota.quotapb.Admin/RefilledPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefilledPolicies(ctx, req.(*RefilledPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "go.chromium.org.luci.server.quota.quotapb.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -1225,6 +2075,22 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WritePolicyConfig",
 			Handler:    _Admin_WritePolicyConfig_Handler,
 		},
+		{
+			MethodName: "TopConsumers",
+			Handler:    _Admin_TopConsumers_Handler,
+		},
+		{
+			MethodName: "TopDenied",
+			Handler:    _Admin_TopDenied_Handler,
+		},
+		{
+			MethodName: "RefillHistory",
+			Handler:    _Admin_RefillHistory_Handler,
+		},
+		{
+			MethodName: "RefilledPolicies",
+			Handler:    _Admin_RefilledPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/server/quota/quotapb/service.proto",
@@ -1033,6 +1033,824 @@ var _ interface {
 	ErrorName() string
 } = WritePolicyConfigResponseValidationError{}
 
+// Validate checks the field values on TopAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopAccountsRequestMultiError, or nil if none found.
+func (m *TopAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWindow() == nil {
+		err := TopAccountsRequestValidationError{
+			field:  "Window",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = TopAccountsRequestValidationError{
+				field:  "Window",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := TopAccountsRequestValidationError{
+					field:  "Window",
+					reason: "value must be inside range (0s, 24h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := TopAccountsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TopAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopAccountsRequestMultiError is an error wrapping multiple validation errors
+// returned by TopAccountsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TopAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopAccountsRequestMultiError) AllErrors() []error { return m }
+
+// TopAccountsRequestValidationError is the validation error returned by
+// TopAccountsRequest.Validate if the designated constraints aren't met.
+type TopAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopAccountsRequestValidationError) ErrorName() string {
+	return "TopAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopAccountsRequestValidationError{}
+
+// Validate checks the field values on TopAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopAccountsResponseMultiError, or nil if none found.
+func (m *TopAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TopAccountsResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TopAccountsResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TopAccountsResponseValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TopAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopAccountsResponseMultiError is an error wrapping multiple validation
+// errors returned by TopAccountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TopAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopAccountsResponseMultiError) AllErrors() []error { return m }
+
+// TopAccountsResponseValidationError is the validation error returned by
+// TopAccountsResponse.Validate if the designated constraints aren't met.
+type TopAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopAccountsResponseValidationError) ErrorName() string {
+	return "TopAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopAccountsResponseValidationError{}
+
+// Validate checks the field values on RefillHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefillHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefillHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefillHistoryRequestMultiError, or nil if none found.
+func (m *RefillHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefillHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPolicy() == nil {
+		err := RefillHistoryRequestValidationError{
+			field:  "Policy",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefillHistoryRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefillHistoryRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefillHistoryRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := RefillHistoryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefillHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefillHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by RefillHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefillHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefillHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefillHistoryRequestMultiError) AllErrors() []error { return m }
+
+// RefillHistoryRequestValidationError is the validation error returned by
+// RefillHistoryRequest.Validate if the designated constraints aren't met.
+type RefillHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefillHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefillHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefillHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefillHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefillHistoryRequestValidationError) ErrorName() string {
+	return "RefillHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefillHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefillHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefillHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefillHistoryRequestValidationError{}
+
+// Validate checks the field values on RefillHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefillHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefillHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefillHistoryResponseMultiError, or nil if none found.
+func (m *RefillHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefillHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRefills() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefillHistoryResponseValidationError{
+						field:  fmt.Sprintf("Refills[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefillHistoryResponseValidationError{
+						field:  fmt.Sprintf("Refills[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefillHistoryResponseValidationError{
+					field:  fmt.Sprintf("Refills[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RefillHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefillHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by RefillHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefillHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefillHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefillHistoryResponseMultiError) AllErrors() []error { return m }
+
+// RefillHistoryResponseValidationError is the validation error returned by
+// RefillHistoryResponse.Validate if the designated constraints aren't met.
+type RefillHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefillHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefillHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefillHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefillHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefillHistoryResponseValidationError) ErrorName() string {
+	return "RefillHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefillHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefillHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefillHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefillHistoryResponseValidationError{}
+
+// Validate checks the field values on RefilledPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefilledPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefilledPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefilledPoliciesRequestMultiError, or nil if none found.
+func (m *RefilledPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefilledPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RefilledPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefilledPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by RefilledPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefilledPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefilledPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefilledPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// RefilledPoliciesRequestValidationError is the validation error returned by
+// RefilledPoliciesRequest.Validate if the designated constraints aren't met.
+type RefilledPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefilledPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefilledPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefilledPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefilledPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefilledPoliciesRequestValidationError) ErrorName() string {
+	return "RefilledPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefilledPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefilledPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefilledPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefilledPoliciesRequestValidationError{}
+
+// Validate checks the field values on RefilledPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefilledPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefilledPoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefilledPoliciesResponseMultiError, or nil if none found.
+func (m *RefilledPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefilledPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefilledPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefilledPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefilledPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RefilledPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefilledPoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by RefilledPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefilledPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefilledPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefilledPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// RefilledPoliciesResponseValidationError is the validation error returned by
+// RefilledPoliciesResponse.Validate if the designated constraints aren't met.
+type RefilledPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefilledPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefilledPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefilledPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefilledPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefilledPoliciesResponseValidationError) ErrorName() string {
+	return "RefilledPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefilledPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefilledPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefilledPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefilledPoliciesResponseValidationError{}
+
 // Validate checks the field values on GetAccountsResponse_AccountState with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -1208,3 +2026,304 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetAccountsResponse_AccountStateValidationError{}
+
+// Validate checks the field values on TopAccountsResponse_AccountUsage with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *TopAccountsResponse_AccountUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopAccountsResponse_AccountUsage with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TopAccountsResponse_AccountUsageMultiError, or nil if none found.
+func (m *TopAccountsResponse_AccountUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopAccountsResponse_AccountUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopAccountsResponse_AccountUsageValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopAccountsResponse_AccountUsageValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopAccountsResponse_AccountUsageValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Units
+
+	if len(errors) > 0 {
+		return TopAccountsResponse_AccountUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopAccountsResponse_AccountUsageMultiError is an error wrapping multiple
+// validation errors returned by
+// TopAccountsResponse_AccountUsage.ValidateAll() if the designated
+// constraints aren't met.
+type TopAccountsResponse_AccountUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopAccountsResponse_AccountUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopAccountsResponse_AccountUsageMultiError) AllErrors() []error { return m }
+
+// TopAccountsResponse_AccountUsageValidationError is the validation error
+// returned by TopAccountsResponse_AccountUsage.Validate if the designated
+// constraints aren't met.
+type TopAccountsResponse_AccountUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopAccountsResponse_AccountUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopAccountsResponse_AccountUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopAccountsResponse_AccountUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopAccountsResponse_AccountUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopAccountsResponse_AccountUsageValidationError) ErrorName() string {
+	return "TopAccountsResponse_AccountUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopAccountsResponse_AccountUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopAccountsResponse_AccountUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopAccountsResponse_AccountUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopAccountsResponse_AccountUsageValidationError{}
+
+// Validate checks the field values on RefillHistoryResponse_Refill with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefillHistoryResponse_Refill) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefillHistoryResponse_Refill with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefillHistoryResponse_RefillMultiError, or nil if none found.
+func (m *RefillHistoryResponse_Refill) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefillHistoryResponse_Refill) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefillHistoryResponse_RefillValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefillHistoryResponse_RefillValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefillHistoryResponse_RefillValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefillHistoryResponse_RefillValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefillHistoryResponse_RefillValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefillHistoryResponse_RefillValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Units
+
+	// no validation rules for Balance
+
+	if len(errors) > 0 {
+		return RefillHistoryResponse_RefillMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefillHistoryResponse_RefillMultiError is an error wrapping multiple
+// validation errors returned by RefillHistoryResponse_Refill.ValidateAll() if
+// the designated constraints aren't met.
+type RefillHistoryResponse_RefillMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefillHistoryResponse_RefillMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefillHistoryResponse_RefillMultiError) AllErrors() []error { return m }
+
+// RefillHistoryResponse_RefillValidationError is the validation error returned
+// by RefillHistoryResponse_Refill.Validate if the designated constraints
+// aren't met.
+type RefillHistoryResponse_RefillValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefillHistoryResponse_RefillValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefillHistoryResponse_RefillValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefillHistoryResponse_RefillValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefillHistoryResponse_RefillValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefillHistoryResponse_RefillValidationError) ErrorName() string {
+	return "RefillHistoryResponse_RefillValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefillHistoryResponse_RefillValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefillHistoryResponse_Refill.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefillHistoryResponse_RefillValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefillHistoryResponse_RefillValidationError{}
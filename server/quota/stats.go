@@ -0,0 +1,454 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/rand/mathrand"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto/msgpackpb"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+
+	"go.chromium.org/luci/server/quota/internal/quotakeys"
+	"go.chromium.org/luci/server/quota/quotapb"
+)
+
+// MaxUsageWindow is the longest window supported by TopConsumers and
+// TopDenied.
+const MaxUsageWindow = 24 * time.Hour
+
+// Statistics kinds, see quotakeys.StatsBucketKey.
+const (
+	statsConsumed = "c"
+	statsDenied   = "d"
+)
+
+// statsResolution describes buckets of a single size.
+type statsResolution struct {
+	id        string        // used in bucket keys
+	size      time.Duration // size of a single bucket
+	retention time.Duration // how long to keep a bucket after it is closed
+	maxWindow time.Duration // the longest window served by this resolution
+}
+
+// statsResolutions are used to record usage, from finest to coarsest.
+var statsResolutions = []statsResolution{
+	{"m", time.Minute, time.Hour + 2*time.Minute, time.Hour},
+	{"h", time.Hour, MaxUsageWindow + 2*time.Hour, MaxUsageWindow},
+}
+
+// refillHistoryLimit is how many refill events to keep per policy.
+const refillHistoryLimit = 100
+
+// refillHistoryRetention is how long to keep the refill history of a policy
+// after its last refill.
+const refillHistoryRetention = MaxUsageWindow
+
+var dryRunDenials = metric.NewCounter(
+	"quota/dry_run/denials",
+	"Count of ops which would have been denied if not in the dry-run mode",
+	nil,
+	field.String("app_id"),        // AccountID.AppId
+	field.String("resource_type"), // AccountID.ResourceType
+	field.String("status"),        // ERR_OVERFLOW | ERR_UNDERFLOW
+)
+
+var accountingKey = "holds a bool indicating if usage accounting is enabled"
+var dryRunKey = "holds a bool indicating if the dry-run mode is enabled"
+
+// WithAccounting returns a context in which ApplyOps records usage statistics
+// (see TopConsumers, TopDenied and RefillHistory).
+//
+// Recording statistics costs a few extra Redis calls per ApplyOps. The quota
+// module enables it for all requests when `-quota-accounting` flag is set.
+func WithAccounting(ctx context.Context) context.Context {
+	return context.WithValue(ctx, &accountingKey, true)
+}
+
+// WithDryRun returns a context in which ApplyOps doesn't enforce policy
+// bounds.
+//
+// Ops which would have failed with ERR_OVERFLOW or ERR_UNDERFLOW are logged,
+// reported to the "quota/dry_run/denials" metric and recorded in TopDenied
+// (if accounting is enabled), and then applied as if they had
+// IGNORE_POLICY_BOUNDS option set. All other errors are returned as usual.
+//
+// This allows to roll out new policies without affecting users. The quota
+// module enables it for all requests when `-quota-dry-run` flag is set.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, &dryRunKey, true)
+}
+
+func accountingEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(&accountingKey).(bool)
+	return enabled
+}
+
+func dryRunEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(&dryRunKey).(bool)
+	return enabled
+}
+
+// AccountUsage is the number of units used by an account over some window.
+type AccountUsage struct {
+	Account *quotapb.AccountID
+	Units   int64
+}
+
+// RefillEvent records a single refill of an account.
+type RefillEvent struct {
+	Time    time.Time
+	Account *quotapb.AccountID
+	Units   int64
+	Balance int64 // the balance after the refill
+}
+
+// refillRecord is how a RefillEvent is stored in Redis.
+type refillRecord struct {
+	Time    time.Time `json:"ts"`
+	Account string    `json:"account"` // the account key
+	Units   int64     `json:"units"`
+	Balance int64     `json:"balance"`
+}
+
+// TopConsumers returns up to n accounts which consumed the most units within
+// the given window (rounded up to a minute or, for windows longer than an
+// hour, to an hour), most consuming first.
+//
+// Consumption is the sum of negative deltas of ops relative to
+// CURRENT_BALANCE. It is recorded only if ApplyOps is called with accounting
+// enabled (see WithAccounting).
+func TopConsumers(ctx context.Context, window time.Duration, n int) ([]AccountUsage, error) {
+	return topAccounts(ctx, statsConsumed, window, n)
+}
+
+// TopDenied is like TopConsumers, but returns accounts with the most units
+// which would have been denied in the dry-run mode (see WithDryRun).
+//
+// Units of denied ops are the absolute values of their deltas.
+func TopDenied(ctx context.Context, window time.Duration, n int) ([]AccountUsage, error) {
+	return topAccounts(ctx, statsDenied, window, n)
+}
+
+// RefillHistory returns up to limit most recent refills of accounts which
+// use the given policy, most recent first.
+//
+// It is recorded only if ApplyOps is called with accounting enabled (see
+// WithAccounting). A refill is noticed when ApplyOps touches an account, so
+// the event time is the time of that ApplyOps and the units are accumulated
+// since the previous update of the account.
+func RefillHistory(ctx context.Context, policy *quotapb.PolicyID, limit int) ([]*RefillEvent, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, errors.Annotate(err, "bad policy").Err()
+	}
+	if limit <= 0 || limit > refillHistoryLimit {
+		limit = refillHistoryLimit
+	}
+
+	var raw []string
+	err := withRedisConn(ctx, func(conn redis.Conn) (err error) {
+		key := quotakeys.RefillHistoryKey(quotakeys.PolicyRef(policy))
+		raw, err = redis.Strings(conn.Do("LRANGE", key, 0, limit-1))
+		return err
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch the refill history").Err()
+	}
+
+	events := make([]*RefillEvent, 0, len(raw))
+	for _, blob := range raw {
+		rec := refillRecord{}
+		if err := json.Unmarshal([]byte(blob), &rec); err != nil {
+			logging.Warningf(ctx, "quota: skipping broken refill event %q: %s", blob, err)
+			continue
+		}
+		id, err := quotakeys.ParseAccountKey(rec.Account)
+		if err != nil {
+			logging.Warningf(ctx, "quota: skipping refill event with bad account %q: %s", rec.Account, err)
+			continue
+		}
+		events = append(events, &RefillEvent{
+			Time:    rec.Time,
+			Account: id,
+			Units:   rec.Units,
+			Balance: rec.Balance,
+		})
+	}
+	return events, nil
+}
+
+// RefilledPolicies returns all policies with non-empty refill history.
+func RefilledPolicies(ctx context.Context) ([]*quotapb.PolicyID, error) {
+	var policies []*quotapb.PolicyID
+	err := withRedisConn(ctx, func(conn redis.Conn) error {
+		cursor := 0
+		for {
+			reply, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", quotakeys.RefillHistoryPattern, "COUNT", 1000))
+			if err != nil {
+				return err
+			}
+			var keys []string
+			if _, err := redis.Scan(reply, &cursor, &keys); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				id, err := quotakeys.ParseRefillHistoryKey(key)
+				if err != nil {
+					logging.Warningf(ctx, "quota: skipping bad refill history key %q: %s", key, err)
+					continue
+				}
+				policies = append(policies, id)
+			}
+			if cursor == 0 {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list refill histories").Err()
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return quotakeys.RefillHistoryKey(quotakeys.PolicyRef(policies[i])) <
+			quotakeys.RefillHistoryKey(quotakeys.PolicyRef(policies[j]))
+	})
+	return policies, nil
+}
+
+// topAccounts implements TopConsumers and TopDenied.
+func topAccounts(ctx context.Context, kind string, window time.Duration, n int) ([]AccountUsage, error) {
+	switch {
+	case window <= 0:
+		return nil, errors.Reason("the window must be positive").Err()
+	case window > MaxUsageWindow:
+		return nil, errors.Reason("the window must not be longer than %s", MaxUsageWindow).Err()
+	case n <= 0:
+		return nil, errors.Reason("the number of accounts must be positive").Err()
+	}
+
+	res := statsResolutions[len(statsResolutions)-1]
+	for _, r := range statsResolutions {
+		if window <= r.maxWindow {
+			res = r
+			break
+		}
+	}
+
+	// Union all buckets overlapping with the window, the current one included.
+	last := bucketNumber(clock.Now(ctx), res)
+	count := int64((window + res.size - 1) / res.size)
+	args := redis.Args{}
+	for b := last - count + 1; b <= last; b++ {
+		args = args.Add(quotakeys.StatsBucketKey(kind, res.id, b))
+	}
+	tmp := quotakeys.StatsTempKey(fmt.Sprintf("%d~%d", clock.Now(ctx).UnixNano(), mathrand.Int63(ctx)))
+
+	var reply []string
+	err := withRedisConn(ctx, func(conn redis.Conn) (err error) {
+		if _, err := conn.Do("ZUNIONSTORE", redis.Args{tmp, len(args)}.AddFlat(args)...); err != nil {
+			return err
+		}
+		defer func() {
+			if _, err := conn.Do("DEL", tmp); err != nil {
+				logging.Warningf(ctx, "quota: failed to delete %q: %s", tmp, err)
+			}
+		}()
+		reply, err = redis.Strings(conn.Do("ZREVRANGE", tmp, 0, n-1, "WITHSCORES"))
+		return err
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch usage statistics").Err()
+	}
+
+	out := make([]AccountUsage, 0, len(reply)/2)
+	for i := 0; i+1 < len(reply); i += 2 {
+		id, err := quotakeys.ParseAccountKey(reply[i])
+		if err != nil {
+			logging.Warningf(ctx, "quota: skipping bad account key %q: %s", reply[i], err)
+			continue
+		}
+		units, err := strconv.ParseFloat(reply[i+1], 64)
+		if err != nil {
+			return nil, errors.Annotate(err, "bad score of %q", reply[i]).Err()
+		}
+		out = append(out, AccountUsage{Account: id, Units: int64(units)})
+	}
+	return out, nil
+}
+
+// bucketNumber returns the number of the bucket containing the given time.
+func bucketNumber(t time.Time, res statsResolution) int64 {
+	return t.Unix() / int64(res.size/time.Second)
+}
+
+// readAccounts fetches the current state of accounts touched by ops.
+//
+// Accounts which don't exist are skipped. Returns nil if there are no ops or
+// the request was already applied, since applying it doesn't change anything.
+func readAccounts(conn redis.Conn, input *quotapb.UpdateAccountsInput) (map[string]*quotapb.Account, error) {
+	if len(input.Ops) == 0 {
+		return nil, nil
+	}
+	if input.RequestKey != "" {
+		switch applied, err := redis.Bool(conn.Do("EXISTS", input.RequestKey)); {
+		case err != nil:
+			return nil, errors.Annotate(err, "running EXISTS").Err()
+		case applied:
+			return nil, nil
+		}
+	}
+
+	ops := input.Ops
+	keys := make(redis.Args, 0, len(ops))
+	for _, op := range ops {
+		keys = append(keys, op.AccountRef)
+	}
+	raw, err := redis.Strings(conn.Do("MGET", keys...))
+	if err != nil {
+		return nil, errors.Annotate(err, "running MGET").Err()
+	}
+	accounts := make(map[string]*quotapb.Account, len(raw))
+	for i, blob := range raw {
+		if blob == "" {
+			continue
+		}
+		account := &quotapb.Account{}
+		if err := msgpackpb.Unmarshal(msgpack.RawMessage(blob), account); err != nil {
+			return nil, errors.Annotate(err, "account %q", ops[i].AccountRef).Err()
+		}
+		accounts[ops[i].AccountRef] = account
+	}
+	return accounts, nil
+}
+
+// recordUsage records statistics about successfully applied ops.
+//
+// `before` is the state of accounts before the ops were applied, as returned
+// by readAccounts. Since it is fetched separately from applying the ops, refill
+// amounts are approximate if accounts are updated concurrently.
+func recordUsage(ctx context.Context, conn redis.Conn, ops []*quotapb.RawOp, before map[string]*quotapb.Account, resp *quotapb.ApplyOpsResponse) error {
+	now := clock.Now(ctx)
+	seen := make(map[string]struct{}, len(ops))
+	for i, op := range ops {
+		if op.RelativeTo == quotapb.Op_CURRENT_BALANCE && op.Delta < 0 {
+			sendBucketIncr(conn, now, statsConsumed, op.AccountRef, -op.Delta)
+		}
+
+		// Only the first op on an account observes the refill.
+		if _, ok := seen[op.AccountRef]; ok {
+			continue
+		}
+		seen[op.AccountRef] = struct{}{}
+		prev := before[op.AccountRef]
+		res := resp.Results[i]
+		if prev == nil || prev.PolicyRef == nil || res.AccountStatus != quotapb.OpResult_ALREADY_EXISTS {
+			continue
+		}
+		if refill := res.PreviousBalance - prev.Balance; refill > 0 {
+			blob, err := json.Marshal(&refillRecord{
+				Time:    now.UTC(),
+				Account: op.AccountRef,
+				Units:   refill,
+				Balance: res.PreviousBalance,
+			})
+			if err != nil {
+				return err
+			}
+			key := quotakeys.RefillHistoryKey(prev.PolicyRef)
+			conn.Send("LPUSH", key, blob)
+			conn.Send("LTRIM", key, 0, refillHistoryLimit-1)
+			conn.Send("EXPIRE", key, int64(refillHistoryRetention/time.Second))
+		}
+	}
+	_, err := conn.Do("")
+	return err
+}
+
+// recordDenials records ops which failed with ERR_OVERFLOW or ERR_UNDERFLOW
+// in the dry-run mode.
+func recordDenials(ctx context.Context, conn redis.Conn, ops []*quotapb.RawOp, resp *quotapb.ApplyOpsResponse) error {
+	now := clock.Now(ctx)
+	for i, res := range resp.Results {
+		if !isBoundsError(res.Status) {
+			continue
+		}
+		op := ops[i]
+		id, err := quotakeys.ParseAccountKey(op.AccountRef)
+		if err != nil {
+			return errors.Annotate(err, "ops[%d]", i).Err()
+		}
+		logging.Warningf(ctx, "quota: dry-run: would have denied %s of %d units to %q",
+			res.Status, op.Delta, op.AccountRef)
+		dryRunDenials.Add(ctx, 1, id.AppId, id.ResourceType, res.Status.String())
+		if accountingEnabled(ctx) {
+			units := op.Delta
+			if units < 0 {
+				units = -units
+			}
+			sendBucketIncr(conn, now, statsDenied, op.AccountRef, units)
+		}
+	}
+	_, err := conn.Do("")
+	return err
+}
+
+// sendBucketIncr sends commands which add units to the account in buckets
+// of all resolutions.
+func sendBucketIncr(conn redis.Conn, now time.Time, kind, account string, units int64) {
+	for _, res := range statsResolutions {
+		b := bucketNumber(now, res)
+		key := quotakeys.StatsBucketKey(kind, res.id, b)
+		expiry := time.Unix((b+1)*int64(res.size/time.Second), 0).Add(res.retention)
+		conn.Send("ZINCRBY", key, units, account)
+		conn.Send("EXPIREAT", key, expiry.Unix())
+	}
+}
+
+// isBoundsError is true for statuses ignored in the dry-run mode.
+func isBoundsError(status quotapb.OpResult_OpStatus) bool {
+	return status == quotapb.OpResult_ERR_OVERFLOW || status == quotapb.OpResult_ERR_UNDERFLOW
+}
+
+// ignoreBounds returns a copy of the input in which ops that failed with
+// bounds errors have IGNORE_POLICY_BOUNDS option set.
+//
+// Returns nil if some ops failed for other reasons.
+func ignoreBounds(input *quotapb.UpdateAccountsInput, resp *quotapb.ApplyOpsResponse) *quotapb.UpdateAccountsInput {
+	out := proto.Clone(input).(*quotapb.UpdateAccountsInput)
+	for i, res := range resp.Results {
+		switch {
+		case res.Status == quotapb.OpResult_SUCCESS:
+		case isBoundsError(res.Status):
+			op := out.Ops[i]
+			op.Options &^= uint32(quotapb.Op_DO_NOT_CAP_PROPOSED)
+			op.Options |= uint32(quotapb.Op_IGNORE_POLICY_BOUNDS)
+		default:
+			return nil
+		}
+	}
+	return out
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon"
+
+	"go.chromium.org/luci/server/quota"
+	"go.chromium.org/luci/server/quota/quotapb"
+	"go.chromium.org/luci/server/redisconn"
+
+	_ "go.chromium.org/luci/server/quota/quotatestmonkeypatch"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStats(t *testing.T) {
+	Convey(`Stats`, t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		s, err := miniredis.Run()
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		tc := testclock.New(testclock.TestRecentTimeUTC.Round(time.Minute))
+		ctx = clock.Set(ctx, tc)
+		s.SetTime(clock.Now(ctx))
+
+		advance := func(d time.Duration) {
+			tc.Add(d)
+			s.SetTime(clock.Now(ctx))
+			s.FastForward(d)
+		}
+
+		ctx = redisconn.UsePool(ctx, &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", s.Addr())
+			},
+		})
+
+		polKey := &quotapb.PolicyKey{Namespace: "ns1", Name: "limited", ResourceType: "qps"}
+		policyConfigID, err := integrationTestApp.LoadPoliciesAuto(ctx, "@internal:integrationTestApp", &quotapb.PolicyConfig{
+			Policies: []*quotapb.PolicyConfig_Entry{
+				{
+					Key: polKey,
+					Policy: &quotapb.Policy{
+						Default: 10,
+						Limit:   100,
+						Refill:  &quotapb.Policy_Refill{Units: 1, Interval: 1},
+					},
+				},
+			},
+		})
+		So(err, ShouldBeNil)
+		policyID := &quotapb.PolicyID{Config: policyConfigID, Key: polKey}
+
+		alice := integrationTestApp.AccountID("project:realm", "ns1", "alice", "qps")
+		bob := integrationTestApp.AccountID("project:realm", "ns1", "bob", "qps")
+
+		consume := func(ctx context.Context, requestID string, account *quotapb.AccountID, units int64) error {
+			_, err := quota.ApplyOps(ctx, requestID, durationpb.New(time.Hour), []*quotapb.Op{
+				{
+					AccountId:  account,
+					PolicyId:   policyID,
+					RelativeTo: quotapb.Op_CURRENT_BALANCE,
+					Delta:      -units,
+				},
+			})
+			return err
+		}
+
+		// usage converts leaderboards to a form easy to compare.
+		usage := func(top []quota.AccountUsage) map[string]int64 {
+			out := make(map[string]int64, len(top))
+			for _, u := range top {
+				out[u.Account.Name] = u.Units
+			}
+			return out
+		}
+
+		balance := func(account *quotapb.AccountID) int64 {
+			res, err := quota.GetAccounts(ctx, []*quotapb.AccountID{account})
+			So(err, ShouldBeNil)
+			return res.Accounts[0].Account.Balance
+		}
+
+		Convey(`Disabled by default`, func() {
+			So(consume(ctx, "", alice, 1), ShouldBeNil)
+
+			top, err := quota.TopConsumers(ctx, time.Hour, 10)
+			So(err, ShouldBeNil)
+			So(top, ShouldBeEmpty)
+
+			So(consume(ctx, "", alice, 100), ShouldEqual, quota.ErrQuotaApply)
+		})
+
+		Convey(`Accounting`, func() {
+			ctx := quota.WithAccounting(ctx)
+
+			So(consume(ctx, "", alice, 1), ShouldBeNil)
+			So(consume(ctx, "", bob, 5), ShouldBeNil)
+			advance(2 * time.Minute)
+			So(consume(ctx, "", alice, 1), ShouldBeNil)
+			So(consume(ctx, "", bob, 1), ShouldBeNil)
+
+			Convey(`TopConsumers`, func() {
+				top, err := quota.TopConsumers(ctx, time.Hour, 10)
+				So(err, ShouldBeNil)
+				So(top, ShouldHaveLength, 2)
+				So(top[0].Account, ShouldResembleProto, bob)
+				So(usage(top), ShouldResemble, map[string]int64{"bob": 6, "alice": 2})
+
+				top, err = quota.TopConsumers(ctx, time.Hour, 1)
+				So(err, ShouldBeNil)
+				So(usage(top), ShouldResemble, map[string]int64{"bob": 6})
+
+				top, err = quota.TopConsumers(ctx, time.Minute, 10)
+				So(err, ShouldBeNil)
+				So(usage(top), ShouldResemble, map[string]int64{"alice": 1, "bob": 1})
+
+				advance(2 * time.Hour)
+				top, err = quota.TopConsumers(ctx, time.Hour, 10)
+				So(err, ShouldBeNil)
+				So(top, ShouldBeEmpty)
+				top, err = quota.TopConsumers(ctx, 3*time.Hour, 10)
+				So(err, ShouldBeNil)
+				So(top, ShouldHaveLength, 2)
+
+				advance(quota.MaxUsageWindow + time.Hour)
+				So(s.Keys(), ShouldHaveLength, 3) // the config and two accounts
+			})
+
+			Convey(`Bad windows`, func() {
+				_, err := quota.TopConsumers(ctx, 0, 10)
+				So(err, ShouldErrLike, "must be positive")
+				_, err = quota.TopConsumers(ctx, 2*quota.MaxUsageWindow, 10)
+				So(err, ShouldErrLike, "must not be longer")
+			})
+
+			Convey(`RefillHistory`, func() {
+				// Alice had 10-1=9 units, and refilled 2 minutes worth of units
+				// before the second op.
+				events, err := quota.RefillHistory(ctx, policyID, 0)
+				So(err, ShouldBeNil)
+				So(events, ShouldHaveLength, 2)
+				So(events[1].Time, ShouldEqual, clock.Now(ctx).UTC())
+				So(events[1].Account, ShouldResembleProto, alice)
+				So(events[1].Units, ShouldEqual, 91)
+				So(events[1].Balance, ShouldEqual, 100)
+				So(events[0].Account, ShouldResembleProto, bob)
+
+				policies, err := quota.RefilledPolicies(ctx)
+				So(err, ShouldBeNil)
+				So(policies, ShouldResembleProto, []*quotapb.PolicyID{policyID})
+			})
+
+			Convey(`No ops`, func() {
+				_, err := quota.ApplyOps(ctx, "", nil, nil)
+				So(err, ShouldBeNil)
+			})
+
+			Convey(`Replays are not counted`, func() {
+				So(consume(ctx, "req", alice, 3), ShouldBeNil)
+				So(consume(ctx, "req", alice, 3), ShouldBeNil)
+
+				top, err := quota.TopConsumers(ctx, time.Minute, 10)
+				So(err, ShouldBeNil)
+				So(usage(top)["alice"], ShouldEqual, 4)
+			})
+		})
+
+		Convey(`Dry run`, func() {
+			ctx := quota.WithDryRun(ctx)
+
+			So(consume(ctx, "", alice, 30), ShouldBeNil)
+			So(balance(alice), ShouldEqual, -20)
+
+			Convey(`Other errors are returned`, func() {
+				_, err := quota.ApplyOps(ctx, "", nil, []*quotapb.Op{
+					{
+						AccountId:  bob,
+						RelativeTo: quotapb.Op_CURRENT_BALANCE,
+						Delta:      -1,
+					},
+				})
+				So(err, ShouldEqual, quota.ErrQuotaApply)
+			})
+
+			Convey(`Denials are recorded with accounting`, func() {
+				ctx := quota.WithAccounting(ctx)
+				So(consume(ctx, "", bob, 15), ShouldBeNil)
+				So(consume(ctx, "", bob, 1), ShouldBeNil)
+
+				top, err := quota.TopDenied(ctx, time.Hour, 10)
+				So(err, ShouldBeNil)
+				So(usage(top), ShouldResemble, map[string]int64{"bob": 16})
+
+				top, err = quota.TopConsumers(ctx, time.Hour, 10)
+				So(err, ShouldBeNil)
+				So(usage(top), ShouldResemble, map[string]int64{"bob": 16})
+			})
+		})
+	})
+}
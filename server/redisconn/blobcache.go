@@ -27,12 +27,12 @@ import (
 
 var tracer = otel.Tracer("go.chromium.org/luci/server/redisconn")
 
-// redisBlobCache implements caching.BlobCache using Redis.
+// redisBlobCache implements caching.MutableBlobCache using Redis.
 type redisBlobCache struct {
 	Prefix string // prefix to prepend to keys
 }
 
-var _ caching.BlobCache = (*redisBlobCache)(nil)
+var _ caching.MutableBlobCache = (*redisBlobCache)(nil)
 
 func (rc *redisBlobCache) key(k string) string { return rc.Prefix + k }
 
@@ -86,3 +86,53 @@ func (rc *redisBlobCache) Set(ctx context.Context, key string, value []byte, exp
 	}
 	return err
 }
+
+// Add stores an item only if there's no such key in the cache yet.
+func (rc *redisBlobCache) Add(ctx context.Context, key string, value []byte, exp time.Duration) (added bool, err error) {
+	ctx, span := tracer.Start(ctx, "go.chromium.org/luci/server.RedisBlobCache.Add")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	conn, err := Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	var reply any
+	if exp == 0 {
+		reply, err = conn.Do("SET", rc.key(key), value, "NX")
+	} else {
+		reply, err = conn.Do("SET", rc.key(key), value, "PX", exp.Nanoseconds()/1e6, "NX")
+	}
+	if err != nil {
+		return false, err
+	}
+	return reply != nil, nil
+}
+
+// Delete removes an item from the cache.
+func (rc *redisBlobCache) Delete(ctx context.Context, key string) (err error) {
+	ctx, span := tracer.Start(ctx, "go.chromium.org/luci/server.RedisBlobCache.Delete")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	conn, err := Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("DEL", rc.key(key))
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisconn
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/caching"
+)
+
+// invalidationsChannel is a Redis pub/sub channel with cache invalidation
+// notifications.
+const invalidationsChannel = "luci.blobcache.invalidations"
+
+// pingInterval is how often to ping the server over an idle pub/sub connection.
+//
+// Must be smaller than the read timeout of connections in the pool.
+const pingInterval = 3 * time.Second
+
+// invalidation is a JSON message sent over invalidationsChannel.
+type invalidation struct {
+	Namespace string `json:"ns"`
+	Key       string `json:"key"`
+}
+
+// redisInvalidationBus implements caching.InvalidationBus using Redis pub/sub.
+type redisInvalidationBus struct{}
+
+var _ caching.InvalidationBus = redisInvalidationBus{}
+
+// Publish notifies all processes that the item has changed.
+func (redisInvalidationBus) Publish(ctx context.Context, namespace, key string) error {
+	msg, err := json.Marshal(&invalidation{Namespace: namespace, Key: key})
+	if err != nil {
+		return err
+	}
+	conn, err := Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Do("PUBLISH", invalidationsChannel, msg)
+	return err
+}
+
+// receiveInvalidations subscribes to invalidation notifications and delivers
+// them via caching.DeliverInvalidation until the context is canceled.
+//
+// Reconnects on errors. Notifications published while the subscription is
+// down are lost: cached items eventually expire on their own in that case.
+func receiveInvalidations(ctx context.Context, pool *redis.Pool) {
+	for ctx.Err() == nil {
+		err := subscribe(ctx, pool)
+		if ctx.Err() != nil {
+			return
+		}
+		logging.Warningf(ctx, "Invalidations subscription failed, will retry: %s", err)
+		clock.Sleep(ctx, 5*time.Second)
+	}
+}
+
+// subscribe receives invalidation notifications through a single connection.
+//
+// Returns nil if the context was canceled.
+func subscribe(ctx context.Context, pool *redis.Pool) error {
+	conn, err := pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	psc := redis.PubSubConn{Conn: conn}
+	defer psc.Close()
+
+	if err := psc.Subscribe(invalidationsChannel); err != nil {
+		return errors.Annotate(err, "subscribing").Err()
+	}
+
+	// Keep the connection alive and unsubscribe when the context is canceled.
+	// This unblocks the receiving loop below.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				psc.Unsubscribe()
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := psc.Ping(""); err != nil {
+					return // the receiving loop will notice the broken connection
+				}
+			}
+		}
+	}()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			msg := invalidation{}
+			if err := json.Unmarshal(v.Data, &msg); err != nil {
+				logging.Errorf(ctx, "Bad invalidation message %q: %s", v.Data, err)
+				continue
+			}
+			caching.DeliverInvalidation(ctx, msg.Namespace, msg.Key)
+		case redis.Subscription:
+			if v.Kind == "unsubscribe" && v.Count == 0 {
+				return nil
+			}
+		case error:
+			return v
+		}
+	}
+}
@@ -54,7 +54,7 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 
 // NewModule returns a server module that adds a Redis connection pool to the
 // global server context and installs Redis as the default caching.BlobCache
-// implementation.
+// and caching.InvalidationBus implementation.
 //
 // The Redis connection pool can be used through redisconn.Get(ctx).
 //
@@ -107,6 +107,12 @@ func (m *redisModule) Initialize(ctx context.Context, host module.Host, opts mod
 		return &redisBlobCache{Prefix: fmt.Sprintf("luci.blobcache.%s:", namespace)}
 	})
 
+	// Use Redis pub/sub to propagate cache invalidations across processes.
+	ctx = caching.WithInvalidationBus(ctx, redisInvalidationBus{})
+	host.RunInBackground("luci.redisconn.invalidations", func(ctx context.Context) {
+		receiveInvalidations(ctx, pool)
+	})
+
 	// Close all connections when exiting gracefully.
 	host.RegisterCleanup(func(ctx context.Context) {
 		if err := pool.Close(); err != nil {
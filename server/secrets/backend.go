@@ -0,0 +1,307 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+// Backend fetches stored secrets from some storage.
+//
+// It is used by BackendStore, which takes care of caching secrets and notifying
+// rotation handlers. See FileBackend and VaultBackend for implementations.
+type Backend interface {
+	// Scheme is a scheme of secret references handled by the backend.
+	//
+	// For example, if the scheme is "file", the backend handles secrets like
+	// "file://<name>".
+	Scheme() string
+
+	// Fetch fetches the current value of a secret.
+	//
+	// Receives the name of the secret without "<scheme>://" prefix. Returns
+	// ErrNoSuchSecret if there's no such secret.
+	Fetch(ctx context.Context, name string) (Secret, error)
+
+	// Watch watches the storage for changes until the context is canceled.
+	//
+	// `names` returns names of secrets (without "<scheme>://" prefix) that are
+	// being tracked right now. Watch calls `changed` when it notices a tracked
+	// secret has (or may have) changed, which results in the secret being
+	// refetched and rotation handlers being called if necessary.
+	Watch(ctx context.Context, names func() []string, changed func(name string))
+}
+
+// BackendStore implements Store on top of a Backend.
+//
+// Stored secrets are fetched from the backend when they are accessed for the
+// first time and then cached in memory. They are refetched when the backend
+// notices changes (see Backend.Watch), when Refresh is called or every
+// PollInterval as a fallback. Rotation handlers are called right after the new
+// value is fetched.
+//
+// Static development secrets (see SecretManagerStore.StoredSecret) are
+// supported as well.
+//
+// Random secrets are derived from a root secret using HKDF via DerivedStore.
+type BackendStore struct {
+	// Backend is the backend to fetch secrets from.
+	Backend Backend
+
+	// PollInterval is how often to refetch all secrets regardless of
+	// notifications from the backend.
+	//
+	// Default is 1 hour.
+	PollInterval time.Duration
+
+	randomSecrets Store // the store used by RandomSecret
+
+	m        sync.RWMutex
+	secrets  map[string]Secret // full name => the latest value
+	handlers map[string][]RotationHandler
+
+	refreshM sync.Mutex // serializes refreshes to call handlers in order
+}
+
+// LoadRootSecret loads the root secret used to generate random secrets.
+//
+// See StoredSecret for the format of the root secret.
+func (s *BackendStore) LoadRootSecret(ctx context.Context, rootSecret string) error {
+	secret, err := s.StoredSecret(ctx, rootSecret)
+	if err != nil {
+		return errors.Annotate(err, "failed to read the initial value of the root secret").Err()
+	}
+	derivedStore := NewDerivedStore(secret)
+	if err := s.AddRotationHandler(ctx, rootSecret, func(_ context.Context, secret Secret) {
+		derivedStore.SetRoot(secret)
+	}); err != nil {
+		return err
+	}
+	s.SetRandomSecretsStore(derivedStore)
+	return nil
+}
+
+// SetRandomSecretsStore changes the store used for RandomSecret(...).
+//
+// Can be used instead of LoadRootSecret to hook up a custom implementation.
+func (s *BackendStore) SetRandomSecretsStore(store Store) {
+	s.randomSecrets = store
+}
+
+// RandomSecret returns a random secret given its name.
+func (s *BackendStore) RandomSecret(ctx context.Context, name string) (Secret, error) {
+	if s.randomSecrets == nil {
+		return Secret{}, errors.Reason("random secrets store is not initialized").Err()
+	}
+	return s.randomSecrets.RandomSecret(ctx, name)
+}
+
+// StoredSecret returns a stored secret given its name.
+//
+// Value of `name` should have form `<scheme>://<name>` where `<scheme>` is
+// the scheme of the backend (e.g. `file://...` or `vault://...`) or be one of
+// static development secrets (`devsecret://...` etc.).
+func (s *BackendStore) StoredSecret(ctx context.Context, name string) (Secret, error) {
+	if err := s.checkName(name); err != nil {
+		return Secret{}, err
+	}
+
+	s.m.RLock()
+	known, ok := s.secrets[name]
+	s.m.RUnlock()
+	if ok {
+		return known, nil
+	}
+
+	// Note: this serializes loading of all secrets, see the comment in
+	// SecretManagerStore.StoredSecret.
+	s.m.Lock()
+	defer s.m.Unlock()
+	if known, ok := s.secrets[name]; ok {
+		return known, nil
+	}
+
+	value, err := s.fetch(ctx, name)
+	if err != nil {
+		return Secret{}, err
+	}
+	if s.secrets == nil {
+		s.secrets = make(map[string]Secret, 1)
+	}
+	s.secrets[name] = value
+	return value, nil
+}
+
+// AddRotationHandler registers a callback which is called when the stored
+// secret is updated.
+//
+// The handler is called from an internal goroutine. If multiple handlers for
+// the same secret are registered, they are called in order of their
+// registration one by one.
+func (s *BackendStore) AddRotationHandler(ctx context.Context, name string, cb RotationHandler) error {
+	if err := s.checkName(name); err != nil {
+		return err
+	}
+	if isDevSecret(name) {
+		return nil // no updates for static secrets
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.handlers == nil {
+		s.handlers = make(map[string][]RotationHandler, 1)
+	}
+	s.handlers[name] = append(s.handlers[name], cb)
+	return nil
+}
+
+// Refresh refetches a previously loaded secret and calls its rotation handlers
+// if it has changed.
+//
+// Can be used to push rotation notifications from external systems. Does
+// nothing if the secret hasn't been loaded yet.
+func (s *BackendStore) Refresh(ctx context.Context, name string) error {
+	if err := s.checkName(name); err != nil {
+		return err
+	}
+	if isDevSecret(name) {
+		return nil
+	}
+
+	s.refreshM.Lock()
+	defer s.refreshM.Unlock()
+
+	s.m.RLock()
+	old, ok := s.secrets[name]
+	s.m.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	fresh, err := s.fetch(ctx, name)
+	if err != nil {
+		return err
+	}
+	if fresh.Equal(old) {
+		return nil
+	}
+	logging.Infof(ctx, "Secret %q has changed", name)
+
+	s.m.Lock()
+	s.secrets[name] = fresh
+	handlers := append([]RotationHandler(nil), s.handlers[name]...)
+	s.m.Unlock()
+
+	for _, cb := range handlers {
+		cb(ctx, fresh)
+	}
+	return nil
+}
+
+// MaintenanceLoop watches the backend for changes and periodically refetches
+// secrets.
+//
+// It exits on context cancellation. Logs errors inside.
+func (s *BackendStore) MaintenanceLoop(ctx context.Context) {
+	pollInterval := s.PollInterval
+	if pollInterval == 0 {
+		pollInterval = time.Hour
+	}
+
+	prefix := s.Backend.Scheme() + "://"
+
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Backend.Watch(ctx,
+			func() []string {
+				var names []string
+				for _, name := range s.loaded() {
+					names = append(names, strings.TrimPrefix(name, prefix))
+				}
+				return names
+			},
+			func(name string) {
+				s.refreshLogged(ctx, prefix+name)
+			},
+		)
+	}()
+
+	for {
+		if res := <-clock.After(ctx, pollInterval); res.Err != nil {
+			return
+		}
+		for _, name := range s.loaded() {
+			s.refreshLogged(ctx, name)
+		}
+	}
+}
+
+// loaded returns sorted full names of loaded non-static secrets.
+func (s *BackendStore) loaded() []string {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	names := make([]string, 0, len(s.secrets))
+	for name := range s.secrets {
+		if !isDevSecret(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// refreshLogged calls Refresh and logs errors.
+func (s *BackendStore) refreshLogged(ctx context.Context, name string) {
+	if err := s.Refresh(ctx, name); err != nil {
+		logging.Errorf(ctx, "Failed to refresh the secret %q: %s", name, err)
+	}
+}
+
+// checkName checks the secret name has a supported format.
+func (s *BackendStore) checkName(name string) error {
+	if isDevSecret(name) {
+		return nil
+	}
+	prefix := s.Backend.Scheme() + "://"
+	if !strings.HasPrefix(name, prefix) || name == prefix {
+		return errors.Reason("not supported secret reference %q, expecting %s<name>", name, prefix).Err()
+	}
+	return nil
+}
+
+// fetch fetches a secret given its full name.
+func (s *BackendStore) fetch(ctx context.Context, name string) (Secret, error) {
+	if isDevSecret(name) {
+		return readDevSecret(ctx, name)
+	}
+	value, err := s.Backend.Fetch(ctx, strings.TrimPrefix(name, s.Backend.Scheme()+"://"))
+	switch {
+	case err == ErrNoSuchSecret:
+		return Secret{}, err
+	case err != nil:
+		return Secret{}, errors.Annotate(err, "failed to fetch the secret %q", name).Err()
+	}
+	return value, nil
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type fakeBackend struct {
+	m       sync.Mutex
+	secrets map[string]Secret
+	fetches int
+}
+
+func (*fakeBackend) Scheme() string { return "fake" }
+
+func (b *fakeBackend) Fetch(ctx context.Context, name string) (Secret, error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.fetches++
+	s, ok := b.secrets[name]
+	if !ok {
+		return Secret{}, ErrNoSuchSecret
+	}
+	return s, nil
+}
+
+func (b *fakeBackend) Watch(ctx context.Context, names func() []string, changed func(name string)) {
+	<-ctx.Done()
+}
+
+func (b *fakeBackend) set(name string, s Secret) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.secrets == nil {
+		b.secrets = map[string]Secret{}
+	}
+	b.secrets[name] = s
+}
+
+func TestBackendStore(t *testing.T) {
+	t.Parallel()
+
+	Convey("With store", t, func() {
+		ctx := context.Background()
+		backend := &fakeBackend{}
+		store := &BackendStore{Backend: backend}
+
+		backend.set("a", Secret{Active: []byte("v1")})
+
+		Convey("Caches secrets", func() {
+			s, err := store.StoredSecret(ctx, "fake://a")
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte("v1"))
+			s, err = store.StoredSecret(ctx, "fake://a")
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte("v1"))
+			So(backend.fetches, ShouldEqual, 1)
+		})
+
+		Convey("Missing secrets", func() {
+			_, err := store.StoredSecret(ctx, "fake://missing")
+			So(err, ShouldEqual, ErrNoSuchSecret)
+		})
+
+		Convey("Bad references", func() {
+			_, err := store.StoredSecret(ctx, "sm://a")
+			So(err, ShouldErrLike, "not supported secret reference")
+			_, err = store.StoredSecret(ctx, "fake://")
+			So(err, ShouldErrLike, "not supported secret reference")
+		})
+
+		Convey("Dev secrets", func() {
+			s, err := store.StoredSecret(ctx, "devsecret-text://hi")
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte("hi"))
+			So(store.AddRotationHandler(ctx, "devsecret-text://hi", func(context.Context, Secret) {}), ShouldBeNil)
+			So(store.Refresh(ctx, "devsecret-text://hi"), ShouldBeNil)
+			So(backend.fetches, ShouldEqual, 0)
+		})
+
+		Convey("Refresh calls rotation handlers", func() {
+			var calls []Secret
+			So(store.AddRotationHandler(ctx, "fake://a", func(_ context.Context, s Secret) {
+				calls = append(calls, s)
+			}), ShouldBeNil)
+
+			// Not loaded yet: nothing to refresh.
+			So(store.Refresh(ctx, "fake://a"), ShouldBeNil)
+			So(backend.fetches, ShouldEqual, 0)
+
+			_, err := store.StoredSecret(ctx, "fake://a")
+			So(err, ShouldBeNil)
+
+			// Unchanged.
+			So(store.Refresh(ctx, "fake://a"), ShouldBeNil)
+			So(calls, ShouldHaveLength, 0)
+
+			// Changed.
+			rotated := Secret{Active: []byte("v2"), Passive: [][]byte{[]byte("v1")}}
+			backend.set("a", rotated)
+			So(store.Refresh(ctx, "fake://a"), ShouldBeNil)
+			So(calls, ShouldResemble, []Secret{rotated})
+
+			s, err := store.StoredSecret(ctx, "fake://a")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, rotated)
+		})
+
+		Convey("Derived secrets follow the root secret", func() {
+			backend.set("root", Secret{Active: []byte("root1")})
+			So(store.LoadRootSecret(ctx, "fake://root"), ShouldBeNil)
+
+			s1, err := store.RandomSecret(ctx, "derived")
+			So(err, ShouldBeNil)
+
+			backend.set("root", Secret{Active: []byte("root2")})
+			So(store.Refresh(ctx, "fake://root"), ShouldBeNil)
+
+			s2, err := store.RandomSecret(ctx, "derived")
+			So(err, ShouldBeNil)
+			So(s2.Active, ShouldNotResemble, s1.Active)
+		})
+
+		Convey("MaintenanceLoop polls", func() {
+			ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			_, err := store.StoredSecret(ctx, "fake://a")
+			So(err, ShouldBeNil)
+
+			rotated := make(chan Secret, 1)
+			So(store.AddRotationHandler(ctx, "fake://a", func(_ context.Context, s Secret) {
+				rotated <- s
+			}), ShouldBeNil)
+			backend.set("a", Secret{Active: []byte("v2")})
+
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				tc.Add(d)
+			})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				store.MaintenanceLoop(ctx)
+			}()
+
+			So((<-rotated).Active, ShouldResemble, []byte("v2"))
+			cancel()
+			<-done
+		})
+	})
+}
+
+func TestFileBackend(t *testing.T) {
+	t.Parallel()
+
+	Convey("With files", t, func() {
+		ctx := context.Background()
+		root := t.TempDir()
+		b := &FileBackend{Root: root}
+
+		write := func(path, body string) {
+			path = filepath.Join(root, filepath.FromSlash(path))
+			So(os.MkdirAll(filepath.Dir(path), 0700), ShouldBeNil)
+			So(os.WriteFile(path, []byte(body), 0600), ShouldBeNil)
+		}
+
+		Convey("Single file", func() {
+			write("a/b", "secret\n")
+			s, err := b.Fetch(ctx, "a/b")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, Secret{Active: []byte("secret\n")})
+		})
+
+		Convey("Directory", func() {
+			write("dir/current", "cur")
+			write("dir/previous", "prev")
+			write("dir/next", "next")
+			s, err := b.Fetch(ctx, "dir")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, Secret{
+				Active:  []byte("cur"),
+				Passive: [][]byte{[]byte("prev"), []byte("next")},
+			})
+		})
+
+		Convey("Missing", func() {
+			_, err := b.Fetch(ctx, "missing")
+			So(err, ShouldEqual, ErrNoSuchSecret)
+			write("dir/previous", "prev")
+			_, err = b.Fetch(ctx, "dir")
+			So(err, ShouldEqual, ErrNoSuchSecret)
+		})
+
+		Convey("Outside of the root", func() {
+			_, err := b.Fetch(ctx, "../zzz")
+			So(err, ShouldErrLike, "outside of the root directory")
+		})
+
+		Convey("Absolute paths", func() {
+			write("abs", "abs")
+			b := &FileBackend{}
+			s, err := b.Fetch(ctx, filepath.ToSlash(filepath.Join(root, "abs")))
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte("abs"))
+			_, err = b.Fetch(ctx, "relative")
+			So(err, ShouldErrLike, "must be absolute")
+		})
+
+		Convey("Rotation", func() {
+			ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			write("dir/current", "v1")
+
+			store := &BackendStore{Backend: b}
+			s, err := store.StoredSecret(ctx, "file://dir")
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte("v1"))
+
+			rotated := make(chan Secret, 1)
+			So(store.AddRotationHandler(ctx, "file://dir", func(_ context.Context, s Secret) {
+				rotated <- s
+			}), ShouldBeNil)
+
+			// Rotate the secret once the watcher recorded the initial state.
+			var once sync.Once
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				once.Do(func() {
+					dir := filepath.Join(root, "dir")
+					if err := os.WriteFile(filepath.Join(dir, "current"), []byte("v2-longer"), 0600); err != nil {
+						panic(err)
+					}
+					if err := os.WriteFile(filepath.Join(dir, "previous"), []byte("v1"), 0600); err != nil {
+						panic(err)
+					}
+				})
+				tc.Add(d)
+			})
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				store.MaintenanceLoop(ctx)
+			}()
+
+			So(<-rotated, ShouldResemble, Secret{
+				Active:  []byte("v2-longer"),
+				Passive: [][]byte{[]byte("v1")},
+			})
+			cancel()
+			<-done
+		})
+	})
+}
@@ -87,4 +87,33 @@
 //	go run main.go rotation-begin sm://<cloud-project>/root-secret
 //	# wait several hours for the new key to propagate into all caches
 //	go run main.go rotation-end sm://<cloud-project>/root-secret
+//
+// # Other secret stores
+//
+// Servers running outside of Google Cloud can use `-secrets-store` flag to
+// read secrets from local files or from HashiCorp Vault instead of Google
+// Secret Manager.
+//
+// With `-secrets-store file` secrets are referred to as `file://<path>`, where
+// the path is relative to `-secrets-file-root` directory. A secret is either a
+// file with the secret value or a directory with files `current`, `previous`
+// and `next`. The latter allows graceful rotation: put the new value into
+// `next`, wait for it to propagate, then move it to `current` and the old
+// value to `previous`. This layout works well with secrets mounted from
+// Kubernetes Secret objects.
+//
+// With `-secrets-store vault` secrets are referred to as `vault://<path>`,
+// where the path is a path of a secret within the KV v2 engine mounted at
+// `-secrets-vault-mount` (default is `secret`). The secret value is read from
+// its `value` field. The latest version of the Vault secret is the current
+// value and the immediately preceding version (if it is not deleted) is the
+// previous one, so writing a new version of the Vault secret rotates it.
+// The Vault address and the token are taken from `-secrets-vault-addr` and
+// `-secrets-vault-token-file` flags or from VAULT_ADDR and VAULT_TOKEN
+// environment variables.
+//
+// In both cases the server watches the store for changes and reloads secrets
+// (including the root secret and the primary Tink AEAD keyset) without a
+// restart, calling registered rotation handlers. BackendStore.Refresh can be
+// used to push a rotation notification explicitly, e.g. from a webhook.
 package secrets
@@ -0,0 +1,173 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+)
+
+// FileBackend is a Backend that reads secrets from local files.
+//
+// It handles secret references of the form "file://<path>". The path is
+// relative to Root, or absolute if Root is empty (e.g. "file:///etc/secret").
+//
+// A secret is either a file, in which case its content is the active value of
+// the secret, or a directory with files "current" (the active value), and
+// optional "previous" and "next" (passive values). The latter is convenient
+// for secrets mounted from Kubernetes Secret objects.
+//
+// File contents are used as is, including any trailing new lines.
+type FileBackend struct {
+	// Root is a directory with secrets.
+	//
+	// If empty, paths in secret references must be absolute.
+	Root string
+
+	// WatchInterval is how often to check files for modifications.
+	//
+	// Default is 10 sec.
+	WatchInterval time.Duration
+}
+
+var _ Backend = (*FileBackend)(nil)
+
+// fileVersions are names of files within a secret directory.
+var fileVersions = []string{"current", "previous", "next"}
+
+// Scheme implements Backend.
+func (*FileBackend) Scheme() string {
+	return "file"
+}
+
+// Fetch implements Backend.
+func (b *FileBackend) Fetch(ctx context.Context, name string) (Secret, error) {
+	path, err := b.path(name)
+	if err != nil {
+		return Secret{}, err
+	}
+
+	st, err := os.Stat(path)
+	switch {
+	case os.IsNotExist(err):
+		return Secret{}, ErrNoSuchSecret
+	case err != nil:
+		return Secret{}, err
+	case !st.IsDir():
+		blob, err := os.ReadFile(path)
+		if err != nil {
+			return Secret{}, err
+		}
+		return Secret{Active: blob}, nil
+	}
+
+	var secret Secret
+	for _, ver := range fileVersions {
+		blob, err := os.ReadFile(filepath.Join(path, ver))
+		switch {
+		case os.IsNotExist(err) && ver == "current":
+			return Secret{}, ErrNoSuchSecret
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			return Secret{}, err
+		case ver == "current":
+			secret.Active = blob
+		default:
+			secret.Passive = append(secret.Passive, blob)
+		}
+	}
+	return secret, nil
+}
+
+// Watch implements Backend.
+//
+// Periodically checks modification times and sizes of files.
+func (b *FileBackend) Watch(ctx context.Context, names func() []string, changed func(name string)) {
+	interval := b.WatchInterval
+	if interval == 0 {
+		interval = 10 * time.Second
+	}
+
+	seen := map[string]string{}
+	for {
+		tracked := names()
+		for _, name := range tracked {
+			sig := b.signature(name)
+			if prev, ok := seen[name]; ok && prev != sig {
+				changed(name)
+			}
+			seen[name] = sig
+		}
+		if len(seen) > len(tracked) {
+			// Forget secrets that are no longer tracked.
+			keep := make(map[string]bool, len(tracked))
+			for _, name := range tracked {
+				keep[name] = true
+			}
+			for name := range seen {
+				if !keep[name] {
+					delete(seen, name)
+				}
+			}
+		}
+		if res := <-clock.After(ctx, interval); res.Err != nil {
+			return
+		}
+	}
+}
+
+// path returns a path to the secret file or directory.
+func (b *FileBackend) path(name string) (string, error) {
+	path := filepath.FromSlash(name)
+	if b.Root == "" {
+		if !filepath.IsAbs(path) {
+			return "", errors.Reason("the secret path %q must be absolute", name).Err()
+		}
+		return filepath.Clean(path), nil
+	}
+	path = filepath.Join(b.Root, path)
+	if rel, err := filepath.Rel(b.Root, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Reason("the secret path %q is outside of the root directory", name).Err()
+	}
+	return path, nil
+}
+
+// signature returns a string that changes when the secret files change.
+func (b *FileBackend) signature(name string) string {
+	path, err := b.path(name)
+	if err != nil {
+		return ""
+	}
+	stat := func(path string) string {
+		st, err := os.Stat(path)
+		if err != nil {
+			return "-"
+		}
+		return fmt.Sprintf("%d/%d", st.ModTime().UnixNano(), st.Size())
+	}
+	sig := stat(path)
+	for _, ver := range fileVersions {
+		sig += ";" + stat(filepath.Join(path, ver))
+	}
+	return sig
+}
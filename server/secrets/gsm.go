@@ -358,43 +358,53 @@ func (sm *SecretManagerStore) normalizeName(name string) (string, error) {
 
 // readSecret fetches a secret given its normalized name.
 func (sm *SecretManagerStore) readSecret(ctx context.Context, name string) (*trackedSecret, error) {
+	if isDevSecret(name) {
+		value, err := readDevSecret(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return &trackedSecret{name: name, value: value}, nil
+	}
+	if strings.HasPrefix(name, "sm://") {
+		return sm.readSecretFromGSM(ctx, name)
+	}
+	panic("impossible, already checked in normalizeSecretName")
+}
+
+// isDevSecret is true for static development secrets, i.e. "devsecret...://".
+func isDevSecret(name string) bool {
+	return strings.HasPrefix(name, "devsecret://") ||
+		strings.HasPrefix(name, "devsecret-gen://") ||
+		strings.HasPrefix(name, "devsecret-text://")
+}
+
+// readDevSecret returns a static development secret given its name.
+func readDevSecret(ctx context.Context, name string) (Secret, error) {
 	switch {
 	case strings.HasPrefix(name, "devsecret://"):
 		value, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(name, "devsecret://"))
 		if err != nil {
-			return nil, errors.Annotate(err, "bad devsecret://, not base64 encoding").Err()
+			return Secret{}, errors.Annotate(err, "bad devsecret://, not base64 encoding").Err()
 		}
-		return &trackedSecret{
-			name:  name,
-			value: Secret{Active: value},
-		}, nil
+		return Secret{Active: value}, nil
 
 	case strings.HasPrefix(name, "devsecret-gen://"):
 		switch kind := strings.TrimPrefix(name, "devsecret-gen://"); kind {
 		case "tink/aead":
 			value, err := generateDevTinkAEADKeyset(ctx)
 			if err != nil {
-				return nil, errors.Annotate(err, "failed to generate new tink AEAD keyset").Err()
+				return Secret{}, errors.Annotate(err, "failed to generate new tink AEAD keyset").Err()
 			}
-			return &trackedSecret{
-				name:  name,
-				value: Secret{Active: value},
-			}, nil
+			return Secret{Active: value}, nil
 		default:
-			return nil, errors.Reason("devsecret-gen:// kind %q is not supported", kind).Err()
+			return Secret{}, errors.Reason("devsecret-gen:// kind %q is not supported", kind).Err()
 		}
 
 	case strings.HasPrefix(name, "devsecret-text://"):
-		return &trackedSecret{
-			name:  name,
-			value: Secret{Active: []byte(strings.TrimPrefix(name, "devsecret-text://"))},
-		}, nil
-
-	case strings.HasPrefix(name, "sm://"):
-		return sm.readSecretFromGSM(ctx, name)
+		return Secret{Active: []byte(strings.TrimPrefix(name, "devsecret-text://"))}, nil
 
 	default:
-		panic("impossible, already checked in normalizeSecretName")
+		return Secret{}, errors.Reason("not a devsecret reference %q", name).Err()
 	}
 }
 
@@ -17,6 +17,7 @@ package secrets
 import (
 	"context"
 	"flag"
+	"os"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"google.golang.org/api/option"
@@ -60,6 +61,38 @@ type ModuleOptions struct {
 	// depends on a presence of an AEAD implementation must check that the return
 	// value of PrimaryTinkAEAD is not nil during startup.
 	PrimaryTinkAEADKey string
+
+	// Store is a kind of the store with secrets: "gsm" (default), "file" or
+	// "vault".
+	//
+	// "gsm" uses Google Secret Manager and handles "sm://..." references.
+	// "file" reads secrets from local files (see FileBackend) and handles
+	// "file://..." references. "vault" reads secrets from a HashiCorp Vault KV v2
+	// engine (see VaultBackend) and handles "vault://..." references.
+	Store string
+
+	// FileRoot is a directory with secrets when using the "file" store.
+	//
+	// If empty, "file://..." references must use absolute paths.
+	FileRoot string
+
+	// VaultAddr is the address of the Vault server when using the "vault" store.
+	//
+	// Default is the value of VAULT_ADDR environment variable.
+	VaultAddr string
+
+	// VaultTokenFile is a path to a file with the Vault token.
+	//
+	// If empty, the value of VAULT_TOKEN environment variable is used.
+	VaultTokenFile string
+
+	// VaultNamespace is a Vault Enterprise namespace, if any.
+	VaultNamespace string
+
+	// VaultMount is a path where the KV v2 engine is mounted in Vault.
+	//
+	// Default is "secret".
+	VaultMount string
 }
 
 // Register registers the command line flags.
@@ -69,6 +102,7 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		"root-secret",
 		o.RootSecret,
 		`Either "sm://<project>/<secret>" or "sm://<secret>" to use Google Secret Manager, `+
+			`"file://<path>" or "vault://<path>" when using the corresponding -secrets-store, `+
 			`or "devsecret://<base64-encoded value>" or "devsecret-text://<value>" `+
 			`for a static development secret.`,
 	)
@@ -84,10 +118,48 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 			`devsecret-gen://tink/aead to automatically generate a new random key, `+
 			`which you can then re-use via devsecret:// in the future.`,
 	)
+	f.StringVar(
+		&o.Store,
+		"secrets-store",
+		o.Store,
+		`A kind of the secrets store: "gsm" (Google Secret Manager, default), `+
+			`"file" (local files) or "vault" (HashiCorp Vault KV v2).`,
+	)
+	f.StringVar(
+		&o.FileRoot,
+		"secrets-file-root",
+		o.FileRoot,
+		`A directory with secrets when using -secrets-store=file.`,
+	)
+	f.StringVar(
+		&o.VaultAddr,
+		"secrets-vault-addr",
+		o.VaultAddr,
+		`Address of the Vault server when using -secrets-store=vault. Default is $VAULT_ADDR.`,
+	)
+	f.StringVar(
+		&o.VaultTokenFile,
+		"secrets-vault-token-file",
+		o.VaultTokenFile,
+		`A file with the Vault token when using -secrets-store=vault. Default is to use $VAULT_TOKEN.`,
+	)
+	f.StringVar(
+		&o.VaultNamespace,
+		"secrets-vault-namespace",
+		o.VaultNamespace,
+		`Vault Enterprise namespace, if any.`,
+	)
+	f.StringVar(
+		&o.VaultMount,
+		"secrets-vault-mount",
+		o.VaultMount,
+		`A path where Vault KV v2 engine is mounted. Default is "secret".`,
+	)
 }
 
 // NewModule returns a server module that adds a secret store backed by Google
-// Secret Manager to the global server context.
+// Secret Manager (or local files or Vault, see ModuleOptions.Store) to the
+// global server context.
 func NewModule(opts *ModuleOptions) module.Module {
 	if opts == nil {
 		opts = &ModuleOptions{}
@@ -127,6 +199,59 @@ func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts mo
 		m.opts.RootSecret = "devsecret-text://phony-root-secret-do-not-depend-on"
 	}
 
+	var store interface {
+		Store
+		LoadRootSecret(ctx context.Context, rootSecret string) error
+		MaintenanceLoop(ctx context.Context)
+	}
+
+	switch m.opts.Store {
+	case "", "gsm":
+		gsm, err := m.secretManagerStore(ctx, host, opts)
+		if err != nil {
+			return nil, err
+		}
+		store = gsm
+	case "file":
+		store = &BackendStore{Backend: &FileBackend{Root: m.opts.FileRoot}}
+	case "vault":
+		backend, err := m.vaultBackend()
+		if err != nil {
+			return nil, err
+		}
+		store = &BackendStore{Backend: backend}
+	default:
+		return nil, errors.Reason("unknown secrets store %q", m.opts.Store).Err()
+	}
+	ctx = Use(ctx, store)
+
+	if m.opts.RootSecret != "" {
+		if err := store.LoadRootSecret(ctx, m.opts.RootSecret); err != nil {
+			return nil, errors.Annotate(err, "failed to initialize the secret store").Err()
+		}
+	}
+
+	if m.opts.PrimaryTinkAEADKey != "" {
+		aead, err := LoadTinkAEAD(ctx, m.opts.PrimaryTinkAEADKey)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to initialize the primary tink AEAD key").Err()
+		}
+		ctx = setPrimaryTinkAEAD(ctx, aead)
+	}
+
+	host.RunInBackground("luci.secrets", store.MaintenanceLoop)
+
+	if gsm, ok := store.(*SecretManagerStore); ok {
+		// Report initial values of metrics and refresh them on every tsmon flush.
+		gsm.ReportMetrics(ctx)
+		tsmon.RegisterCallbackIn(ctx, gsm.ReportMetrics)
+	}
+
+	return ctx, nil
+}
+
+// secretManagerStore creates a store backed by Google Secret Manager.
+func (m *serverModule) secretManagerStore(ctx context.Context, host module.Host, opts module.HostOptions) (*SecretManagerStore, error) {
 	ts, err := auth.GetTokenSource(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to initialize the token source").Err()
@@ -142,31 +267,41 @@ func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts mo
 	}
 	host.RegisterCleanup(func(context.Context) { client.Close() })
 
-	store := &SecretManagerStore{
+	return &SecretManagerStore{
 		CloudProject:        opts.CloudProject,
 		AccessSecretVersion: client.AccessSecretVersion,
+	}, nil
+}
+
+// vaultBackend creates a Vault backend based on the module options.
+func (m *serverModule) vaultBackend() (*VaultBackend, error) {
+	addr := m.opts.VaultAddr
+	if addr == "" {
+		addr = os.Getenv("VAULT_ADDR")
+	}
+	if addr == "" {
+		return nil, errors.Reason("-secrets-vault-addr or $VAULT_ADDR is required when using the vault secrets store").Err()
 	}
-	ctx = Use(ctx, store)
 
-	if m.opts.RootSecret != "" {
-		if err := store.LoadRootSecret(ctx, m.opts.RootSecret); err != nil {
-			return nil, errors.Annotate(err, "failed to initialize the secret store").Err()
-		}
+	backend := &VaultBackend{
+		Addr:      addr,
+		Namespace: m.opts.VaultNamespace,
+		Mount:     m.opts.VaultMount,
 	}
 
-	if m.opts.PrimaryTinkAEADKey != "" {
-		aead, err := LoadTinkAEAD(ctx, m.opts.PrimaryTinkAEADKey)
-		if err != nil {
-			return nil, errors.Annotate(err, "failed to initialize the primary tink AEAD key").Err()
+	if m.opts.VaultTokenFile != "" {
+		// The file is re-read on every request to pick up renewed tokens. Check
+		// it is readable now to fail fast on misconfiguration.
+		backend.TokenFile = m.opts.VaultTokenFile
+		if _, err := backend.token(); err != nil {
+			return nil, err
+		}
+	} else {
+		backend.Token = os.Getenv("VAULT_TOKEN")
+		if backend.Token == "" {
+			return nil, errors.Reason("-secrets-vault-token-file or $VAULT_TOKEN is required when using the vault secrets store").Err()
 		}
-		ctx = setPrimaryTinkAEAD(ctx, aead)
 	}
 
-	host.RunInBackground("luci.secrets", store.MaintenanceLoop)
-
-	// Report initial values of metrics and refresh them on every tsmon flush.
-	store.ReportMetrics(ctx)
-	tsmon.RegisterCallbackIn(ctx, store.ReportMetrics)
-
-	return ctx, nil
+	return backend, nil
 }
@@ -0,0 +1,274 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+)
+
+// VaultBackend is a Backend that reads secrets from a HashiCorp Vault KV v2
+// secrets engine (or anything that implements its HTTP API).
+//
+// It handles secret references of the form "vault://<path>", where <path> is
+// a path of the secret within the KV engine mount.
+//
+// The latest version of the Vault secret is used as the active value of the
+// secret and the version before it (if it is not deleted) as the passive
+// value. This allows graceful rotation by writing a new version of the Vault
+// secret.
+type VaultBackend struct {
+	// Addr is the Vault server address, e.g. "https://vault.example.com:8200".
+	Addr string
+
+	// Token is a Vault token to authenticate with.
+	//
+	// Ignored if TokenFile is set.
+	Token string
+
+	// TokenFile is a path to a file with a Vault token to authenticate with.
+	//
+	// The file is read before every request, since tokens expire and tools like
+	// Vault Agent rewrite the file when renewing them.
+	TokenFile string
+
+	// Namespace is a Vault Enterprise namespace, if any.
+	Namespace string
+
+	// Mount is a path where the KV v2 engine is mounted. Default is "secret".
+	Mount string
+
+	// Field is a field of the Vault secret with the value. Default is "value".
+	Field string
+
+	// Base64 is true if values are base64-encoded (e.g. binary blobs).
+	Base64 bool
+
+	// Client is an HTTP client to use. Default is http.DefaultClient.
+	Client *http.Client
+
+	// WatchInterval is how often to check secrets for new versions.
+	//
+	// Default is 30 sec.
+	WatchInterval time.Duration
+}
+
+var _ Backend = (*VaultBackend)(nil)
+
+// Scheme implements Backend.
+func (*VaultBackend) Scheme() string {
+	return "vault"
+}
+
+// Fetch implements Backend.
+func (b *VaultBackend) Fetch(ctx context.Context, name string) (Secret, error) {
+	cur, err := b.readVersion(ctx, name, 0)
+	if err != nil {
+		return Secret{}, err
+	}
+	if cur == nil {
+		return Secret{}, ErrNoSuchSecret
+	}
+	secret := Secret{Active: cur.value}
+	if cur.version > 1 {
+		switch prev, err := b.readVersion(ctx, name, cur.version-1); {
+		case err != nil:
+			return Secret{}, err
+		case prev != nil:
+			secret.Passive = [][]byte{prev.value}
+		}
+	}
+	return secret, nil
+}
+
+// Watch implements Backend.
+//
+// Periodically checks current versions of secrets via the metadata endpoint.
+func (b *VaultBackend) Watch(ctx context.Context, names func() []string, changed func(name string)) {
+	interval := b.WatchInterval
+	if interval == 0 {
+		interval = 30 * time.Second
+	}
+
+	seen := map[string]int64{}
+	for {
+		tracked := names()
+		for _, name := range tracked {
+			ver, err := b.currentVersion(ctx, name)
+			if err != nil {
+				logging.Warningf(ctx, "Failed to check the version of vault://%s: %s", name, err)
+				continue
+			}
+			if prev, ok := seen[name]; ok && prev != ver {
+				changed(name)
+			}
+			seen[name] = ver
+		}
+		if res := <-clock.After(ctx, interval); res.Err != nil {
+			return
+		}
+	}
+}
+
+// vaultVersion is a single version of a Vault secret.
+type vaultVersion struct {
+	version int64
+	value   []byte
+}
+
+// readVersion reads the given version of the secret (or the latest if 0).
+//
+// Returns nil if it doesn't exist or was deleted.
+func (b *VaultBackend) readVersion(ctx context.Context, name string, version int64) (*vaultVersion, error) {
+	var query url.Values
+	if version != 0 {
+		query = url.Values{"version": {fmt.Sprintf("%d", version)}}
+	}
+	var resp struct {
+		Data *struct {
+			Data     map[string]any `json:"data"`
+			Metadata struct {
+				Version int64 `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	switch found, err := b.call(ctx, "data", name, query, &resp); {
+	case err != nil:
+		return nil, err
+	case !found || resp.Data == nil || resp.Data.Data == nil:
+		return nil, nil // missing or deleted
+	}
+
+	field := b.Field
+	if field == "" {
+		field = "value"
+	}
+	str, ok := resp.Data.Data[field].(string)
+	if !ok {
+		return nil, errors.Reason("vault secret %q has no string field %q", name, field).Err()
+	}
+	value := []byte(str)
+	if b.Base64 {
+		var err error
+		if value, err = base64.StdEncoding.DecodeString(str); err != nil {
+			return nil, errors.Annotate(err, "vault secret %q field %q is not base64", name, field).Err()
+		}
+	}
+	return &vaultVersion{version: resp.Data.Metadata.Version, value: value}, nil
+}
+
+// currentVersion returns the current version of the secret or 0 if missing.
+func (b *VaultBackend) currentVersion(ctx context.Context, name string) (int64, error) {
+	var resp struct {
+		Data struct {
+			CurrentVersion int64 `json:"current_version"`
+		} `json:"data"`
+	}
+	if _, err := b.call(ctx, "metadata", name, nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Data.CurrentVersion, nil
+}
+
+// token returns the Vault token to use for a request.
+func (b *VaultBackend) token() (string, error) {
+	if b.TokenFile == "" {
+		return b.Token, nil
+	}
+	blob, err := os.ReadFile(b.TokenFile)
+	if err != nil {
+		// The file may be in the middle of being rewritten.
+		return "", errors.Annotate(err, "failed to read the Vault token").Tag(transient.Tag).Err()
+	}
+	token := strings.TrimSpace(string(blob))
+	if token == "" {
+		return "", errors.Reason("the Vault token file %q is empty", b.TokenFile).Tag(transient.Tag).Err()
+	}
+	return token, nil
+}
+
+// call calls a KV v2 endpoint, unmarshalling the response into `out`.
+//
+// Returns false if the secret is not found.
+func (b *VaultBackend) call(ctx context.Context, endpoint, name string, query url.Values, out any) (found bool, err error) {
+	mount := b.Mount
+	if mount == "" {
+		mount = "secret"
+	}
+	u := fmt.Sprintf("%s/v1/%s/%s/%s",
+		strings.TrimRight(b.Addr, "/"),
+		strings.Trim(mount, "/"),
+		endpoint,
+		strings.TrimLeft(name, "/"))
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+
+	token, err := b.token()
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return false, errors.Annotate(err, "bad vault request").Err()
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if b.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", b.Namespace)
+	}
+
+	client := b.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, errors.Annotate(err, "vault request failed").Tag(transient.Tag).Err()
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to read vault response").Tag(transient.Tag).Err()
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		// Vault also returns 404 for deleted versions, with metadata in the body.
+		// We don't care about it.
+		return false, nil
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		return false, errors.Reason("vault replied with HTTP %d: %s", resp.StatusCode, body).Tag(transient.Tag).Err()
+	case resp.StatusCode != http.StatusOK:
+		return false, errors.Reason("vault replied with HTTP %d: %s", resp.StatusCode, body).Err()
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return false, errors.Annotate(err, "bad vault response").Err()
+	}
+	return true, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/retry/transient"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeVault implements a subset of Vault KV v2 HTTP API.
+type fakeVault struct {
+	token string
+
+	m        sync.Mutex
+	secrets  map[string][]map[string]any // path => versions (nil if deleted)
+	failNext int                         // HTTP status to reply with next time
+}
+
+func (v *fakeVault) put(path string, data map[string]any) {
+	v.m.Lock()
+	defer v.m.Unlock()
+	if v.secrets == nil {
+		v.secrets = map[string][]map[string]any{}
+	}
+	v.secrets[path] = append(v.secrets[path], data)
+}
+
+func (v *fakeVault) deleteVersion(path string, ver int) {
+	v.m.Lock()
+	defer v.m.Unlock()
+	v.secrets[path][ver-1] = nil
+}
+
+func (v *fakeVault) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	reply := func(code int, body any) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(code)
+		json.NewEncoder(rw).Encode(body)
+	}
+	errs := func(code int, msg string) {
+		reply(code, map[string]any{"errors": []string{msg}})
+	}
+
+	if v.failNext != 0 {
+		code := v.failNext
+		v.failNext = 0
+		errs(code, "failing")
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != v.token {
+		errs(http.StatusForbidden, "permission denied")
+		return
+	}
+
+	var endpoint, path string
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/data/"):
+		endpoint, path = "data", strings.TrimPrefix(r.URL.Path, "/v1/kv/data/")
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/metadata/"):
+		endpoint, path = "metadata", strings.TrimPrefix(r.URL.Path, "/v1/kv/metadata/")
+	default:
+		errs(http.StatusNotFound, "no handler for route")
+		return
+	}
+
+	versions := v.secrets[path]
+	if len(versions) == 0 {
+		errs(http.StatusNotFound, "")
+		return
+	}
+
+	if endpoint == "metadata" {
+		reply(http.StatusOK, map[string]any{
+			"data": map[string]any{"current_version": len(versions)},
+		})
+		return
+	}
+
+	ver := len(versions)
+	if q := r.URL.Query().Get("version"); q != "" {
+		var err error
+		if ver, err = strconv.Atoi(q); err != nil || ver < 1 || ver > len(versions) {
+			errs(http.StatusNotFound, "")
+			return
+		}
+	}
+	meta := map[string]any{"version": ver}
+	if versions[ver-1] == nil {
+		meta["deletion_time"] = "2024-01-01T00:00:00Z"
+		reply(http.StatusNotFound, map[string]any{
+			"data": map[string]any{"data": nil, "metadata": meta},
+		})
+		return
+	}
+	reply(http.StatusOK, map[string]any{
+		"data": map[string]any{"data": versions[ver-1], "metadata": meta},
+	})
+}
+
+func TestVaultBackend(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fake Vault", t, func() {
+		ctx := context.Background()
+
+		vault := &fakeVault{token: "tok"}
+		srv := httptest.NewServer(vault)
+		defer srv.Close()
+
+		b := &VaultBackend{
+			Addr:  srv.URL,
+			Token: "tok",
+			Mount: "kv",
+		}
+
+		Convey("Fetch", func() {
+			vault.put("app/key", map[string]any{"value": "v1"})
+
+			s, err := b.Fetch(ctx, "app/key")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, Secret{Active: []byte("v1")})
+
+			vault.put("app/key", map[string]any{"value": "v2"})
+			s, err = b.Fetch(ctx, "app/key")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, Secret{
+				Active:  []byte("v2"),
+				Passive: [][]byte{[]byte("v1")},
+			})
+
+			vault.put("app/key", map[string]any{"value": "v3"})
+			vault.deleteVersion("app/key", 2)
+			s, err = b.Fetch(ctx, "app/key")
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, Secret{Active: []byte("v3")})
+		})
+
+		Convey("Missing", func() {
+			_, err := b.Fetch(ctx, "missing")
+			So(err, ShouldEqual, ErrNoSuchSecret)
+		})
+
+		Convey("Custom field and base64", func() {
+			vault.put("bin", map[string]any{"blob": base64.StdEncoding.EncodeToString([]byte{0, 1, 2})})
+			b.Field = "blob"
+			b.Base64 = true
+			s, err := b.Fetch(ctx, "bin")
+			So(err, ShouldBeNil)
+			So(s.Active, ShouldResemble, []byte{0, 1, 2})
+
+			b.Field = "zzz"
+			_, err = b.Fetch(ctx, "bin")
+			So(err, ShouldErrLike, `has no string field "zzz"`)
+		})
+
+		Convey("Errors", func() {
+			vault.put("app/key", map[string]any{"value": "v1"})
+
+			b.Token = "wrong"
+			_, err := b.Fetch(ctx, "app/key")
+			So(err, ShouldErrLike, "HTTP 403")
+			So(transient.Tag.In(err), ShouldBeFalse)
+
+			b.Token = "tok"
+			vault.failNext = http.StatusServiceUnavailable
+			_, err = b.Fetch(ctx, "app/key")
+			So(err, ShouldErrLike, "HTTP 503")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Token file is reread", func() {
+			vault.put("app/key", map[string]any{"value": "v1"})
+
+			b.TokenFile = filepath.Join(t.TempDir(), "token")
+			So(os.WriteFile(b.TokenFile, []byte("tok\n"), 0600), ShouldBeNil)
+			_, err := b.Fetch(ctx, "app/key")
+			So(err, ShouldBeNil)
+
+			// The token was renewed and the file was rewritten.
+			vault.token = "renewed"
+			So(os.WriteFile(b.TokenFile, []byte("renewed\n"), 0600), ShouldBeNil)
+			_, err = b.Fetch(ctx, "app/key")
+			So(err, ShouldBeNil)
+
+			So(os.WriteFile(b.TokenFile, nil, 0600), ShouldBeNil)
+			_, err = b.Fetch(ctx, "app/key")
+			So(err, ShouldErrLike, "is empty")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Rotation reloads AEAD keys", func() {
+			ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			newKeyset := func() string {
+				s, err := readDevSecret(ctx, "devsecret-gen://tink/aead")
+				So(err, ShouldBeNil)
+				return string(s.Active)
+			}
+			vault.put("aead", map[string]any{"value": newKeyset()})
+
+			store := &BackendStore{Backend: b}
+			ctx = Use(ctx, store)
+
+			aead, err := LoadTinkAEAD(ctx, "vault://aead")
+			So(err, ShouldBeNil)
+			ciphertext, err := aead.Encrypt([]byte("hello"), nil)
+			So(err, ShouldBeNil)
+
+			rotated := make(chan Secret, 1)
+			So(store.AddRotationHandler(ctx, "vault://aead", func(_ context.Context, s Secret) {
+				rotated <- s
+			}), ShouldBeNil)
+
+			// Rotate the key once the watcher recorded the initial version.
+			var once sync.Once
+			next := newKeyset()
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				once.Do(func() { vault.put("aead", map[string]any{"value": next}) })
+				tc.Add(d)
+			})
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				store.MaintenanceLoop(ctx)
+			}()
+
+			s := <-rotated
+			So(string(s.Active), ShouldEqual, next)
+			cancel()
+			<-done
+
+			// The new key is used for encryption, the old one still decrypts.
+			plaintext, err := aead.Decrypt(ciphertext, nil)
+			So(err, ShouldBeNil)
+			So(string(plaintext), ShouldEqual, "hello")
+
+			fresh, err := LoadTinkAEAD(ctx, "vault://aead")
+			So(err, ShouldBeNil)
+			ciphertext, err = aead.Encrypt([]byte("world"), nil)
+			So(err, ShouldBeNil)
+			plaintext, err = fresh.Decrypt(ciphertext, nil)
+			So(err, ShouldBeNil)
+			So(string(plaintext), ShouldEqual, "world")
+		})
+	})
+}
@@ -1428,6 +1428,9 @@ func (s *Server) ConfigurePRPC(cb func(srv *prpc.Server)) {
 	if s.prpc.UnaryServerInterceptor != nil {
 		panic("use Server.RegisterUnaryServerInterceptors to register interceptors")
 	}
+	if s.prpc.StreamServerInterceptor != nil {
+		panic("use Server.RegisterStreamServerInterceptors to register interceptors")
+	}
 }
 
 // SetRPCAuthMethods overrides how the server authenticates incoming gRPC and
@@ -1517,9 +1520,11 @@ func (s *Server) Serve() error {
 		authInterceptor.Stream(),
 	}, s.streamInterceptors...)
 
-	// Finish setting the pRPC server. It supports only unary RPCs. The root
-	// request context is created in the HTTP land using base HTTP middlewares.
+	// Finish setting the pRPC server. It supports unary and server-streaming
+	// RPCs. The root request context is created in the HTTP land using base
+	// HTTP middlewares.
 	s.prpc.UnaryServerInterceptor = grpcutil.ChainUnaryServerInterceptors(unaryInterceptors...)
+	s.prpc.StreamServerInterceptor = grpcutil.ChainStreamServerInterceptors(streamInterceptors...)
 
 	// Finish setting the gRPC server, if enabled.
 	if s.grpcPort != nil {
@@ -1538,6 +1543,7 @@ func (s *Server) Serve() error {
 				grpcDispatch.Stream(),
 			),
 			grpc.ChainStreamInterceptor(streamInterceptors...),
+			grpc.StatsHandler(&grpcmon.ServerRPCStatsMonitor{}),
 		)
 	}
 
@@ -2882,6 +2888,8 @@ func (s *Server) initMainPort() error {
 		// responses is done by GAE itself and doing it in our code would be
 		// wasteful.
 		EnableResponseCompression: s.Options.Serverless != module.GAE,
+		// Report sizes of requests and responses (before and after compression).
+		StatsHandler: &grpcmon.ServerRPCStatsMonitor{},
 	}
 	discovery.Enable(s.prpc)
 	s.prpc.InstallHandlers(s.Routes, nil)
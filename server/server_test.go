@@ -600,8 +600,31 @@ func TestRPCServers(t *testing.T) {
 					So(resp.Text, ShouldEqual, "root:1:2:3:4")
 				})
 
+				Convey("Panic catcher in stream RPCs", func() {
+					rpcSvc.serverStream = func(req *testpb.Request, ss testpb.Test_ServerStreamServer) error {
+						_ = ss.Send(&testpb.Response{Text: req.Text + ":pong"})
+						panic("BOOM")
+					}
+
+					srv.ServeInBackground()
+					defer srv.StopBackgroundServing()
+
+					ss, err := rpcClient.ServerStream(context.Background(), &testpb.Request{Text: "ping"})
+					So(err, ShouldBeNil)
+
+					resp, err := ss.Recv()
+					So(err, ShouldBeNil)
+					So(resp.Text, ShouldEqual, "ping:pong")
+
+					_, err = ss.Recv()
+					So(err, ShouldHaveGRPCStatus, codes.Internal)
+
+					// Logged the panic.
+					So(srv.stdout.Last(2)[0].Fields["panic.error"], ShouldEqual, "BOOM")
+				})
+
 				if protocol == "prpc" {
-					return // streaming is not support by prpc
+					return // client streaming is not supported by prpc
 				}
 
 				Convey("Context features in stream RPCs", func() {
@@ -642,29 +665,6 @@ func TestRPCServers(t *testing.T) {
 					So(err, ShouldEqual, io.EOF)
 				})
 
-				Convey("Panic catcher in stream RPCs", func() {
-					rpcSvc.serverStream = func(req *testpb.Request, ss testpb.Test_ServerStreamServer) error {
-						_ = ss.Send(&testpb.Response{Text: req.Text + ":pong"})
-						panic("BOOM")
-					}
-
-					srv.ServeInBackground()
-					defer srv.StopBackgroundServing()
-
-					ss, err := rpcClient.ServerStream(context.Background(), &testpb.Request{Text: "ping"})
-					So(err, ShouldBeNil)
-
-					resp, err := ss.Recv()
-					So(err, ShouldBeNil)
-					So(resp.Text, ShouldEqual, "ping:pong")
-
-					_, err = ss.Recv()
-					So(err, ShouldHaveGRPCStatus, codes.Internal)
-
-					// Logged the panic.
-					So(srv.stdout.Last(2)[0].Fields["panic.error"], ShouldEqual, "BOOM")
-				})
-
 				Convey("Stream interceptors", func() {
 					srv.RegisterUnaryServerInterceptors(
 						addingIntr("ignore").Unary(),
@@ -0,0 +1,1001 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperpb"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/spanmapper/internal/tasks"
+	"go.chromium.org/luci/server/tq"
+
+	// Need this to enqueue tasks inside Spanner transactions.
+	_ "go.chromium.org/luci/server/tq/txn/spanner"
+)
+
+// ID identifies a mapper registered in the controller.
+//
+// It will be passed across processes, so all processes that execute mapper jobs
+// should register same mappers under same IDs.
+//
+// The safest approach is to keep mapper IDs in the app unique, e.g. do NOT
+// reuse them when adding new mappers or significantly changing existing ones.
+type ID string
+
+// Mapper applies some function to the given slice of rows.
+//
+// Each row has the primary key columns of the table first (in order of the
+// primary key), followed by JobConfig.Columns. Rows are ordered by the primary
+// key.
+//
+// May be called multiple times for same row (thus should be idempotent).
+//
+// Returning a transient error indicates that the processing of this batch of
+// rows should be retried (even if some rows were processed successfully).
+//
+// Returning a fatal error causes the entire shard (and eventually the entire
+// job) to be marked as failed. The processing of the failed shard stops right
+// away, but other shards are kept running until completion (or their own
+// failure).
+//
+// The function is called outside of any transactions, so it can start its own
+// if needed.
+type Mapper func(ctx context.Context, rows []*spanner.Row) error
+
+// Factory knows how to construct instances of Mapper.
+//
+// Factory is supplied by the users of the library and registered in the
+// controller via RegisterFactory call.
+//
+// It is used to get a mapper to process a set of pages within a shard. It takes
+// a Job (including its Config and Params) and a shard index, so it can prepare
+// the mapper for processing of this specific shard.
+//
+// Returning a transient error triggers an eventual retry. Returning a fatal
+// error causes the shard (eventually the entire job) to be marked as failed.
+type Factory func(ctx context.Context, j *Job, shardIdx int) (Mapper, error)
+
+// Controller is responsible for starting, progressing and finishing mapping
+// jobs.
+//
+// It should be treated as a global singleton object. Having more than one
+// controller in the production application is a bad idea (they'll collide with
+// each other since they use same Spanner tables). It's still useful to
+// instantiate multiple controllers in unit tests.
+type Controller struct {
+	// MapperQueue is a name of the Cloud Tasks queue to use for mapping jobs.
+	//
+	// This queue will perform all "heavy" tasks. It should be configured
+	// appropriately to allow desired number of shards to run in parallel.
+	//
+	// If empty, "default" is used.
+	MapperQueue string
+
+	// ControlQueue is a name of the Cloud Tasks queue to use for control signals.
+	//
+	// This queue is used very lightly when starting, stopping, pausing and
+	// resuming jobs.
+	//
+	// If empty, "default" is used.
+	ControlQueue string
+
+	m       sync.RWMutex
+	mappers map[ID]Factory
+	disp    *tq.Dispatcher
+}
+
+// Install registers task queue task handlers in the given task queue
+// dispatcher.
+//
+// This must be done before Controller is used.
+//
+// There can be at most one Controller installed into an instance of TQ
+// dispatcher. Installing more will cause panics.
+func (ctl *Controller) Install(disp *tq.Dispatcher) {
+	ctl.m.Lock()
+	defer ctl.m.Unlock()
+
+	if ctl.disp != nil {
+		panic("spanmapper.Controller is already installed into a tq.Dispatcher")
+	}
+	ctl.disp = disp
+
+	controlQueue := ctl.ControlQueue
+	if controlQueue == "" {
+		controlQueue = "default"
+	}
+	mapperQueue := ctl.MapperQueue
+	if mapperQueue == "" {
+		mapperQueue = "default"
+	}
+
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-split-and-launch",
+		Prototype: &tasks.SplitAndLaunch{},
+		Kind:      tq.Transactional,
+		Queue:     controlQueue,
+		Handler:   ctl.splitAndLaunchHandler,
+		Quiet:     true,
+	})
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-fan-out-shards",
+		Prototype: &tasks.FanOutShards{},
+		Kind:      tq.Transactional,
+		Queue:     controlQueue,
+		Handler:   ctl.fanOutShardsHandler,
+		Quiet:     true,
+	})
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-resume-shards",
+		Prototype: &tasks.ResumeShards{},
+		Kind:      tq.Transactional,
+		Queue:     controlQueue,
+		Handler:   ctl.resumeShardsHandler,
+		Quiet:     true,
+	})
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-process-shard",
+		Prototype: &tasks.ProcessShard{},
+		Kind:      tq.FollowsContext,
+		Queue:     mapperQueue,
+		Handler:   ctl.processShardHandler,
+		Quiet:     true,
+	})
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-request-job-state-update",
+		Prototype: &tasks.RequestJobStateUpdate{},
+		Kind:      tq.Transactional,
+		Queue:     controlQueue,
+		Handler:   ctl.requestJobStateUpdateHandler,
+		Quiet:     true,
+	})
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "spanmapper-update-job-state",
+		Prototype: &tasks.UpdateJobState{},
+		Kind:      tq.NonTransactional,
+		Queue:     controlQueue,
+		Handler:   ctl.updateJobStateHandler,
+		Quiet:     true,
+	})
+}
+
+// tq returns a dispatcher set in Install or panics if not set yet.
+//
+// Grabs the reader lock inside.
+func (ctl *Controller) tq() *tq.Dispatcher {
+	ctl.m.RLock()
+	defer ctl.m.RUnlock()
+	if ctl.disp == nil {
+		panic("spanmapper.Controller wasn't installed into tq.Dispatcher yet")
+	}
+	return ctl.disp
+}
+
+// installed is true if the controller was installed into a tq.Dispatcher.
+func (ctl *Controller) installed() bool {
+	ctl.m.RLock()
+	defer ctl.m.RUnlock()
+	return ctl.disp != nil
+}
+
+// RegisterFactory adds the given mapper factory to the internal registry.
+//
+// Intended to be used during init() time or early during the process
+// initialization. Panics if a factory with such ID has already been registered.
+//
+// The mapper ID will be used internally to identify which mapper a job should
+// be using. If a factory disappears while the job is running (e.g. if the
+// service binary is updated and new binary doesn't have the mapper registered
+// anymore), the job ends with a failure.
+func (ctl *Controller) RegisterFactory(id ID, m Factory) {
+	ctl.m.Lock()
+	defer ctl.m.Unlock()
+
+	if _, ok := ctl.mappers[id]; ok {
+		panic(fmt.Sprintf("mapper %q is already registered", id))
+	}
+
+	if ctl.mappers == nil {
+		ctl.mappers = make(map[ID]Factory, 1)
+	}
+	ctl.mappers[id] = m
+}
+
+// getFactory returns a registered mapper factory or an error.
+//
+// Grabs the reader lock inside. Can return only fatal errors.
+func (ctl *Controller) getFactory(id ID) (Factory, error) {
+	ctl.m.RLock()
+	defer ctl.m.RUnlock()
+	if m, ok := ctl.mappers[id]; ok {
+		return m, nil
+	}
+	return nil, errors.Reason("no mapper factory with ID %q registered", id).Err()
+}
+
+// initMapper instantiates a Mapper through a registered factory.
+//
+// May return fatal and transient errors.
+func (ctl *Controller) initMapper(ctx context.Context, j *Job, shardIdx int) (Mapper, error) {
+	f, err := ctl.getFactory(j.Config.Mapper)
+	if err != nil {
+		return nil, errors.Annotate(err, "when initializing mapper").Err()
+	}
+	m, err := f(ctx, j, shardIdx)
+	if err != nil {
+		return nil, errors.Annotate(err, "error from mapper factory %q", j.Config.Mapper).Err()
+	}
+	return m, nil
+}
+
+// LaunchJob launches a new mapping job, returning its ID (that can be used to
+// control it or query its status).
+//
+// Launches a Spanner transaction inside.
+func (ctl *Controller) LaunchJob(ctx context.Context, j *JobConfig) (JobID, error) {
+	disp := ctl.tq()
+
+	if err := j.Validate(); err != nil {
+		return 0, errors.Annotate(err, "bad job config").Err()
+	}
+	if _, err := ctl.getFactory(j.Mapper); err != nil {
+		return 0, errors.Annotate(err, "bad job config").Err()
+	}
+
+	// Store the job row under a random ID. Launch a tq task that subdivides the
+	// key space and launches individual shards. We do it asynchronously since
+	// this can be potentially slow (for large tables).
+	var job Job
+	err := runTxn(ctx, func(ctx context.Context) error {
+		now := clock.Now(ctx).UTC()
+		job = Job{
+			ID:      JobID(mathrand.Int63n(math.MaxInt64-1) + 1),
+			Config:  *j,
+			State:   dsmapperpb.State_STARTING,
+			Created: now,
+			Updated: now,
+		}
+		span.BufferWrite(ctx, job.mutation())
+		return disp.AddTask(ctx, &tq.Task{
+			Title: fmt.Sprintf("split:job-%d", job.ID),
+			Payload: &tasks.SplitAndLaunch{
+				JobId: int64(job.ID),
+			},
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return job.ID, nil
+}
+
+// GetJob fetches a previously launched job given its ID.
+//
+// Returns ErrNoSuchJob if not found. All other possible errors are transient
+// and they are marked as such.
+func (ctl *Controller) GetJob(ctx context.Context, id JobID) (*Job, error) {
+	// Even though we could have made getJob public, we want to force API users
+	// to use Controller as a single facade.
+	return getJob(span.Single(ctx), id)
+}
+
+// ListJobs returns up to `limit` most recently created jobs.
+//
+// All possible errors are transient and they are marked as such.
+func (ctl *Controller) ListJobs(ctx context.Context, limit int) ([]*Job, error) {
+	return listJobs(span.Single(ctx), limit)
+}
+
+// AbortJob aborts a job and returns its most recent state.
+//
+// Silently does nothing if the job is finished or already aborted. Aborting
+// a paused job resumes it, so that its shards can notice they are aborted.
+//
+// Returns ErrNoSuchJob is there's no such job at all. All other possible errors
+// are transient and they are marked as such.
+func (ctl *Controller) AbortJob(ctx context.Context, id JobID) (job *Job, err error) {
+	return ctl.jobTxn(ctx, id, func(ctx context.Context, job *Job) (bool, error) {
+		switch {
+		case isFinalState(job.State) || job.State == dsmapperpb.State_ABORTING:
+			return false, nil // nothing to abort, already done
+		case job.State == dsmapperpb.State_STARTING:
+			// Shards haven't been launched yet. Kill the job right away.
+			job.State = dsmapperpb.State_ABORTED
+		case job.State == dsmapperpb.State_RUNNING:
+			// Running shards will discover that the job is aborting and will
+			// eventually move into ABORTED state (notifying the job about it). Once
+			// all shards report they are done, the job itself will switch into
+			// ABORTED state.
+			job.State = dsmapperpb.State_ABORTING
+		}
+		if job.Paused {
+			job.Paused = false
+			if job.State == dsmapperpb.State_ABORTING {
+				return true, ctl.resumeShards(ctx, job.ID)
+			}
+		}
+		return true, nil
+	})
+}
+
+// PauseJob pauses a running job and returns its most recent state.
+//
+// Shards of a paused job checkpoint their progress and stop processing (this
+// may take up to TaskDuration) until the job is resumed via ResumeJob.
+//
+// Silently does nothing if the job is already paused. Returns an error if the
+// job is finished or is being aborted.
+//
+// Returns ErrNoSuchJob is there's no such job at all. All other possible errors
+// are transient and they are marked as such.
+func (ctl *Controller) PauseJob(ctx context.Context, id JobID) (*Job, error) {
+	return ctl.jobTxn(ctx, id, func(ctx context.Context, job *Job) (bool, error) {
+		switch {
+		case job.Paused:
+			return false, nil
+		case job.State != dsmapperpb.State_STARTING && job.State != dsmapperpb.State_RUNNING:
+			return false, errors.Reason("can't pause a job in state %s", job.State).Err()
+		}
+		job.Paused = true
+		return true, nil
+	})
+}
+
+// ResumeJob resumes a paused job and returns its most recent state.
+//
+// Silently does nothing if the job is not paused.
+//
+// Returns ErrNoSuchJob is there's no such job at all. All other possible errors
+// are transient and they are marked as such.
+func (ctl *Controller) ResumeJob(ctx context.Context, id JobID) (*Job, error) {
+	return ctl.jobTxn(ctx, id, func(ctx context.Context, job *Job) (bool, error) {
+		if !job.Paused {
+			return false, nil
+		}
+		job.Paused = false
+		return true, ctl.resumeShards(ctx, job.ID)
+	})
+}
+
+// jobTxn fetches the job and calls the callback to examine or mutate it.
+//
+// If the callback returns true, stores the job. Returns the most recent state
+// of the job or nil if the transaction failed.
+func (ctl *Controller) jobTxn(ctx context.Context, id JobID, cb func(ctx context.Context, job *Job) (bool, error)) (*Job, error) {
+	var job *Job
+	err := runTxn(ctx, func(ctx context.Context) error {
+		var err error
+		if job, err = getJob(ctx, id); err != nil {
+			return err
+		}
+		switch save, err := cb(ctx, job); {
+		case err != nil:
+			return err
+		case save:
+			job.Updated = clock.Now(ctx).UTC()
+			span.BufferWrite(ctx, job.mutation())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // don't return bogus data in case txn failed to land
+	}
+	return job, nil
+}
+
+// resumeShards transactionally enqueues a task that restarts processing of all
+// unfinished shards of the job.
+func (ctl *Controller) resumeShards(ctx context.Context, jobID JobID) error {
+	return ctl.tq().AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("resume:job-%d", jobID),
+		Payload: &tasks.ResumeShards{JobId: int64(jobID)},
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Task queue tasks handlers.
+
+// errJobAborted is used internally as shard failure status when the job is
+// being aborted.
+//
+// It causes the shard to switch into ABORTED state instead of FAIL.
+var errJobAborted = errors.New("the job has been aborted")
+
+// splitSamples is how many keys to sample when splitting a table into shards.
+const splitSamples = 512
+
+// splitAndLaunchHandler splits the job into shards and enqueues tasks that
+// process shards.
+func (ctl *Controller) splitAndLaunchHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.SplitAndLaunch)
+	now := clock.Now(ctx).UTC()
+
+	// Fetch job details. Make sure it isn't canceled and isn't running already.
+	job, err := getJobInState(span.Single(ctx), JobID(msg.JobId), dsmapperpb.State_STARTING)
+	if err != nil || job == nil {
+		return errors.Annotate(err, "in SplitAndLaunch").Err()
+	}
+	table := job.Config.Table
+
+	// Figure out key ranges for shards based on a random sample of keys. There
+	// may be fewer shards than requested if there are too few rows.
+	pk, err := primaryKey(span.Single(ctx), table)
+	if err != nil {
+		return errors.Annotate(err, "in SplitAndLaunch").Err()
+	}
+	samples, err := sampleKeys(span.Single(ctx), table, pk, splitSamples)
+	if err != nil {
+		return errors.Annotate(err, "in SplitAndLaunch").Err()
+	}
+	ranges := splitRanges(samples, job.Config.ShardCount)
+
+	// Estimate number of rows in each shard to track shard processing progress.
+	// If the table is small enough for all its rows to be in the sample, this
+	// is exact.
+	expected := make([]int64, len(ranges))
+	for i := range expected {
+		expected[i] = -1
+	}
+	if job.Config.TrackProgress {
+		logging.Infof(ctx, "Estimating the size of each shard...")
+		total, err := countRows(span.Single(ctx), table)
+		if err != nil {
+			return errors.Annotate(err, "when estimating shard sizes").Err()
+		}
+		estimateShardSizes(ranges, samples, total, expected)
+	}
+
+	shards := make([]*shard, len(ranges))
+	for idx, rng := range ranges {
+		shards[idx] = &shard{
+			JobID:         int64(job.ID),
+			Index:         int64(idx),
+			State:         int64(dsmapperpb.State_STARTING),
+			RangeStart:    rng.Start.nullString(),
+			RangeEnd:      rng.End.nullString(),
+			ExpectedCount: expected[idx],
+			Created:       now,
+			Updated:       now,
+		}
+
+		l, r := "-inf", "+inf"
+		if rng.Start != nil {
+			l = rng.Start.String()
+		}
+		if rng.End != nil {
+			r = rng.End.String()
+		}
+		count := ""
+		if expected[idx] != -1 {
+			count = fmt.Sprintf(" (~%d rows)", expected[idx])
+		}
+		logging.Infof(ctx, "Shard #%d: %s - %s%s", idx, l, r, count)
+	}
+
+	// Transactionally store shards, update the job and launch the TQ task that
+	// kicks off the processing of each individual shard. We use an intermediary
+	// task for this since transactionally launching O(ShardCount) tasks hits TQ
+	// transaction limits.
+	logging.Infof(ctx, "Storing shards and launching the fan out task...")
+	return runTxn(ctx, func(ctx context.Context) error {
+		job, err := getJobInState(ctx, JobID(msg.JobId), dsmapperpb.State_STARTING)
+		if err != nil || job == nil {
+			return errors.Annotate(err, "in SplitAndLaunch txn").Err()
+		}
+
+		ms := make([]*spanner.Mutation, 0, len(shards)+2)
+		ms = append(ms, spanner.Delete(ShardsTable, spanner.Key{int64(job.ID)}.AsPrefix()))
+		for _, sh := range shards {
+			m, err := spanner.InsertOrUpdateStruct(ShardsTable, sh)
+			if err != nil {
+				return errors.Annotate(err, "failed to prepare shard mutation").Err()
+			}
+			ms = append(ms, m)
+		}
+		job.State = dsmapperpb.State_RUNNING
+		job.Updated = now
+		ms = append(ms, job.mutation())
+		span.BufferWrite(ctx, ms...)
+
+		return ctl.tq().AddTask(ctx, &tq.Task{
+			Title: fmt.Sprintf("fanout:job-%d", job.ID),
+			Payload: &tasks.FanOutShards{
+				JobId: int64(job.ID),
+			},
+		})
+	})
+}
+
+// estimateShardSizes estimates number of rows in each range based on how many
+// samples fall into it.
+//
+// Updates `expected` in-place.
+func estimateShardSizes(ranges []keyRange, samples []encodedKey, total int64, expected []int64) {
+	if len(samples) == 0 {
+		expected[0] = total
+		return
+	}
+	// Both samples and ranges are sorted. Ranges cover (Start, End], and range
+	// boundaries are samples themselves (distinct, since they are primary keys).
+	perRange := make([]int64, len(ranges))
+	idx := 0
+	for _, s := range samples {
+		perRange[idx]++
+		if idx < len(ranges)-1 && ranges[idx].End.equal(s) {
+			idx++
+		}
+	}
+	for i, n := range perRange {
+		expected[i] = total * n / int64(len(samples))
+	}
+}
+
+// fanOutShardsHandler fetches a list of shards from the job and launches
+// named ProcessShard tasks, one per shard.
+func (ctl *Controller) fanOutShardsHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.FanOutShards)
+
+	// Make sure the job is still present. If it is aborted, we still need to
+	// launch the shards, so they notice they are being aborted.
+	job, err := getJobInState(span.Single(ctx), JobID(msg.JobId), dsmapperpb.State_RUNNING, dsmapperpb.State_ABORTING)
+	if err != nil || job == nil {
+		return errors.Annotate(err, "in FanOutShards").Err()
+	}
+
+	shards, err := job.fetchShards(span.Single(ctx))
+	if err != nil {
+		return errors.Annotate(err, "in FanOutShards").Err()
+	}
+
+	// Enqueue a bunch of named ProcessShard tasks (one per shard) to actually
+	// launch shard processing. This is idempotent operation, so if FanOutShards
+	// crashes midway and later retried, nothing bad happens.
+	eg, ctx := errgroup.WithContext(ctx)
+	tq := ctl.tq()
+	for _, sh := range shards {
+		task := makeProcessShardTask(job.ID, sh.Index, 0, true)
+		eg.Go(func() error { return tq.AddTask(ctx, task) })
+	}
+	return eg.Wait()
+}
+
+// resumeShardsHandler restarts processing of all unfinished shards of a job.
+//
+// It bumps ProcessTaskNum of each shard, so that any stale ProcessShard tasks
+// that are still in flight are ignored.
+func (ctl *Controller) resumeShardsHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.ResumeShards)
+
+	// Jobs in STARTING state have no shards yet. They will be launched by
+	// FanOutShards.
+	job, err := getJobInState(span.Single(ctx), JobID(msg.JobId), dsmapperpb.State_RUNNING, dsmapperpb.State_ABORTING)
+	if err != nil || job == nil {
+		return errors.Annotate(err, "in ResumeShards").Err()
+	}
+	if job.Paused {
+		logging.Infof(ctx, "The job was paused again, not resuming shards")
+		return nil
+	}
+
+	shards, err := job.fetchShards(span.Single(ctx))
+	if err != nil {
+		return errors.Annotate(err, "in ResumeShards").Err()
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, sh := range shards {
+		if isFinalState(sh.state()) {
+			continue
+		}
+		index := sh.Index
+		eg.Go(func() error {
+			return shardTxn(ctx, job.ID, index, func(ctx context.Context, sh *shard) (bool, error) {
+				sh.ProcessTaskNum++
+				return true, ctl.tq().AddTask(ctx,
+					makeProcessShardTask(job.ID, sh.Index, sh.ProcessTaskNum, false))
+			})
+		})
+	}
+	return eg.Wait()
+}
+
+// processShardHandler reads a bunch of rows (up to PageSize), and hands them
+// to the mapper.
+//
+// After doing this in a loop for 1 min, it checkpoints the state and reenqueues
+// itself to resume mapping in another instance of the task. If the job is
+// paused, the chain of tasks stops after the checkpoint.
+func (ctl *Controller) processShardHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.ProcessShard)
+	jobID := JobID(msg.JobId)
+
+	// Grab the shard. This returns (nil, nil) if this Task Queue task is stale
+	// (based on taskNum) and should be silently skipped.
+	sh, err := getActiveShard(span.Single(ctx), jobID, msg.ShardIndex, msg.TaskNum)
+	if err != nil || sh == nil {
+		return errors.Annotate(err, "when fetching shard state").Err()
+	}
+	ctx = logging.SetField(ctx, "shardIdx", sh.Index)
+
+	logging.Infof(ctx,
+		"Resuming processing of the shard (launched %s ago)",
+		clock.Now(ctx).Sub(sh.Created))
+
+	// Grab the job config, make sure the job is still active.
+	job, err := getJobInState(span.Single(ctx), jobID, dsmapperpb.State_RUNNING, dsmapperpb.State_ABORTING)
+	if err != nil || job == nil {
+		return errors.Annotate(err, "in ProcessShard").Err()
+	}
+
+	// If the job is being killed, kill the shard as well. This will eventually
+	// notify the job about shard's completion. Once all shards are done, the
+	// job will switch into ABORTED state.
+	if job.State == dsmapperpb.State_ABORTING {
+		return ctl.finishShard(ctx, jobID, sh.Index, 0, errJobAborted)
+	}
+
+	// If the job is paused, do nothing. ResumeShards will launch a new chain of
+	// tasks when the job is resumed.
+	if job.Paused {
+		logging.Infof(ctx, "The job is paused")
+		return nil
+	}
+
+	// Prepare the mapper by giving the factory job parameters.
+	mapper, err := ctl.initMapper(ctx, job, int(sh.Index))
+	switch {
+	case transient.Tag.In(err):
+		return errors.Annotate(err, "transient error when instantiating a mapper").Err()
+	case err != nil:
+		// Kill the shard if the factory returns a fatal error.
+		return ctl.finishShard(ctx, jobID, sh.Index, 0, err)
+	}
+
+	// Figure out what to read: the primary key (to know where to resume from)
+	// and all requested columns.
+	pk, err := primaryKey(span.Single(ctx), job.Config.Table)
+	if err != nil {
+		return errors.Annotate(err, "in ProcessShard").Err()
+	}
+	cols := make([]string, 0, len(pk)+len(job.Config.Columns))
+	for _, col := range pk {
+		cols = append(cols, col.Name)
+	}
+	cols = append(cols, job.Config.Columns...)
+
+	rng, err := sh.keyRange()
+	if err != nil {
+		return ctl.finishShard(ctx, jobID, sh.Index, 0, err)
+	}
+	lastKey, err := parseKey(sh.ResumeFrom.StringVal)
+	if err != nil {
+		return ctl.finishShard(ctx, jobID, sh.Index, 0, err)
+	}
+	resumeFrom := sh.ResumeFrom
+
+	shardDone := false    // true when finished processing the shard
+	pageCount := 0        // how many pages processed successfully
+	itemCount := int64(0) // how many rows processed successfully
+
+	// A soft deadline when to checkpoint the progress and reenqueue the
+	// processing task. We never abort processing of a page midway (causes too
+	// many complications), so if the mapper is extremely slow, it may end up
+	// running longer than this deadline.
+	dur := time.Minute
+	if job.Config.TaskDuration > 0 {
+		dur = job.Config.TaskDuration
+	}
+	deadline := clock.Now(ctx).Add(dur)
+
+	// Optionally also put a limit on number of processed pages.
+	pageCountLimit := math.MaxInt32
+	if job.Config.PagesPerTask > 0 {
+		pageCountLimit = job.Config.PagesPerTask
+	}
+
+	for clock.Now(ctx).Before(deadline) && pageCount < pageCountLimit {
+		var kr spanner.KeyRange
+		if kr, err = rng.spannerRange(lastKey); err != nil {
+			err = errors.Annotate(err, "bad key range").Err()
+			break
+		}
+
+		// Fetch next batch of rows. Return an error to the outer scope where it
+		// eventually will bubble up to TQ (so the task is retried with exponential
+		// backoff).
+		logging.Infof(ctx, "Fetching the next batch...")
+		rows := make([]*spanner.Row, 0, job.Config.PageSize)
+		err = span.ReadWithOptions(span.Single(ctx), job.Config.Table, kr, cols, &spanner.ReadOptions{
+			Limit: job.Config.PageSize,
+		}).Do(func(row *spanner.Row) error {
+			rows = append(rows, row)
+			return nil
+		})
+		if err != nil {
+			err = errors.Annotate(err, "when reading rows").Tag(transient.Tag).Err()
+			break
+		}
+
+		// No results within the range? Processing of the shard is complete!
+		if len(rows) == 0 {
+			shardDone = true
+			break
+		}
+
+		var firstKey, pageLastKey encodedKey
+		if firstKey, err = rowKey(rows[0], len(pk)); err == nil {
+			pageLastKey, err = rowKey(rows[len(rows)-1], len(pk))
+		}
+		if err != nil {
+			err = errors.Annotate(err, "when extracting row keys").Err()
+			break
+		}
+
+		// Let the mapper do its thing. Remember where to resume from.
+		logging.Infof(ctx, "Processing %d rows: %s - %s", len(rows), firstKey, pageLastKey)
+		if err = mapper(ctx, rows); err != nil {
+			err = errors.Annotate(err, "while mapping %d rows", len(rows)).Err()
+			break
+		}
+		lastKey = pageLastKey
+		pageCount++
+		itemCount += int64(len(rows))
+
+		// A short page means there's nothing more in the range.
+		if len(rows) < job.Config.PageSize {
+			shardDone = true
+			break
+		}
+	}
+
+	// We are done with the shard when either processed all its range or failed
+	// with a fatal error. finishShard would take care of notifying the parent
+	// job about the shard's completion.
+	if shardDone || (err != nil && !transient.Tag.In(err)) {
+		return ctl.finishShard(ctx, jobID, sh.Index, itemCount, err)
+	}
+
+	if lastKey != nil {
+		logging.Infof(ctx, "The shard processing will resume from %s", lastKey)
+	} else {
+		logging.Infof(ctx, "The shard processing will resume from scratch")
+	}
+
+	// If the shard isn't done and we made no progress at all, then we hit
+	// a transient error. Ask TQ to retry.
+	if pageCount == 0 {
+		return err
+	}
+
+	// Otherwise need to checkpoint the progress and either to retry this task
+	// (on transient errors, to get an exponential backoff from TQ), start a new
+	// task or stop if the job was paused in the meantime.
+	txnErr := shardTxn(ctx, jobID, sh.Index, func(ctx context.Context, sh *shard) (bool, error) {
+		switch {
+		case sh.ProcessTaskNum != msg.TaskNum:
+			logging.Warningf(ctx, "Unexpected shard state: its ProcessTaskNum is %d != %d", sh.ProcessTaskNum, msg.TaskNum)
+			return false, nil // some other task is already running
+		case sh.ResumeFrom != resumeFrom:
+			logging.Warningf(ctx, "Unexpected shard state: its ResumeFrom is %s != %s", sh.ResumeFrom.StringVal, resumeFrom.StringVal)
+			return false, nil // someone already made progress, let them proceed
+		}
+
+		sh.State = int64(dsmapperpb.State_RUNNING)
+		sh.ResumeFrom = lastKey.nullString()
+		sh.ProcessedCount += itemCount
+
+		// If the processing failed, just store the progress, but do not start a
+		// new TQ task. Retry the current task instead (to get exponential backoff).
+		if err != nil {
+			return true, nil
+		}
+
+		// Stop the chain if the job was paused. ResumeShards will start a new one.
+		job, err := getJob(ctx, jobID)
+		switch {
+		case err != nil:
+			return false, err
+		case job.Paused:
+			logging.Infof(ctx, "The job is paused, stopping")
+			return true, nil
+		}
+
+		// Otherwise launch a new task in the chain. This essentially "resets"
+		// the exponential backoff counter.
+		sh.ProcessTaskNum++
+		return true, ctl.tq().AddTask(ctx,
+			makeProcessShardTask(jobID, sh.Index, sh.ProcessTaskNum, false))
+	})
+
+	switch {
+	case err != nil && txnErr == nil:
+		return err
+	case err == nil && txnErr != nil:
+		return errors.Annotate(txnErr, "when storing shard progress").Err()
+	case err != nil && txnErr != nil:
+		return errors.Annotate(txnErr, "when storing shard progress after a transient error (%s)", err).Err()
+	default: // (nil, nil)
+		return nil
+	}
+}
+
+// finishShard marks the shard as finished (with status based on shardErr) and
+// emits a task to update the parent job's status.
+func (ctl *Controller) finishShard(ctx context.Context, jobID JobID, index, processedCount int64, shardErr error) error {
+	err := shardTxn(ctx, jobID, index, func(ctx context.Context, sh *shard) (save bool, err error) {
+		runtime := clock.Now(ctx).Sub(sh.Created)
+		switch {
+		case shardErr == errJobAborted:
+			logging.Warningf(ctx, "The job has been aborted, aborting the shard after it has been running %s", runtime)
+			sh.State = int64(dsmapperpb.State_ABORTED)
+			sh.Error = spanner.NullString{StringVal: errJobAborted.Error(), Valid: true}
+		case shardErr != nil:
+			logging.Errorf(ctx, "The shard processing failed in %s with error: %s", runtime, shardErr)
+			sh.State = int64(dsmapperpb.State_FAIL)
+			sh.Error = spanner.NullString{StringVal: shardErr.Error(), Valid: true}
+		default:
+			logging.Infof(ctx, "The shard processing finished successfully in %s", runtime)
+			sh.State = int64(dsmapperpb.State_SUCCESS)
+		}
+		sh.ProcessedCount += processedCount
+		return true, ctl.requestJobStateUpdate(ctx, jobID, sh.Index)
+	})
+	return errors.Annotate(err, "when marking the shard as finished").Err()
+}
+
+// makeProcessShardTask creates a ProcessShard tq.Task.
+//
+// If 'named' is true, assigns it a name. Tasks are named based on their shard
+// indexes and an index in the chain of ProcessShard tasks (task number), so
+// that on retries we don't rekick already finished tasks.
+func makeProcessShardTask(job JobID, index, taskNum int64, named bool) *tq.Task {
+	t := &tq.Task{
+		Title: fmt.Sprintf("map:job-%d-shard-%d-task-%d", job, index, taskNum),
+		Payload: &tasks.ProcessShard{
+			JobId:      int64(job),
+			ShardIndex: index,
+			TaskNum:    taskNum,
+		},
+	}
+	if named {
+		t.DeduplicationKey = fmt.Sprintf("v1-%d-%d-%d", job, index, taskNum)
+	}
+	return t
+}
+
+// requestJobStateUpdate submits RequestJobStateUpdate task, which eventually
+// causes updateJobStateHandler to execute.
+func (ctl *Controller) requestJobStateUpdate(ctx context.Context, jobID JobID, index int64) error {
+	return ctl.tq().AddTask(ctx, &tq.Task{
+		Title: fmt.Sprintf("notify:job-%d-shard-%d", jobID, index),
+		Payload: &tasks.RequestJobStateUpdate{
+			JobId:      int64(jobID),
+			ShardIndex: index,
+		},
+	})
+}
+
+// requestJobStateUpdateHandler is called whenever state of some shard changes.
+//
+// It forwards this notification to the job (specifically updateJobStateHandler)
+// throttling the rate to ~0.5 QPS to avoid contention on the job's row.
+func (ctl *Controller) requestJobStateUpdateHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.RequestJobStateUpdate)
+
+	// Throttle to once per 2 sec (and make sure it is always in the future). We
+	// rely here on a pretty good (< .5s maximum skew) clock sync on servers.
+	eta := clock.Now(ctx).Unix()
+	eta = (eta/2 + 1) * 2
+	dedupKey := fmt.Sprintf("update-job-state-v1:%d:%d", msg.JobId, eta)
+
+	err := ctl.tq().AddTask(ctx, &tq.Task{
+		DeduplicationKey: dedupKey,
+		Title:            fmt.Sprintf("update:job-%d", msg.JobId),
+		ETA:              time.Unix(eta, 0),
+		Payload:          &tasks.UpdateJobState{JobId: msg.JobId},
+	})
+	return errors.Annotate(err, "when adding UpdateJobState task").Err()
+}
+
+// updateJobStateHandler is called some time later after one or more shards have
+// changed state.
+//
+// It calculates overall job state based on the state of its shards.
+func (ctl *Controller) updateJobStateHandler(ctx context.Context, payload proto.Message) error {
+	msg := payload.(*tasks.UpdateJobState)
+
+	// Get the job and all its shards in their most recent state.
+	job, err := getJobInState(span.Single(ctx), JobID(msg.JobId), dsmapperpb.State_RUNNING, dsmapperpb.State_ABORTING)
+	if err != nil || job == nil {
+		return errors.Annotate(err, "in UpdateJobState").Err()
+	}
+	shards, err := job.fetchShards(span.Single(ctx))
+	if err != nil {
+		return errors.Annotate(err, "failed to fetch shards").Err()
+	}
+
+	// Switch the job into a final state only when all shards are done running.
+	perState := make(map[dsmapperpb.State]int, len(dsmapperpb.State_name))
+	finished := 0
+	for _, sh := range shards {
+		logging.Infof(ctx, "Shard #%d is in state %s", sh.Index, sh.state())
+		perState[sh.state()]++
+		if isFinalState(sh.state()) {
+			finished++
+		}
+	}
+	if finished != len(shards) {
+		return nil
+	}
+
+	jobState := dsmapperpb.State_SUCCESS
+	switch {
+	case perState[dsmapperpb.State_ABORTED] != 0:
+		jobState = dsmapperpb.State_ABORTED
+	case perState[dsmapperpb.State_FAIL] != 0:
+		jobState = dsmapperpb.State_FAIL
+	}
+
+	return runTxn(ctx, func(ctx context.Context) error {
+		job, err := getJobInState(ctx, JobID(msg.JobId), dsmapperpb.State_RUNNING, dsmapperpb.State_ABORTING)
+		if err != nil || job == nil {
+			return errors.Annotate(err, "in UpdateJobState txn").Err()
+		}
+
+		// Make sure an aborting job ends up in aborted state, even if all its
+		// shards manged to finish.
+		if job.State == dsmapperpb.State_ABORTING {
+			job.State = dsmapperpb.State_ABORTED
+		} else {
+			job.State = jobState
+		}
+		job.Paused = false
+		job.Updated = clock.Now(ctx).UTC()
+
+		runtime := job.Updated.Sub(job.Created)
+		switch job.State {
+		case dsmapperpb.State_SUCCESS:
+			logging.Infof(ctx, "The job finished successfully in %s", runtime)
+		case dsmapperpb.State_FAIL:
+			logging.Errorf(ctx, "The job finished with %d shards failing in %s", perState[dsmapperpb.State_FAIL], runtime)
+			for _, sh := range shards {
+				if sh.state() == dsmapperpb.State_FAIL {
+					logging.Errorf(ctx, "Shard #%d error - %s", sh.Index, sh.Error.StringVal)
+				}
+			}
+		case dsmapperpb.State_ABORTED:
+			logging.Warningf(ctx, "The job has been aborted after %s: %d shards succeeded, %d shards failed, %d shards aborted",
+				runtime, perState[dsmapperpb.State_SUCCESS], perState[dsmapperpb.State_FAIL], perState[dsmapperpb.State_ABORTED])
+		}
+
+		span.BufferWrite(ctx, job.mutation())
+		return nil
+	})
+}
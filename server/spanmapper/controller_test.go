@@ -0,0 +1,265 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/spantest"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperpb"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testTable is a table with rows to map over, see init_db.sql.
+const testTable = "SpanMapperTestRows"
+
+// testRowKey identifies a row in testTable.
+type testRowKey struct {
+	Part int64
+	Name string
+}
+
+func TestController(t *testing.T) {
+	Convey("With controller", t, func() {
+		ctx := spantest.SpannerTestContext(t, cleanupDatabase)
+		now := testclock.TestRecentTimeUTC.Truncate(time.Microsecond)
+		ctx, tc := testclock.UseTime(ctx, now)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, tqtesting.ClockTag) {
+				tc.Add(d)
+			}
+		})
+
+		dispatcher := &tq.Dispatcher{}
+		ctx, sched := tq.TestingContext(ctx, dispatcher)
+
+		ctl := Controller{
+			MapperQueue:  "mapper-queue",
+			ControlQueue: "control-queue",
+		}
+		ctl.Install(dispatcher)
+
+		// Rows visited by the mapper, with the number of visits.
+		var m sync.Mutex
+		visited := map[testRowKey]int{}
+		var mapperErr error
+
+		const testMapperID ID = "test-mapper"
+		ctl.RegisterFactory(testMapperID, func(_ context.Context, j *Job, idx int) (Mapper, error) {
+			return func(_ context.Context, rows []*spanner.Row) error {
+				m.Lock()
+				defer m.Unlock()
+				if mapperErr != nil {
+					return mapperErr
+				}
+				for _, row := range rows {
+					var key testRowKey
+					var value spanner.NullInt64
+					if err := row.Columns(&key.Part, &key.Name, &value); err != nil {
+						return err
+					}
+					visited[key]++
+				}
+				return nil
+			}, nil
+		})
+
+		spinUntilDone := func(expectErrors bool) {
+			failed := false
+			sched.TaskFailed = func(ctx context.Context, task *tqtesting.Task) { failed = true }
+			sched.Run(ctx, tqtesting.StopWhenDrained())
+			So(failed, ShouldEqual, expectErrors)
+		}
+
+		// Create a bunch of rows to run the mapper over.
+		const partitions = 5
+		const rowsPerPart = 100
+		var ms []*spanner.Mutation
+		for part := int64(0); part < partitions; part++ {
+			for i := 0; i < rowsPerPart; i++ {
+				ms = append(ms, spanner.Insert(testTable,
+					[]string{"Part", "Name", "Value"},
+					[]any{part, string(rune('a'+i%26)) + string(rune('a'+i/26)), int64(i)},
+				))
+			}
+		}
+		_, err := span.Apply(ctx, ms)
+		So(err, ShouldBeNil)
+
+		cfg := JobConfig{
+			Table:         testTable,
+			Columns:       []string{"Value"},
+			Mapper:        testMapperID,
+			Params:        []byte("zzz"),
+			ShardCount:    4,
+			PageSize:      33, // make it weird to trigger "incomplete" pages
+			PagesPerTask:  2,  // to trigger multiple mapping tasks in a chain
+			TrackProgress: true,
+		}
+
+		Convey("GetJob on missing job", func() {
+			job, err := ctl.GetJob(ctx, 1)
+			So(err, ShouldEqual, ErrNoSuchJob)
+			So(job, ShouldBeNil)
+		})
+
+		Convey("Bad config", func() {
+			bad := cfg
+			bad.Table = "Robert'); DROP TABLE Students;--"
+			_, err := ctl.LaunchJob(ctx, &bad)
+			So(err, ShouldNotBeNil)
+
+			bad = cfg
+			bad.Mapper = "unknown"
+			_, err = ctl.LaunchJob(ctx, &bad)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("LaunchJob works", func() {
+			jobID, err := ctl.LaunchJob(ctx, &cfg)
+			So(err, ShouldBeNil)
+
+			job, err := ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_STARTING)
+			So(job.Config.Table, ShouldEqual, testTable)
+			So(job.Created.Equal(now), ShouldBeTrue)
+
+			jobs, err := ctl.ListJobs(ctx, 10)
+			So(err, ShouldBeNil)
+			So(jobs, ShouldHaveLength, 1)
+			So(jobs[0].ID, ShouldEqual, jobID)
+
+			spinUntilDone(false)
+
+			job, err = ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_SUCCESS)
+
+			// Visited all rows exactly once.
+			So(visited, ShouldHaveLength, partitions*rowsPerPart)
+			for key, count := range visited {
+				if count != 1 {
+					So(key, ShouldBeNil) // to have a nice error message
+				}
+			}
+
+			info, err := job.FetchInfo(ctx)
+			So(err, ShouldBeNil)
+			So(info.State, ShouldEqual, dsmapperpb.State_SUCCESS)
+			So(info.Shards, ShouldHaveLength, 4)
+			So(info.ProcessedEntities, ShouldEqual, partitions*rowsPerPart)
+			So(info.TotalEntities, ShouldBeBetweenOrEqual, partitions*rowsPerPart-4, partitions*rowsPerPart)
+			for _, sh := range info.Shards {
+				So(sh.State, ShouldEqual, dsmapperpb.State_SUCCESS)
+			}
+		})
+
+		Convey("Fatal mapper errors fail the job", func() {
+			mapperErr = errors.New("boom")
+
+			jobID, err := ctl.LaunchJob(ctx, &cfg)
+			So(err, ShouldBeNil)
+			spinUntilDone(false)
+
+			job, err := ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_FAIL)
+
+			info, err := job.FetchInfo(ctx)
+			So(err, ShouldBeNil)
+			for _, sh := range info.Shards {
+				So(sh.State, ShouldEqual, dsmapperpb.State_FAIL)
+				So(sh.Error, ShouldContainSubstring, "boom")
+			}
+		})
+
+		Convey("Pause and resume", func() {
+			jobID, err := ctl.LaunchJob(ctx, &cfg)
+			So(err, ShouldBeNil)
+
+			job, err := ctl.PauseJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.Paused, ShouldBeTrue)
+
+			// Shards are created, but nothing is processed.
+			spinUntilDone(false)
+			job, err = ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_RUNNING)
+			So(visited, ShouldHaveLength, 0)
+
+			job, err = ctl.ResumeJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.Paused, ShouldBeFalse)
+
+			spinUntilDone(false)
+			job, err = ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_SUCCESS)
+			So(visited, ShouldHaveLength, partitions*rowsPerPart)
+		})
+
+		Convey("Abort a paused job", func() {
+			jobID, err := ctl.LaunchJob(ctx, &cfg)
+			So(err, ShouldBeNil)
+
+			_, err = ctl.PauseJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			spinUntilDone(false)
+
+			job, err := ctl.AbortJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_ABORTING)
+			So(job.Paused, ShouldBeFalse)
+
+			spinUntilDone(false)
+			job, err = ctl.GetJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_ABORTED)
+			So(visited, ShouldHaveLength, 0)
+
+			// Can't pause finished jobs.
+			_, err = ctl.PauseJob(ctx, jobID)
+			So(err, ShouldErrLike, "can't pause")
+		})
+
+		Convey("Abort a starting job", func() {
+			jobID, err := ctl.LaunchJob(ctx, &cfg)
+			So(err, ShouldBeNil)
+
+			job, err := ctl.AbortJob(ctx, jobID)
+			So(err, ShouldBeNil)
+			So(job.State, ShouldEqual, dsmapperpb.State_ABORTED)
+
+			spinUntilDone(false)
+			So(visited, ShouldHaveLength, 0)
+		})
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanmapper implements a simple Spanner mapper.
+//
+// It provides a way to apply some function to all rows of some particular
+// Spanner table, in parallel, but with bounded concurrency (to avoid burning
+// through all CPU/Spanner quota at once). This may be useful when examining or
+// mutating large amounts of rows, e.g. when backfilling a new column.
+//
+// It works by sampling primary keys of the table to split the key range into N
+// shards, and launching N worker tasks that each sequentially processes a
+// shard assigned to it, page by page, checkpointing the progress in Spanner.
+// Jobs can be paused, resumed and aborted via Controller methods or via the
+// admin portal page which also shows the progress of recent jobs.
+//
+// The mapper stores its state in two Spanner tables which must be created in
+// the database used by the server (see also init_db.sql):
+//
+//	CREATE TABLE SpanMapperJobs (
+//	    JobID INT64 NOT NULL,
+//	    Config STRING(MAX) NOT NULL,
+//	    State INT64 NOT NULL,
+//	    Paused BOOL NOT NULL,
+//	    Created TIMESTAMP NOT NULL,
+//	    Updated TIMESTAMP NOT NULL,
+//	) PRIMARY KEY (JobID);
+//
+//	CREATE INDEX SpanMapperJobsByCreated ON SpanMapperJobs(Created DESC);
+//
+//	CREATE TABLE SpanMapperShards (
+//	    JobID INT64 NOT NULL,
+//	    ShardIndex INT64 NOT NULL,
+//	    State INT64 NOT NULL,
+//	    Error STRING(MAX),
+//	    ProcessTaskNum INT64 NOT NULL,
+//	    RangeStart STRING(MAX),
+//	    RangeEnd STRING(MAX),
+//	    ResumeFrom STRING(MAX),
+//	    ExpectedCount INT64 NOT NULL,
+//	    ProcessedCount INT64 NOT NULL,
+//	    Created TIMESTAMP NOT NULL,
+//	    Updated TIMESTAMP NOT NULL,
+//	) PRIMARY KEY (JobID, ShardIndex),
+//	  INTERLEAVE IN PARENT SpanMapperJobs ON DELETE CASCADE;
+//
+// Tables being mapped over can have primary keys of INT64, STRING, BYTES,
+// BOOL, FLOAT64, TIMESTAMP, DATE and NUMERIC columns (in any order).
+//
+// The server must also be configured to use server/tq module with Spanner
+// transactional tasks support, see go.chromium.org/luci/server/tq/txn/spanner.
+package spanmapper
@@ -0,0 +1,51 @@
+-- Copyright 2024 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--------------------------------------------------------------------------------
+-- This script initializes Spanner tables required by the Spanner mapper and
+-- used in its tests.
+CREATE TABLE SpanMapperJobs (
+    JobID INT64 NOT NULL,
+    Config STRING(MAX) NOT NULL,
+    State INT64 NOT NULL,
+    Paused BOOL NOT NULL,
+    Created TIMESTAMP NOT NULL,
+    Updated TIMESTAMP NOT NULL,
+) PRIMARY KEY (JobID);
+
+CREATE INDEX SpanMapperJobsByCreated ON SpanMapperJobs(Created DESC);
+
+CREATE TABLE SpanMapperShards (
+    JobID INT64 NOT NULL,
+    ShardIndex INT64 NOT NULL,
+    State INT64 NOT NULL,
+    Error STRING(MAX),
+    ProcessTaskNum INT64 NOT NULL,
+    RangeStart STRING(MAX),
+    RangeEnd STRING(MAX),
+    ResumeFrom STRING(MAX),
+    ExpectedCount INT64 NOT NULL,
+    ProcessedCount INT64 NOT NULL,
+    Created TIMESTAMP NOT NULL,
+    Updated TIMESTAMP NOT NULL,
+) PRIMARY KEY (JobID, ShardIndex),
+  INTERLEAVE IN PARENT SpanMapperJobs ON DELETE CASCADE;
+
+-- A table to run test mappers over.
+CREATE TABLE SpanMapperTestRows (
+    Part INT64 NOT NULL,
+    Name STRING(MAX) NOT NULL,
+    Value INT64,
+) PRIMARY KEY (Part, Name DESC);
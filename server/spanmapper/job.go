@@ -0,0 +1,459 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperpb"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+)
+
+// Names of Spanner tables used by the mapper.
+//
+// If you ever need to change them, change also the package doc and
+// init_db.sql.
+const (
+	JobsTable   = "SpanMapperJobs"
+	ShardsTable = "SpanMapperShards"
+)
+
+// ErrNoSuchJob is returned by GetJob if there's no Job with requested ID.
+var ErrNoSuchJob = errors.New("no such mapping job", tq.Fatal)
+
+// JobConfig defines what a new mapping job should do.
+//
+// It should be supplied by the users of the mapper library.
+type JobConfig struct {
+	Table      string   // a table to map over
+	Columns    []string // columns to fetch in addition to the primary key
+	Mapper     ID       // ID of a registered mapper to apply to rows
+	Params     []byte   // arbitrary user-provided data to pass to the mapper
+	ShardCount int      // number of shards to split the key range into
+	PageSize   int      // how many rows to process at once in each shard
+
+	// Optional parameters below for fine tunning. They have reasonable defaults,
+	// and should generally be not touched.
+
+	// PagesPerTask is how many pages (each of PageSize rows) to process inside
+	// a TQ task.
+	//
+	// Default is unlimited: process until the deadline.
+	PagesPerTask int
+
+	// TaskDuration is how long to run a single mapping TQ task before
+	// checkpointing the state and launching the next mapping TQ task.
+	//
+	// Default is 1 min.
+	TaskDuration time.Duration
+
+	// TrackProgress enables counting rows in the table before launching mappers,
+	// and using it to estimate completion ETA.
+	//
+	// Sizes of individual shards are estimated based on the sample of keys used
+	// to split the table, so they may be imprecise.
+	TrackProgress bool
+}
+
+// Validate returns an error of the config is invalid.
+//
+// Mapper existence is not checked.
+func (jc *JobConfig) Validate() error {
+	switch {
+	case !identRe.MatchString(jc.Table):
+		return errors.Reason("Table %q is not a valid table name", jc.Table).Err()
+	case jc.ShardCount < 1:
+		return errors.Reason("ShardCount should be >= 1, try 8").Err()
+	case jc.PageSize <= 0:
+		return errors.Reason("PageSize should be > 0, try 256").Err()
+	case jc.PagesPerTask < 0:
+		return errors.Reason("PagesPerTask should be >= 0, keep 0 for default").Err()
+	case jc.TaskDuration < 0:
+		return errors.Reason("TaskDuration should be >= 0, keep 0 for default").Err()
+	}
+	for _, col := range jc.Columns {
+		if !identRe.MatchString(col) {
+			return errors.Reason("%q is not a valid column name", col).Err()
+		}
+	}
+	return nil
+}
+
+// JobID identifies a mapping job.
+type JobID int64
+
+// Job is a mapping job (either active or not).
+//
+// It is stored in SpanMapperJobs table. Use Controller and Job methods to work
+// with jobs. Attempting to modify the table directly results in an undefined
+// behavior.
+type Job struct {
+	// ID is a randomly generated unique identifier of the job.
+	ID JobID
+	// Config is the configuration of this job. Doesn't change once set.
+	Config JobConfig
+	// State is used to track job's lifecycle, see the enum.
+	State dsmapperpb.State
+	// Paused is true if processing of the job's shards is paused.
+	Paused bool
+	// Created is when the job was created, FYI.
+	Created time.Time
+	// Updated is when the job was last touched, FYI.
+	Updated time.Time
+}
+
+// jobColumns are columns of SpanMapperJobs table, in order of readJob.
+var jobColumns = []string{"JobID", "Config", "State", "Paused", "Created", "Updated"}
+
+// readJob reads a row of SpanMapperJobs table.
+func readJob(row *spanner.Row) (*Job, error) {
+	var id, state int64
+	var config string
+	job := &Job{}
+	if err := row.Columns(&id, &config, &state, &job.Paused, &job.Created, &job.Updated); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(config), &job.Config); err != nil {
+		return nil, errors.Annotate(err, "bad config of job %d", id).Err()
+	}
+	job.ID = JobID(id)
+	job.State = dsmapperpb.State(state)
+	return job, nil
+}
+
+// mutation returns a mutation that stores the job.
+func (j *Job) mutation() *spanner.Mutation {
+	config, err := json.Marshal(&j.Config)
+	if err != nil {
+		panic(err) // impossible
+	}
+	return spanner.InsertOrUpdate(JobsTable, jobColumns, []any{
+		int64(j.ID), string(config), int64(j.State), j.Paused, j.Created, j.Updated,
+	})
+}
+
+// fetchShards fetches all job shards, ordered by their index.
+func (j *Job) fetchShards(ctx context.Context) ([]*shard, error) {
+	var shards []*shard
+	err := span.Read(ctx, ShardsTable, spanner.Key{int64(j.ID)}.AsPrefix(), shardColumns).Do(
+		func(row *spanner.Row) error {
+			sh := &shard{}
+			if err := row.ToStruct(sh); err != nil {
+				return err
+			}
+			shards = append(shards, sh)
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch shards of job %d", j.ID).Tag(transient.Tag).Err()
+	}
+	return shards, nil
+}
+
+// FetchInfo fetches information about the job (including all shards).
+func (j *Job) FetchInfo(ctx context.Context) (*dsmapperpb.JobInfo, error) {
+	info := &dsmapperpb.JobInfo{
+		Id:            int64(j.ID),
+		State:         j.State,
+		Created:       timestamppb.New(j.Created),
+		Updated:       timestamppb.New(j.Updated),
+		TotalEntities: -1, // assume unknown, will be replaced below if known
+	}
+
+	// Jobs in STARTING state have no shards yet.
+	if j.State == dsmapperpb.State_STARTING {
+		return info, nil
+	}
+
+	shards, err := j.fetchShards(span.Single(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	haveProgress := true // false if at least one shard has unknown ETA
+	updated := j.Updated // will be max(Updated of each shard)
+
+	info.Shards = make([]*dsmapperpb.ShardInfo, len(shards))
+	for i, s := range shards {
+		sh := s.info()
+		info.Shards[i] = sh
+		info.ProcessedEntities += sh.ProcessedEntities
+		if ts := sh.Updated.AsTime(); ts.After(updated) {
+			updated = ts
+		}
+		if sh.TotalEntities == -1 {
+			haveProgress = false
+		}
+	}
+
+	// Calculate the overall rate from scratch, do NOT sum rates of shards,
+	// since it will also sum estimation errors too (which can be wild).
+	info.Updated = timestamppb.New(updated)
+	if runtime := updated.Sub(j.Created); runtime > 0 {
+		info.EntitiesPerSec = float32(float64(info.ProcessedEntities) / runtime.Seconds())
+	}
+
+	if haveProgress {
+		maxETA := time.Time{}
+
+		info.TotalEntities = 0
+		for _, s := range info.Shards {
+			info.TotalEntities += s.TotalEntities
+			if s.Eta != nil {
+				if ts := s.Eta.AsTime(); maxETA.IsZero() || ts.After(maxETA) {
+					maxETA = ts
+				}
+			}
+		}
+
+		// The job completes when its longest shard does. Shards do not pass work
+		// to each other.
+		if !maxETA.IsZero() {
+			info.Eta = timestamppb.New(maxETA)
+		}
+	}
+
+	return info, nil
+}
+
+// getJob fetches a job.
+//
+// Recognizes and tags transient errors.
+func getJob(ctx context.Context, id JobID) (*Job, error) {
+	row, err := span.ReadRow(ctx, JobsTable, spanner.Key{int64(id)}, jobColumns)
+	switch {
+	case spanner.ErrCode(err) == codes.NotFound:
+		return nil, ErrNoSuchJob
+	case err != nil:
+		return nil, errors.Annotate(err, "transient Spanner error").Tag(transient.Tag).Err()
+	}
+	return readJob(row)
+}
+
+// listJobs fetches most recently created jobs.
+func listJobs(ctx context.Context, limit int) ([]*Job, error) {
+	st := spanner.NewStatement(`
+		SELECT JobID, Config, State, Paused, Created, Updated
+		FROM SpanMapperJobs
+		ORDER BY Created DESC
+		LIMIT @limit
+	`)
+	st.Params["limit"] = int64(limit)
+	var jobs []*Job
+	err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+		job, err := readJob(row)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list jobs").Tag(transient.Tag).Err()
+	}
+	return jobs, nil
+}
+
+// getJobInState fetches a job and checks its state.
+//
+// Returns:
+//
+//	(*Job, nil) if the job is there and its state matches one of given states.
+//	(nil, nil) if the job is there, but in a different state.
+//	(nil, transient error) on Spanner fetch errors.
+//	(nil, fatal error) if there's no such job at all.
+func getJobInState(ctx context.Context, id JobID, states ...dsmapperpb.State) (*Job, error) {
+	job, err := getJob(ctx, id)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch job with ID %d", id).Err()
+	}
+	for _, s := range states {
+		if job.State == s {
+			return job, nil
+		}
+	}
+	logging.Infof(ctx, "Skipping the job: its state is %s, expecting one of %q", job.State, states)
+	return nil, nil
+}
+
+// shard represents a key range being worked on by a single worker.
+//
+// It is stored in SpanMapperShards table interleaved into SpanMapperJobs.
+// Shard rows are written to when workers checkpoint progress or finish. They
+// are read when calculating overall progress of the job.
+type shard struct {
+	// JobID is ID of a job that owns this shard.
+	JobID int64
+	// Index is the index of the shard in the job's shards list.
+	Index int64 `spanner:"ShardIndex"`
+	// State is used to track shard's lifecycle, see dsmapperpb.State enum.
+	State int64
+	// Error is an error message for failed shards.
+	Error spanner.NullString
+	// ProcessTaskNum is next expected ProcessShard task number.
+	ProcessTaskNum int64
+	// RangeStart is an encoded exclusive start of the key range or NULL if the
+	// range starts at the beginning of the table.
+	RangeStart spanner.NullString
+	// RangeEnd is an encoded inclusive end of the key range or NULL if the range
+	// ends at the end of the table.
+	RangeEnd spanner.NullString
+	// ResumeFrom is the encoded last processed key or NULL if just starting.
+	ResumeFrom spanner.NullString
+	// ExpectedCount is expected number of rows in the shard, -1 if unknown.
+	ExpectedCount int64
+	// ProcessedCount is number rows processed by the shard thus far.
+	ProcessedCount int64
+	// Created is when the shard was created, FYI.
+	Created time.Time
+	// Updated is when the shard was last touched, FYI.
+	Updated time.Time
+}
+
+// shardColumns are columns of SpanMapperShards table.
+var shardColumns = []string{
+	"JobID", "ShardIndex", "State", "Error", "ProcessTaskNum",
+	"RangeStart", "RangeEnd", "ResumeFrom",
+	"ExpectedCount", "ProcessedCount", "Created", "Updated",
+}
+
+// state returns the state of the shard as an enum.
+func (s *shard) state() dsmapperpb.State {
+	return dsmapperpb.State(s.State)
+}
+
+// keyRange returns the key range covered by the shard.
+func (s *shard) keyRange() (rng keyRange, err error) {
+	if rng.Start, err = parseKey(s.RangeStart.StringVal); err == nil {
+		rng.End, err = parseKey(s.RangeEnd.StringVal)
+	}
+	return
+}
+
+// info returns a proto message with information about the shard.
+func (s *shard) info() *dsmapperpb.ShardInfo {
+	var rate float64
+	var eta *timestamppb.Timestamp
+
+	if runtime := s.Updated.Sub(s.Created); runtime > 0 {
+		rate = float64(s.ProcessedCount) / runtime.Seconds()
+		if s.ExpectedCount != -1 && rate > 0.0001 {
+			secs := float64(s.ExpectedCount) / rate
+			eta = timestamppb.New(s.Created.Add(time.Duration(float64(time.Second) * secs)))
+		}
+	}
+
+	return &dsmapperpb.ShardInfo{
+		Index:             int32(s.Index),
+		State:             s.state(),
+		Error:             s.Error.StringVal,
+		Created:           timestamppb.New(s.Created),
+		Updated:           timestamppb.New(s.Updated),
+		Eta:               eta, // nil if unknown
+		ProcessedEntities: s.ProcessedCount,
+		TotalEntities:     s.ExpectedCount, // -1 if unknown
+		EntitiesPerSec:    float32(rate),   // 0 if unknown
+	}
+}
+
+// getShard fetches a shard.
+//
+// Recognizes and tags transient errors.
+func getShard(ctx context.Context, jobID JobID, index int64) (*shard, error) {
+	row, err := span.ReadRow(ctx, ShardsTable, spanner.Key{int64(jobID), index}, shardColumns)
+	switch {
+	case spanner.ErrCode(err) == codes.NotFound:
+		return nil, errors.Annotate(err, "no shard #%d in job %d", index, jobID).Tag(tq.Fatal).Err()
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch shard #%d", index).Tag(transient.Tag).Err()
+	}
+	sh := &shard{}
+	if err := row.ToStruct(sh); err != nil {
+		return nil, errors.Annotate(err, "bad shard #%d in job %d", index, jobID).Tag(tq.Fatal).Err()
+	}
+	return sh, nil
+}
+
+// getActiveShard returns the shard if its still in active state and its
+// ProcessTaskNum matches the given taskNum.
+//
+// Returns:
+//
+//	(*shard, nil) if the shard is there and matches the criteria.
+//	(nil, nil) if the shard is there, but it doesn't match the criteria.
+//	(nil, transient error) on Spanner fetch errors.
+//	(nil, fatal error) if there's no such shard at all.
+func getActiveShard(ctx context.Context, jobID JobID, index, taskNum int64) (*shard, error) {
+	sh, err := getShard(ctx, jobID, index)
+	switch {
+	case err != nil:
+		return nil, err
+	case isFinalState(sh.state()):
+		logging.Warningf(ctx, "The shard is finished already")
+		return nil, nil
+	case sh.ProcessTaskNum != taskNum:
+		logging.Warningf(ctx, "The task is stale (shard's task_num is %d, but task's is %d). Skipping it", sh.ProcessTaskNum, taskNum)
+		return nil, nil
+	default:
+		return sh, nil
+	}
+}
+
+// shardTxnCb examines and optionally mutates the shard.
+//
+// It returns (true, nil) to instruct shardTxn to store the shard, (false, nil)
+// to skip storing, and (..., err) to return the error.
+type shardTxnCb func(ctx context.Context, sh *shard) (save bool, err error)
+
+// shardTxn fetches the shard and calls the callback to examine or mutate it.
+//
+// Silently skips finished shards.
+func shardTxn(ctx context.Context, jobID JobID, index int64, cb shardTxnCb) error {
+	return runTxn(ctx, func(ctx context.Context) error {
+		sh, err := getShard(ctx, jobID, index)
+		switch {
+		case err != nil:
+			return err
+		case isFinalState(sh.state()):
+			return nil // the shard is already marked as done
+		}
+		switch save, err := cb(ctx, sh); {
+		case err != nil:
+			return err
+		case !save:
+			return nil
+		default:
+			sh.Updated = clock.Now(ctx).UTC()
+			m, err := spanner.InsertOrUpdateStruct(ShardsTable, sh)
+			if err != nil {
+				return errors.Annotate(err, "failed to prepare the shard mutation").Err()
+			}
+			span.BufferWrite(ctx, m)
+			return nil
+		}
+	})
+}
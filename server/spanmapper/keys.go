@@ -0,0 +1,303 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/span"
+)
+
+// identRe matches identifiers that are safe to use in SQL statements as is.
+var identRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// keyColumn is a column of a primary key.
+type keyColumn struct {
+	Name string
+	Desc bool // true if the column is sorted in descending order
+}
+
+// primaryKey returns columns of the primary key of the table.
+func primaryKey(ctx context.Context, table string) ([]keyColumn, error) {
+	st := spanner.NewStatement(`
+		SELECT COLUMN_NAME, COLUMN_ORDERING
+		FROM INFORMATION_SCHEMA.INDEX_COLUMNS
+		WHERE TABLE_SCHEMA = '' AND TABLE_NAME = @table AND INDEX_NAME = 'PRIMARY_KEY'
+		ORDER BY ORDINAL_POSITION
+	`)
+	st.Params["table"] = table
+
+	var cols []keyColumn
+	err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+		var name string
+		var ordering spanner.NullString
+		if err := row.Columns(&name, &ordering); err != nil {
+			return err
+		}
+		cols = append(cols, keyColumn{Name: name, Desc: ordering.StringVal == "DESC"})
+		return nil
+	})
+	switch {
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch the primary key of %q", table).Tag(transient.Tag).Err()
+	case len(cols) == 0:
+		return nil, errors.Reason("no table %q", table).Err()
+	}
+	return cols, nil
+}
+
+// sampleKeys returns up to n randomly sampled primary keys of the table,
+// sorted in the order of the primary key.
+func sampleKeys(ctx context.Context, table string, pk []keyColumn, n int) ([]encodedKey, error) {
+	names := make([]string, len(pk))
+	order := make([]string, len(pk))
+	for i, col := range pk {
+		names[i] = quoteIdent(col.Name)
+		order[i] = names[i]
+		if col.Desc {
+			order[i] += " DESC"
+		}
+	}
+	st := spanner.NewStatement(fmt.Sprintf(
+		"SELECT %s FROM (SELECT %s FROM %s TABLESAMPLE RESERVOIR (%d ROWS)) ORDER BY %s",
+		strings.Join(names, ", "), strings.Join(names, ", "), quoteIdent(table), n, strings.Join(order, ", ")))
+
+	var keys []encodedKey
+	err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+		key, err := rowKey(row, len(pk))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to sample keys of %q", table).Tag(transient.Tag).Err()
+	}
+	return keys, nil
+}
+
+// countRows returns the number of rows in the table.
+func countRows(ctx context.Context, table string) (int64, error) {
+	var count int64
+	st := spanner.NewStatement(fmt.Sprintf("SELECT COUNT(*) FROM %s", quoteIdent(table)))
+	err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+		return row.Columns(&count)
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to count rows of %q", table).Tag(transient.Tag).Err()
+	}
+	return count, nil
+}
+
+// quoteIdent quotes an identifier for use in SQL statements.
+func quoteIdent(name string) string {
+	return "`" + name + "`"
+}
+
+// keyRange is a range of primary keys (Start, End].
+//
+// Nil Start means "from the beginning of the table". Nil End means "till the
+// end of the table".
+type keyRange struct {
+	Start encodedKey
+	End   encodedKey
+}
+
+// splitRanges splits the table into ranges given sorted samples of its keys.
+//
+// Returns at most n ranges, fewer if there are not enough distinct samples.
+// Returns a single range covering the whole table if there are no samples.
+// Ranges are returned in the order of the primary key.
+func splitRanges(samples []encodedKey, n int) []keyRange {
+	var boundaries []encodedKey
+	for i := 1; i < n; i++ {
+		b := samples[len(samples)*i/n:]
+		if len(b) == 0 {
+			break
+		}
+		if len(boundaries) == 0 || !boundaries[len(boundaries)-1].equal(b[0]) {
+			boundaries = append(boundaries, b[0])
+		}
+	}
+
+	ranges := make([]keyRange, 0, len(boundaries)+1)
+	var start encodedKey
+	for _, b := range boundaries {
+		ranges = append(ranges, keyRange{Start: start, End: b})
+		start = b
+	}
+	return append(ranges, keyRange{Start: start})
+}
+
+// spannerRange returns a Spanner key range with keys in (start, r.End].
+//
+// If start is nil, r.Start is used instead.
+func (r keyRange) spannerRange(start encodedKey) (spanner.KeyRange, error) {
+	if start == nil {
+		start = r.Start
+	}
+	var kr spanner.KeyRange
+	var err error
+	if kr.Start, err = start.key(); err != nil {
+		return kr, err
+	}
+	if kr.End, err = r.End.key(); err != nil {
+		return kr, err
+	}
+	// An empty key (i.e. an empty key prefix) denotes the beginning of the
+	// table when used as an inclusive start and the end of the table when used
+	// as an inclusive end.
+	if start == nil {
+		kr.Kind = spanner.ClosedClosed
+	} else {
+		kr.Kind = spanner.OpenClosed
+	}
+	return kr, nil
+}
+
+// encodedKeyPart is a serializable value of a single primary key column.
+type encodedKeyPart struct {
+	Type  string          `json:"type"`  // sppb.TypeCode name
+	Value json.RawMessage `json:"value"` // structpb.Value in JSON form
+}
+
+// encodedKey is a serializable primary key of a row.
+type encodedKey []encodedKeyPart
+
+// rowKey returns the primary key of a row, given it is the first n columns.
+func rowKey(row *spanner.Row, n int) (encodedKey, error) {
+	key := make(encodedKey, n)
+	for i := range key {
+		var val spanner.GenericColumnValue
+		if err := row.Column(i, &val); err != nil {
+			return nil, err
+		}
+		blob, err := protojson.Marshal(val.Value)
+		if err != nil {
+			return nil, err
+		}
+		key[i] = encodedKeyPart{Type: val.Type.Code.String(), Value: blob}
+	}
+	return key, nil
+}
+
+// parseKey parses a key serialized with String.
+//
+// Returns nil if the string is empty.
+func parseKey(s string) (encodedKey, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var key encodedKey
+	if err := json.Unmarshal([]byte(s), &key); err != nil {
+		return nil, errors.Annotate(err, "bad encoded key %q", s).Err()
+	}
+	return key, nil
+}
+
+// String serializes the key.
+//
+// Returns an empty string if the key is nil.
+func (k encodedKey) String() string {
+	if k == nil {
+		return ""
+	}
+	blob, err := json.Marshal(k)
+	if err != nil {
+		panic(err) // impossible, all parts are valid JSON
+	}
+	return string(blob)
+}
+
+// nullString returns the serialized key as a Spanner value, NULL if nil.
+func (k encodedKey) nullString() spanner.NullString {
+	return spanner.NullString{StringVal: k.String(), Valid: k != nil}
+}
+
+// equal is true if two keys are equal.
+func (k encodedKey) equal(other encodedKey) bool {
+	return k.String() == other.String()
+}
+
+// key converts the key to a Spanner key.
+//
+// Returns an empty key if k is nil.
+func (k encodedKey) key() (spanner.Key, error) {
+	key := make(spanner.Key, len(k))
+	for i, part := range k {
+		var err error
+		if key[i], err = part.value(); err != nil {
+			return nil, errors.Annotate(err, "key part #%d", i).Err()
+		}
+	}
+	return key, nil
+}
+
+// value returns a Go value of the key part, suitable for spanner.Key.
+func (p encodedKeyPart) value() (any, error) {
+	val := &structpb.Value{}
+	if err := protojson.Unmarshal(p.Value, val); err != nil {
+		return nil, err
+	}
+	code, ok := sppb.TypeCode_value[p.Type]
+	if !ok {
+		return nil, errors.Reason("unknown type %q", p.Type).Err()
+	}
+
+	var ptr any
+	switch sppb.TypeCode(code) {
+	case sppb.TypeCode_INT64:
+		ptr = &spanner.NullInt64{}
+	case sppb.TypeCode_STRING:
+		ptr = &spanner.NullString{}
+	case sppb.TypeCode_BYTES:
+		ptr = &[]byte{}
+	case sppb.TypeCode_BOOL:
+		ptr = &spanner.NullBool{}
+	case sppb.TypeCode_FLOAT64:
+		ptr = &spanner.NullFloat64{}
+	case sppb.TypeCode_TIMESTAMP:
+		ptr = &spanner.NullTime{}
+	case sppb.TypeCode_DATE:
+		ptr = &spanner.NullDate{}
+	case sppb.TypeCode_NUMERIC:
+		ptr = &spanner.NullNumeric{}
+	default:
+		return nil, errors.Reason("unsupported key column type %s", p.Type).Err()
+	}
+
+	gcv := spanner.GenericColumnValue{
+		Type:  &sppb.Type{Code: sppb.TypeCode(code)},
+		Value: val,
+	}
+	if err := gcv.Decode(ptr); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(ptr).Elem().Interface(), nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	intKey := func(i int64) encodedKey {
+		row, err := spanner.NewRow([]string{"A"}, []any{i})
+		So(err, ShouldBeNil)
+		key, err := rowKey(row, 1)
+		So(err, ShouldBeNil)
+		return key
+	}
+
+	Convey("Encoding round trip", t, func() {
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+		vals := []any{
+			int64(-42),
+			"str",
+			[]byte("bytes"),
+			true,
+			1.5,
+			ts,
+			*big.NewRat(3, 2),
+		}
+		names := make([]string, len(vals)+1)
+		for i := range names {
+			names[i] = fmt.Sprintf("C%d", i)
+		}
+		row, err := spanner.NewRow(names, append(vals, "not a key"))
+		So(err, ShouldBeNil)
+
+		key, err := rowKey(row, len(vals))
+		So(err, ShouldBeNil)
+		So(key, ShouldHaveLength, len(vals))
+
+		parsed, err := parseKey(key.String())
+		So(err, ShouldBeNil)
+		So(parsed.equal(key), ShouldBeTrue)
+
+		spKey, err := parsed.key()
+		So(err, ShouldBeNil)
+		So(spKey, ShouldResemble, spanner.Key{
+			spanner.NullInt64{Int64: -42, Valid: true},
+			spanner.NullString{StringVal: "str", Valid: true},
+			[]byte("bytes"),
+			spanner.NullBool{Bool: true, Valid: true},
+			spanner.NullFloat64{Float64: 1.5, Valid: true},
+			spanner.NullTime{Time: ts, Valid: true},
+			spanner.NullNumeric{Numeric: *big.NewRat(3, 2), Valid: true},
+		})
+	})
+
+	Convey("Nil keys", t, func() {
+		var key encodedKey
+		So(key.String(), ShouldEqual, "")
+		So(key.nullString(), ShouldResemble, spanner.NullString{})
+
+		parsed, err := parseKey("")
+		So(err, ShouldBeNil)
+		So(parsed, ShouldBeNil)
+
+		_, err = parseKey("garbage")
+		So(err, ShouldErrLike, "bad encoded key")
+	})
+
+	Convey("spannerRange", t, func() {
+		Convey("Whole table", func() {
+			kr, err := keyRange{}.spannerRange(nil)
+			So(err, ShouldBeNil)
+			So(kr, ShouldResemble, spanner.KeyRange{
+				Start: spanner.Key{},
+				End:   spanner.Key{},
+				Kind:  spanner.ClosedClosed,
+			})
+		})
+
+		Convey("Bounded", func() {
+			kr, err := keyRange{Start: intKey(1), End: intKey(5)}.spannerRange(nil)
+			So(err, ShouldBeNil)
+			So(kr, ShouldResemble, spanner.KeyRange{
+				Start: spanner.Key{spanner.NullInt64{Int64: 1, Valid: true}},
+				End:   spanner.Key{spanner.NullInt64{Int64: 5, Valid: true}},
+				Kind:  spanner.OpenClosed,
+			})
+		})
+
+		Convey("Resumed", func() {
+			kr, err := keyRange{End: intKey(5)}.spannerRange(intKey(3))
+			So(err, ShouldBeNil)
+			So(kr, ShouldResemble, spanner.KeyRange{
+				Start: spanner.Key{spanner.NullInt64{Int64: 3, Valid: true}},
+				End:   spanner.Key{spanner.NullInt64{Int64: 5, Valid: true}},
+				Kind:  spanner.OpenClosed,
+			})
+		})
+	})
+
+	Convey("splitRanges", t, func() {
+		samples := func(n int) []encodedKey {
+			out := make([]encodedKey, n)
+			for i := range out {
+				out[i] = intKey(int64(i))
+			}
+			return out
+		}
+
+		bounds := func(ranges []keyRange) (out []string) {
+			for _, r := range ranges {
+				out = append(out, fmt.Sprintf("%s..%s", r.Start, r.End))
+			}
+			return
+		}
+
+		Convey("No samples", func() {
+			So(splitRanges(nil, 4), ShouldResemble, []keyRange{{}})
+		})
+
+		Convey("One shard", func() {
+			So(splitRanges(samples(10), 1), ShouldResemble, []keyRange{{}})
+		})
+
+		Convey("Even split", func() {
+			s := samples(8)
+			So(bounds(splitRanges(s, 4)), ShouldResemble, bounds([]keyRange{
+				{End: s[2]},
+				{Start: s[2], End: s[4]},
+				{Start: s[4], End: s[6]},
+				{Start: s[6]},
+			}))
+		})
+
+		Convey("Fewer samples than shards", func() {
+			s := samples(2)
+			So(bounds(splitRanges(s, 4)), ShouldResemble, bounds([]keyRange{
+				{End: s[0]},
+				{Start: s[0], End: s[1]},
+				{Start: s[1]},
+			}))
+		})
+
+		Convey("Shard sizes", func() {
+			s := samples(8)
+			ranges := splitRanges(s, 4)
+			expected := make([]int64, len(ranges))
+			estimateShardSizes(ranges, s, 800, expected)
+			So(expected, ShouldResemble, []int64{300, 200, 200, 100})
+
+			expected = []int64{-1}
+			estimateShardSizes([]keyRange{{}}, nil, 5, expected)
+			So(expected, ShouldResemble, []int64{5})
+		})
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperpb"
+	"go.chromium.org/luci/server/portal"
+)
+
+// portalJobsLimit is how many recent jobs to show on the portal page.
+const portalJobsLimit = 20
+
+var jobsTmpl = template.Must(template.New("jobs").Parse(`
+{{if .Jobs}}
+<table class="table table-condensed">
+  <tr><th>ID</th><th>Table</th><th>Mapper</th><th>State</th><th>Shards</th><th>Processed</th><th>Rate</th><th>ETA</th><th>Created</th></tr>
+  {{range .Jobs}}
+  <tr>
+    <td>{{.Job.ID}}</td>
+    <td>{{.Job.Config.Table}}</td>
+    <td>{{.Job.Config.Mapper}}</td>
+    <td>{{.Info.State}}{{if .Job.Paused}} (paused){{end}}</td>
+    <td>{{.Done}} / {{len .Info.Shards}}</td>
+    <td>{{.Info.ProcessedEntities}}{{if ge .Info.TotalEntities 0}} / ~{{.Info.TotalEntities}}{{end}}</td>
+    <td>{{printf "%.1f" .Info.EntitiesPerSec}}/s</td>
+    <td>{{if .Info.Eta}}{{(.Info.Eta.AsTime).Format "2006-01-02 15:04:05 MST"}}{{end}}</td>
+    <td>{{.Job.Created.Format "2006-01-02 15:04:05 MST"}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No mapping jobs.</p>
+{{end}}
+`))
+
+type portalPage struct {
+	portal.BasePage
+}
+
+func (portalPage) Title(ctx context.Context) (string, error) {
+	return "Spanner mapper jobs", nil
+}
+
+func (portalPage) Overview(ctx context.Context) (template.HTML, error) {
+	if !Default.installed() {
+		return template.HTML(`<p>The Spanner mapper module is not enabled in this
+			process.</p>`), nil
+	}
+
+	jobs, err := Default.ListJobs(ctx, portalJobsLimit)
+	if err != nil {
+		return "", err
+	}
+
+	type jobRow struct {
+		Job  *Job
+		Info *dsmapperpb.JobInfo
+		Done int
+	}
+	rows := make([]jobRow, len(jobs))
+	for i, job := range jobs {
+		info, err := job.FetchInfo(ctx)
+		if err != nil {
+			return "", err
+		}
+		done := 0
+		for _, sh := range info.Shards {
+			if isFinalState(sh.State) {
+				done++
+			}
+		}
+		rows[i] = jobRow{Job: job, Info: info, Done: done}
+	}
+
+	out := strings.Builder{}
+	out.WriteString(`<p>Recently launched Spanner mapping jobs. Buttons below
+		allow to pause, resume or abort active ones.</p>`)
+	if err := jobsTmpl.Execute(&out, map[string]any{"Jobs": rows}); err != nil {
+		return "", errors.Annotate(err, "failed to render jobs").Err()
+	}
+	return template.HTML(out.String()), nil
+}
+
+func (portalPage) Actions(ctx context.Context) ([]portal.Action, error) {
+	if !Default.installed() {
+		return nil, nil
+	}
+
+	jobs, err := Default.ListJobs(ctx, portalJobsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []portal.Action
+	action := func(verb string, id JobID, cb func(context.Context, JobID) (*Job, error)) portal.Action {
+		return portal.Action{
+			ID:           fmt.Sprintf("%s-%d", strings.ToLower(verb), id),
+			Title:        fmt.Sprintf("%s job %d", verb, id),
+			Confirmation: fmt.Sprintf("%s job %d?", verb, id),
+			Callback: func(ctx context.Context) (string, template.HTML, error) {
+				job, err := cb(ctx, id)
+				if err != nil {
+					return "", "", errors.Annotate(err, "failed to %s job %d", strings.ToLower(verb), id).Err()
+				}
+				state := job.State.String()
+				if job.Paused {
+					state += " (paused)"
+				}
+				return "Success", template.HTML(fmt.Sprintf(
+					"<p>Job %d is now in state %s.</p>", id, template.HTMLEscapeString(state))), nil
+			},
+		}
+	}
+	for _, job := range jobs {
+		if isFinalState(job.State) || job.State == dsmapperpb.State_ABORTING {
+			continue
+		}
+		if job.Paused {
+			actions = append(actions, action("Resume", job.ID, Default.ResumeJob))
+		} else {
+			actions = append(actions, action("Pause", job.ID, Default.PauseJob))
+		}
+		actions = append(actions, action("Abort", job.ID, Default.AbortJob))
+	}
+	return actions, nil
+}
+
+func init() {
+	portal.RegisterPage("spanmapper", portalPage{})
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmapper
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/dsmapper/dsmapperpb"
+	"go.chromium.org/luci/server/span"
+)
+
+// runTxn runs a Spanner read-write transaction.
+//
+// Errors returned by the callback are returned as is. All other errors (i.e.
+// commit errors) are tagged as transient. Aborted transactions are retried by
+// the Spanner client.
+func runTxn(ctx context.Context, cb func(context.Context) error) error {
+	var innerErr error
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		innerErr = cb(ctx)
+		return innerErr
+	})
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Transaction failed")
+		if innerErr != nil {
+			return innerErr // the Spanner client may wrap it, return it as is
+		}
+		return transient.Tag.Apply(err)
+	}
+	return nil
+}
+
+func isFinalState(s dsmapperpb.State) bool {
+	return s == dsmapperpb.State_SUCCESS || s == dsmapperpb.State_FAIL || s == dsmapperpb.State_ABORTED
+}
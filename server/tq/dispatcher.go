@@ -1229,6 +1229,7 @@ func (d *Dispatcher) handlePush(ctx context.Context, body []byte, info Execution
 		retry = metrics.MaxRetryFieldValue
 	}
 
+	cls.stats.record(clock.Now(ctx), err)
 	metrics.ServerHandledCount.Add(ctx, 1, cls.ID, result, retry)
 	metrics.ServerDurationMS.Add(ctx, float64(dur.Milliseconds()), cls.ID, result)
 	if !info.expectedETA.IsZero() {
@@ -1307,6 +1308,7 @@ type taskClassImpl struct {
 	protoType protoreflect.MessageType
 	backend   taskBackend
 	running   int32
+	stats     handlerStats
 }
 
 // envelope is what we put into all Cloud Tasks.
@@ -74,4 +74,17 @@
 //	  SerializedParts ARRAY<STRING(MAX)>,
 //	  ExpiresAt TIMESTAMP NOT NULL,
 //	) PRIMARY KEY (SectionID ASC, LeaseID ASC);
+//
+// # Self-hosted backend
+//
+// Instead of Cloud Tasks, tasks can be stored in a database (Redis or Spanner)
+// and executed by the server replicas themselves. This is useful for
+// deployments without access to Google Cloud queue services. To enable it,
+// link the store implementation into the binary:
+//
+//	import _ "go.chromium.org/luci/server/tq/selfhosted/redis"
+//
+// And pass -tq-self-hosted-store flag (e.g. "-tq-self-hosted-store redis").
+// Each replica then leases due tasks from the store and executes them, see
+// SelfHostedExecutor. Transactional tasks and sweeps work as usual.
 package tq
@@ -160,4 +160,26 @@ var (
 		nil,
 		field.String("task_class"), // matches TaskClass.ID
 	)
+
+	// Self-hosted backend metrics.
+
+	SelfHostedDepth = metric.NewInt(
+		"tq/selfhosted/depth",
+		"Number of pending tasks (including leased ones) in a self-hosted queue",
+		nil,
+		field.String("queue"), // full queue name
+	)
+
+	SelfHostedLeased = metric.NewCounter(
+		"tq/selfhosted/leased",
+		"Count of self-hosted tasks leased for execution",
+		nil,
+	)
+
+	SelfHostedDone = metric.NewCounter(
+		"tq/selfhosted/done",
+		"Count of self-hosted task attempts by their outcome",
+		nil,
+		field.String("result"), // OK | retry | dropped | lease_lost | bad_task
+	)
 )
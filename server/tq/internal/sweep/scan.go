@@ -16,6 +16,8 @@ package sweep
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/logging"
@@ -106,8 +108,19 @@ func Scan(ctx context.Context, p *ScanParams) ([]*reminder.Reminder, partition.S
 	}
 
 	// Keep only sufficiently old reminders.
+	fetched := len(rs)
 	filtered := filterOutTooFresh(ctx, rs, p.Level, p.DB.Kind())
 
+	recordScan(ScanReport{
+		Time:     startedAt,
+		DB:       p.DB.Kind(),
+		Level:    p.Level,
+		Status:   status,
+		Fetched:  fetched,
+		Stale:    len(filtered),
+		Duration: clock.Now(ctx).Sub(startedAt),
+	})
+
 	if err != nil {
 		if len(filtered) == 0 && len(scanParts) == 0 {
 			logging.Errorf(ctx, "Scan failed without returning any results: %s", err)
@@ -148,3 +161,44 @@ func filterOutTooFresh(ctx context.Context, reminders []*reminder.Reminder, lvl
 	}
 	return filtered
 }
+
+// ScanReport describes a finished Scan call.
+type ScanReport struct {
+	Time     time.Time     // when the scan started
+	DB       string        // the kind of the scanned DB
+	Level    int           // the recursion level of the scan
+	Status   string        // OK | limit | timeout | fail
+	Fetched  int           // number of fetched reminders
+	Stale    int           // number of stale reminders among them
+	Duration time.Duration // how long it took to fetch reminders
+}
+
+// maxRecentScans is how many recent scans to remember.
+const maxRecentScans = 20
+
+var recentScans struct {
+	sync.Mutex
+	reports []ScanReport
+}
+
+// recordScan remembers the report in the list of recent scans.
+func recordScan(r ScanReport) {
+	recentScans.Lock()
+	defer recentScans.Unlock()
+	if len(recentScans.reports) == maxRecentScans {
+		recentScans.reports = append(recentScans.reports[:0], recentScans.reports[1:]...)
+	}
+	recentScans.reports = append(recentScans.reports, r)
+}
+
+// RecentScans returns reports of the most recent Scan calls made in this
+// process, the most recent first.
+func RecentScans() []ScanReport {
+	recentScans.Lock()
+	defer recentScans.Unlock()
+	out := make([]ScanReport, len(recentScans.reports))
+	for i, r := range recentScans.reports {
+		out[len(out)-1-i] = r
+	}
+	return out
+}
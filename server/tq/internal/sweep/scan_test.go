@@ -93,6 +93,17 @@ func TestScan(t *testing.T) {
 					mkReminder(4, stale),
 				})
 
+				// The scan is remembered (other tests may be scanning concurrently).
+				found := false
+				for _, r := range RecentScans() {
+					if r.Time.Equal(epoch.Add(60*time.Second)) && r.Fetched == 4 {
+						So(r.Stale, ShouldEqual, 2)
+						So(r.Status, ShouldEqual, "OK")
+						found = true
+					}
+				}
+				So(found, ShouldBeTrue)
+
 				Convey("but only within given partition", func() {
 					rems, more := scan(ctx, partition.FromInts(0, 4))
 					So(more, ShouldBeEmpty)
@@ -27,6 +27,7 @@ import (
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/module"
 	"go.chromium.org/luci/server/tq/internal/db"
+	"go.chromium.org/luci/server/tq/selfhosted"
 	"go.chromium.org/luci/server/tq/tqtesting"
 )
 
@@ -189,6 +190,29 @@ type ModuleOptions struct {
 	//
 	// It is safe to change it any time. Default is 16.
 	SweepShards int
+
+	// SelfHostedStore is a kind of a store to use for the self-hosted backend.
+	//
+	// If set, tasks are put into this store (instead of being submitted to
+	// Cloud Tasks) and executed by server replicas themselves, see
+	// SelfHostedWorkers. This allows to use TQ without Google Cloud queue
+	// services. PubSub tasks are not supported in this mode.
+	//
+	// The store implementation must be linked into the binary, see
+	// server/tq/selfhosted package for details. Sweeps of transactional tasks
+	// reminders work as usual and must be initiated as usual.
+	//
+	// Default is "", meaning to use Cloud Tasks.
+	SelfHostedStore string
+
+	// SelfHostedWorkers is how many self-hosted tasks are executed concurrently
+	// by this process.
+	//
+	// If negative, this process doesn't execute self-hosted tasks at all (but
+	// still can submit them). Used only if SelfHostedStore is set.
+	//
+	// Default is 16.
+	SelfHostedWorkers int
 }
 
 // Register registers the command line flags.
@@ -249,6 +273,15 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 	}
 	f.IntVar(&o.SweepShards, "tq-sweep-shards", o.SweepShards,
 		`How many subtasks are submitted when initiating a sweep.`)
+
+	f.StringVar(&o.SelfHostedStore, "tq-self-hosted-store", o.SelfHostedStore,
+		`If set, store tasks in this kind of a store (e.g. "redis" or "spanner") and execute them in the server instead of using Cloud Tasks.`)
+
+	if o.SelfHostedWorkers == 0 {
+		o.SelfHostedWorkers = 16
+	}
+	f.IntVar(&o.SelfHostedWorkers, "tq-self-hosted-workers", o.SelfHostedWorkers,
+		`How many self-hosted tasks to execute concurrently, or -1 to not execute them in this process.`)
 }
 
 // NewModule returns a server module that sets up a TQ dispatcher.
@@ -288,6 +321,11 @@ func (*tqModule) Dependencies() []module.Dependency {
 			deps = append(deps, module.RequiredDependency(db.Module))
 		}
 	})
+	selfhosted.VisitImpls(func(impl *selfhosted.Impl) {
+		if impl.Module.Valid() {
+			deps = append(deps, module.OptionalDependency(impl.Module))
+		}
+	})
 	return deps
 }
 
@@ -339,7 +377,23 @@ func (m *tqModule) initDispatching(ctx context.Context, host module.Host, opts m
 	}
 
 	var submitter Submitter
-	if opts.Prod {
+	if m.opts.SelfHostedStore != "" {
+		logging.Infof(ctx, "TQ is using self-hosted %q store", m.opts.SelfHostedStore)
+		store, err := selfhosted.New(ctx, m.opts.SelfHostedStore)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to initialize the self-hosted TQ store").Err()
+		}
+		if m.opts.SelfHostedWorkers >= 0 {
+			exe := &SelfHostedExecutor{
+				Dispatcher: disp,
+				Store:      store,
+				Workers:    m.opts.SelfHostedWorkers,
+			}
+			host.RunInBackground("luci.tq.selfhosted", exe.Run)
+			tsmon.RegisterCallbackIn(ctx, exe.ReportMetrics)
+		}
+		submitter = &SelfHostedSubmitter{Store: store}
+	} else if opts.Prod {
 		// When running for real use real services.
 		creds, err := auth.GetPerRPCCredentials(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
 		if err != nil {
@@ -0,0 +1,363 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tq
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	taskspb "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/tq/internal/metrics"
+	"go.chromium.org/luci/server/tq/internal/reminder"
+	"go.chromium.org/luci/server/tq/selfhosted"
+)
+
+// SelfHostedSubmitter implements Submitter on top of a selfhosted.Store.
+//
+// It puts Cloud Tasks tasks into the store, from where they are picked up by
+// SelfHostedExecutor. PubSub tasks are not supported.
+type SelfHostedSubmitter struct {
+	// Store is where to put tasks. Required.
+	Store selfhosted.Store
+
+	// DedupWindow is for how long names of named tasks can't be reused.
+	//
+	// Default is 1h, which roughly matches Cloud Tasks.
+	DedupWindow time.Duration
+}
+
+// Submit puts the task into the store.
+func (s *SelfHostedSubmitter) Submit(ctx context.Context, p *reminder.Payload) error {
+	req := p.CreateTaskRequest
+	if req == nil {
+		return status.Errorf(codes.Unimplemented, "the self-hosted TQ backend supports only Cloud Tasks tasks")
+	}
+	if req.Task == nil {
+		return status.Errorf(codes.InvalidArgument, "no Task in the request")
+	}
+	blob, err := proto.Marshal(req.Task)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to marshal the task: %s", err)
+	}
+
+	now := clock.Now(ctx)
+	t := &selfhosted.Task{
+		ID:      req.Task.Name,
+		Queue:   req.Parent,
+		ETA:     now,
+		Payload: blob,
+	}
+	if eta := req.Task.ScheduleTime; eta != nil && eta.AsTime().After(now) {
+		t.ETA = eta.AsTime()
+	}
+	if t.ID == "" {
+		t.ID = req.Parent + "/tasks/" + selfhosted.NewRandomID()
+	} else {
+		window := s.DedupWindow
+		if window == 0 {
+			window = time.Hour
+		}
+		t.DedupUntil = now.Add(window)
+	}
+
+	switch err := s.Store.Add(ctx, t); {
+	case err == selfhosted.ErrAlreadyExists:
+		return status.Errorf(codes.AlreadyExists, "task %q already exists", t.ID)
+	case transient.Tag.In(err):
+		return status.Errorf(codes.Unavailable, "%s", err)
+	case err != nil:
+		return status.Errorf(codes.Internal, "%s", err)
+	}
+	return nil
+}
+
+// SelfHostedExecutor executes tasks stored in a selfhosted.Store.
+//
+// It leases due tasks from the store and calls the dispatcher's task handlers
+// directly, without going through the HTTP layer. Failed tasks are retried
+// with exponential backoff. Tasks of classes with Custom payloads are not
+// supported.
+//
+// Usually runs in every replica of the server.
+type SelfHostedExecutor struct {
+	// Dispatcher is a dispatcher with task handlers. Required.
+	Dispatcher *Dispatcher
+
+	// Store is where to get tasks from. Required.
+	Store selfhosted.Store
+
+	// Workers is how many tasks can be executed concurrently.
+	//
+	// Default is 16.
+	Workers int
+
+	// LeaseDuration is how long a task can be executing before it is given to
+	// another worker.
+	//
+	// It is also a deadline of the task handler. Default is 10 min.
+	LeaseDuration time.Duration
+
+	// PollInterval is how often to check for new tasks when idle.
+	//
+	// Default is 1 sec.
+	PollInterval time.Duration
+
+	// MaxAttempts is the maximum number of attempts for a task, including the
+	// first attempt.
+	//
+	// If negative the number of attempts is unlimited. Default is 20.
+	MaxAttempts int
+
+	// MinBackoff is an initial retry delay for failed tasks.
+	//
+	// It is doubled after each failed attempt until it reaches MaxBackoff.
+	// Default is 1 sec.
+	MinBackoff time.Duration
+
+	// MaxBackoff is an upper limit on a retry delay.
+	//
+	// Default is 5 min.
+	MaxBackoff time.Duration
+
+	m      sync.Mutex
+	queues stringset.Set // queues reported by ReportMetrics
+}
+
+// Run executes tasks until the context is canceled.
+//
+// Waits for running tasks to finish before returning.
+func (e *SelfHostedExecutor) Run(ctx context.Context) {
+	workers := e.Workers
+	if workers <= 0 {
+		workers = 16
+	}
+	pollInterval := e.PollInterval
+	if pollInterval == 0 {
+		pollInterval = time.Second
+	}
+
+	// Each running task holds a slot in `sem`.
+	sem := make(chan struct{}, workers)
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+
+	for ctx.Err() == nil {
+		// Wait for at least one free worker, grab all other free ones.
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		free := 1
+	grab:
+		for free < workers {
+			select {
+			case sem <- struct{}{}:
+				free++
+			default:
+				break grab
+			}
+		}
+
+		tasks, err := e.Store.Lease(ctx, free, e.leaseDuration())
+		if err != nil && ctx.Err() == nil {
+			logging.Warningf(ctx, "TQ: failed to lease self-hosted tasks: %s", err)
+		}
+		metrics.SelfHostedLeased.Add(ctx, int64(len(tasks)))
+
+		for _, t := range tasks {
+			wg.Add(1)
+			go func(t *selfhosted.Task) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				e.execute(ctx, t)
+			}(t)
+		}
+		for i := len(tasks); i < free; i++ {
+			<-sem
+		}
+
+		// If got less than asked, there are no more due tasks now.
+		if len(tasks) < free {
+			clock.Sleep(clock.Tag(ctx, "tq-selfhosted-poll"), pollInterval)
+		}
+	}
+}
+
+// ReportMetrics reports depth of self-hosted queues to tsmon.
+//
+// This should be called before tsmon flush.
+func (e *SelfHostedExecutor) ReportMetrics(ctx context.Context) {
+	depth, err := e.Store.Depth(ctx)
+	if err != nil {
+		logging.Warningf(ctx, "TQ: failed to get self-hosted queues depth: %s", err)
+		return
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	// Report queues that became empty as well.
+	if e.queues == nil {
+		e.queues = stringset.New(len(depth))
+	}
+	for queue := range depth {
+		e.queues.Add(queue)
+	}
+	for _, queue := range e.queues.ToSortedSlice() {
+		metrics.SelfHostedDepth.Set(ctx, depth[queue], queue)
+	}
+}
+
+// execute executes a leased task and updates it in the store.
+func (e *SelfHostedExecutor) execute(ctx context.Context, t *selfhosted.Task) {
+	ctx = logging.SetField(ctx, "task", t.ID)
+	result := "OK"
+	defer func() {
+		metrics.SelfHostedDone.Add(ctx, 1, result)
+	}()
+
+	body, info, err := e.prepare(t)
+	if err != nil {
+		logging.Errorf(ctx, "TQ: dropping a malformed self-hosted task: %s", err)
+		result = "bad_task"
+		e.complete(ctx, t, &result)
+		return
+	}
+
+	handlerCtx, cancel := clock.WithTimeout(ctx, e.leaseDuration())
+	err = e.Dispatcher.handlePush(handlerCtx, body, info)
+	cancel()
+	if err != nil && ctx.Err() != nil {
+		// The server is shutting down, the task will be retried when its lease
+		// expires.
+		return
+	}
+
+	switch {
+	case err == nil || Ignore.In(err):
+		e.complete(ctx, t, &result)
+	case Fatal.In(err):
+		logging.Errorf(ctx, "TQ: dropping the task after a fatal error: %s", err)
+		result = "dropped"
+		e.complete(ctx, t, &result)
+	case e.MaxAttempts >= 0 && t.Attempts >= e.maxAttempts():
+		logging.Errorf(ctx, "TQ: dropping the task after %d failed attempts: %s", t.Attempts, err)
+		result = "dropped"
+		e.complete(ctx, t, &result)
+	default:
+		if !quietOnError.In(err) {
+			logging.Warningf(ctx, "TQ: the task failed, will retry: %s", err)
+		}
+		result = "retry"
+		if err := e.Store.Retry(ctx, t, clock.Now(ctx).Add(e.backoff(t.Attempts))); err != nil {
+			e.reportStoreErr(ctx, err, &result)
+		}
+	}
+}
+
+// prepare extracts the HTTP body of the task and its ExecutionInfo.
+func (e *SelfHostedExecutor) prepare(t *selfhosted.Task) ([]byte, ExecutionInfo, error) {
+	task := &taskspb.Task{}
+	if err := proto.Unmarshal(t.Payload, task); err != nil {
+		return nil, ExecutionInfo{}, errors.Annotate(err, "failed to unmarshal the task").Err()
+	}
+
+	var headers map[string]string
+	var body []byte
+	switch mt := task.MessageType.(type) {
+	case *taskspb.Task_HttpRequest:
+		headers = mt.HttpRequest.Headers
+		body = mt.HttpRequest.Body
+	case *taskspb.Task_AppEngineHttpRequest:
+		headers = mt.AppEngineHttpRequest.Headers
+		body = mt.AppEngineHttpRequest.Body
+	default:
+		return nil, ExecutionInfo{}, errors.Reason("no HTTP request in the task").Err()
+	}
+
+	// Emulate headers set by Cloud Tasks, see parseHeaders.
+	h := make(http.Header, len(headers)+2)
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	h.Set("X-CloudTasks-TaskName", path.Base(t.ID))
+	h.Set("X-CloudTasks-TaskExecutionCount", strconv.Itoa(t.Attempts-1))
+	return body, parseHeaders(h), nil
+}
+
+// complete marks the task as done in the store.
+func (e *SelfHostedExecutor) complete(ctx context.Context, t *selfhosted.Task, result *string) {
+	if err := e.Store.Complete(ctx, t); err != nil {
+		e.reportStoreErr(ctx, err, result)
+	}
+}
+
+// reportStoreErr logs an error from Complete or Retry.
+func (e *SelfHostedExecutor) reportStoreErr(ctx context.Context, err error, result *string) {
+	if err == selfhosted.ErrLeaseLost {
+		logging.Warningf(ctx, "TQ: the task lease expired while the task was executing")
+		*result = "lease_lost"
+	} else {
+		logging.Errorf(ctx, "TQ: failed to update the task, it will be retried: %s", err)
+	}
+}
+
+func (e *SelfHostedExecutor) leaseDuration() time.Duration {
+	if e.LeaseDuration == 0 {
+		return 10 * time.Minute
+	}
+	return e.LeaseDuration
+}
+
+func (e *SelfHostedExecutor) maxAttempts() int {
+	if e.MaxAttempts == 0 {
+		return 20
+	}
+	return e.MaxAttempts
+}
+
+// backoff returns a delay before the next attempt.
+func (e *SelfHostedExecutor) backoff(attempts int) time.Duration {
+	minBackoff := e.MinBackoff
+	if minBackoff == 0 {
+		minBackoff = time.Second
+	}
+	maxBackoff := e.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = 5 * time.Minute
+	}
+	delay := minBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfhosted
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+)
+
+// MemoryStore is a Store that keeps tasks in memory.
+//
+// Useful in tests and when running locally.
+type MemoryStore struct {
+	m     sync.Mutex
+	tasks map[string]*memTask
+}
+
+type memTask struct {
+	Task
+	done bool // true if this is a tombstone of a done task
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// Add implements Store.
+func (s *MemoryStore) Add(ctx context.Context, t *Task) error {
+	now := clock.Now(ctx)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if existing := s.tasks[t.ID]; existing != nil && (!existing.done || existing.DedupUntil.After(now)) {
+		return ErrAlreadyExists
+	}
+	if s.tasks == nil {
+		s.tasks = make(map[string]*memTask, 1)
+	}
+	cpy := *t
+	cpy.Attempts = 0
+	cpy.Lease = ""
+	s.tasks[t.ID] = &memTask{Task: cpy}
+	return nil
+}
+
+// Lease implements Store.
+func (s *MemoryStore) Lease(ctx context.Context, limit int, dur time.Duration) ([]*Task, error) {
+	now := clock.Now(ctx)
+	lease := NewRandomID()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var due []*memTask
+	for id, t := range s.tasks {
+		switch {
+		case t.done && !t.DedupUntil.After(now):
+			delete(s.tasks, id)
+		case !t.done && !t.ETA.After(now):
+			due = append(due, t)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ETA.Before(due[j].ETA) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	out := make([]*Task, len(due))
+	for i, t := range due {
+		t.ETA = now.Add(dur)
+		t.Attempts++
+		t.Lease = lease
+		cpy := t.Task
+		out[i] = &cpy
+	}
+	return out, nil
+}
+
+// Complete implements Store.
+func (s *MemoryStore) Complete(ctx context.Context, t *Task) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	existing, err := s.leasedLocked(t)
+	if err != nil {
+		return err
+	}
+	existing.done = true
+	existing.Payload = nil
+	existing.Lease = ""
+	return nil
+}
+
+// Retry implements Store.
+func (s *MemoryStore) Retry(ctx context.Context, t *Task, eta time.Time) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	existing, err := s.leasedLocked(t)
+	if err != nil {
+		return err
+	}
+	existing.ETA = eta
+	existing.Lease = ""
+	return nil
+}
+
+// Depth implements Store.
+func (s *MemoryStore) Depth(ctx context.Context) (map[string]int64, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	depth := map[string]int64{}
+	for _, t := range s.tasks {
+		if !t.done {
+			depth[t.Queue]++
+		}
+	}
+	return depth, nil
+}
+
+// leasedLocked returns a pending task if it is still leased by `t`.
+func (s *MemoryStore) leasedLocked(t *Task) (*memTask, error) {
+	existing := s.tasks[t.ID]
+	if existing == nil || existing.done || existing.Lease != t.Lease {
+		return nil, ErrLeaseLost
+	}
+	return existing, nil
+}
+
+// NewRandomID generates a random hex-encoded ID.
+//
+// Can be used by Store implementations to generate lease IDs.
+func NewRandomID() string {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf[:])
+}
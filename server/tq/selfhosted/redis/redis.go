@@ -0,0 +1,258 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis contains a Redis-based store for the self-hosted server/tq
+// backend.
+//
+// Importing this package registers "redis" store kind, which uses the Redis
+// connection pool configured by server/redisconn module:
+//
+//	import _ "go.chromium.org/luci/server/tq/selfhosted/redis"
+//
+// All keys are prefixed with "tq:". The store relies on Lua scripts that touch
+// keys not declared upfront and therefore doesn't work with Redis Cluster.
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/redisconn"
+	"go.chromium.org/luci/server/tq/selfhosted"
+)
+
+func init() {
+	selfhosted.Register(selfhosted.Impl{
+		Kind:   "redis",
+		Module: redisconn.ModuleName,
+		New: func(ctx context.Context) (selfhosted.Store, error) {
+			pool := redisconn.GetPool(ctx)
+			if pool == nil {
+				return nil, redisconn.ErrNotConfigured
+			}
+			return NewStore(pool, "tq"), nil
+		},
+	})
+}
+
+// Store is a selfhosted.Store that keeps tasks in Redis.
+//
+// Pending tasks are in a sorted set "<prefix>:queue" keyed by their ETA. Each
+// task is a hash "<prefix>:task:<id>". Done tasks with a deduplication window
+// stay as tombstones (with TTL) until the window ends. The number of pending
+// tasks per queue is in a hash "<prefix>:depth".
+type Store struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+var _ selfhosted.Store = (*Store)(nil)
+
+// NewStore returns a store that uses the given pool and key prefix.
+func NewStore(pool *redis.Pool, prefix string) *Store {
+	return &Store{pool: pool, prefix: prefix}
+}
+
+var (
+	// KEYS: queue, task, depth.
+	// ARGV: id, eta, queue name, payload, dedup, now.
+	addScript = redis.NewScript(3, `
+		local done = redis.call('HGET', KEYS[2], 'done')
+		if done == '1' then
+			if tonumber(redis.call('HGET', KEYS[2], 'dedup')) > tonumber(ARGV[6]) then
+				return 0
+			end
+			redis.call('DEL', KEYS[2])
+		elseif done then
+			return 0
+		end
+		redis.call('HSET', KEYS[2],
+			'queue', ARGV[3], 'payload', ARGV[4], 'dedup', ARGV[5],
+			'attempts', 0, 'lease', '', 'done', '0')
+		redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+		redis.call('HINCRBY', KEYS[3], ARGV[3], 1)
+		return 1
+	`)
+
+	// KEYS: queue.
+	// ARGV: prefix, now, limit, lease expiry, lease ID.
+	leaseScript = redis.NewScript(1, `
+		local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
+		local out = {}
+		for _, id in ipairs(ids) do
+			local key = ARGV[1] .. ':task:' .. id
+			redis.call('ZADD', KEYS[1], ARGV[4], id)
+			local attempts = redis.call('HINCRBY', key, 'attempts', 1)
+			redis.call('HSET', key, 'lease', ARGV[5])
+			local f = redis.call('HMGET', key, 'queue', 'payload', 'dedup')
+			table.insert(out, id)
+			table.insert(out, f[1])
+			table.insert(out, f[2])
+			table.insert(out, f[3])
+			table.insert(out, attempts)
+		end
+		return out
+	`)
+
+	// KEYS: queue, task, depth.
+	// ARGV: id, lease ID, now.
+	completeScript = redis.NewScript(3, `
+		if redis.call('HGET', KEYS[2], 'done') ~= '0' or redis.call('HGET', KEYS[2], 'lease') ~= ARGV[2] then
+			return 0
+		end
+		redis.call('ZREM', KEYS[1], ARGV[1])
+		local queue = redis.call('HGET', KEYS[2], 'queue')
+		if redis.call('HINCRBY', KEYS[3], queue, -1) <= 0 then
+			redis.call('HDEL', KEYS[3], queue)
+		end
+		local ttl = tonumber(redis.call('HGET', KEYS[2], 'dedup')) - tonumber(ARGV[3])
+		if ttl > 0 then
+			redis.call('HSET', KEYS[2], 'done', '1', 'lease', '')
+			redis.call('HDEL', KEYS[2], 'payload')
+			redis.call('PEXPIRE', KEYS[2], ttl)
+		else
+			redis.call('DEL', KEYS[2])
+		end
+		return 1
+	`)
+
+	// KEYS: queue, task.
+	// ARGV: id, lease ID, eta.
+	retryScript = redis.NewScript(2, `
+		if redis.call('HGET', KEYS[2], 'done') ~= '0' or redis.call('HGET', KEYS[2], 'lease') ~= ARGV[2] then
+			return 0
+		end
+		redis.call('HSET', KEYS[2], 'lease', '')
+		redis.call('ZADD', KEYS[1], ARGV[3], ARGV[1])
+		return 1
+	`)
+)
+
+// Add implements selfhosted.Store.
+func (s *Store) Add(ctx context.Context, t *selfhosted.Task) error {
+	added, err := redis.Bool(s.do(ctx, addScript,
+		s.queueKey(), s.taskKey(t.ID), s.depthKey(),
+		t.ID, toMS(t.ETA), t.Queue, t.Payload, toMS(t.DedupUntil), toMS(clock.Now(ctx))))
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "failed to add task %q", t.ID).Tag(transient.Tag).Err()
+	case !added:
+		return selfhosted.ErrAlreadyExists
+	}
+	return nil
+}
+
+// Lease implements selfhosted.Store.
+func (s *Store) Lease(ctx context.Context, limit int, dur time.Duration) ([]*selfhosted.Task, error) {
+	now := clock.Now(ctx)
+	expiry := now.Add(dur)
+	lease := selfhosted.NewRandomID()
+
+	vals, err := redis.Values(s.do(ctx, leaseScript,
+		s.queueKey(),
+		s.prefix, toMS(now), limit, toMS(expiry), lease))
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to lease tasks").Tag(transient.Tag).Err()
+	}
+
+	tasks := make([]*selfhosted.Task, 0, len(vals)/5)
+	for len(vals) >= 5 {
+		t := &selfhosted.Task{ETA: fromMS(toMS(expiry)), Lease: lease}
+		var dedup int64
+		if _, err := redis.Scan(vals, &t.ID, &t.Queue, &t.Payload, &dedup, &t.Attempts); err != nil {
+			return nil, errors.Annotate(err, "unexpected reply from the lease script").Err()
+		}
+		if dedup != 0 {
+			t.DedupUntil = fromMS(dedup)
+		}
+		tasks = append(tasks, t)
+		vals = vals[5:]
+	}
+	return tasks, nil
+}
+
+// Complete implements selfhosted.Store.
+func (s *Store) Complete(ctx context.Context, t *selfhosted.Task) error {
+	done, err := redis.Bool(s.do(ctx, completeScript,
+		s.queueKey(), s.taskKey(t.ID), s.depthKey(),
+		t.ID, t.Lease, toMS(clock.Now(ctx))))
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "failed to complete task %q", t.ID).Tag(transient.Tag).Err()
+	case !done:
+		return selfhosted.ErrLeaseLost
+	}
+	return nil
+}
+
+// Retry implements selfhosted.Store.
+func (s *Store) Retry(ctx context.Context, t *selfhosted.Task, eta time.Time) error {
+	done, err := redis.Bool(s.do(ctx, retryScript,
+		s.queueKey(), s.taskKey(t.ID),
+		t.ID, t.Lease, toMS(eta)))
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "failed to retry task %q", t.ID).Tag(transient.Tag).Err()
+	case !done:
+		return selfhosted.ErrLeaseLost
+	}
+	return nil
+}
+
+// Depth implements selfhosted.Store.
+func (s *Store) Depth(ctx context.Context) (map[string]int64, error) {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get Redis connection").Tag(transient.Tag).Err()
+	}
+	defer conn.Close()
+	depth, err := redis.Int64Map(conn.Do("HGETALL", s.depthKey()))
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch queue depth").Tag(transient.Tag).Err()
+	}
+	return depth, nil
+}
+
+// do runs a script using a connection from the pool.
+func (s *Store) do(ctx context.Context, script *redis.Script, args ...any) (any, error) {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return script.Do(conn, args...)
+}
+
+func (s *Store) queueKey() string         { return s.prefix + ":queue" }
+func (s *Store) depthKey() string         { return s.prefix + ":depth" }
+func (s *Store) taskKey(id string) string { return s.prefix + ":task:" + id }
+
+// toMS converts time to milliseconds since epoch, with zero time being 0.
+func toMS(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// fromMS is the reverse of toMS for non-zero values.
+func fromMS(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
@@ -0,0 +1,29 @@
+-- Copyright 2024 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--------------------------------------------------------------------------------
+-- This script initializes Spanner tables required by the self-hosted tq store.
+CREATE TABLE TQSelfHostedTasks (
+    ID STRING(MAX) NOT NULL,
+    Queue STRING(MAX) NOT NULL,
+    ETA TIMESTAMP NOT NULL,
+    DedupUntil TIMESTAMP,
+    Attempts INT64 NOT NULL,
+    Lease STRING(MAX),
+    Done BOOL NOT NULL,
+    Payload BYTES(MAX),
+) PRIMARY KEY (ID ASC);
+
+CREATE INDEX TQSelfHostedTasksByETA ON TQSelfHostedTasks (ETA);
@@ -0,0 +1,272 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanner contains a Cloud Spanner-based store for the self-hosted
+// server/tq backend.
+//
+// Importing this package registers "spanner" store kind, which uses the Spanner
+// client configured by server/span module:
+//
+//	import _ "go.chromium.org/luci/server/tq/selfhosted/spanner"
+//
+// The database must have the following table:
+//
+//	CREATE TABLE TQSelfHostedTasks (
+//	  ID STRING(MAX) NOT NULL,
+//	  Queue STRING(MAX) NOT NULL,
+//	  ETA TIMESTAMP NOT NULL,
+//	  DedupUntil TIMESTAMP,
+//	  Attempts INT64 NOT NULL,
+//	  Lease STRING(MAX),
+//	  Done BOOL NOT NULL,
+//	  Payload BYTES(MAX),
+//	) PRIMARY KEY (ID ASC);
+//
+//	CREATE INDEX TQSelfHostedTasksByETA ON TQSelfHostedTasks (ETA);
+package spanner
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq/selfhosted"
+)
+
+// tableName is the name of the table that user must create in their Spanner
+// database prior to using this package.
+//
+// If you ever need to change it, change also the package doc and init_db.sql.
+const tableName = "TQSelfHostedTasks"
+
+func init() {
+	selfhosted.Register(selfhosted.Impl{
+		Kind:   "spanner",
+		Module: span.ModuleName,
+		New: func(ctx context.Context) (selfhosted.Store, error) {
+			return Store{}, nil
+		},
+	})
+}
+
+// Store is a selfhosted.Store that keeps tasks in Cloud Spanner.
+//
+// Uses the Spanner client in the context (see server/span). Done tasks with
+// a deduplication window stay as tombstones until the window ends. They are
+// cleaned up by Lease.
+type Store struct{}
+
+var _ selfhosted.Store = Store{}
+
+// Add implements selfhosted.Store.
+func (Store) Add(ctx context.Context, t *selfhosted.Task) error {
+	now := clock.Now(ctx)
+	exists := false
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		exists = false
+		row, err := span.ReadRow(ctx, tableName, spanner.Key{t.ID}, []string{"Done", "DedupUntil"})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			var done bool
+			var dedupUntil spanner.NullTime
+			if err := row.Columns(&done, &dedupUntil); err != nil {
+				return err
+			}
+			if !done || (dedupUntil.Valid && dedupUntil.Time.After(now)) {
+				exists = true
+				return nil
+			}
+		}
+		span.BufferWrite(ctx, spanner.InsertOrUpdateMap(tableName, map[string]any{
+			"ID":         t.ID,
+			"Queue":      t.Queue,
+			"ETA":        t.ETA,
+			"DedupUntil": nullTime(t.DedupUntil),
+			"Attempts":   0,
+			"Lease":      spanner.NullString{},
+			"Done":       false,
+			"Payload":    t.Payload,
+		}))
+		return nil
+	})
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "failed to add task %q", t.ID).Tag(transient.Tag).Err()
+	case exists:
+		return selfhosted.ErrAlreadyExists
+	}
+	return nil
+}
+
+// Lease implements selfhosted.Store.
+func (Store) Lease(ctx context.Context, limit int, dur time.Duration) ([]*selfhosted.Task, error) {
+	now := clock.Now(ctx)
+	expiry := now.Add(dur)
+	lease := selfhosted.NewRandomID()
+
+	var tasks []*selfhosted.Task
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		tasks = nil
+		st := spanner.NewStatement(`
+			SELECT ID, Queue, DedupUntil, Attempts, Done, Payload
+			FROM TQSelfHostedTasks@{FORCE_INDEX=TQSelfHostedTasksByETA}
+			WHERE ETA <= @now
+			ORDER BY ETA
+			LIMIT @limit
+		`)
+		st.Params["now"] = now
+		st.Params["limit"] = limit
+
+		var muts []*spanner.Mutation
+		err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+			t := &selfhosted.Task{ETA: expiry, Lease: lease}
+			var dedupUntil spanner.NullTime
+			var attempts int64
+			var done bool
+			if err := row.Columns(&t.ID, &t.Queue, &dedupUntil, &attempts, &done, &t.Payload); err != nil {
+				return err
+			}
+			if done {
+				// An expired tombstone of a done task.
+				muts = append(muts, spanner.Delete(tableName, spanner.Key{t.ID}))
+				return nil
+			}
+			t.DedupUntil = dedupUntil.Time
+			t.Attempts = int(attempts) + 1
+			muts = append(muts, spanner.UpdateMap(tableName, map[string]any{
+				"ID":       t.ID,
+				"ETA":      t.ETA,
+				"Attempts": t.Attempts,
+				"Lease":    t.Lease,
+			}))
+			tasks = append(tasks, t)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		span.BufferWrite(ctx, muts...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to lease tasks").Tag(transient.Tag).Err()
+	}
+	return tasks, nil
+}
+
+// Complete implements selfhosted.Store.
+func (s Store) Complete(ctx context.Context, t *selfhosted.Task) error {
+	now := clock.Now(ctx)
+	return s.updateLeased(ctx, t, func(dedupUntil time.Time) *spanner.Mutation {
+		if !dedupUntil.After(now) {
+			return spanner.Delete(tableName, spanner.Key{t.ID})
+		}
+		return spanner.UpdateMap(tableName, map[string]any{
+			"ID":      t.ID,
+			"ETA":     dedupUntil,
+			"Lease":   spanner.NullString{},
+			"Done":    true,
+			"Payload": []byte(nil),
+		})
+	})
+}
+
+// Retry implements selfhosted.Store.
+func (s Store) Retry(ctx context.Context, t *selfhosted.Task, eta time.Time) error {
+	return s.updateLeased(ctx, t, func(time.Time) *spanner.Mutation {
+		return spanner.UpdateMap(tableName, map[string]any{
+			"ID":    t.ID,
+			"ETA":   eta,
+			"Lease": spanner.NullString{},
+		})
+	})
+}
+
+// Depth implements selfhosted.Store.
+//
+// Scans the entire table.
+func (Store) Depth(ctx context.Context) (map[string]int64, error) {
+	st := spanner.NewStatement(`
+		SELECT Queue, COUNT(*)
+		FROM TQSelfHostedTasks
+		WHERE Done = FALSE
+		GROUP BY Queue
+	`)
+	depth := map[string]int64{}
+	err := span.Query(span.Single(ctx), st).Do(func(row *spanner.Row) error {
+		var queue string
+		var count int64
+		if err := row.Columns(&queue, &count); err != nil {
+			return err
+		}
+		depth[queue] = count
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch queue depth").Tag(transient.Tag).Err()
+	}
+	return depth, nil
+}
+
+// updateLeased applies a mutation to a task if it is still leased by `t`.
+//
+// The callback receives DedupUntil of the task.
+func (Store) updateLeased(ctx context.Context, t *selfhosted.Task, cb func(dedupUntil time.Time) *spanner.Mutation) error {
+	lost := false
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		lost = false
+		row, err := span.ReadRow(ctx, tableName, spanner.Key{t.ID}, []string{"Done", "Lease", "DedupUntil"})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+			lost = true
+			return nil
+		case err != nil:
+			return err
+		}
+		var done bool
+		var lease spanner.NullString
+		var dedupUntil spanner.NullTime
+		if err := row.Columns(&done, &lease, &dedupUntil); err != nil {
+			return err
+		}
+		if done || lease.StringVal != t.Lease {
+			lost = true
+			return nil
+		}
+		span.BufferWrite(ctx, cb(dedupUntil.Time))
+		return nil
+	})
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "failed to update task %q", t.ID).Tag(transient.Tag).Err()
+	case lost:
+		return selfhosted.ErrLeaseLost
+	}
+	return nil
+}
+
+// nullTime converts zero time to NULL.
+func nullTime(t time.Time) spanner.NullTime {
+	return spanner.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfhosted defines storage for the self-hosted server/tq backend.
+//
+// The self-hosted backend stores tasks in a database (instead of submitting
+// them to Cloud Tasks) and executes them by leasing them to server replicas.
+// It allows to use server/tq in environments without Google Cloud queue
+// services, e.g. on-prem or in Kubernetes clusters.
+//
+// This package defines the Store interface and a registry of its
+// implementations. The actual implementations live in subpackages and are
+// normally imported unnamed:
+//
+//	import _ "go.chromium.org/luci/server/tq/selfhosted/redis"
+//	import _ "go.chromium.org/luci/server/tq/selfhosted/spanner"
+//
+// The store to use is then picked via -tq-self-hosted-store server flag. See
+// server/tq ModuleOptions for details.
+package selfhosted
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/module"
+)
+
+var (
+	// ErrAlreadyExists is returned by Store.Add if a task with the same ID
+	// exists or existed recently.
+	ErrAlreadyExists = errors.New("the task already exists")
+
+	// ErrLeaseLost is returned by Store.Complete and Store.Retry if the lease on
+	// the task has expired and the task was leased by someone else.
+	ErrLeaseLost = errors.New("the task lease is lost")
+)
+
+// Task is a task in a Store.
+type Task struct {
+	// ID is a unique task ID.
+	//
+	// For named tasks it is derived from the task name and used for
+	// deduplication.
+	ID string
+
+	// Queue is a name of the queue the task belongs to.
+	//
+	// Used only for monitoring.
+	Queue string
+
+	// ETA is when the task should be executed.
+	//
+	// When the task is leased, it is set to the lease expiration time.
+	ETA time.Time
+
+	// DedupUntil is when the task ID can be reused after the task is done.
+	//
+	// Zero if it can be reused right away.
+	DedupUntil time.Time
+
+	// Attempts is how many times the task was leased, including the current
+	// lease.
+	Attempts int
+
+	// Payload is a serialized task body, opaque to the Store.
+	Payload []byte
+
+	// Lease identifies the current lease, populated by Store.Lease.
+	Lease string
+}
+
+// Store stores tasks.
+//
+// All methods must be goroutine-safe. Errors that can be retried must be
+// tagged with transient.Tag.
+type Store interface {
+	// Add adds a new task.
+	//
+	// Returns ErrAlreadyExists if there's a pending task with the same ID or
+	// a done task with the same ID and DedupUntil in the future.
+	Add(ctx context.Context, t *Task) error
+
+	// Lease leases up to `limit` tasks with ETA in the past.
+	//
+	// Leased tasks get their ETA set to now+dur and Attempts incremented. They
+	// won't be returned by Lease again until their lease expires.
+	Lease(ctx context.Context, limit int, dur time.Duration) ([]*Task, error)
+
+	// Complete marks a leased task as done.
+	//
+	// Returns ErrLeaseLost if the task's lease has expired and the task was
+	// leased again.
+	Complete(ctx context.Context, t *Task) error
+
+	// Retry releases a leased task, scheduling it to be leased again at `eta`.
+	//
+	// Returns ErrLeaseLost if the task's lease has expired and the task was
+	// leased again.
+	Retry(ctx context.Context, t *Task, eta time.Time) error
+
+	// Depth returns the number of pending (including leased) tasks per queue.
+	Depth(ctx context.Context) (map[string]int64, error)
+}
+
+// Impl knows how to instantiate Store instances.
+type Impl struct {
+	// Kind identifies this particular Store implementation.
+	//
+	// This is what is passed via -tq-self-hosted-store flag.
+	Kind string
+
+	// Module is name of the server module the store depends on, if any.
+	Module module.Name
+
+	// New returns a Store.
+	//
+	// Receives the server context, with all modules initialized.
+	New func(ctx context.Context) (Store, error)
+}
+
+var impls []Impl
+
+// Register registers a Store implementation.
+//
+// Must be called during init() time.
+func Register(impl Impl) {
+	if impl.Kind == "" {
+		panic("Kind must not be empty")
+	}
+	for _, existing := range impls {
+		if existing.Kind == impl.Kind {
+			panic(fmt.Sprintf("store %q is already registered", impl.Kind))
+		}
+	}
+	impls = append(impls, impl)
+}
+
+// VisitImpls calls the callback for all registered implementations.
+func VisitImpls(cb func(impl *Impl)) {
+	for i := range impls {
+		cb(&impls[i])
+	}
+}
+
+// New instantiates a store given its kind.
+func New(ctx context.Context, kind string) (Store, error) {
+	for _, impl := range impls {
+		if impl.Kind == kind {
+			return impl.New(ctx)
+		}
+	}
+	return nil, errors.Reason("no self-hosted TQ store %q is registered in the process", kind).Err()
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storetest contains tests shared by selfhosted.Store implementations.
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/tq/selfhosted"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// TestStore runs a conformance test suite against a store.
+//
+// Must be called within a Convey block. The store must be empty. The context
+// must have the given test clock installed.
+func TestStore(ctx context.Context, tc testclock.TestClock, s selfhosted.Store) {
+	add := func(id, queue string, delay time.Duration) {
+		So(s.Add(ctx, &selfhosted.Task{
+			ID:      id,
+			Queue:   queue,
+			ETA:     tc.Now().Add(delay),
+			Payload: []byte("payload " + id),
+		}), ShouldBeNil)
+	}
+
+	lease := func(limit int) []*selfhosted.Task {
+		tasks, err := s.Lease(ctx, limit, time.Minute)
+		So(err, ShouldBeNil)
+		return tasks
+	}
+
+	ids := func(tasks []*selfhosted.Task) []string {
+		out := make([]string, len(tasks))
+		for i, t := range tasks {
+			out[i] = t.ID
+		}
+		return out
+	}
+
+	Convey(`Leases due tasks in ETA order`, func() {
+		add("b", "q1", 2*time.Second)
+		add("a", "q1", time.Second)
+		add("c", "q2", time.Hour)
+
+		So(lease(10), ShouldBeEmpty)
+
+		tc.Add(5 * time.Second)
+		tasks := lease(1)
+		So(ids(tasks), ShouldResemble, []string{"a"})
+		So(tasks[0].Queue, ShouldEqual, "q1")
+		So(tasks[0].Attempts, ShouldEqual, 1)
+		So(tasks[0].Payload, ShouldResemble, []byte("payload a"))
+		So(tasks[0].Lease, ShouldNotEqual, "")
+
+		So(ids(lease(10)), ShouldResemble, []string{"b"})
+		So(lease(10), ShouldBeEmpty)
+
+		depth, err := s.Depth(ctx)
+		So(err, ShouldBeNil)
+		So(depth, ShouldResemble, map[string]int64{"q1": 2, "q2": 1})
+	})
+
+	Convey(`Complete`, func() {
+		add("a", "q", 0)
+		tasks := lease(10)
+		So(tasks, ShouldHaveLength, 1)
+
+		So(s.Complete(ctx, tasks[0]), ShouldBeNil)
+		So(s.Complete(ctx, tasks[0]), ShouldEqual, selfhosted.ErrLeaseLost)
+
+		tc.Add(time.Hour)
+		So(lease(10), ShouldBeEmpty)
+
+		depth, err := s.Depth(ctx)
+		So(err, ShouldBeNil)
+		So(depth, ShouldBeEmpty)
+	})
+
+	Convey(`Retry`, func() {
+		add("a", "q", 0)
+		tasks := lease(10)
+		So(tasks, ShouldHaveLength, 1)
+
+		So(s.Retry(ctx, tasks[0], tc.Now().Add(10*time.Second)), ShouldBeNil)
+		So(lease(10), ShouldBeEmpty)
+
+		tc.Add(10 * time.Second)
+		tasks = lease(10)
+		So(ids(tasks), ShouldResemble, []string{"a"})
+		So(tasks[0].Attempts, ShouldEqual, 2)
+		So(s.Complete(ctx, tasks[0]), ShouldBeNil)
+	})
+
+	Convey(`Expired leases`, func() {
+		add("a", "q", 0)
+		first := lease(10)
+		So(first, ShouldHaveLength, 1)
+
+		tc.Add(2 * time.Minute)
+		second := lease(10)
+		So(ids(second), ShouldResemble, []string{"a"})
+		So(second[0].Attempts, ShouldEqual, 2)
+
+		So(s.Complete(ctx, first[0]), ShouldEqual, selfhosted.ErrLeaseLost)
+		So(s.Retry(ctx, first[0], tc.Now()), ShouldEqual, selfhosted.ErrLeaseLost)
+		So(s.Complete(ctx, second[0]), ShouldBeNil)
+	})
+
+	Convey(`Deduplication`, func() {
+		task := &selfhosted.Task{
+			ID:         "a",
+			Queue:      "q",
+			ETA:        tc.Now(),
+			DedupUntil: tc.Now().Add(time.Hour),
+			Payload:    []byte("payload"),
+		}
+		So(s.Add(ctx, task), ShouldBeNil)
+		So(s.Add(ctx, task), ShouldEqual, selfhosted.ErrAlreadyExists)
+
+		tasks := lease(10)
+		So(tasks, ShouldHaveLength, 1)
+		So(s.Complete(ctx, tasks[0]), ShouldBeNil)
+		So(s.Add(ctx, task), ShouldEqual, selfhosted.ErrAlreadyExists)
+
+		tc.Add(2 * time.Hour)
+		So(lease(10), ShouldBeEmpty) // collects the tombstone, if necessary
+		task.ETA = tc.Now()
+		So(s.Add(ctx, task), ShouldBeNil)
+		So(ids(lease(10)), ShouldResemble, []string{"a"})
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tq
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/tsmon"
+
+	"go.chromium.org/luci/server/tq/internal/metrics"
+	"go.chromium.org/luci/server/tq/internal/reminder"
+	"go.chromium.org/luci/server/tq/selfhosted"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSelfHosted(t *testing.T) {
+	t.Parallel()
+
+	Convey("With self-hosted backend", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		store := &selfhosted.MemoryStore{}
+		ctx = UseSubmitter(ctx, &SelfHostedSubmitter{Store: store})
+
+		type call struct {
+			payload time.Duration
+			attempt int
+		}
+		var m sync.Mutex
+		var calls []call
+
+		d := &Dispatcher{}
+		d.RegisterTaskClass(TaskClass{
+			ID:        "test-dur",
+			Prototype: &durationpb.Duration{},
+			Kind:      NonTransactional,
+			Queue:     "queue-1",
+			Handler: func(ctx context.Context, msg proto.Message) error {
+				dur := msg.(*durationpb.Duration).AsDuration()
+				info := TaskExecutionInfo(ctx)
+				m.Lock()
+				calls = append(calls, call{dur, info.ExecutionCount})
+				m.Unlock()
+				switch {
+				case dur == time.Second && info.ExecutionCount == 0:
+					return transient.Tag.Apply(errors.New("flaky"))
+				case dur == 2*time.Second:
+					return errors.New("always fails")
+				case dur == 3*time.Second:
+					return Fatal.Apply(errors.New("fatal"))
+				}
+				return nil
+			},
+		})
+
+		exe := &SelfHostedExecutor{
+			Dispatcher:   d,
+			Store:        store,
+			PollInterval: 5 * time.Millisecond,
+			MinBackoff:   time.Millisecond,
+			MaxAttempts:  3,
+		}
+
+		// runUntilDrained runs the executor until all tasks are done.
+		runUntilDrained := func() []call {
+			done := make(chan struct{})
+			exeCtx, stop := context.WithCancel(ctx)
+			go func() {
+				defer close(done)
+				exe.Run(exeCtx)
+			}()
+
+			deadline := time.Now().Add(30 * time.Second)
+			for time.Now().Before(deadline) {
+				depth, err := store.Depth(ctx)
+				So(err, ShouldBeNil)
+				if len(depth) == 0 {
+					break
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+			stop()
+			<-done
+
+			m.Lock()
+			defer m.Unlock()
+			sort.Slice(calls, func(i, j int) bool {
+				if calls[i].payload != calls[j].payload {
+					return calls[i].payload < calls[j].payload
+				}
+				return calls[i].attempt < calls[j].attempt
+			})
+			return calls
+		}
+
+		Convey("Executes and retries tasks", func() {
+			for _, dur := range []time.Duration{0, time.Second, 2 * time.Second, 3 * time.Second} {
+				So(d.AddTask(ctx, &Task{Payload: durationpb.New(dur)}), ShouldBeNil)
+			}
+			So(runUntilDrained(), ShouldResemble, []call{
+				{0, 0},
+				{time.Second, 0},
+				{time.Second, 1},
+				{2 * time.Second, 0},
+				{2 * time.Second, 1},
+				{2 * time.Second, 2},
+				{3 * time.Second, 0},
+			})
+		})
+
+		Convey("Honors ETA", func() {
+			So(d.AddTask(ctx, &Task{Payload: durationpb.New(0), Delay: time.Hour}), ShouldBeNil)
+			tasks, err := store.Lease(ctx, 10, time.Minute)
+			So(err, ShouldBeNil)
+			So(tasks, ShouldBeEmpty)
+		})
+
+		Convey("Deduplicates named tasks", func() {
+			task := &Task{Payload: durationpb.New(0), DeduplicationKey: "key"}
+			So(d.AddTask(ctx, task), ShouldBeNil)
+			So(d.AddTask(ctx, task), ShouldBeNil)
+			So(runUntilDrained(), ShouldResemble, []call{{0, 0}})
+			So(d.AddTask(ctx, task), ShouldBeNil)
+			So(runUntilDrained(), ShouldResemble, []call{{0, 0}})
+		})
+
+		Convey("Rejects PubSub tasks", func() {
+			err := (&SelfHostedSubmitter{Store: store}).Submit(ctx, &reminder.Payload{
+				PublishRequest: &pubsubpb.PublishRequest{},
+			})
+			So(err, ShouldHaveGRPCStatus, codes.Unimplemented)
+		})
+
+		Convey("Reports queue depth", func() {
+			ctx, _ := tsmon.WithDummyInMemory(ctx)
+			depth := func() any {
+				exe.ReportMetrics(ctx)
+				return tsmon.GetState(ctx).Store().Get(ctx, metrics.SelfHostedDepth, time.Time{},
+					[]any{"projects/default/locations/default/queues/queue-1"})
+			}
+
+			So(d.AddTask(ctx, &Task{Payload: durationpb.New(0)}), ShouldBeNil)
+			So(depth(), ShouldEqual, 1)
+			runUntilDrained()
+			So(depth(), ShouldEqual, 0)
+		})
+	})
+}
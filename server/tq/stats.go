@@ -0,0 +1,183 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tq
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/portal"
+	"go.chromium.org/luci/server/tq/internal/sweep"
+)
+
+// statsWindow is the window used to calculate recent throughput of task
+// handlers.
+const statsWindow = 10 * time.Minute
+
+// handlerStats is in-memory statistics about tasks of some class handled by
+// this process.
+//
+// Used to show recent throughput on the "Runtime status" portal page.
+type handlerStats struct {
+	m        sync.Mutex
+	buckets  [statsWindow / time.Minute]statsBucket // per-minute ring buffer
+	lastErr  string                                 // the last handler error
+	lastErrT time.Time                              // when it happened
+}
+
+// statsBucket is the number of tasks handled during some minute.
+type statsBucket struct {
+	minute int64 // Unix time in minutes
+	ok     int64 // handled successfully (or ignored)
+	failed int64 // failed with any error
+}
+
+// record records the outcome of a handler call.
+func (s *handlerStats) record(now time.Time, err error) {
+	minute := now.Unix() / 60
+	s.m.Lock()
+	defer s.m.Unlock()
+	b := &s.buckets[minute%int64(len(s.buckets))]
+	if b.minute != minute {
+		*b = statsBucket{minute: minute}
+	}
+	if err == nil || Ignore.In(err) {
+		b.ok++
+	} else {
+		b.failed++
+		s.lastErr = err.Error()
+		s.lastErrT = now
+	}
+}
+
+// taskClassStats is the recent throughput of handlers of some task class.
+type taskClassStats struct {
+	ID        string    // the task class ID
+	Queue     string    // the queue or the topic of the class
+	Running   int32     // number of handlers running right now
+	OK        int64     // number of tasks handled successfully within the window
+	Failed    int64     // number of tasks that failed within the window
+	LastError string    // the last handler error, if any
+	LastErrAt time.Time // when it happened
+}
+
+// snapshot returns stats within the window ending at `now`.
+func (s *handlerStats) snapshot(now time.Time) (ok, failed int64, lastErr string, lastErrT time.Time) {
+	oldest := now.Unix()/60 - int64(len(s.buckets)) + 1
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, b := range s.buckets {
+		if b.minute >= oldest {
+			ok += b.ok
+			failed += b.failed
+		}
+	}
+	return ok, failed, s.lastErr, s.lastErrT
+}
+
+// stats returns recent throughput of all registered task classes, sorted by
+// ID.
+func (d *Dispatcher) stats(now time.Time) []taskClassStats {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	out := make([]taskClassStats, 0, len(d.clsByID))
+	for id, cls := range d.clsByID {
+		st := taskClassStats{
+			ID:      id,
+			Queue:   cls.Queue,
+			Running: atomic.LoadInt32(&cls.running),
+		}
+		if st.Queue == "" {
+			st.Queue = cls.Topic
+		}
+		st.OK, st.Failed, st.LastError, st.LastErrAt = cls.stats.snapshot(now)
+		out = append(out, st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+var statusTmpl = template.Must(template.New("tq").Parse(`
+{{if .Classes}}
+<p>Tasks handled by this process within the last {{.Window}}.</p>
+<table class="table table-condensed">
+  <tr><th>Task class</th><th>Queue</th><th>Running</th><th>OK</th><th>Failed</th><th>Last error</th></tr>
+  {{range .Classes}}
+  <tr>
+    <td>{{.ID}}</td>
+    <td>{{.Queue}}</td>
+    <td>{{.Running}}</td>
+    <td>{{.OK}}</td>
+    <td>{{.Failed}}</td>
+    <td>{{if .LastError}}{{.LastErrAt.Format "2006-01-02 15:04:05 MST"}}: {{.LastError}}{{end}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No task classes are registered.</p>
+{{end}}
+<h5>Recent sweeps</h5>
+{{if .Scans}}
+<p>Scans of transactional task reminders done by this process. Stale
+reminders are pending tasks which are being submitted by the sweeper.</p>
+<table class="table table-condensed">
+  <tr><th>Started</th><th>DB</th><th>Level</th><th>Status</th><th>Fetched</th><th>Stale</th><th>Duration</th></tr>
+  {{range .Scans}}
+  <tr>
+    <td>{{.Time.Format "2006-01-02 15:04:05 MST"}}</td>
+    <td>{{.DB}}</td>
+    <td>{{.Level}}</td>
+    <td>{{.Status}}</td>
+    <td>{{.Fetched}}</td>
+    <td>{{.Stale}}</td>
+    <td>{{.Duration}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No sweeps were done by this process.</p>
+{{end}}
+`))
+
+// renderStatus renders the state of the Default dispatcher.
+func renderStatus(ctx context.Context) (template.HTML, error) {
+	out := strings.Builder{}
+	err := statusTmpl.Execute(&out, map[string]any{
+		"Window":  fmt.Sprintf("%d min", int(statsWindow/time.Minute)),
+		"Classes": Default.stats(clock.Now(ctx)),
+		"Scans":   sweep.RecentScans(),
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to render tq status").Err()
+	}
+	return template.HTML(out.String()), nil
+}
+
+func init() {
+	portal.RegisterStatusSection(portal.StatusSection{
+		ID:     "tq",
+		Title:  "Task queue",
+		Render: renderStatus,
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHandlerStats(t *testing.T) {
+	t.Parallel()
+
+	Convey("Works", t, func() {
+		now := testclock.TestRecentTimeUTC.Truncate(time.Minute)
+		s := handlerStats{}
+
+		s.record(now, nil)
+		s.record(now.Add(time.Second), Ignore.Apply(errors.New("ignored")))
+		s.record(now.Add(2*time.Second), errors.New("boom"))
+		s.record(now.Add(5*time.Minute), nil)
+
+		ok, failed, lastErr, lastErrT := s.snapshot(now.Add(5 * time.Minute))
+		So(ok, ShouldEqual, 3)
+		So(failed, ShouldEqual, 1)
+		So(lastErr, ShouldEqual, "boom")
+		So(lastErrT.Equal(now.Add(2*time.Second)), ShouldBeTrue)
+
+		// Old buckets drop out of the window.
+		ok, failed, _, _ = s.snapshot(now.Add(statsWindow))
+		So(ok, ShouldEqual, 1)
+		So(failed, ShouldEqual, 0)
+
+		// Reused buckets are reset.
+		s.record(now.Add(statsWindow), nil)
+		ok, failed, _, _ = s.snapshot(now.Add(statsWindow))
+		So(ok, ShouldEqual, 2)
+		So(failed, ShouldEqual, 0)
+	})
+}
@@ -21,7 +21,9 @@ import (
 	"context"
 	"net/http"
 	"sync"
+	"time"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 
@@ -34,6 +36,7 @@ type Callback func(c context.Context) error
 var state struct {
 	sync.Mutex
 	callbacks []callbackWithName
+	report    *Report // the report of the last finished Warmup call
 }
 
 type callbackWithName struct {
@@ -41,6 +44,27 @@ type callbackWithName struct {
 	name string
 }
 
+// Report describes the result of a Warmup call.
+type Report struct {
+	Started   time.Time        // when Warmup was called
+	Callbacks []CallbackReport // results of individual callbacks, in order
+}
+
+// CallbackReport describes the result of a single warmup callback.
+type CallbackReport struct {
+	Name     string        // the name passed to Register
+	Duration time.Duration // how long the callback ran
+	Error    error         // the error returned by the callback, if any
+}
+
+// LastReport returns the report of the last finished Warmup call in this
+// process or nil if the warmup hasn't run (yet).
+func LastReport() *Report {
+	state.Lock()
+	defer state.Unlock()
+	return state.report
+}
+
 // Register adds a callback called during warmup.
 func Register(name string, cb Callback) {
 	if name == "" {
@@ -59,14 +83,27 @@ func Warmup(c context.Context) error {
 	state.Lock()
 	defer state.Unlock()
 
+	report := &Report{
+		Started:   clock.Now(c),
+		Callbacks: make([]CallbackReport, len(state.callbacks)),
+	}
+
 	var merr errors.MultiError
-	for _, cb := range state.callbacks {
+	for i, cb := range state.callbacks {
 		logging.Infof(c, "Warming up %q", cb.name)
-		if err := cb.Callback(c); err != nil {
+		start := clock.Now(c)
+		err := cb.Callback(c)
+		if err != nil {
 			logging.Errorf(c, "Error when warming up %q: %s", cb.name, err)
 			merr = append(merr, err)
 		}
+		report.Callbacks[i] = CallbackReport{
+			Name:     cb.name,
+			Duration: clock.Since(c, start),
+			Error:    err,
+		}
 	}
+	state.report = report
 
 	logging.Infof(c, "Finished warming up")
 	if len(merr) == 0 {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestWorks(t *testing.T) {
@@ -41,8 +42,18 @@ func TestWorks(t *testing.T) {
 			return fmt.Errorf("OMG 2")
 		})
 
+		So(LastReport(), ShouldBeNil)
+
 		err := Warmup(context.Background())
 		So(err.Error(), ShouldEqual, "OMG 1 (and 1 other error)")
 		So(called, ShouldResemble, []string{"1", "2", "3"})
+
+		report := LastReport()
+		So(report, ShouldNotBeNil)
+		So(report.Callbacks, ShouldHaveLength, 3)
+		So(report.Callbacks[0].Name, ShouldEqual, "1")
+		So(report.Callbacks[0].Error, ShouldBeNil)
+		So(report.Callbacks[2].Name, ShouldEqual, "3")
+		So(report.Callbacks[2].Error, ShouldErrLike, "OMG 2")
 	})
 }
@@ -28,6 +28,7 @@ const (
 	Admin_ImportDelegationConfigs_FullMethodName           = "/tokenserver.admin.Admin/ImportDelegationConfigs"
 	Admin_ImportProjectIdentityConfigs_FullMethodName      = "/tokenserver.admin.Admin/ImportProjectIdentityConfigs"
 	Admin_ImportProjectOwnedAccountsConfigs_FullMethodName = "/tokenserver.admin.Admin/ImportProjectOwnedAccountsConfigs"
+	Admin_ImportOIDCFederationConfigs_FullMethodName       = "/tokenserver.admin.Admin/ImportOIDCFederationConfigs"
 	Admin_InspectMachineToken_FullMethodName               = "/tokenserver.admin.Admin/InspectMachineToken"
 	Admin_InspectDelegationToken_FullMethodName            = "/tokenserver.admin.Admin/InspectDelegationToken"
 )
@@ -44,6 +45,8 @@ type AdminClient interface {
 	ImportProjectIdentityConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImportedConfigs, error)
 	// ImportProjectOwnedAccountsConfigs makes the server read 'project_owned_accounts.cfg'.
 	ImportProjectOwnedAccountsConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImportedConfigs, error)
+	// ImportOIDCFederationConfigs makes the server read 'oidc_federation.cfg'.
+	ImportOIDCFederationConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImportedConfigs, error)
 	// InspectMachineToken decodes a machine token and verifies it is valid.
 	//
 	// It verifies the token was signed by a private key of the token server and
@@ -124,6 +127,15 @@ func (c *adminClient) ImportProjectOwnedAccountsConfigs(ctx context.Context, in
 	return out, nil
 }
 
+func (c *adminClient) ImportOIDCFederationConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImportedConfigs, error) {
+	out := new(ImportedConfigs)
+	err := c.cc.Invoke(ctx, Admin_ImportOIDCFederationConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) InspectMachineToken(ctx context.Context, in *InspectMachineTokenRequest, opts ...grpc.CallOption) (*InspectMachineTokenResponse, error) {
 	out := new(InspectMachineTokenResponse)
 	err := c.cc.Invoke(ctx, Admin_InspectMachineToken_FullMethodName, in, out, opts...)
@@ -154,6 +166,8 @@ type AdminServer interface {
 	ImportProjectIdentityConfigs(context.Context, *emptypb.Empty) (*ImportedConfigs, error)
 	// ImportProjectOwnedAccountsConfigs makes the server read 'project_owned_accounts.cfg'.
 	ImportProjectOwnedAccountsConfigs(context.Context, *emptypb.Empty) (*ImportedConfigs, error)
+	// ImportOIDCFederationConfigs makes the server read 'oidc_federation.cfg'.
+	ImportOIDCFederationConfigs(context.Context, *emptypb.Empty) (*ImportedConfigs, error)
 	// InspectMachineToken decodes a machine token and verifies it is valid.
 	//
 	// It verifies the token was signed by a private key of the token server and
@@ -207,6 +221,9 @@ func (UnimplementedAdminServer) ImportProjectIdentityConfigs(context.Context, *e
 func (UnimplementedAdminServer) ImportProjectOwnedAccountsConfigs(context.Context, *emptypb.Empty) (*ImportedConfigs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportProjectOwnedAccountsConfigs not implemented")
 }
+func (UnimplementedAdminServer) ImportOIDCFederationConfigs(context.Context, *emptypb.Empty) (*ImportedConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOIDCFederationConfigs not implemented")
+}
 func (UnimplementedAdminServer) InspectMachineToken(context.Context, *InspectMachineTokenRequest) (*InspectMachineTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectMachineToken not implemented")
 }
@@ -298,6 +315,24 @@ func _Admin_ImportProjectOwnedAccountsConfigs_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ImportOIDCFederationConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ImportOIDCFederationConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ImportOIDCFederationConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ImportOIDCFederationConfigs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_InspectMachineToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InspectMachineTokenRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +392,10 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportProjectOwnedAccountsConfigs",
 			Handler:    _Admin_ImportProjectOwnedAccountsConfigs_Handler,
 		},
+		{
+			MethodName: "ImportOIDCFederationConfigs",
+			Handler:    _Admin_ImportOIDCFederationConfigs_Handler,
+		},
 		{
 			MethodName: "InspectMachineToken",
 			Handler:    _Admin_InspectMachineToken_Handler,
@@ -12,1088 +12,1106 @@ func init() {
 			"tokenserver.admin.Admin", "tokenserver.admin.CertificateAuthorities",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 108, 28, 201,
-			146, 32, 214, 245, 209, 205, 102, 234, 139, 44, 125, 144, 106, 125,
-			165, 90, 35, 177, 57, 211, 108, 126, 72, 226, 140, 164, 25, 205,
-			180, 200, 150, 216, 18, 69, 242, 117, 55, 71, 79, 154, 55, 160,
-			170, 187, 178, 201, 122, 170, 174, 234, 169, 170, 38, 213, 51, 30,
-			27, 246, 121, 125, 182, 23, 240, 222, 190, 51, 14, 94, 99, 215,
-			198, 158, 127, 28, 112, 128, 113, 222, 61, 223, 45, 112, 247, 124,
-			183, 139, 93, 251, 193, 7, 127, 253, 241, 174, 129, 197, 221, 254,
-			216, 243, 15, 219, 119, 7, 255, 92, 219, 128, 109, 68, 100, 102,
-			85, 117, 179, 41, 205, 188, 55, 123, 120, 94, 63, 98, 6, 234,
-			172, 202, 202, 140, 140, 140, 140, 140, 136, 140, 136, 36, 255, 207,
-			36, 185, 176, 235, 121, 187, 14, 155, 239, 250, 94, 232, 53, 123,
-			237, 121, 214, 233, 134, 253, 18, 22, 141, 83, 252, 101, 73, 190,
-			204, 143, 145, 116, 5, 222, 63, 248, 154, 156, 110, 121, 157, 210,
-			208, 251, 7, 4, 223, 110, 65, 113, 75, 121, 33, 95, 239, 122,
-			142, 233, 238, 150, 60, 127, 55, 238, 38, 236, 119, 89, 48, 255,
-			202, 245, 14, 92, 222, 101, 183, 249, 103, 138, 242, 215, 85, 237,
-			209, 214, 131, 191, 169, 94, 126, 196, 191, 220, 18, 213, 75, 207,
-			152, 227, 60, 129, 202, 13, 248, 238, 241, 63, 152, 32, 25, 67,
-			191, 156, 90, 154, 32, 255, 205, 113, 162, 28, 55, 180, 203, 41,
-			99, 233, 247, 142, 83, 252, 160, 229, 57, 244, 65, 175, 221, 102,
-			126, 64, 231, 40, 111, 106, 38, 160, 150, 25, 154, 212, 118, 67,
-			230, 183, 246, 76, 119, 151, 209, 182, 231, 119, 204, 144, 208, 21,
-			175, 219, 247, 237, 221, 189, 144, 46, 45, 44, 124, 32, 62, 160,
-			85, 183, 85, 162, 180, 236, 56, 20, 223, 5, 212, 103, 1, 243,
-			247, 153, 85, 34, 116, 47, 12, 187, 193, 221, 249, 121, 139, 237,
-			51, 199, 235, 50, 63, 144, 99, 109, 121, 29, 62, 200, 150, 231,
-			204, 53, 57, 16, 243, 132, 208, 26, 179, 236, 32, 244, 237, 102,
-			47, 180, 61, 151, 154, 174, 69, 123, 1, 163, 182, 75, 3, 175,
-			231, 183, 24, 62, 105, 218, 174, 233, 247, 17, 174, 160, 72, 15,
-			236, 112, 143, 122, 62, 254, 235, 245, 66, 66, 59, 158, 101, 183,
-			237, 150, 9, 45, 20, 169, 233, 51, 218, 101, 126, 199, 14, 67,
-			102, 209, 174, 239, 237, 219, 22, 179, 104, 184, 103, 134, 52, 220,
-			131, 209, 57, 142, 119, 96, 187, 187, 180, 229, 185, 150, 13, 31,
-			5, 240, 17, 161, 29, 22, 222, 37, 132, 194, 223, 187, 67, 128,
-			5, 212, 107, 75, 136, 90, 158, 197, 104, 167, 23, 132, 212, 103,
-			161, 105, 187, 216, 170, 217, 244, 246, 225, 149, 192, 24, 161, 174,
-			23, 218, 45, 86, 164, 225, 158, 29, 80, 199, 14, 66, 104, 33,
-			217, 163, 107, 13, 129, 99, 217, 65, 203, 49, 237, 14, 243, 75,
-			71, 1, 97, 187, 73, 92, 72, 32, 186, 190, 103, 245, 90, 44,
-			134, 131, 196, 128, 252, 76, 112, 16, 42, 70, 103, 121, 173, 94,
-			135, 185, 161, 41, 39, 105, 222, 243, 169, 23, 238, 49, 159, 118,
-			204, 144, 249, 182, 233, 4, 49, 170, 113, 130, 194, 61, 70, 104,
-			18, 250, 104, 80, 27, 204, 198, 47, 161, 97, 215, 236, 48, 0,
-			40, 73, 91, 174, 23, 191, 67, 188, 219, 97, 0, 35, 114, 121,
-			83, 158, 31, 208, 142, 217, 167, 77, 6, 148, 98, 209, 208, 163,
-			204, 181, 60, 63, 96, 64, 20, 93, 223, 235, 120, 33, 163, 28,
-			39, 97, 64, 45, 230, 219, 251, 204, 162, 109, 223, 235, 16, 142,
-			133, 192, 107, 135, 7, 64, 38, 130, 130, 104, 208, 101, 45, 160,
-			32, 218, 245, 109, 32, 44, 31, 104, 199, 229, 84, 20, 4, 8,
-			59, 161, 141, 181, 106, 157, 214, 55, 31, 54, 158, 149, 107, 21,
-			90, 173, 211, 173, 218, 230, 167, 213, 213, 202, 42, 125, 240, 156,
-			54, 214, 42, 116, 101, 115, 235, 121, 173, 250, 104, 173, 65, 215,
-			54, 215, 87, 43, 181, 58, 45, 111, 172, 210, 149, 205, 141, 70,
-			173, 250, 96, 187, 177, 89, 171, 19, 154, 47, 215, 105, 181, 158,
-			199, 55, 229, 141, 231, 180, 242, 253, 173, 90, 165, 94, 167, 155,
-			53, 90, 125, 186, 181, 94, 173, 172, 210, 103, 229, 90, 173, 188,
-			209, 168, 86, 234, 69, 90, 221, 88, 89, 223, 94, 173, 110, 60,
-			42, 210, 7, 219, 13, 186, 177, 217, 32, 116, 189, 250, 180, 218,
-			168, 172, 210, 198, 102, 17, 187, 61, 252, 29, 221, 124, 72, 159,
-			86, 106, 43, 107, 229, 141, 70, 249, 65, 117, 189, 218, 120, 142,
-			29, 62, 172, 54, 54, 160, 179, 135, 155, 53, 66, 203, 116, 171,
-			92, 107, 84, 87, 182, 215, 203, 53, 186, 181, 93, 219, 218, 172,
-			87, 40, 140, 108, 181, 90, 95, 89, 47, 87, 159, 86, 86, 75,
-			180, 186, 65, 55, 54, 105, 229, 211, 202, 70, 131, 214, 215, 202,
-			235, 235, 131, 3, 37, 116, 243, 217, 70, 165, 6, 208, 39, 135,
-			73, 31, 84, 232, 122, 181, 252, 96, 189, 2, 93, 225, 56, 87,
-			171, 181, 202, 74, 3, 6, 20, 255, 90, 169, 174, 86, 54, 26,
-			229, 245, 34, 161, 245, 173, 202, 74, 181, 188, 94, 164, 149, 239,
-			87, 158, 110, 173, 151, 107, 207, 139, 162, 209, 122, 229, 123, 219,
-			149, 141, 70, 181, 188, 78, 87, 203, 79, 203, 143, 42, 117, 90,
-			120, 27, 86, 182, 106, 155, 43, 219, 181, 202, 83, 128, 122, 243,
-			33, 173, 111, 63, 168, 55, 170, 141, 237, 70, 133, 62, 218, 220,
-			92, 69, 100, 215, 43, 181, 79, 171, 43, 149, 250, 61, 186, 190,
-			89, 71, 132, 109, 215, 43, 69, 66, 87, 203, 141, 50, 118, 189,
-			85, 219, 124, 88, 109, 212, 239, 193, 239, 7, 219, 245, 42, 34,
-			174, 186, 209, 168, 212, 106, 219, 91, 141, 234, 230, 198, 44, 93,
-			219, 124, 86, 249, 180, 82, 163, 43, 229, 237, 122, 101, 21, 49,
-			188, 185, 1, 163, 5, 90, 169, 108, 214, 158, 67, 179, 128, 7,
-			156, 129, 34, 125, 182, 86, 105, 172, 85, 106, 128, 84, 196, 86,
-			25, 208, 80, 111, 212, 170, 43, 141, 100, 181, 205, 26, 109, 108,
-			214, 26, 36, 49, 78, 186, 81, 121, 180, 94, 125, 84, 217, 88,
-			169, 192, 235, 77, 104, 230, 89, 181, 94, 153, 165, 229, 90, 181,
-			14, 21, 170, 216, 49, 125, 86, 126, 78, 55, 183, 113, 212, 48,
-			81, 219, 245, 10, 225, 191, 19, 164, 91, 196, 249, 164, 213, 135,
-			180, 188, 250, 105, 21, 32, 23, 181, 183, 54, 235, 245, 170, 32,
-			23, 68, 219, 202, 154, 192, 121, 137, 144, 44, 81, 84, 67, 163,
-			169, 105, 248, 149, 53, 180, 124, 234, 30, 25, 39, 106, 246, 58,
-			255, 201, 31, 94, 75, 85, 240, 225, 49, 254, 147, 63, 124, 39,
-			85, 196, 135, 10, 255, 201, 31, 94, 79, 189, 135, 15, 197, 79,
-			254, 240, 70, 42, 143, 15, 9, 255, 201, 31, 206, 164, 174, 226,
-			195, 119, 248, 79, 254, 176, 144, 186, 130, 15, 175, 240, 159, 127,
-			95, 37, 170, 158, 50, 180, 165, 212, 68, 238, 111, 169, 180, 76,
-			119, 153, 203, 124, 187, 69, 113, 7, 165, 29, 22, 4, 230, 46,
-			227, 91, 64, 223, 235, 209, 150, 233, 82, 159, 205, 193, 70, 19,
-			122, 212, 220, 247, 108, 139, 90, 172, 109, 187, 200, 254, 122, 93,
-			7, 54, 19, 102, 145, 193, 239, 145, 253, 246, 189, 158, 79, 203,
-			91, 213, 160, 68, 203, 52, 236, 119, 237, 150, 233, 80, 246, 218,
-			236, 116, 29, 70, 237, 0, 218, 195, 253, 43, 164, 102, 128, 92,
-			204, 103, 95, 244, 88, 16, 18, 42, 184, 154, 207, 130, 174, 231,
-			66, 207, 253, 46, 178, 62, 211, 133, 246, 96, 243, 217, 243, 172,
-			18, 125, 232, 249, 212, 118, 131, 208, 116, 91, 76, 238, 70, 176,
-			191, 218, 45, 70, 31, 122, 30, 253, 138, 63, 162, 212, 239, 182,
-			232, 3, 211, 47, 12, 201, 26, 37, 20, 53, 102, 97, 111, 234,
-			249, 110, 64, 143, 120, 127, 143, 55, 243, 53, 129, 63, 77, 79,
-			41, 134, 182, 148, 61, 209, 204, 96, 181, 155, 228, 95, 47, 144,
-			202, 174, 87, 106, 237, 249, 94, 199, 238, 117, 80, 70, 113, 122,
-			45, 123, 30, 183, 122, 127, 222, 236, 133, 123, 243, 22, 115, 216,
-			46, 110, 9, 243, 18, 69, 137, 103, 66, 86, 202, 202, 87, 249,
-			191, 163, 144, 83, 171, 209, 235, 134, 247, 138, 185, 198, 5, 50,
-			30, 216, 187, 46, 243, 119, 108, 107, 90, 165, 74, 97, 188, 150,
-			229, 15, 170, 150, 241, 14, 57, 9, 191, 109, 119, 119, 231, 21,
-			235, 67, 13, 13, 107, 28, 23, 79, 159, 176, 126, 213, 50, 10,
-			100, 162, 251, 170, 21, 44, 238, 4, 123, 230, 210, 237, 229, 157,
-			192, 222, 157, 214, 169, 82, 56, 94, 59, 137, 207, 235, 248, 184,
-			110, 239, 26, 243, 228, 116, 128, 123, 149, 253, 37, 179, 118, 130,
-			94, 51, 4, 24, 166, 211, 88, 217, 136, 95, 213, 197, 155, 199,
-			122, 86, 153, 80, 243, 191, 172, 145, 172, 124, 100, 188, 71, 244,
-			87, 182, 107, 77, 103, 169, 82, 56, 185, 52, 85, 146, 163, 43,
-			201, 26, 165, 39, 182, 107, 213, 176, 146, 113, 133, 28, 147, 189,
-			0, 244, 0, 149, 86, 35, 242, 81, 213, 50, 230, 136, 33, 16,
-			198, 172, 29, 219, 98, 110, 104, 135, 253, 105, 5, 71, 57, 25,
-			189, 169, 138, 23, 80, 93, 144, 147, 231, 199, 213, 199, 120, 245,
-			232, 77, 84, 253, 26, 57, 209, 242, 25, 98, 123, 39, 180, 59,
-			12, 17, 172, 213, 142, 203, 135, 13, 187, 195, 140, 247, 200, 228,
-			190, 233, 216, 150, 29, 246, 119, 172, 158, 143, 47, 16, 207, 233,
-			218, 132, 124, 177, 42, 158, 27, 57, 146, 53, 123, 150, 205, 220,
-			22, 155, 78, 83, 13, 102, 75, 150, 225, 157, 160, 211, 96, 58,
-			195, 223, 201, 178, 97, 16, 61, 52, 119, 131, 233, 113, 124, 142,
-			191, 243, 183, 137, 14, 168, 50, 38, 200, 241, 237, 141, 39, 27,
-			155, 207, 54, 118, 158, 84, 55, 86, 39, 82, 198, 5, 50, 245,
-			160, 82, 174, 85, 106, 59, 171, 149, 245, 202, 163, 50, 176, 219,
-			157, 198, 230, 147, 202, 198, 132, 242, 96, 249, 197, 173, 159, 134,
-			48, 31, 255, 141, 60, 136, 198, 36, 245, 149, 66, 254, 76, 69,
-			209, 152, 164, 140, 165, 191, 169, 12, 72, 185, 139, 183, 105, 99,
-			143, 209, 245, 237, 149, 42, 45, 247, 194, 61, 207, 135, 117, 62,
-			82, 212, 221, 14, 112, 245, 10, 129, 34, 22, 12, 237, 128, 238,
-			122, 251, 204, 119, 153, 69, 123, 174, 37, 228, 156, 114, 215, 108,
-			65, 195, 118, 139, 185, 1, 43, 210, 79, 153, 15, 114, 5, 93,
-			42, 45, 16, 206, 151, 128, 39, 53, 65, 12, 235, 185, 150, 20,
-			187, 214, 171, 43, 149, 141, 122, 133, 182, 109, 135, 149, 200, 210,
-			239, 42, 180, 1, 221, 65, 17, 250, 105, 121, 93, 91, 72, 54,
-			20, 112, 48, 215, 237, 151, 118, 237, 240, 46, 161, 102, 183, 203,
-			220, 93, 219, 101, 243, 45, 175, 211, 245, 92, 230, 134, 65, 242,
-			39, 98, 9, 215, 231, 161, 5, 11, 98, 127, 167, 99, 135, 119,
-			105, 123, 121, 241, 54, 123, 255, 246, 157, 165, 229, 197, 59, 203,
-			119, 22, 219, 236, 78, 219, 92, 190, 117, 231, 206, 251, 31, 124,
-			176, 192, 150, 110, 221, 89, 88, 120, 127, 201, 106, 46, 45, 18,
-			66, 87, 80, 113, 8, 238, 82, 159, 129, 212, 102, 209, 174, 217,
-			122, 133, 44, 215, 163, 51, 114, 10, 102, 162, 109, 228, 120, 106,
-			82, 48, 242, 147, 169, 39, 114, 199, 128, 159, 191, 174, 32, 35,
-			215, 167, 82, 139, 74, 238, 87, 20, 90, 7, 86, 96, 209, 120,
-			105, 210, 104, 153, 129, 68, 6, 200, 144, 204, 221, 14, 232, 15,
-			65, 28, 54, 93, 202, 92, 174, 125, 72, 196, 250, 190, 205, 56,
-			51, 30, 209, 144, 108, 128, 160, 36, 108, 135, 1, 5, 238, 98,
-			134, 61, 159, 65, 31, 27, 236, 117, 72, 171, 171, 119, 233, 114,
-			41, 230, 148, 83, 217, 41, 254, 123, 220, 208, 166, 213, 19, 228,
-			24, 209, 245, 212, 120, 202, 208, 166, 143, 29, 39, 199, 73, 26,
-			10, 202, 64, 73, 229, 165, 191, 170, 66, 77, 53, 101, 104, 87,
-			213, 169, 220, 47, 169, 84, 174, 85, 220, 8, 34, 62, 143, 112,
-			7, 124, 236, 72, 96, 209, 144, 171, 17, 161, 152, 116, 70, 84,
-			191, 251, 161, 217, 237, 206, 217, 214, 253, 25, 10, 210, 182, 187,
-			11, 91, 205, 76, 47, 96, 254, 221, 15, 69, 149, 57, 179, 213,
-			242, 122, 110, 56, 199, 58, 166, 237, 220, 159, 33, 162, 38, 54,
-			233, 210, 166, 23, 238, 209, 150, 25, 8, 44, 153, 221, 174, 239,
-			117, 125, 219, 12, 25, 109, 49, 63, 228, 202, 21, 163, 192, 92,
-			64, 104, 118, 28, 0, 224, 139, 30, 243, 129, 252, 10, 251, 182,
-			73, 235, 245, 245, 89, 2, 74, 9, 54, 208, 237, 53, 29, 187,
-			69, 95, 177, 190, 220, 15, 225, 77, 132, 87, 186, 207, 252, 72,
-			97, 43, 17, 142, 35, 53, 149, 6, 172, 100, 101, 73, 49, 180,
-			171, 227, 134, 44, 105, 134, 118, 245, 236, 57, 242, 79, 57, 254,
-			20, 67, 123, 87, 189, 152, 251, 67, 149, 86, 87, 57, 230, 160,
-			43, 212, 6, 160, 163, 142, 249, 10, 176, 128, 19, 158, 156, 203,
-			198, 30, 243, 153, 196, 95, 167, 231, 132, 54, 108, 218, 102, 43,
-			180, 247, 25, 180, 16, 80, 19, 232, 167, 79, 59, 30, 40, 59,
-			184, 10, 237, 14, 187, 75, 61, 151, 197, 173, 187, 236, 128, 196,
-			237, 6, 69, 164, 27, 168, 209, 100, 208, 171, 239, 133, 192, 179,
-			41, 232, 21, 133, 38, 40, 23, 33, 32, 12, 89, 41, 126, 159,
-			28, 252, 172, 0, 139, 58, 118, 155, 65, 95, 156, 165, 48, 62,
-			225, 212, 118, 45, 219, 103, 173, 208, 233, 83, 139, 117, 153, 107,
-			5, 212, 227, 156, 97, 184, 190, 216, 17, 9, 12, 163, 72, 15,
-			246, 236, 214, 30, 172, 136, 165, 91, 123, 37, 90, 247, 104, 188,
-			200, 121, 203, 1, 96, 97, 38, 164, 14, 140, 220, 241, 220, 93,
-			100, 83, 166, 139, 31, 200, 9, 81, 210, 128, 102, 57, 33, 10,
-			32, 125, 124, 74, 150, 52, 67, 123, 55, 119, 129, 108, 227, 124,
-			168, 134, 54, 167, 94, 202, 173, 225, 80, 34, 212, 220, 165, 91,
-			79, 86, 234, 139, 59, 251, 139, 59, 183, 223, 171, 175, 149, 151,
-			110, 47, 23, 70, 236, 191, 69, 58, 184, 201, 207, 70, 0, 168,
-			105, 104, 119, 76, 150, 20, 67, 155, 203, 78, 203, 146, 102, 104,
-			115, 23, 46, 146, 231, 8, 128, 102, 104, 11, 42, 205, 173, 211,
-			250, 209, 11, 188, 68, 171, 225, 76, 98, 117, 3, 126, 144, 162,
-			145, 221, 14, 139, 16, 17, 16, 90, 26, 218, 150, 64, 104, 138,
-			161, 45, 100, 47, 200, 18, 244, 123, 249, 10, 169, 19, 85, 87,
-			12, 253, 253, 212, 87, 74, 238, 145, 88, 211, 109, 224, 57, 7,
-			123, 17, 238, 177, 4, 244, 133, 219, 10, 44, 249, 208, 131, 247,
-			29, 122, 0, 100, 57, 192, 107, 22, 23, 4, 179, 1, 164, 191,
-			159, 157, 32, 199, 137, 174, 43, 192, 24, 63, 80, 31, 106, 216,
-			185, 130, 140, 232, 131, 177, 99, 228, 63, 212, 72, 6, 138, 192,
-			85, 62, 209, 207, 230, 254, 93, 141, 51, 70, 46, 140, 182, 204,
-			176, 181, 71, 61, 199, 146, 19, 143, 188, 197, 242, 96, 246, 247,
-			204, 125, 70, 103, 64, 82, 153, 161, 109, 155, 57, 22, 237, 179,
-			16, 41, 146, 87, 149, 219, 27, 212, 64, 179, 10, 26, 137, 186,
-			62, 3, 250, 54, 3, 58, 115, 196, 46, 61, 195, 215, 137, 119,
-			80, 228, 44, 1, 54, 30, 51, 180, 155, 182, 99, 135, 253, 18,
-			125, 208, 11, 41, 219, 103, 110, 216, 51, 29, 167, 79, 11, 7,
-			123, 204, 165, 38, 48, 21, 179, 245, 10, 137, 28, 250, 234, 117,
-			45, 88, 71, 179, 69, 160, 239, 62, 145, 108, 167, 229, 117, 0,
-			14, 190, 156, 10, 194, 120, 145, 224, 74, 174, 71, 15, 76, 196,
-			45, 234, 0, 192, 182, 194, 61, 214, 153, 45, 73, 164, 16, 234,
-			50, 102, 113, 187, 1, 151, 252, 1, 57, 92, 122, 8, 168, 217,
-			106, 161, 92, 133, 144, 69, 195, 149, 184, 243, 218, 28, 23, 87,
-			63, 162, 11, 212, 12, 8, 61, 2, 1, 162, 126, 137, 144, 147,
-			100, 140, 207, 141, 2, 147, 51, 17, 151, 85, 67, 251, 228, 244,
-			25, 242, 71, 138, 152, 60, 197, 208, 42, 58, 205, 253, 68, 193,
-			53, 196, 9, 119, 0, 255, 130, 109, 5, 130, 49, 125, 127, 46,
-			22, 165, 231, 112, 190, 230, 62, 93, 164, 107, 141, 198, 22, 221,
-			99, 166, 133, 54, 164, 6, 223, 241, 80, 4, 227, 8, 106, 237,
-			177, 214, 43, 196, 181, 207, 96, 7, 104, 69, 182, 173, 136, 233,
-			112, 126, 6, 181, 9, 210, 42, 208, 50, 114, 127, 33, 95, 6,
-			48, 243, 45, 175, 3, 188, 14, 37, 143, 153, 195, 18, 236, 76,
-			98, 228, 10, 142, 236, 66, 92, 86, 13, 173, 114, 249, 10, 177,
-			128, 164, 129, 102, 159, 168, 19, 185, 103, 244, 25, 144, 37, 14,
-			20, 128, 225, 220, 47, 192, 225, 3, 65, 174, 130, 114, 198, 2,
-			186, 231, 29, 128, 106, 37, 112, 1, 44, 185, 68, 235, 12, 36,
-			175, 14, 112, 236, 0, 201, 13, 164, 74, 202, 220, 94, 71, 172,
-			97, 69, 77, 101, 160, 155, 140, 44, 41, 134, 246, 100, 236, 152,
-			44, 105, 134, 246, 228, 228, 41, 178, 143, 240, 40, 134, 182, 165,
-			78, 231, 236, 120, 17, 251, 177, 148, 39, 25, 138, 25, 68, 196,
-			101, 209, 102, 63, 193, 176, 57, 25, 1, 196, 219, 114, 187, 112,
-			188, 221, 93, 192, 21, 146, 170, 111, 182, 112, 107, 234, 246, 252,
-			174, 23, 176, 32, 130, 16, 120, 237, 150, 224, 50, 10, 226, 108,
-			43, 123, 90, 150, 52, 67, 219, 58, 55, 69, 126, 128, 16, 170,
-			134, 182, 173, 210, 220, 102, 44, 58, 28, 236, 121, 1, 75, 240,
-			21, 59, 136, 120, 142, 5, 160, 148, 35, 177, 160, 45, 44, 125,
-			126, 135, 230, 185, 124, 192, 229, 129, 124, 4, 7, 176, 220, 109,
-			193, 243, 21, 100, 185, 219, 227, 23, 100, 73, 51, 180, 237, 203,
-			87, 200, 127, 173, 32, 32, 154, 161, 253, 64, 165, 185, 191, 175,
-			208, 103, 123, 158, 164, 142, 67, 2, 11, 174, 58, 152, 175, 14,
-			50, 162, 195, 180, 66, 109, 14, 21, 192, 147, 0, 93, 108, 224,
-			182, 79, 189, 3, 151, 208, 168, 54, 104, 199, 9, 25, 200, 178,
-			219, 109, 230, 227, 154, 176, 100, 75, 17, 245, 152, 45, 206, 98,
-			64, 180, 163, 118, 167, 203, 252, 192, 115, 19, 187, 96, 52, 106,
-			224, 241, 63, 136, 70, 13, 60, 254, 7, 209, 168, 129, 199, 255,
-			224, 242, 21, 242, 37, 14, 90, 55, 52, 83, 205, 229, 58, 244,
-			25, 48, 173, 184, 183, 131, 1, 154, 64, 190, 132, 108, 205, 14,
-			5, 207, 10, 184, 4, 128, 27, 254, 70, 175, 211, 228, 116, 21,
-			176, 150, 7, 12, 47, 176, 221, 22, 163, 172, 235, 181, 246, 104,
-			97, 219, 181, 95, 163, 224, 17, 132, 102, 167, 59, 27, 65, 169,
-			167, 161, 115, 73, 35, 186, 98, 104, 102, 246, 172, 44, 105, 134,
-			102, 78, 159, 39, 43, 8, 101, 218, 208, 44, 245, 114, 110, 153,
-			174, 121, 7, 184, 189, 15, 226, 165, 229, 185, 129, 109, 49, 216,
-			249, 4, 31, 181, 93, 9, 74, 220, 93, 26, 91, 145, 221, 165,
-			21, 67, 179, 178, 231, 101, 73, 51, 52, 235, 226, 37, 242, 7,
-			156, 20, 50, 134, 246, 67, 245, 74, 238, 239, 114, 82, 128, 201,
-			233, 130, 166, 228, 134, 67, 196, 80, 49, 65, 44, 9, 89, 39,
-			154, 64, 55, 158, 89, 65, 164, 5, 86, 218, 45, 13, 145, 231,
-			108, 145, 154, 52, 191, 235, 123, 189, 238, 221, 15, 65, 193, 184,
-			159, 151, 18, 108, 17, 40, 2, 237, 183, 166, 67, 243, 239, 230,
-			101, 51, 92, 4, 234, 48, 211, 13, 104, 190, 236, 246, 105, 147,
-			153, 62, 243, 177, 99, 180, 251, 72, 132, 196, 180, 159, 209, 97,
-			24, 81, 41, 109, 104, 63, 60, 54, 41, 75, 138, 161, 253, 208,
-			200, 201, 146, 102, 104, 63, 188, 116, 153, 252, 207, 124, 248, 99,
-			134, 246, 133, 122, 37, 247, 71, 10, 231, 98, 17, 203, 13, 246,
-			188, 158, 99, 193, 158, 194, 186, 195, 168, 88, 23, 150, 248, 168,
-			114, 65, 24, 161, 249, 214, 42, 53, 1, 129, 29, 27, 228, 76,
-			142, 24, 41, 244, 115, 153, 63, 63, 43, 212, 199, 35, 251, 162,
-			79, 205, 62, 53, 157, 192, 67, 11, 186, 9, 51, 125, 8, 91,
-			66, 98, 36, 17, 190, 28, 39, 2, 44, 198, 207, 152, 14, 227,
-			140, 74, 105, 67, 251, 34, 194, 207, 152, 98, 104, 95, 68, 248,
-			25, 211, 12, 237, 139, 75, 151, 201, 255, 165, 34, 126, 178, 134,
-			246, 165, 122, 33, 247, 207, 85, 90, 246, 155, 118, 232, 155, 126,
-			31, 228, 212, 187, 251, 166, 211, 99, 180, 107, 218, 126, 64, 89,
-			167, 201, 44, 11, 101, 177, 208, 75, 144, 107, 19, 249, 27, 219,
-			103, 126, 130, 197, 216, 97, 9, 212, 84, 119, 159, 245, 105, 203,
-			246, 91, 189, 14, 183, 151, 1, 66, 15, 246, 250, 67, 212, 238,
-			51, 201, 9, 235, 18, 217, 136, 178, 8, 87, 178, 114, 199, 236,
-			75, 218, 232, 32, 239, 54, 45, 126, 74, 98, 58, 146, 189, 126,
-			137, 236, 131, 80, 139, 181, 108, 80, 224, 131, 18, 221, 114, 152,
-			25, 32, 255, 162, 236, 117, 232, 179, 14, 115, 250, 160, 116, 178,
-			118, 207, 113, 250, 69, 234, 185, 78, 159, 51, 3, 52, 61, 122,
-			110, 232, 123, 14, 87, 185, 96, 21, 6, 68, 242, 229, 132, 144,
-			238, 216, 238, 43, 220, 49, 128, 92, 119, 123, 166, 111, 186, 33,
-			147, 122, 161, 28, 132, 237, 238, 123, 206, 190, 52, 51, 0, 6,
-			44, 194, 71, 99, 238, 198, 59, 75, 86, 7, 236, 71, 165, 180,
-			161, 125, 25, 205, 90, 86, 49, 180, 47, 141, 115, 178, 164, 25,
-			218, 151, 231, 115, 145, 13, 240, 31, 157, 39, 31, 140, 52, 181,
-			112, 233, 70, 216, 91, 186, 246, 124, 199, 108, 237, 217, 46, 219,
-			225, 4, 199, 205, 126, 199, 18, 149, 242, 255, 80, 33, 19, 79,
-			121, 37, 20, 85, 30, 120, 86, 223, 184, 74, 142, 203, 15, 219,
-			95, 88, 174, 176, 122, 29, 19, 207, 30, 126, 97, 161, 117, 208,
-			14, 130, 30, 179, 118, 154, 125, 105, 29, 228, 15, 30, 244, 19,
-			47, 205, 16, 13, 86, 186, 124, 89, 14, 141, 28, 201, 74, 21,
-			9, 205, 110, 122, 45, 42, 27, 167, 73, 186, 101, 238, 216, 22,
-			26, 254, 180, 154, 222, 50, 171, 150, 49, 69, 198, 64, 213, 221,
-			9, 92, 180, 167, 29, 175, 101, 160, 88, 119, 31, 235, 217, 204,
-			196, 88, 254, 21, 57, 147, 28, 64, 69, 88, 24, 140, 75, 132,
-			112, 243, 94, 211, 179, 184, 225, 238, 120, 109, 60, 140, 198, 120,
-			150, 100, 132, 229, 146, 67, 159, 126, 133, 38, 203, 75, 132, 248,
-			129, 41, 180, 13, 132, 253, 120, 109, 220, 15, 76, 110, 170, 124,
-			247, 195, 65, 108, 53, 250, 93, 150, 180, 146, 53, 158, 111, 85,
-			38, 82, 198, 57, 98, 172, 111, 175, 84, 119, 158, 150, 87, 214,
-			170, 27, 21, 97, 32, 83, 31, 44, 189, 88, 248, 38, 179, 118,
-			47, 81, 126, 252, 71, 103, 73, 198, 208, 245, 212, 99, 133, 252,
-			29, 5, 141, 99, 250, 8, 227, 216, 242, 207, 177, 113, 76, 88,
-			150, 50, 169, 51, 194, 178, 148, 77, 61, 146, 150, 37, 248, 249,
-			239, 40, 68, 77, 167, 12, 125, 34, 117, 90, 201, 125, 141, 3,
-			1, 105, 19, 101, 95, 65, 114, 177, 134, 52, 40, 206, 113, 241,
-			197, 118, 81, 53, 218, 50, 3, 113, 232, 248, 212, 118, 195, 228,
-			52, 225, 122, 173, 186, 192, 93, 7, 158, 151, 240, 148, 155, 163,
-			230, 46, 93, 228, 154, 93, 26, 132, 208, 137, 244, 89, 114, 147,
-			232, 105, 84, 221, 38, 213, 243, 249, 27, 220, 202, 32, 228, 24,
-			174, 146, 217, 1, 117, 61, 16, 249, 237, 80, 104, 182, 184, 150,
-			211, 92, 167, 152, 84, 79, 202, 146, 106, 104, 147, 83, 211, 100,
-			25, 27, 84, 12, 205, 80, 207, 231, 103, 185, 216, 197, 130, 164,
-			229, 107, 20, 33, 203, 54, 21, 252, 240, 140, 44, 169, 134, 102,
-			76, 77, 147, 255, 149, 159, 175, 232, 23, 83, 31, 43, 185, 127,
-			172, 210, 225, 181, 76, 45, 22, 180, 124, 187, 137, 76, 41, 100,
-			62, 48, 204, 32, 244, 123, 45, 212, 179, 5, 123, 27, 192, 178,
-			180, 123, 8, 233, 73, 40, 111, 221, 30, 234, 54, 9, 45, 38,
-			136, 172, 114, 176, 192, 196, 46, 7, 186, 107, 19, 190, 245, 168,
-			99, 250, 187, 172, 68, 240, 212, 4, 41, 197, 103, 102, 224, 185,
-			244, 128, 51, 228, 222, 128, 40, 21, 224, 193, 10, 51, 81, 199,
-			24, 62, 22, 105, 200, 90, 132, 22, 92, 15, 149, 68, 110, 76,
-			178, 91, 190, 199, 133, 35, 144, 106, 56, 219, 159, 229, 90, 146,
-			25, 4, 189, 206, 144, 141, 12, 241, 76, 93, 20, 241, 2, 130,
-			170, 108, 208, 49, 29, 199, 14, 246, 104, 207, 118, 195, 229, 91,
-			136, 163, 93, 24, 91, 1, 102, 214, 55, 93, 203, 235, 208, 166,
-			227, 53, 81, 250, 146, 70, 198, 139, 217, 233, 200, 200, 120, 41,
-			105, 100, 188, 52, 96, 100, 188, 52, 96, 100, 132, 210, 175, 41,
-			210, 200, 120, 77, 205, 229, 126, 89, 145, 211, 21, 139, 90, 177,
-			120, 0, 251, 209, 62, 235, 7, 180, 32, 167, 231, 225, 247, 86,
-			55, 102, 133, 169, 209, 14, 112, 75, 51, 91, 161, 52, 51, 155,
-			104, 29, 246, 92, 186, 33, 14, 238, 205, 129, 209, 35, 249, 154,
-			1, 53, 105, 211, 12, 108, 84, 199, 72, 188, 158, 6, 76, 125,
-			215, 6, 76, 125, 215, 198, 207, 38, 76, 125, 215, 166, 207, 147,
-			255, 88, 145, 166, 190, 89, 117, 42, 247, 171, 138, 220, 188, 169,
-			48, 101, 82, 148, 13, 223, 96, 42, 69, 9, 29, 141, 109, 125,
-			123, 64, 2, 142, 204, 12, 9, 149, 56, 242, 14, 193, 29, 4,
-			21, 17, 219, 37, 52, 15, 219, 254, 28, 126, 54, 39, 76, 4,
-			121, 138, 114, 232, 128, 149, 108, 118, 192, 74, 54, 27, 153, 45,
-			65, 115, 155, 61, 123, 142, 108, 72, 43, 89, 81, 157, 202, 149,
-			233, 160, 128, 79, 99, 225, 155, 139, 9, 137, 217, 1, 181, 130,
-			239, 105, 37, 90, 99, 95, 244, 108, 31, 5, 153, 216, 60, 86,
-			140, 122, 6, 76, 21, 163, 158, 65, 59, 43, 158, 61, 71, 124,
-			105, 30, 91, 84, 167, 114, 108, 132, 230, 241, 70, 197, 128, 43,
-			178, 220, 76, 115, 115, 121, 97, 1, 148, 237, 112, 164, 198, 123,
-			24, 58, 208, 169, 22, 35, 232, 64, 167, 90, 28, 159, 76, 216,
-			205, 22, 207, 158, 147, 148, 170, 27, 218, 178, 106, 0, 165, 86,
-			45, 78, 83, 43, 101, 62, 35, 124, 236, 17, 235, 56, 68, 106,
-			161, 71, 59, 230, 43, 118, 72, 231, 100, 1, 163, 213, 85, 110,
-			72, 194, 131, 93, 110, 228, 27, 228, 234, 158, 219, 182, 119, 185,
-			249, 186, 231, 218, 95, 244, 216, 142, 109, 113, 166, 27, 155, 32,
-			65, 231, 90, 142, 172, 127, 160, 115, 45, 103, 79, 200, 146, 102,
-			104, 203, 19, 147, 228, 143, 185, 81, 58, 109, 104, 247, 213, 51,
-			185, 255, 86, 21, 70, 72, 193, 3, 134, 217, 223, 55, 28, 3,
-			74, 40, 92, 4, 228, 98, 9, 13, 189, 93, 134, 126, 51, 28,
-			88, 167, 47, 214, 115, 155, 79, 71, 162, 221, 98, 36, 58, 54,
-			25, 137, 58, 225, 132, 142, 246, 184, 4, 8, 62, 219, 247, 184,
-			45, 154, 22, 154, 125, 106, 6, 220, 116, 158, 68, 212, 193, 30,
-			246, 203, 23, 242, 174, 189, 15, 92, 35, 209, 2, 46, 21, 186,
-			82, 91, 159, 5, 42, 136, 90, 195, 238, 248, 12, 120, 93, 46,
-			68, 23, 105, 199, 11, 66, 24, 155, 227, 0, 251, 227, 199, 240,
-			78, 159, 122, 46, 101, 175, 187, 182, 63, 240, 37, 200, 205, 209,
-			60, 164, 17, 187, 114, 30, 64, 25, 189, 159, 61, 37, 75, 154,
-			161, 221, 55, 78, 147, 255, 93, 225, 102, 216, 135, 169, 199, 74,
-			238, 79, 149, 145, 251, 28, 64, 123, 192, 41, 43, 50, 15, 8,
-			195, 125, 98, 135, 4, 244, 249, 76, 232, 176, 204, 34, 156, 165,
-			13, 108, 96, 180, 96, 182, 67, 230, 139, 111, 153, 11, 226, 141,
-			69, 123, 1, 148, 154, 102, 192, 150, 111, 81, 148, 200, 77, 223,
-			162, 190, 121, 192, 107, 216, 238, 238, 172, 16, 7, 122, 78, 24,
-			35, 186, 96, 187, 45, 167, 103, 37, 190, 141, 170, 3, 160, 61,
-			177, 4, 255, 213, 219, 11, 11, 180, 217, 15, 89, 128, 74, 125,
-			194, 70, 252, 48, 123, 145, 188, 43, 13, 106, 143, 212, 169, 252,
-			165, 163, 54, 124, 216, 174, 35, 179, 88, 26, 42, 143, 37, 204,
-			98, 143, 178, 70, 194, 44, 246, 232, 236, 57, 242, 137, 52, 139,
-			173, 169, 103, 242, 55, 169, 45, 22, 232, 0, 129, 116, 125, 123,
-			31, 40, 97, 224, 16, 70, 158, 72, 36, 12, 92, 107, 145, 137,
-			5, 96, 94, 27, 63, 149, 48, 112, 173, 25, 167, 73, 65, 26,
-			184, 170, 234, 84, 254, 66, 194, 82, 239, 181, 233, 76, 44, 102,
-			207, 36, 141, 85, 213, 8, 126, 0, 178, 26, 193, 15, 60, 175,
-			122, 246, 92, 164, 204, 252, 131, 85, 114, 231, 27, 41, 51, 166,
-			213, 177, 221, 249, 253, 69, 254, 67, 104, 51, 147, 137, 90, 37,
-			124, 145, 123, 147, 131, 104, 238, 187, 241, 157, 200, 253, 212, 234,
-			87, 126, 142, 156, 170, 118, 186, 158, 31, 50, 107, 5, 249, 92,
-			0, 42, 145, 207, 246, 81, 124, 17, 186, 86, 84, 206, 119, 73,
-			110, 132, 228, 90, 227, 10, 183, 241, 161, 84, 114, 194, 126, 151,
-			225, 183, 39, 151, 46, 149, 146, 40, 25, 86, 87, 132, 14, 132,
-			154, 203, 25, 146, 230, 126, 22, 66, 5, 226, 86, 151, 63, 81,
-			201, 133, 145, 93, 114, 239, 24, 248, 10, 183, 32, 236, 46, 91,
-			227, 5, 227, 61, 50, 41, 108, 255, 118, 216, 223, 225, 178, 158,
-			104, 119, 34, 126, 81, 195, 231, 198, 57, 146, 225, 130, 1, 106,
-			88, 217, 154, 40, 25, 87, 200, 49, 215, 115, 119, 144, 227, 48,
-			238, 149, 145, 173, 17, 215, 115, 43, 252, 137, 172, 0, 172, 241,
-			21, 227, 106, 34, 175, 80, 227, 79, 70, 56, 166, 100, 70, 56,
-			166, 80, 114, 28, 121, 119, 203, 220, 113, 205, 14, 19, 126, 26,
-			4, 158, 173, 152, 32, 63, 25, 79, 137, 1, 51, 186, 51, 48,
-			131, 211, 103, 168, 82, 56, 246, 6, 4, 195, 18, 94, 75, 213,
-			38, 224, 211, 129, 231, 199, 147, 243, 148, 191, 77, 46, 9, 4,
-			15, 57, 221, 200, 105, 141, 38, 70, 73, 78, 204, 95, 82, 201,
-			229, 163, 190, 251, 57, 152, 155, 219, 36, 43, 143, 247, 113, 98,
-			142, 45, 157, 143, 125, 112, 134, 1, 142, 170, 26, 37, 146, 141,
-			252, 125, 50, 248, 153, 113, 216, 117, 167, 22, 213, 89, 250, 19,
-			157, 164, 203, 176, 206, 141, 167, 114, 37, 173, 148, 229, 74, 58,
-			55, 236, 223, 205, 125, 170, 114, 249, 210, 33, 54, 81, 26, 94,
-			133, 219, 100, 138, 63, 138, 97, 253, 46, 154, 125, 65, 46, 242,
-			71, 91, 190, 247, 67, 214, 10, 229, 225, 192, 119, 209, 246, 14,
-			185, 58, 208, 246, 230, 129, 203, 172, 50, 151, 194, 131, 239, 162,
-			131, 144, 156, 30, 193, 10, 140, 185, 81, 159, 30, 201, 165, 114,
-			165, 111, 90, 93, 80, 241, 215, 228, 220, 104, 58, 55, 22, 142,
-			110, 105, 244, 82, 202, 45, 126, 139, 47, 120, 247, 15, 110, 191,
-			184, 249, 173, 246, 163, 123, 248, 227, 241, 191, 88, 38, 99, 70,
-			90, 79, 253, 68, 249, 255, 190, 169, 38, 23, 153, 106, 30, 199,
-			166, 26, 252, 169, 165, 12, 141, 164, 174, 227, 79, 197, 208, 142,
-			167, 54, 241, 167, 106, 104, 39, 82, 15, 72, 157, 168, 153, 148,
-			161, 79, 166, 62, 86, 114, 143, 40, 46, 211, 216, 96, 30, 112,
-			17, 164, 217, 143, 30, 33, 226, 236, 32, 244, 77, 244, 29, 71,
-			121, 219, 229, 254, 160, 44, 62, 177, 35, 68, 203, 160, 69, 37,
-			123, 130, 60, 34, 122, 6, 21, 232, 211, 234, 163, 220, 93, 58,
-			180, 254, 81, 92, 15, 18, 95, 83, 159, 153, 150, 144, 80, 68,
-			123, 173, 246, 238, 12, 23, 94, 51, 92, 193, 61, 157, 57, 43,
-			75, 170, 161, 157, 62, 247, 158, 44, 105, 134, 118, 122, 185, 2,
-			42, 98, 6, 181, 221, 115, 234, 102, 174, 76, 143, 224, 17, 71,
-			117, 157, 144, 28, 6, 122, 6, 57, 235, 92, 230, 146, 44, 169,
-			134, 118, 238, 242, 77, 89, 210, 12, 237, 220, 253, 167, 228, 123,
-			216, 179, 106, 104, 231, 213, 70, 110, 149, 190, 137, 141, 28, 213,
-			125, 151, 215, 14, 6, 59, 135, 209, 156, 207, 228, 101, 9, 122,
-			184, 246, 129, 44, 105, 134, 118, 126, 165, 70, 44, 236, 92, 51,
-			180, 139, 234, 243, 220, 51, 250, 86, 62, 243, 22, 8, 118, 60,
-			248, 102, 71, 152, 8, 134, 224, 1, 74, 186, 152, 153, 145, 37,
-			213, 208, 46, 22, 62, 146, 37, 0, 97, 237, 25, 249, 167, 58,
-			2, 164, 27, 218, 156, 250, 131, 220, 31, 234, 163, 12, 122, 212,
-			98, 176, 66, 14, 171, 4, 160, 54, 112, 239, 30, 22, 80, 27,
-			117, 140, 72, 141, 174, 134, 241, 171, 193, 99, 67, 97, 188, 0,
-			229, 107, 64, 146, 30, 240, 4, 18, 131, 69, 19, 191, 208, 143,
-			240, 197, 76, 144, 212, 156, 208, 35, 136, 43, 47, 145, 30, 22,
-			132, 102, 216, 11, 4, 8, 33, 247, 132, 243, 80, 141, 3, 173,
-			166, 211, 107, 237, 81, 219, 229, 17, 63, 24, 219, 209, 244, 122,
-			201, 51, 145, 200, 41, 14, 155, 65, 167, 135, 174, 23, 4, 118,
-			211, 97, 226, 168, 206, 14, 37, 68, 32, 246, 31, 234, 25, 93,
-			61, 208, 164, 25, 29, 203, 58, 48, 103, 125, 66, 197, 46, 143,
-			42, 81, 121, 112, 145, 198, 199, 116, 120, 124, 236, 56, 0, 180,
-			197, 154, 189, 93, 110, 19, 8, 68, 144, 137, 244, 179, 160, 53,
-			238, 238, 124, 151, 80, 58, 106, 206, 36, 219, 229, 46, 106, 145,
-			55, 71, 208, 235, 242, 173, 8, 141, 193, 24, 161, 178, 235, 119,
-			91, 165, 42, 151, 87, 202, 254, 46, 134, 189, 80, 230, 251, 158,
-			143, 223, 246, 220, 248, 27, 62, 162, 225, 47, 133, 253, 51, 254,
-			36, 244, 77, 55, 176, 163, 102, 130, 136, 32, 117, 197, 208, 230,
-			50, 231, 101, 73, 53, 180, 185, 220, 45, 89, 210, 12, 109, 238,
-			227, 23, 228, 79, 57, 65, 162, 250, 203, 114, 255, 99, 68, 144,
-			67, 219, 74, 130, 38, 135, 125, 189, 126, 62, 200, 242, 207, 145,
-			0, 205, 48, 4, 221, 43, 224, 36, 130, 155, 23, 124, 142, 6,
-			227, 136, 248, 98, 31, 57, 28, 197, 158, 25, 208, 38, 99, 46,
-			137, 252, 245, 4, 85, 254, 121, 17, 227, 17, 98, 192, 207, 21,
-			61, 162, 89, 37, 115, 81, 150, 84, 67, 187, 127, 233, 174, 44,
-			105, 134, 118, 191, 210, 34, 207, 249, 73, 192, 74, 170, 170, 228,
-			158, 210, 33, 73, 14, 72, 139, 199, 29, 112, 202, 225, 175, 63,
-			12, 188, 14, 11, 247, 108, 119, 247, 126, 196, 197, 49, 216, 1,
-			189, 25, 131, 94, 171, 197, 130, 32, 97, 248, 94, 201, 78, 145,
-			223, 141, 108, 216, 107, 234, 185, 220, 111, 115, 175, 40, 169, 174,
-			74, 26, 108, 137, 214, 248, 105, 174, 207, 168, 235, 29, 68, 17,
-			107, 102, 104, 162, 119, 31, 39, 60, 32, 200, 56, 234, 204, 22,
-			96, 71, 45, 22, 129, 70, 100, 115, 60, 10, 18, 29, 11, 128,
-			244, 217, 190, 237, 245, 2, 167, 79, 49, 40, 147, 140, 254, 198,
-			12, 233, 90, 165, 188, 202, 205, 253, 38, 146, 123, 108, 234, 94,
-			27, 48, 117, 175, 69, 102, 80, 216, 251, 215, 206, 156, 37, 95,
-			113, 187, 213, 102, 234, 153, 146, 243, 70, 115, 47, 20, 51, 1,
-			189, 72, 213, 94, 123, 228, 190, 84, 219, 90, 65, 242, 148, 142,
-			194, 252, 188, 63, 24, 218, 165, 48, 144, 177, 195, 4, 157, 68,
-			38, 164, 205, 108, 158, 124, 37, 77, 72, 117, 181, 148, 115, 249,
-			1, 141, 136, 82, 17, 166, 115, 180, 118, 225, 74, 231, 39, 234,
-			43, 61, 223, 103, 110, 136, 54, 60, 167, 79, 15, 31, 72, 162,
-			151, 165, 164, 208, 200, 9, 143, 59, 36, 240, 67, 239, 182, 217,
-			115, 194, 1, 87, 173, 186, 122, 57, 97, 147, 170, 95, 153, 77,
-			216, 164, 234, 197, 57, 225, 228, 162, 24, 218, 167, 234, 233, 220,
-			114, 226, 28, 9, 144, 83, 162, 149, 215, 102, 43, 68, 87, 6,
-			12, 231, 73, 56, 206, 70, 202, 241, 128, 223, 213, 167, 3, 102,
-			169, 79, 199, 79, 38, 204, 82, 159, 78, 26, 228, 51, 2, 188,
-			57, 253, 89, 234, 71, 138, 146, 219, 120, 227, 238, 18, 209, 63,
-			229, 190, 13, 111, 159, 40, 64, 63, 12, 229, 179, 236, 53, 242,
-			95, 0, 209, 171, 128, 255, 151, 234, 100, 238, 111, 41, 180, 225,
-			247, 216, 33, 175, 166, 136, 119, 151, 135, 158, 65, 205, 1, 255,
-			117, 110, 210, 247, 125, 214, 10, 139, 192, 248, 247, 204, 192, 157,
-			9, 229, 182, 75, 251, 44, 164, 145, 207, 64, 203, 103, 40, 235,
-			153, 14, 238, 17, 176, 3, 52, 123, 182, 19, 242, 99, 31, 193,
-			104, 147, 118, 223, 89, 168, 3, 205, 9, 51, 137, 64, 169, 138,
-			20, 255, 82, 56, 219, 169, 56, 131, 47, 199, 142, 203, 146, 102,
-			104, 47, 79, 77, 144, 31, 241, 145, 42, 134, 214, 86, 175, 228,
-			254, 77, 133, 174, 245, 58, 104, 12, 54, 45, 19, 24, 123, 208,
-			235, 116, 76, 191, 31, 249, 115, 200, 113, 10, 59, 2, 250, 114,
-			96, 21, 251, 75, 38, 195, 172, 184, 63, 77, 116, 112, 26, 187,
-			175, 242, 147, 72, 126, 118, 129, 84, 106, 183, 233, 12, 182, 51,
-			131, 254, 160, 109, 211, 9, 88, 4, 62, 80, 68, 91, 80, 132,
-			138, 20, 209, 30, 207, 201, 146, 102, 104, 237, 75, 151, 201, 255,
-			169, 35, 248, 170, 161, 237, 171, 70, 238, 159, 233, 35, 38, 42,
-			158, 4, 64, 165, 216, 99, 229, 150, 203, 221, 108, 142, 242, 30,
-			27, 113, 190, 50, 120, 10, 202, 143, 132, 9, 53, 233, 174, 233,
-			55, 209, 181, 121, 195, 11, 133, 123, 136, 180, 205, 10, 61, 76,
-			204, 191, 211, 23, 27, 122, 145, 54, 241, 144, 85, 58, 126, 1,
-			201, 16, 220, 14, 37, 89, 120, 62, 194, 35, 166, 149, 31, 5,
-			182, 233, 12, 255, 28, 48, 198, 17, 86, 76, 162, 186, 201, 28,
-			239, 0, 253, 103, 10, 232, 127, 223, 7, 0, 103, 185, 139, 158,
-			132, 113, 224, 196, 87, 12, 171, 23, 176, 32, 41, 93, 4, 66,
-			53, 67, 36, 136, 152, 224, 21, 199, 235, 89, 116, 203, 49, 67,
-			16, 18, 164, 59, 48, 48, 218, 150, 135, 113, 114, 97, 100, 194,
-			143, 246, 114, 215, 162, 121, 207, 177, 242, 9, 63, 253, 97, 223,
-			97, 208, 109, 81, 50, 33, 194, 3, 25, 49, 5, 235, 216, 18,
-			66, 2, 63, 188, 72, 250, 243, 123, 109, 202, 94, 219, 65, 200,
-			220, 22, 123, 3, 98, 164, 127, 89, 146, 38, 163, 176, 63, 164,
-			64, 51, 160, 15, 159, 87, 139, 82, 146, 233, 147, 24, 44, 159,
-			203, 44, 29, 211, 177, 91, 98, 227, 193, 113, 2, 13, 39, 86,
-			25, 72, 132, 251, 209, 42, 131, 149, 180, 63, 118, 66, 150, 52,
-			67, 219, 159, 152, 148, 171, 76, 51, 180, 175, 212, 41, 88, 101,
-			223, 148, 76, 185, 179, 42, 86, 56, 204, 51, 240, 108, 149, 31,
-			175, 231, 93, 207, 221, 201, 195, 30, 217, 182, 95, 71, 7, 89,
-			130, 165, 211, 25, 68, 199, 140, 96, 133, 129, 217, 70, 61, 155,
-			131, 168, 165, 1, 40, 9, 62, 104, 102, 95, 141, 25, 178, 4,
-			0, 159, 61, 71, 254, 5, 7, 95, 55, 244, 191, 164, 168, 83,
-			185, 63, 249, 182, 240, 39, 151, 215, 32, 163, 18, 103, 219, 145,
-			28, 137, 190, 94, 110, 52, 72, 254, 85, 232, 113, 127, 114, 55,
-			250, 16, 232, 183, 47, 28, 225, 249, 182, 194, 220, 144, 208, 174,
-			239, 117, 129, 47, 162, 59, 239, 207, 130, 154, 19, 56, 124, 61,
-			141, 3, 206, 200, 162, 2, 197, 49, 67, 22, 53, 40, 158, 61,
-			71, 158, 32, 114, 210, 134, 254, 111, 41, 234, 197, 220, 71, 209,
-			209, 233, 161, 195, 152, 208, 67, 44, 37, 206, 23, 81, 114, 49,
-			187, 24, 230, 218, 68, 139, 12, 111, 59, 205, 91, 203, 202, 162,
-			2, 197, 241, 41, 89, 212, 160, 152, 187, 64, 254, 109, 62, 47,
-			25, 67, 255, 101, 69, 205, 229, 190, 76, 248, 2, 12, 157, 219,
-			202, 115, 201, 193, 13, 172, 105, 2, 88, 222, 8, 48, 104, 141,
-			5, 220, 165, 141, 123, 150, 227, 33, 168, 140, 77, 24, 80, 60,
-			112, 167, 151, 112, 103, 210, 8, 138, 132, 59, 163, 64, 113, 252,
-			172, 44, 106, 80, 156, 62, 79, 126, 5, 225, 206, 166, 140, 204,
-			175, 40, 234, 175, 42, 90, 238, 95, 67, 134, 196, 85, 6, 43,
-			209, 48, 45, 140, 20, 26, 164, 3, 34, 237, 154, 190, 217, 97,
-			33, 243, 103, 75, 20, 173, 156, 212, 110, 147, 4, 43, 239, 152,
-			14, 176, 42, 65, 135, 173, 132, 203, 138, 197, 226, 99, 57, 9,
-			126, 54, 165, 24, 250, 175, 40, 120, 114, 5, 120, 29, 51, 244,
-			191, 162, 232, 119, 4, 244, 99, 25, 44, 82, 89, 84, 160, 120,
-			245, 166, 44, 106, 80, 92, 254, 0, 197, 114, 205, 200, 252, 53,
-			37, 245, 107, 138, 146, 123, 114, 164, 214, 113, 148, 28, 57, 172,
-			78, 38, 36, 148, 99, 68, 211, 53, 197, 208, 255, 154, 146, 189,
-			78, 174, 16, 93, 215, 212, 148, 161, 255, 7, 138, 122, 58, 55,
-			57, 44, 123, 241, 33, 105, 32, 3, 64, 141, 172, 44, 42, 80,
-			28, 63, 41, 139, 26, 20, 39, 13, 242, 57, 81, 117, 221, 200,
-			252, 134, 146, 250, 137, 162, 228, 54, 223, 166, 43, 29, 45, 93,
-			141, 0, 95, 64, 14, 203, 231, 55, 148, 236, 13, 210, 35, 186,
-			174, 3, 228, 191, 169, 168, 147, 185, 221, 239, 80, 182, 18, 155,
-			243, 104, 86, 9, 35, 214, 17, 31, 191, 41, 215, 180, 142, 248,
-			248, 77, 101, 236, 184, 44, 106, 80, 60, 53, 65, 254, 170, 130,
-			64, 42, 134, 254, 55, 148, 159, 31, 185, 136, 3, 169, 164, 17,
-			170, 172, 44, 34, 144, 227, 57, 89, 212, 160, 120, 233, 50, 249,
-			103, 58, 14, 65, 53, 244, 223, 81, 84, 35, 247, 143, 255, 66,
-			201, 70, 191, 16, 134, 126, 222, 132, 33, 78, 125, 176, 13, 254,
-			78, 188, 188, 96, 1, 253, 142, 50, 118, 66, 22, 53, 40, 78,
-			76, 202, 229, 165, 25, 250, 143, 149, 159, 31, 129, 136, 3, 169,
-			165, 17, 42, 57, 4, 96, 184, 63, 150, 187, 190, 14, 34, 145,
-			254, 99, 216, 245, 67, 28, 129, 110, 232, 191, 167, 168, 133, 92,
-			91, 108, 96, 9, 95, 15, 14, 164, 60, 247, 4, 208, 158, 242,
-			92, 57, 76, 108, 84, 63, 237, 62, 165, 171, 122, 6, 187, 149,
-			75, 30, 56, 235, 239, 41, 23, 174, 201, 162, 6, 197, 27, 51,
-			220, 113, 79, 135, 41, 249, 175, 20, 245, 106, 238, 175, 40, 71,
-			1, 41, 183, 218, 196, 139, 153, 8, 240, 17, 129, 162, 51, 179,
-			223, 233, 112, 210, 25, 132, 240, 180, 44, 42, 80, 60, 115, 81,
-			22, 53, 40, 94, 161, 145, 3, 201, 79, 22, 201, 149, 97, 151,
-			143, 200, 135, 239, 168, 188, 96, 247, 200, 120, 228, 87, 106, 76,
-			147, 49, 225, 117, 135, 7, 231, 90, 77, 22, 141, 51, 36, 237,
-			154, 174, 23, 224, 113, 121, 186, 198, 11, 15, 126, 73, 25, 157,
-			76, 236, 100, 212, 164, 76, 40, 182, 244, 13, 19, 138, 69, 240,
-			126, 171, 164, 98, 255, 229, 60, 25, 51, 210, 151, 83, 63, 82,
-			148, 95, 100, 21, 251, 69, 86, 177, 95, 100, 21, 251, 69, 86,
-			177, 95, 100, 21, 251, 69, 86, 177, 127, 137, 89, 197, 162, 100,
-			95, 240, 83, 102, 21, 171, 74, 159, 17, 248, 41, 179, 138, 69,
-			169, 198, 174, 71, 169, 198, 110, 164, 230, 101, 170, 49, 248, 41,
-			179, 138, 69, 169, 198, 102, 162, 84, 99, 133, 56, 213, 24, 252,
-			252, 39, 151, 240, 172, 43, 243, 151, 21, 180, 252, 255, 247, 151,
-			104, 153, 70, 91, 111, 236, 31, 28, 80, 147, 118, 61, 59, 206,
-			23, 146, 180, 14, 241, 4, 95, 125, 254, 252, 75, 207, 69, 110,
-			226, 120, 45, 211, 65, 55, 104, 230, 90, 166, 95, 140, 220, 135,
-			209, 205, 152, 71, 55, 36, 92, 244, 129, 151, 182, 125, 51, 142,
-			213, 143, 94, 192, 134, 0, 162, 2, 143, 78, 241, 89, 224, 57,
-			156, 41, 162, 200, 197, 27, 66, 165, 217, 49, 49, 199, 73, 232,
-			161, 153, 11, 195, 140, 205, 144, 110, 55, 86, 104, 199, 182, 92,
-			228, 232, 158, 75, 232, 99, 211, 237, 193, 54, 176, 88, 164, 139,
-			119, 222, 95, 40, 74, 70, 221, 245, 61, 135, 117, 67, 187, 69,
-			31, 249, 108, 215, 243, 109, 211, 141, 160, 23, 145, 182, 236, 117,
-			200, 68, 60, 1, 25, 85, 171, 105, 182, 94, 29, 152, 190, 133,
-			167, 180, 125, 102, 250, 212, 115, 81, 60, 133, 45, 191, 99, 187,
-			189, 144, 113, 21, 99, 121, 33, 26, 31, 58, 57, 211, 117, 102,
-			118, 227, 33, 251, 140, 230, 131, 14, 51, 125, 6, 10, 141, 199,
-			55, 96, 215, 163, 14, 51, 187, 68, 84, 163, 33, 42, 203, 160,
-			10, 242, 84, 15, 109, 204, 169, 38, 146, 57, 136, 13, 157, 187,
-			106, 155, 244, 179, 165, 91, 115, 123, 94, 207, 167, 142, 237, 50,
-			211, 39, 20, 91, 255, 188, 240, 102, 161, 3, 230, 115, 30, 107,
-			70, 41, 92, 124, 148, 114, 64, 241, 241, 189, 14, 93, 88, 88,
-			88, 156, 195, 255, 26, 11, 11, 119, 241, 191, 23, 48, 244, 59,
-			119, 238, 220, 153, 91, 92, 154, 187, 185, 216, 88, 186, 121, 247,
-			246, 157, 187, 183, 239, 148, 238, 200, 191, 23, 37, 250, 160, 79,
-			226, 220, 12, 232, 32, 46, 226, 154, 248, 153, 228, 1, 163, 204,
-			13, 64, 61, 193, 167, 7, 60, 157, 13, 6, 241, 248, 33, 159,
-			95, 97, 60, 251, 172, 246, 112, 133, 208, 155, 55, 111, 222, 137,
-			199, 114, 112, 112, 80, 178, 89, 216, 70, 9, 209, 111, 183, 224,
-			127, 168, 81, 10, 95, 135, 179, 32, 177, 49, 17, 16, 140, 150,
-			204, 107, 180, 194, 243, 218, 5, 132, 200, 159, 116, 241, 46, 93,
-			241, 58, 221, 94, 200, 18, 107, 1, 59, 220, 218, 172, 87, 191,
-			79, 95, 2, 102, 10, 179, 47, 75, 66, 228, 137, 43, 69, 194,
-			167, 72, 61, 23, 11, 207, 1, 11, 119, 196, 4, 23, 240, 243,
-			141, 237, 245, 245, 217, 217, 145, 245, 144, 222, 11, 11, 179, 247,
-			18, 48, 45, 189, 13, 166, 93, 22, 66, 43, 94, 219, 50, 251,
-			9, 216, 184, 30, 137, 29, 236, 155, 14, 13, 247, 69, 143, 3,
-			213, 111, 132, 251, 69, 138, 0, 221, 251, 105, 135, 180, 95, 10,
-			247, 161, 244, 166, 17, 241, 74, 189, 128, 181, 232, 187, 116, 113,
-			97, 97, 112, 132, 55, 143, 28, 225, 51, 219, 189, 185, 68, 95,
-			62, 98, 97, 189, 31, 132, 172, 3, 175, 203, 193, 67, 219, 97,
-			141, 193, 137, 120, 88, 93, 175, 52, 170, 79, 43, 180, 29, 10,
-			48, 142, 250, 230, 70, 59, 148, 144, 110, 87, 55, 26, 203, 183,
-			104, 104, 183, 94, 5, 244, 35, 90, 40, 20, 248, 147, 217, 118,
-			88, 178, 14, 214, 236, 221, 189, 85, 51, 196, 175, 102, 233, 135,
-			31, 210, 155, 75, 179, 244, 95, 161, 248, 110, 221, 59, 144, 175,
-			36, 222, 230, 231, 105, 25, 224, 181, 188, 131, 0, 155, 132, 197,
-			178, 184, 176, 144, 224, 97, 65, 41, 170, 192, 185, 212, 226, 242,
-			225, 101, 20, 181, 6, 159, 47, 46, 223, 186, 117, 235, 253, 155,
-			203, 11, 49, 219, 104, 178, 182, 231, 51, 30, 101, 37, 90, 185,
-			243, 254, 194, 112, 43, 165, 159, 110, 50, 11, 124, 252, 180, 80,
-			224, 72, 153, 199, 201, 130, 191, 89, 58, 151, 4, 231, 45, 20,
-			12, 237, 0, 186, 100, 59, 215, 19, 237, 32, 1, 204, 14, 16,
-			192, 173, 35, 9, 224, 177, 185, 111, 210, 151, 124, 34, 75, 45,
-			126, 28, 15, 85, 158, 218, 142, 99, 7, 9, 2, 192, 60, 16,
-			29, 124, 74, 63, 162, 71, 127, 240, 6, 50, 167, 31, 197, 79,
-			75, 46, 59, 120, 208, 179, 29, 139, 249, 133, 89, 24, 88, 93,
-			96, 72, 116, 193, 17, 51, 43, 179, 85, 82, 10, 117, 54, 248,
-			216, 109, 55, 132, 145, 139, 154, 124, 232, 98, 216, 136, 129, 217,
-			82, 19, 90, 70, 88, 98, 28, 220, 126, 11, 14, 170, 220, 0,
-			86, 114, 189, 131, 196, 176, 197, 83, 244, 13, 249, 136, 14, 212,
-			121, 227, 72, 99, 192, 223, 62, 100, 215, 59, 40, 237, 178, 176,
-			2, 196, 198, 159, 21, 102, 19, 35, 31, 28, 189, 168, 12, 133,
-			194, 17, 35, 93, 62, 114, 164, 98, 190, 164, 156, 65, 183, 250,
-			225, 30, 87, 36, 6, 8, 45, 57, 81, 133, 217, 97, 42, 124,
-			196, 194, 149, 120, 222, 11, 179, 200, 235, 31, 215, 55, 55, 232,
-			83, 179, 219, 197, 160, 29, 90, 117, 249, 19, 174, 181, 115, 251,
-			102, 2, 79, 125, 30, 74, 53, 32, 184, 136, 204, 27, 92, 102,
-			32, 184, 1, 125, 171, 253, 135, 119, 5, 178, 11, 158, 32, 21,
-			121, 51, 252, 41, 116, 150, 255, 10, 228, 134, 175, 231, 190, 234,
-			120, 110, 184, 247, 245, 220, 87, 150, 217, 255, 186, 241, 21, 108,
-			222, 95, 223, 253, 170, 99, 187, 95, 223, 253, 42, 96, 173, 175,
-			63, 43, 125, 5, 226, 18, 44, 217, 175, 63, 127, 145, 39, 60,
-			193, 22, 229, 95, 115, 231, 147, 3, 179, 143, 70, 93, 159, 97,
-			52, 55, 151, 5, 218, 32, 5, 88, 246, 174, 29, 6, 32, 212,
-			56, 140, 138, 158, 138, 20, 187, 42, 18, 202, 59, 43, 82, 236,
-			141, 135, 217, 97, 151, 40, 151, 124, 201, 124, 111, 174, 107, 90,
-			34, 185, 84, 120, 224, 201, 214, 152, 217, 218, 227, 50, 153, 148,
-			227, 64, 254, 19, 44, 69, 166, 107, 195, 84, 15, 30, 237, 117,
-			81, 76, 144, 159, 22, 236, 18, 43, 137, 135, 139, 163, 165, 189,
-			217, 34, 25, 8, 179, 227, 61, 229, 95, 228, 105, 208, 107, 183,
-			237, 215, 152, 70, 174, 133, 25, 200, 208, 206, 105, 119, 24, 74,
-			162, 133, 252, 118, 99, 37, 63, 123, 111, 224, 41, 225, 2, 163,
-			8, 230, 164, 101, 202, 205, 95, 156, 24, 34, 43, 154, 31, 229,
-			27, 225, 168, 236, 5, 12, 165, 201, 130, 25, 68, 189, 89, 180,
-			217, 39, 0, 198, 44, 15, 107, 237, 250, 182, 43, 211, 250, 12,
-			147, 18, 6, 83, 15, 116, 213, 53, 253, 32, 238, 166, 201, 8,
-			69, 153, 14, 36, 28, 158, 182, 3, 19, 104, 64, 159, 152, 126,
-			143, 171, 254, 98, 12, 193, 33, 56, 64, 236, 245, 218, 237, 128,
-			133, 40, 174, 61, 244, 124, 153, 179, 183, 72, 243, 75, 11, 139,
-			239, 195, 238, 176, 120, 187, 177, 176, 120, 247, 230, 194, 221, 197,
-			219, 165, 133, 197, 23, 121, 65, 221, 1, 197, 114, 180, 189, 116,
-			205, 32, 36, 20, 107, 98, 255, 158, 27, 203, 205, 183, 139, 20,
-			90, 19, 105, 21, 129, 45, 213, 91, 190, 221, 13, 139, 152, 32,
-			48, 41, 170, 153, 20, 182, 71, 234, 53, 127, 200, 90, 33, 151,
-			242, 120, 188, 52, 16, 59, 167, 71, 36, 127, 25, 68, 72, 232,
-			103, 161, 87, 173, 111, 214, 113, 145, 21, 102, 71, 8, 168, 165,
-			142, 247, 165, 237, 56, 38, 174, 46, 230, 206, 109, 215, 231, 45,
-			175, 21, 204, 63, 99, 205, 249, 24, 148, 249, 26, 195, 140, 73,
-			45, 54, 255, 200, 241, 154, 166, 179, 179, 137, 48, 4, 243, 0,
-			208, 124, 162, 147, 89, 18, 37, 37, 174, 74, 78, 83, 196, 117,
-			46, 226, 26, 95, 130, 196, 136, 206, 157, 242, 199, 75, 57, 160,
-			232, 132, 5, 71, 203, 44, 50, 114, 136, 132, 126, 246, 50, 8,
-			253, 54, 126, 154, 24, 145, 215, 10, 74, 93, 206, 217, 96, 44,
-			75, 243, 142, 221, 244, 77, 191, 143, 98, 119, 105, 47, 236, 56,
-			215, 240, 151, 252, 118, 22, 109, 46, 36, 34, 100, 217, 73, 208,
-			101, 45, 58, 115, 253, 249, 220, 245, 206, 220, 117, 171, 113, 125,
-			237, 238, 245, 167, 119, 175, 215, 75, 215, 219, 47, 102, 74, 116,
-			221, 126, 197, 14, 236, 128, 161, 154, 3, 8, 138, 103, 169, 23,
-			48, 222, 218, 99, 207, 50, 145, 88, 103, 2, 250, 217, 203, 106,
-			125, 83, 10, 53, 15, 57, 179, 178, 68, 177, 48, 251, 242, 243,
-			2, 183, 84, 10, 62, 247, 67, 207, 226, 51, 1, 63, 230, 80,
-			95, 48, 187, 54, 78, 136, 124, 202, 181, 8, 14, 235, 252, 225,
-			182, 113, 156, 178, 131, 235, 75, 171, 215, 151, 86, 9, 157, 5,
-			68, 122, 77, 180, 16, 154, 98, 156, 33, 230, 168, 232, 226, 2,
-			241, 218, 242, 180, 12, 73, 40, 153, 250, 32, 137, 127, 244, 60,
-			59, 198, 29, 46, 245, 191, 172, 100, 39, 201, 111, 72, 143, 75,
-			253, 151, 21, 245, 76, 238, 223, 83, 104, 45, 214, 112, 37, 237,
-			123, 109, 36, 121, 196, 49, 207, 89, 21, 75, 89, 100, 180, 152,
-			69, 159, 246, 2, 52, 194, 191, 73, 45, 34, 163, 244, 162, 23,
-			20, 195, 98, 3, 123, 95, 158, 66, 162, 59, 37, 192, 55, 38,
-			139, 120, 212, 159, 61, 37, 139, 120, 212, 111, 156, 230, 137, 153,
-			82, 170, 98, 232, 191, 170, 168, 70, 238, 15, 21, 186, 225, 185,
-			115, 46, 158, 17, 239, 179, 65, 109, 218, 148, 90, 35, 40, 146,
-			163, 181, 233, 13, 241, 97, 164, 96, 226, 9, 142, 112, 251, 141,
-			27, 67, 195, 41, 79, 247, 137, 169, 21, 221, 100, 159, 216, 180,
-			248, 80, 134, 202, 160, 130, 222, 246, 124, 80, 140, 165, 245, 96,
-			24, 97, 66, 105, 44, 138, 255, 201, 8, 164, 40, 105, 28, 167,
-			68, 138, 130, 195, 206, 158, 144, 69, 13, 138, 19, 147, 209, 73,
-			198, 255, 80, 37, 119, 191, 93, 40, 44, 247, 114, 61, 50, 22,
-			54, 127, 64, 38, 241, 220, 189, 142, 15, 185, 147, 175, 209, 36,
-			103, 19, 238, 130, 59, 81, 126, 186, 105, 133, 106, 133, 99, 75,
-			163, 162, 187, 86, 226, 250, 101, 89, 157, 183, 86, 59, 211, 26,
-			241, 46, 255, 75, 42, 201, 29, 253, 145, 113, 129, 140, 71, 145,
-			253, 24, 246, 167, 213, 178, 252, 65, 213, 50, 78, 18, 181, 37,
-			99, 31, 213, 22, 38, 27, 194, 112, 205, 174, 25, 238, 201, 100,
-			67, 240, 96, 203, 12, 247, 48, 61, 144, 239, 236, 244, 124, 71,
-			228, 32, 207, 180, 124, 103, 219, 119, 176, 139, 128, 237, 120, 48,
-			60, 17, 174, 152, 237, 5, 108, 19, 202, 198, 85, 114, 28, 95,
-			236, 4, 45, 175, 203, 130, 233, 49, 76, 127, 125, 12, 159, 213,
-			241, 145, 177, 74, 78, 224, 217, 204, 142, 229, 117, 76, 219, 13,
-			48, 173, 246, 177, 165, 43, 35, 176, 179, 138, 53, 4, 62, 142,
-			227, 87, 252, 81, 144, 15, 201, 241, 228, 91, 227, 28, 201, 240,
-			246, 16, 217, 227, 53, 81, 50, 110, 145, 115, 3, 177, 166, 59,
-			81, 146, 36, 158, 11, 233, 76, 39, 225, 166, 186, 46, 222, 61,
-			214, 179, 234, 132, 246, 88, 207, 106, 19, 250, 99, 61, 171, 79,
-			164, 69, 82, 164, 45, 114, 54, 118, 188, 216, 138, 204, 218, 129,
-			241, 62, 73, 251, 61, 135, 5, 98, 170, 175, 142, 26, 76, 244,
-			97, 173, 231, 176, 26, 175, 159, 255, 247, 85, 114, 114, 240, 141,
-			97, 16, 29, 163, 103, 249, 68, 225, 111, 227, 12, 73, 123, 7,
-			46, 243, 167, 85, 28, 29, 47, 24, 23, 201, 120, 148, 3, 125,
-			90, 195, 55, 241, 3, 24, 186, 233, 56, 222, 1, 179, 118, 66,
-			111, 39, 206, 6, 200, 166, 117, 172, 122, 70, 188, 109, 120, 213,
-			248, 157, 49, 75, 38, 228, 87, 67, 137, 207, 79, 137, 231, 101,
-			153, 255, 252, 58, 57, 25, 154, 254, 46, 234, 138, 24, 120, 38,
-			178, 160, 159, 224, 79, 69, 250, 18, 99, 137, 156, 237, 152, 175,
-			119, 14, 231, 92, 31, 195, 25, 56, 221, 49, 95, 127, 58, 148,
-			118, 61, 255, 127, 43, 228, 146, 248, 94, 198, 67, 137, 24, 41,
-			33, 176, 27, 53, 50, 214, 225, 63, 5, 206, 63, 24, 129, 243,
-			55, 54, 81, 18, 255, 214, 100, 67, 198, 61, 146, 3, 210, 150,
-			161, 85, 72, 195, 81, 108, 149, 64, 253, 84, 47, 96, 162, 29,
-			36, 104, 25, 175, 149, 91, 39, 99, 18, 182, 105, 50, 38, 218,
-			16, 212, 40, 139, 198, 12, 57, 37, 112, 53, 212, 236, 201, 96,
-			0, 212, 159, 54, 88, 242, 159, 124, 204, 131, 37, 255, 224, 47,
-			110, 176, 228, 58, 143, 199, 56, 158, 58, 165, 228, 62, 161, 135,
-			184, 48, 151, 230, 77, 97, 19, 28, 138, 80, 132, 30, 49, 111,
-			188, 224, 239, 113, 8, 198, 241, 236, 121, 242, 142, 140, 192, 56,
-			169, 126, 146, 155, 138, 18, 22, 174, 148, 3, 122, 192, 104, 232,
-			247, 130, 48, 142, 114, 208, 161, 90, 84, 202, 24, 218, 201, 99,
-			215, 19, 49, 15, 39, 111, 220, 75, 196, 60, 156, 188, 255, 49,
-			249, 227, 52, 15, 122, 184, 156, 42, 40, 185, 255, 46, 77, 143,
-			230, 226, 34, 129, 11, 106, 152, 182, 139, 78, 48, 229, 36, 4,
-			180, 222, 107, 237, 193, 51, 17, 139, 147, 216, 40, 2, 145, 136,
-			216, 146, 41, 255, 64, 79, 129, 185, 230, 94, 98, 98, 61, 148,
-			120, 94, 74, 168, 198, 189, 125, 6, 157, 40, 101, 14, 22, 153,
-			239, 4, 55, 253, 100, 22, 164, 128, 9, 1, 158, 39, 79, 146,
-			4, 66, 176, 69, 153, 182, 113, 101, 227, 163, 192, 49, 247, 217,
-			173, 155, 115, 173, 197, 82, 139, 211, 50, 107, 113, 45, 63, 97,
-			89, 46, 201, 4, 90, 121, 105, 87, 142, 97, 149, 62, 66, 72,
-			126, 43, 27, 60, 10, 201, 199, 64, 170, 195, 121, 52, 209, 167,
-			85, 134, 126, 148, 104, 53, 36, 52, 232, 58, 160, 82, 34, 144,
-			152, 102, 209, 164, 123, 94, 16, 226, 105, 105, 33, 31, 131, 151,
-			159, 21, 10, 25, 223, 53, 240, 200, 148, 208, 66, 254, 155, 64,
-			61, 91, 164, 1, 51, 125, 204, 57, 198, 65, 72, 52, 194, 115,
-			42, 13, 236, 119, 121, 64, 31, 106, 210, 69, 238, 99, 135, 142,
-			185, 92, 238, 44, 10, 151, 40, 233, 110, 25, 68, 121, 198, 68,
-			214, 103, 158, 94, 58, 33, 239, 74, 7, 47, 33, 94, 13, 198,
-			191, 204, 136, 161, 15, 79, 40, 215, 106, 137, 204, 215, 83, 48,
-			59, 158, 187, 43, 85, 202, 61, 219, 221, 13, 96, 76, 236, 112,
-			214, 51, 219, 165, 35, 114, 111, 204, 38, 130, 106, 46, 103, 243,
-			228, 158, 12, 170, 185, 162, 78, 229, 75, 34, 99, 61, 159, 194,
-			114, 49, 74, 125, 54, 148, 255, 82, 134, 146, 197, 137, 90, 174,
-			12, 36, 106, 185, 50, 158, 76, 212, 114, 37, 153, 168, 133, 170,
-			19, 249, 155, 176, 30, 18, 52, 90, 228, 178, 41, 207, 193, 91,
-			239, 113, 29, 109, 101, 67, 178, 28, 192, 70, 50, 34, 134, 14,
-			68, 196, 208, 241, 99, 137, 136, 24, 122, 242, 20, 249, 88, 38,
-			106, 185, 170, 78, 229, 151, 40, 72, 76, 114, 5, 248, 158, 23,
-			14, 96, 151, 95, 86, 49, 192, 104, 146, 249, 91, 174, 14, 36,
-			27, 190, 58, 158, 204, 223, 114, 245, 236, 57, 114, 67, 230, 26,
-			206, 171, 103, 243, 231, 133, 41, 39, 244, 104, 155, 193, 80, 86,
-			106, 235, 252, 8, 62, 145, 200, 55, 63, 144, 200, 55, 63, 62,
-			145, 72, 228, 155, 63, 125, 134, 60, 144, 137, 124, 175, 169, 83,
-			249, 219, 192, 70, 176, 193, 128, 185, 150, 96, 255, 34, 203, 167,
-			72, 65, 199, 173, 23, 216, 31, 144, 216, 74, 109, 61, 153, 144,
-			247, 90, 148, 86, 90, 199, 52, 102, 70, 34, 33, 239, 181, 179,
-			231, 200, 45, 153, 144, 247, 29, 245, 90, 126, 134, 110, 66, 15,
-			148, 139, 131, 242, 110, 132, 35, 219, 79, 235, 240, 89, 50, 31,
-			239, 59, 81, 234, 206, 180, 98, 104, 239, 24, 151, 19, 249, 120,
-			223, 185, 154, 39, 117, 153, 142, 119, 70, 125, 55, 247, 144, 62,
-			73, 200, 136, 137, 28, 125, 137, 5, 149, 184, 158, 129, 1, 19,
-			236, 154, 126, 104, 183, 122, 142, 233, 139, 117, 59, 144, 29, 119,
-			38, 206, 142, 11, 125, 28, 155, 74, 100, 199, 157, 153, 190, 158,
-			200, 142, 59, 83, 152, 37, 21, 140, 155, 210, 139, 169, 187, 74,
-			238, 14, 77, 202, 167, 81, 148, 190, 141, 249, 198, 222, 192, 252,
-			19, 17, 82, 197, 236, 25, 242, 30, 252, 30, 55, 180, 57, 245,
-			92, 254, 10, 70, 186, 98, 102, 58, 244, 187, 44, 82, 203, 195,
-			84, 137, 62, 235, 161, 223, 234, 49, 162, 235, 234, 120, 202, 208,
-			230, 68, 138, 60, 21, 19, 230, 37, 74, 42, 47, 241, 138, 240,
-			234, 228, 41, 241, 74, 25, 44, 169, 188, 196, 43, 66, 97, 82,
-			4, 43, 140, 227, 173, 4, 113, 73, 188, 227, 21, 53, 67, 155,
-			59, 115, 86, 188, 2, 98, 76, 148, 84, 94, 250, 63, 162, 144,
-			175, 91, 234, 165, 220, 255, 166, 8, 52, 97, 34, 236, 152, 111,
-			162, 158, 10, 172, 90, 240, 126, 145, 186, 12, 125, 231, 145, 142,
-			184, 99, 28, 178, 162, 64, 230, 65, 68, 204, 162, 149, 32, 232,
-			53, 69, 99, 94, 91, 36, 240, 18, 101, 211, 103, 81, 110, 96,
-			145, 249, 141, 32, 13, 112, 7, 25, 177, 121, 161, 169, 13, 88,
-			103, 64, 243, 0, 69, 73, 216, 206, 128, 243, 231, 185, 127, 41,
-			62, 54, 155, 173, 129, 87, 68, 38, 253, 230, 157, 229, 147, 239,
-			226, 112, 49, 29, 198, 158, 12, 30, 187, 37, 136, 156, 7, 143,
-			221, 50, 166, 19, 193, 99, 183, 46, 92, 36, 255, 70, 20, 60,
-			118, 71, 189, 150, 235, 13, 240, 101, 169, 179, 0, 2, 247, 100,
-			242, 235, 216, 125, 120, 40, 211, 167, 99, 239, 115, 235, 143, 60,
-			125, 226, 94, 172, 11, 197, 225, 138, 60, 184, 52, 164, 66, 230,
-			31, 8, 22, 187, 35, 24, 51, 15, 22, 187, 147, 189, 156, 8,
-			22, 187, 115, 53, 79, 54, 209, 53, 95, 191, 159, 122, 164, 228,
-			86, 232, 72, 133, 105, 80, 74, 27, 76, 230, 48, 90, 72, 211,
-			48, 3, 218, 37, 242, 91, 170, 112, 200, 215, 30, 170, 239, 228,
-			254, 19, 149, 130, 206, 20, 8, 215, 163, 62, 79, 117, 214, 50,
-			29, 39, 144, 25, 75, 135, 61, 229, 57, 5, 200, 81, 137, 207,
-			225, 33, 219, 55, 157, 30, 34, 45, 225, 206, 224, 244, 75, 116,
-			211, 101, 226, 202, 19, 140, 250, 100, 20, 84, 55, 105, 168, 229,
-			19, 142, 150, 75, 25, 45, 1, 114, 6, 116, 128, 219, 131, 215,
-			101, 92, 167, 41, 1, 162, 93, 249, 121, 64, 61, 159, 118, 60,
-			126, 172, 237, 198, 173, 98, 115, 69, 146, 188, 72, 45, 218, 49,
-			45, 230, 218, 50, 173, 51, 163, 131, 90, 227, 224, 133, 0, 216,
-			176, 197, 66, 211, 118, 228, 158, 170, 33, 221, 61, 84, 163, 82,
-			198, 208, 30, 30, 59, 47, 75, 138, 161, 61, 204, 93, 145, 37,
-			205, 208, 30, 230, 175, 145, 127, 152, 193, 112, 133, 244, 103, 169,
-			191, 174, 40, 185, 223, 202, 12, 247, 25, 51, 216, 72, 82, 21,
-			152, 197, 187, 117, 96, 73, 113, 195, 238, 161, 219, 88, 208, 15,
-			194, 165, 182, 43, 238, 85, 24, 49, 81, 201, 208, 13, 51, 176,
-			91, 152, 16, 206, 164, 97, 175, 139, 70, 191, 187, 132, 210, 119,
-			233, 76, 242, 118, 176, 25, 58, 55, 32, 23, 98, 204, 0, 207,
-			164, 29, 39, 209, 16, 31, 148, 248, 231, 35, 110, 114, 24, 106,
-			36, 140, 239, 51, 17, 223, 72, 181, 24, 107, 162, 44, 12, 172,
-			35, 74, 91, 46, 252, 20, 196, 156, 73, 27, 254, 64, 6, 118,
-			209, 144, 204, 107, 61, 208, 80, 48, 144, 177, 123, 56, 201, 121,
-			34, 153, 108, 57, 162, 15, 153, 241, 214, 228, 36, 100, 183, 71,
-			35, 135, 167, 45, 140, 31, 206, 64, 151, 111, 192, 131, 168, 63,
-			218, 134, 144, 252, 56, 70, 136, 29, 112, 230, 43, 198, 50, 51,
-			108, 73, 72, 126, 21, 143, 126, 248, 171, 65, 163, 130, 248, 134,
-			171, 6, 92, 78, 110, 137, 224, 43, 28, 56, 122, 254, 195, 184,
-			185, 179, 42, 79, 119, 99, 187, 73, 108, 113, 111, 149, 158, 195,
-			89, 63, 145, 30, 150, 1, 76, 116, 175, 219, 101, 62, 109, 162,
-			62, 234, 185, 17, 253, 30, 178, 86, 20, 35, 15, 79, 104, 104,
-			38, 16, 58, 130, 29, 224, 125, 23, 44, 82, 102, 97, 168, 33,
-			13, 125, 211, 150, 193, 206, 58, 6, 59, 159, 67, 49, 76, 7,
-			198, 245, 185, 106, 228, 110, 211, 178, 88, 62, 93, 97, 44, 237,
-			196, 138, 54, 244, 80, 140, 238, 150, 226, 45, 58, 158, 188, 161,
-			7, 131, 107, 180, 207, 133, 208, 135, 177, 53, 218, 231, 227, 39,
-			100, 73, 51, 180, 207, 39, 38, 49, 3, 43, 74, 17, 47, 213,
-			139, 185, 50, 173, 96, 238, 88, 175, 77, 99, 239, 30, 78, 97,
-			222, 129, 155, 236, 52, 244, 48, 234, 31, 239, 241, 9, 69, 6,
-			253, 86, 24, 245, 172, 232, 208, 96, 84, 74, 27, 218, 75, 177,
-			119, 97, 128, 140, 246, 210, 152, 146, 37, 205, 208, 94, 230, 46,
-			144, 127, 164, 138, 240, 24, 205, 81, 105, 238, 199, 42, 45, 75,
-			74, 151, 73, 49, 241, 86, 32, 27, 153, 168, 0, 67, 238, 243,
-			124, 147, 7, 226, 182, 168, 185, 11, 50, 93, 24, 7, 40, 36,
-			227, 183, 121, 90, 94, 108, 15, 126, 143, 224, 38, 209, 101, 12,
-			204, 97, 252, 170, 43, 153, 31, 129, 175, 149, 242, 136, 123, 25,
-			134, 174, 100, 224, 116, 91, 230, 217, 112, 169, 47, 15, 149, 104,
-			97, 240, 158, 134, 200, 61, 10, 159, 202, 157, 9, 32, 70, 73,
-			30, 119, 154, 215, 93, 211, 69, 15, 95, 16, 132, 223, 200, 251,
-			72, 188, 45, 69, 147, 0, 162, 188, 19, 77, 2, 136, 218, 78,
-			52, 9, 48, 227, 142, 113, 65, 150, 52, 67, 115, 46, 95, 33,
-			191, 167, 139, 56, 16, 237, 107, 117, 46, 247, 183, 117, 121, 149,
-			74, 196, 174, 18, 155, 162, 8, 3, 141, 56, 194, 124, 98, 213,
-			71, 225, 72, 17, 19, 25, 53, 69, 35, 185, 73, 20, 210, 120,
-			244, 112, 255, 165, 206, 17, 175, 39, 111, 134, 176, 227, 203, 111,
-			242, 181, 202, 247, 182, 43, 245, 198, 102, 45, 47, 67, 59, 145,
-			57, 133, 118, 216, 27, 133, 1, 244, 53, 176, 227, 171, 105, 152,
-			43, 167, 76, 30, 253, 2, 73, 195, 216, 146, 45, 35, 178, 3,
-			220, 247, 7, 110, 110, 242, 18, 87, 86, 9, 44, 198, 183, 194,
-			68, 221, 224, 225, 34, 230, 180, 224, 188, 146, 74, 6, 251, 231,
-			78, 123, 154, 14, 52, 20, 149, 210, 134, 246, 117, 68, 123, 32,
-			162, 125, 109, 20, 100, 9, 168, 237, 189, 34, 249, 195, 140, 140,
-			224, 249, 145, 162, 206, 228, 126, 146, 137, 57, 192, 240, 166, 25,
-			157, 125, 31, 218, 54, 93, 118, 16, 239, 124, 135, 232, 45, 222,
-			94, 254, 127, 79, 101, 131, 29, 114, 219, 80, 254, 93, 209, 135,
-			184, 236, 196, 28, 125, 57, 76, 132, 227, 34, 239, 44, 202, 208,
-			107, 186, 158, 219, 239, 120, 61, 188, 25, 101, 0, 114, 59, 144,
-			55, 2, 59, 34, 246, 26, 233, 12, 211, 98, 161, 144, 203, 153,
-			11, 10, 195, 209, 80, 2, 60, 254, 76, 112, 21, 164, 100, 88,
-			9, 204, 9, 152, 244, 228, 77, 70, 227, 137, 85, 129, 71, 140,
-			3, 88, 193, 228, 72, 40, 101, 99, 88, 157, 96, 96, 223, 124,
-			49, 108, 108, 54, 190, 241, 130, 192, 189, 62, 94, 16, 180, 225,
-			197, 178, 191, 216, 60, 223, 176, 154, 248, 73, 41, 87, 82, 200,
-			155, 69, 36, 1, 46, 123, 221, 117, 236, 150, 29, 226, 181, 108,
-			135, 104, 60, 142, 81, 227, 11, 43, 42, 166, 161, 120, 108, 50,
-			17, 178, 246, 35, 197, 200, 39, 66, 214, 126, 164, 92, 191, 65,
-			254, 83, 85, 134, 172, 253, 186, 162, 94, 207, 253, 71, 137, 109,
-			121, 164, 0, 154, 88, 146, 9, 89, 244, 205, 171, 50, 22, 240,
-			190, 139, 85, 57, 124, 65, 80, 98, 113, 70, 183, 130, 218, 214,
-			136, 69, 55, 98, 13, 240, 37, 128, 199, 31, 178, 213, 67, 130,
-			54, 174, 1, 158, 32, 54, 14, 160, 211, 17, 95, 81, 17, 209,
-			23, 225, 58, 173, 64, 209, 160, 137, 120, 186, 95, 87, 174, 189,
-			67, 94, 33, 170, 51, 24, 15, 157, 207, 125, 78, 159, 154, 175,
-			237, 78, 175, 115, 72, 212, 164, 82, 212, 164, 133, 128, 181, 102,
-			241, 158, 14, 219, 5, 102, 48, 82, 125, 90, 21, 49, 150, 118,
-			64, 23, 151, 232, 158, 215, 195, 188, 86, 188, 239, 12, 143, 190,
-			30, 147, 69, 140, 190, 206, 94, 146, 69, 140, 190, 166, 87, 201,
-			127, 166, 19, 32, 152, 204, 111, 41, 169, 63, 80, 148, 220, 111,
-			234, 244, 141, 7, 102, 209, 185, 196, 65, 226, 86, 30, 121, 59,
-			1, 143, 2, 230, 254, 219, 240, 158, 112, 252, 70, 137, 18, 229,
-			13, 113, 205, 62, 82, 193, 96, 71, 113, 8, 62, 30, 70, 180,
-			237, 67, 23, 16, 209, 32, 100, 221, 34, 197, 20, 228, 34, 243,
-			26, 103, 124, 34, 26, 173, 135, 87, 226, 202, 187, 65, 135, 46,
-			78, 64, 15, 56, 142, 236, 102, 159, 214, 152, 233, 116, 2, 90,
-			94, 89, 15, 162, 251, 218, 184, 10, 18, 122, 93, 186, 107, 118,
-			169, 244, 131, 160, 61, 55, 196, 27, 23, 134, 206, 36, 28, 102,
-			250, 174, 52, 37, 18, 57, 198, 57, 126, 94, 24, 35, 132, 199,
-			21, 155, 142, 131, 86, 78, 140, 99, 174, 150, 159, 150, 232, 166,
-			219, 18, 169, 213, 246, 240, 218, 226, 160, 40, 220, 218, 9, 237,
-			120, 251, 108, 32, 57, 156, 48, 233, 115, 253, 226, 205, 179, 131,
-			118, 244, 168, 27, 146, 8, 187, 10, 70, 221, 29, 44, 110, 2,
-			53, 3, 122, 116, 42, 201, 195, 166, 152, 99, 68, 211, 129, 208,
-			127, 75, 201, 94, 39, 39, 137, 174, 167, 181, 148, 145, 249, 109,
-			69, 253, 29, 69, 67, 242, 74, 107, 41, 197, 208, 127, 91, 33,
-			147, 100, 150, 100, 160, 168, 166, 12, 253, 63, 87, 116, 154, 59,
-			143, 150, 121, 148, 214, 135, 105, 227, 20, 25, 227, 85, 117, 172,
-			123, 60, 126, 144, 134, 7, 39, 78, 199, 15, 20, 120, 112, 230,
-			66, 252, 64, 131, 7, 151, 175, 144, 79, 69, 119, 138, 161, 255,
-			93, 69, 47, 228, 30, 114, 165, 39, 72, 48, 181, 120, 110, 6,
-			133, 93, 153, 64, 21, 51, 221, 225, 93, 10, 35, 96, 83, 116,
-			108, 56, 134, 77, 73, 195, 131, 4, 108, 10, 118, 125, 230, 90,
-			252, 64, 131, 7, 55, 102, 72, 3, 48, 5, 136, 248, 123, 138,
-			122, 5, 32, 67, 86, 231, 134, 126, 159, 118, 204, 46, 94, 31,
-			210, 115, 91, 123, 35, 65, 13, 61, 42, 194, 104, 208, 172, 147,
-			128, 12, 241, 141, 56, 251, 123, 146, 43, 165, 213, 84, 6, 138,
-			199, 12, 89, 84, 160, 120, 58, 39, 139, 26, 20, 47, 93, 38,
-			127, 166, 34, 72, 138, 161, 255, 190, 162, 46, 230, 254, 23, 216,
-			1, 100, 88, 99, 114, 118, 132, 57, 22, 182, 116, 174, 165, 29,
-			90, 92, 3, 215, 12, 114, 62, 74, 80, 131, 224, 151, 8, 3,
-			105, 38, 27, 28, 90, 38, 124, 197, 71, 38, 22, 78, 166, 66,
-			58, 6, 57, 1, 87, 68, 126, 0, 162, 143, 238, 71, 211, 55,
-			4, 76, 158, 118, 146, 139, 161, 90, 126, 74, 187, 30, 236, 162,
-			44, 40, 18, 121, 75, 242, 75, 81, 231, 165, 8, 54, 245, 154,
-			129, 231, 176, 144, 9, 91, 168, 57, 56, 124, 206, 213, 130, 200,
-			155, 15, 112, 132, 185, 197, 112, 71, 119, 61, 113, 169, 44, 108,
-			40, 48, 159, 152, 113, 210, 229, 66, 73, 220, 17, 223, 0, 177,
-			187, 104, 218, 128, 156, 126, 63, 158, 54, 32, 166, 223, 151, 155,
-			73, 26, 73, 233, 247, 21, 163, 40, 139, 26, 20, 231, 23, 34,
-			151, 166, 63, 46, 145, 39, 223, 210, 165, 105, 132, 107, 18, 232,
-			216, 63, 93, 190, 255, 183, 69, 134, 231, 126, 6, 135, 171, 252,
-			251, 228, 212, 67, 22, 182, 246, 86, 106, 235, 50, 117, 251, 176,
-			239, 210, 25, 146, 110, 123, 126, 139, 223, 240, 159, 173, 241, 66,
-			126, 147, 76, 196, 31, 138, 172, 215, 247, 8, 105, 249, 206, 14,
-			207, 166, 137, 45, 28, 91, 186, 56, 202, 21, 171, 182, 94, 199,
-			58, 181, 241, 150, 239, 240, 159, 249, 171, 228, 212, 186, 29, 132,
-			43, 229, 32, 106, 79, 66, 162, 113, 72, 242, 239, 16, 227, 17,
-			11, 87, 202, 226, 227, 209, 240, 230, 127, 172, 146, 211, 3, 213,
-			68, 107, 21, 146, 225, 67, 23, 144, 125, 75, 39, 49, 241, 177,
-			97, 16, 29, 230, 87, 120, 113, 225, 111, 99, 154, 140, 249, 12,
-			150, 143, 76, 57, 47, 139, 128, 60, 76, 253, 41, 220, 183, 120,
-			193, 184, 64, 198, 209, 149, 123, 199, 103, 251, 232, 29, 53, 94,
-			203, 226, 131, 26, 219, 55, 174, 144, 99, 226, 126, 98, 124, 205,
-			179, 255, 19, 241, 72, 84, 16, 205, 99, 5, 145, 250, 95, 60,
-			130, 10, 131, 243, 144, 253, 118, 243, 176, 76, 206, 84, 3, 113,
-			25, 1, 160, 35, 137, 102, 51, 66, 179, 9, 229, 64, 230, 224,
-			87, 3, 55, 191, 72, 206, 14, 125, 39, 240, 142, 200, 225, 183,
-			29, 40, 18, 57, 88, 204, 223, 34, 83, 43, 32, 94, 36, 176,
-			46, 123, 59, 79, 178, 220, 97, 142, 117, 68, 159, 120, 133, 222,
-			22, 235, 228, 127, 64, 166, 15, 127, 37, 250, 58, 79, 178, 118,
-			176, 147, 188, 64, 96, 204, 14, 208, 229, 201, 184, 78, 78, 138,
-			212, 31, 131, 247, 7, 156, 16, 79, 249, 229, 1, 249, 127, 174,
-			144, 241, 8, 47, 198, 42, 153, 112, 204, 32, 220, 225, 216, 223,
-			65, 111, 54, 78, 61, 185, 67, 57, 233, 35, 183, 243, 218, 73,
-			248, 102, 187, 43, 221, 107, 141, 7, 228, 20, 182, 130, 231, 176,
-			188, 17, 245, 173, 141, 156, 128, 79, 112, 137, 97, 27, 55, 6,
-			218, 96, 161, 185, 43, 156, 5, 227, 122, 149, 208, 220, 53, 74,
-			228, 180, 64, 239, 14, 32, 44, 216, 225, 174, 79, 58, 58, 128,
-			77, 250, 241, 252, 4, 43, 240, 98, 233, 79, 53, 114, 110, 4,
-			213, 219, 44, 48, 234, 36, 43, 151, 184, 49, 42, 213, 254, 16,
-			227, 200, 93, 123, 99, 157, 104, 21, 142, 137, 101, 254, 173, 210,
-			250, 15, 179, 134, 31, 144, 99, 137, 53, 110, 92, 31, 241, 201,
-			97, 86, 145, 187, 241, 182, 106, 162, 245, 38, 57, 49, 64, 203,
-			198, 204, 168, 28, 252, 35, 86, 73, 174, 240, 246, 138, 162, 143,
-			87, 100, 98, 152, 140, 141, 119, 71, 45, 210, 209, 43, 36, 247,
-			222, 55, 170, 251, 179, 93, 8, 240, 63, 81, 126, 117, 35, 251,
-			11, 235, 226, 118, 248, 62, 128, 99, 169, 217, 248, 62, 128, 85,
-			242, 187, 10, 191, 16, 224, 116, 42, 175, 228, 254, 182, 50, 202,
-			157, 0, 4, 145, 196, 173, 225, 223, 232, 122, 0, 204, 141, 106,
-			243, 4, 99, 232, 246, 38, 191, 28, 246, 202, 138, 51, 10, 130,
-			122, 131, 150, 129, 67, 121, 170, 80, 87, 15, 3, 230, 180, 121,
-			238, 17, 254, 30, 251, 14, 18, 183, 15, 156, 206, 94, 38, 101,
-			121, 251, 192, 89, 245, 94, 238, 22, 149, 203, 243, 112, 234, 123,
-			238, 3, 99, 114, 47, 24, 207, 231, 38, 208, 149, 242, 192, 189,
-			3, 103, 51, 39, 19, 247, 14, 156, 61, 117, 57, 113, 239, 192,
-			217, 217, 59, 100, 77, 222, 59, 48, 173, 126, 156, 187, 71, 197,
-			26, 22, 121, 214, 64, 22, 149, 82, 112, 194, 155, 8, 117, 8,
-			159, 237, 218, 65, 136, 119, 197, 173, 148, 131, 129, 27, 7, 166,
-			51, 39, 18, 55, 14, 76, 159, 188, 150, 184, 113, 96, 186, 244,
-			17, 169, 201, 27, 7, 46, 168, 171, 185, 10, 77, 172, 238, 168,
-			95, 190, 145, 75, 11, 128, 76, 89, 188, 82, 30, 184, 205, 45,
-			74, 1, 61, 112, 229, 192, 133, 204, 100, 226, 202, 129, 11, 198,
-			59, 137, 43, 7, 46, 204, 63, 0, 229, 131, 95, 57, 112, 89,
-			125, 156, 123, 68, 7, 86, 62, 13, 204, 126, 32, 47, 60, 27,
-			186, 219, 112, 224, 102, 71, 113, 28, 8, 32, 172, 212, 214, 7,
-			174, 24, 184, 156, 57, 157, 184, 98, 224, 242, 153, 66, 226, 138,
-			129, 203, 55, 215, 200, 19, 121, 195, 192, 85, 181, 158, 187, 79,
-			135, 249, 193, 155, 64, 136, 178, 209, 161, 87, 99, 56, 144, 72,
-			254, 106, 230, 92, 34, 145, 252, 213, 169, 82, 34, 145, 252, 213,
-			59, 223, 35, 143, 184, 163, 232, 245, 84, 65, 201, 221, 163, 67,
-			251, 66, 108, 139, 133, 41, 151, 167, 111, 43, 229, 33, 79, 43,
-			207, 79, 248, 136, 94, 207, 78, 197, 62, 162, 55, 212, 137, 252,
-			212, 240, 221, 144, 136, 157, 114, 50, 19, 246, 141, 129, 76, 216,
-			55, 132, 99, 25, 167, 198, 27, 39, 79, 145, 143, 228, 157, 143,
-			51, 234, 100, 126, 65, 18, 184, 107, 241, 112, 48, 4, 66, 102,
-			116, 63, 96, 92, 213, 176, 195, 232, 66, 1, 18, 223, 200, 56,
-			35, 220, 178, 56, 65, 206, 136, 4, 196, 156, 4, 103, 78, 77,
-			144, 247, 185, 247, 233, 123, 169, 57, 37, 247, 30, 29, 222, 1,
-			135, 243, 152, 203, 247, 9, 207, 190, 247, 178, 211, 100, 65, 122,
-			246, 21, 213, 11, 249, 107, 50, 67, 189, 28, 120, 109, 157, 219,
-			103, 120, 30, 28, 104, 33, 153, 227, 186, 168, 30, 75, 184, 243,
-			21, 143, 159, 75, 184, 243, 21, 207, 231, 200, 109, 238, 60, 181,
-			144, 90, 82, 114, 179, 116, 104, 107, 29, 134, 79, 188, 78, 56,
-			75, 45, 136, 201, 65, 215, 162, 69, 245, 252, 155, 39, 135, 123,
-			225, 44, 14, 120, 225, 44, 14, 120, 225, 44, 26, 103, 18, 94,
-			56, 139, 83, 211, 228, 1, 119, 107, 185, 157, 122, 95, 201, 45,
-			211, 195, 27, 249, 91, 73, 42, 225, 201, 114, 59, 155, 67, 104,
-			209, 145, 101, 249, 109, 164, 132, 233, 37, 181, 101, 65, 74, 220,
-			91, 99, 89, 144, 18, 247, 214, 88, 62, 121, 10, 147, 170, 235,
-			134, 254, 97, 170, 162, 228, 60, 58, 66, 130, 24, 70, 98, 146,
-			13, 137, 184, 55, 174, 2, 199, 247, 142, 3, 255, 241, 152, 200,
-			255, 134, 154, 175, 233, 56, 50, 237, 31, 30, 39, 161, 78, 24,
-			31, 116, 127, 152, 189, 64, 46, 201, 131, 238, 143, 212, 66, 126,
-			34, 138, 224, 77, 186, 66, 234, 72, 18, 31, 169, 23, 19, 103,
-			216, 31, 93, 186, 150, 56, 195, 254, 232, 198, 12, 153, 149, 103,
-			216, 247, 85, 35, 127, 145, 118, 89, 103, 78, 198, 223, 174, 148,
-			147, 124, 34, 121, 32, 125, 63, 58, 24, 7, 170, 189, 31, 29,
-			140, 195, 66, 184, 63, 49, 73, 238, 202, 243, 232, 143, 213, 211,
-			249, 57, 238, 13, 105, 71, 254, 169, 34, 19, 52, 106, 45, 194,
-			133, 60, 98, 186, 201, 19, 215, 143, 197, 114, 227, 39, 174, 31,
-			143, 157, 76, 156, 184, 126, 60, 105, 144, 69, 121, 224, 250, 137,
-			58, 153, 127, 231, 80, 47, 194, 253, 169, 207, 111, 52, 8, 204,
-			93, 150, 60, 82, 251, 36, 106, 28, 104, 229, 19, 177, 150, 249,
-			145, 218, 39, 167, 38, 200, 188, 56, 81, 211, 202, 234, 84, 62,
-			47, 157, 227, 124, 182, 159, 184, 78, 117, 165, 76, 205, 110, 23,
-			211, 134, 200, 166, 245, 52, 124, 33, 177, 3, 179, 85, 22, 222,
-			155, 120, 50, 160, 149, 207, 158, 35, 75, 226, 92, 64, 123, 160,
-			158, 207, 95, 63, 178, 105, 192, 146, 80, 254, 100, 235, 105, 252,
-			72, 182, 158, 86, 12, 237, 193, 248, 25, 89, 210, 12, 237, 193,
-			212, 180, 104, 61, 99, 104, 43, 111, 109, 93, 204, 129, 108, 61,
-			147, 134, 143, 100, 235, 25, 197, 208, 86, 162, 214, 51, 154, 161,
-			173, 76, 77, 147, 247, 177, 245, 49, 67, 91, 85, 47, 228, 223,
-			165, 160, 130, 240, 43, 12, 70, 48, 42, 238, 92, 129, 221, 201,
-			46, 198, 50, 240, 229, 49, 89, 82, 12, 109, 85, 48, 41, 29,
-			239, 247, 95, 61, 159, 35, 159, 162, 193, 92, 95, 75, 61, 81,
-			114, 143, 233, 40, 97, 59, 118, 194, 54, 19, 78, 29, 184, 147,
-			115, 23, 115, 76, 15, 60, 176, 181, 138, 229, 3, 40, 91, 203,
-			94, 68, 190, 144, 134, 229, 83, 125, 27, 95, 72, 35, 95, 168,
-			10, 180, 160, 173, 79, 171, 10, 190, 128, 166, 62, 173, 122, 242,
-			20, 169, 10, 67, 159, 246, 88, 157, 200, 127, 136, 253, 207, 4,
-			67, 155, 123, 161, 105, 239, 150, 170, 110, 56, 24, 223, 110, 177,
-			150, 221, 225, 55, 91, 99, 116, 172, 104, 24, 86, 217, 227, 168,
-			83, 88, 101, 143, 163, 78, 97, 149, 61, 62, 121, 138, 124, 66,
-			48, 123, 226, 70, 106, 75, 201, 221, 26, 70, 212, 104, 118, 52,
-			80, 137, 163, 4, 230, 121, 35, 123, 9, 103, 54, 3, 40, 217,
-			84, 79, 231, 223, 77, 172, 38, 145, 109, 25, 35, 20, 184, 159,
-			103, 125, 35, 190, 159, 149, 3, 156, 65, 44, 109, 138, 53, 149,
-			65, 44, 109, 138, 5, 155, 65, 44, 109, 78, 26, 164, 70, 84,
-			125, 204, 208, 235, 169, 109, 37, 247, 240, 144, 140, 50, 98, 114,
-			187, 172, 19, 97, 43, 41, 178, 200, 139, 103, 197, 172, 2, 29,
-			213, 179, 87, 200, 135, 68, 215, 199, 96, 8, 13, 245, 92, 126,
-			254, 173, 95, 35, 129, 202, 147, 33, 62, 142, 49, 28, 71, 67,
-			32, 126, 12, 199, 209, 16, 87, 107, 140, 225, 56, 26, 103, 206,
-			162, 212, 147, 53, 244, 239, 167, 62, 3, 169, 231, 40, 221, 107,
-			24, 247, 195, 245, 4, 240, 89, 197, 208, 190, 159, 165, 100, 142,
-			232, 122, 22, 128, 127, 174, 158, 201, 83, 142, 127, 126, 186, 49,
-			66, 86, 227, 208, 102, 17, 218, 231, 2, 235, 89, 132, 246, 249,
-			216, 41, 89, 210, 12, 237, 185, 113, 26, 47, 182, 200, 2, 109,
-			190, 80, 47, 230, 151, 169, 41, 111, 51, 31, 190, 180, 55, 190,
-			78, 49, 142, 153, 16, 207, 100, 119, 64, 149, 47, 4, 114, 178,
-			72, 149, 47, 198, 167, 100, 73, 51, 180, 23, 185, 11, 228, 215,
-			20, 162, 234, 227, 134, 254, 50, 197, 148, 220, 71, 52, 50, 169,
-			36, 60, 25, 129, 166, 28, 51, 100, 3, 188, 3, 87, 94, 116,
-			135, 10, 202, 69, 75, 27, 243, 223, 229, 31, 199, 247, 184, 98,
-			104, 47, 179, 147, 232, 67, 63, 14, 248, 54, 213, 197, 252, 12,
-			143, 62, 70, 124, 3, 235, 26, 153, 31, 88, 178, 132, 113, 220,
-			88, 77, 245, 130, 44, 41, 134, 102, 94, 44, 202, 146, 102, 104,
-			230, 252, 2, 222, 59, 139, 142, 220, 77, 117, 33, 127, 97, 68,
-			251, 40, 175, 72, 238, 59, 174, 42, 25, 168, 42, 219, 4, 220,
-			54, 47, 190, 39, 75, 154, 161, 53, 75, 243, 2, 102, 213, 208,
-			90, 234, 165, 252, 12, 101, 161, 185, 11, 88, 67, 46, 44, 174,
-			188, 105, 247, 28, 167, 47, 219, 142, 23, 232, 56, 238, 168, 45,
-			49, 119, 227, 184, 163, 182, 198, 167, 101, 73, 51, 180, 214, 133,
-			139, 228, 3, 108, 95, 51, 52, 75, 165, 249, 247, 18, 119, 77,
-			11, 243, 209, 96, 24, 84, 172, 170, 200, 62, 96, 99, 181, 132,
-			231, 242, 56, 110, 172, 86, 86, 142, 8, 54, 86, 235, 242, 21,
-			105, 93, 255, 127, 3, 0, 0, 255, 255, 76, 104, 39, 36, 15,
-			161, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 107, 108, 28, 89,
+			151, 24, 214, 85, 213, 36, 155, 87, 26, 61, 74, 47, 170, 245,
+			58, 211, 51, 26, 146, 51, 205, 230, 67, 143, 249, 36, 205, 204,
+			55, 205, 102, 75, 106, 137, 34, 185, 221, 205, 209, 39, 205, 247,
+			133, 83, 93, 117, 155, 172, 79, 213, 85, 61, 85, 213, 164, 122,
+			38, 227, 60, 236, 141, 237, 44, 214, 137, 119, 243, 35, 78, 252,
+			136, 147, 0, 65, 22, 72, 140, 181, 227, 69, 176, 126, 173, 17,
+			27, 11, 27, 182, 243, 195, 200, 174, 17, 199, 217, 31, 187, 65,
+			16, 199, 206, 19, 1, 118, 19, 32, 9, 206, 185, 247, 86, 87,
+			55, 155, 210, 204, 183, 179, 198, 231, 245, 71, 104, 48, 125, 111,
+			221, 231, 185, 231, 158, 123, 206, 185, 231, 156, 203, 254, 191, 211,
+			236, 210, 110, 16, 236, 122, 124, 177, 27, 6, 113, 208, 234, 181,
+			23, 121, 167, 27, 247, 75, 148, 52, 79, 138, 143, 37, 245, 177,
+			48, 197, 38, 170, 248, 125, 245, 43, 118, 198, 14, 58, 165, 145,
+			239, 171, 140, 190, 110, 97, 114, 75, 123, 174, 62, 239, 6, 158,
+			229, 239, 150, 130, 112, 119, 208, 77, 220, 239, 242, 104, 241, 133,
+			31, 28, 248, 162, 203, 110, 235, 183, 53, 237, 63, 212, 141, 7,
+			91, 171, 191, 160, 95, 125, 32, 106, 110, 201, 226, 165, 167, 220,
+			243, 30, 99, 225, 38, 214, 123, 244, 87, 79, 177, 73, 51, 123,
+			53, 179, 114, 138, 253, 157, 227, 76, 59, 110, 26, 87, 51, 230,
+			202, 95, 63, 14, 84, 193, 14, 60, 88, 237, 181, 219, 60, 140,
+			96, 1, 68, 83, 179, 17, 56, 86, 108, 129, 235, 199, 60, 180,
+			247, 44, 127, 151, 67, 59, 8, 59, 86, 204, 160, 18, 116, 251,
+			161, 187, 187, 23, 195, 202, 210, 210, 119, 100, 5, 168, 249, 118,
+			9, 160, 236, 121, 64, 223, 34, 8, 121, 196, 195, 125, 238, 148,
+			24, 236, 197, 113, 55, 186, 187, 184, 232, 240, 125, 238, 5, 93,
+			30, 70, 106, 174, 118, 208, 17, 147, 180, 3, 111, 161, 37, 6,
+			177, 200, 24, 212, 185, 227, 70, 113, 232, 182, 122, 177, 27, 248,
+			96, 249, 14, 244, 34, 14, 174, 15, 81, 208, 11, 109, 78, 57,
+			45, 215, 183, 194, 62, 141, 43, 42, 194, 129, 27, 239, 65, 16,
+			210, 255, 131, 94, 204, 160, 19, 56, 110, 219, 181, 45, 108, 161,
+			8, 86, 200, 161, 203, 195, 142, 27, 199, 220, 129, 110, 24, 236,
+			187, 14, 119, 32, 222, 179, 98, 136, 247, 112, 118, 158, 23, 28,
+			184, 254, 46, 216, 129, 239, 184, 88, 41, 194, 74, 12, 58, 60,
+			190, 203, 24, 224, 223, 187, 35, 3, 139, 32, 104, 171, 17, 217,
+			129, 195, 161, 211, 139, 98, 8, 121, 108, 185, 62, 181, 106, 181,
+			130, 125, 252, 36, 33, 198, 192, 15, 98, 215, 230, 69, 136, 247,
+			220, 8, 60, 55, 138, 177, 133, 116, 143, 190, 51, 50, 28, 199,
+			141, 108, 207, 114, 59, 60, 44, 29, 53, 8, 215, 79, 195, 66,
+			13, 162, 27, 6, 78, 207, 230, 131, 113, 176, 193, 64, 126, 87,
+			227, 96, 32, 103, 231, 4, 118, 175, 195, 253, 216, 82, 139, 180,
+			24, 132, 16, 196, 123, 60, 132, 142, 21, 243, 208, 181, 188, 104,
+			0, 106, 92, 24, 108, 147, 65, 122, 244, 201, 164, 54, 184, 75,
+			53, 177, 97, 223, 234, 112, 28, 80, 26, 183, 252, 96, 240, 141,
+			224, 238, 198, 17, 206, 200, 23, 77, 5, 97, 4, 29, 171, 15,
+			45, 142, 152, 226, 64, 28, 0, 247, 157, 32, 140, 56, 34, 69,
+			55, 12, 58, 65, 204, 113, 48, 78, 207, 142, 35, 112, 120, 232,
+			238, 115, 7, 218, 97, 208, 97, 2, 10, 81, 208, 142, 15, 16,
+			77, 36, 6, 65, 212, 229, 54, 98, 16, 116, 67, 23, 17, 43,
+			68, 220, 241, 5, 22, 69, 17, 141, 157, 65, 243, 97, 173, 1,
+			141, 205, 251, 205, 167, 229, 122, 21, 106, 13, 216, 170, 111, 126,
+			82, 91, 171, 174, 193, 234, 51, 104, 62, 172, 66, 101, 115, 235,
+			89, 189, 246, 224, 97, 19, 30, 110, 174, 175, 85, 235, 13, 40,
+			111, 172, 65, 101, 115, 163, 89, 175, 173, 110, 55, 55, 235, 13,
+			6, 133, 114, 3, 106, 141, 2, 125, 41, 111, 60, 131, 234, 247,
+			182, 234, 213, 70, 3, 54, 235, 80, 123, 178, 181, 94, 171, 174,
+			193, 211, 114, 189, 94, 222, 104, 214, 170, 141, 34, 212, 54, 42,
+			235, 219, 107, 181, 141, 7, 69, 88, 221, 110, 194, 198, 102, 147,
+			193, 122, 237, 73, 173, 89, 93, 131, 230, 102, 145, 186, 61, 92,
+			15, 54, 239, 195, 147, 106, 189, 242, 176, 188, 209, 44, 175, 214,
+			214, 107, 205, 103, 212, 225, 253, 90, 115, 3, 59, 187, 191, 89,
+			103, 80, 134, 173, 114, 189, 89, 171, 108, 175, 151, 235, 176, 181,
+			93, 223, 218, 108, 84, 1, 103, 182, 86, 107, 84, 214, 203, 181,
+			39, 213, 181, 18, 212, 54, 96, 99, 19, 170, 159, 84, 55, 154,
+			208, 120, 88, 94, 95, 31, 158, 40, 131, 205, 167, 27, 213, 58,
+			142, 62, 61, 77, 88, 173, 194, 122, 173, 188, 186, 94, 133, 251,
+			155, 117, 154, 231, 90, 173, 94, 173, 52, 113, 66, 131, 95, 149,
+			218, 90, 117, 163, 89, 94, 47, 50, 104, 108, 85, 43, 181, 242,
+			122, 17, 170, 223, 171, 62, 217, 90, 47, 215, 159, 21, 101, 163,
+			141, 234, 79, 109, 87, 55, 154, 181, 242, 58, 172, 149, 159, 148,
+			31, 84, 27, 48, 247, 58, 168, 108, 213, 55, 43, 219, 245, 234,
+			19, 28, 245, 230, 125, 104, 108, 175, 54, 154, 181, 230, 118, 179,
+			10, 15, 54, 55, 215, 8, 216, 141, 106, 253, 147, 90, 165, 218,
+			184, 7, 235, 155, 8, 254, 251, 176, 221, 168, 22, 25, 172, 149,
+			155, 101, 234, 122, 171, 190, 121, 191, 214, 108, 220, 195, 223, 171,
+			219, 141, 26, 1, 174, 182, 209, 172, 214, 235, 219, 91, 205, 218,
+			230, 198, 60, 60, 220, 124, 90, 253, 164, 90, 135, 74, 121, 187,
+			81, 93, 35, 8, 111, 110, 224, 108, 17, 87, 170, 155, 245, 103,
+			216, 44, 194, 129, 86, 160, 8, 79, 31, 86, 155, 15, 171, 117,
+			4, 42, 65, 171, 140, 96, 104, 52, 235, 181, 74, 51, 93, 108,
+			179, 14, 205, 205, 122, 147, 165, 230, 9, 27, 213, 7, 235, 181,
+			7, 213, 141, 74, 21, 199, 179, 137, 205, 60, 173, 53, 170, 243,
+			80, 174, 215, 26, 88, 160, 70, 29, 195, 211, 242, 51, 216, 220,
+			166, 89, 227, 66, 109, 55, 170, 76, 252, 78, 161, 110, 145, 214,
+			19, 106, 247, 161, 188, 246, 73, 13, 71, 46, 75, 111, 109, 54,
+			26, 53, 137, 46, 4, 182, 202, 67, 9, 243, 18, 99, 57, 166,
+			233, 166, 1, 153, 25, 252, 149, 51, 141, 66, 230, 30, 155, 102,
+			122, 238, 186, 248, 41, 50, 223, 202, 84, 41, 243, 152, 248, 41,
+			50, 223, 206, 20, 41, 83, 19, 63, 69, 230, 245, 204, 123, 148,
+			41, 127, 138, 204, 119, 50, 5, 202, 100, 226, 167, 200, 156, 205,
+			188, 73, 153, 111, 139, 159, 34, 115, 46, 115, 141, 50, 175, 137,
+			159, 127, 73, 103, 122, 54, 99, 26, 43, 153, 83, 249, 63, 167,
+			67, 25, 118, 185, 207, 67, 215, 6, 58, 65, 161, 195, 163, 200,
+			218, 69, 250, 104, 197, 208, 15, 122, 96, 91, 62, 132, 124, 1,
+			15, 154, 56, 0, 107, 63, 112, 29, 112, 120, 219, 245, 137, 12,
+			247, 186, 30, 30, 38, 220, 97, 195, 245, 137, 252, 246, 131, 94,
+			8, 229, 173, 90, 84, 130, 50, 196, 253, 174, 107, 91, 30, 240,
+			151, 86, 167, 235, 113, 112, 35, 164, 70, 216, 172, 27, 131, 21,
+			17, 21, 11, 249, 231, 61, 30, 197, 12, 36, 85, 11, 121, 212,
+			13, 124, 236, 185, 223, 37, 210, 103, 249, 216, 30, 30, 62, 123,
+			129, 83, 130, 251, 65, 8, 174, 31, 197, 150, 111, 115, 117, 26,
+			225, 249, 234, 218, 28, 238, 7, 1, 124, 41, 178, 0, 194, 174,
+			13, 171, 86, 56, 55, 194, 107, 148, 136, 213, 152, 135, 144, 199,
+			189, 208, 143, 224, 136, 239, 247, 68, 51, 95, 49, 252, 51, 178,
+			25, 205, 52, 86, 114, 111, 180, 38, 169, 216, 13, 246, 175, 205,
+			177, 234, 110, 80, 178, 247, 194, 160, 227, 246, 58, 196, 163, 120,
+			61, 219, 93, 196, 161, 240, 112, 209, 234, 197, 123, 139, 14, 247,
+			248, 46, 29, 9, 139, 10, 68, 169, 60, 201, 43, 229, 212, 167,
+			194, 95, 212, 216, 201, 181, 228, 115, 51, 120, 193, 125, 243, 18,
+			155, 142, 220, 93, 159, 135, 59, 174, 51, 163, 131, 54, 55, 93,
+			207, 137, 140, 154, 99, 190, 205, 78, 224, 111, 215, 223, 221, 121,
+			193, 251, 88, 194, 160, 18, 199, 101, 238, 99, 222, 175, 57, 230,
+			28, 59, 213, 125, 97, 71, 203, 59, 209, 158, 181, 114, 235, 246,
+			78, 228, 238, 206, 100, 65, 155, 59, 94, 63, 65, 249, 13, 202,
+			110, 184, 187, 230, 34, 59, 19, 209, 89, 229, 126, 193, 157, 157,
+			168, 215, 138, 113, 12, 51, 19, 84, 216, 28, 124, 106, 200, 47,
+			143, 178, 57, 237, 148, 94, 248, 25, 131, 229, 84, 150, 249, 30,
+			203, 190, 112, 125, 103, 38, 7, 218, 220, 137, 149, 11, 37, 53,
+			187, 146, 42, 81, 122, 236, 250, 78, 157, 10, 153, 215, 216, 49,
+			213, 11, 142, 30, 71, 101, 212, 153, 202, 170, 57, 230, 2, 51,
+			37, 192, 184, 179, 227, 58, 220, 143, 221, 184, 63, 163, 209, 44,
+			79, 39, 95, 106, 242, 3, 22, 151, 232, 20, 132, 131, 226, 83,
+			162, 120, 242, 37, 41, 254, 22, 123, 195, 14, 57, 65, 123, 39,
+			118, 59, 156, 0, 108, 212, 143, 171, 204, 166, 219, 225, 230, 123,
+			236, 244, 190, 229, 185, 142, 27, 247, 119, 156, 94, 72, 165, 9,
+			206, 19, 245, 83, 234, 195, 154, 204, 55, 243, 44, 103, 245, 28,
+			151, 251, 54, 159, 153, 0, 3, 87, 75, 165, 241, 155, 196, 211,
+			104, 102, 82, 124, 83, 105, 211, 100, 217, 216, 218, 141, 102, 166,
+			41, 159, 126, 23, 110, 177, 44, 130, 202, 60, 197, 142, 111, 111,
+			60, 222, 216, 124, 186, 177, 243, 184, 182, 177, 118, 42, 99, 94,
+			98, 23, 86, 171, 229, 122, 181, 190, 179, 86, 93, 175, 62, 40,
+			35, 185, 221, 105, 110, 62, 174, 110, 156, 210, 86, 111, 63, 191,
+			249, 163, 32, 230, 163, 255, 164, 128, 172, 49, 203, 124, 169, 177,
+			223, 214, 137, 53, 102, 25, 115, 229, 23, 180, 33, 46, 119, 249,
+			22, 52, 247, 56, 172, 111, 87, 106, 80, 238, 197, 123, 65, 24,
+			149, 142, 96, 117, 183, 145, 223, 104, 43, 134, 98, 192, 24, 186,
+			17, 236, 6, 251, 60, 244, 185, 3, 61, 223, 145, 124, 78, 185,
+			107, 217, 216, 176, 107, 115, 63, 226, 69, 248, 132, 135, 200, 87,
+			192, 74, 105, 9, 153, 18, 43, 38, 154, 212, 66, 118, 176, 231,
+			59, 138, 237, 90, 175, 85, 170, 27, 141, 42, 180, 93, 143, 151,
+			216, 202, 175, 104, 208, 196, 238, 48, 137, 180, 198, 14, 186, 174,
+			228, 108, 0, 97, 176, 208, 237, 151, 118, 221, 248, 46, 3, 171,
+			219, 229, 254, 174, 235, 243, 69, 59, 232, 116, 3, 159, 251, 113,
+			148, 254, 73, 80, 162, 253, 121, 104, 195, 50, 168, 4, 157, 142,
+			27, 223, 133, 246, 237, 229, 91, 252, 253, 91, 119, 86, 110, 47,
+			223, 185, 125, 103, 185, 205, 239, 180, 173, 219, 55, 239, 220, 121,
+			255, 59, 223, 89, 226, 43, 55, 239, 44, 45, 189, 191, 226, 180,
+			86, 150, 25, 131, 10, 9, 14, 209, 93, 8, 57, 114, 109, 14,
+			116, 45, 251, 5, 145, 220, 0, 102, 213, 18, 204, 38, 199, 200,
+			241, 204, 105, 73, 200, 79, 100, 30, 171, 19, 3, 127, 254, 73,
+			141, 8, 121, 246, 66, 102, 89, 203, 255, 91, 26, 52, 144, 20,
+			56, 48, 216, 154, 144, 108, 51, 198, 4, 48, 100, 235, 8, 143,
+			31, 34, 59, 108, 249, 192, 125, 33, 125, 40, 192, 134, 161, 203,
+			5, 49, 30, 211, 144, 106, 128, 17, 39, 236, 198, 17, 32, 117,
+			177, 226, 94, 200, 75, 140, 193, 6, 127, 25, 67, 109, 237, 46,
+			220, 46, 13, 40, 229, 133, 220, 5, 241, 123, 218, 52, 102, 244,
+			55, 216, 49, 150, 205, 102, 166, 51, 166, 49, 115, 236, 56, 59,
+			206, 38, 48, 161, 13, 165, 116, 145, 250, 121, 29, 75, 234, 25,
+			211, 120, 83, 191, 144, 255, 105, 29, 212, 94, 69, 84, 178, 18,
+			58, 79, 227, 198, 97, 144, 220, 66, 167, 138, 156, 114, 45, 65,
+			20, 11, 102, 101, 241, 187, 31, 88, 221, 238, 130, 235, 124, 52,
+			11, 40, 43, 248, 187, 120, 212, 204, 246, 34, 30, 222, 253, 64,
+			22, 89, 176, 108, 59, 232, 249, 241, 2, 239, 88, 174, 247, 209,
+			44, 147, 37, 113, 134, 53, 31, 90, 65, 188, 7, 182, 21, 73,
+			40, 89, 221, 110, 24, 116, 67, 215, 138, 57, 216, 60, 140, 145,
+			53, 198, 223, 81, 28, 16, 211, 236, 121, 208, 226, 240, 121, 143,
+			135, 136, 126, 115, 251, 174, 5, 141, 198, 250, 60, 67, 161, 132,
+			26, 232, 246, 90, 158, 107, 195, 11, 222, 87, 231, 33, 126, 73,
+			224, 10, 251, 60, 76, 4, 182, 18, 19, 16, 211, 51, 19, 8,
+			149, 156, 74, 105, 166, 241, 230, 180, 169, 82, 134, 105, 188, 121,
+			238, 60, 251, 45, 1, 63, 205, 52, 222, 213, 47, 231, 127, 77,
+			135, 218, 154, 128, 28, 118, 69, 210, 0, 118, 212, 177, 94, 224,
+			65, 142, 35, 25, 90, 203, 230, 30, 15, 185, 130, 95, 167, 231,
+			197, 46, 30, 218, 150, 29, 187, 251, 28, 7, 27, 129, 133, 248,
+			211, 135, 78, 128, 194, 14, 237, 66, 183, 195, 239, 66, 224, 75,
+			89, 3, 91, 247, 249, 1, 27, 180, 27, 21, 9, 111, 176, 68,
+			139, 99, 175, 97, 16, 35, 239, 0, 40, 87, 204, 181, 122, 49,
+			68, 177, 235, 121, 64, 164, 148, 0, 148, 158, 252, 188, 28, 22,
+			120, 110, 155, 35, 137, 198, 217, 224, 184, 5, 106, 186, 190, 227,
+			134, 220, 142, 189, 62, 56, 188, 203, 125, 39, 130, 64, 80, 134,
+			209, 242, 242, 68, 100, 56, 141, 34, 28, 236, 185, 246, 30, 238,
+			136, 149, 155, 123, 37, 104, 4, 48, 216, 228, 2, 149, 34, 132,
+			194, 108, 12, 30, 206, 220, 11, 252, 93, 34, 83, 150, 79, 21,
+			212, 130, 104, 19, 8, 102, 181, 32, 26, 2, 125, 250, 130, 74,
+			25, 166, 241, 110, 254, 18, 219, 166, 245, 208, 77, 99, 65, 191,
+			146, 127, 8, 205, 52, 200, 239, 194, 214, 227, 74, 99, 121, 103,
+			127, 121, 231, 214, 123, 141, 135, 229, 149, 91, 183, 231, 198, 156,
+			191, 69, 24, 62, 228, 231, 147, 1, 232, 19, 216, 238, 148, 74,
+			105, 166, 177, 144, 155, 81, 41, 195, 52, 22, 46, 93, 102, 207,
+			104, 0, 134, 105, 44, 233, 144, 95, 135, 198, 209, 27, 188, 4,
+			181, 120, 54, 181, 187, 17, 62, 132, 209, 68, 110, 71, 89, 136,
+			100, 16, 198, 4, 182, 173, 6, 97, 104, 166, 177, 148, 187, 164,
+			82, 216, 239, 213, 107, 172, 193, 244, 172, 102, 102, 223, 207, 124,
+			169, 229, 31, 200, 61, 221, 70, 154, 115, 176, 151, 192, 158, 82,
+			136, 95, 116, 172, 184, 49, 109, 141, 131, 189, 160, 3, 7, 123,
+			124, 132, 214, 44, 47, 73, 98, 131, 64, 127, 63, 119, 138, 29,
+			103, 217, 172, 134, 132, 241, 59, 250, 125, 131, 58, 215, 136, 101,
+			251, 206, 212, 49, 246, 239, 25, 108, 18, 63, 34, 85, 249, 56,
+			123, 46, 255, 111, 26, 130, 48, 18, 217, 0, 219, 138, 237, 61,
+			8, 60, 71, 45, 60, 209, 22, 39, 240, 103, 99, 216, 179, 246,
+			57, 204, 34, 167, 50, 11, 109, 151, 123, 14, 244, 121, 140, 3,
+			33, 222, 44, 74, 142, 55, 44, 129, 26, 18, 161, 36, 234, 134,
+			28, 241, 219, 138, 96, 246, 136, 83, 122, 150, 240, 220, 15, 14,
+			138, 130, 36, 224, 193, 99, 197, 110, 203, 245, 220, 184, 95, 130,
+			213, 94, 12, 124, 159, 251, 113, 207, 242, 188, 62, 204, 29, 236,
+			113, 31, 44, 36, 42, 150, 253, 130, 144, 28, 251, 234, 117, 29,
+			220, 71, 243, 168, 65, 225, 125, 166, 200, 142, 29, 116, 80, 59,
+			36, 182, 211, 156, 84, 94, 164, 168, 146, 31, 192, 129, 69, 176,
+			37, 25, 0, 201, 86, 188, 199, 59, 243, 37, 5, 20, 6, 62,
+			231, 168, 165, 72, 56, 127, 4, 14, 210, 73, 84, 139, 89, 182,
+			77, 124, 21, 141, 44, 153, 174, 130, 93, 208, 22, 176, 120, 243,
+			67, 88, 2, 43, 98, 112, 4, 0, 100, 249, 18, 99, 39, 216,
+			148, 88, 27, 13, 23, 231, 212, 32, 173, 155, 198, 199, 103, 206,
+			178, 95, 215, 228, 226, 105, 166, 81, 205, 66, 254, 87, 241, 136,
+			87, 219, 127, 8, 254, 146, 108, 69, 146, 48, 125, 111, 97, 192,
+			74, 47, 208, 122, 45, 124, 178, 12, 15, 155, 205, 45, 216, 227,
+			150, 67, 58, 36, 108, 73, 30, 0, 145, 0, 144, 189, 199, 237,
+			23, 4, 235, 144, 227, 9, 96, 39, 186, 173, 132, 232, 8, 122,
+			134, 165, 25, 225, 42, 158, 79, 40, 13, 129, 228, 47, 35, 20,
+			108, 236, 160, 227, 250, 187, 130, 243, 152, 61, 204, 193, 206, 166,
+			102, 142, 40, 92, 205, 94, 26, 164, 117, 211, 168, 94, 189, 198,
+			28, 68, 105, 196, 217, 199, 250, 169, 252, 83, 120, 138, 104, 73,
+			19, 197, 193, 224, 124, 112, 127, 34, 119, 133, 8, 185, 134, 194,
+			25, 143, 96, 47, 56, 0, 55, 57, 2, 145, 36, 151, 160, 193,
+			81, 239, 214, 65, 138, 29, 17, 186, 33, 87, 9, 220, 239, 117,
+			228, 30, 214, 244, 204, 36, 118, 51, 169, 82, 154, 105, 60, 158,
+			58, 166, 82, 134, 105, 60, 62, 113, 146, 237, 211, 120, 52, 211,
+			216, 210, 103, 242, 238, 96, 19, 135, 201, 50, 40, 130, 133, 0,
+			80, 200, 229, 64, 171, 159, 34, 216, 8, 109, 4, 61, 131, 109,
+			117, 92, 120, 193, 238, 46, 194, 10, 161, 26, 135, 150, 77, 71,
+			83, 183, 23, 118, 131, 136, 71, 201, 8, 145, 214, 110, 73, 42,
+			163, 17, 204, 182, 114, 103, 84, 202, 48, 141, 173, 243, 23, 216,
+			247, 105, 132, 186, 105, 108, 235, 144, 223, 148, 35, 140, 251, 112,
+			176, 23, 68, 60, 69, 87, 220, 40, 161, 57, 14, 14, 165, 156,
+			176, 5, 109, 169, 233, 11, 59, 80, 16, 252, 129, 224, 7, 10,
+			201, 56, 144, 228, 110, 75, 154, 175, 17, 201, 221, 158, 190, 164,
+			82, 134, 105, 108, 95, 189, 198, 254, 150, 70, 3, 49, 76, 227,
+			251, 58, 228, 255, 146, 6, 79, 247, 2, 133, 29, 135, 24, 22,
+			218, 117, 182, 229, 163, 158, 208, 222, 131, 195, 184, 2, 174, 24,
+			21, 142, 39, 53, 116, 121, 128, 187, 33, 4, 7, 62, 131, 164,
+			116, 16, 166, 16, 192, 2, 199, 69, 77, 50, 237, 9, 7, 220,
+			161, 179, 19, 55, 179, 32, 49, 200, 218, 129, 219, 65, 117, 116,
+			224, 167, 78, 193, 100, 214, 72, 227, 191, 159, 204, 26, 105, 252,
+			247, 147, 89, 27, 56, 207, 171, 215, 216, 23, 52, 233, 172, 105,
+			88, 122, 62, 223, 129, 167, 72, 180, 6, 189, 29, 12, 225, 4,
+			209, 37, 34, 107, 110, 44, 105, 86, 36, 56, 0, 60, 220, 96,
+			163, 215, 105, 9, 188, 138, 56, 106, 98, 241, 80, 242, 109, 14,
+			188, 27, 216, 123, 48, 183, 237, 187, 47, 1, 15, 247, 40, 182,
+			58, 93, 117, 28, 106, 122, 118, 2, 59, 87, 56, 146, 213, 76,
+			195, 202, 157, 83, 41, 195, 52, 172, 153, 139, 172, 66, 163, 156,
+			48, 13, 71, 191, 154, 191, 13, 15, 131, 3, 58, 222, 135, 225,
+			98, 7, 126, 228, 58, 60, 228, 142, 100, 75, 230, 80, 221, 46,
+			134, 50, 232, 110, 130, 90, 81, 221, 77, 104, 166, 225, 228, 46,
+			170, 148, 97, 26, 206, 229, 43, 236, 111, 8, 84, 152, 52, 141,
+			31, 234, 215, 242, 191, 36, 80, 1, 23, 167, 139, 146, 146, 31,
+			143, 32, 67, 213, 66, 182, 36, 230, 157, 100, 1, 253, 193, 202,
+			74, 36, 157, 227, 165, 221, 210, 8, 122, 206, 23, 193, 130, 194,
+			110, 24, 244, 186, 119, 63, 64, 1, 227, 163, 130, 226, 96, 139,
+			200, 236, 146, 254, 214, 242, 160, 240, 110, 65, 53, 35, 88, 160,
+			14, 183, 252, 8, 10, 101, 31, 213, 197, 86, 200, 67, 234, 24,
+			25, 210, 4, 32, 3, 220, 159, 204, 226, 52, 146, 212, 132, 105,
+			252, 240, 216, 105, 149, 210, 76, 227, 135, 102, 94, 165, 12, 211,
+			248, 225, 149, 171, 236, 127, 16, 211, 159, 50, 141, 207, 245, 107,
+			249, 95, 199, 233, 91, 241, 128, 228, 70, 123, 65, 207, 115, 192,
+			178, 109, 222, 29, 5, 197, 186, 212, 196, 39, 133, 231, 164, 18,
+			90, 28, 173, 50, 91, 65, 199, 229, 81, 17, 4, 96, 228, 151,
+			187, 130, 231, 47, 204, 75, 241, 241, 200, 190, 224, 137, 213, 7,
+			203, 139, 2, 210, 160, 227, 117, 197, 97, 104, 73, 142, 145, 37,
+			240, 242, 188, 100, 22, 3, 248, 76, 101, 113, 158, 73, 106, 194,
+			52, 62, 79, 224, 51, 165, 153, 198, 231, 9, 124, 166, 12, 211,
+			248, 252, 202, 85, 246, 255, 232, 4, 159, 156, 105, 124, 161, 95,
+			202, 255, 83, 29, 202, 97, 203, 141, 67, 188, 203, 121, 193, 251,
+			119, 247, 45, 175, 199, 161, 107, 185, 97, 4, 188, 211, 226, 14,
+			30, 203, 174, 31, 7, 41, 116, 109, 17, 125, 227, 251, 60, 76,
+			145, 24, 55, 46, 49, 168, 4, 254, 62, 239, 131, 237, 134, 118,
+			175, 35, 244, 101, 184, 165, 14, 246, 210, 4, 25, 137, 15, 106,
+			59, 80, 100, 103, 208, 80, 192, 38, 144, 37, 176, 82, 133, 241,
+			86, 65, 226, 70, 135, 78, 18, 203, 17, 247, 67, 150, 167, 200,
+			235, 23, 68, 62, 24, 56, 220, 118, 81, 128, 143, 74, 176, 229,
+			113, 43, 34, 250, 5, 252, 101, 28, 242, 14, 247, 250, 96, 91,
+			33, 111, 247, 60, 175, 95, 132, 192, 247, 112, 14, 220, 23, 170,
+			199, 192, 143, 195, 192, 19, 34, 23, 238, 194, 136, 41, 186, 172,
+			40, 95, 224, 131, 231, 250, 47, 232, 28, 70, 116, 221, 237, 89,
+			161, 229, 199, 92, 201, 133, 106, 18, 174, 191, 31, 120, 251, 74,
+			205, 128, 16, 112, 16, 25, 56, 160, 122, 37, 89, 181, 92, 22,
+			161, 159, 164, 38, 76, 227, 139, 100, 213, 114, 154, 105, 124, 97,
+			158, 87, 41, 195, 52, 190, 184, 152, 79, 116, 128, 127, 251, 34,
+			251, 206, 88, 85, 11, 65, 75, 233, 91, 186, 238, 98, 199, 178,
+			247, 92, 159, 239, 80, 190, 84, 251, 29, 75, 21, 42, 252, 53,
+			141, 157, 122, 34, 10, 17, 171, 178, 26, 56, 125, 243, 77, 118,
+			92, 85, 108, 127, 238, 248, 82, 235, 117, 76, 230, 221, 255, 220,
+			33, 237, 160, 27, 69, 61, 238, 236, 180, 250, 74, 59, 40, 50,
+			86, 251, 169, 143, 86, 76, 10, 171, 172, 250, 88, 142, 81, 25,
+			165, 68, 36, 82, 6, 102, 235, 73, 218, 60, 195, 38, 108, 11,
+			245, 113, 19, 164, 143, 203, 218, 86, 205, 49, 47, 176, 41, 20,
+			117, 119, 34, 159, 244, 105, 199, 235, 147, 152, 108, 248, 143, 178,
+			185, 201, 83, 83, 133, 23, 236, 108, 122, 2, 85, 169, 97, 48,
+			175, 48, 70, 19, 221, 105, 5, 142, 80, 220, 29, 175, 79, 199,
+			201, 28, 207, 177, 73, 169, 185, 20, 163, 159, 120, 65, 42, 203,
+			43, 140, 133, 145, 37, 165, 13, 26, 251, 241, 250, 116, 24, 89,
+			66, 85, 249, 238, 7, 195, 208, 194, 171, 221, 180, 150, 172, 249,
+			108, 171, 122, 42, 99, 158, 103, 38, 234, 169, 118, 158, 148, 43,
+			15, 107, 27, 85, 169, 32, 211, 87, 87, 158, 47, 125, 157, 85,
+			187, 151, 74, 63, 250, 245, 115, 168, 28, 203, 102, 30, 105, 236,
+			47, 106, 164, 28, 203, 142, 81, 142, 221, 254, 49, 86, 142, 73,
+			205, 210, 100, 230, 172, 212, 44, 229, 50, 15, 148, 102, 9, 127,
+			254, 81, 141, 233, 19, 25, 51, 123, 42, 115, 70, 203, 127, 69,
+			19, 65, 110, 147, 120, 95, 137, 114, 138, 203, 31, 16, 4, 154,
+			143, 56, 51, 58, 174, 79, 162, 209, 150, 21, 201, 75, 199, 39,
+			174, 31, 167, 151, 137, 246, 107, 205, 71, 234, 58, 148, 143, 181,
+			234, 18, 52, 119, 97, 89, 72, 118, 19, 40, 189, 157, 154, 56,
+			199, 110, 176, 236, 68, 6, 217, 224, 211, 250, 197, 194, 59, 72,
+			69, 18, 62, 70, 136, 100, 110, 132, 87, 184, 224, 250, 110, 44,
+			37, 91, 218, 203, 88, 73, 195, 90, 39, 84, 74, 55, 141, 211,
+			23, 102, 216, 109, 106, 80, 51, 13, 83, 191, 88, 152, 23, 108,
+			23, 143, 210, 154, 175, 113, 136, 172, 218, 68, 206, 211, 212, 207,
+			170, 148, 110, 26, 230, 133, 25, 246, 143, 197, 253, 74, 246, 114,
+			230, 187, 90, 254, 31, 233, 67, 45, 224, 94, 6, 135, 71, 118,
+			232, 182, 136, 40, 197, 60, 244, 45, 15, 207, 225, 158, 141, 106,
+			0, 69, 222, 134, 160, 172, 244, 30, 146, 123, 146, 194, 91, 183,
+			71, 178, 77, 74, 138, 137, 18, 173, 28, 110, 48, 121, 162, 162,
+			236, 218, 194, 186, 1, 120, 86, 184, 203, 75, 140, 110, 77, 8,
+			83, 66, 110, 69, 129, 15, 7, 130, 32, 247, 134, 88, 41, 28,
+			93, 20, 115, 203, 193, 33, 141, 94, 139, 52, 85, 41, 6, 115,
+			126, 64, 66, 34, 157, 1, 29, 215, 14, 3, 193, 167, 65, 55,
+			148, 100, 127, 94, 72, 73, 86, 20, 245, 58, 35, 58, 50, 82,
+			64, 128, 79, 44, 94, 196, 72, 108, 142, 58, 150, 231, 185, 209,
+			30, 244, 92, 63, 190, 125, 147, 96, 180, 139, 210, 230, 28, 174,
+			108, 104, 249, 78, 208, 129, 150, 23, 180, 162, 249, 148, 146, 241,
+			114, 110, 38, 81, 50, 94, 73, 43, 25, 175, 12, 41, 25, 83,
+			41, 93, 124, 251, 19, 154, 82, 50, 190, 165, 231, 243, 63, 163,
+			169, 229, 26, 176, 90, 3, 246, 0, 108, 58, 69, 35, 152, 83,
+			203, 115, 255, 167, 214, 54, 230, 165, 170, 209, 141, 232, 72, 179,
+			236, 88, 169, 153, 45, 210, 14, 7, 62, 108, 200, 139, 123, 107,
+			104, 246, 132, 190, 86, 4, 22, 180, 172, 8, 53, 213, 65, 40,
+			143, 36, 185, 232, 82, 157, 55, 97, 26, 111, 73, 126, 91, 224,
+			241, 91, 211, 231, 84, 202, 48, 141, 183, 102, 46, 178, 63, 163,
+			41, 85, 223, 188, 126, 33, 255, 199, 53, 117, 120, 131, 84, 101,
+			2, 241, 134, 175, 80, 149, 18, 135, 78, 202, 182, 190, 210, 6,
+			74, 150, 66, 169, 25, 82, 34, 113, 98, 29, 66, 39, 72, 136,
+			132, 202, 245, 25, 20, 240, 216, 95, 160, 106, 11, 82, 69, 80,
+			0, 226, 67, 147, 185, 160, 228, 54, 159, 204, 5, 247, 207, 124,
+			162, 182, 212, 12, 211, 152, 63, 119, 158, 109, 40, 45, 89, 81,
+			191, 144, 47, 195, 48, 131, 15, 3, 230, 91, 176, 9, 169, 213,
+			65, 177, 130, 70, 228, 148, 160, 206, 63, 239, 185, 33, 146, 87,
+			217, 58, 202, 106, 197, 164, 103, 148, 213, 138, 73, 207, 186, 97,
+			26, 197, 115, 231, 89, 168, 212, 99, 203, 250, 133, 60, 31, 35,
+			121, 188, 82, 48, 64, 52, 216, 142, 72, 134, 130, 27, 183, 151,
+			150, 80, 216, 142, 199, 74, 188, 135, 71, 135, 50, 213, 114, 50,
+			58, 148, 169, 150, 167, 79, 171, 148, 97, 26, 203, 231, 206, 43,
+			76, 205, 154, 198, 109, 221, 68, 76, 173, 209, 214, 180, 160, 82,
+			22, 11, 43, 230, 158, 144, 142, 67, 168, 22, 7, 208, 177, 94,
+			240, 145, 149, 111, 238, 241, 136, 67, 109, 141, 204, 122, 196, 197,
+			46, 23, 199, 198, 16, 85, 15, 252, 182, 187, 43, 212, 215, 61,
+			223, 253, 188, 199, 119, 92, 71, 232, 193, 148, 16, 148, 33, 153,
+			235, 182, 148, 185, 50, 36, 115, 221, 206, 189, 161, 82, 134, 105,
+			220, 62, 117, 154, 253, 67, 228, 114, 51, 184, 26, 31, 233, 103,
+			243, 127, 87, 135, 70, 154, 6, 140, 146, 191, 175, 57, 7, 226,
+			80, 136, 200, 72, 182, 4, 226, 96, 151, 163, 174, 75, 14, 22,
+			245, 83, 180, 159, 219, 98, 57, 82, 237, 22, 19, 214, 177, 197,
+			89, 210, 137, 208, 253, 32, 73, 75, 15, 33, 228, 251, 129, 208,
+			69, 195, 92, 171, 15, 86, 68, 250, 137, 33, 64, 29, 236, 81,
+			191, 98, 35, 239, 186, 251, 220, 31, 106, 129, 182, 10, 84, 234,
+			235, 243, 136, 163, 73, 107, 212, 157, 88, 129, 160, 139, 57, 150,
+			87, 132, 78, 128, 55, 225, 182, 229, 121, 72, 254, 112, 132, 33,
+			114, 203, 129, 15, 252, 101, 215, 13, 135, 106, 34, 223, 156, 172,
+			3, 10, 163, 31, 37, 235, 128, 194, 232, 71, 185, 147, 42, 101,
+			152, 198, 71, 230, 25, 246, 191, 225, 229, 145, 102, 102, 239, 103,
+			30, 105, 249, 223, 212, 198, 158, 115, 184, 177, 15, 4, 102, 37,
+			234, 1, 169, 184, 79, 157, 144, 8, 62, 52, 171, 34, 25, 22,
+			237, 0, 136, 164, 169, 21, 20, 192, 153, 179, 218, 49, 15, 101,
+			93, 238, 35, 123, 131, 166, 107, 8, 189, 150, 21, 241, 219, 55,
+			129, 56, 114, 43, 116, 32, 180, 14, 68, 9, 215, 223, 157, 151,
+			236, 0, 222, 62, 36, 128, 158, 115, 125, 219, 235, 57, 169, 186,
+			73, 113, 28, 112, 79, 110, 193, 63, 112, 107, 105, 9, 90, 253,
+			152, 71, 36, 212, 167, 116, 196, 247, 115, 151, 217, 187, 74, 161,
+			246, 64, 191, 80, 184, 114, 212, 129, 143, 199, 181, 18, 6, 240,
+			198, 229, 129, 4, 170, 70, 100, 248, 65, 206, 84, 41, 195, 52,
+			30, 156, 59, 207, 62, 86, 106, 177, 135, 250, 217, 194, 13, 112,
+			229, 6, 29, 66, 144, 110, 232, 238, 35, 46, 13, 93, 194, 168,
+			27, 9, 217, 30, 146, 201, 135, 146, 28, 8, 5, 215, 195, 233,
+			147, 41, 5, 215, 67, 243, 12, 155, 83, 10, 174, 154, 126, 161,
+			112, 41, 165, 169, 15, 218, 48, 59, 96, 179, 103, 85, 155, 184,
+			229, 106, 201, 248, 113, 144, 181, 100, 252, 72, 243, 106, 231, 206,
+			39, 194, 204, 95, 253, 128, 221, 249, 90, 194, 140, 229, 116, 92,
+			127, 113, 127, 89, 252, 144, 210, 204, 233, 84, 169, 18, 125, 200,
+			191, 202, 64, 52, 255, 237, 216, 78, 228, 127, 100, 241, 171, 176,
+			192, 78, 214, 58, 221, 32, 140, 185, 83, 33, 58, 23, 161, 72,
+			20, 242, 125, 98, 95, 164, 172, 149, 164, 11, 93, 150, 31, 195,
+			185, 34, 77, 231, 81, 108, 126, 160, 132, 28, 52, 78, 165, 186,
+			39, 86, 174, 148, 210, 32, 73, 215, 66, 113, 69, 202, 64, 248,
+			211, 60, 203, 38, 40, 33, 5, 56, 145, 40, 252, 134, 206, 46,
+			141, 237, 82, 88, 199, 96, 45, 58, 130, 168, 187, 92, 93, 36,
+			204, 247, 216, 105, 169, 251, 119, 227, 254, 142, 224, 245, 100, 187,
+			167, 6, 31, 234, 148, 111, 158, 103, 147, 136, 66, 92, 152, 141,
+			228, 234, 50, 133, 86, 25, 126, 224, 239, 16, 197, 225, 194, 42,
+			35, 87, 103, 126, 224, 87, 69, 142, 42, 128, 164, 241, 5, 23,
+			98, 162, 40, 128, 228, 237, 5, 31, 103, 152, 50, 57, 198, 48,
+			5, 216, 113, 164, 180, 59, 182, 181, 131, 58, 43, 146, 43, 167,
+			235, 12, 243, 42, 22, 242, 79, 230, 19, 102, 34, 14, 238, 12,
+			173, 224, 204, 89, 208, 230, 142, 189, 2, 192, 184, 133, 31, 102,
+			234, 167, 176, 234, 80, 254, 241, 244, 58, 21, 110, 177, 43, 18,
+			192, 35, 70, 55, 106, 89, 147, 133, 209, 210, 11, 243, 7, 117,
+			118, 245, 168, 122, 63, 6, 107, 115, 139, 229, 212, 245, 62, 45,
+			204, 177, 149, 139, 3, 27, 156, 209, 1, 39, 69, 205, 18, 203,
+			41, 245, 61, 173, 213, 177, 21, 243, 176, 233, 78, 61, 41, 179,
+			242, 203, 19, 108, 162, 140, 251, 220, 124, 162, 118, 82, 165, 172,
+			118, 210, 249, 210, 168, 240, 64, 54, 87, 249, 194, 208, 146, 17,
+			153, 40, 141, 238, 194, 109, 118, 65, 100, 13, 198, 250, 109, 52,
+			251, 156, 93, 22, 89, 91, 97, 240, 67, 110, 199, 234, 114, 224,
+			219, 104, 123, 135, 189, 57, 212, 246, 230, 129, 207, 157, 178, 224,
+			194, 163, 111, 163, 131, 103, 236, 146, 200, 218, 172, 173, 85, 238,
+			115, 135, 135, 223, 26, 92, 98, 118, 70, 34, 115, 122, 167, 152,
+			11, 227, 170, 142, 163, 70, 180, 83, 242, 165, 175, 91, 92, 110,
+			144, 175, 216, 121, 249, 121, 4, 35, 205, 165, 163, 91, 26, 191,
+			75, 243, 203, 223, 160, 134, 232, 126, 245, 214, 243, 27, 223, 232,
+			168, 187, 71, 203, 242, 232, 231, 75, 3, 229, 207, 228, 63, 159,
+			202, 159, 41, 86, 103, 250, 36, 106, 83, 50, 83, 249, 7, 64,
+			59, 88, 41, 76, 177, 127, 226, 78, 90, 253, 36, 139, 38, 142,
+			54, 234, 22, 153, 149, 147, 56, 225, 163, 53, 145, 52, 22, 66,
+			156, 101, 236, 1, 203, 78, 146, 60, 125, 70, 159, 200, 223, 133,
+			17, 114, 64, 18, 72, 98, 94, 132, 146, 69, 136, 42, 134, 217,
+			244, 178, 217, 237, 93, 188, 19, 221, 160, 134, 52, 211, 56, 175,
+			79, 228, 203, 178, 161, 193, 42, 190, 166, 193, 20, 123, 32, 219,
+			251, 41, 106, 79, 55, 141, 139, 250, 68, 126, 13, 134, 118, 233,
+			8, 5, 56, 170, 209, 174, 40, 29, 169, 38, 29, 106, 210, 48,
+			141, 203, 250, 68, 254, 41, 188, 118, 227, 191, 166, 221, 157, 0,
+			137, 197, 142, 148, 217, 147, 94, 158, 82, 47, 89, 211, 184, 166,
+			79, 228, 31, 193, 43, 118, 255, 81, 237, 7, 174, 99, 239, 180,
+			147, 210, 170, 225, 223, 202, 82, 203, 100, 179, 49, 145, 255, 181,
+			44, 140, 217, 166, 168, 188, 15, 28, 126, 152, 165, 71, 182, 159,
+			20, 6, 104, 186, 225, 146, 140, 144, 136, 193, 181, 120, 240, 105,
+			32, 253, 162, 124, 46, 149, 15, 173, 62, 88, 67, 156, 176, 148,
+			251, 134, 56, 101, 82, 209, 75, 249, 134, 62, 204, 70, 105, 201,
+			7, 53, 85, 36, 187, 165, 164, 178, 40, 182, 226, 94, 36, 135,
+			16, 11, 75, 182, 128, 196, 48, 188, 39, 234, 244, 240, 70, 205,
+			199, 43, 93, 233, 155, 209, 66, 195, 163, 65, 199, 137, 81, 27,
+			53, 67, 70, 11, 221, 32, 138, 220, 150, 199, 229, 85, 27, 222,
+			167, 139, 17, 161, 108, 120, 168, 103, 50, 213, 32, 149, 164, 210,
+			18, 88, 30, 46, 65, 159, 129, 60, 165, 73, 164, 41, 15, 239,
+			164, 193, 53, 27, 170, 21, 208, 224, 32, 70, 75, 152, 86, 111,
+			87, 200, 244, 145, 116, 18, 81, 118, 18, 80, 23, 230, 202, 119,
+			209, 44, 249, 21, 164, 149, 68, 139, 129, 53, 70, 212, 235, 226,
+			94, 228, 14, 217, 101, 148, 176, 242, 110, 216, 181, 75, 53, 193,
+			111, 148, 195, 93, 114, 91, 1, 30, 134, 65, 72, 117, 123, 254,
+			160, 14, 181, 115, 168, 166, 212, 95, 14, 170, 196, 161, 229, 71,
+			110, 210, 12, 14, 247, 55, 5, 154, 77, 154, 70, 89, 159, 200,
+			255, 55, 89, 24, 79, 145, 83, 152, 54, 216, 188, 63, 78, 200,
+			246, 123, 136, 86, 86, 28, 163, 245, 60, 17, 85, 1, 6, 170,
+			142, 2, 220, 0, 165, 6, 150, 107, 52, 139, 61, 43, 130, 22,
+			231, 62, 75, 172, 232, 36, 174, 253, 94, 161, 216, 17, 39, 232,
+			143, 9, 150, 125, 143, 180, 238, 198, 253, 204, 84, 254, 9, 140,
+			112, 54, 136, 47, 33, 77, 72, 208, 30, 241, 249, 131, 40, 232,
+			240, 120, 207, 245, 119, 63, 74, 232, 39, 249, 21, 144, 225, 96,
+			212, 179, 109, 30, 33, 44, 126, 37, 81, 17, 63, 214, 115, 249,
+			63, 47, 140, 142, 148, 52, 168, 144, 201, 150, 45, 208, 33, 140,
+			122, 28, 63, 56, 72, 28, 194, 172, 216, 34, 227, 57, 92, 24,
+			178, 171, 75, 57, 117, 185, 114, 168, 73, 139, 69, 164, 31, 170,
+			57, 225, 100, 72, 247, 246, 72, 48, 249, 190, 27, 244, 34, 175,
+			15, 228, 243, 200, 198, 215, 177, 98, 120, 88, 45, 175, 145, 54,
+			41, 178, 8, 111, 191, 32, 101, 143, 81, 207, 76, 229, 131, 241,
+			20, 131, 120, 55, 132, 19, 225, 92, 208, 30, 87, 10, 234, 91,
+			21, 66, 30, 185, 19, 228, 29, 121, 52, 114, 50, 32, 18, 4,
+			29, 46, 87, 145, 125, 169, 84, 45, 159, 232, 87, 243, 62, 1,
+			79, 121, 115, 72, 21, 51, 105, 133, 104, 239, 33, 75, 196, 160,
+			210, 11, 67, 238, 163, 33, 39, 170, 181, 224, 240, 197, 29, 142,
+			51, 193, 153, 196, 88, 77, 92, 220, 19, 192, 121, 219, 234, 121,
+			120, 5, 85, 81, 26, 153, 103, 122, 46, 127, 59, 117, 139, 130,
+			211, 44, 65, 245, 165, 101, 199, 116, 145, 143, 67, 72, 155, 141,
+			38, 162, 97, 137, 177, 103, 76, 207, 234, 166, 241, 47, 101, 166,
+			242, 27, 175, 164, 183, 9, 154, 161, 169, 72, 143, 127, 13, 48,
+			254, 21, 196, 45, 29, 161, 99, 235, 147, 249, 63, 167, 65, 51,
+			236, 113, 181, 219, 19, 85, 115, 66, 235, 202, 35, 121, 88, 114,
+			200, 10, 27, 191, 216, 65, 136, 118, 176, 69, 180, 253, 217, 179,
+			34, 188, 0, 146, 135, 15, 244, 121, 12, 201, 205, 183, 29, 114,
+			98, 123, 208, 29, 209, 141, 137, 98, 182, 122, 174, 23, 11, 75,
+			53, 73, 152, 210, 218, 203, 121, 44, 131, 205, 73, 97, 191, 196,
+			216, 207, 137, 241, 107, 166, 225, 234, 185, 252, 31, 210, 224, 97,
+			175, 67, 138, 74, 203, 177, 240, 212, 140, 122, 157, 14, 90, 48,
+			40, 91, 3, 53, 122, 41, 227, 226, 106, 55, 168, 136, 251, 133,
+			36, 223, 104, 107, 167, 54, 21, 233, 151, 7, 166, 149, 226, 150,
+			76, 232, 213, 9, 51, 220, 54, 204, 82, 59, 179, 100, 171, 216,
+			182, 188, 8, 177, 253, 255, 206, 210, 160, 116, 211, 232, 235, 147,
+			249, 127, 146, 29, 3, 212, 1, 192, 112, 218, 242, 252, 80, 199,
+			9, 226, 67, 116, 164, 189, 210, 24, 141, 126, 66, 225, 17, 171,
+			228, 37, 36, 3, 11, 118, 173, 176, 69, 198, 180, 27, 232, 101,
+			41, 72, 131, 172, 40, 249, 116, 185, 86, 94, 95, 30, 86, 69,
+			64, 43, 104, 9, 29, 152, 163, 229, 101, 68, 234, 213, 18, 6,
+			33, 174, 130, 90, 2, 162, 245, 181, 54, 204, 138, 234, 8, 7,
+			1, 134, 98, 26, 128, 45, 238, 5, 7, 228, 7, 58, 135, 151,
+			116, 86, 31, 111, 73, 231, 81, 78, 24, 140, 113, 232, 142, 81,
+			78, 171, 135, 70, 238, 169, 147, 51, 146, 28, 63, 1, 65, 122,
+			161, 86, 188, 160, 231, 192, 150, 103, 197, 200, 87, 41, 3, 84,
+			164, 61, 104, 57, 21, 91, 180, 143, 135, 173, 189, 17, 90, 133,
+			192, 115, 10, 3, 188, 141, 70, 173, 85, 3, 4, 43, 158, 186,
+			76, 218, 188, 18, 164, 112, 159, 57, 242, 0, 20, 234, 114, 213,
+			38, 50, 114, 65, 27, 248, 75, 55, 138, 209, 195, 230, 21, 128,
+			81, 22, 77, 105, 76, 75, 28, 205, 8, 175, 172, 8, 238, 63,
+			171, 21, 213, 41, 221, 103, 131, 97, 133, 226, 60, 238, 88, 158,
+			107, 75, 90, 76, 243, 68, 204, 76, 239, 8, 195, 52, 254, 128,
+			62, 153, 255, 67, 227, 118, 244, 120, 228, 35, 36, 18, 5, 14,
+			239, 90, 156, 205, 83, 186, 3, 129, 2, 170, 219, 10, 120, 24,
+			180, 221, 151, 201, 133, 136, 36, 121, 48, 75, 147, 156, 149, 4,
+			40, 178, 218, 36, 148, 253, 47, 98, 80, 89, 51, 251, 111, 104,
+			250, 100, 254, 55, 190, 233, 168, 146, 66, 135, 8, 128, 188, 249,
+			76, 248, 25, 60, 157, 45, 121, 67, 145, 156, 231, 113, 128, 184,
+			230, 7, 126, 82, 17, 113, 173, 47, 205, 164, 5, 217, 229, 126,
+			204, 208, 17, 185, 139, 244, 134, 71, 191, 203, 9, 63, 166, 249,
+			78, 152, 217, 63, 170, 233, 185, 252, 135, 201, 93, 89, 154, 13,
+			84, 119, 61, 56, 241, 212, 133, 18, 157, 191, 86, 151, 252, 26,
+			91, 232, 74, 196, 254, 136, 128, 222, 164, 153, 253, 99, 216, 218,
+			23, 169, 251, 220, 145, 187, 55, 117, 183, 148, 130, 24, 30, 169,
+			22, 246, 20, 140, 105, 25, 47, 55, 132, 89, 18, 209, 220, 89,
+			186, 200, 82, 246, 229, 18, 53, 83, 231, 21, 99, 63, 75, 67,
+			201, 101, 204, 236, 207, 105, 250, 84, 254, 95, 65, 56, 74, 198,
+			89, 1, 155, 238, 254, 231, 198, 158, 101, 202, 42, 12, 186, 86,
+			104, 117, 120, 204, 195, 249, 18, 144, 234, 9, 220, 54, 147, 245,
+			113, 245, 59, 150, 135, 187, 89, 46, 191, 157, 178, 35, 112, 248,
+			224, 174, 132, 46, 156, 16, 50, 83, 102, 246, 231, 181, 44, 176,
+			167, 12, 239, 249, 178, 127, 66, 203, 76, 229, 31, 31, 201, 54,
+			30, 197, 106, 140, 20, 76, 31, 147, 215, 88, 54, 107, 232, 25,
+			51, 251, 239, 227, 2, 156, 30, 61, 199, 25, 251, 148, 233, 217,
+			172, 153, 253, 15, 176, 231, 205, 35, 123, 126, 237, 49, 61, 102,
+			8, 37, 198, 122, 44, 155, 205, 98, 239, 255, 49, 110, 158, 221,
+			111, 241, 140, 150, 7, 199, 248, 13, 255, 243, 184, 214, 89, 93,
+			51, 179, 191, 160, 253, 248, 28, 174, 255, 4, 15, 215, 172, 174,
+			155, 217, 95, 70, 104, 252, 163, 223, 87, 167, 235, 79, 142, 211,
+			31, 183, 227, 84, 110, 2, 195, 204, 254, 53, 237, 199, 231, 60,
+			141, 105, 80, 89, 51, 251, 55, 52, 61, 159, 111, 75, 42, 156,
+			186, 69, 22, 253, 170, 27, 21, 236, 13, 45, 139, 91, 92, 58,
+			201, 187, 237, 31, 133, 216, 146, 149, 79, 86, 159, 48, 179, 127,
+			75, 211, 207, 228, 255, 109, 237, 168, 126, 213, 17, 144, 250, 48,
+			155, 140, 101, 140, 87, 217, 236, 252, 183, 51, 194, 228, 2, 249,
+			87, 151, 217, 181, 209, 43, 223, 196, 134, 231, 168, 184, 64, 247,
+			216, 116, 98, 87, 102, 206, 176, 41, 105, 117, 67, 151, 154, 70,
+			93, 37, 241, 66, 205, 183, 252, 32, 162, 171, 204, 137, 186, 72,
+			172, 254, 180, 54, 62, 152, 208, 137, 164, 73, 21, 80, 104, 229,
+			107, 6, 20, 74, 198, 251, 141, 130, 10, 253, 205, 69, 54, 101,
+			78, 92, 205, 252, 156, 166, 253, 36, 170, 208, 79, 162, 10, 253,
+			36, 170, 208, 79, 162, 10, 253, 36, 170, 208, 79, 162, 10, 253,
+			51, 140, 42, 148, 4, 251, 121, 43, 115, 77, 102, 190, 157, 169,
+			41, 243, 126, 252, 169, 162, 10, 37, 161, 134, 174, 39, 161, 134,
+			222, 201, 44, 170, 80, 67, 248, 83, 69, 21, 74, 66, 13, 205,
+			38, 161, 134, 230, 6, 161, 134, 240, 231, 127, 127, 133, 244, 239,
+			147, 127, 88, 195, 163, 47, 255, 95, 95, 129, 50, 36, 71, 239,
+			192, 62, 16, 239, 17, 187, 129, 59, 136, 23, 144, 150, 255, 73,
+			164, 246, 251, 100, 237, 11, 95, 96, 148, 0, 114, 9, 181, 45,
+			143, 204, 32, 185, 239, 88, 97, 49, 49, 31, 36, 51, 67, 186,
+			41, 77, 155, 232, 34, 211, 215, 14, 45, 123, 112, 98, 168, 15,
+			120, 32, 32, 171, 64, 105, 60, 49, 3, 143, 206, 21, 212, 31,
+			227, 177, 129, 13, 145, 2, 215, 179, 40, 198, 65, 28, 160, 39,
+			164, 112, 51, 180, 98, 216, 110, 86, 160, 227, 58, 62, 81, 244,
+			192, 103, 240, 200, 242, 123, 120, 12, 44, 23, 97, 249, 206, 251,
+			75, 69, 69, 168, 187, 97, 224, 241, 110, 236, 218, 240, 32, 228,
+			187, 65, 232, 90, 126, 50, 122, 225, 58, 134, 118, 226, 92, 218,
+			19, 179, 113, 165, 90, 150, 253, 226, 192, 10, 177, 68, 0, 125,
+			110, 133, 24, 84, 1, 9, 32, 30, 249, 29, 215, 239, 161, 85,
+			35, 30, 178, 183, 151, 146, 249, 145, 145, 35, 172, 115, 171, 59,
+			152, 114, 200, 161, 16, 117, 184, 21, 114, 20, 14, 80, 55, 110,
+			197, 224, 7, 224, 113, 171, 203, 100, 49, 136, 73, 81, 139, 98,
+			149, 112, 245, 70, 13, 78, 226, 204, 45, 15, 116, 97, 170, 105,
+			193, 167, 43, 55, 23, 246, 48, 168, 147, 231, 250, 220, 10, 25,
+			80, 235, 63, 152, 123, 53, 211, 129, 235, 185, 72, 37, 147, 16,
+			14, 33, 94, 107, 144, 116, 133, 122, 143, 165, 165, 165, 229, 5,
+			250, 215, 92, 90, 186, 75, 255, 158, 227, 212, 239, 220, 185, 115,
+			103, 97, 121, 101, 225, 198, 114, 115, 229, 198, 221, 91, 119, 238,
+			222, 186, 83, 186, 163, 254, 158, 151, 96, 181, 207, 6, 190, 217,
+			100, 32, 42, 166, 72, 173, 23, 225, 128, 3, 247, 35, 20, 67,
+			41, 247, 64, 132, 179, 32, 35, 126, 84, 209, 4, 18, 89, 130,
+			14, 124, 90, 191, 95, 97, 112, 227, 198, 141, 59, 131, 185, 28,
+			28, 28, 148, 92, 30, 183, 201, 150, 48, 108, 219, 139, 97, 219,
+			198, 18, 165, 248, 101, 60, 143, 28, 27, 151, 14, 129, 17, 78,
+			234, 45, 188, 75, 192, 184, 86, 17, 99, 234, 39, 44, 223, 69,
+			15, 128, 110, 47, 230, 169, 189, 64, 154, 158, 173, 205, 70, 237,
+			123, 240, 25, 66, 102, 110, 254, 179, 146, 100, 121, 6, 133, 18,
+			230, 83, 134, 158, 74, 210, 165, 136, 199, 59, 114, 129, 231, 48,
+			119, 110, 99, 123, 125, 125, 126, 126, 108, 57, 98, 141, 231, 150,
+			230, 239, 165, 198, 180, 242, 186, 49, 237, 242, 24, 219, 13, 218,
+			142, 213, 79, 141, 77, 200, 100, 212, 193, 190, 229, 65, 188, 47,
+			123, 28, 42, 254, 78, 188, 95, 4, 26, 208, 189, 31, 117, 74,
+			251, 165, 120, 31, 39, 248, 170, 25, 137, 66, 189, 136, 219, 240,
+			46, 44, 47, 45, 13, 207, 240, 198, 145, 51, 124, 234, 250, 55,
+			86, 224, 179, 7, 60, 110, 244, 163, 152, 119, 240, 115, 57, 186,
+			239, 122, 188, 57, 188, 16, 247, 107, 235, 213, 102, 237, 73, 21,
+			218, 177, 28, 198, 81, 117, 222, 105, 199, 106, 164, 219, 181, 141,
+			230, 237, 155, 16, 187, 104, 30, 240, 33, 204, 205, 205, 137, 156,
+			249, 118, 92, 114, 14, 30, 186, 187, 123, 107, 86, 76, 181, 230,
+			225, 131, 15, 224, 198, 202, 60, 252, 203, 64, 223, 214, 131, 3,
+			245, 73, 193, 109, 113, 17, 202, 240, 212, 245, 157, 224, 32, 162,
+			38, 113, 179, 44, 47, 45, 165, 104, 88, 84, 74, 10, 8, 42,
+			181, 124, 251, 240, 54, 74, 90, 195, 234, 203, 183, 111, 222, 188,
+			249, 62, 58, 39, 36, 52, 162, 197, 219, 24, 167, 134, 188, 44,
+			100, 43, 119, 222, 95, 26, 109, 165, 244, 163, 45, 230, 156, 152,
+			63, 204, 205, 225, 12, 34, 88, 164, 197, 194, 127, 243, 176, 144,
+			30, 206, 107, 48, 24, 219, 185, 177, 50, 104, 231, 122, 170, 29,
+			66, 128, 249, 33, 4, 184, 121, 36, 2, 60, 178, 246, 45, 248,
+			76, 44, 126, 201, 22, 215, 140, 88, 228, 137, 139, 62, 71, 41,
+			4, 64, 106, 10, 29, 202, 133, 15, 225, 232, 10, 175, 64, 115,
+			248, 112, 144, 91, 242, 249, 193, 106, 207, 245, 28, 30, 206, 205,
+			227, 196, 26, 18, 66, 178, 11, 1, 152, 121, 21, 173, 14, 0,
+			203, 108, 16, 174, 207, 185, 126, 140, 51, 151, 37, 197, 212, 229,
+			180, 17, 4, 243, 243, 37, 188, 181, 115, 104, 44, 3, 24, 220,
+			122, 13, 12, 106, 66, 153, 84, 242, 131, 131, 212, 180, 101, 46,
+			93, 94, 127, 8, 67, 101, 94, 57, 211, 193, 192, 95, 63, 101,
+			63, 56, 40, 237, 242, 184, 138, 200, 38, 242, 230, 230, 83, 51,
+			31, 158, 189, 44, 140, 137, 185, 35, 102, 122, 251, 200, 153, 202,
+			245, 82, 124, 6, 108, 245, 227, 61, 33, 72, 12, 33, 90, 122,
+			161, 230, 230, 71, 62, 150, 30, 240, 88, 222, 71, 99, 235, 115,
+			243, 68, 235, 31, 53, 54, 55, 224, 137, 213, 237, 146, 209, 62,
+			212, 124, 145, 131, 18, 161, 21, 147, 210, 46, 53, 22, 186, 237,
+			118, 163, 97, 198, 69, 122, 222, 11, 158, 129, 209, 1, 244, 141,
+			206, 31, 209, 21, 242, 46, 228, 159, 65, 125, 50, 169, 54, 64,
+			82, 81, 248, 18, 249, 134, 175, 22, 190, 236, 4, 126, 188, 247,
+			213, 194, 151, 142, 213, 255, 170, 249, 37, 30, 222, 95, 221, 253,
+			178, 227, 250, 95, 221, 253, 50, 226, 246, 87, 159, 150, 190, 68,
+			118, 9, 183, 236, 87, 63, 120, 94, 96, 34, 192, 14, 136, 218,
+			216, 144, 229, 29, 88, 125, 82, 144, 134, 156, 188, 57, 5, 47,
+			208, 70, 46, 192, 113, 119, 241, 246, 249, 96, 207, 245, 56, 200,
+			158, 138, 64, 93, 21, 25, 136, 206, 138, 64, 189, 9, 55, 27,
+			234, 146, 248, 146, 47, 120, 24, 44, 116, 45, 71, 6, 151, 137,
+			15, 2, 213, 26, 183, 236, 61, 156, 23, 79, 248, 56, 228, 255,
+			36, 73, 81, 225, 154, 108, 203, 135, 221, 0, 122, 93, 172, 124,
+			71, 85, 157, 115, 75, 188, 36, 51, 151, 199, 115, 123, 243, 69,
+			54, 228, 102, 35, 122, 42, 60, 47, 64, 212, 107, 227, 133, 26,
+			134, 145, 194, 203, 110, 169, 162, 118, 59, 156, 56, 209, 185, 194,
+			118, 179, 82, 152, 191, 55, 148, 203, 16, 64, 161, 114, 230, 130,
+			50, 202, 223, 113, 112, 67, 32, 67, 162, 24, 11, 165, 39, 166,
+			2, 37, 42, 76, 183, 155, 21, 152, 179, 162, 164, 55, 84, 1,
+			51, 40, 60, 47, 204, 227, 2, 96, 72, 8, 215, 143, 149, 39,
+			222, 8, 42, 33, 32, 173, 161, 174, 186, 86, 24, 13, 186, 65,
+			63, 38, 226, 233, 144, 131, 21, 110, 251, 228, 64, 143, 125, 98,
+			93, 17, 138, 88, 205, 33, 58, 52, 14, 100, 123, 131, 118, 59,
+			226, 49, 177, 107, 232, 45, 42, 99, 118, 22, 161, 176, 178, 180,
+			252, 254, 194, 210, 242, 194, 242, 173, 230, 210, 242, 221, 27, 75,
+			119, 151, 111, 149, 150, 150, 159, 23, 36, 118, 71, 64, 233, 228,
+			120, 233, 90, 232, 195, 68, 37, 137, 131, 14, 252, 1, 223, 124,
+			171, 136, 65, 4, 223, 151, 97, 213, 144, 52, 55, 236, 208, 237,
+			198, 69, 228, 118, 135, 88, 53, 11, 240, 120, 132, 160, 133, 134,
+			149, 184, 226, 164, 67, 144, 200, 46, 240, 145, 208, 95, 57, 17,
+			49, 248, 52, 14, 106, 141, 205, 6, 109, 178, 185, 249, 49, 12,
+			106, 169, 19, 124, 225, 122, 158, 69, 220, 29, 247, 23, 182, 27,
+			139, 78, 96, 71, 139, 79, 121, 107, 113, 48, 148, 197, 58, 167,
+			136, 41, 54, 95, 124, 224, 5, 45, 203, 219, 217, 164, 49, 68,
+			139, 56, 160, 197, 84, 39, 243, 44, 9, 74, 90, 83, 148, 6,
+			3, 113, 36, 126, 77, 159, 33, 199, 136, 64, 47, 169, 31, 159,
+			169, 9, 37, 183, 21, 196, 152, 162, 11, 213, 184, 41, 50, 248,
+			244, 179, 40, 14, 219, 84, 53, 53, 163, 192, 142, 74, 93, 65,
+			217, 112, 46, 43, 139, 158, 219, 194, 168, 17, 139, 88, 176, 180,
+			23, 119, 188, 183, 232, 151, 170, 59, 79, 58, 23, 233, 105, 138,
+			148, 81, 118, 130, 23, 96, 48, 123, 253, 217, 194, 245, 206, 194,
+			117, 167, 121, 253, 225, 221, 235, 79, 238, 94, 111, 148, 174, 183,
+			159, 207, 150, 96, 221, 125, 193, 15, 220, 136, 147, 152, 131, 0,
+			26, 172, 82, 47, 226, 162, 181, 71, 129, 99, 17, 221, 155, 141,
+			224, 211, 207, 106, 141, 77, 197, 212, 220, 167, 30, 104, 226, 146,
+			209, 250, 193, 156, 208, 84, 74, 58, 247, 195, 192, 17, 43, 129,
+			63, 22, 112, 148, 104, 115, 77, 11, 162, 114, 105, 58, 139, 98,
+			172, 139, 135, 219, 166, 121, 170, 14, 174, 175, 172, 93, 95, 89,
+			99, 48, 143, 184, 18, 180, 40, 238, 184, 37, 231, 137, 126, 104,
+			182, 213, 165, 13, 130, 158, 205, 226, 230, 137, 80, 72, 109, 51,
+			220, 150, 105, 248, 147, 239, 216, 49, 244, 46, 214, 204, 236, 31,
+			214, 114, 167, 217, 159, 82, 38, 97, 217, 159, 209, 244, 179, 249,
+			63, 166, 65, 125, 32, 225, 42, 220, 15, 218, 132, 242, 56, 110,
+			25, 179, 102, 192, 101, 177, 241, 108, 22, 60, 65, 117, 98, 139,
+			191, 82, 44, 98, 227, 228, 162, 231, 64, 110, 113, 145, 187, 143,
+			119, 79, 111, 40, 207, 97, 28, 223, 148, 74, 106, 102, 246, 103,
+			180, 220, 73, 149, 52, 48, 105, 158, 17, 129, 89, 50, 120, 207,
+			248, 199, 53, 221, 204, 255, 154, 6, 27, 129, 191, 224, 147, 109,
+			230, 254, 128, 10, 71, 42, 236, 34, 206, 14, 131, 255, 141, 165,
+			175, 37, 216, 144, 21, 19, 1, 147, 76, 17, 164, 129, 225, 160,
+			49, 210, 222, 138, 112, 127, 20, 90, 205, 79, 247, 73, 77, 139,
+			59, 151, 72, 25, 182, 147, 164, 223, 14, 66, 20, 140, 149, 246,
+			96, 20, 96, 82, 104, 44, 202, 255, 216, 24, 160, 104, 19, 52,
+			79, 5, 20, 141, 166, 157, 123, 67, 37, 13, 76, 158, 58, 157,
+			220, 100, 252, 253, 79, 216, 221, 111, 228, 31, 176, 40, 204, 240,
+			142, 244, 133, 43, 28, 176, 211, 116, 185, 140, 46, 218, 60, 20,
+			150, 135, 102, 139, 157, 75, 25, 90, 237, 36, 241, 169, 102, 52,
+			48, 230, 142, 173, 140, 115, 193, 168, 12, 202, 151, 85, 113, 209,
+			90, 253, 172, 61, 230, 91, 225, 167, 117, 150, 63, 186, 18, 134,
+			8, 73, 60, 123, 201, 237, 199, 168, 231, 68, 70, 205, 49, 79,
+			48, 221, 86, 190, 79, 186, 77, 193, 70, 176, 143, 157, 174, 21,
+			239, 73, 191, 165, 28, 102, 108, 89, 241, 30, 133, 7, 9, 189,
+			157, 94, 232, 201, 24, 196, 147, 118, 232, 109, 135, 30, 214, 234,
+			69, 124, 39, 192, 233, 73, 119, 165, 92, 47, 226, 155, 152, 198,
+			16, 39, 244, 97, 39, 178, 131, 46, 143, 102, 166, 40, 252, 237,
+			49, 202, 107, 80, 150, 185, 198, 222, 32, 195, 199, 29, 39, 232,
+			160, 225, 33, 133, 213, 61, 182, 114, 109, 12, 116, 214, 168, 132,
+			132, 199, 113, 170, 37, 178, 162, 194, 247, 217, 241, 244, 87, 116,
+			125, 19, 237, 17, 176, 167, 235, 50, 101, 222, 100, 231, 165, 97,
+			163, 8, 214, 178, 163, 130, 162, 200, 88, 40, 103, 229, 87, 90,
+			205, 117, 249, 173, 176, 197, 206, 13, 172, 8, 182, 18, 245, 117,
+			100, 190, 207, 38, 194, 158, 199, 35, 185, 164, 111, 142, 27, 116,
+			82, 177, 222, 243, 120, 93, 148, 47, 252, 59, 58, 59, 49, 252,
+			5, 67, 4, 147, 151, 156, 88, 16, 250, 141, 247, 98, 232, 63,
+			16, 206, 232, 52, 11, 145, 48, 47, 179, 105, 105, 244, 17, 132,
+			51, 6, 125, 25, 100, 224, 20, 45, 188, 138, 225, 206, 78, 28,
+			236, 12, 162, 126, 241, 153, 44, 21, 61, 43, 191, 54, 131, 218,
+			224, 155, 57, 207, 78, 169, 90, 35, 1, 142, 79, 202, 252, 178,
+			138, 115, 124, 157, 157, 136, 49, 234, 4, 10, 248, 20, 137, 71,
+			70, 59, 126, 67, 228, 202, 48, 5, 230, 10, 59, 215, 177, 94,
+			238, 28, 142, 173, 140, 110, 128, 70, 253, 76, 199, 122, 249, 201,
+			72, 120, 229, 194, 255, 171, 177, 43, 178, 190, 114, 179, 144, 174,
+			23, 146, 49, 55, 235, 108, 170, 35, 126, 74, 152, 127, 103, 12,
+			204, 95, 217, 68, 73, 254, 191, 174, 26, 50, 239, 177, 60, 162,
+			176, 242, 216, 32, 92, 77, 92, 54, 36, 232, 47, 244, 34, 46,
+			219, 33, 196, 85, 110, 32, 249, 117, 54, 37, 27, 196, 251, 77,
+			217, 134, 196, 58, 149, 52, 103, 217, 73, 9, 171, 145, 102, 79,
+			68, 67, 67, 45, 60, 97, 103, 199, 121, 129, 152, 183, 216, 36,
+			153, 35, 133, 114, 214, 195, 206, 145, 130, 120, 96, 197, 26, 21,
+			170, 203, 194, 133, 63, 173, 49, 54, 200, 54, 207, 167, 90, 65,
+			36, 147, 41, 243, 45, 246, 6, 190, 189, 129, 246, 121, 125, 218,
+			226, 98, 247, 31, 79, 50, 113, 163, 167, 67, 95, 27, 35, 161,
+			175, 223, 103, 89, 196, 107, 194, 176, 99, 43, 111, 29, 53, 56,
+			233, 145, 131, 232, 94, 167, 10, 133, 63, 163, 179, 83, 163, 159,
+			198, 238, 132, 53, 54, 65, 145, 92, 8, 110, 199, 86, 74, 95,
+			163, 139, 82, 5, 31, 19, 121, 130, 181, 234, 162, 50, 186, 0,
+			171, 24, 32, 146, 140, 37, 233, 49, 104, 157, 253, 70, 104, 61,
+			113, 36, 90, 231, 239, 50, 54, 24, 11, 110, 106, 186, 8, 148,
+			243, 19, 9, 92, 155, 144, 239, 242, 151, 93, 73, 122, 101, 234,
+			71, 245, 101, 251, 31, 31, 254, 62, 240, 101, 123, 76, 247, 15,
+			198, 241, 204, 84, 254, 99, 56, 116, 192, 10, 65, 205, 146, 234,
+			222, 52, 62, 216, 237, 93, 108, 27, 225, 180, 32, 143, 110, 198,
+			222, 86, 22, 255, 39, 116, 150, 191, 144, 196, 159, 171, 148, 35,
+			56, 224, 16, 135, 189, 40, 46, 49, 246, 223, 78, 8, 179, 250,
+			171, 153, 169, 252, 223, 155, 128, 163, 207, 86, 25, 86, 131, 228,
+			126, 215, 199, 91, 214, 74, 57, 221, 16, 52, 208, 51, 169, 82,
+			86, 190, 24, 246, 160, 165, 72, 134, 135, 117, 84, 32, 54, 148,
+			30, 113, 65, 104, 138, 42, 206, 75, 73, 68, 11, 196, 98, 194,
+			158, 105, 216, 210, 81, 69, 198, 144, 109, 10, 86, 44, 29, 155,
+			38, 226, 82, 172, 18, 33, 109, 212, 42, 226, 195, 60, 14, 87,
+			193, 244, 42, 27, 31, 70, 158, 181, 207, 111, 222, 88, 176, 151,
+			75, 182, 192, 51, 140, 215, 136, 10, 238, 148, 190, 191, 164, 194,
+			26, 21, 148, 182, 127, 48, 86, 101, 5, 133, 173, 67, 101, 3,
+			59, 21, 111, 206, 140, 139, 110, 136, 51, 79, 220, 206, 48, 34,
+			49, 131, 168, 235, 161, 160, 79, 131, 164, 224, 119, 22, 236, 5,
+			81, 140, 219, 30, 230, 10, 131, 225, 21, 230, 73, 223, 96, 129,
+			56, 203, 233, 34, 155, 193, 92, 225, 235, 140, 122, 190, 8, 17,
+			183, 66, 138, 4, 37, 134, 144, 106, 68, 68, 186, 25, 226, 66,
+			10, 16, 241, 152, 244, 27, 100, 224, 41, 220, 147, 164, 52, 80,
+			148, 70, 95, 202, 222, 50, 74, 162, 63, 97, 236, 79, 142, 38,
+			15, 216, 69, 74, 10, 81, 38, 108, 146, 233, 29, 246, 176, 152,
+			149, 83, 31, 93, 80, 161, 107, 96, 42, 138, 202, 156, 213, 65,
+			253, 165, 20, 244, 209, 195, 37, 194, 57, 241, 67, 193, 45, 16,
+			243, 199, 68, 68, 64, 185, 255, 158, 114, 219, 184, 166, 79, 21,
+			74, 50, 118, 184, 88, 182, 114, 49, 9, 66, 53, 18, 137, 80,
+			185, 15, 37, 129, 48, 64, 207, 21, 110, 64, 165, 156, 198, 182,
+			162, 48, 154, 32, 42, 139, 145, 229, 241, 212, 67, 92, 144, 59,
+			28, 231, 197, 216, 119, 85, 120, 139, 55, 245, 92, 97, 5, 144,
+			207, 84, 24, 26, 6, 65, 60, 52, 123, 164, 5, 35, 123, 152,
+			177, 119, 84, 220, 213, 130, 158, 43, 92, 148, 106, 173, 56, 128,
+			54, 199, 110, 43, 245, 117, 34, 5, 140, 173, 82, 185, 44, 198,
+			88, 154, 44, 220, 194, 189, 141, 214, 135, 16, 113, 223, 129, 114,
+			58, 142, 161, 12, 178, 133, 45, 249, 162, 21, 215, 223, 197, 80,
+			46, 140, 221, 84, 129, 68, 223, 214, 89, 97, 22, 54, 177, 30,
+			16, 107, 144, 188, 113, 50, 166, 86, 67, 133, 3, 157, 213, 89,
+			254, 62, 60, 78, 241, 168, 9, 150, 12, 161, 78, 42, 60, 60,
+			42, 201, 240, 83, 236, 218, 61, 207, 10, 37, 134, 150, 24, 91,
+			19, 190, 42, 197, 204, 84, 254, 14, 164, 217, 220, 196, 163, 215,
+			165, 120, 166, 175, 160, 86, 37, 198, 126, 39, 113, 75, 185, 169,
+			179, 252, 255, 172, 201, 150, 176, 141, 212, 94, 32, 137, 16, 183,
+			159, 220, 207, 130, 124, 10, 11, 103, 36, 87, 1, 238, 127, 137,
+			114, 146, 122, 133, 156, 58, 39, 121, 60, 234, 181, 100, 99, 65,
+			91, 134, 202, 145, 105, 84, 208, 169, 40, 156, 50, 198, 18, 163,
+			217, 98, 135, 110, 44, 9, 18, 41, 181, 112, 59, 68, 80, 192,
+			81, 148, 164, 150, 10, 119, 115, 1, 183, 163, 204, 182, 90, 246,
+			208, 39, 166, 194, 235, 138, 206, 10, 233, 111, 37, 198, 254, 245,
+			196, 165, 229, 142, 62, 149, 239, 193, 147, 49, 92, 62, 2, 98,
+			79, 133, 139, 149, 155, 55, 21, 122, 73, 122, 195, 97, 192, 248,
+			98, 42, 98, 149, 180, 161, 92, 42, 142, 22, 196, 249, 98, 116,
+			51, 201, 61, 147, 243, 51, 90, 82, 27, 31, 101, 166, 242, 21,
+			24, 43, 79, 12, 159, 104, 3, 143, 201, 241, 7, 218, 47, 234,
+			210, 130, 218, 184, 175, 179, 252, 127, 164, 3, 50, 61, 145, 180,
+			186, 193, 136, 155, 72, 108, 44, 207, 195, 85, 163, 96, 125, 131,
+			62, 101, 188, 190, 144, 167, 134, 39, 171, 227, 184, 57, 74, 233,
+			164, 57, 76, 221, 228, 123, 253, 18, 108, 250, 92, 70, 251, 39,
+			71, 46, 14, 200, 196, 41, 29, 165, 88, 1, 82, 218, 73, 105,
+			4, 59, 166, 249, 19, 17, 192, 231, 240, 136, 85, 42, 161, 57,
+			174, 175, 170, 71, 104, 95, 212, 193, 11, 171, 120, 15, 181, 148,
+			50, 91, 44, 104, 145, 165, 223, 16, 74, 72, 148, 195, 125, 105,
+			124, 220, 224, 60, 5, 75, 156, 194, 112, 44, 108, 106, 216, 65,
+			131, 50, 47, 42, 49, 246, 87, 38, 201, 168, 220, 248, 52, 51,
+			149, 255, 197, 201, 209, 154, 131, 29, 154, 28, 234, 18, 62, 244,
+			56, 4, 98, 170, 208, 76, 142, 58, 179, 98, 211, 80, 198, 247,
+			10, 100, 96, 240, 49, 224, 78, 27, 201, 91, 17, 62, 155, 132,
+			94, 41, 16, 247, 240, 106, 39, 104, 163, 87, 230, 187, 48, 43,
+			103, 26, 132, 59, 174, 51, 11, 11, 67, 71, 40, 217, 132, 139,
+			80, 176, 3, 167, 112, 89, 1, 111, 243, 222, 29, 27, 138, 124,
+			164, 145, 120, 16, 144, 95, 214, 81, 92, 60, 117, 135, 231, 30,
+			206, 83, 150, 119, 21, 139, 162, 32, 175, 148, 208, 202, 66, 88,
+			198, 235, 162, 134, 36, 223, 28, 13, 53, 164, 50, 199, 71, 233,
+			77, 26, 192, 40, 221, 114, 42, 73, 200, 70, 75, 32, 130, 219,
+			30, 15, 28, 116, 16, 244, 83, 153, 179, 56, 246, 87, 192, 65,
+			150, 31, 47, 28, 167, 43, 15, 0, 130, 119, 37, 16, 245, 90,
+			114, 46, 73, 221, 65, 137, 65, 173, 193, 236, 71, 107, 13, 139,
+			21, 178, 142, 112, 205, 18, 44, 133, 45, 61, 79, 104, 226, 136,
+			95, 52, 111, 178, 147, 139, 105, 247, 98, 240, 205, 20, 180, 168,
+			42, 149, 65, 138, 202, 148, 137, 32, 90, 202, 64, 175, 219, 197,
+			168, 92, 196, 95, 7, 126, 130, 191, 135, 228, 21, 98, 110, 168,
+			77, 108, 104, 54, 146, 236, 20, 190, 213, 24, 236, 238, 242, 132,
+			57, 199, 169, 198, 16, 135, 150, 139, 78, 27, 171, 210, 109, 194,
+			248, 129, 158, 203, 223, 130, 178, 220, 50, 93, 169, 225, 83, 239,
+			113, 32, 29, 235, 121, 188, 152, 60, 136, 34, 90, 241, 2, 164,
+			91, 24, 12, 16, 93, 32, 140, 207, 116, 150, 47, 67, 149, 194,
+			24, 6, 109, 24, 24, 154, 8, 92, 9, 14, 252, 116, 83, 113,
+			64, 30, 178, 184, 5, 16, 36, 228, 168, 106, 35, 32, 255, 182,
+			46, 189, 23, 12, 79, 103, 249, 191, 172, 67, 89, 225, 158, 138,
+			179, 70, 15, 77, 224, 21, 83, 210, 156, 58, 208, 112, 223, 146,
+			132, 136, 119, 120, 187, 120, 76, 39, 158, 231, 206, 144, 51, 37,
+			233, 73, 69, 123, 216, 242, 152, 253, 157, 196, 247, 230, 30, 71,
+			250, 131, 196, 92, 248, 4, 11, 236, 45, 31, 102, 134, 231, 70,
+			162, 124, 11, 228, 45, 139, 0, 139, 16, 170, 123, 10, 152, 27,
+			14, 253, 157, 88, 220, 80, 174, 162, 248, 104, 30, 79, 12, 17,
+			81, 240, 151, 93, 203, 199, 123, 56, 226, 81, 94, 73, 141, 216,
+			128, 220, 151, 24, 251, 235, 89, 105, 155, 111, 124, 165, 179, 252,
+			95, 200, 66, 109, 132, 16, 164, 14, 13, 92, 134, 86, 18, 182,
+			153, 59, 139, 169, 253, 148, 120, 125, 36, 219, 115, 28, 168, 199,
+			238, 211, 196, 83, 234, 232, 97, 255, 51, 133, 53, 53, 152, 4,
+			13, 151, 49, 7, 241, 93, 132, 66, 29, 223, 102, 108, 52, 55,
+			235, 5, 229, 49, 70, 219, 62, 118, 227, 222, 56, 8, 208, 53,
+			116, 50, 46, 90, 25, 9, 122, 117, 43, 136, 91, 17, 231, 150,
+			110, 153, 128, 141, 78, 95, 124, 248, 81, 143, 244, 107, 38, 18,
+			138, 131, 7, 3, 146, 233, 211, 189, 19, 58, 197, 131, 160, 66,
+			160, 72, 215, 239, 33, 14, 253, 218, 164, 114, 165, 248, 57, 77,
+			103, 249, 95, 157, 28, 236, 72, 119, 4, 155, 228, 121, 48, 230,
+			96, 241, 249, 193, 224, 108, 56, 132, 55, 3, 2, 252, 47, 60,
+			182, 12, 119, 72, 32, 163, 119, 0, 8, 51, 100, 60, 123, 107,
+			124, 252, 255, 4, 198, 69, 129, 154, 73, 16, 70, 203, 15, 252,
+			126, 39, 232, 69, 133, 81, 108, 196, 48, 163, 226, 209, 71, 79,
+			122, 91, 18, 205, 65, 58, 143, 200, 43, 151, 149, 112, 118, 48,
+			21, 164, 192, 44, 245, 0, 5, 167, 96, 2, 136, 209, 28, 189,
+			187, 165, 177, 102, 218, 121, 73, 50, 209, 116, 139, 52, 4, 21,
+			10, 224, 130, 57, 194, 169, 87, 18, 162, 175, 143, 212, 27, 155,
+			205, 175, 141, 216, 100, 10, 48, 64, 108, 104, 6, 3, 30, 87,
+			30, 74, 71, 87, 150, 151, 97, 52, 130, 62, 123, 53, 19, 33,
+			135, 203, 95, 162, 131, 170, 139, 190, 109, 174, 127, 8, 199, 75,
+			140, 253, 103, 186, 114, 22, 250, 147, 184, 177, 254, 116, 234, 168,
+			27, 203, 102, 181, 248, 168, 209, 192, 235, 119, 150, 106, 232, 219,
+			217, 89, 131, 216, 83, 135, 54, 152, 252, 114, 247, 3, 215, 25,
+			179, 113, 198, 224, 177, 64, 99, 82, 90, 170, 86, 15, 177, 147,
+			132, 199, 201, 179, 22, 47, 8, 92, 147, 102, 246, 207, 162, 99,
+			237, 15, 224, 137, 245, 210, 237, 244, 58, 135, 152, 34, 80, 76,
+			17, 204, 161, 149, 34, 18, 42, 228, 184, 184, 51, 158, 209, 95,
+			147, 126, 100, 104, 126, 183, 2, 104, 17, 131, 217, 255, 121, 150,
+			97, 180, 220, 236, 47, 162, 191, 234, 159, 205, 66, 99, 72, 179,
+			63, 114, 9, 145, 104, 15, 73, 74, 83, 211, 145, 119, 3, 232,
+			233, 71, 82, 40, 241, 45, 232, 50, 68, 147, 150, 151, 9, 81,
+			242, 186, 78, 171, 79, 75, 51, 220, 209, 192, 207, 150, 140, 169,
+			219, 238, 161, 199, 27, 32, 138, 121, 183, 8, 20, 190, 85, 198,
+			199, 193, 17, 37, 158, 60, 189, 8, 3, 128, 73, 175, 191, 145,
+			161, 225, 142, 80, 208, 107, 245, 161, 206, 45, 175, 19, 65, 185,
+			178, 30, 37, 111, 221, 96, 9, 136, 226, 160, 11, 187, 104, 183,
+			44, 239, 144, 161, 231, 199, 174, 7, 241, 168, 230, 208, 227, 22,
+			62, 210, 42, 8, 63, 113, 178, 8, 165, 5, 82, 180, 56, 3,
+			128, 208, 78, 69, 246, 11, 71, 42, 194, 19, 212, 202, 79, 80,
+			32, 181, 101, 0, 156, 61, 122, 242, 49, 34, 11, 97, 219, 242,
+			209, 125, 105, 159, 15, 133, 240, 145, 138, 55, 193, 218, 190, 122,
+			117, 72, 243, 149, 116, 195, 82, 46, 43, 131, 121, 202, 232, 137,
+			169, 87, 212, 172, 8, 142, 142, 250, 117, 88, 126, 199, 55, 205,
+			38, 140, 140, 153, 253, 243, 154, 62, 205, 230, 217, 100, 118, 194,
+			32, 251, 131, 255, 66, 203, 30, 207, 95, 36, 45, 46, 169, 99,
+			70, 87, 255, 19, 89, 84, 51, 179, 191, 132, 69, 239, 11, 30,
+			90, 154, 198, 143, 160, 146, 90, 45, 193, 171, 169, 248, 111, 20,
+			45, 136, 162, 68, 167, 218, 109, 226, 128, 112, 0, 191, 140, 212,
+			229, 190, 220, 217, 126, 28, 246, 161, 99, 117, 113, 89, 91, 61,
+			223, 222, 27, 219, 13, 26, 101, 8, 227, 126, 146, 184, 83, 173,
+			254, 54, 18, 173, 9, 52, 69, 248, 175, 176, 217, 255, 9, 137,
+			150, 114, 152, 74, 207, 76, 170, 159, 240, 36, 17, 246, 96, 35,
+			125, 140, 60, 96, 36, 182, 62, 195, 73, 57, 120, 146, 8, 193,
+			60, 221, 224, 8, 18, 9, 27, 61, 197, 42, 161, 141, 152, 194,
+			101, 68, 63, 137, 47, 133, 161, 17, 125, 248, 81, 130, 236, 35,
+			131, 41, 128, 188, 60, 20, 74, 210, 90, 249, 9, 116, 3, 36,
+			222, 60, 42, 50, 245, 254, 226, 103, 178, 204, 103, 210, 141, 45,
+			104, 69, 129, 199, 99, 46, 117, 70, 214, 240, 244, 197, 158, 143,
+			18, 59, 33, 132, 17, 197, 123, 161, 131, 4, 85, 73, 116, 26,
+			34, 13, 228, 248, 18, 60, 134, 104, 243, 133, 122, 100, 208, 145,
+			160, 217, 212, 93, 137, 177, 159, 197, 103, 11, 38, 205, 236, 223,
+			65, 146, 244, 175, 234, 48, 238, 106, 49, 161, 68, 232, 192, 128,
+			26, 126, 216, 236, 114, 191, 182, 134, 207, 203, 248, 56, 46, 186,
+			191, 192, 88, 253, 164, 160, 171, 212, 24, 68, 100, 58, 75, 122,
+			155, 199, 189, 22, 222, 1, 161, 105, 29, 26, 101, 160, 74, 115,
+			94, 190, 199, 85, 91, 83, 167, 184, 188, 243, 225, 47, 69, 228,
+			35, 225, 142, 128, 115, 103, 135, 233, 43, 96, 52, 242, 170, 44,
+			137, 227, 77, 59, 237, 191, 98, 227, 141, 9, 135, 119, 120, 199,
+			213, 88, 54, 59, 137, 8, 254, 247, 16, 19, 239, 161, 219, 177,
+			120, 78, 71, 76, 81, 222, 184, 200, 8, 248, 29, 105, 133, 130,
+			235, 128, 155, 59, 166, 224, 221, 16, 8, 54, 99, 157, 233, 217,
+			41, 51, 251, 247, 17, 178, 31, 195, 224, 142, 117, 72, 135, 20,
+			203, 246, 95, 13, 90, 21, 141, 98, 10, 71, 246, 15, 48, 44,
+			192, 23, 80, 125, 137, 193, 14, 184, 52, 180, 193, 173, 242, 153,
+			27, 69, 159, 1, 221, 21, 10, 93, 41, 131, 130, 178, 23, 35,
+			56, 151, 164, 21, 14, 190, 128, 187, 215, 107, 33, 255, 138, 20,
+			143, 251, 177, 210, 130, 38, 134, 53, 150, 159, 184, 148, 194, 118,
+			125, 125, 40, 120, 253, 223, 20, 67, 209, 204, 236, 111, 224, 80,
+			126, 73, 195, 18, 234, 52, 24, 25, 127, 114, 63, 156, 120, 50,
+			170, 130, 201, 204, 196, 73, 21, 7, 228, 243, 67, 1, 115, 184,
+			176, 111, 124, 202, 91, 240, 152, 99, 36, 202, 56, 21, 167, 44,
+			29, 13, 109, 244, 172, 45, 124, 32, 90, 253, 104, 177, 116, 192,
+			61, 111, 1, 181, 1, 254, 98, 208, 229, 190, 235, 200, 53, 150,
+			199, 56, 78, 150, 211, 52, 116, 51, 251, 91, 184, 214, 79, 161,
+			76, 60, 130, 130, 40, 209, 202, 207, 172, 158, 35, 65, 90, 74,
+			161, 43, 45, 188, 216, 107, 49, 186, 213, 32, 169, 242, 213, 129,
+			136, 111, 234, 165, 193, 245, 95, 10, 112, 25, 102, 246, 31, 99,
+			63, 255, 169, 38, 213, 168, 116, 154, 118, 172, 174, 104, 158, 186,
+			27, 244, 16, 7, 98, 235, 75, 166, 72, 234, 33, 170, 138, 211,
+			68, 196, 13, 66, 7, 95, 21, 64, 102, 182, 237, 134, 232, 20,
+			139, 90, 11, 217, 170, 80, 143, 37, 250, 32, 117, 13, 32, 245,
+			170, 76, 114, 226, 196, 171, 146, 17, 113, 178, 245, 176, 100, 162,
+			59, 125, 194, 244, 108, 206, 204, 254, 175, 136, 194, 101, 24, 189,
+			61, 71, 2, 23, 165, 70, 111, 249, 9, 136, 144, 68, 89, 67,
+			51, 192, 112, 29, 31, 176, 108, 54, 135, 199, 217, 255, 174, 233,
+			211, 249, 18, 12, 110, 188, 177, 87, 107, 224, 28, 139, 145, 216,
+			18, 253, 42, 117, 80, 98, 172, 193, 38, 177, 54, 110, 129, 255,
+			83, 203, 178, 124, 37, 9, 200, 130, 227, 79, 161, 61, 20, 66,
+			222, 13, 34, 55, 14, 194, 126, 65, 138, 14, 248, 53, 217, 175,
+			22, 68, 182, 229, 89, 24, 34, 231, 145, 108, 84, 51, 179, 255,
+			23, 54, 122, 23, 234, 213, 21, 8, 249, 46, 221, 175, 72, 243,
+			103, 87, 190, 40, 75, 125, 200, 253, 70, 109, 181, 123, 24, 27,
+			159, 160, 72, 208, 202, 102, 115, 56, 188, 223, 193, 109, 241, 221,
+			31, 69, 245, 149, 198, 155, 63, 98, 32, 184, 240, 84, 252, 131,
+			186, 206, 242, 191, 163, 67, 101, 224, 60, 28, 248, 18, 240, 226,
+			86, 94, 98, 94, 250, 138, 77, 53, 142, 93, 209, 114, 116, 187,
+			30, 46, 2, 148, 253, 62, 28, 4, 225, 11, 47, 176, 28, 41,
+			235, 137, 216, 249, 187, 60, 30, 90, 67, 218, 115, 120, 152, 40,
+			49, 67, 92, 58, 136, 151, 139, 217, 8, 213, 127, 224, 198, 15,
+			123, 45, 40, 11, 10, 131, 151, 169, 65, 218, 217, 153, 6, 70,
+			167, 109, 122, 80, 44, 161, 127, 106, 64, 81, 145, 66, 28, 241,
+			208, 106, 121, 125, 162, 244, 18, 191, 148, 105, 128, 120, 215, 38,
+			228, 136, 205, 138, 65, 21, 47, 106, 171, 145, 164, 86, 159, 66,
+			171, 162, 42, 184, 144, 126, 214, 230, 80, 1, 148, 45, 24, 148,
+			211, 91, 57, 109, 225, 205, 216, 255, 161, 211, 82, 232, 102, 246,
+			103, 117, 61, 151, 255, 77, 100, 80, 48, 116, 163, 103, 197, 9,
+			2, 14, 33, 123, 90, 135, 158, 208, 98, 164, 183, 119, 223, 254,
+			114, 204, 240, 190, 250, 88, 80, 228, 5, 69, 160, 71, 46, 167,
+			160, 240, 246, 151, 4, 135, 175, 10, 208, 245, 44, 155, 239, 5,
+			158, 120, 249, 39, 228, 67, 26, 2, 90, 178, 1, 249, 138, 71,
+			165, 102, 150, 96, 77, 35, 85, 75, 86, 160, 53, 194, 56, 28,
+			196, 122, 161, 240, 28, 180, 193, 227, 49, 30, 217, 69, 101, 108,
+			79, 113, 228, 10, 165, 157, 133, 2, 216, 123, 22, 189, 123, 131,
+			95, 233, 210, 25, 45, 133, 143, 38, 38, 3, 220, 134, 39, 227,
+			233, 103, 106, 106, 37, 198, 254, 59, 1, 116, 195, 204, 254, 187,
+			136, 255, 127, 247, 117, 162, 172, 20, 241, 94, 37, 150, 253, 24,
+			11, 162, 36, 167, 113, 103, 132, 33, 74, 152, 241, 68, 59, 83,
+			148, 70, 197, 2, 204, 146, 105, 80, 187, 135, 129, 19, 240, 40,
+			121, 183, 24, 247, 46, 45, 140, 24, 73, 74, 89, 93, 98, 204,
+			37, 232, 102, 205, 236, 159, 210, 245, 169, 252, 167, 191, 23, 146,
+			47, 9, 190, 169, 248, 32, 255, 176, 196, 30, 127, 35, 75, 165,
+			197, 113, 214, 177, 120, 22, 254, 104, 79, 78, 188, 46, 56, 73,
+			254, 119, 97, 243, 91, 120, 159, 157, 188, 143, 23, 253, 149, 250,
+			186, 84, 125, 28, 50, 159, 61, 203, 38, 218, 65, 104, 115, 178,
+			223, 202, 213, 69, 162, 176, 201, 78, 13, 42, 138, 0, 58, 230,
+			61, 198, 236, 208, 219, 17, 129, 141, 169, 133, 99, 43, 151, 199,
+			24, 180, 85, 234, 235, 13, 42, 83, 159, 182, 67, 79, 252, 44,
+			188, 201, 78, 162, 233, 82, 165, 28, 37, 237, 169, 145, 24, 98,
+			36, 133, 183, 153, 137, 30, 78, 101, 89, 121, 252, 120, 11, 127,
+			89, 103, 103, 134, 138, 201, 214, 170, 108, 82, 48, 83, 114, 100,
+			223, 208, 78, 89, 86, 70, 35, 62, 92, 95, 105, 205, 70, 191,
+			209, 64, 50, 228, 40, 103, 169, 87, 15, 84, 18, 109, 226, 240,
+			226, 187, 47, 45, 136, 69, 2, 109, 139, 201, 155, 104, 39, 228,
+			251, 100, 160, 139, 54, 135, 152, 81, 231, 251, 248, 72, 133, 124,
+			34, 155, 62, 79, 210, 103, 38, 179, 100, 1, 217, 60, 21, 144,
+			175, 79, 200, 44, 44, 48, 188, 14, 185, 111, 182, 14, 183, 217,
+			217, 90, 36, 223, 195, 64, 112, 164, 209, 194, 74, 192, 108, 33,
+			216, 35, 245, 12, 132, 30, 249, 133, 101, 118, 110, 164, 158, 132,
+			59, 1, 135, 178, 229, 179, 18, 42, 89, 184, 201, 46, 84, 48,
+			146, 106, 10, 234, 170, 183, 139, 140, 76, 180, 119, 186, 188, 35,
+			251, 156, 194, 244, 22, 239, 20, 190, 207, 102, 14, 215, 146, 125,
+			93, 100, 57, 55, 18, 166, 185, 170, 51, 55, 34, 179, 69, 52,
+			131, 148, 145, 156, 134, 159, 176, 120, 67, 230, 214, 41, 179, 240,
+			79, 53, 54, 157, 192, 197, 92, 99, 167, 60, 43, 138, 119, 4,
+			244, 119, 98, 87, 90, 111, 30, 91, 201, 43, 115, 172, 195, 239,
+			204, 213, 79, 96, 157, 237, 174, 242, 240, 48, 87, 217, 73, 204,
+			217, 33, 67, 28, 209, 136, 254, 218, 70, 222, 240, 172, 40, 166,
+			45, 134, 121, 230, 59, 67, 109, 240, 216, 218, 149, 134, 158, 131,
+			114, 213, 216, 218, 53, 75, 236, 140, 4, 239, 14, 2, 44, 218,
+			33, 177, 158, 208, 207, 168, 159, 150, 159, 16, 224, 81, 5, 63,
+			172, 252, 166, 193, 206, 143, 193, 122, 151, 71, 102, 131, 229, 212,
+			22, 55, 199, 61, 201, 48, 216, 255, 180, 102, 249, 183, 94, 89,
+			70, 174, 80, 149, 77, 201, 109, 254, 141, 158, 127, 24, 37, 13,
+			223, 103, 199, 82, 123, 220, 188, 62, 166, 202, 16, 13, 16, 35,
+			124, 231, 117, 197, 100, 235, 45, 246, 198, 16, 46, 155, 179, 99,
+			42, 142, 96, 187, 232, 97, 238, 245, 5, 101, 31, 47, 216, 169,
+			81, 52, 54, 223, 29, 83, 251, 48, 174, 139, 158, 222, 251, 90,
+			101, 127, 119, 15, 71, 252, 3, 16, 175, 135, 242, 127, 254, 95,
+			15, 205, 203, 80, 32, 185, 204, 35, 21, 94, 68, 254, 52, 50,
+			166, 193, 50, 215, 49, 23, 31, 179, 59, 150, 153, 167, 159, 186,
+			105, 188, 145, 89, 99, 191, 162, 209, 187, 19, 217, 51, 153, 130,
+			150, 255, 11, 218, 56, 83, 52, 151, 31, 226, 124, 94, 251, 12,
+			5, 49, 164, 174, 8, 253, 72, 166, 186, 170, 230, 168, 9, 234,
+			32, 108, 41, 106, 137, 201, 74, 165, 213, 79, 199, 147, 147, 61,
+			185, 113, 196, 61, 148, 102, 147, 11, 55, 234, 59, 34, 127, 48,
+			99, 18, 223, 0, 59, 147, 187, 202, 202, 234, 197, 139, 115, 250,
+			189, 252, 77, 80, 219, 243, 240, 99, 12, 68, 171, 48, 104, 40,
+			26, 31, 226, 171, 95, 120, 213, 90, 41, 83, 24, 205, 9, 108,
+			66, 51, 141, 115, 147, 39, 84, 74, 55, 141, 115, 39, 175, 170,
+			148, 97, 26, 231, 230, 239, 176, 135, 212, 153, 102, 26, 51, 250,
+			119, 243, 247, 64, 238, 97, 25, 207, 18, 69, 103, 165, 46, 77,
+			25, 92, 146, 70, 33, 228, 187, 168, 156, 66, 21, 88, 165, 44,
+			95, 60, 198, 150, 176, 169, 201, 55, 84, 74, 55, 141, 153, 19,
+			111, 169, 148, 97, 26, 51, 165, 15, 89, 93, 189, 156, 113, 73,
+			95, 203, 87, 33, 181, 187, 147, 126, 135, 84, 42, 73, 4, 240,
+			74, 89, 106, 12, 19, 76, 74, 212, 107, 162, 7, 180, 236, 184,
+			52, 121, 90, 246, 71, 93, 152, 111, 171, 148, 97, 26, 151, 22,
+			87, 89, 83, 61, 178, 113, 85, 127, 148, 127, 0, 67, 59, 31,
+			34, 116, 168, 149, 111, 238, 141, 60, 175, 25, 13, 61, 44, 232,
+			74, 167, 62, 142, 208, 79, 250, 71, 228, 188, 58, 121, 70, 165,
+			116, 211, 184, 122, 118, 78, 165, 12, 211, 184, 122, 227, 33, 123,
+			172, 158, 223, 120, 83, 111, 228, 63, 130, 81, 122, 240, 170, 33,
+			168, 208, 221, 168, 253, 244, 131, 56, 233, 22, 141, 198, 223, 156,
+			60, 175, 82, 186, 105, 188, 121, 161, 164, 82, 134, 105, 188, 121,
+			231, 167, 216, 3, 178, 103, 207, 94, 207, 204, 105, 249, 123, 9,
+			82, 73, 74, 37, 37, 19, 122, 243, 192, 74, 20, 10, 149, 242,
+			136, 129, 107, 16, 166, 158, 72, 189, 158, 187, 48, 176, 107, 127,
+			71, 63, 85, 184, 144, 198, 17, 37, 36, 86, 202, 76, 190, 20,
+			136, 15, 222, 189, 35, 31, 161, 19, 184, 249, 206, 244, 49, 149,
+			50, 76, 227, 157, 19, 39, 217, 135, 234, 217, 209, 89, 253, 116,
+			97, 73, 33, 56, 62, 254, 106, 133, 17, 129, 58, 121, 190, 224,
+			128, 11, 81, 196, 141, 147, 55, 49, 100, 99, 218, 4, 214, 159,
+			84, 41, 205, 52, 102, 167, 228, 51, 173, 132, 130, 179, 39, 79,
+			177, 247, 201, 210, 62, 251, 94, 102, 65, 203, 191, 151, 130, 198,
+			104, 68, 87, 177, 225, 213, 119, 57, 123, 124, 64, 239, 189, 220,
+			12, 91, 82, 38, 205, 69, 253, 82, 225, 45, 245, 28, 131, 154,
+			120, 125, 93, 104, 17, 40, 69, 51, 81, 79, 231, 101, 38, 177,
+			202, 49, 149, 194, 183, 67, 143, 171, 119, 192, 51, 248, 118, 232,
+			197, 60, 187, 69, 118, 183, 217, 165, 204, 138, 150, 159, 87, 219,
+			242, 168, 241, 201, 207, 114, 120, 8, 191, 37, 185, 56, 100, 115,
+			187, 172, 95, 124, 245, 226, 232, 122, 38, 139, 15, 134, 38, 41,
+			124, 62, 84, 62, 84, 174, 211, 82, 45, 155, 103, 85, 10, 159,
+			15, 189, 48, 195, 86, 69, 108, 222, 91, 153, 247, 181, 252, 237,
+			244, 30, 254, 186, 40, 37, 71, 139, 91, 230, 86, 46, 79, 163,
+			37, 131, 210, 219, 175, 67, 37, 131, 80, 233, 182, 68, 37, 131,
+			198, 119, 91, 162, 146, 65, 168, 116, 251, 196, 73, 246, 37, 25,
+			91, 102, 63, 200, 84, 181, 124, 48, 60, 190, 241, 64, 76, 21,
+			81, 174, 215, 72, 215, 219, 234, 254, 159, 8, 93, 34, 6, 83,
+			96, 240, 34, 202, 180, 42, 138, 43, 222, 240, 11, 153, 80, 76,
+			12, 55, 229, 7, 185, 75, 236, 138, 50, 91, 251, 80, 159, 43,
+			156, 74, 130, 72, 40, 187, 114, 156, 81, 150, 80, 226, 67, 253,
+			178, 74, 105, 166, 241, 225, 149, 183, 84, 202, 48, 141, 15, 223,
+			153, 101, 243, 202, 118, 237, 35, 221, 44, 92, 134, 46, 239, 44,
+			168, 16, 16, 149, 114, 154, 78, 168, 70, 113, 35, 124, 36, 193,
+			148, 165, 141, 240, 209, 244, 27, 42, 133, 214, 192, 167, 78, 179,
+			187, 202, 126, 237, 187, 250, 153, 194, 2, 106, 205, 208, 232, 81,
+			250, 83, 148, 101, 104, 120, 146, 90, 164, 171, 203, 30, 31, 25,
+			186, 62, 129, 149, 39, 85, 74, 51, 141, 239, 78, 157, 80, 41,
+			195, 52, 190, 123, 218, 100, 203, 202, 176, 235, 99, 253, 116, 225,
+			237, 67, 189, 72, 211, 99, 10, 129, 40, 174, 128, 85, 227, 248,
+			144, 237, 199, 73, 227, 136, 43, 31, 203, 189, 156, 37, 130, 250,
+			241, 201, 83, 108, 81, 90, 252, 24, 101, 253, 66, 161, 32, 71,
+			7, 33, 223, 79, 189, 232, 91, 41, 163, 213, 29, 234, 57, 28,
+			213, 52, 190, 46, 91, 78, 160, 131, 171, 85, 150, 47, 248, 102,
+			17, 179, 141, 242, 185, 243, 108, 69, 218, 60, 24, 171, 250, 197,
+			194, 245, 35, 155, 70, 40, 73, 225, 79, 181, 142, 111, 166, 174,
+			38, 173, 79, 104, 166, 177, 58, 125, 86, 182, 62, 97, 152, 198,
+			234, 133, 25, 217, 250, 164, 105, 84, 94, 219, 186, 92, 3, 213,
+			250, 228, 4, 86, 82, 173, 79, 106, 166, 81, 73, 90, 159, 52,
+			76, 163, 114, 97, 134, 189, 79, 173, 79, 153, 198, 154, 126, 169,
+			240, 46, 160, 8, 66, 70, 140, 201, 219, 66, 106, 91, 73, 238,
+			65, 118, 167, 186, 152, 154, 196, 154, 199, 84, 74, 51, 141, 53,
+			73, 164, 178, 250, 148, 97, 26, 107, 23, 243, 236, 19, 97, 117,
+			240, 48, 243, 88, 203, 63, 26, 62, 73, 21, 21, 72, 60, 78,
+			6, 52, 64, 236, 101, 233, 79, 131, 104, 59, 124, 180, 202, 237,
+			131, 32, 123, 152, 187, 76, 116, 97, 2, 183, 79, 237, 117, 116,
+			97, 130, 232, 66, 77, 130, 101, 130, 118, 81, 77, 210, 133, 9,
+			162, 11, 181, 19, 39, 89, 77, 222, 8, 27, 143, 244, 83, 133,
+			15, 104, 219, 204, 70, 195, 35, 128, 185, 150, 187, 91, 170, 249,
+			241, 112, 136, 21, 135, 219, 110, 71, 60, 174, 78, 1, 26, 100,
+			195, 184, 203, 30, 37, 157, 226, 46, 123, 148, 116, 138, 199, 205,
+			163, 19, 39, 217, 199, 226, 46, 116, 35, 179, 165, 229, 111, 142,
+			2, 106, 60, 57, 26, 42, 36, 64, 130, 235, 188, 145, 187, 66,
+			43, 139, 87, 137, 198, 166, 126, 166, 240, 110, 106, 55, 113, 154,
+			142, 184, 210, 18, 14, 16, 141, 13, 201, 169, 144, 135, 8, 14,
+			120, 146, 160, 180, 41, 247, 212, 36, 65, 105, 83, 110, 216, 73,
+			130, 210, 230, 105, 147, 213, 197, 21, 99, 35, 179, 173, 229, 239,
+			31, 226, 81, 198, 44, 110, 151, 119, 18, 104, 165, 89, 22, 245,
+			246, 177, 92, 213, 41, 205, 52, 26, 185, 107, 236, 3, 121, 231,
+			104, 52, 245, 243, 133, 197, 215, 214, 38, 4, 85, 122, 67, 177,
+			218, 83, 52, 143, 166, 4, 252, 20, 205, 163, 41, 31, 185, 158,
+			162, 121, 52, 207, 158, 35, 174, 39, 103, 102, 191, 151, 249, 20,
+			185, 158, 195, 243, 24, 15, 251, 209, 114, 114, 240, 57, 205, 52,
+			190, 151, 3, 182, 64, 10, 206, 140, 105, 60, 211, 207, 22, 64,
+			192, 159, 118, 236, 56, 94, 77, 140, 54, 71, 163, 125, 38, 161,
+			158, 163, 209, 62, 155, 58, 169, 82, 134, 105, 60, 51, 207, 208,
+			235, 50, 57, 164, 240, 207, 245, 203, 133, 219, 96, 169, 7, 245,
+			71, 223, 141, 150, 234, 16, 212, 161, 38, 14, 98, 174, 63, 212,
+			29, 98, 229, 115, 9, 156, 28, 209, 254, 231, 211, 23, 84, 202,
+			48, 141, 231, 249, 75, 248, 2, 184, 158, 157, 54, 179, 159, 101,
+			184, 150, 255, 16, 18, 149, 74, 234, 30, 25, 247, 151, 135, 110,
+			131, 105, 218, 65, 39, 121, 242, 206, 16, 241, 191, 43, 27, 139,
+			223, 230, 159, 128, 247, 180, 102, 26, 159, 229, 78, 147, 59, 212,
+			52, 226, 187, 165, 47, 23, 102, 41, 90, 142, 32, 237, 149, 250,
+			250, 248, 112, 239, 138, 36, 76, 211, 193, 106, 233, 151, 84, 74,
+			51, 13, 235, 114, 81, 165, 12, 211, 176, 22, 151, 232, 233, 227,
+			105, 4, 123, 75, 95, 42, 92, 26, 211, 62, 113, 111, 138, 250,
+			78, 235, 218, 36, 22, 85, 109, 34, 108, 91, 151, 223, 83, 41,
+			195, 52, 90, 165, 69, 57, 102, 29, 223, 225, 185, 82, 152, 5,
+			84, 6, 33, 212, 136, 10, 203, 167, 160, 196, 29, 159, 108, 123,
+			176, 65, 167, 117, 60, 112, 108, 185, 118, 211, 228, 57, 100, 79,
+			207, 168, 148, 97, 26, 246, 165, 203, 236, 59, 212, 190, 97, 26,
+			142, 14, 133, 247, 20, 225, 34, 185, 140, 168, 70, 26, 95, 210,
+			162, 138, 234, 3, 15, 86, 71, 62, 223, 60, 77, 7, 171, 147,
+			83, 51, 194, 131, 213, 185, 122, 173, 53, 217, 13, 131, 56, 184,
+			241, 255, 15, 0, 172, 1, 193, 80, 146, 163, 0, 0},
 	)
 }
 
@@ -710,6 +710,181 @@ func (x *ServiceAccountToken) GetAuthDbRev() int64 {
 	return 0
 }
 
+// Delegation tokens minted in exchange for external OpenID Connect ID tokens.
+type OIDCExchangedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First 16 bytes of SHA256 of the delegation token body, hex-encoded.
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Identifier of this token as generated by the token server.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// Identity whose authority is delegated.
+	DelegatedIdentity string `protobuf:"bytes,3,opt,name=delegated_identity,json=delegatedIdentity,proto3" json:"delegated_identity,omitempty"`
+	// `iss` claim of the ID token.
+	OidcIssuer string `protobuf:"bytes,4,opt,name=oidc_issuer,json=oidcIssuer,proto3" json:"oidc_issuer,omitempty"`
+	// `sub` claim of the ID token.
+	OidcSubject string `protobuf:"bytes,5,opt,name=oidc_subject,json=oidcSubject,proto3" json:"oidc_subject,omitempty"`
+	// `aud` claim of the ID token.
+	OidcAudience []string `protobuf:"bytes,6,rep,name=oidc_audience,json=oidcAudience,proto3" json:"oidc_audience,omitempty"`
+	// When the token was generated.
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// When the token expires.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// What services should accept this token.
+	TargetServices []string `protobuf:"bytes,9,rep,name=target_services,json=targetServices,proto3" json:"target_services,omitempty"`
+	// Arbitrary key:value pairs embedded into the token.
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Revision of the luci-config repo with oidc_federation.cfg.
+	ConfigRev string `protobuf:"bytes,11,opt,name=config_rev,json=configRev,proto3" json:"config_rev,omitempty"`
+	// Name of the rule used to derive the identity.
+	ConfigRule string `protobuf:"bytes,12,opt,name=config_rule,json=configRule,proto3" json:"config_rule,omitempty"`
+	// IP address of the caller.
+	PeerIp string `protobuf:"bytes,13,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	// Identifier of the token server GAE app and version.
+	ServiceVersion string `protobuf:"bytes,14,opt,name=service_version,json=serviceVersion,proto3" json:"service_version,omitempty"`
+	// ID of the GAE request that handled the call.
+	GaeRequestId string `protobuf:"bytes,15,opt,name=gae_request_id,json=gaeRequestId,proto3" json:"gae_request_id,omitempty"`
+}
+
+func (x *OIDCExchangedToken) Reset() {
+	*x = OIDCExchangedToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCExchangedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCExchangedToken) ProtoMessage() {}
+
+func (x *OIDCExchangedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCExchangedToken.ProtoReflect.Descriptor instead.
+func (*OIDCExchangedToken) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OIDCExchangedToken) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetDelegatedIdentity() string {
+	if x != nil {
+		return x.DelegatedIdentity
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetOidcIssuer() string {
+	if x != nil {
+		return x.OidcIssuer
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetOidcSubject() string {
+	if x != nil {
+		return x.OidcSubject
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetOidcAudience() []string {
+	if x != nil {
+		return x.OidcAudience
+	}
+	return nil
+}
+
+func (x *OIDCExchangedToken) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *OIDCExchangedToken) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *OIDCExchangedToken) GetTargetServices() []string {
+	if x != nil {
+		return x.TargetServices
+	}
+	return nil
+}
+
+func (x *OIDCExchangedToken) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *OIDCExchangedToken) GetConfigRev() string {
+	if x != nil {
+		return x.ConfigRev
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetConfigRule() string {
+	if x != nil {
+		return x.ConfigRule
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetServiceVersion() string {
+	if x != nil {
+		return x.ServiceVersion
+	}
+	return ""
+}
+
+func (x *OIDCExchangedToken) GetGaeRequestId() string {
+	if x != nil {
+		return x.GaeRequestId
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_tokenserver_api_bq_bq_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDesc = []byte{
@@ -886,10 +1061,46 @@ var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDesc = []byte{
 	0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x64,
 	0x62, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x44, 0x62, 0x52, 0x65, 0x76, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
-	0x71, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x44, 0x62, 0x52, 0x65, 0x76, 0x22, 0xc3, 0x04, 0x0a, 0x12, 0x4f, 0x49, 0x44, 0x43, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x69, 0x64,
+	0x63, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x69,
+	0x64, 0x63, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72,
+	0x65, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x76, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x67, 0x61, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x42, 0x29, 0x5a, 0x27,
+	0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f,
+	0x6c, 0x75, 0x63, 0x69, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x71, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -904,36 +1115,39 @@ func file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDescGZIP() []byte
 	return file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_goTypes = []interface{}{
 	(*DelegationToken)(nil),         // 0: tokenserver.bq.DelegationToken
 	(*MachineToken)(nil),            // 1: tokenserver.bq.MachineToken
 	(*ProjectToken)(nil),            // 2: tokenserver.bq.ProjectToken
 	(*ServiceAccountToken)(nil),     // 3: tokenserver.bq.ServiceAccountToken
-	(messages.Subtoken_Kind)(0),     // 4: messages.Subtoken.Kind
-	(*timestamppb.Timestamp)(nil),   // 5: google.protobuf.Timestamp
-	(api.MachineTokenType)(0),       // 6: tokenserver.MachineTokenType
-	(v1.SignatureAlgorithm)(0),      // 7: tokenserver.minter.SignatureAlgorithm
-	(v1.ServiceAccountTokenKind)(0), // 8: tokenserver.minter.ServiceAccountTokenKind
+	(*OIDCExchangedToken)(nil),      // 4: tokenserver.bq.OIDCExchangedToken
+	(messages.Subtoken_Kind)(0),     // 5: messages.Subtoken.Kind
+	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+	(api.MachineTokenType)(0),       // 7: tokenserver.MachineTokenType
+	(v1.SignatureAlgorithm)(0),      // 8: tokenserver.minter.SignatureAlgorithm
+	(v1.ServiceAccountTokenKind)(0), // 9: tokenserver.minter.ServiceAccountTokenKind
 }
 var file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_depIdxs = []int32{
-	4,  // 0: tokenserver.bq.DelegationToken.token_kind:type_name -> messages.Subtoken.Kind
-	5,  // 1: tokenserver.bq.DelegationToken.issued_at:type_name -> google.protobuf.Timestamp
-	5,  // 2: tokenserver.bq.DelegationToken.expiration:type_name -> google.protobuf.Timestamp
-	6,  // 3: tokenserver.bq.MachineToken.token_type:type_name -> tokenserver.MachineTokenType
-	5,  // 4: tokenserver.bq.MachineToken.issued_at:type_name -> google.protobuf.Timestamp
-	5,  // 5: tokenserver.bq.MachineToken.expiration:type_name -> google.protobuf.Timestamp
-	7,  // 6: tokenserver.bq.MachineToken.signature_algorithm:type_name -> tokenserver.minter.SignatureAlgorithm
-	5,  // 7: tokenserver.bq.ProjectToken.requested_at:type_name -> google.protobuf.Timestamp
-	5,  // 8: tokenserver.bq.ProjectToken.expiration:type_name -> google.protobuf.Timestamp
-	8,  // 9: tokenserver.bq.ServiceAccountToken.kind:type_name -> tokenserver.minter.ServiceAccountTokenKind
-	5,  // 10: tokenserver.bq.ServiceAccountToken.requested_at:type_name -> google.protobuf.Timestamp
-	5,  // 11: tokenserver.bq.ServiceAccountToken.expiration:type_name -> google.protobuf.Timestamp
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	5,  // 0: tokenserver.bq.DelegationToken.token_kind:type_name -> messages.Subtoken.Kind
+	6,  // 1: tokenserver.bq.DelegationToken.issued_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: tokenserver.bq.DelegationToken.expiration:type_name -> google.protobuf.Timestamp
+	7,  // 3: tokenserver.bq.MachineToken.token_type:type_name -> tokenserver.MachineTokenType
+	6,  // 4: tokenserver.bq.MachineToken.issued_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: tokenserver.bq.MachineToken.expiration:type_name -> google.protobuf.Timestamp
+	8,  // 6: tokenserver.bq.MachineToken.signature_algorithm:type_name -> tokenserver.minter.SignatureAlgorithm
+	6,  // 7: tokenserver.bq.ProjectToken.requested_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: tokenserver.bq.ProjectToken.expiration:type_name -> google.protobuf.Timestamp
+	9,  // 9: tokenserver.bq.ServiceAccountToken.kind:type_name -> tokenserver.minter.ServiceAccountTokenKind
+	6,  // 10: tokenserver.bq.ServiceAccountToken.requested_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: tokenserver.bq.ServiceAccountToken.expiration:type_name -> google.protobuf.Timestamp
+	6,  // 12: tokenserver.bq.OIDCExchangedToken.issued_at:type_name -> google.protobuf.Timestamp
+	6,  // 13: tokenserver.bq.OIDCExchangedToken.expiration:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_init() }
@@ -990,6 +1204,18 @@ func file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCExchangedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -997,7 +1223,7 @@ func file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_tokenserver_api_bq_bq_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,974 +12,925 @@ func init() {
 			"tokenserver.minter.TokenMinter",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 125, 140, 35, 201,
-			117, 24, 206, 254, 224, 12, 167, 118, 111, 111, 182, 247, 155, 251,
-			85, 203, 189, 189, 33, 247, 56, 156, 143, 253, 184, 219, 185, 211,
-			201, 220, 25, 238, 46, 247, 102, 103, 70, 36, 231, 86, 119, 146,
-			127, 115, 77, 118, 113, 216, 183, 205, 110, 94, 119, 115, 230, 120,
-			247, 187, 159, 127, 74, 100, 24, 146, 108, 37, 178, 2, 197, 54,
-			244, 1, 25, 145, 12, 56, 10, 226, 4, 144, 99, 196, 134, 146,
-			192, 118, 132, 200, 86, 242, 135, 252, 33, 71, 73, 164, 32, 145,
-			100, 8, 48, 16, 64, 137, 164, 56, 130, 16, 188, 87, 85, 221,
-			77, 14, 103, 239, 36, 203, 130, 3, 100, 161, 131, 248, 186, 171,
-			171, 222, 123, 245, 234, 125, 213, 171, 26, 242, 249, 5, 114, 126,
-			219, 243, 182, 29, 54, 215, 243, 189, 208, 107, 246, 219, 115, 161,
-			221, 101, 65, 104, 118, 123, 37, 124, 100, 60, 202, 27, 148, 100,
-			131, 220, 211, 100, 170, 33, 219, 24, 39, 201, 100, 192, 90, 158,
-			107, 5, 39, 21, 170, 228, 181, 154, 4, 141, 163, 36, 237, 154,
-			174, 23, 156, 84, 169, 146, 79, 215, 56, 112, 243, 103, 21, 114,
-			164, 229, 117, 75, 35, 157, 222, 60, 20, 117, 185, 1, 143, 54,
-			148, 23, 23, 69, 147, 109, 207, 49, 221, 237, 146, 231, 111, 39,
-			112, 28, 244, 88, 48, 247, 192, 245, 118, 221, 24, 223, 94, 243,
-			123, 138, 242, 171, 170, 118, 123, 227, 230, 175, 171, 231, 110, 243,
-			175, 55, 196, 39, 165, 251, 204, 113, 158, 131, 15, 26, 240, 237,
-			221, 223, 159, 35, 147, 70, 250, 92, 234, 23, 21, 133, 252, 225,
-			65, 162, 28, 52, 180, 115, 41, 99, 241, 95, 29, 164, 248, 69,
-			203, 115, 232, 205, 126, 187, 205, 252, 128, 206, 82, 222, 215, 76,
-			64, 45, 51, 52, 169, 237, 134, 204, 111, 117, 76, 119, 155, 209,
-			182, 231, 119, 205, 144, 208, 101, 175, 55, 240, 237, 237, 78, 72,
-			23, 231, 231, 159, 18, 31, 208, 170, 219, 42, 81, 90, 118, 28,
-			138, 239, 2, 234, 179, 128, 249, 59, 204, 42, 17, 218, 9, 195,
-			94, 176, 52, 55, 103, 177, 29, 230, 120, 61, 230, 7, 146, 39,
-			45, 175, 203, 41, 109, 121, 206, 108, 147, 35, 49, 71, 8, 173,
-			49, 203, 14, 66, 223, 110, 246, 67, 219, 115, 169, 233, 90, 180,
-			31, 48, 106, 187, 52, 240, 250, 126, 139, 225, 147, 166, 237, 154,
-			254, 0, 241, 10, 138, 116, 215, 14, 59, 212, 243, 241, 255, 189,
-			126, 72, 104, 215, 179, 236, 182, 221, 50, 161, 135, 34, 53, 125,
-			70, 123, 204, 239, 218, 97, 200, 44, 218, 243, 189, 29, 219, 98,
-			22, 13, 59, 102, 72, 195, 14, 80, 231, 56, 222, 174, 237, 110,
-			83, 152, 82, 27, 62, 10, 224, 35, 66, 187, 44, 92, 34, 132,
-			194, 191, 203, 35, 136, 5, 212, 107, 75, 140, 90, 158, 197, 104,
-			183, 31, 132, 212, 103, 161, 105, 187, 216, 171, 217, 244, 118, 224,
-			149, 224, 24, 161, 174, 23, 218, 45, 86, 164, 97, 199, 14, 168,
-			99, 7, 33, 244, 144, 28, 209, 181, 70, 208, 177, 236, 160, 229,
-			152, 118, 151, 249, 165, 253, 144, 176, 221, 36, 47, 36, 18, 61,
-			223, 179, 250, 45, 22, 227, 65, 98, 68, 254, 74, 120, 16, 42,
-			168, 179, 188, 86, 191, 203, 220, 208, 148, 147, 52, 231, 249, 212,
-			11, 59, 204, 167, 93, 51, 100, 190, 109, 58, 65, 204, 106, 156,
-			160, 176, 195, 8, 77, 98, 31, 17, 181, 198, 108, 252, 18, 58,
-			118, 205, 46, 3, 132, 146, 178, 229, 122, 241, 59, 228, 187, 29,
-			6, 64, 145, 203, 187, 242, 252, 128, 118, 205, 1, 109, 50, 144,
-			20, 139, 134, 30, 101, 174, 229, 249, 1, 3, 161, 232, 249, 94,
-			215, 11, 25, 229, 60, 9, 3, 106, 49, 223, 222, 97, 22, 109,
-			251, 94, 151, 112, 46, 4, 94, 59, 220, 5, 49, 17, 18, 68,
-			131, 30, 107, 129, 4, 209, 158, 111, 131, 96, 249, 32, 59, 46,
-			151, 162, 32, 64, 220, 9, 109, 220, 169, 214, 105, 125, 253, 86,
-			227, 126, 185, 86, 161, 213, 58, 221, 168, 173, 63, 95, 93, 169,
-			172, 208, 155, 47, 208, 198, 157, 10, 93, 94, 223, 120, 161, 86,
-			189, 125, 167, 65, 239, 172, 175, 174, 84, 106, 117, 90, 94, 91,
-			161, 203, 235, 107, 141, 90, 245, 230, 102, 99, 189, 86, 39, 52,
-			87, 174, 211, 106, 61, 135, 111, 202, 107, 47, 208, 202, 59, 55,
-			106, 149, 122, 157, 174, 215, 104, 245, 222, 198, 106, 181, 178, 66,
-			239, 151, 107, 181, 242, 90, 163, 90, 169, 23, 105, 117, 109, 121,
-			117, 115, 165, 186, 118, 187, 72, 111, 110, 54, 232, 218, 122, 131,
-			208, 213, 234, 189, 106, 163, 178, 66, 27, 235, 69, 28, 118, 239,
-			119, 116, 253, 22, 189, 87, 169, 45, 223, 41, 175, 53, 202, 55,
-			171, 171, 213, 198, 11, 56, 224, 173, 106, 99, 13, 6, 187, 181,
-			94, 35, 180, 76, 55, 202, 181, 70, 117, 121, 115, 181, 92, 163,
-			27, 155, 181, 141, 245, 122, 133, 2, 101, 43, 213, 250, 242, 106,
-			185, 122, 175, 178, 82, 162, 213, 53, 186, 182, 78, 43, 207, 87,
-			214, 26, 180, 126, 167, 188, 186, 58, 76, 40, 161, 235, 247, 215,
-			42, 53, 192, 62, 73, 38, 189, 89, 161, 171, 213, 242, 205, 213,
-			10, 12, 133, 116, 174, 84, 107, 149, 229, 6, 16, 20, 255, 90,
-			174, 174, 84, 214, 26, 229, 213, 34, 161, 245, 141, 202, 114, 181,
-			188, 90, 164, 149, 119, 86, 238, 109, 172, 150, 107, 47, 20, 69,
-			167, 245, 202, 59, 54, 43, 107, 141, 106, 121, 149, 174, 148, 239,
-			149, 111, 87, 234, 52, 255, 102, 92, 217, 168, 173, 47, 111, 214,
-			42, 247, 0, 235, 245, 91, 180, 190, 121, 179, 222, 168, 54, 54,
-			27, 21, 122, 123, 125, 125, 5, 153, 93, 175, 212, 158, 175, 46,
-			87, 234, 79, 211, 213, 245, 58, 50, 108, 179, 94, 41, 18, 186,
-			82, 110, 148, 113, 232, 141, 218, 250, 173, 106, 163, 254, 52, 252,
-			190, 185, 89, 175, 34, 227, 170, 107, 141, 74, 173, 182, 185, 209,
-			168, 174, 175, 21, 232, 157, 245, 251, 149, 231, 43, 53, 186, 92,
-			222, 172, 87, 86, 144, 195, 235, 107, 64, 45, 200, 74, 101, 189,
-			246, 2, 116, 11, 124, 192, 25, 40, 210, 251, 119, 42, 141, 59,
-			149, 26, 48, 21, 185, 85, 6, 54, 212, 27, 181, 234, 114, 35,
-			217, 108, 189, 70, 27, 235, 181, 6, 73, 208, 73, 215, 42, 183,
-			87, 171, 183, 43, 107, 203, 21, 120, 189, 14, 221, 220, 175, 214,
-			43, 5, 90, 174, 85, 235, 208, 160, 138, 3, 211, 251, 229, 23,
-			232, 250, 38, 82, 13, 19, 181, 89, 175, 16, 254, 59, 33, 186,
-			69, 156, 79, 90, 189, 69, 203, 43, 207, 87, 1, 115, 209, 122,
-			99, 189, 94, 175, 10, 113, 65, 182, 45, 223, 17, 60, 47, 17,
-			146, 33, 138, 106, 104, 52, 117, 18, 126, 101, 12, 45, 151, 122,
-			154, 76, 17, 53, 115, 137, 255, 228, 15, 47, 166, 206, 227, 195,
-			243, 252, 39, 127, 248, 88, 170, 138, 15, 15, 240, 159, 252, 225,
-			165, 84, 17, 31, 42, 252, 39, 127, 248, 120, 106, 14, 31, 138,
-			159, 252, 225, 76, 42, 135, 15, 9, 255, 201, 31, 230, 83, 23,
-			240, 225, 99, 252, 231, 127, 58, 75, 84, 61, 101, 76, 252, 156,
-			2, 166, 47, 251, 239, 206, 210, 50, 141, 76, 47, 42, 72, 22,
-			48, 55, 12, 168, 73, 123, 158, 237, 134, 168, 214, 236, 46, 152,
-			25, 139, 245, 152, 107, 49, 23, 213, 162, 233, 14, 248, 243, 215,
-			60, 23, 181, 137, 227, 181, 76, 135, 208, 150, 233, 48, 215, 50,
-			253, 34, 101, 46, 104, 127, 139, 154, 208, 87, 203, 235, 243, 239,
-			132, 119, 128, 186, 180, 237, 155, 173, 216, 98, 200, 23, 96, 16,
-			192, 85, 64, 24, 44, 166, 231, 112, 165, 72, 27, 29, 38, 58,
-			178, 193, 148, 58, 102, 104, 239, 48, 80, 106, 166, 75, 89, 207,
-			107, 117, 168, 25, 210, 205, 198, 50, 237, 218, 150, 139, 26, 221,
-			115, 9, 189, 107, 186, 125, 48, 3, 11, 69, 186, 112, 227, 201,
-			249, 162, 84, 212, 61, 223, 115, 88, 47, 180, 91, 244, 182, 207,
-			182, 61, 223, 54, 221, 8, 123, 186, 219, 177, 91, 29, 202, 94,
-			13, 25, 224, 132, 10, 122, 76, 171, 166, 217, 122, 176, 107, 250,
-			208, 194, 163, 3, 102, 250, 212, 115, 25, 40, 64, 48, 249, 93,
-			219, 237, 135, 12, 237, 37, 189, 62, 31, 209, 231, 120, 238, 118,
-			137, 174, 50, 179, 23, 147, 236, 51, 154, 11, 186, 204, 244, 153,
-			149, 163, 129, 199, 13, 176, 235, 81, 135, 153, 61, 34, 154, 209,
-			208, 108, 58, 12, 40, 119, 25, 3, 190, 182, 61, 159, 187, 34,
-			61, 176, 173, 220, 160, 247, 3, 176, 74, 38, 125, 215, 226, 213,
-			217, 142, 215, 247, 169, 99, 187, 204, 244, 9, 197, 222, 127, 58,
-			255, 112, 167, 3, 230, 115, 14, 91, 22, 80, 139, 119, 24, 245,
-			209, 203, 177, 3, 180, 9, 116, 126, 126, 126, 97, 22, 255, 215,
-			152, 159, 95, 194, 255, 189, 8, 164, 223, 184, 113, 227, 198, 236,
-			194, 226, 236, 149, 133, 198, 226, 149, 165, 107, 55, 150, 174, 221,
-			40, 221, 144, 255, 94, 44, 209, 155, 3, 2, 19, 25, 250, 118,
-			43, 4, 4, 67, 65, 34, 246, 94, 164, 187, 140, 50, 55, 232,
-			251, 140, 63, 221, 101, 180, 5, 92, 246, 220, 29, 230, 135, 124,
-			126, 185, 81, 162, 239, 170, 221, 90, 38, 244, 202, 149, 43, 55,
-			98, 90, 118, 119, 119, 75, 54, 11, 219, 232, 33, 250, 237, 22,
-			252, 7, 45, 74, 225, 171, 97, 1, 60, 54, 70, 97, 100, 119,
-			59, 0, 162, 46, 210, 202, 171, 102, 183, 231, 176, 128, 16, 249,
-			147, 46, 44, 209, 101, 175, 219, 235, 135, 44, 177, 22, 112, 192,
-			141, 245, 122, 245, 157, 244, 37, 224, 76, 190, 240, 82, 73, 184,
-			60, 113, 163, 200, 249, 124, 154, 191, 137, 157, 231, 128, 133, 91,
-			98, 130, 243, 248, 249, 218, 230, 234, 106, 161, 48, 182, 29, 202,
-			123, 126, 190, 240, 116, 2, 167, 197, 55, 195, 105, 155, 133, 208,
-			139, 215, 182, 204, 65, 2, 183, 32, 244, 251, 173, 16, 7, 216,
-			49, 29, 26, 238, 136, 17, 135, 154, 63, 30, 238, 20, 41, 34,
-			244, 244, 143, 74, 210, 78, 41, 220, 1, 232, 97, 20, 241, 70,
-			253, 128, 181, 232, 101, 186, 48, 63, 63, 76, 225, 149, 125, 41,
-			188, 111, 187, 87, 22, 233, 75, 183, 89, 88, 31, 4, 33, 235,
-			194, 235, 114, 112, 203, 118, 88, 99, 120, 34, 110, 85, 87, 43,
-			141, 234, 189, 10, 109, 135, 2, 141, 253, 190, 121, 188, 29, 74,
-			76, 55, 171, 107, 141, 235, 87, 105, 104, 183, 30, 4, 244, 109,
-			52, 159, 207, 243, 39, 133, 118, 88, 178, 118, 239, 216, 219, 157,
-			21, 51, 196, 175, 10, 244, 153, 103, 232, 149, 197, 2, 253, 127,
-			41, 190, 91, 245, 118, 229, 43, 201, 183, 185, 57, 90, 6, 124,
-			45, 111, 55, 192, 46, 97, 177, 44, 204, 207, 39, 116, 88, 80,
-			138, 26, 112, 45, 181, 112, 125, 239, 50, 138, 122, 131, 207, 23,
-			174, 95, 189, 122, 245, 201, 43, 215, 231, 99, 181, 209, 100, 109,
-			207, 103, 116, 211, 181, 95, 149, 189, 220, 120, 114, 126, 180, 151,
-			210, 143, 54, 153, 121, 78, 63, 205, 231, 57, 83, 230, 112, 178,
-			224, 95, 129, 206, 38, 209, 121, 19, 9, 134, 126, 128, 93, 178,
-			159, 75, 137, 126, 80, 0, 10, 67, 2, 112, 117, 95, 1, 184,
-			107, 238, 152, 244, 37, 62, 145, 165, 86, 223, 247, 153, 27, 66,
-			147, 123, 182, 227, 216, 65, 66, 0, 64, 155, 210, 46, 62, 165,
-			111, 163, 251, 127, 240, 16, 49, 167, 111, 139, 159, 150, 92, 182,
-			123, 179, 111, 59, 22, 243, 243, 5, 32, 172, 46, 56, 36, 134,
-			224, 140, 41, 240, 190, 224, 31, 180, 89, 227, 180, 219, 110, 8,
-			148, 139, 150, 156, 116, 65, 54, 114, 160, 80, 106, 66, 207, 136,
-			75, 204, 131, 107, 111, 194, 131, 170, 27, 132, 166, 27, 150, 92,
-			111, 55, 65, 182, 120, 74, 93, 111, 151, 190, 141, 14, 181, 121,
-			40, 165, 49, 226, 111, 78, 178, 235, 237, 150, 182, 89, 88, 1,
-			97, 227, 207, 242, 133, 4, 229, 195, 212, 139, 198, 0, 228, 247,
-			161, 244, 250, 190, 148, 138, 249, 146, 126, 6, 221, 24, 132, 29,
-			30, 72, 12, 9, 90, 114, 162, 242, 133, 81, 41, 188, 205, 194,
-			229, 120, 222, 243, 5, 212, 245, 119, 235, 235, 107, 244, 158, 217,
-			235, 217, 238, 54, 33, 180, 234, 242, 39, 60, 106, 47, 162, 27,
-			144, 224, 211, 160, 135, 166, 110, 200, 113, 225, 166, 67, 248, 12,
-			4, 13, 208, 15, 101, 127, 248, 80, 224, 187, 152, 224, 182, 20,
-			121, 55, 252, 41, 12, 150, 123, 29, 252, 134, 55, 102, 95, 239,
-			122, 110, 216, 121, 99, 246, 117, 203, 28, 188, 209, 120, 29, 140,
-			247, 27, 75, 175, 119, 109, 247, 141, 165, 215, 3, 214, 122, 227,
-			93, 165, 215, 193, 93, 130, 37, 251, 198, 79, 191, 152, 35, 116,
-			183, 195, 124, 70, 249, 215, 208, 145, 233, 236, 154, 131, 128, 178,
-			87, 193, 131, 131, 96, 143, 251, 2, 109, 240, 2, 44, 123, 219,
-			14, 3, 112, 106, 28, 70, 197, 72, 69, 138, 67, 21, 9, 229,
-			131, 21, 41, 142, 86, 68, 99, 139, 67, 162, 95, 242, 26, 243,
-			189, 217, 158, 105, 89, 60, 124, 12, 119, 61, 217, 27, 51, 91,
-			29, 238, 147, 73, 63, 14, 252, 63, 161, 82, 138, 194, 131, 2,
-			67, 190, 237, 209, 126, 15, 221, 4, 249, 105, 222, 46, 177, 146,
-			120, 184, 48, 222, 219, 43, 20, 9, 142, 239, 245, 120, 207, 124,
-			164, 220, 139, 57, 26, 244, 219, 109, 251, 85, 240, 71, 237, 150,
-			9, 14, 22, 204, 34, 200, 1, 122, 162, 249, 220, 102, 99, 57,
-			87, 120, 122, 232, 41, 225, 14, 227, 43, 125, 219, 103, 86, 137,
-			150, 41, 166, 87, 174, 112, 97, 8, 48, 38, 183, 95, 99, 62,
-			13, 58, 94, 223, 177, 36, 43, 251, 1, 67, 111, 50, 111, 6,
-			209, 104, 22, 109, 14, 8, 160, 81, 128, 9, 112, 33, 10, 118,
-			185, 75, 179, 87, 148, 128, 145, 230, 208, 80, 61, 211, 15, 226,
-			97, 154, 140, 80, 244, 233, 192, 195, 105, 181, 88, 47, 164, 77,
-			47, 236, 224, 152, 240, 45, 79, 26, 72, 26, 130, 61, 120, 128,
-			219, 235, 181, 219, 1, 11, 209, 93, 187, 229, 249, 148, 241, 181,
-			86, 164, 185, 197, 249, 133, 39, 193, 58, 44, 92, 107, 204, 47,
-			44, 93, 153, 95, 90, 184, 86, 154, 95, 120, 49, 39, 164, 59,
-			160, 8, 71, 230, 165, 103, 6, 33, 161, 216, 18, 199, 247, 220,
-			216, 111, 190, 86, 164, 208, 91, 73, 44, 32, 115, 199, 172, 183,
-			124, 187, 23, 22, 193, 219, 29, 114, 213, 76, 10, 230, 145, 122,
-			205, 151, 89, 43, 228, 94, 30, 184, 142, 92, 216, 185, 60, 162,
-			248, 131, 186, 178, 76, 223, 34, 244, 93, 161, 87, 173, 175, 215,
-			113, 145, 229, 11, 99, 28, 212, 82, 215, 123, 205, 118, 28, 19,
-			87, 23, 115, 103, 55, 235, 115, 150, 215, 10, 230, 238, 179, 230,
-			92, 140, 202, 92, 141, 181, 153, 207, 220, 22, 155, 187, 237, 120,
-			77, 211, 217, 90, 71, 28, 130, 57, 64, 104, 46, 49, 72, 1,
-			115, 87, 29, 207, 42, 1, 49, 92, 211, 20, 113, 157, 115, 148,
-			232, 75, 224, 49, 2, 211, 75, 242, 199, 75, 146, 32, 32, 181,
-			201, 36, 181, 204, 34, 99, 73, 36, 244, 93, 47, 5, 161, 223,
-			198, 79, 19, 20, 121, 173, 160, 212, 227, 154, 13, 104, 89, 156,
-			115, 236, 166, 111, 250, 3, 116, 187, 75, 157, 176, 235, 92, 196,
-			95, 242, 219, 2, 230, 92, 72, 36, 200, 114, 144, 160, 199, 90,
-			116, 230, 210, 11, 179, 151, 186, 179, 151, 172, 198, 165, 59, 75,
-			151, 238, 45, 93, 170, 151, 46, 181, 95, 156, 41, 209, 85, 251,
-			1, 219, 181, 3, 134, 97, 14, 48, 40, 158, 165, 126, 192, 120,
-			111, 119, 61, 203, 68, 97, 157, 9, 232, 187, 94, 170, 214, 215,
-			165, 83, 115, 139, 43, 43, 75, 128, 249, 194, 75, 63, 157, 231,
-			153, 74, 161, 231, 94, 246, 44, 62, 19, 240, 99, 22, 227, 5,
-			179, 103, 227, 132, 200, 167, 60, 138, 224, 184, 206, 237, 237, 27,
-			233, 148, 3, 92, 90, 92, 185, 180, 184, 66, 104, 1, 24, 233,
-			53, 49, 67, 104, 10, 58, 67, 230, 211, 150, 217, 195, 5, 226,
-			181, 233, 54, 115, 153, 111, 242, 165, 38, 151, 89, 192, 213, 114,
-			196, 255, 18, 33, 132, 28, 32, 154, 158, 82, 12, 253, 231, 148,
-			204, 97, 242, 49, 133, 232, 122, 74, 77, 25, 250, 7, 20, 245,
-			104, 246, 131, 10, 173, 197, 17, 174, 148, 125, 175, 141, 34, 143,
-			60, 14, 108, 183, 149, 244, 178, 200, 120, 55, 139, 222, 235, 7,
-			33, 200, 194, 195, 194, 34, 50, 46, 46, 122, 145, 218, 110, 203,
-			233, 7, 246, 14, 4, 138, 143, 144, 52, 160, 151, 70, 252, 38,
-			37, 168, 0, 152, 121, 84, 130, 26, 128, 198, 17, 242, 117, 78,
-			140, 98, 232, 31, 82, 84, 35, 251, 39, 10, 93, 243, 220, 89,
-			151, 109, 243, 56, 120, 40, 154, 54, 101, 212, 8, 129, 228, 248,
-			104, 122, 77, 124, 24, 5, 152, 59, 166, 211, 103, 1, 79, 73,
-			198, 157, 97, 226, 52, 8, 109, 199, 161, 29, 115, 135, 81, 55,
-			57, 38, 118, 45, 62, 36, 60, 122, 227, 1, 122, 219, 243, 33,
-			48, 150, 217, 131, 81, 134, 137, 160, 177, 40, 254, 35, 99, 152,
-			162, 164, 145, 78, 201, 20, 5, 201, 206, 60, 34, 65, 13, 192,
-			233, 195, 205, 9, 174, 95, 201, 123, 242, 164, 178, 237, 149, 90,
-			29, 223, 235, 218, 253, 46, 10, 169, 211, 111, 217, 115, 152, 112,
-			247, 231, 204, 126, 216, 153, 179, 152, 131, 152, 123, 238, 92, 151,
-			5, 129, 185, 205, 130, 196, 51, 177, 223, 145, 145, 175, 114, 159,
-			85, 200, 163, 43, 209, 235, 134, 247, 128, 185, 198, 105, 50, 21,
-			216, 219, 46, 243, 183, 108, 11, 119, 54, 166, 106, 25, 254, 160,
-			106, 25, 143, 145, 67, 240, 219, 118, 183, 183, 30, 176, 1, 180,
-			208, 176, 197, 65, 241, 244, 57, 54, 168, 90, 70, 158, 76, 247,
-			30, 180, 130, 133, 173, 160, 99, 46, 94, 187, 190, 21, 216, 219,
-			39, 117, 170, 228, 15, 214, 14, 225, 243, 58, 62, 174, 219, 219,
-			198, 28, 57, 18, 89, 39, 107, 43, 232, 55, 67, 192, 225, 100,
-			26, 27, 27, 241, 171, 186, 120, 115, 87, 207, 40, 211, 106, 238,
-			3, 26, 201, 200, 71, 198, 19, 68, 127, 96, 187, 214, 201, 12,
-			85, 242, 135, 22, 79, 148, 36, 117, 37, 217, 162, 244, 156, 237,
-			90, 53, 108, 100, 156, 39, 7, 228, 40, 128, 189, 142, 59, 58,
-			68, 62, 170, 90, 198, 44, 49, 4, 195, 152, 181, 101, 91, 204,
-			13, 237, 112, 128, 59, 63, 83, 181, 195, 209, 155, 170, 120, 1,
-			205, 193, 236, 178, 32, 244, 252, 184, 249, 36, 111, 30, 189, 137,
-			154, 95, 36, 143, 180, 124, 134, 220, 222, 2, 177, 65, 6, 107,
-			181, 131, 242, 33, 232, 12, 227, 9, 114, 120, 199, 116, 108, 203,
-			14, 7, 91, 86, 223, 199, 23, 200, 231, 116, 109, 90, 190, 88,
-			17, 207, 141, 44, 201, 152, 125, 203, 6, 203, 112, 50, 77, 53,
-			152, 45, 9, 195, 59, 16, 14, 187, 197, 130, 147, 19, 252, 157,
-			132, 13, 131, 232, 161, 185, 29, 156, 156, 194, 231, 248, 59, 119,
-			141, 232, 192, 42, 99, 154, 28, 220, 92, 123, 110, 109, 253, 254,
-			218, 214, 115, 213, 181, 149, 233, 148, 113, 154, 156, 184, 89, 41,
-			215, 42, 181, 173, 149, 202, 106, 229, 118, 185, 81, 93, 95, 219,
-			106, 172, 63, 87, 89, 155, 86, 110, 94, 127, 241, 234, 143, 34,
-			152, 119, 127, 45, 71, 38, 12, 157, 164, 94, 87, 200, 247, 84,
-			220, 160, 34, 41, 99, 241, 215, 149, 161, 189, 166, 133, 107, 232,
-			23, 173, 110, 46, 87, 105, 185, 31, 118, 60, 63, 40, 237, 179,
-			225, 180, 25, 160, 34, 21, 105, 253, 120, 123, 198, 14, 232, 182,
-			183, 195, 124, 23, 124, 70, 215, 18, 187, 13, 229, 158, 217, 130,
-			142, 237, 22, 115, 193, 154, 60, 207, 252, 192, 246, 92, 186, 88,
-			154, 151, 11, 157, 91, 195, 182, 215, 119, 45, 153, 83, 91, 173,
-			46, 87, 214, 234, 21, 218, 182, 29, 86, 34, 139, 255, 82, 161,
-			13, 84, 207, 54, 207, 92, 181, 188, 158, 45, 246, 23, 40, 240,
-			96, 182, 55, 40, 109, 219, 225, 18, 161, 102, 175, 199, 220, 109,
-			219, 101, 115, 45, 175, 219, 243, 92, 80, 208, 201, 159, 200, 37,
-			92, 159, 123, 22, 44, 129, 176, 162, 107, 135, 75, 180, 125, 125,
-			225, 26, 123, 242, 218, 141, 197, 235, 11, 55, 174, 223, 88, 104,
-			179, 27, 109, 243, 250, 213, 27, 55, 158, 124, 234, 169, 121, 182,
-			120, 245, 198, 252, 252, 147, 139, 86, 115, 113, 129, 16, 186, 140,
-			219, 119, 193, 18, 245, 153, 107, 118, 153, 69, 123, 102, 235, 129,
-			185, 141, 190, 216, 140, 156, 130, 153, 40, 153, 123, 48, 117, 88,
-			164, 83, 15, 165, 158, 147, 41, 90, 248, 249, 81, 5, 211, 169,
-			250, 137, 212, 130, 146, 253, 59, 10, 173, 131, 42, 176, 98, 159,
-			210, 162, 209, 50, 195, 140, 154, 29, 80, 209, 59, 240, 227, 101,
-			80, 138, 166, 75, 153, 203, 189, 29, 201, 88, 223, 183, 133, 95,
-			59, 166, 35, 217, 1, 65, 39, 17, 220, 105, 208, 46, 102, 216,
-			247, 49, 245, 184, 198, 94, 13, 105, 117, 101, 137, 94, 71, 115,
-			136, 198, 80, 59, 145, 57, 193, 127, 79, 25, 218, 73, 245, 17,
-			114, 0, 44, 201, 84, 202, 208, 78, 30, 56, 72, 14, 130, 70,
-			157, 130, 102, 9, 72, 229, 208, 135, 85, 97, 64, 181, 11, 234,
-			137, 236, 207, 170, 84, 174, 85, 105, 100, 112, 189, 112, 188, 3,
-			78, 59, 10, 88, 68, 114, 53, 18, 20, 147, 206, 136, 230, 75,
-			207, 152, 189, 222, 172, 109, 61, 59, 35, 195, 43, 207, 167, 51,
-			253, 128, 249, 75, 207, 136, 38, 179, 102, 11, 13, 201, 44, 235,
-			154, 182, 243, 236, 12, 17, 45, 133, 19, 138, 126, 114, 203, 12,
-			4, 151, 204, 94, 207, 247, 122, 190, 13, 206, 103, 139, 249, 33,
-			223, 226, 100, 20, 148, 11, 163, 187, 96, 187, 154, 140, 190, 210,
-			103, 62, 136, 95, 126, 199, 54, 105, 189, 190, 90, 192, 56, 140,
-			231, 130, 251, 77, 199, 110, 209, 7, 108, 0, 2, 0, 142, 63,
-			188, 137, 248, 74, 119, 152, 31, 109, 155, 150, 8, 231, 17, 152,
-			109, 237, 130, 154, 145, 144, 98, 104, 23, 166, 12, 9, 105, 134,
-			118, 225, 216, 113, 242, 95, 85, 97, 179, 181, 203, 234, 153, 236,
-			159, 168, 180, 186, 194, 57, 7, 67, 225, 158, 28, 12, 212, 53,
-			31, 200, 24, 98, 104, 46, 27, 24, 227, 9, 254, 117, 251, 78,
-			104, 67, 32, 13, 150, 121, 135, 65, 15, 1, 152, 119, 211, 29,
-			208, 174, 215, 101, 113, 174, 126, 9, 93, 190, 168, 119, 151, 237,
-			146, 184, 223, 128, 71, 120, 208, 162, 201, 96, 84, 223, 11, 49,
-			160, 240, 250, 33, 205, 55, 251, 210, 216, 163, 42, 197, 239, 147,
-			196, 71, 137, 97, 199, 110, 163, 115, 204, 85, 10, 227, 19, 142,
-			225, 137, 207, 90, 161, 51, 160, 124, 183, 32, 128, 72, 34, 28,
-			211, 94, 88, 68, 2, 100, 200, 96, 209, 14, 232, 226, 213, 78,
-			137, 214, 61, 26, 47, 114, 222, 115, 0, 92, 152, 9, 169, 3,
-			148, 59, 158, 187, 141, 106, 202, 116, 241, 3, 57, 33, 74, 26,
-			216, 44, 39, 68, 1, 166, 79, 157, 144, 144, 102, 104, 151, 179,
-			167, 201, 38, 206, 135, 106, 104, 179, 234, 217, 236, 29, 36, 37,
-			98, 205, 18, 221, 120, 110, 185, 190, 176, 181, 179, 176, 117, 237,
-			137, 250, 157, 242, 226, 181, 235, 249, 49, 246, 183, 72, 135, 141,
-			124, 33, 66, 64, 77, 67, 191, 147, 18, 82, 12, 109, 54, 115,
-			82, 66, 154, 161, 205, 158, 62, 67, 94, 64, 4, 52, 67, 155,
-			87, 105, 118, 149, 214, 247, 95, 224, 37, 90, 13, 103, 18, 171,
-			27, 248, 131, 18, 141, 234, 118, 212, 133, 136, 144, 208, 210, 208,
-			183, 68, 66, 83, 12, 109, 62, 115, 90, 66, 48, 238, 185, 243,
-			164, 78, 84, 93, 49, 244, 39, 83, 175, 43, 217, 219, 98, 77,
-			183, 65, 231, 236, 118, 34, 222, 35, 4, 242, 133, 102, 5, 150,
-			124, 232, 193, 251, 46, 79, 61, 12, 233, 154, 133, 121, 161, 108,
-			128, 233, 79, 102, 166, 201, 65, 162, 235, 10, 40, 198, 167, 212,
-			91, 26, 14, 174, 160, 34, 122, 106, 242, 0, 249, 21, 141, 76,
-			0, 8, 90, 229, 167, 244, 99, 217, 247, 107, 92, 49, 162, 218,
-			160, 45, 51, 108, 117, 168, 231, 88, 114, 226, 81, 183, 88, 30,
-			204, 62, 58, 160, 51, 224, 169, 204, 208, 182, 205, 28, 139, 14,
-			88, 136, 18, 201, 155, 74, 243, 6, 45, 48, 123, 16, 237, 143,
-			240, 116, 206, 204, 62, 86, 122, 134, 175, 19, 111, 183, 200, 85,
-			2, 24, 30, 51, 180, 155, 182, 99, 135, 131, 18, 189, 217, 15,
-			41, 219, 97, 110, 216, 55, 29, 103, 64, 243, 24, 249, 155, 160,
-			84, 204, 214, 3, 38, 119, 112, 250, 61, 8, 107, 172, 2, 166,
-			120, 6, 68, 170, 157, 150, 135, 73, 45, 190, 156, 242, 162, 132,
-			32, 161, 149, 92, 143, 238, 154, 200, 91, 17, 226, 96, 113, 66,
-			183, 80, 146, 76, 33, 114, 195, 39, 244, 168, 185, 227, 217, 22,
-			50, 135, 123, 15, 1, 53, 91, 45, 244, 171, 16, 179, 136, 92,
-			201, 59, 175, 205, 121, 113, 225, 109, 116, 158, 154, 1, 161, 251,
-			48, 64, 180, 47, 17, 114, 136, 76, 242, 185, 81, 96, 114, 166,
-			99, 88, 53, 180, 159, 58, 114, 148, 252, 169, 34, 38, 79, 49,
-			180, 138, 78, 179, 159, 87, 112, 13, 113, 193, 29, 226, 191, 80,
-			91, 129, 80, 76, 239, 156, 141, 93, 233, 89, 156, 175, 217, 231,
-			23, 232, 157, 70, 99, 131, 118, 152, 105, 97, 37, 71, 131, 91,
-			60, 116, 193, 56, 131, 90, 29, 214, 122, 128, 188, 142, 246, 143,
-			60, 57, 209, 98, 84, 174, 207, 160, 53, 65, 89, 5, 89, 70,
-			237, 47, 252, 203, 0, 102, 190, 229, 117, 49, 27, 6, 158, 199,
-			204, 94, 15, 118, 38, 65, 185, 130, 148, 157, 142, 97, 213, 208,
-			42, 231, 206, 19, 11, 68, 26, 100, 246, 57, 117, 58, 123, 159,
-			222, 7, 177, 68, 66, 1, 25, 174, 253, 2, 36, 31, 4, 114,
-			133, 181, 109, 151, 5, 180, 227, 237, 82, 59, 50, 129, 160, 146,
-			75, 180, 206, 192, 243, 234, 118, 49, 8, 5, 113, 3, 175, 146,
-			50, 183, 223, 21, 107, 88, 81, 83, 19, 48, 204, 132, 132, 20,
-			67, 123, 110, 242, 128, 132, 52, 67, 123, 238, 208, 163, 100, 7,
-			241, 81, 12, 109, 67, 61, 153, 181, 227, 69, 236, 199, 94, 158,
-			84, 40, 102, 16, 9, 23, 38, 142, 98, 133, 205, 197, 8, 48,
-			222, 148, 230, 194, 241, 182, 183, 113, 23, 17, 68, 213, 55, 91,
-			104, 154, 122, 125, 191, 231, 5, 44, 136, 48, 4, 93, 187, 33,
-			180, 140, 130, 60, 219, 200, 28, 145, 144, 102, 104, 27, 199, 79,
-			144, 119, 35, 134, 170, 161, 109, 170, 52, 187, 30, 187, 14, 187,
-			29, 47, 96, 9, 189, 98, 7, 145, 206, 177, 112, 247, 52, 114,
-			11, 218, 162, 222, 198, 239, 210, 28, 247, 15, 184, 63, 144, 139,
-			240, 0, 149, 187, 41, 116, 190, 130, 42, 119, 115, 234, 180, 132,
-			52, 67, 219, 60, 119, 158, 252, 27, 5, 17, 209, 12, 237, 221,
-			42, 205, 254, 182, 66, 239, 119, 60, 41, 29, 123, 28, 22, 92,
-			117, 48, 95, 93, 84, 68, 123, 101, 133, 218, 28, 43, 192, 39,
-			129, 186, 48, 224, 182, 79, 189, 93, 151, 208, 168, 181, 231, 39,
-			4, 192, 164, 150, 221, 198, 76, 85, 72, 109, 75, 246, 20, 73,
-			143, 217, 226, 42, 6, 92, 59, 106, 119, 123, 204, 15, 60, 55,
-			97, 5, 35, 170, 65, 199, 191, 59, 162, 26, 116, 252, 187, 35,
-			170, 65, 199, 191, 251, 220, 121, 242, 26, 18, 173, 27, 154, 169,
-			102, 179, 93, 122, 31, 148, 86, 60, 218, 238, 144, 76, 160, 94,
-			66, 181, 102, 135, 66, 103, 5, 220, 3, 64, 131, 191, 214, 239,
-			54, 185, 92, 201, 156, 9, 207, 147, 240, 141, 168, 60, 166, 75,
-			162, 172, 76, 33, 194, 82, 79, 195, 224, 82, 70, 116, 197, 208,
-			204, 204, 49, 9, 105, 134, 102, 158, 60, 69, 150, 17, 203, 180,
-			161, 89, 234, 185, 236, 117, 122, 199, 219, 229, 123, 58, 67, 124,
-			105, 121, 110, 96, 91, 12, 44, 159, 208, 163, 182, 43, 81, 137,
-			135, 75, 99, 47, 114, 184, 180, 98, 104, 86, 230, 148, 132, 52,
-			67, 179, 206, 156, 37, 191, 199, 69, 97, 194, 208, 94, 86, 207,
-			103, 127, 147, 139, 2, 76, 142, 200, 10, 141, 8, 67, 197, 4,
-			183, 36, 100, 221, 104, 2, 221, 120, 102, 133, 144, 230, 89, 105,
-			187, 52, 34, 158, 133, 34, 53, 105, 110, 219, 247, 250, 189, 165,
-			103, 32, 192, 120, 54, 39, 61, 216, 34, 72, 4, 86, 81, 153,
-			14, 205, 93, 206, 201, 110, 184, 11, 212, 101, 166, 27, 208, 92,
-			217, 29, 208, 38, 51, 125, 204, 129, 97, 230, 46, 102, 72, 44,
-			251, 19, 58, 144, 17, 65, 105, 67, 123, 249, 192, 97, 9, 41,
-			134, 246, 178, 145, 149, 144, 102, 104, 47, 159, 61, 199, 83, 72,
-			138, 58, 105, 104, 175, 168, 231, 179, 127, 170, 112, 45, 22, 169,
-			92, 153, 255, 230, 201, 232, 97, 86, 172, 138, 122, 184, 168, 113,
-			94, 148, 130, 113, 211, 42, 35, 1, 193, 29, 27, 252, 76, 206,
-			24, 233, 244, 115, 159, 63, 87, 16, 225, 227, 190, 99, 209, 123,
-			230, 128, 154, 78, 224, 97, 29, 155, 9, 51, 189, 135, 91, 194,
-			99, 36, 17, 191, 28, 39, 66, 44, 230, 207, 164, 14, 116, 70,
-			80, 218, 208, 94, 137, 248, 51, 169, 24, 218, 43, 17, 127, 38,
-			53, 67, 123, 229, 236, 57, 242, 191, 84, 228, 79, 198, 208, 94,
-			83, 79, 103, 255, 66, 165, 101, 191, 105, 135, 190, 233, 15, 192,
-			79, 93, 194, 68, 23, 237, 153, 182, 31, 80, 214, 109, 50, 220,
-			21, 177, 93, 204, 2, 75, 113, 109, 162, 126, 99, 59, 204, 79,
-			168, 24, 59, 44, 65, 152, 234, 238, 176, 1, 109, 217, 126, 171,
-			223, 13, 66, 19, 86, 144, 215, 166, 187, 157, 193, 136, 180, 251,
-			76, 106, 194, 186, 100, 54, 178, 44, 226, 149, 108, 220, 53, 7,
-			82, 54, 186, 168, 187, 77, 139, 215, 42, 154, 142, 84, 175, 175,
-			161, 250, 32, 212, 98, 45, 27, 2, 248, 160, 68, 55, 28, 102,
-			6, 168, 191, 40, 123, 53, 244, 89, 151, 57, 3, 8, 58, 89,
-			187, 239, 56, 131, 34, 245, 92, 103, 192, 149, 193, 192, 235, 243,
-			98, 66, 207, 225, 33, 23, 172, 194, 128, 72, 189, 156, 112, 210,
-			29, 219, 125, 128, 22, 3, 55, 124, 250, 166, 111, 186, 33, 147,
-			113, 161, 36, 194, 118, 119, 60, 103, 71, 166, 25, 48, 227, 46,
-			210, 219, 230, 118, 108, 89, 50, 58, 112, 63, 130, 210, 134, 246,
-			90, 52, 107, 25, 197, 208, 94, 51, 142, 75, 72, 51, 180, 215,
-			78, 101, 163, 28, 224, 23, 78, 145, 167, 198, 166, 90, 184, 119,
-			35, 242, 45, 61, 123, 174, 107, 182, 58, 182, 203, 182, 184, 192,
-			241, 180, 223, 129, 68, 163, 220, 191, 80, 200, 244, 61, 222, 8,
-			93, 149, 155, 158, 53, 48, 46, 144, 131, 242, 195, 246, 43, 150,
-			43, 178, 94, 7, 196, 179, 91, 175, 88, 152, 29, 180, 131, 160,
-			207, 172, 173, 230, 64, 102, 7, 249, 131, 155, 131, 196, 75, 51,
-			196, 132, 149, 46, 95, 150, 67, 35, 75, 50, 50, 68, 194, 180,
-			155, 94, 139, 96, 227, 8, 73, 183, 204, 45, 219, 194, 196, 159,
-			86, 211, 91, 102, 213, 50, 78, 144, 73, 8, 117, 183, 2, 23,
-			243, 105, 7, 107, 19, 0, 214, 221, 187, 122, 102, 98, 122, 50,
-			247, 128, 28, 77, 18, 80, 17, 25, 6, 227, 44, 33, 60, 189,
-			215, 244, 44, 158, 184, 59, 88, 155, 10, 35, 26, 143, 145, 9,
-			145, 185, 228, 216, 167, 31, 96, 202, 242, 44, 33, 126, 96, 138,
-			104, 3, 113, 63, 88, 155, 242, 3, 147, 167, 42, 47, 63, 51,
-			204, 173, 198, 160, 199, 146, 89, 178, 198, 11, 27, 149, 233, 148,
-			113, 156, 24, 171, 155, 203, 213, 173, 123, 229, 229, 59, 213, 181,
-			138, 72, 144, 169, 55, 23, 95, 156, 127, 43, 179, 246, 116, 2,
-			190, 251, 167, 199, 200, 132, 161, 235, 169, 187, 10, 249, 172, 130,
-			201, 49, 125, 76, 114, 236, 250, 223, 224, 228, 152, 200, 44, 77,
-			164, 142, 138, 204, 82, 38, 117, 91, 102, 150, 224, 231, 251, 20,
-			162, 166, 83, 134, 62, 157, 58, 162, 100, 223, 64, 66, 192, 219,
-			68, 223, 87, 136, 92, 28, 33, 13, 187, 115, 220, 125, 177, 93,
-			12, 141, 54, 204, 64, 148, 254, 222, 179, 221, 48, 57, 77, 184,
-			94, 171, 46, 104, 215, 161, 231, 37, 172, 53, 231, 172, 89, 162,
-			11, 60, 178, 75, 131, 19, 58, 157, 62, 70, 174, 16, 61, 141,
-			161, 219, 97, 245, 84, 238, 113, 158, 101, 16, 126, 12, 15, 201,
-			236, 128, 186, 30, 184, 252, 118, 40, 34, 91, 92, 203, 105, 30,
-			83, 28, 86, 15, 73, 72, 53, 180, 195, 39, 78, 146, 235, 216,
-			161, 98, 104, 134, 122, 42, 87, 224, 110, 23, 11, 146, 153, 175,
-			113, 130, 44, 251, 84, 240, 195, 163, 18, 82, 13, 205, 56, 113,
-			146, 124, 75, 229, 105, 185, 51, 169, 183, 43, 217, 255, 168, 210,
-			209, 181, 76, 45, 22, 180, 124, 187, 137, 74, 41, 100, 62, 40,
-			76, 94, 56, 5, 113, 182, 80, 111, 67, 92, 150, 121, 15, 225,
-			61, 137, 224, 173, 215, 199, 216, 38, 17, 197, 4, 81, 86, 14,
-			22, 152, 176, 114, 16, 187, 54, 225, 91, 143, 58, 166, 191, 205,
-			74, 124, 183, 150, 215, 192, 49, 51, 240, 92, 186, 203, 21, 114,
-			127, 200, 149, 2, 236, 130, 144, 153, 24, 99, 140, 156, 152, 40,
-			69, 187, 205, 132, 230, 93, 15, 131, 68, 158, 76, 178, 91, 190,
-			220, 239, 233, 249, 66, 237, 23, 120, 148, 100, 6, 65, 191, 59,
-			146, 35, 67, 62, 83, 23, 93, 188, 128, 111, 186, 7, 93, 211,
-			113, 236, 160, 67, 251, 182, 27, 94, 191, 138, 60, 218, 6, 218,
-			242, 48, 179, 190, 233, 90, 94, 151, 54, 29, 175, 137, 222, 151,
-			76, 50, 158, 201, 156, 140, 146, 140, 103, 147, 73, 198, 179, 67,
-			73, 198, 179, 67, 73, 70, 128, 126, 89, 238, 210, 105, 23, 213,
-			108, 246, 3, 138, 156, 174, 216, 213, 138, 221, 3, 190, 251, 58,
-			8, 104, 94, 78, 207, 173, 119, 172, 172, 21, 68, 170, 209, 14,
-			208, 164, 153, 173, 80, 166, 153, 77, 204, 14, 123, 46, 93, 19,
-			229, 243, 230, 16, 245, 40, 190, 88, 232, 209, 52, 3, 190, 145,
-			72, 226, 245, 52, 148, 234, 187, 56, 148, 234, 187, 56, 117, 44,
-			145, 234, 187, 120, 242, 20, 249, 132, 220, 158, 211, 10, 234, 137,
-			236, 135, 20, 105, 188, 169, 72, 101, 82, 244, 13, 31, 146, 42,
-			69, 15, 29, 147, 109, 3, 123, 200, 3, 142, 210, 12, 137, 144,
-			56, 58, 163, 129, 22, 4, 3, 17, 219, 37, 52, 7, 102, 127,
-			22, 63, 155, 21, 41, 130, 28, 69, 63, 116, 40, 75, 86, 24,
-			202, 146, 21, 162, 180, 37, 68, 110, 133, 99, 199, 201, 154, 204,
-			146, 21, 213, 19, 217, 50, 29, 118, 240, 105, 236, 124, 115, 55,
-			33, 49, 59, 16, 86, 112, 155, 86, 162, 53, 89, 112, 145, 76,
-			143, 21, 163, 145, 129, 83, 197, 104, 100, 136, 206, 138, 199, 142,
-			19, 95, 166, 199, 22, 212, 19, 89, 54, 38, 242, 120, 104, 96,
-			192, 3, 89, 158, 166, 185, 114, 125, 126, 30, 130, 237, 112, 108,
-			196, 187, 23, 59, 136, 169, 22, 34, 236, 32, 166, 90, 152, 58,
-			156, 200, 155, 45, 28, 59, 46, 37, 85, 55, 180, 235, 170, 1,
-			146, 90, 181, 184, 76, 45, 151, 249, 140, 112, 218, 35, 213, 177,
-			71, 212, 66, 143, 118, 205, 7, 108, 79, 204, 201, 2, 70, 171,
-			43, 60, 145, 100, 97, 238, 128, 155, 141, 33, 173, 238, 185, 109,
-			123, 155, 167, 175, 251, 174, 253, 74, 159, 109, 217, 22, 87, 186,
-			113, 10, 18, 98, 174, 235, 81, 246, 15, 98, 174, 235, 153, 71,
-			36, 164, 25, 218, 245, 233, 195, 228, 43, 60, 41, 157, 54, 180,
-			103, 213, 163, 217, 47, 170, 34, 9, 41, 116, 192, 168, 250, 123,
-			139, 52, 160, 135, 194, 93, 64, 238, 150, 208, 208, 219, 102, 88,
-			194, 194, 145, 117, 6, 98, 61, 183, 249, 116, 36, 250, 45, 70,
-			174, 99, 147, 145, 104, 16, 46, 232, 152, 143, 75, 160, 224, 179,
-			29, 143, 231, 162, 105, 190, 57, 160, 102, 192, 83, 231, 73, 70,
-			237, 118, 112, 92, 190, 144, 183, 237, 29, 208, 26, 137, 30, 112,
-			169, 208, 229, 218, 106, 1, 164, 32, 234, 13, 135, 11, 134, 138,
-			142, 138, 180, 235, 5, 33, 22, 181, 59, 160, 254, 0, 67, 31,
-			8, 241, 92, 202, 94, 237, 217, 254, 208, 151, 224, 55, 71, 243,
-			144, 70, 238, 202, 121, 128, 96, 244, 217, 204, 163, 18, 210, 12,
-			237, 89, 227, 8, 249, 111, 10, 79, 195, 222, 74, 221, 85, 178,
-			255, 69, 25, 107, 231, 0, 219, 93, 46, 89, 81, 122, 64, 36,
-			238, 19, 22, 210, 196, 189, 125, 17, 195, 50, 139, 112, 149, 54,
-			100, 192, 104, 222, 108, 135, 204, 23, 223, 202, 26, 55, 94, 148,
-			211, 52, 3, 118, 253, 106, 92, 3, 227, 155, 187, 188, 133, 237,
-			110, 23, 132, 59, 208, 119, 194, 152, 209, 121, 220, 175, 183, 18,
-			223, 70, 205, 1, 209, 190, 88, 130, 255, 223, 181, 249, 121, 218,
-			28, 132, 76, 148, 189, 199, 57, 226, 91, 153, 51, 228, 178, 76,
-			168, 221, 86, 79, 228, 206, 238, 103, 240, 193, 92, 71, 105, 177,
-			52, 52, 158, 76, 164, 197, 110, 103, 140, 68, 90, 236, 246, 177,
-			227, 228, 167, 100, 90, 236, 142, 122, 52, 119, 133, 218, 98, 129,
-			14, 9, 72, 207, 183, 119, 64, 18, 134, 54, 97, 228, 142, 68,
-			34, 193, 117, 39, 74, 177, 0, 206, 119, 166, 30, 77, 36, 184,
-			238, 24, 71, 72, 94, 38, 184, 170, 234, 137, 220, 233, 68, 166,
-			222, 107, 211, 153, 216, 205, 158, 73, 38, 171, 170, 17, 254, 128,
-			100, 53, 194, 31, 116, 94, 245, 216, 241, 40, 152, 249, 216, 175,
-			40, 164, 252, 214, 162, 25, 116, 97, 230, 118, 22, 248, 155, 45,
-			14, 139, 176, 198, 72, 180, 46, 241, 55, 217, 55, 59, 242, 153,
-			253, 241, 84, 82, 100, 127, 228, 96, 44, 247, 10, 57, 49, 234,
-			173, 214, 120, 48, 109, 60, 69, 78, 38, 246, 100, 56, 197, 34,
-			208, 22, 225, 204, 241, 248, 253, 208, 151, 103, 120, 233, 6, 206,
-			16, 134, 55, 7, 107, 241, 131, 220, 47, 168, 228, 200, 184, 241,
-			40, 57, 144, 80, 30, 98, 136, 228, 35, 227, 62, 57, 18, 117,
-			179, 101, 58, 219, 158, 111, 135, 157, 46, 142, 112, 104, 241, 241,
-			210, 222, 9, 40, 213, 101, 243, 178, 108, 93, 51, 130, 61, 207,
-			140, 39, 71, 3, 198, 3, 139, 217, 209, 131, 179, 177, 27, 152,
-			8, 38, 159, 145, 65, 94, 56, 232, 241, 112, 242, 208, 226, 217,
-			33, 68, 70, 195, 53, 17, 3, 194, 207, 187, 122, 38, 61, 61,
-			145, 251, 174, 66, 78, 238, 157, 131, 160, 231, 185, 1, 131, 1,
-			152, 239, 123, 254, 22, 104, 17, 228, 201, 232, 0, 130, 210, 10,
-			180, 90, 246, 44, 86, 155, 98, 242, 167, 113, 145, 60, 194, 191,
-			22, 162, 35, 98, 205, 131, 248, 240, 30, 127, 102, 172, 147, 67,
-			114, 114, 249, 160, 130, 3, 249, 113, 195, 140, 67, 178, 246, 72,
-			56, 132, 243, 12, 121, 84, 100, 34, 182, 118, 120, 188, 134, 156,
-			153, 170, 29, 18, 143, 69, 20, 151, 251, 199, 202, 112, 236, 252,
-			176, 30, 212, 113, 61, 24, 13, 98, 128, 168, 111, 13, 137, 246,
-			201, 99, 136, 255, 99, 227, 240, 95, 237, 183, 236, 228, 144, 119,
-			82, 181, 105, 103, 228, 217, 205, 131, 201, 89, 229, 165, 62, 119,
-			245, 204, 209, 233, 99, 185, 7, 100, 122, 180, 7, 96, 242, 240,
-			240, 60, 93, 33, 83, 24, 188, 209, 34, 153, 64, 59, 198, 147,
-			21, 15, 23, 47, 209, 50, 247, 31, 20, 146, 5, 193, 24, 169,
-			140, 146, 235, 229, 135, 172, 16, 26, 91, 205, 195, 203, 126, 30,
-			94, 205, 163, 61, 164, 154, 71, 31, 169, 230, 57, 78, 38, 128,
-			207, 110, 136, 25, 148, 169, 154, 128, 162, 42, 159, 137, 68, 149,
-			207, 39, 21, 114, 122, 44, 121, 66, 8, 142, 146, 116, 146, 159,
-			28, 48, 150, 201, 145, 88, 253, 197, 149, 90, 156, 171, 198, 222,
-			162, 171, 154, 17, 55, 143, 74, 181, 198, 200, 151, 54, 86, 66,
-			127, 67, 225, 250, 113, 195, 247, 94, 102, 173, 112, 136, 255, 23,
-			200, 65, 148, 189, 30, 127, 39, 179, 84, 240, 76, 52, 55, 206,
-			147, 3, 30, 104, 241, 173, 160, 229, 245, 96, 245, 1, 245, 4,
-			31, 213, 225, 137, 177, 72, 142, 117, 109, 119, 107, 124, 153, 149,
-			86, 59, 210, 181, 221, 231, 71, 231, 230, 44, 33, 48, 23, 225,
-			22, 114, 148, 207, 192, 20, 62, 105, 0, 91, 255, 173, 80, 39,
-			195, 40, 11, 158, 46, 146, 99, 146, 112, 17, 44, 109, 97, 176,
-			36, 144, 63, 34, 94, 150, 249, 187, 10, 188, 2, 58, 205, 86,
-			139, 5, 193, 86, 204, 234, 169, 218, 1, 254, 108, 84, 186, 223,
-			92, 121, 138, 150, 111, 93, 75, 252, 129, 74, 206, 1, 65, 245,
-			33, 220, 134, 166, 226, 174, 92, 176, 88, 128, 199, 181, 228, 19,
-			99, 237, 193, 222, 62, 176, 40, 143, 43, 101, 44, 58, 75, 224,
-			37, 88, 52, 162, 123, 196, 199, 32, 159, 62, 51, 157, 174, 16,
-			29, 14, 140, 78, 185, 190, 103, 202, 47, 147, 195, 182, 52, 167,
-			137, 138, 57, 232, 226, 81, 155, 219, 209, 178, 92, 106, 251, 138,
-			199, 196, 91, 21, 143, 201, 81, 241, 248, 123, 10, 57, 191, 47,
-			55, 31, 186, 242, 126, 4, 21, 246, 150, 23, 218, 229, 167, 137,
-			177, 215, 86, 39, 83, 155, 229, 213, 219, 235, 211, 41, 227, 8,
-			121, 148, 215, 139, 108, 213, 234, 101, 254, 80, 185, 252, 13, 133,
-			76, 69, 246, 207, 56, 64, 38, 235, 155, 203, 203, 149, 122, 125,
-			58, 101, 156, 34, 199, 54, 215, 234, 155, 27, 27, 235, 181, 70,
-			101, 101, 171, 94, 189, 189, 86, 110, 108, 214, 42, 211, 138, 145,
-			37, 199, 147, 175, 48, 73, 202, 51, 168, 170, 113, 152, 60, 114,
-			179, 188, 178, 213, 168, 222, 171, 212, 27, 229, 123, 27, 211, 26,
-			52, 135, 71, 203, 149, 90, 163, 122, 171, 186, 92, 110, 84, 182,
-			110, 173, 215, 238, 149, 27, 211, 186, 108, 30, 247, 158, 230, 3,
-			55, 106, 155, 117, 232, 59, 241, 209, 244, 132, 113, 130, 28, 193,
-			206, 113, 192, 114, 237, 246, 230, 189, 202, 90, 163, 62, 61, 105,
-			156, 39, 167, 135, 82, 182, 91, 247, 170, 107, 141, 234, 218, 237,
-			173, 74, 173, 182, 94, 155, 206, 92, 254, 121, 133, 156, 216, 71,
-			132, 141, 75, 228, 130, 56, 64, 190, 85, 94, 94, 94, 223, 92,
-			107, 136, 78, 54, 215, 240, 36, 251, 173, 106, 101, 101, 58, 101,
-			60, 78, 114, 227, 155, 149, 145, 101, 178, 152, 210, 200, 145, 115,
-			227, 219, 85, 87, 100, 62, 121, 241, 207, 53, 114, 0, 17, 184,
-			135, 171, 203, 232, 146, 233, 81, 71, 198, 24, 187, 12, 247, 113,
-			57, 179, 197, 183, 214, 88, 136, 233, 14, 57, 50, 198, 126, 24,
-			165, 253, 58, 25, 111, 71, 179, 115, 111, 185, 189, 24, 87, 144,
-			153, 84, 176, 251, 147, 57, 198, 114, 236, 79, 230, 88, 157, 253,
-			183, 132, 13, 26, 51, 241, 198, 226, 126, 61, 237, 175, 44, 179,
-			87, 126, 168, 111, 56, 18, 55, 159, 124, 241, 218, 15, 23, 34,
-			61, 205, 127, 221, 253, 173, 159, 33, 147, 70, 90, 79, 125, 90,
-			251, 63, 127, 3, 225, 116, 180, 129, 176, 26, 111, 32, 224, 79,
-			45, 101, 104, 36, 85, 192, 159, 138, 161, 29, 76, 173, 227, 79,
-			213, 208, 30, 73, 221, 36, 87, 249, 6, 195, 225, 212, 81, 37,
-			155, 167, 245, 126, 175, 231, 249, 33, 179, 40, 30, 126, 242, 218,
-			52, 176, 221, 109, 153, 136, 20, 17, 86, 98, 47, 224, 112, 250,
-			68, 188, 23, 96, 168, 199, 127, 132, 189, 0, 99, 104, 47, 192,
-			56, 122, 140, 92, 147, 123, 1, 71, 212, 227, 185, 124, 180, 23,
-			240, 234, 181, 249, 27, 51, 1, 229, 219, 79, 247, 237, 176, 83,
-			171, 151, 43, 110, 203, 31, 96, 158, 38, 185, 21, 112, 68, 61,
-			156, 216, 10, 56, 114, 244, 24, 113, 137, 154, 86, 12, 61, 155,
-			122, 76, 201, 54, 233, 134, 23, 4, 118, 211, 73, 236, 165, 180,
-			205, 208, 116, 40, 134, 30, 88, 185, 179, 230, 185, 67, 207, 48,
-			33, 228, 179, 176, 143, 83, 104, 6, 116, 219, 239, 181, 74, 85,
-			185, 107, 32, 26, 137, 60, 189, 224, 15, 96, 146, 77, 31, 34,
-			7, 136, 158, 198, 12, 199, 105, 53, 135, 104, 241, 164, 197, 105,
-			117, 74, 66, 170, 161, 157, 166, 23, 200, 83, 216, 80, 49, 180,
-			51, 106, 46, 247, 4, 103, 228, 152, 152, 82, 242, 51, 144, 115,
-			69, 68, 63, 10, 126, 122, 66, 66, 170, 161, 157, 161, 23, 200,
-			85, 236, 85, 53, 180, 179, 106, 46, 55, 147, 172, 120, 137, 194,
-			136, 125, 123, 4, 100, 206, 170, 39, 37, 4, 157, 208, 11, 100,
-			6, 123, 212, 12, 237, 156, 154, 203, 101, 105, 20, 157, 198, 211,
-			189, 235, 123, 34, 135, 146, 230, 133, 41, 231, 212, 71, 37, 164,
-			26, 218, 57, 122, 1, 165, 6, 11, 83, 206, 171, 185, 220, 227,
-			180, 107, 58, 109, 207, 239, 50, 139, 122, 62, 237, 187, 17, 38,
-			201, 156, 157, 236, 80, 87, 224, 43, 137, 149, 174, 26, 218, 121,
-			122, 129, 60, 141, 29, 166, 13, 141, 170, 185, 92, 41, 145, 134,
-			177, 60, 22, 184, 51, 161, 40, 229, 241, 124, 81, 68, 218, 148,
-			5, 189, 49, 185, 105, 5, 190, 150, 152, 166, 85, 67, 163, 244,
-			2, 185, 129, 29, 79, 24, 218, 5, 53, 151, 43, 70, 85, 124,
-			201, 100, 98, 212, 41, 79, 220, 83, 59, 164, 3, 22, 202, 110,
-			39, 20, 44, 156, 150, 144, 106, 104, 23, 232, 5, 242, 12, 118,
-			59, 105, 104, 57, 53, 151, 155, 195, 13, 12, 232, 7, 29, 177,
-			64, 212, 45, 242, 145, 176, 42, 49, 164, 187, 29, 59, 100, 142,
-			29, 36, 230, 103, 82, 129, 207, 143, 73, 72, 53, 180, 28, 189,
-			64, 150, 177, 231, 140, 161, 93, 84, 115, 185, 235, 192, 205, 168,
-			70, 35, 33, 211, 60, 107, 223, 21, 39, 23, 71, 242, 132, 114,
-			128, 140, 2, 189, 156, 149, 144, 106, 104, 23, 233, 5, 82, 34,
-			192, 28, 61, 159, 42, 42, 217, 28, 175, 25, 107, 14, 232, 62,
-			106, 90, 172, 6, 16, 165, 124, 250, 12, 174, 6, 21, 86, 67,
-			65, 45, 96, 183, 42, 174, 134, 130, 122, 81, 66, 170, 161, 21,
-			102, 242, 100, 22, 27, 98, 205, 116, 33, 71, 169, 25, 240, 44,
-			176, 233, 210, 117, 208, 194, 139, 148, 59, 249, 73, 124, 85, 94,
-			238, 171, 62, 38, 33, 213, 208, 46, 207, 228, 73, 1, 187, 82,
-			13, 237, 9, 181, 144, 59, 51, 212, 85, 143, 185, 213, 21, 90,
-			93, 25, 238, 6, 134, 125, 66, 165, 18, 130, 47, 103, 242, 228,
-			31, 76, 17, 117, 34, 101, 164, 111, 167, 126, 65, 81, 178, 31,
-			153, 162, 9, 255, 130, 218, 221, 158, 195, 120, 53, 95, 215, 180,
-			93, 90, 222, 168, 14, 87, 69, 199, 69, 118, 213, 80, 94, 253,
-			20, 96, 41, 17, 124, 223, 54, 91, 188, 220, 60, 62, 226, 134,
-			87, 107, 93, 142, 182, 193, 184, 53, 91, 162, 65, 199, 243, 121,
-			9, 180, 69, 131, 208, 12, 153, 19, 113, 33, 16, 202, 215, 165,
-			245, 93, 211, 199, 90, 199, 166, 23, 226, 25, 233, 68, 77, 36,
-			152, 24, 121, 179, 24, 30, 168, 29, 136, 13, 135, 248, 222, 39,
-			186, 241, 92, 149, 151, 150, 91, 172, 231, 120, 3, 88, 150, 88,
-			111, 31, 20, 121, 111, 174, 133, 187, 46, 125, 60, 52, 225, 219,
-			93, 211, 183, 157, 1, 8, 129, 28, 152, 23, 56, 142, 186, 71,
-			37, 164, 104, 101, 180, 172, 123, 9, 216, 20, 48, 41, 244, 188,
-			60, 4, 196, 19, 11, 104, 226, 3, 6, 45, 211, 113, 2, 126,
-			34, 22, 209, 144, 207, 1, 55, 214, 49, 157, 54, 207, 242, 246,
-			3, 230, 39, 8, 235, 241, 189, 239, 29, 219, 164, 51, 99, 235,
-			79, 103, 176, 179, 196, 246, 45, 53, 177, 250, 12, 207, 192, 153,
-			180, 229, 51, 204, 83, 152, 78, 84, 114, 154, 168, 202, 115, 183,
-			249, 120, 216, 135, 164, 121, 196, 59, 227, 100, 143, 17, 91, 236,
-			80, 196, 232, 179, 24, 148, 89, 114, 163, 112, 136, 41, 67, 159,
-			226, 72, 201, 178, 232, 228, 125, 61, 137, 202, 52, 51, 8, 188,
-			150, 141, 149, 125, 130, 18, 116, 97, 196, 112, 56, 65, 216, 213,
-			168, 115, 199, 177, 29, 217, 183, 12, 198, 76, 213, 88, 130, 176,
-			156, 96, 37, 38, 111, 104, 162, 162, 206, 114, 62, 11, 108, 203,
-			118, 183, 115, 136, 155, 237, 210, 29, 211, 183, 189, 126, 48, 132,
-			99, 16, 207, 34, 118, 211, 119, 66, 187, 11, 18, 47, 18, 246,
-			205, 1, 111, 30, 154, 193, 3, 44, 240, 133, 31, 123, 6, 27,
-			158, 154, 241, 26, 138, 214, 54, 150, 3, 121, 241, 12, 46, 134,
-			158, 207, 248, 17, 107, 190, 171, 210, 245, 118, 152, 37, 87, 164,
-			237, 134, 72, 59, 126, 125, 219, 55, 221, 16, 91, 13, 63, 127,
-			222, 54, 241, 213, 210, 158, 30, 155, 131, 136, 239, 227, 176, 225,
-			10, 53, 143, 197, 111, 174, 183, 43, 7, 47, 208, 145, 208, 63,
-			40, 181, 218, 219, 216, 147, 216, 19, 20, 30, 33, 33, 218, 4,
-			223, 21, 57, 66, 254, 189, 78, 244, 9, 116, 206, 26, 106, 61,
-			251, 135, 250, 222, 242, 15, 89, 254, 25, 80, 147, 186, 108, 87,
-			40, 43, 161, 30, 147, 149, 212, 209, 126, 166, 60, 208, 195, 247,
-			187, 144, 103, 209, 77, 118, 73, 163, 184, 107, 6, 114, 139, 187,
-			57, 160, 129, 215, 101, 52, 244, 251, 232, 122, 44, 151, 139, 162,
-			66, 130, 240, 243, 12, 241, 89, 147, 188, 235, 185, 179, 24, 161,
-			11, 222, 119, 204, 128, 155, 107, 230, 226, 182, 223, 3, 102, 21,
-			74, 128, 0, 160, 150, 196, 130, 36, 125, 212, 145, 209, 113, 171,
-			209, 243, 121, 22, 25, 119, 172, 18, 187, 63, 37, 122, 203, 118,
-			121, 233, 188, 36, 139, 196, 91, 235, 124, 219, 15, 119, 222, 68,
-			49, 29, 223, 152, 140, 10, 245, 99, 143, 106, 168, 16, 28, 217,
-			212, 166, 160, 194, 6, 32, 225, 219, 209, 14, 97, 63, 44, 194,
-			72, 9, 206, 163, 136, 129, 115, 41, 103, 97, 79, 213, 201, 250,
-			144, 63, 10, 159, 203, 15, 44, 22, 154, 182, 195, 44, 241, 70,
-			38, 202, 81, 223, 5, 102, 151, 145, 61, 115, 46, 3, 167, 18,
-			244, 26, 250, 166, 27, 216, 204, 13, 35, 39, 54, 238, 26, 49,
-			180, 91, 100, 156, 183, 203, 247, 56, 39, 184, 15, 223, 152, 56,
-			46, 33, 213, 208, 26, 39, 74, 18, 210, 12, 173, 113, 227, 29,
-			228, 61, 19, 40, 134, 138, 161, 181, 213, 119, 103, 191, 157, 30,
-			167, 36, 247, 72, 162, 40, 125, 29, 61, 4, 132, 21, 145, 253,
-			86, 71, 86, 130, 12, 31, 48, 216, 71, 195, 75, 197, 158, 71,
-			205, 30, 216, 22, 76, 219, 118, 223, 49, 125, 50, 164, 223, 29,
-			251, 193, 88, 189, 134, 103, 211, 121, 209, 201, 30, 201, 72, 94,
-			228, 48, 108, 101, 205, 96, 236, 49, 3, 26, 251, 99, 182, 59,
-			84, 103, 130, 129, 230, 222, 83, 79, 88, 141, 211, 143, 238, 83,
-			136, 206, 128, 13, 157, 134, 200, 39, 106, 81, 19, 213, 43, 133,
-			37, 130, 151, 72, 162, 34, 197, 163, 51, 137, 61, 107, 60, 149,
-			157, 120, 143, 39, 88, 2, 126, 218, 222, 117, 176, 200, 61, 218,
-			78, 222, 107, 14, 249, 21, 159, 93, 230, 185, 76, 220, 22, 137,
-			150, 169, 195, 232, 140, 76, 30, 206, 224, 13, 154, 15, 27, 194,
-			115, 19, 149, 199, 163, 124, 145, 103, 5, 3, 217, 79, 178, 252,
-			74, 108, 227, 118, 197, 153, 114, 174, 4, 251, 226, 96, 148, 105,
-			237, 96, 105, 45, 71, 218, 113, 128, 106, 214, 194, 93, 241, 150,
-			215, 109, 218, 188, 160, 30, 237, 111, 94, 174, 112, 49, 63, 197,
-			184, 220, 63, 241, 76, 82, 84, 148, 232, 22, 8, 13, 251, 61,
-			135, 5, 220, 213, 137, 165, 174, 214, 119, 240, 2, 27, 142, 16,
-			207, 64, 70, 171, 5, 28, 212, 246, 196, 41, 9, 169, 134, 214,
-			206, 94, 149, 144, 102, 104, 237, 183, 191, 72, 62, 161, 226, 106,
-			81, 13, 205, 85, 235, 217, 15, 169, 123, 44, 52, 122, 237, 193,
-			62, 174, 240, 195, 124, 2, 242, 102, 78, 1, 33, 116, 99, 216,
-			29, 17, 34, 216, 243, 241, 236, 83, 226, 164, 17, 109, 249, 94,
-			16, 204, 138, 47, 99, 183, 3, 200, 238, 7, 88, 110, 140, 17,
-			6, 246, 31, 77, 177, 192, 84, 126, 21, 93, 36, 234, 51, 158,
-			47, 9, 104, 0, 107, 155, 223, 186, 19, 103, 80, 8, 16, 228,
-			5, 118, 232, 249, 131, 136, 151, 160, 79, 220, 72, 243, 32, 191,
-			34, 205, 3, 225, 169, 123, 227, 29, 228, 59, 143, 32, 47, 53,
-			67, 255, 121, 69, 237, 100, 191, 249, 200, 126, 102, 247, 225, 60,
-			245, 252, 61, 161, 2, 231, 115, 147, 161, 66, 129, 101, 137, 22,
-			110, 196, 249, 16, 165, 21, 200, 131, 26, 51, 157, 46, 63, 235,
-			51, 84, 150, 141, 135, 93, 68, 36, 128, 165, 132, 33, 13, 205,
-			7, 168, 6, 71, 123, 227, 197, 99, 252, 74, 25, 55, 42, 101,
-			139, 123, 231, 23, 171, 196, 22, 203, 235, 201, 235, 50, 108, 183,
-			68, 27, 94, 108, 194, 34, 5, 54, 166, 110, 84, 154, 84, 188,
-			162, 104, 239, 221, 191, 168, 80, 22, 196, 69, 140, 124, 168, 142,
-			25, 224, 81, 238, 210, 240, 54, 68, 128, 25, 60, 206, 222, 248,
-			110, 216, 232, 26, 37, 252, 135, 59, 19, 69, 88, 162, 124, 28,
-			172, 98, 15, 61, 154, 139, 125, 108, 150, 195, 21, 188, 199, 137,
-			228, 188, 199, 243, 163, 92, 243, 136, 123, 88, 176, 75, 212, 57,
-			139, 165, 228, 105, 178, 136, 141, 251, 162, 203, 94, 181, 131, 176,
-			234, 114, 94, 198, 24, 139, 254, 133, 82, 226, 221, 139, 99, 220,
-			56, 81, 120, 250, 88, 244, 157, 227, 88, 229, 168, 56, 15, 16,
-			35, 115, 165, 196, 39, 105, 102, 216, 201, 229, 215, 54, 57, 222,
-			46, 119, 43, 18, 100, 139, 60, 226, 48, 242, 75, 28, 25, 179,
-			68, 87, 217, 182, 217, 26, 240, 99, 207, 102, 171, 35, 106, 122,
-			98, 63, 115, 137, 143, 61, 102, 184, 181, 245, 6, 21, 137, 4,
-			121, 137, 151, 237, 210, 151, 250, 1, 147, 27, 134, 124, 135, 200,
-			146, 174, 230, 75, 52, 96, 104, 94, 229, 107, 111, 215, 141, 223,
-			198, 142, 40, 94, 49, 136, 87, 239, 36, 125, 210, 34, 109, 114,
-			177, 238, 152, 123, 200, 9, 246, 104, 36, 59, 140, 186, 226, 103,
-			36, 193, 145, 121, 169, 203, 175, 239, 122, 137, 231, 152, 240, 218,
-			30, 145, 33, 13, 68, 106, 150, 139, 89, 93, 20, 127, 69, 53,
-			146, 81, 103, 113, 23, 226, 248, 37, 63, 81, 99, 135, 1, 245,
-			118, 221, 61, 50, 50, 148, 29, 145, 7, 35, 177, 163, 102, 137,
-			174, 177, 221, 81, 190, 251, 158, 227, 240, 243, 211, 251, 243, 157,
-			243, 28, 237, 179, 68, 234, 175, 139, 231, 111, 141, 67, 120, 25,
-			144, 248, 55, 62, 12, 221, 51, 61, 40, 147, 67, 132, 1, 167,
-			162, 94, 36, 199, 70, 217, 41, 56, 200, 87, 13, 11, 2, 238,
-			65, 56, 3, 26, 116, 236, 54, 230, 76, 248, 245, 108, 178, 159,
-			38, 11, 119, 153, 180, 30, 50, 28, 196, 153, 219, 35, 64, 33,
-			184, 21, 242, 128, 148, 237, 134, 94, 212, 201, 40, 18, 213, 242,
-			61, 218, 243, 28, 187, 101, 227, 25, 70, 186, 140, 181, 133, 249,
-			43, 5, 177, 142, 241, 246, 209, 248, 108, 222, 240, 216, 60, 193,
-			103, 138, 163, 64, 54, 143, 65, 241, 254, 51, 194, 83, 18, 65,
-			66, 51, 13, 240, 16, 78, 66, 157, 241, 67, 129, 224, 156, 51,
-			126, 112, 31, 101, 4, 103, 145, 251, 15, 16, 238, 145, 225, 19,
-			161, 195, 86, 42, 16, 134, 89, 92, 100, 135, 78, 78, 207, 158,
-			51, 173, 174, 237, 206, 237, 44, 204, 141, 184, 27, 143, 160, 25,
-			212, 20, 48, 124, 19, 103, 37, 168, 2, 120, 238, 25, 9, 162,
-			89, 188, 221, 38, 191, 207, 47, 176, 152, 248, 136, 146, 250, 85,
-			69, 201, 110, 142, 9, 25, 68, 84, 229, 155, 61, 97, 152, 146,
-			85, 135, 34, 208, 26, 243, 5, 137, 206, 177, 147, 197, 151, 230,
-			126, 172, 255, 200, 72, 106, 172, 20, 95, 251, 244, 17, 37, 115,
-			158, 124, 68, 222, 90, 161, 127, 84, 81, 47, 101, 127, 78, 69,
-			91, 32, 55, 132, 103, 247, 169, 52, 148, 148, 10, 180, 139, 35,
-			65, 164, 8, 27, 9, 191, 37, 2, 214, 151, 220, 158, 24, 211,
-			73, 41, 17, 14, 99, 109, 55, 227, 30, 56, 56, 11, 12, 195,
-			95, 81, 117, 62, 8, 25, 86, 210, 199, 167, 187, 45, 102, 58,
-			182, 204, 65, 245, 228, 126, 133, 68, 158, 68, 19, 192, 253, 120,
-			219, 197, 26, 232, 32, 100, 110, 139, 31, 224, 151, 161, 241, 184,
-			27, 37, 226, 251, 166, 62, 58, 124, 223, 212, 71, 149, 12, 77,
-			220, 55, 245, 81, 229, 226, 99, 228, 103, 163, 251, 166, 62, 169,
-			168, 199, 179, 187, 195, 151, 37, 96, 141, 227, 158, 74, 188, 158,
-			233, 155, 93, 22, 50, 63, 152, 65, 178, 248, 33, 54, 54, 150,
-			69, 227, 54, 56, 218, 252, 18, 187, 86, 136, 39, 251, 248, 133,
-			31, 241, 125, 80, 159, 28, 190, 15, 234, 147, 74, 230, 112, 226,
-			62, 168, 79, 42, 71, 143, 145, 231, 176, 164, 118, 226, 211, 74,
-			234, 183, 21, 37, 251, 182, 177, 19, 44, 206, 21, 74, 235, 29,
-			246, 77, 39, 74, 30, 196, 4, 8, 161, 130, 129, 62, 173, 100,
-			78, 115, 96, 202, 208, 127, 77, 81, 31, 225, 247, 27, 76, 165,
-			0, 58, 112, 16, 113, 80, 166, 128, 143, 73, 80, 21, 224, 111,
-			43, 162, 208, 85, 255, 71, 138, 122, 50, 251, 25, 126, 98, 62,
-			153, 47, 225, 165, 227, 241, 45, 12, 166, 244, 174, 242, 102, 64,
-			203, 245, 181, 133, 164, 200, 2, 91, 229, 121, 7, 121, 14, 37,
-			186, 221, 159, 31, 126, 160, 201, 179, 17, 52, 111, 135, 51, 1,
-			93, 94, 147, 41, 76, 24, 185, 128, 139, 119, 185, 140, 190, 36,
-			145, 151, 158, 68, 69, 215, 178, 153, 224, 62, 86, 222, 2, 246,
-			147, 18, 84, 0, 204, 28, 145, 160, 6, 224, 241, 19, 228, 103,
-			68, 241, 173, 254, 79, 21, 117, 54, 251, 202, 136, 196, 196, 211,
-			44, 241, 133, 119, 210, 115, 147, 27, 140, 209, 217, 249, 221, 142,
-			9, 136, 219, 238, 126, 58, 41, 150, 32, 225, 27, 72, 108, 149,
-			9, 196, 224, 168, 4, 17, 161, 99, 121, 9, 106, 0, 62, 81,
-			36, 247, 68, 249, 174, 254, 89, 69, 189, 156, 125, 123, 226, 254,
-			198, 248, 88, 67, 50, 169, 36, 14, 102, 22, 165, 70, 16, 71,
-			47, 90, 142, 215, 122, 16, 141, 173, 78, 96, 127, 167, 37, 168,
-			0, 120, 230, 146, 4, 53, 0, 243, 5, 242, 255, 136, 35, 233,
-			250, 63, 87, 212, 92, 118, 35, 17, 220, 226, 182, 156, 240, 44,
-			100, 126, 105, 148, 45, 156, 222, 40, 41, 29, 101, 126, 48, 93,
-			192, 226, 105, 211, 38, 112, 0, 67, 130, 10, 128, 71, 206, 74,
-			16, 135, 167, 23, 200, 31, 129, 17, 80, 141, 137, 223, 85, 82,
-			127, 164, 40, 217, 223, 87, 246, 77, 28, 241, 139, 52, 197, 102,
-			168, 216, 249, 25, 74, 42, 138, 237, 95, 30, 80, 16, 80, 91,
-			45, 126, 29, 233, 240, 46, 50, 191, 218, 36, 234, 9, 34, 76,
-			248, 84, 196, 33, 62, 107, 247, 241, 110, 31, 15, 189, 10, 89,
-			190, 141, 185, 191, 161, 197, 139, 249, 40, 34, 18, 97, 226, 58,
-			64, 225, 248, 136, 229, 11, 252, 255, 93, 80, 110, 61, 162, 235,
-			42, 44, 194, 207, 43, 234, 233, 159, 200, 78, 48, 48, 89, 85,
-			83, 19, 56, 228, 1, 9, 42, 0, 30, 60, 46, 65, 13, 192,
-			83, 89, 50, 143, 232, 41, 134, 254, 5, 64, 47, 71, 215, 197,
-			17, 132, 209, 36, 95, 100, 83, 69, 7, 160, 22, 191, 160, 168,
-			25, 9, 98, 15, 83, 178, 123, 16, 245, 47, 64, 247, 171, 216,
-			189, 106, 232, 95, 4, 81, 127, 150, 174, 187, 16, 109, 99, 152,
-			155, 23, 101, 73, 98, 0, 136, 185, 11, 195, 92, 22, 127, 52,
-			197, 138, 146, 85, 188, 115, 144, 244, 47, 42, 170, 28, 11, 144,
-			255, 162, 114, 226, 146, 4, 53, 0, 243, 5, 242, 175, 21, 28,
-			91, 51, 244, 47, 41, 234, 217, 236, 63, 83, 246, 92, 85, 145,
-			8, 211, 196, 217, 57, 81, 149, 21, 165, 152, 197, 240, 9, 81,
-			231, 134, 5, 47, 26, 20, 71, 147, 57, 57, 237, 190, 19, 181,
-			225, 94, 163, 152, 208, 4, 201, 152, 131, 181, 3, 161, 113, 241,
-			207, 22, 128, 159, 54, 84, 109, 92, 26, 41, 16, 139, 168, 214,
-			210, 72, 136, 100, 56, 44, 169, 47, 41, 83, 39, 37, 136, 100,
-			158, 62, 67, 238, 19, 85, 215, 140, 137, 47, 43, 169, 63, 87,
-			148, 108, 149, 142, 93, 76, 17, 151, 229, 249, 132, 56, 161, 12,
-			107, 107, 140, 198, 227, 66, 13, 131, 126, 89, 201, 156, 225, 192,
-			148, 161, 255, 153, 130, 117, 15, 186, 174, 129, 77, 250, 51, 105,
-			132, 52, 180, 73, 73, 80, 21, 32, 111, 139, 47, 15, 77, 139,
-			151, 202, 8, 168, 10, 240, 139, 48, 129, 26, 44, 157, 175, 194,
-			4, 126, 238, 175, 54, 129, 81, 194, 243, 142, 25, 36, 110, 247,
-			144, 87, 130, 205, 61, 211, 245, 172, 190, 195, 102, 69, 15, 207,
-			230, 32, 118, 22, 21, 1, 34, 176, 238, 122, 174, 29, 122, 120,
-			107, 66, 115, 192, 19, 158, 45, 199, 102, 110, 24, 221, 153, 67,
-			183, 92, 47, 220, 162, 38, 152, 243, 112, 104, 51, 86, 204, 163,
-			134, 22, 237, 171, 114, 30, 53, 92, 151, 95, 149, 243, 168, 225,
-			186, 252, 42, 204, 227, 135, 144, 248, 76, 202, 152, 248, 186, 162,
-			126, 83, 209, 178, 255, 63, 55, 223, 241, 221, 25, 67, 25, 92,
-			238, 187, 240, 106, 8, 8, 226, 58, 98, 3, 72, 94, 133, 101,
-			38, 171, 48, 64, 209, 15, 223, 100, 19, 48, 70, 198, 206, 123,
-			92, 177, 33, 9, 200, 0, 198, 95, 87, 240, 252, 8, 76, 143,
-			98, 232, 223, 80, 244, 89, 129, 63, 88, 192, 111, 40, 250, 81,
-			9, 226, 219, 99, 51, 18, 212, 0, 188, 92, 36, 159, 209, 137,
-			170, 235, 198, 196, 119, 148, 212, 255, 84, 148, 236, 199, 116, 58,
-			90, 71, 142, 155, 52, 137, 125, 231, 61, 219, 19, 252, 212, 165,
-			56, 209, 239, 121, 22, 207, 237, 202, 253, 57, 121, 116, 177, 36,
-			218, 153, 50, 183, 47, 8, 70, 249, 192, 244, 117, 64, 162, 190,
-			59, 94, 16, 98, 142, 11, 164, 73, 234, 118, 179, 233, 245, 195,
-			125, 15, 166, 217, 97, 226, 232, 33, 136, 84, 9, 49, 195, 180,
-			131, 192, 94, 220, 63, 150, 151, 135, 147, 22, 58, 67, 167, 70,
-			121, 142, 24, 68, 76, 238, 41, 192, 79, 121, 242, 146, 239, 47,
-			0, 115, 102, 5, 119, 248, 14, 195, 204, 240, 13, 70, 201, 36,
-			53, 78, 61, 222, 194, 211, 235, 121, 65, 212, 117, 200, 157, 8,
-			116, 255, 93, 234, 245, 204, 87, 250, 76, 28, 162, 154, 149, 167,
-			178, 192, 197, 43, 18, 158, 59, 113, 49, 94, 196, 123, 103, 16,
-			211, 55, 57, 23, 141, 50, 68, 199, 156, 169, 33, 98, 175, 201,
-			180, 196, 150, 24, 134, 155, 34, 209, 130, 231, 134, 61, 220, 131,
-			111, 123, 9, 86, 71, 91, 130, 252, 118, 93, 80, 55, 186, 98,
-			232, 223, 81, 50, 39, 9, 37, 186, 174, 131, 86, 248, 174, 162,
-			158, 206, 25, 73, 103, 90, 84, 84, 128, 176, 233, 184, 208, 190,
-			43, 23, 154, 142, 11, 237, 187, 210, 66, 233, 184, 208, 190, 11,
-			22, 234, 113, 236, 78, 49, 244, 239, 41, 234, 76, 238, 164, 116,
-			193, 36, 14, 124, 95, 48, 144, 157, 130, 124, 127, 79, 122, 89,
-			58, 202, 247, 247, 148, 51, 57, 9, 106, 0, 94, 122, 156, 252,
-			19, 112, 108, 210, 198, 196, 123, 212, 212, 111, 170, 74, 246, 217,
-			113, 187, 79, 50, 42, 176, 3, 89, 47, 32, 206, 202, 239, 217,
-			202, 95, 108, 253, 184, 195, 216, 61, 245, 16, 130, 201, 105, 197,
-			208, 223, 163, 102, 114, 228, 147, 16, 201, 166, 129, 203, 239, 83,
-			85, 154, 253, 69, 245, 199, 122, 139, 18, 245, 176, 198, 66, 92,
-			175, 194, 25, 157, 171, 85, 222, 177, 89, 169, 55, 214, 107, 57,
-			177, 183, 202, 239, 88, 9, 227, 139, 225, 68, 116, 50, 195, 211,
-			103, 50, 253, 95, 218, 187, 65, 147, 56, 175, 140, 127, 152, 172,
-			188, 188, 26, 68, 199, 71, 197, 31, 124, 217, 127, 155, 117, 79,
-			62, 52, 26, 9, 79, 36, 70, 199, 119, 97, 206, 211, 40, 102,
-			239, 83, 133, 152, 165, 81, 204, 222, 167, 78, 157, 150, 160, 6,
-			224, 185, 243, 164, 137, 252, 84, 12, 253, 131, 170, 122, 46, 219,
-			24, 119, 225, 80, 180, 171, 247, 166, 247, 14, 129, 79, 110, 246,
-			29, 148, 29, 249, 215, 58, 34, 132, 192, 51, 251, 160, 42, 66,
-			166, 52, 138, 232, 7, 213, 204, 41, 9, 106, 0, 158, 57, 75,
-			254, 7, 159, 97, 213, 208, 127, 69, 85, 207, 103, 191, 161, 226,
-			149, 68, 50, 176, 147, 247, 195, 203, 27, 128, 162, 125, 250, 191,
-			182, 219, 137, 254, 74, 87, 19, 21, 41, 184, 227, 73, 17, 26,
-			215, 197, 125, 113, 63, 14, 207, 162, 243, 60, 5, 94, 186, 5,
-			210, 18, 247, 73, 226, 251, 142, 34, 187, 30, 176, 174, 201, 125,
-			54, 103, 192, 237, 73, 192, 196, 31, 114, 240, 124, 139, 251, 35,
-			76, 86, 99, 37, 202, 255, 66, 174, 157, 247, 72, 141, 170, 35,
-			223, 35, 48, 13, 224, 129, 195, 18, 84, 0, 52, 178, 18, 212,
-			0, 60, 123, 142, 124, 135, 207, 153, 102, 232, 159, 134, 57, 251,
-			166, 250, 38, 247, 40, 141, 204, 155, 188, 74, 105, 120, 127, 236,
-			173, 221, 167, 68, 246, 92, 168, 132, 139, 56, 136, 254, 158, 36,
-			245, 61, 47, 164, 155, 181, 213, 64, 220, 191, 36, 95, 60, 3,
-			102, 245, 217, 92, 65, 172, 234, 31, 251, 253, 75, 67, 212, 252,
-			181, 78, 154, 166, 35, 227, 35, 48, 13, 96, 52, 105, 224, 28,
-			127, 58, 158, 52, 13, 103, 233, 236, 57, 242, 1, 5, 39, 77,
-			55, 244, 207, 168, 234, 209, 236, 27, 113, 136, 37, 175, 224, 120,
-			200, 69, 76, 88, 152, 131, 158, 205, 232, 21, 121, 120, 134, 101,
-			232, 138, 60, 186, 34, 72, 16, 151, 63, 38, 124, 80, 50, 228,
-			132, 166, 193, 54, 1, 54, 82, 105, 129, 109, 253, 140, 58, 245,
-			168, 4, 53, 0, 141, 35, 228, 251, 92, 222, 210, 134, 254, 89,
-			245, 255, 222, 75, 245, 19, 191, 151, 138, 207, 70, 90, 71, 246,
-			71, 32, 206, 70, 36, 117, 96, 177, 63, 171, 26, 199, 37, 168,
-			1, 120, 42, 139, 215, 248, 232, 19, 198, 196, 239, 168, 169, 63,
-			80, 149, 236, 235, 227, 61, 144, 253, 147, 43, 163, 165, 50, 94,
-			20, 197, 162, 5, 24, 147, 152, 192, 234, 31, 121, 146, 127, 187,
-			182, 177, 140, 33, 189, 244, 44, 38, 20, 67, 255, 29, 53, 115,
-			145, 60, 73, 116, 125, 2, 28, 139, 207, 169, 234, 145, 108, 1,
-			141, 183, 112, 223, 70, 188, 81, 121, 89, 73, 66, 114, 39, 208,
-			220, 126, 78, 74, 238, 4, 154, 219, 207, 169, 83, 135, 36, 168,
-			1, 120, 216, 32, 255, 80, 193, 97, 20, 67, 255, 61, 85, 45,
-			102, 63, 170, 208, 58, 56, 246, 248, 119, 116, 77, 238, 86, 135,
-			220, 143, 182, 93, 106, 177, 132, 115, 11, 238, 74, 145, 223, 144,
-			205, 175, 100, 117, 119, 152, 139, 117, 24, 64, 248, 61, 47, 8,
-			197, 98, 196, 191, 172, 34, 175, 31, 192, 138, 204, 160, 40, 238,
-			39, 196, 253, 33, 16, 70, 215, 11, 19, 147, 203, 147, 233, 146,
-			62, 18, 187, 94, 28, 121, 240, 45, 127, 79, 85, 143, 72, 16,
-			145, 63, 58, 35, 65, 13, 192, 203, 79, 144, 207, 115, 210, 84,
-			67, 255, 130, 170, 158, 205, 254, 214, 79, 60, 44, 30, 142, 117,
-			199, 68, 186, 15, 209, 90, 113, 8, 29, 145, 13, 234, 229, 11,
-			241, 140, 98, 46, 74, 21, 1, 241, 4, 218, 190, 47, 168, 167,
-			207, 144, 223, 0, 129, 158, 52, 38, 190, 164, 166, 254, 179, 170,
-			100, 159, 218, 83, 162, 178, 175, 63, 61, 92, 105, 186, 216, 249,
-			113, 59, 211, 163, 247, 237, 12, 121, 212, 147, 138, 161, 127, 73,
-			205, 156, 39, 111, 39, 186, 62, 9, 114, 255, 199, 170, 154, 205,
-			46, 96, 192, 27, 109, 104, 226, 13, 199, 224, 169, 36, 175, 180,
-			17, 174, 88, 211, 235, 187, 210, 6, 77, 162, 252, 255, 177, 228,
-			214, 36, 202, 255, 31, 171, 83, 199, 36, 168, 1, 120, 242, 20,
-			185, 134, 163, 41, 134, 254, 101, 85, 205, 101, 103, 104, 45, 82,
-			189, 88, 107, 66, 3, 94, 243, 47, 239, 33, 79, 174, 177, 73,
-			85, 209, 241, 187, 8, 76, 3, 40, 52, 206, 36, 202, 229, 151,
-			85, 227, 172, 4, 53, 0, 249, 105, 8, 125, 18, 196, 242, 43,
-			48, 228, 44, 176, 222, 238, 246, 187, 130, 26, 121, 104, 147, 202,
-			67, 155, 137, 219, 124, 162, 129, 65, 20, 190, 34, 93, 215, 73,
-			20, 133, 175, 168, 25, 57, 18, 136, 194, 87, 96, 36, 188, 241,
-			104, 18, 192, 175, 169, 234, 133, 236, 223, 85, 30, 98, 150, 196,
-			133, 91, 88, 79, 146, 48, 52, 241, 157, 205, 224, 29, 252, 208,
-			150, 118, 13, 15, 23, 9, 237, 109, 187, 123, 170, 107, 56, 194,
-			224, 47, 124, 45, 230, 35, 248, 11, 95, 139, 249, 8, 254, 194,
-			215, 84, 227, 140, 4, 145, 156, 243, 148, 84, 137, 170, 103, 140,
-			137, 175, 171, 169, 255, 174, 42, 217, 167, 199, 200, 249, 254, 90,
-			123, 88, 212, 81, 0, 51, 138, 161, 127, 93, 205, 80, 242, 4,
-			209, 245, 12, 8, 224, 55, 85, 245, 98, 246, 44, 189, 213, 223,
-			91, 225, 194, 203, 125, 4, 1, 25, 20, 182, 111, 74, 97, 203,
-			160, 176, 125, 83, 157, 58, 39, 65, 13, 192, 11, 57, 114, 17,
-			123, 86, 12, 253, 91, 32, 218, 199, 132, 136, 37, 171, 153, 162,
-			30, 65, 150, 190, 21, 247, 168, 224, 71, 66, 124, 51, 40, 75,
-			223, 2, 241, 229, 184, 170, 134, 254, 23, 170, 58, 147, 61, 43,
-			234, 8, 70, 202, 8, 249, 205, 33, 178, 103, 117, 2, 91, 159,
-			150, 160, 2, 160, 136, 204, 51, 40, 59, 127, 161, 94, 122, 156,
-			124, 90, 193, 174, 53, 67, 255, 54, 104, 207, 191, 255, 19, 215,
-			158, 251, 202, 25, 217, 171, 28, 51, 40, 51, 223, 142, 249, 5,
-			50, 243, 109, 169, 28, 51, 40, 51, 223, 6, 229, 216, 32, 170,
-			62, 101, 76, 124, 79, 77, 253, 178, 166, 100, 111, 237, 87, 113,
-			182, 175, 138, 28, 95, 162, 14, 226, 51, 165, 24, 250, 247, 212,
-			204, 227, 228, 38, 209, 245, 41, 16, 159, 191, 84, 213, 66, 246,
-			234, 240, 61, 212, 102, 236, 173, 141, 238, 32, 141, 186, 209, 83,
-			184, 51, 241, 151, 170, 122, 74, 130, 10, 128, 217, 199, 36, 168,
-			1, 56, 147, 39, 207, 225, 128, 138, 161, 127, 31, 38, 234, 109,
-			20, 143, 223, 15, 255, 249, 135, 88, 227, 210, 109, 223, 108, 14,
-			167, 142, 198, 140, 12, 210, 247, 125, 201, 205, 41, 148, 190, 239,
-			75, 110, 78, 161, 244, 125, 31, 184, 185, 130, 35, 171, 134, 254,
-			3, 112, 81, 174, 211, 50, 143, 49, 124, 94, 239, 22, 109, 89,
-			238, 173, 108, 27, 51, 36, 168, 180, 31, 196, 67, 2, 61, 63,
-			144, 254, 202, 20, 138, 229, 15, 192, 95, 65, 177, 156, 2, 240,
-			189, 154, 154, 3, 177, 44, 71, 127, 9, 94, 232, 106, 24, 100,
-			92, 161, 160, 8, 168, 58, 230, 14, 247, 80, 68, 113, 42, 150,
-			242, 136, 194, 112, 188, 8, 0, 38, 231, 205, 207, 58, 115, 43,
-			221, 15, 66, 2, 157, 176, 110, 47, 28, 240, 90, 147, 93, 59,
-			96, 17, 85, 160, 217, 222, 171, 169, 17, 152, 6, 80, 104, 182,
-			41, 148, 210, 247, 106, 194, 66, 76, 161, 148, 190, 87, 163, 23,
-			200, 47, 114, 34, 117, 67, 127, 191, 166, 158, 207, 254, 109, 133,
-			150, 221, 168, 202, 21, 233, 147, 165, 142, 63, 6, 162, 228, 193,
-			236, 183, 70, 16, 4, 68, 239, 215, 162, 105, 130, 128, 232, 253,
-			218, 84, 86, 130, 26, 128, 16, 203, 169, 72, 65, 218, 208, 63,
-			12, 211, 244, 125, 133, 91, 57, 12, 48, 121, 52, 34, 83, 54,
-			67, 166, 46, 218, 46, 21, 218, 90, 92, 102, 149, 204, 232, 52,
-			162, 205, 75, 116, 28, 121, 211, 104, 93, 161, 230, 113, 236, 29,
-			113, 161, 149, 252, 35, 20, 120, 235, 59, 126, 218, 53, 95, 69,
-			99, 107, 7, 132, 46, 116, 74, 200, 216, 48, 4, 106, 249, 193,
-			129, 240, 205, 186, 90, 232, 112, 135, 195, 199, 27, 183, 176, 96,
-			204, 116, 163, 186, 251, 161, 132, 211, 80, 190, 105, 10, 3, 146,
-			15, 107, 194, 104, 79, 97, 64, 242, 97, 45, 35, 39, 31, 2,
-			146, 15, 107, 210, 104, 79, 129, 150, 254, 37, 237, 111, 174, 209,
-			158, 82, 39, 116, 196, 48, 2, 211, 0, 70, 162, 13, 97, 204,
-			47, 105, 194, 104, 79, 169, 19, 26, 128, 231, 41, 110, 187, 17,
-			99, 226, 99, 90, 234, 211, 26, 110, 187, 61, 252, 0, 249, 56,
-			219, 189, 191, 14, 38, 138, 161, 127, 76, 203, 204, 144, 28, 209,
-			117, 2, 58, 248, 227, 154, 122, 36, 119, 116, 204, 38, 41, 71,
-			155, 160, 229, 254, 184, 148, 103, 130, 58, 246, 227, 154, 80, 59,
-			4, 117, 236, 199, 181, 195, 6, 153, 193, 14, 21, 67, 255, 132,
-			166, 206, 228, 78, 237, 185, 86, 113, 40, 251, 77, 48, 66, 249,
-			132, 38, 108, 44, 65, 253, 249, 9, 77, 216, 88, 130, 250, 243,
-			19, 154, 180, 177, 4, 20, 232, 167, 180, 191, 241, 54, 150, 160,
-			138, 254, 84, 204, 43, 96, 199, 167, 52, 97, 21, 8, 170, 232,
-			79, 105, 167, 207, 200, 11, 210, 254, 119, 0, 0, 0, 255, 255,
-			2, 167, 222, 234, 188, 134, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 125, 107, 144, 36, 71,
+			90, 216, 212, 99, 94, 185, 43, 105, 182, 86, 171, 29, 245, 106,
+			165, 79, 173, 147, 166, 71, 55, 83, 243, 216, 135, 180, 179, 58,
+			65, 239, 76, 239, 110, 239, 206, 206, 12, 221, 61, 218, 147, 238,
+			240, 108, 117, 85, 246, 116, 221, 118, 87, 181, 170, 170, 103, 182,
+			37, 203, 54, 56, 206, 23, 248, 176, 129, 195, 129, 141, 13, 113,
+			1, 14, 155, 48, 96, 76, 132, 57, 63, 2, 191, 48, 96, 140,
+			225, 76, 4, 142, 0, 131, 177, 127, 96, 204, 69, 16, 225, 71,
+			216, 128, 113, 152, 31, 142, 239, 203, 204, 234, 234, 158, 158, 221,
+			213, 33, 46, 142, 8, 239, 137, 160, 171, 42, 31, 223, 247, 229,
+			151, 223, 59, 115, 216, 207, 173, 176, 23, 246, 195, 112, 191, 197,
+			151, 58, 81, 152, 132, 245, 110, 99, 41, 241, 219, 60, 78, 156,
+			118, 199, 166, 87, 214, 83, 162, 129, 173, 26, 228, 175, 178, 233,
+			154, 106, 99, 205, 178, 201, 152, 187, 97, 224, 197, 179, 26, 104,
+			5, 163, 162, 30, 173, 167, 217, 120, 224, 4, 97, 60, 171, 131,
+			86, 24, 175, 136, 135, 107, 159, 213, 216, 105, 55, 108, 219, 67,
+			131, 94, 123, 50, 29, 114, 7, 95, 237, 104, 239, 172, 202, 38,
+			251, 97, 203, 9, 246, 237, 48, 218, 207, 192, 216, 235, 240, 120,
+			233, 126, 16, 30, 6, 125, 120, 59, 245, 63, 208, 180, 31, 212,
+			141, 27, 59, 215, 126, 88, 127, 254, 134, 232, 189, 35, 187, 216,
+			119, 121, 171, 117, 27, 59, 212, 176, 239, 173, 159, 93, 98, 147,
+			214, 248, 243, 99, 95, 208, 52, 246, 139, 39, 153, 118, 210, 50,
+			158, 31, 179, 86, 127, 234, 36, 80, 15, 55, 108, 193, 181, 110,
+			163, 193, 163, 24, 22, 65, 140, 53, 23, 131, 231, 36, 14, 248,
+			65, 194, 35, 183, 233, 4, 251, 28, 26, 97, 212, 118, 18, 6,
+			235, 97, 167, 23, 249, 251, 205, 4, 86, 151, 151, 95, 151, 29,
+			160, 28, 184, 54, 64, 177, 213, 2, 250, 22, 67, 196, 99, 30,
+			29, 112, 207, 102, 208, 76, 146, 78, 188, 182, 180, 228, 241, 3,
+			222, 10, 59, 60, 138, 21, 77, 220, 176, 45, 48, 117, 195, 214,
+			98, 93, 0, 177, 196, 24, 84, 184, 231, 199, 73, 228, 215, 187,
+			137, 31, 6, 224, 4, 30, 116, 99, 14, 126, 0, 113, 216, 141,
+			92, 78, 111, 234, 126, 224, 68, 61, 130, 43, 94, 128, 67, 63,
+			105, 66, 24, 209, 255, 15, 187, 9, 131, 118, 232, 249, 13, 223,
+			117, 112, 132, 5, 112, 34, 14, 29, 30, 181, 253, 36, 225, 30,
+			116, 162, 240, 192, 247, 184, 7, 73, 211, 73, 32, 105, 34, 118,
+			173, 86, 120, 232, 7, 251, 128, 43, 236, 99, 167, 24, 59, 49,
+			104, 243, 100, 141, 49, 192, 127, 175, 14, 1, 22, 67, 216, 80,
+			16, 185, 161, 199, 161, 221, 141, 19, 136, 120, 226, 248, 1, 141,
+			234, 212, 195, 3, 252, 36, 41, 198, 32, 8, 19, 223, 229, 11,
+			144, 52, 253, 24, 90, 126, 156, 224, 8, 217, 25, 3, 111, 8,
+			28, 207, 143, 221, 150, 227, 183, 121, 100, 31, 7, 132, 31, 100,
+			105, 161, 128, 232, 68, 161, 215, 117, 121, 31, 14, 214, 7, 228,
+			143, 4, 7, 3, 137, 157, 23, 186, 221, 54, 15, 18, 71, 45,
+			210, 82, 24, 65, 152, 52, 121, 4, 109, 39, 225, 145, 239, 180,
+			226, 62, 169, 113, 97, 112, 76, 6, 89, 232, 83, 164, 182, 184,
+			79, 61, 113, 224, 192, 105, 115, 4, 40, 203, 91, 65, 216, 255,
+			70, 116, 247, 147, 24, 49, 10, 196, 80, 97, 20, 67, 219, 233,
+			65, 157, 35, 167, 120, 144, 132, 192, 3, 47, 140, 98, 142, 76,
+			209, 137, 194, 118, 152, 112, 4, 198, 235, 186, 73, 12, 30, 143,
+			252, 3, 238, 65, 35, 10, 219, 76, 80, 33, 14, 27, 201, 33,
+			178, 137, 228, 32, 136, 59, 220, 69, 14, 130, 78, 228, 35, 99,
+			69, 200, 59, 129, 224, 162, 56, 38, 216, 25, 212, 110, 150, 171,
+			80, 221, 190, 94, 187, 91, 172, 148, 160, 92, 133, 157, 202, 246,
+			91, 229, 141, 210, 6, 92, 123, 27, 106, 55, 75, 176, 190, 189,
+			243, 118, 165, 124, 227, 102, 13, 110, 110, 111, 110, 148, 42, 85,
+			40, 110, 109, 192, 250, 246, 86, 173, 82, 190, 182, 91, 219, 174,
+			84, 25, 228, 139, 85, 40, 87, 243, 244, 165, 184, 245, 54, 148,
+			62, 185, 83, 41, 85, 171, 176, 93, 129, 242, 157, 157, 205, 114,
+			105, 3, 238, 22, 43, 149, 226, 86, 173, 92, 170, 46, 64, 121,
+			107, 125, 115, 119, 163, 188, 117, 99, 1, 174, 237, 214, 96, 107,
+			187, 198, 96, 179, 124, 167, 92, 43, 109, 64, 109, 123, 129, 166,
+			61, 218, 15, 182, 175, 195, 157, 82, 101, 253, 102, 113, 171, 86,
+			188, 86, 222, 44, 215, 222, 166, 9, 175, 151, 107, 91, 56, 217,
+			245, 237, 10, 131, 34, 236, 20, 43, 181, 242, 250, 238, 102, 177,
+			2, 59, 187, 149, 157, 237, 106, 9, 16, 179, 141, 114, 117, 125,
+			179, 88, 190, 83, 218, 176, 161, 188, 5, 91, 219, 80, 122, 171,
+			180, 85, 131, 234, 205, 226, 230, 230, 32, 162, 12, 182, 239, 110,
+			149, 42, 8, 125, 22, 77, 184, 86, 130, 205, 114, 241, 218, 102,
+			9, 174, 111, 87, 8, 207, 141, 114, 165, 180, 94, 67, 132, 250,
+			191, 214, 203, 27, 165, 173, 90, 113, 115, 129, 65, 117, 167, 180,
+			94, 46, 110, 46, 64, 233, 147, 165, 59, 59, 155, 197, 202, 219,
+			11, 114, 208, 106, 233, 155, 118, 75, 91, 181, 114, 113, 19, 54,
+			138, 119, 138, 55, 74, 85, 40, 60, 138, 42, 59, 149, 237, 245,
+			221, 74, 233, 14, 66, 189, 125, 29, 170, 187, 215, 170, 181, 114,
+			109, 183, 86, 130, 27, 219, 219, 27, 68, 236, 106, 169, 242, 86,
+			121, 189, 84, 189, 10, 155, 219, 72, 254, 235, 176, 91, 45, 45,
+			48, 216, 40, 214, 138, 52, 245, 78, 101, 251, 122, 185, 86, 189,
+			138, 191, 175, 237, 86, 203, 68, 184, 242, 86, 173, 84, 169, 236,
+			238, 212, 202, 219, 91, 243, 112, 115, 251, 110, 233, 173, 82, 5,
+			214, 139, 187, 213, 210, 6, 81, 120, 123, 11, 177, 69, 94, 41,
+			109, 87, 222, 198, 97, 145, 14, 180, 2, 11, 112, 247, 102, 169,
+			118, 179, 84, 65, 162, 18, 181, 138, 72, 134, 106, 173, 82, 94,
+			175, 101, 155, 109, 87, 160, 182, 93, 169, 177, 12, 158, 176, 85,
+			186, 177, 89, 190, 81, 218, 90, 47, 33, 60, 219, 56, 204, 221,
+			114, 181, 52, 15, 197, 74, 185, 138, 13, 202, 52, 49, 220, 45,
+			190, 13, 219, 187, 132, 53, 46, 212, 110, 181, 196, 196, 239, 12,
+			235, 46, 208, 122, 66, 249, 58, 20, 55, 222, 42, 35, 228, 178,
+			245, 206, 118, 181, 90, 150, 236, 66, 100, 91, 191, 41, 105, 110,
+			51, 54, 197, 52, 221, 50, 96, 108, 22, 127, 77, 89, 70, 126,
+			236, 42, 155, 102, 250, 212, 203, 226, 167, 120, 249, 210, 216, 11,
+			244, 242, 5, 241, 83, 188, 252, 216, 88, 153, 94, 158, 16, 63,
+			197, 203, 151, 199, 22, 232, 165, 38, 126, 138, 151, 175, 140, 45,
+			209, 75, 249, 83, 188, 156, 27, 203, 211, 75, 38, 126, 138, 151,
+			133, 177, 23, 233, 229, 199, 196, 207, 255, 116, 158, 233, 230, 152,
+			53, 241, 57, 13, 85, 95, 238, 151, 206, 67, 17, 82, 213, 75,
+			2, 146, 199, 60, 72, 98, 112, 160, 19, 250, 65, 66, 98, 205,
+			111, 163, 154, 241, 120, 135, 7, 30, 15, 72, 60, 59, 65, 15,
+			80, 243, 194, 123, 97, 64, 210, 164, 21, 186, 78, 139, 129, 235,
+			180, 120, 224, 57, 209, 2, 240, 0, 165, 191, 7, 14, 142, 229,
+			134, 93, 209, 79, 90, 7, 36, 75, 27, 145, 227, 246, 53, 134,
+			250, 144, 48, 32, 83, 129, 158, 81, 99, 134, 45, 33, 20, 161,
+			214, 228, 114, 32, 31, 85, 105, 203, 73, 252, 3, 142, 66, 205,
+			9, 128, 119, 66, 183, 9, 78, 2, 187, 181, 117, 104, 251, 94,
+			64, 18, 61, 12, 24, 220, 114, 130, 46, 170, 196, 149, 5, 88,
+			185, 242, 218, 242, 130, 18, 212, 157, 40, 108, 241, 78, 226, 187,
+			112, 35, 226, 251, 97, 228, 59, 65, 10, 61, 28, 54, 125, 183,
+			9, 252, 65, 194, 17, 88, 18, 208, 35, 90, 213, 29, 247, 254,
+			161, 19, 97, 139, 16, 122, 220, 137, 32, 12, 184, 205, 24, 169,
+			252, 182, 31, 116, 19, 78, 250, 18, 46, 47, 167, 248, 181, 194,
+			96, 223, 134, 77, 238, 116, 250, 40, 71, 28, 242, 113, 155, 59,
+			17, 247, 242, 16, 135, 66, 1, 7, 33, 180, 184, 211, 97, 178,
+			25, 36, 78, 189, 197, 193, 143, 33, 224, 28, 233, 218, 8, 35,
+			97, 138, 116, 80, 183, 34, 133, 22, 160, 27, 163, 118, 116, 224,
+			83, 171, 23, 23, 155, 97, 55, 130, 150, 31, 112, 39, 98, 64,
+			163, 127, 115, 225, 225, 70, 7, 174, 231, 18, 181, 156, 71, 36,
+			144, 220, 17, 89, 57, 126, 76, 58, 1, 150, 151, 151, 87, 22,
+			233, 191, 218, 242, 242, 26, 253, 247, 14, 162, 126, 229, 202, 149,
+			43, 139, 43, 171, 139, 23, 86, 106, 171, 23, 214, 46, 93, 89,
+			187, 116, 197, 190, 162, 254, 189, 99, 195, 181, 30, 195, 133, 76,
+			34, 223, 77, 16, 192, 68, 162, 72, 163, 47, 192, 33, 7, 30,
+			196, 221, 8, 213, 178, 147, 224, 163, 139, 107, 17, 6, 7, 60,
+			74, 112, 124, 193, 44, 97, 27, 62, 85, 185, 190, 206, 224, 194,
+			133, 11, 87, 250, 184, 28, 30, 30, 218, 62, 79, 26, 100, 33,
+			70, 13, 119, 41, 106, 184, 216, 194, 78, 30, 36, 243, 104, 177,
+			113, 64, 187, 32, 216, 143, 17, 169, 151, 160, 244, 192, 105, 119,
+			90, 60, 102, 76, 253, 132, 149, 53, 88, 15, 219, 157, 110, 194,
+			51, 123, 129, 38, 220, 217, 174, 150, 63, 9, 247, 144, 50, 133,
+			249, 123, 182, 52, 121, 250, 141, 82, 227, 243, 170, 248, 146, 62,
+			219, 49, 79, 246, 228, 2, 23, 240, 109, 97, 107, 119, 115, 115,
+			126, 126, 100, 59, 226, 247, 194, 242, 252, 213, 12, 76, 171, 143,
+			130, 105, 159, 39, 56, 110, 216, 240, 156, 94, 6, 182, 56, 137,
+			186, 110, 66, 19, 28, 56, 45, 72, 14, 228, 140, 3, 205, 95,
+			73, 14, 22, 128, 0, 186, 250, 213, 162, 116, 96, 39, 7, 136,
+			224, 195, 48, 18, 141, 186, 49, 119, 225, 85, 88, 89, 94, 30,
+			196, 240, 194, 177, 24, 222, 245, 131, 11, 171, 112, 239, 6, 79,
+			170, 189, 56, 225, 109, 252, 92, 140, 175, 251, 45, 94, 27, 92,
+			136, 235, 229, 205, 82, 173, 124, 167, 4, 141, 68, 130, 113, 92,
+			159, 87, 26, 137, 130, 116, 183, 188, 85, 187, 124, 17, 18, 223,
+			189, 31, 195, 39, 160, 80, 40, 136, 55, 243, 141, 196, 246, 14,
+			111, 250, 251, 205, 13, 39, 161, 94, 243, 240, 198, 27, 112, 97,
+			117, 30, 254, 52, 208, 183, 205, 240, 80, 125, 82, 116, 91, 90,
+			130, 34, 220, 245, 3, 47, 60, 140, 105, 72, 220, 161, 43, 203,
+			203, 25, 25, 22, 219, 105, 3, 33, 165, 86, 46, 31, 221, 70,
+			233, 104, 216, 125, 229, 242, 197, 139, 23, 95, 187, 112, 121, 185,
+			47, 54, 234, 188, 17, 70, 28, 118, 3, 255, 129, 148, 117, 40,
+			204, 134, 71, 177, 191, 186, 197, 44, 8, 252, 161, 80, 64, 12,
+			98, 88, 162, 197, 194, 255, 230, 97, 49, 11, 206, 35, 56, 24,
+			199, 185, 176, 218, 31, 231, 229, 204, 56, 196, 0, 243, 3, 12,
+			112, 241, 88, 6, 184, 229, 28, 56, 112, 79, 44, 190, 237, 118,
+			163, 136, 7, 9, 54, 185, 227, 183, 90, 126, 156, 97, 0, 148,
+			166, 208, 166, 183, 240, 9, 56, 190, 195, 67, 216, 28, 62, 209,
+			127, 107, 7, 252, 240, 90, 215, 111, 121, 60, 42, 204, 35, 98,
+			85, 73, 33, 57, 133, 32, 204, 188, 24, 11, 255, 135, 109, 182,
+			136, 215, 11, 126, 144, 32, 230, 178, 165, 64, 93, 162, 141, 36,
+			152, 159, 183, 235, 56, 50, 193, 210, 167, 193, 165, 71, 208, 160,
+			28, 196, 137, 19, 36, 118, 16, 30, 102, 208, 150, 111, 33, 8,
+			15, 225, 19, 48, 208, 230, 161, 152, 246, 1, 127, 52, 202, 65,
+			120, 104, 239, 243, 164, 132, 204, 38, 222, 21, 230, 51, 152, 15,
+			98, 47, 27, 227, 67, 225, 24, 76, 47, 31, 139, 169, 92, 47,
+			101, 103, 192, 78, 47, 105, 10, 71, 98, 128, 209, 178, 11, 85,
+			152, 31, 250, 104, 223, 224, 201, 122, 127, 221, 11, 243, 36, 235,
+			111, 85, 183, 183, 224, 142, 211, 233, 248, 193, 62, 99, 80, 14,
+			196, 27, 244, 142, 157, 4, 29, 206, 44, 44, 73, 175, 67, 250,
+			117, 192, 112, 17, 170, 67, 218, 12, 140, 20, 208, 135, 210, 63,
+			98, 42, 27, 106, 168, 210, 253, 152, 230, 100, 50, 108, 128, 147,
+			229, 223, 71, 187, 225, 131, 197, 247, 219, 97, 144, 52, 63, 88,
+			124, 223, 115, 122, 31, 212, 222, 71, 229, 253, 193, 218, 251, 109,
+			63, 248, 96, 237, 253, 152, 187, 31, 124, 202, 126, 31, 205, 37,
+			220, 178, 31, 124, 243, 59, 121, 6, 135, 77, 30, 113, 16, 189,
+			113, 32, 167, 117, 232, 244, 98, 224, 15, 208, 130, 67, 103, 79,
+			216, 2, 13, 180, 2, 60, 127, 223, 79, 98, 52, 106, 90, 28,
+			228, 76, 11, 64, 83, 45, 48, 16, 147, 45, 0, 205, 182, 64,
+			150, 25, 77, 73, 118, 201, 123, 60, 10, 23, 59, 142, 135, 4,
+			65, 181, 125, 24, 170, 209, 184, 227, 54, 17, 47, 158, 218, 113,
+			104, 255, 73, 145, 178, 32, 45, 40, 215, 9, 96, 63, 132, 110,
+			7, 213, 248, 21, 213, 181, 224, 219, 220, 150, 47, 87, 70, 91,
+			123, 243, 11, 140, 230, 15, 59, 248, 228, 180, 196, 76, 249, 119,
+			242, 16, 119, 27, 13, 255, 1, 218, 163, 190, 235, 160, 129, 133,
+			171, 136, 76, 66, 150, 104, 33, 191, 91, 91, 207, 207, 95, 29,
+			120, 203, 144, 64, 17, 127, 183, 235, 71, 220, 179, 161, 136, 46,
+			111, 18, 94, 16, 204, 16, 147, 79, 238, 191, 199, 35, 136, 155,
+			97, 183, 229, 41, 82, 98, 112, 101, 183, 182, 14, 5, 39, 78,
+			103, 243, 160, 222, 99, 144, 127, 39, 63, 143, 11, 16, 160, 23,
+			28, 8, 147, 230, 40, 43, 33, 33, 157, 129, 169, 58, 78, 20,
+			247, 167, 169, 115, 6, 100, 211, 161, 133, 227, 186, 188, 147, 64,
+			61, 76, 154, 100, 193, 98, 95, 17, 52, 80, 56, 196, 71, 224,
+			0, 39, 128, 176, 209, 136, 121, 66, 230, 218, 245, 48, 2, 46,
+			246, 218, 2, 228, 87, 151, 87, 94, 91, 92, 94, 89, 92, 185,
+			84, 91, 94, 89, 187, 176, 188, 182, 114, 201, 94, 94, 121, 39,
+			47, 205, 242, 24, 232, 57, 85, 47, 29, 39, 78, 24, 80, 75,
+			154, 63, 12, 250, 118, 243, 165, 5, 192, 209, 108, 185, 129, 156,
+			3, 167, 234, 70, 126, 39, 89, 64, 107, 119, 192, 84, 115, 0,
+			213, 35, 132, 245, 207, 112, 52, 65, 208, 202, 67, 211, 81, 48,
+			187, 224, 71, 98, 127, 20, 87, 158, 19, 121, 12, 62, 149, 132,
+			229, 234, 118, 149, 54, 89, 97, 126, 132, 129, 106, 183, 195, 247,
+			252, 86, 203, 33, 235, 142, 7, 139, 187, 213, 37, 47, 116, 227,
+			165, 187, 188, 190, 212, 7, 101, 169, 194, 27, 60, 226, 129, 203,
+			151, 110, 180, 194, 186, 211, 218, 219, 38, 24, 226, 37, 4, 104,
+			41, 51, 201, 60, 197, 174, 154, 161, 103, 163, 52, 16, 146, 102,
+			1, 156, 20, 36, 184, 135, 22, 35, 18, 221, 86, 63, 238, 41,
+			132, 16, 213, 58, 87, 216, 114, 143, 141, 68, 145, 193, 167, 238,
+			197, 73, 212, 160, 174, 25, 140, 66, 55, 182, 59, 52, 31, 225,
+			178, 186, 212, 242, 235, 145, 19, 245, 40, 128, 105, 55, 147, 118,
+			235, 37, 250, 165, 250, 206, 83, 212, 142, 165, 140, 172, 38, 193,
+			8, 12, 204, 189, 252, 246, 226, 203, 237, 197, 151, 189, 218, 203,
+			55, 215, 94, 190, 179, 246, 114, 213, 126, 185, 241, 206, 156, 13,
+			155, 254, 125, 126, 232, 199, 156, 220, 28, 36, 80, 127, 149, 186,
+			49, 23, 163, 221, 10, 61, 135, 152, 117, 46, 134, 79, 221, 43,
+			87, 183, 149, 81, 115, 157, 102, 32, 196, 165, 161, 245, 205, 5,
+			17, 169, 148, 114, 238, 51, 161, 39, 86, 2, 127, 44, 34, 148,
+			75, 78, 199, 167, 5, 81, 111, 9, 157, 37, 1, 235, 210, 209,
+			177, 9, 79, 53, 193, 203, 171, 27, 47, 175, 110, 48, 152, 71,
+			66, 134, 117, 138, 16, 58, 18, 207, 132, 71, 224, 58, 29, 218,
+			32, 97, 3, 246, 121, 192, 35, 71, 108, 53, 181, 205, 112, 91,
+			102, 233, 111, 51, 198, 216, 9, 102, 152, 99, 154, 101, 126, 78,
+			155, 58, 197, 190, 95, 99, 166, 57, 166, 143, 89, 230, 231, 53,
+			253, 233, 220, 95, 214, 160, 210, 247, 112, 21, 239, 135, 13, 98,
+			121, 132, 27, 98, 63, 112, 179, 86, 22, 27, 109, 102, 193, 29,
+			12, 39, 214, 249, 67, 221, 34, 54, 202, 47, 122, 7, 252, 192,
+			109, 117, 99, 255, 0, 29, 197, 39, 216, 56, 130, 55, 78, 240,
+			77, 170, 71, 13, 31, 167, 158, 82, 143, 6, 62, 90, 167, 217,
+			127, 17, 200, 104, 150, 249, 93, 154, 110, 229, 126, 69, 131, 173,
+			48, 88, 12, 248, 190, 240, 131, 149, 20, 38, 132, 28, 137, 29,
+			122, 196, 35, 229, 171, 13, 91, 178, 99, 234, 96, 30, 56, 173,
+			46, 143, 137, 235, 50, 131, 81, 224, 52, 78, 252, 86, 11, 154,
+			206, 1, 135, 32, 59, 39, 13, 45, 59, 34, 111, 57, 137, 116,
+			208, 27, 97, 132, 142, 177, 138, 30, 12, 19, 76, 58, 141, 11,
+			242, 255, 216, 8, 162, 104, 227, 132, 167, 34, 138, 70, 104, 79,
+			61, 161, 30, 13, 124, 156, 57, 85, 159, 16, 242, 149, 125, 75,
+			129, 149, 246, 67, 219, 109, 70, 97, 219, 239, 182, 137, 73, 91,
+			93, 215, 95, 162, 128, 123, 180, 228, 116, 147, 230, 146, 199, 91,
+			4, 121, 24, 44, 181, 121, 28, 59, 251, 60, 206, 188, 147, 249,
+			142, 41, 245, 41, 255, 37, 141, 61, 181, 145, 126, 174, 133, 247,
+			121, 96, 157, 99, 211, 177, 191, 31, 240, 104, 207, 247, 40, 179,
+			49, 93, 153, 18, 47, 202, 158, 245, 49, 246, 36, 254, 246, 131,
+			253, 189, 251, 188, 135, 45, 12, 106, 113, 82, 190, 189, 205, 123,
+			101, 207, 42, 176, 153, 206, 125, 55, 94, 217, 139, 155, 206, 234,
+			165, 203, 123, 177, 191, 63, 107, 130, 86, 56, 89, 121, 146, 222,
+			87, 233, 117, 213, 223, 183, 150, 216, 233, 84, 59, 121, 123, 113,
+			183, 158, 32, 12, 179, 227, 212, 216, 234, 127, 170, 202, 47, 183,
+			204, 41, 109, 70, 207, 127, 222, 96, 83, 234, 149, 245, 113, 102,
+			222, 247, 3, 111, 118, 10, 180, 194, 147, 171, 103, 109, 133, 157,
+			173, 90, 216, 183, 253, 192, 171, 80, 35, 235, 5, 118, 66, 205,
+			130, 208, 35, 84, 70, 133, 169, 87, 101, 207, 90, 100, 150, 36,
+			24, 247, 246, 124, 12, 6, 249, 73, 143, 50, 63, 211, 149, 83,
+			233, 151, 178, 252, 128, 205, 81, 237, 242, 56, 9, 163, 126, 243,
+			73, 209, 60, 253, 146, 54, 127, 137, 61, 225, 70, 156, 168, 189,
+			135, 108, 67, 4, 54, 42, 39, 213, 75, 148, 25, 214, 199, 217,
+			169, 3, 167, 229, 123, 126, 210, 219, 243, 186, 40, 19, 194, 128,
+			232, 60, 94, 153, 81, 31, 54, 228, 123, 43, 199, 166, 156, 174,
+			231, 163, 102, 152, 29, 7, 163, 48, 93, 73, 159, 241, 27, 50,
+			135, 239, 242, 120, 118, 66, 124, 83, 207, 150, 197, 204, 196, 217,
+			143, 103, 167, 233, 61, 253, 206, 95, 98, 38, 146, 202, 154, 97,
+			39, 119, 183, 110, 111, 109, 223, 221, 218, 187, 93, 222, 218, 152,
+			25, 179, 206, 177, 179, 215, 74, 197, 74, 169, 178, 183, 81, 218,
+			44, 221, 40, 98, 208, 115, 175, 182, 125, 187, 180, 53, 163, 93,
+			187, 252, 206, 197, 175, 134, 49, 111, 253, 80, 158, 77, 88, 38,
+			27, 123, 95, 99, 127, 160, 83, 130, 138, 141, 89, 171, 63, 172,
+			13, 228, 154, 86, 46, 145, 93, 180, 185, 187, 94, 134, 98, 55,
+			105, 134, 81, 108, 31, 147, 112, 218, 197, 168, 127, 67, 133, 245,
+			251, 233, 25, 63, 134, 253, 240, 128, 71, 1, 218, 140, 129, 39,
+			179, 13, 197, 142, 227, 226, 192, 190, 203, 3, 212, 38, 111, 241,
+			8, 163, 251, 176, 106, 47, 171, 141, 46, 180, 97, 35, 236, 6,
+			158, 138, 169, 109, 150, 215, 75, 91, 213, 18, 52, 252, 22, 183,
+			217, 234, 191, 208, 160, 134, 211, 225, 35, 154, 96, 110, 216, 241,
+			101, 126, 1, 144, 6, 139, 157, 158, 189, 239, 39, 107, 12, 156,
+			78, 135, 7, 251, 126, 192, 151, 220, 176, 221, 9, 3, 12, 65,
+			102, 127, 18, 149, 104, 127, 30, 217, 176, 12, 221, 138, 182, 159,
+			172, 65, 227, 242, 202, 37, 254, 218, 165, 43, 171, 151, 87, 174,
+			92, 190, 178, 210, 224, 87, 26, 206, 229, 139, 87, 174, 188, 246,
+			250, 235, 203, 124, 245, 226, 149, 229, 229, 215, 86, 189, 250, 234,
+			10, 99, 176, 78, 233, 187, 120, 13, 34, 142, 185, 19, 15, 58,
+			142, 123, 223, 217, 167, 104, 226, 156, 90, 130, 185, 52, 152, 123,
+			114, 236, 148, 12, 167, 62, 57, 118, 91, 133, 104, 241, 231, 247,
+			105, 20, 78, 53, 207, 142, 173, 104, 185, 239, 208, 160, 138, 162,
+			192, 235, 219, 148, 30, 164, 219, 140, 34, 106, 126, 12, 114, 116,
+			164, 199, 103, 80, 182, 98, 240, 50, 16, 214, 142, 34, 108, 20,
+			249, 210, 174, 29, 49, 144, 26, 128, 145, 165, 142, 230, 52, 74,
+			23, 39, 233, 70, 40, 60, 97, 139, 63, 72, 160, 188, 177, 6,
+			151, 73, 29, 146, 50, 52, 206, 78, 157, 21, 191, 167, 45, 99,
+			86, 127, 130, 157, 64, 77, 50, 61, 102, 25, 179, 39, 78, 178,
+			147, 40, 81, 167, 199, 180, 129, 39, 93, 60, 125, 183, 46, 21,
+			168, 241, 162, 126, 54, 247, 89, 29, 212, 94, 85, 74, 134, 246,
+			139, 128, 27, 193, 64, 47, 1, 87, 60, 69, 185, 156, 50, 138,
+			3, 115, 178, 249, 218, 27, 78, 167, 179, 232, 123, 111, 206, 201,
+			200, 28, 198, 143, 231, 186, 49, 143, 214, 222, 144, 77, 22, 29,
+			151, 20, 201, 34, 111, 59, 126, 235, 205, 57, 38, 91, 74, 35,
+			148, 236, 100, 215, 137, 37, 149, 156, 78, 39, 10, 59, 145, 143,
+			198, 167, 203, 163, 4, 19, 84, 248, 27, 197, 14, 166, 174, 90,
+			45, 228, 212, 119, 187, 60, 66, 246, 43, 28, 248, 14, 84, 171,
+			155, 243, 12, 77, 13, 26, 160, 211, 173, 183, 124, 23, 238, 243,
+			30, 50, 0, 26, 254, 248, 37, 165, 43, 28, 240, 40, 77, 155,
+			218, 76, 80, 12, 213, 182, 241, 162, 62, 165, 158, 52, 203, 120,
+			113, 218, 82, 79, 134, 101, 188, 120, 230, 25, 246, 91, 186, 212,
+			217, 198, 171, 250, 115, 185, 95, 209, 161, 188, 33, 40, 135, 83,
+			81, 78, 14, 39, 106, 59, 247, 165, 109, 60, 184, 150, 53, 242,
+			241, 36, 253, 218, 221, 86, 226, 99, 200, 0, 213, 252, 1, 71,
+			96, 99, 84, 239, 24, 137, 111, 135, 109, 222, 143, 213, 175, 145,
+			201, 151, 142, 30, 240, 67, 214, 31, 55, 22, 30, 30, 182, 168,
+			115, 156, 53, 10, 19, 114, 40, 48, 187, 87, 168, 119, 149, 178,
+			39, 81, 74, 253, 179, 200, 167, 129, 225, 150, 223, 224, 56, 23,
+			98, 131, 112, 211, 130, 147, 123, 18, 113, 55, 105, 245, 64, 100,
+			11, 98, 8, 133, 100, 24, 110, 47, 53, 34, 67, 52, 148, 179,
+			232, 199, 176, 122, 177, 105, 67, 53, 132, 254, 38, 23, 35, 199,
+			200, 69, 115, 9, 180, 208, 234, 192, 160, 15, 137, 41, 39, 160,
+			14, 106, 65, 180, 113, 203, 120, 53, 93, 16, 13, 137, 62, 125,
+			86, 61, 25, 150, 241, 106, 238, 28, 219, 165, 245, 208, 45, 99,
+			81, 63, 159, 187, 9, 181, 44, 201, 215, 96, 231, 246, 122, 117,
+			101, 239, 96, 101, 239, 210, 199, 171, 55, 139, 171, 151, 46, 23,
+			70, 232, 223, 5, 24, 84, 242, 243, 41, 71, 232, 227, 56, 238,
+			164, 122, 210, 44, 99, 113, 106, 86, 61, 25, 150, 177, 120, 238,
+			57, 246, 54, 1, 96, 88, 198, 178, 14, 185, 77, 168, 30, 191,
+			193, 109, 40, 39, 115, 153, 221, 141, 18, 131, 56, 154, 196, 237,
+			176, 9, 145, 2, 97, 140, 227, 216, 10, 8, 67, 179, 140, 229,
+			169, 115, 234, 9, 231, 125, 254, 5, 86, 101, 186, 169, 89, 230,
+			107, 99, 239, 107, 185, 27, 114, 79, 55, 80, 230, 28, 54, 83,
+			218, 211, 19, 242, 23, 169, 21, 63, 161, 173, 113, 216, 12, 219,
+			232, 249, 14, 201, 154, 149, 101, 41, 108, 144, 232, 175, 77, 205,
+			176, 147, 204, 52, 53, 20, 140, 175, 235, 215, 13, 154, 92, 35,
+			65, 244, 250, 228, 9, 246, 215, 13, 54, 129, 31, 81, 170, 124,
+			163, 121, 38, 247, 23, 13, 33, 24, 73, 108, 128, 235, 36, 110,
+			19, 194, 150, 167, 22, 158, 100, 139, 23, 6, 115, 137, 48, 64,
+			231, 208, 82, 153, 131, 134, 207, 91, 30, 244, 120, 130, 128, 144,
+			109, 22, 167, 234, 13, 91, 80, 244, 32, 205, 143, 136, 112, 206,
+			220, 49, 90, 122, 142, 248, 60, 8, 15, 23, 132, 72, 64, 197,
+			227, 36, 126, 221, 111, 249, 73, 207, 134, 107, 221, 4, 248, 1,
+			15, 146, 174, 211, 106, 245, 160, 64, 158, 191, 131, 66, 197, 113,
+			239, 115, 149, 193, 233, 118, 208, 173, 241, 230, 41, 196, 211, 99,
+			74, 236, 184, 33, 37, 207, 196, 118, 42, 200, 18, 130, 140, 84,
+			10, 66, 56, 116, 136, 182, 210, 197, 225, 216, 191, 61, 111, 43,
+			162, 48, 149, 240, 73, 66, 112, 14, 66, 223, 35, 226, 160, 156,
+			196, 226, 20, 199, 117, 201, 174, 34, 200, 82, 116, 21, 237, 194,
+			134, 160, 197, 139, 159, 128, 101, 112, 98, 6, 199, 16, 64, 182,
+			183, 25, 123, 146, 77, 138, 181, 209, 112, 113, 102, 250, 207, 186,
+			101, 124, 227, 233, 167, 217, 175, 106, 114, 241, 52, 203, 40, 153,
+			144, 251, 57, 84, 241, 106, 251, 15, 208, 95, 138, 173, 88, 10,
+			166, 79, 46, 246, 77, 233, 69, 90, 175, 197, 183, 86, 224, 102,
+			173, 182, 3, 77, 238, 120, 84, 201, 129, 35, 73, 5, 128, 126,
+			72, 171, 5, 110, 147, 187, 247, 137, 214, 105, 254, 72, 122, 56,
+			169, 208, 17, 242, 12, 91, 51, 226, 85, 212, 79, 24, 170, 1,
+			105, 95, 198, 184, 242, 110, 216, 246, 131, 125, 97, 121, 204, 29,
+			181, 96, 231, 50, 152, 35, 11, 151, 204, 115, 253, 103, 221, 50,
+			74, 207, 191, 192, 60, 100, 105, 228, 217, 219, 250, 76, 238, 46,
+			220, 69, 182, 36, 68, 17, 24, 196, 7, 247, 39, 90, 87, 200,
+			144, 27, 188, 225, 99, 204, 166, 25, 30, 130, 159, 170, 64, 20,
+			201, 54, 84, 57, 198, 16, 218, 40, 177, 201, 115, 5, 180, 42,
+			129, 7, 221, 182, 220, 195, 154, 62, 54, 129, 211, 76, 168, 39,
+			205, 50, 110, 79, 158, 80, 79, 134, 101, 220, 126, 242, 41, 118,
+			64, 240, 104, 150, 177, 163, 207, 230, 252, 254, 38, 142, 210, 101,
+			80, 2, 11, 9, 160, 152, 11, 3, 88, 25, 129, 141, 212, 70,
+			210, 51, 216, 85, 234, 162, 21, 238, 239, 35, 173, 144, 170, 73,
+			228, 184, 164, 154, 58, 221, 168, 19, 198, 60, 78, 33, 68, 89,
+			187, 35, 165, 140, 70, 52, 219, 153, 58, 173, 158, 12, 203, 216,
+			121, 230, 44, 251, 52, 65, 168, 91, 198, 174, 14, 185, 109, 9,
+			97, 210, 131, 195, 102, 24, 243, 140, 92, 241, 227, 84, 230, 120,
+			8, 74, 49, 53, 11, 26, 178, 222, 38, 106, 67, 94, 216, 7,
+			194, 30, 200, 167, 112, 160, 200, 221, 149, 50, 95, 35, 145, 187,
+			59, 125, 78, 61, 25, 150, 177, 251, 252, 11, 236, 231, 209, 113,
+			214, 116, 195, 50, 62, 173, 67, 238, 39, 53, 184, 219, 12, 21,
+			119, 28, 49, 88, 104, 215, 185, 78, 128, 213, 58, 110, 19, 142,
+			242, 10, 248, 2, 42, 132, 39, 3, 186, 84, 224, 126, 4, 225,
+			97, 192, 32, 109, 29, 70, 25, 6, 112, 192, 243, 177, 158, 139,
+			246, 132, 7, 254, 128, 238, 196, 205, 44, 68, 12, 154, 118, 224,
+			183, 49, 63, 27, 6, 25, 45, 152, 98, 141, 50, 254, 211, 41,
+			214, 40, 227, 63, 157, 98, 109, 32, 158, 207, 191, 192, 222, 35,
+			164, 77, 203, 112, 244, 92, 174, 13, 119, 81, 104, 245, 103, 59,
+			28, 224, 9, 146, 75, 36, 214, 252, 68, 202, 44, 114, 235, 133,
+			114, 131, 173, 110, 187, 46, 248, 74, 197, 76, 68, 156, 132, 66,
+			36, 80, 160, 112, 73, 26, 149, 81, 234, 80, 211, 205, 113, 156,
+			92, 241, 136, 169, 89, 134, 51, 117, 70, 61, 25, 150, 225, 204,
+			62, 203, 214, 9, 202, 113, 203, 240, 244, 231, 115, 151, 225, 102,
+			120, 72, 234, 125, 144, 46, 110, 24, 196, 190, 199, 35, 238, 73,
+			179, 164, 128, 69, 111, 2, 148, 254, 116, 227, 52, 138, 154, 110,
+			92, 179, 12, 111, 234, 89, 245, 100, 88, 134, 247, 220, 121, 246,
+			51, 130, 21, 38, 44, 227, 51, 250, 11, 185, 127, 32, 88, 1,
+			23, 71, 70, 133, 134, 152, 161, 228, 184, 77, 240, 19, 222, 78,
+			23, 48, 232, 175, 172, 100, 210, 2, 183, 247, 237, 33, 246, 156,
+			199, 144, 98, 126, 63, 10, 187, 157, 181, 55, 208, 193, 120, 51,
+			175, 44, 216, 5, 52, 118, 49, 134, 231, 59, 45, 200, 191, 154,
+			87, 195, 8, 19, 168, 205, 157, 32, 134, 124, 49, 192, 162, 45,
+			39, 162, 24, 24, 69, 238, 250, 4, 233, 243, 254, 132, 137, 104,
+			164, 79, 227, 150, 241, 153, 19, 167, 212, 147, 102, 25, 159, 177,
+			114, 234, 201, 176, 140, 207, 156, 127, 94, 132, 144, 52, 125, 210,
+			50, 222, 213, 95, 200, 253, 42, 162, 239, 36, 125, 145, 171, 226,
+			223, 34, 24, 61, 72, 138, 77, 89, 15, 151, 54, 46, 200, 82,
+			48, 161, 90, 229, 107, 69, 29, 159, 199, 11, 32, 8, 35, 191,
+			172, 9, 155, 63, 63, 47, 221, 199, 99, 231, 130, 59, 78, 15,
+			156, 86, 28, 98, 160, 149, 66, 130, 71, 169, 37, 45, 70, 150,
+			210, 171, 213, 74, 177, 232, 211, 103, 210, 68, 60, 211, 167, 113,
+			203, 120, 55, 165, 207, 164, 102, 25, 239, 166, 244, 153, 52, 44,
+			227, 221, 243, 207, 179, 255, 171, 19, 125, 166, 44, 227, 61, 253,
+			92, 238, 191, 234, 80, 140, 234, 126, 130, 81, 90, 184, 207, 123,
+			107, 20, 232, 130, 142, 227, 71, 49, 240, 118, 157, 83, 86, 196,
+			15, 146, 176, 191, 58, 40, 94, 15, 155, 33, 63, 224, 81, 70,
+			196, 248, 137, 205, 96, 61, 12, 14, 120, 15, 92, 63, 114, 187,
+			109, 140, 55, 187, 228, 144, 31, 54, 179, 2, 25, 133, 15, 70,
+			59, 208, 101, 103, 80, 85, 196, 38, 146, 165, 180, 82, 141, 177,
+			182, 79, 242, 70, 155, 52, 137, 227, 137, 90, 69, 167, 165, 196,
+			235, 123, 36, 62, 24, 120, 220, 245, 209, 129, 143, 109, 216, 105,
+			113, 39, 38, 249, 133, 5, 46, 17, 111, 243, 86, 15, 92, 39,
+			226, 141, 110, 171, 213, 195, 120, 113, 11, 113, 224, 1, 244, 194,
+			46, 45, 66, 20, 182, 68, 106, 2, 119, 97, 204, 148, 92, 86,
+			146, 47, 12, 160, 229, 7, 247, 73, 15, 227, 62, 217, 239, 58,
+			145, 19, 36, 92, 250, 179, 106, 97, 208, 10, 10, 91, 7, 42,
+			204, 128, 20, 240, 100, 120, 219, 217, 239, 107, 150, 41, 211, 50,
+			222, 75, 87, 109, 106, 220, 50, 222, 75, 87, 109, 74, 179, 140,
+			247, 172, 103, 212, 147, 97, 25, 239, 61, 155, 75, 99, 128, 255,
+			230, 89, 246, 250, 200, 80, 11, 81, 75, 197, 91, 58, 254, 82,
+			219, 113, 155, 126, 192, 247, 232, 189, 12, 251, 157, 200, 52, 202,
+			255, 115, 141, 205, 220, 17, 141, 200, 84, 185, 22, 122, 61, 235,
+			69, 118, 82, 117, 108, 188, 235, 5, 50, 234, 117, 66, 190, 187,
+			254, 174, 71, 209, 65, 63, 142, 187, 220, 219, 171, 247, 84, 116,
+			80, 188, 184, 214, 203, 124, 116, 18, 10, 88, 153, 234, 99, 49,
+			193, 96, 148, 114, 145, 40, 24, 104, 86, 210, 103, 235, 52, 27,
+			119, 29, 140, 199, 141, 83, 60, 206, 116, 157, 178, 103, 157, 101,
+			147, 232, 234, 238, 197, 1, 197, 211, 78, 86, 38, 240, 177, 26,
+			220, 50, 167, 38, 102, 38, 243, 247, 217, 211, 89, 4, 74, 50,
+			194, 96, 157, 103, 140, 16, 221, 171, 135, 158, 8, 220, 157, 172,
+			76, 39, 41, 142, 103, 216, 132, 140, 92, 10, 232, 199, 239, 83,
+			200, 242, 60, 99, 81, 236, 72, 111, 131, 96, 63, 89, 153, 142,
+			98, 71, 132, 42, 95, 125, 99, 144, 90, 88, 97, 157, 141, 146,
+			213, 222, 222, 41, 205, 140, 89, 207, 48, 11, 227, 84, 123, 119,
+			138, 235, 55, 203, 91, 37, 25, 32, 211, 175, 173, 190, 179, 252,
+			56, 171, 118, 53, 243, 124, 235, 87, 207, 96, 112, 204, 28, 187,
+			165, 177, 47, 105, 20, 28, 51, 71, 4, 199, 46, 127, 29, 7,
+			199, 100, 100, 105, 98, 236, 105, 25, 89, 154, 26, 187, 161, 34,
+			75, 248, 243, 219, 52, 166, 143, 143, 89, 230, 204, 216, 105, 45,
+			247, 1, 33, 130, 214, 38, 217, 190, 146, 229, 148, 149, 223, 23,
+			8, 132, 143, 208, 25, 109, 63, 32, 215, 104, 199, 137, 101, 233,
+			239, 29, 63, 72, 178, 203, 68, 251, 181, 28, 160, 116, 29, 120,
+			143, 189, 42, 146, 52, 107, 176, 34, 60, 187, 113, 244, 222, 102,
+			198, 207, 176, 11, 204, 28, 199, 140, 138, 113, 74, 127, 54, 255,
+			10, 74, 145, 212, 142, 17, 46, 25, 86, 168, 133, 88, 55, 232,
+			39, 210, 179, 165, 189, 140, 157, 52, 203, 56, 165, 63, 169, 158,
+			116, 203, 56, 117, 118, 150, 93, 166, 1, 53, 203, 176, 244, 103,
+			243, 243, 194, 236, 226, 113, 54, 132, 54, 138, 145, 213, 152, 104,
+			121, 90, 250, 211, 234, 73, 183, 12, 235, 236, 44, 251, 29, 93,
+			132, 229, 158, 27, 251, 6, 45, 247, 31, 245, 129, 17, 112, 47,
+			131, 199, 99, 55, 242, 235, 28, 83, 72, 9, 143, 2, 167, 133,
+			122, 184, 235, 98, 24, 64, 137, 183, 1, 42, 171, 184, 135, 180,
+			158, 164, 243, 214, 233, 34, 162, 89, 47, 38, 78, 163, 114, 184,
+			193, 164, 70, 69, 223, 181, 142, 125, 67, 104, 57, 209, 62, 183,
+			69, 182, 150, 56, 37, 226, 78, 28, 6, 112, 40, 4, 114, 119,
+			192, 148, 66, 232, 226, 132, 59, 30, 130, 52, 116, 98, 194, 78,
+			179, 205, 12, 10, 65, 72, 78, 34, 233, 128, 182, 239, 70, 42,
+			223, 211, 137, 164, 216, 159, 23, 94, 146, 19, 199, 221, 246, 80,
+			140, 140, 2, 16, 16, 144, 137, 23, 139, 164, 123, 220, 118, 176,
+			136, 165, 9, 93, 63, 72, 46, 95, 36, 26, 237, 35, 110, 133,
+			32, 76, 32, 114, 2, 47, 108, 67, 189, 21, 214, 227, 249, 76,
+			144, 241, 185, 169, 217, 52, 200, 120, 62, 27, 100, 60, 63, 16,
+			100, 204, 60, 233, 226, 219, 247, 170, 44, 157, 241, 146, 158, 203,
+			125, 94, 83, 203, 213, 55, 181, 250, 230, 129, 200, 190, 246, 98,
+			40, 168, 229, 185, 254, 77, 27, 91, 243, 50, 212, 136, 213, 27,
+			15, 208, 105, 73, 84, 152, 217, 161, 232, 112, 24, 192, 150, 44,
+			159, 119, 6, 176, 39, 246, 165, 66, 143, 186, 19, 99, 164, 58,
+			140, 164, 74, 146, 139, 46, 195, 121, 227, 150, 241, 146, 180, 183,
+			5, 31, 191, 52, 125, 70, 61, 25, 150, 241, 210, 236, 179, 236,
+			139, 42, 61, 103, 204, 235, 103, 115, 223, 165, 41, 229, 13, 50,
+			148, 9, 100, 27, 62, 36, 84, 74, 22, 58, 5, 219, 122, 42,
+			26, 40, 77, 10, 21, 102, 200, 184, 196, 233, 25, 13, 210, 32,
+			17, 10, 42, 63, 96, 144, 71, 181, 191, 72, 221, 22, 101, 136,
+			32, 15, 100, 135, 166, 184, 160, 231, 54, 159, 226, 130, 251, 103,
+			62, 13, 91, 106, 134, 101, 204, 159, 121, 134, 109, 169, 40, 217,
+			130, 126, 54, 87, 132, 65, 3, 31, 250, 198, 183, 48, 19, 50,
+			171, 131, 110, 5, 65, 228, 217, 80, 81, 5, 23, 106, 102, 244,
+			213, 22, 210, 153, 209, 87, 91, 72, 103, 214, 13, 203, 88, 56,
+			243, 12, 139, 84, 120, 108, 69, 63, 155, 227, 35, 60, 143, 135,
+			58, 6, 200, 6, 187, 49, 249, 80, 128, 245, 106, 232, 108, 39,
+			35, 61, 222, 163, 208, 161, 79, 181, 146, 66, 135, 62, 213, 202,
+			244, 41, 245, 100, 88, 198, 202, 153, 103, 20, 167, 154, 150, 113,
+			89, 183, 144, 83, 203, 180, 53, 29, 88, 47, 138, 133, 21, 184,
+			167, 162, 227, 8, 171, 37, 33, 180, 157, 251, 124, 104, 229, 107,
+			77, 30, 115, 40, 111, 196, 180, 251, 60, 138, 29, 8, 181, 49,
+			32, 213, 195, 160, 225, 239, 139, 240, 117, 55, 240, 223, 237, 242,
+			61, 223, 19, 113, 48, 229, 4, 141, 145, 207, 117, 89, 250, 92,
+			99, 228, 115, 93, 158, 122, 66, 61, 25, 150, 113, 121, 230, 20,
+			251, 15, 104, 229, 142, 225, 106, 188, 169, 63, 157, 251, 178, 14,
+			213, 172, 12, 24, 22, 127, 143, 137, 3, 89, 40, 36, 100, 164,
+			89, 2, 73, 184, 207, 49, 214, 37, 129, 197, 248, 20, 237, 231,
+			134, 88, 142, 204, 184, 11, 169, 233, 136, 53, 49, 106, 18, 17,
+			251, 65, 145, 150, 5, 33, 226, 7, 161, 136, 69, 67, 161, 222,
+			3, 39, 166, 248, 196, 0, 161, 14, 155, 52, 175, 216, 200, 251,
+			254, 1, 15, 6, 70, 160, 173, 2, 235, 149, 205, 121, 228, 209,
+			116, 52, 154, 46, 30, 40, 58, 90, 128, 118, 136, 117, 49, 174,
+			211, 106, 161, 248, 67, 8, 35, 180, 150, 195, 0, 11, 173, 252,
+			104, 160, 39, 218, 205, 233, 58, 160, 51, 250, 102, 186, 14, 232,
+			140, 190, 57, 245, 148, 122, 50, 44, 227, 77, 235, 52, 251, 31,
+			152, 60, 210, 44, 243, 250, 216, 45, 45, 247, 159, 181, 145, 122,
+			14, 55, 246, 161, 224, 172, 52, 60, 32, 3, 247, 25, 13, 137,
+			228, 75, 107, 247, 177, 48, 133, 68, 154, 90, 65, 65, 156, 130,
+			211, 192, 154, 10, 209, 87, 213, 184, 81, 197, 10, 212, 157, 152,
+			95, 190, 216, 175, 129, 137, 156, 67, 81, 39, 132, 5, 51, 210,
+			28, 192, 236, 67, 74, 232, 2, 229, 235, 189, 76, 223, 180, 57,
+			2, 220, 149, 91, 240, 207, 92, 90, 94, 134, 122, 15, 139, 180,
+			208, 169, 207, 196, 136, 175, 79, 61, 199, 94, 85, 1, 181, 27,
+			250, 217, 252, 249, 227, 20, 62, 170, 107, 229, 12, 96, 198, 229,
+			134, 36, 170, 70, 98, 248, 198, 148, 165, 158, 12, 203, 184, 113,
+			230, 25, 246, 141, 42, 44, 118, 83, 127, 58, 127, 1, 124, 185,
+			65, 7, 24, 164, 19, 249, 7, 200, 75, 3, 73, 24, 149, 145,
+			144, 227, 161, 152, 188, 41, 197, 129, 8, 112, 221, 156, 126, 42,
+			19, 224, 186, 105, 157, 102, 5, 21, 224, 42, 235, 103, 243, 231,
+			50, 145, 250, 176, 1, 115, 125, 51, 123, 78, 141, 137, 91, 174,
+			156, 194, 143, 64, 150, 83, 248, 81, 230, 149, 207, 60, 147, 58,
+			51, 223, 253, 5, 141, 21, 31, 207, 155, 161, 176, 239, 210, 193,
+			138, 248, 178, 135, 150, 31, 143, 164, 91, 99, 101, 90, 219, 226,
+			75, 238, 81, 71, 62, 115, 31, 77, 37, 69, 238, 171, 118, 198,
+			242, 239, 178, 179, 195, 214, 42, 202, 107, 30, 39, 214, 235, 108,
+			182, 207, 41, 194, 131, 219, 147, 142, 182, 116, 103, 158, 233, 127,
+			31, 232, 249, 156, 40, 221, 160, 92, 10, 57, 103, 39, 43, 253,
+			23, 249, 207, 233, 236, 244, 168, 249, 128, 157, 200, 8, 15, 57,
+			69, 246, 149, 117, 151, 157, 78, 135, 217, 115, 90, 251, 97, 228,
+			39, 205, 54, 205, 240, 228, 234, 43, 246, 209, 5, 176, 171, 170,
+			121, 81, 181, 174, 88, 241, 145, 119, 214, 107, 195, 14, 227, 137,
+			213, 156, 125, 172, 25, 152, 113, 38, 223, 80, 78, 30, 150, 180,
+			146, 59, 249, 228, 234, 249, 1, 64, 178, 152, 162, 187, 38, 125,
+			64, 252, 153, 255, 223, 26, 155, 61, 74, 253, 184, 19, 6, 49,
+			183, 222, 96, 140, 71, 81, 24, 237, 161, 252, 152, 213, 70, 12,
+			45, 113, 44, 97, 171, 245, 208, 227, 149, 105, 174, 126, 90, 47,
+			177, 39, 232, 97, 79, 50, 141, 244, 145, 79, 210, 203, 59, 226,
+			157, 181, 205, 158, 84, 203, 42, 38, 149, 184, 23, 70, 77, 51,
+			10, 200, 202, 19, 201, 0, 204, 115, 236, 41, 25, 131, 216, 59,
+			16, 158, 26, 209, 100, 186, 242, 164, 124, 45, 253, 183, 252, 223,
+			212, 216, 211, 163, 6, 28, 53, 130, 62, 106, 4, 171, 198, 44,
+			220, 163, 123, 3, 76, 61, 123, 134, 224, 255, 216, 40, 248, 55,
+			187, 174, 159, 157, 242, 230, 88, 101, 166, 53, 244, 238, 218, 201,
+			236, 122, 230, 239, 179, 153, 225, 94, 72, 216, 193, 41, 113, 101,
+			166, 43, 42, 96, 65, 251, 192, 90, 101, 19, 164, 181, 68, 104,
+			226, 225, 204, 36, 91, 230, 127, 67, 99, 57, 100, 134, 161, 58,
+			40, 181, 59, 62, 100, 61, 208, 200, 218, 29, 4, 198, 120, 68,
+			237, 142, 241, 144, 218, 29, 115, 168, 118, 231, 25, 54, 129, 180,
+			13, 18, 42, 148, 154, 174, 200, 167, 180, 166, 103, 34, 83, 211,
+			243, 3, 26, 59, 55, 18, 61, 185, 240, 79, 179, 241, 44, 61,
+			197, 131, 181, 206, 78, 75, 220, 176, 82, 73, 165, 89, 36, 85,
+			173, 163, 37, 86, 21, 69, 36, 63, 12, 212, 187, 81, 60, 101,
+			140, 226, 169, 252, 143, 107, 66, 26, 238, 68, 33, 214, 180, 14,
+			208, 255, 69, 118, 18, 185, 101, 175, 35, 190, 73, 48, 79, 224,
+			59, 217, 28, 107, 186, 66, 244, 10, 246, 98, 55, 236, 240, 89,
+			157, 176, 103, 244, 170, 138, 111, 172, 85, 118, 166, 237, 7, 123,
+			71, 23, 198, 160, 133, 57, 221, 246, 131, 183, 134, 215, 230, 60,
+			99, 184, 22, 201, 30, 81, 84, 172, 192, 52, 189, 169, 33, 89,
+			255, 173, 20, 33, 131, 32, 75, 154, 174, 178, 51, 10, 113, 233,
+			26, 237, 145, 107, 36, 129, 63, 45, 63, 22, 197, 183, 18, 126,
+			194, 216, 27, 70, 63, 227, 88, 178, 183, 78, 236, 125, 66, 188,
+			27, 230, 110, 227, 113, 185, 251, 241, 37, 195, 47, 232, 236, 121,
+			92, 131, 234, 0, 108, 3, 75, 113, 75, 109, 82, 12, 211, 72,
+			201, 248, 241, 81, 91, 126, 196, 24, 152, 1, 148, 34, 24, 127,
+			102, 225, 146, 36, 26, 146, 55, 178, 51, 94, 178, 16, 113, 167,
+			213, 150, 172, 35, 30, 134, 151, 220, 60, 178, 228, 175, 178, 83,
+			190, 82, 158, 233, 30, 19, 187, 229, 41, 223, 35, 144, 138, 106,
+			171, 29, 203, 30, 19, 143, 203, 30, 147, 195, 236, 241, 87, 52,
+			246, 194, 177, 212, 124, 232, 206, 251, 42, 68, 216, 227, 111, 180,
+			3, 54, 91, 122, 32, 46, 118, 216, 46, 111, 172, 15, 172, 238,
+			179, 108, 74, 81, 76, 66, 52, 41, 9, 245, 225, 132, 154, 18,
+			66, 70, 70, 8, 125, 81, 99, 207, 142, 152, 248, 235, 71, 4,
+			189, 122, 149, 89, 71, 109, 150, 108, 136, 183, 184, 121, 99, 123,
+			102, 204, 58, 205, 158, 18, 117, 51, 123, 149, 106, 81, 188, 212,
+			94, 253, 109, 141, 77, 167, 214, 128, 117, 130, 77, 86, 119, 215,
+			215, 75, 213, 234, 204, 152, 245, 44, 59, 179, 187, 85, 221, 221,
+			217, 217, 174, 212, 74, 27, 123, 213, 242, 141, 173, 98, 109, 183,
+			82, 154, 209, 172, 28, 123, 38, 251, 137, 130, 197, 34, 146, 172,
+			91, 167, 216, 19, 215, 138, 27, 123, 181, 242, 157, 82, 181, 86,
+			188, 179, 51, 99, 96, 115, 124, 181, 94, 170, 212, 202, 215, 203,
+			235, 197, 90, 105, 239, 250, 118, 229, 78, 177, 54, 99, 170, 230,
+			253, 209, 199, 197, 196, 181, 202, 110, 181, 86, 26, 232, 52, 51,
+			97, 157, 101, 167, 177, 181, 152, 176, 88, 185, 177, 139, 135, 227,
+			171, 51, 147, 214, 11, 236, 220, 64, 232, 122, 239, 78, 121, 171,
+			86, 222, 186, 177, 87, 170, 84, 182, 43, 51, 83, 175, 126, 187,
+			198, 206, 30, 179, 185, 173, 151, 217, 139, 242, 32, 253, 94, 113,
+			125, 125, 123, 119, 171, 38, 7, 217, 221, 162, 19, 253, 215, 203,
+			37, 172, 36, 125, 133, 229, 71, 55, 43, 18, 201, 84, 81, 169,
+			149, 103, 207, 143, 110, 87, 150, 144, 207, 232, 171, 191, 110, 178,
+			19, 4, 0, 110, 53, 30, 89, 109, 54, 51, 108, 214, 89, 35,
+			5, 212, 81, 227, 143, 148, 125, 110, 225, 241, 26, 75, 190, 61,
+			96, 167, 71, 104, 86, 203, 62, 110, 144, 35, 42, 88, 76, 186,
+			244, 216, 237, 229, 188, 18, 205, 172, 234, 57, 30, 205, 65, 5,
+			245, 8, 52, 7, 27, 203, 233, 190, 85, 106, 231, 17, 11, 111,
+			173, 30, 55, 210, 136, 198, 106, 246, 11, 31, 170, 143, 4, 162,
+			195, 78, 29, 17, 32, 214, 72, 60, 70, 200, 25, 49, 239, 226,
+			99, 182, 22, 51, 94, 123, 237, 157, 75, 31, 206, 57, 189, 42,
+			0, 184, 245, 19, 73, 63, 99, 51, 241, 39, 51, 99, 51, 201,
+			46, 80, 110, 198, 56, 53, 102, 230, 10, 80, 237, 118, 58, 97,
+			132, 17, 103, 58, 129, 135, 177, 74, 63, 216, 87, 33, 92, 233,
+			155, 218, 172, 159, 57, 177, 244, 39, 31, 59, 115, 114, 73, 101,
+			71, 78, 235, 167, 242, 133, 52, 59, 242, 224, 210, 242, 21, 172,
+			13, 164, 252, 219, 93, 63, 105, 86, 170, 197, 82, 224, 70, 61,
+			58, 46, 199, 88, 155, 81, 204, 41, 55, 102, 230, 234, 176, 19,
+			198, 177, 95, 111, 101, 18, 72, 13, 39, 113, 90, 64, 94, 87,
+			140, 241, 157, 173, 48, 24, 120, 71, 81, 176, 136, 39, 93, 34,
+			35, 150, 138, 68, 29, 215, 46, 171, 84, 137, 108, 36, 147, 19,
+			54, 30, 186, 49, 199, 53, 68, 237, 156, 62, 205, 94, 167, 7,
+			205, 50, 158, 211, 207, 230, 63, 46, 240, 28, 225, 32, 43, 116,
+			99, 69, 62, 198, 46, 82, 79, 221, 50, 206, 235, 179, 249, 185,
+			76, 254, 188, 239, 253, 140, 232, 53, 71, 189, 12, 203, 120, 94,
+			127, 42, 159, 147, 33, 216, 61, 39, 233, 83, 245, 48, 10, 241,
+			252, 39, 46, 128, 166, 155, 150, 241, 130, 62, 155, 127, 5, 218,
+			78, 11, 43, 139, 176, 244, 53, 130, 110, 144, 142, 152, 13, 22,
+			50, 118, 149, 58, 141, 91, 6, 232, 79, 229, 237, 76, 140, 199,
+			11, 121, 140, 137, 29, 90, 18, 28, 66, 84, 168, 214, 85, 181,
+			48, 34, 116, 133, 58, 79, 88, 198, 139, 250, 217, 252, 66, 90,
+			6, 152, 153, 160, 223, 145, 122, 245, 176, 88, 168, 199, 19, 198,
+			222, 160, 174, 147, 150, 145, 215, 207, 228, 151, 40, 203, 129, 109,
+			201, 126, 139, 101, 113, 163, 24, 141, 74, 23, 19, 172, 136, 72,
+			56, 222, 90, 132, 19, 175, 83, 239, 41, 204, 90, 156, 207, 95,
+			70, 236, 210, 98, 141, 204, 58, 139, 240, 125, 91, 30, 97, 28,
+			10, 24, 50, 182, 200, 244, 113, 29, 175, 13, 49, 115, 121, 220,
+			111, 84, 59, 118, 140, 40, 146, 76, 160, 35, 19, 204, 235, 47,
+			177, 69, 122, 192, 18, 92, 253, 99, 121, 192, 48, 45, 5, 217,
+			156, 0, 182, 113, 99, 175, 98, 86, 132, 199, 50, 126, 204, 216,
+			60, 53, 215, 45, 227, 227, 58, 228, 159, 27, 104, 222, 225, 65,
+			121, 3, 235, 166, 101, 211, 31, 156, 102, 250, 196, 152, 101, 220,
+			24, 155, 204, 125, 207, 52, 100, 52, 28, 150, 76, 181, 184, 168,
+			171, 107, 99, 225, 72, 113, 167, 172, 162, 217, 217, 248, 159, 76,
+			22, 201, 75, 152, 48, 99, 71, 25, 174, 168, 225, 184, 40, 0,
+			34, 85, 24, 229, 7, 251, 116, 201, 213, 171, 112, 103, 32, 225,
+			186, 134, 105, 189, 72, 20, 35, 123, 24, 53, 77, 120, 43, 197,
+			37, 150, 27, 59, 128, 234, 161, 19, 81, 213, 97, 61, 76, 232,
+			180, 114, 166, 58, 17, 195, 198, 234, 142, 47, 172, 244, 228, 61,
+			25, 250, 239, 223, 192, 4, 59, 183, 203, 88, 117, 130, 21, 102,
+			157, 86, 216, 67, 62, 13, 176, 12, 35, 94, 16, 163, 97, 168,
+			61, 12, 48, 161, 135, 137, 62, 191, 237, 68, 126, 171, 135, 75,
+			164, 38, 198, 236, 7, 135, 97, 5, 109, 19, 70, 125, 237, 153,
+			34, 149, 80, 22, 66, 114, 150, 40, 212, 64, 254, 160, 82, 150,
+			126, 169, 63, 198, 194, 99, 113, 161, 21, 129, 161, 222, 135, 24,
+			188, 111, 58, 173, 6, 82, 220, 65, 34, 68, 25, 196, 58, 34,
+			11, 141, 169, 139, 185, 145, 149, 160, 115, 52, 88, 38, 145, 10,
+			14, 134, 140, 197, 105, 52, 7, 107, 98, 40, 184, 128, 151, 103,
+			201, 21, 205, 212, 199, 5, 251, 98, 62, 26, 67, 225, 220, 159,
+			36, 131, 246, 8, 230, 163, 1, 165, 255, 188, 72, 14, 147, 167,
+			82, 118, 3, 68, 25, 232, 74, 51, 201, 238, 50, 141, 43, 163,
+			239, 241, 64, 141, 152, 19, 199, 161, 139, 231, 18, 228, 85, 95,
+			142, 208, 109, 114, 58, 90, 32, 26, 106, 216, 188, 16, 139, 52,
+			148, 65, 140, 71, 44, 213, 72, 132, 48, 29, 160, 54, 12, 162,
+			55, 176, 80, 233, 96, 249, 136, 199, 62, 198, 236, 243, 4, 155,
+			31, 192, 129, 19, 249, 97, 55, 30, 128, 49, 238, 175, 34, 13,
+			131, 121, 0, 188, 200, 172, 37, 67, 231, 245, 158, 104, 158, 56,
+			241, 253, 24, 43, 193, 240, 199, 145, 201, 6, 151, 102, 180, 252,
+			128, 202, 206, 186, 164, 230, 33, 167, 205, 128, 233, 107, 162, 29,
+			34, 20, 241, 118, 136, 21, 9, 114, 71, 250, 65, 66, 184, 83,
+			239, 27, 88, 111, 68, 59, 98, 240, 253, 91, 190, 67, 159, 214,
+			142, 140, 88, 239, 165, 116, 31, 5, 141, 16, 119, 5, 42, 67,
+			195, 75, 22, 228, 228, 243, 48, 228, 150, 199, 182, 219, 216, 167,
+			145, 100, 118, 78, 154, 10, 191, 110, 50, 115, 98, 12, 133, 97,
+			77, 31, 207, 253, 162, 121, 100, 23, 166, 197, 151, 152, 168, 9,
+			248, 161, 20, 80, 82, 232, 101, 235, 152, 211, 108, 162, 20, 91,
+			50, 219, 68, 116, 74, 239, 145, 203, 106, 148, 67, 39, 86, 9,
+			230, 122, 15, 226, 176, 205, 33, 137, 186, 168, 23, 96, 189, 184,
+			32, 235, 19, 232, 72, 124, 246, 164, 71, 1, 15, 118, 82, 208,
+			79, 210, 187, 233, 144, 122, 171, 115, 142, 73, 175, 131, 240, 62,
+			247, 230, 241, 72, 2, 110, 137, 52, 231, 133, 80, 176, 172, 157,
+			51, 52, 59, 126, 113, 195, 72, 68, 114, 145, 215, 178, 185, 23,
+			27, 174, 251, 1, 37, 138, 124, 133, 150, 52, 198, 168, 27, 37,
+			221, 16, 76, 85, 202, 70, 134, 64, 74, 184, 140, 121, 48, 80,
+			134, 77, 100, 106, 96, 193, 126, 212, 75, 154, 56, 165, 132, 53,
+			236, 38, 11, 168, 94, 51, 148, 39, 182, 66, 43, 71, 173, 194,
+			128, 6, 196, 145, 182, 7, 12, 35, 236, 174, 58, 120, 120, 163,
+			97, 139, 123, 242, 139, 10, 86, 147, 140, 139, 157, 54, 103, 71,
+			214, 92, 25, 207, 54, 108, 7, 144, 68, 78, 16, 251, 88, 114,
+			170, 172, 169, 254, 208, 4, 161, 239, 178, 81, 102, 151, 205, 216,
+			183, 76, 16, 115, 105, 150, 209, 208, 199, 115, 255, 107, 124, 148,
+			184, 203, 98, 73, 152, 201, 114, 210, 126, 20, 160, 143, 99, 181,
+			235, 54, 37, 255, 13, 21, 237, 31, 35, 171, 149, 136, 46, 144,
+			140, 198, 12, 60, 68, 124, 191, 219, 194, 155, 166, 178, 146, 186,
+			229, 223, 31, 41, 161, 232, 188, 183, 40, 228, 56, 178, 222, 178,
+			46, 20, 19, 193, 67, 250, 18, 15, 112, 28, 13, 54, 207, 169,
+			43, 9, 121, 106, 182, 167, 136, 213, 6, 139, 20, 149, 116, 140,
+			56, 196, 221, 244, 142, 130, 244, 92, 213, 192, 9, 131, 66, 166,
+			190, 51, 83, 17, 50, 191, 134, 155, 253, 85, 33, 18, 233, 56,
+			74, 38, 15, 140, 21, 18, 118, 230, 59, 202, 231, 110, 44, 78,
+			176, 7, 200, 229, 13, 85, 102, 204, 189, 163, 138, 13, 25, 24,
+			55, 43, 158, 204, 194, 210, 21, 121, 193, 73, 147, 195, 156, 10,
+			209, 205, 209, 237, 152, 15, 155, 34, 12, 148, 132, 138, 143, 210,
+			69, 157, 191, 139, 213, 56, 217, 146, 38, 153, 26, 109, 203, 115,
+			218, 66, 156, 117, 229, 97, 35, 199, 59, 160, 114, 85, 212, 19,
+			116, 26, 131, 63, 192, 170, 49, 204, 52, 187, 97, 187, 238, 139,
+			34, 117, 84, 53, 80, 144, 235, 168, 214, 103, 65, 173, 0, 247,
+			50, 239, 20, 70, 11, 10, 92, 44, 11, 238, 226, 181, 92, 164,
+			19, 161, 207, 117, 149, 46, 158, 29, 13, 36, 64, 34, 206, 103,
+			51, 246, 69, 157, 246, 128, 110, 25, 129, 62, 158, 251, 46, 253,
+			136, 6, 37, 179, 22, 183, 248, 40, 6, 124, 152, 206, 102, 143,
+			82, 218, 140, 193, 206, 160, 185, 32, 25, 171, 19, 209, 41, 161,
+			204, 153, 28, 192, 82, 172, 120, 81, 246, 76, 167, 32, 100, 186,
+			232, 128, 210, 61, 46, 129, 208, 160, 106, 117, 20, 164, 170, 151,
+			92, 71, 151, 78, 239, 227, 185, 221, 24, 98, 220, 177, 148, 227,
+			207, 184, 190, 120, 199, 90, 39, 140, 253, 36, 140, 122, 54, 99,
+			191, 255, 4, 81, 200, 176, 204, 111, 215, 244, 241, 220, 87, 158,
+			56, 78, 217, 61, 156, 82, 97, 116, 196, 4, 23, 212, 171, 115,
+			218, 252, 184, 149, 145, 109, 135, 85, 190, 44, 45, 32, 204, 42,
+			24, 149, 166, 226, 170, 193, 178, 100, 228, 192, 162, 180, 191, 169,
+			148, 46, 129, 196, 185, 143, 20, 56, 50, 26, 101, 8, 228, 149,
+			42, 234, 38, 84, 185, 46, 52, 58, 110, 81, 85, 168, 129, 58,
+			3, 111, 212, 21, 150, 161, 143, 55, 7, 134, 125, 37, 146, 10,
+			27, 72, 134, 153, 63, 85, 106, 156, 101, 46, 121, 237, 223, 0,
+			75, 155, 127, 69, 94, 68, 40, 216, 188, 233, 196, 128, 129, 15,
+			59, 30, 32, 108, 76, 17, 20, 193, 136, 253, 187, 81, 229, 70,
+			148, 151, 40, 81, 172, 126, 1, 183, 147, 184, 212, 150, 170, 184,
+			147, 16, 242, 125, 203, 150, 231, 241, 243, 48, 49, 98, 73, 123,
+			172, 86, 160, 145, 210, 123, 72, 104, 72, 146, 15, 171, 2, 202,
+			161, 158, 199, 131, 203, 31, 248, 113, 82, 14, 4, 45, 251, 16,
+			203, 241, 165, 0, 17, 195, 203, 99, 204, 180, 80, 73, 179, 63,
+			118, 94, 64, 149, 7, 89, 15, 223, 7, 230, 2, 22, 218, 56,
+			173, 246, 220, 160, 105, 137, 235, 68, 216, 11, 197, 158, 65, 91,
+			134, 117, 6, 129, 39, 234, 3, 56, 120, 123, 226, 190, 227, 246,
+			240, 208, 120, 20, 58, 110, 83, 214, 180, 244, 173, 59, 60, 214,
+			61, 122, 186, 173, 237, 26, 72, 31, 25, 228, 63, 63, 128, 123,
+			221, 152, 171, 20, 154, 72, 147, 121, 202, 192, 187, 7, 49, 39,
+			85, 168, 62, 135, 135, 1, 247, 142, 154, 127, 248, 191, 125, 186,
+			122, 70, 74, 42, 10, 26, 45, 0, 30, 168, 245, 241, 228, 226,
+			17, 116, 226, 35, 114, 198, 79, 210, 161, 208, 189, 18, 166, 196,
+			189, 182, 184, 190, 234, 158, 8, 103, 208, 181, 53, 50, 96, 21,
+			203, 72, 153, 96, 179, 170, 44, 126, 74, 107, 4, 211, 193, 250,
+			67, 200, 227, 135, 226, 68, 9, 30, 29, 15, 15, 131, 97, 184,
+			6, 131, 2, 234, 96, 32, 13, 84, 199, 91, 67, 14, 135, 233,
+			30, 133, 45, 52, 131, 194, 110, 114, 60, 221, 5, 205, 73, 151,
+			42, 160, 254, 184, 104, 254, 120, 20, 162, 203, 112, 228, 24, 157,
+			65, 105, 46, 231, 57, 178, 60, 196, 147, 3, 136, 33, 165, 210,
+			81, 20, 197, 134, 201, 41, 41, 40, 118, 13, 94, 205, 69, 110,
+			108, 171, 7, 113, 211, 111, 36, 184, 145, 104, 125, 211, 113, 234,
+			60, 57, 68, 155, 59, 59, 19, 202, 72, 111, 120, 100, 244, 149,
+			240, 60, 137, 35, 151, 51, 72, 194, 116, 144, 97, 32, 202, 197,
+			59, 208, 9, 91, 190, 235, 211, 25, 62, 88, 71, 73, 7, 133,
+			11, 243, 114, 31, 199, 88, 247, 220, 63, 155, 54, 56, 183, 136,
+			79, 57, 242, 40, 12, 6, 25, 112, 62, 188, 255, 139, 137, 64,
+			64, 220, 7, 169, 222, 3, 199, 243, 178, 226, 76, 28, 138, 67,
+			243, 152, 139, 131, 235, 196, 35, 180, 138, 66, 215, 163, 147, 197,
+			6, 79, 68, 14, 106, 169, 88, 170, 91, 121, 145, 27, 238, 70,
+			44, 28, 114, 188, 182, 31, 96, 217, 211, 144, 105, 240, 45, 227,
+			164, 248, 76, 203, 252, 27, 168, 248, 254, 167, 9, 71, 194, 216,
+			192, 229, 27, 36, 108, 170, 216, 84, 245, 38, 226, 144, 186, 76,
+			120, 7, 44, 90, 223, 76, 169, 193, 245, 48, 8, 184, 155, 250,
+			94, 17, 20, 232, 156, 147, 3, 235, 101, 136, 233, 2, 194, 121,
+			194, 65, 106, 167, 97, 251, 83, 89, 93, 178, 110, 87, 217, 91,
+			233, 6, 129, 208, 247, 220, 189, 6, 247, 72, 127, 133, 1, 210,
+			105, 36, 151, 215, 80, 225, 13, 168, 47, 25, 119, 36, 190, 232,
+			35, 213, 143, 88, 10, 141, 44, 38, 158, 139, 197, 149, 100, 119,
+			121, 29, 110, 243, 30, 198, 148, 164, 246, 67, 177, 160, 204, 179,
+			1, 129, 17, 203, 80, 117, 20, 203, 160, 39, 142, 22, 117, 197,
+			5, 102, 226, 238, 112, 166, 116, 114, 106, 233, 80, 36, 171, 175,
+			104, 233, 182, 244, 88, 209, 64, 222, 198, 238, 29, 49, 210, 83,
+			155, 17, 231, 244, 227, 116, 56, 242, 63, 165, 143, 34, 163, 15,
+			120, 86, 82, 29, 150, 19, 226, 246, 209, 134, 48, 2, 173, 96,
+			168, 236, 172, 167, 161, 92, 121, 69, 92, 214, 29, 73, 66, 92,
+			157, 1, 127, 124, 97, 144, 190, 126, 44, 252, 131, 190, 37, 111,
+			51, 246, 211, 88, 218, 57, 102, 153, 127, 75, 27, 155, 204, 237,
+			142, 240, 4, 165, 179, 28, 57, 29, 105, 237, 200, 136, 63, 81,
+			92, 250, 207, 35, 122, 176, 244, 114, 0, 182, 122, 111, 233, 35,
+			253, 199, 134, 162, 156, 54, 99, 223, 163, 110, 255, 48, 127, 68,
+			211, 39, 115, 159, 211, 213, 170, 81, 170, 125, 49, 3, 245, 8,
+			80, 21, 164, 11, 67, 225, 0, 25, 0, 160, 155, 31, 132, 156,
+			86, 73, 141, 17, 131, 216, 153, 192, 6, 174, 216, 93, 46, 46,
+			1, 64, 163, 147, 211, 65, 11, 12, 57, 57, 84, 100, 74, 39,
+			18, 250, 167, 228, 61, 238, 180, 124, 21, 65, 236, 168, 20, 136,
+			2, 158, 165, 52, 23, 108, 231, 7, 116, 200, 52, 78, 120, 128,
+			50, 18, 14, 211, 32, 135, 148, 101, 253, 125, 100, 51, 246, 89,
+			85, 235, 111, 254, 56, 18, 230, 112, 240, 30, 9, 180, 77, 231,
+			142, 20, 41, 118, 156, 200, 105, 243, 132, 71, 241, 28, 65, 138,
+			232, 160, 248, 27, 133, 245, 168, 116, 9, 202, 20, 254, 192, 113,
+			19, 58, 244, 40, 238, 66, 41, 139, 2, 226, 47, 33, 151, 125,
+			98, 212, 72, 234, 12, 165, 178, 212, 240, 124, 113, 26, 170, 233,
+			67, 100, 51, 246, 147, 154, 44, 199, 53, 255, 9, 162, 244, 163,
+			226, 92, 127, 54, 174, 68, 107, 37, 75, 184, 145, 68, 142, 218,
+			35, 120, 49, 97, 177, 186, 181, 146, 101, 8, 196, 80, 157, 202,
+			80, 87, 29, 168, 194, 110, 121, 68, 35, 13, 182, 80, 110, 163,
+			224, 227, 101, 23, 235, 91, 42, 188, 139, 51, 207, 211, 110, 88,
+			47, 146, 197, 207, 212, 213, 44, 105, 105, 184, 106, 102, 51, 246,
+			103, 101, 213, 175, 249, 83, 120, 207, 219, 187, 67, 235, 209, 39,
+			162, 2, 1, 9, 172, 76, 102, 149, 159, 75, 15, 237, 99, 189,
+			245, 28, 21, 137, 31, 179, 111, 251, 235, 35, 141, 50, 198, 238,
+			200, 82, 96, 243, 103, 52, 253, 92, 238, 27, 50, 119, 65, 246,
+			143, 72, 40, 186, 31, 58, 233, 33, 207, 5, 181, 43, 164, 58,
+			112, 91, 161, 123, 223, 102, 236, 79, 209, 112, 134, 101, 254, 60,
+			94, 245, 182, 147, 241, 211, 41, 9, 38, 13, 47, 21, 0, 27,
+			6, 94, 64, 149, 70, 202, 211, 208, 20, 70, 11, 98, 186, 49,
+			227, 151, 81, 62, 233, 150, 249, 203, 200, 57, 63, 171, 29, 27,
+			170, 66, 219, 45, 205, 3, 214, 123, 71, 218, 169, 4, 38, 202,
+			90, 76, 8, 116, 162, 16, 189, 108, 36, 242, 64, 238, 83, 92,
+			101, 146, 142, 132, 126, 50, 118, 149, 138, 43, 226, 13, 161, 95,
+			66, 242, 69, 85, 185, 182, 144, 246, 89, 6, 166, 80, 25, 147,
+			161, 55, 121, 253, 159, 52, 244, 108, 198, 58, 204, 52, 49, 1,
+			101, 254, 138, 166, 159, 248, 154, 228, 61, 151, 105, 70, 205, 50,
+			127, 77, 211, 167, 114, 121, 216, 150, 7, 7, 134, 131, 131, 169,
+			208, 102, 155, 212, 67, 183, 204, 223, 208, 244, 103, 114, 111, 194,
+			118, 128, 206, 60, 210, 12, 10, 178, 46, 70, 246, 193, 108, 246,
+			252, 32, 250, 169, 190, 84, 150, 196, 191, 194, 77, 171, 235, 134,
+			101, 254, 38, 2, 240, 15, 181, 35, 215, 64, 100, 92, 64, 121,
+			46, 77, 86, 250, 164, 1, 100, 57, 98, 134, 79, 112, 189, 240,
+			220, 12, 74, 28, 113, 236, 87, 64, 216, 232, 182, 210, 54, 104,
+			56, 73, 236, 98, 59, 131, 5, 69, 88, 83, 141, 237, 162, 221,
+			132, 54, 224, 64, 61, 175, 61, 84, 116, 100, 51, 182, 203, 240,
+			116, 138, 249, 21, 100, 199, 50, 140, 228, 196, 148, 18, 170, 152,
+			95, 114, 151, 100, 204, 129, 46, 138, 237, 190, 140, 244, 49, 144,
+			37, 254, 27, 210, 231, 159, 253, 209, 232, 147, 218, 111, 55, 29,
+			105, 11, 209, 197, 20, 234, 54, 171, 165, 55, 218, 161, 215, 109,
+			241, 69, 57, 194, 155, 121, 116, 123, 101, 78, 89, 250, 196, 237,
+			48, 192, 248, 12, 238, 94, 204, 64, 224, 168, 110, 11, 163, 192,
+			233, 117, 47, 176, 23, 132, 201, 30, 254, 249, 5, 39, 74, 20,
+			140, 106, 230, 239, 32, 124, 166, 198, 44, 243, 119, 81, 72, 255,
+			57, 33, 164, 85, 168, 183, 207, 23, 244, 90, 40, 11, 18, 5,
+			228, 196, 54, 101, 10, 68, 93, 203, 228, 100, 40, 72, 82, 37,
+			59, 25, 6, 230, 56, 27, 73, 214, 126, 50, 158, 78, 187, 32,
+			129, 53, 203, 252, 61, 205, 124, 154, 253, 176, 201, 116, 211, 180,
+			204, 207, 234, 99, 147, 185, 239, 55, 97, 184, 78, 25, 241, 203,
+			230, 78, 143, 132, 219, 203, 9, 54, 145, 231, 195, 195, 16, 83,
+			158, 34, 167, 73, 182, 180, 186, 111, 199, 150, 103, 253, 28, 21,
+			213, 150, 32, 211, 146, 81, 224, 54, 102, 233, 216, 205, 48, 78,
+			80, 127, 16, 195, 42, 201, 225, 212, 241, 186, 171, 227, 142, 57,
+			249, 66, 70, 75, 119, 160, 129, 65, 119, 156, 177, 233, 244, 161,
+			151, 183, 89, 21, 212, 81, 151, 149, 166, 210, 118, 116, 6, 81,
+			68, 71, 165, 245, 136, 46, 31, 253, 84, 231, 248, 68, 100, 29,
+			137, 179, 40, 169, 35, 242, 160, 115, 131, 247, 225, 100, 195, 179,
+			180, 120, 72, 61, 172, 82, 144, 74, 12, 143, 148, 138, 187, 2,
+			80, 84, 225, 109, 192, 29, 231, 221, 46, 151, 71, 114, 22, 213,
+			25, 31, 84, 197, 11, 140, 76, 99, 31, 119, 141, 131, 183, 26,
+			201, 109, 154, 209, 216, 163, 78, 31, 17, 23, 192, 136, 19, 26,
+			76, 230, 78, 28, 177, 51, 164, 243, 134, 191, 81, 39, 226, 41,
+			84, 188, 121, 205, 15, 26, 97, 134, 212, 105, 138, 11, 47, 130,
+			101, 12, 152, 105, 154, 184, 55, 255, 130, 174, 79, 229, 173, 172,
+			133, 34, 83, 253, 175, 80, 11, 188, 204, 85, 215, 207, 229, 103,
+			213, 105, 67, 53, 18, 133, 222, 241, 15, 57, 252, 61, 141, 225,
+			193, 55, 243, 59, 145, 237, 222, 28, 149, 14, 145, 188, 139, 76,
+			211, 25, 56, 16, 61, 212, 206, 102, 171, 174, 50, 136, 63, 154,
+			127, 44, 19, 203, 238, 91, 214, 63, 128, 150, 245, 56, 34, 255,
+			215, 116, 125, 42, 247, 5, 253, 35, 189, 29, 7, 11, 69, 156,
+			244, 146, 17, 154, 20, 242, 149, 210, 55, 237, 150, 170, 181, 237,
+			74, 158, 68, 191, 186, 59, 35, 233, 95, 248, 37, 237, 185, 57,
+			17, 22, 234, 251, 92, 125, 46, 140, 135, 99, 76, 168, 81, 24,
+			20, 215, 55, 227, 244, 88, 96, 220, 29, 244, 160, 142, 36, 240,
+			142, 196, 249, 210, 153, 24, 203, 30, 203, 172, 19, 137, 52, 203,
+			252, 126, 93, 159, 204, 213, 70, 221, 13, 147, 38, 139, 30, 121,
+			69, 12, 26, 119, 78, 183, 69, 76, 175, 254, 176, 130, 205, 216,
+			239, 137, 117, 208, 45, 243, 239, 232, 58, 203, 253, 182, 78, 23,
+			194, 40, 131, 85, 221, 206, 173, 238, 95, 73, 243, 180, 127, 108,
+			119, 195, 28, 189, 234, 228, 67, 92, 12, 131, 87, 203, 176, 129,
+			133, 30, 53, 196, 93, 121, 59, 137, 31, 247, 239, 29, 196, 163,
+			180, 24, 104, 206, 140, 41, 99, 11, 249, 244, 78, 36, 146, 22,
+			109, 71, 104, 117, 204, 102, 161, 252, 141, 185, 188, 70, 63, 140,
+			48, 33, 24, 54, 128, 171, 10, 156, 76, 125, 20, 150, 18, 14,
+			174, 237, 239, 11, 186, 27, 150, 249, 37, 164, 251, 87, 144, 238,
+			217, 171, 66, 134, 111, 135, 25, 162, 189, 186, 140, 102, 48, 111,
+			242, 120, 55, 210, 176, 35, 87, 210, 208, 118, 137, 233, 158, 107,
+			188, 169, 27, 162, 48, 76, 96, 183, 178, 25, 203, 27, 108, 212,
+			135, 55, 80, 149, 188, 153, 159, 151, 251, 103, 24, 198, 244, 52,
+			236, 87, 123, 131, 205, 0, 54, 127, 76, 132, 255, 188, 70, 132,
+			55, 45, 243, 39, 81, 240, 124, 208, 55, 89, 213, 69, 4, 15,
+			185, 142, 134, 138, 34, 72, 35, 15, 95, 20, 134, 1, 163, 100,
+			224, 162, 48, 216, 144, 96, 200, 43, 240, 50, 230, 140, 100, 45,
+			155, 177, 63, 20, 108, 48, 110, 153, 63, 131, 108, 240, 255, 47,
+			220, 249, 218, 94, 184, 243, 57, 212, 155, 19, 150, 249, 11, 168,
+			55, 223, 31, 173, 55, 143, 247, 3, 135, 154, 66, 152, 58, 1,
+			72, 201, 210, 8, 135, 10, 243, 25, 233, 33, 227, 125, 140, 188,
+			161, 133, 130, 144, 188, 198, 76, 115, 2, 213, 225, 151, 145, 43,
+			231, 161, 214, 183, 5, 134, 172, 25, 25, 76, 82, 28, 244, 35,
+			200, 207, 19, 232, 249, 255, 59, 93, 63, 157, 251, 62, 13, 170,
+			104, 235, 209, 31, 234, 196, 187, 8, 2, 121, 16, 119, 14, 127,
+			122, 60, 99, 239, 160, 29, 178, 32, 174, 224, 197, 149, 164, 114,
+			132, 128, 142, 188, 32, 248, 119, 194, 56, 145, 124, 78, 113, 82,
+			117, 190, 25, 151, 63, 138, 23, 228, 5, 104, 20, 128, 199, 91,
+			152, 176, 36, 181, 79, 100, 17, 101, 82, 32, 75, 118, 71, 52,
+			127, 78, 64, 171, 91, 230, 175, 33, 158, 255, 232, 107, 238, 143,
+			40, 129, 34, 156, 140, 17, 46, 198, 67, 246, 120, 223, 119, 177,
+			25, 251, 49, 100, 157, 73, 203, 252, 77, 100, 157, 215, 143, 100,
+			223, 143, 181, 183, 178, 141, 108, 182, 218, 84, 102, 210, 71, 243,
+			47, 221, 192, 105, 226, 35, 37, 253, 55, 48, 211, 156, 68, 14,
+			251, 45, 164, 252, 10, 185, 38, 42, 197, 129, 58, 94, 232, 200,
+			190, 20, 79, 163, 86, 117, 188, 224, 218, 102, 236, 18, 13, 160,
+			89, 230, 87, 80, 84, 205, 129, 68, 145, 123, 162, 192, 0, 40,
+			123, 20, 167, 87, 10, 171, 197, 185, 74, 221, 116, 203, 252, 29,
+			180, 98, 22, 145, 8, 126, 187, 219, 150, 147, 168, 243, 65, 160,
+			14, 14, 101, 46, 215, 176, 153, 184, 83, 100, 18, 253, 251, 255,
+			142, 147, 126, 167, 246, 16, 249, 40, 175, 180, 241, 131, 253, 1,
+			137, 39, 163, 64, 82, 195, 127, 104, 41, 190, 21, 38, 125, 49,
+			226, 7, 131, 82, 206, 102, 236, 6, 211, 205, 41, 203, 252, 93,
+			100, 131, 171, 35, 216, 224, 120, 241, 49, 200, 9, 236, 227, 204,
+			52, 167, 112, 125, 126, 31, 215, 231, 60, 92, 239, 30, 77, 124,
+			139, 43, 84, 108, 198, 94, 162, 198, 154, 101, 254, 31, 108, 124,
+			70, 174, 64, 182, 110, 33, 29, 81, 183, 204, 63, 212, 245, 115,
+			185, 243, 50, 9, 56, 84, 175, 67, 71, 197, 108, 198, 254, 54,
+			82, 122, 10, 41, 253, 173, 134, 62, 149, 251, 171, 95, 243, 157,
+			121, 236, 170, 176, 193, 141, 87, 97, 186, 57, 109, 153, 159, 51,
+			198, 38, 115, 215, 143, 43, 233, 56, 118, 251, 141, 104, 107, 51,
+			118, 141, 153, 230, 52, 210, 254, 219, 12, 253, 217, 220, 197, 193,
+			187, 77, 85, 184, 197, 143, 143, 132, 29, 179, 134, 197, 109, 26,
+			3, 255, 116, 5, 210, 239, 19, 64, 231, 54, 7, 111, 9, 239,
+			239, 73, 216, 143, 156, 122, 159, 82, 72, 158, 129, 193, 54, 104,
+			48, 221, 50, 255, 18, 14, 118, 25, 138, 34, 171, 132, 190, 107,
+			59, 19, 6, 86, 204, 216, 247, 55, 6, 70, 161, 53, 157, 198,
+			53, 253, 110, 67, 103, 184, 166, 197, 244, 207, 250, 202, 221, 138,
+			253, 70, 85, 189, 72, 219, 14, 115, 13, 184, 101, 212, 95, 175,
+			192, 48, 138, 47, 235, 12, 247, 136, 64, 126, 12, 143, 62, 176,
+			37, 196, 103, 23, 175, 18, 169, 115, 224, 237, 14, 222, 11, 138,
+			190, 50, 254, 165, 22, 155, 177, 47, 8, 64, 77, 203, 252, 94,
+			196, 247, 207, 107, 80, 12, 250, 57, 184, 193, 108, 222, 31, 25,
+			176, 242, 198, 227, 1, 245, 121, 157, 128, 26, 183, 204, 31, 52,
+			244, 201, 220, 31, 82, 144, 216, 199, 155, 161, 122, 210, 48, 226,
+			222, 8, 249, 37, 247, 74, 186, 219, 229, 133, 33, 89, 87, 76,
+			21, 166, 96, 250, 211, 233, 73, 193, 144, 242, 25, 237, 38, 12,
+			15, 197, 3, 23, 125, 163, 100, 22, 174, 104, 219, 121, 64, 18,
+			20, 19, 111, 43, 77, 155, 104, 149, 36, 136, 0, 242, 196, 62,
+			79, 30, 53, 212, 74, 83, 8, 247, 136, 199, 228, 17, 6, 24,
+			205, 72, 171, 43, 7, 60, 197, 212, 81, 252, 162, 88, 162, 9,
+			203, 252, 33, 227, 235, 83, 18, 239, 50, 221, 100, 150, 249, 163,
+			40, 24, 202, 199, 11, 134, 227, 5, 242, 104, 217, 144, 103, 166,
+			201, 80, 54, 252, 93, 67, 159, 202, 63, 61, 34, 14, 205, 216,
+			28, 181, 209, 44, 243, 199, 12, 253, 92, 254, 217, 35, 183, 66,
+			165, 129, 26, 218, 145, 12, 211, 36, 63, 241, 245, 45, 101, 175,
+			51, 221, 60, 97, 153, 255, 24, 137, 185, 118, 180, 126, 96, 164,
+			124, 61, 210, 202, 102, 116, 105, 244, 9, 164, 222, 63, 69, 124,
+			223, 130, 90, 118, 47, 23, 110, 221, 173, 205, 63, 180, 232, 224,
+			184, 154, 131, 33, 167, 238, 95, 34, 89, 79, 160, 17, 252, 211,
+			184, 85, 255, 190, 246, 209, 199, 74, 64, 154, 53, 45, 191, 237,
+			163, 159, 158, 222, 170, 133, 185, 116, 233, 204, 170, 176, 2, 93,
+			31, 135, 64, 138, 191, 250, 131, 182, 49, 127, 224, 114, 238, 29,
+			63, 130, 205, 88, 64, 56, 232, 150, 249, 175, 113, 131, 221, 251,
+			240, 158, 160, 74, 168, 222, 195, 59, 9, 238, 169, 244, 221, 17,
+			135, 70, 229, 6, 48, 119, 122, 210, 50, 191, 108, 80, 238, 116,
+			196, 18, 143, 222, 41, 163, 86, 25, 245, 231, 73, 92, 229, 95,
+			194, 85, 190, 248, 104, 239, 37, 227, 200, 41, 208, 171, 52, 134,
+			102, 153, 191, 108, 232, 167, 115, 165, 143, 198, 141, 33, 127, 227,
+			36, 238, 183, 127, 111, 252, 137, 246, 55, 234, 19, 157, 40, 76,
+			194, 11, 255, 111, 0, 52, 40, 158, 181, 178, 130, 0, 0},
 	)
 }
 
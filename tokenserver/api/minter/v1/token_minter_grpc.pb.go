@@ -27,6 +27,7 @@ const (
 	TokenMinter_MintDelegationToken_FullMethodName     = "/tokenserver.minter.TokenMinter/MintDelegationToken"
 	TokenMinter_MintProjectToken_FullMethodName        = "/tokenserver.minter.TokenMinter/MintProjectToken"
 	TokenMinter_MintServiceAccountToken_FullMethodName = "/tokenserver.minter.TokenMinter/MintServiceAccountToken"
+	TokenMinter_ExchangeOIDCToken_FullMethodName       = "/tokenserver.minter.TokenMinter/ExchangeOIDCToken"
 )
 
 // TokenMinterClient is the client API for TokenMinter service.
@@ -99,6 +100,20 @@ type TokenMinterClient interface {
 	// others accounts by adding them to their respective realms.cfg. See also
 	// comments for ServiceAccountsProjectMapping in api/admin/v1/config.proto.
 	MintServiceAccountToken(ctx context.Context, in *MintServiceAccountTokenRequest, opts ...grpc.CallOption) (*MintServiceAccountTokenResponse, error)
+	// ExchangeOIDCToken exchanges an ID token issued by a trusted external
+	// OpenID Connect provider (e.g. a CI system) for a LUCI delegation token.
+	//
+	// The issuer must be listed in oidc_federation.cfg global config file. The
+	// token server verifies the ID token signature using issuer's JSON Web Keys,
+	// checks its audience and then uses the first matching rule to derive
+	// a LUCI identity from the token claims.
+	//
+	// The produced delegation token delegates this identity. It can be used by
+	// any bearer, but only on services specified in the rule.
+	//
+	// The RPC doesn't require the caller to be authenticated, the ID token is
+	// the credential.
+	ExchangeOIDCToken(ctx context.Context, in *ExchangeOIDCTokenRequest, opts ...grpc.CallOption) (*ExchangeOIDCTokenResponse, error)
 }
 
 type tokenMinterClient struct {
@@ -145,6 +160,15 @@ func (c *tokenMinterClient) MintServiceAccountToken(ctx context.Context, in *Min
 	return out, nil
 }
 
+func (c *tokenMinterClient) ExchangeOIDCToken(ctx context.Context, in *ExchangeOIDCTokenRequest, opts ...grpc.CallOption) (*ExchangeOIDCTokenResponse, error) {
+	out := new(ExchangeOIDCTokenResponse)
+	err := c.cc.Invoke(ctx, TokenMinter_ExchangeOIDCToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenMinterServer is the server API for TokenMinter service.
 // All implementations must embed UnimplementedTokenMinterServer
 // for forward compatibility
@@ -215,6 +239,20 @@ type TokenMinterServer interface {
 	// others accounts by adding them to their respective realms.cfg. See also
 	// comments for ServiceAccountsProjectMapping in api/admin/v1/config.proto.
 	MintServiceAccountToken(context.Context, *MintServiceAccountTokenRequest) (*MintServiceAccountTokenResponse, error)
+	// ExchangeOIDCToken exchanges an ID token issued by a trusted external
+	// OpenID Connect provider (e.g. a CI system) for a LUCI delegation token.
+	//
+	// The issuer must be listed in oidc_federation.cfg global config file. The
+	// token server verifies the ID token signature using issuer's JSON Web Keys,
+	// checks its audience and then uses the first matching rule to derive
+	// a LUCI identity from the token claims.
+	//
+	// The produced delegation token delegates this identity. It can be used by
+	// any bearer, but only on services specified in the rule.
+	//
+	// The RPC doesn't require the caller to be authenticated, the ID token is
+	// the credential.
+	ExchangeOIDCToken(context.Context, *ExchangeOIDCTokenRequest) (*ExchangeOIDCTokenResponse, error)
 	mustEmbedUnimplementedTokenMinterServer()
 }
 
@@ -234,6 +272,9 @@ func (UnimplementedTokenMinterServer) MintProjectToken(context.Context, *MintPro
 func (UnimplementedTokenMinterServer) MintServiceAccountToken(context.Context, *MintServiceAccountTokenRequest) (*MintServiceAccountTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintServiceAccountToken not implemented")
 }
+func (UnimplementedTokenMinterServer) ExchangeOIDCToken(context.Context, *ExchangeOIDCTokenRequest) (*ExchangeOIDCTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOIDCToken not implemented")
+}
 func (UnimplementedTokenMinterServer) mustEmbedUnimplementedTokenMinterServer() {}
 
 // UnsafeTokenMinterServer may be embedded to opt out of forward compatibility for this service.
@@ -319,6 +360,24 @@ func _TokenMinter_MintServiceAccountToken_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenMinter_ExchangeOIDCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeOIDCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenMinterServer).ExchangeOIDCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokenMinter_ExchangeOIDCToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenMinterServer).ExchangeOIDCToken(ctx, req.(*ExchangeOIDCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TokenMinter_ServiceDesc is the grpc.ServiceDesc for TokenMinter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -342,6 +401,10 @@ var TokenMinter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintServiceAccountToken",
 			Handler:    _TokenMinter_MintServiceAccountToken_Handler,
 		},
+		{
+			MethodName: "ExchangeOIDCToken",
+			Handler:    _TokenMinter_ExchangeOIDCToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/tokenserver/api/minter/v1/token_minter.proto",
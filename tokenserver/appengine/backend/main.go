@@ -88,6 +88,7 @@ func readConfigCron(ctx context.Context, adminServer admin.AdminServer) error {
 	}{
 		{"ImportCAConfigs", adminServer.ImportCAConfigs},
 		{"ImportDelegationConfigs", adminServer.ImportDelegationConfigs},
+		{"ImportOIDCFederationConfigs", adminServer.ImportOIDCFederationConfigs},
 		{"ImportProjectIdentityConfigs", adminServer.ImportProjectIdentityConfigs},
 		{"ImportProjectOwnedAccountsConfigs", adminServer.ImportProjectOwnedAccountsConfigs},
 	}
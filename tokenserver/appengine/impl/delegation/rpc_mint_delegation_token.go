@@ -47,6 +47,14 @@ import (
 // Changing it will effectively reset the ID generation.
 const tokenIDSequenceKind = "delegationTokenID"
 
+// GenerateTokenID produces a new unique ID for a delegation subtoken.
+//
+// IDs are allocated from the same sequence regardless of how the delegation
+// token is minted.
+func GenerateTokenID(ctx context.Context) (int64, error) {
+	return revocation.GenerateTokenID(ctx, tokenIDSequenceKind)
+}
+
 // MintDelegationTokenRPC implements TokenMinter.MintDelegationToken RPC method.
 type MintDelegationTokenRPC struct {
 	// Signer is mocked in tests.
@@ -217,7 +225,7 @@ type mintParams struct {
 
 // mint is called to make the token after the request has been authorized.
 func (r *MintDelegationTokenRPC) mint(c context.Context, p *mintParams) (*minter.MintDelegationTokenResponse, error) {
-	id, err := revocation.GenerateTokenID(c, tokenIDSequenceKind)
+	id, err := GenerateTokenID(c)
 	if err != nil {
 		logging.WithError(err).Errorf(c, "Error when generating token ID.")
 		return nil, status.Errorf(codes.Internal, "error when generating token ID - %s", err)
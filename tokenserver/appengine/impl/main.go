@@ -57,6 +57,7 @@ func Main(init func(srv *server.Server, services *Services) error) {
 		adminSrv := adminsrv.NewServer(signer)
 		adminSrv.ImportCAConfigsRPC.SetupConfigValidation(&validation.Rules)
 		adminSrv.ImportDelegationConfigsRPC.SetupConfigValidation(&validation.Rules)
+		adminSrv.ImportOIDCFederationConfigsRPC.SetupConfigValidation(&validation.Rules)
 		adminSrv.ImportProjectIdentityConfigsRPC.SetupConfigValidation(&validation.Rules)
 		adminSrv.ImportProjectOwnedAccountsConfigsRPC.SetupConfigValidation(&validation.Rules)
 
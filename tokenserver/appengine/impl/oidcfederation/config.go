@@ -0,0 +1,291 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/server/auth/openid"
+
+	"go.chromium.org/luci/tokenserver/api/admin/v1"
+	"go.chromium.org/luci/tokenserver/appengine/impl/utils/policy"
+)
+
+// configFileName is name of the config file with the federation policy.
+//
+// Also used as a name for the imported configs in the datastore, so change it
+// very carefully.
+const configFileName = "oidc_federation.cfg"
+
+// defaultMaxValidityDuration is used when the rule doesn't specify
+// max_validity_duration.
+const defaultMaxValidityDuration = 3600
+
+var (
+	// placeholderRe matches "${claim}" placeholders in identity templates.
+	placeholderRe = regexp.MustCompile(`\$\{([^}]*)\}`)
+	// claimNameRe is a regexp for claim names that can be used in placeholders.
+	claimNameRe = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+	// claimValueRe is a regexp for claim values that can be substituted into
+	// identity templates.
+	claimValueRe = regexp.MustCompile(`^[A-Za-z0-9._\-]+$`)
+)
+
+// Rules is a queryable representation of oidc_federation.cfg.
+type Rules struct {
+	revision string             // config revision this policy is imported from
+	issuers  map[string]*Issuer // issuer URL (as in the config) => its policy
+}
+
+// Issuer is a preprocessed admin.OIDCIssuer message.
+type Issuer struct {
+	Issuer       string // e.g. "https://token.actions.githubusercontent.com"
+	DiscoveryURL string // URL of the OpenID Connect discovery document
+
+	audience stringset.Set // accepted `aud` claim values
+	rules    []*Rule       // identity rules in order of evaluation
+}
+
+// Rule is a preprocessed admin.OIDCIdentityRule message.
+type Rule struct {
+	Name                string   // name of the rule, for logs
+	TargetServices      []string // services that should accept the token
+	MaxValidityDuration int64    // max validity of the minted token, sec
+
+	match    []claimMatch // all must match for the rule to apply
+	identity string       // identity template with "${claim}" placeholders
+}
+
+// claimMatch is a preprocessed admin.OIDCIdentityRule_ClaimMatch.
+type claimMatch struct {
+	claim string
+	re    *regexp.Regexp
+}
+
+// ConfigRevision is part of policy.Queryable interface.
+func (r *Rules) ConfigRevision() string {
+	return r.revision
+}
+
+// Issuer returns a trusted issuer given the value of `iss` claim.
+//
+// The `iss` claim is allowed to omit "https://" prefix. Returns nil if the
+// issuer is not in the config.
+func (r *Rules) Issuer(iss string) *Issuer {
+	if i := r.issuers[iss]; i != nil {
+		return i
+	}
+	return r.issuers["https://"+iss]
+}
+
+// AudienceAllowed returns true if any of the given audiences is accepted.
+func (i *Issuer) AudienceAllowed(aud []string) bool {
+	for _, a := range aud {
+		if i.audience.Has(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchRule returns the first rule that matches the claims or nil.
+func (i *Issuer) MatchRule(claims openid.Claims) *Rule {
+	for _, rule := range i.rules {
+		if rule.matches(claims) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// matches returns true if all claim conditions are satisfied.
+func (r *Rule) matches(claims openid.Claims) bool {
+	for _, m := range r.match {
+		val, ok := claims.Get(m.claim)
+		if !ok || !m.re.MatchString(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// Identity derives the LUCI identity from the claims using the rule template.
+//
+// Returns an error if some referenced claim is missing or has a value that
+// can't be safely substituted into the template.
+func (r *Rule) Identity(claims openid.Claims) (identity.Identity, error) {
+	return renderIdentity(r.identity, func(name string) (string, error) {
+		switch val, ok := claims.Get(name); {
+		case !ok:
+			return "", fmt.Errorf("claim %q is missing or not a scalar", name)
+		case !claimValueRe.MatchString(val):
+			return "", fmt.Errorf("claim %q has a value %q with forbidden characters", name, val)
+		default:
+			return val, nil
+		}
+	})
+}
+
+// renderIdentity substitutes placeholders in the identity template and
+// validates the result.
+func renderIdentity(tmpl string, value func(name string) (string, error)) (identity.Identity, error) {
+	var err error
+	out := placeholderRe.ReplaceAllStringFunc(tmpl, func(placeholder string) string {
+		name := placeholder[2 : len(placeholder)-1]
+		if err != nil {
+			return ""
+		}
+		if !claimNameRe.MatchString(name) {
+			err = fmt.Errorf("bad placeholder %q", placeholder)
+			return ""
+		}
+		var val string
+		val, err = value(name)
+		return val
+	})
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(out, "${") {
+		return "", fmt.Errorf("unterminated placeholder in %q", tmpl)
+	}
+	return identity.MakeIdentity(out)
+}
+
+// RulesCache is a stateful object with parsed oidc_federation.cfg.
+//
+// It uses policy.Policy internally to manage datastore-cached copy of imported
+// configs.
+//
+// Use NewRulesCache() to create a new instance. Each instance owns its own
+// in-memory cache, but uses the same shared datastore cache.
+//
+// There's also a process global instance of RulesCache (GlobalRulesCache var)
+// which is used by the main process. Unit tests don't use it though to avoid
+// relying on shared state.
+type RulesCache struct {
+	policy policy.Policy // holds cached *Rules
+}
+
+// GlobalRulesCache is the process-wide rules cache.
+var GlobalRulesCache = NewRulesCache()
+
+// NewRulesCache properly initializes RulesCache instance.
+func NewRulesCache() *RulesCache {
+	return &RulesCache{
+		policy: policy.Policy{
+			Name:     configFileName,       // used as part of datastore keys
+			Fetch:    fetchConfigs,         // see below
+			Validate: validateConfigBundle, // see config_validation.go
+			Prepare:  prepareRules,         // see below
+		},
+	}
+}
+
+// ImportConfigs refetches oidc_federation.cfg and updates the datastore.
+//
+// Called from cron.
+func (rc *RulesCache) ImportConfigs(ctx context.Context) (rev string, err error) {
+	return rc.policy.ImportConfigs(ctx)
+}
+
+// SetupConfigValidation registers the config validation rules.
+func (rc *RulesCache) SetupConfigValidation(rules *validation.RuleSet) {
+	rules.Add("services/${appid}", configFileName, func(ctx *validation.Context, configSet, path string, content []byte) error {
+		cfg := &admin.OIDCFederationConfig{}
+		if err := prototext.Unmarshal(content, cfg); err != nil {
+			ctx.Errorf("not a valid OIDCFederationConfig proto message - %s", err)
+		} else {
+			validateFederationCfg(ctx, cfg)
+		}
+		return nil
+	})
+}
+
+// Rules returns in-memory copy of the federation rules, ready for querying.
+func (rc *RulesCache) Rules(ctx context.Context) (*Rules, error) {
+	q, err := rc.policy.Queryable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return q.(*Rules), nil
+}
+
+// fetchConfigs loads proto messages with the rules from the config.
+func fetchConfigs(ctx context.Context, f policy.ConfigFetcher) (policy.ConfigBundle, error) {
+	cfg := &admin.OIDCFederationConfig{}
+	if err := f.FetchTextProto(ctx, configFileName, cfg); err != nil {
+		return nil, err
+	}
+	return policy.ConfigBundle{configFileName: cfg}, nil
+}
+
+// prepareRules converts validated configs into *Rules.
+//
+// Returns them as a policy.Queryable object to satisfy policy.Policy API.
+func prepareRules(ctx context.Context, cfg policy.ConfigBundle, revision string) (policy.Queryable, error) {
+	parsed, ok := cfg[configFileName].(*admin.OIDCFederationConfig)
+	if !ok {
+		return nil, fmt.Errorf("wrong type of %s - %T", configFileName, cfg[configFileName])
+	}
+
+	issuers := make(map[string]*Issuer, len(parsed.Issuer))
+	for _, iss := range parsed.Issuer {
+		discoveryURL := iss.DiscoveryUrl
+		if discoveryURL == "" {
+			discoveryURL = strings.TrimSuffix(iss.Issuer, "/") + "/.well-known/openid-configuration"
+		}
+		issuer := &Issuer{
+			Issuer:       iss.Issuer,
+			DiscoveryURL: discoveryURL,
+			audience:     stringset.NewFromSlice(iss.Audience...),
+			rules:        make([]*Rule, 0, len(iss.Rule)),
+		}
+		for _, r := range iss.Rule {
+			rule := &Rule{
+				Name:                r.Name,
+				TargetServices:      r.TargetService,
+				MaxValidityDuration: r.MaxValidityDuration,
+				match:               make([]claimMatch, len(r.Match)),
+				identity:            r.Identity,
+			}
+			if rule.MaxValidityDuration == 0 {
+				rule.MaxValidityDuration = defaultMaxValidityDuration
+			}
+			for i, m := range r.Match {
+				re, err := regexp.Compile("^(?:" + m.Regexp + ")$")
+				if err != nil {
+					return nil, fmt.Errorf("rule %q: bad regexp %q - %s", r.Name, m.Regexp, err)
+				}
+				rule.match[i] = claimMatch{claim: m.Claim, re: re}
+			}
+			issuer.rules = append(issuer.rules, rule)
+		}
+		issuers[iss.Issuer] = issuer
+	}
+
+	return &Rules{
+		revision: revision,
+		issuers:  issuers,
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth/openid"
+
+	"go.chromium.org/luci/tokenserver/api/admin/v1"
+	"go.chromium.org/luci/tokenserver/appengine/impl/utils/policy"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+const fakeFederationConfig = `
+issuer {
+	issuer: "https://ci.example.com"
+	audience: "https://luci.example.com"
+	rule {
+		name: "main-branch"
+		match { claim: "repository_owner" regexp: "good-org" }
+		match { claim: "ref" regexp: "refs/heads/main" }
+		identity: "user:${repository}@ci.example.com"
+		target_service: "service:some-service"
+		max_validity_duration: 1800
+	}
+	rule {
+		name: "any-branch"
+		match { claim: "repository_owner" regexp: "good-org|other-org" }
+		identity: "user:untrusted-${run_id}@ci.example.com"
+		target_service: "*"
+	}
+}
+
+issuer {
+	issuer: "https://k8s.example.com"
+	discovery_url: "https://k8s.example.com/custom-discovery"
+	audience: "aud"
+	rule {
+		name: "k8s"
+		match { claim: "sub" regexp: "system:serviceaccount:ci:.+" }
+		identity: "user:k8s-${cluster}@example.com"
+		target_service: "*"
+	}
+}
+`
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	Convey("With rules", t, func() {
+		ctx := context.Background()
+
+		rules, err := loadRules(ctx, fakeFederationConfig)
+		So(err, ShouldBeNil)
+		So(rules.ConfigRevision(), ShouldEqual, "fake-revision")
+
+		Convey("Issuer lookup", func() {
+			iss := rules.Issuer("https://ci.example.com")
+			So(iss, ShouldNotBeNil)
+			So(iss.DiscoveryURL, ShouldEqual, "https://ci.example.com/.well-known/openid-configuration")
+			So(rules.Issuer("ci.example.com"), ShouldEqual, iss)
+			So(rules.Issuer("https://k8s.example.com").DiscoveryURL, ShouldEqual, "https://k8s.example.com/custom-discovery")
+			So(rules.Issuer("https://unknown.example.com"), ShouldBeNil)
+		})
+
+		Convey("Audience check", func() {
+			iss := rules.Issuer("https://ci.example.com")
+			So(iss.AudienceAllowed([]string{"a", "https://luci.example.com"}), ShouldBeTrue)
+			So(iss.AudienceAllowed([]string{"a"}), ShouldBeFalse)
+			So(iss.AudienceAllowed(nil), ShouldBeFalse)
+		})
+
+		Convey("Rule matching", func() {
+			iss := rules.Issuer("https://ci.example.com")
+
+			rule := iss.MatchRule(openid.Claims{
+				"repository_owner": "good-org",
+				"repository":       "good-repo",
+				"ref":              "refs/heads/main",
+			})
+			So(rule.Name, ShouldEqual, "main-branch")
+			So(rule.MaxValidityDuration, ShouldEqual, 1800)
+			So(rule.TargetServices, ShouldResemble, []string{"service:some-service"})
+
+			rule = iss.MatchRule(openid.Claims{
+				"repository_owner": "good-org",
+				"ref":              "refs/heads/main-but-not-really",
+			})
+			So(rule.Name, ShouldEqual, "any-branch")
+			So(rule.MaxValidityDuration, ShouldEqual, defaultMaxValidityDuration)
+
+			// Partial matches don't count.
+			So(iss.MatchRule(openid.Claims{"repository_owner": "good-org-fake"}), ShouldBeNil)
+			// Missing claims don't match.
+			So(iss.MatchRule(openid.Claims{}), ShouldBeNil)
+		})
+
+		Convey("Identity", func() {
+			iss := rules.Issuer("https://ci.example.com")
+			rule := iss.MatchRule(openid.Claims{
+				"repository_owner": "good-org",
+				"ref":              "refs/heads/main",
+			})
+
+			id, err := rule.Identity(openid.Claims{"repository": "good-repo"})
+			So(err, ShouldBeNil)
+			So(id, ShouldEqual, identity.Identity("user:good-repo@ci.example.com"))
+
+			_, err = rule.Identity(openid.Claims{})
+			So(err, ShouldErrLike, `claim "repository" is missing or not a scalar`)
+
+			_, err = rule.Identity(openid.Claims{"repository": "evil@example.com#"})
+			So(err, ShouldErrLike, `has a value "evil@example.com#" with forbidden characters`)
+
+			// Numbers are OK.
+			rule = iss.MatchRule(openid.Claims{"repository_owner": "other-org"})
+			id, err = rule.Identity(openid.Claims{"run_id": float64(12345)})
+			So(err, ShouldBeNil)
+			So(id, ShouldEqual, identity.Identity("user:untrusted-12345@ci.example.com"))
+		})
+	})
+}
+
+func loadRules(ctx context.Context, text string) (*Rules, error) {
+	cfg := &admin.OIDCFederationConfig{}
+	if err := prototext.Unmarshal([]byte(text), cfg); err != nil {
+		return nil, err
+	}
+	rules, err := prepareRules(ctx, policy.ConfigBundle{configFileName: cfg}, "fake-revision")
+	if err != nil {
+		return nil, err
+	}
+	return rules.(*Rules), nil
+}
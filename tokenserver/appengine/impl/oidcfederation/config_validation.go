@@ -0,0 +1,159 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/config/validation"
+
+	"go.chromium.org/luci/tokenserver/api/admin/v1"
+	"go.chromium.org/luci/tokenserver/appengine/impl/utils/policy"
+)
+
+// validateConfigBundle validates the structure of a config bundle fetched by
+// fetchConfigs.
+func validateConfigBundle(ctx *validation.Context, bundle policy.ConfigBundle) {
+	ctx.SetFile(configFileName)
+	cfg, ok := bundle[configFileName].(*admin.OIDCFederationConfig)
+	if ok {
+		validateFederationCfg(ctx, cfg)
+	} else {
+		ctx.Errorf("unexpectedly wrong proto type %T", cfg)
+	}
+}
+
+// validateFederationCfg checks deserialized oidc_federation.cfg.
+func validateFederationCfg(ctx *validation.Context, cfg *admin.OIDCFederationConfig) {
+	issuers := stringset.New(0)
+	names := stringset.New(0)
+
+	for i, iss := range cfg.Issuer {
+		ctx.Enter("issuer #%d: %q", i+1, iss.Issuer)
+
+		if iss.Issuer == "" {
+			ctx.Errorf(`"issuer" is required`)
+		} else {
+			validateHTTPS(ctx, "issuer", iss.Issuer)
+			if !issuers.Add(iss.Issuer) {
+				ctx.Errorf("the issuer is specified more than once")
+			}
+		}
+		if iss.DiscoveryUrl != "" {
+			validateHTTPS(ctx, "discovery_url", iss.DiscoveryUrl)
+		}
+
+		if len(iss.Audience) == 0 {
+			ctx.Errorf(`"audience" is required`)
+		}
+		for _, aud := range iss.Audience {
+			if aud == "" {
+				ctx.Errorf("empty audience")
+			}
+		}
+
+		if len(iss.Rule) == 0 {
+			ctx.Errorf("at least one rule is required")
+		}
+		for j, rule := range iss.Rule {
+			if rule.Name != "" && !names.Add(rule.Name) {
+				ctx.Errorf("two rules with identical name %q", rule.Name)
+			}
+			validateRule(ctx, fmt.Sprintf("rule #%d: %q", j+1, rule.Name), rule)
+		}
+
+		ctx.Exit()
+	}
+}
+
+// validateHTTPS checks the value is an https:// URL.
+func validateHTTPS(ctx *validation.Context, field, val string) {
+	switch u, err := url.Parse(val); {
+	case err != nil:
+		ctx.Errorf("bad %q %q: %s", field, val, err)
+	case u.Scheme != "https" || u.Host == "":
+		ctx.Errorf("bad %q %q: must be an https:// URL", field, val)
+	}
+}
+
+// validateRule checks single OIDCIdentityRule proto.
+//
+// See config.proto, OIDCIdentityRule for the description of allowed values.
+func validateRule(ctx *validation.Context, title string, r *admin.OIDCIdentityRule) {
+	ctx.Enter(title)
+	defer ctx.Exit()
+
+	if r.Name == "" {
+		ctx.Errorf(`"name" is required`)
+	}
+
+	// Without conditions any token from the issuer matches. For public issuers
+	// like GitHub Actions this is the whole internet.
+	if len(r.Match) == 0 {
+		ctx.Errorf(`at least one "match" is required`)
+	}
+	for _, m := range r.Match {
+		if m.Claim == "" {
+			ctx.Errorf(`"claim" is required in "match"`)
+		}
+		if _, err := regexp.Compile("^(?:" + m.Regexp + ")$"); err != nil {
+			ctx.Errorf("bad regexp %q: %s", m.Regexp, err)
+		}
+	}
+
+	if r.Identity == "" {
+		ctx.Errorf(`"identity" is required`)
+	} else {
+		// Substitute a dummy value to check the template produces identities.
+		placeholders := 0
+		_, err := renderIdentity(r.Identity, func(string) (string, error) {
+			placeholders++
+			return "x", nil
+		})
+		switch {
+		case err != nil:
+			ctx.Errorf("bad identity template %q: %s", r.Identity, err)
+		case placeholders == 0:
+			// All tokens matching the rule would share the same identity.
+			ctx.Errorf("bad identity template %q: must have at least one ${claim} placeholder", r.Identity)
+		}
+	}
+
+	if len(r.TargetService) == 0 {
+		ctx.Errorf(`"target_service" is required`)
+	}
+	for _, srv := range r.TargetService {
+		if srv == "*" {
+			continue
+		}
+		switch id, err := identity.MakeIdentity(srv); {
+		case err != nil:
+			ctx.Errorf("bad target_service %q: %s", srv, err)
+		case id.Kind() != identity.Service:
+			ctx.Errorf("bad target_service %q: not a service identity", srv)
+		}
+	}
+
+	switch {
+	case r.MaxValidityDuration < 0:
+		ctx.Errorf(`"max_validity_duration" must be positive`)
+	case r.MaxValidityDuration > 24*3600:
+		ctx.Errorf(`"max_validity_duration" must be smaller than 86401`)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"go.chromium.org/luci/config/validation"
+
+	"go.chromium.org/luci/tokenserver/api/admin/v1"
+	"go.chromium.org/luci/tokenserver/appengine/impl/utils/policy"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cfg    string
+		Errors []string
+	}{
+		{
+			// Non-trivial good config.
+			Cfg: fakeFederationConfig,
+		},
+
+		// Minimal config.
+		{
+			Cfg: ``,
+		},
+
+		// Bad issuers.
+		{
+			Cfg: `
+				issuer {
+					audience: "aud"
+					rule { name: "r1" match { claim: "c" regexp: "v" } identity: "user:${c}@example.com" target_service: "*" }
+				}
+				issuer {
+					issuer: "http://insecure.example.com"
+					discovery_url: "not-a-url"
+					audience: "aud"
+					rule { name: "r2" match { claim: "c" regexp: "v" } identity: "user:${c}@example.com" target_service: "*" }
+				}
+				issuer {
+					issuer: "https://dup.example.com"
+					audience: "aud"
+					rule { name: "r3" match { claim: "c" regexp: "v" } identity: "user:${c}@example.com" target_service: "*" }
+				}
+				issuer {
+					issuer: "https://dup.example.com"
+					audience: "aud"
+					rule { name: "r4" match { claim: "c" regexp: "v" } identity: "user:${c}@example.com" target_service: "*" }
+				}
+			`,
+			Errors: []string{
+				`"issuer" is required`,
+				`bad "issuer" "http://insecure.example.com": must be an https:// URL`,
+				`bad "discovery_url" "not-a-url": must be an https:// URL`,
+				`the issuer is specified more than once`,
+			},
+		},
+
+		// Missing audience and rules.
+		{
+			Cfg: `
+				issuer {
+					issuer: "https://ci.example.com"
+					audience: ""
+				}
+			`,
+			Errors: []string{
+				`empty audience`,
+				`at least one rule is required`,
+			},
+		},
+
+		// Bad rules.
+		{
+			Cfg: `
+				issuer {
+					issuer: "https://ci.example.com"
+					audience: "aud"
+					rule {
+						match { regexp: "(" }
+						identity: "user:${bad name}@example.com"
+						target_service: "user:a@example.com"
+						target_service: "huh"
+						max_validity_duration: -1
+					}
+					rule {
+						name: "dup"
+						match { claim: "c" regexp: "v" }
+						identity: "user:${unterminated@example.com"
+						max_validity_duration: 100000
+					}
+					rule {
+						name: "dup"
+						match { claim: "c" regexp: "v" }
+						identity: "${claim}"
+						target_service: "*"
+					}
+				}
+			`,
+			Errors: []string{
+				`"name" is required`,
+				`"claim" is required in "match"`,
+				`bad regexp "("`,
+				`bad identity template "user:${bad name}@example.com": bad placeholder "${bad name}"`,
+				`bad target_service "user:a@example.com": not a service identity`,
+				`bad target_service "huh"`,
+				`"max_validity_duration" must be positive`,
+				`bad identity template "user:${unterminated@example.com": unterminated placeholder`,
+				`"target_service" is required`,
+				`"max_validity_duration" must be smaller than 86401`,
+				`two rules with identical name "dup"`,
+				`bad identity template "${claim}"`,
+			},
+		},
+
+		// Rules that would match tokens of any workload of the issuer.
+		{
+			Cfg: `
+				issuer {
+					issuer: "https://ci.example.com"
+					audience: "aud"
+					rule {
+						name: "no-match"
+						identity: "user:${repository_owner_id}@example.com"
+						target_service: "*"
+					}
+					rule {
+						name: "constant"
+						match { claim: "repository_owner_id" regexp: "123" }
+						identity: "user:ci@example.com"
+						target_service: "*"
+					}
+				}
+			`,
+			Errors: []string{
+				`at least one "match" is required`,
+				`bad identity template "user:ci@example.com": must have at least one ${claim} placeholder`,
+			},
+		},
+	}
+
+	Convey("Validation works", t, func(c C) {
+		for idx, cs := range cases {
+			c.Printf("Case #%d\n", idx)
+
+			cfg := &admin.OIDCFederationConfig{}
+			err := prototext.Unmarshal([]byte(cs.Cfg), cfg)
+			So(err, ShouldBeNil)
+
+			ctx := &validation.Context{Context: context.Background()}
+			validateConfigBundle(ctx, policy.ConfigBundle{configFileName: cfg})
+			verr := ctx.Finalize()
+
+			if len(cs.Errors) == 0 {
+				So(verr, ShouldBeNil)
+			} else {
+				verr := verr.(*validation.Error)
+				So(verr.Errors, ShouldHaveLength, len(cs.Errors))
+				for i, err := range verr.Errors {
+					So(err, ShouldErrLike, cs.Errors[i])
+				}
+			}
+		}
+	})
+}
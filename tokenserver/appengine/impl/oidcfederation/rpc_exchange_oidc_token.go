@@ -0,0 +1,217 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/auth/jwt"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/delegation/messages"
+	"go.chromium.org/luci/server/auth/openid"
+	"go.chromium.org/luci/server/auth/signing"
+
+	"go.chromium.org/luci/tokenserver/api/minter/v1"
+
+	"go.chromium.org/luci/tokenserver/appengine/impl/delegation"
+	"go.chromium.org/luci/tokenserver/appengine/impl/utils"
+)
+
+// ExchangeOIDCTokenRPC implements TokenMinter.ExchangeOIDCToken RPC method.
+type ExchangeOIDCTokenRPC struct {
+	// Signer is mocked in tests.
+	//
+	// In prod it is the default server signer that uses server's service account.
+	Signer signing.Signer
+
+	// Rules returns federation rules to use for the request.
+	//
+	// In prod it is GlobalRulesCache.Rules.
+	Rules func(context.Context) (*Rules, error)
+
+	// SigningKeys returns keys used to verify ID tokens of an issuer.
+	//
+	// In prod it is FetchSigningKeys.
+	SigningKeys func(ctx context.Context, discoveryURL string) (jwt.SignatureVerifier, error)
+
+	// LogToken is mocked in tests.
+	//
+	// In prod it is produced by NewTokenLogger.
+	LogToken TokenLogger
+}
+
+// FetchSigningKeys fetches the discovery document and the JSON Web Key set it
+// points to.
+//
+// Both are cached in the process cache.
+func FetchSigningKeys(ctx context.Context, discoveryURL string) (jwt.SignatureVerifier, error) {
+	doc, err := openid.FetchDiscoveryDoc(ctx, discoveryURL)
+	if err != nil {
+		return nil, err
+	}
+	return doc.SigningKeys(ctx)
+}
+
+// ExchangeOIDCToken exchanges an external ID token for a delegation token.
+func (r *ExchangeOIDCTokenRPC) ExchangeOIDCToken(ctx context.Context, req *minter.ExchangeOIDCTokenRequest) (*minter.ExchangeOIDCTokenResponse, error) {
+	if req.IdToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bad request - 'id_token' is required")
+	}
+
+	// Grab a string that identifies token server version. This almost always
+	// just hits local memory cache.
+	serviceVer, err := utils.ServiceVersion(ctx, r.Signer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "can't grab service version - %s", err)
+	}
+
+	rules, err := r.Rules(ctx)
+	if err != nil {
+		// Don't put error details in the message, it is returned to unauthorized
+		// callers.
+		logging.WithError(err).Errorf(ctx, "Failed to load OIDC federation rules")
+		return nil, status.Errorf(codes.Internal, "failed to load OIDC federation rules")
+	}
+
+	// Peek at the issuer to know what keys to use to verify the token. The
+	// token is fully verified below.
+	var unverified struct {
+		Iss string `json:"iss"`
+	}
+	if err := jwt.UnsafeDecode(req.IdToken, &unverified); err != nil {
+		logging.WithError(err).Errorf(ctx, "Bad ID token")
+		return nil, status.Errorf(codes.InvalidArgument, "bad request - malformed 'id_token': %s", err)
+	}
+	issuer := rules.Issuer(unverified.Iss)
+	if issuer == nil {
+		logging.Errorf(ctx, "Untrusted issuer %q", unverified.Iss)
+		return nil, status.Errorf(codes.PermissionDenied, "issuer %q is not trusted", unverified.Iss)
+	}
+
+	keys, err := r.SigningKeys(ctx, issuer.DiscoveryURL)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to fetch signing keys of %q", issuer.Issuer)
+		return nil, status.Errorf(codes.Internal, "failed to fetch signing keys of the issuer")
+	}
+	claims, err := openid.VerifyClaims(ctx, req.IdToken, keys, issuer.Issuer)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "ID token verification failed")
+		return nil, status.Errorf(codes.Unauthenticated, "%s", err)
+	}
+	sub, _ := claims.Get("sub")
+	aud := claims.Audiences()
+	logging.Infof(ctx, "ID token: iss=%q sub=%q aud=%q", issuer.Issuer, sub, aud)
+
+	if !issuer.AudienceAllowed(aud) {
+		logging.Errorf(ctx, "The audience %q is not accepted", aud)
+		return nil, status.Errorf(codes.PermissionDenied, "the ID token audience %q is not accepted", aud)
+	}
+
+	// Consult the config to find the rule that maps the token to an identity.
+	rule := issuer.MatchRule(claims)
+	if rule == nil {
+		logging.Errorf(ctx, "No matching rules")
+		return nil, status.Errorf(codes.PermissionDenied, "forbidden - no rules match the ID token claims")
+	}
+	ident, err := rule.Identity(claims)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to derive the identity using rule %q", rule.Name)
+		return nil, status.Errorf(codes.PermissionDenied, "forbidden - can't derive the identity: %s", err)
+	}
+	logging.Infof(ctx, "Found the matching rule %q in the config rev %s, delegating %q", rule.Name, rules.ConfigRevision(), ident)
+
+	// Validate requested token lifetime.
+	if req.ValidityDuration == 0 {
+		req.ValidityDuration = min(3600, rule.MaxValidityDuration)
+	}
+	if req.ValidityDuration < 0 {
+		err = fmt.Errorf("invalid 'validity_duration' (%d)", req.ValidityDuration)
+		logging.WithError(err).Errorf(ctx, "Bad request")
+		return nil, status.Errorf(codes.InvalidArgument, "bad request - %s", err)
+	}
+	if req.ValidityDuration > rule.MaxValidityDuration {
+		err = fmt.Errorf(
+			"the requested validity duration (%d sec) exceeds the maximum allowed one (%d sec)",
+			req.ValidityDuration, rule.MaxValidityDuration)
+		logging.WithError(err).Errorf(ctx, "Validity duration check didn't pass")
+		return nil, status.Errorf(codes.PermissionDenied, "forbidden - %s", err)
+	}
+
+	// Tags are transferred intact to the final token.
+	if err := utils.ValidateTags(req.Tags); err != nil {
+		err = fmt.Errorf("invalid 'tags': %s", err)
+		logging.WithError(err).Errorf(ctx, "Bad request")
+		return nil, status.Errorf(codes.InvalidArgument, "bad request - %s", err)
+	}
+
+	id, err := delegation.GenerateTokenID(ctx)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Error when generating token ID.")
+		return nil, status.Errorf(codes.Internal, "error when generating token ID - %s", err)
+	}
+
+	// The external workload has no other LUCI credentials, so the token is
+	// usable by any bearer.
+	subtok := &messages.Subtoken{
+		Kind:              messages.Subtoken_BEARER_DELEGATION_TOKEN,
+		SubtokenId:        id,
+		DelegatedIdentity: string(ident),
+		RequestorIdentity: string(ident),
+		CreationTime:      clock.Now(ctx).Unix(),
+		ValidityDuration:  int32(req.ValidityDuration),
+		Audience:          []string{"*"},
+		Services:          rule.TargetServices,
+		Tags:              req.Tags,
+	}
+	signed, err := delegation.SignToken(ctx, r.Signer, subtok)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Error when signing the token.")
+		return nil, status.Errorf(codes.Internal, "error when signing the token - %s", err)
+	}
+
+	resp := &minter.ExchangeOIDCTokenResponse{
+		Token:              signed,
+		DelegationSubtoken: subtok,
+		ServiceVersion:     serviceVer,
+	}
+
+	if r.LogToken != nil {
+		// Errors during logging are considered not fatal. We have a monitoring
+		// counter that tracks number of errors, so they are not totally invisible.
+		tokInfo := MintedTokenInfo{
+			Request:   req,
+			Response:  resp,
+			Issuer:    issuer.Issuer,
+			Subject:   sub,
+			Audience:  aud,
+			ConfigRev: rules.ConfigRevision(),
+			Rule:      rule,
+			PeerIP:    auth.GetState(ctx).PeerIP(),
+			RequestID: trace.SpanContextFromContext(ctx).TraceID().String(),
+		}
+		if logErr := r.LogToken(ctx, &tokInfo); logErr != nil {
+			logging.WithError(logErr).Errorf(ctx, "Failed to insert the OIDC exchanged token into the BigQuery log")
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/appengine/gaetesting"
+	"go.chromium.org/luci/auth/jwt"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/auth/delegation/messages"
+	"go.chromium.org/luci/server/auth/openid"
+	"go.chromium.org/luci/server/auth/signing"
+	"go.chromium.org/luci/server/auth/signing/signingtest"
+
+	bqpb "go.chromium.org/luci/tokenserver/api/bq"
+	"go.chromium.org/luci/tokenserver/api/minter/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var testingRequestID = trace.TraceID{1, 2, 3, 4, 5}
+
+const testKeyID = "test-key"
+
+func testingContext() context.Context {
+	ctx := gaetesting.TestingContext()
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: testingRequestID,
+	}))
+	ctx, _ = testclock.UseTime(ctx, time.Date(2015, time.February, 3, 4, 5, 6, 0, time.UTC))
+	return auth.WithState(ctx, &authtest.FakeState{
+		PeerIPOverride: net.ParseIP("127.10.10.10"),
+	})
+}
+
+func testingSigner() *signingtest.Signer {
+	return signingtest.NewSigner(&signing.ServiceInfo{
+		ServiceAccountName: "signer@testing.host",
+		AppID:              "unit-tests",
+		AppVersion:         "mocked-ver",
+	})
+}
+
+// jwksForTest returns a key set with the public key of the signer.
+func jwksForTest(pubKey *rsa.PublicKey) *openid.JSONWebKeySet {
+	exp := []byte{0, 0, 0, 0}
+	binary.BigEndian.PutUint32(exp, uint32(pubKey.E))
+	keys, err := openid.NewJSONWebKeySet(&openid.JSONWebKeySetStruct{
+		Keys: []openid.JSONWebKeyStruct{
+			{
+				Kty: "RSA",
+				Alg: "RS256",
+				Use: "sig",
+				Kid: testKeyID,
+				N:   base64.RawURLEncoding.EncodeToString(pubKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(exp),
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}
+
+// idTokenForTest produces a JWT with the given claims signed by the signer.
+func idTokenForTest(ctx context.Context, claims map[string]any, signer *signingtest.Signer) string {
+	body, err := json.Marshal(claims)
+	if err != nil {
+		panic(err)
+	}
+	b64hdr := base64.RawURLEncoding.EncodeToString([]byte(
+		fmt.Sprintf(`{"alg": "RS256","kid": "%s"}`, testKeyID)))
+	b64bdy := base64.RawURLEncoding.EncodeToString(body)
+	_, sig, err := signer.SignBytes(ctx, []byte(b64hdr+"."+b64bdy))
+	if err != nil {
+		panic(err)
+	}
+	return b64hdr + "." + b64bdy + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestExchangeOIDCToken(t *testing.T) {
+	t.Parallel()
+
+	Convey("with mocked context", t, func() {
+		ctx := testingContext()
+
+		rules, err := loadRules(ctx, fakeFederationConfig)
+		So(err, ShouldBeNil)
+
+		// Signs ID tokens of the external issuer.
+		issuerSigner := signingtest.NewSigner(nil)
+		issuerKeys := jwksForTest(&issuerSigner.KeyForTest().PublicKey)
+
+		var loggedInfo *MintedTokenInfo
+		var requestedDiscoveryURL string
+		rpc := ExchangeOIDCTokenRPC{
+			Signer: testingSigner(),
+			Rules:  func(context.Context) (*Rules, error) { return rules, nil },
+			SigningKeys: func(_ context.Context, discoveryURL string) (jwt.SignatureVerifier, error) {
+				requestedDiscoveryURL = discoveryURL
+				return issuerKeys, nil
+			},
+			LogToken: func(_ context.Context, info *MintedTokenInfo) error {
+				loggedInfo = info
+				return nil
+			},
+		}
+
+		claims := map[string]any{
+			"iss":              "https://ci.example.com",
+			"sub":              "repo:good-org/good-repo:ref:refs/heads/main",
+			"aud":              "https://luci.example.com",
+			"exp":              clock.Now(ctx).Add(time.Hour).Unix(),
+			"repository_owner": "good-org",
+			"repository":       "good-repo",
+			"ref":              "refs/heads/main",
+		}
+
+		exchange := func(validity int64) (*minter.ExchangeOIDCTokenResponse, error) {
+			return rpc.ExchangeOIDCToken(ctx, &minter.ExchangeOIDCTokenRequest{
+				IdToken:          idTokenForTest(ctx, claims, issuerSigner),
+				ValidityDuration: validity,
+				Tags:             []string{"k:v"},
+			})
+		}
+
+		Convey("Happy path", func() {
+			resp, err := exchange(0)
+			So(err, ShouldBeNil)
+			So(requestedDiscoveryURL, ShouldEqual, "https://ci.example.com/.well-known/openid-configuration")
+			So(resp.Token, ShouldNotBeEmpty)
+			So(resp.ServiceVersion, ShouldEqual, "unit-tests/mocked-ver")
+			So(resp.DelegationSubtoken, ShouldResembleProto, &messages.Subtoken{
+				Kind:              messages.Subtoken_BEARER_DELEGATION_TOKEN,
+				SubtokenId:        resp.DelegationSubtoken.SubtokenId,
+				DelegatedIdentity: "user:good-repo@ci.example.com",
+				RequestorIdentity: "user:good-repo@ci.example.com",
+				CreationTime:      clock.Now(ctx).Unix(),
+				ValidityDuration:  1800,
+				Audience:          []string{"*"},
+				Services:          []string{"service:some-service"},
+				Tags:              []string{"k:v"},
+			})
+
+			So(loggedInfo.toBigQueryMessage(), ShouldResembleProto, &bqpb.OIDCExchangedToken{
+				Fingerprint:       loggedInfo.toBigQueryMessage().Fingerprint,
+				TokenId:           fmt.Sprintf("%d", resp.DelegationSubtoken.SubtokenId),
+				DelegatedIdentity: "user:good-repo@ci.example.com",
+				OidcIssuer:        "https://ci.example.com",
+				OidcSubject:       "repo:good-org/good-repo:ref:refs/heads/main",
+				OidcAudience:      []string{"https://luci.example.com"},
+				IssuedAt:          &timestamppb.Timestamp{Seconds: 1422936306},
+				Expiration:        &timestamppb.Timestamp{Seconds: 1422938106},
+				TargetServices:    []string{"service:some-service"},
+				Tags:              []string{"k:v"},
+				ConfigRev:         "fake-revision",
+				ConfigRule:        "main-branch",
+				PeerIp:            "127.10.10.10",
+				ServiceVersion:    "unit-tests/mocked-ver",
+				GaeRequestId:      testingRequestID.String(),
+			})
+		})
+
+		Convey("Issuer without https:// prefix", func() {
+			claims["iss"] = "ci.example.com"
+			_, err := exchange(0)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Empty request", func() {
+			_, err := rpc.ExchangeOIDCToken(ctx, &minter.ExchangeOIDCTokenRequest{})
+			So(err, ShouldBeRPCInvalidArgument, "'id_token' is required")
+		})
+
+		Convey("Not a JWT", func() {
+			_, err := rpc.ExchangeOIDCToken(ctx, &minter.ExchangeOIDCTokenRequest{IdToken: "huh"})
+			So(err, ShouldBeRPCInvalidArgument, "malformed 'id_token'")
+		})
+
+		Convey("Unknown issuer", func() {
+			claims["iss"] = "https://unknown.example.com"
+			_, err := exchange(0)
+			So(err, ShouldBeRPCPermissionDenied, `issuer "https://unknown.example.com" is not trusted`)
+		})
+
+		Convey("Bad signature", func() {
+			tok := idTokenForTest(ctx, claims, signingtest.NewSigner(nil))
+			_, err := rpc.ExchangeOIDCToken(ctx, &minter.ExchangeOIDCTokenRequest{IdToken: tok})
+			So(err, ShouldHaveRPCCode, codes.Unauthenticated)
+		})
+
+		Convey("Expired token", func() {
+			claims["exp"] = clock.Now(ctx).Add(-time.Hour).Unix()
+			_, err := exchange(0)
+			So(err, ShouldHaveRPCCode, codes.Unauthenticated, "expired")
+		})
+
+		Convey("Wrong audience", func() {
+			claims["aud"] = "https://another.example.com"
+			_, err := exchange(0)
+			So(err, ShouldBeRPCPermissionDenied, "audience")
+		})
+
+		Convey("No matching rules", func() {
+			claims["repository_owner"] = "evil-org"
+			_, err := exchange(0)
+			So(err, ShouldBeRPCPermissionDenied, "no rules match")
+		})
+
+		Convey("Bad claim value", func() {
+			claims["repository"] = "../evil"
+			_, err := exchange(0)
+			So(err, ShouldBeRPCPermissionDenied, "can't derive the identity")
+		})
+
+		Convey("Too long validity duration", func() {
+			_, err := exchange(3600)
+			So(err, ShouldBeRPCPermissionDenied, "exceeds the maximum allowed one (1800 sec)")
+		})
+
+		Convey("Negative validity duration", func() {
+			_, err := exchange(-1)
+			So(err, ShouldBeRPCInvalidArgument, "invalid 'validity_duration'")
+		})
+	})
+}
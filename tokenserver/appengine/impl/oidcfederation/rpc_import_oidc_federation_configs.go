@@ -0,0 +1,48 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcfederation
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/config/validation"
+
+	"go.chromium.org/luci/tokenserver/api/admin/v1"
+)
+
+// ImportOIDCFederationConfigsRPC implements the corresponding method.
+type ImportOIDCFederationConfigsRPC struct {
+	RulesCache *RulesCache // usually GlobalRulesCache, but replaced in tests
+}
+
+// ImportOIDCFederationConfigs fetches configs from luci-config right now.
+func (r *ImportOIDCFederationConfigsRPC) ImportOIDCFederationConfigs(ctx context.Context, _ *emptypb.Empty) (*admin.ImportedConfigs, error) {
+	rev, err := r.RulesCache.ImportConfigs(ctx)
+	if err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to fetch OIDC federation configs")
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+	return &admin.ImportedConfigs{Revision: rev}, nil
+}
+
+// SetupConfigValidation registers the config validation rules.
+func (r *ImportOIDCFederationConfigsRPC) SetupConfigValidation(rules *validation.RuleSet) {
+	r.RulesCache.SetupConfigValidation(rules)
+}
@@ -26,6 +26,7 @@ import (
 	"go.chromium.org/luci/tokenserver/appengine/impl/certconfig"
 	"go.chromium.org/luci/tokenserver/appengine/impl/delegation"
 	"go.chromium.org/luci/tokenserver/appengine/impl/machinetoken"
+	"go.chromium.org/luci/tokenserver/appengine/impl/oidcfederation"
 	"go.chromium.org/luci/tokenserver/appengine/impl/projectscope"
 	"go.chromium.org/luci/tokenserver/appengine/impl/serviceaccounts"
 )
@@ -38,6 +39,7 @@ type AdminServer struct {
 	delegation.ImportDelegationConfigsRPC
 	delegation.InspectDelegationTokenRPC
 	machinetoken.InspectMachineTokenRPC
+	oidcfederation.ImportOIDCFederationConfigsRPC
 	projectscope.ImportProjectIdentityConfigsRPC
 	serviceaccounts.ImportProjectOwnedAccountsConfigsRPC
 }
@@ -56,6 +58,9 @@ func NewServer(signer signing.Signer) *AdminServer {
 		InspectMachineTokenRPC: machinetoken.InspectMachineTokenRPC{
 			Signer: signer,
 		},
+		ImportOIDCFederationConfigsRPC: oidcfederation.ImportOIDCFederationConfigsRPC{
+			RulesCache: oidcfederation.GlobalRulesCache,
+		},
 		ImportProjectIdentityConfigsRPC: projectscope.ImportProjectIdentityConfigsRPC{},
 		ImportProjectOwnedAccountsConfigsRPC: serviceaccounts.ImportProjectOwnedAccountsConfigsRPC{
 			MappingCache: serviceaccounts.GlobalMappingCache,
@@ -25,6 +25,7 @@ import (
 	"go.chromium.org/luci/tokenserver/appengine/impl/certchecker"
 	"go.chromium.org/luci/tokenserver/appengine/impl/delegation"
 	"go.chromium.org/luci/tokenserver/appengine/impl/machinetoken"
+	"go.chromium.org/luci/tokenserver/appengine/impl/oidcfederation"
 	"go.chromium.org/luci/tokenserver/appengine/impl/projectscope"
 	"go.chromium.org/luci/tokenserver/appengine/impl/serviceaccounts"
 	"go.chromium.org/luci/tokenserver/appengine/impl/utils/projectidentity"
@@ -36,6 +37,7 @@ type serverImpl struct {
 
 	machinetoken.MintMachineTokenRPC
 	delegation.MintDelegationTokenRPC
+	oidcfederation.ExchangeOIDCTokenRPC
 	projectscope.MintProjectTokenRPC
 	serviceaccounts.MintServiceAccountTokenRPC
 }
@@ -55,6 +57,12 @@ func NewServer(signer signing.Signer, prod bool) minter.TokenMinterServer {
 			Rules:    delegation.GlobalRulesCache.Rules,
 			LogToken: delegation.NewTokenLogger(!prod),
 		},
+		ExchangeOIDCTokenRPC: oidcfederation.ExchangeOIDCTokenRPC{
+			Signer:      signer,
+			Rules:       oidcfederation.GlobalRulesCache.Rules,
+			SigningKeys: oidcfederation.FetchSigningKeys,
+			LogToken:    oidcfederation.NewTokenLogger(!prod),
+		},
 		MintProjectTokenRPC: projectscope.MintProjectTokenRPC{
 			Signer:            signer,
 			MintAccessToken:   auth.MintAccessTokenForServiceAccount,
@@ -18,6 +18,13 @@ bqschemaupdater \
   -message "tokenserver.bq.MachineToken" \
   -partitioning-expiration "2160h"  # 90d
 
+bqschemaupdater \
+  -table "$PROJECT_ID.tokens.oidc_exchanged_tokens" \
+  -friendly-name "Delegation tokens exchanged for external ID tokens." \
+  -message-dir "$THIS_DIR/api/bq" \
+  -message "tokenserver.bq.OIDCExchangedToken" \
+  -partitioning-expiration "8760h"  # 1y
+
 bqschemaupdater \
   -table "$PROJECT_ID.tokens.project_tokens" \
   -friendly-name "Issued project tokens." \